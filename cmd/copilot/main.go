@@ -5,39 +5,82 @@
 package main
 
 import (
+	"errors"
+	"fmt"
 	"os"
+	osexec "os/exec"
+	"strings"
+	"time"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/cli"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/spf13/cobra"
 )
 
 func init() {
 	color.DisableColorBasedOnEnvVar()
+	progress.SetModeBasedOnEnvVar()
 	cobra.EnableCommandSorting = false // Maintain the order in which we add commands.
 }
 
 func main() {
 	cmd := buildRootCmd()
-	if err := cmd.Execute(); err != nil {
+	if ran, err := cli.RunPlugin(cmd, os.Args[1:]); ran {
+		var exitErr *osexec.ExitError
+		if errors.As(err, &exitErr) {
+			// The plugin already reported its error.
+			os.Exit(exitErr.ExitCode())
+		}
+		if err != nil {
+			log.Errorln(err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+	start := time.Now()
+	executed, err := cmd.ExecuteC()
+	cli.RecordUsage(executed, start, err)
+	if err != nil {
 		log.Errorln(err.Error())
 		os.Exit(1)
 	}
 }
 
+const (
+	progressFlag      = "progress"
+	jsonFlag          = "json"
+	strictVersionFlag = "strict-version"
+)
+
+var progressFlagDescription = fmt.Sprintf(`How deployment progress is displayed, must be one of %s.
+Use "plain" for one timestamped line per event, without re-rendering.
+Defaults to the %s environment variable or "tree".`, strings.Join(progress.Modes, ", "), progress.ModeEnvVar)
+
+const jsonFlagDescription = `Optional. Writes the result of the command to stdout as JSON.
+Messages and progress updates are still written to stderr.`
+
+const strictVersionFlagDescription = `Optional. Fails the command if an application or environment
+was deployed with a newer version of Copilot, instead of logging a warning.`
+
 func buildRootCmd() *cobra.Command {
+	var progressMode string
 	cmd := &cobra.Command{
 		Use:   "copilot",
 		Short: shortDescription,
 		Example: `
   Displays the help menu for the "init" command.
   /code $ copilot init --help`,
-		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			// If we don't set a Run() function the help menu doesn't show up.
 			// See https://github.com/spf13/cobra/issues/790
+			if !cmd.Flags().Changed(progressFlag) {
+				return nil
+			}
+			return progress.SetMode(progressMode)
 		},
 		SilenceUsage:  true,
 		SilenceErrors: true,
@@ -47,11 +90,14 @@ func buildRootCmd() *cobra.Command {
 	// version information.
 	cmd.Version = version.Version
 	cmd.SetVersionTemplate("copilot version: {{.Version}}\n")
+	cmd.PersistentFlags().StringVar(&progressMode, progressFlag, "", progressFlagDescription)
+	cmd.PersistentFlags().Bool(jsonFlag, false, jsonFlagDescription)
+	cmd.PersistentFlags().Bool(strictVersionFlag, false, strictVersionFlagDescription)
 
 	// NOTE: Order for each grouping below is significant in that it affects help menu output ordering.
 	// "Getting Started" command group.
 	cmd.AddCommand(cli.BuildInitCmd())
-	cmd.AddCommand(cli.BuildDocsCmd())
+	cmd.AddCommand(cli.BuildDocsCmd(cmd))
 
 	// "Develop" command group.
 	cmd.AddCommand(cli.BuildAppCmd())
@@ -59,12 +105,17 @@ func buildRootCmd() *cobra.Command {
 	cmd.AddCommand(cli.BuildSvcCmd())
 	cmd.AddCommand(cli.BuildJobCmd())
 	cmd.AddCommand(cli.BuildTaskCmd())
+	cmd.AddCommand(cli.BuildRunCmd())
+	cmd.AddCommand(cli.BuildSecretCmd())
+	cmd.AddCommand(cli.BuildManifestCmd())
 
 	// "Addons" command group
 	cmd.AddCommand(cli.BuildStorageCmd())
 
 	// "Settings" command group.
 	cmd.AddCommand(cli.BuildVersionCmd())
+	cmd.AddCommand(cli.BuildDoctorCmd())
+	cmd.AddCommand(cli.BuildTelemetryCmd())
 	cmd.AddCommand(cli.BuildCompletionCmd(cmd))
 
 	// "Release" command group.
@@ -73,5 +124,8 @@ func buildRootCmd() *cobra.Command {
 
 	// "Debug" command group.
 	cmd.SetUsageTemplate(template.RootUsage)
+
+	// Completes flags with the names of existing resources once every command is added.
+	cli.RegisterCompletionFuncs(cmd)
 	return cmd
 }
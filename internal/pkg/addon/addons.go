@@ -7,20 +7,49 @@ package addon
 import (
 	"fmt"
 	"path/filepath"
+	"sort"
 
+	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"golang.org/x/sync/errgroup"
 	"gopkg.in/yaml.v3"
 )
 
 const (
 	// StackName is the name of the addons nested stack resource.
 	StackName = "AddonsStack"
+
+	// cdkBootstrapVersionParam is the parameter added by CDK to check the version of the bootstrap stack.
+	cdkBootstrapVersionParam = "BootstrapVersion"
+
+	// ParametersFileName is the name of the file under the "addons/" directory that holds
+	// the values passed to the parameters of the addons stack.
+	ParametersFileName = "addons.parameters.yml"
 )
 
+// copilotParameters are the parameters that Copilot passes to the addons stack.
+var copilotParameters = []string{"App", "Env", "Name"}
+
+const copilotParametersTemplate = `App:
+  Type: String
+  Description: Your application's name.
+Env:
+  Type: String
+  Description: The environment name your service, job, or workflow is being deployed to.
+Name:
+  Type: String
+  Description: The name of the service, job, or workflow being deployed.
+`
+
 type workspaceReader interface {
 	ReadAddonsDir(svcName string) ([]string, error)
 	ReadAddon(svcName, fileName string) ([]byte, error)
+	ReadAddonCDKApps(svcName string) ([]string, error)
+}
+
+type cdkSynthesizer interface {
+	Synth(appDir string) ([]byte, error)
 }
 
 // Addons represents additional resources for a workload.
@@ -29,6 +58,7 @@ type Addons struct {
 
 	parser template.Parser
 	ws     workspaceReader
+	cdk    cdkSynthesizer
 }
 
 // New creates an Addons object given a workload name.
@@ -41,11 +71,13 @@ func New(wlName string) (*Addons, error) {
 		wlName: wlName,
 		parser: template.New(),
 		ws:     ws,
+		cdk:    exec.NewCDKCommand(),
 	}, nil
 }
 
 // Template merges CloudFormation templates under the "addons/" directory of a workload
 // into a single CloudFormation template and returns it.
+// AWS CDK apps under the "addons/" directory are synthesized and their templates are merged as well.
 //
 // If the addons directory doesn't exist, it returns the empty string and
 // ErrAddonsDirNotExist.
@@ -58,20 +90,43 @@ func (a *Addons) Template() (string, error) {
 		}
 	}
 
-	mergedTemplate := newCFNTemplate("merged")
+	var files []string
 	for _, fname := range filterYAMLfiles(fnames) {
-		out, err := a.ws.ReadAddon(a.wlName, fname)
-		if err != nil {
-			return "", fmt.Errorf("read addon %s under %s: %w", fname, a.wlName, err)
-		}
-		tpl := newCFNTemplate(fname)
-		if err := yaml.Unmarshal(out, tpl); err != nil {
-			return "", fmt.Errorf("unmarshal addon %s under %s: %w", fname, a.wlName, err)
+		if fname == ParametersFileName {
+			continue
 		}
+		files = append(files, fname)
+	}
+	// Addons are read and unmarshaled concurrently, then merged in order so that conflicts are reported deterministically.
+	tpls := make([]*cfnTemplate, len(files))
+	g := new(errgroup.Group)
+	for i, fname := range files {
+		i, fname := i, fname
+		g.Go(func() error {
+			out, err := a.ws.ReadAddon(a.wlName, fname)
+			if err != nil {
+				return fmt.Errorf("read addon %s under %s: %w", fname, a.wlName, err)
+			}
+			tpl := newCFNTemplate(fname)
+			if err := yaml.Unmarshal(out, tpl); err != nil {
+				return fmt.Errorf("unmarshal addon %s under %s: %w", fname, a.wlName, err)
+			}
+			tpls[i] = tpl
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return "", err
+	}
+	mergedTemplate := newCFNTemplate("merged")
+	for _, tpl := range tpls {
 		if err := mergedTemplate.merge(tpl); err != nil {
 			return "", err
 		}
 	}
+	if err := a.mergeCDKApps(mergedTemplate); err != nil {
+		return "", err
+	}
 	out, err := yaml.Marshal(mergedTemplate)
 	if err != nil {
 		return "", fmt.Errorf("marshal merged addons template: %w", err)
@@ -79,6 +134,143 @@ func (a *Addons) Template() (string, error) {
 	return string(out), nil
 }
 
+// Resource is a resource declared in an addon template.
+type Resource struct {
+	LogicalID string
+	Type      string // CloudFormation type of the resource such as "AWS::DynamoDB::Table".
+}
+
+// Resources returns the resources declared in the CloudFormation templates under the "addons/" directory
+// of the workload, sorted by logical ID. AWS CDK apps are not synthesized, so their resources are not included.
+//
+// If the addons directory doesn't exist, it returns nil.
+func (a *Addons) Resources() ([]Resource, error) {
+	fnames, err := a.ws.ReadAddonsDir(a.wlName)
+	if err != nil {
+		return nil, nil // Addons are optional.
+	}
+	var resources []Resource
+	for _, fname := range filterYAMLfiles(fnames) {
+		if fname == ParametersFileName {
+			continue
+		}
+		out, err := a.ws.ReadAddon(a.wlName, fname)
+		if err != nil {
+			return nil, fmt.Errorf("read addon %s under %s: %w", fname, a.wlName, err)
+		}
+		tpl := struct {
+			Resources yaml.Node `yaml:"Resources"`
+		}{}
+		if err := yaml.Unmarshal(out, &tpl); err != nil {
+			return nil, fmt.Errorf("unmarshal addon %s under %s: %w", fname, a.wlName, err)
+		}
+		typeFor, err := parseTypeByLogicalID(&tpl.Resources)
+		if err != nil {
+			return nil, fmt.Errorf("parse resources of addon %s under %s: %w", fname, a.wlName, err)
+		}
+		for logicalID, typ := range typeFor {
+			resources = append(resources, Resource{
+				LogicalID: logicalID,
+				Type:      typ,
+			})
+		}
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].LogicalID < resources[j].LogicalID
+	})
+	return resources, nil
+}
+
+// addonsParameters represents the "addons.parameters.yml" file of a workload.
+type addonsParameters struct {
+	Parameters   map[string]string            `yaml:"Parameters"`
+	Environments map[string]map[string]string `yaml:"environments"`
+}
+
+// Parameters returns the values to pass to the parameters of the addons stack when the workload is deployed to envName.
+// The values under the "environments" section of the "addons.parameters.yml" file override the default values
+// under the "Parameters" section.
+//
+// If the addons directory or the parameters file doesn't exist, it returns nil.
+func (a *Addons) Parameters(envName string) (map[string]string, error) {
+	fnames, err := a.ws.ReadAddonsDir(a.wlName)
+	if err != nil || !contains(fnames, ParametersFileName) {
+		return nil, nil // The parameters file is optional.
+	}
+	out, err := a.ws.ReadAddon(a.wlName, ParametersFileName)
+	if err != nil {
+		return nil, fmt.Errorf("read addon %s under %s: %w", ParametersFileName, a.wlName, err)
+	}
+	var file addonsParameters
+	if err := yaml.Unmarshal(out, &file); err != nil {
+		return nil, fmt.Errorf("unmarshal addon %s under %s: %w", ParametersFileName, a.wlName, err)
+	}
+	params := make(map[string]string)
+	for name, value := range file.Parameters {
+		params[name] = value
+	}
+	for name, value := range file.Environments[envName] {
+		params[name] = value
+	}
+	for name := range params {
+		if contains(copilotParameters, name) {
+			return nil, &errReservedParameter{name: name}
+		}
+	}
+	if len(params) == 0 {
+		return nil, nil
+	}
+	return params, nil
+}
+
+// mergeCDKApps synthesizes the CDK apps of the workload and merges their templates into mergedTemplate.
+func (a *Addons) mergeCDKApps(mergedTemplate *cfnTemplate) error {
+	apps, err := a.ws.ReadAddonCDKApps(a.wlName)
+	if err != nil {
+		return fmt.Errorf("read CDK apps under %s: %w", a.wlName, err)
+	}
+	if len(apps) == 0 {
+		return nil
+	}
+	// Synthesizing CDK apps is slow, so the apps are synthesized concurrently.
+	synthesized := make([][]byte, len(apps))
+	g := new(errgroup.Group)
+	for i, appDir := range apps {
+		i, appDir := i, appDir
+		g.Go(func() error {
+			out, err := a.cdk.Synth(appDir)
+			if err != nil {
+				return err
+			}
+			synthesized[i] = out
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	for i, appDir := range apps {
+		name := filepath.Base(appDir)
+		tpl := newCFNTemplate(name)
+		if err := yaml.Unmarshal(synthesized[i], tpl); err != nil {
+			return fmt.Errorf("unmarshal synthesized template of CDK app %s under %s: %w", name, a.wlName, err)
+		}
+		// The addons stack is deployed as a nested stack, so it doesn't rely on the CDK bootstrap stack.
+		// The parameters passed by Copilot are declared once in the merged template instead
+		// so that they don't conflict with the definitions of other addons.
+		tpl.removeParameters(append([]string{cdkBootstrapVersionParam}, copilotParameters...)...)
+		if err := mergedTemplate.merge(tpl); err != nil {
+			return err
+		}
+	}
+	var params yaml.Node
+	if err := yaml.Unmarshal([]byte(copilotParametersTemplate), &params); err != nil {
+		return fmt.Errorf("unmarshal addons parameters: %w", err)
+	}
+	mergedTemplate.addMissingParameters(params.Content[0])
+	return nil
+}
+
 func filterYAMLfiles(files []string) []string {
 	yamlExtensions := []string{".yaml", ".yml"}
 
@@ -173,13 +173,14 @@ func TestAddons_Template(t *testing.T) {
 		"merge fields successfully": {
 			mockAddons: func(ctrl *gomock.Controller) *Addons {
 				ws := mocks.NewMockworkspaceReader(ctrl)
-				ws.EXPECT().ReadAddonsDir(testSvcName).Return([]string{"first.yaml", "second.yaml"}, nil)
+				ws.EXPECT().ReadAddonsDir(testSvcName).Return([]string{"first.yaml", "second.yaml", ParametersFileName}, nil)
 
 				first, _ := ioutil.ReadFile(filepath.Join("testdata", "merge", "first.yaml"))
 				ws.EXPECT().ReadAddon(testSvcName, "first.yaml").Return(first, nil)
 
 				second, _ := ioutil.ReadFile(filepath.Join("testdata", "merge", "second.yaml"))
 				ws.EXPECT().ReadAddon(testSvcName, "second.yaml").Return(second, nil)
+				ws.EXPECT().ReadAddonCDKApps(testSvcName).Return(nil, nil)
 				return &Addons{
 					wlName: testSvcName,
 					ws:     ws,
@@ -190,6 +191,75 @@ func TestAddons_Template(t *testing.T) {
 				return string(wanted)
 			}(),
 		},
+		"returns err if failed to read CDK apps": {
+			mockAddons: func(ctrl *gomock.Controller) *Addons {
+				ws := mocks.NewMockworkspaceReader(ctrl)
+				ws.EXPECT().ReadAddonsDir(testSvcName).Return([]string{"queue"}, nil)
+				ws.EXPECT().ReadAddonCDKApps(testSvcName).Return(nil, testErr)
+				return &Addons{
+					wlName: testSvcName,
+					ws:     ws,
+				}
+			},
+			wantedErr: errors.New("read CDK apps under mysvc: some error"),
+		},
+		"returns err if failed to synthesize a CDK app": {
+			mockAddons: func(ctrl *gomock.Controller) *Addons {
+				ws := mocks.NewMockworkspaceReader(ctrl)
+				ws.EXPECT().ReadAddonsDir(testSvcName).Return([]string{"queue"}, nil)
+				ws.EXPECT().ReadAddonCDKApps(testSvcName).Return([]string{"/copilot/mysvc/addons/queue"}, nil)
+				cdk := mocks.NewMockcdkSynthesizer(ctrl)
+				cdk.EXPECT().Synth("/copilot/mysvc/addons/queue").Return(nil, testErr)
+				return &Addons{
+					wlName: testSvcName,
+					ws:     ws,
+					cdk:    cdk,
+				}
+			},
+			wantedErr: testErr,
+		},
+		"merge synthesized CDK templates with CloudFormation templates": {
+			mockAddons: func(ctrl *gomock.Controller) *Addons {
+				ws := mocks.NewMockworkspaceReader(ctrl)
+				ws.EXPECT().ReadAddonsDir(testSvcName).Return([]string{"queue", "table.yml"}, nil)
+				table, _ := ioutil.ReadFile(filepath.Join("testdata", "cdk", "table.yml"))
+				ws.EXPECT().ReadAddon(testSvcName, "table.yml").Return(table, nil)
+				ws.EXPECT().ReadAddonCDKApps(testSvcName).Return([]string{"/copilot/mysvc/addons/queue"}, nil)
+
+				cdk := mocks.NewMockcdkSynthesizer(ctrl)
+				synth, _ := ioutil.ReadFile(filepath.Join("testdata", "cdk", "synth.yml"))
+				cdk.EXPECT().Synth("/copilot/mysvc/addons/queue").Return(synth, nil)
+				return &Addons{
+					wlName: testSvcName,
+					ws:     ws,
+					cdk:    cdk,
+				}
+			},
+			wantedTemplate: func() string {
+				wanted, _ := ioutil.ReadFile(filepath.Join("testdata", "cdk", "wanted.yml"))
+				return string(wanted)
+			}(),
+		},
+		"declare the Copilot parameters if only CDK apps are present": {
+			mockAddons: func(ctrl *gomock.Controller) *Addons {
+				ws := mocks.NewMockworkspaceReader(ctrl)
+				ws.EXPECT().ReadAddonsDir(testSvcName).Return([]string{"queue"}, nil)
+				ws.EXPECT().ReadAddonCDKApps(testSvcName).Return([]string{"/copilot/mysvc/addons/queue"}, nil)
+
+				cdk := mocks.NewMockcdkSynthesizer(ctrl)
+				synth, _ := ioutil.ReadFile(filepath.Join("testdata", "cdk", "synth.yml"))
+				cdk.EXPECT().Synth("/copilot/mysvc/addons/queue").Return(synth, nil)
+				return &Addons{
+					wlName: testSvcName,
+					ws:     ws,
+					cdk:    cdk,
+				}
+			},
+			wantedTemplate: func() string {
+				wanted, _ := ioutil.ReadFile(filepath.Join("testdata", "cdk", "wanted-cdk-only.yml"))
+				return string(wanted)
+			}(),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -212,3 +282,167 @@ func TestAddons_Template(t *testing.T) {
 		})
 	}
 }
+
+func TestAddons_Parameters(t *testing.T) {
+	const testSvcName = "mysvc"
+	const paramsFile = `Parameters:
+  BillingMode: PAY_PER_REQUEST
+  ReadCapacity: 0
+environments:
+  prod:
+    BillingMode: PROVISIONED
+    ReadCapacity: 5
+`
+	testCases := map[string]struct {
+		inEnv        string
+		setupMocks   func(m *mocks.MockworkspaceReader)
+		wantedParams map[string]string
+		wantedErr    error
+	}{
+		"returns nil if the addons directory doesn't exist": {
+			inEnv: "test",
+			setupMocks: func(m *mocks.MockworkspaceReader) {
+				m.EXPECT().ReadAddonsDir(testSvcName).Return(nil, errors.New("some error"))
+			},
+		},
+		"returns nil if there is no parameters file": {
+			inEnv: "test",
+			setupMocks: func(m *mocks.MockworkspaceReader) {
+				m.EXPECT().ReadAddonsDir(testSvcName).Return([]string{"ddb.yml"}, nil)
+			},
+		},
+		"returns a wrapped error if the parameters file can't be read": {
+			inEnv: "test",
+			setupMocks: func(m *mocks.MockworkspaceReader) {
+				m.EXPECT().ReadAddonsDir(testSvcName).Return([]string{"ddb.yml", ParametersFileName}, nil)
+				m.EXPECT().ReadAddon(testSvcName, ParametersFileName).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("read addon addons.parameters.yml under mysvc: some error"),
+		},
+		"returns an error if a copilot parameter is overridden": {
+			inEnv: "prod",
+			setupMocks: func(m *mocks.MockworkspaceReader) {
+				m.EXPECT().ReadAddonsDir(testSvcName).Return([]string{ParametersFileName}, nil)
+				m.EXPECT().ReadAddon(testSvcName, ParametersFileName).Return([]byte(`environments:
+  prod:
+    Env: staging
+`), nil)
+			},
+			wantedErr: errors.New(`parameter "Env" in addons.parameters.yml is reserved by Copilot and cannot be overridden`),
+		},
+		"returns the default values if the environment isn't overridden": {
+			inEnv: "test",
+			setupMocks: func(m *mocks.MockworkspaceReader) {
+				m.EXPECT().ReadAddonsDir(testSvcName).Return([]string{"ddb.yml", ParametersFileName}, nil)
+				m.EXPECT().ReadAddon(testSvcName, ParametersFileName).Return([]byte(paramsFile), nil)
+			},
+			wantedParams: map[string]string{
+				"BillingMode":  "PAY_PER_REQUEST",
+				"ReadCapacity": "0",
+			},
+		},
+		"returns the environment overrides over the default values": {
+			inEnv: "prod",
+			setupMocks: func(m *mocks.MockworkspaceReader) {
+				m.EXPECT().ReadAddonsDir(testSvcName).Return([]string{"ddb.yml", ParametersFileName}, nil)
+				m.EXPECT().ReadAddon(testSvcName, ParametersFileName).Return([]byte(paramsFile), nil)
+			},
+			wantedParams: map[string]string{
+				"BillingMode":  "PROVISIONED",
+				"ReadCapacity": "5",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockworkspaceReader(ctrl)
+			tc.setupMocks(ws)
+			addons := &Addons{
+				wlName: testSvcName,
+				ws:     ws,
+			}
+
+			// WHEN
+			actual, err := addons.Parameters(tc.inEnv)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedParams, actual)
+			}
+		})
+	}
+}
+
+func TestAddons_Resources(t *testing.T) {
+	const testSvcName = "mysvc"
+	testCases := map[string]struct {
+		setupMocks      func(m *mocks.MockworkspaceReader)
+		wantedResources []Resource
+		wantedErr       error
+	}{
+		"returns nil if the addons directory doesn't exist": {
+			setupMocks: func(m *mocks.MockworkspaceReader) {
+				m.EXPECT().ReadAddonsDir(testSvcName).Return(nil, errors.New("some error"))
+			},
+		},
+		"wraps the error if a template is invalid": {
+			setupMocks: func(m *mocks.MockworkspaceReader) {
+				m.EXPECT().ReadAddonsDir(testSvcName).Return([]string{"invalid.yml"}, nil)
+				m.EXPECT().ReadAddon(testSvcName, "invalid.yml").Return([]byte("Resources: hello"), nil)
+			},
+			wantedErr: errors.New(`parse resources of addon invalid.yml under mysvc: "Resources" field in cloudformation template is not a map`),
+		},
+		"returns the resources of every template sorted by logical ID": {
+			setupMocks: func(m *mocks.MockworkspaceReader) {
+				m.EXPECT().ReadAddonsDir(testSvcName).Return([]string{"queue.yml", "README.md", ParametersFileName, "ddb.yaml"}, nil)
+				m.EXPECT().ReadAddon(testSvcName, "queue.yml").Return([]byte(`Resources:
+  OrdersQueue:
+    Type: AWS::SQS::Queue
+`), nil)
+				m.EXPECT().ReadAddon(testSvcName, "ddb.yaml").Return([]byte(`Resources:
+  OrdersTable:
+    Type: AWS::DynamoDB::Table
+  OrdersAccessPolicy:
+    Type: AWS::IAM::ManagedPolicy
+`), nil)
+			},
+			wantedResources: []Resource{
+				{LogicalID: "OrdersAccessPolicy", Type: "AWS::IAM::ManagedPolicy"},
+				{LogicalID: "OrdersQueue", Type: "AWS::SQS::Queue"},
+				{LogicalID: "OrdersTable", Type: "AWS::DynamoDB::Table"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockworkspaceReader(ctrl)
+			tc.setupMocks(ws)
+			addons := &Addons{
+				wlName: testSvcName,
+				ws:     ws,
+			}
+
+			// WHEN
+			actual, err := addons.Resources()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedResources, actual)
+			}
+		})
+	}
+}
@@ -114,6 +114,31 @@ func (t *cfnTemplate) mergeOutputs(outputs yaml.Node) error {
 	return mergeSingleLevelMaps(&t.Outputs, &outputs)
 }
 
+// removeParameters deletes the parameters with the given logical IDs from t.
+func (t *cfnTemplate) removeParameters(logicalIDs ...string) {
+	var content []*yaml.Node
+	for _, param := range mappingContents(&t.Parameters) {
+		if contains(logicalIDs, param.keyNode.Value) {
+			continue
+		}
+		content = append(content, param.keyNode, param.valueNode)
+	}
+	if len(content) == 0 {
+		t.Parameters = yaml.Node{}
+		return
+	}
+	t.Parameters.Content = content
+}
+
+// addMissingParameters adds the parameters that are not already defined in t.
+// Parameters that already exist in t are left unchanged.
+func (t *cfnTemplate) addMissingParameters(params *yaml.Node) {
+	keepExisting := func(key string, dstVal, srcVal *yaml.Node) error {
+		return nil
+	}
+	_ = mergeMapNodes(&t.Parameters, params, keepExisting)
+}
+
 // assignNewNodesTo associates every new node added to the template t with the tplName.
 func (t *cfnTemplate) assignNewNodesTo(tplName string) {
 	if t == nil {
@@ -20,6 +20,15 @@ func (e *ErrAddonsDirNotExist) Error() string {
 	return fmt.Sprintf("read addons directory for %s: %v", e.WlName, e.ParentErr)
 }
 
+// errReservedParameter occurs if the addons parameters file defines a value for a parameter passed by Copilot.
+type errReservedParameter struct {
+	name string
+}
+
+func (e *errReservedParameter) Error() string {
+	return fmt.Sprintf(`parameter "%s" in %s is reserved by Copilot and cannot be overridden`, e.name, ParametersFileName)
+}
+
 type errKeyAlreadyExists struct {
 	Key    string
 	First  *yaml.Node
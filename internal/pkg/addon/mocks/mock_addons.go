@@ -48,6 +48,21 @@ func (mr *MockworkspaceReaderMockRecorder) ReadAddon(svcName, fileName interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAddon", reflect.TypeOf((*MockworkspaceReader)(nil).ReadAddon), svcName, fileName)
 }
 
+// ReadAddonCDKApps mocks base method.
+func (m *MockworkspaceReader) ReadAddonCDKApps(svcName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAddonCDKApps", svcName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAddonCDKApps indicates an expected call of ReadAddonCDKApps.
+func (mr *MockworkspaceReaderMockRecorder) ReadAddonCDKApps(svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAddonCDKApps", reflect.TypeOf((*MockworkspaceReader)(nil).ReadAddonCDKApps), svcName)
+}
+
 // ReadAddonsDir mocks base method.
 func (m *MockworkspaceReader) ReadAddonsDir(svcName string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -62,3 +77,41 @@ func (mr *MockworkspaceReaderMockRecorder) ReadAddonsDir(svcName interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAddonsDir", reflect.TypeOf((*MockworkspaceReader)(nil).ReadAddonsDir), svcName)
 }
+
+// MockcdkSynthesizer is a mock of cdkSynthesizer interface.
+type MockcdkSynthesizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockcdkSynthesizerMockRecorder
+}
+
+// MockcdkSynthesizerMockRecorder is the mock recorder for MockcdkSynthesizer.
+type MockcdkSynthesizerMockRecorder struct {
+	mock *MockcdkSynthesizer
+}
+
+// NewMockcdkSynthesizer creates a new mock instance.
+func NewMockcdkSynthesizer(ctrl *gomock.Controller) *MockcdkSynthesizer {
+	mock := &MockcdkSynthesizer{ctrl: ctrl}
+	mock.recorder = &MockcdkSynthesizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcdkSynthesizer) EXPECT() *MockcdkSynthesizerMockRecorder {
+	return m.recorder
+}
+
+// Synth mocks base method.
+func (m *MockcdkSynthesizer) Synth(appDir string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Synth", appDir)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Synth indicates an expected call of Synth.
+func (mr *MockcdkSynthesizerMockRecorder) Synth(appDir interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Synth", reflect.TypeOf((*MockcdkSynthesizer)(nil).Synth), appDir)
+}
@@ -13,9 +13,12 @@ import (
 )
 
 const (
-	dynamoDbAddonPath = "addons/ddb/cf.yml"
-	s3AddonPath       = "addons/s3/cf.yml"
-	rdsAddonPath      = "addons/aurora/cf.yml"
+	dynamoDbAddonPath    = "addons/ddb/cf.yml"
+	s3AddonPath          = "addons/s3/cf.yml"
+	rdsAddonPath         = "addons/aurora/cf.yml"
+	redisAddonPath       = "addons/redis/cf.yml"
+	rdsInstanceAddonPath = "addons/rds/cf.yml"
+	efsAddonPath         = "addons/efs/cf.yml"
 )
 
 const (
@@ -57,6 +60,30 @@ type RDS struct {
 	parser template.Parser
 }
 
+// RDSInstance contains configuration options which fully describe a provisioned RDS instance.
+// Implements the encoding.BinaryMarshaler interface.
+type RDSInstance struct {
+	RDSInstanceProps
+
+	parser template.Parser
+}
+
+// Redis contains configuration options which fully describe an ElastiCache Redis cluster.
+// Implements the encoding.BinaryMarshaler interface.
+type Redis struct {
+	RedisProps
+
+	parser template.Parser
+}
+
+// EFS contains configuration options which fully describe an EFS file system.
+// Implements the encoding.BinaryMarshaler interface.
+type EFS struct {
+	EFSProps
+
+	parser template.Parser
+}
+
 // StorageProps holds basic input properties for addon.NewDynamoDB() or addon.NewS3().
 type StorageProps struct {
 	Name string
@@ -75,6 +102,18 @@ type DynamoDBProps struct {
 	SortKey      *string
 	PartitionKey *string
 	HasLSI       bool
+
+	// Provisioned read and write capacity of the table, nil if the table is billed on-demand.
+	Capacity *DDBCapacity
+	// The attribute holding the expiration time of items, nil if time to live is disabled.
+	TTLAttribute        *string
+	PointInTimeRecovery bool
+}
+
+// DDBCapacity holds the auto scaling range of the read and write capacity units of a provisioned DynamoDB table.
+type DDBCapacity struct {
+	Min int
+	Max int
 }
 
 // DDBAttribute holds the attribute definition of a DynamoDB attribute (keys, local secondary indices).
@@ -104,6 +143,40 @@ type RDSProps struct {
 	Envs []string
 }
 
+// RDSInstanceProps holds RDS instance specific properties for addon.NewRDSInstance().
+type RDSInstanceProps struct {
+	// The name of the instance.
+	InstanceName string
+	// The engine type of the RDS instance.
+	Engine string
+	// The name of the initial database created inside the instance.
+	InitialDBName string
+	// The parameter group to use for the instance.
+	ParameterGroup string
+	// The compute and memory capacity of the instance, e.g. "db.t3.micro".
+	InstanceClass string
+	// The amount of storage in GiB allocated to the instance.
+	AllocatedStorage int
+	// Whether the instance has a standby replica in another Availability Zone.
+	MultiAZ bool
+	// The copilot environments found inside the current app.
+	Envs []string
+}
+
+// RedisProps holds Redis-specific properties for addon.NewRedis().
+type RedisProps struct {
+	// The name of the cluster.
+	ClusterName string
+	// The copilot environments found inside the current app.
+	Envs []string
+}
+
+// EFSProps holds EFS-specific properties for addon.NewEFS().
+type EFSProps struct {
+	// The name of the file system.
+	FileSystemName string
+}
+
 // MarshalBinary serializes the DynamoDB object into a binary YAML CF template.
 // Implements the encoding.BinaryMarshaler interface.
 func (d *DynamoDB) MarshalBinary() ([]byte, error) {
@@ -162,6 +235,63 @@ func NewRDS(input RDSProps) *RDS {
 	}
 }
 
+// MarshalBinary serializes the RDSInstance object into a binary YAML CF template.
+// Implements the encoding.BinaryMarshaler interface.
+func (r *RDSInstance) MarshalBinary() ([]byte, error) {
+	content, err := r.parser.Parse(rdsInstanceAddonPath, *r, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// NewRDSInstance creates a new RDS instance marshaler which can be used to write CF via addonWriter.
+func NewRDSInstance(input RDSInstanceProps) *RDSInstance {
+	return &RDSInstance{
+		RDSInstanceProps: input,
+
+		parser: template.New(),
+	}
+}
+
+// MarshalBinary serializes the Redis object into a binary YAML CF template.
+// Implements the encoding.BinaryMarshaler interface.
+func (r *Redis) MarshalBinary() ([]byte, error) {
+	content, err := r.parser.Parse(redisAddonPath, *r, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// NewRedis creates a new Redis marshaler which can be used to write CF via addonWriter.
+func NewRedis(input RedisProps) *Redis {
+	return &Redis{
+		RedisProps: input,
+
+		parser: template.New(),
+	}
+}
+
+// MarshalBinary serializes the EFS object into a binary YAML CF template.
+// Implements the encoding.BinaryMarshaler interface.
+func (e *EFS) MarshalBinary() ([]byte, error) {
+	content, err := e.parser.Parse(efsAddonPath, *e, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// NewEFS creates a new EFS marshaler which can be used to write CF via addonWriter.
+func NewEFS(input EFSProps) *EFS {
+	return &EFS{
+		EFSProps: input,
+
+		parser: template.New(),
+	}
+}
+
 // BuildPartitionKey generates the properties required to specify the partition key
 // based on customer inputs.
 func (p *DynamoDBProps) BuildPartitionKey(partitionKey string) error {
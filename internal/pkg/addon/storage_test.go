@@ -171,6 +171,156 @@ func TestRDS_MarshalBinary(t *testing.T) {
 	}
 }
 
+func TestRDSInstance_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		engine           string
+		mockDependencies func(ctrl *gomock.Controller, r *RDSInstance)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			engine: RDSEngineTypePostgreSQL,
+			mockDependencies: func(ctrl *gomock.Controller, r *RDSInstance) {
+				m := mocks.NewMockParser(ctrl)
+				r.parser = m
+				m.EXPECT().Parse(gomock.Any(), *r, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"renders content": {
+			engine: RDSEngineTypeMySQL,
+			mockDependencies: func(ctrl *gomock.Controller, r *RDSInstance) {
+				m := mocks.NewMockParser(ctrl)
+				r.parser = m
+				m.EXPECT().Parse(gomock.Eq(rdsInstanceAddonPath), *r, gomock.Any()).
+					Return(&template.Content{Buffer: bytes.NewBufferString("mysql")}, nil)
+			},
+			wantedBinary: []byte("mysql"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &RDSInstance{
+				RDSInstanceProps: RDSInstanceProps{
+					Engine:           tc.engine,
+					InstanceClass:    "db.t3.micro",
+					AllocatedStorage: 20,
+				},
+			}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
+func TestRedis_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, r *Redis)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, r *Redis) {
+				m := mocks.NewMockParser(ctrl)
+				r.parser = m
+				m.EXPECT().Parse(gomock.Any(), *r, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"renders content": {
+			mockDependencies: func(ctrl *gomock.Controller, r *Redis) {
+				m := mocks.NewMockParser(ctrl)
+				r.parser = m
+				m.EXPECT().Parse(gomock.Eq(redisAddonPath), *r, gomock.Any()).
+					Return(&template.Content{Buffer: bytes.NewBufferString("redis")}, nil)
+			},
+			wantedBinary: []byte("redis"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &Redis{
+				RedisProps: RedisProps{
+					ClusterName: "cache",
+					Envs:        []string{"test"},
+				},
+			}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
+func TestEFS_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, e *EFS)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, e *EFS) {
+				m := mocks.NewMockParser(ctrl)
+				e.parser = m
+				m.EXPECT().Parse(gomock.Any(), *e, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"renders content": {
+			mockDependencies: func(ctrl *gomock.Controller, e *EFS) {
+				m := mocks.NewMockParser(ctrl)
+				e.parser = m
+				m.EXPECT().Parse(gomock.Eq(efsAddonPath), *e, gomock.Any()).
+					Return(&template.Content{Buffer: bytes.NewBufferString("efs")}, nil)
+			},
+			wantedBinary: []byte("efs"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &EFS{
+				EFSProps: EFSProps{
+					FileSystemName: "media",
+				},
+			}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
 func TestDDBAttributeFromKey(t *testing.T) {
 	testCases := map[string]struct {
 		input     string
@@ -17,10 +17,28 @@ const (
 	// ECS service resource ID format: service/${clusterName}/${serviceName}.
 	fmtECSResourceID    = "service/%s/%s"
 	ecsServiceNamespace = "ecs"
+	ecsServiceDimension = "ecs:service:DesiredCount"
 )
 
 type api interface {
 	DescribeScalingPolicies(input *aas.DescribeScalingPoliciesInput) (*aas.DescribeScalingPoliciesOutput, error)
+	DescribeScalableTargets(input *aas.DescribeScalableTargetsInput) (*aas.DescribeScalableTargetsOutput, error)
+}
+
+// ScalableTarget is the range within which Application Auto Scaling keeps the number of tasks of an ECS service.
+type ScalableTarget struct {
+	MinCapacity int64 `json:"minCapacity"`
+	MaxCapacity int64 `json:"maxCapacity"`
+}
+
+// ScalingPolicy is a policy that scales the number of tasks of an ECS service.
+type ScalingPolicy struct {
+	Name string `json:"name"`
+	Type string `json:"type"` // Either "TargetTrackingScaling" or "StepScaling".
+	// Metric is the predefined metric tracked by a target tracking policy, such as "ECSServiceAverageCPUUtilization".
+	Metric      string   `json:"metric,omitempty"`
+	TargetValue float64  `json:"targetValue,omitempty"`
+	Alarms      []string `json:"alarms,omitempty"`
 }
 
 // ApplicationAutoscaling wraps an Amazon Application Auto Scaling client.
@@ -38,8 +56,21 @@ func New(s *session.Session) *ApplicationAutoscaling {
 // ECSServiceAlarmNames returns names of the CloudWatch alarms associated with the
 // scaling policies attached to the ECS service.
 func (a *ApplicationAutoscaling) ECSServiceAlarmNames(cluster, service string) ([]string, error) {
-	resourceID := fmt.Sprintf(fmtECSResourceID, cluster, service)
+	policies, err := a.ECSServiceScalingPolicies(cluster, service)
+	if err != nil {
+		return nil, err
+	}
 	var alarms []string
+	for _, policy := range policies {
+		alarms = append(alarms, policy.Alarms...)
+	}
+	return alarms, nil
+}
+
+// ECSServiceScalingPolicies returns the scaling policies attached to the ECS service.
+func (a *ApplicationAutoscaling) ECSServiceScalingPolicies(cluster, service string) ([]ScalingPolicy, error) {
+	resourceID := fmt.Sprintf(fmtECSResourceID, cluster, service)
+	var policies []ScalingPolicy
 	var err error
 	resp := &aas.DescribeScalingPoliciesOutput{}
 	for {
@@ -52,13 +83,44 @@ func (a *ApplicationAutoscaling) ECSServiceAlarmNames(cluster, service string) (
 			return nil, fmt.Errorf("describe scaling policies for ECS service %s/%s: %w", cluster, service, err)
 		}
 		for _, policy := range resp.ScalingPolicies {
+			p := ScalingPolicy{
+				Name: aws.StringValue(policy.PolicyName),
+				Type: aws.StringValue(policy.PolicyType),
+			}
+			if conf := policy.TargetTrackingScalingPolicyConfiguration; conf != nil {
+				p.TargetValue = aws.Float64Value(conf.TargetValue)
+				if conf.PredefinedMetricSpecification != nil {
+					p.Metric = aws.StringValue(conf.PredefinedMetricSpecification.PredefinedMetricType)
+				}
+			}
 			for _, alarm := range policy.Alarms {
-				alarms = append(alarms, aws.StringValue(alarm.AlarmName))
+				p.Alarms = append(p.Alarms, aws.StringValue(alarm.AlarmName))
 			}
+			policies = append(policies, p)
 		}
 		if resp.NextToken == nil {
 			break
 		}
 	}
-	return alarms, nil
+	return policies, nil
+}
+
+// ECSServiceScalableTarget returns the range of tasks of the ECS service, or nil if the service doesn't autoscale.
+func (a *ApplicationAutoscaling) ECSServiceScalableTarget(cluster, service string) (*ScalableTarget, error) {
+	resp, err := a.client.DescribeScalableTargets(&aas.DescribeScalableTargetsInput{
+		ResourceIds:       aws.StringSlice([]string{fmt.Sprintf(fmtECSResourceID, cluster, service)}),
+		ScalableDimension: aws.String(ecsServiceDimension),
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe scalable target for ECS service %s/%s: %w", cluster, service, err)
+	}
+	if len(resp.ScalableTargets) == 0 {
+		return nil, nil
+	}
+	target := resp.ScalableTargets[0]
+	return &ScalableTarget{
+		MinCapacity: aws.Int64Value(target.MinCapacity),
+		MaxCapacity: aws.Int64Value(target.MaxCapacity),
+	}, nil
 }
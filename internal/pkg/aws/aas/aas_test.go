@@ -139,3 +139,122 @@ func TestCloudWatch_ECSServiceAutoscalingAlarms(t *testing.T) {
 
 	}
 }
+
+func TestApplicationAutoscaling_ECSServiceScalingPolicies(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := mocks.NewMockapi(ctrl)
+	mockClient.EXPECT().DescribeScalingPolicies(&aas.DescribeScalingPoliciesInput{
+		ResourceId:       aws.String("service/mockCluster/mockService"),
+		ServiceNamespace: aws.String(ecsServiceNamespace),
+	}).Return(&aas.DescribeScalingPoliciesOutput{
+		ScalingPolicies: []*aas.ScalingPolicy{
+			{
+				PolicyName: aws.String("cpu"),
+				PolicyType: aws.String("TargetTrackingScaling"),
+				TargetTrackingScalingPolicyConfiguration: &aas.TargetTrackingScalingPolicyConfiguration{
+					PredefinedMetricSpecification: &aas.PredefinedMetricSpecification{
+						PredefinedMetricType: aws.String("ECSServiceAverageCPUUtilization"),
+					},
+					TargetValue: aws.Float64(70),
+				},
+				Alarms: []*aas.Alarm{
+					{AlarmName: aws.String("cpu-high")},
+					{AlarmName: aws.String("cpu-low")},
+				},
+			},
+			{
+				PolicyName: aws.String("queue"),
+				PolicyType: aws.String("StepScaling"),
+			},
+		},
+	}, nil)
+	aasSvc := ApplicationAutoscaling{
+		client: mockClient,
+	}
+
+	// WHEN
+	policies, err := aasSvc.ECSServiceScalingPolicies("mockCluster", "mockService")
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []ScalingPolicy{
+		{
+			Name:        "cpu",
+			Type:        "TargetTrackingScaling",
+			Metric:      "ECSServiceAverageCPUUtilization",
+			TargetValue: 70,
+			Alarms:      []string{"cpu-high", "cpu-low"},
+		},
+		{
+			Name: "queue",
+			Type: "StepScaling",
+		},
+	}, policies)
+}
+
+func TestApplicationAutoscaling_ECSServiceScalableTarget(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m aasMocks)
+
+		wantTarget *ScalableTarget
+		wantErr    error
+	}{
+		"errors if failed to describe the scalable target": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("describe scalable target for ECS service mockCluster/mockService: some error"),
+		},
+		"returns nil if the service doesn't autoscale": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(gomock.Any()).Return(&aas.DescribeScalableTargetsOutput{}, nil)
+			},
+		},
+		"success": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(&aas.DescribeScalableTargetsInput{
+					ResourceIds:       aws.StringSlice([]string{"service/mockCluster/mockService"}),
+					ScalableDimension: aws.String("ecs:service:DesiredCount"),
+					ServiceNamespace:  aws.String("ecs"),
+				}).Return(&aas.DescribeScalableTargetsOutput{
+					ScalableTargets: []*aas.ScalableTarget{
+						{
+							MinCapacity: aws.Int64(1),
+							MaxCapacity: aws.Int64(10),
+						},
+					},
+				}, nil)
+			},
+			wantTarget: &ScalableTarget{
+				MinCapacity: 1,
+				MaxCapacity: 10,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(aasMocks{client: mockClient})
+			aasSvc := ApplicationAutoscaling{
+				client: mockClient,
+			}
+
+			// WHEN
+			target, err := aasSvc.ECSServiceScalableTarget("mockCluster", "mockService")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantTarget, target)
+		})
+	}
+}
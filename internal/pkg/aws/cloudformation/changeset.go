@@ -194,6 +194,15 @@ func (cs *changeSet) execute() error {
 // createAndExecute calls create and then execute.
 // If the change set is empty, returns a ErrChangeSetEmpty.
 func (cs *changeSet) createAndExecute(conf *stackConfig) error {
+	if err := cs.createNonEmpty(conf); err != nil {
+		return err
+	}
+	return cs.execute()
+}
+
+// createNonEmpty calls create.
+// If the change set is empty, deletes it and returns a ErrChangeSetEmpty.
+func (cs *changeSet) createNonEmpty(conf *stackConfig) error {
 	if err := cs.create(conf); err != nil {
 		// It's possible that there are no changes between the previous and proposed stack change sets.
 		// We make a call to describe the change set to see if that is indeed the case and handle it gracefully.
@@ -213,7 +222,7 @@ func (cs *changeSet) createAndExecute(conf *stackConfig) error {
 		}
 		return err
 	}
-	return cs.execute()
+	return nil
 }
 
 // delete removes the change set.
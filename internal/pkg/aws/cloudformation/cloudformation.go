@@ -92,6 +92,54 @@ func (c *CloudFormation) DescribeChangeSet(changeSetID, stackName string) (*Chan
 	return out, nil
 }
 
+// CreateChangeSet proposes the changes to create the stack if it doesn't exist, or to update it otherwise, without executing them.
+// If the stack exists in a failed state, deletes the stack first.
+// If there are no changes for the stack, deletes the empty change set and returns ErrChangeSetEmpty.
+func (c *CloudFormation) CreateChangeSet(stack *Stack) (changeSetID string, err error) {
+	newChangeSet := newUpdateChangeSet
+	descr, err := c.Describe(stack.Name)
+	if err != nil {
+		var stackNotFound *ErrStackNotFound
+		if !errors.As(err, &stackNotFound) {
+			return "", err
+		}
+		newChangeSet = newCreateChangeSet
+	} else {
+		status := StackStatus(aws.StringValue(descr.StackStatus))
+		if status.InProgress() {
+			return "", &ErrStackUpdateInProgress{
+				Name: stack.Name,
+			}
+		}
+		if status.requiresCleanup() {
+			if err := c.DeleteAndWait(stack.Name); err != nil {
+				return "", fmt.Errorf("clean up previously failed stack %s: %w", stack.Name, err)
+			}
+			newChangeSet = newCreateChangeSet
+		}
+	}
+	cs, err := newChangeSet(c.client, stack.Name)
+	if err != nil {
+		return "", err
+	}
+	if err := cs.createNonEmpty(stack.stackConfig); err != nil {
+		return "", err
+	}
+	return cs.name, nil
+}
+
+// ExecuteChangeSet executes a change set created with CreateChangeSet.
+func (c *CloudFormation) ExecuteChangeSet(changeSetID, stackName string) error {
+	cs := &changeSet{name: changeSetID, stackName: stackName, client: c.client}
+	return cs.execute()
+}
+
+// DeleteChangeSet removes a change set that won't be executed.
+func (c *CloudFormation) DeleteChangeSet(changeSetID, stackName string) error {
+	cs := &changeSet{name: changeSetID, stackName: stackName, client: c.client}
+	return cs.delete()
+}
+
 // WaitForCreate blocks until the stack is created or until the max attempt window expires.
 func (c *CloudFormation) WaitForCreate(ctx context.Context, stackName string) error {
 	err := c.client.WaitUntilStackCreateCompleteWithContext(ctx, &cloudformation.DescribeStacksInput{
@@ -138,6 +186,24 @@ func (c *CloudFormation) WaitForUpdate(ctx context.Context, stackName string) er
 	return nil
 }
 
+// CancelUpdateAndWait cancels the in-progress update of a stack and then blocks until the stack is rolled back
+// to its previous configuration or until the max attempt window expires.
+func (c *CloudFormation) CancelUpdateAndWait(stackName string) error {
+	_, err := c.client.CancelUpdateStack(&cloudformation.CancelUpdateStackInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return fmt.Errorf("cancel update of stack %s: %w", stackName, err)
+	}
+	err = c.client.WaitUntilStackRollbackCompleteWithContext(context.Background(), &cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackName),
+	}, waiters...)
+	if err != nil {
+		return fmt.Errorf("wait until stack %s rollback is complete: %w", stackName, err)
+	}
+	return nil
+}
+
 // Delete removes an existing CloudFormation stack.
 // If the stack doesn't exist then do nothing.
 func (c *CloudFormation) Delete(stackName string) error {
@@ -275,6 +341,38 @@ func (c *CloudFormation) Events(stackName string) ([]StackEvent, error) {
 	return c.events(stackName, func(in *cloudformation.StackEvent) bool { return true })
 }
 
+// RecentEvents returns the list of stack events of the latest operations on the stack in **chronological** order.
+// Unlike Events, it stops paginating once it reads the start event of the oldest operation.
+func (c *CloudFormation) RecentEvents(stackName string, operations int) ([]StackEvent, error) {
+	var nextToken *string
+	var events []StackEvent
+	started := 0
+	for started < operations {
+		out, err := c.client.DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+			NextToken: nextToken,
+			StackName: aws.String(stackName),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe stack events for stack %s: %w", stackName, err)
+		}
+		for _, event := range out.StackEvents {
+			events = append(events, StackEvent(*event))
+			if aws.StringValue(event.LogicalResourceId) != stackName || !StackStatus(aws.StringValue(event.ResourceStatus)).UpsertInProgress() {
+				continue
+			}
+			if started++; started == operations {
+				break
+			}
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	reverseEvents(events)
+	return events, nil
+}
+
 // StackResources returns the list of resources created as part of a CloudFormation stack.
 func (c *CloudFormation) StackResources(name string) ([]*StackResource, error) {
 	out, err := c.DescribeStackResources(&cloudformation.DescribeStackResourcesInput{
@@ -315,13 +413,17 @@ func (c *CloudFormation) events(stackName string, match eventMatcher) ([]StackEv
 			break
 		}
 	}
-	// Reverse the events so that they're returned in chronological order.
-	// Taken from https://github.com/golang/go/wiki/SliceTricks#reversing.
+	reverseEvents(events)
+	return events, nil
+}
+
+// reverseEvents reverses the events so that they're returned in chronological order.
+// Taken from https://github.com/golang/go/wiki/SliceTricks#reversing.
+func reverseEvents(events []StackEvent) {
 	for i := len(events)/2 - 1; i >= 0; i-- {
 		opp := len(events) - 1 - i
 		events[i], events[opp] = events[opp], events[i]
 	}
-	return events, nil
 }
 
 // ErrorEvents returns the list of events with "failed" status in **chronological order**
@@ -218,6 +218,223 @@ func TestCloudFormation_DescribeChangeSet(t *testing.T) {
 	})
 }
 
+func TestCloudFormation_CreateChangeSet(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) client
+		wantedErr  error
+	}{
+		"fail if checking the stack description fails": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(nil, errors.New("some unexpected error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("describe stack %s: %w", mockStack.Name, errors.New("some unexpected error")),
+		},
+		"fail if the stack is already in progress": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusUpdateInProgress),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedErr: &ErrStackUpdateInProgress{
+				Name: mockStack.Name,
+			},
+		},
+		"proposes to create the stack if it doesn't exist": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(nil, errDoesNotExist)
+				addCreateChangeSetCalls(m, cloudformation.ChangeSetTypeCreate)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"proposes to create the stack after cleaning the previously failed execution": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusRollbackComplete),
+						},
+					},
+				}, nil)
+				m.EXPECT().DeleteStack(&cloudformation.DeleteStackInput{
+					StackName: aws.String(mockStack.Name),
+				})
+				m.EXPECT().WaitUntilStackDeleteCompleteWithContext(gomock.Any(), &cloudformation.DescribeStacksInput{
+					StackName: aws.String(mockStack.Name),
+				}, gomock.Any(), gomock.Any())
+				addCreateChangeSetCalls(m, cloudformation.ChangeSetTypeCreate)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"proposes to update a previously existing stack": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusCreateComplete),
+						},
+					},
+				}, nil)
+				addCreateChangeSetCalls(m, cloudformation.ChangeSetTypeUpdate)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			seed := bytes.NewBufferString("12345678901233456789") // always generate the same UUID
+			uuid.SetRand(seed)
+			defer uuid.SetRand(nil)
+
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			id, err := c.CreateChangeSet(mockStack)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, mockChangeSetID, id)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_ExecuteChangeSet(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) client
+		wantedErr  error
+	}{
+		"does nothing if the change set has no changes": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusUnavailable),
+					StatusReason:    aws.String(noChangesReason),
+				}, nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"wraps the error if the change set can't be executed": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+				}, nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("execute change set %s for stack %s: some error", mockChangeSetID, mockStack.Name),
+		},
+		"executes the change set": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeChangeSet(&cloudformation.DescribeChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetID),
+					StackName:     aws.String(mockStack.Name),
+				}).Return(&cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+				}, nil)
+				m.EXPECT().ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetID),
+					StackName:     aws.String(mockStack.Name),
+				}).Return(nil, nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.ExecuteChangeSet(mockChangeSetID, mockStack.Name)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_DeleteChangeSet(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) client
+		wantedErr  error
+	}{
+		"wraps the error if the change set can't be deleted": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DeleteChangeSet(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("delete change set %s for stack %s: some error", mockChangeSetID, mockStack.Name),
+		},
+		"deletes the change set": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetID),
+					StackName:     aws.String(mockStack.Name),
+				}).Return(nil, nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.DeleteChangeSet(mockChangeSetID, mockStack.Name)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_WaitForCreate(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) client
@@ -459,6 +676,65 @@ func TestCloudFormation_DeleteAndWait(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_CancelUpdateAndWait(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) client
+		wantedErr  error
+	}{
+		"wraps error if the update can't be canceled": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().CancelUpdateStack(gomock.Any()).Return(nil, errors.New("some error"))
+				m.EXPECT().WaitUntilStackRollbackCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				return m
+			},
+			wantedErr: fmt.Errorf("cancel update of stack %s: some error", mockStack.Name),
+		},
+		"wraps error if the rollback doesn't complete": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().CancelUpdateStack(gomock.Any()).Return(nil, nil)
+				m.EXPECT().WaitUntilStackRollbackCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("wait until stack %s rollback is complete: some error", mockStack.Name),
+		},
+		"waits for the stack to roll back after canceling the update": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().CancelUpdateStack(&cloudformation.CancelUpdateStackInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(nil, nil)
+				m.EXPECT().WaitUntilStackRollbackCompleteWithContext(gomock.Any(), &cloudformation.DescribeStacksInput{
+					StackName: aws.String(mockStack.Name),
+				}, gomock.Any()).Return(nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.CancelUpdateAndWait(mockStack.Name)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestStackDescriber_Metadata(t *testing.T) {
 	testCases := map[string]struct {
 		isStackSet bool
@@ -1188,6 +1464,29 @@ func addUpdateDeployCalls(m *mocks.Mockclient) {
 }
 
 func addDeployCalls(m *mocks.Mockclient, changeSetType string) {
+	addCreateChangeSetCalls(m, changeSetType)
+	m.EXPECT().DescribeChangeSet(&cloudformation.DescribeChangeSetInput{
+		ChangeSetName: aws.String(mockChangeSetID),
+		StackName:     aws.String(mockStack.Name),
+	}).Return(&cloudformation.DescribeChangeSetOutput{
+		Changes: []*cloudformation.Change{
+			{
+				ResourceChange: &cloudformation.ResourceChange{
+					ResourceType: aws.String("ecs service"),
+				},
+				Type: aws.String(cloudformation.ChangeTypeResource),
+			},
+		},
+		ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+		StatusReason:    aws.String("some reason"),
+	}, nil)
+	m.EXPECT().ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
+		ChangeSetName: aws.String(mockChangeSetID),
+		StackName:     aws.String(mockStack.Name),
+	})
+}
+
+func addCreateChangeSetCalls(m *mocks.Mockclient, changeSetType string) {
 	m.EXPECT().CreateChangeSet(&cloudformation.CreateChangeSetInput{
 		ChangeSetName:       aws.String(mockChangeSetName),
 		StackName:           aws.String(mockStack.Name),
@@ -1209,23 +1508,126 @@ func addDeployCalls(m *mocks.Mockclient, changeSetType string) {
 	m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), &cloudformation.DescribeChangeSetInput{
 		ChangeSetName: aws.String(mockChangeSetID),
 	}, gomock.Any())
-	m.EXPECT().DescribeChangeSet(&cloudformation.DescribeChangeSetInput{
-		ChangeSetName: aws.String(mockChangeSetID),
-		StackName:     aws.String(mockStack.Name),
-	}).Return(&cloudformation.DescribeChangeSetOutput{
-		Changes: []*cloudformation.Change{
-			{
-				ResourceChange: &cloudformation.ResourceChange{
-					ResourceType: aws.String("ecs service"),
+}
+
+func TestCloudFormation_RecentEvents(t *testing.T) {
+	testCases := map[string]struct {
+		operations   int
+		createMock   func(ctrl *gomock.Controller) client
+		wantedEvents []StackEvent
+		wantedErr    error
+	}{
+		"stops paginating after the start of the oldest operation": {
+			operations: 2,
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(&cloudformation.DescribeStackEventsOutput{
+					StackEvents: []*cloudformation.StackEvent{
+						{
+							LogicalResourceId: aws.String(mockStack.Name),
+							ResourceStatus:    aws.String("UPDATE_COMPLETE"),
+						},
+						{
+							LogicalResourceId: aws.String(mockStack.Name),
+							ResourceStatus:    aws.String("UPDATE_IN_PROGRESS"),
+						},
+					},
+					NextToken: aws.String("1111"),
+				}, nil)
+				m.EXPECT().DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+					StackName: aws.String(mockStack.Name),
+					NextToken: aws.String("1111"),
+				}).Return(&cloudformation.DescribeStackEventsOutput{
+					StackEvents: []*cloudformation.StackEvent{
+						{
+							LogicalResourceId: aws.String("Service"),
+							ResourceStatus:    aws.String("CREATE_COMPLETE"),
+						},
+						{
+							LogicalResourceId: aws.String(mockStack.Name),
+							ResourceStatus:    aws.String("CREATE_IN_PROGRESS"),
+						},
+						{
+							LogicalResourceId: aws.String(mockStack.Name),
+							ResourceStatus:    aws.String("DELETE_COMPLETE"),
+						},
+					},
+					NextToken: aws.String("2222"),
+				}, nil)
+				return m
+			},
+			wantedEvents: []StackEvent{
+				{
+					LogicalResourceId: aws.String(mockStack.Name),
+					ResourceStatus:    aws.String("CREATE_IN_PROGRESS"),
+				},
+				{
+					LogicalResourceId: aws.String("Service"),
+					ResourceStatus:    aws.String("CREATE_COMPLETE"),
+				},
+				{
+					LogicalResourceId: aws.String(mockStack.Name),
+					ResourceStatus:    aws.String("UPDATE_IN_PROGRESS"),
+				},
+				{
+					LogicalResourceId: aws.String(mockStack.Name),
+					ResourceStatus:    aws.String("UPDATE_COMPLETE"),
 				},
-				Type: aws.String(cloudformation.ChangeTypeResource),
 			},
 		},
-		ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
-		StatusReason:    aws.String("some reason"),
-	}, nil)
-	m.EXPECT().ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
-		ChangeSetName: aws.String(mockChangeSetID),
-		StackName:     aws.String(mockStack.Name),
-	})
+		"returns all events if there are fewer operations": {
+			operations: 2,
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStackEvents(gomock.Any()).Return(&cloudformation.DescribeStackEventsOutput{
+					StackEvents: []*cloudformation.StackEvent{
+						{
+							LogicalResourceId: aws.String(mockStack.Name),
+							ResourceStatus:    aws.String("CREATE_IN_PROGRESS"),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedEvents: []StackEvent{
+				{
+					LogicalResourceId: aws.String(mockStack.Name),
+					ResourceStatus:    aws.String("CREATE_IN_PROGRESS"),
+				},
+			},
+		},
+		"error retrieving events": {
+			operations: 2,
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStackEvents(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("describe stack events for stack %s: %w", mockStack.Name, errors.New("some error")),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			events, err := c.RecentEvents(mockStack.Name, tc.operations)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEvents, events)
+		})
+	}
 }
@@ -0,0 +1,187 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatch
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+const (
+	containerInsightsNamespace = "ECS/ContainerInsights"
+	// containerInsightsPeriod is the granularity, in seconds, of the Container Insights datapoints we retrieve.
+	containerInsightsPeriod = 300
+
+	metricIDCPUUtilization    = "cpuUtilization"
+	metricIDMemoryUtilization = "memoryUtilization"
+	metricIDNetworkRxBytes    = "networkRxBytes"
+	metricIDNetworkTxBytes    = "networkTxBytes"
+	metricIDRunningTaskCount  = "runningTaskCount"
+
+	usageNamespace = "AWS/Usage"
+	// usagePeriod is the granularity, in seconds, of the usage datapoints we retrieve.
+	usagePeriod = 300
+
+	metricIDResourceCount = "resourceCount"
+)
+
+// Datapoint is the value of a metric at a point in time.
+type Datapoint struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"`
+}
+
+// ServiceMetrics contains the Container Insights metrics of an ECS service in chronological order.
+type ServiceMetrics struct {
+	CPUUtilization    []Datapoint `json:"cpuUtilization"`    // Percentage of the reserved CPU units in use.
+	MemoryUtilization []Datapoint `json:"memoryUtilization"` // Percentage of the reserved memory in use.
+	NetworkRxBytes    []Datapoint `json:"networkRxBytes"`    // Bytes received per second.
+	NetworkTxBytes    []Datapoint `json:"networkTxBytes"`    // Bytes transmitted per second.
+	RunningTaskCount  []Datapoint `json:"runningTaskCount"`
+}
+
+// ContainerInsightsMetrics returns the Container Insights metrics of an ECS service between the start and end time.
+func (cw *CloudWatch) ContainerInsightsMetrics(cluster, service string, startTime, endTime time.Time) (*ServiceMetrics, error) {
+	datapoints := make(map[string][]Datapoint)
+	in := &cloudwatch.GetMetricDataInput{
+		StartTime:         aws.Time(startTime),
+		EndTime:           aws.Time(endTime),
+		MetricDataQueries: containerInsightsQueries(cluster, service),
+		ScanBy:            aws.String(cloudwatch.ScanByTimestampAscending),
+	}
+	for {
+		resp, err := cw.client.GetMetricData(in)
+		if err != nil {
+			return nil, fmt.Errorf("get Container Insights metrics for ECS service %s/%s: %w", cluster, service, err)
+		}
+		for _, result := range resp.MetricDataResults {
+			id := aws.StringValue(result.Id)
+			for i, timestamp := range result.Timestamps {
+				if i >= len(result.Values) {
+					break
+				}
+				datapoints[id] = append(datapoints[id], Datapoint{
+					Timestamp: aws.TimeValue(timestamp),
+					Value:     aws.Float64Value(result.Values[i]),
+				})
+			}
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	return &ServiceMetrics{
+		CPUUtilization:    datapoints[metricIDCPUUtilization],
+		MemoryUtilization: datapoints[metricIDMemoryUtilization],
+		NetworkRxBytes:    datapoints[metricIDNetworkRxBytes],
+		NetworkTxBytes:    datapoints[metricIDNetworkTxBytes],
+		RunningTaskCount:  datapoints[metricIDRunningTaskCount],
+	}, nil
+}
+
+func containerInsightsQueries(cluster, service string) []*cloudwatch.MetricDataQuery {
+	metric := func(id, name string, returnData bool) *cloudwatch.MetricDataQuery {
+		return &cloudwatch.MetricDataQuery{
+			Id: aws.String(id),
+			MetricStat: &cloudwatch.MetricStat{
+				Metric: &cloudwatch.Metric{
+					Namespace:  aws.String(containerInsightsNamespace),
+					MetricName: aws.String(name),
+					Dimensions: []*cloudwatch.Dimension{
+						{
+							Name:  aws.String("ClusterName"),
+							Value: aws.String(cluster),
+						},
+						{
+							Name:  aws.String("ServiceName"),
+							Value: aws.String(service),
+						},
+					},
+				},
+				Period: aws.Int64(containerInsightsPeriod),
+				Stat:   aws.String(cloudwatch.StatisticAverage),
+			},
+			ReturnData: aws.Bool(returnData),
+		}
+	}
+	expression := func(id, expr string) *cloudwatch.MetricDataQuery {
+		return &cloudwatch.MetricDataQuery{
+			Id:         aws.String(id),
+			Expression: aws.String(expr),
+			ReturnData: aws.Bool(true),
+		}
+	}
+	return []*cloudwatch.MetricDataQuery{
+		metric("cpuUtilized", "CpuUtilized", false),
+		metric("cpuReserved", "CpuReserved", false),
+		metric("memoryUtilized", "MemoryUtilized", false),
+		metric("memoryReserved", "MemoryReserved", false),
+		expression(metricIDCPUUtilization, "100 * cpuUtilized / cpuReserved"),
+		expression(metricIDMemoryUtilization, "100 * memoryUtilized / memoryReserved"),
+		metric(metricIDNetworkRxBytes, "NetworkRxBytes", true),
+		metric(metricIDNetworkTxBytes, "NetworkTxBytes", true),
+		metric(metricIDRunningTaskCount, "RunningTaskCount", true),
+	}
+}
+
+// MaxResourceCount returns the maximum number of resources in use in the account and region between the start and end time,
+// according to the "ResourceCount" metric that the service publishes under the AWS/Usage namespace.
+func (cw *CloudWatch) MaxResourceCount(service, resource, class string, startTime, endTime time.Time) (float64, error) {
+	in := &cloudwatch.GetMetricDataInput{
+		StartTime: aws.Time(startTime),
+		EndTime:   aws.Time(endTime),
+		MetricDataQueries: []*cloudwatch.MetricDataQuery{
+			{
+				Id: aws.String(metricIDResourceCount),
+				MetricStat: &cloudwatch.MetricStat{
+					Metric: &cloudwatch.Metric{
+						Namespace:  aws.String(usageNamespace),
+						MetricName: aws.String("ResourceCount"),
+						Dimensions: []*cloudwatch.Dimension{
+							{
+								Name:  aws.String("Service"),
+								Value: aws.String(service),
+							},
+							{
+								Name:  aws.String("Type"),
+								Value: aws.String("Resource"),
+							},
+							{
+								Name:  aws.String("Resource"),
+								Value: aws.String(resource),
+							},
+							{
+								Name:  aws.String("Class"),
+								Value: aws.String(class),
+							},
+						},
+					},
+					Period: aws.Int64(usagePeriod),
+					Stat:   aws.String(cloudwatch.StatisticMaximum),
+				},
+			},
+		},
+	}
+	var max float64
+	for {
+		resp, err := cw.client.GetMetricData(in)
+		if err != nil {
+			return 0, fmt.Errorf("get %s %s usage: %w", service, resource, err)
+		}
+		for _, result := range resp.MetricDataResults {
+			for _, value := range result.Values {
+				max = math.Max(max, aws.Float64Value(value))
+			}
+		}
+		if resp.NextToken == nil {
+			return max, nil
+		}
+		in.NextToken = resp.NextToken
+	}
+}
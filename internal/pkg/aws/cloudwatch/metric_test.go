@@ -0,0 +1,205 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatch
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudWatch_ContainerInsightsMetrics(t *testing.T) {
+	startTime, _ := time.Parse(time.RFC3339, "2021-05-01T10:00:00+00:00")
+	endTime, _ := time.Parse(time.RFC3339, "2021-05-01T11:00:00+00:00")
+	firstTime, _ := time.Parse(time.RFC3339, "2021-05-01T10:05:00+00:00")
+	secondTime, _ := time.Parse(time.RFC3339, "2021-05-01T10:10:00+00:00")
+
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedErr     string
+		wantedMetrics *ServiceMetrics
+	}{
+		"errors if failed to get metric data": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetMetricData(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedErr: "get Container Insights metrics for ECS service mockCluster/mockService: some error",
+		},
+		"success across pages": {
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().GetMetricData(&cloudwatch.GetMetricDataInput{
+						StartTime:         aws.Time(startTime),
+						EndTime:           aws.Time(endTime),
+						MetricDataQueries: containerInsightsQueries("mockCluster", "mockService"),
+						ScanBy:            aws.String(cloudwatch.ScanByTimestampAscending),
+					}).Return(&cloudwatch.GetMetricDataOutput{
+						MetricDataResults: []*cloudwatch.MetricDataResult{
+							{
+								Id:         aws.String(metricIDCPUUtilization),
+								Timestamps: []*time.Time{&firstTime},
+								Values:     aws.Float64Slice([]float64{12.5}),
+							},
+							{
+								Id:         aws.String(metricIDRunningTaskCount),
+								Timestamps: []*time.Time{&firstTime, &secondTime},
+								Values:     aws.Float64Slice([]float64{1, 2}),
+							},
+						},
+						NextToken: aws.String("mockToken"),
+					}, nil),
+					m.EXPECT().GetMetricData(&cloudwatch.GetMetricDataInput{
+						StartTime:         aws.Time(startTime),
+						EndTime:           aws.Time(endTime),
+						MetricDataQueries: containerInsightsQueries("mockCluster", "mockService"),
+						ScanBy:            aws.String(cloudwatch.ScanByTimestampAscending),
+						NextToken:         aws.String("mockToken"),
+					}).Return(&cloudwatch.GetMetricDataOutput{
+						MetricDataResults: []*cloudwatch.MetricDataResult{
+							{
+								Id:         aws.String(metricIDCPUUtilization),
+								Timestamps: []*time.Time{&secondTime},
+								Values:     aws.Float64Slice([]float64{25}),
+							},
+							{
+								Id:         aws.String(metricIDNetworkRxBytes),
+								Timestamps: []*time.Time{&secondTime},
+								Values:     aws.Float64Slice([]float64{1024}),
+							},
+						},
+					}, nil),
+				)
+			},
+
+			wantedMetrics: &ServiceMetrics{
+				CPUUtilization: []Datapoint{
+					{Timestamp: firstTime, Value: 12.5},
+					{Timestamp: secondTime, Value: 25},
+				},
+				NetworkRxBytes: []Datapoint{
+					{Timestamp: secondTime, Value: 1024},
+				},
+				RunningTaskCount: []Datapoint{
+					{Timestamp: firstTime, Value: 1},
+					{Timestamp: secondTime, Value: 2},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcwClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockcwClient)
+
+			cwSvc := CloudWatch{
+				client: mockcwClient,
+			}
+
+			// WHEN
+			got, err := cwSvc.ContainerInsightsMetrics("mockCluster", "mockService", startTime, endTime)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedMetrics, got)
+			}
+		})
+	}
+}
+
+func TestCloudWatch_MaxResourceCount(t *testing.T) {
+	startTime, _ := time.Parse(time.RFC3339, "2021-05-01T10:00:00+00:00")
+	endTime, _ := time.Parse(time.RFC3339, "2021-05-01T11:00:00+00:00")
+
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedErr   string
+		wantedCount float64
+	}{
+		"errors if failed to get metric data": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetMetricData(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedErr: "get Fargate vCPU usage: some error",
+		},
+		"returns the maximum value across pages": {
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().GetMetricData(gomock.Any()).DoAndReturn(func(in *cloudwatch.GetMetricDataInput) (*cloudwatch.GetMetricDataOutput, error) {
+						query := in.MetricDataQueries[0]
+						require.Equal(t, "AWS/Usage", aws.StringValue(query.MetricStat.Metric.Namespace))
+						require.Equal(t, "ResourceCount", aws.StringValue(query.MetricStat.Metric.MetricName))
+						require.Equal(t, []*cloudwatch.Dimension{
+							{Name: aws.String("Service"), Value: aws.String("Fargate")},
+							{Name: aws.String("Type"), Value: aws.String("Resource")},
+							{Name: aws.String("Resource"), Value: aws.String("vCPU")},
+							{Name: aws.String("Class"), Value: aws.String("Standard/OnDemand")},
+						}, query.MetricStat.Metric.Dimensions)
+						require.Equal(t, cloudwatch.StatisticMaximum, aws.StringValue(query.MetricStat.Stat))
+						return &cloudwatch.GetMetricDataOutput{
+							MetricDataResults: []*cloudwatch.MetricDataResult{
+								{
+									Values: aws.Float64Slice([]float64{4, 12}),
+								},
+							},
+							NextToken: aws.String("mockToken"),
+						}, nil
+					}),
+					m.EXPECT().GetMetricData(gomock.Any()).Return(&cloudwatch.GetMetricDataOutput{
+						MetricDataResults: []*cloudwatch.MetricDataResult{
+							{
+								Values: aws.Float64Slice([]float64{8}),
+							},
+						},
+					}, nil),
+				)
+			},
+
+			wantedCount: 12,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcwClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockcwClient)
+
+			cwSvc := CloudWatch{
+				client: mockcwClient,
+			}
+
+			// WHEN
+			got, err := cwSvc.MaxResourceCount("Fargate", "vCPU", "Standard/OnDemand", startTime, endTime)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedCount, got)
+			}
+		})
+	}
+}
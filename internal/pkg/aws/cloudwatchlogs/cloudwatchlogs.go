@@ -18,6 +18,9 @@ import (
 const (
 	// SleepDuration is the sleep time for making the next request for log events.
 	SleepDuration = 1 * time.Second
+
+	// queryPointerField is the field that Logs Insights adds to every row to retrieve the full log event.
+	queryPointerField = "@ptr"
 )
 
 var (
@@ -28,6 +31,9 @@ var (
 type api interface {
 	DescribeLogStreams(input *cloudwatchlogs.DescribeLogStreamsInput) (*cloudwatchlogs.DescribeLogStreamsOutput, error)
 	GetLogEvents(input *cloudwatchlogs.GetLogEventsInput) (*cloudwatchlogs.GetLogEventsOutput, error)
+	FilterLogEvents(input *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error)
+	StartQuery(input *cloudwatchlogs.StartQueryInput) (*cloudwatchlogs.StartQueryOutput, error)
+	GetQueryResults(input *cloudwatchlogs.GetQueryResultsInput) (*cloudwatchlogs.GetQueryResultsOutput, error)
 }
 
 // CloudWatchLogs wraps an AWS Cloudwatch Logs client.
@@ -53,6 +59,21 @@ type LogEventsOpts struct {
 	StreamLastEventTime map[string]int64
 }
 
+// QueryInput wraps the parameters to call Query.
+type QueryInput struct {
+	LogGroups []string
+	Query     string // A CloudWatch Logs Insights query.
+	StartTime int64  // Unix time in milliseconds.
+	EndTime   int64  // Unix time in milliseconds.
+	Limit     *int64 // If nil, the query's limit or the default limit of Logs Insights applies.
+}
+
+// QueryResults holds the rows matched by a CloudWatch Logs Insights query.
+type QueryResults struct {
+	Fields []string            // Names of the fields in the order they appear in the rows.
+	Rows   []map[string]string // Values of the fields, by name, of each matched row.
+}
+
 // New returns a CloudWatchLogs configured against the input session.
 func New(s *session.Session) *CloudWatchLogs {
 	return &CloudWatchLogs{
@@ -140,6 +161,88 @@ func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error)
 	}, nil
 }
 
+// FilteredLogEvents returns all the events in a log group since startTime, in milliseconds, that match the filter pattern.
+func (c *CloudWatchLogs) FilteredLogEvents(logGroup, filterPattern string, startTime int64) ([]*Event, error) {
+	in := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName:  aws.String(logGroup),
+		FilterPattern: aws.String(filterPattern),
+		StartTime:     aws.Int64(startTime),
+	}
+	var events []*Event
+	for {
+		resp, err := c.client.FilterLogEvents(in)
+		if err != nil {
+			return nil, fmt.Errorf("filter log events of log group %s: %w", logGroup, err)
+		}
+		for _, event := range resp.Events {
+			events = append(events, &Event{
+				LogStreamName: aws.StringValue(event.LogStreamName),
+				IngestionTime: aws.Int64Value(event.IngestionTime),
+				Message:       aws.StringValue(event.Message),
+				Timestamp:     aws.Int64Value(event.Timestamp),
+			})
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp < events[j].Timestamp })
+	return events, nil
+}
+
+// Query runs a CloudWatch Logs Insights query across the log groups and waits for its results.
+func (c *CloudWatchLogs) Query(in QueryInput) (*QueryResults, error) {
+	startResp, err := c.client.StartQuery(&cloudwatchlogs.StartQueryInput{
+		LogGroupNames: aws.StringSlice(in.LogGroups),
+		QueryString:   aws.String(in.Query),
+		StartTime:     aws.Int64(in.StartTime / 1000),
+		EndTime:       aws.Int64(in.EndTime / 1000),
+		Limit:         in.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("start query on log groups %s: %w", strings.Join(in.LogGroups, ", "), err)
+	}
+	queryID := aws.StringValue(startResp.QueryId)
+	for {
+		resp, err := c.client.GetQueryResults(&cloudwatchlogs.GetQueryResultsInput{
+			QueryId: aws.String(queryID),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get results of query %s: %w", queryID, err)
+		}
+		switch status := aws.StringValue(resp.Status); status {
+		case cloudwatchlogs.QueryStatusComplete:
+			return toQueryResults(resp.Results), nil
+		case cloudwatchlogs.QueryStatusScheduled, cloudwatchlogs.QueryStatusRunning:
+			time.Sleep(SleepDuration)
+		default:
+			return nil, fmt.Errorf("query %s ended with status %s", queryID, status)
+		}
+	}
+}
+
+func toQueryResults(results [][]*cloudwatchlogs.ResultField) *QueryResults {
+	out := &QueryResults{}
+	seen := make(map[string]bool)
+	for _, result := range results {
+		row := make(map[string]string)
+		for _, field := range result {
+			name := aws.StringValue(field.Field)
+			if name == queryPointerField {
+				continue
+			}
+			if !seen[name] {
+				seen[name] = true
+				out.Fields = append(out.Fields, name)
+			}
+			row[name] = aws.StringValue(field.Value)
+		}
+		out.Rows = append(out.Rows, row)
+	}
+	return out
+}
+
 func truncateEvents(limit int, events []*Event) []*Event {
 	if len(events) <= limit {
 		return events
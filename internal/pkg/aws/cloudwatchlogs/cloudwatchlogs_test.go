@@ -269,3 +269,212 @@ func TestLogEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestFilteredLogEvents(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		mockcloudwatchlogsClient func(m *mocks.Mockapi)
+
+		wantLogEvents []*Event
+		wantErr       error
+	}{
+		"errors if failed to filter log events": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().FilterLogEvents(gomock.Any()).Return(nil, mockError)
+			},
+
+			wantErr: fmt.Errorf("filter log events of log group mockLogGroup: some error"),
+		},
+		"should paginate and return sorted log events": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName:  aws.String("mockLogGroup"),
+					FilterPattern: aws.String("mockPattern"),
+					StartTime:     aws.Int64(100),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							LogStreamName: aws.String("mockLogStream"),
+							Message:       aws.String("other log"),
+							Timestamp:     aws.Int64(2),
+						},
+					},
+					NextToken: aws.String("mockToken"),
+				}, nil)
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName:  aws.String("mockLogGroup"),
+					FilterPattern: aws.String("mockPattern"),
+					StartTime:     aws.Int64(100),
+					NextToken:     aws.String("mockToken"),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							LogStreamName: aws.String("mockLogStream"),
+							Message:       aws.String("some log"),
+							Timestamp:     aws.Int64(1),
+						},
+					},
+				}, nil)
+			},
+
+			wantLogEvents: []*Event{
+				{
+					LogStreamName: "mockLogStream",
+					Message:       "some log",
+					Timestamp:     1,
+				},
+				{
+					LogStreamName: "mockLogStream",
+					Message:       "other log",
+					Timestamp:     2,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcloudwatchlogsClient := mocks.NewMockapi(ctrl)
+			tc.mockcloudwatchlogsClient(mockcloudwatchlogsClient)
+
+			service := CloudWatchLogs{
+				client: mockcloudwatchlogsClient,
+			}
+
+			// WHEN
+			got, err := service.FilteredLogEvents("mockLogGroup", "mockPattern", 100)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantLogEvents, got)
+			}
+		})
+	}
+}
+
+func TestQuery(t *testing.T) {
+	testCases := map[string]struct {
+		mockcloudwatchlogsClient func(m *mocks.Mockapi)
+
+		wantResults *QueryResults
+		wantErr     error
+	}{
+		"errors if failed to start the query": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQuery(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantErr: fmt.Errorf("start query on log groups mockLogGroup, mockOtherLogGroup: some error"),
+		},
+		"errors if failed to get the query results": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQuery(gomock.Any()).Return(&cloudwatchlogs.StartQueryOutput{
+					QueryId: aws.String("mockQueryID"),
+				}, nil)
+				m.EXPECT().GetQueryResults(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantErr: fmt.Errorf("get results of query mockQueryID: some error"),
+		},
+		"errors if the query fails": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQuery(gomock.Any()).Return(&cloudwatchlogs.StartQueryOutput{
+					QueryId: aws.String("mockQueryID"),
+				}, nil)
+				m.EXPECT().GetQueryResults(gomock.Any()).Return(&cloudwatchlogs.GetQueryResultsOutput{
+					Status: aws.String(cloudwatchlogs.QueryStatusFailed),
+				}, nil)
+			},
+
+			wantErr: fmt.Errorf("query mockQueryID ended with status Failed"),
+		},
+		"should wait for the query to complete and return its rows": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQuery(&cloudwatchlogs.StartQueryInput{
+					LogGroupNames: aws.StringSlice([]string{"mockLogGroup", "mockOtherLogGroup"}),
+					QueryString:   aws.String("fields @timestamp, @message"),
+					StartTime:     aws.Int64(1),
+					EndTime:       aws.Int64(2),
+					Limit:         aws.Int64(10),
+				}).Return(&cloudwatchlogs.StartQueryOutput{
+					QueryId: aws.String("mockQueryID"),
+				}, nil)
+				gomock.InOrder(
+					m.EXPECT().GetQueryResults(&cloudwatchlogs.GetQueryResultsInput{
+						QueryId: aws.String("mockQueryID"),
+					}).Return(&cloudwatchlogs.GetQueryResultsOutput{
+						Status: aws.String(cloudwatchlogs.QueryStatusRunning),
+					}, nil),
+					m.EXPECT().GetQueryResults(&cloudwatchlogs.GetQueryResultsInput{
+						QueryId: aws.String("mockQueryID"),
+					}).Return(&cloudwatchlogs.GetQueryResultsOutput{
+						Status: aws.String(cloudwatchlogs.QueryStatusComplete),
+						Results: [][]*cloudwatchlogs.ResultField{
+							{
+								{Field: aws.String("@timestamp"), Value: aws.String("2021-07-01 00:00:00.000")},
+								{Field: aws.String("@message"), Value: aws.String("some log")},
+								{Field: aws.String("@ptr"), Value: aws.String("mockPointer")},
+							},
+							{
+								{Field: aws.String("@timestamp"), Value: aws.String("2021-07-01 00:00:01.000")},
+								{Field: aws.String("@message"), Value: aws.String("other log")},
+								{Field: aws.String("@ptr"), Value: aws.String("mockPointer")},
+							},
+						},
+					}, nil),
+				)
+			},
+
+			wantResults: &QueryResults{
+				Fields: []string{"@timestamp", "@message"},
+				Rows: []map[string]string{
+					{
+						"@timestamp": "2021-07-01 00:00:00.000",
+						"@message":   "some log",
+					},
+					{
+						"@timestamp": "2021-07-01 00:00:01.000",
+						"@message":   "other log",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockcloudwatchlogsClient := mocks.NewMockapi(ctrl)
+			tc.mockcloudwatchlogsClient(mockcloudwatchlogsClient)
+			service := CloudWatchLogs{
+				client: mockcloudwatchlogsClient,
+			}
+
+			// WHEN
+			results, err := service.Query(QueryInput{
+				LogGroups: []string{"mockLogGroup", "mockOtherLogGroup"},
+				Query:     "fields @timestamp, @message",
+				StartTime: 1000,
+				EndTime:   2000,
+				Limit:     aws.Int64(10),
+			})
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantResults, results)
+		})
+	}
+}
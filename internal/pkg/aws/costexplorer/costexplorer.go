@@ -0,0 +1,190 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package costexplorer provides a client to make API requests to AWS Cost Explorer.
+package costexplorer
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+)
+
+const (
+	// Cost Explorer is a global service whose API is only available in us-east-1.
+	apiRegion = "us-east-1"
+
+	dateLayout        = "2006-01-02"
+	unblendedCost     = "UnblendedCost"
+	tagGroupSeparator = "$" // Cost Explorer returns tag groups as "key$value".
+)
+
+type api interface {
+	GetCostAndUsage(input *costexplorer.GetCostAndUsageInput) (*costexplorer.GetCostAndUsageOutput, error)
+	GetCostForecast(input *costexplorer.GetCostForecastInput) (*costexplorer.GetCostForecastOutput, error)
+}
+
+// CostExplorer wraps an AWS Cost Explorer client.
+type CostExplorer struct {
+	client api
+	now    func() time.Time
+}
+
+// New returns a CostExplorer client configured against the input session.
+func New(s *session.Session) *CostExplorer {
+	return &CostExplorer{
+		client: costexplorer.New(s, aws.NewConfig().WithRegion(apiRegion)),
+		now:    time.Now,
+	}
+}
+
+// ErrForecastUnavailable occurs when Cost Explorer doesn't have enough history to forecast the cost of resources,
+// for example for resources created during the last days.
+type ErrForecastUnavailable struct {
+	tags map[string]string
+}
+
+func (e *ErrForecastUnavailable) Error() string {
+	return fmt.Sprintf("not enough cost history to forecast the cost of resources tagged %s", tagsString(e.tags))
+}
+
+// MonthToDateCostByTag returns the unblended cost in USD since the start of the month of the resources that match the tags,
+// grouped by the value of their groupByKey tag. The cost of resources without the groupByKey tag is keyed by an empty string.
+// A tag with an empty value matches the resources that don't have the tag.
+func (c *CostExplorer) MonthToDateCostByTag(tags map[string]string, groupByKey string) (map[string]float64, error) {
+	start, end := c.monthToDate()
+	costs := make(map[string]float64)
+	var token *string
+	for {
+		out, err := c.client.GetCostAndUsage(&costexplorer.GetCostAndUsageInput{
+			TimePeriod: &costexplorer.DateInterval{
+				Start: aws.String(start.Format(dateLayout)),
+				End:   aws.String(end.Format(dateLayout)),
+			},
+			Granularity: aws.String(costexplorer.GranularityMonthly),
+			Metrics:     aws.StringSlice([]string{unblendedCost}),
+			Filter:      tagsExpression(tags),
+			GroupBy: []*costexplorer.GroupDefinition{
+				{
+					Type: aws.String(costexplorer.GroupDefinitionTypeTag),
+					Key:  aws.String(groupByKey),
+				},
+			},
+			NextPageToken: token,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get cost and usage of resources tagged %s: %w", tagsString(tags), err)
+		}
+		for _, result := range out.ResultsByTime {
+			for _, group := range result.Groups {
+				if len(group.Keys) == 0 {
+					continue
+				}
+				value := strings.TrimPrefix(aws.StringValue(group.Keys[0]), groupByKey+tagGroupSeparator)
+				amount, err := metricAmount(group.Metrics[unblendedCost])
+				if err != nil {
+					return nil, err
+				}
+				costs[value] += amount
+			}
+		}
+		if out.NextPageToken == nil {
+			break
+		}
+		token = out.NextPageToken
+	}
+	return costs, nil
+}
+
+// ForecastedCost returns the forecasted unblended cost in USD of the resources that match the tags
+// from tomorrow to the end of the month. A tag with an empty value matches the resources that don't have the tag.
+func (c *CostExplorer) ForecastedCost(tags map[string]string) (float64, error) {
+	_, tomorrow := c.monthToDate()
+	if tomorrow.Day() == 1 {
+		// Today is the last day of the month.
+		return 0, nil
+	}
+	endOfMonth := time.Date(tomorrow.Year(), tomorrow.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1, 0)
+	out, err := c.client.GetCostForecast(&costexplorer.GetCostForecastInput{
+		TimePeriod: &costexplorer.DateInterval{
+			Start: aws.String(tomorrow.Format(dateLayout)),
+			End:   aws.String(endOfMonth.Format(dateLayout)),
+		},
+		Granularity: aws.String(costexplorer.GranularityMonthly),
+		Metric:      aws.String(costexplorer.MetricUnblendedCost),
+		Filter:      tagsExpression(tags),
+	})
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == costexplorer.ErrCodeDataUnavailableException {
+			return 0, &ErrForecastUnavailable{tags: tags}
+		}
+		return 0, fmt.Errorf("get cost forecast of resources tagged %s: %w", tagsString(tags), err)
+	}
+	return metricAmount(out.Total)
+}
+
+// monthToDate returns the first day of the current month and the day after today in UTC, the time zone of billing data.
+func (c *CostExplorer) monthToDate() (start, end time.Time) {
+	now := c.now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC), today.AddDate(0, 0, 1)
+}
+
+func tagsExpression(tags map[string]string) *costexplorer.Expression {
+	var exprs []*costexplorer.Expression
+	for _, key := range sortedKeys(tags) {
+		tag := &costexplorer.TagValues{
+			Key:          aws.String(key),
+			MatchOptions: aws.StringSlice([]string{costexplorer.MatchOptionEquals}),
+			Values:       aws.StringSlice([]string{tags[key]}),
+		}
+		if tags[key] == "" {
+			tag = &costexplorer.TagValues{
+				Key:          aws.String(key),
+				MatchOptions: aws.StringSlice([]string{costexplorer.MatchOptionAbsent}),
+			}
+		}
+		exprs = append(exprs, &costexplorer.Expression{Tags: tag})
+	}
+	if len(exprs) == 1 {
+		return exprs[0]
+	}
+	return &costexplorer.Expression{And: exprs}
+}
+
+func metricAmount(metric *costexplorer.MetricValue) (float64, error) {
+	if metric == nil || metric.Amount == nil {
+		return 0, nil
+	}
+	amount, err := strconv.ParseFloat(aws.StringValue(metric.Amount), 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse cost amount %s: %w", aws.StringValue(metric.Amount), err)
+	}
+	return amount, nil
+}
+
+func tagsString(tags map[string]string) string {
+	var pairs []string
+	for _, key := range sortedKeys(tags) {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, tags[key]))
+	}
+	return strings.Join(pairs, ",")
+}
+
+func sortedKeys(tags map[string]string) []string {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
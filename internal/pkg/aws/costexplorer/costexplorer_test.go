@@ -0,0 +1,251 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package costexplorer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/aws/copilot-cli/internal/pkg/aws/costexplorer/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+var envTags = map[string]string{
+	"copilot-application": "phonetool",
+	"copilot-environment": "test",
+}
+
+func envFilter() *costexplorer.Expression {
+	return &costexplorer.Expression{
+		And: []*costexplorer.Expression{
+			{
+				Tags: &costexplorer.TagValues{
+					Key:          aws.String("copilot-application"),
+					MatchOptions: aws.StringSlice([]string{"EQUALS"}),
+					Values:       aws.StringSlice([]string{"phonetool"}),
+				},
+			},
+			{
+				Tags: &costexplorer.TagValues{
+					Key:          aws.String("copilot-environment"),
+					MatchOptions: aws.StringSlice([]string{"EQUALS"}),
+					Values:       aws.StringSlice([]string{"test"}),
+				},
+			},
+		},
+	}
+}
+
+func TestCostExplorer_MonthToDateCostByTag(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wanted    map[string]float64
+		wantedErr error
+	}{
+		"wraps the API error": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetCostAndUsage(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get cost and usage of resources tagged copilot-application=phonetool,copilot-environment=test: some error"),
+		},
+		"sums the cost of each tag value across pages": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetCostAndUsage(&costexplorer.GetCostAndUsageInput{
+					TimePeriod: &costexplorer.DateInterval{
+						Start: aws.String("2021-03-01"),
+						End:   aws.String("2021-03-16"),
+					},
+					Granularity: aws.String("MONTHLY"),
+					Metrics:     aws.StringSlice([]string{"UnblendedCost"}),
+					Filter:      envFilter(),
+					GroupBy: []*costexplorer.GroupDefinition{
+						{
+							Type: aws.String("TAG"),
+							Key:  aws.String("copilot-service"),
+						},
+					},
+				}).Return(&costexplorer.GetCostAndUsageOutput{
+					ResultsByTime: []*costexplorer.ResultByTime{
+						{
+							Groups: []*costexplorer.Group{
+								{
+									Keys:    aws.StringSlice([]string{"copilot-service$"}),
+									Metrics: map[string]*costexplorer.MetricValue{"UnblendedCost": {Amount: aws.String("20.5")}},
+								},
+								{
+									Keys:    aws.StringSlice([]string{"copilot-service$frontend"}),
+									Metrics: map[string]*costexplorer.MetricValue{"UnblendedCost": {Amount: aws.String("10.25")}},
+								},
+							},
+						},
+					},
+					NextPageToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().GetCostAndUsage(gomock.Any()).DoAndReturn(func(in *costexplorer.GetCostAndUsageInput) (*costexplorer.GetCostAndUsageOutput, error) {
+					require.Equal(t, "next", aws.StringValue(in.NextPageToken))
+					return &costexplorer.GetCostAndUsageOutput{
+						ResultsByTime: []*costexplorer.ResultByTime{
+							{
+								Groups: []*costexplorer.Group{
+									{
+										Keys:    aws.StringSlice([]string{"copilot-service$frontend"}),
+										Metrics: map[string]*costexplorer.MetricValue{"UnblendedCost": {Amount: aws.String("1.75")}},
+									},
+								},
+							},
+						},
+					}, nil
+				})
+			},
+			wanted: map[string]float64{
+				"":         20.5,
+				"frontend": 12,
+			},
+		},
+		"errors if an amount is not a number": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetCostAndUsage(gomock.Any()).Return(&costexplorer.GetCostAndUsageOutput{
+					ResultsByTime: []*costexplorer.ResultByTime{
+						{
+							Groups: []*costexplorer.Group{
+								{
+									Keys:    aws.StringSlice([]string{"copilot-service$frontend"}),
+									Metrics: map[string]*costexplorer.MetricValue{"UnblendedCost": {Amount: aws.String("ten")}},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedErr: errors.New(`parse cost amount ten: strconv.ParseFloat: parsing "ten": invalid syntax`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockClient)
+			ce := &CostExplorer{
+				client: mockClient,
+				now: func() time.Time {
+					return time.Date(2021, 3, 15, 22, 0, 0, 0, time.UTC)
+				},
+			}
+
+			// WHEN
+			costs, err := ce.MonthToDateCostByTag(envTags, "copilot-service")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, costs)
+		})
+	}
+}
+
+func TestCostExplorer_ForecastedCost(t *testing.T) {
+	testCases := map[string]struct {
+		inNow      time.Time
+		inTags     map[string]string
+		mockClient func(m *mocks.Mockapi)
+
+		wanted    float64
+		wantedErr error
+	}{
+		"forecasts the cost from tomorrow to the end of the month": {
+			inNow:  time.Date(2021, 12, 15, 8, 0, 0, 0, time.UTC),
+			inTags: envTags,
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetCostForecast(&costexplorer.GetCostForecastInput{
+					TimePeriod: &costexplorer.DateInterval{
+						Start: aws.String("2021-12-16"),
+						End:   aws.String("2022-01-01"),
+					},
+					Granularity: aws.String("MONTHLY"),
+					Metric:      aws.String("UNBLENDED_COST"),
+					Filter:      envFilter(),
+				}).Return(&costexplorer.GetCostForecastOutput{
+					Total: &costexplorer.MetricValue{Amount: aws.String("42.5")},
+				}, nil)
+			},
+			wanted: 42.5,
+		},
+		"matches resources without a tag whose value is empty": {
+			inNow:  time.Date(2021, 3, 15, 8, 0, 0, 0, time.UTC),
+			inTags: map[string]string{"copilot-service": ""},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetCostForecast(gomock.Any()).DoAndReturn(func(in *costexplorer.GetCostForecastInput) (*costexplorer.GetCostForecastOutput, error) {
+					require.Equal(t, &costexplorer.Expression{
+						Tags: &costexplorer.TagValues{
+							Key:          aws.String("copilot-service"),
+							MatchOptions: aws.StringSlice([]string{"ABSENT"}),
+						},
+					}, in.Filter)
+					return &costexplorer.GetCostForecastOutput{
+						Total: &costexplorer.MetricValue{Amount: aws.String("1")},
+					}, nil
+				})
+			},
+			wanted: 1,
+		},
+		"nothing left to forecast on the last day of the month": {
+			inNow:      time.Date(2021, 3, 31, 8, 0, 0, 0, time.UTC),
+			inTags:     envTags,
+			mockClient: func(m *mocks.Mockapi) {},
+		},
+		"returns ErrForecastUnavailable without enough history": {
+			inNow:  time.Date(2021, 3, 15, 8, 0, 0, 0, time.UTC),
+			inTags: envTags,
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetCostForecast(gomock.Any()).Return(nil, awserr.New(costexplorer.ErrCodeDataUnavailableException, "insufficient amount of historical data", nil))
+			},
+			wantedErr: &ErrForecastUnavailable{tags: envTags},
+		},
+		"wraps other errors": {
+			inNow:  time.Date(2021, 3, 15, 8, 0, 0, 0, time.UTC),
+			inTags: envTags,
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetCostForecast(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get cost forecast of resources tagged copilot-application=phonetool,copilot-environment=test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockClient)
+			ce := &CostExplorer{
+				client: mockClient,
+				now:    func() time.Time { return tc.inNow },
+			}
+
+			// WHEN
+			cost, err := ce.ForecastedCost(tc.inTags)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, cost)
+		})
+	}
+}
@@ -6,9 +6,11 @@ package ecr
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -29,6 +31,8 @@ type api interface {
 	GetAuthorizationToken(*ecr.GetAuthorizationTokenInput) (*ecr.GetAuthorizationTokenOutput, error)
 	DescribeRepositories(*ecr.DescribeRepositoriesInput) (*ecr.DescribeRepositoriesOutput, error)
 	BatchDeleteImage(*ecr.BatchDeleteImageInput) (*ecr.BatchDeleteImageOutput, error)
+	PutLifecyclePolicy(*ecr.PutLifecyclePolicyInput) (*ecr.PutLifecyclePolicyOutput, error)
+	DeleteLifecyclePolicy(*ecr.DeleteLifecyclePolicyInput) (*ecr.DeleteLifecyclePolicyOutput, error)
 }
 
 // ECR wraps an AWS ECR client.
@@ -84,7 +88,20 @@ func (c ECR) RepositoryURI(name string) (string, error) {
 
 // Image houses metadata for ECR repository images.
 type Image struct {
-	Digest string
+	Digest   string
+	Tags     []string
+	PushedAt time.Time
+}
+
+func newImage(detail *ecr.ImageDetail) Image {
+	img := Image{
+		Digest:   aws.StringValue(detail.ImageDigest),
+		PushedAt: aws.TimeValue(detail.ImagePushedAt),
+	}
+	if len(detail.ImageTags) != 0 {
+		img.Tags = aws.StringValueSlice(detail.ImageTags)
+	}
+	return img
 }
 
 func (i Image) imageIdentifier() *ecr.ImageIdentifier {
@@ -104,9 +121,7 @@ func (c ECR) ListImages(repoName string) ([]Image, error) {
 		return nil, fmt.Errorf("ecr repo %s describe images: %w", repoName, err)
 	}
 	for _, imageDetails := range resp.ImageDetails {
-		images = append(images, Image{
-			Digest: *imageDetails.ImageDigest,
-		})
+		images = append(images, newImage(imageDetails))
 	}
 	for resp.NextToken != nil {
 		resp, err = c.client.DescribeImages(&ecr.DescribeImagesInput{
@@ -117,14 +132,34 @@ func (c ECR) ListImages(repoName string) ([]Image, error) {
 			return nil, fmt.Errorf("ecr repo %s describe images: %w", repoName, err)
 		}
 		for _, imageDetails := range resp.ImageDetails {
-			images = append(images, Image{
-				Digest: *imageDetails.ImageDigest,
-			})
+			images = append(images, newImage(imageDetails))
 		}
 	}
 	return images, nil
 }
 
+// LastPushedDigest returns the digest of the tagged image most recently pushed to the input ECR repository name.
+func (c ECR) LastPushedDigest(repoName string) (string, error) {
+	images, err := c.ListImages(repoName)
+	if err != nil {
+		return "", err
+	}
+	var last *Image
+	for i, image := range images {
+		if len(image.Tags) == 0 {
+			// Skip untagged artifacts such as lazy loading indexes or images whose tags were moved.
+			continue
+		}
+		if last == nil || image.PushedAt.After(last.PushedAt) {
+			last = &images[i]
+		}
+	}
+	if last == nil {
+		return "", fmt.Errorf("no tagged images found in ecr repo %s", repoName)
+	}
+	return last.Digest, nil
+}
+
 // DeleteImages calls the ECR BatchDeleteImage API with the input image list and repository name.
 func (c ECR) DeleteImages(images []Image, repoName string) error {
 	if len(images) == 0 {
@@ -158,6 +193,95 @@ func (c ECR) DeleteImages(images []Image, repoName string) error {
 	return nil
 }
 
+// lifecyclePolicy is the JSON document of an ECR lifecycle policy.
+// See https://docs.aws.amazon.com/AmazonECR/latest/userguide/LifecyclePolicies.html.
+type lifecyclePolicy struct {
+	Rules []lifecycleRule `json:"rules"`
+}
+
+type lifecycleRule struct {
+	RulePriority int                `json:"rulePriority"`
+	Description  string             `json:"description"`
+	Selection    lifecycleSelection `json:"selection"`
+	Action       lifecycleAction    `json:"action"`
+}
+
+type lifecycleSelection struct {
+	TagStatus   string `json:"tagStatus"`
+	CountType   string `json:"countType"`
+	CountUnit   string `json:"countUnit,omitempty"`
+	CountNumber int    `json:"countNumber"`
+}
+
+type lifecycleAction struct {
+	Type string `json:"type"`
+}
+
+// LifecyclePolicy represents which images expire from a repository.
+// Zero values mean that the corresponding images never expire.
+type LifecyclePolicy struct {
+	KeepLast                int // Expire all images but the most recently pushed ones.
+	ExpireUntaggedAfterDays int // Expire untagged images after they've been in the repository for a number of days.
+}
+
+// SetLifecyclePolicy sets a lifecycle policy on the repository that expires images according to the policy.
+func (c ECR) SetLifecyclePolicy(repoName string, policy LifecyclePolicy) error {
+	var rules []lifecycleRule
+	if policy.ExpireUntaggedAfterDays > 0 {
+		rules = append(rules, lifecycleRule{
+			Description: fmt.Sprintf("Expire untagged images after %d days", policy.ExpireUntaggedAfterDays),
+			Selection: lifecycleSelection{
+				TagStatus:   "untagged",
+				CountType:   "sinceImagePushed",
+				CountUnit:   "days",
+				CountNumber: policy.ExpireUntaggedAfterDays,
+			},
+		})
+	}
+	if policy.KeepLast > 0 {
+		// ECR requires the rule that selects "any" images to be evaluated last.
+		rules = append(rules, lifecycleRule{
+			Description: fmt.Sprintf("Keep the last %d images", policy.KeepLast),
+			Selection: lifecycleSelection{
+				TagStatus:   "any",
+				CountType:   "imageCountMoreThan",
+				CountNumber: policy.KeepLast,
+			},
+		})
+	}
+	for i := range rules {
+		rules[i].RulePriority = i + 1
+		rules[i].Action = lifecycleAction{
+			Type: "expire",
+		}
+	}
+	text, err := json.Marshal(lifecyclePolicy{
+		Rules: rules,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal lifecycle policy: %w", err)
+	}
+	if _, err := c.client.PutLifecyclePolicy(&ecr.PutLifecyclePolicyInput{
+		RepositoryName:      aws.String(repoName),
+		LifecyclePolicyText: aws.String(string(text)),
+	}); err != nil {
+		return fmt.Errorf("put lifecycle policy on ecr repo %s: %w", repoName, err)
+	}
+	return nil
+}
+
+// DeleteLifecyclePolicy deletes the lifecycle policy of the repository so that its images never expire.
+// It's a no-op if the repository has no lifecycle policy.
+func (c ECR) DeleteLifecyclePolicy(repoName string) error {
+	_, err := c.client.DeleteLifecyclePolicy(&ecr.DeleteLifecyclePolicyInput{
+		RepositoryName: aws.String(repoName),
+	})
+	if err == nil || isLifecyclePolicyNotFoundErr(err) {
+		return nil
+	}
+	return fmt.Errorf("delete lifecycle policy of ecr repo %s: %w", repoName, err)
+}
+
 // ClearRepository orchestrates a ListImages call followed by a DeleteImages
 // call to delete all images from the input ECR repository name.
 func (c ECR) ClearRepository(repoName string) error {
@@ -188,6 +312,11 @@ func URIFromARN(repositoryARN string) (string, error) {
 		repoName), nil
 }
 
+func isLifecyclePolicyNotFoundErr(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == ecr.ErrCodeLifecyclePolicyNotFoundException
+}
+
 func isRepoNotFoundErr(err error) bool {
 	aerr, ok := err.(awserr.Error)
 	if !ok {
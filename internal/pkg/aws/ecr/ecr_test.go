@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -211,6 +212,26 @@ func TestListImages(t *testing.T) {
 			wantImages: []Image{Image{Digest: mockDigest}},
 			wantError:  nil,
 		},
+		"should return the tags and push time of images": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(&ecr.DescribeImagesOutput{
+					ImageDetails: []*ecr.ImageDetail{
+						{
+							ImageDigest:   aws.String(mockDigest),
+							ImageTags:     aws.StringSlice([]string{"latest", "v1"}),
+							ImagePushedAt: aws.Time(time.Unix(1600000000, 0)),
+						},
+					},
+				}, nil)
+			},
+			wantImages: []Image{
+				{
+					Digest:   mockDigest,
+					Tags:     []string{"latest", "v1"},
+					PushedAt: time.Unix(1600000000, 0),
+				},
+			},
+		},
 		"should return all images when paginated": {
 			mockECRClient: func(m *mocks.Mockapi) {
 				m.EXPECT().DescribeImages(&ecr.DescribeImagesInput{
@@ -260,6 +281,83 @@ func TestListImages(t *testing.T) {
 	}
 }
 
+func TestLastPushedDigest(t *testing.T) {
+	mockRepoName := "mockRepoName"
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantDigest string
+		wantError  error
+	}{
+		"should wrap error returned by ECR DescribeImages": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("ecr repo %s describe images: %w", mockRepoName, mockError),
+		},
+		"should return an error if there are no tagged images": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(&ecr.DescribeImagesOutput{
+					ImageDetails: []*ecr.ImageDetail{
+						{
+							ImageDigest:   aws.String("sha256:index"),
+							ImagePushedAt: aws.Time(time.Unix(1600000000, 0)),
+						},
+					},
+				}, nil)
+			},
+			wantError: fmt.Errorf("no tagged images found in ecr repo %s", mockRepoName),
+		},
+		"should return the digest of the most recently pushed tagged image": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(&ecr.DescribeImagesOutput{
+					ImageDetails: []*ecr.ImageDetail{
+						{
+							ImageDigest:   aws.String("sha256:old"),
+							ImageTags:     aws.StringSlice([]string{"v1"}),
+							ImagePushedAt: aws.Time(time.Unix(1600000000, 0)),
+						},
+						{
+							ImageDigest:   aws.String("sha256:new"),
+							ImageTags:     aws.StringSlice([]string{"latest", "v2"}),
+							ImagePushedAt: aws.Time(time.Unix(1600000100, 0)),
+						},
+						{
+							ImageDigest:   aws.String("sha256:index"),
+							ImagePushedAt: aws.Time(time.Unix(1600000200, 0)),
+						},
+					},
+				}, nil)
+			},
+			wantDigest: "sha256:new",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			// WHEN
+			gotDigest, gotError := client.LastPushedDigest(mockRepoName)
+
+			// THEN
+			require.Equal(t, tc.wantError, gotError)
+			require.Equal(t, tc.wantDigest, gotDigest)
+		})
+	}
+}
+
 func TestDeleteImages(t *testing.T) {
 	mockRepoName := "mockRepoName"
 	mockError := errors.New("mockError")
@@ -446,3 +544,131 @@ func TestClearRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestSetLifecyclePolicy(t *testing.T) {
+	mockRepoName := "mockRepoName"
+	mockError := errors.New("some error")
+
+	tests := map[string]struct {
+		inPolicy      LifecyclePolicy
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantError error
+	}{
+		"should wrap error returned by ECR PutLifecyclePolicy": {
+			inPolicy: LifecyclePolicy{
+				KeepLast: 20,
+			},
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().PutLifecyclePolicy(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("put lifecycle policy on ecr repo mockRepoName: %w", mockError),
+		},
+		"should expire all images but the most recent ones": {
+			inPolicy: LifecyclePolicy{
+				KeepLast: 20,
+			},
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().PutLifecyclePolicy(&ecr.PutLifecyclePolicyInput{
+					RepositoryName:      aws.String(mockRepoName),
+					LifecyclePolicyText: aws.String(`{"rules":[{"rulePriority":1,"description":"Keep the last 20 images","selection":{"tagStatus":"any","countType":"imageCountMoreThan","countNumber":20},"action":{"type":"expire"}}]}`),
+				}).Return(&ecr.PutLifecyclePolicyOutput{}, nil)
+			},
+		},
+		"should expire untagged images after a number of days": {
+			inPolicy: LifecyclePolicy{
+				ExpireUntaggedAfterDays: 7,
+			},
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().PutLifecyclePolicy(&ecr.PutLifecyclePolicyInput{
+					RepositoryName:      aws.String(mockRepoName),
+					LifecyclePolicyText: aws.String(`{"rules":[{"rulePriority":1,"description":"Expire untagged images after 7 days","selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":7},"action":{"type":"expire"}}]}`),
+				}).Return(&ecr.PutLifecyclePolicyOutput{}, nil)
+			},
+		},
+		"should evaluate the rule on any images last": {
+			inPolicy: LifecyclePolicy{
+				KeepLast:                20,
+				ExpireUntaggedAfterDays: 7,
+			},
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().PutLifecyclePolicy(&ecr.PutLifecyclePolicyInput{
+					RepositoryName:      aws.String(mockRepoName),
+					LifecyclePolicyText: aws.String(`{"rules":[{"rulePriority":1,"description":"Expire untagged images after 7 days","selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":7},"action":{"type":"expire"}},{"rulePriority":2,"description":"Keep the last 20 images","selection":{"tagStatus":"any","countType":"imageCountMoreThan","countNumber":20},"action":{"type":"expire"}}]}`),
+				}).Return(&ecr.PutLifecyclePolicyOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			// WHEN
+			gotError := client.SetLifecyclePolicy(mockRepoName, tc.inPolicy)
+
+			// THEN
+			require.Equal(t, tc.wantError, gotError)
+		})
+	}
+}
+
+func TestDeleteLifecyclePolicy(t *testing.T) {
+	mockRepoName := "mockRepoName"
+	mockError := errors.New("some error")
+
+	tests := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantError error
+	}{
+		"should wrap error returned by ECR DeleteLifecyclePolicy": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteLifecyclePolicy(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("delete lifecycle policy of ecr repo mockRepoName: %w", mockError),
+		},
+		"should ignore a repository without lifecycle policy": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteLifecyclePolicy(gomock.Any()).Return(nil, awserr.New(ecr.ErrCodeLifecyclePolicyNotFoundException, "no policy", nil))
+			},
+		},
+		"should delete the lifecycle policy": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteLifecyclePolicy(&ecr.DeleteLifecyclePolicyInput{
+					RepositoryName: aws.String(mockRepoName),
+				}).Return(&ecr.DeleteLifecyclePolicyOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			// WHEN
+			gotError := client.DeleteLifecyclePolicy(mockRepoName)
+
+			// THEN
+			require.Equal(t, tc.wantError, gotError)
+		})
+	}
+}
@@ -17,7 +17,12 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 )
 
-const clusterStatusActive = "ACTIVE"
+const (
+	clusterStatusActive       = "ACTIVE"
+	containerInsightsEnabled  = "enabled"
+	containerInsightsEnhanced = "enhanced"
+	primaryDeploymentStatus   = "PRIMARY"
+)
 
 type api interface {
 	DescribeClusters(input *ecs.DescribeClustersInput) (*ecs.DescribeClustersOutput, error)
@@ -104,6 +109,11 @@ func (e *ECS) ServiceTasks(cluster, service string) ([]*Task, error) {
 	return e.listTasks(cluster, withService(service))
 }
 
+// StoppedServiceTasks calls ECS API and returns the ECS tasks of a service that were recently stopped.
+func (e *ECS) StoppedServiceTasks(cluster, service string) ([]*Task, error) {
+	return e.listTasks(cluster, withService(service), withStoppedTasks())
+}
+
 // RunningTasksInFamily calls ECS API and returns ECS tasks with the desired status to be RUNNING
 // within the same task definition family.
 func (e *ECS) RunningTasksInFamily(cluster, family string) ([]*Task, error) {
@@ -134,6 +144,12 @@ func withRunningTasks() listTasksOpts {
 	}
 }
 
+func withStoppedTasks() listTasksOpts {
+	return func(in *ecs.ListTasksInput) {
+		in.DesiredStatus = aws.String(ecs.DesiredStatusStopped)
+	}
+}
+
 func (e *ECS) listTasks(cluster string, opts ...listTasksOpts) ([]*Task, error) {
 	var tasks []*Task
 	in := &ecs.ListTasksInput{
@@ -233,6 +249,30 @@ func (e *ECS) HasDefaultCluster() (bool, error) {
 	return true, nil
 }
 
+// ContainerInsightsEnabled returns true if Container Insights, with or without enhanced observability, is enabled on the cluster.
+func (e *ECS) ContainerInsightsEnabled(cluster string) (bool, error) {
+	resp, err := e.client.DescribeClusters(&ecs.DescribeClustersInput{
+		Clusters: aws.StringSlice([]string{cluster}),
+		Include:  aws.StringSlice([]string{ecs.ClusterFieldSettings}),
+	})
+	if err != nil {
+		return false, fmt.Errorf("describe cluster %s: %w", cluster, err)
+	}
+	if len(resp.Clusters) == 0 {
+		return false, fmt.Errorf("cannot find cluster %s", cluster)
+	}
+	for _, setting := range resp.Clusters[0].Settings {
+		if aws.StringValue(setting.Name) == ecs.ClusterSettingNameContainerInsights {
+			switch aws.StringValue(setting.Value) {
+			case containerInsightsEnabled, containerInsightsEnhanced:
+				return true, nil
+			}
+			return false, nil
+		}
+	}
+	return false, nil
+}
+
 // RunTask runs a number of tasks with the task definition and network configurations in a cluster, and returns after
 // the task(s) is running or fails to run, along with task ARNs if possible.
 func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
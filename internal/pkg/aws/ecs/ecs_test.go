@@ -333,6 +333,81 @@ func TestECS_Tasks(t *testing.T) {
 	}
 }
 
+func TestECS_StoppedServiceTasks(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr   error
+		wantTasks []*Task
+	}{
+		"errors if failed to list stopped tasks": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					ServiceName:   aws.String("mockService"),
+					DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("list running tasks: some error"),
+		},
+		"success": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					ServiceName:   aws.String("mockService"),
+					DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+				}).Return(&ecs.ListTasksOutput{
+					TaskArns: aws.StringSlice([]string{"mockTaskArn"}),
+				}, nil)
+				m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+					Cluster: aws.String("mockCluster"),
+					Tasks:   aws.StringSlice([]string{"mockTaskArn"}),
+					Include: aws.StringSlice([]string{ecs.TaskFieldTags}),
+				}).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn:       aws.String("mockTaskArn"),
+							StoppedReason: aws.String("Essential container in task exited"),
+						},
+					},
+				}, nil)
+			},
+			wantTasks: []*Task{
+				{
+					TaskArn:       aws.String("mockTaskArn"),
+					StoppedReason: aws.String("Essential container in task exited"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			gotTasks, gotErr := service.StoppedServiceTasks("mockCluster", "mockService")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantTasks, gotTasks)
+			}
+		})
+	}
+}
+
 func TestECS_StopTasks(t *testing.T) {
 	mockTasks := []string{"mockTask1", "mockTask2"}
 	mockError := errors.New("some error")
@@ -545,6 +620,110 @@ func TestECS_HasDefaultCluster(t *testing.T) {
 	}
 }
 
+func TestECS_ContainerInsightsEnabled(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedEnabled bool
+		wantedErr     error
+	}{
+		"error describing the cluster": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeClusters(&ecs.DescribeClustersInput{
+					Clusters: aws.StringSlice([]string{"mockCluster"}),
+					Include:  aws.StringSlice([]string{"SETTINGS"}),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("describe cluster mockCluster: some error"),
+		},
+		"error if the cluster does not exist": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeClusters(gomock.Any()).Return(&ecs.DescribeClustersOutput{}, nil)
+			},
+			wantedErr: fmt.Errorf("cannot find cluster mockCluster"),
+		},
+		"disabled if the setting is missing": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeClusters(gomock.Any()).Return(&ecs.DescribeClustersOutput{
+					Clusters: []*ecs.Cluster{{}},
+				}, nil)
+			},
+		},
+		"disabled": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeClusters(gomock.Any()).Return(&ecs.DescribeClustersOutput{
+					Clusters: []*ecs.Cluster{
+						{
+							Settings: []*ecs.ClusterSetting{
+								{
+									Name:  aws.String("containerInsights"),
+									Value: aws.String("disabled"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+		},
+		"enabled": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeClusters(gomock.Any()).Return(&ecs.DescribeClustersOutput{
+					Clusters: []*ecs.Cluster{
+						{
+							Settings: []*ecs.ClusterSetting{
+								{
+									Name:  aws.String("containerInsights"),
+									Value: aws.String("enabled"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedEnabled: true,
+		},
+		"enabled with enhanced observability": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeClusters(gomock.Any()).Return(&ecs.DescribeClustersOutput{
+					Clusters: []*ecs.Cluster{
+						{
+							Settings: []*ecs.ClusterSetting{
+								{
+									Name:  aws.String("containerInsights"),
+									Value: aws.String("enhanced"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedEnabled: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			ecs := ECS{
+				client: mockECSClient,
+			}
+
+			enabled, err := ecs.ContainerInsightsEnabled("mockCluster")
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedEnabled, enabled)
+			}
+		})
+	}
+}
+
 func TestECS_RunTask(t *testing.T) {
 	type input struct {
 		cluster        string
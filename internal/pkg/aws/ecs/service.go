@@ -37,6 +37,26 @@ func (s *Service) ServiceStatus() ServiceStatus {
 	}
 }
 
+// Deployment contains the info of a deployment of a service.
+type Deployment struct {
+	TaskDefinition string    `json:"taskDefinition"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+// PrimaryDeployment returns the most recent deployment of the service.
+func (s *Service) PrimaryDeployment() (*Deployment, error) {
+	for _, dep := range s.Deployments {
+		if aws.StringValue(dep.Status) != primaryDeploymentStatus {
+			continue
+		}
+		return &Deployment{
+			TaskDefinition: aws.StringValue(dep.TaskDefinition),
+			CreatedAt:      aws.TimeValue(dep.CreatedAt),
+		}, nil
+	}
+	return nil, fmt.Errorf("no primary deployment found for service %s", aws.StringValue(s.ServiceName))
+}
+
 // ServiceArn is the arn of an ECS service.
 type ServiceArn string
 
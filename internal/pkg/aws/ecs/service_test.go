@@ -0,0 +1,66 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_PrimaryDeployment(t *testing.T) {
+	createdAt := time.Date(2021, time.March, 1, 10, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inService *Service
+
+		wanted      *Deployment
+		wantedError string
+	}{
+		"returns the primary deployment": {
+			inService: &Service{
+				ServiceName: aws.String("mockService"),
+				Deployments: []*ecs.Deployment{
+					{
+						Status:         aws.String("ACTIVE"),
+						TaskDefinition: aws.String("mockTaskDef:1"),
+						CreatedAt:      aws.Time(createdAt.Add(-time.Hour)),
+					},
+					{
+						Status:         aws.String("PRIMARY"),
+						TaskDefinition: aws.String("mockTaskDef:2"),
+						CreatedAt:      aws.Time(createdAt),
+					},
+				},
+			},
+			wanted: &Deployment{
+				TaskDefinition: "mockTaskDef:2",
+				CreatedAt:      createdAt,
+			},
+		},
+		"returns an error if there is no primary deployment": {
+			inService: &Service{
+				ServiceName: aws.String("mockService"),
+			},
+			wantedError: "no primary deployment found for service mockService",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := tc.inService.PrimaryDeployment()
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
@@ -117,6 +117,30 @@ func (t *Task) ENI() (string, error) {
 	}
 }
 
+// SSMTarget returns the Systems Manager target of a container of the running task, which has the format
+// "ecs:<cluster name>_<task ID>_<container runtime ID>".
+func (t *Task) SSMTarget(containerName string) (string, error) {
+	clusterARN, err := arn.Parse(aws.StringValue(t.ClusterArn))
+	if err != nil {
+		return "", fmt.Errorf("parse ECS cluster ARN: %w", err)
+	}
+	cluster := strings.TrimPrefix(clusterARN.Resource, "cluster/")
+	taskID, err := TaskID(aws.StringValue(t.TaskArn))
+	if err != nil {
+		return "", err
+	}
+	for _, container := range t.Containers {
+		if aws.StringValue(container.Name) != containerName {
+			continue
+		}
+		if container.RuntimeId == nil {
+			return "", fmt.Errorf("container %s of task %s does not have a runtime ID", containerName, taskID)
+		}
+		return fmt.Sprintf("ecs:%s_%s_%s", cluster, taskID, aws.StringValue(container.RuntimeId)), nil
+	}
+	return "", fmt.Errorf("container %s not found in task %s", containerName, taskID)
+}
+
 // TaskStatus contains the status info of a task.
 type TaskStatus struct {
 	Health           string    `json:"health"`
@@ -225,6 +225,62 @@ func TestTask_ENI(t *testing.T) {
 	}
 }
 
+func TestTask_SSMTarget(t *testing.T) {
+	testCases := map[string]struct {
+		containers   []*ecs.Container
+		wantedTarget string
+		wantedErr    error
+	}{
+		"container not found": {
+			containers: []*ecs.Container{
+				{
+					Name: aws.String("sidecar"),
+				},
+			},
+			wantedErr: errors.New("container frontend not found in task 4082490ee6c245e09d2145010aa1ba8d"),
+		},
+		"container without a runtime ID": {
+			containers: []*ecs.Container{
+				{
+					Name: aws.String("frontend"),
+				},
+			},
+			wantedErr: errors.New("container frontend of task 4082490ee6c245e09d2145010aa1ba8d does not have a runtime ID"),
+		},
+		"success": {
+			containers: []*ecs.Container{
+				{
+					Name:      aws.String("sidecar"),
+					RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-1111111111"),
+				},
+				{
+					Name:      aws.String("frontend"),
+					RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-2222222222"),
+				},
+			},
+			wantedTarget: "ecs:my-project-test-Cluster-9F7Y0RLP60R7_4082490ee6c245e09d2145010aa1ba8d_4082490ee6c245e09d2145010aa1ba8d-2222222222",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			task := Task{
+				ClusterArn: aws.String("arn:aws:ecs:us-west-2:123456789:cluster/my-project-test-Cluster-9F7Y0RLP60R7"),
+				TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d"),
+				Containers: tc.containers,
+			}
+
+			out, err := task.SSMTarget("frontend")
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTarget, out)
+			}
+		})
+	}
+}
+
 func TestTaskStatus_HumanString(t *testing.T) {
 	// from the function changes (ex: from "1 month ago" to "2 months ago"). To make our tests stable,
 	oldHumanize := humanizeTime
@@ -0,0 +1,389 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package elbv2 provides a client to make API requests to Amazon Elastic Load Balancing.
+package elbv2
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+)
+
+type api interface {
+	AddTags(input *elbv2.AddTagsInput) (*elbv2.AddTagsOutput, error)
+	DescribeRules(input *elbv2.DescribeRulesInput) (*elbv2.DescribeRulesOutput, error)
+	DescribeTargetGroups(input *elbv2.DescribeTargetGroupsInput) (*elbv2.DescribeTargetGroupsOutput, error)
+	DescribeTargetHealth(input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error)
+	DescribeTags(input *elbv2.DescribeTagsInput) (*elbv2.DescribeTagsOutput, error)
+	ModifyRule(input *elbv2.ModifyRuleInput) (*elbv2.ModifyRuleOutput, error)
+	RemoveTags(input *elbv2.RemoveTagsInput) (*elbv2.RemoveTagsOutput, error)
+}
+
+// Maximum number of resources whose tags can be described in a single DescribeTags call.
+const describeTagsMaxResources = 20
+
+// TargetHealth represents the health of a target registered with a target group.
+type TargetHealth struct {
+	TargetID    string `json:"targetID"`
+	Port        int64  `json:"port"`
+	State       string `json:"state"`
+	Reason      string `json:"reason,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// TargetGroup represents the routing and health check configuration of a target group.
+type TargetGroup struct {
+	ARN             string
+	Port            int64
+	Protocol        string
+	HealthCheckPath string
+	SuccessCodes    string // HTTP codes that healthy targets respond with, such as "200" or "200-299".
+}
+
+// ListenerRule represents a rule attached to a listener.
+type ListenerRule struct {
+	ARN             string
+	Priority        string
+	IsDefault       bool
+	HostHeaders     []string
+	PathPatterns    []string
+	SourceIPs       []string
+	TargetGroupARNs []string
+	// Set if the rule forwards requests with a ForwardConfig, which splits them across target groups by weight.
+	WeightedTargetGroups []WeightedTargetGroup
+	FixedResponse        *FixedResponse // Set if the rule responds to requests itself instead of forwarding them.
+}
+
+// WeightedTargetGroup represents a target group of a ForwardConfig and the share of requests it receives.
+type WeightedTargetGroup struct {
+	ARN    string
+	Weight int64
+}
+
+// FixedResponse represents the response a rule returns without forwarding requests.
+type FixedResponse struct {
+	StatusCode  string
+	ContentType string
+	Body        string
+}
+
+// ELBV2 wraps an AWS ELBV2 client.
+type ELBV2 struct {
+	client api
+}
+
+// New returns a ELBV2 configured against the input session.
+func New(s *session.Session) *ELBV2 {
+	return &ELBV2{
+		client: elbv2.New(s),
+	}
+}
+
+// TargetGroup returns the target group with the given ARN.
+func (e *ELBV2) TargetGroup(targetGroupARN string) (*TargetGroup, error) {
+	out, err := e.client.DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{
+		TargetGroupArns: aws.StringSlice([]string{targetGroupARN}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe target group %s: %w", targetGroupARN, err)
+	}
+	if len(out.TargetGroups) == 0 {
+		return nil, fmt.Errorf("cannot find target group %s", targetGroupARN)
+	}
+	tg := out.TargetGroups[0]
+	group := &TargetGroup{
+		ARN:             aws.StringValue(tg.TargetGroupArn),
+		Port:            aws.Int64Value(tg.Port),
+		Protocol:        aws.StringValue(tg.Protocol),
+		HealthCheckPath: aws.StringValue(tg.HealthCheckPath),
+	}
+	if tg.Matcher != nil {
+		group.SuccessCodes = aws.StringValue(tg.Matcher.HttpCode)
+	}
+	return group, nil
+}
+
+// TargetsHealth returns the health of the targets registered with a target group.
+func (e *ELBV2) TargetsHealth(targetGroupARN string) ([]*TargetHealth, error) {
+	out, err := e.client.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(targetGroupARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe target health for target group %s: %w", targetGroupARN, err)
+	}
+	var targets []*TargetHealth
+	for _, desc := range out.TargetHealthDescriptions {
+		target := &TargetHealth{}
+		if desc.Target != nil {
+			target.TargetID = aws.StringValue(desc.Target.Id)
+			target.Port = aws.Int64Value(desc.Target.Port)
+		}
+		if desc.TargetHealth != nil {
+			target.State = aws.StringValue(desc.TargetHealth.State)
+			target.Reason = aws.StringValue(desc.TargetHealth.Reason)
+			target.Description = aws.StringValue(desc.TargetHealth.Description)
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// ListenerRuleCount returns the number of rules, excluding the default rule, attached to a listener.
+func (e *ELBV2) ListenerRuleCount(listenerARN string) (int, error) {
+	var count int
+	in := &elbv2.DescribeRulesInput{
+		ListenerArn: aws.String(listenerARN),
+	}
+	for {
+		out, err := e.client.DescribeRules(in)
+		if err != nil {
+			return 0, fmt.Errorf("describe rules for listener %s: %w", listenerARN, err)
+		}
+		for _, rule := range out.Rules {
+			if aws.BoolValue(rule.IsDefault) {
+				continue
+			}
+			count++
+		}
+		if out.NextMarker == nil {
+			return count, nil
+		}
+		in.Marker = out.NextMarker
+	}
+}
+
+// ListenerRules returns the rules, including the default rule, attached to a listener.
+func (e *ELBV2) ListenerRules(listenerARN string) ([]*ListenerRule, error) {
+	var rules []*ListenerRule
+	in := &elbv2.DescribeRulesInput{
+		ListenerArn: aws.String(listenerARN),
+	}
+	for {
+		out, err := e.client.DescribeRules(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe rules for listener %s: %w", listenerARN, err)
+		}
+		for _, rule := range out.Rules {
+			rules = append(rules, newListenerRule(rule))
+		}
+		if out.NextMarker == nil {
+			return rules, nil
+		}
+		in.Marker = out.NextMarker
+	}
+}
+
+// Rules returns the listener rules with the given ARNs.
+func (e *ELBV2) Rules(ruleARNs []string) ([]*ListenerRule, error) {
+	out, err := e.client.DescribeRules(&elbv2.DescribeRulesInput{
+		RuleArns: aws.StringSlice(ruleARNs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe rules %s: %w", strings.Join(ruleARNs, ", "), err)
+	}
+	var rules []*ListenerRule
+	for _, rule := range out.Rules {
+		rules = append(rules, newListenerRule(rule))
+	}
+	return rules, nil
+}
+
+// RespondWith replaces the actions of a listener rule with a fixed response.
+func (e *ELBV2) RespondWith(ruleARN string, resp FixedResponse) error {
+	_, err := e.client.ModifyRule(&elbv2.ModifyRuleInput{
+		RuleArn: aws.String(ruleARN),
+		Actions: []*elbv2.Action{
+			{
+				Type: aws.String(elbv2.ActionTypeEnumFixedResponse),
+				FixedResponseConfig: &elbv2.FixedResponseActionConfig{
+					StatusCode:  aws.String(resp.StatusCode),
+					ContentType: aws.String(resp.ContentType),
+					MessageBody: aws.String(resp.Body),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("modify rule %s to respond with status %s: %w", ruleARN, resp.StatusCode, err)
+	}
+	return nil
+}
+
+// ForwardTo replaces the actions of a listener rule to forward requests to a target group.
+func (e *ELBV2) ForwardTo(ruleARN, targetGroupARN string) error {
+	_, err := e.client.ModifyRule(&elbv2.ModifyRuleInput{
+		RuleArn: aws.String(ruleARN),
+		Actions: []*elbv2.Action{
+			{
+				Type:           aws.String(elbv2.ActionTypeEnumForward),
+				TargetGroupArn: aws.String(targetGroupARN),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("modify rule %s to forward to target group %s: %w", ruleARN, targetGroupARN, err)
+	}
+	return nil
+}
+
+// ForwardToWeighted replaces the actions of a listener rule to forward requests across target groups by weight.
+func (e *ELBV2) ForwardToWeighted(ruleARN string, targetGroups []WeightedTargetGroup) error {
+	var tgs []*elbv2.TargetGroupTuple
+	for _, tg := range targetGroups {
+		tgs = append(tgs, &elbv2.TargetGroupTuple{
+			TargetGroupArn: aws.String(tg.ARN),
+			Weight:         aws.Int64(tg.Weight),
+		})
+	}
+	_, err := e.client.ModifyRule(&elbv2.ModifyRuleInput{
+		RuleArn: aws.String(ruleARN),
+		Actions: []*elbv2.Action{
+			{
+				Type: aws.String(elbv2.ActionTypeEnumForward),
+				ForwardConfig: &elbv2.ForwardActionConfig{
+					TargetGroups: tgs,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("modify rule %s to forward to weighted target groups: %w", ruleARN, err)
+	}
+	return nil
+}
+
+// RuleTags returns the tags of a listener rule.
+func (e *ELBV2) RuleTags(ruleARN string) (map[string]string, error) {
+	out, err := e.client.DescribeTags(&elbv2.DescribeTagsInput{
+		ResourceArns: aws.StringSlice([]string{ruleARN}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe tags for rule %s: %w", ruleARN, err)
+	}
+	tags := make(map[string]string)
+	for _, desc := range out.TagDescriptions {
+		for _, tag := range desc.Tags {
+			tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+	}
+	return tags, nil
+}
+
+// TagRule adds the tags to a listener rule, overwriting the values of existing keys.
+func (e *ELBV2) TagRule(ruleARN string, tags map[string]string) error {
+	var elbTags []*elbv2.Tag
+	for _, key := range sortedKeys(tags) {
+		elbTags = append(elbTags, &elbv2.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(tags[key]),
+		})
+	}
+	_, err := e.client.AddTags(&elbv2.AddTagsInput{
+		ResourceArns: aws.StringSlice([]string{ruleARN}),
+		Tags:         elbTags,
+	})
+	if err != nil {
+		return fmt.Errorf("add tags to rule %s: %w", ruleARN, err)
+	}
+	return nil
+}
+
+// UntagRule removes the tags with the keys from a listener rule.
+func (e *ELBV2) UntagRule(ruleARN string, keys []string) error {
+	_, err := e.client.RemoveTags(&elbv2.RemoveTagsInput{
+		ResourceArns: aws.StringSlice([]string{ruleARN}),
+		TagKeys:      aws.StringSlice(keys),
+	})
+	if err != nil {
+		return fmt.Errorf("remove tags from rule %s: %w", ruleARN, err)
+	}
+	return nil
+}
+
+// TargetGroupsTags returns the tags of each target group keyed by the target group ARN.
+func (e *ELBV2) TargetGroupsTags(targetGroupARNs []string) (map[string]map[string]string, error) {
+	tags := make(map[string]map[string]string)
+	for start := 0; start < len(targetGroupARNs); start += describeTagsMaxResources {
+		end := start + describeTagsMaxResources
+		if end > len(targetGroupARNs) {
+			end = len(targetGroupARNs)
+		}
+		out, err := e.client.DescribeTags(&elbv2.DescribeTagsInput{
+			ResourceArns: aws.StringSlice(targetGroupARNs[start:end]),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe tags for target groups: %w", err)
+		}
+		for _, desc := range out.TagDescriptions {
+			resourceTags := make(map[string]string)
+			for _, tag := range desc.Tags {
+				resourceTags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+			tags[aws.StringValue(desc.ResourceArn)] = resourceTags
+		}
+	}
+	return tags, nil
+}
+
+func newListenerRule(rule *elbv2.Rule) *ListenerRule {
+	out := &ListenerRule{
+		ARN:       aws.StringValue(rule.RuleArn),
+		Priority:  aws.StringValue(rule.Priority),
+		IsDefault: aws.BoolValue(rule.IsDefault),
+	}
+	for _, cond := range rule.Conditions {
+		switch aws.StringValue(cond.Field) {
+		case "host-header":
+			if cond.HostHeaderConfig != nil {
+				out.HostHeaders = append(out.HostHeaders, aws.StringValueSlice(cond.HostHeaderConfig.Values)...)
+			}
+		case "path-pattern":
+			if cond.PathPatternConfig != nil {
+				out.PathPatterns = append(out.PathPatterns, aws.StringValueSlice(cond.PathPatternConfig.Values)...)
+			}
+		case "source-ip":
+			if cond.SourceIpConfig != nil {
+				out.SourceIPs = append(out.SourceIPs, aws.StringValueSlice(cond.SourceIpConfig.Values)...)
+			}
+		}
+	}
+	for _, action := range rule.Actions {
+		if conf := action.FixedResponseConfig; conf != nil {
+			out.FixedResponse = &FixedResponse{
+				StatusCode:  aws.StringValue(conf.StatusCode),
+				ContentType: aws.StringValue(conf.ContentType),
+				Body:        aws.StringValue(conf.MessageBody),
+			}
+			continue
+		}
+		if action.TargetGroupArn != nil {
+			out.TargetGroupARNs = append(out.TargetGroupARNs, aws.StringValue(action.TargetGroupArn))
+			continue
+		}
+		if action.ForwardConfig == nil {
+			continue
+		}
+		for _, tg := range action.ForwardConfig.TargetGroups {
+			out.TargetGroupARNs = append(out.TargetGroupARNs, aws.StringValue(tg.TargetGroupArn))
+			out.WeightedTargetGroups = append(out.WeightedTargetGroups, WeightedTargetGroup{
+				ARN:    aws.StringValue(tg.TargetGroupArn),
+				Weight: aws.Int64Value(tg.Weight),
+			})
+		}
+	}
+	return out
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
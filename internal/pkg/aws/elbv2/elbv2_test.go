@@ -0,0 +1,917 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package elbv2
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestELBV2_ListenerRuleCount(t *testing.T) {
+	mockListenerARN := "mockListenerARN"
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		wantedCount int
+		wantedErr   error
+	}{
+		"fail to describe rules": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String(mockListenerARN),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe rules for listener mockListenerARN: some error"),
+		},
+		"counts rules excluding the default rule": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String(mockListenerARN),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{IsDefault: aws.Bool(false)},
+						{IsDefault: aws.Bool(false)},
+						{IsDefault: aws.Bool(true)},
+					},
+				}, nil)
+			},
+			wantedCount: 2,
+		},
+		"counts rules across pages": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String(mockListenerARN),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{IsDefault: aws.Bool(false)},
+					},
+					NextMarker: aws.String("mockMarker"),
+				}, nil)
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String(mockListenerARN),
+					Marker:      aws.String("mockMarker"),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{IsDefault: aws.Bool(false)},
+						{IsDefault: aws.Bool(true)},
+					},
+				}, nil)
+			},
+			wantedCount: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			got, err := elbv2Client.ListenerRuleCount(mockListenerARN)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedCount, got)
+			}
+		})
+	}
+}
+
+func TestELBV2_TargetGroup(t *testing.T) {
+	mockTargetGroupARN := "mockTargetGroupARN"
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		wantedTargetGroup *TargetGroup
+		wantedErr         error
+	}{
+		"fail to describe target group": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{
+					TargetGroupArns: aws.StringSlice([]string{mockTargetGroupARN}),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe target group mockTargetGroupARN: some error"),
+		},
+		"error if the target group doesn't exist": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTargetGroups(gomock.Any()).Return(&elbv2.DescribeTargetGroupsOutput{}, nil)
+			},
+			wantedErr: errors.New("cannot find target group mockTargetGroupARN"),
+		},
+		"returns the routing and health check configuration": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTargetGroups(gomock.Any()).Return(&elbv2.DescribeTargetGroupsOutput{
+					TargetGroups: []*elbv2.TargetGroup{
+						{
+							TargetGroupArn:  aws.String(mockTargetGroupARN),
+							Port:            aws.Int64(8080),
+							Protocol:        aws.String("HTTP"),
+							HealthCheckPath: aws.String("/healthz"),
+							Matcher: &elbv2.Matcher{
+								HttpCode: aws.String("200-299"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedTargetGroup: &TargetGroup{
+				ARN:             mockTargetGroupARN,
+				Port:            8080,
+				Protocol:        "HTTP",
+				HealthCheckPath: "/healthz",
+				SuccessCodes:    "200-299",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			got, err := elbv2Client.TargetGroup(mockTargetGroupARN)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTargetGroup, got)
+			}
+		})
+	}
+}
+
+func TestELBV2_TargetsHealth(t *testing.T) {
+	mockTargetGroupARN := "mockTargetGroupARN"
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		wantedTargets []*TargetHealth
+		wantedErr     error
+	}{
+		"fail to describe target health": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+					TargetGroupArn: aws.String(mockTargetGroupARN),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe target health for target group mockTargetGroupARN: some error"),
+		},
+		"returns the health of each target": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+					TargetGroupArn: aws.String(mockTargetGroupARN),
+				}).Return(&elbv2.DescribeTargetHealthOutput{
+					TargetHealthDescriptions: []*elbv2.TargetHealthDescription{
+						{
+							Target: &elbv2.TargetDescription{
+								Id:   aws.String("10.0.0.12"),
+								Port: aws.Int64(80),
+							},
+							TargetHealth: &elbv2.TargetHealth{
+								State:       aws.String("unhealthy"),
+								Reason:      aws.String("Target.ResponseCodeMismatch"),
+								Description: aws.String("Health checks failed with these codes: [404]"),
+							},
+						},
+						{
+							Target: &elbv2.TargetDescription{
+								Id:   aws.String("10.0.0.13"),
+								Port: aws.Int64(80),
+							},
+							TargetHealth: &elbv2.TargetHealth{
+								State: aws.String("healthy"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedTargets: []*TargetHealth{
+				{
+					TargetID:    "10.0.0.12",
+					Port:        80,
+					State:       "unhealthy",
+					Reason:      "Target.ResponseCodeMismatch",
+					Description: "Health checks failed with these codes: [404]",
+				},
+				{
+					TargetID: "10.0.0.13",
+					Port:     80,
+					State:    "healthy",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			got, err := elbv2Client.TargetsHealth(mockTargetGroupARN)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTargets, got)
+			}
+		})
+	}
+}
+
+func TestELBV2_ListenerRules(t *testing.T) {
+	mockListenerARN := "mockListenerARN"
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		wantedRules []*ListenerRule
+		wantedErr   error
+	}{
+		"fail to describe rules": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String(mockListenerARN),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe rules for listener mockListenerARN: some error"),
+		},
+		"returns rules across pages": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String(mockListenerARN),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							Priority:  aws.String("1"),
+							IsDefault: aws.Bool(false),
+							Conditions: []*elbv2.RuleCondition{
+								{
+									Field: aws.String("path-pattern"),
+									PathPatternConfig: &elbv2.PathPatternConditionConfig{
+										Values: aws.StringSlice([]string{"/api", "/api/*"}),
+									},
+								},
+								{
+									Field: aws.String("source-ip"),
+									SourceIpConfig: &elbv2.SourceIpConditionConfig{
+										Values: aws.StringSlice([]string{"10.0.0.0/24"}),
+									},
+								},
+							},
+							Actions: []*elbv2.Action{
+								{
+									Type:           aws.String("forward"),
+									TargetGroupArn: aws.String("mockTargetGroupARN"),
+								},
+							},
+						},
+					},
+					NextMarker: aws.String("mockMarker"),
+				}, nil)
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String(mockListenerARN),
+					Marker:      aws.String("mockMarker"),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							Priority:  aws.String("2"),
+							IsDefault: aws.Bool(false),
+							Conditions: []*elbv2.RuleCondition{
+								{
+									Field: aws.String("host-header"),
+									HostHeaderConfig: &elbv2.HostHeaderConditionConfig{
+										Values: aws.StringSlice([]string{"web.example.com"}),
+									},
+								},
+							},
+							Actions: []*elbv2.Action{
+								{
+									Type: aws.String("forward"),
+									ForwardConfig: &elbv2.ForwardActionConfig{
+										TargetGroups: []*elbv2.TargetGroupTuple{
+											{TargetGroupArn: aws.String("mockOtherTargetGroupARN")},
+										},
+									},
+								},
+							},
+						},
+						{
+							Priority:  aws.String("default"),
+							IsDefault: aws.Bool(true),
+							Actions: []*elbv2.Action{
+								{Type: aws.String("fixed-response")},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedRules: []*ListenerRule{
+				{
+					Priority:        "1",
+					PathPatterns:    []string{"/api", "/api/*"},
+					SourceIPs:       []string{"10.0.0.0/24"},
+					TargetGroupARNs: []string{"mockTargetGroupARN"},
+				},
+				{
+					Priority:        "2",
+					HostHeaders:     []string{"web.example.com"},
+					TargetGroupARNs: []string{"mockOtherTargetGroupARN"},
+					WeightedTargetGroups: []WeightedTargetGroup{
+						{ARN: "mockOtherTargetGroupARN"},
+					},
+				},
+				{
+					Priority:  "default",
+					IsDefault: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			got, err := elbv2Client.ListenerRules(mockListenerARN)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedRules, got)
+			}
+		})
+	}
+}
+
+func TestELBV2_TargetGroupsTags(t *testing.T) {
+	testCases := map[string]struct {
+		inARNs    []string
+		setUpMock func(m *mocks.Mockapi)
+
+		wantedTags map[string]map[string]string
+		wantedErr  error
+	}{
+		"fail to describe tags": {
+			inARNs: []string{"mockTargetGroupARN"},
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTags(&elbv2.DescribeTagsInput{
+					ResourceArns: aws.StringSlice([]string{"mockTargetGroupARN"}),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe tags for target groups: some error"),
+		},
+		"returns tags of each target group in batches": {
+			inARNs: func() []string {
+				var arns []string
+				for i := 0; i < 21; i++ {
+					arns = append(arns, fmt.Sprintf("tg-%d", i))
+				}
+				return arns
+			}(),
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTags(gomock.Any()).DoAndReturn(func(in *elbv2.DescribeTagsInput) (*elbv2.DescribeTagsOutput, error) {
+					require.Equal(t, 20, len(in.ResourceArns))
+					return &elbv2.DescribeTagsOutput{
+						TagDescriptions: []*elbv2.TagDescription{
+							{
+								ResourceArn: aws.String("tg-0"),
+								Tags: []*elbv2.Tag{
+									{Key: aws.String("copilot-service"), Value: aws.String("api")},
+								},
+							},
+						},
+					}, nil
+				})
+				m.EXPECT().DescribeTags(&elbv2.DescribeTagsInput{
+					ResourceArns: aws.StringSlice([]string{"tg-20"}),
+				}).Return(&elbv2.DescribeTagsOutput{
+					TagDescriptions: []*elbv2.TagDescription{
+						{
+							ResourceArn: aws.String("tg-20"),
+							Tags: []*elbv2.Tag{
+								{Key: aws.String("copilot-service"), Value: aws.String("web")},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedTags: map[string]map[string]string{
+				"tg-0":  {"copilot-service": "api"},
+				"tg-20": {"copilot-service": "web"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			got, err := elbv2Client.TargetGroupsTags(tc.inARNs)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTags, got)
+			}
+		})
+	}
+}
+
+func TestELBV2_Rules(t *testing.T) {
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		wantedRules []*ListenerRule
+		wantedErr   error
+	}{
+		"fail to describe rules": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe rules mockHTTPRuleARN, mockHTTPSRuleARN: some error"),
+		},
+		"returns forwarding and fixed response rules": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					RuleArns: aws.StringSlice([]string{"mockHTTPRuleARN", "mockHTTPSRuleARN"}),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							RuleArn:  aws.String("mockHTTPRuleARN"),
+							Priority: aws.String("1"),
+							Actions: []*elbv2.Action{
+								{
+									Type:           aws.String("forward"),
+									TargetGroupArn: aws.String("mockTargetGroupARN"),
+								},
+							},
+						},
+						{
+							RuleArn:  aws.String("mockHTTPSRuleARN"),
+							Priority: aws.String("2"),
+							Actions: []*elbv2.Action{
+								{
+									Type: aws.String("fixed-response"),
+									FixedResponseConfig: &elbv2.FixedResponseActionConfig{
+										StatusCode:  aws.String("503"),
+										ContentType: aws.String("text/html"),
+										MessageBody: aws.String("back soon"),
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedRules: []*ListenerRule{
+				{
+					ARN:             "mockHTTPRuleARN",
+					Priority:        "1",
+					TargetGroupARNs: []string{"mockTargetGroupARN"},
+				},
+				{
+					ARN:      "mockHTTPSRuleARN",
+					Priority: "2",
+					FixedResponse: &FixedResponse{
+						StatusCode:  "503",
+						ContentType: "text/html",
+						Body:        "back soon",
+					},
+				},
+			},
+		},
+		"returns the weights of the target groups of a ForwardConfig": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(gomock.Any()).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							RuleArn:  aws.String("mockHTTPSRuleARN"),
+							Priority: aws.String("1"),
+							Actions: []*elbv2.Action{
+								{
+									Type: aws.String("forward"),
+									ForwardConfig: &elbv2.ForwardActionConfig{
+										TargetGroups: []*elbv2.TargetGroupTuple{
+											{TargetGroupArn: aws.String("mockTargetGroupARN"), Weight: aws.Int64(0)},
+											{TargetGroupArn: aws.String("mockAlternateTargetGroupARN"), Weight: aws.Int64(100)},
+										},
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedRules: []*ListenerRule{
+				{
+					ARN:             "mockHTTPSRuleARN",
+					Priority:        "1",
+					TargetGroupARNs: []string{"mockTargetGroupARN", "mockAlternateTargetGroupARN"},
+					WeightedTargetGroups: []WeightedTargetGroup{
+						{ARN: "mockTargetGroupARN", Weight: 0},
+						{ARN: "mockAlternateTargetGroupARN", Weight: 100},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			got, err := elbv2Client.Rules([]string{"mockHTTPRuleARN", "mockHTTPSRuleARN"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedRules, got)
+			}
+		})
+	}
+}
+
+func TestELBV2_RespondWith(t *testing.T) {
+	testCases := map[string]struct {
+		inErr error
+
+		wantedErr error
+	}{
+		"fail to modify rule": {
+			inErr:     errors.New("some error"),
+			wantedErr: errors.New("modify rule mockRuleARN to respond with status 503: some error"),
+		},
+		"success": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			mockAPI.EXPECT().ModifyRule(&elbv2.ModifyRuleInput{
+				RuleArn: aws.String("mockRuleARN"),
+				Actions: []*elbv2.Action{
+					{
+						Type: aws.String("fixed-response"),
+						FixedResponseConfig: &elbv2.FixedResponseActionConfig{
+							StatusCode:  aws.String("503"),
+							ContentType: aws.String("text/html"),
+							MessageBody: aws.String("back soon"),
+						},
+					},
+				},
+			}).Return(&elbv2.ModifyRuleOutput{}, tc.inErr)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			err := elbv2Client.RespondWith("mockRuleARN", FixedResponse{
+				StatusCode:  "503",
+				ContentType: "text/html",
+				Body:        "back soon",
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestELBV2_ForwardTo(t *testing.T) {
+	testCases := map[string]struct {
+		inErr error
+
+		wantedErr error
+	}{
+		"fail to modify rule": {
+			inErr:     errors.New("some error"),
+			wantedErr: errors.New("modify rule mockRuleARN to forward to target group mockTargetGroupARN: some error"),
+		},
+		"success": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			mockAPI.EXPECT().ModifyRule(&elbv2.ModifyRuleInput{
+				RuleArn: aws.String("mockRuleARN"),
+				Actions: []*elbv2.Action{
+					{
+						Type:           aws.String("forward"),
+						TargetGroupArn: aws.String("mockTargetGroupARN"),
+					},
+				},
+			}).Return(&elbv2.ModifyRuleOutput{}, tc.inErr)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			err := elbv2Client.ForwardTo("mockRuleARN", "mockTargetGroupARN")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestELBV2_ForwardToWeighted(t *testing.T) {
+	testCases := map[string]struct {
+		inErr error
+
+		wantedErr error
+	}{
+		"fail to modify rule": {
+			inErr:     errors.New("some error"),
+			wantedErr: errors.New("modify rule mockRuleARN to forward to weighted target groups: some error"),
+		},
+		"success": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			mockAPI.EXPECT().ModifyRule(&elbv2.ModifyRuleInput{
+				RuleArn: aws.String("mockRuleARN"),
+				Actions: []*elbv2.Action{
+					{
+						Type: aws.String("forward"),
+						ForwardConfig: &elbv2.ForwardActionConfig{
+							TargetGroups: []*elbv2.TargetGroupTuple{
+								{TargetGroupArn: aws.String("mockTargetGroupARN"), Weight: aws.Int64(0)},
+								{TargetGroupArn: aws.String("mockAlternateTargetGroupARN"), Weight: aws.Int64(100)},
+							},
+						},
+					},
+				},
+			}).Return(&elbv2.ModifyRuleOutput{}, tc.inErr)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			err := elbv2Client.ForwardToWeighted("mockRuleARN", []WeightedTargetGroup{
+				{ARN: "mockTargetGroupARN", Weight: 0},
+				{ARN: "mockAlternateTargetGroupARN", Weight: 100},
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestELBV2_RuleTags(t *testing.T) {
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		wantedTags map[string]string
+		wantedErr  error
+	}{
+		"fail to describe tags": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTags(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe tags for rule mockRuleARN: some error"),
+		},
+		"returns the tags of the rule": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTags(&elbv2.DescribeTagsInput{
+					ResourceArns: aws.StringSlice([]string{"mockRuleARN"}),
+				}).Return(&elbv2.DescribeTagsOutput{
+					TagDescriptions: []*elbv2.TagDescription{
+						{
+							ResourceArn: aws.String("mockRuleARN"),
+							Tags: []*elbv2.Tag{
+								{Key: aws.String("copilot-service"), Value: aws.String("api")},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedTags: map[string]string{
+				"copilot-service": "api",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			got, err := elbv2Client.RuleTags("mockRuleARN")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTags, got)
+			}
+		})
+	}
+}
+
+func TestELBV2_TagRule(t *testing.T) {
+	testCases := map[string]struct {
+		inErr error
+
+		wantedErr error
+	}{
+		"fail to add tags": {
+			inErr:     errors.New("some error"),
+			wantedErr: errors.New("add tags to rule mockRuleARN: some error"),
+		},
+		"success": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			mockAPI.EXPECT().AddTags(&elbv2.AddTagsInput{
+				ResourceArns: aws.StringSlice([]string{"mockRuleARN"}),
+				Tags: []*elbv2.Tag{
+					{Key: aws.String("a"), Value: aws.String("1")},
+					{Key: aws.String("b"), Value: aws.String("2")},
+				},
+			}).Return(&elbv2.AddTagsOutput{}, tc.inErr)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			err := elbv2Client.TagRule("mockRuleARN", map[string]string{
+				"b": "2",
+				"a": "1",
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestELBV2_UntagRule(t *testing.T) {
+	testCases := map[string]struct {
+		inErr error
+
+		wantedErr error
+	}{
+		"fail to remove tags": {
+			inErr:     errors.New("some error"),
+			wantedErr: errors.New("remove tags from rule mockRuleARN: some error"),
+		},
+		"success": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			mockAPI.EXPECT().RemoveTags(&elbv2.RemoveTagsInput{
+				ResourceArns: aws.StringSlice([]string{"mockRuleARN"}),
+				TagKeys:      aws.StringSlice([]string{"a", "b"}),
+			}).Return(&elbv2.RemoveTagsOutput{}, tc.inErr)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			err := elbv2Client.UntagRule("mockRuleARN", []string{"a", "b"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -25,6 +25,18 @@ type api interface {
 	ListRolePolicies(input *iam.ListRolePoliciesInput) (*iam.ListRolePoliciesOutput, error)
 	DeleteRole(input *iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error)
 	CreateServiceLinkedRole(input *iam.CreateServiceLinkedRoleInput) (*iam.CreateServiceLinkedRoleOutput, error)
+	SimulatePrincipalPolicy(input *iam.SimulatePrincipalPolicyInput) (*iam.SimulatePolicyResponse, error)
+}
+
+// Permission is an action allowed on a resource.
+type Permission struct {
+	Action   string // Such as "ecr:BatchGetImage".
+	Resource string // ARN of the resource, or "*".
+}
+
+// String returns the human readable format of the permission.
+func (p Permission) String() string {
+	return fmt.Sprintf("%s on %s", p.Action, p.Resource)
 }
 
 // IAM wraps the AWS SDK's IAM client.
@@ -98,6 +110,47 @@ func (c *IAM) CreateECSServiceLinkedRole() error {
 	return nil
 }
 
+// DeniedPermissions simulates the policies attached to a role and returns the permissions that the role isn't allowed.
+func (c *IAM) DeniedPermissions(roleARN string, perms []Permission) ([]Permission, error) {
+	var resources []string
+	actionsByResource := make(map[string][]string)
+	for _, perm := range perms {
+		if _, ok := actionsByResource[perm.Resource]; !ok {
+			resources = append(resources, perm.Resource)
+		}
+		actionsByResource[perm.Resource] = append(actionsByResource[perm.Resource], perm.Action)
+	}
+	var denied []Permission
+	for _, resource := range resources {
+		var marker *string
+		for {
+			out, err := c.client.SimulatePrincipalPolicy(&iam.SimulatePrincipalPolicyInput{
+				PolicySourceArn: aws.String(roleARN),
+				ActionNames:     aws.StringSlice(actionsByResource[resource]),
+				ResourceArns:    aws.StringSlice([]string{resource}),
+				Marker:          marker,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("simulate policies of role %s on resource %s: %w", roleARN, resource, err)
+			}
+			for _, result := range out.EvaluationResults {
+				if aws.StringValue(result.EvalDecision) == iam.PolicyEvaluationDecisionTypeAllowed {
+					continue
+				}
+				denied = append(denied, Permission{
+					Action:   aws.StringValue(result.EvalActionName),
+					Resource: resource,
+				})
+			}
+			if !aws.BoolValue(out.IsTruncated) {
+				break
+			}
+			marker = out.Marker
+		}
+	}
+	return denied, nil
+}
+
 func (c *IAM) deleteRolePolicies(roleName string) error {
 	policyNames, err := c.listRolePolicyNames(roleName)
 	if err != nil {
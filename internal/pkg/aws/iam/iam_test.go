@@ -242,3 +242,104 @@ func TestIAM_CreateECSServiceLinkedRole(t *testing.T) {
 		})
 	}
 }
+
+func TestIAM_DeniedPermissions(t *testing.T) {
+	const (
+		mockRoleARN = "arn:aws:iam::123456789012:role/phonetool-execution-role"
+		mockRepoARN = "arn:aws:ecr:us-west-2:123456789012:repository/phonetool/api"
+	)
+	perms := []Permission{
+		{Action: "ecr:GetAuthorizationToken", Resource: "*"},
+		{Action: "ecr:BatchCheckLayerAvailability", Resource: mockRepoARN},
+		{Action: "ecr:BatchGetImage", Resource: mockRepoARN},
+	}
+	testCases := map[string]struct {
+		inClient func(ctrl *gomock.Controller) *mocks.Mockapi
+
+		wantedDenied []Permission
+		wantedErr    error
+	}{
+		"wraps error on failure": {
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().SimulatePrincipalPolicy(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+
+			wantedErr: errors.New("simulate policies of role arn:aws:iam::123456789012:role/phonetool-execution-role on resource *: some error"),
+		},
+		"simulates the actions of each resource and returns the ones that aren't allowed": {
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				gomock.InOrder(
+					m.EXPECT().SimulatePrincipalPolicy(&iam.SimulatePrincipalPolicyInput{
+						PolicySourceArn: aws.String(mockRoleARN),
+						ActionNames:     aws.StringSlice([]string{"ecr:GetAuthorizationToken"}),
+						ResourceArns:    aws.StringSlice([]string{"*"}),
+					}).Return(&iam.SimulatePolicyResponse{
+						EvaluationResults: []*iam.EvaluationResult{
+							{
+								EvalActionName: aws.String("ecr:GetAuthorizationToken"),
+								EvalDecision:   aws.String(iam.PolicyEvaluationDecisionTypeAllowed),
+							},
+						},
+					}, nil),
+					m.EXPECT().SimulatePrincipalPolicy(&iam.SimulatePrincipalPolicyInput{
+						PolicySourceArn: aws.String(mockRoleARN),
+						ActionNames:     aws.StringSlice([]string{"ecr:BatchCheckLayerAvailability", "ecr:BatchGetImage"}),
+						ResourceArns:    aws.StringSlice([]string{mockRepoARN}),
+					}).Return(&iam.SimulatePolicyResponse{
+						IsTruncated: aws.Bool(true),
+						Marker:      aws.String("marker"),
+						EvaluationResults: []*iam.EvaluationResult{
+							{
+								EvalActionName: aws.String("ecr:BatchCheckLayerAvailability"),
+								EvalDecision:   aws.String(iam.PolicyEvaluationDecisionTypeAllowed),
+							},
+						},
+					}, nil),
+					m.EXPECT().SimulatePrincipalPolicy(&iam.SimulatePrincipalPolicyInput{
+						PolicySourceArn: aws.String(mockRoleARN),
+						ActionNames:     aws.StringSlice([]string{"ecr:BatchCheckLayerAvailability", "ecr:BatchGetImage"}),
+						ResourceArns:    aws.StringSlice([]string{mockRepoARN}),
+						Marker:          aws.String("marker"),
+					}).Return(&iam.SimulatePolicyResponse{
+						EvaluationResults: []*iam.EvaluationResult{
+							{
+								EvalActionName: aws.String("ecr:BatchGetImage"),
+								EvalDecision:   aws.String(iam.PolicyEvaluationDecisionTypeImplicitDeny),
+							},
+						},
+					}, nil),
+				)
+				return m
+			},
+
+			wantedDenied: []Permission{
+				{Action: "ecr:BatchGetImage", Resource: mockRepoARN},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			iam := &IAM{
+				client: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			denied, err := iam.DeniedPermissions(mockRoleARN, perms)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDenied, denied)
+			}
+		})
+	}
+}
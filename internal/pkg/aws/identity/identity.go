@@ -7,6 +7,7 @@ package identity
 import (
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sts"
 )
@@ -32,6 +33,7 @@ type Caller struct {
 	RootUserARN string
 	Account     string
 	UserID      string
+	ARN         string // ARN of the user or assumed role that makes the requests.
 }
 
 // Get returns the Caller associated with the Client's session.
@@ -46,5 +48,6 @@ func (s STS) Get() (Caller, error) {
 		RootUserARN: fmt.Sprintf("arn:aws:iam::%s:root", *out.Account),
 		Account:     *out.Account,
 		UserID:      *out.UserId,
+		ARN:         aws.StringValue(out.Arn),
 	}, nil
 }
@@ -44,6 +44,7 @@ func TestIdentity_Get(t *testing.T) {
 				Account:     mockAccount,
 				RootUserARN: fmt.Sprintf("arn:aws:iam::%s:root", mockAccount),
 				UserID:      mockUserID,
+				ARN:         mockARN,
 			},
 		},
 	}
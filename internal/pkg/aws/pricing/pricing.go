@@ -0,0 +1,164 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pricing provides a client to make API requests to the AWS Price List Service.
+package pricing
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/pricing"
+)
+
+const (
+	// The Price List Service API is only available in a few regions, and returns the prices of every region.
+	apiRegion = "us-east-1"
+
+	regionCodeAttribute = "regionCode"
+	currencyUSD         = "USD"
+)
+
+type api interface {
+	GetProducts(input *pricing.GetProductsInput) (*pricing.GetProductsOutput, error)
+}
+
+// Pricing wraps an AWS Price List Service client.
+type Pricing struct {
+	client api
+}
+
+// New returns a Pricing client configured against the input session.
+func New(s *session.Session) *Pricing {
+	return &Pricing{
+		client: pricing.New(s, aws.NewConfig().WithRegion(apiRegion)),
+	}
+}
+
+// PriceInput identifies the product to get the price of.
+type PriceInput struct {
+	ServiceCode string            // For example, "AmazonEC2".
+	Region      string            // For example, "us-west-2".
+	UsageType   string            // Usage type without its region prefix, for example "NatGateway-Hours".
+	Filters     map[string]string // Additional product attributes to match, for example {"productFamily": "NAT Gateway"}.
+}
+
+// ErrPriceNotFound occurs when no product matches the input of OnDemandPrice.
+type ErrPriceNotFound struct {
+	in PriceInput
+}
+
+func (e *ErrPriceNotFound) Error() string {
+	return fmt.Sprintf("no on-demand price found for usage type %s of %s in region %s", e.in.UsageType, e.in.ServiceCode, e.in.Region)
+}
+
+type product struct {
+	Product struct {
+		Attributes map[string]string `json:"attributes"`
+	} `json:"product"`
+	Terms struct {
+		OnDemand map[string]struct {
+			PriceDimensions map[string]struct {
+				PricePerUnit map[string]string `json:"pricePerUnit"`
+			} `json:"priceDimensions"`
+		} `json:"OnDemand"`
+	} `json:"terms"`
+}
+
+// OnDemandPrice returns the on-demand price per unit in USD of the product that matches the input.
+// If the price is tiered, it returns the price of the most expensive tier.
+func (p *Pricing) OnDemandPrice(in PriceInput) (float64, error) {
+	filters := map[string]string{
+		regionCodeAttribute: in.Region,
+	}
+	for k, v := range in.Filters {
+		filters[k] = v
+	}
+	var keys []string
+	for k := range filters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	input := &pricing.GetProductsInput{
+		ServiceCode: aws.String(in.ServiceCode),
+	}
+	for _, k := range keys {
+		input.Filters = append(input.Filters, &pricing.Filter{
+			Field: aws.String(k),
+			Type:  aws.String(pricing.FilterTypeTermMatch),
+			Value: aws.String(filters[k]),
+		})
+	}
+
+	var price float64
+	var found bool
+	for {
+		out, err := p.client.GetProducts(input)
+		if err != nil {
+			return 0, fmt.Errorf("get products of %s: %w", in.ServiceCode, err)
+		}
+		for _, item := range out.PriceList {
+			usd, ok, err := onDemandUSD(item, in.UsageType)
+			if err != nil {
+				return 0, err
+			}
+			if ok && (!found || usd > price) {
+				price, found = usd, true
+			}
+		}
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+	if !found {
+		return 0, &ErrPriceNotFound{in: in}
+	}
+	return price, nil
+}
+
+// onDemandUSD returns the highest on-demand price in USD of the price list item if its usage type matches.
+func onDemandUSD(item aws.JSONValue, usageType string) (price float64, ok bool, err error) {
+	raw, err := json.Marshal(item)
+	if err != nil {
+		return 0, false, fmt.Errorf("marshal price list item: %w", err)
+	}
+	var prod product
+	if err := json.Unmarshal(raw, &prod); err != nil {
+		return 0, false, fmt.Errorf("unmarshal price list item: %w", err)
+	}
+	if !matchesUsageType(prod.Product.Attributes["usagetype"], usageType) {
+		return 0, false, nil
+	}
+	for _, term := range prod.Terms.OnDemand {
+		for _, dimension := range term.PriceDimensions {
+			value, exists := dimension.PricePerUnit[currencyUSD]
+			if !exists {
+				continue
+			}
+			usd, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return 0, false, fmt.Errorf("parse price %s: %w", value, err)
+			}
+			if !ok || usd > price {
+				price, ok = usd, true
+			}
+		}
+	}
+	return price, ok, nil
+}
+
+// matchesUsageType returns true if got is the wanted usage type, optionally prefixed with a region code.
+// For example, "USW2-NatGateway-Hours" matches "NatGateway-Hours" but "USW2-SpotUsage-Fargate-GB-Hours" doesn't match "Fargate-GB-Hours".
+func matchesUsageType(got, want string) bool {
+	if got == want {
+		return true
+	}
+	parts := strings.SplitN(got, "-", 2)
+	return len(parts) == 2 && parts[1] == want
+}
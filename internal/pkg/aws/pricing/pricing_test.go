@@ -0,0 +1,163 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package pricing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/copilot-cli/internal/pkg/aws/pricing/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func priceListItem(usageType string, usd ...string) aws.JSONValue {
+	dimensions := make(map[string]interface{})
+	for i, price := range usd {
+		dimensions[string(rune('a'+i))] = map[string]interface{}{
+			"unit": "Hrs",
+			"pricePerUnit": map[string]interface{}{
+				"USD": price,
+			},
+		}
+	}
+	return aws.JSONValue{
+		"product": map[string]interface{}{
+			"attributes": map[string]interface{}{
+				"usagetype": usageType,
+			},
+		},
+		"terms": map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				"sku.term": map[string]interface{}{
+					"priceDimensions": dimensions,
+				},
+			},
+		},
+	}
+}
+
+func TestPricing_OnDemandPrice(t *testing.T) {
+	in := PriceInput{
+		ServiceCode: "AmazonEC2",
+		Region:      "us-west-2",
+		UsageType:   "NatGateway-Hours",
+		Filters: map[string]string{
+			"productFamily": "NAT Gateway",
+		},
+	}
+	wantedInput := &pricing.GetProductsInput{
+		ServiceCode: aws.String("AmazonEC2"),
+		Filters: []*pricing.Filter{
+			{
+				Field: aws.String("productFamily"),
+				Type:  aws.String("TERM_MATCH"),
+				Value: aws.String("NAT Gateway"),
+			},
+			{
+				Field: aws.String("regionCode"),
+				Type:  aws.String("TERM_MATCH"),
+				Value: aws.String("us-west-2"),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wanted    float64
+		wantedErr error
+	}{
+		"wraps the error": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetProducts(wantedInput).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get products of AmazonEC2: some error"),
+		},
+		"returns ErrPriceNotFound if no usage type matches": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetProducts(wantedInput).Return(&pricing.GetProductsOutput{
+					PriceList: []aws.JSONValue{
+						priceListItem("USW2-NatGateway-Bytes", "0.045"),
+					},
+				}, nil)
+			},
+			wantedErr: errors.New("no on-demand price found for usage type NatGateway-Hours of AmazonEC2 in region us-west-2"),
+		},
+		"returns the highest price of the matching usage type across pages": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetProducts(wantedInput).Return(&pricing.GetProductsOutput{
+					PriceList: []aws.JSONValue{
+						priceListItem("USW2-NatGateway-Bytes", "0.5"),
+						priceListItem("USW2-NatGateway-Hours", "0.04"),
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().GetProducts(gomock.Any()).DoAndReturn(func(in *pricing.GetProductsInput) (*pricing.GetProductsOutput, error) {
+					require.Equal(t, "next", aws.StringValue(in.NextToken))
+					return &pricing.GetProductsOutput{
+						PriceList: []aws.JSONValue{
+							priceListItem("USW2-NatGateway-Hours", "0.045", "0"),
+						},
+					}, nil
+				})
+			},
+			wanted: 0.045,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := Pricing{
+				client: m,
+			}
+
+			// WHEN
+			got, err := client.OnDemandPrice(in)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestMatchesUsageType(t *testing.T) {
+	testCases := map[string]struct {
+		got    string
+		want   string
+		wanted bool
+	}{
+		"without region prefix": {
+			got:    "NatGateway-Hours",
+			want:   "NatGateway-Hours",
+			wanted: true,
+		},
+		"with region prefix": {
+			got:    "USW2-NatGateway-Hours",
+			want:   "NatGateway-Hours",
+			wanted: true,
+		},
+		"different usage type with the same suffix": {
+			got:  "USW2-SpotUsage-Fargate-GB-Hours",
+			want: "Fargate-GB-Hours",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, matchesUsageType(tc.got, tc.want))
+		})
+	}
+}
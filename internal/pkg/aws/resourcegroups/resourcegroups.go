@@ -36,6 +36,7 @@ func New(s *session.Session) *ResourceGroups {
 }
 
 // GetResourcesByTags gets tag set and ARN for the resource with input resource type and tags.
+// If the resource type is empty, resources of every type are returned.
 func (rg *ResourceGroups) GetResourcesByTags(resourceType string, tags map[string]string) ([]*Resource, error) {
 	var resources []*Resource
 	var tagFilter []*resourcegroupstaggingapi.TagFilter
@@ -45,12 +46,16 @@ func (rg *ResourceGroups) GetResourcesByTags(resourceType string, tags map[strin
 			Values: aws.StringSlice([]string{v}),
 		})
 	}
+	var resourceTypeFilters []*string
+	if resourceType != "" {
+		resourceTypeFilters = aws.StringSlice([]string{resourceType})
+	}
 	resourceResp := &resourcegroupstaggingapi.GetResourcesOutput{}
 	for {
 		var err error
 		resourceResp, err = rg.client.GetResources(&resourcegroupstaggingapi.GetResourcesInput{
 			PaginationToken:     resourceResp.PaginationToken,
-			ResourceTypeFilters: aws.StringSlice([]string{resourceType}),
+			ResourceTypeFilters: resourceTypeFilters,
 			TagFilters:          tagFilter,
 		})
 		if err != nil {
@@ -74,6 +74,25 @@ func TestResourceGroups_GetResourcesByTags(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		"returns resources of every type if the resource type is empty": {
+			inTags: testTags,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetResources(&rgapi.GetResourcesInput{
+					TagFilters: []*rgapi.TagFilter{
+						{
+							Key:    aws.String("copilot-environment"),
+							Values: aws.StringSlice([]string{"test"}),
+						},
+					},
+				}).Return(mockResponse, nil)
+			},
+			expectedOut: []*Resource{
+				{
+					ARN:  testArn,
+					Tags: testTags,
+				},
+			},
+		},
 		"wraps error from API call": {
 			inTags:         testTags,
 			inResourceType: testResourceType,
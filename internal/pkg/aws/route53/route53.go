@@ -18,10 +18,14 @@ const (
 	// > To view limits and request higher limits for Route 53, you must change the Region to US East (N. Virginia).
 	// So we have to set the region to us-east-1 to be able to find out if a domain name exists in the account.
 	route53Region = "us-east-1"
+
+	nsRecordTTL = 900 // Same TTL as the delegation record set in the application stack.
 )
 
 type api interface {
 	ListHostedZonesByName(in *route53.ListHostedZonesByNameInput) (*route53.ListHostedZonesByNameOutput, error)
+	GetHostedZone(in *route53.GetHostedZoneInput) (*route53.GetHostedZoneOutput, error)
+	ChangeResourceRecordSets(in *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error)
 }
 
 // Route53 wraps an Route53 client.
@@ -60,6 +64,51 @@ func (r *Route53) DomainHostedZoneID(domainName string) (string, error) {
 	}
 }
 
+// NameServers returns the name servers of the delegation set of a hosted zone.
+func (r *Route53) NameServers(hostedZoneID string) ([]string, error) {
+	resp, err := r.client.GetHostedZone(&route53.GetHostedZoneInput{
+		Id: aws.String(hostedZoneID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get hosted zone %s: %w", hostedZoneID, err)
+	}
+	if resp.DelegationSet == nil {
+		return nil, fmt.Errorf("hosted zone %s does not have a delegation set", hostedZoneID)
+	}
+	return aws.StringValueSlice(resp.DelegationSet.NameServers), nil
+}
+
+// UpsertNSRecord creates or updates the NS record of a subdomain in a hosted zone to delegate it to the name servers.
+func (r *Route53) UpsertNSRecord(hostedZoneID, subdomain string, nameServers []string) error {
+	var records []*route53.ResourceRecord
+	for _, ns := range nameServers {
+		records = append(records, &route53.ResourceRecord{
+			Value: aws.String(ns),
+		})
+	}
+	_, err := r.client.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(hostedZoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Comment: aws.String(fmt.Sprintf("Record for copilot domain delegation of %s", subdomain)),
+			Changes: []*route53.Change{
+				{
+					Action: aws.String(route53.ChangeActionUpsert),
+					ResourceRecordSet: &route53.ResourceRecordSet{
+						Name:            aws.String(subdomain),
+						Type:            aws.String(route53.RRTypeNs),
+						TTL:             aws.Int64(nsRecordTTL),
+						ResourceRecords: records,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("upsert NS record %s in hosted zone %s: %w", subdomain, hostedZoneID, err)
+	}
+	return nil
+}
+
 type filterZoneFunc func(*route53.HostedZone) bool
 
 func filterHostedZones(zones []*route53.HostedZone, fn filterZoneFunc) []*route53.HostedZone {
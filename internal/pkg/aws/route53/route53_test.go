@@ -163,3 +163,127 @@ func TestRoute53_DomainHostedZoneID(t *testing.T) {
 
 	}
 }
+
+func TestRoute53_NameServers(t *testing.T) {
+	testCases := map[string]struct {
+		mockRoute53Client func(m *mocks.Mockapi)
+
+		wantErr         error
+		wantNameServers []string
+	}{
+		"failed to get hosted zone": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().GetHostedZone(&route53.GetHostedZoneInput{
+					Id: aws.String("mockID"),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("get hosted zone mockID: some error"),
+		},
+		"hosted zone without delegation set": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().GetHostedZone(gomock.Any()).Return(&route53.GetHostedZoneOutput{}, nil)
+			},
+			wantErr: fmt.Errorf("hosted zone mockID does not have a delegation set"),
+		},
+		"returns the name servers": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().GetHostedZone(gomock.Any()).Return(&route53.GetHostedZoneOutput{
+					DelegationSet: &route53.DelegationSet{
+						NameServers: aws.StringSlice([]string{"ns-1.awsdns.com", "ns-2.awsdns.net"}),
+					},
+				}, nil)
+			},
+			wantNameServers: []string{"ns-1.awsdns.com", "ns-2.awsdns.net"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRoute53Client := mocks.NewMockapi(ctrl)
+			tc.mockRoute53Client(mockRoute53Client)
+
+			service := Route53{
+				client: mockRoute53Client,
+			}
+
+			// WHEN
+			got, err := service.NameServers("mockID")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantNameServers, got)
+		})
+	}
+}
+
+func TestRoute53_UpsertNSRecord(t *testing.T) {
+	testCases := map[string]struct {
+		mockRoute53Client func(m *mocks.Mockapi)
+
+		wantErr error
+	}{
+		"failed to change record sets": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ChangeResourceRecordSets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("upsert NS record phonetool.example.com in hosted zone mockID: some error"),
+		},
+		"upserts the NS record": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+					HostedZoneId: aws.String("mockID"),
+					ChangeBatch: &route53.ChangeBatch{
+						Comment: aws.String("Record for copilot domain delegation of phonetool.example.com"),
+						Changes: []*route53.Change{
+							{
+								Action: aws.String(route53.ChangeActionUpsert),
+								ResourceRecordSet: &route53.ResourceRecordSet{
+									Name: aws.String("phonetool.example.com"),
+									Type: aws.String(route53.RRTypeNs),
+									TTL:  aws.Int64(900),
+									ResourceRecords: []*route53.ResourceRecord{
+										{Value: aws.String("ns-1.awsdns.com")},
+										{Value: aws.String("ns-2.awsdns.net")},
+									},
+								},
+							},
+						},
+					},
+				}).Return(&route53.ChangeResourceRecordSetsOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRoute53Client := mocks.NewMockapi(ctrl)
+			tc.mockRoute53Client(mockRoute53Client)
+
+			service := Route53{
+				client: mockRoute53Client,
+			}
+
+			// WHEN
+			err := service.UpsertNSRecord("mockID", "phonetool.example.com", []string{"ns-1.awsdns.com", "ns-2.awsdns.net"})
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"io"
 	"io/ioutil"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -347,6 +348,61 @@ func TestS3_EmptyBucket(t *testing.T) {
 	}
 }
 
+func TestS3_GetObject(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wanted  []byte
+		wantErr error
+	}{
+		"should wrap up error if fail to get object": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantErr: fmt.Errorf("get object manifests/api.yml from bucket mockBucket: some error"),
+		},
+		"should return the content of the object": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(&s3.GetObjectInput{
+					Bucket: aws.String("mockBucket"),
+					Key:    aws.String("manifests/api.yml"),
+				}).Return(&s3.GetObjectOutput{
+					Body: ioutil.NopCloser(strings.NewReader("name: api")),
+				}, nil)
+			},
+
+			wanted: []byte("name: api"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			// WHEN
+			got, gotErr := service.GetObject("mockBucket", "manifests/api.yml")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func TestS3_ParseURL(t *testing.T) {
 	testCases := map[string]struct {
 		inURL string
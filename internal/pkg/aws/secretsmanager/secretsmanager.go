@@ -5,11 +5,14 @@
 package secretsmanager
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 )
@@ -17,6 +20,8 @@ import (
 type api interface {
 	CreateSecret(*secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error)
 	DeleteSecret(*secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error)
+	GetSecretValue(*secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error)
+	PutSecretValue(*secretsmanager.PutSecretValueInput) (*secretsmanager.PutSecretValueOutput, error)
 }
 
 // SecretsManager wraps the AWS SecretManager client.
@@ -40,6 +45,14 @@ func New() (*SecretsManager, error) {
 	}, nil
 }
 
+// NewWithSession returns a SecretsManager configured against the input session.
+func NewWithSession(s *session.Session) *SecretsManager {
+	return &SecretsManager{
+		secretsManager: secretsmanager.New(s),
+		sessionRegion:  aws.StringValue(s.Config.Region),
+	}
+}
+
 var secretTags = func() []*secretsmanager.Tag {
 	timestamp := time.Now().UTC().Format(time.UnixDate)
 	return []*secretsmanager.Tag{
@@ -88,6 +101,83 @@ func (s *SecretsManager) DeleteSecret(secretName string) error {
 	return nil
 }
 
+// SecretValue returns the string value of the secret with the given name.
+// If the secret does not exist, it returns an ErrSecretNotFound.
+func (s *SecretsManager) SecretValue(secretName string) (string, error) {
+	resp, err := s.secretsManager.GetSecretValue(&secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretName),
+	})
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+			return "", &ErrSecretNotFound{
+				secretName: secretName,
+			}
+		}
+		return "", fmt.Errorf("get value of secret %s: %w", secretName, err)
+	}
+	return aws.StringValue(resp.SecretString), nil
+}
+
+// PutSecretInput holds the fields needed to create or update a secret.
+type PutSecretInput struct {
+	Name      string
+	Value     string
+	Overwrite bool
+	Tags      map[string]string
+}
+
+// PutSecret creates a tagged secret, or stores a new value for the secret if Overwrite is set.
+func (s *SecretsManager) PutSecret(in PutSecretInput) error {
+	if in.Overwrite {
+		_, err := s.secretsManager.PutSecretValue(&secretsmanager.PutSecretValueInput{
+			SecretId:     aws.String(in.Name),
+			SecretString: aws.String(in.Value),
+		})
+		if err != nil {
+			return fmt.Errorf("put value of secret %s: %w", in.Name, err)
+		}
+		return nil
+	}
+	var tags []*secretsmanager.Tag
+	var keys []string
+	for k := range in.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		tags = append(tags, &secretsmanager.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(in.Tags[k]),
+		})
+	}
+	_, err := s.secretsManager.CreateSecret(&secretsmanager.CreateSecretInput{
+		Name:         aws.String(in.Name),
+		SecretString: aws.String(in.Value),
+		Tags:         tags,
+	})
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == secretsmanager.ErrCodeResourceExistsException {
+			return &ErrSecretAlreadyExists{
+				secretName: in.Name,
+				parentErr:  err,
+			}
+		}
+		return fmt.Errorf("create secret %s: %w", in.Name, err)
+	}
+	return nil
+}
+
+// ErrSecretNotFound occurs if a secret with the given name does not exist.
+type ErrSecretNotFound struct {
+	secretName string
+}
+
+func (err *ErrSecretNotFound) Error() string {
+	return fmt.Sprintf("secret %s not found", err.secretName)
+}
+
 // ErrSecretAlreadyExists occurs if a secret with the same name already exists.
 type ErrSecretAlreadyExists struct {
 	secretName string
@@ -105,3 +105,168 @@ func TestSecretsManager_CreateSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretsManager_SecretValue(t *testing.T) {
+	mockSecretName := "/copilot/phonetool/test/secrets/DB_PASSWORD"
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		callMock func(m *mocks.Mockapi)
+
+		expectedValue string
+		expectedError error
+	}{
+		"should return ErrSecretNotFound if the secret does not exist": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(gomock.Any()).Return(nil, awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "", nil))
+			},
+			expectedError: &ErrSecretNotFound{
+				secretName: mockSecretName,
+			},
+		},
+		"should wrap error returned by GetSecretValue": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(gomock.Any()).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("get value of secret %s: %w", mockSecretName, mockError),
+		},
+		"should return the secret string": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(&secretsmanager.GetSecretValueInput{
+					SecretId: aws.String(mockSecretName),
+				}).Return(&secretsmanager.GetSecretValueOutput{
+					SecretString: aws.String("hunter2"),
+				}, nil)
+			},
+			expectedValue: "hunter2",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+			tc.callMock(mockSecretsManager)
+
+			// WHEN
+			value, err := sm.SecretValue(mockSecretName)
+
+			// THEN
+			require.Equal(t, tc.expectedError, err)
+			require.Equal(t, tc.expectedValue, value)
+		})
+	}
+}
+
+func TestSecretsManager_PutSecret(t *testing.T) {
+	mockSecretName := "/copilot/phonetool/test/secrets/DB_PASSWORD"
+	mockError := errors.New("mockError")
+	mockAwsErr := awserr.New(secretsmanager.ErrCodeResourceExistsException, "", nil)
+
+	tests := map[string]struct {
+		in       PutSecretInput
+		callMock func(m *mocks.Mockapi)
+
+		expectedError error
+	}{
+		"should create a tagged secret": {
+			in: PutSecretInput{
+				Name:  mockSecretName,
+				Value: "hunter2",
+				Tags: map[string]string{
+					"copilot-environment": "test",
+					"copilot-application": "phonetool",
+				},
+			},
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(&secretsmanager.CreateSecretInput{
+					Name:         aws.String(mockSecretName),
+					SecretString: aws.String("hunter2"),
+					Tags: []*secretsmanager.Tag{
+						{
+							Key:   aws.String("copilot-application"),
+							Value: aws.String("phonetool"),
+						},
+						{
+							Key:   aws.String("copilot-environment"),
+							Value: aws.String("test"),
+						},
+					},
+				}).Return(&secretsmanager.CreateSecretOutput{}, nil)
+			},
+		},
+		"should return ErrSecretAlreadyExists if the secret exists": {
+			in: PutSecretInput{
+				Name:  mockSecretName,
+				Value: "hunter2",
+			},
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(gomock.Any()).Return(nil, mockAwsErr)
+			},
+			expectedError: &ErrSecretAlreadyExists{
+				secretName: mockSecretName,
+				parentErr:  mockAwsErr,
+			},
+		},
+		"should wrap error returned by CreateSecret": {
+			in: PutSecretInput{
+				Name:  mockSecretName,
+				Value: "hunter2",
+			},
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(gomock.Any()).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("create secret %s: %w", mockSecretName, mockError),
+		},
+		"should put a new value if overwriting": {
+			in: PutSecretInput{
+				Name:      mockSecretName,
+				Value:     "hunter3",
+				Overwrite: true,
+			},
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().PutSecretValue(&secretsmanager.PutSecretValueInput{
+					SecretId:     aws.String(mockSecretName),
+					SecretString: aws.String("hunter3"),
+				}).Return(&secretsmanager.PutSecretValueOutput{}, nil)
+			},
+		},
+		"should wrap error returned by PutSecretValue": {
+			in: PutSecretInput{
+				Name:      mockSecretName,
+				Value:     "hunter3",
+				Overwrite: true,
+			},
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().PutSecretValue(gomock.Any()).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("put value of secret %s: %w", mockSecretName, mockError),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+			tc.callMock(mockSecretsManager)
+
+			// WHEN
+			err := sm.PutSecret(tc.in)
+
+			// THEN
+			require.Equal(t, tc.expectedError, err)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package servicediscovery provides a client to make API requests to AWS Cloud Map.
+package servicediscovery
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+)
+
+type api interface {
+	ListServices(input *servicediscovery.ListServicesInput) (*servicediscovery.ListServicesOutput, error)
+}
+
+// ServiceDiscovery wraps an AWS Cloud Map client.
+type ServiceDiscovery struct {
+	client api
+}
+
+// New returns a ServiceDiscovery client configured against the input session.
+func New(s *session.Session) *ServiceDiscovery {
+	return &ServiceDiscovery{
+		client: servicediscovery.New(s),
+	}
+}
+
+// NamespaceInstanceCount returns the number of instances registered across all the services of a namespace.
+func (s *ServiceDiscovery) NamespaceInstanceCount(namespaceID string) (int, error) {
+	var count int
+	in := &servicediscovery.ListServicesInput{
+		Filters: []*servicediscovery.ServiceFilter{
+			{
+				Name:      aws.String(servicediscovery.ServiceFilterNameNamespaceId),
+				Values:    aws.StringSlice([]string{namespaceID}),
+				Condition: aws.String(servicediscovery.FilterConditionEq),
+			},
+		},
+	}
+	for {
+		out, err := s.client.ListServices(in)
+		if err != nil {
+			return 0, fmt.Errorf("list services in namespace %s: %w", namespaceID, err)
+		}
+		for _, svc := range out.Services {
+			count += int(aws.Int64Value(svc.InstanceCount))
+		}
+		if out.NextToken == nil {
+			return count, nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
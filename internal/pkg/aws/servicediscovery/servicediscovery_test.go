@@ -0,0 +1,84 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package servicediscovery
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/copilot-cli/internal/pkg/aws/servicediscovery/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceDiscovery_NamespaceInstanceCount(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wanted    int
+		wantedErr error
+	}{
+		"wraps the error": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListServices(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list services in namespace ns-1234: some error"),
+		},
+		"sums the instances of every service across pages": {
+			mockClient: func(m *mocks.Mockapi) {
+				filters := []*servicediscovery.ServiceFilter{
+					{
+						Name:      aws.String("NAMESPACE_ID"),
+						Values:    aws.StringSlice([]string{"ns-1234"}),
+						Condition: aws.String("EQ"),
+					},
+				}
+				m.EXPECT().ListServices(&servicediscovery.ListServicesInput{
+					Filters: filters,
+				}).Return(&servicediscovery.ListServicesOutput{
+					Services: []*servicediscovery.ServiceSummary{
+						{InstanceCount: aws.Int64(2)},
+						{InstanceCount: aws.Int64(3)},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().ListServices(&servicediscovery.ListServicesInput{
+					Filters:   filters,
+					NextToken: aws.String("next"),
+				}).Return(&servicediscovery.ListServicesOutput{
+					Services: []*servicediscovery.ServiceSummary{
+						{InstanceCount: aws.Int64(1)},
+					},
+				}, nil)
+			},
+			wanted: 6,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := ServiceDiscovery{
+				client: m,
+			}
+
+			// WHEN
+			got, err := client.NamespaceInstanceCount("ns-1234")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
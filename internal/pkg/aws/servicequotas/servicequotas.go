@@ -0,0 +1,56 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package servicequotas provides a client to make API requests to Service Quotas.
+package servicequotas
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+)
+
+type api interface {
+	GetServiceQuota(input *servicequotas.GetServiceQuotaInput) (*servicequotas.GetServiceQuotaOutput, error)
+	GetAWSDefaultServiceQuota(input *servicequotas.GetAWSDefaultServiceQuotaInput) (*servicequotas.GetAWSDefaultServiceQuotaOutput, error)
+}
+
+// ServiceQuotas wraps an AWS Service Quotas client.
+type ServiceQuotas struct {
+	client api
+}
+
+// New returns a ServiceQuotas client configured against the input session.
+func New(s *session.Session) *ServiceQuotas {
+	return &ServiceQuotas{
+		client: servicequotas.New(s),
+	}
+}
+
+// Value returns the value of a quota in the account and region.
+// If the quota was never adjusted in the account, it returns the AWS default value of the quota.
+func (s *ServiceQuotas) Value(serviceCode, quotaCode string) (float64, error) {
+	out, err := s.client.GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err == nil {
+		return aws.Float64Value(out.Quota.Value), nil
+	}
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) || aerr.Code() != servicequotas.ErrCodeNoSuchResourceException {
+		return 0, fmt.Errorf("get quota %s for service %s: %w", quotaCode, serviceCode, err)
+	}
+	defaultOut, err := s.client.GetAWSDefaultServiceQuota(&servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("get default quota %s for service %s: %w", quotaCode, serviceCode, err)
+	}
+	return aws.Float64Value(defaultOut.Quota.Value), nil
+}
@@ -0,0 +1,91 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package servicequotas
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/servicequotas/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceQuotas_Value(t *testing.T) {
+	errNoSuchResource := awserr.New(servicequotas.ErrCodeNoSuchResourceException, "not applied", nil)
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wanted    float64
+		wantedErr error
+	}{
+		"returns the applied quota value": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+					ServiceCode: aws.String("fargate"),
+					QuotaCode:   aws.String("L-3032A538"),
+				}).Return(&servicequotas.GetServiceQuotaOutput{
+					Quota: &servicequotas.ServiceQuota{
+						Value: aws.Float64(1000),
+					},
+				}, nil)
+			},
+			wanted: 1000,
+		},
+		"wraps the error if the applied quota can't be retrieved": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetServiceQuota(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get quota L-3032A538 for service fargate: some error"),
+		},
+		"falls back to the default quota value if the quota was never adjusted": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetServiceQuota(gomock.Any()).Return(nil, errNoSuchResource)
+				m.EXPECT().GetAWSDefaultServiceQuota(&servicequotas.GetAWSDefaultServiceQuotaInput{
+					ServiceCode: aws.String("fargate"),
+					QuotaCode:   aws.String("L-3032A538"),
+				}).Return(&servicequotas.GetAWSDefaultServiceQuotaOutput{
+					Quota: &servicequotas.ServiceQuota{
+						Value: aws.Float64(500),
+					},
+				}, nil)
+			},
+			wanted: 500,
+		},
+		"wraps the error if the default quota can't be retrieved": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetServiceQuota(gomock.Any()).Return(nil, errNoSuchResource)
+				m.EXPECT().GetAWSDefaultServiceQuota(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get default quota L-3032A538 for service fargate: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := ServiceQuotas{
+				client: m,
+			}
+
+			// WHEN
+			got, err := client.Value("fargate", "L-3032A538")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
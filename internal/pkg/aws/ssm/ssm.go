@@ -0,0 +1,177 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ssm provides a client to make API requests to AWS Systems Manager.
+package ssm
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+)
+
+const (
+	portForwardingDocument             = "AWS-StartPortForwardingSession"
+	portForwardingToRemoteHostDocument = "AWS-StartPortForwardingSessionToRemoteHost"
+)
+
+type api interface {
+	CreateActivation(input *ssm.CreateActivationInput) (*ssm.CreateActivationOutput, error)
+	GetParameter(input *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+	PutParameter(input *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+	StartSession(input *ssm.StartSessionInput) (*ssm.StartSessionOutput, error)
+}
+
+type ssmSessionStarter interface {
+	StartPortForwardingSession(ssmSess *ssm.StartSessionOutput, request *ssm.StartSessionInput) error
+}
+
+// SSM wraps an AWS Systems Manager client.
+type SSM struct {
+	client         api
+	newSessStarter func() ssmSessionStarter
+}
+
+// New returns a SSM client configured against the input session.
+func New(s *session.Session) *SSM {
+	return &SSM{
+		client: ssm.New(s),
+		newSessStarter: func() ssmSessionStarter {
+			return exec.NewSSMPluginCommand(s)
+		},
+	}
+}
+
+// Activation holds the credentials that on-premises instances use to register with Systems Manager.
+type Activation struct {
+	ID   string
+	Code string
+}
+
+// CreateActivation creates an activation that lets up to registrationLimit on-premises instances
+// register with Systems Manager and assume the IAM role.
+func (s *SSM) CreateActivation(iamRole string, registrationLimit int) (*Activation, error) {
+	out, err := s.client.CreateActivation(&ssm.CreateActivationInput{
+		IamRole:           aws.String(iamRole),
+		RegistrationLimit: aws.Int64(int64(registrationLimit)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create activation for role %s: %w", iamRole, err)
+	}
+	return &Activation{
+		ID:   aws.StringValue(out.ActivationId),
+		Code: aws.StringValue(out.ActivationCode),
+	}, nil
+}
+
+// ErrParameterNotFound occurs when a parameter does not exist in the parameter store.
+type ErrParameterNotFound struct {
+	name string
+}
+
+func (e *ErrParameterNotFound) Error() string {
+	return fmt.Sprintf("parameter %s not found", e.name)
+}
+
+// SecretValue returns the decrypted value of the parameter with the given name.
+// If the parameter does not exist, it returns an ErrParameterNotFound.
+func (s *SSM) SecretValue(name string) (string, error) {
+	out, err := s.client.GetParameter(&ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == ssm.ErrCodeParameterNotFound {
+			return "", &ErrParameterNotFound{name: name}
+		}
+		return "", fmt.Errorf("get parameter %s: %w", name, err)
+	}
+	return aws.StringValue(out.Parameter.Value), nil
+}
+
+// PutSecretInput holds the fields needed to create or update a secret.
+type PutSecretInput struct {
+	Name      string
+	Value     string
+	Overwrite bool
+	Tags      map[string]string
+}
+
+// PutSecret creates a SecureString parameter, or updates its value if Overwrite is set.
+// Tags are only applied when the parameter is created since the parameter store does not
+// allow tagging a parameter while overwriting it.
+func (s *SSM) PutSecret(in PutSecretInput) error {
+	input := &ssm.PutParameterInput{
+		Name:      aws.String(in.Name),
+		Value:     aws.String(in.Value),
+		Type:      aws.String(ssm.ParameterTypeSecureString),
+		Overwrite: aws.Bool(in.Overwrite),
+	}
+	if !in.Overwrite {
+		input.Tags = convertTags(in.Tags)
+	}
+	if _, err := s.client.PutParameter(input); err != nil {
+		return fmt.Errorf("put parameter %s: %w", in.Name, err)
+	}
+	return nil
+}
+
+// PortForwardingSessionInput holds the fields needed to forward a local port to a port reachable from a target.
+type PortForwardingSessionInput struct {
+	Target     string // For example, "ecs:<cluster name>_<task ID>_<container runtime ID>".
+	RemoteHost string // Optional. Host to forward to through the target instead of the target itself, e.g. a database endpoint.
+	RemotePort int
+	LocalPort  int
+}
+
+// StartPortForwardingSession forwards the local port to the remote port until the session is terminated.
+func (s *SSM) StartPortForwardingSession(in PortForwardingSessionInput) error {
+	document := portForwardingDocument
+	params := map[string][]*string{
+		"portNumber":      aws.StringSlice([]string{strconv.Itoa(in.RemotePort)}),
+		"localPortNumber": aws.StringSlice([]string{strconv.Itoa(in.LocalPort)}),
+	}
+	if in.RemoteHost != "" {
+		document = portForwardingToRemoteHostDocument
+		params["host"] = aws.StringSlice([]string{in.RemoteHost})
+	}
+	request := &ssm.StartSessionInput{
+		DocumentName: aws.String(document),
+		Parameters:   params,
+		Target:       aws.String(in.Target),
+	}
+	out, err := s.client.StartSession(request)
+	if err != nil {
+		return fmt.Errorf("start session to %s: %w", in.Target, err)
+	}
+	sessID := aws.StringValue(out.SessionId)
+	if err := s.newSessStarter().StartPortForwardingSession(out, request); err != nil {
+		return fmt.Errorf("start session %s using ssm plugin: %w", sessID, err)
+	}
+	return nil
+}
+
+func convertTags(tags map[string]string) []*ssm.Tag {
+	var keys []string
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var out []*ssm.Tag
+	for _, k := range keys {
+		out = append(out, &ssm.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
+	return out
+}
@@ -0,0 +1,334 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSM_CreateActivation(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wanted    *Activation
+		wantedErr error
+	}{
+		"wraps the error": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateActivation(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("create activation for role phonetool-test-ExternalInstanceRole: some error"),
+		},
+		"returns the activation": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateActivation(&ssm.CreateActivationInput{
+					IamRole:           aws.String("phonetool-test-ExternalInstanceRole"),
+					RegistrationLimit: aws.Int64(5),
+				}).Return(&ssm.CreateActivationOutput{
+					ActivationId:   aws.String("id"),
+					ActivationCode: aws.String("code"),
+				}, nil)
+			},
+			wanted: &Activation{
+				ID:   "id",
+				Code: "code",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := SSM{
+				client: m,
+			}
+
+			// WHEN
+			got, err := client.CreateActivation("phonetool-test-ExternalInstanceRole", 5)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestSSM_SecretValue(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wanted    string
+		wantedErr error
+	}{
+		"returns ErrParameterNotFound if the parameter does not exist": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(gomock.Any()).Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+			},
+			wantedErr: &ErrParameterNotFound{name: "/copilot/phonetool/test/secrets/DB_PASSWORD"},
+		},
+		"wraps other errors": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get parameter /copilot/phonetool/test/secrets/DB_PASSWORD: some error"),
+		},
+		"returns the decrypted value": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(&ssm.GetParameterInput{
+					Name:           aws.String("/copilot/phonetool/test/secrets/DB_PASSWORD"),
+					WithDecryption: aws.Bool(true),
+				}).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Value: aws.String("hunter2"),
+					},
+				}, nil)
+			},
+			wanted: "hunter2",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := SSM{
+				client: m,
+			}
+
+			// WHEN
+			got, err := client.SecretValue("/copilot/phonetool/test/secrets/DB_PASSWORD")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestSSM_PutSecret(t *testing.T) {
+	testCases := map[string]struct {
+		in         PutSecretInput
+		mockClient func(m *mocks.Mockapi)
+
+		wantedErr error
+	}{
+		"creates a tagged parameter": {
+			in: PutSecretInput{
+				Name:  "/copilot/phonetool/test/secrets/DB_PASSWORD",
+				Value: "hunter2",
+				Tags: map[string]string{
+					"copilot-environment": "test",
+					"copilot-application": "phonetool",
+				},
+			},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().PutParameter(&ssm.PutParameterInput{
+					Name:      aws.String("/copilot/phonetool/test/secrets/DB_PASSWORD"),
+					Value:     aws.String("hunter2"),
+					Type:      aws.String(ssm.ParameterTypeSecureString),
+					Overwrite: aws.Bool(false),
+					Tags: []*ssm.Tag{
+						{
+							Key:   aws.String("copilot-application"),
+							Value: aws.String("phonetool"),
+						},
+						{
+							Key:   aws.String("copilot-environment"),
+							Value: aws.String("test"),
+						},
+					},
+				}).Return(&ssm.PutParameterOutput{}, nil)
+			},
+		},
+		"overwrites the parameter without tags": {
+			in: PutSecretInput{
+				Name:      "/copilot/phonetool/test/secrets/DB_PASSWORD",
+				Value:     "hunter3",
+				Overwrite: true,
+				Tags: map[string]string{
+					"copilot-application": "phonetool",
+				},
+			},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().PutParameter(&ssm.PutParameterInput{
+					Name:      aws.String("/copilot/phonetool/test/secrets/DB_PASSWORD"),
+					Value:     aws.String("hunter3"),
+					Type:      aws.String(ssm.ParameterTypeSecureString),
+					Overwrite: aws.Bool(true),
+				}).Return(&ssm.PutParameterOutput{}, nil)
+			},
+		},
+		"wraps the error": {
+			in: PutSecretInput{
+				Name:  "/copilot/phonetool/test/secrets/DB_PASSWORD",
+				Value: "hunter2",
+			},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().PutParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("put parameter /copilot/phonetool/test/secrets/DB_PASSWORD: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := SSM{
+				client: m,
+			}
+
+			// WHEN
+			err := client.PutSecret(tc.in)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSSM_StartPortForwardingSession(t *testing.T) {
+	testCases := map[string]struct {
+		input           PortForwardingSessionInput
+		mockClient      func(m *mocks.Mockapi)
+		mockSessStarter func(m *mocks.MockssmSessionStarter)
+
+		wantedErr error
+	}{
+		"wraps the error if the session can't be created": {
+			input: PortForwardingSessionInput{
+				Target:     "ecs:cluster_task_runtime",
+				RemotePort: 80,
+				LocalPort:  8080,
+			},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {},
+			wantedErr:       errors.New("start session to ecs:cluster_task_runtime: some error"),
+		},
+		"wraps the error if the plugin fails": {
+			input: PortForwardingSessionInput{
+				Target:     "ecs:cluster_task_runtime",
+				RemotePort: 80,
+				LocalPort:  8080,
+			},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(gomock.Any()).Return(&ssm.StartSessionOutput{
+					SessionId: aws.String("session"),
+				}, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartPortForwardingSession(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("start session session using ssm plugin: some error"),
+		},
+		"forwards to the target": {
+			input: PortForwardingSessionInput{
+				Target:     "ecs:cluster_task_runtime",
+				RemotePort: 80,
+				LocalPort:  8080,
+			},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(&ssm.StartSessionInput{
+					DocumentName: aws.String("AWS-StartPortForwardingSession"),
+					Parameters: map[string][]*string{
+						"portNumber":      {aws.String("80")},
+						"localPortNumber": {aws.String("8080")},
+					},
+					Target: aws.String("ecs:cluster_task_runtime"),
+				}).Return(&ssm.StartSessionOutput{
+					SessionId: aws.String("session"),
+				}, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartPortForwardingSession(&ssm.StartSessionOutput{
+					SessionId: aws.String("session"),
+				}, gomock.Any()).Return(nil)
+			},
+		},
+		"forwards to a remote host through the target": {
+			input: PortForwardingSessionInput{
+				Target:     "ecs:cluster_task_runtime",
+				RemoteHost: "mydb.cluster-abc.us-west-2.rds.amazonaws.com",
+				RemotePort: 5432,
+				LocalPort:  5432,
+			},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(&ssm.StartSessionInput{
+					DocumentName: aws.String("AWS-StartPortForwardingSessionToRemoteHost"),
+					Parameters: map[string][]*string{
+						"host":            {aws.String("mydb.cluster-abc.us-west-2.rds.amazonaws.com")},
+						"portNumber":      {aws.String("5432")},
+						"localPortNumber": {aws.String("5432")},
+					},
+					Target: aws.String("ecs:cluster_task_runtime"),
+				}).Return(&ssm.StartSessionOutput{
+					SessionId: aws.String("session"),
+				}, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartPortForwardingSession(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			mockSessStarter := mocks.NewMockssmSessionStarter(ctrl)
+			tc.mockSessStarter(mockSessStarter)
+			client := SSM{
+				client: m,
+				newSessStarter: func() ssmSessionStarter {
+					return mockSessStarter
+				},
+			}
+
+			// WHEN
+			err := client.StartPortForwardingSession(tc.input)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
+
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
@@ -33,21 +35,30 @@ const (
 )
 
 type initAppVars struct {
-	name         string
-	domainName   string
-	resourceTags map[string]string
+	name             string
+	domainName       string
+	domainRoleARN    string
+	repositoryPrefix string
+	resourceTags     map[string]string
+
+	pullThroughCaches    []string // Upstream registries to cache in ECR.
+	dockerHubCredentials string   // ARN of the secret with the Docker Hub credentials of the pull-through cache.
+
+	taskExecutionRoleARN string // Execution role shared by the tasks of all workloads.
 }
 
 type initAppOpts struct {
 	initAppVars
 
-	identity identityService
-	store    applicationStore
-	route53  domainHostedZoneGetter
-	ws       wsAppManager
-	cfn      appDeployer
-	prompt   prompter
-	prog     progress
+	identity   identityService
+	store      applicationStore
+	route53    domainHostedZoneGetter      // Client for the account that owns the domain.
+	appRoute53 hostedZoneNameServersGetter // Client for the account of the application.
+	nsUpserter nsRecordUpserter            // Client for the account that owns the domain, only used if it's a different account.
+	ws         wsAppManager
+	cfn        appDeployer
+	prompt     prompter
+	prog       progress
 
 	cachedHostedZoneID string
 }
@@ -65,12 +76,23 @@ func newInitAppOpts(vars initAppVars) (*initAppOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new workspace: %w", err)
 	}
+	appRoute53 := route53.New(sess)
+	domainRoute53 := appRoute53
+	if vars.domainRoleARN != "" {
+		domainSess, err := sessions.NewProvider().FromRole(vars.domainRoleARN, aws.StringValue(sess.Config.Region))
+		if err != nil {
+			return nil, fmt.Errorf("session from role %s: %w", vars.domainRoleARN, err)
+		}
+		domainRoute53 = route53.New(domainSess)
+	}
 
 	return &initAppOpts{
 		initAppVars: vars,
 		identity:    identity.New(sess),
 		store:       store,
-		route53:     route53.New(sess),
+		route53:     domainRoute53,
+		appRoute53:  appRoute53,
+		nsUpserter:  domainRoute53,
 		ws:          ws,
 		cfn:         cloudformation.New(sess),
 		prompt:      prompt.New(),
@@ -85,6 +107,14 @@ func (o *initAppOpts) Validate() error {
 			return err
 		}
 	}
+	if o.domainRoleARN != "" {
+		if o.domainName == "" {
+			return fmt.Errorf("--%s must be specified with --%s", domainRoleARNFlag, domainNameFlag)
+		}
+		if err := validateRoleARN(o.domainRoleARN); err != nil {
+			return fmt.Errorf("domain role ARN %s is invalid: %w", o.domainRoleARN, err)
+		}
+	}
 	if o.domainName != "" {
 		if err := validateDomainName(o.domainName); err != nil {
 			return fmt.Errorf("domain name %s is invalid: %w", o.domainName, err)
@@ -95,6 +125,19 @@ func (o *initAppOpts) Validate() error {
 		}
 		o.cachedHostedZoneID = id
 	}
+	if o.repositoryPrefix != "" {
+		if err := validateRepositoryPrefix(o.repositoryPrefix); err != nil {
+			return fmt.Errorf("repository prefix %s is invalid: %w", o.repositoryPrefix, err)
+		}
+	}
+	if err := o.validatePullThroughCaches(); err != nil {
+		return err
+	}
+	if o.taskExecutionRoleARN != "" {
+		if err := validateRoleARN(o.taskExecutionRoleARN); err != nil {
+			return fmt.Errorf("task execution role ARN %s is invalid: %w", o.taskExecutionRoleARN, err)
+		}
+	}
 	return nil
 }
 
@@ -158,6 +201,10 @@ If you'd like to delete the application and all of its resources, run %s.
 
 // Execute creates a new managed empty application.
 func (o *initAppOpts) Execute() error {
+	caches, err := o.pullThroughCacheConfigs()
+	if err != nil {
+		return err
+	}
 	caller, err := o.identity.Get()
 	if err != nil {
 		return fmt.Errorf("get identity: %w", err)
@@ -180,24 +227,82 @@ func (o *initAppOpts) Execute() error {
 		AccountID:          caller.Account,
 		DomainName:         o.domainName,
 		DomainHostedZoneID: hostedZoneID,
+		DomainRoleARN:      o.domainRoleARN,
 		AdditionalTags:     o.resourceTags,
 		Version:            deploy.LatestAppTemplateVersion,
+		RepositoryPrefix:   o.repositoryPrefix,
+		PullThroughCaches:  caches,
 	})
 	if err != nil {
 		o.prog.Stop(log.Serrorf(fmtAppInitFailed, color.HighlightUserInput(o.name)))
 		return err
 	}
+	if o.domainRoleARN != "" {
+		if err := o.delegateAppDomain(hostedZoneID); err != nil {
+			o.prog.Stop(log.Serrorf(fmtAppInitFailed, color.HighlightUserInput(o.name)))
+			return err
+		}
+	}
 	o.prog.Stop(log.Ssuccessf(fmtAppInitComplete, color.HighlightUserInput(o.name)))
 
 	return o.store.CreateApplication(&config.Application{
-		AccountID:          caller.Account,
-		Name:               o.name,
-		Domain:             o.domainName,
-		DomainHostedZoneID: hostedZoneID,
-		Tags:               o.resourceTags,
+		AccountID:            caller.Account,
+		Name:                 o.name,
+		Domain:               o.domainName,
+		DomainHostedZoneID:   hostedZoneID,
+		DomainRoleARN:        o.domainRoleARN,
+		Tags:                 o.resourceTags,
+		RepositoryPrefix:     o.repositoryPrefix,
+		PullThroughCaches:    caches,
+		TaskExecutionRoleARN: o.taskExecutionRoleARN,
 	})
 }
 
+func (o *initAppOpts) validatePullThroughCaches() error {
+	cachesDockerHub := false
+	for _, registry := range o.pullThroughCaches {
+		if err := validatePullThroughCacheRegistry(registry); err != nil {
+			return err
+		}
+		if registry == config.DockerHubRegistry {
+			cachesDockerHub = true
+		}
+	}
+	if o.dockerHubCredentials == "" {
+		if cachesDockerHub {
+			return fmt.Errorf("--%s is required to cache %s", dockerHubCredsFlag, config.DockerHubRegistry)
+		}
+		return nil
+	}
+	if !cachesDockerHub {
+		return fmt.Errorf("--%s must be specified with --%s %s", dockerHubCredsFlag, pullThroughCacheFlag, config.DockerHubRegistry)
+	}
+	if err := validatePullThroughCacheCredentials(o.dockerHubCredentials); err != nil {
+		return fmt.Errorf("%s credentials %s are invalid: %w", config.DockerHubRegistry, o.dockerHubCredentials, err)
+	}
+	return nil
+}
+
+// pullThroughCacheConfigs returns the pull-through caches of the application.
+// The application name is only known after Ask, so the length of the repository prefixes is checked here.
+func (o *initAppOpts) pullThroughCacheConfigs() ([]config.PullThroughCache, error) {
+	var caches []config.PullThroughCache
+	for _, registry := range o.pullThroughCaches {
+		cache := config.PullThroughCache{
+			Registry: registry,
+		}
+		if registry == config.DockerHubRegistry {
+			cache.CredentialARN = o.dockerHubCredentials
+		}
+		if prefix := cache.RepositoryPrefix(o.name); len(prefix) > maxPullThroughCachePrefixLength {
+			return nil, fmt.Errorf("repository prefix %s of the %s pull-through cache must be at most %d characters long, choose a shorter application name",
+				prefix, registry, maxPullThroughCachePrefixLength)
+		}
+		caches = append(caches, cache)
+	}
+	return caches, nil
+}
+
 func (o *initAppOpts) validateAppName(name string) error {
 	if err := validateAppName(name); err != nil {
 		return err
@@ -227,6 +332,21 @@ func (o *initAppOpts) domainHostedZoneID(domainName string) (string, error) {
 	return hostedZoneID, nil
 }
 
+// delegateAppDomain creates the NS record of the application subdomain in the domain hosted zone.
+// The application stack can't create it when the domain hosted zone lives in another account.
+func (o *initAppOpts) delegateAppDomain(domainHostedZoneID string) error {
+	appDomain := fmt.Sprintf("%s.%s", o.name, o.domainName)
+	appHostedZoneID, err := o.appRoute53.DomainHostedZoneID(appDomain)
+	if err != nil {
+		return fmt.Errorf("get hosted zone ID for domain %s: %w", appDomain, err)
+	}
+	nameServers, err := o.appRoute53.NameServers(appHostedZoneID)
+	if err != nil {
+		return err
+	}
+	return o.nsUpserter.UpsertNSRecord(domainHostedZoneID, appDomain, nameServers)
+}
+
 // RecommendedActions returns a list of suggested additional commands users can run after successfully executing this command.
 func (o *initAppOpts) RecommendedActions() []string {
 	return []string{
@@ -277,8 +397,14 @@ An application is a collection of containerized services that operate together.`
   /code $ copilot app init test
   Create a new application with an existing domain name in Amazon Route53.
   /code $ copilot app init --domain example.com
+  Create a new application with a domain name hosted in Amazon Route53 in another account.
+  /code $ copilot app init --domain example.com --domain-role-arn arn:aws:iam::123456789012:role/DNSAdmin
   Create a new application with resource tags.
-  /code $ copilot app init --resource-tags department=MyDept,team=MyTeam`,
+  /code $ copilot app init --resource-tags department=MyDept,team=MyTeam
+  Create a new application whose ECR repositories are named "team-a/<name>".
+  /code $ copilot app init --repository-prefix team-a
+  Create a new application that caches the images of ECR Public and Quay in ECR.
+  /code $ copilot app init --pull-through-cache ecr-public,quay`,
 		Args: reservedArgs,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitAppOpts(vars)
@@ -307,6 +433,11 @@ An application is a collection of containerized services that operate together.`
 		}),
 	}
 	cmd.Flags().StringVar(&vars.domainName, domainNameFlag, "", domainNameFlagDescription)
+	cmd.Flags().StringVar(&vars.domainRoleARN, domainRoleARNFlag, "", domainRoleARNFlagDescription)
+	cmd.Flags().StringVar(&vars.repositoryPrefix, repositoryPrefixFlag, "", repositoryPrefixFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.pullThroughCaches, pullThroughCacheFlag, nil, pullThroughCacheFlagDescription)
+	cmd.Flags().StringVar(&vars.dockerHubCredentials, dockerHubCredsFlag, "", dockerHubCredsFlagDescription)
+	cmd.Flags().StringVar(&vars.taskExecutionRoleARN, taskExecutionRoleFlag, "", appTaskExecutionRoleFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	return cmd
 }
@@ -21,10 +21,15 @@ import (
 
 func TestInitAppOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inAppName      string
-		inDomainName   string
-		mockRoute53Svc func(m *mocks.MockdomainHostedZoneGetter)
-		mockStore      func(m *mocks.Mockstore)
+		inAppName       string
+		inDomainName    string
+		inDomainRoleARN string
+		inRepoPrefix    string
+		inCaches        []string
+		inDockerHubCred string
+		inExecutionRole string
+		mockRoute53Svc  func(m *mocks.MockdomainHostedZoneGetter)
+		mockStore       func(m *mocks.Mockstore)
 
 		wantedError string
 	}{
@@ -117,6 +122,77 @@ func TestInitAppOpts_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("domain name %s is invalid: %w", "hello_website", errDomainInvalid).Error(),
 		},
+		"errors if domain role ARN is set without a domain name": {
+			inDomainRoleARN: "arn:aws:iam::123456789012:role/DNSAdmin",
+			mockRoute53Svc:  func(m *mocks.MockdomainHostedZoneGetter) {},
+			mockStore:       func(m *mocks.Mockstore) {},
+
+			wantedError: "--domain-role-arn must be specified with --domain",
+		},
+		"errors if domain role ARN is invalid": {
+			inDomainName:    "mockDomain.com",
+			inDomainRoleARN: "DNSAdmin",
+			mockRoute53Svc:  func(m *mocks.MockdomainHostedZoneGetter) {},
+			mockStore:       func(m *mocks.Mockstore) {},
+
+			wantedError: "domain role ARN DNSAdmin is invalid: value must be an IAM role ARN (example: arn:aws:iam::123456789012:role/DNSAdmin)",
+		},
+		"valid domain name with a domain role ARN": {
+			inDomainName:    "mockDomain.com",
+			inDomainRoleARN: "arn:aws:iam::123456789012:role/DNSAdmin",
+			mockRoute53Svc: func(m *mocks.MockdomainHostedZoneGetter) {
+				m.EXPECT().DomainHostedZoneID("mockDomain.com").Return("mockHostedZoneID", nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {},
+		},
+		"valid repository prefix": {
+			inRepoPrefix:   "team-a/metrics",
+			mockRoute53Svc: func(m *mocks.MockdomainHostedZoneGetter) {},
+			mockStore:      func(m *mocks.Mockstore) {},
+		},
+		"errors if repository prefix is invalid": {
+			inRepoPrefix:   "Team-A",
+			mockRoute53Svc: func(m *mocks.MockdomainHostedZoneGetter) {},
+			mockStore:      func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Sprintf("repository prefix Team-A is invalid: %s", errRepositoryPrefixBadFormat),
+		},
+		"valid pull-through caches": {
+			inCaches:        []string{"ecr-public", "docker-hub"},
+			inDockerHubCred: "arn:aws:secretsmanager:us-west-2:123456789012:secret:ecr-pullthroughcache/docker-hub-AbCdEf",
+			mockRoute53Svc:  func(m *mocks.MockdomainHostedZoneGetter) {},
+			mockStore:       func(m *mocks.Mockstore) {},
+		},
+		"errors if a pull-through cache registry is not supported": {
+			inCaches:       []string{"ghcr"},
+			mockRoute53Svc: func(m *mocks.MockdomainHostedZoneGetter) {},
+			mockStore:      func(m *mocks.Mockstore) {},
+
+			wantedError: `invalid pull-through cache registry ghcr: must be one of "ecr-public", "quay", "docker-hub"`,
+		},
+		"errors if docker-hub is cached without credentials": {
+			inCaches:       []string{"docker-hub"},
+			mockRoute53Svc: func(m *mocks.MockdomainHostedZoneGetter) {},
+			mockStore:      func(m *mocks.Mockstore) {},
+
+			wantedError: "--docker-hub-credentials is required to cache docker-hub",
+		},
+		"errors if credentials are set without caching docker-hub": {
+			inCaches:        []string{"quay"},
+			inDockerHubCred: "arn:aws:secretsmanager:us-west-2:123456789012:secret:ecr-pullthroughcache/docker-hub-AbCdEf",
+			mockRoute53Svc:  func(m *mocks.MockdomainHostedZoneGetter) {},
+			mockStore:       func(m *mocks.Mockstore) {},
+
+			wantedError: "--docker-hub-credentials must be specified with --pull-through-cache docker-hub",
+		},
+		"errors if the docker-hub credentials are not a pull-through cache secret": {
+			inCaches:        []string{"docker-hub"},
+			inDockerHubCred: "arn:aws:secretsmanager:us-west-2:123456789012:secret:docker-hub-AbCdEf",
+			mockRoute53Svc:  func(m *mocks.MockdomainHostedZoneGetter) {},
+			mockStore:       func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Sprintf("docker-hub credentials arn:aws:secretsmanager:us-west-2:123456789012:secret:docker-hub-AbCdEf are invalid: %s", errPullThroughCacheCredentialsInvalid),
+		},
 		"domain name contains multiple dots": {
 			inDomainName: "hello.dog.com",
 			mockRoute53Svc: func(m *mocks.MockdomainHostedZoneGetter) {
@@ -125,6 +201,18 @@ func TestInitAppOpts_Validate(t *testing.T) {
 			mockStore:   func(m *mocks.Mockstore) {},
 			wantedError: "",
 		},
+		"errors if task execution role ARN is invalid": {
+			inExecutionRole: "ecsTaskExecutionRole",
+			mockRoute53Svc:  func(m *mocks.MockdomainHostedZoneGetter) {},
+			mockStore:       func(m *mocks.Mockstore) {},
+
+			wantedError: "task execution role ARN ecsTaskExecutionRole is invalid: value must be an IAM role ARN (example: arn:aws:iam::123456789012:role/DNSAdmin)",
+		},
+		"valid task execution role ARN": {
+			inExecutionRole: "arn:aws:iam::123456789012:role/ecsTaskExecutionRole",
+			mockRoute53Svc:  func(m *mocks.MockdomainHostedZoneGetter) {},
+			mockStore:       func(m *mocks.Mockstore) {},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -140,8 +228,15 @@ func TestInitAppOpts_Validate(t *testing.T) {
 				route53: mockRoute53Svc,
 				store:   mockStore,
 				initAppVars: initAppVars{
-					name:       tc.inAppName,
-					domainName: tc.inDomainName,
+					name:             tc.inAppName,
+					domainName:       tc.inDomainName,
+					domainRoleARN:    tc.inDomainRoleARN,
+					repositoryPrefix: tc.inRepoPrefix,
+
+					pullThroughCaches:    tc.inCaches,
+					dockerHubCredentials: tc.inDockerHubCred,
+
+					taskExecutionRoleARN: tc.inExecutionRole,
 				},
 			}
 
@@ -287,10 +382,14 @@ func TestInitAppOpts_Execute(t *testing.T) {
 	mockError := fmt.Errorf("error")
 
 	testCases := map[string]struct {
+		inAppName            string
 		inDomainName         string
 		inDomainHostedZoneID string
+		inCaches             []string
+		inDockerHubCred      string
 
 		expectedError error
+		wantedErr     string
 		mocking       func(t *testing.T,
 			mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
 			mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
@@ -338,6 +437,57 @@ func TestInitAppOpts_Execute(t *testing.T) {
 				mockProgress.EXPECT().Stop(log.Ssuccessf(fmtAppInitComplete, "myapp"))
 			},
 		},
+		"with pull-through caches": {
+			inCaches:        []string{"quay", "docker-hub"},
+			inDockerHubCred: "arn:aws:secretsmanager:us-west-2:12345:secret:ecr-pullthroughcache/docker-hub",
+
+			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
+				mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
+				mockProgress *mocks.Mockprogress) {
+				caches := []config.PullThroughCache{
+					{
+						Registry: "quay",
+					},
+					{
+						Registry:      "docker-hub",
+						CredentialARN: "arn:aws:secretsmanager:us-west-2:12345:secret:ecr-pullthroughcache/docker-hub",
+					},
+				}
+				mockIdentityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				mockWorkspace.EXPECT().Create("myapp").Return(nil)
+				mockProgress.EXPECT().Start(gomock.Any())
+				mockDeployer.EXPECT().DeployApp(&deploy.CreateAppInput{
+					Name:      "myapp",
+					AccountID: "12345",
+					AdditionalTags: map[string]string{
+						"owner": "boss",
+					},
+					Version:           deploy.LatestAppTemplateVersion,
+					PullThroughCaches: caches,
+				}).Return(nil)
+				mockProgress.EXPECT().Stop(gomock.Any())
+				mockstore.EXPECT().CreateApplication(&config.Application{
+					AccountID: "12345",
+					Name:      "myapp",
+					Tags: map[string]string{
+						"owner": "boss",
+					},
+					PullThroughCaches: caches,
+				})
+			},
+		},
+		"should return error if the repository prefix of a pull-through cache is too long": {
+			inAppName: "a-very-long-application-name",
+			inCaches:  []string{"ecr-public"},
+
+			wantedErr: "repository prefix a-very-long-application-name-ecr-public of the ecr-public pull-through cache must be at most 30 characters long, choose a shorter application name",
+			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
+				mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
+				mockProgress *mocks.Mockprogress) {
+			},
+		},
 		"should return error from workspace.Create": {
 			expectedError: mockError,
 			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
@@ -411,13 +561,19 @@ func TestInitAppOpts_Execute(t *testing.T) {
 			mockDeployer := mocks.NewMockappDeployer(ctrl)
 			mockProgress := mocks.NewMockprogress(ctrl)
 
+			name := "myapp"
+			if tc.inAppName != "" {
+				name = tc.inAppName
+			}
 			opts := &initAppOpts{
 				initAppVars: initAppVars{
-					name:       "myapp",
+					name:       name,
 					domainName: tc.inDomainName,
 					resourceTags: map[string]string{
 						"owner": "boss",
 					},
+					pullThroughCaches:    tc.inCaches,
+					dockerHubCredentials: tc.inDockerHubCred,
 				},
 				store:              mockstore,
 				identity:           mockIdentityService,
@@ -432,7 +588,9 @@ func TestInitAppOpts_Execute(t *testing.T) {
 			err := opts.Execute()
 
 			// THEN
-			if tc.expectedError == nil {
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else if tc.expectedError == nil {
 				require.NoError(t, err)
 			} else {
 				require.True(t, errors.Is(err, tc.expectedError))
@@ -440,3 +598,109 @@ func TestInitAppOpts_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestInitAppOpts_ExecuteWithDomainRole(t *testing.T) {
+	testCases := map[string]struct {
+		mockAppRoute53 func(m *mocks.MockhostedZoneNameServersGetter)
+		mockUpserter   func(m *mocks.MocknsRecordUpserter)
+		mockStore      func(m *mocks.Mockstore)
+
+		wantedErr string
+	}{
+		"errors if the application hosted zone is not found": {
+			mockAppRoute53: func(m *mocks.MockhostedZoneNameServersGetter) {
+				m.EXPECT().DomainHostedZoneID("myapp.example.com").Return("", errors.New("some error"))
+			},
+			mockUpserter: func(m *mocks.MocknsRecordUpserter) {},
+			mockStore:    func(m *mocks.Mockstore) {},
+
+			wantedErr: "get hosted zone ID for domain myapp.example.com: some error",
+		},
+		"errors if the NS record cannot be created in the domain hosted zone": {
+			mockAppRoute53: func(m *mocks.MockhostedZoneNameServersGetter) {
+				m.EXPECT().DomainHostedZoneID("myapp.example.com").Return("appZoneID", nil)
+				m.EXPECT().NameServers("appZoneID").Return([]string{"ns-1.awsdns.com"}, nil)
+			},
+			mockUpserter: func(m *mocks.MocknsRecordUpserter) {
+				m.EXPECT().UpsertNSRecord("domainZoneID", "myapp.example.com", []string{"ns-1.awsdns.com"}).Return(errors.New("some error"))
+			},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: "some error",
+		},
+		"delegates the application subdomain and stores the role": {
+			mockAppRoute53: func(m *mocks.MockhostedZoneNameServersGetter) {
+				m.EXPECT().DomainHostedZoneID("myapp.example.com").Return("appZoneID", nil)
+				m.EXPECT().NameServers("appZoneID").Return([]string{"ns-1.awsdns.com", "ns-2.awsdns.net"}, nil)
+			},
+			mockUpserter: func(m *mocks.MocknsRecordUpserter) {
+				m.EXPECT().UpsertNSRecord("domainZoneID", "myapp.example.com", []string{"ns-1.awsdns.com", "ns-2.awsdns.net"}).Return(nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().CreateApplication(&config.Application{
+					AccountID:          "12345",
+					Name:               "myapp",
+					Domain:             "example.com",
+					DomainHostedZoneID: "domainZoneID",
+					DomainRoleARN:      "arn:aws:iam::67890:role/DNSAdmin",
+				}).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockWorkspace := mocks.NewMockwsAppManager(ctrl)
+			mockIdentityService := mocks.NewMockidentityService(ctrl)
+			mockDeployer := mocks.NewMockappDeployer(ctrl)
+			mockProgress := mocks.NewMockprogress(ctrl)
+			mockAppRoute53 := mocks.NewMockhostedZoneNameServersGetter(ctrl)
+			mockUpserter := mocks.NewMocknsRecordUpserter(ctrl)
+
+			mockIdentityService.EXPECT().Get().Return(identity.Caller{Account: "12345"}, nil)
+			mockWorkspace.EXPECT().Create("myapp").Return(nil)
+			mockProgress.EXPECT().Start(gomock.Any())
+			mockDeployer.EXPECT().DeployApp(&deploy.CreateAppInput{
+				Name:               "myapp",
+				AccountID:          "12345",
+				DomainName:         "example.com",
+				DomainHostedZoneID: "domainZoneID",
+				DomainRoleARN:      "arn:aws:iam::67890:role/DNSAdmin",
+				Version:            deploy.LatestAppTemplateVersion,
+			}).Return(nil)
+			mockProgress.EXPECT().Stop(gomock.Any())
+			tc.mockAppRoute53(mockAppRoute53)
+			tc.mockUpserter(mockUpserter)
+			tc.mockStore(mockStore)
+
+			opts := &initAppOpts{
+				initAppVars: initAppVars{
+					name:          "myapp",
+					domainName:    "example.com",
+					domainRoleARN: "arn:aws:iam::67890:role/DNSAdmin",
+				},
+				store:              mockStore,
+				identity:           mockIdentityService,
+				cfn:                mockDeployer,
+				ws:                 mockWorkspace,
+				prog:               mockProgress,
+				appRoute53:         mockAppRoute53,
+				nsUpserter:         mockUpserter,
+				cachedHostedZoneID: "domainZoneID",
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -6,13 +6,16 @@ package cli
 import (
 	"fmt"
 	"io"
+	"sort"
 
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -25,8 +28,10 @@ const (
 )
 
 type showAppVars struct {
-	name             string
-	shouldOutputJSON bool
+	name                      string
+	shouldOutputJSON          bool
+	shouldOutputResourcesJSON bool
+	format                    string
 }
 
 type showAppOpts struct {
@@ -37,6 +42,13 @@ type showAppOpts struct {
 	sel           appSelector
 	pipelineSvc   pipelineGetter
 	versionGetter versionGetter
+	resourceGroup resourceGroupURLGetter
+	deployStore   deployedEnvironmentLister
+	ws            wsTopologyReader // Nil if the command isn't run from a workspace.
+
+	newQuotaDescriber         func(env *config.Environment) (quotaDescriber, error)
+	newResourceGroupDescriber func() (resourceGroupDescriber, error)
+	newAddons                 func(wlName string) (addonResourcesGetter, error)
 }
 
 func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
@@ -52,18 +64,49 @@ func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new app describer for application %s: %v", vars.name, err)
 	}
-	return &showAppOpts{
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &showAppOpts{
 		showAppVars:   vars,
 		store:         store,
 		w:             log.OutputWriter,
 		sel:           selector.NewSelect(prompt.New(), store),
 		pipelineSvc:   codepipeline.New(defaultSession),
 		versionGetter: d,
-	}, nil
+		resourceGroup: d,
+		deployStore:   deployStore,
+		newQuotaDescriber: func(env *config.Environment) (quotaDescriber, error) {
+			return describe.NewEnvQuotaDescriber(vars.name, env)
+		},
+		newResourceGroupDescriber: func() (resourceGroupDescriber, error) {
+			return describe.NewAppResourceGroupDescriber(vars.name)
+		},
+		newAddons: func(wlName string) (addonResourcesGetter, error) {
+			return addon.New(wlName)
+		},
+	}
+	if ws, err := workspace.New(); err == nil {
+		// Dependencies and addons are read from the manifests in the workspace, if there is one.
+		opts.ws = ws
+	}
+	return opts, nil
 }
 
 // Validate returns an error if the values provided by the user are invalid.
 func (o *showAppOpts) Validate() error {
+	if o.shouldOutputJSON && o.shouldOutputResourcesJSON {
+		return fmt.Errorf("cannot specify both --%s and --%s", jsonFlag, resourcesJSONFlag)
+	}
+	if o.format != "" {
+		if o.shouldOutputJSON || o.shouldOutputResourcesJSON {
+			return fmt.Errorf("cannot specify --%s with --%s or --%s", appFormatFlag, jsonFlag, resourcesJSONFlag)
+		}
+		if !contains(o.format, describe.TopologyFormats) {
+			return fmt.Errorf(`format %q is not supported: must be one of "%s" or "%s"`, o.format, describe.TopologyFormatDOT, describe.TopologyFormatMermaid)
+		}
+	}
 	if o.name != "" {
 		_, err := o.store.GetApplication(o.name)
 		if err != nil {
@@ -85,6 +128,12 @@ func (o *showAppOpts) Ask() error {
 
 // Execute writes the application's description.
 func (o *showAppOpts) Execute() error {
+	if o.shouldOutputResourcesJSON {
+		return o.writeResourceGroup()
+	}
+	if o.format != "" {
+		return o.writeTopology()
+	}
 	description, err := o.description()
 	if err != nil {
 		return err
@@ -143,16 +192,153 @@ func (o *showAppOpts) description() (*describe.App, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get version for application %s: %w", o.name, err)
 	}
+	resourceGroup, err := o.resourceGroup.ResourceGroupURL()
+	if err != nil {
+		return nil, fmt.Errorf("get resource group for application %s: %w", o.name, err)
+	}
+	var quotas []*describe.QuotaUsage
+	for _, env := range envs {
+		d, err := o.newQuotaDescriber(env)
+		if err != nil {
+			return nil, fmt.Errorf("new quota describer for environment %s: %w", env.Name, err)
+		}
+		envQuotas, err := d.Describe()
+		if err != nil {
+			return nil, fmt.Errorf("describe quotas for environment %s: %w", env.Name, err)
+		}
+		quotas = append(quotas, envQuotas...)
+	}
 	return &describe.App{
-		Name:      app.Name,
-		Version:   version,
-		URI:       app.Domain,
-		Envs:      trimmedEnvs,
-		Services:  trimmedSvcs,
-		Pipelines: pipelines,
+		Name:          app.Name,
+		Version:       version,
+		URI:           app.Domain,
+		Envs:          trimmedEnvs,
+		Services:      trimmedSvcs,
+		Pipelines:     pipelines,
+		Quotas:        quotas,
+		ResourceGroup: resourceGroup,
 	}, nil
 }
 
+// writeResourceGroup writes the member resources of the application's resource group in JSON format.
+func (o *showAppOpts) writeResourceGroup() error {
+	d, err := o.newResourceGroupDescriber()
+	if err != nil {
+		return fmt.Errorf("new resource group describer for application %s: %w", o.name, err)
+	}
+	group, err := d.Describe()
+	if err != nil {
+		return fmt.Errorf("describe resource group of application %s: %w", o.name, err)
+	}
+	data, err := group.JSONString()
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(o.w, data)
+	return nil
+}
+
+// writeTopology writes the architecture graph of the application in the requested format.
+func (o *showAppOpts) writeTopology() error {
+	topology, err := o.topology()
+	if err != nil {
+		return err
+	}
+	graph, err := topology.Render(o.format)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(o.w, graph)
+	return nil
+}
+
+// topology returns the environments and workloads of the application along with the services deployed to each environment.
+// If the command is run from the workspace of the application, the graph also includes the service discovery references
+// between workloads and the resources declared in their addons.
+func (o *showAppOpts) topology() (*describe.Topology, error) {
+	envs, err := o.store.ListEnvironments(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.name, err)
+	}
+	wls, err := o.store.ListWorkloads(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in application %s: %w", o.name, err)
+	}
+	topology := &describe.Topology{
+		App: o.name,
+	}
+	deployedTo := make(map[string][]string)
+	for _, env := range envs {
+		topology.Envs = append(topology.Envs, env.Name)
+		svcs, err := o.deployStore.ListDeployedServices(o.name, env.Name)
+		if err != nil {
+			return nil, fmt.Errorf("list deployed services in environment %s: %w", env.Name, err)
+		}
+		for _, svc := range svcs {
+			deployedTo[svc] = append(deployedTo[svc], env.Name)
+		}
+	}
+	manifests, err := o.workspaceManifests()
+	if err != nil {
+		return nil, err
+	}
+	refs := workloadReferences(o.name, manifests)
+	for _, wl := range wls {
+		node := &describe.TopologyWorkload{
+			Name:       wl.Name,
+			Type:       wl.Type,
+			DeployedTo: deployedTo[wl.Name],
+		}
+		for ref := range refs[wl.Name] {
+			node.DependsOn = append(node.DependsOn, ref)
+		}
+		sort.Strings(node.DependsOn)
+		if _, ok := manifests[wl.Name]; ok {
+			addons, err := o.newAddons(wl.Name)
+			if err != nil {
+				return nil, fmt.Errorf("new addons for %s: %w", wl.Name, err)
+			}
+			resources, err := addons.Resources()
+			if err != nil {
+				return nil, fmt.Errorf("read addon resources of %s: %w", wl.Name, err)
+			}
+			for _, resource := range resources {
+				node.Addons = append(node.Addons, &describe.TopologyAddon{
+					LogicalID: resource.LogicalID,
+					Type:      resource.Type,
+				})
+			}
+		}
+		topology.Workloads = append(topology.Workloads, node)
+	}
+	return topology, nil
+}
+
+// workspaceManifests returns the manifest of every workload in the workspace keyed by name,
+// or nil if the command isn't run from the workspace of the application.
+func (o *showAppOpts) workspaceManifests() (map[string][]byte, error) {
+	if o.ws == nil {
+		return nil, nil
+	}
+	summary, err := o.ws.Summary()
+	if err != nil || summary.Application != o.name {
+		return nil, nil
+	}
+	names, err := o.ws.WorkloadNames()
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	manifests := make(map[string][]byte, len(names))
+	for _, name := range names {
+		mft, err := o.ws.ReadWorkloadManifest(name)
+		if err != nil {
+			return nil, fmt.Errorf("read manifest of %s: %w", name, err)
+		}
+		manifests[name] = mft
+	}
+	return manifests, nil
+}
+
 func (o *showAppOpts) askName() error {
 	if o.name != "" {
 		return nil
@@ -171,10 +357,14 @@ func buildAppShowCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show",
 		Short: "Shows info about an application.",
-		Long:  "Shows configuration, environments and services for an application.",
+		Long:  "Shows configuration, environments, services and quota usage for an application.",
 		Example: `
   Shows info about the application "my-app"
-  /code $ copilot app show -n my-app`,
+  /code $ copilot app show -n my-app
+  Lists every resource of the application "my-app" in its Resource Group for inventory tooling.
+  /code $ copilot app show -n my-app --resources-json
+  Renders the architecture graph of the application "my-app" as an image with Graphviz.
+  /code $ copilot app show -n my-app --format dot | dot -Tpng -o my-app.png`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newShowAppOpts(vars)
 			if err != nil {
@@ -195,6 +385,8 @@ func buildAppShowCmd() *cobra.Command {
 	}
 	// The flags bound by viper are available to all sub-commands through viper.GetString({flagName})
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputResourcesJSON, resourcesJSONFlag, false, appResourcesJSONFlagDescription)
+	cmd.Flags().StringVar(&vars.format, appFormatFlag, "", appFormatFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
 	return cmd
 }
@@ -9,26 +9,42 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
 type showAppMocks struct {
-	storeSvc      *mocks.Mockstore
-	sel           *mocks.MockappSelector
-	pipelineSvc   *mocks.MockpipelineGetter
-	versionGetter *mocks.MockversionGetter
+	storeSvc       *mocks.Mockstore
+	sel            *mocks.MockappSelector
+	pipelineSvc    *mocks.MockpipelineGetter
+	versionGetter  *mocks.MockversionGetter
+	quotaDescriber *mocks.MockquotaDescriber
+	resourceGroup  *mocks.MockresourceGroupURLGetter
+	groupDescriber *mocks.MockresourceGroupDescriber
+}
+
+type topologyMocks struct {
+	store       *mocks.Mockstore
+	deployStore *mocks.MockdeployedEnvironmentLister
+	ws          *mocks.MockwsTopologyReader
+	addons      *mocks.MockaddonResourcesGetter
 }
 
 func TestShowAppOpts_Validate(t *testing.T) {
 	testError := errors.New("some error")
 	testCases := map[string]struct {
-		inAppName  string
-		setupMocks func(mocks showAppMocks)
+		inAppName             string
+		inOutputJSON          bool
+		inOutputResourcesJSON bool
+		inFormat              string
+		setupMocks            func(mocks showAppMocks)
 
 		wantedError error
 	}{
@@ -51,6 +67,32 @@ func TestShowAppOpts_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("get application %s: %w", "my-app", testError),
 		},
+		"cannot output both the description and the resources in JSON": {
+			inAppName:             "my-app",
+			inOutputJSON:          true,
+			inOutputResourcesJSON: true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: errors.New("cannot specify both --json and --resources-json"),
+		},
+		"cannot output both the graph and JSON": {
+			inAppName:    "my-app",
+			inOutputJSON: true,
+			inFormat:     "dot",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: errors.New("cannot specify --format with --json or --resources-json"),
+		},
+		"unsupported graph format": {
+			inAppName: "my-app",
+			inFormat:  "png",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: errors.New(`format "png" is not supported: must be one of "dot" or "mermaid"`),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -67,7 +109,10 @@ func TestShowAppOpts_Validate(t *testing.T) {
 
 			opts := &showAppOpts{
 				showAppVars: showAppVars{
-					name: tc.inAppName,
+					name:                      tc.inAppName,
+					shouldOutputJSON:          tc.inOutputJSON,
+					shouldOutputResourcesJSON: tc.inOutputResourcesJSON,
+					format:                    tc.inFormat,
 				},
 				store: mockStoreReader,
 			}
@@ -159,7 +204,8 @@ func TestShowAppOpts_Execute(t *testing.T) {
 	testAppName := "my-app"
 	testError := errors.New("some error")
 	testCases := map[string]struct {
-		shouldOutputJSON bool
+		shouldOutputJSON          bool
+		shouldOutputResourcesJSON bool
 
 		setupMocks func(mocks showAppMocks)
 
@@ -201,6 +247,8 @@ func TestShowAppOpts_Execute(t *testing.T) {
 						{Name: "pipeline2"},
 					}, nil)
 				m.versionGetter.EXPECT().Version().Return("v0.0.0", nil)
+				m.resourceGroup.EXPECT().ResourceGroupURL().Return("", nil)
+				m.quotaDescriber.EXPECT().Describe().Return(nil, nil).Times(2)
 			},
 
 			wantedContent: "{\"name\":\"my-app\",\"version\":\"v0.0.0\",\"uri\":\"example.com\",\"environments\":[{\"app\":\"\",\"name\":\"test\",\"region\":\"us-west-2\",\"accountID\":\"123456789\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},{\"app\":\"\",\"name\":\"prod\",\"region\":\"us-west-1\",\"accountID\":\"123456789\",\"prod\":true,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"}],\"services\":[{\"app\":\"\",\"name\":\"my-svc\",\"type\":\"lb-web-svc\"}],\"pipelines\":[{\"name\":\"pipeline1\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"},{\"name\":\"pipeline2\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"}]}\n",
@@ -236,13 +284,21 @@ func TestShowAppOpts_Execute(t *testing.T) {
 						{Name: "pipeline2"},
 					}, nil)
 				m.versionGetter.EXPECT().Version().Return("v0.0.0", nil)
+				m.resourceGroup.EXPECT().ResourceGroupURL().Return("https://console.aws.amazon.com/resource-groups/group/my-app-infrastructure-roles?region=us-west-2", nil)
+				m.quotaDescriber.EXPECT().Describe().Return([]*describe.QuotaUsage{
+					{Environment: "test", Name: describe.QuotaLoadBalancerRules, Usage: 12, Limit: 100},
+				}, nil)
+				m.quotaDescriber.EXPECT().Describe().Return([]*describe.QuotaUsage{
+					{Environment: "prod", Name: describe.QuotaCloudFormationStacks, Usage: 50, Limit: 2000},
+				}, nil)
 			},
 
 			wantedContent: `About
 
   Name              my-app
-  Version           v0.0.0 (latest available: v1.0.1)
+  Version           v0.0.0 (latest available: v1.1.0)
   URI               example.com
+  Resource Group    https://console.aws.amazon.com/resource-groups/group/my-app-infrastructure-roles?region=us-west-2
 
 Environments
 
@@ -263,6 +319,13 @@ Pipelines
   ----
   pipeline1
   pipeline2
+
+Quotas
+
+  Environment       Quota                                Usage
+  -----------       -----                                -----
+  test              Rules per Application Load Balancer  12/100 (12%)
+  prod              CloudFormation stacks                50/2000 (2%)
 `,
 		},
 		"correctly shows human output with latest version": {
@@ -296,12 +359,14 @@ Pipelines
 						{Name: "pipeline2"},
 					}, nil)
 				m.versionGetter.EXPECT().Version().Return(deploy.LatestAppTemplateVersion, nil)
+				m.resourceGroup.EXPECT().ResourceGroupURL().Return("", nil)
+				m.quotaDescriber.EXPECT().Describe().Return(nil, nil).Times(2)
 			},
 
 			wantedContent: `About
 
   Name              my-app
-  Version           v1.0.1 
+  Version           v1.1.0 
   URI               example.com
 
 Environments
@@ -438,6 +503,72 @@ Pipelines
 			},
 			wantedError: fmt.Errorf("get version for application %s: %w", "my-app", testError),
 		},
+		"returns error if fail to get the resource group": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name: "my-app",
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.pipelineSvc.EXPECT().
+					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
+					Return(nil, nil)
+				m.versionGetter.EXPECT().Version().Return("v0.0.0", nil)
+				m.resourceGroup.EXPECT().ResourceGroupURL().Return("", testError)
+			},
+			wantedError: fmt.Errorf("get resource group for application %s: %w", "my-app", testError),
+		},
+		"writes the resources of the resource group in JSON": {
+			shouldOutputResourcesJSON: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.groupDescriber.EXPECT().Describe().Return(&describe.ResourceGroup{
+					Name:   "my-app-infrastructure-roles",
+					Region: "us-west-2",
+					Resources: []*describe.GroupResource{
+						{
+							ARN: "arn:aws:ecr:us-west-2:123456789:repository/my-app/my-svc",
+							Tags: map[string]string{
+								"copilot-application": "my-app",
+							},
+						},
+					},
+				}, nil)
+			},
+
+			wantedContent: "{\"name\":\"my-app-infrastructure-roles\",\"region\":\"us-west-2\",\"resources\":[{\"arn\":\"arn:aws:ecr:us-west-2:123456789:repository/my-app/my-svc\",\"tags\":{\"copilot-application\":\"my-app\"}}]}\n",
+		},
+		"returns error if fail to describe the resource group": {
+			shouldOutputResourcesJSON: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.groupDescriber.EXPECT().Describe().Return(nil, testError)
+			},
+			wantedError: fmt.Errorf("describe resource group of application %s: %w", "my-app", testError),
+		},
+		"returns error if fail to describe quotas": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:   "my-app",
+					Domain: "example.com",
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.pipelineSvc.EXPECT().
+					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
+					Return(nil, nil)
+				m.versionGetter.EXPECT().Version().Return("v0.0.0", nil)
+				m.resourceGroup.EXPECT().ResourceGroupURL().Return("", nil)
+				m.quotaDescriber.EXPECT().Describe().Return(nil, testError)
+			},
+			wantedError: fmt.Errorf("describe quotas for environment %s: %w", "test", testError),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -449,23 +580,37 @@ Pipelines
 			mockStoreReader := mocks.NewMockstore(ctrl)
 			mockPLSvc := mocks.NewMockpipelineGetter(ctrl)
 			mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+			mockQuotaDescriber := mocks.NewMockquotaDescriber(ctrl)
+			mockResourceGroup := mocks.NewMockresourceGroupURLGetter(ctrl)
+			mockGroupDescriber := mocks.NewMockresourceGroupDescriber(ctrl)
 
 			mocks := showAppMocks{
-				storeSvc:      mockStoreReader,
-				pipelineSvc:   mockPLSvc,
-				versionGetter: mockVersionGetter,
+				storeSvc:       mockStoreReader,
+				pipelineSvc:    mockPLSvc,
+				versionGetter:  mockVersionGetter,
+				quotaDescriber: mockQuotaDescriber,
+				resourceGroup:  mockResourceGroup,
+				groupDescriber: mockGroupDescriber,
 			}
 			tc.setupMocks(mocks)
 
 			opts := &showAppOpts{
 				showAppVars: showAppVars{
-					shouldOutputJSON: tc.shouldOutputJSON,
-					name:             testAppName,
+					shouldOutputJSON:          tc.shouldOutputJSON,
+					shouldOutputResourcesJSON: tc.shouldOutputResourcesJSON,
+					name:                      testAppName,
 				},
 				store:         mockStoreReader,
 				w:             b,
 				pipelineSvc:   mockPLSvc,
 				versionGetter: mockVersionGetter,
+				resourceGroup: mockResourceGroup,
+				newQuotaDescriber: func(_ *config.Environment) (quotaDescriber, error) {
+					return mockQuotaDescriber, nil
+				},
+				newResourceGroupDescriber: func() (resourceGroupDescriber, error) {
+					return mockGroupDescriber, nil
+				},
 			}
 
 			// WHEN
@@ -481,3 +626,122 @@ Pipelines
 		})
 	}
 }
+
+func TestShowAppOpts_Topology(t *testing.T) {
+	const testAppName = "my-app"
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		inFormat   string
+		setupMocks func(m topologyMocks)
+
+		wantedGraph string
+		wantedError error
+	}{
+		"wraps the error if the deployed services can't be listed": {
+			inFormat: "dot",
+			setupMocks: func(m topologyMocks) {
+				m.store.EXPECT().ListEnvironments(testAppName).Return([]*config.Environment{{Name: "test"}}, nil)
+				m.store.EXPECT().ListWorkloads(testAppName).Return(nil, nil)
+				m.deployStore.EXPECT().ListDeployedServices(testAppName, "test").Return(nil, testError)
+			},
+			wantedError: errors.New("list deployed services in environment test: some error"),
+		},
+		"does not read the workspace of another application": {
+			inFormat: "mermaid",
+			setupMocks: func(m topologyMocks) {
+				m.store.EXPECT().ListEnvironments(testAppName).Return([]*config.Environment{{Name: "test"}}, nil)
+				m.store.EXPECT().ListWorkloads(testAppName).Return([]*config.Workload{
+					{Name: "frontend", Type: "Load Balanced Web Service"},
+				}, nil)
+				m.deployStore.EXPECT().ListDeployedServices(testAppName, "test").Return([]string{"frontend"}, nil)
+				m.ws.EXPECT().Summary().Return(&workspace.Summary{Application: "other-app"}, nil)
+			},
+			wantedGraph: `graph LR
+  subgraph environments [Environments]
+    env_test(["test"])
+  end
+  wl_frontend["frontend<br/>Load Balanced Web Service"]
+  env_test -.-> wl_frontend
+`,
+		},
+		"includes the references and addons of the workloads in the workspace": {
+			inFormat: "dot",
+			setupMocks: func(m topologyMocks) {
+				m.store.EXPECT().ListEnvironments(testAppName).Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				m.store.EXPECT().ListWorkloads(testAppName).Return([]*config.Workload{
+					{Name: "api", Type: "Backend Service"},
+					{Name: "frontend", Type: "Load Balanced Web Service"},
+				}, nil)
+				m.deployStore.EXPECT().ListDeployedServices(testAppName, "test").Return([]string{"api", "frontend"}, nil)
+				m.deployStore.EXPECT().ListDeployedServices(testAppName, "prod").Return(nil, nil)
+				m.ws.EXPECT().Summary().Return(&workspace.Summary{Application: testAppName}, nil)
+				m.ws.EXPECT().WorkloadNames().Return([]string{"api", "frontend"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte("name: api"), nil)
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(`name: frontend
+variables:
+  API_URL: http://api.my-app.local:8080`), nil)
+				m.addons.EXPECT().Resources().Return([]addon.Resource{
+					{LogicalID: "OrdersTable", Type: "AWS::DynamoDB::Table"},
+				}, nil)
+				m.addons.EXPECT().Resources().Return(nil, nil)
+			},
+			wantedGraph: `digraph "my-app" {
+  rankdir=LR;
+  node [shape=box];
+  subgraph "cluster_environments" {
+    label="Environments";
+    "env:test" [label="test", shape=ellipse];
+    "env:prod" [label="prod", shape=ellipse];
+  }
+  "wl:api" [label="api\nBackend Service"];
+  "addon:api:OrdersTable" [label="OrdersTable\nAWS::DynamoDB::Table", shape=cylinder];
+  "wl:frontend" [label="frontend\nLoad Balanced Web Service"];
+  "env:test" -> "wl:api" [style=dashed];
+  "wl:api" -> "addon:api:OrdersTable";
+  "env:test" -> "wl:frontend" [style=dashed];
+  "wl:frontend" -> "wl:api";
+}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := topologyMocks{
+				store:       mocks.NewMockstore(ctrl),
+				deployStore: mocks.NewMockdeployedEnvironmentLister(ctrl),
+				ws:          mocks.NewMockwsTopologyReader(ctrl),
+				addons:      mocks.NewMockaddonResourcesGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &showAppOpts{
+				showAppVars: showAppVars{
+					name:   testAppName,
+					format: tc.inFormat,
+				},
+				store:       m.store,
+				w:           b,
+				deployStore: m.deployStore,
+				ws:          m.ws,
+				newAddons: func(_ string) (addonResourcesGetter, error) {
+					return m.addons, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedGraph, b.String())
+		})
+	}
+}
@@ -5,7 +5,10 @@ package cli
 
 import (
 	"fmt"
+	"io"
+	"os"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -25,6 +28,7 @@ const (
 	fmtAppUpgradeStart    = "Upgrading application %s from version %s to version %s."
 	fmtAppUpgradeFailed   = "Failed to upgrade application %s's template to version %s.\n"
 	fmtAppUpgradeComplete = "Upgraded application %s's template to version %s.\n"
+	fmtAppUpgradePreview  = "Upgrade application %s from version %s to version %s:\n"
 
 	appUpgradeNamePrompt     = "Which application would you like to upgrade?"
 	appUpgradeNameHelpPrompt = "An application is a collection of related services."
@@ -32,7 +36,11 @@ const (
 
 // appUpgradeVars holds flag values.
 type appUpgradeVars struct {
-	name string
+	name       string
+	dryRun     bool
+	pinVersion *bool // If nil, the pinned template version of the application is left as is.
+
+	strictVersion bool // True means an application on a newer template version fails the command instead of logging a warning.
 }
 
 // appUpgradeOpts represents the app upgrade command and holds the necessary data
@@ -46,6 +54,7 @@ type appUpgradeOpts struct {
 	sel           appSelector
 	identity      identityService
 	upgrader      appUpgrader
+	previewWriter io.Writer
 }
 
 func newAppUpgradeOpts(vars appUpgradeVars) (*appUpgradeOpts, error) {
@@ -69,11 +78,15 @@ func newAppUpgradeOpts(vars appUpgradeVars) (*appUpgradeOpts, error) {
 		sel:            selector.NewSelect(prompt.New(), store),
 		versionGetter:  d,
 		upgrader:       cloudformation.New(sess),
+		previewWriter:  os.Stdout,
 	}, nil
 }
 
 // Validate returns an error if the values provided by the user are invalid.
 func (o *appUpgradeOpts) Validate() error {
+	if o.dryRun && o.pinVersion != nil {
+		return fmt.Errorf("cannot specify both --%s and --%s", dryRunFlag, pinVersionFlag)
+	}
 	if o.name != "" {
 		_, err := o.store.GetApplication(o.name)
 		if err != nil {
@@ -98,26 +111,37 @@ func (o *appUpgradeOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("get template version of application %s: %v", o.name, err)
 	}
+	if err := handleTemplateVersionSkew(deploy.ValidateAppTemplateVersion(o.name, version), o.strictVersion); err != nil {
+		return err
+	}
 	if !shouldUpgradeApp(o.name, version) {
-		return nil
+		if o.dryRun {
+			_, err := fmt.Fprintf(o.previewWriter, "Application %s is on version %s, there are no changes to apply.\n", o.name, version)
+			return err
+		}
+		if o.pinVersion == nil {
+			return nil
+		}
+		app, err := o.store.GetApplication(o.name)
+		if err != nil {
+			return fmt.Errorf("get application %s: %w", o.name, err)
+		}
+		return o.updatePinnedVersion(app, version)
 	}
 	app, err := o.store.GetApplication(o.name)
 	if err != nil {
 		return fmt.Errorf("get application %s: %w", o.name, err)
 	}
-	o.prog.Start(fmt.Sprintf(fmtAppUpgradeStart, color.HighlightUserInput(o.name), color.Emphasize(version), color.Emphasize(deploy.LatestAppTemplateVersion)))
-	defer func() {
-		if err != nil {
-			o.prog.Stop(log.Serrorf(fmtAppUpgradeFailed, color.HighlightUserInput(o.name), color.Emphasize(deploy.LatestAppTemplateVersion)))
-			return
-		}
-		o.prog.Stop(log.Ssuccessf(fmtAppUpgradeComplete, color.HighlightUserInput(o.name), color.Emphasize(deploy.LatestAppTemplateVersion)))
-	}()
-	err = o.upgradeApplication(app, version, deploy.LatestAppTemplateVersion)
-	if err != nil {
+	if err := deploy.ValidateAppVersion(app); err != nil {
 		return err
 	}
-	return nil
+	if o.dryRun {
+		return o.writeUpgradePreview(app, version, deploy.LatestAppTemplateVersion)
+	}
+	if err := o.upgrade(app, version); err != nil {
+		return err
+	}
+	return o.updatePinnedVersion(app, deploy.LatestAppTemplateVersion)
 }
 
 func (o *appUpgradeOpts) askName() error {
@@ -151,40 +175,136 @@ Are you using the latest version of AWS Copilot?`, appName, deploy.LatestAppTemp
 	return false
 }
 
+func (o *appUpgradeOpts) upgrade(app *config.Application, version string) (err error) {
+	o.prog.Start(fmt.Sprintf(fmtAppUpgradeStart, color.HighlightUserInput(o.name), color.Emphasize(version), color.Emphasize(deploy.LatestAppTemplateVersion)))
+	defer func() {
+		if err != nil {
+			o.prog.Stop(log.Serrorf(fmtAppUpgradeFailed, color.HighlightUserInput(o.name), color.Emphasize(deploy.LatestAppTemplateVersion)))
+			return
+		}
+		o.prog.Stop(log.Ssuccessf(fmtAppUpgradeComplete, color.HighlightUserInput(o.name), color.Emphasize(deploy.LatestAppTemplateVersion)))
+	}()
+	return o.upgradeApplication(app, version, deploy.LatestAppTemplateVersion)
+}
+
 func (o *appUpgradeOpts) upgradeApplication(app *config.Application, fromVersion, toVersion string) error {
+	in, err := o.upgradeInput(app, toVersion)
+	if err != nil {
+		return err
+	}
+	if err := o.upgrader.UpgradeApplication(in); err != nil {
+		return fmt.Errorf("upgrade application %s from version %s to version %s: %v", app.Name, fromVersion, toVersion, err)
+	}
+	return nil
+}
+
+func (o *appUpgradeOpts) upgradeInput(app *config.Application, toVersion string) (*deploy.CreateAppInput, error) {
 	caller, err := o.identity.Get()
 	if err != nil {
-		return fmt.Errorf("get identity: %w", err)
+		return nil, fmt.Errorf("get identity: %w", err)
 	}
-	if err := o.upgrader.UpgradeApplication(&deploy.CreateAppInput{
+	return &deploy.CreateAppInput{
 		Name:               o.name,
 		AccountID:          caller.Account,
 		DomainName:         app.Domain,
 		DomainHostedZoneID: app.DomainHostedZoneID,
+		DomainRoleARN:      app.DomainRoleARN,
 		Version:            toVersion,
-	}); err != nil {
-		return fmt.Errorf("upgrade application %s from version %s to version %s: %v", app.Name, fromVersion, toVersion, err)
+	}, nil
+}
+
+// writeUpgradePreview writes the changes to the infrastructure roles stack and to the StackSet of the application
+// that an upgrade applies, without upgrading the application.
+func (o *appUpgradeOpts) writeUpgradePreview(app *config.Application, fromVersion, toVersion string) error {
+	in, err := o.upgradeInput(app, toVersion)
+	if err != nil {
+		return err
+	}
+	preview, err := o.upgrader.PreviewApplicationUpgrade(in)
+	if err != nil {
+		return fmt.Errorf("preview the upgrade of application %s to version %s: %v", app.Name, toVersion, err)
 	}
+	if _, err := fmt.Fprintf(o.previewWriter, fmtAppUpgradePreview, app.Name, fromVersion, toVersion); err != nil {
+		return err
+	}
+	for _, section := range []struct {
+		title         string
+		deployed, gen string
+	}{
+		{
+			title:    fmt.Sprintf("template of stack %s", preview.StackName),
+			deployed: preview.DeployedStackTemplate,
+			gen:      preview.StackTemplate,
+		},
+		{
+			title:    fmt.Sprintf("template of stack set %s", preview.StackSetName),
+			deployed: preview.DeployedStackSetTemplate,
+			gen:      preview.StackSetTemplate,
+		},
+	} {
+		if err := writeDiffSection(o.previewWriter, section.title, section.deployed, section.gen); err != nil {
+			return fmt.Errorf("compare the %s: %w", section.title, err)
+		}
+	}
+	return nil
+}
+
+// updatePinnedVersion pins the application to a template version, or unpins it, as requested with the --pin-version flag.
+// An application that is already pinned stays pinned to its latest template version.
+func (o *appUpgradeOpts) updatePinnedVersion(app *config.Application, version string) error {
+	pinned := app.PinnedVersion
+	switch {
+	case o.pinVersion != nil && !aws.BoolValue(o.pinVersion):
+		pinned = ""
+	case o.pinVersion != nil || app.PinnedVersion != "":
+		pinned = version
+	}
+	if pinned == app.PinnedVersion {
+		return nil
+	}
+	app.PinnedVersion = pinned
+	if err := o.store.UpdateApplication(app); err != nil {
+		return fmt.Errorf("update application %s: %w", app.Name, err)
+	}
+	if pinned == "" {
+		log.Successf("Unpinned the template version of application %s.\n", color.HighlightUserInput(app.Name))
+		return nil
+	}
+	log.Successf("Pinned application %s to template version %s.\n", color.HighlightUserInput(app.Name), color.Emphasize(pinned))
 	return nil
 }
 
 // buildAppUpgradeCmd builds the command to update an application to the latest version.
 func buildAppUpgradeCmd() *cobra.Command {
 	vars := appUpgradeVars{}
+	var pinVersion bool
 	cmd := &cobra.Command{
 		Use:   "upgrade",
 		Short: "Upgrades the template of an application to the latest version.",
 		Example: `
     Upgrade the application "my-app" to the latest version
-    /code $ copilot app upgrade -n my-app`,
+    /code $ copilot app upgrade -n my-app
+    Preview the changes to the infrastructure of "my-app" without upgrading it
+    /code $ copilot app upgrade -n my-app --dry-run
+    Upgrade "my-app" and prevent older versions of Copilot from updating its infrastructure
+    /code $ copilot app upgrade -n my-app --pin-version`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newAppUpgradeOpts(vars)
 			if err != nil {
 				return err
 			}
+			if cmd.Flags().Changed(pinVersionFlag) {
+				opts.pinVersion = aws.Bool(pinVersion)
+			}
+			opts.strictVersion = shouldEnforceTemplateVersion(cmd)
+			if err := opts.Validate(); err != nil {
+				return err
+			}
 			return opts.Execute()
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, appUpgradeDryRunFlagDescription)
+	cmd.Flags().BoolVar(&pinVersion, pinVersionFlag, false, pinVersionFlagDescription)
 	return cmd
 }
@@ -4,14 +4,17 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -24,11 +27,22 @@ type appUpgradeMocks struct {
 func TestAppUpgradeOpts_Validate(t *testing.T) {
 	testError := errors.New("some error")
 	testCases := map[string]struct {
-		inAppName  string
-		setupMocks func(mocks appUpgradeMocks)
+		inAppName    string
+		inDryRun     bool
+		inPinVersion *bool
+		setupMocks   func(mocks appUpgradeMocks)
 
 		wantedError error
 	}{
+		"cannot pin the version of a dry run": {
+			inAppName:    "my-app",
+			inDryRun:     true,
+			inPinVersion: aws.Bool(true),
+
+			setupMocks: func(m appUpgradeMocks) {},
+
+			wantedError: errors.New("cannot specify both --dry-run and --pin-version"),
+		},
 		"valid app name": {
 			inAppName: "my-app",
 
@@ -64,7 +78,9 @@ func TestAppUpgradeOpts_Validate(t *testing.T) {
 
 			opts := &appUpgradeOpts{
 				appUpgradeVars: appUpgradeVars{
-					name: tc.inAppName,
+					name:       tc.inAppName,
+					dryRun:     tc.inDryRun,
+					pinVersion: tc.inPinVersion,
 				},
 				store: mockStoreReader,
 			}
@@ -184,6 +200,34 @@ func TestAppUpgradeOpts_Execute(t *testing.T) {
 				}
 			},
 		},
+		"should skip upgrading if the application is on a newer template version": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+				mockVersionGetter.EXPECT().Version().Return("v99.0.0", nil)
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name: "phonetool",
+					},
+					versionGetter: mockVersionGetter,
+				}
+			},
+		},
+		"should return error if the application is on a newer template version with --strict-version": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+				mockVersionGetter.EXPECT().Version().Return("v99.0.0", nil)
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name:          "phonetool",
+						strictVersion: true,
+					},
+					versionGetter: mockVersionGetter,
+				}
+			},
+			wantedErr: fmt.Errorf("application phonetool is on template version v99.0.0, which is newer than the latest version %s supported by this Copilot CLI", deploy.LatestAppTemplateVersion),
+		},
 		"should return error if fail to get application": {
 			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
 				mockVersionGetter := mocks.NewMockversionGetter(ctrl)
@@ -260,6 +304,143 @@ func TestAppUpgradeOpts_Execute(t *testing.T) {
 			},
 			wantedErr: fmt.Errorf("upgrade application phonetool from version v0.0.0 to version %s: some error", deploy.LatestAppTemplateVersion),
 		},
+		"should return error if the application is pinned to a newer template version": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+				mockVersionGetter.EXPECT().Version().Return(deploy.LegacyAppTemplateVersion, nil)
+
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name:          "phonetool",
+					PinnedVersion: "v99.0.0",
+				}, nil)
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name: "phonetool",
+					},
+					versionGetter: mockVersionGetter,
+					store:         mockStore,
+				}
+			},
+			wantedErr: fmt.Errorf("application phonetool is pinned to template version v99.0.0, which is newer than version %s of this Copilot CLI", deploy.LatestAppTemplateVersion),
+		},
+		"should pin the application to the upgraded version": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+				mockVersionGetter.EXPECT().Version().Return(deploy.LegacyAppTemplateVersion, nil)
+
+				mockProg := mocks.NewMockprogress(ctrl)
+				mockProg.EXPECT().Start(gomock.Any())
+				mockProg.EXPECT().Stop(gomock.Any())
+
+				mockIdentity := mocks.NewMockidentityService(ctrl)
+				mockIdentity.EXPECT().Get().Return(identity.Caller{Account: "1234"}, nil)
+
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				mockStore.EXPECT().UpdateApplication(&config.Application{
+					Name:          "phonetool",
+					PinnedVersion: deploy.LatestAppTemplateVersion,
+				}).Return(nil)
+
+				mockUpgrader := mocks.NewMockappUpgrader(ctrl)
+				mockUpgrader.EXPECT().UpgradeApplication(gomock.Any()).Return(nil)
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name:       "phonetool",
+						pinVersion: aws.Bool(true),
+					},
+					versionGetter: mockVersionGetter,
+					identity:      mockIdentity,
+					store:         mockStore,
+					prog:          mockProg,
+					upgrader:      mockUpgrader,
+				}
+			},
+		},
+		"should move the pin of a pinned application to the upgraded version": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+				mockVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
+
+				mockProg := mocks.NewMockprogress(ctrl)
+				mockProg.EXPECT().Start(gomock.Any())
+				mockProg.EXPECT().Stop(gomock.Any())
+
+				mockIdentity := mocks.NewMockidentityService(ctrl)
+				mockIdentity.EXPECT().Get().Return(identity.Caller{Account: "1234"}, nil)
+
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name:          "phonetool",
+					PinnedVersion: "v1.0.0",
+				}, nil)
+				mockStore.EXPECT().UpdateApplication(&config.Application{
+					Name:          "phonetool",
+					PinnedVersion: deploy.LatestAppTemplateVersion,
+				}).Return(nil)
+
+				mockUpgrader := mocks.NewMockappUpgrader(ctrl)
+				mockUpgrader.EXPECT().UpgradeApplication(gomock.Any()).Return(nil)
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name: "phonetool",
+					},
+					versionGetter: mockVersionGetter,
+					identity:      mockIdentity,
+					store:         mockStore,
+					prog:          mockProg,
+					upgrader:      mockUpgrader,
+				}
+			},
+		},
+		"should unpin an up-to-date application": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+				mockVersionGetter.EXPECT().Version().Return(deploy.LatestAppTemplateVersion, nil)
+
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name:          "phonetool",
+					PinnedVersion: deploy.LatestAppTemplateVersion,
+				}, nil)
+				mockStore.EXPECT().UpdateApplication(&config.Application{
+					Name: "phonetool",
+				}).Return(nil)
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name:       "phonetool",
+						pinVersion: aws.Bool(false),
+					},
+					versionGetter: mockVersionGetter,
+					store:         mockStore,
+				}
+			},
+		},
+		"should return error if fail to pin an up-to-date application": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+				mockVersionGetter.EXPECT().Version().Return(deploy.LatestAppTemplateVersion, nil)
+
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				mockStore.EXPECT().UpdateApplication(gomock.Any()).Return(errors.New("some error"))
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name:       "phonetool",
+						pinVersion: aws.Bool(true),
+					},
+					versionGetter: mockVersionGetter,
+					store:         mockStore,
+				}
+			},
+			wantedErr: errors.New("update application phonetool: some error"),
+		},
 		"success": {
 			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
 				mockVersionGetter := mocks.NewMockversionGetter(ctrl)
@@ -318,3 +499,108 @@ func TestAppUpgradeOpts_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestAppUpgradeOpts_ExecuteDryRun(t *testing.T) {
+	testCases := map[string]struct {
+		inVersion  string
+		setupMocks func(m *mocks.MockappUpgrader)
+
+		wantedPreview string
+		wantedErr     error
+	}{
+		"should write that there are no changes if the application is up-to-date": {
+			inVersion:  deploy.LatestAppTemplateVersion,
+			setupMocks: func(m *mocks.MockappUpgrader) {},
+
+			wantedPreview: fmt.Sprintf("Application phonetool is on version %s, there are no changes to apply.\n", deploy.LatestAppTemplateVersion),
+		},
+		"should return error if fail to preview the upgrade": {
+			inVersion: "v1.0.0",
+			setupMocks: func(m *mocks.MockappUpgrader) {
+				m.EXPECT().PreviewApplicationUpgrade(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedErr: fmt.Errorf("preview the upgrade of application phonetool to version %s: some error", deploy.LatestAppTemplateVersion),
+		},
+		"should write the changes to the stack and the stack set without upgrading": {
+			inVersion: "v1.0.0",
+			setupMocks: func(m *mocks.MockappUpgrader) {
+				m.EXPECT().PreviewApplicationUpgrade(&deploy.CreateAppInput{
+					Name:      "phonetool",
+					AccountID: "1234",
+					Version:   deploy.LatestAppTemplateVersion,
+				}).Return(&cloudformation.AppUpgradePreview{
+					StackName: "phonetool-infrastructure-roles",
+					DeployedStackTemplate: `Resources:
+  AdministrationRole:
+    Type: AWS::IAM::Role
+`,
+					StackTemplate: `Resources:
+  AdministrationRole:
+    Type: AWS::IAM::Role
+    Properties:
+      MaxSessionDuration: 3600
+`,
+					StackSetName: "phonetool-infrastructure",
+					DeployedStackSetTemplate: `Resources:
+  KMSKey:
+    Type: AWS::KMS::Key
+`,
+					StackSetTemplate: `Resources:
+  KMSKey:
+    Type: AWS::KMS::Key
+`,
+				}, nil)
+				m.EXPECT().UpgradeApplication(gomock.Any()).Times(0)
+			},
+
+			wantedPreview: fmt.Sprintf(`Upgrade application phonetool from version v1.0.0 to version %s:
+Changes to the template of stack phonetool-infrastructure-roles:
+~ Resources:
+    ~ AdministrationRole:
+        + Properties:
+        +   MaxSessionDuration: 3600
+No changes to the template of stack set phonetool-infrastructure.
+`, deploy.LatestAppTemplateVersion),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+			mockVersionGetter.EXPECT().Version().Return(tc.inVersion, nil)
+			mockStore := mocks.NewMockstore(ctrl)
+			mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil).AnyTimes()
+			mockIdentity := mocks.NewMockidentityService(ctrl)
+			mockIdentity.EXPECT().Get().Return(identity.Caller{Account: "1234"}, nil).AnyTimes()
+			mockUpgrader := mocks.NewMockappUpgrader(ctrl)
+			tc.setupMocks(mockUpgrader)
+			buf := new(bytes.Buffer)
+			opts := &appUpgradeOpts{
+				appUpgradeVars: appUpgradeVars{
+					name:   "phonetool",
+					dryRun: true,
+				},
+				versionGetter: mockVersionGetter,
+				store:         mockStore,
+				identity:      mockIdentity,
+				upgrader:      mockUpgrader,
+				previewWriter: buf,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPreview, buf.String())
+		})
+	}
+}
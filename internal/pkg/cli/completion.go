@@ -5,13 +5,23 @@ package cli
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const (
+	bashShell = "bash"
+	zshShell  = "zsh"
 )
 
 type shellCompleter interface {
@@ -28,10 +38,10 @@ type completionOpts struct {
 
 // Validate returns an error if the shell is not "bash" or "zsh".
 func (opts *completionOpts) Validate() error {
-	if opts.Shell == "bash" {
+	if opts.Shell == bashShell {
 		return nil
 	}
-	if opts.Shell == "zsh" {
+	if opts.Shell == zshShell {
 		return nil
 	}
 	return errors.New("shell must be bash or zsh")
@@ -40,12 +50,113 @@ func (opts *completionOpts) Validate() error {
 // Execute writes the completion code to the writer.
 // This method assumes that Validate() was called prior to invocation.
 func (opts *completionOpts) Execute() error {
-	if opts.Shell == "bash" {
+	if opts.Shell == bashShell {
 		return opts.completer.GenBashCompletion(opts.w)
 	}
 	return opts.completer.GenZshCompletion(opts.w)
 }
 
+type installCompletionOpts struct {
+	shell string // Detected from the SHELL environment variable if empty.
+
+	homeDir   string
+	getenv    func(key string) string
+	fs        afero.Fs
+	completer shellCompleter
+}
+
+// Validate returns an error if the shell is not "bash" or "zsh", or if it can't be detected.
+func (o *installCompletionOpts) Validate() error {
+	if o.shell == "" {
+		o.shell = filepath.Base(o.getenv("SHELL"))
+	}
+	if o.shell != bashShell && o.shell != zshShell {
+		return fmt.Errorf("shell %q is not supported: specify bash or zsh", o.shell)
+	}
+	return nil
+}
+
+// Execute writes the completion code of the shell to the file the shell loads completions from.
+func (o *installCompletionOpts) Execute() error {
+	path := o.completionFilePath()
+	if err := o.fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("create directory for %s: %w", path, err)
+	}
+	f, err := o.fs.Create(path)
+	if err != nil {
+		return fmt.Errorf("create completion file %s: %w", path, err)
+	}
+	defer f.Close()
+
+	opts := &completionOpts{
+		Shell:     o.shell,
+		w:         f,
+		completer: o.completer,
+	}
+	if err := opts.Execute(); err != nil {
+		return fmt.Errorf("write %s completion to %s: %w", o.shell, path, err)
+	}
+	log.Successf("Wrote the %s completion to %s.\n", o.shell, color.HighlightResource(path))
+	if o.shell == zshShell {
+		log.Infof(`Add the following lines to your %s to load the completion on startup:
+  fpath=(%s $fpath)
+  autoload -U compinit && compinit
+`, color.HighlightResource("~/.zshrc"), filepath.Dir(path))
+	} else {
+		log.Infof("Restart your shell to load the completion. It requires the %s package.\n", color.HighlightResource("bash-completion@2"))
+	}
+	return nil
+}
+
+// completionFilePath returns the user-level path that the shell loads completions from.
+func (o *installCompletionOpts) completionFilePath() string {
+	if o.shell == zshShell {
+		return filepath.Join(o.homeDir, ".zsh", "completions", "_copilot")
+	}
+	dataDir := o.getenv("XDG_DATA_HOME")
+	if dataDir == "" {
+		dataDir = filepath.Join(o.homeDir, ".local", "share")
+	}
+	return filepath.Join(dataDir, "bash-completion", "completions", "copilot")
+}
+
+// buildCompletionInstallCmd returns the command to install the shell completion code for the current user.
+func buildCompletionInstallCmd(rootCmd *cobra.Command) *cobra.Command {
+	opts := &installCompletionOpts{}
+	cmd := &cobra.Command{
+		Use:   "install [shell]",
+		Short: "Install shell completion code.",
+		Long: `Install shell completion code for bash or zsh for the current user.
+If no shell is specified, it is detected from the SHELL environment variable.`,
+		Example: `
+  Install completion for the current shell
+  /code $ copilot completion install
+
+  Install zsh completion
+  /code $ copilot completion install zsh`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				opts.shell = args[0]
+			}
+			homeDir, err := os.UserHomeDir()
+			if err != nil {
+				return fmt.Errorf("get home directory: %w", err)
+			}
+			opts.homeDir = homeDir
+			opts.getenv = os.Getenv
+			opts.fs = afero.NewOsFs()
+			opts.completer = rootCmd
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
+
 // BuildCompletionCmd returns the command to output shell completion code for the specified shell (bash or zsh).
 func BuildCompletionCmd(rootCmd *cobra.Command) *cobra.Command {
 	opts := &completionOpts{}
@@ -67,7 +178,10 @@ The code must be evaluated to provide interactive completion of commands.`,
   Install bash completion on linux
   /code $ source <(copilot completion bash)
   /code $ copilot completion bash > copilot.sh
-  /code $ sudo mv copilot.sh /etc/bash_completion.d/copilot`,
+  /code $ sudo mv copilot.sh /etc/bash_completion.d/copilot
+
+  Install completion for the current user's shell
+  /code $ copilot completion install`,
 		Args: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
 				return errors.New("requires a single shell argument (bash or zsh)")
@@ -84,6 +198,7 @@ The code must be evaluated to provide interactive completion of commands.`,
 			return opts.Execute()
 		}),
 	}
+	cmd.AddCommand(buildCompletionInstallCmd(rootCmd))
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
 		"group": group.Settings,
@@ -0,0 +1,169 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+// completeFunc returns the values that the shell suggests for a flag.
+type completeFunc func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// nameCompleter suggests the names of the applications, environments, services and jobs in the SSM store.
+type nameCompleter struct {
+	newStore func() (store, error)
+}
+
+func newNameCompleter() *nameCompleter {
+	return &nameCompleter{
+		newStore: func() (store, error) {
+			return config.NewStore()
+		},
+	}
+}
+
+// RegisterCompletionFuncs registers the functions that complete the --app, --env and --name flags of the
+// commands under root with the names of existing resources.
+func RegisterCompletionFuncs(root *cobra.Command) {
+	c := newNameCompleter()
+	registerCompletionFuncs(root, c)
+}
+
+func registerCompletionFuncs(cmd *cobra.Command, c *nameCompleter) {
+	for _, child := range cmd.Commands() {
+		registerCompletionFuncs(child, c)
+	}
+	if cmd.Flags().Lookup(appFlag) != nil {
+		_ = cmd.RegisterFlagCompletionFunc(appFlag, c.apps)
+	}
+	if cmd.Flags().Lookup(envFlag) != nil {
+		_ = cmd.RegisterFlagCompletionFunc(envFlag, c.envs)
+	}
+	if cmd.Flags().Lookup(nameFlag) == nil || cmd.Name() == "init" {
+		// The name of a resource that doesn't exist yet can't be completed.
+		return
+	}
+	var fn completeFunc
+	switch {
+	case !cmd.HasParent():
+		return
+	case cmd.Name() == "deploy" && !cmd.Parent().HasParent(), cmd.Parent().Name() == "manifest":
+		fn = c.workloads
+	case cmd.Parent().Name() == "app":
+		fn = c.apps
+	case cmd.Parent().Name() == "env":
+		fn = c.envs
+	case cmd.Parent().Name() == "svc":
+		fn = c.svcs
+	case cmd.Parent().Name() == "job":
+		fn = c.jobs
+	default:
+		return
+	}
+	_ = cmd.RegisterFlagCompletionFunc(nameFlag, fn)
+}
+
+func (c *nameCompleter) apps(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return c.complete(toComplete, func(s store) ([]string, error) {
+		apps, err := s.ListApplications()
+		if err != nil {
+			return nil, fmt.Errorf("list applications: %w", err)
+		}
+		var names []string
+		for _, app := range apps {
+			names = append(names, app.Name)
+		}
+		return names, nil
+	})
+}
+
+func (c *nameCompleter) envs(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	appName := completionAppName(cmd)
+	return c.complete(toComplete, func(s store) ([]string, error) {
+		envs, err := s.ListEnvironments(appName)
+		if err != nil {
+			return nil, fmt.Errorf("list environments in application %s: %w", appName, err)
+		}
+		var names []string
+		for _, env := range envs {
+			names = append(names, env.Name)
+		}
+		return names, nil
+	})
+}
+
+func (c *nameCompleter) svcs(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	appName := completionAppName(cmd)
+	return c.complete(toComplete, func(s store) ([]string, error) {
+		svcs, err := s.ListServices(appName)
+		if err != nil {
+			return nil, fmt.Errorf("list services in application %s: %w", appName, err)
+		}
+		return workloadNames(svcs), nil
+	})
+}
+
+func (c *nameCompleter) jobs(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	appName := completionAppName(cmd)
+	return c.complete(toComplete, func(s store) ([]string, error) {
+		jobs, err := s.ListJobs(appName)
+		if err != nil {
+			return nil, fmt.Errorf("list jobs in application %s: %w", appName, err)
+		}
+		return workloadNames(jobs), nil
+	})
+}
+
+func (c *nameCompleter) workloads(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	appName := completionAppName(cmd)
+	return c.complete(toComplete, func(s store) ([]string, error) {
+		wklds, err := s.ListWorkloads(appName)
+		if err != nil {
+			return nil, fmt.Errorf("list workloads in application %s: %w", appName, err)
+		}
+		return workloadNames(wklds), nil
+	})
+}
+
+// complete returns the names listed from the store that start with toComplete.
+// Files are never suggested, and no names are suggested if they can't be listed.
+func (c *nameCompleter) complete(toComplete string, list func(s store) ([]string, error)) ([]string, cobra.ShellCompDirective) {
+	s, err := c.newStore()
+	if err != nil {
+		cobra.CompDebugln(fmt.Sprintf("connect to config store: %v", err), false)
+		return nil, cobra.ShellCompDirectiveError
+	}
+	names, err := list(s)
+	if err != nil {
+		cobra.CompDebugln(err.Error(), false)
+		return nil, cobra.ShellCompDirectiveError
+	}
+	var matches []string
+	for _, name := range names {
+		if strings.HasPrefix(name, toComplete) {
+			matches = append(matches, name)
+		}
+	}
+	return matches, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completionAppName returns the application passed to the command, or the application of the workspace.
+func completionAppName(cmd *cobra.Command) string {
+	if appName, err := cmd.Flags().GetString(appFlag); err == nil && appName != "" {
+		return appName
+	}
+	return tryReadingAppName()
+}
+
+func workloadNames(wklds []*config.Workload) []string {
+	var names []string
+	for _, wkld := range wklds {
+		names = append(names, wkld.Name)
+	}
+	return names
+}
@@ -0,0 +1,157 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNameCompleter(t *testing.T) {
+	testCases := map[string]struct {
+		complete   func(c *nameCompleter) completeFunc
+		inAppName  string
+		toComplete string
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedNames     []string
+		wantedDirective cobra.ShellCompDirective
+	}{
+		"completes application names": {
+			complete:   func(c *nameCompleter) completeFunc { return c.apps },
+			toComplete: "p",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListApplications().Return([]*config.Application{
+					{Name: "phonetool"}, {Name: "ecommerce"}, {Name: "payments"},
+				}, nil)
+			},
+			wantedNames:     []string{"phonetool", "payments"},
+			wantedDirective: cobra.ShellCompDirectiveNoFileComp,
+		},
+		"completes environment names of the application flag": {
+			complete:  func(c *nameCompleter) completeFunc { return c.envs },
+			inAppName: "phonetool",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test"}, {Name: "prod"},
+				}, nil)
+			},
+			wantedNames:     []string{"test", "prod"},
+			wantedDirective: cobra.ShellCompDirectiveNoFileComp,
+		},
+		"completes service names": {
+			complete:   func(c *nameCompleter) completeFunc { return c.svcs },
+			inAppName:  "phonetool",
+			toComplete: "fr",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListServices("phonetool").Return([]*config.Workload{
+					{Name: "frontend"}, {Name: "api"},
+				}, nil)
+			},
+			wantedNames:     []string{"frontend"},
+			wantedDirective: cobra.ShellCompDirectiveNoFileComp,
+		},
+		"completes job names": {
+			complete:  func(c *nameCompleter) completeFunc { return c.jobs },
+			inAppName: "phonetool",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListJobs("phonetool").Return([]*config.Workload{
+					{Name: "report-gen"},
+				}, nil)
+			},
+			wantedNames:     []string{"report-gen"},
+			wantedDirective: cobra.ShellCompDirectiveNoFileComp,
+		},
+		"completes workload names": {
+			complete:  func(c *nameCompleter) completeFunc { return c.workloads },
+			inAppName: "phonetool",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListWorkloads("phonetool").Return([]*config.Workload{
+					{Name: "frontend"}, {Name: "report-gen"},
+				}, nil)
+			},
+			wantedNames:     []string{"frontend", "report-gen"},
+			wantedDirective: cobra.ShellCompDirectiveNoFileComp,
+		},
+		"suggests nothing if the names can't be listed": {
+			complete:  func(c *nameCompleter) completeFunc { return c.svcs },
+			inAppName: "phonetool",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListServices("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedDirective: cobra.ShellCompDirectiveError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+			c := &nameCompleter{
+				newStore: func() (store, error) {
+					return mockStore, nil
+				},
+			}
+			cmd := &cobra.Command{}
+			cmd.Flags().String(appFlag, tc.inAppName, "")
+
+			// WHEN
+			names, directive := tc.complete(c)(cmd, nil, tc.toComplete)
+
+			// THEN
+			require.Equal(t, tc.wantedNames, names)
+			require.Equal(t, tc.wantedDirective, directive)
+		})
+	}
+}
+
+func TestRegisterCompletionFuncs(t *testing.T) {
+	// GIVEN
+	newCmd := func(use string, flags ...string) *cobra.Command {
+		cmd := &cobra.Command{Use: use, Run: func(cmd *cobra.Command, args []string) {}}
+		for _, flag := range flags {
+			cmd.Flags().String(flag, "", "")
+		}
+		return cmd
+	}
+	root := newCmd("copilot")
+	svc := newCmd("svc")
+	svcDeploy := newCmd("deploy", appFlag, envFlag, nameFlag)
+	svcInit := newCmd("init", appFlag, nameFlag)
+	svc.AddCommand(svcDeploy, svcInit)
+	env := newCmd("env")
+	envShow := newCmd("show", appFlag, nameFlag)
+	env.AddCommand(envShow)
+	deploy := newCmd("deploy", appFlag, envFlag, nameFlag)
+	mft := newCmd("manifest")
+	mftValidate := newCmd("validate", appFlag, nameFlag)
+	mft.AddCommand(mftValidate)
+	root.AddCommand(svc, env, deploy, mft)
+
+	// WHEN
+	registerCompletionFuncs(root, &nameCompleter{})
+
+	// THEN
+	completes := func(cmd *cobra.Command, flag string) bool {
+		// Registering a flag a second time fails.
+		return cmd.RegisterFlagCompletionFunc(flag, nil) != nil
+	}
+	require.True(t, completes(svcDeploy, appFlag))
+	require.True(t, completes(svcDeploy, envFlag))
+	require.True(t, completes(svcDeploy, nameFlag))
+	require.True(t, completes(svcInit, appFlag))
+	require.False(t, completes(svcInit, nameFlag), "names of new services should not be completed")
+	require.True(t, completes(envShow, nameFlag))
+	require.True(t, completes(deploy, nameFlag))
+	require.True(t, completes(mftValidate, nameFlag))
+}
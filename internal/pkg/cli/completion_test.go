@@ -5,10 +5,13 @@ package cli
 
 import (
 	"errors"
+	"fmt"
+	"io"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
 
@@ -86,3 +89,139 @@ func TestCompletionOpts_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestInstallCompletionOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputShell string
+		shellEnv   string
+
+		wantedShell string
+		wantedError error
+	}{
+		"uses the shell argument": {
+			inputShell:  "zsh",
+			shellEnv:    "/bin/bash",
+			wantedShell: "zsh",
+		},
+		"detects the shell from the environment": {
+			shellEnv:    "/usr/local/bin/bash",
+			wantedShell: "bash",
+		},
+		"errors if the shell is not supported": {
+			shellEnv:    "/usr/bin/fish",
+			wantedError: errors.New(`shell "fish" is not supported: specify bash or zsh`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := installCompletionOpts{
+				shell: tc.inputShell,
+				getenv: func(key string) string {
+					if key == "SHELL" {
+						return tc.shellEnv
+					}
+					return ""
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedShell, opts.shell)
+		})
+	}
+}
+
+func TestInstallCompletionOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inputShell  string
+		dataHomeEnv string
+		mocking     func(mock *mocks.MockshellCompleter)
+
+		wantedPath  string
+		wantedError error
+	}{
+		"writes the bash completion to the default data directory": {
+			inputShell: "bash",
+			mocking: func(mock *mocks.MockshellCompleter) {
+				mock.EXPECT().GenBashCompletion(gomock.Any()).DoAndReturn(func(w io.Writer) error {
+					_, err := fmt.Fprint(w, "bash completion")
+					return err
+				})
+			},
+			wantedPath: "/home/user/.local/share/bash-completion/completions/copilot",
+		},
+		"writes the bash completion to XDG_DATA_HOME if set": {
+			inputShell:  "bash",
+			dataHomeEnv: "/data",
+			mocking: func(mock *mocks.MockshellCompleter) {
+				mock.EXPECT().GenBashCompletion(gomock.Any()).DoAndReturn(func(w io.Writer) error {
+					_, err := fmt.Fprint(w, "bash completion")
+					return err
+				})
+			},
+			wantedPath: "/data/bash-completion/completions/copilot",
+		},
+		"writes the zsh completion": {
+			inputShell: "zsh",
+			mocking: func(mock *mocks.MockshellCompleter) {
+				mock.EXPECT().GenZshCompletion(gomock.Any()).DoAndReturn(func(w io.Writer) error {
+					_, err := fmt.Fprint(w, "zsh completion")
+					return err
+				})
+			},
+			wantedPath: "/home/user/.zsh/completions/_copilot",
+		},
+		"wraps the error if the completion can't be generated": {
+			inputShell: "zsh",
+			mocking: func(mock *mocks.MockshellCompleter) {
+				mock.EXPECT().GenZshCompletion(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("write zsh completion to /home/user/.zsh/completions/_copilot: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mock := mocks.NewMockshellCompleter(ctrl)
+			tc.mocking(mock)
+			fs := afero.NewMemMapFs()
+			opts := installCompletionOpts{
+				shell:   tc.inputShell,
+				homeDir: "/home/user",
+				getenv: func(key string) string {
+					if key == "XDG_DATA_HOME" {
+						return tc.dataHomeEnv
+					}
+					return ""
+				},
+				fs:        fs,
+				completer: mock,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			content, err := afero.ReadFile(fs, tc.wantedPath)
+			require.NoError(t, err)
+			require.Equal(t, fmt.Sprintf("%s completion", tc.inputShell), string(content))
+		})
+	}
+}
@@ -4,20 +4,28 @@
 package cli
 
 import (
+	"context"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/orchestrator"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
@@ -26,22 +34,33 @@ const (
 	jobWkldType = "job"
 )
 
-type deployOpts struct {
+type deployVars struct {
 	deployWkldVars
 
+	all bool // True if every workload in the workspace should be deployed.
+}
+
+type deployOpts struct {
+	deployVars
+
 	deployWkld     actionCommand
 	setupDeployCmd func(*deployOpts, string)
 
-	sel    wsSelector
-	store  store
-	ws     wsWlDirReader
-	prompt prompter
+	// Used by --all to deploy multiple workloads concurrently.
+	newWkldCmd       func(vars deployWkldVars, workloadType string, spinner progress) actionCommand
+	newEnvUpgradeCmd func(appName, envName string) (actionCommand, error)
+
+	sel         wsSelector
+	store       store
+	ws          wsWlDirReader
+	prompt      prompter
+	targetGuard targetConfirmer
 
 	// values for logging
 	wlType string
 }
 
-func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
+func newDeployOpts(vars deployVars) (*deployOpts, error) {
 	store, err := config.NewStore()
 	if err != nil {
 		return nil, fmt.Errorf("new config store: %w", err)
@@ -51,48 +70,79 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new workspace: %w", err)
 	}
+	defaultSess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, err
+	}
 	prompter := prompt.New()
-	return &deployOpts{
-		deployWkldVars: vars,
-		store:          store,
-		sel:            selector.NewWorkspaceSelect(prompter, store, ws),
-		ws:             ws,
-		prompt:         prompter,
+	guard, err := newTargetGuard(defaultSess, prompter)
+	if err != nil {
+		return nil, err
+	}
+	opts := &deployOpts{
+		deployVars:  vars,
+		store:       store,
+		targetGuard: guard,
+		sel:         selector.NewWorkspaceSelect(prompter, store, ws),
+		ws:          ws,
+		prompt:      prompter,
 
 		setupDeployCmd: func(o *deployOpts, workloadType string) {
-			switch {
-			case contains(workloadType, manifest.JobTypes):
-				o.deployWkld = &deployJobOpts{
-					deployWkldVars: o.deployWkldVars,
-
-					store:        o.store,
-					ws:           o.ws,
-					unmarshal:    manifest.UnmarshalWorkload,
-					spinner:      termprogress.NewSpinner(log.DiagnosticWriter),
-					sel:          selector.NewWorkspaceSelect(o.prompt, o.store, o.ws),
-					prompt:       o.prompt,
-					cmd:          command.New(),
-					sessProvider: sessions.NewProvider(),
-				}
-			case contains(workloadType, manifest.ServiceTypes):
-				o.deployWkld = &deploySvcOpts{
-					deployWkldVars: o.deployWkldVars,
-
-					store:        o.store,
-					ws:           o.ws,
-					unmarshal:    manifest.UnmarshalWorkload,
-					spinner:      termprogress.NewSpinner(log.DiagnosticWriter),
-					sel:          selector.NewWorkspaceSelect(o.prompt, o.store, o.ws),
-					prompt:       o.prompt,
-					cmd:          command.New(),
-					sessProvider: sessions.NewProvider(),
-				}
-			}
+			o.deployWkld = o.newWkldCmd(o.deployWkldVars, workloadType, termprogress.NewSpinner(log.DiagnosticWriter))
 		},
-	}, nil
+		newEnvUpgradeCmd: func(appName, envName string) (actionCommand, error) {
+			return newEnvUpgradeOpts(envUpgradeVars{
+				appName:         appName,
+				name:            envName,
+				strictVersion:   vars.strictVersion,
+				targetConfirmed: true, // The target was confirmed once for all the deployments.
+			})
+		},
+	}
+	opts.newWkldCmd = func(vars deployWkldVars, workloadType string, spinner progress) actionCommand {
+		switch {
+		case contains(workloadType, manifest.JobTypes):
+			return &deployJobOpts{
+				deployWkldVars: vars,
+
+				store:        opts.store,
+				targetGuard:  opts.targetGuard,
+				ws:           opts.ws,
+				fs:           afero.NewOsFs(),
+				unmarshal:    manifest.UnmarshalWorkload,
+				spinner:      spinner,
+				sel:          selector.NewWorkspaceSelect(opts.prompt, opts.store, opts.ws),
+				prompt:       opts.prompt,
+				w:            log.OutputWriter,
+				cmd:          command.New(),
+				sessProvider: sessions.NewProvider(),
+			}
+		case contains(workloadType, manifest.ServiceTypes):
+			return &deploySvcOpts{
+				deployWkldVars: vars,
+
+				store:        opts.store,
+				targetGuard:  opts.targetGuard,
+				ws:           opts.ws,
+				fs:           afero.NewOsFs(),
+				unmarshal:    manifest.UnmarshalWorkload,
+				spinner:      spinner,
+				sel:          selector.NewWorkspaceSelect(opts.prompt, opts.store, opts.ws),
+				prompt:       opts.prompt,
+				w:            log.OutputWriter,
+				cmd:          command.New(),
+				sessProvider: sessions.NewProvider(),
+			}
+		}
+		return nil
+	}
+	return opts, nil
 }
 
 func (o *deployOpts) Run() error {
+	if o.all {
+		return o.runAll()
+	}
 	if err := o.askName(); err != nil {
 		return err
 	}
@@ -144,9 +194,189 @@ func (o *deployOpts) loadWkldCmd() error {
 	return nil
 }
 
+// runAll deploys every workload in the workspace to a single environment.
+// The environment is upgraded first, and then each workload is deployed as soon as the workloads that it references are deployed.
+func (o *deployOpts) runAll() error {
+	if o.name != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s", allFlag, nameFlag)
+	}
+	if o.forceDeploy {
+		// A forced deployment is a break-glass option for a single stuck service.
+		return fmt.Errorf("cannot specify both --%s and --%s", allFlag, forceFlag)
+	}
+	if err := o.askEnvName(); err != nil {
+		return err
+	}
+	env, err := targetEnv(o.store, o.appName, o.envName)
+	if err != nil {
+		return err
+	}
+	if err := o.targetGuard.Confirm(env, o.skipConfirmation); err != nil {
+		return err
+	}
+	g, err := o.deploymentGraph(env.Name)
+	if err != nil {
+		return err
+	}
+	return orchestrator.New(g).Run(context.Background())
+}
+
+func (o *deployOpts) askEnvName() error {
+	if o.envName != "" {
+		return nil
+	}
+	name, err := o.sel.Environment("Select an environment", "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = name
+	return nil
+}
+
+// deploymentGraph returns the tasks that upgrade the environment and deploy every workload in the workspace to it.
+// The deployments of all the workloads are validated before any task runs.
+func (o *deployOpts) deploymentGraph(env string) (*orchestrator.Graph, error) {
+	manifests, err := o.workloadManifests()
+	if err != nil {
+		return nil, err
+	}
+	refs := workloadReferences(o.appName, manifests)
+
+	g := orchestrator.NewGraph()
+	// Upgrade the environment once so that the concurrent deployments don't race to upgrade it.
+	upgradeCmd, err := o.newEnvUpgradeCmd(o.appName, env)
+	if err != nil {
+		return nil, fmt.Errorf("new env upgrade command: %v", err)
+	}
+	if err := g.AddEnv(env, orchestrator.Task{
+		Description: fmt.Sprintf("Upgrade environment %s", env),
+		Run: func(ctx context.Context) error {
+			if err := upgradeCmd.Execute(); err != nil {
+				return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, env, err)
+			}
+			return nil
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	// A task can only depend on tasks already in the graph, so workloads are added after the workloads that they reference.
+	added := make(map[string]bool, len(refs))
+	for len(added) < len(refs) {
+		var next []string
+		for name, wlRefs := range refs {
+			if !added[name] && allAdded(wlRefs, added) {
+				next = append(next, name)
+			}
+		}
+		if len(next) == 0 {
+			var cycle []string
+			for name := range refs {
+				if !added[name] {
+					cycle = append(cycle, name)
+				}
+			}
+			sort.Strings(cycle)
+			return nil, fmt.Errorf("cannot order the deployments of %s because they reference each other", english.WordSeries(cycle, "and"))
+		}
+		sort.Strings(next)
+		for _, name := range next {
+			task, err := o.deployWkldTask(name)
+			if err != nil {
+				return nil, err
+			}
+			var dependsOn []string
+			for ref := range refs[name] {
+				dependsOn = append(dependsOn, orchestrator.WorkloadID(env, ref))
+			}
+			sort.Strings(dependsOn)
+			if err := g.AddWorkload(env, name, task, dependsOn...); err != nil {
+				return nil, err
+			}
+			added[name] = true
+		}
+	}
+	return g, nil
+}
+
+// workloadManifests returns the manifest of every workload in the workspace by workload name.
+func (o *deployOpts) workloadManifests() (map[string][]byte, error) {
+	names, err := o.ws.WorkloadNames()
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no workloads found in the workspace")
+	}
+	manifests := make(map[string][]byte, len(names))
+	for _, name := range names {
+		mft, err := o.ws.ReadWorkloadManifest(name)
+		if err != nil {
+			return nil, err
+		}
+		manifests[name] = mft
+	}
+	return manifests, nil
+}
+
+// deployWkldTask returns the task that deploys the workload to the environment once its deployment is validated.
+func (o *deployOpts) deployWkldTask(name string) (orchestrator.Task, error) {
+	wl, err := o.store.GetWorkload(o.appName, name)
+	if err != nil {
+		return orchestrator.Task{}, fmt.Errorf("retrieve %s from application %s: %w", name, o.appName, err)
+	}
+	vars := o.deployWkldVars
+	vars.name = name
+	vars.targetConfirmed = true // The target was confirmed and recorded once for all the workloads.
+	cmd := o.newWkldCmd(vars, wl.Type, termprogress.NewPlainSpinner(log.DiagnosticWriter))
+	if err := cmd.Validate(); err != nil {
+		return orchestrator.Task{}, fmt.Errorf("validate %s deploy: %w", name, err)
+	}
+	return orchestrator.Task{
+		Description: fmt.Sprintf("Deploy %s", name),
+		Run: func(ctx context.Context) error {
+			log.Infof("Deploying %s to environment %s.\n", color.HighlightUserInput(name), color.HighlightUserInput(o.envName))
+			if err := cmd.Execute(); err != nil {
+				return fmt.Errorf("execute %s deploy: %w", name, err)
+			}
+			return nil
+		},
+	}, nil
+}
+
+// workloadReferences returns, for each workload, the set of other workloads that it references.
+// A workload references another one if its manifest contains the service discovery endpoint "{name}.{app}.local" of the other workload.
+func workloadReferences(app string, manifests map[string][]byte) map[string]map[string]bool {
+	refs := make(map[string]map[string]bool, len(manifests))
+	for name, mft := range manifests {
+		refs[name] = make(map[string]bool)
+		for other := range manifests {
+			if other == name {
+				continue
+			}
+			endpoint := regexp.MustCompile(fmt.Sprintf(`(^|[^a-z0-9-])%s($|[^a-z0-9-])`, regexp.QuoteMeta(fmt.Sprintf("%s.%s.local", other, app))))
+			if endpoint.Match(mft) {
+				refs[name][other] = true
+			}
+		}
+	}
+	return refs
+}
+
+// allAdded returns true if every workload of names is in added.
+func allAdded(names map[string]bool, added map[string]bool) bool {
+	for name := range names {
+		if !added[name] {
+			return false
+		}
+	}
+	return true
+}
+
 // BuildDeployCmd is the deploy command.
 func BuildDeployCmd() *cobra.Command {
-	vars := deployWkldVars{}
+	vars := deployVars{}
+	var forceDesiredCount int
 	cmd := &cobra.Command{
 		Use:   "deploy",
 		Short: "Deploy a Copilot job or service.",
@@ -155,8 +385,23 @@ func BuildDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot deploy --name frontend --env test
   Deploys a job named "mailer" with additional resource tags to a "prod" environment.
-  /code $ copilot deploy -n mailer -e prod --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot deploy -n mailer -e prod --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Cancels a stuck deployment of the "frontend" service, and deploys it with 1 task without waiting for it to stabilize.
+  /code $ copilot deploy --name frontend --env prod --force --force-desired-count 1
+  Deploys every service and job in the workspace to a "test" environment.
+  /code $ copilot deploy --all --env test`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if vars.all {
+				// Concurrent deployments write their progress one line at a time so that they can share the terminal.
+				if err := termprogress.SetMode(string(termprogress.PlainMode)); err != nil {
+					return err
+				}
+			}
+			vars.shouldOutputJSON = shouldOutputJSON(cmd)
+			vars.strictVersion = shouldEnforceTemplateVersion(cmd)
+			if cmd.Flags().Changed(forceDesiredCountFlag) {
+				vars.forceDesiredCount = aws.Int(forceDesiredCount)
+			}
 			opts, err := newDeployOpts(vars)
 			if err != nil {
 				return err
@@ -172,6 +417,10 @@ func BuildDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.forceDeploy, forceFlag, false, forceDeployFlagDescription)
+	cmd.Flags().IntVar(&forceDesiredCount, forceDesiredCountFlag, 0, forceDesiredCountFlagDescription)
+	cmd.Flags().BoolVar(&vars.all, allFlag, false, deployAllFlagDescription)
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -140,10 +140,12 @@ func TestDeployOpts_Run(t *testing.T) {
 			tc.mockSel(mockSel)
 			tc.mockActionCommand(mockCmd)
 			opts := &deployOpts{
-				deployWkldVars: deployWkldVars{
-					appName: tc.inAppName,
-					name:    tc.inName,
-					envName: "test",
+				deployVars: deployVars{
+					deployWkldVars: deployWkldVars{
+						appName: tc.inAppName,
+						name:    tc.inName,
+						envName: "test",
+					},
 				},
 				deployWkld: mockCmd,
 				sel:        mockSel,
@@ -162,3 +164,345 @@ func TestDeployOpts_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestDeployOpts_RunAll(t *testing.T) {
+	mockEnv := &config.Environment{
+		App:            "app",
+		Name:           "test",
+		Region:         "us-west-2",
+		ManagerRoleARN: "arn:aws:iam::123456789012:role/app-test-EnvManagerRole",
+	}
+	mockManifests := map[string]string{
+		"api":      "name: api",
+		"frontend": "variables:\n  API_URL: http://api.app.local:8080",
+		"mailer":   "name: mailer",
+	}
+	testCases := map[string]struct {
+		inName    string
+		inEnvName string
+		inForce   bool
+
+		mockSel        func(m *mocks.MockwsSelector)
+		mockStore      func(m *mocks.Mockstore)
+		mockTargets    func(m *mocks.MocktargetConfirmer)
+		mockWs         func(m *mocks.MockwsWlDirReader)
+		mockEnvUpgrade func(m *mocks.MockactionCommand)
+		mockWkldCmd    func(m *mocks.MockactionCommand)
+		wantedDeployed []string
+		wantedErr      string
+	}{
+		"cannot specify a workload name": {
+			inName:    "api",
+			inEnvName: "test",
+
+			mockSel:        func(m *mocks.MockwsSelector) {},
+			mockStore:      func(m *mocks.Mockstore) {},
+			mockTargets:    func(m *mocks.MocktargetConfirmer) {},
+			mockWs:         func(m *mocks.MockwsWlDirReader) {},
+			mockEnvUpgrade: func(m *mocks.MockactionCommand) {},
+			mockWkldCmd:    func(m *mocks.MockactionCommand) {},
+			wantedErr:      "cannot specify both --all and --name",
+		},
+		"cannot force deploy every workload": {
+			inEnvName: "test",
+			inForce:   true,
+
+			mockSel:        func(m *mocks.MockwsSelector) {},
+			mockStore:      func(m *mocks.Mockstore) {},
+			mockTargets:    func(m *mocks.MocktargetConfirmer) {},
+			mockWs:         func(m *mocks.MockwsWlDirReader) {},
+			mockEnvUpgrade: func(m *mocks.MockactionCommand) {},
+			mockWkldCmd:    func(m *mocks.MockactionCommand) {},
+			wantedErr:      "cannot specify both --all and --force",
+		},
+		"returns an error if workloads reference each other": {
+			inEnvName: "test",
+
+			mockSel: func(m *mocks.MockwsSelector) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("app", "test").Return(mockEnv, nil)
+			},
+			mockTargets: func(m *mocks.MocktargetConfirmer) {
+				m.EXPECT().Confirm(mockEnv, false).Return(nil)
+			},
+			mockWs: func(m *mocks.MockwsWlDirReader) {
+				m.EXPECT().WorkloadNames().Return([]string{"api", "frontend"}, nil)
+				m.EXPECT().ReadWorkloadManifest("api").Return([]byte("URL: frontend.app.local"), nil)
+				m.EXPECT().ReadWorkloadManifest("frontend").Return([]byte("URL: api.app.local"), nil)
+			},
+			mockEnvUpgrade: func(m *mocks.MockactionCommand) {},
+			mockWkldCmd:    func(m *mocks.MockactionCommand) {},
+			wantedErr:      "cannot order the deployments of api and frontend because they reference each other",
+		},
+		"returns an error if a deployment is invalid": {
+			inEnvName: "test",
+
+			mockSel: func(m *mocks.MockwsSelector) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("app", "test").Return(mockEnv, nil)
+				m.EXPECT().GetWorkload("app", "api").Return(&config.Workload{Name: "api", Type: "Backend Service"}, nil)
+			},
+			mockTargets: func(m *mocks.MocktargetConfirmer) {
+				m.EXPECT().Confirm(mockEnv, false).Return(nil)
+			},
+			mockWs: func(m *mocks.MockwsWlDirReader) {
+				m.EXPECT().WorkloadNames().Return([]string{"api"}, nil)
+				m.EXPECT().ReadWorkloadManifest("api").Return([]byte("name: api"), nil)
+			},
+			mockEnvUpgrade: func(m *mocks.MockactionCommand) {
+				m.EXPECT().Execute().Times(0)
+			},
+			mockWkldCmd: func(m *mocks.MockactionCommand) {
+				m.EXPECT().Validate().Return(errors.New("some error"))
+			},
+			wantedErr: "validate api deploy: some error",
+		},
+		"returns an error if the environment can't be upgraded": {
+			inEnvName: "test",
+
+			mockSel: func(m *mocks.MockwsSelector) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("app", "test").Return(mockEnv, nil)
+				m.EXPECT().GetWorkload("app", "api").Return(&config.Workload{Name: "api", Type: "Backend Service"}, nil)
+			},
+			mockTargets: func(m *mocks.MocktargetConfirmer) {
+				m.EXPECT().Confirm(mockEnv, false).Return(nil)
+			},
+			mockWs: func(m *mocks.MockwsWlDirReader) {
+				m.EXPECT().WorkloadNames().Return([]string{"api"}, nil)
+				m.EXPECT().ReadWorkloadManifest("api").Return([]byte("name: api"), nil)
+			},
+			mockEnvUpgrade: func(m *mocks.MockactionCommand) {
+				m.EXPECT().Execute().Return(errors.New("some error"))
+			},
+			mockWkldCmd: func(m *mocks.MockactionCommand) {
+				m.EXPECT().Validate().Return(nil)
+				m.EXPECT().Execute().Times(0)
+			},
+			wantedErr: `task env/test: execute "env upgrade --app app --name test": some error`,
+		},
+		"skips the workloads that reference a failed deployment": {
+			inEnvName: "test",
+
+			mockSel: func(m *mocks.MockwsSelector) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("app", "test").Return(mockEnv, nil)
+				m.EXPECT().GetWorkload("app", "api").Return(&config.Workload{Name: "api", Type: "Backend Service"}, nil)
+				m.EXPECT().GetWorkload("app", "frontend").Return(&config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}, nil)
+			},
+			mockTargets: func(m *mocks.MocktargetConfirmer) {
+				m.EXPECT().Confirm(mockEnv, false).Return(nil)
+			},
+			mockWs: func(m *mocks.MockwsWlDirReader) {
+				m.EXPECT().WorkloadNames().Return([]string{"api", "frontend"}, nil)
+				for name, mft := range mockManifests {
+					if name == "mailer" {
+						continue
+					}
+					m.EXPECT().ReadWorkloadManifest(name).Return([]byte(mft), nil)
+				}
+			},
+			mockEnvUpgrade: func(m *mocks.MockactionCommand) {
+				m.EXPECT().Execute().Return(nil)
+			},
+			mockWkldCmd: func(m *mocks.MockactionCommand) {
+				m.EXPECT().Validate().Return(nil).Times(2)
+				m.EXPECT().Execute().Return(errors.New("some error"))
+			},
+			wantedErr: "task env/test/workload/api: execute api deploy: some error",
+		},
+		"prompts for the environment and deploys workloads in dependency order": {
+			mockSel: func(m *mocks.MockwsSelector) {
+				m.EXPECT().Environment("Select an environment", "", "app").Return("test", nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("app", "test").Return(mockEnv, nil)
+				m.EXPECT().GetWorkload("app", "api").Return(&config.Workload{Name: "api", Type: "Backend Service"}, nil)
+				m.EXPECT().GetWorkload("app", "mailer").Return(&config.Workload{Name: "mailer", Type: "Scheduled Job"}, nil)
+				m.EXPECT().GetWorkload("app", "frontend").Return(&config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}, nil)
+			},
+			mockTargets: func(m *mocks.MocktargetConfirmer) {
+				m.EXPECT().Confirm(mockEnv, false).Return(nil)
+			},
+			mockWs: func(m *mocks.MockwsWlDirReader) {
+				m.EXPECT().WorkloadNames().Return([]string{"api", "frontend", "mailer"}, nil)
+				for name, mft := range mockManifests {
+					m.EXPECT().ReadWorkloadManifest(name).Return([]byte(mft), nil)
+				}
+			},
+			mockEnvUpgrade: func(m *mocks.MockactionCommand) {
+				m.EXPECT().Execute().Return(nil)
+			},
+			mockWkldCmd: func(m *mocks.MockactionCommand) {
+				m.EXPECT().Validate().Return(nil).Times(3)
+				m.EXPECT().Execute().Return(nil).Times(3)
+			},
+			wantedDeployed: []string{"api", "mailer", "frontend"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockwsSelector(ctrl)
+			mockStore := mocks.NewMockstore(ctrl)
+			mockTargets := mocks.NewMocktargetConfirmer(ctrl)
+			mockWs := mocks.NewMockwsWlDirReader(ctrl)
+			mockEnvUpgrade := mocks.NewMockactionCommand(ctrl)
+			mockWkldCmd := mocks.NewMockactionCommand(ctrl)
+			tc.mockSel(mockSel)
+			tc.mockStore(mockStore)
+			tc.mockTargets(mockTargets)
+			tc.mockWs(mockWs)
+			tc.mockEnvUpgrade(mockEnvUpgrade)
+			tc.mockWkldCmd(mockWkldCmd)
+
+			var deployed []string
+			opts := &deployOpts{
+				deployVars: deployVars{
+					deployWkldVars: deployWkldVars{
+						appName: "app",
+						name:    tc.inName,
+						envName: tc.inEnvName,
+
+						forceDeploy: tc.inForce,
+					},
+					all: true,
+				},
+				sel:         mockSel,
+				store:       mockStore,
+				targetGuard: mockTargets,
+				ws:          mockWs,
+
+				newWkldCmd: func(vars deployWkldVars, workloadType string, spinner progress) actionCommand {
+					require.True(t, vars.targetConfirmed)
+					require.Equal(t, "test", vars.envName)
+					deployed = append(deployed, vars.name)
+					return mockWkldCmd
+				},
+				newEnvUpgradeCmd: func(appName, envName string) (actionCommand, error) {
+					return mockEnvUpgrade, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Run()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDeployed, deployed)
+		})
+	}
+}
+
+func TestDeployOpts_deploymentGraph(t *testing.T) {
+	testCases := map[string]struct {
+		inManifests map[string]string
+
+		wantedDeps map[string][]string
+		wantedErr  string
+	}{
+		"deploys workloads without references once the environment is upgraded": {
+			inManifests: map[string]string{
+				"frontend": "name: frontend",
+				"api":      "name: api",
+			},
+			wantedDeps: map[string][]string{
+				"env/test":                   nil,
+				"env/test/workload/api":      {"env/test"},
+				"env/test/workload/frontend": {"env/test"},
+			},
+		},
+		"deploys referenced workloads first": {
+			inManifests: map[string]string{
+				"frontend": "variables:\n  API: http://api.app.local:8080\n  CART: cart.app.local",
+				"api":      "variables:\n  DB: db.app.local",
+				"cart":     "name: cart",
+				"db":       "name: db",
+			},
+			wantedDeps: map[string][]string{
+				"env/test/workload/cart":     {"env/test"},
+				"env/test/workload/db":       {"env/test"},
+				"env/test/workload/api":      {"env/test", "env/test/workload/db"},
+				"env/test/workload/frontend": {"env/test", "env/test/workload/api", "env/test/workload/cart"},
+			},
+		},
+		"ignores endpoints that only end with a workload name": {
+			inManifests: map[string]string{
+				"api":    "name: api",
+				"web":    "variables:\n  URL: http://myapi.app.local",
+				"worker": "variables:\n  URL: api.app.localhost",
+			},
+			wantedDeps: map[string][]string{
+				"env/test/workload/api":    {"env/test"},
+				"env/test/workload/web":    {"env/test"},
+				"env/test/workload/worker": {"env/test"},
+			},
+		},
+		"returns an error on a cycle": {
+			inManifests: map[string]string{
+				"a": "URL: b.app.local",
+				"b": "URL: c.app.local",
+				"c": "URL: a.app.local",
+				"d": "name: d",
+			},
+			wantedErr: "cannot order the deployments of a, b and c because they reference each other",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockWs := mocks.NewMockwsWlDirReader(ctrl)
+			mockWkldCmd := mocks.NewMockactionCommand(ctrl)
+			var names []string
+			for name, mft := range tc.inManifests {
+				names = append(names, name)
+				mockWs.EXPECT().ReadWorkloadManifest(name).Return([]byte(mft), nil)
+				mockStore.EXPECT().GetWorkload("app", name).Return(&config.Workload{Name: name, Type: "Backend Service"}, nil).AnyTimes()
+			}
+			mockWs.EXPECT().WorkloadNames().Return(names, nil)
+			mockWkldCmd.EXPECT().Validate().Return(nil).AnyTimes()
+			opts := &deployOpts{
+				deployVars: deployVars{
+					deployWkldVars: deployWkldVars{
+						appName: "app",
+						envName: "test",
+					},
+					all: true,
+				},
+				store: mockStore,
+				ws:    mockWs,
+
+				newWkldCmd: func(vars deployWkldVars, workloadType string, spinner progress) actionCommand {
+					return mockWkldCmd
+				},
+				newEnvUpgradeCmd: func(appName, envName string) (actionCommand, error) {
+					return mocks.NewMockactionCommand(ctrl), nil
+				},
+			}
+
+			// WHEN
+			g, err := opts.deploymentGraph("test")
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			for id, deps := range tc.wantedDeps {
+				require.Equal(t, deps, g.Dependencies(id), "dependencies of %s", id)
+			}
+		})
+	}
+}
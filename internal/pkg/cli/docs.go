@@ -4,49 +4,136 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"os/exec"
 	"runtime"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/spf13/cobra"
+	"github.com/spf13/cobra/doc"
 )
 
 const (
 	docsURL = "https://aws.github.io/copilot-cli/"
 )
 
-// BuildDocsCmd builds the command for opening the documentation.
-func BuildDocsCmd() *cobra.Command {
+// Formats of the reference pages generated by "copilot docs".
+const (
+	docsFormatMan      = "man"
+	docsFormatMarkdown = "markdown"
+)
+
+type docsVars struct {
+	outputDir string
+	format    string
+}
+
+type docsOpts struct {
+	docsVars
+
+	rootCmd     *cobra.Command
+	openBrowser func(url string) error
+}
+
+// Validate returns an error if the flag values are invalid.
+func (o *docsOpts) Validate() error {
+	if o.outputDir == "" {
+		if o.format != docsFormatMan {
+			return fmt.Errorf("flag --%s requires flag --%s", docsFormatFlag, docsOutputDirFlag)
+		}
+		return nil
+	}
+	if o.format != docsFormatMan && o.format != docsFormatMarkdown {
+		return fmt.Errorf(`format %q is not supported: must be one of "%s" or "%s"`, o.format, docsFormatMan, docsFormatMarkdown)
+	}
+	return nil
+}
+
+// Execute opens the docs in the browser, or writes a reference page for every command if an output directory is set.
+func (o *docsOpts) Execute() error {
+	if o.outputDir == "" {
+		if err := o.openBrowser(docsURL); err != nil {
+			return fmt.Errorf("open docs: %w", err)
+		}
+		return nil
+	}
+	if err := os.MkdirAll(o.outputDir, 0755); err != nil {
+		return fmt.Errorf("create directory %s: %w", o.outputDir, err)
+	}
+	// Cobra prints a footer with the generation date unless the root command disables it.
+	o.rootCmd.DisableAutoGenTag = true
+	var err error
+	switch o.format {
+	case docsFormatMarkdown:
+		err = doc.GenMarkdownTree(o.rootCmd, o.outputDir)
+	default:
+		err = doc.GenManTree(o.rootCmd, &doc.GenManHeader{
+			Title:   "COPILOT",
+			Section: "1",
+			Source:  fmt.Sprintf("Copilot %s", version.Version),
+			Manual:  "Copilot Manual",
+		}, o.outputDir)
+	}
+	if err != nil {
+		return fmt.Errorf("generate %s pages: %w", o.format, err)
+	}
+	log.Successf("Wrote the %s reference pages to %s.\n", o.format, color.HighlightResource(o.outputDir))
+	return nil
+}
+
+func openBrowser(url string) error {
+	switch runtime.GOOS {
+	case "linux":
+		return exec.Command("xdg-open", url).Start()
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", url).Start()
+	case "darwin":
+		return exec.Command("open", url).Start()
+	default:
+		return errors.New("unsupported platform")
+	}
+}
+
+// BuildDocsCmd builds the command for opening the documentation or generating it for offline reference.
+func BuildDocsCmd(rootCmd *cobra.Command) *cobra.Command {
+	vars := docsVars{}
 	cmd := &cobra.Command{
 		Use:   "docs",
 		Short: "Open the copilot docs.",
-		Long:  "Open the copilot docs.",
+		Long: `Open the copilot docs.
+Use --output-dir to write man pages or markdown files of every command for offline reference instead.`,
+		Example: `
+  Open the docs in your browser
+  /code $ copilot docs
+
+  Write man pages for every command
+  /code $ copilot docs --output-dir ./man
+
+  Write markdown files for every command
+  /code $ copilot docs --output-dir ./docs --format markdown`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			var err error
-
-			switch runtime.GOOS {
-			case "linux":
-				err = exec.Command("xdg-open", docsURL).Start()
-			case "windows":
-				err = exec.Command("rundll32", "url.dll,FileProtocolHandler", docsURL).Start()
-			case "darwin":
-				err = exec.Command("open", docsURL).Start()
-			default:
-				err = fmt.Errorf("unsupported platform")
+			opts := &docsOpts{
+				docsVars:    vars,
+				rootCmd:     rootCmd,
+				openBrowser: openBrowser,
 			}
-			if err != nil {
-				return fmt.Errorf("open docs: %w", err)
+			if err := opts.Validate(); err != nil {
+				return err
 			}
-
-			return nil
+			return opts.Execute()
 		}),
 		Annotations: map[string]string{
 			"group": group.GettingStarted,
 		},
 	}
-
+	cmd.Flags().StringVar(&vars.outputDir, docsOutputDirFlag, "", docsOutputDirFlagDescription)
+	cmd.Flags().StringVar(&vars.format, docsFormatFlag, docsFormatMan, docsFormatFlagDescription)
 	cmd.SetUsageTemplate(template.Usage)
 
 	return cmd
@@ -0,0 +1,136 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocsOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputOutputDir string
+		inputFormat    string
+
+		wantedError error
+	}{
+		"opens the browser by default": {
+			inputFormat: docsFormatMan,
+		},
+		"errors if the format is set without an output directory": {
+			inputFormat: docsFormatMarkdown,
+			wantedError: errors.New("flag --format requires flag --output-dir"),
+		},
+		"errors if the format is not supported": {
+			inputOutputDir: "man",
+			inputFormat:    "html",
+			wantedError:    errors.New(`format "html" is not supported: must be one of "man" or "markdown"`),
+		},
+		"valid markdown format": {
+			inputOutputDir: "docs",
+			inputFormat:    docsFormatMarkdown,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := docsOpts{
+				docsVars: docsVars{
+					outputDir: tc.inputOutputDir,
+					format:    tc.inputFormat,
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDocsOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inputFormat    string
+		withOutputDir  bool
+		mockBrowserErr error
+
+		wantedFiles []string
+		wantedError error
+	}{
+		"wraps the error if the browser can't be opened": {
+			inputFormat:    docsFormatMan,
+			mockBrowserErr: errors.New("some error"),
+			wantedError:    errors.New("open docs: some error"),
+		},
+		"writes a man page for every command": {
+			inputFormat:   docsFormatMan,
+			withOutputDir: true,
+			wantedFiles:   []string{"copilot-svc-deploy.1", "copilot-svc.1", "copilot.1"},
+		},
+		"writes a markdown file for every command": {
+			inputFormat:   docsFormatMarkdown,
+			withOutputDir: true,
+			wantedFiles:   []string{"copilot.md", "copilot_svc.md", "copilot_svc_deploy.md"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			rootCmd := &cobra.Command{Use: "copilot"}
+			svcCmd := &cobra.Command{Use: "svc"}
+			svcCmd.AddCommand(&cobra.Command{Use: "deploy", Run: func(cmd *cobra.Command, args []string) {}})
+			rootCmd.AddCommand(svcCmd)
+
+			var outputDir string
+			if tc.withOutputDir {
+				tmpDir, err := ioutil.TempDir("", "docs")
+				require.NoError(t, err)
+				defer os.RemoveAll(tmpDir)
+				outputDir = filepath.Join(tmpDir, "out")
+			}
+			opts := docsOpts{
+				docsVars: docsVars{
+					outputDir: outputDir,
+					format:    tc.inputFormat,
+				},
+				rootCmd: rootCmd,
+				openBrowser: func(url string) error {
+					require.Equal(t, docsURL, url)
+					return tc.mockBrowserErr
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			files, err := ioutil.ReadDir(outputDir)
+			require.NoError(t, err)
+			var names []string
+			for _, f := range files {
+				names = append(names, f.Name())
+			}
+			require.Equal(t, tc.wantedFiles, names)
+		})
+	}
+}
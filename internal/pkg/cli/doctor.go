@@ -0,0 +1,107 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+var errDoctorChecksFailed = errors.New("some checks failed")
+
+type doctorOpts struct {
+	newRuntimeChecker func() (containerRuntimeChecker, error)
+}
+
+func newDoctorOpts() *doctorOpts {
+	return &doctorOpts{
+		newRuntimeChecker: func() (containerRuntimeChecker, error) {
+			return exec.NewContainerEngineCommand()
+		},
+	}
+}
+
+// Execute checks that the container engine can build and push images, and prints how to fix the failed checks.
+func (o *doctorOpts) Execute() error {
+	checker, err := o.newRuntimeChecker()
+	if err != nil {
+		log.Errorf("Container engine: %v\n", err)
+		log.Infof("Set %s to one of %s, or unset it to detect the engine.\n",
+			exec.EngineEnvVar, strings.Join(exec.Engines, ", "))
+		return errDoctorChecksFailed
+	}
+	log.Successf("Container engine: %s\n", color.HighlightResource(checker.Engine()))
+
+	failed := false
+	if err := o.checkDaemon(checker); err != nil {
+		failed = true
+	}
+	if err := checker.CheckCredentialHelper(); err != nil {
+		failed = true
+		log.Errorf("Credential helper: %v\n", err)
+		var errHelper *exec.ErrCredentialHelperNotFound
+		if errors.As(err, &errHelper) {
+			log.Infof(`Install docker-credential-%s, or remove "credsStore" from the docker config file to store credentials in the file instead.`+"\n", errHelper.Store)
+		}
+	} else {
+		log.Successln("Credential helper: ready")
+	}
+	if failed {
+		return errDoctorChecksFailed
+	}
+	return nil
+}
+
+func (o *doctorOpts) checkDaemon(checker containerRuntimeChecker) error {
+	host := checker.Host()
+	if host == "" {
+		host = "default"
+	}
+	err := checker.CheckDockerEngineRunning()
+	if err == nil {
+		log.Successf("Daemon: running at %s\n", color.HighlightResource(host))
+		return nil
+	}
+	var errDaemon *exec.ErrDockerDaemonNotResponsive
+	switch {
+	case errors.Is(err, exec.ErrDockerCommandNotFound):
+		log.Errorf("Daemon: %s is not installed\n", checker.Engine())
+		log.Infoln("Install Docker, Podman or Finch, or a Docker Desktop alternative such as Colima or Rancher Desktop.")
+	case errors.As(err, &errDaemon):
+		log.Errorf("Daemon: %v\n", err)
+		log.Infof("Start the container runtime, or set %s to the address of its daemon, for example %s.\n",
+			exec.HostEnvVar, color.HighlightCode("unix:///path/to/docker.sock"))
+	default:
+		log.Errorf("Daemon: %v\n", err)
+	}
+	return err
+}
+
+// BuildDoctorCmd builds the command for checking the container runtime used to build images.
+func BuildDoctorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check that Copilot can build and push images.",
+		Long: `Check that Copilot can build and push images.
+Checks the container engine, the daemon it connects to and the credential helper of the docker config file.`,
+		Example: `
+  Check the container runtime
+  /code $ copilot doctor`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return newDoctorOpts().Execute()
+		}),
+		Annotations: map[string]string{
+			"group": group.Settings,
+		},
+	}
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
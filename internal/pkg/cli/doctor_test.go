@@ -0,0 +1,87 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoctorOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		mockChecker func(m *mocks.MockcontainerRuntimeChecker)
+		inEngineErr error
+
+		wantedError error
+	}{
+		"fails if the container engine is not supported": {
+			inEngineErr: errors.New(`container engine "nerdctl" set by COPILOT_CONTAINER_ENGINE is not supported`),
+
+			wantedError: errDoctorChecksFailed,
+		},
+		"fails if the daemon is not responsive": {
+			mockChecker: func(m *mocks.MockcontainerRuntimeChecker) {
+				m.EXPECT().Engine().Return("docker").AnyTimes()
+				m.EXPECT().Host().Return("")
+				m.EXPECT().CheckDockerEngineRunning().Return(&exec.ErrDockerDaemonNotResponsive{})
+				m.EXPECT().CheckCredentialHelper().Return(nil)
+			},
+
+			wantedError: errDoctorChecksFailed,
+		},
+		"fails if the credential helper is not installed": {
+			mockChecker: func(m *mocks.MockcontainerRuntimeChecker) {
+				m.EXPECT().Engine().Return("docker").AnyTimes()
+				m.EXPECT().Host().Return("unix:///Users/user/.colima/default/docker.sock")
+				m.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.EXPECT().CheckCredentialHelper().Return(&exec.ErrCredentialHelperNotFound{Store: "desktop"})
+			},
+
+			wantedError: errDoctorChecksFailed,
+		},
+		"succeeds if all the checks pass": {
+			mockChecker: func(m *mocks.MockcontainerRuntimeChecker) {
+				m.EXPECT().Engine().Return("finch").AnyTimes()
+				m.EXPECT().Host().Return("")
+				m.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.EXPECT().CheckCredentialHelper().Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcontainerRuntimeChecker(ctrl)
+			if tc.mockChecker != nil {
+				tc.mockChecker(m)
+			}
+			opts := &doctorOpts{
+				newRuntimeChecker: func() (containerRuntimeChecker, error) {
+					if tc.inEngineErr != nil {
+						return nil, tc.inEngineErr
+					}
+					return m, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
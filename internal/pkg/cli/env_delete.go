@@ -60,6 +60,7 @@ type deleteEnvOpts struct {
 	prog     progress
 	prompt   prompter
 	sel      configSelector
+	guard    targetConfirmer
 
 	// cached data to avoid fetching the same information multiple times.
 	envConfig *config.Environment
@@ -74,7 +75,15 @@ func newDeleteEnvOpts(vars deleteEnvVars) (*deleteEnvOpts, error) {
 		return nil, fmt.Errorf("connect to copilot config store: %w", err)
 	}
 
+	defaultSess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, err
+	}
 	prompter := prompt.New()
+	guard, err := newTargetGuard(defaultSess, prompter)
+	if err != nil {
+		return nil, err
+	}
 	return &deleteEnvOpts{
 		deleteEnvVars: vars,
 
@@ -82,6 +91,7 @@ func newDeleteEnvOpts(vars deleteEnvVars) (*deleteEnvOpts, error) {
 		prog:   termprogress.NewSpinner(log.DiagnosticWriter),
 		sel:    selector.NewConfigSelect(prompter, store),
 		prompt: prompter,
+		guard:  guard,
 
 		initRuntimeClients: func(o *deleteEnvOpts) error {
 			env, err := o.getEnvConfig()
@@ -136,6 +146,13 @@ func (o *deleteEnvOpts) Ask() error {
 // The environment is removed from the store only if other delete operations succeed.
 // Execute assumes that Validate is invoked first.
 func (o *deleteEnvOpts) Execute() error {
+	env, err := o.getEnvConfig()
+	if err != nil {
+		return err
+	}
+	if err := o.guard.Confirm(env, o.skipConfirmation); err != nil {
+		return err
+	}
 	if err := o.initRuntimeClients(o); err != nil {
 		return err
 	}
@@ -161,6 +161,25 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 
 		wantedError error
 	}{
+		"returns the error if the target of the environment is not confirmed": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				env := &config.Environment{App: "phonetool", Name: "test"}
+				guard := mocks.NewMocktargetConfirmer(ctrl)
+				guard.EXPECT().Confirm(env, true).Return(errTargetNotConfirmed)
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						skipConfirmation: true,
+					},
+					guard:     guard,
+					envConfig: env,
+					initRuntimeClients: func(*deleteEnvOpts) error {
+						return errors.New("should not be called")
+					},
+				}
+			},
+			wantedError: errTargetNotConfirmed,
+		},
 		"returns wrapped errors when failed to retrieve running services in the environment": {
 			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
 				m := mocks.NewMockresourceGetter(ctrl)
@@ -168,6 +187,8 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 
 				return &deleteEnvOpts{
 					rg:                 m,
+					guard:              mockConfirmedTarget(ctrl),
+					envConfig:          &config.Environment{},
 					initRuntimeClients: noopInitRuntimeClients,
 				}
 			},
@@ -199,6 +220,8 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 						name:    "test",
 					},
 					rg:                 m,
+					guard:              mockConfirmedTarget(ctrl),
+					envConfig:          &config.Environment{},
 					initRuntimeClients: noopInitRuntimeClients,
 				}
 			},
@@ -255,6 +278,7 @@ Resources:
 						name:    "test",
 					},
 					rg:       rg,
+					guard:    mockConfirmedTarget(ctrl),
 					deployer: deployer,
 					prog:     prog,
 					envConfig: &config.Environment{
@@ -292,6 +316,7 @@ Resources:
 						name:    "test",
 					},
 					rg:                 rg,
+					guard:              mockConfirmedTarget(ctrl),
 					deployer:           deployer,
 					prog:               prog,
 					envConfig:          &config.Environment{},
@@ -338,6 +363,7 @@ Resources:
 						name:    "test",
 					},
 					rg:       rg,
+					guard:    mockConfirmedTarget(ctrl),
 					deployer: deployer,
 					prog:     prog,
 					iam:      iam,
@@ -4,9 +4,11 @@
 package cli
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"strings"
 
@@ -16,13 +18,18 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/pricing"
 	"github.com/aws/copilot-cli/internal/pkg/aws/profile"
+	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/cost"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -67,6 +74,21 @@ https://aws.github.io/copilot-cli/docs/credentials/#environment-credentials`
 	fmtAddEnvToAppStart      = "Linking account %s and region %s to application %s."
 	fmtAddEnvToAppFailed     = "Failed to link account %s and region %s to application %s.\n\n"
 	fmtAddEnvToAppComplete   = "Linked account %s and region %s to application %s.\n\n"
+
+	// Size of the tasks of workloads that don't override the default cpu and memory in their manifest.
+	defaultTaskCPU           = 256
+	defaultTaskMemory        = 512
+	defaultTaskCostCondition = "Per running task of the default size."
+
+	minAZCount                = 2
+	maxAZCount                = 3
+	defaultSubnetPrefixLength = 24
+	maxSubnetPrefixLength     = 28 // The smallest subnets allowed in a VPC are /28.
+	subnetSplitBits           = 3  // Split the VPC in 2^3 blocks, enough for the subnets of 3 availability zones.
+
+	fmtExternalInstanceRoleName       = "%s-ExternalInstanceRole"
+	externalInstanceRegistrationLimit = 10
+	fmtECSAnywhereInstallCmd          = `curl --proto "https" -o "/tmp/ecs-anywhere-install.sh" "https://amazon-ecs-agent.s3.amazonaws.com/ecs-anywhere-install-latest.sh" && sudo bash /tmp/ecs-anywhere-install.sh --region %s --cluster %s --activation-id %s --activation-code %s`
 )
 
 var (
@@ -92,6 +114,7 @@ func (v importVPCVars) isSet() bool {
 
 type adjustVPCVars struct {
 	CIDR               net.IPNet
+	AZCount            int // Number of availability zones with a public and a private subnet.
 	PublicSubnetCIDRs  []string
 	PrivateSubnetCIDRs []string
 }
@@ -100,7 +123,33 @@ func (v adjustVPCVars) isSet() bool {
 	if v.CIDR.String() != emptyIPNet.String() {
 		return true
 	}
-	return len(v.PublicSubnetCIDRs) != 0 || len(v.PrivateSubnetCIDRs) != 0
+	return v.AZCount != 0 || len(v.PublicSubnetCIDRs) != 0 || len(v.PrivateSubnetCIDRs) != 0
+}
+
+type envResourceNamesVars struct {
+	Cluster        string
+	LoadBalancer   string
+	LogGroupPrefix string
+}
+
+func (v envResourceNamesVars) isSet() bool {
+	return v.Cluster != "" || v.LoadBalancer != "" || v.LogGroupPrefix != ""
+}
+
+type httpsListenerVars struct {
+	CertARNs      []string
+	SSLPolicy     string
+	MutualTLSMode string
+	CABundle      string // S3 URI of the CA certificates bundle.
+	HSTS          string
+
+	HTTPRedirectCode    int
+	DisableHTTPRedirect bool
+}
+
+func (v httpsListenerVars) isSet() bool {
+	return len(v.CertARNs) != 0 || v.SSLPolicy != "" || v.MutualTLSMode != "" || v.CABundle != "" || v.HSTS != "" ||
+		v.HTTPRedirectCode != 0 || v.DisableHTTPRedirect
 }
 
 type tempCredsVars struct {
@@ -120,11 +169,23 @@ type initEnvVars struct {
 	isProduction  bool   // True means retain resources even after deletion.
 	defaultConfig bool   // True means using default environment configuration.
 
+	externalInstances bool // True means on-premises instances can be registered to the cluster with ECS Anywhere.
+	ipv6              bool // True means the VPC and the public load balancer are dual-stack.
+
+	domain string // Existing domain of the environment that replaces the subdomain of the application's domain.
+
+	taskExecutionRoleARN string // Execution role shared by the tasks of the workloads in the environment.
+
 	importVPC importVPCVars // Existing VPC resources to use instead of creating new ones.
 	adjustVPC adjustVPCVars // Configure parameters for VPC resources generated while initializing an environment.
 
+	resourceNames envResourceNamesVars // Names that override the ones generated for the environment's resources.
+	httpsListener httpsListenerVars    // TLS settings of the HTTPS listener of the public load balancer.
+
 	tempCreds tempCredsVars // Temporary credentials to initialize the environment. Mutually exclusive with the profile.
 	region    string        // The region to create the environment in.
+
+	skipCostEstimate bool // True means the approximate monthly cost of the environment isn't printed.
 }
 
 type initEnvOpts struct {
@@ -140,6 +201,8 @@ type initEnvOpts struct {
 	ec2Client    ec2Client
 	iam          roleManager
 	cfn          stackExistChecker
+	clusters     clusterGetter
+	activations  activationCreator
 	prog         progress
 	prompt       prompter
 	selVPC       ec2Selector
@@ -148,8 +211,14 @@ type initEnvOpts struct {
 	appCFN       appResourcesGetter
 	newS3        func(string) (zipAndUploader, error)
 	uploader     customResourcesUploader
+	estimator    costEstimator
+	newTemplater func(*deploy.CreateEnvironmentInput) templater
+	envRoute53   domainHostedZoneGetter
 
 	sess *session.Session // Session pointing to environment's AWS account and region.
+
+	// Cached variables.
+	envDomain *config.EnvDomain
 }
 
 func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
@@ -191,6 +260,9 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 			}
 			return s3.New(sess), nil
 		},
+		newTemplater: func(in *deploy.CreateEnvironmentInput) templater {
+			return stack.NewEnvStackConfig(in)
+		},
 	}, nil
 }
 
@@ -205,6 +277,22 @@ func (o *initEnvOpts) Validate() error {
 	if err := o.validateCustomizedResources(); err != nil {
 		return err
 	}
+	if err := o.validateResourceNames(); err != nil {
+		return err
+	}
+	if err := o.validateHTTPSListener(); err != nil {
+		return err
+	}
+	if o.domain != "" {
+		if err := validateDomainName(o.domain); err != nil {
+			return fmt.Errorf("domain name %s is invalid: %w", o.domain, err)
+		}
+	}
+	if o.taskExecutionRoleARN != "" {
+		if err := validateRoleARN(o.taskExecutionRoleARN); err != nil {
+			return fmt.Errorf("task execution role ARN %s is invalid: %w", o.taskExecutionRoleARN, err)
+		}
+	}
 	return o.validateCredentials()
 }
 
@@ -233,13 +321,27 @@ func (o *initEnvOpts) Execute() error {
 		// Ensure the app actually exists before we do a deployment.
 		return err
 	}
+	if o.domain != "" {
+		id, err := o.envRoute53.DomainHostedZoneID(o.domain)
+		if err != nil {
+			return fmt.Errorf("get hosted zone ID of domain %s: %w", o.domain, err)
+		}
+		o.envDomain = &config.EnvDomain{
+			Name:         o.domain,
+			HostedZoneID: id,
+		}
+	}
+	if o.httpsListener.isSet() && app.Domain == "" && o.envDomain == nil {
+		// The HTTPS listener is only created for environments with a domain name.
+		return fmt.Errorf("configure the HTTPS listener of environment %s: application %s does not have a domain name and --%s is not set", o.name, app.Name, domainNameFlag)
+	}
 
 	envCaller, err := o.envIdentity.Get()
 	if err != nil {
 		return fmt.Errorf("get identity: %w", err)
 	}
 
-	if app.RequiresDNSDelegation() {
+	if app.RequiresDNSDelegation() && o.envDomain == nil {
 		if err := o.delegateDNSFromApp(app, envCaller.Account); err != nil {
 			return fmt.Errorf("granting DNS permissions: %w", err)
 		}
@@ -289,7 +391,8 @@ func (o *initEnvOpts) Execute() error {
 		return fmt.Errorf("get environment struct for %s: %w", o.name, err)
 	}
 	env.Prod = o.isProduction
-	env.CustomConfig = config.NewCustomizeEnv(o.importVPCConfig(), o.adjustVPCConfig())
+	env.CustomConfig = config.NewCustomizeEnv(o.importVPCConfig(), o.adjustVPCConfig(), o.resourceNamesConfig(), o.httpsListenerConfig(), o.envDomain, o.externalInstances, o.ipv6)
+	env.TaskExecutionRoleARN = o.taskExecutionRoleARN
 
 	// 6. Store the environment in SSM.
 	if err := o.store.CreateEnvironment(env); err != nil {
@@ -297,6 +400,11 @@ func (o *initEnvOpts) Execute() error {
 	}
 	log.Successf("Created environment %s in region %s under application %s.\n",
 		color.HighlightUserInput(env.Name), color.Emphasize(env.Region), color.HighlightUserInput(env.App))
+
+	// 7. Show how to register on-premises instances to the environment.
+	if o.externalInstances {
+		return o.showExternalInstanceActivation(env)
+	}
 	return nil
 }
 
@@ -319,6 +427,18 @@ func (o *initEnvOpts) initRuntimeClients() {
 	if o.iam == nil {
 		o.iam = iam.New(o.sess)
 	}
+	if o.clusters == nil {
+		o.clusters = ecs.New(o.sess)
+	}
+	if o.activations == nil {
+		o.activations = ssm.New(o.sess)
+	}
+	if o.estimator == nil {
+		o.estimator = cost.New(aws.StringValue(o.sess.Config.Region), pricing.New(o.sess))
+	}
+	if o.envRoute53 == nil {
+		o.envRoute53 = route53.New(o.sess)
+	}
 }
 
 func (o *initEnvOpts) validateCustomizedResources() error {
@@ -328,6 +448,72 @@ func (o *initEnvOpts) validateCustomizedResources() error {
 	if (o.importVPC.isSet() || o.adjustVPC.isSet()) && o.defaultConfig {
 		return fmt.Errorf("cannot import or configure vpc if --%s is set", defaultConfigFlag)
 	}
+	if o.adjustVPC.AZCount != 0 && (o.adjustVPC.AZCount < minAZCount || o.adjustVPC.AZCount > maxAZCount) {
+		return fmt.Errorf("--%s must be %d or %d", azCountFlag, minAZCount, maxAZCount)
+	}
+	if o.importVPC.isSet() && o.ipv6 {
+		return fmt.Errorf("cannot import vpc if --%s is set", ipv6Flag)
+	}
+	return nil
+}
+
+func (o *initEnvOpts) validateResourceNames() error {
+	if o.resourceNames.Cluster != "" {
+		if err := validateClusterName(o.resourceNames.Cluster); err != nil {
+			return fmt.Errorf("--%s: %w", clusterNameFlag, err)
+		}
+	}
+	if o.resourceNames.LoadBalancer != "" {
+		if err := validateLBName(o.resourceNames.LoadBalancer); err != nil {
+			return fmt.Errorf("--%s: %w", lbNameFlag, err)
+		}
+	}
+	if o.resourceNames.LogGroupPrefix != "" {
+		if err := validateLogGroupPrefix(o.resourceNames.LogGroupPrefix); err != nil {
+			return fmt.Errorf("--%s: %w", logGroupPrefixFlag, err)
+		}
+	}
+	return nil
+}
+
+func (o *initEnvOpts) validateHTTPSListener() error {
+	for _, certARN := range o.httpsListener.CertARNs {
+		if err := validateCertARN(certARN); err != nil {
+			return fmt.Errorf("--%s: %w", importCertARNsFlag, err)
+		}
+	}
+	switch o.httpsListener.MutualTLSMode {
+	case "":
+		if o.httpsListener.CABundle != "" {
+			return fmt.Errorf("--%s requires --%s %s", mutualTLSCABundleFlag, mutualTLSModeFlag, config.MutualTLSModeVerify)
+		}
+	case config.MutualTLSModeVerify:
+		if o.httpsListener.CABundle == "" {
+			return fmt.Errorf("--%s is required for mutual TLS mode %s", mutualTLSCABundleFlag, config.MutualTLSModeVerify)
+		}
+		if err := validateS3ObjectURI(o.httpsListener.CABundle); err != nil {
+			return fmt.Errorf("--%s: %w", mutualTLSCABundleFlag, err)
+		}
+	case config.MutualTLSModePassthrough:
+		if o.httpsListener.CABundle != "" {
+			return fmt.Errorf("--%s cannot be used with mutual TLS mode %s", mutualTLSCABundleFlag, config.MutualTLSModePassthrough)
+		}
+	default:
+		return fmt.Errorf(fmtErrInvalidMutualTLSMode, o.httpsListener.MutualTLSMode, prettify(config.MutualTLSModes))
+	}
+	if o.httpsListener.HSTS != "" {
+		if err := validateHSTS(o.httpsListener.HSTS); err != nil {
+			return fmt.Errorf("--%s: %w", hstsFlag, err)
+		}
+	}
+	if o.httpsListener.HTTPRedirectCode != 0 {
+		if o.httpsListener.DisableHTTPRedirect {
+			return fmt.Errorf("cannot specify both --%s and --%s", httpRedirectCodeFlag, noHTTPRedirectFlag)
+		}
+		if code := o.httpsListener.HTTPRedirectCode; code != http.StatusMovedPermanently && code != http.StatusFound {
+			return fmt.Errorf("--%s must be 301 or 302", httpRedirectCodeFlag)
+		}
+	}
 	return nil
 }
 
@@ -408,9 +594,14 @@ func (o *initEnvOpts) askCustomizedResources() error {
 	if o.adjustVPC.isSet() {
 		return o.askAdjustResources()
 	}
+	envTypes := envInitCustomizedEnvTypes
+	if o.ipv6 {
+		// Only VPCs created by Copilot can be made dual-stack.
+		envTypes = []string{envInitDefaultConfigSelectOption, envInitAdjustEnvResourcesSelectOption}
+	}
 	adjustOrImport, err := o.prompt.SelectOne(
 		envInitDefaultEnvConfirmPrompt, "",
-		envInitCustomizedEnvTypes)
+		envTypes)
 	if err != nil {
 		return fmt.Errorf("select adjusting or importing resources: %w", err)
 	}
@@ -496,9 +687,14 @@ func (o *initEnvOpts) askAdjustResources() error {
 		}
 		o.adjustVPC.CIDR = *vpcCIDR
 	}
+	azCount := o.adjustVPC.AZCount
+	if azCount == 0 {
+		azCount = minAZCount
+	}
+	defaultPublicCIDRs, defaultPrivateCIDRs := subnetCIDRs(o.adjustVPC.CIDR, azCount)
 	if o.adjustVPC.PublicSubnetCIDRs == nil {
 		publicCIDR, err := o.prompt.Get(envInitPublicCIDRPrompt, envInitPublicCIDRPromptHelp, validateCIDRSlice,
-			prompt.WithDefaultInput(stack.DefaultPublicSubnetCIDRs))
+			prompt.WithDefaultInput(strings.Join(defaultPublicCIDRs, ",")))
 		if err != nil {
 			return fmt.Errorf("get public subnet CIDRs: %w", err)
 		}
@@ -506,15 +702,84 @@ func (o *initEnvOpts) askAdjustResources() error {
 	}
 	if o.adjustVPC.PrivateSubnetCIDRs == nil {
 		privateCIDR, err := o.prompt.Get(envInitPrivateCIDRPrompt, envInitPrivateCIDRPromptHelp, validateCIDRSlice,
-			prompt.WithDefaultInput(stack.DefaultPrivateSubnetCIDRs))
+			prompt.WithDefaultInput(strings.Join(defaultPrivateCIDRs, ",")))
 		if err != nil {
 			return fmt.Errorf("get private subnet CIDRs: %w", err)
 		}
 		o.adjustVPC.PrivateSubnetCIDRs = strings.Split(privateCIDR, ",")
 	}
+	return o.validateSubnetLayout()
+}
+
+// validateSubnetLayout returns an error if the subnets don't fit in the VPC, overlap,
+// or don't pair a public subnet with a private subnet in each availability zone.
+func (o *initEnvOpts) validateSubnetLayout() error {
+	public, private := o.adjustVPC.PublicSubnetCIDRs, o.adjustVPC.PrivateSubnetCIDRs
+	if o.adjustVPC.AZCount != 0 && (len(public) != o.adjustVPC.AZCount || len(private) != o.adjustVPC.AZCount) {
+		return fmt.Errorf("%d public and %d private subnets must be specified for --%s %d",
+			o.adjustVPC.AZCount, o.adjustVPC.AZCount, azCountFlag, o.adjustVPC.AZCount)
+	}
+	if len(private) > len(public) {
+		// The private subnet of an availability zone reaches the internet through the NAT gateway in the public subnet of the same zone.
+		return fmt.Errorf("the number of private subnets %d must not exceed the number of public subnets %d", len(private), len(public))
+	}
+	var subnets []*net.IPNet
+	for _, cidr := range append(append([]string{}, public...), private...) {
+		_, subnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return fmt.Errorf("parse subnet CIDR %s: %w", cidr, err)
+		}
+		if !containsIPNet(&o.adjustVPC.CIDR, subnet) {
+			return fmt.Errorf("subnet CIDR %s is not within VPC CIDR %s", cidr, o.adjustVPC.CIDR.String())
+		}
+		for _, other := range subnets {
+			if other.Contains(subnet.IP) || subnet.Contains(other.IP) {
+				return fmt.Errorf("subnet CIDR %s overlaps with subnet CIDR %s", cidr, other.String())
+			}
+		}
+		subnets = append(subnets, subnet)
+	}
 	return nil
 }
 
+// subnetCIDRs divides the VPC CIDR into consecutive public subnets followed by private subnets, one of each per availability zone.
+// Subnets are /24 blocks if the VPC is large enough, for example 10.0.0.0/24 to 10.0.3.0/24 for 10.0.0.0/16 and 2 zones.
+// Otherwise, the VPC is split in 8 blocks. It returns nil slices if the VPC is too small to be split.
+func subnetCIDRs(vpc net.IPNet, azCount int) (public, private []string) {
+	ip := vpc.IP.To4()
+	ones, bits := vpc.Mask.Size()
+	if ip == nil || bits != net.IPv4len*8 {
+		return nil, nil
+	}
+	subnetOnes := defaultSubnetPrefixLength
+	if ones+subnetSplitBits > subnetOnes {
+		subnetOnes = ones + subnetSplitBits
+	}
+	if subnetOnes > maxSubnetPrefixLength {
+		return nil, nil
+	}
+	start := binary.BigEndian.Uint32(ip)
+	size := uint32(1) << uint(bits-subnetOnes)
+	for i := 0; i < 2*azCount; i++ {
+		subnetIP := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(subnetIP, start+uint32(i)*size)
+		cidr := fmt.Sprintf("%s/%d", subnetIP, subnetOnes)
+		if i < azCount {
+			public = append(public, cidr)
+			continue
+		}
+		private = append(private, cidr)
+	}
+	return public, private
+}
+
+// containsIPNet returns true if the subnet is within the network.
+func containsIPNet(network, subnet *net.IPNet) bool {
+	networkOnes, _ := network.Mask.Size()
+	subnetOnes, _ := subnet.Mask.Size()
+	return network.Contains(subnet.IP) && subnetOnes >= networkOnes
+}
+
 func (o *initEnvOpts) importVPCConfig() *config.ImportVPC {
 	if o.defaultConfig || !o.importVPC.isSet() {
 		return nil
@@ -537,23 +802,74 @@ func (o *initEnvOpts) adjustVPCConfig() *config.AdjustVPC {
 	}
 }
 
+func (o *initEnvOpts) resourceNamesConfig() *config.EnvResourceNames {
+	if !o.resourceNames.isSet() {
+		return nil
+	}
+	return &config.EnvResourceNames{
+		Cluster:        o.resourceNames.Cluster,
+		LoadBalancer:   o.resourceNames.LoadBalancer,
+		LogGroupPrefix: o.resourceNames.LogGroupPrefix,
+	}
+}
+
+func (o *initEnvOpts) httpsListenerConfig() *config.HTTPSListener {
+	if !o.httpsListener.isSet() {
+		return nil
+	}
+	listener := &config.HTTPSListener{
+		CertificateARNs: o.httpsListener.CertARNs,
+		SSLPolicy:       o.httpsListener.SSLPolicy,
+		HSTS:            o.httpsListener.HSTS,
+	}
+	if o.httpsListener.MutualTLSMode != "" {
+		// The bundle is validated along with the other flags.
+		bucket, key, _ := parseS3ObjectURI(o.httpsListener.CABundle)
+		listener.MutualTLS = &config.MutualTLS{
+			Mode:           o.httpsListener.MutualTLSMode,
+			CABundleBucket: bucket,
+			CABundleKey:    key,
+		}
+	}
+	if o.httpsListener.HTTPRedirectCode != 0 || o.httpsListener.DisableHTTPRedirect {
+		listener.HTTPRedirect = &config.HTTPRedirect{
+			Disabled:   o.httpsListener.DisableHTTPRedirect,
+			StatusCode: o.httpsListener.HTTPRedirectCode,
+		}
+	}
+	return listener
+}
+
 func (o *initEnvOpts) deployEnv(app *config.Application, customResourcesURLs map[string]string) error {
 	caller, err := o.identity.Get()
 	if err != nil {
 		return fmt.Errorf("get identity: %w", err)
 	}
+	appDNSName := app.Domain
+	if o.envDomain != nil {
+		// The environment's own domain replaces the subdomain delegated from the application's domain.
+		appDNSName = ""
+	}
 	deployEnvInput := &deploy.CreateEnvironmentInput{
 		Name:                     o.name,
 		AppName:                  o.appName,
 		Prod:                     o.isProduction,
 		ToolsAccountPrincipalARN: caller.RootUserARN,
-		AppDNSName:               app.Domain,
+		AppDNSName:               appDNSName,
 		AdditionalTags:           app.Tags,
 		CustomResourcesURLs:      customResourcesURLs,
 		AdjustVPCConfig:          o.adjustVPCConfig(),
 		ImportVPCConfig:          o.importVPCConfig(),
+		ResourceNames:            o.resourceNamesConfig(),
+		HTTPSListener:            o.httpsListenerConfig(),
+		Domain:                   o.envDomain,
+		ExternalInstances:        o.externalInstances,
+		IPv6:                     o.ipv6,
 		Version:                  deploy.LatestEnvTemplateVersion,
 	}
+	if !o.skipCostEstimate {
+		o.showCostEstimate(deployEnvInput)
+	}
 
 	if err := o.cleanUpDanglingRoles(o.appName, o.name); err != nil {
 		return err
@@ -572,6 +888,34 @@ func (o *initEnvOpts) deployEnv(app *config.Application, customResourcesURLs map
 	return nil
 }
 
+// showCostEstimate prints the approximate monthly cost of the environment and of a task running in it.
+// The estimate is informational, so failing to compute it doesn't stop the environment's creation.
+func (o *initEnvOpts) showCostEstimate(in *deploy.CreateEnvironmentInput) {
+	estimate, err := o.estimateCost(in)
+	if err != nil {
+		log.Warningf("Failed to estimate the monthly cost of environment %s: %v\n", o.name, err)
+		return
+	}
+	log.Infoln(estimate.HumanString())
+}
+
+func (o *initEnvOpts) estimateCost(in *deploy.CreateEnvironmentInput) (*cost.Estimate, error) {
+	tpl, err := o.newTemplater(in).Template()
+	if err != nil {
+		return nil, fmt.Errorf("render template: %w", err)
+	}
+	estimate, err := o.estimator.Estimate([]byte(tpl))
+	if err != nil {
+		return nil, err
+	}
+	task, err := o.estimator.FargateTask(defaultTaskCPU, defaultTaskMemory, defaultTaskCostCondition)
+	if err != nil {
+		return nil, err
+	}
+	estimate.Items = append(estimate.Items, task)
+	return estimate, nil
+}
+
 func (o *initEnvOpts) addToStackset(opts *deploycfn.AddEnvToAppOpts) error {
 	o.prog.Start(fmt.Sprintf(fmtAddEnvToAppStart, color.Emphasize(opts.EnvAccountID), color.Emphasize(opts.EnvRegion), color.HighlightUserInput(o.appName)))
 	if err := o.appDeployer.AddEnvToApp(opts); err != nil {
@@ -611,6 +955,25 @@ func (o *initEnvOpts) validateCredentials() error {
 	return nil
 }
 
+// showExternalInstanceActivation creates an activation for the environment's external instance role,
+// and prints the command that registers an on-premises instance to the environment's cluster.
+func (o *initEnvOpts) showExternalInstanceActivation(env *config.Environment) error {
+	clusterARN, err := o.clusters.ClusterARN(env.App, env.Name)
+	if err != nil {
+		return fmt.Errorf("get cluster of environment %s: %w", env.Name, err)
+	}
+	cluster := clusterARN[strings.LastIndex(clusterARN, "/")+1:]
+	role := fmt.Sprintf(fmtExternalInstanceRoleName, stack.NameForEnv(env.App, env.Name))
+	activation, err := o.activations.CreateActivation(role, externalInstanceRegistrationLimit)
+	if err != nil {
+		return err
+	}
+	log.Infof("Run the following command on up to %d on-premises instances to register them to environment %s:\n",
+		externalInstanceRegistrationLimit, color.HighlightUserInput(env.Name))
+	log.Infof("%s\n", color.HighlightCode(fmt.Sprintf(fmtECSAnywhereInstallCmd, env.Region, cluster, activation.ID, activation.Code)))
+	return nil
+}
+
 // cleanUpDanglingRoles deletes any IAM roles created for the same app and env that were left over from a previous
 // environment creation.
 func (o *initEnvOpts) cleanUpDanglingRoles(app, env string) error {
@@ -671,7 +1034,26 @@ func buildEnvInitCmd() *cobra.Command {
   Creates an environment with overrided CIDRs.
   /code $ copilot env init --override-vpc-cidr 10.1.0.0/16 \
   /code --override-public-cidrs 10.1.0.0/24,10.1.1.0/24 \
-  /code --override-private-cidrs 10.1.2.0/24,10.1.3.0/24`,
+  /code --override-private-cidrs 10.1.2.0/24,10.1.3.0/24
+
+  Creates an environment whose cluster, load balancer and log groups follow a naming convention.
+  /code $ copilot env init --name prod --cluster-name team-a-prod \
+  /code --alb-name team-a-prod-alb --log-group-prefix /team-a/ecs
+
+  Creates an environment that on-premises instances can join with ECS Anywhere.
+  /code $ copilot env init --name onprem --enable-external-instances
+
+  Creates an environment with subnets in three availability zones that follow your IP allocation plan.
+  /code $ copilot env init --name prod --override-vpc-cidr 172.20.0.0/20 --az-count 3 \
+  /code --override-public-cidrs 172.20.0.0/24,172.20.1.0/24,172.20.2.0/24 \
+  /code --override-private-cidrs 172.20.8.0/22,172.20.12.0/23,172.20.14.0/23
+
+  Creates an environment with a dual-stack VPC and load balancer that accept IPv6 traffic.
+  /code $ copilot env init --name prod --ipv6
+
+  Creates an environment whose HTTPS listener also serves a Private CA certificate and verifies client certificates.
+  /code $ copilot env init --name prod --import-cert-arns arn:aws:acm:us-west-2:123456789012:certificate/abc \
+  /code --ssl-policy ELBSecurityPolicy-TLS13-1-2-2021-06 --mtls-mode verify --mtls-ca-bundle s3://my-bucket/ca-bundle.pem`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitEnvOpts(vars)
 			if err != nil {
@@ -701,10 +1083,29 @@ func buildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringSliceVar(&vars.importVPC.PrivateSubnetIDs, privateSubnetsFlag, nil, privateSubnetsFlagDescription)
 
 	cmd.Flags().IPNetVar(&vars.adjustVPC.CIDR, vpcCIDRFlag, net.IPNet{}, vpcCIDRFlagDescription)
+	cmd.Flags().IntVar(&vars.adjustVPC.AZCount, azCountFlag, 0, azCountFlagDescription)
 	// TODO: use IPNetSliceVar when it is available (https://github.com/spf13/pflag/issues/273).
 	cmd.Flags().StringSliceVar(&vars.adjustVPC.PublicSubnetCIDRs, publicSubnetCIDRsFlag, nil, publicSubnetCIDRsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.adjustVPC.PrivateSubnetCIDRs, privateSubnetCIDRsFlag, nil, privateSubnetCIDRsFlagDescription)
 	cmd.Flags().BoolVar(&vars.defaultConfig, defaultConfigFlag, false, defaultConfigFlagDescription)
+	cmd.Flags().StringVar(&vars.taskExecutionRoleARN, taskExecutionRoleFlag, "", envTaskExecutionRoleFlagDescription)
+
+	cmd.Flags().StringVar(&vars.resourceNames.Cluster, clusterNameFlag, "", clusterNameFlagDescription)
+	cmd.Flags().StringVar(&vars.resourceNames.LoadBalancer, lbNameFlag, "", lbNameFlagDescription)
+	cmd.Flags().StringVar(&vars.resourceNames.LogGroupPrefix, logGroupPrefixFlag, "", logGroupPrefixFlagDescription)
+
+	cmd.Flags().StringVar(&vars.domain, domainNameFlag, "", envDomainFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.httpsListener.CertARNs, importCertARNsFlag, nil, importCertARNsFlagDescription)
+	cmd.Flags().StringVar(&vars.httpsListener.SSLPolicy, sslPolicyFlag, "", sslPolicyFlagDescription)
+	cmd.Flags().StringVar(&vars.httpsListener.MutualTLSMode, mutualTLSModeFlag, "", mutualTLSModeFlagDescription)
+	cmd.Flags().StringVar(&vars.httpsListener.CABundle, mutualTLSCABundleFlag, "", mutualTLSCABundleFlagDescription)
+	cmd.Flags().StringVar(&vars.httpsListener.HSTS, hstsFlag, "", hstsFlagDescription)
+	cmd.Flags().IntVar(&vars.httpsListener.HTTPRedirectCode, httpRedirectCodeFlag, 0, httpRedirectCodeFlagDescription)
+	cmd.Flags().BoolVar(&vars.httpsListener.DisableHTTPRedirect, noHTTPRedirectFlag, false, noHTTPRedirectFlagDescription)
+
+	cmd.Flags().BoolVar(&vars.externalInstances, enableExternalInstancesFlag, false, enableExternalInstancesFlagDescription)
+	cmd.Flags().BoolVar(&vars.ipv6, ipv6Flag, false, ipv6FlagDescription)
+	cmd.Flags().BoolVar(&vars.skipCostEstimate, skipCostEstimateFlag, false, skipCostEstimateFlagDescription)
 
 	flags := pflag.NewFlagSet("Common", pflag.ContinueOnError)
 	flags.AddFlag(cmd.Flags().Lookup(appFlag))
@@ -716,6 +1117,10 @@ func buildEnvInitCmd() *cobra.Command {
 	flags.AddFlag(cmd.Flags().Lookup(regionFlag))
 	flags.AddFlag(cmd.Flags().Lookup(defaultConfigFlag))
 	flags.AddFlag(cmd.Flags().Lookup(prodEnvFlag))
+	flags.AddFlag(cmd.Flags().Lookup(enableExternalInstancesFlag))
+	flags.AddFlag(cmd.Flags().Lookup(ipv6Flag))
+	flags.AddFlag(cmd.Flags().Lookup(skipCostEstimateFlag))
+	flags.AddFlag(cmd.Flags().Lookup(taskExecutionRoleFlag))
 
 	resourcesImportFlag := pflag.NewFlagSet("Import Existing Resources", pflag.ContinueOnError)
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(vpcIDFlag))
@@ -724,15 +1129,33 @@ func buildEnvInitCmd() *cobra.Command {
 
 	resourcesConfigFlag := pflag.NewFlagSet("Configure Default Resources", pflag.ContinueOnError)
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(vpcCIDRFlag))
+	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(azCountFlag))
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(publicSubnetCIDRsFlag))
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(privateSubnetCIDRsFlag))
 
+	resourceNamesFlag := pflag.NewFlagSet("Override Resource Names", pflag.ContinueOnError)
+	resourceNamesFlag.AddFlag(cmd.Flags().Lookup(clusterNameFlag))
+	resourceNamesFlag.AddFlag(cmd.Flags().Lookup(lbNameFlag))
+	resourceNamesFlag.AddFlag(cmd.Flags().Lookup(logGroupPrefixFlag))
+
+	httpsListenerFlag := pflag.NewFlagSet("HTTPS Listener", pflag.ContinueOnError)
+	httpsListenerFlag.AddFlag(cmd.Flags().Lookup(domainNameFlag))
+	httpsListenerFlag.AddFlag(cmd.Flags().Lookup(importCertARNsFlag))
+	httpsListenerFlag.AddFlag(cmd.Flags().Lookup(sslPolicyFlag))
+	httpsListenerFlag.AddFlag(cmd.Flags().Lookup(mutualTLSModeFlag))
+	httpsListenerFlag.AddFlag(cmd.Flags().Lookup(mutualTLSCABundleFlag))
+	httpsListenerFlag.AddFlag(cmd.Flags().Lookup(hstsFlag))
+	httpsListenerFlag.AddFlag(cmd.Flags().Lookup(httpRedirectCodeFlag))
+	httpsListenerFlag.AddFlag(cmd.Flags().Lookup(noHTTPRedirectFlag))
+
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
-		"sections":                    "Common,Import Existing Resources,Configure Default Resources",
+		"sections":                    "Common,Import Existing Resources,Configure Default Resources,Override Resource Names,HTTPS Listener",
 		"Common":                      flags.FlagUsages(),
 		"Import Existing Resources":   resourcesImportFlag.FlagUsages(),
 		"Configure Default Resources": resourcesConfigFlag.FlagUsages(),
+		"Override Resource Names":     resourceNamesFlag.FlagUsages(),
+		"HTTPS Listener":              httpsListenerFlag.FlagUsages(),
 	}
 
 	cmd.SetUsageTemplate(`{{h1 "Usage"}}{{if .Runnable}}
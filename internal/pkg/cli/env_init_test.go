@@ -14,7 +14,9 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/cost"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -39,10 +41,16 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 		inEnvName     string
 		inAppName     string
 		inDefault     bool
+		inIPv6        bool
 		inVPCID       string
 		inPublicIDs   []string
 		inVPCCIDR     net.IPNet
+		inAZCount     int
 		inPublicCIDRs []string
+		inNames       envResourceNamesVars
+		inListener    httpsListenerVars
+		inDomain      string
+		inExecRole    string
 
 		inProfileName     string
 		inAccessKeyID     string
@@ -82,6 +90,160 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 
 			wantedErrMsg: fmt.Sprintf("cannot import or configure vpc if --%s is set", defaultConfigFlag),
 		},
+		"invalid number of availability zones": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inAZCount: 4,
+
+			wantedErrMsg: "--az-count must be 2 or 3",
+		},
+		"cannot import vpc if ipv6 flag is set": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inIPv6:    true,
+			inVPCID:   "mockID",
+
+			wantedErrMsg: "cannot import vpc if --ipv6 is set",
+		},
+		"invalid domain of the environment": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inDomain:  "localhost",
+
+			wantedErrMsg: "domain name localhost is invalid: value must contain at least one '.' character",
+		},
+		"valid resource names": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inNames: envResourceNamesVars{
+				Cluster:        "team-a-cluster",
+				LoadBalancer:   "team-a-alb",
+				LogGroupPrefix: "/team-a/ecs",
+			},
+		},
+		"invalid cluster name": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inNames: envResourceNamesVars{
+				Cluster: "team.a",
+			},
+
+			wantedErrMsg: fmt.Sprintf("--cluster-name: %s", errClusterNameBadFormat),
+		},
+		"invalid load balancer name": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inNames: envResourceNamesVars{
+				LoadBalancer: "internal-alb",
+			},
+
+			wantedErrMsg: fmt.Sprintf("--alb-name: %s", errLBNameBadFormat),
+		},
+		"invalid log group prefix": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inNames: envResourceNamesVars{
+				LogGroupPrefix: "team-a",
+			},
+
+			wantedErrMsg: fmt.Sprintf("--log-group-prefix: %s", errLogGroupPrefixBadFormat),
+		},
+		"invalid imported certificate ARN": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inListener: httpsListenerVars{
+				CertARNs: []string{"arn:aws:iam::123456789012:role/DNSAdmin"},
+			},
+
+			wantedErrMsg: fmt.Sprintf("--import-cert-arns: %s", errCertARNInvalid),
+		},
+		"invalid mutual TLS mode": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inListener: httpsListenerVars{
+				MutualTLSMode: "strict",
+			},
+
+			wantedErrMsg: `invalid mutual TLS mode strict: must be one of "verify", "passthrough"`,
+		},
+		"verify mode requires a CA bundle": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inListener: httpsListenerVars{
+				MutualTLSMode: "verify",
+			},
+
+			wantedErrMsg: "--mtls-ca-bundle is required for mutual TLS mode verify",
+		},
+		"invalid CA bundle URI": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inListener: httpsListenerVars{
+				MutualTLSMode: "verify",
+				CABundle:      "https://my-bucket.s3.amazonaws.com/ca-bundle.pem",
+			},
+
+			wantedErrMsg: fmt.Sprintf("--mtls-ca-bundle: %s", errS3ObjectURIInvalid),
+		},
+		"passthrough mode cannot have a CA bundle": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inListener: httpsListenerVars{
+				MutualTLSMode: "passthrough",
+				CABundle:      "s3://my-bucket/ca-bundle.pem",
+			},
+
+			wantedErrMsg: "--mtls-ca-bundle cannot be used with mutual TLS mode passthrough",
+		},
+		"CA bundle requires a mutual TLS mode": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inListener: httpsListenerVars{
+				CABundle: "s3://my-bucket/ca-bundle.pem",
+			},
+
+			wantedErrMsg: "--mtls-ca-bundle requires --mtls-mode verify",
+		},
+		"invalid HSTS header": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inListener: httpsListenerVars{
+				HSTS: "31536000",
+			},
+
+			wantedErrMsg: fmt.Sprintf("--hsts: %s", errHSTSBadFormat),
+		},
+		"invalid redirect status code": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inListener: httpsListenerVars{
+				HTTPRedirectCode: 307,
+			},
+
+			wantedErrMsg: "--http-redirect-code must be 301 or 302",
+		},
+		"redirect status code with redirect disabled": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inListener: httpsListenerVars{
+				HTTPRedirectCode:    302,
+				DisableHTTPRedirect: true,
+			},
+
+			wantedErrMsg: "cannot specify both --http-redirect-code and --no-http-redirect",
+		},
+		"valid HTTPS listener settings": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inListener: httpsListenerVars{
+				CertARNs:         []string{"arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012"},
+				SSLPolicy:        "ELBSecurityPolicy-TLS13-1-2-2021-06",
+				MutualTLSMode:    "verify",
+				CABundle:         "s3://my-bucket/ca-bundle.pem",
+				HSTS:             "max-age=63072000; includeSubDomains; preload",
+				HTTPRedirectCode: 302,
+			},
+		},
 		"should err if both profile and access key id are set": {
 			inAppName:     "phonetool",
 			inEnvName:     "test",
@@ -106,6 +268,18 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 
 			wantedErrMsg: "cannot specify both --profile and --aws-session-token",
 		},
+		"invalid task execution role": {
+			inEnvName:  "test-pdx",
+			inAppName:  "phonetool",
+			inExecRole: "ecsTaskExecutionRole",
+
+			wantedErrMsg: "task execution role ARN ecsTaskExecutionRole is invalid: value must be an IAM role ARN (example: arn:aws:iam::123456789012:role/DNSAdmin)",
+		},
+		"valid task execution role": {
+			inEnvName:  "test-pdx",
+			inAppName:  "phonetool",
+			inExecRole: "arn:aws:iam::123456789012:role/ecsTaskExecutionRole",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -115,16 +289,23 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 				initEnvVars: initEnvVars{
 					name:          tc.inEnvName,
 					defaultConfig: tc.inDefault,
+					ipv6:          tc.inIPv6,
 					adjustVPC: adjustVPCVars{
 						PublicSubnetCIDRs: tc.inPublicCIDRs,
 						CIDR:              tc.inVPCCIDR,
+						AZCount:           tc.inAZCount,
 					},
 					importVPC: importVPCVars{
 						PublicSubnetIDs: tc.inPublicIDs,
 						ID:              tc.inVPCID,
 					},
-					appName: tc.inAppName,
-					profile: tc.inProfileName,
+					resourceNames: tc.inNames,
+					httpsListener: tc.inListener,
+					domain:        tc.inDomain,
+					appName:       tc.inAppName,
+					profile:       tc.inProfileName,
+
+					taskExecutionRoleARN: tc.inExecRole,
 					tempCreds: tempCredsVars{
 						AccessKeyID:     tc.inAccessKeyID,
 						SecretAccessKey: tc.inSecretAccessKey,
@@ -148,12 +329,13 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 
 func TestInitEnvOpts_Ask(t *testing.T) {
 	const (
-		mockApp         = "test-app"
-		mockEnv         = "test"
-		mockProfile     = "default"
-		mockVPCCIDR     = "10.10.10.10/24"
-		mockSubnetCIDRs = "10.10.10.10/24,10.10.10.10/24"
-		mockRegion      = "us-west-2"
+		mockApp          = "test-app"
+		mockEnv          = "test"
+		mockProfile      = "default"
+		mockVPCCIDR      = "10.10.10.10/24"
+		mockPublicCIDRs  = "10.10.10.0/26,10.10.10.64/26"
+		mockPrivateCIDRs = "10.10.10.128/26,10.10.10.192/26"
+		mockRegion       = "us-west-2"
 	)
 	mockErr := errors.New("some error")
 	mockSession := &session.Session{
@@ -169,6 +351,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 		inTempCreds     tempCredsVars
 		inRegion        string
 		inDefault       bool
+		inIPv6          bool
 		inImportVPCVars importVPCVars
 		inAdjustVPCVars adjustVPCVars
 
@@ -306,6 +489,18 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 					Return(envInitDefaultConfigSelectOption, nil)
 			},
 		},
+		"should not offer to import resources for a dual-stack environment": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inIPv6:    true,
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, "",
+					[]string{envInitDefaultConfigSelectOption, envInitAdjustEnvResourcesSelectOption}).
+					Return(envInitDefaultConfigSelectOption, nil)
+			},
+		},
 		"fail to select VPC": {
 			inAppName: mockApp,
 			inEnv:     mockEnv,
@@ -446,7 +641,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				m.prompt.EXPECT().Get(envInitVPCCIDRPrompt, envInitVPCCIDRPromptHelp, gomock.Any(), gomock.Any()).
 					Return(mockVPCCIDR, nil)
 				m.prompt.EXPECT().Get(envInitPublicCIDRPrompt, envInitPublicCIDRPromptHelp, gomock.Any(), gomock.Any()).
-					Return(mockSubnetCIDRs, nil)
+					Return(mockPublicCIDRs, nil)
 				m.prompt.EXPECT().Get(envInitPrivateCIDRPrompt, envInitPrivateCIDRPromptHelp, gomock.Any(), gomock.Any()).
 					Return("", mockErr)
 			},
@@ -463,9 +658,9 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				m.prompt.EXPECT().Get(envInitVPCCIDRPrompt, envInitVPCCIDRPromptHelp, gomock.Any(), gomock.Any()).
 					Return(mockVPCCIDR, nil)
 				m.prompt.EXPECT().Get(envInitPublicCIDRPrompt, envInitPublicCIDRPromptHelp, gomock.Any(), gomock.Any()).
-					Return(mockSubnetCIDRs, nil)
+					Return(mockPublicCIDRs, nil)
 				m.prompt.EXPECT().Get(envInitPrivateCIDRPrompt, envInitPrivateCIDRPromptHelp, gomock.Any(), gomock.Any()).
-					Return(mockSubnetCIDRs, nil)
+					Return(mockPrivateCIDRs, nil)
 			},
 		},
 		"success with adjusting default env config with flags": {
@@ -477,14 +672,100 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 					IP:   net.IP{10, 1, 232, 0},
 					Mask: net.IPMask{255, 255, 255, 0},
 				},
-				PrivateSubnetCIDRs: []string{"mockPrivateCIDR"},
-				PublicSubnetCIDRs:  []string{"mockPublicCIDR"},
+				PrivateSubnetCIDRs: []string{"10.1.232.128/26", "10.1.232.192/26"},
+				PublicSubnetCIDRs:  []string{"10.1.232.0/26", "10.1.232.64/26"},
 			},
 			setupMocks: func(m initEnvMocks) {
 				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
 				m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, gomock.Any(), gomock.Any()).Times(0)
 			},
 		},
+		"success with subnets in three availability zones": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inAdjustVPCVars: adjustVPCVars{
+				CIDR: net.IPNet{
+					IP:   net.IP{172, 20, 0, 0},
+					Mask: net.IPMask{255, 255, 240, 0},
+				},
+				AZCount:            3,
+				PublicSubnetCIDRs:  []string{"172.20.0.0/24", "172.20.1.0/24", "172.20.2.0/24"},
+				PrivateSubnetCIDRs: []string{"172.20.8.0/22", "172.20.12.0/23", "172.20.14.0/23"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+			},
+		},
+		"fail if the subnets don't match the number of availability zones": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inAdjustVPCVars: adjustVPCVars{
+				CIDR: net.IPNet{
+					IP:   net.IP{172, 20, 0, 0},
+					Mask: net.IPMask{255, 255, 240, 0},
+				},
+				AZCount:            3,
+				PublicSubnetCIDRs:  []string{"172.20.0.0/24", "172.20.1.0/24"},
+				PrivateSubnetCIDRs: []string{"172.20.2.0/24", "172.20.3.0/24"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+			},
+			wantedError: errors.New("3 public and 3 private subnets must be specified for --az-count 3"),
+		},
+		"fail if there are more private than public subnets": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inAdjustVPCVars: adjustVPCVars{
+				CIDR: net.IPNet{
+					IP:   net.IP{10, 0, 0, 0},
+					Mask: net.IPMask{255, 255, 0, 0},
+				},
+				PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+				PrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24", "10.0.4.0/24"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+			},
+			wantedError: errors.New("the number of private subnets 3 must not exceed the number of public subnets 2"),
+		},
+		"fail if a subnet is not within the VPC": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inAdjustVPCVars: adjustVPCVars{
+				CIDR: net.IPNet{
+					IP:   net.IP{10, 0, 0, 0},
+					Mask: net.IPMask{255, 255, 0, 0},
+				},
+				PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.1.0.0/24"},
+				PrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+			},
+			wantedError: errors.New("subnet CIDR 10.1.0.0/24 is not within VPC CIDR 10.0.0.0/16"),
+		},
+		"fail if subnets overlap": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inAdjustVPCVars: adjustVPCVars{
+				CIDR: net.IPNet{
+					IP:   net.IP{10, 0, 0, 0},
+					Mask: net.IPMask{255, 255, 0, 0},
+				},
+				PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+				PrivateSubnetCIDRs: []string{"10.0.0.0/22", "10.0.4.0/22"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+			},
+			wantedError: errors.New("subnet CIDR 10.0.0.0/22 overlaps with subnet CIDR 10.0.0.0/24"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -511,6 +792,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 					tempCreds:     tc.inTempCreds,
 					region:        tc.inRegion,
 					defaultConfig: tc.inDefault,
+					ipv6:          tc.inIPv6,
 					adjustVPC:     tc.inAdjustVPCVars,
 					importVPC:     tc.inImportVPCVars,
 				},
@@ -538,7 +820,13 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 
 func TestInitEnvOpts_Execute(t *testing.T) {
 	testCases := map[string]struct {
-		inProd bool
+		inProd     bool
+		inNames    envResourceNamesVars
+		inExternal bool
+		inIPv6     bool
+		inListener httpsListenerVars
+		inDomain   string
+		inExecRole string
 
 		expectStore             func(m *mocks.Mockstore)
 		expectDeployer          func(m *mocks.Mockdeployer)
@@ -548,6 +836,11 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 		expectCFN               func(m *mocks.MockstackExistChecker)
 		expectAppCFN            func(m *mocks.MockappResourcesGetter)
 		expectResourcesUploader func(m *mocks.MockcustomResourcesUploader)
+		expectClusters          func(m *mocks.MockclusterGetter)
+		expectActivations       func(m *mocks.MockactivationCreator)
+		expectTemplater         func(m *mocks.Mocktemplater)
+		expectEstimator         func(m *mocks.MockcostEstimator)
+		expectRoute53           func(m *mocks.MockdomainHostedZoneGetter)
 
 		wantedErrorS string
 	}{
@@ -558,6 +851,27 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 
 			wantedErrorS: "some error",
 		},
+		"errors if the HTTPS listener is configured for an application without a domain": {
+			inListener: httpsListenerVars{
+				SSLPolicy: "ELBSecurityPolicy-TLS13-1-2-2021-06",
+			},
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+
+			wantedErrorS: "configure the HTTPS listener of environment test: application phonetool does not have a domain name and --domain is not set",
+		},
+		"errors if the hosted zone of the environment's domain cannot be found": {
+			inDomain: "dev.example.com",
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectRoute53: func(m *mocks.MockdomainHostedZoneGetter) {
+				m.EXPECT().DomainHostedZoneID("dev.example.com").Return("", errors.New("some error"))
+			},
+
+			wantedErrorS: "get hosted zone ID of domain dev.example.com: some error",
+		},
 		"returns identity get error": {
 			expectStore: func(m *mocks.Mockstore) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
@@ -785,7 +1099,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				m.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(nil, nil)
 			},
 		},
-		"skips creating stack if environment stack already exists": {
+		"prints the cost estimate before deploying the environment": {
 			expectStore: func(m *mocks.Mockstore) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
 				m.EXPECT().CreateEnvironment(&config.Environment{
@@ -800,28 +1114,23 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			},
 			expectIAM: func(m *mocks.MockroleManager) {
 				m.EXPECT().CreateECSServiceLinkedRole().Return(nil)
-				// Don't attempt to delete any roles since an environment stack already exists.
-				m.EXPECT().ListRoleTags(gomock.Any()).Times(0)
+				m.EXPECT().ListRoleTags(gomock.Eq("phonetool-test-CFNExecutionRole")).Return(nil, errors.New("does not exist"))
+				m.EXPECT().ListRoleTags(gomock.Eq("phonetool-test-EnvManagerRole")).Return(nil, errors.New("does not exist"))
 			},
 			expectCFN: func(m *mocks.MockstackExistChecker) {
-				m.EXPECT().Exists("phonetool-test").Return(true, nil)
+				m.EXPECT().Exists("phonetool-test").Return(false, nil)
 			},
 			expectProgress: func(m *mocks.Mockprogress) {
 				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "us-west-2", "phonetool"))
 				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "1234", "us-west-2", "phonetool"))
 			},
 			expectDeployer: func(m *mocks.Mockdeployer) {
-				m.EXPECT().DeployAndRenderEnvironment(gomock.Any(), &deploy.CreateEnvironmentInput{
-					Name:                     "test",
-					AppName:                  "phonetool",
-					ToolsAccountPrincipalARN: "some arn",
-					CustomResourcesURLs:      map[string]string{"mockCustomResource": "mockURL"},
-					Version:                  deploy.LatestEnvTemplateVersion,
-				}).Return(&cloudformation.ErrStackAlreadyExists{})
+				m.EXPECT().DeployAndRenderEnvironment(gomock.Any(), gomock.Any()).Return(nil)
 				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
 					AccountID: "1234",
 					Region:    "mars-1",
 					Name:      "test",
+					Prod:      false,
 					App:       "phonetool",
 				}, nil)
 				m.EXPECT().AddEnvToApp(gomock.Any()).Return(nil)
@@ -833,69 +1142,54 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 					}, nil)
 			},
 			expectResourcesUploader: func(m *mocks.MockcustomResourcesUploader) {
-				m.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{"mockCustomResource": "mockURL"}, nil)
-			},
-		},
-		"failed to delegate DNS (app has Domain and env and apps are different)": {
-			expectStore: func(m *mocks.Mockstore) {
-				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", AccountID: "1234", Domain: "amazon.com"}, nil)
-			},
-			expectIdentity: func(m *mocks.MockidentityService) {
-				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "4567"}, nil).Times(1)
+				m.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(nil, nil)
 			},
-			expectProgress: func(m *mocks.Mockprogress) {
-				m.EXPECT().Start(fmt.Sprintf(fmtDNSDelegationStart, "4567"))
-				m.EXPECT().Stop(log.Serrorf(fmtDNSDelegationFailed, "4567"))
+			expectTemplater: func(m *mocks.Mocktemplater) {
+				m.EXPECT().Template().Return("Resources: {}", nil)
 			},
-			expectDeployer: func(m *mocks.Mockdeployer) {
-				m.EXPECT().DelegateDNSPermissions(gomock.Any(), "4567").Return(errors.New("some error"))
+			expectEstimator: func(m *mocks.MockcostEstimator) {
+				m.EXPECT().Estimate([]byte("Resources: {}")).Return(&cost.Estimate{Region: "us-west-2"}, nil)
+				m.EXPECT().FargateTask(256, 512, defaultTaskCostCondition).Return(cost.LineItem{}, nil)
 			},
-			wantedErrorS: "granting DNS permissions: some error",
 		},
-		"success with DNS Delegation (app has Domain and env and app are different)": {
+		"deploys the environment even if its cost can't be estimated": {
 			expectStore: func(m *mocks.Mockstore) {
-				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", AccountID: "1234", Domain: "amazon.com"}, nil)
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
 				m.EXPECT().CreateEnvironment(&config.Environment{
 					App:       "phonetool",
 					Name:      "test",
-					AccountID: "4567",
-					Region:    "us-west-2",
+					AccountID: "1234",
+					Region:    "mars-1",
 				}).Return(nil)
 			},
 			expectIdentity: func(m *mocks.MockidentityService) {
-				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "4567"}, nil).Times(2)
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "1234"}, nil).Times(2)
 			},
 			expectIAM: func(m *mocks.MockroleManager) {
 				m.EXPECT().CreateECSServiceLinkedRole().Return(nil)
-				m.EXPECT().ListRoleTags(gomock.Any()).
-					Return(nil, errors.New("does not exist")).AnyTimes()
+				m.EXPECT().ListRoleTags(gomock.Eq("phonetool-test-CFNExecutionRole")).Return(nil, errors.New("does not exist"))
+				m.EXPECT().ListRoleTags(gomock.Eq("phonetool-test-EnvManagerRole")).Return(nil, errors.New("does not exist"))
 			},
 			expectCFN: func(m *mocks.MockstackExistChecker) {
 				m.EXPECT().Exists("phonetool-test").Return(false, nil)
 			},
 			expectProgress: func(m *mocks.Mockprogress) {
-				m.EXPECT().Start(fmt.Sprintf(fmtDNSDelegationStart, "4567"))
-				m.EXPECT().Stop(log.Ssuccessf(fmtDNSDelegationComplete, "4567"))
-				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "4567", "us-west-2", "phonetool"))
-				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "4567", "us-west-2", "phonetool"))
+				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "us-west-2", "phonetool"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "1234", "us-west-2", "phonetool"))
 			},
 			expectDeployer: func(m *mocks.Mockdeployer) {
-				m.EXPECT().DelegateDNSPermissions(gomock.Any(), "4567").Return(nil)
 				m.EXPECT().DeployAndRenderEnvironment(gomock.Any(), gomock.Any()).Return(nil)
 				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
-					AccountID: "4567",
-					Region:    "us-west-2",
+					AccountID: "1234",
+					Region:    "mars-1",
 					Name:      "test",
+					Prod:      false,
 					App:       "phonetool",
 				}, nil)
 				m.EXPECT().AddEnvToApp(gomock.Any()).Return(nil)
 			},
 			expectAppCFN: func(m *mocks.MockappResourcesGetter) {
-				m.EXPECT().GetAppResourcesByRegion(&config.Application{
-					Name:      "phonetool",
-					AccountID: "1234",
-					Domain:    "amazon.com",
-				}, "us-west-2").
+				m.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
 					Return(&stack.AppRegionalResources{
 						S3Bucket: "mockBucket",
 					}, nil)
@@ -903,31 +1197,543 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			expectResourcesUploader: func(m *mocks.MockcustomResourcesUploader) {
 				m.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(nil, nil)
 			},
+			expectTemplater: func(m *mocks.Mocktemplater) {
+				m.EXPECT().Template().Return("Resources: {}", nil)
+			},
+			expectEstimator: func(m *mocks.MockcostEstimator) {
+				m.EXPECT().Estimate([]byte("Resources: {}")).Return(nil, errors.New("some error"))
+			},
 		},
-	}
-
-	for name, tc := range testCases {
-		t.Run(name, func(t *testing.T) {
-			// GIVEN
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
+		"stores the task execution role shared by the workloads of the environment": {
+			inExecRole: "arn:aws:iam::1234:role/ecsTaskExecutionRole",
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().CreateEnvironment(&config.Environment{
+					App:       "phonetool",
+					Name:      "test",
+					AccountID: "1234",
+					Region:    "mars-1",
 
-			mockStore := mocks.NewMockstore(ctrl)
-			mockDeployer := mocks.NewMockdeployer(ctrl)
-			mockIdentity := mocks.NewMockidentityService(ctrl)
-			mockProgress := mocks.NewMockprogress(ctrl)
-			mockAppCFN := mocks.NewMockappResourcesGetter(ctrl)
-			mockIAM := mocks.NewMockroleManager(ctrl)
-			mockCFN := mocks.NewMockstackExistChecker(ctrl)
-			mockResourcesUploader := mocks.NewMockcustomResourcesUploader(ctrl)
-			mockUploader := mocks.NewMockzipAndUploader(ctrl)
-			if tc.expectStore != nil {
-				tc.expectStore(mockStore)
-			}
-			if tc.expectDeployer != nil {
-				tc.expectDeployer(mockDeployer)
-			}
-			if tc.expectIdentity != nil {
+					TaskExecutionRoleARN: "arn:aws:iam::1234:role/ecsTaskExecutionRole",
+				}).Return(nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "1234"}, nil).Times(2)
+			},
+			expectIAM: func(m *mocks.MockroleManager) {
+				m.EXPECT().CreateECSServiceLinkedRole().Return(nil)
+			},
+			expectCFN: func(m *mocks.MockstackExistChecker) {
+				m.EXPECT().Exists("phonetool-test").Return(true, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "us-west-2", "phonetool"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "1234", "us-west-2", "phonetool"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployAndRenderEnvironment(gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					AccountID: "1234",
+					Region:    "mars-1",
+					Name:      "test",
+					App:       "phonetool",
+				}, nil)
+				m.EXPECT().AddEnvToApp(gomock.Any()).Return(nil)
+			},
+			expectAppCFN: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+					Return(&stack.AppRegionalResources{
+						S3Bucket: "mockBucket",
+					}, nil)
+			},
+			expectResourcesUploader: func(m *mocks.MockcustomResourcesUploader) {
+				m.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(nil, nil)
+			},
+		},
+		"skips creating stack if environment stack already exists": {
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().CreateEnvironment(&config.Environment{
+					App:       "phonetool",
+					Name:      "test",
+					AccountID: "1234",
+					Region:    "mars-1",
+				}).Return(nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "1234"}, nil).Times(2)
+			},
+			expectIAM: func(m *mocks.MockroleManager) {
+				m.EXPECT().CreateECSServiceLinkedRole().Return(nil)
+				// Don't attempt to delete any roles since an environment stack already exists.
+				m.EXPECT().ListRoleTags(gomock.Any()).Times(0)
+			},
+			expectCFN: func(m *mocks.MockstackExistChecker) {
+				m.EXPECT().Exists("phonetool-test").Return(true, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "us-west-2", "phonetool"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "1234", "us-west-2", "phonetool"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployAndRenderEnvironment(gomock.Any(), &deploy.CreateEnvironmentInput{
+					Name:                     "test",
+					AppName:                  "phonetool",
+					ToolsAccountPrincipalARN: "some arn",
+					CustomResourcesURLs:      map[string]string{"mockCustomResource": "mockURL"},
+					Version:                  deploy.LatestEnvTemplateVersion,
+				}).Return(&cloudformation.ErrStackAlreadyExists{})
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					AccountID: "1234",
+					Region:    "mars-1",
+					Name:      "test",
+					App:       "phonetool",
+				}, nil)
+				m.EXPECT().AddEnvToApp(gomock.Any()).Return(nil)
+			},
+			expectAppCFN: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+					Return(&stack.AppRegionalResources{
+						S3Bucket: "mockBucket",
+					}, nil)
+			},
+			expectResourcesUploader: func(m *mocks.MockcustomResourcesUploader) {
+				m.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{"mockCustomResource": "mockURL"}, nil)
+			},
+		},
+		"deploys and stores the overridden resource names": {
+			inNames: envResourceNamesVars{
+				Cluster:        "team-a-cluster",
+				LogGroupPrefix: "/team-a/ecs",
+			},
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().CreateEnvironment(&config.Environment{
+					App:       "phonetool",
+					Name:      "test",
+					AccountID: "1234",
+					Region:    "mars-1",
+					CustomConfig: &config.CustomizeEnv{
+						ResourceNames: &config.EnvResourceNames{
+							Cluster:        "team-a-cluster",
+							LogGroupPrefix: "/team-a/ecs",
+						},
+					},
+				}).Return(nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "1234"}, nil).Times(2)
+			},
+			expectIAM: func(m *mocks.MockroleManager) {
+				m.EXPECT().CreateECSServiceLinkedRole().Return(nil)
+			},
+			expectCFN: func(m *mocks.MockstackExistChecker) {
+				m.EXPECT().Exists("phonetool-test").Return(true, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "us-west-2", "phonetool"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "1234", "us-west-2", "phonetool"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployAndRenderEnvironment(gomock.Any(), &deploy.CreateEnvironmentInput{
+					Name:                     "test",
+					AppName:                  "phonetool",
+					ToolsAccountPrincipalARN: "some arn",
+					CustomResourcesURLs:      map[string]string{"mockCustomResource": "mockURL"},
+					ResourceNames: &config.EnvResourceNames{
+						Cluster:        "team-a-cluster",
+						LogGroupPrefix: "/team-a/ecs",
+					},
+					Version: deploy.LatestEnvTemplateVersion,
+				}).Return(nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					AccountID: "1234",
+					Region:    "mars-1",
+					Name:      "test",
+					App:       "phonetool",
+				}, nil)
+				m.EXPECT().AddEnvToApp(gomock.Any()).Return(nil)
+			},
+			expectAppCFN: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+					Return(&stack.AppRegionalResources{
+						S3Bucket: "mockBucket",
+					}, nil)
+			},
+			expectResourcesUploader: func(m *mocks.MockcustomResourcesUploader) {
+				m.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{"mockCustomResource": "mockURL"}, nil)
+			},
+		},
+		"deploys with external instances and creates an activation": {
+			inExternal: true,
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().CreateEnvironment(&config.Environment{
+					App:       "phonetool",
+					Name:      "test",
+					AccountID: "1234",
+					Region:    "mars-1",
+					CustomConfig: &config.CustomizeEnv{
+						ExternalInstances: true,
+					},
+				}).Return(nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "1234"}, nil).Times(2)
+			},
+			expectIAM: func(m *mocks.MockroleManager) {
+				m.EXPECT().CreateECSServiceLinkedRole().Return(nil)
+			},
+			expectCFN: func(m *mocks.MockstackExistChecker) {
+				m.EXPECT().Exists("phonetool-test").Return(true, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "us-west-2", "phonetool"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "1234", "us-west-2", "phonetool"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployAndRenderEnvironment(gomock.Any(), &deploy.CreateEnvironmentInput{
+					Name:                     "test",
+					AppName:                  "phonetool",
+					ToolsAccountPrincipalARN: "some arn",
+					CustomResourcesURLs:      map[string]string{"mockCustomResource": "mockURL"},
+					ExternalInstances:        true,
+					Version:                  deploy.LatestEnvTemplateVersion,
+				}).Return(nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					AccountID: "1234",
+					Region:    "mars-1",
+					Name:      "test",
+					App:       "phonetool",
+				}, nil)
+				m.EXPECT().AddEnvToApp(gomock.Any()).Return(nil)
+			},
+			expectAppCFN: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+					Return(&stack.AppRegionalResources{
+						S3Bucket: "mockBucket",
+					}, nil)
+			},
+			expectResourcesUploader: func(m *mocks.MockcustomResourcesUploader) {
+				m.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{"mockCustomResource": "mockURL"}, nil)
+			},
+			expectClusters: func(m *mocks.MockclusterGetter) {
+				m.EXPECT().ClusterARN("phonetool", "test").Return("arn:aws:ecs:mars-1:1234:cluster/phonetool-test-Cluster-abc", nil)
+			},
+			expectActivations: func(m *mocks.MockactivationCreator) {
+				m.EXPECT().CreateActivation("phonetool-test-ExternalInstanceRole", externalInstanceRegistrationLimit).
+					Return(&ssm.Activation{ID: "id", Code: "code"}, nil)
+			},
+		},
+		"deploys a dual-stack environment": {
+			inIPv6: true,
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().CreateEnvironment(&config.Environment{
+					App:       "phonetool",
+					Name:      "test",
+					AccountID: "1234",
+					Region:    "mars-1",
+					CustomConfig: &config.CustomizeEnv{
+						IPv6: true,
+					},
+				}).Return(nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "1234"}, nil).Times(2)
+			},
+			expectIAM: func(m *mocks.MockroleManager) {
+				m.EXPECT().CreateECSServiceLinkedRole().Return(nil)
+			},
+			expectCFN: func(m *mocks.MockstackExistChecker) {
+				m.EXPECT().Exists("phonetool-test").Return(true, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "us-west-2", "phonetool"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "1234", "us-west-2", "phonetool"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployAndRenderEnvironment(gomock.Any(), &deploy.CreateEnvironmentInput{
+					Name:                     "test",
+					AppName:                  "phonetool",
+					ToolsAccountPrincipalARN: "some arn",
+					CustomResourcesURLs:      map[string]string{"mockCustomResource": "mockURL"},
+					IPv6:                     true,
+					Version:                  deploy.LatestEnvTemplateVersion,
+				}).Return(nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					AccountID: "1234",
+					Region:    "mars-1",
+					Name:      "test",
+					App:       "phonetool",
+				}, nil)
+				m.EXPECT().AddEnvToApp(gomock.Any()).Return(nil)
+			},
+			expectAppCFN: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+					Return(&stack.AppRegionalResources{
+						S3Bucket: "mockBucket",
+					}, nil)
+			},
+			expectResourcesUploader: func(m *mocks.MockcustomResourcesUploader) {
+				m.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{"mockCustomResource": "mockURL"}, nil)
+			},
+		},
+		"deploys an environment with its own domain instead of a subdomain of the application's domain": {
+			inDomain: "dev.example.com",
+			inListener: httpsListenerVars{
+				SSLPolicy: "ELBSecurityPolicy-TLS13-1-2-2021-06",
+			},
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", AccountID: "1234", Domain: "example.com"}, nil)
+				m.EXPECT().CreateEnvironment(&config.Environment{
+					App:       "phonetool",
+					Name:      "test",
+					AccountID: "1234",
+					Region:    "mars-1",
+					CustomConfig: &config.CustomizeEnv{
+						HTTPSListener: &config.HTTPSListener{
+							SSLPolicy: "ELBSecurityPolicy-TLS13-1-2-2021-06",
+						},
+						Domain: &config.EnvDomain{
+							Name:         "dev.example.com",
+							HostedZoneID: "Z0123",
+						},
+					},
+				}).Return(nil)
+			},
+			expectRoute53: func(m *mocks.MockdomainHostedZoneGetter) {
+				m.EXPECT().DomainHostedZoneID("dev.example.com").Return("Z0123", nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "1234"}, nil).Times(2)
+			},
+			expectIAM: func(m *mocks.MockroleManager) {
+				m.EXPECT().CreateECSServiceLinkedRole().Return(nil)
+			},
+			expectCFN: func(m *mocks.MockstackExistChecker) {
+				m.EXPECT().Exists("phonetool-test").Return(true, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "us-west-2", "phonetool"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "1234", "us-west-2", "phonetool"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployAndRenderEnvironment(gomock.Any(), &deploy.CreateEnvironmentInput{
+					Name:                     "test",
+					AppName:                  "phonetool",
+					ToolsAccountPrincipalARN: "some arn",
+					CustomResourcesURLs:      map[string]string{"mockCustomResource": "mockURL"},
+					HTTPSListener: &config.HTTPSListener{
+						SSLPolicy: "ELBSecurityPolicy-TLS13-1-2-2021-06",
+					},
+					Domain: &config.EnvDomain{
+						Name:         "dev.example.com",
+						HostedZoneID: "Z0123",
+					},
+					Version: deploy.LatestEnvTemplateVersion,
+				}).Return(nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					AccountID: "1234",
+					Region:    "mars-1",
+					Name:      "test",
+					App:       "phonetool",
+				}, nil)
+				m.EXPECT().AddEnvToApp(gomock.Any()).Return(nil)
+			},
+			expectAppCFN: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").
+					Return(&stack.AppRegionalResources{
+						S3Bucket: "mockBucket",
+					}, nil)
+			},
+			expectResourcesUploader: func(m *mocks.MockcustomResourcesUploader) {
+				m.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{"mockCustomResource": "mockURL"}, nil)
+			},
+		},
+		"deploys with TLS settings of the HTTPS listener": {
+			inListener: httpsListenerVars{
+				CertARNs:         []string{"arn:aws:acm:us-west-2:1234:certificate/private"},
+				SSLPolicy:        "ELBSecurityPolicy-TLS13-1-2-2021-06",
+				MutualTLSMode:    "verify",
+				CABundle:         "s3://my-bucket/certs/ca-bundle.pem",
+				HSTS:             "max-age=31536000; includeSubDomains",
+				HTTPRedirectCode: 302,
+			},
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", AccountID: "1234", Domain: "example.com"}, nil)
+				m.EXPECT().CreateEnvironment(&config.Environment{
+					App:       "phonetool",
+					Name:      "test",
+					AccountID: "1234",
+					Region:    "mars-1",
+					CustomConfig: &config.CustomizeEnv{
+						HTTPSListener: &config.HTTPSListener{
+							CertificateARNs: []string{"arn:aws:acm:us-west-2:1234:certificate/private"},
+							SSLPolicy:       "ELBSecurityPolicy-TLS13-1-2-2021-06",
+							MutualTLS: &config.MutualTLS{
+								Mode:           "verify",
+								CABundleBucket: "my-bucket",
+								CABundleKey:    "certs/ca-bundle.pem",
+							},
+							HSTS: "max-age=31536000; includeSubDomains",
+							HTTPRedirect: &config.HTTPRedirect{
+								StatusCode: 302,
+							},
+						},
+					},
+				}).Return(nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "1234"}, nil).Times(2)
+			},
+			expectIAM: func(m *mocks.MockroleManager) {
+				m.EXPECT().CreateECSServiceLinkedRole().Return(nil)
+			},
+			expectCFN: func(m *mocks.MockstackExistChecker) {
+				m.EXPECT().Exists("phonetool-test").Return(true, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "us-west-2", "phonetool"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "1234", "us-west-2", "phonetool"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployAndRenderEnvironment(gomock.Any(), &deploy.CreateEnvironmentInput{
+					Name:                     "test",
+					AppName:                  "phonetool",
+					ToolsAccountPrincipalARN: "some arn",
+					AppDNSName:               "example.com",
+					CustomResourcesURLs:      map[string]string{"mockCustomResource": "mockURL"},
+					HTTPSListener: &config.HTTPSListener{
+						CertificateARNs: []string{"arn:aws:acm:us-west-2:1234:certificate/private"},
+						SSLPolicy:       "ELBSecurityPolicy-TLS13-1-2-2021-06",
+						MutualTLS: &config.MutualTLS{
+							Mode:           "verify",
+							CABundleBucket: "my-bucket",
+							CABundleKey:    "certs/ca-bundle.pem",
+						},
+						HSTS: "max-age=31536000; includeSubDomains",
+						HTTPRedirect: &config.HTTPRedirect{
+							StatusCode: 302,
+						},
+					},
+					Version: deploy.LatestEnvTemplateVersion,
+				}).Return(nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					AccountID: "1234",
+					Region:    "mars-1",
+					Name:      "test",
+					App:       "phonetool",
+				}, nil)
+				m.EXPECT().AddEnvToApp(gomock.Any()).Return(nil)
+			},
+			expectAppCFN: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool", AccountID: "1234", Domain: "example.com"}, "us-west-2").
+					Return(&stack.AppRegionalResources{
+						S3Bucket: "mockBucket",
+					}, nil)
+			},
+			expectResourcesUploader: func(m *mocks.MockcustomResourcesUploader) {
+				m.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{"mockCustomResource": "mockURL"}, nil)
+			},
+		},
+		"failed to delegate DNS (app has Domain and env and apps are different)": {
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", AccountID: "1234", Domain: "amazon.com"}, nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "4567"}, nil).Times(1)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDNSDelegationStart, "4567"))
+				m.EXPECT().Stop(log.Serrorf(fmtDNSDelegationFailed, "4567"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DelegateDNSPermissions(gomock.Any(), "4567").Return(errors.New("some error"))
+			},
+			wantedErrorS: "granting DNS permissions: some error",
+		},
+		"success with DNS Delegation (app has Domain and env and app are different)": {
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", AccountID: "1234", Domain: "amazon.com"}, nil)
+				m.EXPECT().CreateEnvironment(&config.Environment{
+					App:       "phonetool",
+					Name:      "test",
+					AccountID: "4567",
+					Region:    "us-west-2",
+				}).Return(nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "4567"}, nil).Times(2)
+			},
+			expectIAM: func(m *mocks.MockroleManager) {
+				m.EXPECT().CreateECSServiceLinkedRole().Return(nil)
+				m.EXPECT().ListRoleTags(gomock.Any()).
+					Return(nil, errors.New("does not exist")).AnyTimes()
+			},
+			expectCFN: func(m *mocks.MockstackExistChecker) {
+				m.EXPECT().Exists("phonetool-test").Return(false, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDNSDelegationStart, "4567"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtDNSDelegationComplete, "4567"))
+				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "4567", "us-west-2", "phonetool"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "4567", "us-west-2", "phonetool"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DelegateDNSPermissions(gomock.Any(), "4567").Return(nil)
+				m.EXPECT().DeployAndRenderEnvironment(gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					AccountID: "4567",
+					Region:    "us-west-2",
+					Name:      "test",
+					App:       "phonetool",
+				}, nil)
+				m.EXPECT().AddEnvToApp(gomock.Any()).Return(nil)
+			},
+			expectAppCFN: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(&config.Application{
+					Name:      "phonetool",
+					AccountID: "1234",
+					Domain:    "amazon.com",
+				}, "us-west-2").
+					Return(&stack.AppRegionalResources{
+						S3Bucket: "mockBucket",
+					}, nil)
+			},
+			expectResourcesUploader: func(m *mocks.MockcustomResourcesUploader) {
+				m.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(nil, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockDeployer := mocks.NewMockdeployer(ctrl)
+			mockIdentity := mocks.NewMockidentityService(ctrl)
+			mockProgress := mocks.NewMockprogress(ctrl)
+			mockAppCFN := mocks.NewMockappResourcesGetter(ctrl)
+			mockIAM := mocks.NewMockroleManager(ctrl)
+			mockCFN := mocks.NewMockstackExistChecker(ctrl)
+			mockResourcesUploader := mocks.NewMockcustomResourcesUploader(ctrl)
+			mockUploader := mocks.NewMockzipAndUploader(ctrl)
+			mockClusters := mocks.NewMockclusterGetter(ctrl)
+			mockActivations := mocks.NewMockactivationCreator(ctrl)
+			mockTemplater := mocks.NewMocktemplater(ctrl)
+			mockEstimator := mocks.NewMockcostEstimator(ctrl)
+			mockRoute53 := mocks.NewMockdomainHostedZoneGetter(ctrl)
+			if tc.expectStore != nil {
+				tc.expectStore(mockStore)
+			}
+			if tc.expectDeployer != nil {
+				tc.expectDeployer(mockDeployer)
+			}
+			if tc.expectIdentity != nil {
 				tc.expectIdentity(mockIdentity)
 			}
 			if tc.expectIAM != nil {
@@ -945,15 +1751,40 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			if tc.expectResourcesUploader != nil {
 				tc.expectResourcesUploader(mockResourcesUploader)
 			}
+			if tc.expectClusters != nil {
+				tc.expectClusters(mockClusters)
+			}
+			if tc.expectActivations != nil {
+				tc.expectActivations(mockActivations)
+			}
+			if tc.expectTemplater != nil {
+				tc.expectTemplater(mockTemplater)
+			}
+			if tc.expectEstimator != nil {
+				tc.expectEstimator(mockEstimator)
+			}
+			if tc.expectRoute53 != nil {
+				tc.expectRoute53(mockRoute53)
+			}
 
 			provider := sessions.NewProvider()
 			sess, _ := provider.DefaultWithRegion("us-west-2")
 
 			opts := &initEnvOpts{
 				initEnvVars: initEnvVars{
-					name:         "test",
-					appName:      "phonetool",
-					isProduction: tc.inProd,
+					name:              "test",
+					appName:           "phonetool",
+					isProduction:      tc.inProd,
+					resourceNames:     tc.inNames,
+					externalInstances: tc.inExternal,
+					ipv6:              tc.inIPv6,
+					httpsListener:     tc.inListener,
+					domain:            tc.inDomain,
+
+					// Only estimate the cost in the test cases that expect it.
+					skipCostEstimate: tc.expectEstimator == nil,
+
+					taskExecutionRoleARN: tc.inExecRole,
 				},
 				store:       mockStore,
 				envDeployer: mockDeployer,
@@ -962,6 +1793,8 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				envIdentity: mockIdentity,
 				iam:         mockIAM,
 				cfn:         mockCFN,
+				clusters:    mockClusters,
+				activations: mockActivations,
 				prog:        mockProgress,
 				sess:        sess,
 				appCFN:      mockAppCFN,
@@ -969,6 +1802,11 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				newS3: func(region string) (zipAndUploader, error) {
 					return mockUploader, nil
 				},
+				estimator:  mockEstimator,
+				envRoute53: mockRoute53,
+				newTemplater: func(*deploy.CreateEnvironmentInput) templater {
+					return mockTemplater
+				},
 			}
 
 			// WHEN
@@ -1070,3 +1908,54 @@ func TestInitEnvOpts_delegateDNSFromApp(t *testing.T) {
 		})
 	}
 }
+
+func TestSubnetCIDRs(t *testing.T) {
+	testCases := map[string]struct {
+		inVPC     string
+		inAZCount int
+
+		wantedPublic  []string
+		wantedPrivate []string
+	}{
+		"matches the default layout": {
+			inVPC:     "10.0.0.0/16",
+			inAZCount: 2,
+
+			wantedPublic:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+			wantedPrivate: []string{"10.0.2.0/24", "10.0.3.0/24"},
+		},
+		"creates /24 subnets in three availability zones": {
+			inVPC:     "172.20.0.0/20",
+			inAZCount: 3,
+
+			wantedPublic:  []string{"172.20.0.0/24", "172.20.1.0/24", "172.20.2.0/24"},
+			wantedPrivate: []string{"172.20.3.0/24", "172.20.4.0/24", "172.20.5.0/24"},
+		},
+		"splits a small VPC in 8 blocks": {
+			inVPC:     "192.168.1.0/24",
+			inAZCount: 2,
+
+			wantedPublic:  []string{"192.168.1.0/27", "192.168.1.32/27"},
+			wantedPrivate: []string{"192.168.1.64/27", "192.168.1.96/27"},
+		},
+		"returns no subnets if the VPC is too small": {
+			inVPC:     "192.168.1.0/27",
+			inAZCount: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			_, vpc, err := net.ParseCIDR(tc.inVPC)
+			require.NoError(t, err)
+
+			// WHEN
+			public, private := subnetCIDRs(*vpc, tc.inAZCount)
+
+			// THEN
+			require.Equal(t, tc.wantedPublic, public)
+			require.Equal(t, tc.wantedPrivate, private)
+		})
+	}
+}
@@ -4,11 +4,15 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"os"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -25,10 +29,14 @@ const (
 )
 
 type showEnvVars struct {
-	appName               string
-	name                  string
-	shouldOutputJSON      bool
-	shouldOutputResources bool
+	appName                   string
+	name                      string
+	shouldOutputJSON          bool
+	shouldOutputResources     bool
+	shouldOutputRoutes        bool
+	shouldOutputCosts         bool
+	shouldOutputResourcesJSON bool
+	shouldWatch               bool
 }
 
 type showEnvOpts struct {
@@ -39,6 +47,9 @@ type showEnvOpts struct {
 	describer        envDescriber
 	sel              configSelector
 	initEnvDescriber func() error
+
+	newResourceGroupDescriber func(env *config.Environment) (resourceGroupDescriber, error)
+	newUpdateRenderer         func(env *config.Environment) (envUpdateRenderer, error)
 }
 
 func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
@@ -56,6 +67,16 @@ func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
 		store:       configStore,
 		w:           log.OutputWriter,
 		sel:         selector.NewConfigSelect(prompt.New(), configStore),
+		newResourceGroupDescriber: func(env *config.Environment) (resourceGroupDescriber, error) {
+			return describe.NewEnvResourceGroupDescriber(env)
+		},
+		newUpdateRenderer: func(env *config.Environment) (envUpdateRenderer, error) {
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return cloudformation.New(sess), nil
+		},
 	}
 	opts.initEnvDescriber = func() error {
 		d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
@@ -64,6 +85,8 @@ func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
 			ConfigStore:     configStore,
 			DeployStore:     deployStore,
 			EnableResources: opts.shouldOutputResources,
+			EnableRoutes:    opts.shouldOutputRoutes,
+			EnableCosts:     opts.shouldOutputCosts,
 		})
 		if err != nil {
 			return fmt.Errorf("creating describer for environment %s in application %s: %w", opts.name, opts.appName, err)
@@ -76,6 +99,9 @@ func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
 
 // Validate returns an error if the values provided by the user are invalid.
 func (o *showEnvOpts) Validate() error {
+	if o.shouldOutputJSON && o.shouldOutputResourcesJSON {
+		return fmt.Errorf("cannot specify both --%s and --%s", jsonFlag, resourcesJSONFlag)
+	}
 	if o.appName != "" {
 		if _, err := o.store.GetApplication(o.appName); err != nil {
 			return err
@@ -100,6 +126,14 @@ func (o *showEnvOpts) Ask() error {
 
 // Execute shows the environments through the prompt.
 func (o *showEnvOpts) Execute() error {
+	if o.shouldWatch {
+		if err := o.renderUpdate(); err != nil {
+			return err
+		}
+	}
+	if o.shouldOutputResourcesJSON {
+		return o.writeResourceGroup()
+	}
 	if err := o.initEnvDescriber(); err != nil {
 		return err
 	}
@@ -120,6 +154,50 @@ func (o *showEnvOpts) Execute() error {
 	return nil
 }
 
+// writeResourceGroup writes the member resources of the environment's resource group in JSON format.
+func (o *showEnvOpts) writeResourceGroup() error {
+	env, err := o.store.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.name, err)
+	}
+	d, err := o.newResourceGroupDescriber(env)
+	if err != nil {
+		return fmt.Errorf("new resource group describer for environment %s: %w", o.name, err)
+	}
+	group, err := d.Describe()
+	if err != nil {
+		return fmt.Errorf("describe resource group of environment %s: %w", o.name, err)
+	}
+	data, err := group.JSONString()
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(o.w, data)
+	return nil
+}
+
+// renderUpdate renders the update of the environment stack that is in progress until it completes.
+func (o *showEnvOpts) renderUpdate() error {
+	env, err := o.store.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.name, err)
+	}
+	r, err := o.newUpdateRenderer(env)
+	if err != nil {
+		return err
+	}
+	err = r.RenderEnvironmentUpdate(os.Stderr, o.appName, o.name)
+	var errNotInProgress *cloudformation.ErrStackNotInProgress
+	if errors.As(err, &errNotInProgress) {
+		log.Infof("Environment %s is not being updated.\n", o.name)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("render the update of environment %s: %w", o.name, err)
+	}
+	return nil
+}
+
 func (o *showEnvOpts) askApp() error {
 	if o.appName != "" {
 		return nil
@@ -156,7 +234,15 @@ func buildEnvShowCmd() *cobra.Command {
 
 		Example: `
   Shows info about the environment "test".
-  /code $ copilot env show -n test`,
+  /code $ copilot env show -n test
+  Shows the load balancer listener rules of the environment "test" and the services they route to.
+  /code $ copilot env show -n test --routes
+  Shows the month-to-date and forecasted cost of each service in the environment "test".
+  /code $ copilot env show -n test --costs
+  Lists every resource of the environment "test" in its Resource Group for inventory tooling.
+  /code $ copilot env show -n test --resources-json
+  Follows the update of the environment "test" that a pipeline started, then shows its info.
+  /code $ copilot env show -n test --watch`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newShowEnvOpts(vars)
 			if err != nil {
@@ -175,5 +261,9 @@ func buildEnvShowCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, envResourcesFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputRoutes, routesFlag, false, envRoutesFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputCosts, costsFlag, false, envCostsFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputResourcesJSON, resourcesJSONFlag, false, envResourcesJSONFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldWatch, watchFlag, false, envWatchFlagDescription)
 	return cmd
 }
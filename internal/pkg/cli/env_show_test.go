@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 
@@ -20,16 +21,20 @@ import (
 )
 
 type showEnvMocks struct {
-	storeSvc  *mocks.Mockstore
-	describer *mocks.MockenvDescriber
-	sel       *mocks.MockconfigSelector
+	storeSvc       *mocks.Mockstore
+	describer      *mocks.MockenvDescriber
+	sel            *mocks.MockconfigSelector
+	groupDescriber *mocks.MockresourceGroupDescriber
+	updateRenderer *mocks.MockenvUpdateRenderer
 }
 
 func TestEnvShow_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inputApp         string
-		inputEnvironment string
-		setupMocks       func(mocks showEnvMocks)
+		inputApp                 string
+		inputEnvironment         string
+		inputOutputJSON          bool
+		inputOutputResourcesJSON bool
+		setupMocks               func(mocks showEnvMocks)
 
 		wantedError error
 	}{
@@ -75,6 +80,16 @@ func TestEnvShow_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("some error"),
 		},
+		"cannot output both the description and the resources in JSON": {
+			inputApp:                 "my-app",
+			inputEnvironment:         "my-env",
+			inputOutputJSON:          true,
+			inputOutputResourcesJSON: true,
+
+			setupMocks: func(m showEnvMocks) {},
+
+			wantedError: errors.New("cannot specify both --json and --resources-json"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -92,8 +107,10 @@ func TestEnvShow_Validate(t *testing.T) {
 
 			showEnvs := &showEnvOpts{
 				showEnvVars: showEnvVars{
-					name:    tc.inputEnvironment,
-					appName: tc.inputApp,
+					name:                      tc.inputEnvironment,
+					appName:                   tc.inputApp,
+					shouldOutputJSON:          tc.inputOutputJSON,
+					shouldOutputResourcesJSON: tc.inputOutputResourcesJSON,
 				},
 				store: mockStoreReader,
 			}
@@ -248,8 +265,10 @@ func TestEnvShow_Execute(t *testing.T) {
 	}
 
 	testCases := map[string]struct {
-		inputEnv         string
-		shouldOutputJSON bool
+		inputEnv                  string
+		shouldOutputJSON          bool
+		shouldOutputResourcesJSON bool
+		shouldWatch               bool
 
 		setupMocks func(mocks showEnvMocks)
 
@@ -315,6 +334,82 @@ Resources
   testApp-testEnv-Cluster  AWS::ECS::Cluster-jI63pYBWU6BZ
 `,
 		},
+		"success in resources JSON format": {
+			inputEnv:                  "testEnv",
+			shouldOutputResourcesJSON: true,
+			setupMocks: func(m showEnvMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetEnvironment("testApp", "testEnv").Return(testEnv, nil),
+					m.groupDescriber.EXPECT().Describe().Return(&describe.ResourceGroup{
+						Name:   "testApp-testEnv",
+						Region: "us-west-2",
+						Resources: []*describe.GroupResource{
+							{
+								ARN: "arn:aws:ecs:us-west-2:123456789012:cluster/testApp-testEnv-Cluster-jI63pYBWU6BZ",
+								Tags: map[string]string{
+									"copilot-application": "testApp",
+									"copilot-environment": "testEnv",
+								},
+							},
+						},
+					}, nil),
+				)
+			},
+
+			wantedContent: "{\"name\":\"testApp-testEnv\",\"region\":\"us-west-2\",\"resources\":[{\"arn\":\"arn:aws:ecs:us-west-2:123456789012:cluster/testApp-testEnv-Cluster-jI63pYBWU6BZ\",\"tags\":{\"copilot-application\":\"testApp\",\"copilot-environment\":\"testEnv\"}}]}\n",
+		},
+		"return error if fail to describe the resource group": {
+			inputEnv:                  "testEnv",
+			shouldOutputResourcesJSON: true,
+			setupMocks: func(m showEnvMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetEnvironment("testApp", "testEnv").Return(testEnv, nil),
+					m.groupDescriber.EXPECT().Describe().Return(nil, mockError),
+				)
+			},
+
+			wantedError: fmt.Errorf("describe resource group of environment testEnv: some error"),
+		},
+		"return error if fail to render the update of the env": {
+			inputEnv:    "testEnv",
+			shouldWatch: true,
+			setupMocks: func(m showEnvMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetEnvironment("testApp", "testEnv").Return(testEnv, nil),
+					m.updateRenderer.EXPECT().RenderEnvironmentUpdate(gomock.Any(), "testApp", "testEnv").Return(mockError),
+				)
+			},
+
+			wantedError: fmt.Errorf("render the update of environment testEnv: some error"),
+		},
+		"describes the env once its update completes": {
+			inputEnv:         "testEnv",
+			shouldOutputJSON: true,
+			shouldWatch:      true,
+			setupMocks: func(m showEnvMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetEnvironment("testApp", "testEnv").Return(testEnv, nil),
+					m.updateRenderer.EXPECT().RenderEnvironmentUpdate(gomock.Any(), "testApp", "testEnv").Return(nil),
+					m.describer.EXPECT().Describe().Return(&mockEnvDescription, nil),
+				)
+			},
+
+			wantedContent: "{\"environment\":{\"app\":\"testApp\",\"name\":\"testEnv\",\"region\":\"us-west-2\",\"accountID\":\"123456789012\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},\"services\":[{\"app\":\"testApp\",\"name\":\"testSvc1\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc2\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc3\",\"type\":\"load-balanced\"}],\"tags\":{\"copilot-application\":\"testApp\",\"copilot-environment\":\"testEnv\",\"key1\":\"value1\",\"key2\":\"value2\"},\"resources\":[{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"testApp-testEnv-CFNExecutionRole\"},{\"type\":\"testApp-testEnv-Cluster\",\"physicalID\":\"AWS::ECS::Cluster-jI63pYBWU6BZ\"}],\"environmentVPC\":{\"id\":\"\",\"publicSubnetIDs\":null,\"privateSubnetIDs\":null}}\n",
+		},
+		"describes the env if it is not being updated": {
+			inputEnv:         "testEnv",
+			shouldOutputJSON: true,
+			shouldWatch:      true,
+			setupMocks: func(m showEnvMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetEnvironment("testApp", "testEnv").Return(testEnv, nil),
+					m.updateRenderer.EXPECT().RenderEnvironmentUpdate(gomock.Any(), "testApp", "testEnv").Return(&cloudformation.ErrStackNotInProgress{}),
+					m.describer.EXPECT().Describe().Return(&mockEnvDescription, nil),
+				)
+			},
+
+			wantedContent: "{\"environment\":{\"app\":\"testApp\",\"name\":\"testEnv\",\"region\":\"us-west-2\",\"accountID\":\"123456789012\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},\"services\":[{\"app\":\"testApp\",\"name\":\"testSvc1\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc2\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc3\",\"type\":\"load-balanced\"}],\"tags\":{\"copilot-application\":\"testApp\",\"copilot-environment\":\"testEnv\",\"key1\":\"value1\",\"key2\":\"value2\"},\"resources\":[{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"testApp-testEnv-CFNExecutionRole\"},{\"type\":\"testApp-testEnv-Cluster\",\"physicalID\":\"AWS::ECS::Cluster-jI63pYBWU6BZ\"}],\"environmentVPC\":{\"id\":\"\",\"publicSubnetIDs\":null,\"privateSubnetIDs\":null}}\n",
+		},
 		"success in JSON format": {
 			inputEnv:         "testEnv",
 			shouldOutputJSON: true,
@@ -336,22 +431,36 @@ Resources
 			b := &bytes.Buffer{}
 			mockStoreReader := mocks.NewMockstore(ctrl)
 			mockEnvDescriber := mocks.NewMockenvDescriber(ctrl)
+			mockGroupDescriber := mocks.NewMockresourceGroupDescriber(ctrl)
+			mockUpdateRenderer := mocks.NewMockenvUpdateRenderer(ctrl)
 
 			mocks := showEnvMocks{
-				describer: mockEnvDescriber,
+				storeSvc:       mockStoreReader,
+				describer:      mockEnvDescriber,
+				groupDescriber: mockGroupDescriber,
+				updateRenderer: mockUpdateRenderer,
 			}
 
 			tc.setupMocks(mocks)
 
 			showEnvs := &showEnvOpts{
 				showEnvVars: showEnvVars{
-					name:             tc.inputEnv,
-					shouldOutputJSON: tc.shouldOutputJSON,
+					appName:                   "testApp",
+					name:                      tc.inputEnv,
+					shouldOutputJSON:          tc.shouldOutputJSON,
+					shouldOutputResourcesJSON: tc.shouldOutputResourcesJSON,
+					shouldWatch:               tc.shouldWatch,
 				},
 				store:            mockStoreReader,
 				describer:        mockEnvDescriber,
 				initEnvDescriber: func() error { return nil },
-				w:                b,
+				newResourceGroupDescriber: func(*config.Environment) (resourceGroupDescriber, error) {
+					return mockGroupDescriber, nil
+				},
+				newUpdateRenderer: func(*config.Environment) (envUpdateRenderer, error) {
+					return mockUpdateRenderer, nil
+				},
+				w: b,
 			}
 
 			// WHEN
@@ -6,7 +6,14 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -23,6 +30,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/spf13/cobra"
 	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -35,13 +43,27 @@ to support the latest Copilot features.`
 	fmtEnvUpgradeStart    = "Upgrading environment %s from version %s to version %s."
 	fmtEnvUpgradeFailed   = "Failed to upgrade environment %s's template to version %s.\n"
 	fmtEnvUpgradeComplete = "Upgraded environment %s's template to version %s.\n"
+	fmtEnvUpgradeDiff     = "Upgrade environment %s from version %s to version %s:\n"
+)
+
+// Parameters of the legacy environment template that are replaced during an upgrade.
+// See UpgradeLegacyEnvironment in the deploy/cloudformation package.
+const (
+	legacyEnvIncludeLoadBalancerParamKey = "IncludePublicLoadBalancer"
+	envALBWorkloadsParamKey              = "ALBWorkloads"
 )
 
 // envUpgradeVars holds flag values.
 type envUpgradeVars struct {
-	appName string // Required. Name of the application.
-	name    string // Required. Name of the environment.
-	all     bool   // True means all environments should be upgraded.
+	appName  string // Required. Name of the application.
+	name     string // Required. Name of the environment.
+	all      bool   // True means all environments should be upgraded.
+	showDiff bool   // True means the changes are written instead of upgrading the environments.
+
+	strictVersion bool // True means an environment on a newer template version fails the command instead of logging a warning.
+
+	skipConfirmation bool // True means the command doesn't ask to confirm a change of identity, account or region.
+	targetConfirmed  bool // True means the caller already confirmed the target of the environments, such as a deployment.
 }
 
 // envUpgradeOpts represents the env upgrade command and holds the necessary data
@@ -51,16 +73,20 @@ type envUpgradeOpts struct {
 
 	store              store
 	sel                appEnvSelector
+	targetGuard        targetConfirmer
 	legacyEnvTemplater templater
 	prog               progress
 	appCFN             appResourcesGetter
 	uploader           customResourcesUploader
+	diffWriter         io.Writer
 
 	// Constructors for clients that can be initialized only at runtime.
 	// These functions are overriden in tests to provide mocks.
 	newEnvVersionGetter func(app, env string) (versionGetter, error)
 	newTemplateUpgrader func(conf *config.Environment) (envTemplateUpgrader, error)
 	newS3               func(region string) (zipAndUploader, error)
+	newStackDescriber   func(env *config.Environment) (deployedStackDescriber, error)
+	newEnvTemplater     func(in *deploy.CreateEnvironmentInput) templater
 }
 
 func newEnvUpgradeOpts(vars envUpgradeVars) (*envUpgradeOpts, error) {
@@ -72,17 +98,24 @@ func newEnvUpgradeOpts(vars envUpgradeVars) (*envUpgradeOpts, error) {
 	if err != nil {
 		return nil, err
 	}
+	prompter := prompt.New()
+	guard, err := newTargetGuard(defaultSession, prompter)
+	if err != nil {
+		return nil, err
+	}
 	return &envUpgradeOpts{
 		envUpgradeVars: vars,
 
-		store: store,
-		sel:   selector.NewSelect(prompt.New(), store),
+		store:       store,
+		sel:         selector.NewSelect(prompter, store),
+		targetGuard: guard,
 		legacyEnvTemplater: stack.NewEnvStackConfig(&deploy.CreateEnvironmentInput{
 			Version: deploy.LegacyEnvTemplateVersion,
 		}),
-		prog:     termprogress.NewSpinner(log.DiagnosticWriter),
-		uploader: template.New(),
-		appCFN:   cloudformation.New(defaultSession),
+		prog:       termprogress.NewSpinner(log.DiagnosticWriter),
+		uploader:   template.New(),
+		appCFN:     cloudformation.New(defaultSession),
+		diffWriter: os.Stdout,
 
 		newEnvVersionGetter: func(app, env string) (versionGetter, error) {
 			d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
@@ -109,6 +142,10 @@ func newEnvUpgradeOpts(vars envUpgradeVars) (*envUpgradeOpts, error) {
 			}
 			return s3.New(sess), nil
 		},
+		newStackDescriber: newDeployedStackDescriber,
+		newEnvTemplater: func(in *deploy.CreateEnvironmentInput) templater {
+			return stack.NewEnvStackConfig(in)
+		},
 	}, nil
 }
 
@@ -162,6 +199,14 @@ func (o *envUpgradeOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("get application %s: %w", o.appName, err)
 	}
+	if !o.showDiff && !o.targetConfirmed {
+		// Confirm the target of every environment before upgrading any of them.
+		for _, env := range envs {
+			if err := o.targetGuard.Confirm(env, o.skipConfirmation); err != nil {
+				return err
+			}
+		}
+	}
 	for _, env := range envs {
 		resources, err := o.appCFN.GetAppResourcesByRegion(app, env.Region)
 		if err != nil {
@@ -210,9 +255,15 @@ func (o *envUpgradeOpts) upgrade(env *config.Environment, customResourcesURLs ma
 	if err != nil {
 		return err
 	}
+	if err := handleTemplateVersionSkew(deploy.ValidateEnvTemplateVersion(env.Name, version), o.strictVersion); err != nil {
+		return err
+	}
 	if !shouldUpgradeEnv(env.Name, version) {
 		return nil
 	}
+	if o.showDiff {
+		return o.writeDiff(env, customResourcesURLs, version)
+	}
 
 	o.prog.Start(fmt.Sprintf(fmtEnvUpgradeStart, color.HighlightUserInput(env.Name), color.Emphasize(version), color.Emphasize(deploy.LatestEnvTemplateVersion)))
 	defer func() {
@@ -265,50 +316,95 @@ Are you using the latest version of AWS Copilot?`, env, deploy.LatestEnvTemplate
 
 func (o *envUpgradeOpts) upgradeEnvironment(upgrader envUpgrader, conf *config.Environment,
 	customResourcesURLs map[string]string, fromVersion, toVersion string) error {
+	if err := upgrader.UpgradeEnvironment(envUpgradeInput(conf, customResourcesURLs, toVersion)); err != nil {
+		return fmt.Errorf("upgrade environment %s from version %s to version %s: %v", conf.Name, fromVersion, toVersion, err)
+	}
+	return nil
+}
+
+// envUpgradeInput returns the input to upgrade the stack of a non-legacy environment to a version.
+func envUpgradeInput(conf *config.Environment, customResourcesURLs map[string]string, toVersion string) *deploy.CreateEnvironmentInput {
 	var importedVPC *config.ImportVPC
 	var adjustedVPC *config.AdjustVPC
+	var resourceNames *config.EnvResourceNames
+	var httpsListener *config.HTTPSListener
+	var domain *config.EnvDomain
+	var externalInstances, ipv6 bool
 	if conf.CustomConfig != nil {
 		importedVPC = conf.CustomConfig.ImportVPC
 		adjustedVPC = conf.CustomConfig.VPCConfig
+		resourceNames = conf.CustomConfig.ResourceNames
+		httpsListener = conf.CustomConfig.HTTPSListener
+		domain = conf.CustomConfig.Domain
+		externalInstances = conf.CustomConfig.ExternalInstances
+		ipv6 = conf.CustomConfig.IPv6
 	}
-
-	if err := upgrader.UpgradeEnvironment(&deploy.CreateEnvironmentInput{
+	return &deploy.CreateEnvironmentInput{
 		Version:             toVersion,
 		AppName:             conf.App,
 		Name:                conf.Name,
 		CustomResourcesURLs: customResourcesURLs,
 		ImportVPCConfig:     importedVPC,
 		AdjustVPCConfig:     adjustedVPC,
+		ResourceNames:       resourceNames,
+		HTTPSListener:       httpsListener,
+		Domain:              domain,
+		ExternalInstances:   externalInstances,
+		IPv6:                ipv6,
 		CFNServiceRoleARN:   conf.ExecutionRoleARN,
-	}); err != nil {
-		return fmt.Errorf("upgrade environment %s from version %s to version %s: %v", conf.Name, fromVersion, toVersion, err)
 	}
-	return nil
 }
 
 func (o *envUpgradeOpts) upgradeLegacyEnvironment(upgrader legacyEnvUpgrader, conf *config.Environment,
 	customResourcesURLs map[string]string, fromVersion, toVersion string) error {
-	isDefaultEnv, err := o.isDefaultLegacyTemplate(upgrader, conf.App, conf.Name)
+	albWorkloads, err := o.listLBWebServices()
 	if err != nil {
 		return err
 	}
-	albWorkloads, err := o.listLBWebServices()
+	in, err := o.legacyEnvUpgradeInput(upgrader, conf, customResourcesURLs, toVersion)
 	if err != nil {
 		return err
 	}
+	if err := upgrader.UpgradeLegacyEnvironment(in, albWorkloads...); err != nil {
+		return fmt.Errorf("upgrade environment %s from version %s to version %s: %v", conf.Name, fromVersion, toVersion, err)
+	}
+	return nil
+}
+
+// legacyEnvUpgradeInput returns the input to upgrade the stack of a legacy environment to a version.
+func (o *envUpgradeOpts) legacyEnvUpgradeInput(cfn envTemplater, conf *config.Environment,
+	customResourcesURLs map[string]string, toVersion string) (*deploy.CreateEnvironmentInput, error) {
+	isDefaultEnv, err := o.isDefaultLegacyTemplate(cfn, conf.App, conf.Name)
+	if err != nil {
+		return nil, err
+	}
 	if isDefaultEnv {
-		if err := upgrader.UpgradeLegacyEnvironment(&deploy.CreateEnvironmentInput{
+		return &deploy.CreateEnvironmentInput{
 			Version:             toVersion,
 			AppName:             conf.App,
 			Name:                conf.Name,
 			CustomResourcesURLs: customResourcesURLs,
 			CFNServiceRoleARN:   conf.ExecutionRoleARN,
-		}, albWorkloads...); err != nil {
-			return fmt.Errorf("upgrade environment %s from version %s to version %s: %v", conf.Name, fromVersion, toVersion, err)
-		}
-		return nil
+		}, nil
+	}
+	if conf.CustomConfig != nil {
+		return &deploy.CreateEnvironmentInput{
+			Version:           toVersion,
+			AppName:           conf.App,
+			Name:              conf.Name,
+			ImportVPCConfig:   conf.CustomConfig.ImportVPC,
+			AdjustVPCConfig:   conf.CustomConfig.VPCConfig,
+			CFNServiceRoleARN: conf.ExecutionRoleARN,
+		}, nil
 	}
-	return o.upgradeLegacyEnvironmentWithVPCOverrides(upgrader, conf, fromVersion, toVersion, albWorkloads)
+	// Prior to #1433, we did not store the custom VPC config in SSM.
+	// In this situation we unfortunately have to ask the users to enter the VPC configuration into SSM or re-create the
+	// environment in case they run into this issue.
+	log.Warningln(`
+Looks like you've an environment with a customized VPC configuration.
+Copilot could not upgrade your environment's CloudFormation template.
+To learn more about how to fix it: https://github.com/aws/copilot-cli/issues/1601`)
+	return nil, errors.New("cannot upgrade environment due to missing vpc configuration")
 }
 
 func (o *envUpgradeOpts) isDefaultLegacyTemplate(cfn envTemplater, appName, envName string) (bool, error) {
@@ -338,29 +434,110 @@ func (o *envUpgradeOpts) listLBWebServices() ([]string, error) {
 	return lbWebServiceNames, nil
 }
 
-func (o *envUpgradeOpts) upgradeLegacyEnvironmentWithVPCOverrides(upgrader legacyEnvUpgrader, conf *config.Environment,
-	fromVersion, toVersion string, albWorkloads []string) error {
-	if conf.CustomConfig != nil {
-		if err := upgrader.UpgradeLegacyEnvironment(&deploy.CreateEnvironmentInput{
-			Version:           toVersion,
-			AppName:           conf.App,
-			Name:              conf.Name,
-			ImportVPCConfig:   conf.CustomConfig.ImportVPC,
-			AdjustVPCConfig:   conf.CustomConfig.VPCConfig,
-			CFNServiceRoleARN: conf.ExecutionRoleARN,
-		}, albWorkloads...); err != nil {
-			return fmt.Errorf("upgrade environment %s from version %s to version %s: %v", conf.Name, fromVersion, toVersion, err)
+// writeDiff writes the differences between the deployed environment stack and the upgraded one to the diff writer.
+// Parameters keep their deployed values during an upgrade, so only the parameters introduced by the template can change.
+func (o *envUpgradeOpts) writeDiff(conf *config.Environment, customResourcesURLs map[string]string, fromVersion string) error {
+	toVersion := deploy.LatestEnvTemplateVersion
+	in := envUpgradeInput(conf, customResourcesURLs, toVersion)
+	var albWorkloads []string
+	isLegacy := fromVersion == deploy.LegacyEnvTemplateVersion
+	if isLegacy {
+		upgrader, err := o.newTemplateUpgrader(conf)
+		if err != nil {
+			return err
+		}
+		if albWorkloads, err = o.listLBWebServices(); err != nil {
+			return err
+		}
+		if in, err = o.legacyEnvUpgradeInput(upgrader, conf, customResourcesURLs, toVersion); err != nil {
+			return err
 		}
-		return nil
 	}
-	// Prior to #1433, we did not store the custom VPC config in SSM.
-	// In this situation we unfortunately have to ask the users to enter the VPC configuration into SSM or re-create the
-	// environment in case they run into this issue.
-	log.Warningln(`
-Looks like you've an environment with a customized VPC configuration.
-Copilot could not upgrade your environment's CloudFormation template.
-To learn more about how to fix it: https://github.com/aws/copilot-cli/issues/1601`)
-	return errors.New("cannot upgrade environment due to missing vpc configuration")
+	tpl, err := o.newEnvTemplater(in).Template()
+	if err != nil {
+		return fmt.Errorf("generate template of environment %s: %w", conf.Name, err)
+	}
+
+	describer, err := o.newStackDescriber(conf)
+	if err != nil {
+		return err
+	}
+	stackName := stack.NameForEnv(conf.App, conf.Name)
+	descr, err := describer.Describe(stackName)
+	if err != nil {
+		return fmt.Errorf("describe stack %s: %w", stackName, err)
+	}
+	deployedTpl, err := describer.TemplateBody(stackName)
+	if err != nil {
+		return fmt.Errorf("get template of stack %s: %w", stackName, err)
+	}
+	deployedParams, err := serializeDeployedParameters(descr)
+	if err != nil {
+		return err
+	}
+	upgradedParams, err := upgradedEnvParameters(descr.Parameters, tpl, isLegacy, albWorkloads)
+	if err != nil {
+		return fmt.Errorf("get parameters of the upgraded stack %s: %w", stackName, err)
+	}
+	params, err := serializeDeployedParameters(&awscloudformation.StackDescription{
+		Parameters: upgradedParams,
+		Tags:       descr.Tags,
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(o.diffWriter, fmtEnvUpgradeDiff, conf.Name, fromVersion, toVersion); err != nil {
+		return err
+	}
+	return writeStackDiff(o.diffWriter, stackName, stackDiffInput{
+		deployedTemplate: deployedTpl,
+		template:         tpl,
+		deployedParams:   deployedParams,
+		params:           params,
+	})
+}
+
+// upgradedEnvParameters returns the parameters of an environment stack once upgraded to a template.
+// The deployed parameters keep their values, and the parameters introduced by the template take their default value.
+func upgradedEnvParameters(deployed []*sdkcloudformation.Parameter, tpl string, isLegacy bool, albWorkloads []string) ([]*sdkcloudformation.Parameter, error) {
+	var parsed struct {
+		Parameters map[string]struct {
+			Default *string `yaml:"Default"`
+		} `yaml:"Parameters"`
+	}
+	if err := yaml.Unmarshal([]byte(tpl), &parsed); err != nil {
+		return nil, fmt.Errorf("unmarshal template: %w", err)
+	}
+	var params []*sdkcloudformation.Parameter
+	seen := make(map[string]bool)
+	for _, param := range deployed {
+		key := aws.StringValue(param.ParameterKey)
+		if isLegacy && key == legacyEnvIncludeLoadBalancerParamKey {
+			params = append(params, &sdkcloudformation.Parameter{
+				ParameterKey:   aws.String(envALBWorkloadsParamKey),
+				ParameterValue: aws.String(strings.Join(albWorkloads, ",")),
+			})
+			seen[envALBWorkloadsParamKey] = true
+			continue
+		}
+		params = append(params, param)
+		seen[key] = true
+	}
+	var added []string
+	for key := range parsed.Parameters {
+		if !seen[key] {
+			added = append(added, key)
+		}
+	}
+	sort.Strings(added)
+	for _, key := range added {
+		params = append(params, &sdkcloudformation.Parameter{
+			ParameterKey:   aws.String(key),
+			ParameterValue: parsed.Parameters[key].Default,
+		})
+	}
+	return params, nil
 }
 
 // buildEnvUpgradeCmd builds the command to update environment(s) to the latest version of
@@ -371,11 +548,17 @@ func buildEnvUpgradeCmd() *cobra.Command {
 		Use:    "upgrade",
 		Short:  "Upgrades the template of an environment to the latest version.",
 		Hidden: true,
+		Example: `
+  Upgrade the "test" environment to the latest version.
+  /code $ copilot env upgrade -n test
+  Preview the changes to the stacks of every environment without upgrading them.
+  /code $ copilot env upgrade --all --diff`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newEnvUpgradeOpts(vars)
 			if err != nil {
 				return err
 			}
+			opts.strictVersion = shouldEnforceTemplateVersion(cmd)
 			if err := opts.Validate(); err != nil {
 				return err
 			}
@@ -388,5 +571,7 @@ func buildEnvUpgradeCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.all, allFlag, false, upgradeAllEnvsDescription)
+	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, envUpgradeDiffFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
 	return cmd
 }
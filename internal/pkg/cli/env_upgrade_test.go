@@ -4,9 +4,14 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -201,6 +206,38 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 		given     func(ctrl *gomock.Controller) *envUpgradeOpts
 		wantedErr error
 	}{
+		"should return the error if the target of an environment is not confirmed": {
+			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{
+						Name:   "test",
+						Region: "us-west-2",
+					},
+					{
+						Name:   "prod",
+						Region: "us-east-1",
+					},
+				}, nil)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				mockGuard := mocks.NewMocktargetConfirmer(ctrl)
+				mockGuard.EXPECT().Confirm(&config.Environment{Name: "test", Region: "us-west-2"}, false).Return(nil)
+				mockGuard.EXPECT().Confirm(&config.Environment{Name: "prod", Region: "us-east-1"}, false).Return(errTargetNotConfirmed)
+				mockAppCFN := mocks.NewMockappResourcesGetter(ctrl)
+				mockAppCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), gomock.Any()).Times(0)
+
+				return &envUpgradeOpts{
+					envUpgradeVars: envUpgradeVars{
+						appName: "phonetool",
+						all:     true,
+					},
+					store:       mockStore,
+					targetGuard: mockGuard,
+					appCFN:      mockAppCFN,
+				}
+			},
+			wantedErr: errTargetNotConfirmed,
+		},
 		"should skip upgrading if the environment version is already at least latest": {
 			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
 				mockStore := mocks.NewMockstore(ctrl)
@@ -234,7 +271,45 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 						appName: "phonetool",
 						all:     true,
 					},
-					store: mockStore,
+					store:       mockStore,
+					targetGuard: mockConfirmedTarget(ctrl),
+					newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+						return mockEnvTpl, nil
+					},
+					uploader: mockUploader,
+					appCFN:   mockAppCFN,
+					newS3: func(region string) (zipAndUploader, error) {
+						return mocks.NewMockzipAndUploader(ctrl), nil
+					},
+				}
+			},
+		},
+		"should return error if an environment is on a newer template version with --strict-version": {
+			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					Name:   "test",
+					Region: "us-west-2",
+				}, nil)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				mockAppCFN := mocks.NewMockappResourcesGetter(ctrl)
+				mockAppCFN.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+					Return(&stack.AppRegionalResources{
+						S3Bucket: "mockBucket",
+					}, nil)
+				mockUploader := mocks.NewMockcustomResourcesUploader(ctrl)
+				mockUploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(nil, nil)
+				mockEnvTpl := mocks.NewMockversionGetter(ctrl)
+				mockEnvTpl.EXPECT().Version().Return("v99.0.0", nil)
+
+				return &envUpgradeOpts{
+					envUpgradeVars: envUpgradeVars{
+						appName:       "phonetool",
+						name:          "test",
+						strictVersion: true,
+					},
+					store:       mockStore,
+					targetGuard: mockConfirmedTarget(ctrl),
 					newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
 						return mockEnvTpl, nil
 					},
@@ -245,6 +320,7 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 					},
 				}
 			},
+			wantedErr: fmt.Errorf("environment test is on template version v99.0.0, which is newer than the latest version %s supported by this Copilot CLI", deploy.LatestEnvTemplateVersion),
 		},
 		"should upgrade non-legacy environments with UpgradeEnvironment call": {
 			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
@@ -266,6 +342,18 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 							ImportVPC: &config.ImportVPC{
 								ID: "abc",
 							},
+							ResourceNames: &config.EnvResourceNames{
+								Cluster: "team-a-cluster",
+							},
+							HTTPSListener: &config.HTTPSListener{
+								SSLPolicy: "ELBSecurityPolicy-TLS13-1-2-2021-06",
+							},
+							Domain: &config.EnvDomain{
+								Name:         "dev.example.com",
+								HostedZoneID: "Z0123",
+							},
+							ExternalInstances: true,
+							IPv6:              true,
 						},
 					}, nil)
 				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
@@ -285,6 +373,18 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 					ImportVPCConfig: &config.ImportVPC{
 						ID: "abc",
 					},
+					ResourceNames: &config.EnvResourceNames{
+						Cluster: "team-a-cluster",
+					},
+					HTTPSListener: &config.HTTPSListener{
+						SSLPolicy: "ELBSecurityPolicy-TLS13-1-2-2021-06",
+					},
+					Domain: &config.EnvDomain{
+						Name:         "dev.example.com",
+						HostedZoneID: "Z0123",
+					},
+					ExternalInstances:   true,
+					IPv6:                true,
 					CFNServiceRoleARN:   "execARN",
 					CustomResourcesURLs: map[string]string{"mockCustomResource": "mockURL"},
 				}).Return(nil)
@@ -294,8 +394,9 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 						appName: "phonetool",
 						name:    "test",
 					},
-					store: mockStore,
-					prog:  mockProg,
+					store:       mockStore,
+					targetGuard: mockConfirmedTarget(ctrl),
+					prog:        mockProg,
 					newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
 						return mockEnvTpl, nil
 					},
@@ -367,6 +468,7 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 						name:    "test",
 					},
 					store:              mockStore,
+					targetGuard:        mockConfirmedTarget(ctrl),
 					legacyEnvTemplater: mockTemplater,
 					prog:               mockProg,
 					newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
@@ -436,6 +538,7 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 						name:    "test",
 					},
 					store:              mockStore,
+					targetGuard:        mockConfirmedTarget(ctrl),
 					legacyEnvTemplater: mockTemplater,
 					prog:               mockProg,
 					newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
@@ -492,6 +595,7 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 						name:    "test",
 					},
 					store:              mockStore,
+					targetGuard:        mockConfirmedTarget(ctrl),
 					legacyEnvTemplater: mockTemplater,
 					prog:               mockProg,
 					newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
@@ -527,3 +631,228 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvUpgradeOpts_ExecuteWithDiff(t *testing.T) {
+	const (
+		deployedTpl = `Parameters:
+  AppName:
+    Type: String
+  IncludePublicLoadBalancer:
+    Type: String
+Resources:
+  Cluster:
+    Type: AWS::ECS::Cluster
+`
+		upgradedTpl = `Parameters:
+  AppName:
+    Type: String
+  ALBWorkloads:
+    Type: String
+  EFSWorkloads:
+    Type: String
+    Default: ""
+Resources:
+  Cluster:
+    Type: AWS::ECS::Cluster
+    Properties:
+      CapacityProviders: [FARGATE, FARGATE_SPOT]
+`
+	)
+	testCases := map[string]struct {
+		inVersion        string
+		mockDependencies func(ctrl *gomock.Controller, mockStore *mocks.Mockstore, opts *envUpgradeOpts)
+		wantedInput      *deploy.CreateEnvironmentInput
+		wantedDiff       string
+		wantedErr        error
+	}{
+		"should write the changes to the template and parameters instead of upgrading": {
+			inVersion: "v1.0.0",
+			mockDependencies: func(ctrl *gomock.Controller, mockStore *mocks.Mockstore, opts *envUpgradeOpts) {
+				m := mocks.NewMockdeployedStackDescriber(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(&awscfn.StackDescription{
+					Parameters: []*sdkcfn.Parameter{
+						{ParameterKey: aws.String("AppName"), ParameterValue: aws.String("phonetool")},
+						{ParameterKey: aws.String("ALBWorkloads"), ParameterValue: aws.String("frontend")},
+					},
+					Tags: []*sdkcfn.Tag{
+						{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+					},
+				}, nil)
+				m.EXPECT().TemplateBody("phonetool-test").Return(`Parameters:
+  AppName:
+    Type: String
+  ALBWorkloads:
+    Type: String
+Resources:
+  Cluster:
+    Type: AWS::ECS::Cluster
+`, nil)
+				opts.newStackDescriber = func(_ *config.Environment) (deployedStackDescriber, error) {
+					return m, nil
+				}
+			},
+			wantedInput: &deploy.CreateEnvironmentInput{
+				Version:             deploy.LatestEnvTemplateVersion,
+				AppName:             "phonetool",
+				Name:                "test",
+				CFNServiceRoleARN:   "execARN",
+				CustomResourcesURLs: map[string]string{"mockCustomResource": "mockURL"},
+			},
+			wantedDiff: `Upgrade environment test from version v1.0.0 to version ` + deploy.LatestEnvTemplateVersion + `:
+Changes to the template:
+~ Parameters:
+    + EFSWorkloads:
+    +   Type: String
+    +   Default: ""
+~ Resources:
+    ~ Cluster:
+        + Properties:
+        +   CapacityProviders:
+        +     - FARGATE
+        +     - FARGATE_SPOT
+Changes to the parameters and tags:
+~ Parameters:
+    + EFSWorkloads: ""
+`,
+		},
+		"should replace the load balancer parameter of a legacy environment": {
+			inVersion: deploy.LegacyEnvTemplateVersion,
+			mockDependencies: func(ctrl *gomock.Controller, mockStore *mocks.Mockstore, opts *envUpgradeOpts) {
+				mockStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{
+					{Name: "frontend", Type: manifest.LoadBalancedWebServiceType},
+					{Name: "backend", Type: manifest.BackendServiceType},
+				}, nil)
+
+				mockLegacyTemplater := mocks.NewMocktemplater(ctrl)
+				mockLegacyTemplater.EXPECT().Template().Return(deployedTpl, nil)
+				opts.legacyEnvTemplater = mockLegacyTemplater
+				mockUpgrader := mocks.NewMockenvTemplateUpgrader(ctrl)
+				mockUpgrader.EXPECT().EnvironmentTemplate("phonetool", "test").Return(deployedTpl, nil)
+				opts.newTemplateUpgrader = func(_ *config.Environment) (envTemplateUpgrader, error) {
+					return mockUpgrader, nil
+				}
+
+				m := mocks.NewMockdeployedStackDescriber(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(&awscfn.StackDescription{
+					Parameters: []*sdkcfn.Parameter{
+						{ParameterKey: aws.String("AppName"), ParameterValue: aws.String("phonetool")},
+						{ParameterKey: aws.String("IncludePublicLoadBalancer"), ParameterValue: aws.String("true")},
+					},
+				}, nil)
+				m.EXPECT().TemplateBody("phonetool-test").Return(deployedTpl, nil)
+				opts.newStackDescriber = func(_ *config.Environment) (deployedStackDescriber, error) {
+					return m, nil
+				}
+			},
+			wantedInput: &deploy.CreateEnvironmentInput{
+				Version:             deploy.LatestEnvTemplateVersion,
+				AppName:             "phonetool",
+				Name:                "test",
+				CFNServiceRoleARN:   "execARN",
+				CustomResourcesURLs: map[string]string{"mockCustomResource": "mockURL"},
+			},
+			wantedDiff: `Upgrade environment test from version ` + deploy.LegacyEnvTemplateVersion + ` to version ` + deploy.LatestEnvTemplateVersion + `:
+Changes to the template:
+~ Parameters:
+    + ALBWorkloads:
+    +   Type: String
+    + EFSWorkloads:
+    +   Type: String
+    +   Default: ""
+    - IncludePublicLoadBalancer:
+    -   Type: String
+~ Resources:
+    ~ Cluster:
+        + Properties:
+        +   CapacityProviders:
+        +     - FARGATE
+        +     - FARGATE_SPOT
+Changes to the parameters and tags:
+~ Parameters:
+    + ALBWorkloads: frontend
+    + EFSWorkloads: ""
+    - IncludePublicLoadBalancer: "true"
+`,
+		},
+		"should return a wrapped error if the environment stack can't be described": {
+			inVersion: "v1.0.0",
+			mockDependencies: func(ctrl *gomock.Controller, mockStore *mocks.Mockstore, opts *envUpgradeOpts) {
+				m := mocks.NewMockdeployedStackDescriber(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(nil, errors.New("some error"))
+				opts.newStackDescriber = func(_ *config.Environment) (deployedStackDescriber, error) {
+					return m, nil
+				}
+			},
+			wantedInput: &deploy.CreateEnvironmentInput{
+				Version:             deploy.LatestEnvTemplateVersion,
+				AppName:             "phonetool",
+				Name:                "test",
+				CFNServiceRoleARN:   "execARN",
+				CustomResourcesURLs: map[string]string{"mockCustomResource": "mockURL"},
+			},
+			wantedErr: errors.New("describe stack phonetool-test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := &config.Environment{
+				App:              "phonetool",
+				Name:             "test",
+				Region:           "us-west-2",
+				ExecutionRoleARN: "execARN",
+			}
+			mockStore := mocks.NewMockstore(ctrl)
+			mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(env, nil)
+			mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			mockAppCFN := mocks.NewMockappResourcesGetter(ctrl)
+			mockAppCFN.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+				Return(&stack.AppRegionalResources{
+					S3Bucket: "mockBucket",
+				}, nil)
+			mockUploader := mocks.NewMockcustomResourcesUploader(ctrl)
+			mockUploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{"mockCustomResource": "mockURL"}, nil)
+			mockEnvTpl := mocks.NewMockversionGetter(ctrl)
+			mockEnvTpl.EXPECT().Version().Return(tc.inVersion, nil)
+			buf := new(bytes.Buffer)
+			opts := &envUpgradeOpts{
+				envUpgradeVars: envUpgradeVars{
+					appName:  "phonetool",
+					name:     "test",
+					showDiff: true,
+				},
+				store:      mockStore,
+				uploader:   mockUploader,
+				appCFN:     mockAppCFN,
+				diffWriter: buf,
+				newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+					return mockEnvTpl, nil
+				},
+				newS3: func(_ string) (zipAndUploader, error) {
+					return mocks.NewMockzipAndUploader(ctrl), nil
+				},
+				newEnvTemplater: func(in *deploy.CreateEnvironmentInput) templater {
+					require.Equal(t, tc.wantedInput, in)
+					mockTemplater := mocks.NewMocktemplater(ctrl)
+					mockTemplater.EXPECT().Template().Return(upgradedTpl, nil)
+					return mockTemplater
+				},
+			}
+			tc.mockDependencies(ctrl, mockStore, opts)
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDiff, buf.String())
+		})
+	}
+}
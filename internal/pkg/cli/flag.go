@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
@@ -26,29 +27,62 @@ const (
 	jsonFlag     = "json"
 	allFlag      = "all"
 
+	// Global flags registered on the root command.
+	strictVersionFlag = "strict-version"
+
 	// Command specific flags.
-	dockerFileFlag        = "dockerfile"
-	imageTagFlag          = "tag"
-	resourceTagsFlag      = "resource-tags"
-	stackOutputDirFlag    = "output-dir"
-	limitFlag             = "limit"
-	followFlag            = "follow"
-	sinceFlag             = "since"
-	startTimeFlag         = "start-time"
-	endTimeFlag           = "end-time"
-	tasksFlag             = "tasks"
-	prodEnvFlag           = "prod"
-	deployFlag            = "deploy"
-	resourcesFlag         = "resources"
-	githubURLFlag         = "github-url"
-	repoURLFlag           = "url"
-	githubAccessTokenFlag = "github-access-token"
-	gitBranchFlag         = "git-branch"
-	envsFlag              = "environments"
-	domainNameFlag        = "domain"
-	localFlag             = "local"
-	deleteSecretFlag      = "delete-secret"
-	svcPortFlag           = "port"
+	dockerFileFlag          = "dockerfile"
+	imageTagFlag            = "tag"
+	resourceTagsFlag        = "resource-tags"
+	forceFlag               = "force"
+	forceDesiredCountFlag   = "force-desired-count"
+	buildContextFlag        = "context"
+	imageDigestFlag         = "image-digest"
+	noBuildFlag             = "no-build"
+	verifyFlag              = "verify"
+	confirmChangeSetFlag    = "confirm-changeset"
+	watchOnlyFlag           = "watch-only"
+	manifestFlag            = "manifest"
+	maintenanceBodyFlag     = "body"
+	maintenanceDurationFlag = "duration"
+	stackOutputDirFlag      = "output-dir"
+	diffFlag                = "diff"
+	dryRunFlag              = "dry-run"
+	pinVersionFlag          = "pin-version"
+	limitFlag               = "limit"
+	followFlag              = "follow"
+	sinceFlag               = "since"
+	startTimeFlag           = "start-time"
+	startFromDeployFlag     = "start-from-deploy"
+	endTimeFlag             = "end-time"
+	tasksFlag               = "tasks"
+	queryFlag               = "query"
+	includeStateMachineFlag = "include-state-machine"
+	prodEnvFlag             = "prod"
+	deployFlag              = "deploy"
+	resourcesFlag           = "resources"
+	routesFlag              = "routes"
+	costsFlag               = "costs"
+	resourcesJSONFlag       = "resources-json"
+	watchFlag               = "watch"
+	githubURLFlag           = "github-url"
+	repoURLFlag             = "url"
+	githubAccessTokenFlag   = "github-access-token"
+	gitBranchFlag           = "git-branch"
+	envsFlag                = "environments"
+	domainNameFlag          = "domain"
+	domainRoleARNFlag       = "domain-role-arn"
+	repositoryPrefixFlag    = "repository-prefix"
+	pullThroughCacheFlag    = "pull-through-cache"
+	dockerHubCredsFlag      = "docker-hub-credentials"
+	localFlag               = "local"
+	deleteSecretFlag        = "delete-secret"
+	svcPortFlag             = "port"
+	fromComposeFlag         = "from-compose"
+	fromEnvFileFlag         = "from-env-file"
+	secretProviderFlag      = "provider"
+	pipelineProviderFlag    = "provider"
+	importECSFlag           = "import-ecs"
 
 	storageTypeFlag              = "storage-type"
 	storagePartitionKeyFlag      = "partition-key"
@@ -56,10 +90,19 @@ const (
 	storageNoSortFlag            = "no-sort"
 	storageLSIConfigFlag         = "lsi"
 	storageNoLSIFlag             = "no-lsi"
+	storageBillingModeFlag       = "billing-mode"
+	storageMinCapacityFlag       = "min-capacity"
+	storageMaxCapacityFlag       = "max-capacity"
+	storageTTLAttributeFlag      = "ttl-attribute"
+	storagePITRFlag              = "point-in-time-recovery"
 	storageRDSEngineFlag         = "engine"
 	storageRDSInitialDBFlag      = "initial-db"
 	storageRDSParameterGroupFlag = "parameter-group"
 
+	storageRDSInstanceClassFlag    = "instance-class"
+	storageRDSAllocatedStorageFlag = "allocated-storage"
+	storageRDSMultiAZFlag          = "multi-az"
+
 	taskGroupNameFlag  = "task-group-name"
 	countFlag          = "count"
 	cpuFlag            = "cpu"
@@ -81,11 +124,29 @@ const (
 	privateSubnetsFlag = "import-private-subnets"
 
 	vpcCIDRFlag            = "override-vpc-cidr"
+	azCountFlag            = "az-count"
 	publicSubnetCIDRsFlag  = "override-public-cidrs"
 	privateSubnetCIDRsFlag = "override-private-cidrs"
 
 	defaultConfigFlag = "default-config"
 
+	clusterNameFlag    = "cluster-name"
+	lbNameFlag         = "alb-name"
+	logGroupPrefixFlag = "log-group-prefix"
+
+	enableExternalInstancesFlag = "enable-external-instances"
+	ipv6Flag                    = "ipv6"
+
+	importCertARNsFlag    = "import-cert-arns"
+	sslPolicyFlag         = "ssl-policy"
+	mutualTLSModeFlag     = "mtls-mode"
+	mutualTLSCABundleFlag = "mtls-ca-bundle"
+	hstsFlag              = "hsts"
+	httpRedirectCodeFlag  = "http-redirect-code"
+	noHTTPRedirectFlag    = "no-http-redirect"
+
+	taskExecutionRoleFlag = "task-execution-role"
+
 	accessKeyIDFlag     = "aws-access-key-id"
 	secretAccessKeyFlag = "aws-secret-access-key"
 	sessionTokenFlag    = "aws-session-token"
@@ -97,6 +158,19 @@ const (
 
 	taskIDFlag    = "task-id"
 	containerFlag = "container"
+
+	docsOutputDirFlag = "output-dir"
+	docsFormatFlag    = "format"
+	appFormatFlag     = "format"
+
+	localPortFlag  = "local-port"
+	remotePortFlag = "remote-port"
+	remoteHostFlag = "remote-host"
+
+	portOverrideFlag = "port-override"
+	viaFlag          = "via"
+
+	skipCostEstimateFlag = "skip-cost-estimate"
 )
 
 // Short flag names.
@@ -133,6 +207,13 @@ Mutually exclusive with -%s, --%s`, imageFlagShort, imageFlag)
 %s`, strings.Join(template.QuoteSliceFunc(manifest.JobTypes), ", "))
 	wkldTypeFlagDescription = fmt.Sprintf(`Type of job or svc to create. Must be one of:
 %s`, strings.Join(template.QuoteSliceFunc(manifest.WorkloadTypes), ", "))
+	manifestTypeFlagDescription = fmt.Sprintf(`Type of the manifest to print the JSON Schema of. Must be one of:
+%s`, strings.Join(template.QuoteSliceFunc(manifest.WorkloadTypes), ", "))
+	secretProviderFlagDescription = fmt.Sprintf(`Optional. Where to store the secrets. Must be one of:
+%s`, strings.Join(template.QuoteSliceFunc(secretProviders), ", "))
+
+	pipelineProviderFlagDescription = fmt.Sprintf(`Optional. Where to run the pipeline. Must be one of:
+%s`, strings.Join(template.QuoteSliceFunc(pipelineProviders), ", "))
 
 	clusterFlagDescription = fmt.Sprintf(`Optional. The short name or full ARN of the cluster to run the task in. 
 Cannot be specified with '%s', '%s' or '%s'.`, appFlag, envFlag, taskDefaultFlag)
@@ -150,6 +231,9 @@ Cannot be specified with '%s' or '%s'`, appFlag, envFlag)
 Cannot be specified with '%s', '%s' or '%s'`, taskDefaultFlag, subnetsFlag, securityGroupsFlag)
 	taskAppFlagDescription = fmt.Sprintf(`Optional. Name of the application.
 Cannot be specified with '%s', '%s' or '%s'`, taskDefaultFlag, subnetsFlag, securityGroupsFlag)
+
+	pullThroughCacheFlagDescription = fmt.Sprintf(`Optional. Upstream registries to cache in ECR. Must be one of:
+%s`, strings.Join(template.QuoteSliceFunc(config.PullThroughCacheRegistries), ", "))
 )
 
 const (
@@ -168,7 +252,31 @@ const (
 	imageTagFlagDescription     = `Optional. The container image tag.`
 	resourceTagsFlagDescription = `Optional. Labels with a key and value separated by commas.
 Allows you to categorize resources.`
+	forceDeployFlagDescription = `Optional. Cancel any in-progress deployment of the service
+and start the new deployment without waiting for it to stabilize.`
+	forceDesiredCountFlagDescription = `Optional. Number of tasks of the service during a --force deployment.
+Overrides the count of the manifest.`
+	buildContextFlagDescription = `Optional. Override the Docker build context of the image.
+Relative to the workspace root, like image.build.context in the manifest.`
+	imageDigestFlagDescription = `Optional. Deploy the image with this digest from the service's repository
+instead of building it, for example to promote an image between environments.`
+	noBuildFlagDescription = `Optional. Deploy the image last pushed to the service's repository
+instead of building it.`
+	verifyFlagDescription = `Optional. Run the HTTP checks in the verify section of the manifest
+against the service once it's deployed.`
+	confirmChangeSetFlagDescription = `Optional. Show the resources that the deployment adds, modifies, replaces and removes,
+and wait for confirmation before deploying them.`
+	watchOnlyFlagDescription = `Optional. Follow the deployment of the service that is already in progress,
+for example after an interrupted deploy, instead of starting a new one.`
+	manifestFlagDescription = `Optional. Location of a manifest to deploy instead of the one in the workspace,
+either s3://bucket/key or an https:// URL. Fields of the workspace manifest override it.`
+	maintenanceBodyFlagDescription = `Optional. HTML body of the 503 response returned
+while the service is in maintenance. Up to 1024 characters.`
+	maintenanceDurationFlagDescription = `Optional. Expected length of the maintenance, like 30m or 2h.
+Recorded and shown by "svc status", maintenance stays on until "svc maintenance off".`
+
 	stackOutputDirFlagDescription = "Optional. Writes the stack template and template configuration to a directory."
+	diffFlagDescription           = "Optional. Compares the stack template and template configuration with the deployed ones."
 	prodEnvFlagDescription        = "If the environment contains production services."
 
 	limitFlagDescription = `Optional. The maximum number of log events returned. Default is 10
@@ -180,7 +288,13 @@ Defaults to all logs. Only one of start-time / since may be used.`
 Defaults to all logs. Only one of start-time / since may be used.`
 	endTimeFlagDescription = `Optional. Only return logs before a specific date (RFC3339).
 Defaults to all logs. Only one of end-time / follow may be used.`
-	tasksLogsFlagDescription = "Optional. Only return logs from specific task IDs."
+	tasksLogsFlagDescription       = "Optional. Only return logs from specific task IDs."
+	startFromDeployFlagDescription = `Optional. Only return logs since the latest deployment of the service started.
+Cannot be used with start-time or since.`
+	queryFlagDescription = `Optional. A CloudWatch Logs Insights query to run on the logs of the service's containers, sidecars included.
+Queries the last hour unless start-time, since, or start-from-deploy is set. Cannot be used with follow.`
+	includeStateMachineFlagDescription = `Optional. Include the execution events of the job's state machine,
+such as retries, failures and timeouts, in between the logs of its tasks.`
 
 	deployTestFlagDescription        = `Deploy your service or job to a "test" environment.`
 	githubURLFlagDescription         = "(Deprecated.) Use --url instead. Repository URL to trigger your pipeline."
@@ -189,13 +303,31 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	gitBranchFlagDescription         = "Branch used to trigger your pipeline."
 	pipelineEnvsFlagDescription      = "Environments to add to the pipeline."
 	domainNameFlagDescription        = "Optional. Your existing custom domain name."
-	envResourcesFlagDescription      = "Optional. Show the resources in your environment."
+	domainRoleARNFlagDescription     = `Optional. IAM role to assume to manage the hosted zone of the domain
+when it lives in a different account than the application.`
+	repositoryPrefixFlagDescription = `Optional. Prefix of the ECR repository names of your workloads
+(default application name).`
+	dockerHubCredsFlagDescription = `Optional. ARN of the Secrets Manager secret with your Docker Hub credentials.
+The secret name must start with "ecr-pullthroughcache/".
+Required to cache docker-hub.`
+	envResourcesFlagDescription     = "Optional. Show the resources in your environment."
+	envRoutesFlagDescription        = "Optional. Show the listener rules of your environment's load balancer."
+	envCostsFlagDescription         = "Optional. Show the month-to-date and forecasted cost of each service in your environment."
+	envResourcesJSONFlagDescription = "Optional. Output every resource in the Resource Group of your environment in JSON format."
+	envWatchFlagDescription         = `Optional. Follow an update of the environment started elsewhere,
+such as by a pipeline, until it completes.`
+	appResourcesJSONFlagDescription  = "Optional. Output every resource in the Resource Group of your application's region in JSON format."
+	appFormatFlagDescription         = `Optional. Output the architecture graph of your application in "dot" or "mermaid" format.`
 	svcResourcesFlagDescription      = "Optional. Show the resources in your service."
 	pipelineResourcesFlagDescription = "Optional. Show the resources in your pipeline."
 	localSvcFlagDescription          = "Only show services in the workspace."
 	localJobFlagDescription          = "Only show jobs in the workspace."
 	deleteSecretFlagDescription      = "Deletes AWS Secrets Manager secret associated with a pipeline source repository."
 	svcPortFlagDescription           = "Optional. The port on which your service listens."
+	fromComposeFlagDescription       = `Optional. Path to a Docker Compose file to import services from.
+Cannot be used with name, type, dockerfile, image, port or schedule.`
+	fromEnvFileFlagDescription = `Path to a dotenv file of KEY=VALUE lines.
+Each line is stored as a secret in every environment of the application.`
 
 	storageFlagDescription             = "Name of the storage resource to create."
 	storageWorkloadFlagDescription     = "Name of the service or job to associate with storage."
@@ -207,10 +339,22 @@ Must be of the format '<keyName>:<dataType>'.`
 	storageNoLSIFlagDescription     = `Optional. Don't ask about configuring alternate sort keys.`
 	storageLSIConfigFlagDescription = `Optional. Attribute to use as an alternate sort key. May be specified up to 5 times.
 Must be of the format '<keyName>:<dataType>'.`
-	storageRDSEngineFlagDescription = `The database engine used in the cluster.
+	storageBillingModeFlagDescription = `Optional. How the DDB table is charged for reads and writes.
+Must be either "OnDemand" or "Provisioned".`
+	storageMinCapacityFlagDescription = `Optional. The minimum read and write capacity units of a provisioned DDB table.`
+	storageMaxCapacityFlagDescription = `Optional. The maximum read and write capacity units that the provisioned DDB table
+can auto scale to.`
+	storageTTLAttributeFlagDescription = `Optional. Name of the attribute that holds the expiration time of items in the DDB table.`
+	storagePITRFlagDescription         = `Optional. Enable point-in-time recovery for the DDB table.`
+	storageRDSEngineFlagDescription    = `The database engine used in the cluster or instance.
 Must be either "MySQL" or "PostgreSQL".`
-	storageRDSInitialDBFlagDescription      = "The initial database to create in the cluster."
-	storageRDSParameterGroupFlagDescription = "Optional. The name of the parameter group to associate with the cluster."
+	storageRDSInitialDBFlagDescription      = "The initial database to create in the cluster or instance."
+	storageRDSParameterGroupFlagDescription = "Optional. The name of the parameter group to associate with the cluster or instance."
+
+	storageRDSInstanceClassFlagDescription    = `The compute and memory capacity of the instance (example: db.t3.micro).`
+	storageRDSAllocatedStorageFlagDescription = `The amount of storage to allocate to the instance in GiB.
+Must be between 20 and 65536.`
+	storageRDSMultiAZFlagDescription = `Optional. Run a standby instance in another Availability Zone.`
 
 	countFlagDescription         = "Optional. The number of tasks to set up."
 	cpuFlagDescription           = "Optional. The number of CPU units to reserve for each task."
@@ -230,12 +374,37 @@ Tasks with the same group name share the same set of resources.
 	publicSubnetsFlagDescription  = "Optional. Use existing public subnet IDs."
 	privateSubnetsFlagDescription = "Optional. Use existing private subnet IDs."
 
-	vpcCIDRFlagDescription            = "Optional. Global CIDR to use for VPC (default 10.0.0.0/16)."
+	vpcCIDRFlagDescription = "Optional. Global CIDR to use for VPC (default 10.0.0.0/16)."
+	azCountFlagDescription = `Optional. Number of availability zones, 2 or 3, each with a public and a private subnet (default 2).
+Subnet CIDRs default to consecutive blocks of the VPC CIDR.`
 	publicSubnetCIDRsFlagDescription  = "Optional. CIDR to use for public subnets (default 10.0.0.0/24,10.0.1.0/24)."
 	privateSubnetCIDRsFlagDescription = "Optional. CIDR to use for private subnets (default 10.0.2.0/24,10.0.3.0/24)."
 
 	defaultConfigFlagDescription = "Optional. Skip prompting and use default environment configuration."
 
+	clusterNameFlagDescription    = "Optional. Name of the ECS cluster (default generated by CloudFormation)."
+	lbNameFlagDescription         = "Optional. Name of the public Application Load Balancer (default generated by CloudFormation)."
+	logGroupPrefixFlagDescription = "Optional. Prefix of the log groups of your workloads (default /copilot)."
+
+	enableExternalInstancesFlagDescription = `Optional. Allow on-premises instances to join the environment's cluster with ECS Anywhere.
+Prints the command that registers an instance once the environment is created.`
+	ipv6FlagDescription = `Optional. Create a dual-stack VPC and public load balancer that accept IPv6 traffic.
+Cannot be used with an imported VPC.`
+
+	envDomainFlagDescription = `Optional. Existing domain of the environment, such as "dev.example.com".
+Its public hosted zone must be in the environment's account.
+Replaces the subdomain of the application's domain.`
+	importCertARNsFlagDescription = `Optional. ARNs of ACM certificates, such as ones issued by a Private CA,
+added to the HTTPS listener of the environment's load balancer.`
+	sslPolicyFlagDescription         = "Optional. Security policy that defines the TLS protocols and ciphers of the HTTPS listener."
+	mutualTLSModeFlagDescription     = `Optional. Authenticate clients of the HTTPS listener with certificates. Must be one of "verify" or "passthrough".`
+	mutualTLSCABundleFlagDescription = `Optional. S3 URI of the bundle of CA certificates trusted to issue client certificates.
+Required if --mtls-mode is "verify".`
+	hstsFlagDescription = `Optional. Value of the Strict-Transport-Security header added to the responses of the HTTPS listener.
+For example: "max-age=31536000; includeSubDomains".`
+	httpRedirectCodeFlagDescription = "Optional. Status code of the redirect from HTTP to HTTPS, must be 301 or 302. Defaults to 301."
+	noHTTPRedirectFlagDescription   = "Optional. Forward HTTP requests to services instead of redirecting them to HTTPS."
+
 	accessKeyIDFlagDescription     = "Optional. An AWS access key."
 	secretAccessKeyFlagDescription = "Optional. An AWS secret access key."
 	sessionTokenFlagDescription    = "Optional. An AWS session token for temporary credentials."
@@ -251,8 +420,51 @@ AWS Schedule Expressions of the form "rate(10 minutes)" or "cron(0 12 L * ? 2021
 are also accepted.`
 
 	upgradeAllEnvsDescription = "Optional. Upgrade all environments."
+	deployAllFlagDescription  = `Optional. Deploy every service and job in the workspace to an environment.
+Workloads that don't reference each other are deployed in parallel.`
+	envUpgradeDiffFlagDescription = `Optional. Compares the template and parameters of the environment stacks with the upgraded ones,
+without upgrading the environments.`
+	appUpgradeDryRunFlagDescription = "Optional. Compares the templates of the application infrastructure with the upgraded ones, without upgrading."
+	pinVersionFlagDescription       = `Optional. Pins the application to its template version, so that older versions of Copilot
+can't update its infrastructure. Use --pin-version=false to unpin the application.`
+
+	taskIDFlagDescription        = "Optional. ID of the task you want to exec in."
+	execCommandFlagDescription   = `Optional. The command that is passed to a running container.`
+	containerFlagDescription     = "Optional. The specific container you want to exec in. By default the first essential container will be used."
+	svcExecTaskIDFlagDescription = `Optional. ID of the task you want to exec in.
+By default, you are prompted for a task if the service runs more than one.`
+	svcExecContainerFlagDescription = `Optional. The specific container you want to exec in, such as a sidecar.
+By default, you are prompted for a container if the task runs more than one.`
+
+	docsOutputDirFlagDescription = "Optional. Writes a reference page for every command to a directory instead of opening the docs."
+	docsFormatFlagDescription    = `Optional. Format of the reference pages written to --output-dir.
+Must be one of "man" or "markdown".`
+
+	localPortFlagDescription  = "Optional. The port on your machine to listen on. Defaults to the remote port."
+	remotePortFlagDescription = "The port to forward to, on the container or on the remote host."
+	remoteHostFlagDescription = `Optional. A host reachable from the service's task to forward to, for example a database endpoint.
+By default, traffic is forwarded to the container itself.`
+
+	portOverrideFlagDescription = `Optional. Publish a container port on another port of your machine, as host:container.
+By default, each container port is published on the same port of your machine.`
+	runLocalWatchFlagDescription = `Optional. Rebuild the image and restart the containers
+whenever a file of the build context changes.`
+
+	proxyLocalPortFlagDescription = "Optional. The port on your machine to listen on. Defaults to the port of the service."
+	viaFlagDescription            = `Optional. Name of the service whose task tunnels the traffic, which must have exec enabled.
+Defaults to the service to reach.`
+
+	skipCostEstimateFlagDescription = "Optional. Skip printing the approximate monthly cost of the created resources."
+
+	manifestValidateNameFlagDescription = `Optional. Name of the service or job whose manifest to validate.
+Defaults to every service and job in the workspace.`
+
+	importECSFlagDescription = `Optional. An existing Amazon ECS service to import, as <cluster>/<service>.
+Cannot be used with type, dockerfile, image or port.`
 
-	taskIDFlagDescription      = "Optional. ID of the task you want to exec in."
-	execCommandFlagDescription = `Optional. The command that is passed to a running container.`
-	containerFlagDescription   = "Optional. The specific container you want to exec in. By default the first essential container will be used."
+	appTaskExecutionRoleFlagDescription = `Optional. ARN of an IAM role used as the task execution role
+by all services and jobs instead of one role per workload.`
+	envTaskExecutionRoleFlagDescription = `Optional. ARN of an IAM role in the environment's account used as the
+task execution role by all services and jobs in the environment.
+Overrides the application's task execution role.`
 )
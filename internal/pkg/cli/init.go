@@ -57,6 +57,9 @@ type initVars struct {
 	schedule string
 	retries  int
 	timeout  string
+
+	// Docker Compose specific flags
+	composePath string
 }
 
 type initOpts struct {
@@ -79,6 +82,7 @@ type initOpts struct {
 	port         *uint16
 	schedule     *string
 	initWkldVars *initWkldVars
+	composeSvcs  *[]string
 
 	prompt prompter
 
@@ -152,6 +156,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		store:        ssm,
 		prompt:       prompt,
 		ws:           ws,
+		fs:           afero.NewOsFs(),
 		unmarshal:    manifest.UnmarshalWorkload,
 		sel:          sel,
 		spinner:      spin,
@@ -167,6 +172,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		store:        ssm,
 		prompt:       prompt,
 		ws:           ws,
+		fs:           afero.NewOsFs(),
 		unmarshal:    manifest.UnmarshalWorkload,
 		sel:          sel,
 		spinner:      spin,
@@ -189,6 +195,24 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 
 		setupWorkloadInit: func(o *initOpts, wkldType string) error {
 			wlInitializer := &initialize.WorkloadInitializer{Store: ssm, Ws: ws, Prog: spin, Deployer: deployer}
+			if vars.composePath != "" {
+				opts := initComposeOpts{
+					initComposeVars: initComposeVars{
+						appName: *o.appName,
+						path:    vars.composePath,
+					},
+					fs:   &afero.Afero{Fs: afero.NewOsFs()},
+					ws:   ws,
+					init: wlInitializer,
+				}
+				o.initWlCmd = &opts
+				o.composeSvcs = &opts.services // Surfaced via pointer for deployments.
+				return nil
+			}
+			engine, err := exec.NewContainerEngineCommand()
+			if err != nil {
+				return fmt.Errorf("detect container engine: %w", err)
+			}
 			wkldVars := initWkldVars{
 				appName:        *o.appName,
 				wkldType:       wkldType,
@@ -212,7 +236,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 					init:                  wlInitializer,
 					sel:                   sel,
 					prompt:                prompt,
-					dockerEngineValidator: exec.NewDockerCommand(),
+					dockerEngineValidator: engine,
 				}
 				o.initWlCmd = &opts
 				o.schedule = &opts.schedule // Surfaced via pointer for logging
@@ -229,7 +253,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 					init:                  wlInitializer,
 					sel:                   sel,
 					prompt:                prompt,
-					dockerEngineValidator: exec.NewDockerCommand(),
+					dockerEngineValidator: engine,
 					setupParser: func(o *initSvcOpts) {
 						o.df = exec.NewDockerfile(o.fs, o.dockerfilePath)
 					},
@@ -255,21 +279,33 @@ to help you get set up with an application on ECS. An application is a collectio
 containerized services that operate together.`))
 	log.Infoln()
 
-	if err := o.loadApp(); err != nil {
+	if err := o.validateCompose(); err != nil {
 		return err
 	}
 
-	if err := o.loadWkld(); err != nil {
+	if err := o.loadApp(); err != nil {
 		return err
 	}
 
-	o.logWorkloadTypeAck()
+	if o.composePath != "" {
+		if err := o.loadCompose(); err != nil {
+			return err
+		}
+	} else {
+		if err := o.loadWkld(); err != nil {
+			return err
+		}
+		o.logWorkloadTypeAck()
+	}
 
 	log.Infoln()
 	if err := o.initAppCmd.Execute(); err != nil {
 		return fmt.Errorf("execute app init: %w", err)
 	}
 	if err := o.initWlCmd.Execute(); err != nil {
+		if o.composePath != "" {
+			return fmt.Errorf("import compose file %s: %w", o.composePath, err)
+		}
 		return fmt.Errorf("execute %s init: %w", o.wkldType, err)
 	}
 
@@ -292,11 +328,43 @@ func (o *initOpts) logWorkloadTypeAck() {
 	}
 }
 
+// validateCompose returns an error if workload flags are specified along with a Docker Compose file.
+func (o *initOpts) validateCompose() error {
+	if o.composePath == "" {
+		return nil
+	}
+	flags := []struct {
+		name  string
+		isSet bool
+	}{
+		{nameFlag, o.svcName != ""},
+		{typeFlag, o.wkldType != ""},
+		{dockerFileFlag, o.dockerfilePath != ""},
+		{imageFlag, o.image != ""},
+		{svcPortFlag, o.initVars.port != 0},
+		{scheduleFlag, o.initVars.schedule != ""},
+	}
+	for _, flag := range flags {
+		if flag.isSet {
+			return fmt.Errorf("--%s cannot be specified with --%s", flag.name, fromComposeFlag)
+		}
+	}
+	return nil
+}
+
 func (o *initOpts) deploy() error {
+	if o.composePath != "" {
+		for _, svc := range *o.composeSvcs {
+			if err := o.deploySvc(svc); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	if o.initWkldVars.wkldType == manifest.ScheduledJobType {
 		return o.deployJob()
 	}
-	return o.deploySvc()
+	return o.deploySvc(o.initWkldVars.name)
 }
 func (o *initOpts) loadApp() error {
 	if err := o.initAppCmd.Ask(); err != nil {
@@ -323,6 +391,16 @@ func (o *initOpts) loadWkld() error {
 	return nil
 }
 
+func (o *initOpts) loadCompose() error {
+	if err := o.setupWorkloadInit(o, ""); err != nil {
+		return err
+	}
+	if err := o.initWlCmd.Validate(); err != nil {
+		return fmt.Errorf("validate compose file %s: %w", o.composePath, err)
+	}
+	return nil
+}
+
 func (o *initOpts) loadWkldCmd() error {
 	wkldType, err := o.askWorkload()
 	if err != nil {
@@ -377,13 +455,13 @@ func (o *initOpts) deployEnv() error {
 	return o.initEnvCmd.Execute()
 }
 
-func (o *initOpts) deploySvc() error {
+func (o *initOpts) deploySvc(name string) error {
 	if !o.ShouldDeploy {
 		return nil
 	}
 	if deployOpts, ok := o.deploySvcCmd.(*deploySvcOpts); ok {
 		// Set the service's name and app name to the deploy sub-command.
-		deployOpts.name = o.initWkldVars.name
+		deployOpts.name = name
 		deployOpts.appName = *o.appName
 	}
 
@@ -425,6 +503,9 @@ func BuildInitCmd() *cobra.Command {
 		Use:   "init",
 		Short: "Create a new ECS application.",
 		Long:  "Create a new ECS application.",
+		Example: `
+  Import the services of a Docker Compose file and deploy them to a "test" environment.
+  /code $ copilot init --from-compose docker-compose.yml --deploy`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitOpts(vars)
 			if err != nil {
@@ -456,6 +537,7 @@ func BuildInitCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.schedule, scheduleFlag, "", scheduleFlagDescription)
 	cmd.Flags().StringVar(&vars.timeout, timeoutFlag, "", timeoutFlagDescription)
 	cmd.Flags().IntVar(&vars.retries, retriesFlag, 0, retriesFlagDescription)
+	cmd.Flags().StringVar(&vars.composePath, fromComposeFlag, "", fromComposeFlagDescription)
 	cmd.SetUsageTemplate(cmdtemplate.Usage)
 	cmd.Annotations = map[string]string{
 		"group": group.GettingStarted,
@@ -0,0 +1,112 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/compose"
+	"github.com/aws/copilot-cli/internal/pkg/initialize"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/afero"
+)
+
+type initComposeVars struct {
+	appName string
+	path    string
+}
+
+// initComposeOpts imports the services of a Docker Compose file as Copilot services.
+type initComposeOpts struct {
+	initComposeVars
+
+	fs   afero.Fs
+	ws   copilotDirGetter
+	init svcImporter
+
+	// Outputs stored on successful actions.
+	services      []string
+	manifestPaths []string
+}
+
+// Validate returns an error if the flag values are invalid.
+func (o *initComposeOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if _, err := o.fs.Stat(o.path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Ask is a no-op since all the information is read from the Compose file.
+func (o *initComposeOpts) Ask() error {
+	return nil
+}
+
+// Execute translates the Compose services into manifests, and adds the services to the application.
+func (o *initComposeOpts) Execute() error {
+	content, err := afero.ReadFile(o.fs, o.path)
+	if err != nil {
+		return fmt.Errorf("read compose file %s: %w", o.path, err)
+	}
+	project, err := compose.Parse(content)
+	if err != nil {
+		return fmt.Errorf("parse compose file %s: %w", o.path, err)
+	}
+	composeDir, err := filepath.Abs(filepath.Dir(o.path))
+	if err != nil {
+		return fmt.Errorf("get absolute path of compose file directory: %w", err)
+	}
+	copilotDirPath, err := o.ws.CopilotDirPath()
+	if err != nil {
+		return fmt.Errorf("get copilot directory: %w", err)
+	}
+	conversion, err := compose.Convert(project, composeDir, filepath.Dir(copilotDirPath))
+	if err != nil {
+		return err
+	}
+	for _, wl := range conversion.Workloads {
+		if err := validateSvcName(wl.Name); err != nil {
+			return err
+		}
+	}
+	for _, msg := range conversion.Unsupported {
+		log.Warningln(msg)
+	}
+	if len(conversion.Unsupported) != 0 {
+		log.Infoln()
+	}
+
+	for _, wl := range conversion.Workloads {
+		manifestPath, err := o.init.ImportService(&initialize.WorkloadProps{
+			App:  o.appName,
+			Type: wl.Type,
+			Name: wl.Name,
+		}, wl.Manifest)
+		if err != nil {
+			return err
+		}
+		o.services = append(o.services, wl.Name)
+		o.manifestPaths = append(o.manifestPaths, manifestPath)
+	}
+	return nil
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *initComposeOpts) RecommendedActions() []string {
+	actions := []string{
+		fmt.Sprintf("Update your manifests %s to change the defaults.", color.HighlightResource(strings.Join(o.manifestPaths, ", "))),
+	}
+	for _, svc := range o.services {
+		actions = append(actions, fmt.Sprintf("Run %s to deploy your service to a %s environment.",
+			color.HighlightCode(fmt.Sprintf("copilot svc deploy --name %s --env %s", svc, defaultEnvironmentName)),
+			defaultEnvironmentName))
+	}
+	return actions
+}
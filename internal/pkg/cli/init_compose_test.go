@@ -0,0 +1,178 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/initialize"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitComposeOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+		inPath    string
+		setupFs   func(fs afero.Fs)
+
+		wantedError string
+	}{
+		"fails if not in a workspace": {
+			wantedError: errNoAppInWorkspace.Error(),
+		},
+		"fails if the compose file does not exist": {
+			inAppName:   "phonetool",
+			inPath:      "docker-compose.yml",
+			wantedError: "open docker-compose.yml: file does not exist",
+		},
+		"succeeds if the compose file exists": {
+			inAppName: "phonetool",
+			inPath:    "docker-compose.yml",
+			setupFs: func(fs afero.Fs) {
+				afero.WriteFile(fs, "docker-compose.yml", []byte("services:"), 0644)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			if tc.setupFs != nil {
+				tc.setupFs(fs)
+			}
+			opts := initComposeOpts{
+				initComposeVars: initComposeVars{
+					appName: tc.inAppName,
+					path:    tc.inPath,
+				},
+				fs: fs,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestInitComposeOpts_Execute(t *testing.T) {
+	const composeFile = `
+services:
+  web:
+    build: ./web
+    ports:
+      - "8080:80"
+  api:
+    image: node
+    expose:
+      - 3000
+    links:
+      - web
+`
+	testCases := map[string]struct {
+		inContent string
+
+		mockWs       func(m *mocks.MockcopilotDirGetter)
+		mockImporter func(m *mocks.MocksvcImporter)
+
+		wantedServices []string
+		wantedError    string
+	}{
+		"fails to parse the compose file": {
+			inContent:   "services: {}",
+			wantedError: "parse compose file /ws/docker-compose.yml: no services defined in compose file",
+		},
+		"fails if a service name is invalid": {
+			inContent: `
+services:
+  Web:
+    image: nginx
+`,
+			mockWs: func(m *mocks.MockcopilotDirGetter) {
+				m.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+			},
+			wantedError: "service name Web is invalid: value must start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen",
+		},
+		"fails to import a service": {
+			inContent: composeFile,
+			mockWs: func(m *mocks.MockcopilotDirGetter) {
+				m.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+			},
+			mockImporter: func(m *mocks.MocksvcImporter) {
+				m.EXPECT().ImportService(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: "some error",
+		},
+		"imports every service": {
+			inContent: composeFile,
+			mockWs: func(m *mocks.MockcopilotDirGetter) {
+				m.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+			},
+			mockImporter: func(m *mocks.MocksvcImporter) {
+				m.EXPECT().ImportService(&initialize.WorkloadProps{
+					App:  "phonetool",
+					Type: manifest.BackendServiceType,
+					Name: "api",
+				}, gomock.Any()).Return("copilot/api/manifest.yml", nil)
+				m.EXPECT().ImportService(&initialize.WorkloadProps{
+					App:  "phonetool",
+					Type: manifest.LoadBalancedWebServiceType,
+					Name: "web",
+				}, gomock.Any()).Return("copilot/web/manifest.yml", nil)
+			},
+			wantedServices: []string{"api", "web"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fs := afero.NewMemMapFs()
+			afero.WriteFile(fs, "/ws/docker-compose.yml", []byte(tc.inContent), 0644)
+			mockWs := mocks.NewMockcopilotDirGetter(ctrl)
+			mockImporter := mocks.NewMocksvcImporter(ctrl)
+			if tc.mockWs != nil {
+				tc.mockWs(mockWs)
+			}
+			if tc.mockImporter != nil {
+				tc.mockImporter(mockImporter)
+			}
+			opts := initComposeOpts{
+				initComposeVars: initComposeVars{
+					appName: "phonetool",
+					path:    "/ws/docker-compose.yml",
+				},
+				fs:   fs,
+				ws:   mockWs,
+				init: mockImporter,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedServices, opts.services)
+		})
+	}
+}
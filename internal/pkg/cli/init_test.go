@@ -22,8 +22,10 @@ func TestInitOpts_Run(t *testing.T) {
 		inShouldDeploy          bool
 		inPromptForShouldDeploy bool
 
-		inAppName string
-		inWlType  string
+		inAppName     string
+		inWlType      string
+		inSvcName     string
+		inComposePath string
 
 		expect      func(opts *initOpts)
 		wantedError string
@@ -153,6 +155,48 @@ func TestInitOpts_Run(t *testing.T) {
 					Return(false, nil)
 			},
 		},
+		"returns error if workload flags are used with a compose file": {
+			inComposePath: "docker-compose.yml",
+			inSvcName:     "frontend",
+			expect:        func(opts *initOpts) {},
+			wantedError:   "--name cannot be specified with --from-compose",
+		},
+		"returns validation error for compose file": {
+			inComposePath: "docker-compose.yml",
+			expect: func(opts *initOpts) {
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Ask().Return(nil)
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Validate().Return(nil)
+				opts.initWlCmd.(*climocks.MockactionCommand).EXPECT().Ask().Times(0)
+				opts.initWlCmd.(*climocks.MockactionCommand).EXPECT().Validate().Return(errors.New("my error"))
+			},
+			wantedError: "validate compose file docker-compose.yml: my error",
+		},
+		"returns execute error for compose file": {
+			inComposePath: "docker-compose.yml",
+			expect: func(opts *initOpts) {
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Ask().Return(nil)
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Validate().Return(nil)
+				opts.initWlCmd.(*climocks.MockactionCommand).EXPECT().Validate().Return(nil)
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Execute().Return(nil)
+				opts.initWlCmd.(*climocks.MockactionCommand).EXPECT().Execute().Return(errors.New("my error"))
+			},
+			wantedError: "import compose file docker-compose.yml: my error",
+		},
+		"deploys every service imported from a compose file": {
+			inComposePath:  "docker-compose.yml",
+			inShouldDeploy: true,
+			expect: func(opts *initOpts) {
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Ask().Return(nil)
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Validate().Return(nil)
+				opts.initWlCmd.(*climocks.MockactionCommand).EXPECT().Validate().Return(nil)
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Execute().Return(nil)
+				opts.initWlCmd.(*climocks.MockactionCommand).EXPECT().Execute().Return(nil)
+
+				opts.initEnvCmd.(*climocks.MockactionCommand).EXPECT().Execute().Return(nil)
+				opts.deploySvcCmd.(*climocks.MockactionCommand).EXPECT().Ask().Return(nil).Times(2)
+				opts.deploySvcCmd.(*climocks.MockactionCommand).EXPECT().Execute().Return(nil).Times(2)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -163,8 +207,10 @@ func TestInitOpts_Run(t *testing.T) {
 
 			opts := &initOpts{
 				initVars: initVars{
-					appName:  tc.inAppName,
-					wkldType: tc.inWlType,
+					appName:     tc.inAppName,
+					wkldType:    tc.inWlType,
+					svcName:     tc.inSvcName,
+					composePath: tc.inComposePath,
 				},
 				ShouldDeploy:          tc.inShouldDeploy,
 				promptForShouldDeploy: tc.inPromptForShouldDeploy,
@@ -181,6 +227,7 @@ func TestInitOpts_Run(t *testing.T) {
 				initWkldVars:      &initWkldVars{},
 				schedule:          &mockSchedule,
 				port:              &mockPort,
+				composeSvcs:       &[]string{"api", "web"},
 				setupWorkloadInit: func(*initOpts, string) error { return nil },
 			}
 			tc.expect(opts)
@@ -4,15 +4,24 @@
 package cli
 
 import (
+	"context"
 	"encoding"
 	"io"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/cost"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -22,11 +31,13 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/initialize"
 	"github.com/aws/copilot-cli/internal/pkg/logging"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
 	"github.com/aws/copilot-cli/internal/pkg/task"
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/verify"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 )
 
@@ -75,12 +86,17 @@ type applicationStore interface {
 	applicationGetter
 	applicationLister
 	applicationDeleter
+	applicationUpdater
 }
 
 type applicationCreator interface {
 	CreateApplication(app *config.Application) error
 }
 
+type applicationUpdater interface {
+	UpdateApplication(app *config.Application) error
+}
+
 type applicationGetter interface {
 	GetApplication(appName string) (*config.Application, error)
 }
@@ -147,6 +163,10 @@ type secretDeleter interface {
 
 type imageBuilderPusher interface {
 	BuildAndPush(docker repository.ContainerLoginBuildPusher, args *exec.BuildArguments) (string, error)
+	PushLazyLoadIndex(soci repository.LazyLoadIndexPusher, digest string) error
+	SetLifecyclePolicy(policy ecr.LifecyclePolicy) error
+	DeleteLifecyclePolicy() error
+	LastPushedDigest() (string, error)
 }
 
 type repositoryURIGetter interface {
@@ -162,10 +182,24 @@ type logEventsWriter interface {
 	WriteLogEvents(opts logging.WriteLogEventsOpts) error
 }
 
+type logQueryWriter interface {
+	WriteQueryResults(opts logging.QueryOpts) error
+}
+
 type templater interface {
 	Template() (string, error)
 }
 
+type costEstimator interface {
+	Estimate(tpl []byte) (*cost.Estimate, error)
+	FargateTask(cpu, memory int, condition string) (cost.LineItem, error)
+}
+
+type deployedStackDescriber interface {
+	Describe(stackName string) (*awscloudformation.StackDescription, error)
+	TemplateBody(stackName string) (string, error)
+}
+
 type stackSerializer interface {
 	templater
 	SerializedParameters() (string, error)
@@ -234,6 +268,7 @@ type wsPipelineManifestReader interface {
 type wsPipelineWriter interface {
 	WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error)
 	WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error)
+	WriteGitHubActionsWorkflow(marshaler encoding.BinaryMarshaler, fileName string) (string, error)
 }
 
 type wsServiceLister interface {
@@ -273,6 +308,7 @@ type wsWlDirReader interface {
 	wsSvcReader
 	copilotDirGetter
 	wsWlReader
+	ReadWorkloadManifest(name string) ([]byte, error)
 	ListDockerfiles() ([]string, error)
 	Summary() (*workspace.Summary, error)
 }
@@ -289,6 +325,8 @@ type wsAppManager interface {
 
 type wsAddonManager interface {
 	WriteAddon(f encoding.BinaryMarshaler, svc, name string) (string, error)
+	ReadWorkloadManifest(name string) ([]byte, error)
+	OverwriteWorkloadManifest(data []byte, name string) (string, error)
 	wsWlReader
 }
 
@@ -357,6 +395,10 @@ type appResourcesGetter interface {
 	GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error)
 }
 
+type envImageAccessGranter interface {
+	GrantEnvImageAccess(opts *cloudformation.AddEnvToAppOpts) error
+}
+
 type taskDeployer interface {
 	DeployTask(out termprogress.FileWriter, input *deploy.CreateTaskResourcesInput, opts ...awscloudformation.StackOption) error
 }
@@ -385,6 +427,15 @@ type domainHostedZoneGetter interface {
 	DomainHostedZoneID(domainName string) (string, error)
 }
 
+type hostedZoneNameServersGetter interface {
+	domainHostedZoneGetter
+	NameServers(hostedZoneID string) ([]string, error)
+}
+
+type nsRecordUpserter interface {
+	UpsertNSRecord(hostedZoneID, subdomain string, nameServers []string) error
+}
+
 type dockerfileParser interface {
 	GetExposedPorts() ([]uint16, error)
 	GetHealthCheck() (*exec.HealthCheck, error)
@@ -394,14 +445,57 @@ type statusDescriber interface {
 	Describe() (*describe.ServiceStatusDesc, error)
 }
 
+type imagesDescriber interface {
+	Describe() (*describe.ServiceImagesDesc, error)
+}
+
+type lastDeploymentDescriber interface {
+	Describe() (*describe.LastDeploymentDesc, error)
+}
+
 type envDescriber interface {
 	Describe() (*describe.EnvDescription, error)
 }
 
+type envUpdateRenderer interface {
+	RenderEnvironmentUpdate(out termprogress.FileWriter, appName, envName string) error
+}
+
+type workloadUpdateRenderer interface {
+	RenderWorkloadUpdate(out termprogress.FileWriter, stackName string) error
+}
+
 type versionGetter interface {
 	Version() (string, error)
 }
 
+type resourceGroupURLGetter interface {
+	ResourceGroupURL() (string, error)
+}
+
+type resourceGroupDescriber interface {
+	Describe() (*describe.ResourceGroup, error)
+}
+
+type addonResourcesGetter interface {
+	Resources() ([]addon.Resource, error)
+}
+
+type wsWlManifestReader interface {
+	WorkloadNames() ([]string, error)
+	ReadWorkloadManifest(name string) ([]byte, error)
+}
+
+type wsTopologyReader interface {
+	Summary() (*workspace.Summary, error)
+	WorkloadNames() ([]string, error)
+	ReadWorkloadManifest(name string) ([]byte, error)
+}
+
+type quotaDescriber interface {
+	Describe() ([]*describe.QuotaUsage, error)
+}
+
 type envTemplater interface {
 	EnvironmentTemplate(appName, envName string) (string, error)
 }
@@ -422,6 +516,7 @@ type envTemplateUpgrader interface {
 
 type appUpgrader interface {
 	UpgradeApplication(in *deploy.CreateAppInput) error
+	PreviewApplicationUpgrade(in *deploy.CreateAppInput) (*cloudformation.AppUpgradePreview, error)
 }
 
 type pipelineGetter interface {
@@ -509,6 +604,24 @@ type svcInitializer interface {
 	Service(props *initialize.ServiceProps) (string, error)
 }
 
+type svcImporter interface {
+	ImportService(props *initialize.WorkloadProps, mf encoding.BinaryMarshaler) (string, error)
+}
+
+type ecsServiceImportDescriber interface {
+	Service(cluster, service string) (*awsecs.Service, error)
+	TaskDefinition(taskDefName string) (*awsecs.TaskDefinition, error)
+}
+
+type ecsServiceScalingDescriber interface {
+	ECSServiceScalableTarget(cluster, service string) (*aas.ScalableTarget, error)
+	ECSServiceScalingPolicies(cluster, service string) ([]aas.ScalingPolicy, error)
+}
+
+type targetGroupDescriber interface {
+	TargetGroup(targetGroupARN string) (*elbv2.TargetGroup, error)
+}
+
 type roleDeleter interface {
 	DeleteRole(string) error
 }
@@ -517,10 +630,36 @@ type serviceDescriber interface {
 	DescribeService(app, env, svc string) (*ecs.ServiceDesc, error)
 }
 
+type manifestReader interface {
+	Read(location string) ([]byte, error)
+}
+
+type execServiceDescriber interface {
+	serviceDescriber
+	ExecuteCommandEnabled(app, env, svc string) (bool, error)
+}
+
+type containerInsightsChecker interface {
+	ContainerInsightsEnabled(cluster string) (bool, error)
+}
+
+type taskStatsStreamer interface {
+	stream.Streamer
+	Subscribe() <-chan []stream.ECSTaskStats
+}
+
+type serviceDeploymentGetter interface {
+	LatestDeployment(app, env, svc string) (*awsecs.Deployment, error)
+}
+
 type ecsCommandExecutor interface {
 	ExecuteCommand(in awsecs.ExecuteCommandInput) error
 }
 
+type ssmPortForwarder interface {
+	StartPortForwardingSession(in ssm.PortForwardingSessionInput) error
+}
+
 type ssmPluginManager interface {
 	ValidateBinary() error
 	InstallLatestBinary() error
@@ -546,10 +685,36 @@ type roleManager interface {
 	serviceLinkedRoleCreator
 }
 
+type clusterGetter interface {
+	ClusterARN(app, env string) (string, error)
+}
+
+type activationCreator interface {
+	CreateActivation(iamRole string, registrationLimit int) (*ssm.Activation, error)
+}
+
+type secretReadWriter interface {
+	SecretValue(name string) (string, error)
+	PutSecret(in ssm.PutSecretInput) error
+}
+
 type stackExistChecker interface {
 	Exists(string) (bool, error)
 }
 
+type stackOutputsDescriber interface {
+	stackExistChecker
+	Outputs(stack *awscloudformation.Stack) (map[string]string, error)
+}
+
+type listenerRuleCounter interface {
+	ListenerRuleCount(listenerARN string) (int, error)
+}
+
+type rolePermissionsSimulator interface {
+	DeniedPermissions(roleARN string, perms []iam.Permission) ([]iam.Permission, error)
+}
+
 type runningTaskSelector interface {
 	RunningTask(prompt, help string, opts ...selector.TaskOpts) (*awsecs.Task, error)
 }
@@ -558,6 +723,13 @@ type dockerEngineValidator interface {
 	CheckDockerEngineRunning() error
 }
 
+type containerRuntimeChecker interface {
+	dockerEngineValidator
+	Engine() string
+	Host() string
+	CheckCredentialHelper() error
+}
+
 type codestar interface {
 	GetConnectionARN(string) (string, error)
 }
@@ -565,3 +737,44 @@ type codestar interface {
 type publicIPGetter interface {
 	PublicIP(ENI string) (string, error)
 }
+
+type targetStore interface {
+	LastTarget(appName, envName string) (*config.Target, error)
+	SaveTarget(appName, envName string, target config.Target) error
+}
+
+type targetConfirmer interface {
+	Confirm(env *config.Environment, skipConfirmation bool) error
+}
+
+type endpointDescriber interface {
+	URI(envName string) (string, error)
+}
+
+type suiteVerifier interface {
+	Run(ctx context.Context, baseURL string, suite verify.Suite) verify.Report
+}
+
+type maintenanceSwitcher interface {
+	Enable(app, env, svc, body string, until time.Time) error
+	Disable(app, env, svc string) error
+}
+
+type taskDefDescriber interface {
+	TaskDefinition(app, env, svc string) (*awsecs.TaskDefinition, error)
+}
+
+type secretGetter interface {
+	SecretValue(name string) (string, error)
+}
+
+type localContainerRunner interface {
+	Build(args *exec.BuildArguments) error
+	RunContainer(in *exec.RunContainerInput) error
+	FollowContainerLogs(name string, w io.Writer) error
+	StopContainer(name string) error
+}
+
+type fileWatcher interface {
+	Changed() ([]string, error)
+}
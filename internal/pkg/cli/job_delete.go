@@ -66,6 +66,7 @@ type deleteJobOpts struct {
 	sel             configSelector
 	sess            sessionProvider
 	spinner         progress
+	targetGuard     targetConfirmer
 	appCFN          jobRemoverFromApp
 	newWlDeleter    func(sess *session.Session) wlDeleter
 	newImageRemover func(sess *session.Session) imageRemover
@@ -84,15 +85,20 @@ func newDeleteJobOpts(vars deleteJobVars) (*deleteJobOpts, error) {
 		return nil, err
 	}
 	prompter := prompt.New()
+	guard, err := newTargetGuard(defaultSession, prompter)
+	if err != nil {
+		return nil, err
+	}
 	return &deleteJobOpts{
 		deleteJobVars: vars,
 
-		store:   store,
-		spinner: termprogress.NewSpinner(log.DiagnosticWriter),
-		prompt:  prompt.New(),
-		sel:     selector.NewConfigSelect(prompter, store),
-		sess:    provider,
-		appCFN:  cloudformation.New(defaultSession),
+		store:       store,
+		spinner:     termprogress.NewSpinner(log.DiagnosticWriter),
+		prompt:      prompt.New(),
+		sel:         selector.NewConfigSelect(prompter, store),
+		sess:        provider,
+		targetGuard: guard,
+		appCFN:      cloudformation.New(defaultSession),
 		newWlDeleter: func(session *session.Session) wlDeleter {
 			return cloudformation.New(session)
 		},
@@ -164,6 +170,12 @@ func (o *deleteJobOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	// Confirm the target of every environment before deleting the job from any of them.
+	for _, env := range envs {
+		if err := o.targetGuard.Confirm(env, o.skipConfirmation); err != nil {
+			return err
+		}
+	}
 
 	if err := o.deleteJobs(envs); err != nil {
 		return err
@@ -175,10 +187,14 @@ func (o *deleteJobOpts) Execute() error {
 		return nil
 	}
 
-	if err := o.emptyECRRepos(envs); err != nil {
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return err
+	}
+	if err := o.emptyECRRepos(app, envs); err != nil {
 		return err
 	}
-	if err := o.removeJobFromApp(); err != nil {
+	if err := o.removeJobFromApp(app); err != nil {
 		return err
 	}
 	if err := o.deleteSSMParam(); err != nil {
@@ -301,7 +317,7 @@ func (o *deleteJobOpts) needsAppCleanup() bool {
 }
 
 // This is to make mocking easier in unit tests
-func (o *deleteJobOpts) emptyECRRepos(envs []*config.Environment) error {
+func (o *deleteJobOpts) emptyECRRepos(app *config.Application, envs []*config.Environment) error {
 	var uniqueRegions []string
 	for _, env := range envs {
 		if !contains(env.Region, uniqueRegions) {
@@ -309,8 +325,7 @@ func (o *deleteJobOpts) emptyECRRepos(envs []*config.Environment) error {
 		}
 	}
 
-	// TODO: centralized ECR repo name
-	repoName := fmt.Sprintf("%s/%s", o.appName, o.name)
+	repoName := app.RepositoryName(o.name)
 	for _, region := range uniqueRegions {
 		sess, err := o.sess.DefaultWithRegion(region)
 		if err != nil {
@@ -324,14 +339,9 @@ func (o *deleteJobOpts) emptyECRRepos(envs []*config.Environment) error {
 	return nil
 }
 
-func (o *deleteJobOpts) removeJobFromApp() error {
-	proj, err := o.store.GetApplication(o.appName)
-	if err != nil {
-		return err
-	}
-
+func (o *deleteJobOpts) removeJobFromApp(app *config.Application) error {
 	o.spinner.Start(fmt.Sprintf(fmtJobDeleteResourcesStart, o.name, o.appName))
-	if err := o.appCFN.RemoveJobFromApp(proj, o.name); err != nil {
+	if err := o.appCFN.RemoveJobFromApp(app, o.name); err != nil {
 		if !isStackSetNotExistsErr(err) {
 			o.spinner.Stop(log.Serrorf(fmtJobDeleteResourcesFailed, o.name, o.appName))
 			return err
@@ -291,6 +291,7 @@ type deleteJobMocks struct {
 	sessProvider   *sessions.Provider
 	appCFN         *mocks.MockjobRemoverFromApp
 	spinner        *mocks.Mockprogress
+	targetGuard    *mocks.MocktargetConfirmer
 	jobCFN         *mocks.MockwlDeleter
 	ecr            *mocks.MockimageRemover
 	ecs            *mocks.MocktaskStopper
@@ -330,6 +331,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 				gomock.InOrder(
 					// appEnvironments
 					mocks.store.EXPECT().ListEnvironments(gomock.Eq(mockAppName)).Times(1).Return(mockEnvs, nil),
+					mocks.targetGuard.EXPECT().Confirm(mockEnv, false).Return(nil),
 					// deleteStacks
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtJobStackDeleteStart, mockJobName, mockEnvName)),
 					mocks.jobCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
@@ -338,11 +340,11 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtJobTasksStopStart, mockJobName, mockEnvName)),
 					mocks.ecs.EXPECT().StopWorkloadTasks(mockAppName, mockEnvName, mockJobName).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtJobTasksStopComplete, mockJobName, mockEnvName)),
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil),
 					// emptyECRRepos
 					mocks.ecr.EXPECT().ClearRepository(mockRepo).Return(nil),
 
 					// removeJobFromApp
-					mocks.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil),
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtJobDeleteResourcesStart, mockJobName, mockAppName)),
 					mocks.appCFN.EXPECT().RemoveJobFromApp(mockApp, mockJobName).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtJobDeleteResourcesComplete, mockJobName, mockAppName)),
@@ -364,6 +366,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 				gomock.InOrder(
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
+					mocks.targetGuard.EXPECT().Confirm(mockEnv, false).Return(nil),
 					// deleteStacks
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtJobStackDeleteStart, mockJobName, mockEnvName)),
 					mocks.jobCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
@@ -393,6 +396,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 				gomock.InOrder(
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
+					mocks.targetGuard.EXPECT().Confirm(mockEnv, false).Return(nil),
 					// deleteStacks
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtJobStackDeleteStart, mockJobName, mockEnvName)),
 					mocks.jobCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(testError),
@@ -409,6 +413,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 				gomock.InOrder(
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
+					mocks.targetGuard.EXPECT().Confirm(mockEnv, false).Return(nil),
 					// deleteStacks
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtJobStackDeleteStart, mockJobName, mockEnvName)),
 					mocks.jobCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
@@ -435,6 +440,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 			mockAppCFN := mocks.NewMockjobRemoverFromApp(ctrl)
 			mockJobCFN := mocks.NewMockwlDeleter(ctrl)
 			mockSpinner := mocks.NewMockprogress(ctrl)
+			mockTargetGuard := mocks.NewMocktargetConfirmer(ctrl)
 			mockImageRemover := mocks.NewMockimageRemover(ctrl)
 			mockTaskStopper := mocks.NewMocktaskStopper(ctrl)
 			mockGetJobCFN := func(_ *session.Session) wlDeleter {
@@ -453,6 +459,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 				sessProvider:   mockSession,
 				appCFN:         mockAppCFN,
 				spinner:        mockSpinner,
+				targetGuard:    mockTargetGuard,
 				jobCFN:         mockJobCFN,
 				ecr:            mockImageRemover,
 				ecs:            mockTaskStopper,
@@ -469,6 +476,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 				store:           mockstore,
 				sess:            mockSession,
 				spinner:         mockSpinner,
+				targetGuard:     mockTargetGuard,
 				appCFN:          mockAppCFN,
 				newWlDeleter:    mockGetJobCFN,
 				newImageRemover: mockGetImageRemover,
@@ -6,7 +6,9 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -18,12 +20,14 @@ import (
 
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
@@ -31,6 +35,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
@@ -39,25 +44,33 @@ type deployJobOpts struct {
 
 	store              store
 	ws                 wsJobDirReader
+	fs                 afero.Fs
 	unmarshal          func(in []byte) (interface{}, error)
 	cmd                runner
 	addons             templater
 	appCFN             appResourcesGetter
+	imageAccess        envImageAccessGranter
 	jobCFN             cloudformation.CloudFormation
 	imageBuilderPusher imageBuilderPusher
 	sessProvider       sessionProvider
 	s3                 artifactUploader
 	envUpgradeCmd      actionCommand
+	quotaDescriber     quotaDescriber
+	targetGuard        targetConfirmer
+	roleSimulator      rolePermissionsSimulator
 
 	spinner progress
 	sel     wsSelector
 	prompt  prompter
+	w       io.Writer
 
 	targetApp         *config.Application
 	targetEnvironment *config.Environment
 	targetJob         *config.Workload
 	imageDigest       string
 	buildRequired     bool
+	pullThroughImage  *stack.ECRImage // Image of the manifest in the application's ECR pull-through cache.
+	pullThroughCache  string          // Repository prefix of the pull-through cache of pullThroughImage.
 }
 
 func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
@@ -70,7 +83,12 @@ func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new workspace: %w", err)
 	}
+	defaultSess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, err
+	}
 	prompter := prompt.New()
+	guard, err := newTargetGuard(defaultSess, prompter)
 	if err != nil {
 		return nil, err
 	}
@@ -78,11 +96,14 @@ func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
 		deployWkldVars: vars,
 
 		store:        store,
+		targetGuard:  guard,
 		ws:           ws,
+		fs:           afero.NewOsFs(),
 		unmarshal:    manifest.UnmarshalWorkload,
 		spinner:      termprogress.NewSpinner(log.DiagnosticWriter),
 		sel:          selector.NewWorkspaceSelect(prompter, store, ws),
 		prompt:       prompter,
+		w:            log.OutputWriter,
 		cmd:          command.New(),
 		sessProvider: sessions.NewProvider(),
 	}, nil
@@ -93,6 +114,9 @@ func (o *deployJobOpts) Validate() error {
 	if o.appName == "" {
 		return errNoAppInWorkspace
 	}
+	if o.forceDeploy {
+		return fmt.Errorf("--%s is only supported for services", forceFlag)
+	}
 	if o.name != "" {
 		if err := o.validateJobName(); err != nil {
 			return err
@@ -125,6 +149,11 @@ func (o *deployJobOpts) Execute() error {
 		return err
 	}
 	o.targetEnvironment = env
+	if !o.targetConfirmed {
+		if err := o.targetGuard.Confirm(env, o.skipConfirmation); err != nil {
+			return err
+		}
+	}
 
 	app, err := o.store.GetApplication(o.appName)
 	if err != nil {
@@ -146,6 +175,10 @@ func (o *deployJobOpts) Execute() error {
 		return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.targetEnvironment.Name, err)
 	}
 
+	if err := grantEnvImageAccess(o.imageAccess, o.targetApp, o.targetEnvironment); err != nil {
+		return err
+	}
+
 	if err := o.configureContainerImage(); err != nil {
 		return err
 	}
@@ -155,7 +188,21 @@ func (o *deployJobOpts) Execute() error {
 		return err
 	}
 
-	return o.deployJob(addonsURL)
+	if err := o.deployJob(addonsURL); err != nil {
+		return err
+	}
+	warnNearQuotas(o.quotaDescriber)
+	if !o.shouldOutputJSON {
+		return nil
+	}
+	return writeJSON(o.w, deployWkldOutput{
+		Application: o.appName,
+		Environment: o.envName,
+		Name:        o.name,
+		Type:        o.targetJob.Type,
+		Stack:       stack.NameForService(o.appName, o.envName, o.name),
+		ImageDigest: o.imageDigest,
+	})
 }
 
 // pushAddonsTemplateToS3Bucket generates the addons template for the job and pushes it to S3.
@@ -196,7 +243,7 @@ func (o *deployJobOpts) configureClients() error {
 	}
 
 	// ECR client against tools account profile AND target environment region
-	repoName := fmt.Sprintf("%s/%s", o.appName, o.name)
+	repoName := o.targetApp.RepositoryName(o.name)
 	registry := ecr.New(defaultSessEnvRegion)
 	o.imageBuilderPusher, err = repository.New(repoName, registry)
 	if err != nil {
@@ -207,6 +254,11 @@ func (o *deployJobOpts) configureClients() error {
 
 	// CF client against env account profile AND target environment region
 	o.jobCFN = cloudformation.New(envSession)
+	o.quotaDescriber, err = describe.NewEnvQuotaDescriber(o.appName, o.targetEnvironment)
+	if err != nil {
+		return fmt.Errorf("new quota describer for environment %s: %w", o.targetEnvironment.Name, err)
+	}
+	o.roleSimulator = iam.New(envSession)
 
 	addonsSvc, err := addon.New(o.name)
 	if err != nil {
@@ -219,11 +271,15 @@ func (o *deployJobOpts) configureClients() error {
 	if err != nil {
 		return fmt.Errorf("create default session: %w", err)
 	}
-	o.appCFN = cloudformation.New(defaultSess)
+	appCFN := cloudformation.New(defaultSess)
+	o.appCFN = appCFN
+	o.imageAccess = appCFN
 
 	cmd, err := newEnvUpgradeOpts(envUpgradeVars{
-		appName: o.appName,
-		name:    o.targetEnvironment.Name,
+		appName:         o.appName,
+		name:            o.targetEnvironment.Name,
+		strictVersion:   o.strictVersion,
+		targetConfirmed: true, // The target was confirmed for the deployment.
 	})
 	if err != nil {
 		return fmt.Errorf("new env upgrade command: %v", err)
@@ -242,6 +298,7 @@ func (o *deployJobOpts) configureContainerImage() error {
 		return err
 	}
 	if !required {
+		o.pullThroughImage, o.pullThroughCache = pullThroughImage(job, o.targetApp, o.targetEnvironment)
 		return nil
 	}
 	// If it is built from local Dockerfile, build and push to the ECR repo.
@@ -249,10 +306,22 @@ func (o *deployJobOpts) configureContainerImage() error {
 	if err != nil {
 		return err
 	}
-	digest, err := o.imageBuilderPusher.BuildAndPush(exec.NewDockerCommand(), buildArg)
+	engine, err := exec.NewContainerEngineCommand()
+	if err != nil {
+		return fmt.Errorf("detect container engine: %w", err)
+	}
+	digest, err := o.imageBuilderPusher.BuildAndPush(engine, buildArg)
 	if err != nil {
 		return fmt.Errorf("build and push image: %w", err)
 	}
+	if lazyLoadImage(job) {
+		if err := o.imageBuilderPusher.PushLazyLoadIndex(exec.NewSOCICommand(), digest); err != nil {
+			return fmt.Errorf("push lazy loading index: %w", err)
+		}
+	}
+	if err := updateImageLifecyclePolicy(o.imageBuilderPusher, job); err != nil {
+		return err
+	}
 	o.imageDigest = digest
 	o.buildRequired = true
 	return nil
@@ -263,7 +332,14 @@ func (o *deployJobOpts) dfBuildArgs(job interface{}) (*exec.BuildArguments, erro
 	if err != nil {
 		return nil, fmt.Errorf("get copilot directory: %w", err)
 	}
-	return buildArgs(o.name, o.imageTag, copilotDir, job)
+	args, err := buildArgs(o.name, o.imageTag, copilotDir, job)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateBuildArgs(o.fs, filepath.Dir(copilotDir), args); err != nil {
+		return nil, err
+	}
+	return args, nil
 }
 
 func (o *deployJobOpts) deployJob(addonsURL string) error {
@@ -287,6 +363,12 @@ func (o *deployJobOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	if err != nil {
 		return nil, err
 	}
+	if rc.ExecutionRoleARN != "" {
+		perms := executionRolePermissions(mft, o.targetEnvironment, o.name, rc.Image)
+		if err := validateExecutionRole(o.roleSimulator, rc.ExecutionRoleARN, perms); err != nil {
+			return nil, err
+		}
+	}
 	var conf cloudformation.StackConfiguration
 	switch t := mft.(type) {
 	case *manifest.ScheduledJob:
@@ -301,10 +383,18 @@ func (o *deployJobOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 }
 
 func (o *deployJobOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, error) {
+	executionRole, err := o.targetEnvironment.TaskExecutionRole(o.targetApp)
+	if err != nil {
+		return nil, err
+	}
 	if !o.buildRequired {
 		return &stack.RuntimeConfig{
+			Image:             o.pullThroughImage,
+			PullThroughCache:  o.pullThroughCache,
 			AddonsTemplateURL: addonsURL,
 			AdditionalTags:    tags.Merge(o.targetApp.Tags, o.resourceTags),
+			LogGroupPrefix:    o.targetEnvironment.LogGroupPrefix(),
+			ExecutionRoleARN:  executionRole,
 		}, nil
 	}
 	resources, err := o.appCFN.GetAppResourcesByRegion(o.targetApp, o.targetEnvironment.Region)
@@ -327,6 +417,8 @@ func (o *deployJobOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 		},
 		AddonsTemplateURL: addonsURL,
 		AdditionalTags:    tags.Merge(o.targetApp.Tags, o.resourceTags),
+		LogGroupPrefix:    o.targetEnvironment.LogGroupPrefix(),
+		ExecutionRoleARN:  executionRole,
 	}, nil
 }
 
@@ -410,6 +502,8 @@ func buildJobDeployCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			opts.shouldOutputJSON = shouldOutputJSON(cmd)
+			opts.strictVersion = shouldEnforceTemplateVersion(cmd)
 			if err := opts.Validate(); err != nil {
 				return err
 			}
@@ -427,6 +521,7 @@ func buildJobDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
 
 	return cmd
 }
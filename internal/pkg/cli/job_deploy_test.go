@@ -9,11 +9,13 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
 
@@ -27,6 +29,7 @@ func TestJobDeployOpts_Validate(t *testing.T) {
 		inAppName string
 		inEnvName string
 		inJobName string
+		inForce   bool
 
 		mockWs    func(m *mocks.MockwsJobDirReader)
 		mockStore func(m *mocks.Mockstore)
@@ -39,6 +42,15 @@ func TestJobDeployOpts_Validate(t *testing.T) {
 
 			wantedError: errNoAppInWorkspace,
 		},
+		"cannot force deploy a job": {
+			inAppName: "phonetool",
+			inJobName: "resizer",
+			inForce:   true,
+			mockWs:    func(m *mocks.MockwsJobDirReader) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--force is only supported for services"),
+		},
 		"with workspace error": {
 			inAppName: "phonetool",
 			inJobName: "resizer",
@@ -99,6 +111,8 @@ func TestJobDeployOpts_Validate(t *testing.T) {
 					appName: tc.inAppName,
 					name:    tc.inJobName,
 					envName: tc.inEnvName,
+
+					forceDeploy: tc.inForce,
 				},
 				ws:    mockWs,
 				store: mockStore,
@@ -217,6 +231,19 @@ type: 'Scheduled Job'
 image:
   build:
     dockerfile: path/to/Dockerfile`)
+	mockMftLazyLoad := []byte(`name: mailer
+type: 'Scheduled Job'
+image:
+  build: path/to/Dockerfile
+  lazy_load: true`)
+	mockMftLifecycle := []byte(`name: mailer
+type: 'Scheduled Job'
+image:
+  build: path/to/Dockerfile
+  repository:
+    lifecycle:
+      keep_last: 5
+      expire_untagged_after_days: 7`)
 
 	tests := map[string]struct {
 		inputSvc   string
@@ -275,10 +302,36 @@ image:
 						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
 						Context:    filepath.Join("/ws", "root", "path"),
 					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+					m.mockimageBuilderPusher.EXPECT().DeleteLifecyclePolicy().Return(nil),
 				)
 			},
 			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
 		},
+		"success with lazy loading": {
+			inputSvc: "mailer",
+			setupMocks: func(m deployJobMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadJobManifest("mailer").Return(mockMftLazyLoad, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return("sha256:1234", nil),
+					m.mockimageBuilderPusher.EXPECT().PushLazyLoadIndex(gomock.Any(), "sha256:1234").Return(nil),
+					m.mockimageBuilderPusher.EXPECT().DeleteLifecyclePolicy().Return(nil),
+				)
+			},
+			wantedDigest: "sha256:1234",
+		},
+		"success with image lifecycle policy": {
+			inputSvc: "mailer",
+			setupMocks: func(m deployJobMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadJobManifest("mailer").Return(mockMftLifecycle, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return("sha256:1234", nil),
+					m.mockimageBuilderPusher.EXPECT().SetLifecyclePolicy(ecr.LifecyclePolicy{KeepLast: 5, ExpireUntaggedAfterDays: 7}).Return(nil),
+				)
+			},
+			wantedDigest: "sha256:1234",
+		},
 		"using simple buildstring (backwards compatible)": {
 			inputSvc: "mailer",
 			setupMocks: func(m deployJobMocks) {
@@ -289,6 +342,7 @@ image:
 						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
 						Context:    filepath.Join("/ws", "root", "path", "to"),
 					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+					m.mockimageBuilderPusher.EXPECT().DeleteLifecyclePolicy().Return(nil),
 				)
 			},
 			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
@@ -303,6 +357,7 @@ image:
 						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
 						Context:    filepath.Join("/ws", "root", "path", "to"),
 					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+					m.mockimageBuilderPusher.EXPECT().DeleteLifecyclePolicy().Return(nil),
 				)
 			},
 			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
@@ -321,6 +376,8 @@ image:
 				mockimageBuilderPusher: mockimageBuilderPusher,
 			}
 			test.setupMocks(mocks)
+			fs := afero.NewMemMapFs()
+			_ = afero.WriteFile(fs, filepath.Join("/ws", "root", "path", "to", "Dockerfile"), []byte("FROM nginx"), 0644)
 			opts := deployJobOpts{
 				deployWkldVars: deployWkldVars{
 					name: test.inputSvc,
@@ -328,6 +385,9 @@ image:
 				unmarshal:          manifest.UnmarshalWorkload,
 				imageBuilderPusher: mockimageBuilderPusher,
 				ws:                 mockWorkspace,
+				fs:                 fs,
+				targetApp:          &config.Application{Name: "phonetool"},
+				targetEnvironment:  &config.Environment{Name: "test"},
 			}
 
 			gotErr := opts.configureContainerImage()
@@ -82,6 +82,10 @@ func newInitJobOpts(vars initJobVars) (*initJobOpts, error) {
 		return nil, err
 	}
 
+	engine, err := exec.NewContainerEngineCommand()
+	if err != nil {
+		return nil, fmt.Errorf("detect container engine: %w", err)
+	}
 	jobInitter := &initialize.WorkloadInitializer{
 		Store:    store,
 		Ws:       ws,
@@ -100,7 +104,7 @@ func newInitJobOpts(vars initJobVars) (*initJobOpts, error) {
 		init:                  jobInitter,
 		prompt:                prompter,
 		sel:                   sel,
-		dockerEngineValidator: exec.NewDockerCommand(),
+		dockerEngineValidator: engine,
 	}, nil
 }
 
@@ -247,7 +251,7 @@ func (o *initJobOpts) askDockerfile() (isDfSelected bool, err error) {
 		var errDaemon *exec.ErrDockerDaemonNotResponsive
 		switch {
 		case errors.Is(err, exec.ErrDockerCommandNotFound):
-			log.Info("Docker command is not found; Copilot won't build from a Dockerfile.\n")
+			log.Infof("%v; Copilot won't build from a Dockerfile.\n", err)
 			return false, nil
 		case errors.As(err, &errDaemon):
 			log.Info("Docker daemon is not responsive; Copilot won't build from a Dockerfile.\n")
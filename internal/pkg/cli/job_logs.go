@@ -0,0 +1,226 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	jobLogsAppNamePrompt = "Which application does your job belong to?"
+	jobLogsJobNamePrompt = "Which job's logs would you like to show?"
+	jobLogsEnvNamePrompt = "Which environment is your job deployed to?"
+	jobLogsHelpPrompt    = "The logs of a deployed job will be shown."
+)
+
+type jobLogsVars struct {
+	shouldOutputJSON    bool
+	follow              bool
+	includeStateMachine bool
+	limit               int
+	jobName             string
+	envName             string
+	appName             string
+	humanStartTime      string
+	humanEndTime        string
+	taskIDs             []string
+	since               time.Duration
+}
+
+type jobLogsOpts struct {
+	jobLogsVars
+
+	// internal states
+	startTime *int64
+	endTime   *int64
+
+	w           io.Writer
+	configStore store
+	sel         configSelector
+	logsSvc     logEventsWriter
+	initLogsSvc func() error // Overriden in tests.
+}
+
+func newJobLogOpts(vars jobLogsVars) (*jobLogsOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment config store: %w", err)
+	}
+	opts := &jobLogsOpts{
+		jobLogsVars: vars,
+		w:           log.OutputWriter,
+		configStore: configStore,
+		sel:         selector.NewConfigSelect(prompt.New(), configStore),
+	}
+	opts.initLogsSvc = func() error {
+		env, err := opts.configStore.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment: %w", err)
+		}
+		sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return err
+		}
+		opts.logsSvc = logging.NewJobClient(sess, env, opts.jobName)
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by flags are invalid.
+func (o *jobLogsOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.configStore.GetApplication(o.appName); err != nil {
+			return err
+		}
+		if o.jobName != "" {
+			if _, err := o.configStore.GetJob(o.appName, o.jobName); err != nil {
+				return err
+			}
+		}
+		if o.envName != "" {
+			if _, err := o.configStore.GetEnvironment(o.appName, o.envName); err != nil {
+				return err
+			}
+		}
+	}
+	if o.since != 0 && o.humanStartTime != "" {
+		return errors.New("only one of --since or --start-time may be used")
+	}
+	if o.humanEndTime != "" && o.follow {
+		return errors.New("only one of --follow or --end-time may be used")
+	}
+	if o.since != 0 {
+		if o.since < 0 {
+			return fmt.Errorf("--since must be greater than 0")
+		}
+		o.startTime = parseSince(o.since)
+	}
+	if o.humanStartTime != "" {
+		startTime, err := parseRFC3339(o.humanStartTime)
+		if err != nil {
+			return fmt.Errorf(`invalid argument %s for "--start-time" flag: %w`, o.humanStartTime, err)
+		}
+		o.startTime = aws.Int64(startTime)
+	}
+	if o.humanEndTime != "" {
+		endTime, err := parseRFC3339(o.humanEndTime)
+		if err != nil {
+			return fmt.Errorf(`invalid argument %s for "--end-time" flag: %w`, o.humanEndTime, err)
+		}
+		o.endTime = aws.Int64(endTime)
+	}
+	if o.limit != 0 && (o.limit < cwGetLogEventsLimitMin || o.limit > cwGetLogEventsLimitMax) {
+		return fmt.Errorf("--limit %d is out-of-bounds, value must be between %d and %d", o.limit, cwGetLogEventsLimitMin, cwGetLogEventsLimitMax)
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *jobLogsOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(jobLogsAppNamePrompt, jobLogsHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.jobName == "" {
+		job, err := o.sel.Job(jobLogsJobNamePrompt, jobLogsHelpPrompt, o.appName)
+		if err != nil {
+			return fmt.Errorf("select job for application %s: %w", o.appName, err)
+		}
+		o.jobName = job
+	}
+	if o.envName == "" {
+		env, err := o.sel.Environment(jobLogsEnvNamePrompt, jobLogsHelpPrompt, o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment for application %s: %w", o.appName, err)
+		}
+		o.envName = env
+	}
+	return nil
+}
+
+// Execute outputs logs of the job, interleaved with the execution events of its state machine if requested.
+func (o *jobLogsOpts) Execute() error {
+	if err := o.initLogsSvc(); err != nil {
+		return err
+	}
+	eventsWriter := logging.WriteHumanLogs
+	if o.shouldOutputJSON {
+		eventsWriter = logging.WriteJSONLogs
+	}
+	var limit *int64
+	if o.limit != 0 {
+		limit = aws.Int64(int64(o.limit))
+	}
+	err := o.logsSvc.WriteLogEvents(logging.WriteLogEventsOpts{
+		Follow:              o.follow,
+		Limit:               limit,
+		EndTime:             o.endTime,
+		StartTime:           o.startTime,
+		TaskIDs:             o.taskIDs,
+		IncludeStateMachine: o.includeStateMachine,
+		OnEvents:            eventsWriter,
+	})
+	if err != nil {
+		return fmt.Errorf("write log events for job %s: %w", o.jobName, err)
+	}
+	return nil
+}
+
+// buildJobLogsCmd builds the command for displaying job logs in an application.
+func buildJobLogsCmd() *cobra.Command {
+	vars := jobLogsVars{}
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Displays logs of a deployed job.",
+
+		Example: `
+  Displays logs of the job "my-job" in environment "test".
+  /code $ copilot job logs -n my-job -e test
+  Displays logs in the last hour.
+  /code $ copilot job logs --since 1h
+  Displays logs in real time, with the invocations, retries and failures of the job.
+  /code $ copilot job logs --follow --include-state-machine`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newJobLogOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.jobName, nameFlag, nameFlagShort, "", jobFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.humanStartTime, startTimeFlag, "", startTimeFlagDescription)
+	cmd.Flags().StringVar(&vars.humanEndTime, endTimeFlag, "", endTimeFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
+	cmd.Flags().DurationVar(&vars.since, sinceFlag, 0, sinceFlagDescription)
+	cmd.Flags().IntVar(&vars.limit, limitFlag, 0, limitFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.taskIDs, tasksFlag, nil, tasksLogsFlagDescription)
+	cmd.Flags().BoolVar(&vars.includeStateMachine, includeStateMachineFlag, false, includeStateMachineFlagDescription)
+	return cmd
+}
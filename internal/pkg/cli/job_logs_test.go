@@ -0,0 +1,241 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobLogs_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp       string
+		inputJob       string
+		inputEnv       string
+		inputSince     time.Duration
+		inputStartTime string
+		inputEndTime   string
+		inputFollow    bool
+		inputLimit     int
+		setupMocks     func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"valid app, job and environment": {
+			inputApp: "my-app",
+			inputJob: "my-job",
+			inputEnv: "test",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetJob("my-app", "my-job").Return(&config.Workload{Name: "my-job"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+		},
+		"invalid job name": {
+			inputApp: "my-app",
+			inputJob: "my-job",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetJob("my-app", "my-job").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns error if since and startTime flags are set together": {
+			inputSince:     time.Minute,
+			inputStartTime: "1970-01-01T01:01:01+00:00",
+			setupMocks:     func(m *mocks.Mockstore) {},
+			wantedError:    errors.New("only one of --since or --start-time may be used"),
+		},
+		"returns error if follow and endTime flags are set together": {
+			inputFollow:  true,
+			inputEndTime: "1970-01-01T01:01:01+00:00",
+			setupMocks:   func(m *mocks.Mockstore) {},
+			wantedError:  errors.New("only one of --follow or --end-time may be used"),
+		},
+		"returns error if invalid start time flag value": {
+			inputStartTime: "2020",
+			setupMocks:     func(m *mocks.Mockstore) {},
+			wantedError:    fmt.Errorf(`invalid argument 2020 for "--start-time" flag: reading time value 2020: parsing time "2020" as "2006-01-02T15:04:05Z07:00": cannot parse "" as "-"`),
+		},
+		"returns error if limit is out of bounds": {
+			inputLimit:  10001,
+			setupMocks:  func(m *mocks.Mockstore) {},
+			wantedError: errors.New("--limit 10001 is out-of-bounds, value must be between 1 and 10000"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+			opts := &jobLogsOpts{
+				jobLogsVars: jobLogsVars{
+					appName:        tc.inputApp,
+					jobName:        tc.inputJob,
+					envName:        tc.inputEnv,
+					since:          tc.inputSince,
+					humanStartTime: tc.inputStartTime,
+					humanEndTime:   tc.inputEndTime,
+					follow:         tc.inputFollow,
+					limit:          tc.inputLimit,
+				},
+				configStore: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJobLogs_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp   string
+		inputJob   string
+		inputEnv   string
+		setupMocks func(m *mocks.MockconfigSelector)
+
+		wantedApp   string
+		wantedJob   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"skips prompting if the flags are set": {
+			inputApp:   "my-app",
+			inputJob:   "my-job",
+			inputEnv:   "test",
+			setupMocks: func(m *mocks.MockconfigSelector) {},
+			wantedApp:  "my-app",
+			wantedJob:  "my-job",
+			wantedEnv:  "test",
+		},
+		"prompts for the application, the job and the environment": {
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				gomock.InOrder(
+					m.EXPECT().Application(jobLogsAppNamePrompt, jobLogsHelpPrompt).Return("my-app", nil),
+					m.EXPECT().Job(jobLogsJobNamePrompt, jobLogsHelpPrompt, "my-app").Return("my-job", nil),
+					m.EXPECT().Environment(jobLogsEnvNamePrompt, jobLogsHelpPrompt, "my-app").Return("test", nil),
+				)
+			},
+			wantedApp: "my-app",
+			wantedJob: "my-job",
+			wantedEnv: "test",
+		},
+		"wraps the error of selecting the job": {
+			inputApp: "my-app",
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Job(jobLogsJobNamePrompt, jobLogsHelpPrompt, "my-app").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select job for application my-app: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSel := mocks.NewMockconfigSelector(ctrl)
+			tc.setupMocks(mockSel)
+			opts := &jobLogsOpts{
+				jobLogsVars: jobLogsVars{
+					appName: tc.inputApp,
+					jobName: tc.inputJob,
+					envName: tc.inputEnv,
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedJob, opts.jobName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+		})
+	}
+}
+
+func TestJobLogs_Execute(t *testing.T) {
+	mockStartTime := int64(123456789)
+	testCases := map[string]struct {
+		includeStateMachine bool
+		mockLogsSvc         func(m *mocks.MocklogEventsWriter)
+
+		wantedError error
+	}{
+		"wraps the error of writing log events": {
+			mockLogsSvc: func(m *mocks.MocklogEventsWriter) {
+				m.EXPECT().WriteLogEvents(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("write log events for job my-job: some error"),
+		},
+		"includes the events of the state machine": {
+			includeStateMachine: true,
+			mockLogsSvc: func(m *mocks.MocklogEventsWriter) {
+				m.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+					require.Equal(t, &mockStartTime, param.StartTime)
+					require.Equal(t, []string{"mockTaskID"}, param.TaskIDs)
+					require.True(t, param.IncludeStateMachine)
+				}).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockLogsSvc := mocks.NewMocklogEventsWriter(ctrl)
+			tc.mockLogsSvc(mockLogsSvc)
+			opts := &jobLogsOpts{
+				jobLogsVars: jobLogsVars{
+					appName:             "my-app",
+					jobName:             "my-job",
+					envName:             "test",
+					taskIDs:             []string{"mockTaskID"},
+					includeStateMachine: tc.includeStateMachine,
+				},
+				startTime:   &mockStartTime,
+				logsSvc:     mockLogsSvc,
+				initLogsSvc: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -32,6 +32,7 @@ type packageJobVars struct {
 	appName   string
 	tag       string
 	outputDir string
+	showDiff  bool
 }
 
 type packageJobOpts struct {
@@ -92,6 +93,7 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 				appName:   o.appName,
 				tag:       imageTagFromGit(o.runner, o.tag),
 				outputDir: o.outputDir,
+				showDiff:  o.showDiff,
 			},
 			runner:           o.runner,
 			initAddonsClient: initPackageAddonsClient,
@@ -103,6 +105,8 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 			addonsWriter:     ioutil.Discard,
 			fs:               &afero.Afero{Fs: afero.NewOsFs()},
 			stackSerializer:  o.stackSerializer,
+
+			newStackDescriber: newDeployedStackDescriber,
 		}
 	}
 	return opts, nil
@@ -113,6 +117,9 @@ func (o *packageJobOpts) Validate() error {
 	if o.appName == "" {
 		return errNoAppInWorkspace
 	}
+	if o.showDiff && o.outputDir != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s", diffFlag, stackOutputDirFlag)
+	}
 	if o.name != "" {
 		names, err := o.ws.JobNames()
 		if err != nil {
@@ -187,7 +194,10 @@ func buildJobPackageCmd() *cobra.Command {
   Write the CloudFormation stack and configuration to a "infrastructure/" sub-directory instead of printing.
   /code $ copilot job package -n report-generator -e test --output-dir ./infrastructure
   /code $ ls ./infrastructure
-  /code report-generator-test.stack.yml      report-generator-test.params.yml`,
+  /code report-generator-test.stack.yml      report-generator-test.params.yml
+
+  Compare the template and parameters with the ones deployed in the "test" environment.
+  /code $ copilot job package -n report-generator -e test --diff`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newPackageJobOpts(vars)
 			if err != nil {
@@ -208,5 +218,6 @@ func buildJobPackageCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVar(&vars.tag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, "", stackOutputDirFlagDescription)
+	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
 	return cmd
 }
@@ -0,0 +1,71 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// The structures below are written to stdout, one object per line, by commands run with the global --json flag.
+// Scripts depend on them: fields can be added, but never renamed or removed.
+
+// deployWkldOutput is the result of deploying a service or a job.
+type deployWkldOutput struct {
+	Application string `json:"application"`
+	Environment string `json:"environment"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Stack       string `json:"stack"`
+	ImageDigest string `json:"imageDigest,omitempty"`
+	URI         string `json:"uri,omitempty"` // Only set for services.
+}
+
+// runTaskOutput is the result of running one-off tasks.
+type runTaskOutput struct {
+	Group string              `json:"group"`
+	Tasks []runTaskOutputTask `json:"tasks"`
+}
+
+type runTaskOutputTask struct {
+	ARN       string     `json:"arn"`
+	Cluster   string     `json:"cluster"`
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+	PublicIP  string     `json:"publicIP,omitempty"`
+}
+
+// secretInitOutput is the result of creating or updating secrets.
+type secretInitOutput struct {
+	Application string                   `json:"application"`
+	Secrets     []secretInitOutputSecret `json:"secrets"`
+}
+
+type secretInitOutputSecret struct {
+	Name        string `json:"name"`
+	Environment string `json:"environment"`
+	Parameter   string `json:"parameter"`
+	Action      string `json:"action"` // One of "create", "update" or "unchanged".
+}
+
+// shouldOutputJSON returns true if the global --json flag is set for the command.
+func shouldOutputJSON(cmd *cobra.Command) bool {
+	value, err := cmd.Flags().GetBool(jsonFlag)
+	return err == nil && value
+}
+
+// writeJSON writes v to w as a single line of JSON.
+func writeJSON(w io.Writer, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshal %T to JSON: %w", v, err)
+	}
+	if _, err := fmt.Fprintf(w, "%s\n", data); err != nil {
+		return fmt.Errorf("write JSON output: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/spf13/cobra"
+)
+
+const (
+	manifestSchemaTypePrompt     = "Which type of manifest would you like the JSON Schema of?"
+	manifestSchemaTypeHelpPrompt = `Editors such as VS Code can use the JSON Schema of a manifest
+to autocomplete its fields and flag mistakes as you type.`
+)
+
+type manifestSchemaVars struct {
+	wlType string
+}
+
+type manifestSchemaOpts struct {
+	manifestSchemaVars
+
+	prompt prompter
+	w      io.Writer
+}
+
+func newManifestSchemaOpts(vars manifestSchemaVars) *manifestSchemaOpts {
+	return &manifestSchemaOpts{
+		manifestSchemaVars: vars,
+		prompt:             prompt.New(),
+		w:                  log.OutputWriter,
+	}
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *manifestSchemaOpts) Validate() error {
+	if o.wlType == "" {
+		return nil
+	}
+	if !contains(o.wlType, manifest.WorkloadTypes) {
+		return &manifest.ErrInvalidWorkloadType{Type: o.wlType}
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *manifestSchemaOpts) Ask() error {
+	if o.wlType != "" {
+		return nil
+	}
+	typ, err := o.prompt.SelectOne(manifestSchemaTypePrompt, manifestSchemaTypeHelpPrompt, manifest.WorkloadTypes)
+	if err != nil {
+		return fmt.Errorf("select manifest type: %w", err)
+	}
+	o.wlType = typ
+	return nil
+}
+
+// Execute writes the JSON Schema of the manifest type.
+func (o *manifestSchemaOpts) Execute() error {
+	schema, err := manifest.WorkloadSchema(o.wlType)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal JSON schema of %s manifest: %w", o.wlType, err)
+	}
+	if _, err := fmt.Fprintf(o.w, "%s\n", data); err != nil {
+		return fmt.Errorf("write JSON schema: %w", err)
+	}
+	return nil
+}
+
+// buildManifestSchemaCmd builds the command for printing the JSON Schema of a manifest type.
+func buildManifestSchemaCmd() *cobra.Command {
+	vars := manifestSchemaVars{}
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Prints the JSON Schema of a manifest type.",
+		Long: `Prints the JSON Schema of a manifest type.
+The schema describes every field of the manifest, so that editors can autocomplete and validate it.`,
+		Example: `
+  Write the JSON Schema of Load Balanced Web Service manifests to a file.
+  /code $ copilot manifest schema --type "Load Balanced Web Service" > lb-web-svc.schema.json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts := newManifestSchemaOpts(vars)
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.wlType, typeFlag, typeFlagShort, "", manifestTypeFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,133 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestSchemaOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inType string
+
+		wantedErr error
+	}{
+		"succeeds without a type": {},
+		"succeeds with a workload type": {
+			inType: manifest.ScheduledJobType,
+		},
+		"errors on unknown types": {
+			inType:    "Static Site",
+			wantedErr: errors.New("invalid manifest type: Static Site"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &manifestSchemaOpts{
+				manifestSchemaVars: manifestSchemaVars{
+					wlType: tc.inType,
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestManifestSchemaOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inType     string
+		setupMocks func(m *mocks.Mockprompter)
+
+		wantedType string
+		wantedErr  error
+	}{
+		"does not prompt if the type is set": {
+			inType:     manifest.BackendServiceType,
+			setupMocks: func(m *mocks.Mockprompter) {},
+			wantedType: manifest.BackendServiceType,
+		},
+		"errors if the type can't be selected": {
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(manifestSchemaTypePrompt, manifestSchemaTypeHelpPrompt, manifest.WorkloadTypes).
+					Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select manifest type: some error"),
+		},
+		"prompts for the type": {
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(manifestSchemaTypePrompt, manifestSchemaTypeHelpPrompt, manifest.WorkloadTypes).
+					Return(manifest.ScheduledJobType, nil)
+			},
+			wantedType: manifest.ScheduledJobType,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(m)
+			opts := &manifestSchemaOpts{
+				manifestSchemaVars: manifestSchemaVars{
+					wlType: tc.inType,
+				},
+				prompt: m,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedType, opts.wlType)
+		})
+	}
+}
+
+func TestManifestSchemaOpts_Execute(t *testing.T) {
+	// GIVEN
+	buf := new(bytes.Buffer)
+	opts := &manifestSchemaOpts{
+		manifestSchemaVars: manifestSchemaVars{
+			wlType: manifest.LoadBalancedWebServiceType,
+		},
+		w: buf,
+	}
+
+	// WHEN
+	err := opts.Execute()
+
+	// THEN
+	require.NoError(t, err)
+	var schema map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &schema))
+	require.Equal(t, "http://json-schema.org/draft-07/schema#", schema["$schema"])
+	require.Equal(t, "Load Balanced Web Service manifest", schema["title"])
+	require.Contains(t, schema["properties"], "http")
+}
@@ -0,0 +1,159 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+type manifestValidateVars struct {
+	appName string // If empty, the per-environment overrides are not checked against the environments of the application.
+	name    string // If empty, every workload of the workspace is validated.
+}
+
+type manifestValidateOpts struct {
+	manifestValidateVars
+
+	ws    wsWlManifestReader
+	store store
+	w     io.Writer
+}
+
+func newManifestValidateOpts(vars manifestValidateVars) (*manifestValidateOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	return &manifestValidateOpts{
+		manifestValidateVars: vars,
+		ws:                   ws,
+		store:                store,
+		w:                    log.OutputWriter,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *manifestValidateOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+	}
+	if o.name == "" {
+		return nil
+	}
+	names, err := o.ws.WorkloadNames()
+	if err != nil {
+		return fmt.Errorf("list services and jobs in the workspace: %w", err)
+	}
+	if !contains(o.name, names) {
+		return fmt.Errorf("service or job %s does not exist in the workspace", o.name)
+	}
+	return nil
+}
+
+// Execute checks the manifests against the JSON Schema of their type and writes the fields that don't match.
+// It returns an error if any manifest is invalid.
+func (o *manifestValidateOpts) Execute() error {
+	names := []string{o.name}
+	if o.name == "" {
+		wlNames, err := o.ws.WorkloadNames()
+		if err != nil {
+			return fmt.Errorf("list services and jobs in the workspace: %w", err)
+		}
+		if len(wlNames) == 0 {
+			return fmt.Errorf("no service or job found in the workspace")
+		}
+		names = wlNames
+	}
+	envs, err := o.envNames()
+	if err != nil {
+		return err
+	}
+	var invalid []string
+	for _, name := range names {
+		mft, err := o.ws.ReadWorkloadManifest(name)
+		if err != nil {
+			return fmt.Errorf("read manifest of %s: %w", name, err)
+		}
+		violations, err := manifest.ValidateWorkload(mft, envs)
+		if err != nil {
+			return fmt.Errorf("validate manifest of %s: %w", name, err)
+		}
+		if len(violations) == 0 {
+			fmt.Fprint(o.w, log.Ssuccessf("Manifest of %s is valid.\n", color.HighlightUserInput(name)))
+			continue
+		}
+		invalid = append(invalid, name)
+		fmt.Fprint(o.w, log.Serrorf("Manifest of %s is invalid:\n", color.HighlightUserInput(name)))
+		for _, v := range violations {
+			fmt.Fprintf(o.w, "  %s\n", v)
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid manifest for %s", strings.Join(invalid, ", "))
+	}
+	return nil
+}
+
+// envNames returns the names of the environments of the application, or nil if there is no application.
+func (o *manifestValidateOpts) envNames() ([]string, error) {
+	if o.appName == "" {
+		return nil, nil
+	}
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	names := make([]string, 0, len(envs))
+	for _, env := range envs {
+		names = append(names, env.Name)
+	}
+	return names, nil
+}
+
+// buildManifestValidateCmd builds the command for validating the manifests of the workspace.
+func buildManifestValidateCmd() *cobra.Command {
+	vars := manifestValidateVars{}
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Checks the manifests of services and jobs for mistakes.",
+		Long: `Checks the manifests of services and jobs for mistakes, such as unknown fields,
+values of the wrong type, and overrides of environments that don't exist in the application.`,
+		Example: `
+  Validate the manifest of every service and job in the workspace.
+  /code $ copilot manifest validate
+  Validate the manifest of the "frontend" service.
+  /code $ copilot manifest validate -n frontend`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newManifestValidateOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", manifestValidateNameFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,197 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type manifestValidateMocks struct {
+	ws    *mocks.MockwsWlManifestReader
+	store *mocks.Mockstore
+}
+
+func TestManifestValidateOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		inName     string
+		setupMocks func(m manifestValidateMocks)
+
+		wantedErr error
+	}{
+		"errors if the application does not exist": {
+			inAppName: "phonetool",
+			setupMocks: func(m manifestValidateMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get application phonetool: some error"),
+		},
+		"errors if the workload is not in the workspace": {
+			inAppName: "phonetool",
+			inName:    "api",
+			setupMocks: func(m manifestValidateMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.ws.EXPECT().WorkloadNames().Return([]string{"frontend"}, nil)
+			},
+			wantedErr: errors.New("service or job api does not exist in the workspace"),
+		},
+		"succeeds without an application": {
+			inName: "frontend",
+			setupMocks: func(m manifestValidateMocks) {
+				m.ws.EXPECT().WorkloadNames().Return([]string{"frontend"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := manifestValidateMocks{
+				ws:    mocks.NewMockwsWlManifestReader(ctrl),
+				store: mocks.NewMockstore(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &manifestValidateOpts{
+				manifestValidateVars: manifestValidateVars{
+					appName: tc.inAppName,
+					name:    tc.inName,
+				},
+				ws:    m.ws,
+				store: m.store,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestManifestValidateOpts_Execute(t *testing.T) {
+	const (
+		validMft = `name: frontend
+type: Backend Service
+image:
+  build: ./Dockerfile
+environments:
+  test:
+    count: 2
+`
+		invalidMft = `name: api
+type: Backend Service
+image:
+  build: ./Dockerfile
+  prot: 80
+environments:
+  staging:
+    count: 2
+`
+	)
+	testCases := map[string]struct {
+		inAppName  string
+		inName     string
+		setupMocks func(m manifestValidateMocks)
+
+		wantedOutput []string
+		wantedErr    error
+	}{
+		"errors if there is no workload in the workspace": {
+			setupMocks: func(m manifestValidateMocks) {
+				m.ws.EXPECT().WorkloadNames().Return(nil, nil)
+			},
+			wantedErr: errors.New("no service or job found in the workspace"),
+		},
+		"errors if the environments can't be listed": {
+			inAppName: "phonetool",
+			inName:    "frontend",
+			setupMocks: func(m manifestValidateMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list environments in application phonetool: some error"),
+		},
+		"errors if the manifest can't be read": {
+			inName: "frontend",
+			setupMocks: func(m manifestValidateMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("read manifest of frontend: some error"),
+		},
+		"validates a single manifest": {
+			inAppName: "phonetool",
+			inName:    "frontend",
+			setupMocks: func(m manifestValidateMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(validMft), nil)
+			},
+			wantedOutput: []string{"Manifest of frontend is valid."},
+		},
+		"writes the invalid fields of every manifest in the workspace": {
+			inAppName: "phonetool",
+			setupMocks: func(m manifestValidateMocks) {
+				m.ws.EXPECT().WorkloadNames().Return([]string{"frontend", "api"}, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(validMft), nil)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(invalidMft), nil)
+			},
+			wantedOutput: []string{
+				"Manifest of frontend is valid.",
+				"Manifest of api is invalid:",
+				"  line 5: image.prot: unknown field",
+				"  line 7: environments.staging: environment does not exist in the application, must be one of: test",
+			},
+			wantedErr: errors.New("invalid manifest for api"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := manifestValidateMocks{
+				ws:    mocks.NewMockwsWlManifestReader(ctrl),
+				store: mocks.NewMockstore(ctrl),
+			}
+			tc.setupMocks(m)
+			buf := new(bytes.Buffer)
+			opts := &manifestValidateOpts{
+				manifestValidateVars: manifestValidateVars{
+					appName: tc.inAppName,
+					name:    tc.inName,
+				},
+				ws:    m.ws,
+				store: m.store,
+				w:     buf,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			for _, line := range tc.wantedOutput {
+				require.Contains(t, buf.String(), line)
+			}
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,20 +1,29 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./internal/pkg/cli/interfaces.go
+// Source: ././internal/pkg/cli/interfaces.go
 
 // Package mocks is a generated GoMock package.
 package mocks
 
 import (
+	context "context"
 	encoding "encoding"
 	io "io"
 	reflect "reflect"
+	time "time"
 
 	session "github.com/aws/aws-sdk-go/aws/session"
+	addon "github.com/aws/copilot-cli/internal/pkg/addon"
+	aas "github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	ecr "github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	elbv2 "github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	iam "github.com/aws/copilot-cli/internal/pkg/aws/iam"
 	s3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	ssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
+	cost "github.com/aws/copilot-cli/internal/pkg/cost"
 	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	stack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -24,11 +33,13 @@ import (
 	initialize "github.com/aws/copilot-cli/internal/pkg/initialize"
 	logging "github.com/aws/copilot-cli/internal/pkg/logging"
 	repository "github.com/aws/copilot-cli/internal/pkg/repository"
+	stream "github.com/aws/copilot-cli/internal/pkg/stream"
 	task "github.com/aws/copilot-cli/internal/pkg/task"
 	command "github.com/aws/copilot-cli/internal/pkg/term/command"
 	progress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	prompt "github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	selector "github.com/aws/copilot-cli/internal/pkg/term/selector"
+	verify "github.com/aws/copilot-cli/internal/pkg/verify"
 	workspace "github.com/aws/copilot-cli/internal/pkg/workspace"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -445,6 +456,20 @@ func (mr *MockapplicationStoreMockRecorder) ListApplications() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplications", reflect.TypeOf((*MockapplicationStore)(nil).ListApplications))
 }
 
+// UpdateApplication mocks base method.
+func (m *MockapplicationStore) UpdateApplication(app *config.Application) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplication", app)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateApplication indicates an expected call of UpdateApplication.
+func (mr *MockapplicationStoreMockRecorder) UpdateApplication(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplication", reflect.TypeOf((*MockapplicationStore)(nil).UpdateApplication), app)
+}
+
 // MockapplicationCreator is a mock of applicationCreator interface.
 type MockapplicationCreator struct {
 	ctrl     *gomock.Controller
@@ -482,6 +507,43 @@ func (mr *MockapplicationCreatorMockRecorder) CreateApplication(app interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApplication", reflect.TypeOf((*MockapplicationCreator)(nil).CreateApplication), app)
 }
 
+// MockapplicationUpdater is a mock of applicationUpdater interface.
+type MockapplicationUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockapplicationUpdaterMockRecorder
+}
+
+// MockapplicationUpdaterMockRecorder is the mock recorder for MockapplicationUpdater.
+type MockapplicationUpdaterMockRecorder struct {
+	mock *MockapplicationUpdater
+}
+
+// NewMockapplicationUpdater creates a new mock instance.
+func NewMockapplicationUpdater(ctrl *gomock.Controller) *MockapplicationUpdater {
+	mock := &MockapplicationUpdater{ctrl: ctrl}
+	mock.recorder = &MockapplicationUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockapplicationUpdater) EXPECT() *MockapplicationUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateApplication mocks base method.
+func (m *MockapplicationUpdater) UpdateApplication(app *config.Application) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplication", app)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateApplication indicates an expected call of UpdateApplication.
+func (mr *MockapplicationUpdaterMockRecorder) UpdateApplication(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplication", reflect.TypeOf((*MockapplicationUpdater)(nil).UpdateApplication), app)
+}
+
 // MockapplicationGetter is a mock of applicationGetter interface.
 type MockapplicationGetter struct {
 	ctrl     *gomock.Controller
@@ -1111,6 +1173,20 @@ func (mr *MockstoreMockRecorder) ListWorkloads(appName interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*Mockstore)(nil).ListWorkloads), appName)
 }
 
+// UpdateApplication mocks base method.
+func (m *Mockstore) UpdateApplication(app *config.Application) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplication", app)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateApplication indicates an expected call of UpdateApplication.
+func (mr *MockstoreMockRecorder) UpdateApplication(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplication", reflect.TypeOf((*Mockstore)(nil).UpdateApplication), app)
+}
+
 // MockdeployedEnvironmentLister is a mock of deployedEnvironmentLister interface.
 type MockdeployedEnvironmentLister struct {
 	ctrl     *gomock.Controller
@@ -1344,6 +1420,63 @@ func (mr *MockimageBuilderPusherMockRecorder) BuildAndPush(docker, args interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndPush", reflect.TypeOf((*MockimageBuilderPusher)(nil).BuildAndPush), docker, args)
 }
 
+// DeleteLifecyclePolicy mocks base method.
+func (m *MockimageBuilderPusher) DeleteLifecyclePolicy() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLifecyclePolicy")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLifecyclePolicy indicates an expected call of DeleteLifecyclePolicy.
+func (mr *MockimageBuilderPusherMockRecorder) DeleteLifecyclePolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLifecyclePolicy", reflect.TypeOf((*MockimageBuilderPusher)(nil).DeleteLifecyclePolicy))
+}
+
+// LastPushedDigest mocks base method.
+func (m *MockimageBuilderPusher) LastPushedDigest() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastPushedDigest")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastPushedDigest indicates an expected call of LastPushedDigest.
+func (mr *MockimageBuilderPusherMockRecorder) LastPushedDigest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastPushedDigest", reflect.TypeOf((*MockimageBuilderPusher)(nil).LastPushedDigest))
+}
+
+// PushLazyLoadIndex mocks base method.
+func (m *MockimageBuilderPusher) PushLazyLoadIndex(soci repository.LazyLoadIndexPusher, digest string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PushLazyLoadIndex", soci, digest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PushLazyLoadIndex indicates an expected call of PushLazyLoadIndex.
+func (mr *MockimageBuilderPusherMockRecorder) PushLazyLoadIndex(soci, digest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushLazyLoadIndex", reflect.TypeOf((*MockimageBuilderPusher)(nil).PushLazyLoadIndex), soci, digest)
+}
+
+// SetLifecyclePolicy mocks base method.
+func (m *MockimageBuilderPusher) SetLifecyclePolicy(policy ecr.LifecyclePolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLifecyclePolicy", policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLifecyclePolicy indicates an expected call of SetLifecyclePolicy.
+func (mr *MockimageBuilderPusherMockRecorder) SetLifecyclePolicy(policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLifecyclePolicy", reflect.TypeOf((*MockimageBuilderPusher)(nil).SetLifecyclePolicy), policy)
+}
+
 // MockrepositoryURIGetter is a mock of repositoryURIGetter interface.
 type MockrepositoryURIGetter struct {
 	ctrl     *gomock.Controller
@@ -1419,6 +1552,63 @@ func (mr *MockrepositoryServiceMockRecorder) BuildAndPush(docker, args interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndPush", reflect.TypeOf((*MockrepositoryService)(nil).BuildAndPush), docker, args)
 }
 
+// DeleteLifecyclePolicy mocks base method.
+func (m *MockrepositoryService) DeleteLifecyclePolicy() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLifecyclePolicy")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLifecyclePolicy indicates an expected call of DeleteLifecyclePolicy.
+func (mr *MockrepositoryServiceMockRecorder) DeleteLifecyclePolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLifecyclePolicy", reflect.TypeOf((*MockrepositoryService)(nil).DeleteLifecyclePolicy))
+}
+
+// LastPushedDigest mocks base method.
+func (m *MockrepositoryService) LastPushedDigest() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastPushedDigest")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastPushedDigest indicates an expected call of LastPushedDigest.
+func (mr *MockrepositoryServiceMockRecorder) LastPushedDigest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastPushedDigest", reflect.TypeOf((*MockrepositoryService)(nil).LastPushedDigest))
+}
+
+// PushLazyLoadIndex mocks base method.
+func (m *MockrepositoryService) PushLazyLoadIndex(soci repository.LazyLoadIndexPusher, digest string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PushLazyLoadIndex", soci, digest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PushLazyLoadIndex indicates an expected call of PushLazyLoadIndex.
+func (mr *MockrepositoryServiceMockRecorder) PushLazyLoadIndex(soci, digest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushLazyLoadIndex", reflect.TypeOf((*MockrepositoryService)(nil).PushLazyLoadIndex), soci, digest)
+}
+
+// SetLifecyclePolicy mocks base method.
+func (m *MockrepositoryService) SetLifecyclePolicy(policy ecr.LifecyclePolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLifecyclePolicy", policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLifecyclePolicy indicates an expected call of SetLifecyclePolicy.
+func (mr *MockrepositoryServiceMockRecorder) SetLifecyclePolicy(policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLifecyclePolicy", reflect.TypeOf((*MockrepositoryService)(nil).SetLifecyclePolicy), policy)
+}
+
 // URI mocks base method.
 func (m *MockrepositoryService) URI() string {
 	m.ctrl.T.Helper()
@@ -1470,6 +1660,43 @@ func (mr *MocklogEventsWriterMockRecorder) WriteLogEvents(opts interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteLogEvents", reflect.TypeOf((*MocklogEventsWriter)(nil).WriteLogEvents), opts)
 }
 
+// MocklogQueryWriter is a mock of logQueryWriter interface.
+type MocklogQueryWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogQueryWriterMockRecorder
+}
+
+// MocklogQueryWriterMockRecorder is the mock recorder for MocklogQueryWriter.
+type MocklogQueryWriterMockRecorder struct {
+	mock *MocklogQueryWriter
+}
+
+// NewMocklogQueryWriter creates a new mock instance.
+func NewMocklogQueryWriter(ctrl *gomock.Controller) *MocklogQueryWriter {
+	mock := &MocklogQueryWriter{ctrl: ctrl}
+	mock.recorder = &MocklogQueryWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklogQueryWriter) EXPECT() *MocklogQueryWriterMockRecorder {
+	return m.recorder
+}
+
+// WriteQueryResults mocks base method.
+func (m *MocklogQueryWriter) WriteQueryResults(opts logging.QueryOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteQueryResults", opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteQueryResults indicates an expected call of WriteQueryResults.
+func (mr *MocklogQueryWriterMockRecorder) WriteQueryResults(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteQueryResults", reflect.TypeOf((*MocklogQueryWriter)(nil).WriteQueryResults), opts)
+}
+
 // Mocktemplater is a mock of templater interface.
 type Mocktemplater struct {
 	ctrl     *gomock.Controller
@@ -1508,6 +1735,112 @@ func (mr *MocktemplaterMockRecorder) Template() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*Mocktemplater)(nil).Template))
 }
 
+// MockcostEstimator is a mock of costEstimator interface.
+type MockcostEstimator struct {
+	ctrl     *gomock.Controller
+	recorder *MockcostEstimatorMockRecorder
+}
+
+// MockcostEstimatorMockRecorder is the mock recorder for MockcostEstimator.
+type MockcostEstimatorMockRecorder struct {
+	mock *MockcostEstimator
+}
+
+// NewMockcostEstimator creates a new mock instance.
+func NewMockcostEstimator(ctrl *gomock.Controller) *MockcostEstimator {
+	mock := &MockcostEstimator{ctrl: ctrl}
+	mock.recorder = &MockcostEstimatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcostEstimator) EXPECT() *MockcostEstimatorMockRecorder {
+	return m.recorder
+}
+
+// Estimate mocks base method.
+func (m *MockcostEstimator) Estimate(tpl []byte) (*cost.Estimate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Estimate", tpl)
+	ret0, _ := ret[0].(*cost.Estimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Estimate indicates an expected call of Estimate.
+func (mr *MockcostEstimatorMockRecorder) Estimate(tpl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Estimate", reflect.TypeOf((*MockcostEstimator)(nil).Estimate), tpl)
+}
+
+// FargateTask mocks base method.
+func (m *MockcostEstimator) FargateTask(cpu, memory int, condition string) (cost.LineItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FargateTask", cpu, memory, condition)
+	ret0, _ := ret[0].(cost.LineItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FargateTask indicates an expected call of FargateTask.
+func (mr *MockcostEstimatorMockRecorder) FargateTask(cpu, memory, condition interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FargateTask", reflect.TypeOf((*MockcostEstimator)(nil).FargateTask), cpu, memory, condition)
+}
+
+// MockdeployedStackDescriber is a mock of deployedStackDescriber interface.
+type MockdeployedStackDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedStackDescriberMockRecorder
+}
+
+// MockdeployedStackDescriberMockRecorder is the mock recorder for MockdeployedStackDescriber.
+type MockdeployedStackDescriberMockRecorder struct {
+	mock *MockdeployedStackDescriber
+}
+
+// NewMockdeployedStackDescriber creates a new mock instance.
+func NewMockdeployedStackDescriber(ctrl *gomock.Controller) *MockdeployedStackDescriber {
+	mock := &MockdeployedStackDescriber{ctrl: ctrl}
+	mock.recorder = &MockdeployedStackDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeployedStackDescriber) EXPECT() *MockdeployedStackDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockdeployedStackDescriber) Describe(stackName string) (*cloudformation.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe", stackName)
+	ret0, _ := ret[0].(*cloudformation.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockdeployedStackDescriberMockRecorder) Describe(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockdeployedStackDescriber)(nil).Describe), stackName)
+}
+
+// TemplateBody mocks base method.
+func (m *MockdeployedStackDescriber) TemplateBody(stackName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TemplateBody", stackName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TemplateBody indicates an expected call of TemplateBody.
+func (mr *MockdeployedStackDescriberMockRecorder) TemplateBody(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TemplateBody", reflect.TypeOf((*MockdeployedStackDescriber)(nil).TemplateBody), stackName)
+}
+
 // MockstackSerializer is a mock of stackSerializer interface.
 type MockstackSerializer struct {
 	ctrl     *gomock.Controller
@@ -2178,6 +2511,21 @@ func (m *MockwsPipelineWriter) EXPECT() *MockwsPipelineWriterMockRecorder {
 	return m.recorder
 }
 
+// WriteGitHubActionsWorkflow mocks base method.
+func (m *MockwsPipelineWriter) WriteGitHubActionsWorkflow(marshaler encoding.BinaryMarshaler, fileName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteGitHubActionsWorkflow", marshaler, fileName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteGitHubActionsWorkflow indicates an expected call of WriteGitHubActionsWorkflow.
+func (mr *MockwsPipelineWriterMockRecorder) WriteGitHubActionsWorkflow(marshaler, fileName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteGitHubActionsWorkflow", reflect.TypeOf((*MockwsPipelineWriter)(nil).WriteGitHubActionsWorkflow), marshaler, fileName)
+}
+
 // WritePipelineBuildspec mocks base method.
 func (m *MockwsPipelineWriter) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error) {
 	m.ctrl.T.Helper()
@@ -2662,6 +3010,21 @@ func (mr *MockwsWlDirReaderMockRecorder) ReadServiceManifest(svcName interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsWlDirReader)(nil).ReadServiceManifest), svcName)
 }
 
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsWlDirReader) ReadWorkloadManifest(name string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsWlDirReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsWlDirReader)(nil).ReadWorkloadManifest), name)
+}
+
 // ServiceNames mocks base method.
 func (m *MockwsWlDirReader) ServiceNames() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -2835,6 +3198,36 @@ func (m *MockwsAddonManager) EXPECT() *MockwsAddonManagerMockRecorder {
 	return m.recorder
 }
 
+// OverwriteWorkloadManifest mocks base method.
+func (m *MockwsAddonManager) OverwriteWorkloadManifest(data []byte, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverwriteWorkloadManifest", data, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OverwriteWorkloadManifest indicates an expected call of OverwriteWorkloadManifest.
+func (mr *MockwsAddonManagerMockRecorder) OverwriteWorkloadManifest(data, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverwriteWorkloadManifest", reflect.TypeOf((*MockwsAddonManager)(nil).OverwriteWorkloadManifest), data, name)
+}
+
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsAddonManager) ReadWorkloadManifest(name string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsAddonManagerMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsAddonManager)(nil).ReadWorkloadManifest), name)
+}
+
 // WorkloadNames mocks base method.
 func (m *MockwsAddonManager) WorkloadNames() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -3548,6 +3941,43 @@ func (mr *MockappResourcesGetterMockRecorder) GetRegionalAppResources(app interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockappResourcesGetter)(nil).GetRegionalAppResources), app)
 }
 
+// MockenvImageAccessGranter is a mock of envImageAccessGranter interface.
+type MockenvImageAccessGranter struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvImageAccessGranterMockRecorder
+}
+
+// MockenvImageAccessGranterMockRecorder is the mock recorder for MockenvImageAccessGranter.
+type MockenvImageAccessGranterMockRecorder struct {
+	mock *MockenvImageAccessGranter
+}
+
+// NewMockenvImageAccessGranter creates a new mock instance.
+func NewMockenvImageAccessGranter(ctrl *gomock.Controller) *MockenvImageAccessGranter {
+	mock := &MockenvImageAccessGranter{ctrl: ctrl}
+	mock.recorder = &MockenvImageAccessGranterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvImageAccessGranter) EXPECT() *MockenvImageAccessGranterMockRecorder {
+	return m.recorder
+}
+
+// GrantEnvImageAccess mocks base method.
+func (m *MockenvImageAccessGranter) GrantEnvImageAccess(opts *cloudformation0.AddEnvToAppOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantEnvImageAccess", opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantEnvImageAccess indicates an expected call of GrantEnvImageAccess.
+func (mr *MockenvImageAccessGranterMockRecorder) GrantEnvImageAccess(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantEnvImageAccess", reflect.TypeOf((*MockenvImageAccessGranter)(nil).GrantEnvImageAccess), opts)
+}
+
 // MocktaskDeployer is a mock of taskDeployer interface.
 type MocktaskDeployer struct {
 	ctrl     *gomock.Controller
@@ -4051,39 +4481,129 @@ func (mr *MockdomainHostedZoneGetterMockRecorder) DomainHostedZoneID(domainName
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainHostedZoneID", reflect.TypeOf((*MockdomainHostedZoneGetter)(nil).DomainHostedZoneID), domainName)
 }
 
-// MockdockerfileParser is a mock of dockerfileParser interface.
-type MockdockerfileParser struct {
+// MockhostedZoneNameServersGetter is a mock of hostedZoneNameServersGetter interface.
+type MockhostedZoneNameServersGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockdockerfileParserMockRecorder
+	recorder *MockhostedZoneNameServersGetterMockRecorder
 }
 
-// MockdockerfileParserMockRecorder is the mock recorder for MockdockerfileParser.
-type MockdockerfileParserMockRecorder struct {
-	mock *MockdockerfileParser
+// MockhostedZoneNameServersGetterMockRecorder is the mock recorder for MockhostedZoneNameServersGetter.
+type MockhostedZoneNameServersGetterMockRecorder struct {
+	mock *MockhostedZoneNameServersGetter
 }
 
-// NewMockdockerfileParser creates a new mock instance.
-func NewMockdockerfileParser(ctrl *gomock.Controller) *MockdockerfileParser {
-	mock := &MockdockerfileParser{ctrl: ctrl}
-	mock.recorder = &MockdockerfileParserMockRecorder{mock}
+// NewMockhostedZoneNameServersGetter creates a new mock instance.
+func NewMockhostedZoneNameServersGetter(ctrl *gomock.Controller) *MockhostedZoneNameServersGetter {
+	mock := &MockhostedZoneNameServersGetter{ctrl: ctrl}
+	mock.recorder = &MockhostedZoneNameServersGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockdockerfileParser) EXPECT() *MockdockerfileParserMockRecorder {
+func (m *MockhostedZoneNameServersGetter) EXPECT() *MockhostedZoneNameServersGetterMockRecorder {
 	return m.recorder
 }
 
-// GetExposedPorts mocks base method.
-func (m *MockdockerfileParser) GetExposedPorts() ([]uint16, error) {
+// DomainHostedZoneID mocks base method.
+func (m *MockhostedZoneNameServersGetter) DomainHostedZoneID(domainName string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetExposedPorts")
-	ret0, _ := ret[0].([]uint16)
+	ret := m.ctrl.Call(m, "DomainHostedZoneID", domainName)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetExposedPorts indicates an expected call of GetExposedPorts.
+// DomainHostedZoneID indicates an expected call of DomainHostedZoneID.
+func (mr *MockhostedZoneNameServersGetterMockRecorder) DomainHostedZoneID(domainName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainHostedZoneID", reflect.TypeOf((*MockhostedZoneNameServersGetter)(nil).DomainHostedZoneID), domainName)
+}
+
+// NameServers mocks base method.
+func (m *MockhostedZoneNameServersGetter) NameServers(hostedZoneID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NameServers", hostedZoneID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NameServers indicates an expected call of NameServers.
+func (mr *MockhostedZoneNameServersGetterMockRecorder) NameServers(hostedZoneID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NameServers", reflect.TypeOf((*MockhostedZoneNameServersGetter)(nil).NameServers), hostedZoneID)
+}
+
+// MocknsRecordUpserter is a mock of nsRecordUpserter interface.
+type MocknsRecordUpserter struct {
+	ctrl     *gomock.Controller
+	recorder *MocknsRecordUpserterMockRecorder
+}
+
+// MocknsRecordUpserterMockRecorder is the mock recorder for MocknsRecordUpserter.
+type MocknsRecordUpserterMockRecorder struct {
+	mock *MocknsRecordUpserter
+}
+
+// NewMocknsRecordUpserter creates a new mock instance.
+func NewMocknsRecordUpserter(ctrl *gomock.Controller) *MocknsRecordUpserter {
+	mock := &MocknsRecordUpserter{ctrl: ctrl}
+	mock.recorder = &MocknsRecordUpserterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocknsRecordUpserter) EXPECT() *MocknsRecordUpserterMockRecorder {
+	return m.recorder
+}
+
+// UpsertNSRecord mocks base method.
+func (m *MocknsRecordUpserter) UpsertNSRecord(hostedZoneID, subdomain string, nameServers []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertNSRecord", hostedZoneID, subdomain, nameServers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertNSRecord indicates an expected call of UpsertNSRecord.
+func (mr *MocknsRecordUpserterMockRecorder) UpsertNSRecord(hostedZoneID, subdomain, nameServers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertNSRecord", reflect.TypeOf((*MocknsRecordUpserter)(nil).UpsertNSRecord), hostedZoneID, subdomain, nameServers)
+}
+
+// MockdockerfileParser is a mock of dockerfileParser interface.
+type MockdockerfileParser struct {
+	ctrl     *gomock.Controller
+	recorder *MockdockerfileParserMockRecorder
+}
+
+// MockdockerfileParserMockRecorder is the mock recorder for MockdockerfileParser.
+type MockdockerfileParserMockRecorder struct {
+	mock *MockdockerfileParser
+}
+
+// NewMockdockerfileParser creates a new mock instance.
+func NewMockdockerfileParser(ctrl *gomock.Controller) *MockdockerfileParser {
+	mock := &MockdockerfileParser{ctrl: ctrl}
+	mock.recorder = &MockdockerfileParserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdockerfileParser) EXPECT() *MockdockerfileParserMockRecorder {
+	return m.recorder
+}
+
+// GetExposedPorts mocks base method.
+func (m *MockdockerfileParser) GetExposedPorts() ([]uint16, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExposedPorts")
+	ret0, _ := ret[0].([]uint16)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExposedPorts indicates an expected call of GetExposedPorts.
 func (mr *MockdockerfileParserMockRecorder) GetExposedPorts() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExposedPorts", reflect.TypeOf((*MockdockerfileParser)(nil).GetExposedPorts))
@@ -4142,6 +4662,82 @@ func (mr *MockstatusDescriberMockRecorder) Describe() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstatusDescriber)(nil).Describe))
 }
 
+// MockimagesDescriber is a mock of imagesDescriber interface.
+type MockimagesDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockimagesDescriberMockRecorder
+}
+
+// MockimagesDescriberMockRecorder is the mock recorder for MockimagesDescriber.
+type MockimagesDescriberMockRecorder struct {
+	mock *MockimagesDescriber
+}
+
+// NewMockimagesDescriber creates a new mock instance.
+func NewMockimagesDescriber(ctrl *gomock.Controller) *MockimagesDescriber {
+	mock := &MockimagesDescriber{ctrl: ctrl}
+	mock.recorder = &MockimagesDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimagesDescriber) EXPECT() *MockimagesDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockimagesDescriber) Describe() (*describe.ServiceImagesDesc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.ServiceImagesDesc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockimagesDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockimagesDescriber)(nil).Describe))
+}
+
+// MocklastDeploymentDescriber is a mock of lastDeploymentDescriber interface.
+type MocklastDeploymentDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MocklastDeploymentDescriberMockRecorder
+}
+
+// MocklastDeploymentDescriberMockRecorder is the mock recorder for MocklastDeploymentDescriber.
+type MocklastDeploymentDescriberMockRecorder struct {
+	mock *MocklastDeploymentDescriber
+}
+
+// NewMocklastDeploymentDescriber creates a new mock instance.
+func NewMocklastDeploymentDescriber(ctrl *gomock.Controller) *MocklastDeploymentDescriber {
+	mock := &MocklastDeploymentDescriber{ctrl: ctrl}
+	mock.recorder = &MocklastDeploymentDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklastDeploymentDescriber) EXPECT() *MocklastDeploymentDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MocklastDeploymentDescriber) Describe() (*describe.LastDeploymentDesc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.LastDeploymentDesc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MocklastDeploymentDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MocklastDeploymentDescriber)(nil).Describe))
+}
+
 // MockenvDescriber is a mock of envDescriber interface.
 type MockenvDescriber struct {
 	ctrl     *gomock.Controller
@@ -4180,6 +4776,80 @@ func (mr *MockenvDescriberMockRecorder) Describe() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockenvDescriber)(nil).Describe))
 }
 
+// MockenvUpdateRenderer is a mock of envUpdateRenderer interface.
+type MockenvUpdateRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvUpdateRendererMockRecorder
+}
+
+// MockenvUpdateRendererMockRecorder is the mock recorder for MockenvUpdateRenderer.
+type MockenvUpdateRendererMockRecorder struct {
+	mock *MockenvUpdateRenderer
+}
+
+// NewMockenvUpdateRenderer creates a new mock instance.
+func NewMockenvUpdateRenderer(ctrl *gomock.Controller) *MockenvUpdateRenderer {
+	mock := &MockenvUpdateRenderer{ctrl: ctrl}
+	mock.recorder = &MockenvUpdateRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvUpdateRenderer) EXPECT() *MockenvUpdateRendererMockRecorder {
+	return m.recorder
+}
+
+// RenderEnvironmentUpdate mocks base method.
+func (m *MockenvUpdateRenderer) RenderEnvironmentUpdate(out progress.FileWriter, appName, envName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderEnvironmentUpdate", out, appName, envName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenderEnvironmentUpdate indicates an expected call of RenderEnvironmentUpdate.
+func (mr *MockenvUpdateRendererMockRecorder) RenderEnvironmentUpdate(out, appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderEnvironmentUpdate", reflect.TypeOf((*MockenvUpdateRenderer)(nil).RenderEnvironmentUpdate), out, appName, envName)
+}
+
+// MockworkloadUpdateRenderer is a mock of workloadUpdateRenderer interface.
+type MockworkloadUpdateRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkloadUpdateRendererMockRecorder
+}
+
+// MockworkloadUpdateRendererMockRecorder is the mock recorder for MockworkloadUpdateRenderer.
+type MockworkloadUpdateRendererMockRecorder struct {
+	mock *MockworkloadUpdateRenderer
+}
+
+// NewMockworkloadUpdateRenderer creates a new mock instance.
+func NewMockworkloadUpdateRenderer(ctrl *gomock.Controller) *MockworkloadUpdateRenderer {
+	mock := &MockworkloadUpdateRenderer{ctrl: ctrl}
+	mock.recorder = &MockworkloadUpdateRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkloadUpdateRenderer) EXPECT() *MockworkloadUpdateRendererMockRecorder {
+	return m.recorder
+}
+
+// RenderWorkloadUpdate mocks base method.
+func (m *MockworkloadUpdateRenderer) RenderWorkloadUpdate(out progress.FileWriter, stackName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderWorkloadUpdate", out, stackName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenderWorkloadUpdate indicates an expected call of RenderWorkloadUpdate.
+func (mr *MockworkloadUpdateRendererMockRecorder) RenderWorkloadUpdate(out, stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderWorkloadUpdate", reflect.TypeOf((*MockworkloadUpdateRenderer)(nil).RenderWorkloadUpdate), out, stackName)
+}
+
 // MockversionGetter is a mock of versionGetter interface.
 type MockversionGetter struct {
 	ctrl     *gomock.Controller
@@ -4218,1760 +4888,3268 @@ func (mr *MockversionGetterMockRecorder) Version() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockversionGetter)(nil).Version))
 }
 
-// MockenvTemplater is a mock of envTemplater interface.
-type MockenvTemplater struct {
+// MockresourceGroupURLGetter is a mock of resourceGroupURLGetter interface.
+type MockresourceGroupURLGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockenvTemplaterMockRecorder
+	recorder *MockresourceGroupURLGetterMockRecorder
 }
 
-// MockenvTemplaterMockRecorder is the mock recorder for MockenvTemplater.
-type MockenvTemplaterMockRecorder struct {
-	mock *MockenvTemplater
+// MockresourceGroupURLGetterMockRecorder is the mock recorder for MockresourceGroupURLGetter.
+type MockresourceGroupURLGetterMockRecorder struct {
+	mock *MockresourceGroupURLGetter
 }
 
-// NewMockenvTemplater creates a new mock instance.
-func NewMockenvTemplater(ctrl *gomock.Controller) *MockenvTemplater {
-	mock := &MockenvTemplater{ctrl: ctrl}
-	mock.recorder = &MockenvTemplaterMockRecorder{mock}
+// NewMockresourceGroupURLGetter creates a new mock instance.
+func NewMockresourceGroupURLGetter(ctrl *gomock.Controller) *MockresourceGroupURLGetter {
+	mock := &MockresourceGroupURLGetter{ctrl: ctrl}
+	mock.recorder = &MockresourceGroupURLGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockenvTemplater) EXPECT() *MockenvTemplaterMockRecorder {
+func (m *MockresourceGroupURLGetter) EXPECT() *MockresourceGroupURLGetterMockRecorder {
 	return m.recorder
 }
 
-// EnvironmentTemplate mocks base method.
-func (m *MockenvTemplater) EnvironmentTemplate(appName, envName string) (string, error) {
+// ResourceGroupURL mocks base method.
+func (m *MockresourceGroupURLGetter) ResourceGroupURL() (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnvironmentTemplate", appName, envName)
+	ret := m.ctrl.Call(m, "ResourceGroupURL")
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// EnvironmentTemplate indicates an expected call of EnvironmentTemplate.
-func (mr *MockenvTemplaterMockRecorder) EnvironmentTemplate(appName, envName interface{}) *gomock.Call {
+// ResourceGroupURL indicates an expected call of ResourceGroupURL.
+func (mr *MockresourceGroupURLGetterMockRecorder) ResourceGroupURL() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentTemplate", reflect.TypeOf((*MockenvTemplater)(nil).EnvironmentTemplate), appName, envName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourceGroupURL", reflect.TypeOf((*MockresourceGroupURLGetter)(nil).ResourceGroupURL))
 }
 
-// MockenvUpgrader is a mock of envUpgrader interface.
-type MockenvUpgrader struct {
+// MockresourceGroupDescriber is a mock of resourceGroupDescriber interface.
+type MockresourceGroupDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *MockenvUpgraderMockRecorder
+	recorder *MockresourceGroupDescriberMockRecorder
 }
 
-// MockenvUpgraderMockRecorder is the mock recorder for MockenvUpgrader.
-type MockenvUpgraderMockRecorder struct {
-	mock *MockenvUpgrader
+// MockresourceGroupDescriberMockRecorder is the mock recorder for MockresourceGroupDescriber.
+type MockresourceGroupDescriberMockRecorder struct {
+	mock *MockresourceGroupDescriber
 }
 
-// NewMockenvUpgrader creates a new mock instance.
-func NewMockenvUpgrader(ctrl *gomock.Controller) *MockenvUpgrader {
-	mock := &MockenvUpgrader{ctrl: ctrl}
-	mock.recorder = &MockenvUpgraderMockRecorder{mock}
+// NewMockresourceGroupDescriber creates a new mock instance.
+func NewMockresourceGroupDescriber(ctrl *gomock.Controller) *MockresourceGroupDescriber {
+	mock := &MockresourceGroupDescriber{ctrl: ctrl}
+	mock.recorder = &MockresourceGroupDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockenvUpgrader) EXPECT() *MockenvUpgraderMockRecorder {
+func (m *MockresourceGroupDescriber) EXPECT() *MockresourceGroupDescriberMockRecorder {
 	return m.recorder
 }
 
-// UpgradeEnvironment mocks base method.
-func (m *MockenvUpgrader) UpgradeEnvironment(in *deploy.CreateEnvironmentInput) error {
+// Describe mocks base method.
+func (m *MockresourceGroupDescriber) Describe() (*describe.ResourceGroup, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpgradeEnvironment", in)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.ResourceGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpgradeEnvironment indicates an expected call of UpgradeEnvironment.
-func (mr *MockenvUpgraderMockRecorder) UpgradeEnvironment(in interface{}) *gomock.Call {
+// Describe indicates an expected call of Describe.
+func (mr *MockresourceGroupDescriberMockRecorder) Describe() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeEnvironment", reflect.TypeOf((*MockenvUpgrader)(nil).UpgradeEnvironment), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockresourceGroupDescriber)(nil).Describe))
 }
 
-// MocklegacyEnvUpgrader is a mock of legacyEnvUpgrader interface.
-type MocklegacyEnvUpgrader struct {
+// MockaddonResourcesGetter is a mock of addonResourcesGetter interface.
+type MockaddonResourcesGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MocklegacyEnvUpgraderMockRecorder
+	recorder *MockaddonResourcesGetterMockRecorder
 }
 
-// MocklegacyEnvUpgraderMockRecorder is the mock recorder for MocklegacyEnvUpgrader.
-type MocklegacyEnvUpgraderMockRecorder struct {
-	mock *MocklegacyEnvUpgrader
+// MockaddonResourcesGetterMockRecorder is the mock recorder for MockaddonResourcesGetter.
+type MockaddonResourcesGetterMockRecorder struct {
+	mock *MockaddonResourcesGetter
 }
 
-// NewMocklegacyEnvUpgrader creates a new mock instance.
-func NewMocklegacyEnvUpgrader(ctrl *gomock.Controller) *MocklegacyEnvUpgrader {
-	mock := &MocklegacyEnvUpgrader{ctrl: ctrl}
-	mock.recorder = &MocklegacyEnvUpgraderMockRecorder{mock}
+// NewMockaddonResourcesGetter creates a new mock instance.
+func NewMockaddonResourcesGetter(ctrl *gomock.Controller) *MockaddonResourcesGetter {
+	mock := &MockaddonResourcesGetter{ctrl: ctrl}
+	mock.recorder = &MockaddonResourcesGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocklegacyEnvUpgrader) EXPECT() *MocklegacyEnvUpgraderMockRecorder {
+func (m *MockaddonResourcesGetter) EXPECT() *MockaddonResourcesGetterMockRecorder {
 	return m.recorder
 }
 
-// EnvironmentTemplate mocks base method.
-func (m *MocklegacyEnvUpgrader) EnvironmentTemplate(appName, envName string) (string, error) {
+// Resources mocks base method.
+func (m *MockaddonResourcesGetter) Resources() ([]addon.Resource, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnvironmentTemplate", appName, envName)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Resources")
+	ret0, _ := ret[0].([]addon.Resource)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// EnvironmentTemplate indicates an expected call of EnvironmentTemplate.
-func (mr *MocklegacyEnvUpgraderMockRecorder) EnvironmentTemplate(appName, envName interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentTemplate", reflect.TypeOf((*MocklegacyEnvUpgrader)(nil).EnvironmentTemplate), appName, envName)
-}
-
-// UpgradeLegacyEnvironment mocks base method.
-func (m *MocklegacyEnvUpgrader) UpgradeLegacyEnvironment(in *deploy.CreateEnvironmentInput, lbWebServices ...string) error {
-	m.ctrl.T.Helper()
-	varargs := []interface{}{in}
-	for _, a := range lbWebServices {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "UpgradeLegacyEnvironment", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// UpgradeLegacyEnvironment indicates an expected call of UpgradeLegacyEnvironment.
-func (mr *MocklegacyEnvUpgraderMockRecorder) UpgradeLegacyEnvironment(in interface{}, lbWebServices ...interface{}) *gomock.Call {
+// Resources indicates an expected call of Resources.
+func (mr *MockaddonResourcesGetterMockRecorder) Resources() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{in}, lbWebServices...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeLegacyEnvironment", reflect.TypeOf((*MocklegacyEnvUpgrader)(nil).UpgradeLegacyEnvironment), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockaddonResourcesGetter)(nil).Resources))
 }
 
-// MockenvTemplateUpgrader is a mock of envTemplateUpgrader interface.
-type MockenvTemplateUpgrader struct {
+// MockwsWlManifestReader is a mock of wsWlManifestReader interface.
+type MockwsWlManifestReader struct {
 	ctrl     *gomock.Controller
-	recorder *MockenvTemplateUpgraderMockRecorder
+	recorder *MockwsWlManifestReaderMockRecorder
 }
 
-// MockenvTemplateUpgraderMockRecorder is the mock recorder for MockenvTemplateUpgrader.
-type MockenvTemplateUpgraderMockRecorder struct {
-	mock *MockenvTemplateUpgrader
+// MockwsWlManifestReaderMockRecorder is the mock recorder for MockwsWlManifestReader.
+type MockwsWlManifestReaderMockRecorder struct {
+	mock *MockwsWlManifestReader
 }
 
-// NewMockenvTemplateUpgrader creates a new mock instance.
-func NewMockenvTemplateUpgrader(ctrl *gomock.Controller) *MockenvTemplateUpgrader {
-	mock := &MockenvTemplateUpgrader{ctrl: ctrl}
-	mock.recorder = &MockenvTemplateUpgraderMockRecorder{mock}
+// NewMockwsWlManifestReader creates a new mock instance.
+func NewMockwsWlManifestReader(ctrl *gomock.Controller) *MockwsWlManifestReader {
+	mock := &MockwsWlManifestReader{ctrl: ctrl}
+	mock.recorder = &MockwsWlManifestReaderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockenvTemplateUpgrader) EXPECT() *MockenvTemplateUpgraderMockRecorder {
+func (m *MockwsWlManifestReader) EXPECT() *MockwsWlManifestReaderMockRecorder {
 	return m.recorder
 }
 
-// EnvironmentTemplate mocks base method.
-func (m *MockenvTemplateUpgrader) EnvironmentTemplate(appName, envName string) (string, error) {
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsWlManifestReader) ReadWorkloadManifest(name string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnvironmentTemplate", appName, envName)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// EnvironmentTemplate indicates an expected call of EnvironmentTemplate.
-func (mr *MockenvTemplateUpgraderMockRecorder) EnvironmentTemplate(appName, envName interface{}) *gomock.Call {
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsWlManifestReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentTemplate", reflect.TypeOf((*MockenvTemplateUpgrader)(nil).EnvironmentTemplate), appName, envName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsWlManifestReader)(nil).ReadWorkloadManifest), name)
 }
 
-// UpgradeEnvironment mocks base method.
-func (m *MockenvTemplateUpgrader) UpgradeEnvironment(in *deploy.CreateEnvironmentInput) error {
+// WorkloadNames mocks base method.
+func (m *MockwsWlManifestReader) WorkloadNames() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpgradeEnvironment", in)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// UpgradeEnvironment indicates an expected call of UpgradeEnvironment.
-func (mr *MockenvTemplateUpgraderMockRecorder) UpgradeEnvironment(in interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeEnvironment", reflect.TypeOf((*MockenvTemplateUpgrader)(nil).UpgradeEnvironment), in)
-}
-
-// UpgradeLegacyEnvironment mocks base method.
-func (m *MockenvTemplateUpgrader) UpgradeLegacyEnvironment(in *deploy.CreateEnvironmentInput, lbWebServices ...string) error {
-	m.ctrl.T.Helper()
-	varargs := []interface{}{in}
-	for _, a := range lbWebServices {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "UpgradeLegacyEnvironment", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// UpgradeLegacyEnvironment indicates an expected call of UpgradeLegacyEnvironment.
-func (mr *MockenvTemplateUpgraderMockRecorder) UpgradeLegacyEnvironment(in interface{}, lbWebServices ...interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{in}, lbWebServices...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeLegacyEnvironment", reflect.TypeOf((*MockenvTemplateUpgrader)(nil).UpgradeLegacyEnvironment), varargs...)
-}
-
-// MockappUpgrader is a mock of appUpgrader interface.
-type MockappUpgrader struct {
-	ctrl     *gomock.Controller
-	recorder *MockappUpgraderMockRecorder
-}
-
-// MockappUpgraderMockRecorder is the mock recorder for MockappUpgrader.
-type MockappUpgraderMockRecorder struct {
-	mock *MockappUpgrader
-}
-
-// NewMockappUpgrader creates a new mock instance.
-func NewMockappUpgrader(ctrl *gomock.Controller) *MockappUpgrader {
-	mock := &MockappUpgrader{ctrl: ctrl}
-	mock.recorder = &MockappUpgraderMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockappUpgrader) EXPECT() *MockappUpgraderMockRecorder {
-	return m.recorder
-}
-
-// UpgradeApplication mocks base method.
-func (m *MockappUpgrader) UpgradeApplication(in *deploy.CreateAppInput) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpgradeApplication", in)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "WorkloadNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpgradeApplication indicates an expected call of UpgradeApplication.
-func (mr *MockappUpgraderMockRecorder) UpgradeApplication(in interface{}) *gomock.Call {
+// WorkloadNames indicates an expected call of WorkloadNames.
+func (mr *MockwsWlManifestReaderMockRecorder) WorkloadNames() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeApplication", reflect.TypeOf((*MockappUpgrader)(nil).UpgradeApplication), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadNames", reflect.TypeOf((*MockwsWlManifestReader)(nil).WorkloadNames))
 }
 
-// MockpipelineGetter is a mock of pipelineGetter interface.
-type MockpipelineGetter struct {
+// MockwsTopologyReader is a mock of wsTopologyReader interface.
+type MockwsTopologyReader struct {
 	ctrl     *gomock.Controller
-	recorder *MockpipelineGetterMockRecorder
+	recorder *MockwsTopologyReaderMockRecorder
 }
 
-// MockpipelineGetterMockRecorder is the mock recorder for MockpipelineGetter.
-type MockpipelineGetterMockRecorder struct {
-	mock *MockpipelineGetter
+// MockwsTopologyReaderMockRecorder is the mock recorder for MockwsTopologyReader.
+type MockwsTopologyReaderMockRecorder struct {
+	mock *MockwsTopologyReader
 }
 
-// NewMockpipelineGetter creates a new mock instance.
-func NewMockpipelineGetter(ctrl *gomock.Controller) *MockpipelineGetter {
-	mock := &MockpipelineGetter{ctrl: ctrl}
-	mock.recorder = &MockpipelineGetterMockRecorder{mock}
+// NewMockwsTopologyReader creates a new mock instance.
+func NewMockwsTopologyReader(ctrl *gomock.Controller) *MockwsTopologyReader {
+	mock := &MockwsTopologyReader{ctrl: ctrl}
+	mock.recorder = &MockwsTopologyReaderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockpipelineGetter) EXPECT() *MockpipelineGetterMockRecorder {
+func (m *MockwsTopologyReader) EXPECT() *MockwsTopologyReaderMockRecorder {
 	return m.recorder
 }
 
-// GetPipeline mocks base method.
-func (m *MockpipelineGetter) GetPipeline(pipelineName string) (*codepipeline.Pipeline, error) {
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsTopologyReader) ReadWorkloadManifest(name string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPipeline", pipelineName)
-	ret0, _ := ret[0].(*codepipeline.Pipeline)
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPipeline indicates an expected call of GetPipeline.
-func (mr *MockpipelineGetterMockRecorder) GetPipeline(pipelineName interface{}) *gomock.Call {
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsTopologyReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipeline), pipelineName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsTopologyReader)(nil).ReadWorkloadManifest), name)
 }
 
-// GetPipelinesByTags mocks base method.
-func (m *MockpipelineGetter) GetPipelinesByTags(tags map[string]string) ([]*codepipeline.Pipeline, error) {
+// Summary mocks base method.
+func (m *MockwsTopologyReader) Summary() (*workspace.Summary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPipelinesByTags", tags)
-	ret0, _ := ret[0].([]*codepipeline.Pipeline)
+	ret := m.ctrl.Call(m, "Summary")
+	ret0, _ := ret[0].(*workspace.Summary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPipelinesByTags indicates an expected call of GetPipelinesByTags.
-func (mr *MockpipelineGetterMockRecorder) GetPipelinesByTags(tags interface{}) *gomock.Call {
+// Summary indicates an expected call of Summary.
+func (mr *MockwsTopologyReaderMockRecorder) Summary() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelinesByTags", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipelinesByTags), tags)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockwsTopologyReader)(nil).Summary))
 }
 
-// ListPipelineNamesByTags mocks base method.
-func (m *MockpipelineGetter) ListPipelineNamesByTags(tags map[string]string) ([]string, error) {
+// WorkloadNames mocks base method.
+func (m *MockwsTopologyReader) WorkloadNames() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPipelineNamesByTags", tags)
+	ret := m.ctrl.Call(m, "WorkloadNames")
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListPipelineNamesByTags indicates an expected call of ListPipelineNamesByTags.
-func (mr *MockpipelineGetterMockRecorder) ListPipelineNamesByTags(tags interface{}) *gomock.Call {
+// WorkloadNames indicates an expected call of WorkloadNames.
+func (mr *MockwsTopologyReaderMockRecorder) WorkloadNames() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelineNamesByTags", reflect.TypeOf((*MockpipelineGetter)(nil).ListPipelineNamesByTags), tags)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadNames", reflect.TypeOf((*MockwsTopologyReader)(nil).WorkloadNames))
 }
 
-// Mockexecutor is a mock of executor interface.
-type Mockexecutor struct {
+// MockquotaDescriber is a mock of quotaDescriber interface.
+type MockquotaDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *MockexecutorMockRecorder
+	recorder *MockquotaDescriberMockRecorder
 }
 
-// MockexecutorMockRecorder is the mock recorder for Mockexecutor.
-type MockexecutorMockRecorder struct {
-	mock *Mockexecutor
+// MockquotaDescriberMockRecorder is the mock recorder for MockquotaDescriber.
+type MockquotaDescriberMockRecorder struct {
+	mock *MockquotaDescriber
 }
 
-// NewMockexecutor creates a new mock instance.
-func NewMockexecutor(ctrl *gomock.Controller) *Mockexecutor {
-	mock := &Mockexecutor{ctrl: ctrl}
-	mock.recorder = &MockexecutorMockRecorder{mock}
+// NewMockquotaDescriber creates a new mock instance.
+func NewMockquotaDescriber(ctrl *gomock.Controller) *MockquotaDescriber {
+	mock := &MockquotaDescriber{ctrl: ctrl}
+	mock.recorder = &MockquotaDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockexecutor) EXPECT() *MockexecutorMockRecorder {
+func (m *MockquotaDescriber) EXPECT() *MockquotaDescriberMockRecorder {
 	return m.recorder
 }
 
-// Execute mocks base method.
-func (m *Mockexecutor) Execute() error {
+// Describe mocks base method.
+func (m *MockquotaDescriber) Describe() ([]*describe.QuotaUsage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].([]*describe.QuotaUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Execute indicates an expected call of Execute.
-func (mr *MockexecutorMockRecorder) Execute() *gomock.Call {
+// Describe indicates an expected call of Describe.
+func (mr *MockquotaDescriberMockRecorder) Describe() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*Mockexecutor)(nil).Execute))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockquotaDescriber)(nil).Describe))
 }
 
-// MockdeletePipelineRunner is a mock of deletePipelineRunner interface.
-type MockdeletePipelineRunner struct {
+// MockenvTemplater is a mock of envTemplater interface.
+type MockenvTemplater struct {
 	ctrl     *gomock.Controller
-	recorder *MockdeletePipelineRunnerMockRecorder
+	recorder *MockenvTemplaterMockRecorder
 }
 
-// MockdeletePipelineRunnerMockRecorder is the mock recorder for MockdeletePipelineRunner.
-type MockdeletePipelineRunnerMockRecorder struct {
-	mock *MockdeletePipelineRunner
+// MockenvTemplaterMockRecorder is the mock recorder for MockenvTemplater.
+type MockenvTemplaterMockRecorder struct {
+	mock *MockenvTemplater
 }
 
-// NewMockdeletePipelineRunner creates a new mock instance.
-func NewMockdeletePipelineRunner(ctrl *gomock.Controller) *MockdeletePipelineRunner {
-	mock := &MockdeletePipelineRunner{ctrl: ctrl}
-	mock.recorder = &MockdeletePipelineRunnerMockRecorder{mock}
+// NewMockenvTemplater creates a new mock instance.
+func NewMockenvTemplater(ctrl *gomock.Controller) *MockenvTemplater {
+	mock := &MockenvTemplater{ctrl: ctrl}
+	mock.recorder = &MockenvTemplaterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockdeletePipelineRunner) EXPECT() *MockdeletePipelineRunnerMockRecorder {
+func (m *MockenvTemplater) EXPECT() *MockenvTemplaterMockRecorder {
 	return m.recorder
 }
 
-// Run mocks base method.
-func (m *MockdeletePipelineRunner) Run() error {
+// EnvironmentTemplate mocks base method.
+func (m *MockenvTemplater) EnvironmentTemplate(appName, envName string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Run")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "EnvironmentTemplate", appName, envName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Run indicates an expected call of Run.
-func (mr *MockdeletePipelineRunnerMockRecorder) Run() *gomock.Call {
+// EnvironmentTemplate indicates an expected call of EnvironmentTemplate.
+func (mr *MockenvTemplaterMockRecorder) EnvironmentTemplate(appName, envName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockdeletePipelineRunner)(nil).Run))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentTemplate", reflect.TypeOf((*MockenvTemplater)(nil).EnvironmentTemplate), appName, envName)
 }
 
-// MockexecuteAsker is a mock of executeAsker interface.
-type MockexecuteAsker struct {
+// MockenvUpgrader is a mock of envUpgrader interface.
+type MockenvUpgrader struct {
 	ctrl     *gomock.Controller
-	recorder *MockexecuteAskerMockRecorder
+	recorder *MockenvUpgraderMockRecorder
 }
 
-// MockexecuteAskerMockRecorder is the mock recorder for MockexecuteAsker.
-type MockexecuteAskerMockRecorder struct {
-	mock *MockexecuteAsker
+// MockenvUpgraderMockRecorder is the mock recorder for MockenvUpgrader.
+type MockenvUpgraderMockRecorder struct {
+	mock *MockenvUpgrader
 }
 
-// NewMockexecuteAsker creates a new mock instance.
-func NewMockexecuteAsker(ctrl *gomock.Controller) *MockexecuteAsker {
-	mock := &MockexecuteAsker{ctrl: ctrl}
-	mock.recorder = &MockexecuteAskerMockRecorder{mock}
+// NewMockenvUpgrader creates a new mock instance.
+func NewMockenvUpgrader(ctrl *gomock.Controller) *MockenvUpgrader {
+	mock := &MockenvUpgrader{ctrl: ctrl}
+	mock.recorder = &MockenvUpgraderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockexecuteAsker) EXPECT() *MockexecuteAskerMockRecorder {
+func (m *MockenvUpgrader) EXPECT() *MockenvUpgraderMockRecorder {
 	return m.recorder
 }
 
-// Ask mocks base method.
-func (m *MockexecuteAsker) Ask() error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Ask")
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Ask indicates an expected call of Ask.
-func (mr *MockexecuteAskerMockRecorder) Ask() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ask", reflect.TypeOf((*MockexecuteAsker)(nil).Ask))
-}
-
-// Execute mocks base method.
-func (m *MockexecuteAsker) Execute() error {
+// UpgradeEnvironment mocks base method.
+func (m *MockenvUpgrader) UpgradeEnvironment(in *deploy.CreateEnvironmentInput) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute")
+	ret := m.ctrl.Call(m, "UpgradeEnvironment", in)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Execute indicates an expected call of Execute.
-func (mr *MockexecuteAskerMockRecorder) Execute() *gomock.Call {
+// UpgradeEnvironment indicates an expected call of UpgradeEnvironment.
+func (mr *MockenvUpgraderMockRecorder) UpgradeEnvironment(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockexecuteAsker)(nil).Execute))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeEnvironment", reflect.TypeOf((*MockenvUpgrader)(nil).UpgradeEnvironment), in)
 }
 
-// MockappSelector is a mock of appSelector interface.
-type MockappSelector struct {
+// MocklegacyEnvUpgrader is a mock of legacyEnvUpgrader interface.
+type MocklegacyEnvUpgrader struct {
 	ctrl     *gomock.Controller
-	recorder *MockappSelectorMockRecorder
+	recorder *MocklegacyEnvUpgraderMockRecorder
 }
 
-// MockappSelectorMockRecorder is the mock recorder for MockappSelector.
-type MockappSelectorMockRecorder struct {
-	mock *MockappSelector
+// MocklegacyEnvUpgraderMockRecorder is the mock recorder for MocklegacyEnvUpgrader.
+type MocklegacyEnvUpgraderMockRecorder struct {
+	mock *MocklegacyEnvUpgrader
 }
 
-// NewMockappSelector creates a new mock instance.
-func NewMockappSelector(ctrl *gomock.Controller) *MockappSelector {
-	mock := &MockappSelector{ctrl: ctrl}
-	mock.recorder = &MockappSelectorMockRecorder{mock}
+// NewMocklegacyEnvUpgrader creates a new mock instance.
+func NewMocklegacyEnvUpgrader(ctrl *gomock.Controller) *MocklegacyEnvUpgrader {
+	mock := &MocklegacyEnvUpgrader{ctrl: ctrl}
+	mock.recorder = &MocklegacyEnvUpgraderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockappSelector) EXPECT() *MockappSelectorMockRecorder {
+func (m *MocklegacyEnvUpgrader) EXPECT() *MocklegacyEnvUpgraderMockRecorder {
 	return m.recorder
 }
 
-// Application mocks base method.
-func (m *MockappSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+// EnvironmentTemplate mocks base method.
+func (m *MocklegacyEnvUpgrader) EnvironmentTemplate(appName, envName string) (string, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret := m.ctrl.Call(m, "EnvironmentTemplate", appName, envName)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Application indicates an expected call of Application.
-func (mr *MockappSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+// EnvironmentTemplate indicates an expected call of EnvironmentTemplate.
+func (mr *MocklegacyEnvUpgraderMockRecorder) EnvironmentTemplate(appName, envName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockappSelector)(nil).Application), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentTemplate", reflect.TypeOf((*MocklegacyEnvUpgrader)(nil).EnvironmentTemplate), appName, envName)
 }
 
-// MockappEnvSelector is a mock of appEnvSelector interface.
-type MockappEnvSelector struct {
-	ctrl     *gomock.Controller
-	recorder *MockappEnvSelectorMockRecorder
+// UpgradeLegacyEnvironment mocks base method.
+func (m *MocklegacyEnvUpgrader) UpgradeLegacyEnvironment(in *deploy.CreateEnvironmentInput, lbWebServices ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{in}
+	for _, a := range lbWebServices {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpgradeLegacyEnvironment", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockappEnvSelectorMockRecorder is the mock recorder for MockappEnvSelector.
-type MockappEnvSelectorMockRecorder struct {
-	mock *MockappEnvSelector
+// UpgradeLegacyEnvironment indicates an expected call of UpgradeLegacyEnvironment.
+func (mr *MocklegacyEnvUpgraderMockRecorder) UpgradeLegacyEnvironment(in interface{}, lbWebServices ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{in}, lbWebServices...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeLegacyEnvironment", reflect.TypeOf((*MocklegacyEnvUpgrader)(nil).UpgradeLegacyEnvironment), varargs...)
 }
 
-// NewMockappEnvSelector creates a new mock instance.
-func NewMockappEnvSelector(ctrl *gomock.Controller) *MockappEnvSelector {
-	mock := &MockappEnvSelector{ctrl: ctrl}
-	mock.recorder = &MockappEnvSelectorMockRecorder{mock}
+// MockenvTemplateUpgrader is a mock of envTemplateUpgrader interface.
+type MockenvTemplateUpgrader struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvTemplateUpgraderMockRecorder
+}
+
+// MockenvTemplateUpgraderMockRecorder is the mock recorder for MockenvTemplateUpgrader.
+type MockenvTemplateUpgraderMockRecorder struct {
+	mock *MockenvTemplateUpgrader
+}
+
+// NewMockenvTemplateUpgrader creates a new mock instance.
+func NewMockenvTemplateUpgrader(ctrl *gomock.Controller) *MockenvTemplateUpgrader {
+	mock := &MockenvTemplateUpgrader{ctrl: ctrl}
+	mock.recorder = &MockenvTemplateUpgraderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockappEnvSelector) EXPECT() *MockappEnvSelectorMockRecorder {
+func (m *MockenvTemplateUpgrader) EXPECT() *MockenvTemplateUpgraderMockRecorder {
 	return m.recorder
 }
 
-// Application mocks base method.
-func (m *MockappEnvSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+// EnvironmentTemplate mocks base method.
+func (m *MockenvTemplateUpgrader) EnvironmentTemplate(appName, envName string) (string, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret := m.ctrl.Call(m, "EnvironmentTemplate", appName, envName)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Application indicates an expected call of Application.
-func (mr *MockappEnvSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+// EnvironmentTemplate indicates an expected call of EnvironmentTemplate.
+func (mr *MockenvTemplateUpgraderMockRecorder) EnvironmentTemplate(appName, envName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockappEnvSelector)(nil).Application), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentTemplate", reflect.TypeOf((*MockenvTemplateUpgrader)(nil).EnvironmentTemplate), appName, envName)
 }
 
-// Environment mocks base method.
-func (m *MockappEnvSelector) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
+// UpgradeEnvironment mocks base method.
+func (m *MockenvTemplateUpgrader) UpgradeEnvironment(in *deploy.CreateEnvironmentInput) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help, app}
-	for _, a := range additionalOpts {
+	ret := m.ctrl.Call(m, "UpgradeEnvironment", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpgradeEnvironment indicates an expected call of UpgradeEnvironment.
+func (mr *MockenvTemplateUpgraderMockRecorder) UpgradeEnvironment(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeEnvironment", reflect.TypeOf((*MockenvTemplateUpgrader)(nil).UpgradeEnvironment), in)
+}
+
+// UpgradeLegacyEnvironment mocks base method.
+func (m *MockenvTemplateUpgrader) UpgradeLegacyEnvironment(in *deploy.CreateEnvironmentInput, lbWebServices ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{in}
+	for _, a := range lbWebServices {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "Environment", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "UpgradeLegacyEnvironment", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Environment indicates an expected call of Environment.
-func (mr *MockappEnvSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
+// UpgradeLegacyEnvironment indicates an expected call of UpgradeLegacyEnvironment.
+func (mr *MockenvTemplateUpgraderMockRecorder) UpgradeLegacyEnvironment(in interface{}, lbWebServices ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockappEnvSelector)(nil).Environment), varargs...)
+	varargs := append([]interface{}{in}, lbWebServices...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeLegacyEnvironment", reflect.TypeOf((*MockenvTemplateUpgrader)(nil).UpgradeLegacyEnvironment), varargs...)
 }
 
-// MockconfigSelector is a mock of configSelector interface.
-type MockconfigSelector struct {
+// MockappUpgrader is a mock of appUpgrader interface.
+type MockappUpgrader struct {
 	ctrl     *gomock.Controller
-	recorder *MockconfigSelectorMockRecorder
+	recorder *MockappUpgraderMockRecorder
 }
 
-// MockconfigSelectorMockRecorder is the mock recorder for MockconfigSelector.
-type MockconfigSelectorMockRecorder struct {
-	mock *MockconfigSelector
+// MockappUpgraderMockRecorder is the mock recorder for MockappUpgrader.
+type MockappUpgraderMockRecorder struct {
+	mock *MockappUpgrader
 }
 
-// NewMockconfigSelector creates a new mock instance.
-func NewMockconfigSelector(ctrl *gomock.Controller) *MockconfigSelector {
-	mock := &MockconfigSelector{ctrl: ctrl}
-	mock.recorder = &MockconfigSelectorMockRecorder{mock}
+// NewMockappUpgrader creates a new mock instance.
+func NewMockappUpgrader(ctrl *gomock.Controller) *MockappUpgrader {
+	mock := &MockappUpgrader{ctrl: ctrl}
+	mock.recorder = &MockappUpgraderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockconfigSelector) EXPECT() *MockconfigSelectorMockRecorder {
+func (m *MockappUpgrader) EXPECT() *MockappUpgraderMockRecorder {
 	return m.recorder
 }
 
-// Application mocks base method.
-func (m *MockconfigSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+// PreviewApplicationUpgrade mocks base method.
+func (m *MockappUpgrader) PreviewApplicationUpgrade(in *deploy.CreateAppInput) (*cloudformation0.AppUpgradePreview, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Application", varargs...)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "PreviewApplicationUpgrade", in)
+	ret0, _ := ret[0].(*cloudformation0.AppUpgradePreview)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Application indicates an expected call of Application.
-func (mr *MockconfigSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+// PreviewApplicationUpgrade indicates an expected call of PreviewApplicationUpgrade.
+func (mr *MockappUpgraderMockRecorder) PreviewApplicationUpgrade(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockconfigSelector)(nil).Application), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewApplicationUpgrade", reflect.TypeOf((*MockappUpgrader)(nil).PreviewApplicationUpgrade), in)
 }
 
-// Environment mocks base method.
-func (m *MockconfigSelector) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
+// UpgradeApplication mocks base method.
+func (m *MockappUpgrader) UpgradeApplication(in *deploy.CreateAppInput) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help, app}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Environment", varargs...)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "UpgradeApplication", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpgradeApplication indicates an expected call of UpgradeApplication.
+func (mr *MockappUpgraderMockRecorder) UpgradeApplication(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeApplication", reflect.TypeOf((*MockappUpgrader)(nil).UpgradeApplication), in)
+}
+
+// MockpipelineGetter is a mock of pipelineGetter interface.
+type MockpipelineGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineGetterMockRecorder
+}
+
+// MockpipelineGetterMockRecorder is the mock recorder for MockpipelineGetter.
+type MockpipelineGetterMockRecorder struct {
+	mock *MockpipelineGetter
+}
+
+// NewMockpipelineGetter creates a new mock instance.
+func NewMockpipelineGetter(ctrl *gomock.Controller) *MockpipelineGetter {
+	mock := &MockpipelineGetter{ctrl: ctrl}
+	mock.recorder = &MockpipelineGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineGetter) EXPECT() *MockpipelineGetterMockRecorder {
+	return m.recorder
+}
+
+// GetPipeline mocks base method.
+func (m *MockpipelineGetter) GetPipeline(pipelineName string) (*codepipeline.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipeline", pipelineName)
+	ret0, _ := ret[0].(*codepipeline.Pipeline)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Environment indicates an expected call of Environment.
-func (mr *MockconfigSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
+// GetPipeline indicates an expected call of GetPipeline.
+func (mr *MockpipelineGetterMockRecorder) GetPipeline(pipelineName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockconfigSelector)(nil).Environment), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipeline), pipelineName)
 }
 
-// Job mocks base method.
-func (m *MockconfigSelector) Job(prompt, help, app string) (string, error) {
+// GetPipelinesByTags mocks base method.
+func (m *MockpipelineGetter) GetPipelinesByTags(tags map[string]string) ([]*codepipeline.Pipeline, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Job", prompt, help, app)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetPipelinesByTags", tags)
+	ret0, _ := ret[0].([]*codepipeline.Pipeline)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Job indicates an expected call of Job.
-func (mr *MockconfigSelectorMockRecorder) Job(prompt, help, app interface{}) *gomock.Call {
+// GetPipelinesByTags indicates an expected call of GetPipelinesByTags.
+func (mr *MockpipelineGetterMockRecorder) GetPipelinesByTags(tags interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockconfigSelector)(nil).Job), prompt, help, app)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelinesByTags", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipelinesByTags), tags)
 }
 
-// Service mocks base method.
-func (m *MockconfigSelector) Service(prompt, help, app string) (string, error) {
+// ListPipelineNamesByTags mocks base method.
+func (m *MockpipelineGetter) ListPipelineNamesByTags(tags map[string]string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Service", prompt, help, app)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ListPipelineNamesByTags", tags)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Service indicates an expected call of Service.
-func (mr *MockconfigSelectorMockRecorder) Service(prompt, help, app interface{}) *gomock.Call {
+// ListPipelineNamesByTags indicates an expected call of ListPipelineNamesByTags.
+func (mr *MockpipelineGetterMockRecorder) ListPipelineNamesByTags(tags interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockconfigSelector)(nil).Service), prompt, help, app)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelineNamesByTags", reflect.TypeOf((*MockpipelineGetter)(nil).ListPipelineNamesByTags), tags)
 }
 
-// MockdeploySelector is a mock of deploySelector interface.
-type MockdeploySelector struct {
+// Mockexecutor is a mock of executor interface.
+type Mockexecutor struct {
 	ctrl     *gomock.Controller
-	recorder *MockdeploySelectorMockRecorder
+	recorder *MockexecutorMockRecorder
 }
 
-// MockdeploySelectorMockRecorder is the mock recorder for MockdeploySelector.
-type MockdeploySelectorMockRecorder struct {
-	mock *MockdeploySelector
+// MockexecutorMockRecorder is the mock recorder for Mockexecutor.
+type MockexecutorMockRecorder struct {
+	mock *Mockexecutor
 }
 
-// NewMockdeploySelector creates a new mock instance.
-func NewMockdeploySelector(ctrl *gomock.Controller) *MockdeploySelector {
-	mock := &MockdeploySelector{ctrl: ctrl}
-	mock.recorder = &MockdeploySelectorMockRecorder{mock}
+// NewMockexecutor creates a new mock instance.
+func NewMockexecutor(ctrl *gomock.Controller) *Mockexecutor {
+	mock := &Mockexecutor{ctrl: ctrl}
+	mock.recorder = &MockexecutorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockdeploySelector) EXPECT() *MockdeploySelectorMockRecorder {
+func (m *Mockexecutor) EXPECT() *MockexecutorMockRecorder {
 	return m.recorder
 }
 
-// Application mocks base method.
-func (m *MockdeploySelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+// Execute mocks base method.
+func (m *Mockexecutor) Execute() error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Application", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Execute")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Application indicates an expected call of Application.
-func (mr *MockdeploySelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+// Execute indicates an expected call of Execute.
+func (mr *MockexecutorMockRecorder) Execute() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockdeploySelector)(nil).Application), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*Mockexecutor)(nil).Execute))
 }
 
-// DeployedService mocks base method.
-func (m *MockdeploySelector) DeployedService(prompt, help, app string, opts ...selector.GetDeployedServiceOpts) (*selector.DeployedService, error) {
+// MockdeletePipelineRunner is a mock of deletePipelineRunner interface.
+type MockdeletePipelineRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeletePipelineRunnerMockRecorder
+}
+
+// MockdeletePipelineRunnerMockRecorder is the mock recorder for MockdeletePipelineRunner.
+type MockdeletePipelineRunnerMockRecorder struct {
+	mock *MockdeletePipelineRunner
+}
+
+// NewMockdeletePipelineRunner creates a new mock instance.
+func NewMockdeletePipelineRunner(ctrl *gomock.Controller) *MockdeletePipelineRunner {
+	mock := &MockdeletePipelineRunner{ctrl: ctrl}
+	mock.recorder = &MockdeletePipelineRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeletePipelineRunner) EXPECT() *MockdeletePipelineRunnerMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockdeletePipelineRunner) Run() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockdeletePipelineRunnerMockRecorder) Run() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockdeletePipelineRunner)(nil).Run))
+}
+
+// MockexecuteAsker is a mock of executeAsker interface.
+type MockexecuteAsker struct {
+	ctrl     *gomock.Controller
+	recorder *MockexecuteAskerMockRecorder
+}
+
+// MockexecuteAskerMockRecorder is the mock recorder for MockexecuteAsker.
+type MockexecuteAskerMockRecorder struct {
+	mock *MockexecuteAsker
+}
+
+// NewMockexecuteAsker creates a new mock instance.
+func NewMockexecuteAsker(ctrl *gomock.Controller) *MockexecuteAsker {
+	mock := &MockexecuteAsker{ctrl: ctrl}
+	mock.recorder = &MockexecuteAskerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockexecuteAsker) EXPECT() *MockexecuteAskerMockRecorder {
+	return m.recorder
+}
+
+// Ask mocks base method.
+func (m *MockexecuteAsker) Ask() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ask")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ask indicates an expected call of Ask.
+func (mr *MockexecuteAskerMockRecorder) Ask() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ask", reflect.TypeOf((*MockexecuteAsker)(nil).Ask))
+}
+
+// Execute mocks base method.
+func (m *MockexecuteAsker) Execute() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockexecuteAskerMockRecorder) Execute() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockexecuteAsker)(nil).Execute))
+}
+
+// MockappSelector is a mock of appSelector interface.
+type MockappSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockappSelectorMockRecorder
+}
+
+// MockappSelectorMockRecorder is the mock recorder for MockappSelector.
+type MockappSelectorMockRecorder struct {
+	mock *MockappSelector
+}
+
+// NewMockappSelector creates a new mock instance.
+func NewMockappSelector(ctrl *gomock.Controller) *MockappSelector {
+	mock := &MockappSelector{ctrl: ctrl}
+	mock.recorder = &MockappSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockappSelector) EXPECT() *MockappSelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method.
+func (m *MockappSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application.
+func (mr *MockappSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockappSelector)(nil).Application), varargs...)
+}
+
+// MockappEnvSelector is a mock of appEnvSelector interface.
+type MockappEnvSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockappEnvSelectorMockRecorder
+}
+
+// MockappEnvSelectorMockRecorder is the mock recorder for MockappEnvSelector.
+type MockappEnvSelectorMockRecorder struct {
+	mock *MockappEnvSelector
+}
+
+// NewMockappEnvSelector creates a new mock instance.
+func NewMockappEnvSelector(ctrl *gomock.Controller) *MockappEnvSelector {
+	mock := &MockappEnvSelector{ctrl: ctrl}
+	mock.recorder = &MockappEnvSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockappEnvSelector) EXPECT() *MockappEnvSelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method.
+func (m *MockappEnvSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application.
+func (mr *MockappEnvSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockappEnvSelector)(nil).Application), varargs...)
+}
+
+// Environment mocks base method.
+func (m *MockappEnvSelector) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{prompt, help, app}
-	for _, a := range opts {
+	for _, a := range additionalOpts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DeployedService", varargs...)
-	ret0, _ := ret[0].(*selector.DeployedService)
+	ret := m.ctrl.Call(m, "Environment", varargs...)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeployedService indicates an expected call of DeployedService.
-func (mr *MockdeploySelectorMockRecorder) DeployedService(prompt, help, app interface{}, opts ...interface{}) *gomock.Call {
+// Environment indicates an expected call of Environment.
+func (mr *MockappEnvSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help, app}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedService", reflect.TypeOf((*MockdeploySelector)(nil).DeployedService), varargs...)
+	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockappEnvSelector)(nil).Environment), varargs...)
 }
 
-// MockpipelineSelector is a mock of pipelineSelector interface.
-type MockpipelineSelector struct {
+// MockconfigSelector is a mock of configSelector interface.
+type MockconfigSelector struct {
 	ctrl     *gomock.Controller
-	recorder *MockpipelineSelectorMockRecorder
+	recorder *MockconfigSelectorMockRecorder
 }
 
-// MockpipelineSelectorMockRecorder is the mock recorder for MockpipelineSelector.
-type MockpipelineSelectorMockRecorder struct {
-	mock *MockpipelineSelector
+// MockconfigSelectorMockRecorder is the mock recorder for MockconfigSelector.
+type MockconfigSelectorMockRecorder struct {
+	mock *MockconfigSelector
 }
 
-// NewMockpipelineSelector creates a new mock instance.
-func NewMockpipelineSelector(ctrl *gomock.Controller) *MockpipelineSelector {
-	mock := &MockpipelineSelector{ctrl: ctrl}
-	mock.recorder = &MockpipelineSelectorMockRecorder{mock}
+// NewMockconfigSelector creates a new mock instance.
+func NewMockconfigSelector(ctrl *gomock.Controller) *MockconfigSelector {
+	mock := &MockconfigSelector{ctrl: ctrl}
+	mock.recorder = &MockconfigSelectorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockpipelineSelector) EXPECT() *MockpipelineSelectorMockRecorder {
+func (m *MockconfigSelector) EXPECT() *MockconfigSelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method.
+func (m *MockconfigSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application.
+func (mr *MockconfigSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockconfigSelector)(nil).Application), varargs...)
+}
+
+// Environment mocks base method.
+func (m *MockconfigSelector) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Environment", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Environment indicates an expected call of Environment.
+func (mr *MockconfigSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockconfigSelector)(nil).Environment), varargs...)
+}
+
+// Job mocks base method.
+func (m *MockconfigSelector) Job(prompt, help, app string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Job", prompt, help, app)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Job indicates an expected call of Job.
+func (mr *MockconfigSelectorMockRecorder) Job(prompt, help, app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockconfigSelector)(nil).Job), prompt, help, app)
+}
+
+// Service mocks base method.
+func (m *MockconfigSelector) Service(prompt, help, app string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", prompt, help, app)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockconfigSelectorMockRecorder) Service(prompt, help, app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockconfigSelector)(nil).Service), prompt, help, app)
+}
+
+// MockdeploySelector is a mock of deploySelector interface.
+type MockdeploySelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeploySelectorMockRecorder
+}
+
+// MockdeploySelectorMockRecorder is the mock recorder for MockdeploySelector.
+type MockdeploySelectorMockRecorder struct {
+	mock *MockdeploySelector
+}
+
+// NewMockdeploySelector creates a new mock instance.
+func NewMockdeploySelector(ctrl *gomock.Controller) *MockdeploySelector {
+	mock := &MockdeploySelector{ctrl: ctrl}
+	mock.recorder = &MockdeploySelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeploySelector) EXPECT() *MockdeploySelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method.
+func (m *MockdeploySelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application.
+func (mr *MockdeploySelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockdeploySelector)(nil).Application), varargs...)
+}
+
+// DeployedService mocks base method.
+func (m *MockdeploySelector) DeployedService(prompt, help, app string, opts ...selector.GetDeployedServiceOpts) (*selector.DeployedService, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployedService", varargs...)
+	ret0, _ := ret[0].(*selector.DeployedService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployedService indicates an expected call of DeployedService.
+func (mr *MockdeploySelectorMockRecorder) DeployedService(prompt, help, app interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedService", reflect.TypeOf((*MockdeploySelector)(nil).DeployedService), varargs...)
+}
+
+// MockpipelineSelector is a mock of pipelineSelector interface.
+type MockpipelineSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineSelectorMockRecorder
+}
+
+// MockpipelineSelectorMockRecorder is the mock recorder for MockpipelineSelector.
+type MockpipelineSelectorMockRecorder struct {
+	mock *MockpipelineSelector
+}
+
+// NewMockpipelineSelector creates a new mock instance.
+func NewMockpipelineSelector(ctrl *gomock.Controller) *MockpipelineSelector {
+	mock := &MockpipelineSelector{ctrl: ctrl}
+	mock.recorder = &MockpipelineSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineSelector) EXPECT() *MockpipelineSelectorMockRecorder {
+	return m.recorder
+}
+
+// Environments mocks base method.
+func (m *MockpipelineSelector) Environments(prompt, help, app string, finalMsgFunc func(int) prompt.PromptConfig) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Environments", prompt, help, app, finalMsgFunc)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Environments indicates an expected call of Environments.
+func (mr *MockpipelineSelectorMockRecorder) Environments(prompt, help, app, finalMsgFunc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environments", reflect.TypeOf((*MockpipelineSelector)(nil).Environments), prompt, help, app, finalMsgFunc)
+}
+
+// MockwsSelector is a mock of wsSelector interface.
+type MockwsSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsSelectorMockRecorder
+}
+
+// MockwsSelectorMockRecorder is the mock recorder for MockwsSelector.
+type MockwsSelectorMockRecorder struct {
+	mock *MockwsSelector
+}
+
+// NewMockwsSelector creates a new mock instance.
+func NewMockwsSelector(ctrl *gomock.Controller) *MockwsSelector {
+	mock := &MockwsSelector{ctrl: ctrl}
+	mock.recorder = &MockwsSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsSelector) EXPECT() *MockwsSelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method.
+func (m *MockwsSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application.
+func (mr *MockwsSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockwsSelector)(nil).Application), varargs...)
+}
+
+// Environment mocks base method.
+func (m *MockwsSelector) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Environment", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Environment indicates an expected call of Environment.
+func (mr *MockwsSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockwsSelector)(nil).Environment), varargs...)
+}
+
+// Job mocks base method.
+func (m *MockwsSelector) Job(prompt, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Job", prompt, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Job indicates an expected call of Job.
+func (mr *MockwsSelectorMockRecorder) Job(prompt, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockwsSelector)(nil).Job), prompt, help)
+}
+
+// Service mocks base method.
+func (m *MockwsSelector) Service(prompt, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", prompt, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockwsSelectorMockRecorder) Service(prompt, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockwsSelector)(nil).Service), prompt, help)
+}
+
+// Workload mocks base method.
+func (m *MockwsSelector) Workload(msg, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Workload", msg, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Workload indicates an expected call of Workload.
+func (mr *MockwsSelectorMockRecorder) Workload(msg, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workload", reflect.TypeOf((*MockwsSelector)(nil).Workload), msg, help)
+}
+
+// MockinitJobSelector is a mock of initJobSelector interface.
+type MockinitJobSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockinitJobSelectorMockRecorder
+}
+
+// MockinitJobSelectorMockRecorder is the mock recorder for MockinitJobSelector.
+type MockinitJobSelectorMockRecorder struct {
+	mock *MockinitJobSelector
+}
+
+// NewMockinitJobSelector creates a new mock instance.
+func NewMockinitJobSelector(ctrl *gomock.Controller) *MockinitJobSelector {
+	mock := &MockinitJobSelector{ctrl: ctrl}
+	mock.recorder = &MockinitJobSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockinitJobSelector) EXPECT() *MockinitJobSelectorMockRecorder {
+	return m.recorder
+}
+
+// Dockerfile mocks base method.
+func (m *MockinitJobSelector) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoundHelp string, pv prompt.ValidatorFunc) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dockerfile", selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Dockerfile indicates an expected call of Dockerfile.
+func (mr *MockinitJobSelectorMockRecorder) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dockerfile", reflect.TypeOf((*MockinitJobSelector)(nil).Dockerfile), selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv)
+}
+
+// Schedule mocks base method.
+func (m *MockinitJobSelector) Schedule(scheduleTypePrompt, scheduleTypeHelp string, scheduleValidator, rateValidator prompt.ValidatorFunc) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Schedule", scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockinitJobSelectorMockRecorder) Schedule(scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockinitJobSelector)(nil).Schedule), scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator)
+}
+
+// MockcfTaskSelector is a mock of cfTaskSelector interface.
+type MockcfTaskSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockcfTaskSelectorMockRecorder
+}
+
+// MockcfTaskSelectorMockRecorder is the mock recorder for MockcfTaskSelector.
+type MockcfTaskSelectorMockRecorder struct {
+	mock *MockcfTaskSelector
+}
+
+// NewMockcfTaskSelector creates a new mock instance.
+func NewMockcfTaskSelector(ctrl *gomock.Controller) *MockcfTaskSelector {
+	mock := &MockcfTaskSelector{ctrl: ctrl}
+	mock.recorder = &MockcfTaskSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcfTaskSelector) EXPECT() *MockcfTaskSelectorMockRecorder {
+	return m.recorder
+}
+
+// Task mocks base method.
+func (m *MockcfTaskSelector) Task(prompt, help string, opts ...selector.GetDeployedTaskOpts) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Task", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Task indicates an expected call of Task.
+func (mr *MockcfTaskSelectorMockRecorder) Task(prompt, help interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Task", reflect.TypeOf((*MockcfTaskSelector)(nil).Task), varargs...)
+}
+
+// MockdockerfileSelector is a mock of dockerfileSelector interface.
+type MockdockerfileSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockdockerfileSelectorMockRecorder
+}
+
+// MockdockerfileSelectorMockRecorder is the mock recorder for MockdockerfileSelector.
+type MockdockerfileSelectorMockRecorder struct {
+	mock *MockdockerfileSelector
+}
+
+// NewMockdockerfileSelector creates a new mock instance.
+func NewMockdockerfileSelector(ctrl *gomock.Controller) *MockdockerfileSelector {
+	mock := &MockdockerfileSelector{ctrl: ctrl}
+	mock.recorder = &MockdockerfileSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdockerfileSelector) EXPECT() *MockdockerfileSelectorMockRecorder {
+	return m.recorder
+}
+
+// Dockerfile mocks base method.
+func (m *MockdockerfileSelector) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoundHelp string, pv prompt.ValidatorFunc) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dockerfile", selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Dockerfile indicates an expected call of Dockerfile.
+func (mr *MockdockerfileSelectorMockRecorder) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dockerfile", reflect.TypeOf((*MockdockerfileSelector)(nil).Dockerfile), selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv)
+}
+
+// Mockec2Selector is a mock of ec2Selector interface.
+type Mockec2Selector struct {
+	ctrl     *gomock.Controller
+	recorder *Mockec2SelectorMockRecorder
+}
+
+// Mockec2SelectorMockRecorder is the mock recorder for Mockec2Selector.
+type Mockec2SelectorMockRecorder struct {
+	mock *Mockec2Selector
+}
+
+// NewMockec2Selector creates a new mock instance.
+func NewMockec2Selector(ctrl *gomock.Controller) *Mockec2Selector {
+	mock := &Mockec2Selector{ctrl: ctrl}
+	mock.recorder = &Mockec2SelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockec2Selector) EXPECT() *Mockec2SelectorMockRecorder {
+	return m.recorder
+}
+
+// PrivateSubnets mocks base method.
+func (m *Mockec2Selector) PrivateSubnets(prompt, help, vpcID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivateSubnets", prompt, help, vpcID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivateSubnets indicates an expected call of PrivateSubnets.
+func (mr *Mockec2SelectorMockRecorder) PrivateSubnets(prompt, help, vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivateSubnets", reflect.TypeOf((*Mockec2Selector)(nil).PrivateSubnets), prompt, help, vpcID)
+}
+
+// PublicSubnets mocks base method.
+func (m *Mockec2Selector) PublicSubnets(prompt, help, vpcID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicSubnets", prompt, help, vpcID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicSubnets indicates an expected call of PublicSubnets.
+func (mr *Mockec2SelectorMockRecorder) PublicSubnets(prompt, help, vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicSubnets", reflect.TypeOf((*Mockec2Selector)(nil).PublicSubnets), prompt, help, vpcID)
+}
+
+// VPC mocks base method.
+func (m *Mockec2Selector) VPC(prompt, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPC", prompt, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPC indicates an expected call of VPC.
+func (mr *Mockec2SelectorMockRecorder) VPC(prompt, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPC", reflect.TypeOf((*Mockec2Selector)(nil).VPC), prompt, help)
+}
+
+// MockcredsSelector is a mock of credsSelector interface.
+type MockcredsSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockcredsSelectorMockRecorder
+}
+
+// MockcredsSelectorMockRecorder is the mock recorder for MockcredsSelector.
+type MockcredsSelectorMockRecorder struct {
+	mock *MockcredsSelector
+}
+
+// NewMockcredsSelector creates a new mock instance.
+func NewMockcredsSelector(ctrl *gomock.Controller) *MockcredsSelector {
+	mock := &MockcredsSelector{ctrl: ctrl}
+	mock.recorder = &MockcredsSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcredsSelector) EXPECT() *MockcredsSelectorMockRecorder {
+	return m.recorder
+}
+
+// Creds mocks base method.
+func (m *MockcredsSelector) Creds(prompt, help string) (*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Creds", prompt, help)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Creds indicates an expected call of Creds.
+func (mr *MockcredsSelectorMockRecorder) Creds(prompt, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Creds", reflect.TypeOf((*MockcredsSelector)(nil).Creds), prompt, help)
+}
+
+// Mockec2Client is a mock of ec2Client interface.
+type Mockec2Client struct {
+	ctrl     *gomock.Controller
+	recorder *Mockec2ClientMockRecorder
+}
+
+// Mockec2ClientMockRecorder is the mock recorder for Mockec2Client.
+type Mockec2ClientMockRecorder struct {
+	mock *Mockec2Client
+}
+
+// NewMockec2Client creates a new mock instance.
+func NewMockec2Client(ctrl *gomock.Controller) *Mockec2Client {
+	mock := &Mockec2Client{ctrl: ctrl}
+	mock.recorder = &Mockec2ClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockec2Client) EXPECT() *Mockec2ClientMockRecorder {
+	return m.recorder
+}
+
+// HasDNSSupport mocks base method.
+func (m *Mockec2Client) HasDNSSupport(vpcID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasDNSSupport", vpcID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasDNSSupport indicates an expected call of HasDNSSupport.
+func (mr *Mockec2ClientMockRecorder) HasDNSSupport(vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDNSSupport", reflect.TypeOf((*Mockec2Client)(nil).HasDNSSupport), vpcID)
+}
+
+// MockjobInitializer is a mock of jobInitializer interface.
+type MockjobInitializer struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobInitializerMockRecorder
+}
+
+// MockjobInitializerMockRecorder is the mock recorder for MockjobInitializer.
+type MockjobInitializerMockRecorder struct {
+	mock *MockjobInitializer
+}
+
+// NewMockjobInitializer creates a new mock instance.
+func NewMockjobInitializer(ctrl *gomock.Controller) *MockjobInitializer {
+	mock := &MockjobInitializer{ctrl: ctrl}
+	mock.recorder = &MockjobInitializerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobInitializer) EXPECT() *MockjobInitializerMockRecorder {
+	return m.recorder
+}
+
+// Job mocks base method.
+func (m *MockjobInitializer) Job(props *initialize.JobProps) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Job", props)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Job indicates an expected call of Job.
+func (mr *MockjobInitializerMockRecorder) Job(props interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockjobInitializer)(nil).Job), props)
+}
+
+// MocksvcInitializer is a mock of svcInitializer interface.
+type MocksvcInitializer struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcInitializerMockRecorder
+}
+
+// MocksvcInitializerMockRecorder is the mock recorder for MocksvcInitializer.
+type MocksvcInitializerMockRecorder struct {
+	mock *MocksvcInitializer
+}
+
+// NewMocksvcInitializer creates a new mock instance.
+func NewMocksvcInitializer(ctrl *gomock.Controller) *MocksvcInitializer {
+	mock := &MocksvcInitializer{ctrl: ctrl}
+	mock.recorder = &MocksvcInitializerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksvcInitializer) EXPECT() *MocksvcInitializerMockRecorder {
+	return m.recorder
+}
+
+// Service mocks base method.
+func (m *MocksvcInitializer) Service(props *initialize.ServiceProps) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", props)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MocksvcInitializerMockRecorder) Service(props interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MocksvcInitializer)(nil).Service), props)
+}
+
+// MocksvcImporter is a mock of svcImporter interface.
+type MocksvcImporter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcImporterMockRecorder
+}
+
+// MocksvcImporterMockRecorder is the mock recorder for MocksvcImporter.
+type MocksvcImporterMockRecorder struct {
+	mock *MocksvcImporter
+}
+
+// NewMocksvcImporter creates a new mock instance.
+func NewMocksvcImporter(ctrl *gomock.Controller) *MocksvcImporter {
+	mock := &MocksvcImporter{ctrl: ctrl}
+	mock.recorder = &MocksvcImporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksvcImporter) EXPECT() *MocksvcImporterMockRecorder {
+	return m.recorder
+}
+
+// ImportService mocks base method.
+func (m *MocksvcImporter) ImportService(props *initialize.WorkloadProps, mf encoding.BinaryMarshaler) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportService", props, mf)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportService indicates an expected call of ImportService.
+func (mr *MocksvcImporterMockRecorder) ImportService(props, mf interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportService", reflect.TypeOf((*MocksvcImporter)(nil).ImportService), props, mf)
+}
+
+// MockecsServiceImportDescriber is a mock of ecsServiceImportDescriber interface.
+type MockecsServiceImportDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsServiceImportDescriberMockRecorder
+}
+
+// MockecsServiceImportDescriberMockRecorder is the mock recorder for MockecsServiceImportDescriber.
+type MockecsServiceImportDescriberMockRecorder struct {
+	mock *MockecsServiceImportDescriber
+}
+
+// NewMockecsServiceImportDescriber creates a new mock instance.
+func NewMockecsServiceImportDescriber(ctrl *gomock.Controller) *MockecsServiceImportDescriber {
+	mock := &MockecsServiceImportDescriber{ctrl: ctrl}
+	mock.recorder = &MockecsServiceImportDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockecsServiceImportDescriber) EXPECT() *MockecsServiceImportDescriberMockRecorder {
+	return m.recorder
+}
+
+// Service mocks base method.
+func (m *MockecsServiceImportDescriber) Service(cluster, service string) (*ecs.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", cluster, service)
+	ret0, _ := ret[0].(*ecs.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockecsServiceImportDescriberMockRecorder) Service(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsServiceImportDescriber)(nil).Service), cluster, service)
+}
+
+// TaskDefinition mocks base method.
+func (m *MockecsServiceImportDescriber) TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskDefinition", taskDefName)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskDefinition indicates an expected call of TaskDefinition.
+func (mr *MockecsServiceImportDescriberMockRecorder) TaskDefinition(taskDefName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MockecsServiceImportDescriber)(nil).TaskDefinition), taskDefName)
+}
+
+// MockecsServiceScalingDescriber is a mock of ecsServiceScalingDescriber interface.
+type MockecsServiceScalingDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsServiceScalingDescriberMockRecorder
+}
+
+// MockecsServiceScalingDescriberMockRecorder is the mock recorder for MockecsServiceScalingDescriber.
+type MockecsServiceScalingDescriberMockRecorder struct {
+	mock *MockecsServiceScalingDescriber
+}
+
+// NewMockecsServiceScalingDescriber creates a new mock instance.
+func NewMockecsServiceScalingDescriber(ctrl *gomock.Controller) *MockecsServiceScalingDescriber {
+	mock := &MockecsServiceScalingDescriber{ctrl: ctrl}
+	mock.recorder = &MockecsServiceScalingDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockecsServiceScalingDescriber) EXPECT() *MockecsServiceScalingDescriberMockRecorder {
+	return m.recorder
+}
+
+// ECSServiceScalableTarget mocks base method.
+func (m *MockecsServiceScalingDescriber) ECSServiceScalableTarget(cluster, service string) (*aas.ScalableTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSServiceScalableTarget", cluster, service)
+	ret0, _ := ret[0].(*aas.ScalableTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSServiceScalableTarget indicates an expected call of ECSServiceScalableTarget.
+func (mr *MockecsServiceScalingDescriberMockRecorder) ECSServiceScalableTarget(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceScalableTarget", reflect.TypeOf((*MockecsServiceScalingDescriber)(nil).ECSServiceScalableTarget), cluster, service)
+}
+
+// ECSServiceScalingPolicies mocks base method.
+func (m *MockecsServiceScalingDescriber) ECSServiceScalingPolicies(cluster, service string) ([]aas.ScalingPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSServiceScalingPolicies", cluster, service)
+	ret0, _ := ret[0].([]aas.ScalingPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSServiceScalingPolicies indicates an expected call of ECSServiceScalingPolicies.
+func (mr *MockecsServiceScalingDescriberMockRecorder) ECSServiceScalingPolicies(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceScalingPolicies", reflect.TypeOf((*MockecsServiceScalingDescriber)(nil).ECSServiceScalingPolicies), cluster, service)
+}
+
+// MocktargetGroupDescriber is a mock of targetGroupDescriber interface.
+type MocktargetGroupDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MocktargetGroupDescriberMockRecorder
+}
+
+// MocktargetGroupDescriberMockRecorder is the mock recorder for MocktargetGroupDescriber.
+type MocktargetGroupDescriberMockRecorder struct {
+	mock *MocktargetGroupDescriber
+}
+
+// NewMocktargetGroupDescriber creates a new mock instance.
+func NewMocktargetGroupDescriber(ctrl *gomock.Controller) *MocktargetGroupDescriber {
+	mock := &MocktargetGroupDescriber{ctrl: ctrl}
+	mock.recorder = &MocktargetGroupDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktargetGroupDescriber) EXPECT() *MocktargetGroupDescriberMockRecorder {
+	return m.recorder
+}
+
+// TargetGroup mocks base method.
+func (m *MocktargetGroupDescriber) TargetGroup(targetGroupARN string) (*elbv2.TargetGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TargetGroup", targetGroupARN)
+	ret0, _ := ret[0].(*elbv2.TargetGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TargetGroup indicates an expected call of TargetGroup.
+func (mr *MocktargetGroupDescriberMockRecorder) TargetGroup(targetGroupARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetGroup", reflect.TypeOf((*MocktargetGroupDescriber)(nil).TargetGroup), targetGroupARN)
+}
+
+// MockroleDeleter is a mock of roleDeleter interface.
+type MockroleDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockroleDeleterMockRecorder
+}
+
+// MockroleDeleterMockRecorder is the mock recorder for MockroleDeleter.
+type MockroleDeleterMockRecorder struct {
+	mock *MockroleDeleter
+}
+
+// NewMockroleDeleter creates a new mock instance.
+func NewMockroleDeleter(ctrl *gomock.Controller) *MockroleDeleter {
+	mock := &MockroleDeleter{ctrl: ctrl}
+	mock.recorder = &MockroleDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockroleDeleter) EXPECT() *MockroleDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteRole mocks base method.
+func (m *MockroleDeleter) DeleteRole(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockroleDeleterMockRecorder) DeleteRole(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockroleDeleter)(nil).DeleteRole), arg0)
+}
+
+// MockserviceDescriber is a mock of serviceDescriber interface.
+type MockserviceDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceDescriberMockRecorder
+}
+
+// MockserviceDescriberMockRecorder is the mock recorder for MockserviceDescriber.
+type MockserviceDescriberMockRecorder struct {
+	mock *MockserviceDescriber
+}
+
+// NewMockserviceDescriber creates a new mock instance.
+func NewMockserviceDescriber(ctrl *gomock.Controller) *MockserviceDescriber {
+	mock := &MockserviceDescriber{ctrl: ctrl}
+	mock.recorder = &MockserviceDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceDescriber) EXPECT() *MockserviceDescriberMockRecorder {
+	return m.recorder
+}
+
+// DescribeService mocks base method.
+func (m *MockserviceDescriber) DescribeService(app, env, svc string) (*ecs0.ServiceDesc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeService", app, env, svc)
+	ret0, _ := ret[0].(*ecs0.ServiceDesc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeService indicates an expected call of DescribeService.
+func (mr *MockserviceDescriberMockRecorder) DescribeService(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockserviceDescriber)(nil).DescribeService), app, env, svc)
+}
+
+// MockmanifestReader is a mock of manifestReader interface.
+type MockmanifestReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockmanifestReaderMockRecorder
+}
+
+// MockmanifestReaderMockRecorder is the mock recorder for MockmanifestReader.
+type MockmanifestReaderMockRecorder struct {
+	mock *MockmanifestReader
+}
+
+// NewMockmanifestReader creates a new mock instance.
+func NewMockmanifestReader(ctrl *gomock.Controller) *MockmanifestReader {
+	mock := &MockmanifestReader{ctrl: ctrl}
+	mock.recorder = &MockmanifestReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmanifestReader) EXPECT() *MockmanifestReaderMockRecorder {
+	return m.recorder
+}
+
+// Read mocks base method.
+func (m *MockmanifestReader) Read(location string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", location)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockmanifestReaderMockRecorder) Read(location interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockmanifestReader)(nil).Read), location)
+}
+
+// MockexecServiceDescriber is a mock of execServiceDescriber interface.
+type MockexecServiceDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockexecServiceDescriberMockRecorder
+}
+
+// MockexecServiceDescriberMockRecorder is the mock recorder for MockexecServiceDescriber.
+type MockexecServiceDescriberMockRecorder struct {
+	mock *MockexecServiceDescriber
+}
+
+// NewMockexecServiceDescriber creates a new mock instance.
+func NewMockexecServiceDescriber(ctrl *gomock.Controller) *MockexecServiceDescriber {
+	mock := &MockexecServiceDescriber{ctrl: ctrl}
+	mock.recorder = &MockexecServiceDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockexecServiceDescriber) EXPECT() *MockexecServiceDescriberMockRecorder {
+	return m.recorder
+}
+
+// DescribeService mocks base method.
+func (m *MockexecServiceDescriber) DescribeService(app, env, svc string) (*ecs0.ServiceDesc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeService", app, env, svc)
+	ret0, _ := ret[0].(*ecs0.ServiceDesc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeService indicates an expected call of DescribeService.
+func (mr *MockexecServiceDescriberMockRecorder) DescribeService(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockexecServiceDescriber)(nil).DescribeService), app, env, svc)
+}
+
+// ExecuteCommandEnabled mocks base method.
+func (m *MockexecServiceDescriber) ExecuteCommandEnabled(app, env, svc string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteCommandEnabled", app, env, svc)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteCommandEnabled indicates an expected call of ExecuteCommandEnabled.
+func (mr *MockexecServiceDescriberMockRecorder) ExecuteCommandEnabled(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommandEnabled", reflect.TypeOf((*MockexecServiceDescriber)(nil).ExecuteCommandEnabled), app, env, svc)
+}
+
+// MockcontainerInsightsChecker is a mock of containerInsightsChecker interface.
+type MockcontainerInsightsChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontainerInsightsCheckerMockRecorder
+}
+
+// MockcontainerInsightsCheckerMockRecorder is the mock recorder for MockcontainerInsightsChecker.
+type MockcontainerInsightsCheckerMockRecorder struct {
+	mock *MockcontainerInsightsChecker
+}
+
+// NewMockcontainerInsightsChecker creates a new mock instance.
+func NewMockcontainerInsightsChecker(ctrl *gomock.Controller) *MockcontainerInsightsChecker {
+	mock := &MockcontainerInsightsChecker{ctrl: ctrl}
+	mock.recorder = &MockcontainerInsightsCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontainerInsightsChecker) EXPECT() *MockcontainerInsightsCheckerMockRecorder {
+	return m.recorder
+}
+
+// ContainerInsightsEnabled mocks base method.
+func (m *MockcontainerInsightsChecker) ContainerInsightsEnabled(cluster string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainerInsightsEnabled", cluster)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainerInsightsEnabled indicates an expected call of ContainerInsightsEnabled.
+func (mr *MockcontainerInsightsCheckerMockRecorder) ContainerInsightsEnabled(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerInsightsEnabled", reflect.TypeOf((*MockcontainerInsightsChecker)(nil).ContainerInsightsEnabled), cluster)
+}
+
+// MocktaskStatsStreamer is a mock of taskStatsStreamer interface.
+type MocktaskStatsStreamer struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskStatsStreamerMockRecorder
+}
+
+// MocktaskStatsStreamerMockRecorder is the mock recorder for MocktaskStatsStreamer.
+type MocktaskStatsStreamerMockRecorder struct {
+	mock *MocktaskStatsStreamer
+}
+
+// NewMocktaskStatsStreamer creates a new mock instance.
+func NewMocktaskStatsStreamer(ctrl *gomock.Controller) *MocktaskStatsStreamer {
+	mock := &MocktaskStatsStreamer{ctrl: ctrl}
+	mock.recorder = &MocktaskStatsStreamerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaskStatsStreamer) EXPECT() *MocktaskStatsStreamerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MocktaskStatsStreamer) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MocktaskStatsStreamerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MocktaskStatsStreamer)(nil).Close))
+}
+
+// Done mocks base method.
+func (m *MocktaskStatsStreamer) Done() <-chan struct{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Done")
+	ret0, _ := ret[0].(<-chan struct{})
+	return ret0
+}
+
+// Done indicates an expected call of Done.
+func (mr *MocktaskStatsStreamerMockRecorder) Done() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Done", reflect.TypeOf((*MocktaskStatsStreamer)(nil).Done))
+}
+
+// Fetch mocks base method.
+func (m *MocktaskStatsStreamer) Fetch() (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch")
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MocktaskStatsStreamerMockRecorder) Fetch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MocktaskStatsStreamer)(nil).Fetch))
+}
+
+// Notify mocks base method.
+func (m *MocktaskStatsStreamer) Notify() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Notify")
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MocktaskStatsStreamerMockRecorder) Notify() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MocktaskStatsStreamer)(nil).Notify))
+}
+
+// Subscribe mocks base method.
+func (m *MocktaskStatsStreamer) Subscribe() <-chan []stream.ECSTaskStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe")
+	ret0, _ := ret[0].(<-chan []stream.ECSTaskStats)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MocktaskStatsStreamerMockRecorder) Subscribe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MocktaskStatsStreamer)(nil).Subscribe))
+}
+
+// MockserviceDeploymentGetter is a mock of serviceDeploymentGetter interface.
+type MockserviceDeploymentGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceDeploymentGetterMockRecorder
+}
+
+// MockserviceDeploymentGetterMockRecorder is the mock recorder for MockserviceDeploymentGetter.
+type MockserviceDeploymentGetterMockRecorder struct {
+	mock *MockserviceDeploymentGetter
+}
+
+// NewMockserviceDeploymentGetter creates a new mock instance.
+func NewMockserviceDeploymentGetter(ctrl *gomock.Controller) *MockserviceDeploymentGetter {
+	mock := &MockserviceDeploymentGetter{ctrl: ctrl}
+	mock.recorder = &MockserviceDeploymentGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceDeploymentGetter) EXPECT() *MockserviceDeploymentGetterMockRecorder {
+	return m.recorder
+}
+
+// LatestDeployment mocks base method.
+func (m *MockserviceDeploymentGetter) LatestDeployment(app, env, svc string) (*ecs.Deployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestDeployment", app, env, svc)
+	ret0, _ := ret[0].(*ecs.Deployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestDeployment indicates an expected call of LatestDeployment.
+func (mr *MockserviceDeploymentGetterMockRecorder) LatestDeployment(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestDeployment", reflect.TypeOf((*MockserviceDeploymentGetter)(nil).LatestDeployment), app, env, svc)
+}
+
+// MockecsCommandExecutor is a mock of ecsCommandExecutor interface.
+type MockecsCommandExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsCommandExecutorMockRecorder
+}
+
+// MockecsCommandExecutorMockRecorder is the mock recorder for MockecsCommandExecutor.
+type MockecsCommandExecutorMockRecorder struct {
+	mock *MockecsCommandExecutor
+}
+
+// NewMockecsCommandExecutor creates a new mock instance.
+func NewMockecsCommandExecutor(ctrl *gomock.Controller) *MockecsCommandExecutor {
+	mock := &MockecsCommandExecutor{ctrl: ctrl}
+	mock.recorder = &MockecsCommandExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockecsCommandExecutor) EXPECT() *MockecsCommandExecutorMockRecorder {
+	return m.recorder
+}
+
+// ExecuteCommand mocks base method.
+func (m *MockecsCommandExecutor) ExecuteCommand(in ecs.ExecuteCommandInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteCommand", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteCommand indicates an expected call of ExecuteCommand.
+func (mr *MockecsCommandExecutorMockRecorder) ExecuteCommand(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockecsCommandExecutor)(nil).ExecuteCommand), in)
+}
+
+// MockssmPortForwarder is a mock of ssmPortForwarder interface.
+type MockssmPortForwarder struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmPortForwarderMockRecorder
+}
+
+// MockssmPortForwarderMockRecorder is the mock recorder for MockssmPortForwarder.
+type MockssmPortForwarderMockRecorder struct {
+	mock *MockssmPortForwarder
+}
+
+// NewMockssmPortForwarder creates a new mock instance.
+func NewMockssmPortForwarder(ctrl *gomock.Controller) *MockssmPortForwarder {
+	mock := &MockssmPortForwarder{ctrl: ctrl}
+	mock.recorder = &MockssmPortForwarderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockssmPortForwarder) EXPECT() *MockssmPortForwarderMockRecorder {
+	return m.recorder
+}
+
+// StartPortForwardingSession mocks base method.
+func (m *MockssmPortForwarder) StartPortForwardingSession(in ssm.PortForwardingSessionInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPortForwardingSession", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartPortForwardingSession indicates an expected call of StartPortForwardingSession.
+func (mr *MockssmPortForwarderMockRecorder) StartPortForwardingSession(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPortForwardingSession", reflect.TypeOf((*MockssmPortForwarder)(nil).StartPortForwardingSession), in)
+}
+
+// MockssmPluginManager is a mock of ssmPluginManager interface.
+type MockssmPluginManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmPluginManagerMockRecorder
+}
+
+// MockssmPluginManagerMockRecorder is the mock recorder for MockssmPluginManager.
+type MockssmPluginManagerMockRecorder struct {
+	mock *MockssmPluginManager
+}
+
+// NewMockssmPluginManager creates a new mock instance.
+func NewMockssmPluginManager(ctrl *gomock.Controller) *MockssmPluginManager {
+	mock := &MockssmPluginManager{ctrl: ctrl}
+	mock.recorder = &MockssmPluginManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockssmPluginManager) EXPECT() *MockssmPluginManagerMockRecorder {
+	return m.recorder
+}
+
+// InstallLatestBinary mocks base method.
+func (m *MockssmPluginManager) InstallLatestBinary() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallLatestBinary")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallLatestBinary indicates an expected call of InstallLatestBinary.
+func (mr *MockssmPluginManagerMockRecorder) InstallLatestBinary() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallLatestBinary", reflect.TypeOf((*MockssmPluginManager)(nil).InstallLatestBinary))
+}
+
+// ValidateBinary mocks base method.
+func (m *MockssmPluginManager) ValidateBinary() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateBinary")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateBinary indicates an expected call of ValidateBinary.
+func (mr *MockssmPluginManagerMockRecorder) ValidateBinary() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBinary", reflect.TypeOf((*MockssmPluginManager)(nil).ValidateBinary))
+}
+
+// MocktaskStopper is a mock of taskStopper interface.
+type MocktaskStopper struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskStopperMockRecorder
+}
+
+// MocktaskStopperMockRecorder is the mock recorder for MocktaskStopper.
+type MocktaskStopperMockRecorder struct {
+	mock *MocktaskStopper
+}
+
+// NewMocktaskStopper creates a new mock instance.
+func NewMocktaskStopper(ctrl *gomock.Controller) *MocktaskStopper {
+	mock := &MocktaskStopper{ctrl: ctrl}
+	mock.recorder = &MocktaskStopperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaskStopper) EXPECT() *MocktaskStopperMockRecorder {
+	return m.recorder
+}
+
+// StopDefaultClusterTasks mocks base method.
+func (m *MocktaskStopper) StopDefaultClusterTasks(familyName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopDefaultClusterTasks", familyName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopDefaultClusterTasks indicates an expected call of StopDefaultClusterTasks.
+func (mr *MocktaskStopperMockRecorder) StopDefaultClusterTasks(familyName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopDefaultClusterTasks", reflect.TypeOf((*MocktaskStopper)(nil).StopDefaultClusterTasks), familyName)
+}
+
+// StopOneOffTasks mocks base method.
+func (m *MocktaskStopper) StopOneOffTasks(app, env, family string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopOneOffTasks", app, env, family)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopOneOffTasks indicates an expected call of StopOneOffTasks.
+func (mr *MocktaskStopperMockRecorder) StopOneOffTasks(app, env, family interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopOneOffTasks", reflect.TypeOf((*MocktaskStopper)(nil).StopOneOffTasks), app, env, family)
+}
+
+// StopWorkloadTasks mocks base method.
+func (m *MocktaskStopper) StopWorkloadTasks(app, env, workload string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopWorkloadTasks", app, env, workload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopWorkloadTasks indicates an expected call of StopWorkloadTasks.
+func (mr *MocktaskStopperMockRecorder) StopWorkloadTasks(app, env, workload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopWorkloadTasks", reflect.TypeOf((*MocktaskStopper)(nil).StopWorkloadTasks), app, env, workload)
+}
+
+// MockserviceLinkedRoleCreator is a mock of serviceLinkedRoleCreator interface.
+type MockserviceLinkedRoleCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceLinkedRoleCreatorMockRecorder
+}
+
+// MockserviceLinkedRoleCreatorMockRecorder is the mock recorder for MockserviceLinkedRoleCreator.
+type MockserviceLinkedRoleCreatorMockRecorder struct {
+	mock *MockserviceLinkedRoleCreator
+}
+
+// NewMockserviceLinkedRoleCreator creates a new mock instance.
+func NewMockserviceLinkedRoleCreator(ctrl *gomock.Controller) *MockserviceLinkedRoleCreator {
+	mock := &MockserviceLinkedRoleCreator{ctrl: ctrl}
+	mock.recorder = &MockserviceLinkedRoleCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceLinkedRoleCreator) EXPECT() *MockserviceLinkedRoleCreatorMockRecorder {
+	return m.recorder
+}
+
+// CreateECSServiceLinkedRole mocks base method.
+func (m *MockserviceLinkedRoleCreator) CreateECSServiceLinkedRole() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateECSServiceLinkedRole")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateECSServiceLinkedRole indicates an expected call of CreateECSServiceLinkedRole.
+func (mr *MockserviceLinkedRoleCreatorMockRecorder) CreateECSServiceLinkedRole() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateECSServiceLinkedRole", reflect.TypeOf((*MockserviceLinkedRoleCreator)(nil).CreateECSServiceLinkedRole))
+}
+
+// MockroleTagsLister is a mock of roleTagsLister interface.
+type MockroleTagsLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockroleTagsListerMockRecorder
+}
+
+// MockroleTagsListerMockRecorder is the mock recorder for MockroleTagsLister.
+type MockroleTagsListerMockRecorder struct {
+	mock *MockroleTagsLister
+}
+
+// NewMockroleTagsLister creates a new mock instance.
+func NewMockroleTagsLister(ctrl *gomock.Controller) *MockroleTagsLister {
+	mock := &MockroleTagsLister{ctrl: ctrl}
+	mock.recorder = &MockroleTagsListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockroleTagsLister) EXPECT() *MockroleTagsListerMockRecorder {
 	return m.recorder
 }
 
-// Environments mocks base method.
-func (m *MockpipelineSelector) Environments(prompt, help, app string, finalMsgFunc func(int) prompt.PromptConfig) ([]string, error) {
+// ListRoleTags mocks base method.
+func (m *MockroleTagsLister) ListRoleTags(arg0 string) (map[string]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Environments", prompt, help, app, finalMsgFunc)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "ListRoleTags", arg0)
+	ret0, _ := ret[0].(map[string]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Environments indicates an expected call of Environments.
-func (mr *MockpipelineSelectorMockRecorder) Environments(prompt, help, app, finalMsgFunc interface{}) *gomock.Call {
+// ListRoleTags indicates an expected call of ListRoleTags.
+func (mr *MockroleTagsListerMockRecorder) ListRoleTags(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environments", reflect.TypeOf((*MockpipelineSelector)(nil).Environments), prompt, help, app, finalMsgFunc)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleTags", reflect.TypeOf((*MockroleTagsLister)(nil).ListRoleTags), arg0)
 }
 
-// MockwsSelector is a mock of wsSelector interface.
-type MockwsSelector struct {
+// MockroleManager is a mock of roleManager interface.
+type MockroleManager struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsSelectorMockRecorder
+	recorder *MockroleManagerMockRecorder
 }
 
-// MockwsSelectorMockRecorder is the mock recorder for MockwsSelector.
-type MockwsSelectorMockRecorder struct {
-	mock *MockwsSelector
+// MockroleManagerMockRecorder is the mock recorder for MockroleManager.
+type MockroleManagerMockRecorder struct {
+	mock *MockroleManager
 }
 
-// NewMockwsSelector creates a new mock instance.
-func NewMockwsSelector(ctrl *gomock.Controller) *MockwsSelector {
-	mock := &MockwsSelector{ctrl: ctrl}
-	mock.recorder = &MockwsSelectorMockRecorder{mock}
+// NewMockroleManager creates a new mock instance.
+func NewMockroleManager(ctrl *gomock.Controller) *MockroleManager {
+	mock := &MockroleManager{ctrl: ctrl}
+	mock.recorder = &MockroleManagerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockwsSelector) EXPECT() *MockwsSelectorMockRecorder {
+func (m *MockroleManager) EXPECT() *MockroleManagerMockRecorder {
 	return m.recorder
 }
 
-// Application mocks base method.
-func (m *MockwsSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+// CreateECSServiceLinkedRole mocks base method.
+func (m *MockroleManager) CreateECSServiceLinkedRole() error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Application", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CreateECSServiceLinkedRole")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Application indicates an expected call of Application.
-func (mr *MockwsSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+// CreateECSServiceLinkedRole indicates an expected call of CreateECSServiceLinkedRole.
+func (mr *MockroleManagerMockRecorder) CreateECSServiceLinkedRole() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockwsSelector)(nil).Application), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateECSServiceLinkedRole", reflect.TypeOf((*MockroleManager)(nil).CreateECSServiceLinkedRole))
 }
 
-// Environment mocks base method.
-func (m *MockwsSelector) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
+// DeleteRole mocks base method.
+func (m *MockroleManager) DeleteRole(arg0 string) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help, app}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Environment", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteRole", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Environment indicates an expected call of Environment.
-func (mr *MockwsSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockroleManagerMockRecorder) DeleteRole(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockwsSelector)(nil).Environment), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockroleManager)(nil).DeleteRole), arg0)
 }
 
-// Job mocks base method.
-func (m *MockwsSelector) Job(prompt, help string) (string, error) {
+// ListRoleTags mocks base method.
+func (m *MockroleManager) ListRoleTags(arg0 string) (map[string]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Job", prompt, help)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ListRoleTags", arg0)
+	ret0, _ := ret[0].(map[string]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Job indicates an expected call of Job.
-func (mr *MockwsSelectorMockRecorder) Job(prompt, help interface{}) *gomock.Call {
+// ListRoleTags indicates an expected call of ListRoleTags.
+func (mr *MockroleManagerMockRecorder) ListRoleTags(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockwsSelector)(nil).Job), prompt, help)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleTags", reflect.TypeOf((*MockroleManager)(nil).ListRoleTags), arg0)
 }
 
-// Service mocks base method.
-func (m *MockwsSelector) Service(prompt, help string) (string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Service", prompt, help)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// MockclusterGetter is a mock of clusterGetter interface.
+type MockclusterGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockclusterGetterMockRecorder
 }
 
-// Service indicates an expected call of Service.
-func (mr *MockwsSelectorMockRecorder) Service(prompt, help interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockwsSelector)(nil).Service), prompt, help)
+// MockclusterGetterMockRecorder is the mock recorder for MockclusterGetter.
+type MockclusterGetterMockRecorder struct {
+	mock *MockclusterGetter
 }
 
-// Workload mocks base method.
-func (m *MockwsSelector) Workload(msg, help string) (string, error) {
+// NewMockclusterGetter creates a new mock instance.
+func NewMockclusterGetter(ctrl *gomock.Controller) *MockclusterGetter {
+	mock := &MockclusterGetter{ctrl: ctrl}
+	mock.recorder = &MockclusterGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockclusterGetter) EXPECT() *MockclusterGetterMockRecorder {
+	return m.recorder
+}
+
+// ClusterARN mocks base method.
+func (m *MockclusterGetter) ClusterARN(app, env string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Workload", msg, help)
+	ret := m.ctrl.Call(m, "ClusterARN", app, env)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Workload indicates an expected call of Workload.
-func (mr *MockwsSelectorMockRecorder) Workload(msg, help interface{}) *gomock.Call {
+// ClusterARN indicates an expected call of ClusterARN.
+func (mr *MockclusterGetterMockRecorder) ClusterARN(app, env interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workload", reflect.TypeOf((*MockwsSelector)(nil).Workload), msg, help)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterARN", reflect.TypeOf((*MockclusterGetter)(nil).ClusterARN), app, env)
 }
 
-// MockinitJobSelector is a mock of initJobSelector interface.
-type MockinitJobSelector struct {
+// MockactivationCreator is a mock of activationCreator interface.
+type MockactivationCreator struct {
 	ctrl     *gomock.Controller
-	recorder *MockinitJobSelectorMockRecorder
+	recorder *MockactivationCreatorMockRecorder
 }
 
-// MockinitJobSelectorMockRecorder is the mock recorder for MockinitJobSelector.
-type MockinitJobSelectorMockRecorder struct {
-	mock *MockinitJobSelector
+// MockactivationCreatorMockRecorder is the mock recorder for MockactivationCreator.
+type MockactivationCreatorMockRecorder struct {
+	mock *MockactivationCreator
 }
 
-// NewMockinitJobSelector creates a new mock instance.
-func NewMockinitJobSelector(ctrl *gomock.Controller) *MockinitJobSelector {
-	mock := &MockinitJobSelector{ctrl: ctrl}
-	mock.recorder = &MockinitJobSelectorMockRecorder{mock}
+// NewMockactivationCreator creates a new mock instance.
+func NewMockactivationCreator(ctrl *gomock.Controller) *MockactivationCreator {
+	mock := &MockactivationCreator{ctrl: ctrl}
+	mock.recorder = &MockactivationCreatorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockinitJobSelector) EXPECT() *MockinitJobSelectorMockRecorder {
+func (m *MockactivationCreator) EXPECT() *MockactivationCreatorMockRecorder {
 	return m.recorder
 }
 
-// Dockerfile mocks base method.
-func (m *MockinitJobSelector) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoundHelp string, pv prompt.ValidatorFunc) (string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Dockerfile", selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Dockerfile indicates an expected call of Dockerfile.
-func (mr *MockinitJobSelectorMockRecorder) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dockerfile", reflect.TypeOf((*MockinitJobSelector)(nil).Dockerfile), selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv)
-}
-
-// Schedule mocks base method.
-func (m *MockinitJobSelector) Schedule(scheduleTypePrompt, scheduleTypeHelp string, scheduleValidator, rateValidator prompt.ValidatorFunc) (string, error) {
+// CreateActivation mocks base method.
+func (m *MockactivationCreator) CreateActivation(iamRole string, registrationLimit int) (*ssm.Activation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Schedule", scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "CreateActivation", iamRole, registrationLimit)
+	ret0, _ := ret[0].(*ssm.Activation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Schedule indicates an expected call of Schedule.
-func (mr *MockinitJobSelectorMockRecorder) Schedule(scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator interface{}) *gomock.Call {
+// CreateActivation indicates an expected call of CreateActivation.
+func (mr *MockactivationCreatorMockRecorder) CreateActivation(iamRole, registrationLimit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockinitJobSelector)(nil).Schedule), scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateActivation", reflect.TypeOf((*MockactivationCreator)(nil).CreateActivation), iamRole, registrationLimit)
 }
 
-// MockcfTaskSelector is a mock of cfTaskSelector interface.
-type MockcfTaskSelector struct {
+// MocksecretReadWriter is a mock of secretReadWriter interface.
+type MocksecretReadWriter struct {
 	ctrl     *gomock.Controller
-	recorder *MockcfTaskSelectorMockRecorder
+	recorder *MocksecretReadWriterMockRecorder
 }
 
-// MockcfTaskSelectorMockRecorder is the mock recorder for MockcfTaskSelector.
-type MockcfTaskSelectorMockRecorder struct {
-	mock *MockcfTaskSelector
+// MocksecretReadWriterMockRecorder is the mock recorder for MocksecretReadWriter.
+type MocksecretReadWriterMockRecorder struct {
+	mock *MocksecretReadWriter
 }
 
-// NewMockcfTaskSelector creates a new mock instance.
-func NewMockcfTaskSelector(ctrl *gomock.Controller) *MockcfTaskSelector {
-	mock := &MockcfTaskSelector{ctrl: ctrl}
-	mock.recorder = &MockcfTaskSelectorMockRecorder{mock}
+// NewMocksecretReadWriter creates a new mock instance.
+func NewMocksecretReadWriter(ctrl *gomock.Controller) *MocksecretReadWriter {
+	mock := &MocksecretReadWriter{ctrl: ctrl}
+	mock.recorder = &MocksecretReadWriterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockcfTaskSelector) EXPECT() *MockcfTaskSelectorMockRecorder {
+func (m *MocksecretReadWriter) EXPECT() *MocksecretReadWriterMockRecorder {
 	return m.recorder
 }
 
-// Task mocks base method.
-func (m *MockcfTaskSelector) Task(prompt, help string, opts ...selector.GetDeployedTaskOpts) (string, error) {
+// PutSecret mocks base method.
+func (m *MocksecretReadWriter) PutSecret(in ssm.PutSecretInput) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Task", varargs...)
+	ret := m.ctrl.Call(m, "PutSecret", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutSecret indicates an expected call of PutSecret.
+func (mr *MocksecretReadWriterMockRecorder) PutSecret(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretReadWriter)(nil).PutSecret), in)
+}
+
+// SecretValue mocks base method.
+func (m *MocksecretReadWriter) SecretValue(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretValue", name)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Task indicates an expected call of Task.
-func (mr *MockcfTaskSelectorMockRecorder) Task(prompt, help interface{}, opts ...interface{}) *gomock.Call {
+// SecretValue indicates an expected call of SecretValue.
+func (mr *MocksecretReadWriterMockRecorder) SecretValue(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Task", reflect.TypeOf((*MockcfTaskSelector)(nil).Task), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretValue", reflect.TypeOf((*MocksecretReadWriter)(nil).SecretValue), name)
 }
 
-// MockdockerfileSelector is a mock of dockerfileSelector interface.
-type MockdockerfileSelector struct {
+// MockstackExistChecker is a mock of stackExistChecker interface.
+type MockstackExistChecker struct {
 	ctrl     *gomock.Controller
-	recorder *MockdockerfileSelectorMockRecorder
+	recorder *MockstackExistCheckerMockRecorder
 }
 
-// MockdockerfileSelectorMockRecorder is the mock recorder for MockdockerfileSelector.
-type MockdockerfileSelectorMockRecorder struct {
-	mock *MockdockerfileSelector
+// MockstackExistCheckerMockRecorder is the mock recorder for MockstackExistChecker.
+type MockstackExistCheckerMockRecorder struct {
+	mock *MockstackExistChecker
 }
 
-// NewMockdockerfileSelector creates a new mock instance.
-func NewMockdockerfileSelector(ctrl *gomock.Controller) *MockdockerfileSelector {
-	mock := &MockdockerfileSelector{ctrl: ctrl}
-	mock.recorder = &MockdockerfileSelectorMockRecorder{mock}
+// NewMockstackExistChecker creates a new mock instance.
+func NewMockstackExistChecker(ctrl *gomock.Controller) *MockstackExistChecker {
+	mock := &MockstackExistChecker{ctrl: ctrl}
+	mock.recorder = &MockstackExistCheckerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockdockerfileSelector) EXPECT() *MockdockerfileSelectorMockRecorder {
+func (m *MockstackExistChecker) EXPECT() *MockstackExistCheckerMockRecorder {
 	return m.recorder
 }
 
-// Dockerfile mocks base method.
-func (m *MockdockerfileSelector) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoundHelp string, pv prompt.ValidatorFunc) (string, error) {
+// Exists mocks base method.
+func (m *MockstackExistChecker) Exists(arg0 string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Dockerfile", selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Exists", arg0)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Dockerfile indicates an expected call of Dockerfile.
-func (mr *MockdockerfileSelectorMockRecorder) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv interface{}) *gomock.Call {
+// Exists indicates an expected call of Exists.
+func (mr *MockstackExistCheckerMockRecorder) Exists(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dockerfile", reflect.TypeOf((*MockdockerfileSelector)(nil).Dockerfile), selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockstackExistChecker)(nil).Exists), arg0)
 }
 
-// Mockec2Selector is a mock of ec2Selector interface.
-type Mockec2Selector struct {
+// MockstackOutputsDescriber is a mock of stackOutputsDescriber interface.
+type MockstackOutputsDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *Mockec2SelectorMockRecorder
+	recorder *MockstackOutputsDescriberMockRecorder
 }
 
-// Mockec2SelectorMockRecorder is the mock recorder for Mockec2Selector.
-type Mockec2SelectorMockRecorder struct {
-	mock *Mockec2Selector
+// MockstackOutputsDescriberMockRecorder is the mock recorder for MockstackOutputsDescriber.
+type MockstackOutputsDescriberMockRecorder struct {
+	mock *MockstackOutputsDescriber
 }
 
-// NewMockec2Selector creates a new mock instance.
-func NewMockec2Selector(ctrl *gomock.Controller) *Mockec2Selector {
-	mock := &Mockec2Selector{ctrl: ctrl}
-	mock.recorder = &Mockec2SelectorMockRecorder{mock}
+// NewMockstackOutputsDescriber creates a new mock instance.
+func NewMockstackOutputsDescriber(ctrl *gomock.Controller) *MockstackOutputsDescriber {
+	mock := &MockstackOutputsDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackOutputsDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockec2Selector) EXPECT() *Mockec2SelectorMockRecorder {
+func (m *MockstackOutputsDescriber) EXPECT() *MockstackOutputsDescriberMockRecorder {
 	return m.recorder
 }
 
-// PrivateSubnets mocks base method.
-func (m *Mockec2Selector) PrivateSubnets(prompt, help, vpcID string) ([]string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PrivateSubnets", prompt, help, vpcID)
-	ret0, _ := ret[0].([]string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// PrivateSubnets indicates an expected call of PrivateSubnets.
-func (mr *Mockec2SelectorMockRecorder) PrivateSubnets(prompt, help, vpcID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivateSubnets", reflect.TypeOf((*Mockec2Selector)(nil).PrivateSubnets), prompt, help, vpcID)
-}
-
-// PublicSubnets mocks base method.
-func (m *Mockec2Selector) PublicSubnets(prompt, help, vpcID string) ([]string, error) {
+// Exists mocks base method.
+func (m *MockstackOutputsDescriber) Exists(arg0 string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PublicSubnets", prompt, help, vpcID)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "Exists", arg0)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PublicSubnets indicates an expected call of PublicSubnets.
-func (mr *Mockec2SelectorMockRecorder) PublicSubnets(prompt, help, vpcID interface{}) *gomock.Call {
+// Exists indicates an expected call of Exists.
+func (mr *MockstackOutputsDescriberMockRecorder) Exists(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicSubnets", reflect.TypeOf((*Mockec2Selector)(nil).PublicSubnets), prompt, help, vpcID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockstackOutputsDescriber)(nil).Exists), arg0)
 }
 
-// VPC mocks base method.
-func (m *Mockec2Selector) VPC(prompt, help string) (string, error) {
+// Outputs mocks base method.
+func (m *MockstackOutputsDescriber) Outputs(stack *cloudformation.Stack) (map[string]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VPC", prompt, help)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Outputs", stack)
+	ret0, _ := ret[0].(map[string]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// VPC indicates an expected call of VPC.
-func (mr *Mockec2SelectorMockRecorder) VPC(prompt, help interface{}) *gomock.Call {
+// Outputs indicates an expected call of Outputs.
+func (mr *MockstackOutputsDescriberMockRecorder) Outputs(stack interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPC", reflect.TypeOf((*Mockec2Selector)(nil).VPC), prompt, help)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockstackOutputsDescriber)(nil).Outputs), stack)
 }
 
-// MockcredsSelector is a mock of credsSelector interface.
-type MockcredsSelector struct {
+// MocklistenerRuleCounter is a mock of listenerRuleCounter interface.
+type MocklistenerRuleCounter struct {
 	ctrl     *gomock.Controller
-	recorder *MockcredsSelectorMockRecorder
+	recorder *MocklistenerRuleCounterMockRecorder
 }
 
-// MockcredsSelectorMockRecorder is the mock recorder for MockcredsSelector.
-type MockcredsSelectorMockRecorder struct {
-	mock *MockcredsSelector
+// MocklistenerRuleCounterMockRecorder is the mock recorder for MocklistenerRuleCounter.
+type MocklistenerRuleCounterMockRecorder struct {
+	mock *MocklistenerRuleCounter
 }
 
-// NewMockcredsSelector creates a new mock instance.
-func NewMockcredsSelector(ctrl *gomock.Controller) *MockcredsSelector {
-	mock := &MockcredsSelector{ctrl: ctrl}
-	mock.recorder = &MockcredsSelectorMockRecorder{mock}
+// NewMocklistenerRuleCounter creates a new mock instance.
+func NewMocklistenerRuleCounter(ctrl *gomock.Controller) *MocklistenerRuleCounter {
+	mock := &MocklistenerRuleCounter{ctrl: ctrl}
+	mock.recorder = &MocklistenerRuleCounterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockcredsSelector) EXPECT() *MockcredsSelectorMockRecorder {
+func (m *MocklistenerRuleCounter) EXPECT() *MocklistenerRuleCounterMockRecorder {
 	return m.recorder
 }
 
-// Creds mocks base method.
-func (m *MockcredsSelector) Creds(prompt, help string) (*session.Session, error) {
+// ListenerRuleCount mocks base method.
+func (m *MocklistenerRuleCounter) ListenerRuleCount(listenerARN string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Creds", prompt, help)
-	ret0, _ := ret[0].(*session.Session)
+	ret := m.ctrl.Call(m, "ListenerRuleCount", listenerARN)
+	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Creds indicates an expected call of Creds.
-func (mr *MockcredsSelectorMockRecorder) Creds(prompt, help interface{}) *gomock.Call {
+// ListenerRuleCount indicates an expected call of ListenerRuleCount.
+func (mr *MocklistenerRuleCounterMockRecorder) ListenerRuleCount(listenerARN interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Creds", reflect.TypeOf((*MockcredsSelector)(nil).Creds), prompt, help)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenerRuleCount", reflect.TypeOf((*MocklistenerRuleCounter)(nil).ListenerRuleCount), listenerARN)
 }
 
-// Mockec2Client is a mock of ec2Client interface.
-type Mockec2Client struct {
+// MockrolePermissionsSimulator is a mock of rolePermissionsSimulator interface.
+type MockrolePermissionsSimulator struct {
 	ctrl     *gomock.Controller
-	recorder *Mockec2ClientMockRecorder
+	recorder *MockrolePermissionsSimulatorMockRecorder
 }
 
-// Mockec2ClientMockRecorder is the mock recorder for Mockec2Client.
-type Mockec2ClientMockRecorder struct {
-	mock *Mockec2Client
+// MockrolePermissionsSimulatorMockRecorder is the mock recorder for MockrolePermissionsSimulator.
+type MockrolePermissionsSimulatorMockRecorder struct {
+	mock *MockrolePermissionsSimulator
 }
 
-// NewMockec2Client creates a new mock instance.
-func NewMockec2Client(ctrl *gomock.Controller) *Mockec2Client {
-	mock := &Mockec2Client{ctrl: ctrl}
-	mock.recorder = &Mockec2ClientMockRecorder{mock}
+// NewMockrolePermissionsSimulator creates a new mock instance.
+func NewMockrolePermissionsSimulator(ctrl *gomock.Controller) *MockrolePermissionsSimulator {
+	mock := &MockrolePermissionsSimulator{ctrl: ctrl}
+	mock.recorder = &MockrolePermissionsSimulatorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockec2Client) EXPECT() *Mockec2ClientMockRecorder {
+func (m *MockrolePermissionsSimulator) EXPECT() *MockrolePermissionsSimulatorMockRecorder {
 	return m.recorder
 }
 
-// HasDNSSupport mocks base method.
-func (m *Mockec2Client) HasDNSSupport(vpcID string) (bool, error) {
+// DeniedPermissions mocks base method.
+func (m *MockrolePermissionsSimulator) DeniedPermissions(roleARN string, perms []iam.Permission) ([]iam.Permission, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HasDNSSupport", vpcID)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "DeniedPermissions", roleARN, perms)
+	ret0, _ := ret[0].([]iam.Permission)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// HasDNSSupport indicates an expected call of HasDNSSupport.
-func (mr *Mockec2ClientMockRecorder) HasDNSSupport(vpcID interface{}) *gomock.Call {
+// DeniedPermissions indicates an expected call of DeniedPermissions.
+func (mr *MockrolePermissionsSimulatorMockRecorder) DeniedPermissions(roleARN, perms interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDNSSupport", reflect.TypeOf((*Mockec2Client)(nil).HasDNSSupport), vpcID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeniedPermissions", reflect.TypeOf((*MockrolePermissionsSimulator)(nil).DeniedPermissions), roleARN, perms)
 }
 
-// MockjobInitializer is a mock of jobInitializer interface.
-type MockjobInitializer struct {
+// MockrunningTaskSelector is a mock of runningTaskSelector interface.
+type MockrunningTaskSelector struct {
 	ctrl     *gomock.Controller
-	recorder *MockjobInitializerMockRecorder
+	recorder *MockrunningTaskSelectorMockRecorder
 }
 
-// MockjobInitializerMockRecorder is the mock recorder for MockjobInitializer.
-type MockjobInitializerMockRecorder struct {
-	mock *MockjobInitializer
+// MockrunningTaskSelectorMockRecorder is the mock recorder for MockrunningTaskSelector.
+type MockrunningTaskSelectorMockRecorder struct {
+	mock *MockrunningTaskSelector
 }
 
-// NewMockjobInitializer creates a new mock instance.
-func NewMockjobInitializer(ctrl *gomock.Controller) *MockjobInitializer {
-	mock := &MockjobInitializer{ctrl: ctrl}
-	mock.recorder = &MockjobInitializerMockRecorder{mock}
+// NewMockrunningTaskSelector creates a new mock instance.
+func NewMockrunningTaskSelector(ctrl *gomock.Controller) *MockrunningTaskSelector {
+	mock := &MockrunningTaskSelector{ctrl: ctrl}
+	mock.recorder = &MockrunningTaskSelectorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockjobInitializer) EXPECT() *MockjobInitializerMockRecorder {
+func (m *MockrunningTaskSelector) EXPECT() *MockrunningTaskSelectorMockRecorder {
 	return m.recorder
 }
 
-// Job mocks base method.
-func (m *MockjobInitializer) Job(props *initialize.JobProps) (string, error) {
+// RunningTask mocks base method.
+func (m *MockrunningTaskSelector) RunningTask(prompt, help string, opts ...selector.TaskOpts) (*ecs.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Job", props)
-	ret0, _ := ret[0].(string)
+	varargs := []interface{}{prompt, help}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunningTask", varargs...)
+	ret0, _ := ret[0].(*ecs.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Job indicates an expected call of Job.
-func (mr *MockjobInitializerMockRecorder) Job(props interface{}) *gomock.Call {
+// RunningTask indicates an expected call of RunningTask.
+func (mr *MockrunningTaskSelectorMockRecorder) RunningTask(prompt, help interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockjobInitializer)(nil).Job), props)
+	varargs := append([]interface{}{prompt, help}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTask", reflect.TypeOf((*MockrunningTaskSelector)(nil).RunningTask), varargs...)
 }
 
-// MocksvcInitializer is a mock of svcInitializer interface.
-type MocksvcInitializer struct {
+// MockdockerEngineValidator is a mock of dockerEngineValidator interface.
+type MockdockerEngineValidator struct {
 	ctrl     *gomock.Controller
-	recorder *MocksvcInitializerMockRecorder
+	recorder *MockdockerEngineValidatorMockRecorder
 }
 
-// MocksvcInitializerMockRecorder is the mock recorder for MocksvcInitializer.
-type MocksvcInitializerMockRecorder struct {
-	mock *MocksvcInitializer
+// MockdockerEngineValidatorMockRecorder is the mock recorder for MockdockerEngineValidator.
+type MockdockerEngineValidatorMockRecorder struct {
+	mock *MockdockerEngineValidator
 }
 
-// NewMocksvcInitializer creates a new mock instance.
-func NewMocksvcInitializer(ctrl *gomock.Controller) *MocksvcInitializer {
-	mock := &MocksvcInitializer{ctrl: ctrl}
-	mock.recorder = &MocksvcInitializerMockRecorder{mock}
+// NewMockdockerEngineValidator creates a new mock instance.
+func NewMockdockerEngineValidator(ctrl *gomock.Controller) *MockdockerEngineValidator {
+	mock := &MockdockerEngineValidator{ctrl: ctrl}
+	mock.recorder = &MockdockerEngineValidatorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocksvcInitializer) EXPECT() *MocksvcInitializerMockRecorder {
+func (m *MockdockerEngineValidator) EXPECT() *MockdockerEngineValidatorMockRecorder {
 	return m.recorder
 }
 
-// Service mocks base method.
-func (m *MocksvcInitializer) Service(props *initialize.ServiceProps) (string, error) {
+// CheckDockerEngineRunning mocks base method.
+func (m *MockdockerEngineValidator) CheckDockerEngineRunning() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Service", props)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CheckDockerEngineRunning")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Service indicates an expected call of Service.
-func (mr *MocksvcInitializerMockRecorder) Service(props interface{}) *gomock.Call {
+// CheckDockerEngineRunning indicates an expected call of CheckDockerEngineRunning.
+func (mr *MockdockerEngineValidatorMockRecorder) CheckDockerEngineRunning() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MocksvcInitializer)(nil).Service), props)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDockerEngineRunning", reflect.TypeOf((*MockdockerEngineValidator)(nil).CheckDockerEngineRunning))
 }
 
-// MockroleDeleter is a mock of roleDeleter interface.
-type MockroleDeleter struct {
+// MockcontainerRuntimeChecker is a mock of containerRuntimeChecker interface.
+type MockcontainerRuntimeChecker struct {
 	ctrl     *gomock.Controller
-	recorder *MockroleDeleterMockRecorder
+	recorder *MockcontainerRuntimeCheckerMockRecorder
 }
 
-// MockroleDeleterMockRecorder is the mock recorder for MockroleDeleter.
-type MockroleDeleterMockRecorder struct {
-	mock *MockroleDeleter
+// MockcontainerRuntimeCheckerMockRecorder is the mock recorder for MockcontainerRuntimeChecker.
+type MockcontainerRuntimeCheckerMockRecorder struct {
+	mock *MockcontainerRuntimeChecker
 }
 
-// NewMockroleDeleter creates a new mock instance.
-func NewMockroleDeleter(ctrl *gomock.Controller) *MockroleDeleter {
-	mock := &MockroleDeleter{ctrl: ctrl}
-	mock.recorder = &MockroleDeleterMockRecorder{mock}
+// NewMockcontainerRuntimeChecker creates a new mock instance.
+func NewMockcontainerRuntimeChecker(ctrl *gomock.Controller) *MockcontainerRuntimeChecker {
+	mock := &MockcontainerRuntimeChecker{ctrl: ctrl}
+	mock.recorder = &MockcontainerRuntimeCheckerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockroleDeleter) EXPECT() *MockroleDeleterMockRecorder {
+func (m *MockcontainerRuntimeChecker) EXPECT() *MockcontainerRuntimeCheckerMockRecorder {
 	return m.recorder
 }
 
-// DeleteRole mocks base method.
-func (m *MockroleDeleter) DeleteRole(arg0 string) error {
+// CheckCredentialHelper mocks base method.
+func (m *MockcontainerRuntimeChecker) CheckCredentialHelper() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRole", arg0)
+	ret := m.ctrl.Call(m, "CheckCredentialHelper")
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteRole indicates an expected call of DeleteRole.
-func (mr *MockroleDeleterMockRecorder) DeleteRole(arg0 interface{}) *gomock.Call {
+// CheckCredentialHelper indicates an expected call of CheckCredentialHelper.
+func (mr *MockcontainerRuntimeCheckerMockRecorder) CheckCredentialHelper() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockroleDeleter)(nil).DeleteRole), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckCredentialHelper", reflect.TypeOf((*MockcontainerRuntimeChecker)(nil).CheckCredentialHelper))
 }
 
-// MockserviceDescriber is a mock of serviceDescriber interface.
-type MockserviceDescriber struct {
-	ctrl     *gomock.Controller
-	recorder *MockserviceDescriberMockRecorder
+// CheckDockerEngineRunning mocks base method.
+func (m *MockcontainerRuntimeChecker) CheckDockerEngineRunning() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDockerEngineRunning")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockserviceDescriberMockRecorder is the mock recorder for MockserviceDescriber.
-type MockserviceDescriberMockRecorder struct {
-	mock *MockserviceDescriber
+// CheckDockerEngineRunning indicates an expected call of CheckDockerEngineRunning.
+func (mr *MockcontainerRuntimeCheckerMockRecorder) CheckDockerEngineRunning() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDockerEngineRunning", reflect.TypeOf((*MockcontainerRuntimeChecker)(nil).CheckDockerEngineRunning))
 }
 
-// NewMockserviceDescriber creates a new mock instance.
-func NewMockserviceDescriber(ctrl *gomock.Controller) *MockserviceDescriber {
-	mock := &MockserviceDescriber{ctrl: ctrl}
-	mock.recorder = &MockserviceDescriberMockRecorder{mock}
-	return mock
+// Engine mocks base method.
+func (m *MockcontainerRuntimeChecker) Engine() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Engine")
+	ret0, _ := ret[0].(string)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockserviceDescriber) EXPECT() *MockserviceDescriberMockRecorder {
-	return m.recorder
+// Engine indicates an expected call of Engine.
+func (mr *MockcontainerRuntimeCheckerMockRecorder) Engine() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Engine", reflect.TypeOf((*MockcontainerRuntimeChecker)(nil).Engine))
 }
 
-// DescribeService mocks base method.
-func (m *MockserviceDescriber) DescribeService(app, env, svc string) (*ecs0.ServiceDesc, error) {
+// Host mocks base method.
+func (m *MockcontainerRuntimeChecker) Host() string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeService", app, env, svc)
-	ret0, _ := ret[0].(*ecs0.ServiceDesc)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Host")
+	ret0, _ := ret[0].(string)
+	return ret0
 }
 
-// DescribeService indicates an expected call of DescribeService.
-func (mr *MockserviceDescriberMockRecorder) DescribeService(app, env, svc interface{}) *gomock.Call {
+// Host indicates an expected call of Host.
+func (mr *MockcontainerRuntimeCheckerMockRecorder) Host() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockserviceDescriber)(nil).DescribeService), app, env, svc)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Host", reflect.TypeOf((*MockcontainerRuntimeChecker)(nil).Host))
 }
 
-// MockecsCommandExecutor is a mock of ecsCommandExecutor interface.
-type MockecsCommandExecutor struct {
+// Mockcodestar is a mock of codestar interface.
+type Mockcodestar struct {
 	ctrl     *gomock.Controller
-	recorder *MockecsCommandExecutorMockRecorder
+	recorder *MockcodestarMockRecorder
 }
 
-// MockecsCommandExecutorMockRecorder is the mock recorder for MockecsCommandExecutor.
-type MockecsCommandExecutorMockRecorder struct {
-	mock *MockecsCommandExecutor
+// MockcodestarMockRecorder is the mock recorder for Mockcodestar.
+type MockcodestarMockRecorder struct {
+	mock *Mockcodestar
 }
 
-// NewMockecsCommandExecutor creates a new mock instance.
-func NewMockecsCommandExecutor(ctrl *gomock.Controller) *MockecsCommandExecutor {
-	mock := &MockecsCommandExecutor{ctrl: ctrl}
-	mock.recorder = &MockecsCommandExecutorMockRecorder{mock}
+// NewMockcodestar creates a new mock instance.
+func NewMockcodestar(ctrl *gomock.Controller) *Mockcodestar {
+	mock := &Mockcodestar{ctrl: ctrl}
+	mock.recorder = &MockcodestarMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockecsCommandExecutor) EXPECT() *MockecsCommandExecutorMockRecorder {
+func (m *Mockcodestar) EXPECT() *MockcodestarMockRecorder {
 	return m.recorder
 }
 
-// ExecuteCommand mocks base method.
-func (m *MockecsCommandExecutor) ExecuteCommand(in ecs.ExecuteCommandInput) error {
+// GetConnectionARN mocks base method.
+func (m *Mockcodestar) GetConnectionARN(arg0 string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExecuteCommand", in)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetConnectionARN", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ExecuteCommand indicates an expected call of ExecuteCommand.
-func (mr *MockecsCommandExecutorMockRecorder) ExecuteCommand(in interface{}) *gomock.Call {
+// GetConnectionARN indicates an expected call of GetConnectionARN.
+func (mr *MockcodestarMockRecorder) GetConnectionARN(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockecsCommandExecutor)(nil).ExecuteCommand), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectionARN", reflect.TypeOf((*Mockcodestar)(nil).GetConnectionARN), arg0)
 }
 
-// MockssmPluginManager is a mock of ssmPluginManager interface.
-type MockssmPluginManager struct {
+// MockpublicIPGetter is a mock of publicIPGetter interface.
+type MockpublicIPGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockssmPluginManagerMockRecorder
+	recorder *MockpublicIPGetterMockRecorder
 }
 
-// MockssmPluginManagerMockRecorder is the mock recorder for MockssmPluginManager.
-type MockssmPluginManagerMockRecorder struct {
-	mock *MockssmPluginManager
+// MockpublicIPGetterMockRecorder is the mock recorder for MockpublicIPGetter.
+type MockpublicIPGetterMockRecorder struct {
+	mock *MockpublicIPGetter
 }
 
-// NewMockssmPluginManager creates a new mock instance.
-func NewMockssmPluginManager(ctrl *gomock.Controller) *MockssmPluginManager {
-	mock := &MockssmPluginManager{ctrl: ctrl}
-	mock.recorder = &MockssmPluginManagerMockRecorder{mock}
+// NewMockpublicIPGetter creates a new mock instance.
+func NewMockpublicIPGetter(ctrl *gomock.Controller) *MockpublicIPGetter {
+	mock := &MockpublicIPGetter{ctrl: ctrl}
+	mock.recorder = &MockpublicIPGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockssmPluginManager) EXPECT() *MockssmPluginManagerMockRecorder {
+func (m *MockpublicIPGetter) EXPECT() *MockpublicIPGetterMockRecorder {
 	return m.recorder
 }
-
-// InstallLatestBinary mocks base method.
-func (m *MockssmPluginManager) InstallLatestBinary() error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InstallLatestBinary")
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// InstallLatestBinary indicates an expected call of InstallLatestBinary.
-func (mr *MockssmPluginManagerMockRecorder) InstallLatestBinary() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallLatestBinary", reflect.TypeOf((*MockssmPluginManager)(nil).InstallLatestBinary))
-}
-
-// ValidateBinary mocks base method.
-func (m *MockssmPluginManager) ValidateBinary() error {
+
+// PublicIP mocks base method.
+func (m *MockpublicIPGetter) PublicIP(ENI string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateBinary")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "PublicIP", ENI)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ValidateBinary indicates an expected call of ValidateBinary.
-func (mr *MockssmPluginManagerMockRecorder) ValidateBinary() *gomock.Call {
+// PublicIP indicates an expected call of PublicIP.
+func (mr *MockpublicIPGetterMockRecorder) PublicIP(ENI interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBinary", reflect.TypeOf((*MockssmPluginManager)(nil).ValidateBinary))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicIP", reflect.TypeOf((*MockpublicIPGetter)(nil).PublicIP), ENI)
 }
 
-// MocktaskStopper is a mock of taskStopper interface.
-type MocktaskStopper struct {
+// MocktargetStore is a mock of targetStore interface.
+type MocktargetStore struct {
 	ctrl     *gomock.Controller
-	recorder *MocktaskStopperMockRecorder
+	recorder *MocktargetStoreMockRecorder
 }
 
-// MocktaskStopperMockRecorder is the mock recorder for MocktaskStopper.
-type MocktaskStopperMockRecorder struct {
-	mock *MocktaskStopper
+// MocktargetStoreMockRecorder is the mock recorder for MocktargetStore.
+type MocktargetStoreMockRecorder struct {
+	mock *MocktargetStore
 }
 
-// NewMocktaskStopper creates a new mock instance.
-func NewMocktaskStopper(ctrl *gomock.Controller) *MocktaskStopper {
-	mock := &MocktaskStopper{ctrl: ctrl}
-	mock.recorder = &MocktaskStopperMockRecorder{mock}
+// NewMocktargetStore creates a new mock instance.
+func NewMocktargetStore(ctrl *gomock.Controller) *MocktargetStore {
+	mock := &MocktargetStore{ctrl: ctrl}
+	mock.recorder = &MocktargetStoreMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocktaskStopper) EXPECT() *MocktaskStopperMockRecorder {
+func (m *MocktargetStore) EXPECT() *MocktargetStoreMockRecorder {
 	return m.recorder
 }
 
-// StopDefaultClusterTasks mocks base method.
-func (m *MocktaskStopper) StopDefaultClusterTasks(familyName string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StopDefaultClusterTasks", familyName)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// StopDefaultClusterTasks indicates an expected call of StopDefaultClusterTasks.
-func (mr *MocktaskStopperMockRecorder) StopDefaultClusterTasks(familyName interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopDefaultClusterTasks", reflect.TypeOf((*MocktaskStopper)(nil).StopDefaultClusterTasks), familyName)
-}
-
-// StopOneOffTasks mocks base method.
-func (m *MocktaskStopper) StopOneOffTasks(app, env, family string) error {
+// LastTarget mocks base method.
+func (m *MocktargetStore) LastTarget(appName, envName string) (*config.Target, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StopOneOffTasks", app, env, family)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "LastTarget", appName, envName)
+	ret0, _ := ret[0].(*config.Target)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// StopOneOffTasks indicates an expected call of StopOneOffTasks.
-func (mr *MocktaskStopperMockRecorder) StopOneOffTasks(app, env, family interface{}) *gomock.Call {
+// LastTarget indicates an expected call of LastTarget.
+func (mr *MocktargetStoreMockRecorder) LastTarget(appName, envName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopOneOffTasks", reflect.TypeOf((*MocktaskStopper)(nil).StopOneOffTasks), app, env, family)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastTarget", reflect.TypeOf((*MocktargetStore)(nil).LastTarget), appName, envName)
 }
 
-// StopWorkloadTasks mocks base method.
-func (m *MocktaskStopper) StopWorkloadTasks(app, env, workload string) error {
+// SaveTarget mocks base method.
+func (m *MocktargetStore) SaveTarget(appName, envName string, target config.Target) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StopWorkloadTasks", app, env, workload)
+	ret := m.ctrl.Call(m, "SaveTarget", appName, envName, target)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// StopWorkloadTasks indicates an expected call of StopWorkloadTasks.
-func (mr *MocktaskStopperMockRecorder) StopWorkloadTasks(app, env, workload interface{}) *gomock.Call {
+// SaveTarget indicates an expected call of SaveTarget.
+func (mr *MocktargetStoreMockRecorder) SaveTarget(appName, envName, target interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopWorkloadTasks", reflect.TypeOf((*MocktaskStopper)(nil).StopWorkloadTasks), app, env, workload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTarget", reflect.TypeOf((*MocktargetStore)(nil).SaveTarget), appName, envName, target)
 }
 
-// MockserviceLinkedRoleCreator is a mock of serviceLinkedRoleCreator interface.
-type MockserviceLinkedRoleCreator struct {
+// MocktargetConfirmer is a mock of targetConfirmer interface.
+type MocktargetConfirmer struct {
 	ctrl     *gomock.Controller
-	recorder *MockserviceLinkedRoleCreatorMockRecorder
+	recorder *MocktargetConfirmerMockRecorder
 }
 
-// MockserviceLinkedRoleCreatorMockRecorder is the mock recorder for MockserviceLinkedRoleCreator.
-type MockserviceLinkedRoleCreatorMockRecorder struct {
-	mock *MockserviceLinkedRoleCreator
+// MocktargetConfirmerMockRecorder is the mock recorder for MocktargetConfirmer.
+type MocktargetConfirmerMockRecorder struct {
+	mock *MocktargetConfirmer
 }
 
-// NewMockserviceLinkedRoleCreator creates a new mock instance.
-func NewMockserviceLinkedRoleCreator(ctrl *gomock.Controller) *MockserviceLinkedRoleCreator {
-	mock := &MockserviceLinkedRoleCreator{ctrl: ctrl}
-	mock.recorder = &MockserviceLinkedRoleCreatorMockRecorder{mock}
+// NewMocktargetConfirmer creates a new mock instance.
+func NewMocktargetConfirmer(ctrl *gomock.Controller) *MocktargetConfirmer {
+	mock := &MocktargetConfirmer{ctrl: ctrl}
+	mock.recorder = &MocktargetConfirmerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockserviceLinkedRoleCreator) EXPECT() *MockserviceLinkedRoleCreatorMockRecorder {
+func (m *MocktargetConfirmer) EXPECT() *MocktargetConfirmerMockRecorder {
 	return m.recorder
 }
 
-// CreateECSServiceLinkedRole mocks base method.
-func (m *MockserviceLinkedRoleCreator) CreateECSServiceLinkedRole() error {
+// Confirm mocks base method.
+func (m *MocktargetConfirmer) Confirm(env *config.Environment, skipConfirmation bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateECSServiceLinkedRole")
+	ret := m.ctrl.Call(m, "Confirm", env, skipConfirmation)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CreateECSServiceLinkedRole indicates an expected call of CreateECSServiceLinkedRole.
-func (mr *MockserviceLinkedRoleCreatorMockRecorder) CreateECSServiceLinkedRole() *gomock.Call {
+// Confirm indicates an expected call of Confirm.
+func (mr *MocktargetConfirmerMockRecorder) Confirm(env, skipConfirmation interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateECSServiceLinkedRole", reflect.TypeOf((*MockserviceLinkedRoleCreator)(nil).CreateECSServiceLinkedRole))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MocktargetConfirmer)(nil).Confirm), env, skipConfirmation)
 }
 
-// MockroleTagsLister is a mock of roleTagsLister interface.
-type MockroleTagsLister struct {
+// MockendpointDescriber is a mock of endpointDescriber interface.
+type MockendpointDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *MockroleTagsListerMockRecorder
+	recorder *MockendpointDescriberMockRecorder
 }
 
-// MockroleTagsListerMockRecorder is the mock recorder for MockroleTagsLister.
-type MockroleTagsListerMockRecorder struct {
-	mock *MockroleTagsLister
+// MockendpointDescriberMockRecorder is the mock recorder for MockendpointDescriber.
+type MockendpointDescriberMockRecorder struct {
+	mock *MockendpointDescriber
 }
 
-// NewMockroleTagsLister creates a new mock instance.
-func NewMockroleTagsLister(ctrl *gomock.Controller) *MockroleTagsLister {
-	mock := &MockroleTagsLister{ctrl: ctrl}
-	mock.recorder = &MockroleTagsListerMockRecorder{mock}
+// NewMockendpointDescriber creates a new mock instance.
+func NewMockendpointDescriber(ctrl *gomock.Controller) *MockendpointDescriber {
+	mock := &MockendpointDescriber{ctrl: ctrl}
+	mock.recorder = &MockendpointDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockroleTagsLister) EXPECT() *MockroleTagsListerMockRecorder {
+func (m *MockendpointDescriber) EXPECT() *MockendpointDescriberMockRecorder {
 	return m.recorder
 }
 
-// ListRoleTags mocks base method.
-func (m *MockroleTagsLister) ListRoleTags(arg0 string) (map[string]string, error) {
+// URI mocks base method.
+func (m *MockendpointDescriber) URI(envName string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRoleTags", arg0)
-	ret0, _ := ret[0].(map[string]string)
+	ret := m.ctrl.Call(m, "URI", envName)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListRoleTags indicates an expected call of ListRoleTags.
-func (mr *MockroleTagsListerMockRecorder) ListRoleTags(arg0 interface{}) *gomock.Call {
+// URI indicates an expected call of URI.
+func (mr *MockendpointDescriberMockRecorder) URI(envName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleTags", reflect.TypeOf((*MockroleTagsLister)(nil).ListRoleTags), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URI", reflect.TypeOf((*MockendpointDescriber)(nil).URI), envName)
 }
 
-// MockroleManager is a mock of roleManager interface.
-type MockroleManager struct {
+// MocksuiteVerifier is a mock of suiteVerifier interface.
+type MocksuiteVerifier struct {
 	ctrl     *gomock.Controller
-	recorder *MockroleManagerMockRecorder
+	recorder *MocksuiteVerifierMockRecorder
 }
 
-// MockroleManagerMockRecorder is the mock recorder for MockroleManager.
-type MockroleManagerMockRecorder struct {
-	mock *MockroleManager
+// MocksuiteVerifierMockRecorder is the mock recorder for MocksuiteVerifier.
+type MocksuiteVerifierMockRecorder struct {
+	mock *MocksuiteVerifier
 }
 
-// NewMockroleManager creates a new mock instance.
-func NewMockroleManager(ctrl *gomock.Controller) *MockroleManager {
-	mock := &MockroleManager{ctrl: ctrl}
-	mock.recorder = &MockroleManagerMockRecorder{mock}
+// NewMocksuiteVerifier creates a new mock instance.
+func NewMocksuiteVerifier(ctrl *gomock.Controller) *MocksuiteVerifier {
+	mock := &MocksuiteVerifier{ctrl: ctrl}
+	mock.recorder = &MocksuiteVerifierMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockroleManager) EXPECT() *MockroleManagerMockRecorder {
+func (m *MocksuiteVerifier) EXPECT() *MocksuiteVerifierMockRecorder {
 	return m.recorder
 }
 
-// CreateECSServiceLinkedRole mocks base method.
-func (m *MockroleManager) CreateECSServiceLinkedRole() error {
+// Run mocks base method.
+func (m *MocksuiteVerifier) Run(ctx context.Context, baseURL string, suite verify.Suite) verify.Report {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateECSServiceLinkedRole")
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "Run", ctx, baseURL, suite)
+	ret0, _ := ret[0].(verify.Report)
 	return ret0
 }
 
-// CreateECSServiceLinkedRole indicates an expected call of CreateECSServiceLinkedRole.
-func (mr *MockroleManagerMockRecorder) CreateECSServiceLinkedRole() *gomock.Call {
+// Run indicates an expected call of Run.
+func (mr *MocksuiteVerifierMockRecorder) Run(ctx, baseURL, suite interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateECSServiceLinkedRole", reflect.TypeOf((*MockroleManager)(nil).CreateECSServiceLinkedRole))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocksuiteVerifier)(nil).Run), ctx, baseURL, suite)
 }
 
-// DeleteRole mocks base method.
-func (m *MockroleManager) DeleteRole(arg0 string) error {
+// MockmaintenanceSwitcher is a mock of maintenanceSwitcher interface.
+type MockmaintenanceSwitcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockmaintenanceSwitcherMockRecorder
+}
+
+// MockmaintenanceSwitcherMockRecorder is the mock recorder for MockmaintenanceSwitcher.
+type MockmaintenanceSwitcherMockRecorder struct {
+	mock *MockmaintenanceSwitcher
+}
+
+// NewMockmaintenanceSwitcher creates a new mock instance.
+func NewMockmaintenanceSwitcher(ctrl *gomock.Controller) *MockmaintenanceSwitcher {
+	mock := &MockmaintenanceSwitcher{ctrl: ctrl}
+	mock.recorder = &MockmaintenanceSwitcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmaintenanceSwitcher) EXPECT() *MockmaintenanceSwitcherMockRecorder {
+	return m.recorder
+}
+
+// Disable mocks base method.
+func (m *MockmaintenanceSwitcher) Disable(app, env, svc string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRole", arg0)
+	ret := m.ctrl.Call(m, "Disable", app, env, svc)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteRole indicates an expected call of DeleteRole.
-func (mr *MockroleManagerMockRecorder) DeleteRole(arg0 interface{}) *gomock.Call {
+// Disable indicates an expected call of Disable.
+func (mr *MockmaintenanceSwitcherMockRecorder) Disable(app, env, svc interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockroleManager)(nil).DeleteRole), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockmaintenanceSwitcher)(nil).Disable), app, env, svc)
 }
 
-// ListRoleTags mocks base method.
-func (m *MockroleManager) ListRoleTags(arg0 string) (map[string]string, error) {
+// Enable mocks base method.
+func (m *MockmaintenanceSwitcher) Enable(app, env, svc, body string, until time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRoleTags", arg0)
-	ret0, _ := ret[0].(map[string]string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Enable", app, env, svc, body, until)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListRoleTags indicates an expected call of ListRoleTags.
-func (mr *MockroleManagerMockRecorder) ListRoleTags(arg0 interface{}) *gomock.Call {
+// Enable indicates an expected call of Enable.
+func (mr *MockmaintenanceSwitcherMockRecorder) Enable(app, env, svc, body, until interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleTags", reflect.TypeOf((*MockroleManager)(nil).ListRoleTags), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enable", reflect.TypeOf((*MockmaintenanceSwitcher)(nil).Enable), app, env, svc, body, until)
 }
 
-// MockstackExistChecker is a mock of stackExistChecker interface.
-type MockstackExistChecker struct {
+// MocktaskDefDescriber is a mock of taskDefDescriber interface.
+type MocktaskDefDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *MockstackExistCheckerMockRecorder
+	recorder *MocktaskDefDescriberMockRecorder
 }
 
-// MockstackExistCheckerMockRecorder is the mock recorder for MockstackExistChecker.
-type MockstackExistCheckerMockRecorder struct {
-	mock *MockstackExistChecker
+// MocktaskDefDescriberMockRecorder is the mock recorder for MocktaskDefDescriber.
+type MocktaskDefDescriberMockRecorder struct {
+	mock *MocktaskDefDescriber
 }
 
-// NewMockstackExistChecker creates a new mock instance.
-func NewMockstackExistChecker(ctrl *gomock.Controller) *MockstackExistChecker {
-	mock := &MockstackExistChecker{ctrl: ctrl}
-	mock.recorder = &MockstackExistCheckerMockRecorder{mock}
+// NewMocktaskDefDescriber creates a new mock instance.
+func NewMocktaskDefDescriber(ctrl *gomock.Controller) *MocktaskDefDescriber {
+	mock := &MocktaskDefDescriber{ctrl: ctrl}
+	mock.recorder = &MocktaskDefDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockstackExistChecker) EXPECT() *MockstackExistCheckerMockRecorder {
+func (m *MocktaskDefDescriber) EXPECT() *MocktaskDefDescriberMockRecorder {
 	return m.recorder
 }
 
-// Exists mocks base method.
-func (m *MockstackExistChecker) Exists(arg0 string) (bool, error) {
+// TaskDefinition mocks base method.
+func (m *MocktaskDefDescriber) TaskDefinition(app, env, svc string) (*ecs.TaskDefinition, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Exists", arg0)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "TaskDefinition", app, env, svc)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Exists indicates an expected call of Exists.
-func (mr *MockstackExistCheckerMockRecorder) Exists(arg0 interface{}) *gomock.Call {
+// TaskDefinition indicates an expected call of TaskDefinition.
+func (mr *MocktaskDefDescriberMockRecorder) TaskDefinition(app, env, svc interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockstackExistChecker)(nil).Exists), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MocktaskDefDescriber)(nil).TaskDefinition), app, env, svc)
 }
 
-// MockrunningTaskSelector is a mock of runningTaskSelector interface.
-type MockrunningTaskSelector struct {
+// MocksecretGetter is a mock of secretGetter interface.
+type MocksecretGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockrunningTaskSelectorMockRecorder
+	recorder *MocksecretGetterMockRecorder
 }
 
-// MockrunningTaskSelectorMockRecorder is the mock recorder for MockrunningTaskSelector.
-type MockrunningTaskSelectorMockRecorder struct {
-	mock *MockrunningTaskSelector
+// MocksecretGetterMockRecorder is the mock recorder for MocksecretGetter.
+type MocksecretGetterMockRecorder struct {
+	mock *MocksecretGetter
 }
 
-// NewMockrunningTaskSelector creates a new mock instance.
-func NewMockrunningTaskSelector(ctrl *gomock.Controller) *MockrunningTaskSelector {
-	mock := &MockrunningTaskSelector{ctrl: ctrl}
-	mock.recorder = &MockrunningTaskSelectorMockRecorder{mock}
+// NewMocksecretGetter creates a new mock instance.
+func NewMocksecretGetter(ctrl *gomock.Controller) *MocksecretGetter {
+	mock := &MocksecretGetter{ctrl: ctrl}
+	mock.recorder = &MocksecretGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockrunningTaskSelector) EXPECT() *MockrunningTaskSelectorMockRecorder {
+func (m *MocksecretGetter) EXPECT() *MocksecretGetterMockRecorder {
 	return m.recorder
 }
 
-// RunningTask mocks base method.
-func (m *MockrunningTaskSelector) RunningTask(prompt, help string, opts ...selector.TaskOpts) (*ecs.Task, error) {
+// SecretValue mocks base method.
+func (m *MocksecretGetter) SecretValue(name string) (string, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "RunningTask", varargs...)
-	ret0, _ := ret[0].(*ecs.Task)
+	ret := m.ctrl.Call(m, "SecretValue", name)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RunningTask indicates an expected call of RunningTask.
-func (mr *MockrunningTaskSelectorMockRecorder) RunningTask(prompt, help interface{}, opts ...interface{}) *gomock.Call {
+// SecretValue indicates an expected call of SecretValue.
+func (mr *MocksecretGetterMockRecorder) SecretValue(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTask", reflect.TypeOf((*MockrunningTaskSelector)(nil).RunningTask), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretValue", reflect.TypeOf((*MocksecretGetter)(nil).SecretValue), name)
 }
 
-// MockdockerEngineValidator is a mock of dockerEngineValidator interface.
-type MockdockerEngineValidator struct {
+// MocklocalContainerRunner is a mock of localContainerRunner interface.
+type MocklocalContainerRunner struct {
 	ctrl     *gomock.Controller
-	recorder *MockdockerEngineValidatorMockRecorder
+	recorder *MocklocalContainerRunnerMockRecorder
 }
 
-// MockdockerEngineValidatorMockRecorder is the mock recorder for MockdockerEngineValidator.
-type MockdockerEngineValidatorMockRecorder struct {
-	mock *MockdockerEngineValidator
+// MocklocalContainerRunnerMockRecorder is the mock recorder for MocklocalContainerRunner.
+type MocklocalContainerRunnerMockRecorder struct {
+	mock *MocklocalContainerRunner
 }
 
-// NewMockdockerEngineValidator creates a new mock instance.
-func NewMockdockerEngineValidator(ctrl *gomock.Controller) *MockdockerEngineValidator {
-	mock := &MockdockerEngineValidator{ctrl: ctrl}
-	mock.recorder = &MockdockerEngineValidatorMockRecorder{mock}
+// NewMocklocalContainerRunner creates a new mock instance.
+func NewMocklocalContainerRunner(ctrl *gomock.Controller) *MocklocalContainerRunner {
+	mock := &MocklocalContainerRunner{ctrl: ctrl}
+	mock.recorder = &MocklocalContainerRunnerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockdockerEngineValidator) EXPECT() *MockdockerEngineValidatorMockRecorder {
+func (m *MocklocalContainerRunner) EXPECT() *MocklocalContainerRunnerMockRecorder {
 	return m.recorder
 }
 
-// CheckDockerEngineRunning mocks base method.
-func (m *MockdockerEngineValidator) CheckDockerEngineRunning() error {
+// Build mocks base method.
+func (m *MocklocalContainerRunner) Build(args *exec.BuildArguments) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckDockerEngineRunning")
+	ret := m.ctrl.Call(m, "Build", args)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CheckDockerEngineRunning indicates an expected call of CheckDockerEngineRunning.
-func (mr *MockdockerEngineValidatorMockRecorder) CheckDockerEngineRunning() *gomock.Call {
+// Build indicates an expected call of Build.
+func (mr *MocklocalContainerRunnerMockRecorder) Build(args interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDockerEngineRunning", reflect.TypeOf((*MockdockerEngineValidator)(nil).CheckDockerEngineRunning))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MocklocalContainerRunner)(nil).Build), args)
 }
 
-// Mockcodestar is a mock of codestar interface.
-type Mockcodestar struct {
-	ctrl     *gomock.Controller
-	recorder *MockcodestarMockRecorder
+// FollowContainerLogs mocks base method.
+func (m *MocklocalContainerRunner) FollowContainerLogs(name string, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowContainerLogs", name, w)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockcodestarMockRecorder is the mock recorder for Mockcodestar.
-type MockcodestarMockRecorder struct {
-	mock *Mockcodestar
+// FollowContainerLogs indicates an expected call of FollowContainerLogs.
+func (mr *MocklocalContainerRunnerMockRecorder) FollowContainerLogs(name, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowContainerLogs", reflect.TypeOf((*MocklocalContainerRunner)(nil).FollowContainerLogs), name, w)
 }
 
-// NewMockcodestar creates a new mock instance.
-func NewMockcodestar(ctrl *gomock.Controller) *Mockcodestar {
-	mock := &Mockcodestar{ctrl: ctrl}
-	mock.recorder = &MockcodestarMockRecorder{mock}
-	return mock
+// RunContainer mocks base method.
+func (m *MocklocalContainerRunner) RunContainer(in *exec.RunContainerInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunContainer", in)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockcodestar) EXPECT() *MockcodestarMockRecorder {
-	return m.recorder
+// RunContainer indicates an expected call of RunContainer.
+func (mr *MocklocalContainerRunnerMockRecorder) RunContainer(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunContainer", reflect.TypeOf((*MocklocalContainerRunner)(nil).RunContainer), in)
 }
 
-// GetConnectionARN mocks base method.
-func (m *Mockcodestar) GetConnectionARN(arg0 string) (string, error) {
+// StopContainer mocks base method.
+func (m *MocklocalContainerRunner) StopContainer(name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetConnectionARN", arg0)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "StopContainer", name)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetConnectionARN indicates an expected call of GetConnectionARN.
-func (mr *MockcodestarMockRecorder) GetConnectionARN(arg0 interface{}) *gomock.Call {
+// StopContainer indicates an expected call of StopContainer.
+func (mr *MocklocalContainerRunnerMockRecorder) StopContainer(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectionARN", reflect.TypeOf((*Mockcodestar)(nil).GetConnectionARN), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopContainer", reflect.TypeOf((*MocklocalContainerRunner)(nil).StopContainer), name)
 }
 
-// MockpublicIPGetter is a mock of publicIPGetter interface.
-type MockpublicIPGetter struct {
+// MockfileWatcher is a mock of fileWatcher interface.
+type MockfileWatcher struct {
 	ctrl     *gomock.Controller
-	recorder *MockpublicIPGetterMockRecorder
+	recorder *MockfileWatcherMockRecorder
 }
 
-// MockpublicIPGetterMockRecorder is the mock recorder for MockpublicIPGetter.
-type MockpublicIPGetterMockRecorder struct {
-	mock *MockpublicIPGetter
+// MockfileWatcherMockRecorder is the mock recorder for MockfileWatcher.
+type MockfileWatcherMockRecorder struct {
+	mock *MockfileWatcher
 }
 
-// NewMockpublicIPGetter creates a new mock instance.
-func NewMockpublicIPGetter(ctrl *gomock.Controller) *MockpublicIPGetter {
-	mock := &MockpublicIPGetter{ctrl: ctrl}
-	mock.recorder = &MockpublicIPGetterMockRecorder{mock}
+// NewMockfileWatcher creates a new mock instance.
+func NewMockfileWatcher(ctrl *gomock.Controller) *MockfileWatcher {
+	mock := &MockfileWatcher{ctrl: ctrl}
+	mock.recorder = &MockfileWatcherMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockpublicIPGetter) EXPECT() *MockpublicIPGetterMockRecorder {
+func (m *MockfileWatcher) EXPECT() *MockfileWatcherMockRecorder {
 	return m.recorder
 }
 
-// PublicIP mocks base method.
-func (m *MockpublicIPGetter) PublicIP(ENI string) (string, error) {
+// Changed mocks base method.
+func (m *MockfileWatcher) Changed() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PublicIP", ENI)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Changed")
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PublicIP indicates an expected call of PublicIP.
-func (mr *MockpublicIPGetterMockRecorder) PublicIP(ENI interface{}) *gomock.Call {
+// Changed indicates an expected call of Changed.
+func (mr *MockfileWatcherMockRecorder) Changed() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicIP", reflect.TypeOf((*MockpublicIPGetter)(nil).PublicIP), ENI)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Changed", reflect.TypeOf((*MockfileWatcher)(nil).Changed))
 }
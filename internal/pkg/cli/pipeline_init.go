@@ -43,6 +43,14 @@ Pushing to this repository will trigger your pipeline build stage.
 Please enter full repository URL, e.g. "https://github.com/myCompany/myRepo", or the owner/rep, e.g. "myCompany/myRepo"`
 )
 
+// Pipeline providers.
+const (
+	pipelineProviderCodePipeline  = "codepipeline"
+	pipelineProviderGitHubActions = "github-actions"
+)
+
+var pipelineProviders = []string{pipelineProviderCodePipeline, pipelineProviderGitHubActions}
+
 const (
 	buildspecTemplatePath = "cicd/buildspec.yml"
 	fmtPipelineName       = "pipeline-%s-%s" // Ex: "pipeline-appName-repoName"
@@ -60,6 +68,12 @@ const (
 	bbURL           = "bitbucket.org"
 	defaultBBBranch = "master"
 	fmtBBRepoURL    = "https://%s@%s/%s/%s" // Ex: "https://repoOwner@bitbucket.org/repoOwner/repoName
+	// For a GitHub Actions workflow.
+	githubActionsTemplatePath    = "cicd/github-actions.yml"
+	fmtGitHubActionsWorkflowName = "copilot-%s"          // Ex: "copilot-appName"
+	fmtGitHubActionsWorkflowFile = "copilot-%s.yml"      // Ex: "copilot-appName.yml"
+	fmtGitHubActionsJobID        = "deploy-%s"           // Ex: "deploy-envName"
+	fmtGitHubActionsRoleSecret   = "COPILOT_%s_ROLE_ARN" // Ex: "COPILOT_ENVNAME_ROLE_ARN"
 )
 
 var (
@@ -73,6 +87,7 @@ type initPipelineVars struct {
 	repoURL           string
 	repoBranch        string
 	githubAccessToken string
+	cicdProvider      string
 }
 
 type initPipelineOpts struct {
@@ -94,6 +109,7 @@ type initPipelineOpts struct {
 	repoName  string
 	repoOwner string
 	ccRegion  string
+	appRegion string
 
 	// Caches variables
 	fs         *afero.Afero
@@ -101,6 +117,22 @@ type initPipelineOpts struct {
 	envConfigs []*config.Environment
 }
 
+type githubActionsWorkflow struct {
+	Name               string
+	Branch             string
+	Region             string
+	BinaryS3BucketPath string
+	Version            string
+	Stages             []githubActionsStage
+}
+
+type githubActionsStage struct {
+	Name           string
+	JobID          string
+	Needs          string // ID of the job of the previous stage.
+	RoleSecretName string
+}
+
 type artifactBucket struct {
 	BucketName   string
 	Region       string
@@ -154,8 +186,11 @@ func (o *initPipelineOpts) Validate() error {
 	if _, err := o.store.GetApplication(o.appName); err != nil {
 		return err
 	}
+	if err := validatePipelineProvider(o.cicdProvider); err != nil {
+		return err
+	}
 
-	if o.repoURL != "" {
+	if o.repoURL != "" && o.cicdProvider == pipelineProviderCodePipeline {
 		if err := o.validateURL(o.repoURL); err != nil {
 			return err
 		}
@@ -178,14 +213,20 @@ func (o *initPipelineOpts) Ask() error {
 	if err := o.askEnvs(); err != nil {
 		return err
 	}
+	if o.cicdProvider == pipelineProviderGitHubActions {
+		return o.askGitHubActionsDetails()
+	}
 	if err := o.askRepository(); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Execute writes the pipeline manifest file.
+// Execute writes the pipeline manifest file, or the GitHub Actions workflow.
 func (o *initPipelineOpts) Execute() error {
+	if o.cicdProvider == pipelineProviderGitHubActions {
+		return o.createGitHubActionsWorkflow()
+	}
 	if o.provider == manifest.GithubV1ProviderName {
 		if err := o.storeGitHubAccessToken(); err != nil {
 			return err
@@ -207,6 +248,16 @@ func (o *initPipelineOpts) Execute() error {
 
 // RequiredActions returns follow-up actions the user must take after successfully executing the command.
 func (o *initPipelineOpts) RequiredActions() []string {
+	if o.cicdProvider == pipelineProviderGitHubActions {
+		var secrets []string
+		for _, stage := range o.githubActionsStages() {
+			secrets = append(secrets, color.HighlightUserInput(stage.RoleSecretName))
+		}
+		return []string{
+			fmt.Sprintf("Create IAM roles that trust GitHub's OIDC provider %s and store their ARNs in the repository secrets %s.", color.HighlightResource("token.actions.githubusercontent.com"), strings.Join(secrets, ", ")),
+			fmt.Sprintf("Commit and push the %s directory and the %s file to your repository to start deploying.", color.HighlightResource(".github/workflows"), color.HighlightResource("copilot/.workspace")),
+		}
+	}
 	return []string{
 		fmt.Sprintf("Commit and push the %s, %s, and %s files of your %s directory to your repository.", color.HighlightResource("buildspec.yml"), color.HighlightResource("pipeline.yml"), color.HighlightResource(".workspace"), color.HighlightResource("copilot")),
 		fmt.Sprintf("Run %s to create your pipeline.", color.HighlightCode("copilot pipeline update")),
@@ -246,6 +297,19 @@ func (o *initPipelineOpts) askEnvs() error {
 	return nil
 }
 
+func (o *initPipelineOpts) askGitHubActionsDetails() error {
+	if o.repoBranch == "" {
+		o.repoBranch = defaultGHBranch
+	}
+	// The workflow runs copilot against the application's region, where its configuration is stored.
+	sess, err := o.sessProvider.Default()
+	if err != nil {
+		return fmt.Errorf("retrieve default session: %w", err)
+	}
+	o.appRegion = aws.StringValue(sess.Config.Region)
+	return nil
+}
+
 func (o *initPipelineOpts) askRepository() error {
 	var err error
 	if o.repoURL == "" {
@@ -576,6 +640,59 @@ Update the %s phase to unit test your services before pushing the images.
 	return nil
 }
 
+func (o *initPipelineOpts) createGitHubActionsWorkflow() error {
+	content, err := o.parser.Parse(githubActionsTemplatePath, githubActionsWorkflow{
+		Name:               fmt.Sprintf(fmtGitHubActionsWorkflowName, o.appName),
+		Branch:             o.repoBranch,
+		Region:             o.appRegion,
+		BinaryS3BucketPath: binaryS3BucketPath,
+		Version:            version.Version,
+		Stages:             o.githubActionsStages(),
+	})
+	if err != nil {
+		return err
+	}
+	workflowPath, err := o.workspace.WriteGitHubActionsWorkflow(content, fmt.Sprintf(fmtGitHubActionsWorkflowFile, o.appName))
+	var workflowExists bool
+	if err != nil {
+		e, ok := err.(*workspace.ErrFileExists)
+		if !ok {
+			return fmt.Errorf("write github actions workflow to workspace: %w", err)
+		}
+		workflowExists = true
+		workflowPath = e.FileName
+	}
+	workflowMsgFmt := "Wrote the GitHub Actions workflow at '%s'\n"
+	if workflowExists {
+		workflowMsgFmt = "GitHub Actions workflow already exists at %s, skipping writing it.\n"
+	}
+	workflowPath, err = relPath(workflowPath)
+	if err != nil {
+		return err
+	}
+	log.Successf(workflowMsgFmt, color.HighlightResource(workflowPath))
+	log.Infof(`The workflow deploys your services and jobs to each environment in order on every push to %s.
+Add required reviewers to the GitHub environments of your production stages to approve their deployments manually.
+`, color.HighlightUserInput(o.repoBranch))
+	return nil
+}
+
+func (o *initPipelineOpts) githubActionsStages() []githubActionsStage {
+	var stages []githubActionsStage
+	for i, env := range o.envConfigs {
+		stage := githubActionsStage{
+			Name:           env.Name,
+			JobID:          fmt.Sprintf(fmtGitHubActionsJobID, env.Name),
+			RoleSecretName: fmt.Sprintf(fmtGitHubActionsRoleSecret, strings.ToUpper(strings.ReplaceAll(env.Name, "-", "_"))),
+		}
+		if i > 0 {
+			stage.Needs = stages[i-1].JobID
+		}
+		stages = append(stages, stage)
+	}
+	return stages
+}
+
 func (o *initPipelineOpts) secretName() string {
 	return fmt.Sprintf(fmtSecretName, o.appName, o.repoName)
 }
@@ -652,12 +769,15 @@ func buildPipelineInitCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "init",
 		Short: "Creates a pipeline for the services in your workspace.",
-		Long:  `Creates a pipeline for the services in your workspace, using the environments associated with the application.`,
+		Long: `Creates a pipeline for the services in your workspace, using the environments associated with the application.
+By default, the pipeline runs in AWS CodePipeline. Use --provider github-actions to generate a GitHub Actions workflow instead.`,
 		Example: `
   Create a pipeline for the services in your workspace.
   /code $ copilot pipeline init \
   /code  --url https://github.com/gitHubUserName/myFrontendApp.git \
-  /code  --environments "stage,prod"`,
+  /code  --environments "stage,prod"
+  Generate a GitHub Actions workflow that deploys to the "stage" and "prod" environments.
+  /code $ copilot pipeline init --provider github-actions --environments "stage,prod"`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitPipelineOpts(vars)
 			if err != nil {
@@ -688,6 +808,7 @@ func buildPipelineInitCmd() *cobra.Command {
 	_ = cmd.Flags().MarkHidden(githubAccessTokenFlag)
 	cmd.Flags().StringVarP(&vars.repoBranch, gitBranchFlag, gitBranchFlagShort, "", gitBranchFlagDescription)
 	cmd.Flags().StringSliceVarP(&vars.environments, envsFlag, envsFlagShort, []string{}, pipelineEnvsFlagDescription)
+	cmd.Flags().StringVar(&vars.cicdProvider, pipelineProviderFlag, pipelineProviderCodePipeline, pipelineProviderFlagDescription)
 
 	return cmd
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	templatemocks "github.com/aws/copilot-cli/internal/pkg/template/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/spf13/afero"
@@ -29,6 +30,7 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 		inAppName     string
 		inrepoURL     string
 		inEnvs        []string
+		inProvider    string
 		setupMocks    func(m *mocks.Mockstore)
 		expectedError error
 	}{
@@ -45,6 +47,23 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 
 			expectedError: fmt.Errorf("get application ghost-app: some error"),
 		},
+		"invalid pipeline provider": {
+			inAppName:  "my-app",
+			inProvider: "jenkins",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+
+			expectedError: errors.New(`invalid pipeline provider jenkins: must be one of "codepipeline", "github-actions"`),
+		},
+		"URL is ignored for GitHub Actions workflows": {
+			inAppName:  "my-app",
+			inrepoURL:  "unsupported.org/repositories/repoName",
+			inProvider: pipelineProviderGitHubActions,
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+		},
 		"URL to unsupported repo provider": {
 			inAppName: "my-app",
 			inrepoURL: "unsupported.org/repositories/repoName",
@@ -116,12 +135,16 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 			mockStore := mocks.NewMockstore(ctrl)
 
 			tc.setupMocks(mockStore)
+			if tc.inProvider == "" {
+				tc.inProvider = pipelineProviderCodePipeline
+			}
 
 			opts := &initPipelineOpts{
 				initPipelineVars: initPipelineVars{
 					appName:      tc.inAppName,
 					repoURL:      tc.inrepoURL,
 					environments: tc.inEnvs,
+					cicdProvider: tc.inProvider,
 				},
 				store: mockStore,
 			}
@@ -158,6 +181,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 		inRepoURL           string
 		inGitHubAccessToken string
 		inGitBranch         string
+		inProvider          string
 
 		mockPrompt       func(m *mocks.Mockprompter)
 		mockRunner       func(m *mocks.Mockrunner)
@@ -173,8 +197,52 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 		expectedGitHubOwner       string
 		expectedGitHubAccessToken string
 		expectedCodeCommitRegion  string
+		expectedAppRegion         string
 		expectedError             error
 	}{
+		"GitHub Actions workflows don't need a repository URL": {
+			inEnvironments: []string{"test"},
+			inProvider:     pipelineProviderGitHubActions,
+
+			mockSelector: func(m *mocks.MockpipelineSelector) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{
+					Name:   "test",
+					Region: "us-west-2",
+				}, nil)
+			},
+			mockRunner: func(m *mocks.Mockrunner) {},
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			mockSessProvider: func(m *mocks.MocksessionProvider) {
+				m.EXPECT().Default().Return(&session.Session{
+					Config: &aws.Config{
+						Region: aws.String("us-east-1"),
+					},
+				}, nil)
+			},
+
+			expectedEnvironments: []string{"test"},
+			expectedRepoBranch:   "main",
+			expectedAppRegion:    "us-east-1",
+		},
+		"wraps error if the application region can't be retrieved for GitHub Actions workflows": {
+			inEnvironments: []string{"test"},
+			inProvider:     pipelineProviderGitHubActions,
+
+			mockSelector: func(m *mocks.MockpipelineSelector) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{
+					Name: "test",
+				}, nil)
+			},
+			mockRunner: func(m *mocks.Mockrunner) {},
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			mockSessProvider: func(m *mocks.MocksessionProvider) {
+				m.EXPECT().Default().Return(nil, errors.New("some error"))
+			},
+
+			expectedError: errors.New("retrieve default session: some error"),
+		},
 		"no flags, prompts for all input, success case for GitHub": {
 			inEnvironments:      []string{},
 			inRepoURL:           "",
@@ -505,6 +573,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 					environments:      tc.inEnvironments,
 					repoURL:           tc.inRepoURL,
 					githubAccessToken: tc.inGitHubAccessToken,
+					cicdProvider:      tc.inProvider,
 				},
 				prompt:       mockPrompt,
 				runner:       mockRunner,
@@ -532,6 +601,8 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				require.Equal(t, tc.expectedGitHubOwner, opts.repoOwner)
 				require.Equal(t, tc.expectedGitHubAccessToken, opts.githubAccessToken)
 				require.Equal(t, tc.expectedCodeCommitRegion, opts.ccRegion)
+				require.Equal(t, tc.expectedAppRegion, opts.appRegion)
+				require.Equal(t, tc.expectedRepoBranch, opts.repoBranch)
 				require.ElementsMatch(t, tc.expectedEnvironments, opts.environments)
 			}
 		})
@@ -542,6 +613,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 	buildspecExistsErr := &workspace.ErrFileExists{FileName: "/buildspec.yml"}
 	manifestExistsErr := &workspace.ErrFileExists{FileName: "/pipeline.yml"}
 	testCases := map[string]struct {
+		inCICDProvider string
 		inProvider     string
 		inEnvironments []string
 		inEnvConfigs   []*config.Environment
@@ -559,6 +631,94 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 
 		expectedError error
 	}{
+		"writes a GitHub Actions workflow with a job per environment": {
+			inCICDProvider: pipelineProviderGitHubActions,
+			inEnvConfigs: []*config.Environment{
+				{
+					Name: "test",
+				},
+				{
+					Name: "prod-iad",
+					Prod: true,
+				},
+			},
+			inBranch:  "main",
+			inAppName: "badgoose",
+
+			mockSecretsManager: func(m *mocks.MocksecretsManager) {},
+			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
+				m.EXPECT().WriteGitHubActionsWorkflow(gomock.Any(), "copilot-badgoose.yml").Return("/.github/workflows/copilot-badgoose.yml", nil)
+			},
+			mockParser: func(m *templatemocks.MockParser) {
+				m.EXPECT().Parse(githubActionsTemplatePath, githubActionsWorkflow{
+					Name:    "copilot-badgoose",
+					Branch:  "main",
+					Region:  "us-west-2",
+					Version: version.Version,
+					Stages: []githubActionsStage{
+						{
+							Name:           "test",
+							JobID:          "deploy-test",
+							RoleSecretName: "COPILOT_TEST_ROLE_ARN",
+						},
+						{
+							Name:           "prod-iad",
+							JobID:          "deploy-prod-iad",
+							Needs:          "deploy-test",
+							RoleSecretName: "COPILOT_PROD_IAD_ROLE_ARN",
+						},
+					},
+				}).Return(&template.Content{
+					Buffer: bytes.NewBufferString("hello"),
+				}, nil)
+			},
+			mockStoreSvc:                func(m *mocks.Mockstore) {},
+			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+		},
+		"does not overwrite an existing GitHub Actions workflow": {
+			inCICDProvider: pipelineProviderGitHubActions,
+			inEnvConfigs: []*config.Environment{
+				{
+					Name: "test",
+				},
+			},
+			inAppName: "badgoose",
+
+			mockSecretsManager: func(m *mocks.MocksecretsManager) {},
+			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
+				m.EXPECT().WriteGitHubActionsWorkflow(gomock.Any(), "copilot-badgoose.yml").Return("", &workspace.ErrFileExists{FileName: "/.github/workflows/copilot-badgoose.yml"})
+			},
+			mockParser: func(m *templatemocks.MockParser) {
+				m.EXPECT().Parse(githubActionsTemplatePath, gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("hello"),
+				}, nil)
+			},
+			mockStoreSvc:                func(m *mocks.Mockstore) {},
+			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+		},
+		"wraps error if the GitHub Actions workflow can't be written": {
+			inCICDProvider: pipelineProviderGitHubActions,
+			inEnvConfigs: []*config.Environment{
+				{
+					Name: "test",
+				},
+			},
+			inAppName: "badgoose",
+
+			mockSecretsManager: func(m *mocks.MocksecretsManager) {},
+			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
+				m.EXPECT().WriteGitHubActionsWorkflow(gomock.Any(), "copilot-badgoose.yml").Return("", errors.New("some error"))
+			},
+			mockParser: func(m *templatemocks.MockParser) {
+				m.EXPECT().Parse(githubActionsTemplatePath, gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("hello"),
+				}, nil)
+			},
+			mockStoreSvc:                func(m *mocks.Mockstore) {},
+			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+
+			expectedError: errors.New("write github actions workflow to workspace: some error"),
+		},
 		"creates secret and writes manifest and buildspec for GHV1 provider": {
 			inProvider: "GitHubV1",
 			inEnvConfigs: []*config.Environment{
@@ -993,7 +1153,10 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				initPipelineVars: initPipelineVars{
 					githubAccessToken: tc.inGitHubToken,
 					appName:           tc.inAppName,
+					repoBranch:        tc.inBranch,
+					cicdProvider:      tc.inCICDProvider,
 				},
+				appRegion: "us-west-2",
 
 				secretsmanager: mockSecretsManager,
 				cfnClient:      mockRegionalResourcesGetter,
@@ -186,6 +186,17 @@ func (o *updatePipelineOpts) convertStages(manifestStages []manifest.PipelineSta
 			return nil, fmt.Errorf("get environment %s in application %s: %w", stage.Name, o.appName, err)
 		}
 
+		var postDeployments []deploy.PostDeployment
+		for _, pd := range stage.PostDeployments {
+			postDeployments = append(postDeployments, deploy.PostDeployment{
+				Name:        pd.Name,
+				Commands:    pd.Commands,
+				Buildspec:   pd.Buildspec,
+				Image:       pd.Image,
+				ComputeType: pd.ComputeType,
+				Variables:   pd.Variables,
+			})
+		}
 		pipelineStage := deploy.PipelineStage{
 			LocalWorkloads: workloads,
 			AssociatedEnvironment: &deploy.AssociatedEnvironment{
@@ -194,7 +205,10 @@ func (o *updatePipelineOpts) convertStages(manifestStages []manifest.PipelineSta
 				AccountID: env.AccountID,
 			},
 			RequiresApproval: stage.RequiresApproval,
+			ReviewChangeSets: stage.ReviewChangeSets,
+			ApprovalTopic:    stage.ApprovalTopic,
 			TestCommands:     stage.TestCommands,
+			PostDeployments:  postDeployments,
 		}
 		stages = append(stages, pipelineStage)
 	}
@@ -71,6 +71,67 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		"converts stages with post deployments": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+					PostDeployments: []manifest.PostDeployment{
+						{
+							Name:        "integ-tests",
+							Commands:    []string{"make integ-test"},
+							Image:       "aws/codebuild/standard:5.0",
+							ComputeType: "BUILD_GENERAL1_MEDIUM",
+							Variables: map[string]string{
+								"ENDPOINT": "https://test.badgoose.com",
+							},
+						},
+						{
+							Name:      "load-tests",
+							Buildspec: "copilot/load-tests/buildspec.yml",
+						},
+					},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				mockEnv := &config.Environment{
+					Name:      "test",
+					App:       "badgoose",
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				}
+				gomock.InOrder(
+					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(mockEnv, nil).Times(1),
+				)
+			},
+
+			expectedStages: []deploy.PipelineStage{
+				{
+					AssociatedEnvironment: &deploy.AssociatedEnvironment{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					},
+					LocalWorkloads: []string{"frontend"},
+					PostDeployments: []deploy.PostDeployment{
+						{
+							Name:        "integ-tests",
+							Commands:    []string{"make integ-test"},
+							Image:       "aws/codebuild/standard:5.0",
+							ComputeType: "BUILD_GENERAL1_MEDIUM",
+							Variables: map[string]string{
+								"ENDPOINT": "https://test.badgoose.com",
+							},
+						},
+						{
+							Name:      "load-tests",
+							Buildspec: "copilot/load-tests/buildspec.yml",
+						},
+					},
+				},
+			},
+		},
 		"converts stages with only stage name": {
 			stages: []manifest.PipelineStage{
 				{
@@ -111,6 +172,7 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 				{
 					Name:             "test",
 					RequiresApproval: true,
+					ApprovalTopic:    "arn:aws:sns:us-west-2:123456789012:approvals",
 				},
 			},
 			inAppName: "badgoose",
@@ -137,6 +199,42 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 					},
 					LocalWorkloads:   []string{"frontend", "backend"},
 					RequiresApproval: true,
+					ApprovalTopic:    "arn:aws:sns:us-west-2:123456789012:approvals",
+					TestCommands:     []string(nil),
+				},
+			},
+			expectedError: nil,
+		},
+		"converts stages that review change sets": {
+			stages: []manifest.PipelineStage{
+				{
+					Name:             "test",
+					ReviewChangeSets: true,
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				mockEnv := &config.Environment{
+					Name:      "test",
+					App:       "badgoose",
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				}
+				gomock.InOrder(
+					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(mockEnv, nil).Times(1),
+				)
+			},
+
+			expectedStages: []deploy.PipelineStage{
+				{
+					AssociatedEnvironment: &deploy.AssociatedEnvironment{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					},
+					LocalWorkloads:   []string{"frontend"},
+					ReviewChangeSets: true,
 					TestCommands:     []string(nil),
 				},
 			},
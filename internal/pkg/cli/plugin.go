@@ -0,0 +1,115 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pluginPrefix = "copilot-"
+
+	// Environment variables that pass the context of the command to plugins.
+	pluginAppEnvVar = "COPILOT_APPLICATION_NAME"
+	pluginEnvEnvVar = "COPILOT_ENVIRONMENT_NAME"
+)
+
+type pluginRunner interface {
+	Run(name string, args []string, options ...command.Option) error
+}
+
+// pluginOpts runs an executable named "copilot-<name>" on the PATH for "copilot <name>".
+type pluginOpts struct {
+	root     *cobra.Command
+	lookPath func(file string) (string, error)
+	appName  func() string // Application of the workspace.
+	runner   pluginRunner
+}
+
+// RunPlugin runs the plugin for args if the first argument isn't a command of root and an executable
+// named "copilot-<name>" is on the PATH. It returns false if there is no plugin to run for args.
+func RunPlugin(root *cobra.Command, args []string) (bool, error) {
+	opts := &pluginOpts{
+		root:     root,
+		lookPath: exec.LookPath,
+		appName:  tryReadingAppName,
+		runner:   command.New(),
+	}
+	return opts.Run(args)
+}
+
+// Run looks up the plugin for args and runs it with the remaining arguments.
+func (o *pluginOpts) Run(args []string) (bool, error) {
+	path, ok := o.plugin(args)
+	if !ok {
+		return false, nil
+	}
+	err := o.runner.Run(path, args[1:],
+		command.Stdin(os.Stdin), command.Stdout(os.Stdout), command.Stderr(os.Stderr), command.Env(o.env(args[1:])))
+	if err != nil {
+		return true, fmt.Errorf("run plugin %s: %w", path, err)
+	}
+	return true, nil
+}
+
+// plugin returns the path of the plugin executable for the first argument.
+func (o *pluginOpts) plugin(args []string) (string, bool) {
+	if len(args) == 0 {
+		return "", false
+	}
+	name := args[0]
+	if name == "" || strings.HasPrefix(name, "-") || strings.ContainsAny(name, `/\`) {
+		return "", false
+	}
+	switch name {
+	case "help", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+		// Commands that cobra adds on execution.
+		return "", false
+	}
+	if cmd, _, err := o.root.Find(args); err == nil && cmd != o.root {
+		return "", false
+	}
+	path, err := o.lookPath(pluginPrefix + name)
+	if err != nil {
+		return "", false
+	}
+	return path, true
+}
+
+// env returns the environment variables with the application and environment that the plugin runs against.
+// They're read from the --app and --env flags of the plugin if present, otherwise the application of the workspace is used.
+func (o *pluginOpts) env(args []string) []string {
+	appName, envName := flagValue(args, appFlag), flagValue(args, envFlag)
+	if appName == "" {
+		appName = o.appName()
+	}
+	var env []string
+	if appName != "" {
+		env = append(env, fmt.Sprintf("%s=%s", pluginAppEnvVar, appName))
+	}
+	if envName != "" {
+		env = append(env, fmt.Sprintf("%s=%s", pluginEnvEnvVar, envName))
+	}
+	return env
+}
+
+// flagValue returns the value of the long flag in args passed either as "--name value" or "--name=value".
+func flagValue(args []string, name string) string {
+	flag := "--" + name
+	for i, arg := range args {
+		if arg == flag && i+1 < len(args) {
+			return args[i+1]
+		}
+		if strings.HasPrefix(arg, flag+"=") {
+			return strings.TrimPrefix(arg, flag+"=")
+		}
+	}
+	return ""
+}
@@ -0,0 +1,129 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"os/exec"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluginOpts_Run(t *testing.T) {
+	testCases := map[string]struct {
+		inArgs     []string
+		inAppName  string
+		setupMocks func(m *mocks.MockpluginRunner)
+
+		wantedRan   bool
+		wantedEnv   []string
+		wantedError error
+	}{
+		"does not run a plugin without arguments": {
+			setupMocks: func(m *mocks.MockpluginRunner) {},
+		},
+		"does not run a plugin for a flag": {
+			inArgs:     []string{"--help"},
+			setupMocks: func(m *mocks.MockpluginRunner) {},
+		},
+		"does not run a plugin for a built-in command": {
+			inArgs:     []string{"svc", "ls"},
+			setupMocks: func(m *mocks.MockpluginRunner) {},
+		},
+		"does not run a plugin for the commands added by cobra": {
+			inArgs:     []string{"__complete", "svc"},
+			setupMocks: func(m *mocks.MockpluginRunner) {},
+		},
+		"does not run a plugin that is not on the PATH": {
+			inArgs:     []string{"missing"},
+			setupMocks: func(m *mocks.MockpluginRunner) {},
+		},
+		"runs the plugin with the application of the workspace": {
+			inArgs:    []string{"cost", "--days", "7"},
+			inAppName: "phonetool",
+			setupMocks: func(m *mocks.MockpluginRunner) {
+				m.EXPECT().Run("/usr/local/bin/copilot-cost", []string{"--days", "7"}, gomock.Any()).Return(nil)
+			},
+			wantedRan: true,
+			wantedEnv: []string{"COPILOT_APPLICATION_NAME=phonetool"},
+		},
+		"passes the application and environment flags of the plugin": {
+			inArgs:    []string{"cost", "--app", "ecommerce", "--env=prod"},
+			inAppName: "phonetool",
+			setupMocks: func(m *mocks.MockpluginRunner) {
+				m.EXPECT().Run("/usr/local/bin/copilot-cost", []string{"--app", "ecommerce", "--env=prod"}, gomock.Any()).Return(nil)
+			},
+			wantedRan: true,
+			wantedEnv: []string{"COPILOT_APPLICATION_NAME=ecommerce", "COPILOT_ENVIRONMENT_NAME=prod"},
+		},
+		"wraps the plugin error": {
+			inArgs: []string{"cost"},
+			setupMocks: func(m *mocks.MockpluginRunner) {
+				m.EXPECT().Run("/usr/local/bin/copilot-cost", []string{}, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedRan:   true,
+			wantedError: errors.New("run plugin /usr/local/bin/copilot-cost: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRunner := mocks.NewMockpluginRunner(ctrl)
+			tc.setupMocks(mockRunner)
+
+			root := &cobra.Command{Use: "copilot"}
+			svc := &cobra.Command{Use: "svc"}
+			svc.AddCommand(&cobra.Command{Use: "ls", Run: func(cmd *cobra.Command, args []string) {}})
+			root.AddCommand(svc)
+
+			var gotEnv []string
+			opts := &pluginOpts{
+				root: root,
+				lookPath: func(file string) (string, error) {
+					if file == "copilot-cost" {
+						return "/usr/local/bin/copilot-cost", nil
+					}
+					return "", errors.New("executable file not found in $PATH")
+				},
+				appName: func() string { return tc.inAppName },
+				runner: runnerFunc(func(name string, args []string, options ...command.Option) error {
+					cmd := exec.Command(name)
+					for _, opt := range options {
+						opt(cmd)
+					}
+					if len(tc.wantedEnv) > 0 {
+						gotEnv = cmd.Env[len(cmd.Env)-len(tc.wantedEnv):]
+					}
+					return mockRunner.Run(name, args, options...)
+				}),
+			}
+
+			// WHEN
+			ran, err := opts.Run(tc.inArgs)
+
+			// THEN
+			require.Equal(t, tc.wantedRan, ran)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedEnv, gotEnv)
+		})
+	}
+}
+
+type runnerFunc func(name string, args []string, options ...command.Option) error
+
+func (f runnerFunc) Run(name string, args []string, options ...command.Option) error {
+	return f(name, args, options...)
+}
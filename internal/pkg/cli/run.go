@@ -0,0 +1,27 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildRunCmd is the top level command for running workloads.
+func BuildRunCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "run",
+		Short: `Commands for running workloads outside of ECS.
+Run a service on your machine with the configuration of an environment.`,
+	}
+
+	cmd.AddCommand(buildRunLocalCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
@@ -0,0 +1,604 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/watch"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	runLocalNamePrompt     = "Which service would you like to run locally?"
+	runLocalNameHelpPrompt = `Copilot builds the service's image and runs its containers on your machine
+with the environment variables and secrets of the service deployed to the environment.`
+)
+
+const (
+	secretsManagerARNPrefix = "arn:aws:secretsmanager:"
+	// Number of colon-separated parts in the ARN of a Secrets Manager secret, before the optional JSON key.
+	secretsManagerARNParts = 7
+)
+
+// runLocalWatchInterval is the time between two checks for changes of the build context with --watch.
+const runLocalWatchInterval = time.Second
+
+type runLocalVars struct {
+	appName       string
+	envName       string
+	wkldName      string
+	portOverrides []string
+	watch         bool
+}
+
+type runLocalOpts struct {
+	runLocalVars
+
+	w            io.Writer
+	store        store
+	ws           wsSvcDirReader
+	fs           afero.Fs
+	unmarshal    func([]byte) (interface{}, error)
+	sel          deploySelector
+	sessProvider sessionProvider
+
+	newContainerRunner  func() (localContainerRunner, error)
+	newTaskDefDescriber func(*session.Session) taskDefDescriber
+	newSSM              func(*session.Session) secretGetter
+	newSecretsManager   func(*session.Session) secretGetter
+	newWatcher          func(dir string) fileWatcher
+	watchInterval       time.Duration
+
+	// Cached variables.
+	ports          map[string]string // Host port keyed by container port, from --port-override.
+	imageBuildArgs *exec.BuildArguments
+	watcher        fileWatcher // Nil unless --watch is set.
+}
+
+func newRunLocalOpts(vars runLocalVars) (*runLocalOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	fs := &afero.Afero{Fs: afero.NewOsFs()}
+	return &runLocalOpts{
+		runLocalVars: vars,
+		w:            os.Stdout,
+		store:        configStore,
+		ws:           ws,
+		fs:           fs,
+		unmarshal:    manifest.UnmarshalWorkload,
+		sel:          selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		sessProvider: sessions.NewProvider(),
+		newContainerRunner: func() (localContainerRunner, error) {
+			return exec.NewContainerEngineCommand()
+		},
+		newTaskDefDescriber: func(s *session.Session) taskDefDescriber {
+			return ecs.New(s)
+		},
+		newSSM: func(s *session.Session) secretGetter {
+			return ssm.New(s)
+		},
+		newSecretsManager: func(s *session.Session) secretGetter {
+			return secretsmanager.NewWithSession(s)
+		},
+		newWatcher: func(dir string) fileWatcher {
+			return watch.New(fs, dir)
+		},
+		watchInterval: runLocalWatchInterval,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *runLocalOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.wkldName != "" {
+		if _, err := o.store.GetService(o.appName, o.wkldName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	ports, err := parsePortOverrides(o.portOverrides)
+	if err != nil {
+		return err
+	}
+	o.ports = ports
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *runLocalOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askSvcEnvName()
+}
+
+// Execute builds the service's image and runs its containers locally with the configuration
+// of the service deployed to the environment, until the user interrupts the command or a container stops.
+func (o *runLocalOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	envSess, err := o.sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return err
+	}
+	taskDef, err := o.newTaskDefDescriber(envSess).TaskDefinition(o.appName, o.envName, o.wkldName)
+	if err != nil {
+		return fmt.Errorf("get task definition of service %s in environment %s: %w", o.wkldName, o.envName, err)
+	}
+	runner, err := o.newContainerRunner()
+	if err != nil {
+		return fmt.Errorf("detect container engine: %w", err)
+	}
+	image, err := o.buildImage(runner)
+	if err != nil {
+		return err
+	}
+	if err := o.watchBuildContext(); err != nil {
+		return err
+	}
+	credentials, err := o.credentialVars(env.Region)
+	if err != nil {
+		return err
+	}
+	containers, err := o.localContainers(taskDef, image, credentials, envSess)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// Stop the containers on interrupt instead of exiting so that they aren't left running.
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt)
+	defer signal.Stop(sigCh)
+	go func() {
+		select {
+		case <-sigCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return o.run(ctx, runner, containers)
+}
+
+// buildImage builds the image of the service from the workspace and returns its name.
+// If the service uses an existing image, it returns an empty string.
+func (o *runLocalOpts) buildImage(runner localContainerRunner) (string, error) {
+	raw, err := o.ws.ReadServiceManifest(o.wkldName)
+	if err != nil {
+		return "", fmt.Errorf("read service %s manifest file: %w", o.wkldName, err)
+	}
+	mft, err := o.unmarshal(raw)
+	if err != nil {
+		return "", fmt.Errorf("unmarshal service %s manifest: %w", o.wkldName, err)
+	}
+	required, err := manifest.ServiceDockerfileBuildRequired(mft)
+	if err != nil {
+		return "", err
+	}
+	if !required {
+		return "", nil
+	}
+	copilotDir, err := o.ws.CopilotDirPath()
+	if err != nil {
+		return "", fmt.Errorf("get copilot directory: %w", err)
+	}
+	args, err := buildArgs(o.wkldName, "", copilotDir, mft)
+	if err != nil {
+		return "", err
+	}
+	if err := validateBuildArgs(o.fs, filepath.Dir(copilotDir), args); err != nil {
+		return "", err
+	}
+	args.Platforms = nil // The image only needs to run on this machine.
+	args.URI = fmt.Sprintf("%s/%s", o.appName, o.wkldName)
+	if err := runner.Build(args); err != nil {
+		return "", fmt.Errorf("build image of service %s: %w", o.wkldName, err)
+	}
+	o.imageBuildArgs = args
+	return args.URI, nil
+}
+
+// watchBuildContext records the files of the build context of the image if --watch is set.
+func (o *runLocalOpts) watchBuildContext() error {
+	if !o.watch {
+		return nil
+	}
+	if o.imageBuildArgs == nil {
+		return fmt.Errorf("service %s can't be watched: it uses an existing image instead of building one from a Dockerfile", o.wkldName)
+	}
+	o.watcher = o.newWatcher(o.imageBuildArgs.Context)
+	if _, err := o.watcher.Changed(); err != nil {
+		return fmt.Errorf("watch the build context of service %s: %w", o.wkldName, err)
+	}
+	log.Infof("Watching %s for changes.\n", color.HighlightResource(o.imageBuildArgs.Context))
+	return nil
+}
+
+// credentialVars returns the environment variables that let the AWS SDKs in the containers
+// use the credentials of the default profile in the environment's region.
+func (o *runLocalOpts) credentialVars(region string) (map[string]string, error) {
+	sess, err := o.sessProvider.DefaultWithRegion(region)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := sess.Config.Credentials.Get()
+	if err != nil {
+		return nil, fmt.Errorf("get credentials of the default profile: %w", err)
+	}
+	vars := map[string]string{
+		"AWS_ACCESS_KEY_ID":     creds.AccessKeyID,
+		"AWS_SECRET_ACCESS_KEY": creds.SecretAccessKey,
+		"AWS_REGION":            region,
+		"AWS_DEFAULT_REGION":    region,
+	}
+	if creds.SessionToken != "" {
+		vars["AWS_SESSION_TOKEN"] = creds.SessionToken
+	}
+	return vars, nil
+}
+
+// localContainers returns the containers of the task definition to run locally, starting with the main container
+// which publishes the ports of every container. Init containers and the FireLens log router are left out.
+func (o *runLocalOpts) localContainers(taskDef *awsecs.TaskDefinition, image string, credentials map[string]string, sess *session.Session) ([]*exec.RunContainerInput, error) {
+	var main *exec.RunContainerInput
+	var sidecars []*exec.RunContainerInput
+	ports := make(map[string]string)
+	initContainers := initContainerNames(taskDef)
+	for _, def := range taskDef.ContainerDefinitions {
+		name := aws.StringValue(def.Name)
+		if def.FirelensConfiguration != nil || initContainers[name] {
+			continue
+		}
+		vars := make(map[string]string)
+		for _, env := range def.Environment {
+			vars[aws.StringValue(env.Name)] = aws.StringValue(env.Value)
+		}
+		for _, secret := range def.Secrets {
+			value, err := o.secretValue(sess, aws.StringValue(secret.ValueFrom))
+			if err != nil {
+				return nil, fmt.Errorf("get secret %s of container %s: %w", aws.StringValue(secret.Name), name, err)
+			}
+			vars[aws.StringValue(secret.Name)] = value
+		}
+		for k, v := range credentials {
+			vars[k] = v
+		}
+		for _, mapping := range def.PortMappings {
+			containerPort := strconv.FormatInt(aws.Int64Value(mapping.ContainerPort), 10)
+			hostPort, ok := o.ports[containerPort]
+			if !ok {
+				hostPort = containerPort
+			}
+			ports[hostPort] = containerPort
+		}
+		container := &exec.RunContainerInput{
+			Name:       fmt.Sprintf("%s-%s-%s", o.appName, o.wkldName, name),
+			Image:      aws.StringValue(def.Image),
+			EnvVars:    vars,
+			EntryPoint: aws.StringValueSlice(def.EntryPoint),
+			Command:    aws.StringValueSlice(def.Command),
+		}
+		if name != o.wkldName {
+			sidecars = append(sidecars, container)
+			continue
+		}
+		if image != "" {
+			container.Image = image
+		}
+		main = container
+	}
+	if main == nil {
+		return nil, fmt.Errorf("task definition of service %s has no container named %s", o.wkldName, o.wkldName)
+	}
+	// Containers of a task reach each other on localhost, so the sidecars share the network of the main container.
+	main.Ports = ports
+	for _, sidecar := range sidecars {
+		sidecar.NetworkOf = main.Name
+	}
+	return append([]*exec.RunContainerInput{main}, sidecars...), nil
+}
+
+// secretValue returns the value of a secret referenced by a task definition,
+// either an SSM parameter or a Secrets Manager secret with an optional JSON key.
+func (o *runLocalOpts) secretValue(sess *session.Session, valueFrom string) (string, error) {
+	if !strings.HasPrefix(valueFrom, secretsManagerARNPrefix) {
+		return o.newSSM(sess).SecretValue(valueFrom)
+	}
+	parts := strings.Split(valueFrom, ":")
+	if len(parts) <= secretsManagerARNParts {
+		return o.newSecretsManager(sess).SecretValue(valueFrom)
+	}
+	arn, jsonKey := strings.Join(parts[:secretsManagerARNParts], ":"), parts[secretsManagerARNParts]
+	value, err := o.newSecretsManager(sess).SecretValue(arn)
+	if err != nil {
+		return "", err
+	}
+	if jsonKey == "" {
+		return value, nil
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &fields); err != nil {
+		return "", fmt.Errorf("unmarshal secret %s as JSON: %w", arn, err)
+	}
+	field, ok := fields[jsonKey]
+	if !ok {
+		return "", fmt.Errorf("secret %s has no key %s", arn, jsonKey)
+	}
+	return fmt.Sprint(field), nil
+}
+
+// run starts the containers in order and streams their logs to o.w until ctx is canceled or a container stops.
+// With --watch, the image is rebuilt and the containers are restarted whenever a file of the build context changes.
+// The containers are stopped before it returns.
+func (o *runLocalOpts) run(ctx context.Context, runner localContainerRunner, containers []*exec.RunContainerInput) error {
+	task, err := o.startContainers(runner, containers)
+	if err != nil {
+		return err
+	}
+	log.Infof("Running service %s with the configuration of environment %s. Press Ctrl+C to stop.\n",
+		color.HighlightUserInput(o.wkldName), color.HighlightUserInput(o.envName))
+	var ticks <-chan time.Time
+	if o.watcher != nil {
+		ticker := time.NewTicker(o.watchInterval)
+		defer ticker.Stop()
+		ticks = ticker.C
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			task.stop(runner, "")
+			return nil
+		case res := <-task.logs:
+			task.stop(runner, res.container)
+			if res.err != nil {
+				return res.err
+			}
+			return fmt.Errorf("container %s stopped", res.container)
+		case <-ticks:
+			changed, err := o.watcher.Changed()
+			if err != nil {
+				task.stop(runner, "")
+				return fmt.Errorf("watch the build context of service %s: %w", o.wkldName, err)
+			}
+			if len(changed) == 0 {
+				continue
+			}
+			log.Infof("Rebuilding service %s after changes to %s.\n", color.HighlightUserInput(o.wkldName), strings.Join(changed, ", "))
+			if err := runner.Build(o.imageBuildArgs); err != nil {
+				// Keep the containers running so that the next change can fix the build.
+				log.Errorf("Failed to rebuild the image of service %s: %v\n", o.wkldName, err)
+				continue
+			}
+			task.stop(runner, "")
+			if task, err = o.startContainers(runner, containers); err != nil {
+				return err
+			}
+			log.Successf("Restarted service %s.\n", color.HighlightUserInput(o.wkldName))
+		}
+	}
+}
+
+// startContainers runs the containers in order and follows their logs.
+// If a container fails to run, the ones already started are stopped.
+func (o *runLocalOpts) startContainers(runner localContainerRunner, containers []*exec.RunContainerInput) (*localTask, error) {
+	task := &localTask{}
+	for _, container := range containers {
+		if err := runner.RunContainer(container); err != nil {
+			task.stop(runner, "")
+			return nil, err
+		}
+		task.containers = append(task.containers, container.Name)
+	}
+	task.logs = make(chan containerLogsResult, len(task.containers))
+	mu := new(sync.Mutex)
+	for _, name := range task.containers {
+		go func(name string) {
+			err := runner.FollowContainerLogs(name, &prefixWriter{w: o.w, mu: mu, prefix: name + " | "})
+			task.logs <- containerLogsResult{container: name, err: err}
+		}(name)
+	}
+	return task, nil
+}
+
+// containerLogsResult is the outcome of following the logs of a container until it stops.
+type containerLogsResult struct {
+	container string
+	err       error
+}
+
+// localTask holds the containers of the service running locally.
+type localTask struct {
+	containers []string                 // In the order they were started.
+	logs       chan containerLogsResult // Nil until the logs are followed.
+}
+
+// stop stops the containers in the reverse order they were started, except the one that already stopped,
+// and waits for their logs to be written in full.
+func (t *localTask) stop(runner localContainerRunner, stopped string) {
+	for i := len(t.containers) - 1; i >= 0; i-- {
+		name := t.containers[i]
+		if name == stopped {
+			continue
+		}
+		if err := runner.StopContainer(name); err != nil {
+			log.Warningf("Failed to stop container %s: %v\n", name, err)
+			continue
+		}
+		if t.logs != nil {
+			// The logs of a stopped container end.
+			<-t.logs
+		}
+	}
+}
+
+func (o *runLocalOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *runLocalOpts) askSvcEnvName() error {
+	deployedService, err := o.sel.DeployedService(runLocalNamePrompt, runLocalNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.wkldName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.wkldName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// parsePortOverrides returns the host ports keyed by container port from overrides formatted as "host:container".
+func parsePortOverrides(overrides []string) (map[string]string, error) {
+	ports := make(map[string]string)
+	for _, override := range overrides {
+		parts := strings.Split(override, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("port override %s must be formatted as host:container", override)
+		}
+		for _, port := range parts {
+			if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+				return nil, fmt.Errorf("port override %s: %s is not a valid port", override, port)
+			}
+		}
+		ports[parts[1]] = parts[0]
+	}
+	return ports, nil
+}
+
+// initContainerNames returns the containers that others wait on to complete before starting.
+func initContainerNames(taskDef *awsecs.TaskDefinition) map[string]bool {
+	names := make(map[string]bool)
+	for _, def := range taskDef.ContainerDefinitions {
+		for _, dep := range def.DependsOn {
+			switch aws.StringValue(dep.Condition) {
+			case sdkecs.ContainerConditionComplete, sdkecs.ContainerConditionSuccess:
+				names[aws.StringValue(dep.ContainerName)] = true
+			}
+		}
+	}
+	return names
+}
+
+// prefixWriter writes each line with a prefix. Writers sharing a mutex don't interleave their lines.
+type prefixWriter struct {
+	w      io.Writer
+	mu     *sync.Mutex
+	prefix string
+	buf    bytes.Buffer // Incomplete line.
+}
+
+// Write writes the complete lines of p with the prefix and holds on to the rest until the line is complete.
+func (pw *prefixWriter) Write(p []byte) (int, error) {
+	pw.buf.Write(p)
+	pw.mu.Lock()
+	defer pw.mu.Unlock()
+	for {
+		i := bytes.IndexByte(pw.buf.Bytes(), '\n')
+		if i == -1 {
+			return len(p), nil
+		}
+		line := pw.buf.Next(i + 1)
+		if _, err := fmt.Fprintf(pw.w, "%s%s", color.Faint.Sprint(pw.prefix), line); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// buildRunLocalCmd builds the command for running a service locally.
+func buildRunLocalCmd() *cobra.Command {
+	vars := runLocalVars{}
+	cmd := &cobra.Command{
+		Use:   "local",
+		Short: "Runs a service on your machine with the configuration of an environment.",
+		Long: `Runs a service on your machine with the configuration of an environment.
+The service's image is built from the workspace, and its containers and sidecars are run with the container engine
+using the environment variables and secrets of the service deployed to the environment, and your default AWS credentials.
+The ports of the containers are published on your machine and their logs are streamed until you press Ctrl-C.
+With --watch, the image is rebuilt and the containers are restarted whenever a file of the build context changes.`,
+
+		Example: `
+  Runs the service "frontend" with the configuration of the "test" environment.
+  /code $ copilot run local -n frontend -e test
+  Publishes port 80 of the containers on port 8080 of your machine.
+  /code $ copilot run local -n frontend -e test --port-override 8080:80
+  Restarts the service with a new image whenever its source code changes.
+  /code $ copilot run local -n frontend -e test --watch`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRunLocalOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.wkldName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.portOverrides, portOverrideFlag, nil, portOverrideFlagDescription)
+	cmd.Flags().BoolVar(&vars.watch, watchFlag, false, runLocalWatchFlagDescription)
+	return cmd
+}
@@ -0,0 +1,686 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunLocalOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inPortOverrides []string
+
+		wantedPorts map[string]string
+		wantedErr   error
+	}{
+		"errors if a port override is missing the container port": {
+			inPortOverrides: []string{"8080"},
+
+			wantedErr: errors.New("port override 8080 must be formatted as host:container"),
+		},
+		"errors if a port override is not a number": {
+			inPortOverrides: []string{"8080:http"},
+
+			wantedErr: errors.New("port override 8080:http: http is not a valid port"),
+		},
+		"keys the host ports by container port": {
+			inPortOverrides: []string{"8080:80", "9000:9000"},
+
+			wantedPorts: map[string]string{
+				"80":   "8080",
+				"9000": "9000",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &runLocalOpts{
+				runLocalVars: runLocalVars{
+					portOverrides: tc.inPortOverrides,
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPorts, opts.ports)
+		})
+	}
+}
+
+func TestRunLocalOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp     string
+		mockSelector func(m *mocks.MockdeploySelector)
+
+		wantedApp string
+		wantedSvc string
+		wantedEnv string
+		wantedErr error
+	}{
+		"errors if failed to select application": {
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(svcAppNamePrompt, svcAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+
+			wantedErr: errors.New("select application: some error"),
+		},
+		"errors if failed to select deployed service": {
+			inputApp: "phonetool",
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(runLocalNamePrompt, runLocalNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+
+			wantedErr: errors.New("select deployed services for application phonetool: some error"),
+		},
+		"success": {
+			inputApp: "phonetool",
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(runLocalNamePrompt, runLocalNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env: "test",
+						Svc: "frontend",
+					}, nil)
+			},
+
+			wantedApp: "phonetool",
+			wantedSvc: "frontend",
+			wantedEnv: "test",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSelector := mocks.NewMockdeploySelector(ctrl)
+			tc.mockSelector(mockSelector)
+			opts := &runLocalOpts{
+				runLocalVars: runLocalVars{
+					appName: tc.inputApp,
+				},
+				sel: mockSelector,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedSvc, opts.wkldName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+		})
+	}
+}
+
+func TestRunLocalOpts_buildImage(t *testing.T) {
+	const wsRoot = "/ws"
+	mockManifest := []byte(`name: frontend
+type: Load Balanced Web Service
+image:
+  build: frontend/Dockerfile
+  port: 80`)
+	testCases := map[string]struct {
+		mockWs     func(m *mocks.MockwsSvcDirReader)
+		mockRunner func(m *mocks.MocklocalContainerRunner)
+
+		wantedImage string
+		wantedErr   error
+	}{
+		"errors if the manifest can't be read": {
+			mockWs: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest("frontend").Return(nil, errors.New("some error"))
+			},
+			mockRunner: func(m *mocks.MocklocalContainerRunner) {},
+
+			wantedErr: errors.New("read service frontend manifest file: some error"),
+		},
+		"does not build an existing image": {
+			mockWs: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest("frontend").Return([]byte(`name: frontend
+type: Load Balanced Web Service
+image:
+  location: nginx
+  port: 80`), nil)
+			},
+			mockRunner: func(m *mocks.MocklocalContainerRunner) {},
+		},
+		"wraps the build error": {
+			mockWs: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest("frontend").Return(mockManifest, nil)
+				m.EXPECT().CopilotDirPath().Return(wsRoot+"/copilot", nil)
+			},
+			mockRunner: func(m *mocks.MocklocalContainerRunner) {
+				m.EXPECT().Build(gomock.Any()).Return(errors.New("some error"))
+			},
+
+			wantedErr: errors.New("build image of service frontend: some error"),
+		},
+		"builds the image named after the application and service": {
+			mockWs: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest("frontend").Return(mockManifest, nil)
+				m.EXPECT().CopilotDirPath().Return(wsRoot+"/copilot", nil)
+			},
+			mockRunner: func(m *mocks.MocklocalContainerRunner) {
+				m.EXPECT().Build(&exec.BuildArguments{
+					URI:        "phonetool/frontend",
+					Dockerfile: wsRoot + "/frontend/Dockerfile",
+					Context:    wsRoot + "/frontend",
+				}).Return(nil)
+			},
+
+			wantedImage: "phonetool/frontend",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs := mocks.NewMockwsSvcDirReader(ctrl)
+			mockRunner := mocks.NewMocklocalContainerRunner(ctrl)
+			tc.mockWs(mockWs)
+			tc.mockRunner(mockRunner)
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, wsRoot+"/frontend/Dockerfile", []byte("FROM nginx"), 0644))
+			opts := &runLocalOpts{
+				runLocalVars: runLocalVars{
+					appName:  "phonetool",
+					wkldName: "frontend",
+				},
+				ws:        mockWs,
+				fs:        fs,
+				unmarshal: manifest.UnmarshalWorkload,
+			}
+
+			// WHEN
+			image, err := opts.buildImage(mockRunner)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedImage, image)
+		})
+	}
+}
+
+func TestRunLocalOpts_watchBuildContext(t *testing.T) {
+	buildArgs := &exec.BuildArguments{
+		Context: "/ws/frontend",
+	}
+	testCases := map[string]struct {
+		inWatch          bool
+		inImageBuildArgs *exec.BuildArguments
+		mockWatcher      func(m *mocks.MockfileWatcher)
+
+		wantedWatched bool
+		wantedErr     error
+	}{
+		"does nothing without --watch": {
+			inImageBuildArgs: buildArgs,
+		},
+		"errors if the image isn't built": {
+			inWatch: true,
+
+			wantedErr: errors.New("service frontend can't be watched: it uses an existing image instead of building one from a Dockerfile"),
+		},
+		"errors if the files of the build context can't be recorded": {
+			inWatch:          true,
+			inImageBuildArgs: buildArgs,
+			mockWatcher: func(m *mocks.MockfileWatcher) {
+				m.EXPECT().Changed().Return(nil, errors.New("some error"))
+			},
+
+			wantedErr: errors.New("watch the build context of service frontend: some error"),
+		},
+		"records the files of the build context": {
+			inWatch:          true,
+			inImageBuildArgs: buildArgs,
+			mockWatcher: func(m *mocks.MockfileWatcher) {
+				m.EXPECT().Changed().Return(nil, nil)
+			},
+
+			wantedWatched: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWatcher := mocks.NewMockfileWatcher(ctrl)
+			if tc.mockWatcher != nil {
+				tc.mockWatcher(mockWatcher)
+			}
+			opts := &runLocalOpts{
+				runLocalVars: runLocalVars{
+					wkldName: "frontend",
+					watch:    tc.inWatch,
+				},
+				imageBuildArgs: tc.inImageBuildArgs,
+				newWatcher: func(dir string) fileWatcher {
+					require.Equal(t, "/ws/frontend", dir)
+					return mockWatcher
+				},
+			}
+
+			// WHEN
+			err := opts.watchBuildContext()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedWatched, opts.watcher != nil)
+		})
+	}
+}
+
+func TestRunLocalOpts_credentialVars(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMocksessionProvider(ctrl)
+	m.EXPECT().DefaultWithRegion("us-west-2").Return(&session.Session{
+		Config: &aws.Config{
+			Credentials: credentials.NewStaticCredentials("AKIA", "secret", "token"),
+		},
+	}, nil)
+	opts := &runLocalOpts{
+		sessProvider: m,
+	}
+
+	// WHEN
+	vars, err := opts.credentialVars("us-west-2")
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"AWS_ACCESS_KEY_ID":     "AKIA",
+		"AWS_SECRET_ACCESS_KEY": "secret",
+		"AWS_SESSION_TOKEN":     "token",
+		"AWS_REGION":            "us-west-2",
+		"AWS_DEFAULT_REGION":    "us-west-2",
+	}, vars)
+}
+
+func TestRunLocalOpts_localContainers(t *testing.T) {
+	credentials := map[string]string{
+		"AWS_REGION": "us-west-2",
+	}
+	testCases := map[string]struct {
+		taskDef     *awsecs.TaskDefinition
+		image       string
+		ports       map[string]string
+		mockSSM     func(m *mocks.MocksecretGetter)
+		mockSecrets func(m *mocks.MocksecretGetter)
+
+		wanted    []*exec.RunContainerInput
+		wantedErr error
+	}{
+		"errors if a secret can't be read": {
+			taskDef: &awsecs.TaskDefinition{
+				ContainerDefinitions: []*sdkecs.ContainerDefinition{
+					{
+						Name: aws.String("frontend"),
+						Secrets: []*sdkecs.Secret{
+							{
+								Name:      aws.String("DB_PASSWORD"),
+								ValueFrom: aws.String("/copilot/phonetool/test/secrets/db-password"),
+							},
+						},
+					},
+				},
+			},
+			mockSSM: func(m *mocks.MocksecretGetter) {
+				m.EXPECT().SecretValue("/copilot/phonetool/test/secrets/db-password").Return("", errors.New("some error"))
+			},
+			mockSecrets: func(m *mocks.MocksecretGetter) {},
+
+			wantedErr: errors.New("get secret DB_PASSWORD of container frontend: some error"),
+		},
+		"errors if the main container is missing": {
+			taskDef: &awsecs.TaskDefinition{
+				ContainerDefinitions: []*sdkecs.ContainerDefinition{
+					{
+						Name: aws.String("nginx"),
+					},
+				},
+			},
+			mockSSM:     func(m *mocks.MocksecretGetter) {},
+			mockSecrets: func(m *mocks.MocksecretGetter) {},
+
+			wantedErr: errors.New("task definition of service frontend has no container named frontend"),
+		},
+		"runs the built image with the sidecars on the network of the main container": {
+			taskDef: &awsecs.TaskDefinition{
+				ContainerDefinitions: []*sdkecs.ContainerDefinition{
+					{
+						Name:  aws.String("nginx"),
+						Image: aws.String("nginx:latest"),
+						PortMappings: []*sdkecs.PortMapping{
+							{ContainerPort: aws.Int64(80)},
+						},
+						Secrets: []*sdkecs.Secret{
+							{
+								Name:      aws.String("TLS_KEY"),
+								ValueFrom: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:tls-AbCdEf:key::"),
+							},
+						},
+					},
+					{
+						Name:      aws.String("migrate"),
+						Image:     aws.String("flyway"),
+						Essential: aws.Bool(false),
+					},
+					{
+						Name:                  aws.String("firelens_log_router"),
+						Image:                 aws.String("aws-for-fluent-bit"),
+						FirelensConfiguration: &sdkecs.FirelensConfiguration{},
+					},
+					{
+						Name:       aws.String("frontend"),
+						Image:      aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend@sha256:abc"),
+						EntryPoint: aws.StringSlice([]string{"/bin/sh", "-c"}),
+						Command:    aws.StringSlice([]string{"npm start"}),
+						Environment: []*sdkecs.KeyValuePair{
+							{
+								Name:  aws.String("COPILOT_ENVIRONMENT_NAME"),
+								Value: aws.String("test"),
+							},
+						},
+						Secrets: []*sdkecs.Secret{
+							{
+								Name:      aws.String("DB_PASSWORD"),
+								ValueFrom: aws.String("/copilot/phonetool/test/secrets/db-password"),
+							},
+						},
+						PortMappings: []*sdkecs.PortMapping{
+							{ContainerPort: aws.Int64(8080)},
+						},
+						DependsOn: []*sdkecs.ContainerDependency{
+							{
+								ContainerName: aws.String("migrate"),
+								Condition:     aws.String(sdkecs.ContainerConditionSuccess),
+							},
+						},
+					},
+				},
+			},
+			image: "phonetool/frontend",
+			ports: map[string]string{
+				"80": "8000",
+			},
+			mockSSM: func(m *mocks.MocksecretGetter) {
+				m.EXPECT().SecretValue("/copilot/phonetool/test/secrets/db-password").Return("hunter2", nil)
+			},
+			mockSecrets: func(m *mocks.MocksecretGetter) {
+				m.EXPECT().SecretValue("arn:aws:secretsmanager:us-west-2:123456789012:secret:tls-AbCdEf").Return(`{"key":"private"}`, nil)
+			},
+
+			wanted: []*exec.RunContainerInput{
+				{
+					Name:  "phonetool-frontend-frontend",
+					Image: "phonetool/frontend",
+					EnvVars: map[string]string{
+						"COPILOT_ENVIRONMENT_NAME": "test",
+						"DB_PASSWORD":              "hunter2",
+						"AWS_REGION":               "us-west-2",
+					},
+					Ports: map[string]string{
+						"8000": "80",
+						"8080": "8080",
+					},
+					EntryPoint: []string{"/bin/sh", "-c"},
+					Command:    []string{"npm start"},
+				},
+				{
+					Name:  "phonetool-frontend-nginx",
+					Image: "nginx:latest",
+					EnvVars: map[string]string{
+						"TLS_KEY":    "private",
+						"AWS_REGION": "us-west-2",
+					},
+					NetworkOf:  "phonetool-frontend-frontend",
+					EntryPoint: []string{},
+					Command:    []string{},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSSM := mocks.NewMocksecretGetter(ctrl)
+			mockSecrets := mocks.NewMocksecretGetter(ctrl)
+			tc.mockSSM(mockSSM)
+			tc.mockSecrets(mockSecrets)
+			opts := &runLocalOpts{
+				runLocalVars: runLocalVars{
+					appName:  "phonetool",
+					wkldName: "frontend",
+				},
+				newSSM: func(*session.Session) secretGetter {
+					return mockSSM
+				},
+				newSecretsManager: func(*session.Session) secretGetter {
+					return mockSecrets
+				},
+				ports: tc.ports,
+			}
+
+			// WHEN
+			containers, err := opts.localContainers(tc.taskDef, tc.image, credentials, nil)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, containers)
+		})
+	}
+}
+
+func TestRunLocalOpts_run(t *testing.T) {
+	containers := []*exec.RunContainerInput{
+		{Name: "phonetool-frontend-frontend"},
+		{Name: "phonetool-frontend-nginx"},
+	}
+	// followUntilStopped mocks the logs of a container that end once the container is stopped.
+	followUntilStopped := func(m *mocks.MocklocalContainerRunner, name string) {
+		stopped := make(chan struct{})
+		m.EXPECT().FollowContainerLogs(name, gomock.Any()).DoAndReturn(func(_ string, _ interface{}) error {
+			<-stopped
+			return nil
+		})
+		m.EXPECT().StopContainer(name).DoAndReturn(func(_ string) error {
+			close(stopped)
+			return nil
+		})
+	}
+	buildArgs := &exec.BuildArguments{
+		URI:        "phonetool/frontend",
+		Dockerfile: "/ws/frontend/Dockerfile",
+		Context:    "/ws/frontend",
+	}
+	testCases := map[string]struct {
+		mockRunner  func(m *mocks.MocklocalContainerRunner)
+		mockWatcher func(m *mocks.MockfileWatcher)
+		canceled    bool
+
+		wantedErr error
+	}{
+		"stops the containers already started if a container fails to run": {
+			mockRunner: func(m *mocks.MocklocalContainerRunner) {
+				gomock.InOrder(
+					m.EXPECT().RunContainer(containers[0]).Return(nil),
+					m.EXPECT().RunContainer(containers[1]).Return(errors.New("some error")),
+					m.EXPECT().StopContainer("phonetool-frontend-frontend").Return(nil),
+				)
+			},
+
+			wantedErr: errors.New("some error"),
+		},
+		"stops the other containers once a container stops": {
+			mockRunner: func(m *mocks.MocklocalContainerRunner) {
+				m.EXPECT().RunContainer(gomock.Any()).Return(nil).Times(2)
+				followUntilStopped(m, "phonetool-frontend-frontend")
+				m.EXPECT().FollowContainerLogs("phonetool-frontend-nginx", gomock.Any()).Return(nil)
+			},
+
+			wantedErr: errors.New("container phonetool-frontend-nginx stopped"),
+		},
+		"returns the error of the logs of a container": {
+			mockRunner: func(m *mocks.MocklocalContainerRunner) {
+				m.EXPECT().RunContainer(gomock.Any()).Return(nil).Times(2)
+				followUntilStopped(m, "phonetool-frontend-frontend")
+				m.EXPECT().FollowContainerLogs("phonetool-frontend-nginx", gomock.Any()).Return(errors.New("some error"))
+			},
+
+			wantedErr: errors.New("some error"),
+		},
+		"stops every container once canceled": {
+			mockRunner: func(m *mocks.MocklocalContainerRunner) {
+				m.EXPECT().RunContainer(gomock.Any()).Return(nil).Times(2)
+				followUntilStopped(m, "phonetool-frontend-frontend")
+				followUntilStopped(m, "phonetool-frontend-nginx")
+			},
+			canceled: true,
+		},
+		"restarts the containers with the rebuilt image once the build context changes": {
+			mockRunner: func(m *mocks.MocklocalContainerRunner) {
+				m.EXPECT().RunContainer(gomock.Any()).Return(nil).Times(4)
+				followUntilStopped(m, "phonetool-frontend-frontend")
+				followUntilStopped(m, "phonetool-frontend-nginx")
+				m.EXPECT().Build(buildArgs).Return(nil)
+				followUntilStopped(m, "phonetool-frontend-frontend")
+				m.EXPECT().FollowContainerLogs("phonetool-frontend-nginx", gomock.Any()).Return(nil)
+			},
+			mockWatcher: func(m *mocks.MockfileWatcher) {
+				m.EXPECT().Changed().Return([]string{"index.html"}, nil)
+				m.EXPECT().Changed().Return(nil, nil).AnyTimes()
+			},
+
+			wantedErr: errors.New("container phonetool-frontend-nginx stopped"),
+		},
+		"keeps the containers running if the image fails to rebuild": {
+			mockRunner: func(m *mocks.MocklocalContainerRunner) {
+				m.EXPECT().RunContainer(gomock.Any()).Return(nil).Times(2)
+				m.EXPECT().Build(buildArgs).Return(errors.New("some error"))
+				followUntilStopped(m, "phonetool-frontend-frontend")
+				followUntilStopped(m, "phonetool-frontend-nginx")
+			},
+			mockWatcher: func(m *mocks.MockfileWatcher) {
+				gomock.InOrder(
+					m.EXPECT().Changed().Return([]string{"index.html"}, nil),
+					m.EXPECT().Changed().Return(nil, errors.New("some error")),
+				)
+			},
+
+			wantedErr: errors.New("watch the build context of service frontend: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRunner := mocks.NewMocklocalContainerRunner(ctrl)
+			tc.mockRunner(mockRunner)
+			var watcher fileWatcher
+			if tc.mockWatcher != nil {
+				mockWatcher := mocks.NewMockfileWatcher(ctrl)
+				tc.mockWatcher(mockWatcher)
+				watcher = mockWatcher
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tc.canceled {
+				cancel()
+			}
+			opts := &runLocalOpts{
+				runLocalVars: runLocalVars{
+					wkldName: "frontend",
+					envName:  "test",
+				},
+				w:              new(strings.Builder),
+				watcher:        watcher,
+				watchInterval:  time.Millisecond,
+				imageBuildArgs: buildArgs,
+			}
+
+			// WHEN
+			err := opts.run(ctx, mockRunner, containers)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPrefixWriter_Write(t *testing.T) {
+	// GIVEN
+	buf := new(strings.Builder)
+	pw := &prefixWriter{w: buf, mu: new(sync.Mutex), prefix: "nginx | "}
+
+	// WHEN
+	for _, chunk := range []string{"GET / 2", "00\nGET /health", "z 200\n", "partial"} {
+		_, err := fmt.Fprint(pw, chunk)
+		require.NoError(t, err)
+	}
+
+	// THEN
+	require.Equal(t, "nginx | GET / 200\nnginx | GET /healthz 200\n", buf.String())
+}
@@ -0,0 +1,475 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/dotenv"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretInitAppPrompt     = "Which application do you want to add the secrets to?"
+	secretInitAppHelpPrompt = "The secrets are stored in every environment of the application."
+	fmtSecretInitConfirm    = "Apply %s to the secrets of application %s?"
+
+	fmtSecretParameterName = "/copilot/%s/%s/secrets/%s"
+)
+
+// Secret providers.
+const (
+	secretProviderSSM            = "ssm"
+	secretProviderSecretsManager = "secretsmanager"
+)
+
+var secretProviders = []string{secretProviderSSM, secretProviderSecretsManager}
+
+var (
+	errSecretInitCancelled = errors.New("secret init cancelled - no changes made")
+
+	secretNameRegExp = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+)
+
+type secretAction int
+
+const (
+	secretUnchanged secretAction = iota
+	secretCreate
+	secretUpdate
+)
+
+func (a secretAction) String() string {
+	switch a {
+	case secretCreate:
+		return "create"
+	case secretUpdate:
+		return "update"
+	default:
+		return "unchanged"
+	}
+}
+
+// envFileSecret is a KEY=VALUE line of a dotenv file.
+type envFileSecret struct {
+	name  string
+	value string
+}
+
+// secretChange is the action to take for a secret in an environment.
+type secretChange struct {
+	env    string
+	name   string
+	value  string
+	action secretAction
+}
+
+type secretInitVars struct {
+	appName          string
+	envFilePath      string
+	provider         string
+	skipConfirmation bool
+	shouldOutputJSON bool
+}
+
+type secretInitOpts struct {
+	secretInitVars
+
+	fs     afero.Fs
+	store  store
+	sel    appSelector
+	prompt prompter
+	guard  targetConfirmer
+	w      io.Writer
+	jsonW  io.Writer // Stdout when the result is written as JSON, in which case w writes the summary to stderr.
+
+	// newSecretClient is overridden in tests.
+	newSecretClient func(env *config.Environment) (secretReadWriter, error)
+
+	// Cached values.
+	secrets []envFileSecret
+	changes []secretChange
+}
+
+func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	prompter := prompt.New()
+	sessProvider := sessions.NewProvider()
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	guard, err := newTargetGuard(defaultSess, prompter)
+	if err != nil {
+		return nil, err
+	}
+	w := log.OutputWriter
+	if vars.shouldOutputJSON {
+		w = log.DiagnosticWriter
+	}
+	return &secretInitOpts{
+		secretInitVars: vars,
+
+		fs:     afero.NewOsFs(),
+		store:  store,
+		sel:    selector.NewSelect(prompter, store),
+		prompt: prompter,
+		guard:  guard,
+		w:      w,
+		jsonW:  log.OutputWriter,
+		newSecretClient: func(env *config.Environment) (secretReadWriter, error) {
+			sess, err := sessProvider.DefaultWithRegion(env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session in region %s: %w", env.Region, err)
+			}
+			if vars.provider == secretProviderSecretsManager {
+				return &secretsManagerReadWriter{secretsmanager.NewWithSession(sess)}, nil
+			}
+			return ssm.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the flag values are invalid.
+func (o *secretInitOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+	}
+	if err := validateSecretProvider(o.provider); err != nil {
+		return err
+	}
+	if o.envFilePath == "" {
+		return fmt.Errorf("--%s is required", fromEnvFileFlag)
+	}
+	content, err := afero.ReadFile(o.fs, o.envFilePath)
+	if err != nil {
+		return fmt.Errorf("read env file %s: %w", o.envFilePath, err)
+	}
+	secrets, err := parseEnvFile(content)
+	if err != nil {
+		return fmt.Errorf("parse env file %s: %w", o.envFilePath, err)
+	}
+	if len(secrets) == 0 {
+		return fmt.Errorf("env file %s does not contain any secrets", o.envFilePath)
+	}
+	o.secrets = secrets
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *secretInitOpts) Ask() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(secretInitAppPrompt, secretInitAppHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+// Execute compares the secrets in the env file against the parameters stored in each environment,
+// writes a summary of the differences, and creates or updates the secrets that differ.
+func (o *secretInitOpts) Execute() error {
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	if len(envs) == 0 {
+		return fmt.Errorf("no environments found in application %s", o.appName)
+	}
+	clients := make(map[string]secretReadWriter)
+	for _, env := range envs {
+		client, err := o.newSecretClient(env)
+		if err != nil {
+			return err
+		}
+		clients[env.Name] = client
+		changes, err := o.diff(env.Name, client)
+		if err != nil {
+			return err
+		}
+		o.changes = append(o.changes, changes...)
+	}
+
+	o.writeSummary()
+	if !o.hasChanges() {
+		log.Infoln("All secrets are up to date. No changes to apply.")
+		return o.writeJSON()
+	}
+	if !o.skipConfirmation {
+		confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtSecretInitConfirm, o.fmtChangeCounts(), o.appName), "")
+		if err != nil {
+			return fmt.Errorf("confirm secret changes: %w", err)
+		}
+		if !confirmed {
+			return errSecretInitCancelled
+		}
+	}
+	for _, env := range envs {
+		if !o.hasChangesIn(env.Name) {
+			continue
+		}
+		if err := o.guard.Confirm(env, o.skipConfirmation); err != nil {
+			return err
+		}
+	}
+
+	for _, change := range o.changes {
+		if change.action == secretUnchanged {
+			continue
+		}
+		err := clients[change.env].PutSecret(ssm.PutSecretInput{
+			Name:      secretParameterName(o.appName, change.env, change.name),
+			Value:     change.value,
+			Overwrite: change.action == secretUpdate,
+			Tags: map[string]string{
+				deploy.AppTagKey: o.appName,
+				deploy.EnvTagKey: change.env,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("put secret %s in environment %s: %w", change.name, change.env, err)
+		}
+		if change.action == secretCreate {
+			log.Successf("Created secret %s in environment %s.\n", color.HighlightUserInput(change.name), color.HighlightUserInput(change.env))
+		} else {
+			log.Successf("Updated secret %s in environment %s.\n", color.HighlightUserInput(change.name), color.HighlightUserInput(change.env))
+		}
+	}
+	return o.writeJSON()
+}
+
+// writeJSON writes the action taken for each secret to stdout if the result should be written as JSON.
+func (o *secretInitOpts) writeJSON() error {
+	if !o.shouldOutputJSON {
+		return nil
+	}
+	out := secretInitOutput{
+		Application: o.appName,
+		Secrets:     make([]secretInitOutputSecret, len(o.changes)),
+	}
+	for i, change := range o.changes {
+		out.Secrets[i] = secretInitOutputSecret{
+			Name:        change.name,
+			Environment: change.env,
+			Parameter:   secretParameterName(o.appName, change.env, change.name),
+			Action:      change.action.String(),
+		}
+	}
+	return writeJSON(o.jsonW, out)
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *secretInitOpts) RecommendedActions() []string {
+	if !o.hasChanges() {
+		return nil
+	}
+	return []string{
+		fmt.Sprintf("Reference the secrets under the %s section of your manifest by name, for example %s.",
+			color.HighlightCode("secrets"),
+			color.HighlightResource(o.manifestReference(o.secrets[0].name))),
+		fmt.Sprintf("Run %s to deploy your service with the secrets.", color.HighlightCode("copilot svc deploy")),
+	}
+}
+
+func (o *secretInitOpts) diff(env string, client secretReadWriter) ([]secretChange, error) {
+	var changes []secretChange
+	for _, secret := range o.secrets {
+		change := secretChange{
+			env:   env,
+			name:  secret.name,
+			value: secret.value,
+		}
+		name := secretParameterName(o.appName, env, secret.name)
+		value, err := client.SecretValue(name)
+		switch {
+		case isSecretNotFound(err):
+			change.action = secretCreate
+		case err != nil:
+			return nil, fmt.Errorf("get secret %s in environment %s: %w", secret.name, env, err)
+		case value != secret.value:
+			change.action = secretUpdate
+		default:
+			change.action = secretUnchanged
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+func (o *secretInitOpts) writeSummary() {
+	var env string
+	for _, change := range o.changes {
+		if change.env != env {
+			env = change.env
+			fmt.Fprintf(o.w, "Environment %s\n", env)
+		}
+		switch change.action {
+		case secretCreate:
+			fmt.Fprintf(o.w, "  + %s (create)\n", change.name)
+		case secretUpdate:
+			fmt.Fprintf(o.w, "  ~ %s (update)\n", change.name)
+		default:
+			fmt.Fprintf(o.w, "    %s (unchanged)\n", change.name)
+		}
+	}
+	fmt.Fprintf(o.w, "\n%s, %d unchanged.\n\n", o.fmtChangeCounts(), o.count(secretUnchanged))
+}
+
+func (o *secretInitOpts) fmtChangeCounts() string {
+	return fmt.Sprintf("%d to create, %d to update", o.count(secretCreate), o.count(secretUpdate))
+}
+
+func (o *secretInitOpts) count(action secretAction) int {
+	var n int
+	for _, change := range o.changes {
+		if change.action == action {
+			n++
+		}
+	}
+	return n
+}
+
+func (o *secretInitOpts) hasChanges() bool {
+	return o.count(secretCreate)+o.count(secretUpdate) > 0
+}
+
+func (o *secretInitOpts) hasChangesIn(env string) bool {
+	for _, change := range o.changes {
+		if change.env == env && change.action != secretUnchanged {
+			return true
+		}
+	}
+	return false
+}
+
+// manifestReference returns how a secret stored by the command is referenced in a manifest.
+func (o *secretInitOpts) manifestReference(name string) string {
+	ref := secretParameterName(o.appName, "<env>", name)
+	if o.provider == secretProviderSecretsManager {
+		return template.SecretsManagerValueFromPrefix + ref
+	}
+	return ref
+}
+
+func secretParameterName(app, env, name string) string {
+	return fmt.Sprintf(fmtSecretParameterName, app, env, name)
+}
+
+func isSecretNotFound(err error) bool {
+	var errParamNotFound *ssm.ErrParameterNotFound
+	var errSecretNotFound *secretsmanager.ErrSecretNotFound
+	return errors.As(err, &errParamNotFound) || errors.As(err, &errSecretNotFound)
+}
+
+// secretsManagerReadWriter stores secrets in AWS Secrets Manager instead of the SSM parameter store.
+type secretsManagerReadWriter struct {
+	*secretsmanager.SecretsManager
+}
+
+// PutSecret creates or updates the secret in Secrets Manager.
+func (s *secretsManagerReadWriter) PutSecret(in ssm.PutSecretInput) error {
+	return s.SecretsManager.PutSecret(secretsmanager.PutSecretInput{
+		Name:      in.Name,
+		Value:     in.Value,
+		Overwrite: in.Overwrite,
+		Tags:      in.Tags,
+	})
+}
+
+// parseEnvFile returns the secrets defined by the KEY=VALUE lines of a dotenv file.
+// Secrets must have a valid name and a non-empty value.
+func parseEnvFile(content []byte) ([]envFileSecret, error) {
+	vars, err := dotenv.Parse(content)
+	if err != nil {
+		return nil, err
+	}
+	secrets := make([]envFileSecret, 0, len(vars))
+	for _, v := range vars {
+		if !secretNameRegExp.MatchString(v.Key) {
+			return nil, fmt.Errorf("line %d: secret name %s can only contain letters, numbers, periods, hyphens and underscores", v.Line, v.Key)
+		}
+		if v.Value == "" {
+			return nil, fmt.Errorf("line %d: secret %s has an empty value", v.Line, v.Key)
+		}
+		secrets = append(secrets, envFileSecret{
+			name:  v.Key,
+			value: v.Value,
+		})
+	}
+	return secrets, nil
+}
+
+// buildSecretInitCmd builds the command for creating secrets.
+func buildSecretInitCmd() *cobra.Command {
+	vars := secretInitVars{}
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Create or update secrets in every environment of an application.",
+		Long: `Create or update secrets in every environment of an application.
+Each KEY=VALUE line of the env file is stored as a SecureString parameter,
+or a Secrets Manager secret, named /copilot/<app>/<env>/secrets/<KEY>.`,
+		Example: `
+  Create or update the secrets in secrets.env across all environments.
+  /code $ copilot secret init --from-env-file ./secrets.env
+
+  Apply the changes without prompting for confirmation.
+  /code $ copilot secret init --from-env-file ./secrets.env --yes
+
+  Store the secrets in AWS Secrets Manager instead of the SSM parameter store.
+  /code $ copilot secret init --from-env-file ./secrets.env --provider secretsmanager`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.shouldOutputJSON = shouldOutputJSON(cmd)
+			opts, err := newSecretInitOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			actions := opts.RecommendedActions()
+			if len(actions) == 0 {
+				return nil
+			}
+			log.Infoln()
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range actions {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.envFilePath, fromEnvFileFlag, "", fromEnvFileFlagDescription)
+	cmd.Flags().StringVar(&vars.provider, secretProviderFlag, secretProviderSSM, secretProviderFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,451 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretInitOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName     string
+		inEnvFilePath string
+		inEnvFile     string
+		inProvider    string
+		setupMocks    func(m *mocks.Mockstore)
+
+		wantedSecrets []envFileSecret
+		wantedErr     error
+	}{
+		"invalid app name": {
+			inAppName: "phonetool",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get application phonetool: some error"),
+		},
+		"invalid provider": {
+			inProvider: "vault",
+			wantedErr:  errors.New(`invalid secret provider vault: must be one of "ssm", "secretsmanager"`),
+		},
+		"env file is required": {
+			wantedErr: errors.New("--from-env-file is required"),
+		},
+		"env file does not exist": {
+			inEnvFilePath: "missing.env",
+			wantedErr:     errors.New("read env file missing.env: open missing.env: file does not exist"),
+		},
+		"line without a value": {
+			inEnvFilePath: "secrets.env",
+			inEnvFile:     "# Database\nDB_PASSWORD\n",
+			wantedErr:     errors.New("parse env file secrets.env: line 2: \"DB_PASSWORD\" must be of the form \"KEY=VALUE\""),
+		},
+		"invalid secret name": {
+			inEnvFilePath: "secrets.env",
+			inEnvFile:     "DB/PASSWORD=hunter2\n",
+			wantedErr:     errors.New("parse env file secrets.env: line 1: secret name DB/PASSWORD can only contain letters, numbers, periods, hyphens and underscores"),
+		},
+		"empty value": {
+			inEnvFilePath: "secrets.env",
+			inEnvFile:     "DB_PASSWORD=\"\"\n",
+			wantedErr:     errors.New("parse env file secrets.env: line 1: secret DB_PASSWORD has an empty value"),
+		},
+		"duplicate secret": {
+			inEnvFilePath: "secrets.env",
+			inEnvFile:     "DB_PASSWORD=hunter2\nDB_PASSWORD=hunter3\n",
+			wantedErr:     errors.New("parse env file secrets.env: line 2: variable DB_PASSWORD is defined more than once"),
+		},
+		"no secrets": {
+			inEnvFilePath: "secrets.env",
+			inEnvFile:     "# Nothing yet\n\n",
+			wantedErr:     errors.New("env file secrets.env does not contain any secrets"),
+		},
+		"parses the env file": {
+			inAppName:     "phonetool",
+			inEnvFilePath: "secrets.env",
+			inEnvFile: `# Database
+DB_PASSWORD=hunter2
+
+export API_KEY = "abc=123"
+GITHUB_TOKEN='ghp_xyz'
+`,
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+			},
+			wantedSecrets: []envFileSecret{
+				{name: "DB_PASSWORD", value: "hunter2"},
+				{name: "API_KEY", value: "abc=123"},
+				{name: "GITHUB_TOKEN", value: "ghp_xyz"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockStore)
+			}
+			fs := afero.NewMemMapFs()
+			if tc.inEnvFile != "" {
+				require.NoError(t, afero.WriteFile(fs, tc.inEnvFilePath, []byte(tc.inEnvFile), 0644))
+			}
+			if tc.inProvider == "" {
+				tc.inProvider = secretProviderSSM
+			}
+			opts := secretInitOpts{
+				secretInitVars: secretInitVars{
+					appName:     tc.inAppName,
+					envFilePath: tc.inEnvFilePath,
+					provider:    tc.inProvider,
+				},
+				fs:    fs,
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSecrets, opts.secrets)
+			}
+		})
+	}
+}
+
+func TestSecretInitOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		setupMocks func(m *mocks.MockappSelector)
+
+		wantedAppName string
+		wantedErr     error
+	}{
+		"skips prompting if the app name is set": {
+			inAppName:     "phonetool",
+			setupMocks:    func(m *mocks.MockappSelector) {},
+			wantedAppName: "phonetool",
+		},
+		"prompts for the app name": {
+			setupMocks: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(secretInitAppPrompt, secretInitAppHelpPrompt).Return("phonetool", nil)
+			},
+			wantedAppName: "phonetool",
+		},
+		"wraps the selector error": {
+			setupMocks: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(secretInitAppPrompt, secretInitAppHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select application: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSel := mocks.NewMockappSelector(ctrl)
+			tc.setupMocks(mockSel)
+			opts := secretInitOpts{
+				secretInitVars: secretInitVars{
+					appName: tc.inAppName,
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedAppName, opts.appName)
+			}
+		})
+	}
+}
+
+func TestSecretInitOpts_Execute(t *testing.T) {
+	testEnvs := []*config.Environment{
+		{Name: "test", Region: "us-west-2"},
+		{Name: "prod", Region: "us-east-1"},
+	}
+	testSecrets := []envFileSecret{
+		{name: "DB_PASSWORD", value: "hunter2"},
+		{name: "API_KEY", value: "abc"},
+	}
+	notFound := func() error {
+		return fmt.Errorf("wrapped: %w", &ssm.ErrParameterNotFound{})
+	}
+	testCases := map[string]struct {
+		inSkipConfirmation bool
+		inJSON             bool
+		setupMocks         func(store *mocks.Mockstore, prompt *mocks.Mockprompter, clients map[string]*mocks.MocksecretReadWriter)
+		setupGuard         func(m *mocks.MocktargetConfirmer)
+
+		wantedSummary string
+		wantedJSON    string
+		wantedErr     error
+	}{
+		"wraps the list environments error": {
+			setupMocks: func(store *mocks.Mockstore, _ *mocks.Mockprompter, _ map[string]*mocks.MocksecretReadWriter) {
+				store.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list environments in application phonetool: some error"),
+		},
+		"errors if the app has no environments": {
+			setupMocks: func(store *mocks.Mockstore, _ *mocks.Mockprompter, _ map[string]*mocks.MocksecretReadWriter) {
+				store.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+			},
+			wantedErr: errors.New("no environments found in application phonetool"),
+		},
+		"wraps the get secret error": {
+			setupMocks: func(store *mocks.Mockstore, _ *mocks.Mockprompter, clients map[string]*mocks.MocksecretReadWriter) {
+				store.EXPECT().ListEnvironments("phonetool").Return(testEnvs, nil)
+				clients["test"].EXPECT().SecretValue("/copilot/phonetool/test/secrets/DB_PASSWORD").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("get secret DB_PASSWORD in environment test: some error"),
+		},
+		"makes no changes if every secret is up to date": {
+			setupMocks: func(store *mocks.Mockstore, _ *mocks.Mockprompter, clients map[string]*mocks.MocksecretReadWriter) {
+				store.EXPECT().ListEnvironments("phonetool").Return(testEnvs, nil)
+				for _, env := range []string{"test", "prod"} {
+					clients[env].EXPECT().SecretValue(fmt.Sprintf("/copilot/phonetool/%s/secrets/DB_PASSWORD", env)).Return("hunter2", nil)
+					clients[env].EXPECT().SecretValue(fmt.Sprintf("/copilot/phonetool/%s/secrets/API_KEY", env)).Return("abc", nil)
+				}
+			},
+			wantedSummary: `Environment test
+    DB_PASSWORD (unchanged)
+    API_KEY (unchanged)
+Environment prod
+    DB_PASSWORD (unchanged)
+    API_KEY (unchanged)
+
+0 to create, 0 to update, 4 unchanged.
+
+`,
+		},
+		"cancels if the user does not confirm": {
+			setupMocks: func(store *mocks.Mockstore, prompt *mocks.Mockprompter, clients map[string]*mocks.MocksecretReadWriter) {
+				store.EXPECT().ListEnvironments("phonetool").Return(testEnvs[:1], nil)
+				clients["test"].EXPECT().SecretValue("/copilot/phonetool/test/secrets/DB_PASSWORD").Return("", notFound())
+				clients["test"].EXPECT().SecretValue("/copilot/phonetool/test/secrets/API_KEY").Return("abc", nil)
+				prompt.EXPECT().Confirm("Apply 1 to create, 0 to update to the secrets of application phonetool?", "").Return(false, nil)
+			},
+			wantedSummary: `Environment test
+  + DB_PASSWORD (create)
+    API_KEY (unchanged)
+
+1 to create, 0 to update, 1 unchanged.
+
+`,
+			wantedErr: errSecretInitCancelled,
+		},
+		"creates and updates the secrets that differ": {
+			inSkipConfirmation: true,
+			setupMocks: func(store *mocks.Mockstore, _ *mocks.Mockprompter, clients map[string]*mocks.MocksecretReadWriter) {
+				store.EXPECT().ListEnvironments("phonetool").Return(testEnvs, nil)
+				clients["test"].EXPECT().SecretValue("/copilot/phonetool/test/secrets/DB_PASSWORD").Return("hunter2", nil)
+				clients["test"].EXPECT().SecretValue("/copilot/phonetool/test/secrets/API_KEY").Return("old", nil)
+				clients["prod"].EXPECT().SecretValue("/copilot/phonetool/prod/secrets/DB_PASSWORD").Return("", notFound())
+				clients["prod"].EXPECT().SecretValue("/copilot/phonetool/prod/secrets/API_KEY").Return("abc", nil)
+				clients["test"].EXPECT().PutSecret(ssm.PutSecretInput{
+					Name:      "/copilot/phonetool/test/secrets/API_KEY",
+					Value:     "abc",
+					Overwrite: true,
+					Tags: map[string]string{
+						"copilot-application": "phonetool",
+						"copilot-environment": "test",
+					},
+				}).Return(nil)
+				clients["prod"].EXPECT().PutSecret(ssm.PutSecretInput{
+					Name:  "/copilot/phonetool/prod/secrets/DB_PASSWORD",
+					Value: "hunter2",
+					Tags: map[string]string{
+						"copilot-application": "phonetool",
+						"copilot-environment": "prod",
+					},
+				}).Return(nil)
+			},
+			setupGuard: func(m *mocks.MocktargetConfirmer) {
+				m.EXPECT().Confirm(testEnvs[0], true).Return(nil)
+				m.EXPECT().Confirm(testEnvs[1], true).Return(nil)
+			},
+			wantedSummary: `Environment test
+    DB_PASSWORD (unchanged)
+  ~ API_KEY (update)
+Environment prod
+  + DB_PASSWORD (create)
+    API_KEY (unchanged)
+
+1 to create, 1 to update, 2 unchanged.
+
+`,
+		},
+		"does not put any secret if the target of an environment is not confirmed": {
+			setupMocks: func(store *mocks.Mockstore, prompt *mocks.Mockprompter, clients map[string]*mocks.MocksecretReadWriter) {
+				store.EXPECT().ListEnvironments("phonetool").Return(testEnvs, nil)
+				clients["test"].EXPECT().SecretValue("/copilot/phonetool/test/secrets/DB_PASSWORD").Return("hunter2", nil)
+				clients["test"].EXPECT().SecretValue("/copilot/phonetool/test/secrets/API_KEY").Return("old", nil)
+				clients["prod"].EXPECT().SecretValue("/copilot/phonetool/prod/secrets/DB_PASSWORD").Return("", notFound())
+				clients["prod"].EXPECT().SecretValue("/copilot/phonetool/prod/secrets/API_KEY").Return("abc", nil)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil)
+				clients["test"].EXPECT().PutSecret(gomock.Any()).Times(0)
+				clients["prod"].EXPECT().PutSecret(gomock.Any()).Times(0)
+			},
+			setupGuard: func(m *mocks.MocktargetConfirmer) {
+				m.EXPECT().Confirm(testEnvs[0], false).Return(nil)
+				m.EXPECT().Confirm(testEnvs[1], false).Return(errTargetNotConfirmed)
+			},
+			wantedSummary: `Environment test
+    DB_PASSWORD (unchanged)
+  ~ API_KEY (update)
+Environment prod
+  + DB_PASSWORD (create)
+    API_KEY (unchanged)
+
+1 to create, 1 to update, 2 unchanged.
+
+`,
+			wantedErr: errTargetNotConfirmed,
+		},
+		"creates the secrets that are missing from Secrets Manager": {
+			inSkipConfirmation: true,
+			setupMocks: func(store *mocks.Mockstore, _ *mocks.Mockprompter, clients map[string]*mocks.MocksecretReadWriter) {
+				store.EXPECT().ListEnvironments("phonetool").Return(testEnvs[:1], nil)
+				clients["test"].EXPECT().SecretValue("/copilot/phonetool/test/secrets/DB_PASSWORD").Return("", &secretsmanager.ErrSecretNotFound{})
+				clients["test"].EXPECT().SecretValue("/copilot/phonetool/test/secrets/API_KEY").Return("abc", nil)
+				clients["test"].EXPECT().PutSecret(ssm.PutSecretInput{
+					Name:  "/copilot/phonetool/test/secrets/DB_PASSWORD",
+					Value: "hunter2",
+					Tags: map[string]string{
+						"copilot-application": "phonetool",
+						"copilot-environment": "test",
+					},
+				}).Return(nil)
+			},
+			setupGuard: func(m *mocks.MocktargetConfirmer) {
+				m.EXPECT().Confirm(testEnvs[0], true).Return(nil)
+			},
+			wantedSummary: `Environment test
+  + DB_PASSWORD (create)
+    API_KEY (unchanged)
+
+1 to create, 0 to update, 1 unchanged.
+
+`,
+		},
+		"writes the changes as JSON": {
+			inSkipConfirmation: true,
+			inJSON:             true,
+			setupMocks: func(store *mocks.Mockstore, _ *mocks.Mockprompter, clients map[string]*mocks.MocksecretReadWriter) {
+				store.EXPECT().ListEnvironments("phonetool").Return(testEnvs[:1], nil)
+				clients["test"].EXPECT().SecretValue("/copilot/phonetool/test/secrets/DB_PASSWORD").Return("", notFound())
+				clients["test"].EXPECT().SecretValue("/copilot/phonetool/test/secrets/API_KEY").Return("abc", nil)
+				clients["test"].EXPECT().PutSecret(gomock.Any()).Return(nil)
+			},
+			setupGuard: func(m *mocks.MocktargetConfirmer) {
+				m.EXPECT().Confirm(testEnvs[0], true).Return(nil)
+			},
+			wantedSummary: `Environment test
+  + DB_PASSWORD (create)
+    API_KEY (unchanged)
+
+1 to create, 0 to update, 1 unchanged.
+
+`,
+			wantedJSON: `{"application":"phonetool","secrets":[{"name":"DB_PASSWORD","environment":"test","parameter":"/copilot/phonetool/test/secrets/DB_PASSWORD","action":"create"},{"name":"API_KEY","environment":"test","parameter":"/copilot/phonetool/test/secrets/API_KEY","action":"unchanged"}]}
+`,
+		},
+		"wraps the put secret error": {
+			inSkipConfirmation: true,
+			setupMocks: func(store *mocks.Mockstore, _ *mocks.Mockprompter, clients map[string]*mocks.MocksecretReadWriter) {
+				store.EXPECT().ListEnvironments("phonetool").Return(testEnvs[:1], nil)
+				clients["test"].EXPECT().SecretValue(gomock.Any()).Return("", notFound()).Times(2)
+				clients["test"].EXPECT().PutSecret(gomock.Any()).Return(errors.New("some error"))
+			},
+			setupGuard: func(m *mocks.MocktargetConfirmer) {
+				m.EXPECT().Confirm(testEnvs[0], true).Return(nil)
+			},
+			wantedSummary: `Environment test
+  + DB_PASSWORD (create)
+  + API_KEY (create)
+
+2 to create, 0 to update, 0 unchanged.
+
+`,
+			wantedErr: errors.New("put secret DB_PASSWORD in environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			clients := map[string]*mocks.MocksecretReadWriter{
+				"test": mocks.NewMocksecretReadWriter(ctrl),
+				"prod": mocks.NewMocksecretReadWriter(ctrl),
+			}
+			mockGuard := mocks.NewMocktargetConfirmer(ctrl)
+			tc.setupMocks(mockStore, mockPrompt, clients)
+			if tc.setupGuard != nil {
+				tc.setupGuard(mockGuard)
+			}
+			b := &strings.Builder{}
+			jsonB := &strings.Builder{}
+			opts := secretInitOpts{
+				secretInitVars: secretInitVars{
+					appName:          "phonetool",
+					skipConfirmation: tc.inSkipConfirmation,
+					shouldOutputJSON: tc.inJSON,
+				},
+				store:  mockStore,
+				prompt: mockPrompt,
+				guard:  mockGuard,
+				w:      b,
+				jsonW:  jsonB,
+				newSecretClient: func(env *config.Environment) (secretReadWriter, error) {
+					return clients[env.Name], nil
+				},
+				secrets: testSecrets,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedSummary, b.String())
+			require.Equal(t, tc.wantedJSON, jsonB.String())
+		})
+	}
+}
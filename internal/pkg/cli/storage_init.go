@@ -7,9 +7,15 @@ import (
 	"encoding"
 	"errors"
 	"fmt"
+	"strconv"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/pricing"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/cost"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -22,28 +28,40 @@ import (
 )
 
 const (
-	dynamoDBStorageType = "DynamoDB"
-	s3StorageType       = "S3"
-	rdsStorageType      = "Aurora"
+	dynamoDBStorageType    = "DynamoDB"
+	s3StorageType          = "S3"
+	rdsStorageType         = "Aurora"
+	rdsInstanceStorageType = "RDS"
+	redisStorageType       = "Redis"
+	efsStorageType         = "EFS"
 )
 
 var storageTypes = []string{
 	dynamoDBStorageType,
 	s3StorageType,
 	rdsStorageType,
+	rdsInstanceStorageType,
+	redisStorageType,
+	efsStorageType,
 }
 
 // Displayed options for storage types
 const (
-	dynamoDBStorageTypeOption = "DynamoDB"
-	s3StorageTypeOption       = "S3"
-	rdsStorageTypeOption      = "Aurora Serverless"
+	dynamoDBStorageTypeOption    = "DynamoDB"
+	s3StorageTypeOption          = "S3"
+	rdsStorageTypeOption         = "Aurora Serverless"
+	rdsInstanceStorageTypeOption = "RDS"
+	redisStorageTypeOption       = "ElastiCache Redis"
+	efsStorageTypeOption         = "EFS"
 )
 
 var optionToStorageType = map[string]string{
-	dynamoDBStorageTypeOption: dynamoDBStorageType,
-	s3StorageTypeOption:       s3StorageType,
-	rdsStorageTypeOption:      rdsStorageType,
+	dynamoDBStorageTypeOption:    dynamoDBStorageType,
+	s3StorageTypeOption:          s3StorageType,
+	rdsStorageTypeOption:         rdsStorageType,
+	rdsInstanceStorageTypeOption: rdsInstanceStorageType,
+	redisStorageTypeOption:       redisStorageType,
+	efsStorageTypeOption:         efsStorageType,
 }
 
 var storageTypeOptions = map[string]prompt.Option{
@@ -59,12 +77,27 @@ var storageTypeOptions = map[string]prompt.Option{
 		Value: rdsStorageTypeOption,
 		Hint:  "SQL",
 	},
+	rdsInstanceStorageType: {
+		Value: rdsInstanceStorageTypeOption,
+		Hint:  "Provisioned SQL",
+	},
+	redisStorageType: {
+		Value: redisStorageTypeOption,
+		Hint:  "In-memory",
+	},
+	efsStorageType: {
+		Value: efsStorageTypeOption,
+		Hint:  "File system",
+	},
 }
 
 const (
 	s3BucketFriendlyText      = "S3 Bucket"
 	dynamoDBTableFriendlyText = "DynamoDB Table"
 	rdsFriendlyText           = "Database Cluster"
+	rdsInstanceFriendlyText   = "Database Instance"
+	redisFriendlyText         = "Redis Cluster"
+	efsFriendlyText           = "EFS File System"
 )
 
 // General-purpose prompts, collected for all storage resources.
@@ -74,6 +107,9 @@ var (
 DynamoDB is a key-value and document database that delivers single-digit millisecond performance at any scale.
 S3 is a web object store built to store and retrieve any amount of data from anywhere on the Internet.
 Aurora Serverless is an on-demand autoscaling configuration for Amazon Aurora, a MySQL and PostgreSQL-compatible relational database.
+RDS provisions a MySQL or PostgreSQL database instance with a fixed instance class and storage size.
+ElastiCache Redis is a fully managed in-memory data store, compatible with Redis, that you can use as a cache or a message broker.
+EFS is a serverless, elastic file system that your workload's tasks can mount and share.
 `
 
 	fmtStorageInitNamePrompt = "What would you like to " + color.Emphasize("name") + " this %s?"
@@ -100,6 +136,15 @@ partition key but a different sort key. You may specify up to 5 alternate sort k
 
 	storageInitDDBMoreLSIPrompt = "Would you like to add more alternate sort keys to this table?"
 
+	storageInitDDBBillingModePrompt = "How would you like to be " + color.Emphasize("charged") + " for reads and writes on this table?"
+	storageInitDDBBillingModeHelp   = `OnDemand tables are charged per request and serve any amount of traffic without capacity planning.
+Provisioned tables are charged for the read and write capacity units they provision, which auto scale between a minimum and a maximum.`
+
+	storageInitDDBMinCapacityPrompt = "What is the " + color.Emphasize("minimum") + " number of read and write capacity units of this table?"
+	storageInitDDBMaxCapacityPrompt = "What is the " + color.Emphasize("maximum") + " number of read and write capacity units that this table can scale to?"
+	storageInitDDBCapacityHelp      = `Auto scaling adjusts the provisioned read and write capacity units of the table between the minimum and the maximum
+to keep the consumed capacity at 70% of the provisioned capacity.`
+
 	storageInitDDBLSINamePrompt = "What would you like to name this " + color.Emphasize("alternate sort key") + "?"
 	storageInitDDBLSINameHelp   = "You can use the characters [a-zA-Z0-9.-_]"
 )
@@ -107,8 +152,19 @@ partition key but a different sort key. You may specify up to 5 alternate sort k
 // DynamoDB specific constants and variables.
 const (
 	ddbKeyString = "key"
+
+	ddbBillingModeOnDemand    = "OnDemand"
+	ddbBillingModeProvisioned = "Provisioned"
+
+	defaultDDBMinCapacity = 5
+	defaultDDBMaxCapacity = 50
 )
 
+var ddbBillingModes = []string{
+	ddbBillingModeOnDemand,
+	ddbBillingModeProvisioned,
+}
+
 const (
 	ddbStringType = "String"
 	ddbIntType    = "Number"
@@ -127,9 +183,27 @@ var (
 	storageInitRDSDBEnginePrompt      = "Which database engine would you like to use?"
 )
 
-// RDS Aurora Serverless specific constants and variables.
+// RDS instance specific questions and help prompts.
+var (
+	storageInitRDSInstanceClassPrompt = "Which " + color.Emphasize("instance class") + " would you like to use?"
+	storageInitRDSInstanceClassHelp   = `The instance class determines the compute and memory capacity of the database instance.
+See https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/Concepts.DBInstanceClass.html`
+
+	storageInitRDSAllocatedStoragePrompt = "How much " + color.Emphasize("storage") + " (in GiB) would you like to allocate to the instance?"
+	storageInitRDSAllocatedStorageHelp   = "The amount of general purpose (SSD) storage of the database instance, between 20 and 65536 GiB."
+
+	storageInitRDSMultiAZConfirm = "Would you like to run a " + color.Emphasize("standby instance") + " in another Availability Zone?"
+	storageInitRDSMultiAZHelp    = `A Multi-AZ deployment synchronously replicates the data to a standby instance in another Availability Zone
+and fails over to it automatically. It doubles the cost of the instance.`
+)
+
+// RDS Aurora Serverless and RDS instance specific constants and variables.
 const (
-	fmtRDSStorageNameDefault = "%s-cluster"
+	fmtRDSStorageNameDefault         = "%s-cluster"
+	fmtRDSInstanceStorageNameDefault = "%s-db"
+
+	defaultRDSInstanceClass    = "db.t3.micro"
+	defaultRDSAllocatedStorage = 20
 
 	engineTypeMySQL      = "MySQL"
 	engineTypePostgreSQL = "PostgreSQL"
@@ -140,6 +214,27 @@ var engineTypes = []string{
 	engineTypePostgreSQL,
 }
 
+// ElastiCache Redis specific constants.
+const (
+	fmtRedisStorageNameDefault = "%s-redis"
+)
+
+// EFS specific constants.
+const (
+	fmtEFSStorageNameDefault = "%s-efs"
+	fmtEFSMountPath          = "/mnt/%s"
+
+	// The volume added to the workload manifest. The file system and access point IDs refer to the outputs of the addon.
+	fmtEFSVolumeSnippet = `path: %s
+read_only: false
+efs:
+  id: %sFileSystem
+  auth:
+    iam: true
+    access_point_id: %sAccessPoint
+`
+)
+
 type initStorageVars struct {
 	storageType  string
 	storageName  string
@@ -151,20 +246,33 @@ type initStorageVars struct {
 	lsiSorts     []string // lsi sort keys collected as "name:T" where T is one of [SNB]
 	noLSI        bool
 	noSort       bool
+	billingMode  string
+	minCapacity  int
+	maxCapacity  int
+	ttlAttribute string
+	enablePITR   bool
 
 	// RDS Aurora Serverless specific values collected via flags or prompts
 	rdsEngine         string
 	rdsParameterGroup string
 	rdsInitialDBName  string
+
+	// RDS instance specific values collected via flags or prompts
+	rdsInstanceClass    string
+	rdsAllocatedStorage int
+
+	skipCostEstimate bool
 }
 
 type initStorageOpts struct {
 	initStorageVars
-	appName string
+	appName    string
+	rdsMultiAZ *bool // Whether the RDS instance is deployed in multiple Availability Zones, nil if not specified.
 
-	fs    afero.Fs
-	ws    wsAddonManager
-	store store
+	fs        afero.Fs
+	ws        wsAddonManager
+	store     store
+	estimator costEstimator
 
 	sel    wsSelector
 	prompt prompter
@@ -181,16 +289,22 @@ func newStorageInitOpts(vars initStorageVars) (*initStorageOpts, error) {
 		return nil, fmt.Errorf("new workspace client: %w", err)
 	}
 
+	sess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, err
+	}
+
 	prompter := prompt.New()
 	return &initStorageOpts{
 		initStorageVars: vars,
 		appName:         tryReadingAppName(),
 
-		fs:     &afero.Afero{Fs: afero.NewOsFs()},
-		store:  store,
-		ws:     ws,
-		sel:    selector.NewWorkspaceSelect(prompter, store, ws),
-		prompt: prompter,
+		fs:        &afero.Afero{Fs: afero.NewOsFs()},
+		store:     store,
+		ws:        ws,
+		estimator: cost.New(aws.StringValue(sess.Config.Region), pricing.New(sess)),
+		sel:       selector.NewWorkspaceSelect(prompter, store, ws),
+		prompt:    prompter,
 	}, nil
 }
 
@@ -215,7 +329,7 @@ func (o *initStorageOpts) Validate() error {
 			err = dynamoTableNameValidation(o.storageName)
 		case s3StorageType:
 			err = s3BucketNameValidation(o.storageName)
-		case rdsStorageType:
+		case rdsStorageType, rdsInstanceStorageType, redisStorageType, efsStorageType:
 			err = rdsNameValidation(o.storageName)
 		default:
 			// use dynamo since it's a superset of s3
@@ -234,6 +348,16 @@ func (o *initStorageOpts) Validate() error {
 			return err
 		}
 	}
+	if o.rdsInstanceClass != "" {
+		if err := validateRDSInstanceClass(o.rdsInstanceClass); err != nil {
+			return fmt.Errorf("invalid instance class %s: %w", o.rdsInstanceClass, err)
+		}
+	}
+	if o.rdsAllocatedStorage != 0 {
+		if err := validateRDSAllocatedStorage(o.rdsAllocatedStorage); err != nil {
+			return fmt.Errorf("invalid allocated storage %d: %w", o.rdsAllocatedStorage, err)
+		}
+	}
 	return nil
 }
 
@@ -262,6 +386,43 @@ func (o *initStorageOpts) validateDDB() error {
 			return err
 		}
 	}
+	if o.billingMode != "" {
+		if err := validateDDBBillingMode(o.billingMode); err != nil {
+			return err
+		}
+	}
+	if o.billingMode == ddbBillingModeOnDemand && (o.minCapacity != 0 || o.maxCapacity != 0) {
+		return fmt.Errorf("validate capacity configuration: cannot specify --%s or --%s with the %s billing mode",
+			storageMinCapacityFlag, storageMaxCapacityFlag, ddbBillingModeOnDemand)
+	}
+	if o.minCapacity != 0 {
+		if err := validateDDBCapacity(o.minCapacity); err != nil {
+			return fmt.Errorf("invalid minimum capacity %d: %w", o.minCapacity, err)
+		}
+	}
+	if o.maxCapacity != 0 {
+		if err := validateDDBCapacity(o.maxCapacity); err != nil {
+			return fmt.Errorf("invalid maximum capacity %d: %w", o.maxCapacity, err)
+		}
+	}
+	if err := o.validateDDBCapacityRange(); err != nil {
+		return err
+	}
+	if o.ttlAttribute != "" {
+		if err := dynamoAttributeNameValidation(o.ttlAttribute); err != nil {
+			return fmt.Errorf("invalid TTL attribute %s: %w", o.ttlAttribute, err)
+		}
+	}
+	return nil
+}
+
+func (o *initStorageOpts) validateDDBCapacityRange() error {
+	if o.minCapacity == 0 || o.maxCapacity == 0 {
+		return nil
+	}
+	if o.maxCapacity < o.minCapacity {
+		return fmt.Errorf("maximum capacity %d must be greater than or equal to minimum capacity %d", o.maxCapacity, o.minCapacity)
+	}
 	return nil
 }
 
@@ -287,6 +448,12 @@ func (o *initStorageOpts) Ask() error {
 		if err := o.askDynamoLSIConfig(); err != nil {
 			return err
 		}
+		if err := o.askDynamoBillingMode(); err != nil {
+			return err
+		}
+		if err := o.askDynamoCapacity(); err != nil {
+			return err
+		}
 	case rdsStorageType:
 		if err := o.askAuroraEngineType(); err != nil {
 			return err
@@ -295,6 +462,22 @@ func (o *initStorageOpts) Ask() error {
 		if err := o.askAuroraInitialDBName(); err != nil {
 			return err
 		}
+	case rdsInstanceStorageType:
+		if err := o.askAuroraEngineType(); err != nil {
+			return err
+		}
+		if err := o.askAuroraInitialDBName(); err != nil {
+			return err
+		}
+		if err := o.askRDSInstanceClass(); err != nil {
+			return err
+		}
+		if err := o.askRDSAllocatedStorage(); err != nil {
+			return err
+		}
+		if err := o.askRDSMultiAZ(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -350,6 +533,12 @@ func (o *initStorageOpts) askStorageName() error {
 		friendlyText = dynamoDBTableFriendlyText
 	case rdsStorageType:
 		return o.askStorageNameWithDefault(rdsFriendlyText, fmt.Sprintf(fmtRDSStorageNameDefault, o.workloadName), rdsNameValidation)
+	case rdsInstanceStorageType:
+		return o.askStorageNameWithDefault(rdsInstanceFriendlyText, fmt.Sprintf(fmtRDSInstanceStorageNameDefault, o.workloadName), rdsNameValidation)
+	case redisStorageType:
+		return o.askStorageNameWithDefault(redisFriendlyText, fmt.Sprintf(fmtRedisStorageNameDefault, o.workloadName), rdsNameValidation)
+	case efsStorageType:
+		return o.askStorageNameWithDefault(efsFriendlyText, fmt.Sprintf(fmtEFSStorageNameDefault, o.workloadName), rdsNameValidation)
 	}
 
 	name, err := o.prompt.Get(fmt.Sprintf(fmtStorageInitNamePrompt,
@@ -516,6 +705,56 @@ func (o *initStorageOpts) askDynamoLSIConfig() error {
 	}
 }
 
+func (o *initStorageOpts) askDynamoBillingMode() error {
+	if o.billingMode != "" {
+		return nil
+	}
+	// Specifying the capacity range implies a provisioned table.
+	if o.minCapacity != 0 || o.maxCapacity != 0 {
+		o.billingMode = ddbBillingModeProvisioned
+		return nil
+	}
+	mode, err := o.prompt.SelectOne(storageInitDDBBillingModePrompt,
+		storageInitDDBBillingModeHelp,
+		ddbBillingModes,
+		prompt.WithFinalMessage("Billing mode:"))
+	if err != nil {
+		return fmt.Errorf("select DDB billing mode: %w", err)
+	}
+	o.billingMode = mode
+	return nil
+}
+
+func (o *initStorageOpts) askDynamoCapacity() error {
+	if o.billingMode != ddbBillingModeProvisioned {
+		return nil
+	}
+	if o.minCapacity == 0 {
+		capacity, err := o.prompt.Get(storageInitDDBMinCapacityPrompt,
+			storageInitDDBCapacityHelp,
+			validateDDBCapacity,
+			prompt.WithDefaultInput(strconv.Itoa(defaultDDBMinCapacity)),
+			prompt.WithFinalMessage("Minimum capacity:"))
+		if err != nil {
+			return fmt.Errorf("input DDB minimum capacity: %w", err)
+		}
+		// The input was already validated to be an integer.
+		o.minCapacity, _ = strconv.Atoi(capacity)
+	}
+	if o.maxCapacity == 0 {
+		capacity, err := o.prompt.Get(storageInitDDBMaxCapacityPrompt,
+			storageInitDDBCapacityHelp,
+			validateDDBCapacity,
+			prompt.WithDefaultInput(strconv.Itoa(defaultDDBMaxCapacity)),
+			prompt.WithFinalMessage("Maximum capacity:"))
+		if err != nil {
+			return fmt.Errorf("input DDB maximum capacity: %w", err)
+		}
+		o.maxCapacity, _ = strconv.Atoi(capacity)
+	}
+	return o.validateDDBCapacityRange()
+}
+
 func (o *initStorageOpts) askAuroraEngineType() error {
 	if o.rdsEngine != "" {
 		return nil
@@ -558,6 +797,53 @@ func (o *initStorageOpts) askAuroraInitialDBName() error {
 	return nil
 }
 
+func (o *initStorageOpts) askRDSInstanceClass() error {
+	if o.rdsInstanceClass != "" {
+		return nil
+	}
+	class, err := o.prompt.Get(storageInitRDSInstanceClassPrompt,
+		storageInitRDSInstanceClassHelp,
+		validateRDSInstanceClass,
+		prompt.WithDefaultInput(defaultRDSInstanceClass),
+		prompt.WithFinalMessage("Instance class:"))
+	if err != nil {
+		return fmt.Errorf("input instance class: %w", err)
+	}
+	o.rdsInstanceClass = class
+	return nil
+}
+
+func (o *initStorageOpts) askRDSAllocatedStorage() error {
+	if o.rdsAllocatedStorage != 0 {
+		return nil
+	}
+	size, err := o.prompt.Get(storageInitRDSAllocatedStoragePrompt,
+		storageInitRDSAllocatedStorageHelp,
+		validateRDSAllocatedStorage,
+		prompt.WithDefaultInput(strconv.Itoa(defaultRDSAllocatedStorage)),
+		prompt.WithFinalMessage("Allocated storage (GiB):"))
+	if err != nil {
+		return fmt.Errorf("input allocated storage: %w", err)
+	}
+	// The input was already validated to be an integer.
+	o.rdsAllocatedStorage, _ = strconv.Atoi(size)
+	return nil
+}
+
+func (o *initStorageOpts) askRDSMultiAZ() error {
+	if o.rdsMultiAZ != nil {
+		return nil
+	}
+	multiAZ, err := o.prompt.Confirm(storageInitRDSMultiAZConfirm,
+		storageInitRDSMultiAZHelp,
+		prompt.WithFinalMessage("Multi-AZ:"))
+	if err != nil {
+		return fmt.Errorf("confirm multi-AZ deployment: %w", err)
+	}
+	o.rdsMultiAZ = aws.Bool(multiAZ)
+	return nil
+}
+
 func (o *initStorageOpts) validateWorkloadName() error {
 	names, err := o.ws.WorkloadNames()
 	if err != nil {
@@ -576,6 +862,9 @@ func (o *initStorageOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	if !o.skipCostEstimate {
+		o.showCostEstimate(addonCf)
+	}
 
 	addonPath, err := o.ws.WriteAddon(addonCf, o.workloadName, o.storageName)
 	if err != nil {
@@ -599,6 +888,12 @@ func (o *initStorageOpts) Execute() error {
 		addonFriendlyText = s3BucketFriendlyText
 	case rdsStorageType:
 		addonFriendlyText = rdsFriendlyText
+	case rdsInstanceStorageType:
+		addonFriendlyText = rdsInstanceFriendlyText
+	case redisStorageType:
+		addonFriendlyText = redisFriendlyText
+	case efsStorageType:
+		addonFriendlyText = efsFriendlyText
 	default:
 		return fmt.Errorf(fmtErrInvalidStorageType, o.storageType, prettify(storageTypes))
 	}
@@ -607,11 +902,59 @@ func (o *initStorageOpts) Execute() error {
 		color.HighlightUserInput(o.storageName),
 		color.HighlightResource(addonPath),
 	)
+	if o.storageType == efsStorageType {
+		if err := o.addEFSVolume(); err != nil {
+			return err
+		}
+	}
 	log.Infoln()
 
 	return nil
 }
 
+// showCostEstimate prints the approximate monthly cost of the addon in the region of the default session.
+// The estimate is informational, so failing to compute it doesn't stop the addon from being written.
+func (o *initStorageOpts) showCostEstimate(addonCf encoding.BinaryMarshaler) {
+	tpl, err := addonCf.MarshalBinary()
+	if err != nil {
+		log.Warningf("Failed to estimate the monthly cost of %s: render template: %v\n", o.storageName, err)
+		return
+	}
+	estimate, err := o.estimator.Estimate(tpl)
+	if err != nil {
+		log.Warningf("Failed to estimate the monthly cost of %s: %v\n", o.storageName, err)
+		return
+	}
+	log.Infoln(estimate.HumanString())
+}
+
+// addEFSVolume adds a volume referencing the EFS addon's outputs to the workload's manifest.
+func (o *initStorageOpts) addEFSVolume() error {
+	mft, err := o.ws.ReadWorkloadManifest(o.workloadName)
+	if err != nil {
+		return err
+	}
+	id := template.StripNonAlphaNumFunc(o.storageName)
+	volume := fmt.Sprintf(fmtEFSVolumeSnippet, fmt.Sprintf(fmtEFSMountPath, o.storageName), id, id)
+	mft, err = manifest.AddVolume(mft, o.storageName, volume)
+	if err != nil {
+		return fmt.Errorf("add volume %s to the manifest of %s: %w", o.storageName, o.workloadName, err)
+	}
+	mftPath, err := o.ws.OverwriteWorkloadManifest(mft, o.workloadName)
+	if err != nil {
+		return err
+	}
+	mftPath, err = relPath(mftPath)
+	if err != nil {
+		return err
+	}
+	log.Successf("Added volume %s to the manifest at %s\n",
+		color.HighlightUserInput(o.storageName),
+		color.HighlightResource(mftPath),
+	)
+	return nil
+}
+
 func (o *initStorageOpts) newAddon() (encoding.BinaryMarshaler, error) {
 	switch o.storageType {
 	case dynamoDBStorageType:
@@ -620,6 +963,12 @@ func (o *initStorageOpts) newAddon() (encoding.BinaryMarshaler, error) {
 		return o.newS3Addon()
 	case rdsStorageType:
 		return o.newRDSAddon()
+	case rdsInstanceStorageType:
+		return o.newRDSInstanceAddon()
+	case redisStorageType:
+		return o.newRedisAddon()
+	case efsStorageType:
+		return o.newEFSAddon(), nil
 	default:
 		return nil, fmt.Errorf("storage type %s doesn't have a CF template", o.storageType)
 	}
@@ -648,6 +997,17 @@ func (o *initStorageOpts) newDynamoDBAddon() (*addon.DynamoDB, error) {
 		}
 	}
 
+	if o.billingMode == ddbBillingModeProvisioned {
+		props.Capacity = &addon.DDBCapacity{
+			Min: o.minCapacity,
+			Max: o.maxCapacity,
+		}
+	}
+	if o.ttlAttribute != "" {
+		props.TTLAttribute = aws.String(o.ttlAttribute)
+	}
+	props.PointInTimeRecovery = o.enablePITR
+
 	return addon.NewDynamoDB(&props), nil
 }
 
@@ -685,6 +1045,52 @@ func (o *initStorageOpts) newRDSAddon() (*addon.RDS, error) {
 	}), nil
 }
 
+func (o *initStorageOpts) newRDSInstanceAddon() (*addon.RDSInstance, error) {
+	var engine string
+	switch o.rdsEngine {
+	case engineTypeMySQL:
+		engine = addon.RDSEngineTypeMySQL
+	case engineTypePostgreSQL:
+		engine = addon.RDSEngineTypePostgreSQL
+	default:
+		return nil, errors.New("unknown engine type")
+	}
+
+	envs, err := o.environmentNames()
+	if err != nil {
+		return nil, err
+	}
+
+	return addon.NewRDSInstance(addon.RDSInstanceProps{
+		InstanceName:     o.storageName,
+		Engine:           engine,
+		InitialDBName:    o.rdsInitialDBName,
+		ParameterGroup:   o.rdsParameterGroup,
+		InstanceClass:    o.rdsInstanceClass,
+		AllocatedStorage: o.rdsAllocatedStorage,
+		MultiAZ:          aws.BoolValue(o.rdsMultiAZ),
+		Envs:             envs,
+	}), nil
+}
+
+func (o *initStorageOpts) newRedisAddon() (*addon.Redis, error) {
+	envs, err := o.environmentNames()
+	if err != nil {
+		return nil, err
+	}
+
+	return addon.NewRedis(addon.RedisProps{
+		ClusterName: o.storageName,
+		Envs:        envs,
+	}), nil
+}
+
+func (o *initStorageOpts) newEFSAddon() *addon.EFS {
+	return addon.NewEFS(addon.EFSProps{
+		FileSystemName: o.storageName,
+	})
+}
+
 func (o *initStorageOpts) environmentNames() ([]string, error) {
 	var envNames []string
 	envs, err := o.store.ListEnvironments(o.appName)
@@ -706,10 +1112,19 @@ func (o *initStorageOpts) RecommendedActions() []string {
 	case dynamoDBStorageType, s3StorageType:
 		newVar = template.ToSnakeCaseFunc(template.EnvVarNameFunc(o.storageName))
 		retrieveEnvVarCode = fmt.Sprintf("const storageName = process.env.%s", newVar)
-	case rdsStorageType:
+	case rdsStorageType, rdsInstanceStorageType:
 		newVar = template.ToSnakeCaseFunc(template.EnvVarSecretFunc(o.storageName))
 		retrieveEnvVarCode = fmt.Sprintf("const {username, host, dbname, password, port} = JSON.parse(process.env.%s)", newVar)
-
+	case redisStorageType:
+		newVar = template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "Endpoint")
+		retrieveEnvVarCode = fmt.Sprintf("const client = redis.createClient({host: process.env.%s})", newVar)
+	case efsStorageType:
+		mountPath := fmt.Sprintf(fmtEFSMountPath, o.storageName)
+		deployCmd := fmt.Sprintf("copilot deploy --name %s", o.workloadName)
+		return []string{
+			fmt.Sprintf("Update %s's code to read and write files under the mount path %s.", o.workloadName, color.HighlightUserInput(mountPath)),
+			fmt.Sprintf("Run %s to deploy your storage resources.", color.HighlightCode(deployCmd)),
+		}
 	}
 
 	actionRetrieveEnvVar := fmt.Sprintf(
@@ -730,12 +1145,14 @@ For example, in JavaScript you can write %s.`,
 // buildStorageInitCmd builds the command and adds it to the CLI.
 func buildStorageInitCmd() *cobra.Command {
 	vars := initStorageVars{}
+	var multiAZ bool
 	cmd := &cobra.Command{
 		Use:   "init",
 		Short: "Creates a new AWS CloudFormation template for a storage resource.",
 		Long: `Creates a new AWS CloudFormation template for a storage resource.
 Storage resources are stored in the Copilot addons directory (e.g. ./copilot/frontend/addons) for a given workload and deployed to your environments when you run ` + color.HighlightCode("copilot deploy") + `. 
-Resource names are injected into your containers as environment variables for easy access.`,
+Resource names are injected into your containers as environment variables for easy access.
+EFS file systems are mounted into your containers by adding a volume to the workload's manifest.`,
 		Example: `
   Create an S3 bucket named "my-bucket" attached to the "frontend" service.
   /code $ copilot storage init -n my-bucket -t S3 -w frontend
@@ -743,13 +1160,24 @@ Resource names are injected into your containers as environment variables for ea
   /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --sort-key UserId:N --no-lsi
   Create a DynamoDB table with multiple alternate sort keys.
   /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --sort-key UserId:N --lsi Points:N --lsi Goodness:N
+  Create a provisioned DynamoDB table that auto scales between 5 and 100 capacity units, expires items and can be restored to any point in time.
+  /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --no-sort --billing-mode Provisioned --min-capacity 5 --max-capacity 100 --ttl-attribute ExpiresAt --point-in-time-recovery
   Create an RDS Aurora Serverless cluster using PostgreSQL as the database engine.
-  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL`,
+  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL
+  Create a Multi-AZ RDS instance using MySQL as the database engine.
+  /code $ copilot storage init -n my-db -t RDS -w frontend --engine MySQL --instance-class db.m5.large --allocated-storage 100 --multi-az
+  Create an ElastiCache Redis cluster attached to the "frontend" service.
+  /code $ copilot storage init -n my-cache -t Redis -w frontend
+  Create an EFS file system mounted by the "frontend" service.
+  /code $ copilot storage init -n my-files -t EFS -w frontend`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newStorageInitOpts(vars)
 			if err != nil {
 				return err
 			}
+			if cmd.Flags().Changed(storageRDSMultiAZFlag) {
+				opts.rdsMultiAZ = aws.Bool(multiAZ)
+			}
 			if err := opts.Validate(); err != nil {
 				return err
 			}
@@ -775,34 +1203,61 @@ Resource names are injected into your containers as environment variables for ea
 	cmd.Flags().StringArrayVar(&vars.lsiSorts, storageLSIConfigFlag, []string{}, storageLSIConfigFlagDescription)
 	cmd.Flags().BoolVar(&vars.noLSI, storageNoLSIFlag, false, storageNoLSIFlagDescription)
 	cmd.Flags().BoolVar(&vars.noSort, storageNoSortFlag, false, storageNoSortFlagDescription)
+	cmd.Flags().StringVar(&vars.billingMode, storageBillingModeFlag, "", storageBillingModeFlagDescription)
+	cmd.Flags().IntVar(&vars.minCapacity, storageMinCapacityFlag, 0, storageMinCapacityFlagDescription)
+	cmd.Flags().IntVar(&vars.maxCapacity, storageMaxCapacityFlag, 0, storageMaxCapacityFlagDescription)
+	cmd.Flags().StringVar(&vars.ttlAttribute, storageTTLAttributeFlag, "", storageTTLAttributeFlagDescription)
+	cmd.Flags().BoolVar(&vars.enablePITR, storagePITRFlag, false, storagePITRFlagDescription)
 
 	cmd.Flags().StringVar(&vars.rdsEngine, storageRDSEngineFlag, "", storageRDSEngineFlagDescription)
 	cmd.Flags().StringVar(&vars.rdsInitialDBName, storageRDSInitialDBFlag, "", storageRDSInitialDBFlagDescription)
 	cmd.Flags().StringVar(&vars.rdsParameterGroup, storageRDSParameterGroupFlag, "", storageRDSParameterGroupFlagDescription)
+	cmd.Flags().StringVar(&vars.rdsInstanceClass, storageRDSInstanceClassFlag, "", storageRDSInstanceClassFlagDescription)
+	cmd.Flags().IntVar(&vars.rdsAllocatedStorage, storageRDSAllocatedStorageFlag, 0, storageRDSAllocatedStorageFlagDescription)
+	cmd.Flags().BoolVar(&multiAZ, storageRDSMultiAZFlag, false, storageRDSMultiAZFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipCostEstimate, skipCostEstimateFlag, false, skipCostEstimateFlagDescription)
 
 	requiredFlags := pflag.NewFlagSet("Required", pflag.ContinueOnError)
 	requiredFlags.AddFlag(cmd.Flags().Lookup(nameFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(storageTypeFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(workloadFlag))
 
+	commonFlags := pflag.NewFlagSet("Common", pflag.ContinueOnError)
+	commonFlags.AddFlag(cmd.Flags().Lookup(skipCostEstimateFlag))
+
 	ddbFlags := pflag.NewFlagSet("DynamoDB", pflag.ContinueOnError)
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storagePartitionKeyFlag))
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storageSortKeyFlag))
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storageNoSortFlag))
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storageLSIConfigFlag))
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storageNoLSIFlag))
+	ddbFlags.AddFlag(cmd.Flags().Lookup(storageBillingModeFlag))
+	ddbFlags.AddFlag(cmd.Flags().Lookup(storageMinCapacityFlag))
+	ddbFlags.AddFlag(cmd.Flags().Lookup(storageMaxCapacityFlag))
+	ddbFlags.AddFlag(cmd.Flags().Lookup(storageTTLAttributeFlag))
+	ddbFlags.AddFlag(cmd.Flags().Lookup(storagePITRFlag))
 
 	auroraFlags := pflag.NewFlagSet("Aurora Serverless", pflag.ContinueOnError)
 	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSEngineFlag))
 	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSInitialDBFlag))
 	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSParameterGroupFlag))
 
+	rdsFlags := pflag.NewFlagSet("RDS", pflag.ContinueOnError)
+	rdsFlags.AddFlag(cmd.Flags().Lookup(storageRDSEngineFlag))
+	rdsFlags.AddFlag(cmd.Flags().Lookup(storageRDSInitialDBFlag))
+	rdsFlags.AddFlag(cmd.Flags().Lookup(storageRDSParameterGroupFlag))
+	rdsFlags.AddFlag(cmd.Flags().Lookup(storageRDSInstanceClassFlag))
+	rdsFlags.AddFlag(cmd.Flags().Lookup(storageRDSAllocatedStorageFlag))
+	rdsFlags.AddFlag(cmd.Flags().Lookup(storageRDSMultiAZFlag))
+
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
-		"sections":          `Required,DynamoDB,Aurora Serverless`,
+		"sections":          `Required,Common,DynamoDB,Aurora Serverless,RDS`,
 		"Required":          requiredFlags.FlagUsages(),
+		"Common":            commonFlags.FlagUsages(),
 		"DynamoDB":          ddbFlags.FlagUsages(),
 		"Aurora Serverless": auroraFlags.FlagUsages(),
+		"RDS":               rdsFlags.FlagUsages(),
 	}
 	cmd.SetUsageTemplate(`{{h1 "Usage"}}{{if .Runnable}}
   {{.UseLine}}{{end}}{{$annotations := .Annotations}}{{$sections := split .Annotations.sections ","}}{{if gt (len $sections) 0}}
@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/cost"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
@@ -31,6 +33,14 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 		inNoLSI       bool
 		inEngine      string
 
+		inBillingMode  string
+		inMinCapacity  int
+		inMaxCapacity  int
+		inTTLAttribute string
+
+		inInstanceClass    string
+		inAllocatedStorage int
+
 		mockWs    func(m *mocks.MockwsAddonManager)
 		mockStore func(m *mocks.Mockstore)
 
@@ -90,6 +100,22 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 			inStorageName: "my-cool_table.3",
 			wantedErr:     nil,
 		},
+		"redis bad character": {
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+			inAppName:     "bowie",
+			inStorageType: redisStorageType,
+			inStorageName: "my-cache!",
+			wantedErr:     errInvalidRDSNameCharacters,
+		},
+		"efs bad character": {
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+			inAppName:     "bowie",
+			inStorageType: efsStorageType,
+			inStorageName: "my-files!",
+			wantedErr:     errInvalidRDSNameCharacters,
+		},
 		"s3 bad character": {
 			mockWs:        func(m *mocks.MockwsAddonManager) {},
 			mockStore:     func(m *mocks.Mockstore) {},
@@ -173,6 +199,72 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 
 			wantedErr: errors.New("invalid engine type mysql: must be one of \"MySQL\", \"PostgreSQL\""),
 		},
+		"invalid instance class": {
+			inAppName:       "meow",
+			inInstanceClass: "t3.micro",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: fmt.Errorf("invalid instance class t3.micro: %w", errInvalidRDSInstanceClass),
+		},
+		"allocated storage too small": {
+			inAppName:          "meow",
+			inAllocatedStorage: 10,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("invalid allocated storage 10: value must be an integer between 20 and 65536"),
+		},
+		"invalid billing mode": {
+			inAppName:     "meow",
+			inBillingMode: "Reserved",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New(`invalid billing mode Reserved: must be one of "OnDemand", "Provisioned"`),
+		},
+		"capacity with on-demand billing mode": {
+			inAppName:     "meow",
+			inBillingMode: ddbBillingModeOnDemand,
+			inMinCapacity: 5,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("validate capacity configuration: cannot specify --min-capacity or --max-capacity with the OnDemand billing mode"),
+		},
+		"capacity out of range": {
+			inAppName:     "meow",
+			inMaxCapacity: 50000,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("invalid maximum capacity 50000: value must be an integer between 1 and 40000"),
+		},
+		"maximum capacity less than minimum capacity": {
+			inAppName:     "meow",
+			inMinCapacity: 10,
+			inMaxCapacity: 5,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("maximum capacity 5 must be greater than or equal to minimum capacity 10"),
+		},
+		"valid provisioned table configuration": {
+			inAppName:      "meow",
+			inBillingMode:  ddbBillingModeProvisioned,
+			inMinCapacity:  5,
+			inMaxCapacity:  100,
+			inTTLAttribute: "ExpiresAt",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -194,6 +286,13 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 					noLSI:        tc.inNoLSI,
 					noSort:       tc.inNoSort,
 					rdsEngine:    tc.inEngine,
+					billingMode:  tc.inBillingMode,
+					minCapacity:  tc.inMinCapacity,
+					maxCapacity:  tc.inMaxCapacity,
+					ttlAttribute: tc.inTTLAttribute,
+
+					rdsInstanceClass:    tc.inInstanceClass,
+					rdsAllocatedStorage: tc.inAllocatedStorage,
 				},
 				appName: tc.inAppName,
 				ws:      mockWs,
@@ -235,10 +334,17 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 		inLSISorts    []string
 		inNoLSI       bool
 		inNoSort      bool
+		inBillingMode string
+		inMinCapacity int
+		inMaxCapacity int
 
 		inDBEngine      string
 		inInitialDBName string
 
+		inInstanceClass    string
+		inAllocatedStorage int
+		inMultiAZ          *bool
+
 		mockPrompt func(m *mocks.Mockprompter)
 		mockCfg    func(m *mocks.MockwsSelector)
 
@@ -265,6 +371,18 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 						Value: rdsStorageTypeOption,
 						Hint:  "SQL",
 					},
+					{
+						Value: rdsInstanceStorageTypeOption,
+						Hint:  "Provisioned SQL",
+					},
+					{
+						Value: redisStorageTypeOption,
+						Hint:  "In-memory",
+					},
+					{
+						Value: efsStorageTypeOption,
+						Hint:  "File system",
+					},
 				}
 				m.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Eq(options), gomock.Any()).Return(s3StorageType, nil)
 			},
@@ -354,6 +472,50 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 				rdsInitialDBName: wantedInitialDBName,
 			},
 		},
+		"Asks for cluster name for Redis storage": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageType: redisStorageType,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(
+					gomock.Eq("What would you like to name this Redis Cluster?"),
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+				).Return("frontend-redis", nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+
+			wantedErr: nil,
+			wantedVars: &initStorageVars{
+				storageType:  redisStorageType,
+				storageName:  "frontend-redis",
+				workloadName: wantedSvcName,
+			},
+		},
+		"Asks for file system name for EFS storage": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageType: efsStorageType,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(
+					gomock.Eq("What would you like to name this EFS File System?"),
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+				).Return("frontend-efs", nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+
+			wantedErr: nil,
+			wantedVars: &initStorageVars{
+				storageType:  efsStorageType,
+				storageName:  "frontend-efs",
+				workloadName: wantedSvcName,
+			},
+		},
 		"error if storage name not returned": {
 			inAppName:     wantedAppName,
 			inSvcName:     wantedSvcName,
@@ -370,6 +532,7 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 			inAppName:     wantedAppName,
 			inSvcName:     wantedSvcName,
 			inStorageType: dynamoDBStorageType,
+			inBillingMode: ddbBillingModeOnDemand,
 			inStorageName: wantedTableName,
 			inSort:        wantedSortKey,
 			inNoLSI:       true,
@@ -439,6 +602,7 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 			inAppName:     wantedAppName,
 			inSvcName:     wantedSvcName,
 			inStorageType: dynamoDBStorageType,
+			inBillingMode: ddbBillingModeOnDemand,
 			inStorageName: wantedTableName,
 			inPartition:   wantedPartitionKey,
 			inNoLSI:       true,
@@ -542,6 +706,7 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 			inAppName:     wantedAppName,
 			inSvcName:     wantedSvcName,
 			inStorageType: dynamoDBStorageType,
+			inBillingMode: ddbBillingModeOnDemand,
 			inStorageName: wantedTableName,
 			inPartition:   wantedPartitionKey,
 			inNoSort:      true,
@@ -556,6 +721,7 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 			inAppName:     wantedAppName,
 			inSvcName:     wantedSvcName,
 			inStorageType: dynamoDBStorageType,
+			inBillingMode: ddbBillingModeOnDemand,
 			inStorageName: wantedTableName,
 			inPartition:   wantedPartitionKey,
 			inSort:        wantedSortKey,
@@ -570,6 +736,7 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 			inAppName:     wantedAppName,
 			inSvcName:     wantedSvcName,
 			inStorageType: dynamoDBStorageType,
+			inBillingMode: ddbBillingModeOnDemand,
 			inStorageName: wantedTableName,
 			inPartition:   wantedPartitionKey,
 			inNoSort:      true,
@@ -583,6 +750,7 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 			inAppName:     wantedAppName,
 			inSvcName:     wantedSvcName,
 			inStorageType: dynamoDBStorageType,
+			inBillingMode: ddbBillingModeOnDemand,
 			inStorageName: wantedTableName,
 			inPartition:   wantedPartitionKey,
 			inSort:        wantedSortKey,
@@ -624,12 +792,14 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 				sortKey:      wantedSortKey,
 				noLSI:        false,
 				lsiSorts:     []string{"Email:String"},
+				billingMode:  ddbBillingModeOnDemand,
 			},
 		},
 		"noLSI is set correctly if no lsis specified": {
 			inAppName:     wantedAppName,
 			inSvcName:     wantedSvcName,
 			inStorageType: dynamoDBStorageType,
+			inBillingMode: ddbBillingModeOnDemand,
 			inStorageName: wantedTableName,
 			inPartition:   wantedPartitionKey,
 			inSort:        wantedSortKey,
@@ -651,12 +821,14 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 				partitionKey: wantedPartitionKey,
 				sortKey:      wantedSortKey,
 				noLSI:        true,
+				billingMode:  ddbBillingModeOnDemand,
 			},
 		},
 		"noLSI is set correctly if no sort key": {
 			inAppName:     wantedAppName,
 			inSvcName:     wantedSvcName,
 			inStorageType: dynamoDBStorageType,
+			inBillingMode: ddbBillingModeOnDemand,
 			inStorageName: wantedTableName,
 			inPartition:   wantedPartitionKey,
 
@@ -677,6 +849,7 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 				partitionKey: wantedPartitionKey,
 				noLSI:        true,
 				noSort:       true,
+				billingMode:  ddbBillingModeOnDemand,
 			},
 		},
 		"error if lsi name misspecified": {
@@ -756,6 +929,7 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 			inAppName:     wantedAppName,
 			inSvcName:     wantedSvcName,
 			inStorageType: dynamoDBStorageType,
+			inBillingMode: ddbBillingModeOnDemand,
 			inStorageName: wantedTableName,
 			inPartition:   wantedPartitionKey,
 			inSort:        wantedSortKey,
@@ -766,6 +940,97 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 
 			wantedErr: nil,
 		},
+		"asks for billing mode and capacity if not specified": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageType: dynamoDBStorageType,
+			inStorageName: wantedTableName,
+			inPartition:   wantedPartitionKey,
+			inNoSort:      true,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Eq(storageInitDDBBillingModePrompt), gomock.Any(), gomock.Eq(ddbBillingModes), gomock.Any()).
+					Return(ddbBillingModeProvisioned, nil)
+				m.EXPECT().Get(gomock.Eq(storageInitDDBMinCapacityPrompt), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("10", nil)
+				m.EXPECT().Get(gomock.Eq(storageInitDDBMaxCapacityPrompt), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("200", nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+
+			wantedVars: &initStorageVars{
+				storageName:  wantedTableName,
+				workloadName: wantedSvcName,
+				storageType:  dynamoDBStorageType,
+
+				partitionKey: wantedPartitionKey,
+				noLSI:        true,
+				noSort:       true,
+				billingMode:  ddbBillingModeProvisioned,
+				minCapacity:  10,
+				maxCapacity:  200,
+			},
+		},
+		"provisioned billing mode is implied by the capacity flags": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageType: dynamoDBStorageType,
+			inStorageName: wantedTableName,
+			inPartition:   wantedPartitionKey,
+			inNoSort:      true,
+			inMinCapacity: 10,
+			inMaxCapacity: 200,
+
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			mockCfg:    func(m *mocks.MockwsSelector) {},
+
+			wantedVars: &initStorageVars{
+				storageName:  wantedTableName,
+				workloadName: wantedSvcName,
+				storageType:  dynamoDBStorageType,
+
+				partitionKey: wantedPartitionKey,
+				noLSI:        true,
+				noSort:       true,
+				billingMode:  ddbBillingModeProvisioned,
+				minCapacity:  10,
+				maxCapacity:  200,
+			},
+		},
+		"error if maximum capacity is less than the minimum capacity": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageType: dynamoDBStorageType,
+			inStorageName: wantedTableName,
+			inPartition:   wantedPartitionKey,
+			inNoSort:      true,
+			inBillingMode: ddbBillingModeProvisioned,
+			inMinCapacity: 10,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq(storageInitDDBMaxCapacityPrompt), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("5", nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+
+			wantedErr: errors.New("maximum capacity 5 must be greater than or equal to minimum capacity 10"),
+		},
+		"error if billing mode not selected": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageType: dynamoDBStorageType,
+			inStorageName: wantedTableName,
+			inPartition:   wantedPartitionKey,
+			inNoSort:      true,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Eq(storageInitDDBBillingModePrompt), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", errors.New("some error"))
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+
+			wantedErr: errors.New("select DDB billing mode: some error"),
+		},
 		"asks for engine if not specified": {
 			inAppName:     wantedAppName,
 			inSvcName:     wantedSvcName,
@@ -826,6 +1091,74 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 				rdsInitialDBName: wantedInitialDBName,
 			},
 		},
+		"asks for RDS instance configuration": {
+			inAppName:       wantedAppName,
+			inSvcName:       wantedSvcName,
+			inStorageName:   wantedBucketName,
+			inStorageType:   rdsInstanceStorageType,
+			inDBEngine:      wantedDBEngine,
+			inInitialDBName: wantedInitialDBName,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq(storageInitRDSInstanceClassPrompt), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("db.m5.large", nil)
+				m.EXPECT().Get(gomock.Eq(storageInitRDSAllocatedStoragePrompt), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("100", nil)
+				m.EXPECT().Confirm(gomock.Eq(storageInitRDSMultiAZConfirm), gomock.Any(), gomock.Any()).
+					Return(true, nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+
+			wantedVars: &initStorageVars{
+				storageType:         rdsInstanceStorageType,
+				storageName:         wantedBucketName,
+				workloadName:        wantedSvcName,
+				rdsEngine:           wantedDBEngine,
+				rdsInitialDBName:    wantedInitialDBName,
+				rdsInstanceClass:    "db.m5.large",
+				rdsAllocatedStorage: 100,
+			},
+		},
+		"skips RDS instance prompts if flags are provided": {
+			inAppName:          wantedAppName,
+			inSvcName:          wantedSvcName,
+			inStorageName:      wantedBucketName,
+			inStorageType:      rdsInstanceStorageType,
+			inDBEngine:         wantedDBEngine,
+			inInitialDBName:    wantedInitialDBName,
+			inInstanceClass:    "db.t3.micro",
+			inAllocatedStorage: 20,
+			inMultiAZ:          aws.Bool(false),
+
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			mockCfg:    func(m *mocks.MockwsSelector) {},
+
+			wantedVars: &initStorageVars{
+				storageType:         rdsInstanceStorageType,
+				storageName:         wantedBucketName,
+				workloadName:        wantedSvcName,
+				rdsEngine:           wantedDBEngine,
+				rdsInitialDBName:    wantedInitialDBName,
+				rdsInstanceClass:    "db.t3.micro",
+				rdsAllocatedStorage: 20,
+			},
+		},
+		"error if instance class not gotten": {
+			inAppName:       wantedAppName,
+			inSvcName:       wantedSvcName,
+			inStorageName:   wantedBucketName,
+			inStorageType:   rdsInstanceStorageType,
+			inDBEngine:      wantedDBEngine,
+			inInitialDBName: wantedInitialDBName,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq(storageInitRDSInstanceClassPrompt), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", errors.New("some error"))
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+
+			wantedErr: fmt.Errorf("input instance class: some error"),
+		},
 		"error if initial database name not gotten": {
 			inAppName:     wantedAppName,
 			inSvcName:     wantedSvcName,
@@ -861,13 +1194,20 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 					lsiSorts:     tc.inLSISorts,
 					noLSI:        tc.inNoLSI,
 					noSort:       tc.inNoSort,
+					billingMode:  tc.inBillingMode,
+					minCapacity:  tc.inMinCapacity,
+					maxCapacity:  tc.inMaxCapacity,
 
 					rdsEngine:        tc.inDBEngine,
 					rdsInitialDBName: tc.inInitialDBName,
+
+					rdsInstanceClass:    tc.inInstanceClass,
+					rdsAllocatedStorage: tc.inAllocatedStorage,
 				},
-				appName: tc.inAppName,
-				sel:     mockConfig,
-				prompt:  mockPrompt,
+				appName:    tc.inAppName,
+				rdsMultiAZ: tc.inMultiAZ,
+				sel:        mockConfig,
+				prompt:     mockPrompt,
 			}
 			tc.mockPrompt(mockPrompt)
 			tc.mockCfg(mockConfig)
@@ -911,8 +1251,12 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 		inInitialDBName  string
 		inParameterGroup string
 
-		mockWs    func(m *mocks.MockwsAddonManager)
-		mockStore func(m *mocks.Mockstore)
+		inInstanceClass    string
+		inAllocatedStorage int
+
+		mockWs        func(m *mocks.MockwsAddonManager)
+		mockStore     func(m *mocks.Mockstore)
+		mockEstimator func(m *mocks.MockcostEstimator)
 
 		wantedErr error
 	}{
@@ -974,6 +1318,106 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 			},
 			wantedErr: nil,
 		},
+		"estimates the cost of the addon before writing it": {
+			inSvcName: wantedSvcName,
+
+			inStorageType: redisStorageType,
+			inStorageName: "mycache",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "mycache").Return("/frontend/addons/mycache.yml", nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments(gomock.Any()).AnyTimes()
+			},
+			mockEstimator: func(m *mocks.MockcostEstimator) {
+				m.EXPECT().Estimate(gomock.Any()).Return(&cost.Estimate{Region: "us-west-2"}, nil)
+			},
+		},
+		"writes the addon even if its cost can't be estimated": {
+			inSvcName: wantedSvcName,
+
+			inStorageType: redisStorageType,
+			inStorageName: "mycache",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "mycache").Return("/frontend/addons/mycache.yml", nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments(gomock.Any()).AnyTimes()
+			},
+			mockEstimator: func(m *mocks.MockcostEstimator) {
+				m.EXPECT().Estimate(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+		},
+		"happy calls for RDS instance": {
+			inSvcName: wantedSvcName,
+
+			inStorageType:      rdsInstanceStorageType,
+			inStorageName:      "mydb",
+			inEngine:           engineTypePostgreSQL,
+			inInstanceClass:    "db.t3.micro",
+			inAllocatedStorage: 20,
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "mydb").Return("/frontend/addons/mydb.yml", nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments(gomock.Any()).AnyTimes()
+			},
+			wantedErr: nil,
+		},
+		"happy calls for Redis": {
+			inSvcName: wantedSvcName,
+
+			inStorageType: redisStorageType,
+			inStorageName: "mycache",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "mycache").Return("/frontend/addons/mycache.yml", nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments(gomock.Any()).AnyTimes()
+			},
+			wantedErr: nil,
+		},
+		"happy calls for EFS": {
+			inSvcName: wantedSvcName,
+
+			inStorageType: efsStorageType,
+			inStorageName: "my-files",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "my-files").Return("/frontend/addons/my-files.yml", nil)
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("name: frontend\n"), nil)
+				m.EXPECT().OverwriteWorkloadManifest([]byte(`name: frontend
+
+storage:
+  volumes:
+    my-files:
+      path: /mnt/my-files
+      read_only: false
+      efs:
+        id: myfilesFileSystem
+        auth:
+          iam: true
+          access_point_id: myfilesAccessPoint
+`), wantedSvcName).Return("/frontend/manifest.yml", nil)
+			},
+			wantedErr: nil,
+		},
+		"error if the EFS volume cannot be added to the manifest": {
+			inSvcName: wantedSvcName,
+
+			inStorageType: efsStorageType,
+			inStorageName: "my-files",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "my-files").Return("/frontend/addons/my-files.yml", nil)
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("storage:\n  volumes:\n    my-files:\n      path: /data\n"), nil)
+			},
+			wantedErr: errors.New("add volume my-files to the manifest of frontend: volume my-files already exists in the manifest"),
+		},
 		"error addon exists": {
 			inAppName:     wantedAppName,
 			inStorageType: s3StorageType,
@@ -1010,6 +1454,7 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 
 			mockAddon := mocks.NewMockwsAddonManager(ctrl)
 			mockStore := mocks.NewMockstore(ctrl)
+			mockEstimator := mocks.NewMockcostEstimator(ctrl)
 			opts := initStorageOpts{
 				initStorageVars: initStorageVars{
 					storageType:  tc.inStorageType,
@@ -1023,15 +1468,25 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 
 					rdsEngine:         tc.inEngine,
 					rdsParameterGroup: tc.inParameterGroup,
+
+					rdsInstanceClass:    tc.inInstanceClass,
+					rdsAllocatedStorage: tc.inAllocatedStorage,
+
+					// Only estimate the cost in the test cases that expect it.
+					skipCostEstimate: tc.mockEstimator == nil,
 				},
-				appName: tc.inAppName,
-				ws:      mockAddon,
-				store:   mockStore,
+				appName:   tc.inAppName,
+				ws:        mockAddon,
+				store:     mockStore,
+				estimator: mockEstimator,
 			}
 			tc.mockWs(mockAddon)
 			if tc.mockStore != nil {
 				tc.mockStore(mockStore)
 			}
+			if tc.mockEstimator != nil {
+				tc.mockEstimator(mockEstimator)
+			}
 
 			// WHEN
 			err := opts.Execute()
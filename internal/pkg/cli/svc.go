@@ -30,8 +30,15 @@ Services are long-running Amazon ECS services.`,
 	cmd.AddCommand(buildSvcDeleteCmd())
 	cmd.AddCommand(buildSvcShowCmd())
 	cmd.AddCommand(buildSvcStatusCmd())
+	cmd.AddCommand(buildSvcImagesCmd())
 	cmd.AddCommand(buildSvcLogsCmd())
 	cmd.AddCommand(buildSvcExecCmd())
+	cmd.AddCommand(buildSvcPortForwardCmd())
+	cmd.AddCommand(buildSvcProxyCmd())
+	cmd.AddCommand(buildSvcTopCmd())
+	cmd.AddCommand(buildSvcDebugCmd())
+	cmd.AddCommand(buildSvcVerifyCmd())
+	cmd.AddCommand(buildSvcMaintenanceCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
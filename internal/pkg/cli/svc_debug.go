@@ -0,0 +1,23 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/spf13/cobra"
+)
+
+// buildSvcDebugCmd builds the command group for troubleshooting a deployed service.
+func buildSvcDebugCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Commands for troubleshooting a deployed service.",
+		Long:  "Commands for troubleshooting a deployed service.",
+	}
+
+	cmd.AddCommand(buildSvcDebugLastDeployCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,174 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcLastDeployNamePrompt     = "Which service's last deployment would you like to debug?"
+	svcLastDeployNameHelpPrompt = "Displays the failed stack events, stopped tasks, unhealthy targets and logs of the service's latest deployment."
+)
+
+type svcLastDeployVars struct {
+	shouldOutputJSON bool
+	svcName          string
+	envName          string
+	appName          string
+}
+
+type svcLastDeployOpts struct {
+	svcLastDeployVars
+
+	w                       io.Writer
+	store                   store
+	describer               lastDeploymentDescriber
+	sel                     deploySelector
+	initLastDeployDescriber func(*svcLastDeployOpts) error
+}
+
+func newSvcLastDeployOpts(vars svcLastDeployVars) (*svcLastDeployOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcLastDeployOpts{
+		svcLastDeployVars: vars,
+		store:             configStore,
+		w:                 log.OutputWriter,
+		sel:               selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		initLastDeployDescriber: func(o *svcLastDeployOpts) error {
+			d, err := describe.NewLastDeployment(&describe.NewLastDeploymentConfig{
+				App:         o.appName,
+				Env:         o.envName,
+				Svc:         o.svcName,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("creating last deployment describer for service %s in application %s: %w", o.svcName, o.appName, err)
+			}
+			o.describer = d
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcLastDeployOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcLastDeployOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askSvcEnvName()
+}
+
+// Execute displays the timeline of the latest deployment of the service.
+func (o *svcLastDeployOpts) Execute() error {
+	if err := o.initLastDeployDescriber(o); err != nil {
+		return err
+	}
+	lastDeploy, err := o.describer.Describe()
+	if err != nil {
+		return fmt.Errorf("describe last deployment of service %s: %w", o.svcName, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := lastDeploy.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	} else {
+		fmt.Fprint(o.w, lastDeploy.HumanString())
+	}
+	return nil
+}
+
+func (o *svcLastDeployOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcLastDeployOpts) askSvcEnvName() error {
+	deployedService, err := o.sel.DeployedService(svcLastDeployNamePrompt, svcLastDeployNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcDebugLastDeployCmd builds the command for troubleshooting the latest deployment of a service.
+func buildSvcDebugLastDeployCmd() *cobra.Command {
+	vars := svcLastDeployVars{}
+	cmd := &cobra.Command{
+		Use:   "last-deploy",
+		Short: "Shows why the latest deployment of a service failed.",
+		Long: `Shows why the latest deployment of a service failed.
+Failed stack events, stopped tasks with their exit codes and recent logs are merged into a single timeline,
+followed by the targets failing the load balancer health check.`,
+
+		Example: `
+  Shows the timeline of the last deployment of the service "my-svc" in the "test" environment.
+  /code $ copilot svc debug last-deploy -n my-svc -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcLastDeployOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,152 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcLastDeploy_Ask(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inputApp         string
+		inputSvc         string
+		inputEnvironment string
+		mockSelector     func(m *mocks.MockdeploySelector)
+
+		wantedError error
+	}{
+		"errors if failed to select application": {
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(svcAppNamePrompt, svcAppNameHelpPrompt).Return("", mockError)
+			},
+
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"errors if failed to select deployed service": {
+			inputApp: "mockApp",
+
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(svcLastDeployNamePrompt, svcLastDeployNameHelpPrompt, "mockApp", gomock.Any(), gomock.Any()).
+					Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("select deployed services for application mockApp: some error"),
+		},
+		"success": {
+			inputApp:         "mockApp",
+			inputSvc:         "mockSvc",
+			inputEnvironment: "mockEnv",
+
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(svcLastDeployNamePrompt, svcLastDeployNameHelpPrompt, "mockApp", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env: "mockEnv",
+						Svc: "mockSvc",
+					}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSelector := mocks.NewMockdeploySelector(ctrl)
+			tc.mockSelector(mockSelector)
+
+			opts := &svcLastDeployOpts{
+				svcLastDeployVars: svcLastDeployVars{
+					svcName: tc.inputSvc,
+					envName: tc.inputEnvironment,
+					appName: tc.inputApp,
+				},
+				sel: mockSelector,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcLastDeploy_Execute(t *testing.T) {
+	mockError := errors.New("some error")
+	mockLastDeployment := &describe.LastDeploymentDesc{}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		mockDescriber    func(m *mocks.MocklastDeploymentDescriber)
+		wantedError      error
+	}{
+		"errors if failed to describe the last deployment of the service": {
+			mockDescriber: func(m *mocks.MocklastDeploymentDescriber) {
+				m.EXPECT().Describe().Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("describe last deployment of service mockSvc: some error"),
+		},
+		"success with JSON output": {
+			shouldOutputJSON: true,
+
+			mockDescriber: func(m *mocks.MocklastDeploymentDescriber) {
+				m.EXPECT().Describe().Return(mockLastDeployment, nil)
+			},
+		},
+		"success with HumanString": {
+			mockDescriber: func(m *mocks.MocklastDeploymentDescriber) {
+				m.EXPECT().Describe().Return(mockLastDeployment, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockDescriber := mocks.NewMocklastDeploymentDescriber(ctrl)
+			tc.mockDescriber(mockDescriber)
+
+			opts := &svcLastDeployOpts{
+				svcLastDeployVars: svcLastDeployVars{
+					svcName:          "mockSvc",
+					envName:          "mockEnv",
+					shouldOutputJSON: tc.shouldOutputJSON,
+					appName:          "mockApp",
+				},
+				describer:               mockDescriber,
+				initLastDeployDescriber: func(*svcLastDeployOpts) error { return nil },
+				w:                       b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.NotEmpty(t, b.String(), "expected output content to not be empty")
+			}
+		})
+	}
+}
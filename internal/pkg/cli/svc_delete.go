@@ -56,14 +56,15 @@ type deleteSvcOpts struct {
 	deleteSvcVars
 
 	// Interfaces to dependencies.
-	store     store
-	sess      sessionProvider
-	spinner   progress
-	prompt    prompter
-	sel       configSelector
-	appCFN    svcRemoverFromApp
-	getSvcCFN func(session *awssession.Session) wlDeleter
-	getECR    func(session *awssession.Session) imageRemover
+	store       store
+	sess        sessionProvider
+	spinner     progress
+	prompt      prompter
+	sel         configSelector
+	targetGuard targetConfirmer
+	appCFN      svcRemoverFromApp
+	getSvcCFN   func(session *awssession.Session) wlDeleter
+	getECR      func(session *awssession.Session) imageRemover
 }
 
 func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
@@ -78,16 +79,21 @@ func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
 		return nil, err
 	}
 	prompter := prompt.New()
+	guard, err := newTargetGuard(defaultSession, prompter)
+	if err != nil {
+		return nil, err
+	}
 
 	return &deleteSvcOpts{
 		deleteSvcVars: vars,
 
-		store:   store,
-		spinner: termprogress.NewSpinner(log.DiagnosticWriter),
-		prompt:  prompter,
-		sess:    provider,
-		sel:     selector.NewConfigSelect(prompter, store),
-		appCFN:  cloudformation.New(defaultSession),
+		store:       store,
+		spinner:     termprogress.NewSpinner(log.DiagnosticWriter),
+		prompt:      prompter,
+		sess:        provider,
+		sel:         selector.NewConfigSelect(prompter, store),
+		targetGuard: guard,
+		appCFN:      cloudformation.New(defaultSession),
 		getSvcCFN: func(session *awssession.Session) wlDeleter {
 			return cloudformation.New(session)
 		},
@@ -156,6 +162,12 @@ func (o *deleteSvcOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	// Confirm the target of every environment before deleting the service from any of them.
+	for _, env := range envs {
+		if err := o.targetGuard.Confirm(env, o.skipConfirmation); err != nil {
+			return err
+		}
+	}
 
 	if err := o.deleteStacks(envs); err != nil {
 		return err
@@ -167,10 +179,14 @@ func (o *deleteSvcOpts) Execute() error {
 		return nil
 	}
 
-	if err := o.emptyECRRepos(envs); err != nil {
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return err
+	}
+	if err := o.emptyECRRepos(app, envs); err != nil {
 		return err
 	}
-	if err := o.removeSvcFromApp(); err != nil {
+	if err := o.removeSvcFromApp(app); err != nil {
 		return err
 	}
 	if err := o.deleteSSMParam(); err != nil {
@@ -272,7 +288,7 @@ func (o *deleteSvcOpts) deleteStacks(envs []*config.Environment) error {
 }
 
 // This is to make mocking easier in unit tests
-func (o *deleteSvcOpts) emptyECRRepos(envs []*config.Environment) error {
+func (o *deleteSvcOpts) emptyECRRepos(app *config.Application, envs []*config.Environment) error {
 	var uniqueRegions []string
 	for _, env := range envs {
 		if !contains(env.Region, uniqueRegions) {
@@ -280,8 +296,7 @@ func (o *deleteSvcOpts) emptyECRRepos(envs []*config.Environment) error {
 		}
 	}
 
-	// TODO: centralized ECR repo name
-	repoName := fmt.Sprintf("%s/%s", o.appName, o.name)
+	repoName := app.RepositoryName(o.name)
 	for _, region := range uniqueRegions {
 		sess, err := o.sess.DefaultWithRegion(region)
 		if err != nil {
@@ -295,14 +310,9 @@ func (o *deleteSvcOpts) emptyECRRepos(envs []*config.Environment) error {
 	return nil
 }
 
-func (o *deleteSvcOpts) removeSvcFromApp() error {
-	proj, err := o.store.GetApplication(o.appName)
-	if err != nil {
-		return err
-	}
-
+func (o *deleteSvcOpts) removeSvcFromApp(app *config.Application) error {
 	o.spinner.Start(fmt.Sprintf(fmtSvcDeleteResourcesStart, o.name, o.appName))
-	if err := o.appCFN.RemoveServiceFromApp(proj, o.name); err != nil {
+	if err := o.appCFN.RemoveServiceFromApp(app, o.name); err != nil {
 		if !isStackSetNotExistsErr(err) {
 			o.spinner.Stop(log.Serrorf(fmtSvcDeleteResourcesFailed, o.name, o.appName))
 			return err
@@ -291,6 +291,7 @@ type deleteSvcMocks struct {
 	sessProvider   *sessions.Provider
 	appCFN         *mocks.MocksvcRemoverFromApp
 	spinner        *mocks.Mockprogress
+	targetGuard    *mocks.MocktargetConfirmer
 	svcCFN         *mocks.MockwlDeleter
 	ecr            *mocks.MockimageRemover
 }
@@ -322,6 +323,18 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 
 		wantedError error
 	}{
+		"does not delete anything if the target of an environment is not confirmed": {
+			inAppName: mockAppName,
+			inSvcName: mockSvcName,
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					mocks.store.EXPECT().ListEnvironments(gomock.Eq(mockAppName)).Times(1).Return(mockEnvs, nil),
+					mocks.targetGuard.EXPECT().Confirm(mockEnv, false).Return(errTargetNotConfirmed),
+					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Times(0),
+				)
+			},
+			wantedError: errTargetNotConfirmed,
+		},
 		"happy path with no environment passed in as flag": {
 			inAppName: mockAppName,
 			inSvcName: mockSvcName,
@@ -329,15 +342,16 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 				gomock.InOrder(
 					// appEnvironments
 					mocks.store.EXPECT().ListEnvironments(gomock.Eq(mockAppName)).Times(1).Return(mockEnvs, nil),
+					mocks.targetGuard.EXPECT().Confirm(mockEnv, false).Return(nil),
 					// deleteStacks
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
 					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil),
 					// emptyECRRepos
 					mocks.ecr.EXPECT().ClearRepository(mockRepo).Return(nil),
 
 					// removeSvcFromApp
-					mocks.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil),
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteResourcesStart, mockSvcName, mockAppName)),
 					mocks.appCFN.EXPECT().RemoveServiceFromApp(mockApp, mockSvcName).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteResourcesComplete, mockSvcName, mockAppName)),
@@ -359,6 +373,7 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 				gomock.InOrder(
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
+					mocks.targetGuard.EXPECT().Confirm(mockEnv, false).Return(nil),
 					// deleteStacks
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
 					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
@@ -376,6 +391,29 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			},
 			wantedError: nil,
 		},
+		"empties the repository with the application's repository prefix": {
+			inAppName: mockAppName,
+			inSvcName: mockSvcName,
+			setupMocks: func(mocks deleteSvcMocks) {
+				prefixedApp := &config.Application{
+					Name:             mockAppName,
+					RepositoryPrefix: "team-a",
+				}
+				gomock.InOrder(
+					mocks.store.EXPECT().ListEnvironments(gomock.Eq(mockAppName)).Times(1).Return(mockEnvs, nil),
+					mocks.targetGuard.EXPECT().Confirm(mockEnv, false).Return(nil),
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
+					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(prefixedApp, nil),
+					mocks.ecr.EXPECT().ClearRepository("team-a/"+mockSvcName).Return(nil),
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteResourcesStart, mockSvcName, mockAppName)),
+					mocks.appCFN.EXPECT().RemoveServiceFromApp(prefixedApp, mockSvcName).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteResourcesComplete, mockSvcName, mockAppName)),
+					mocks.store.EXPECT().DeleteService(mockAppName, mockSvcName).Return(nil),
+				)
+			},
+		},
 		"errors when deleting stack": {
 			inAppName: mockAppName,
 			inSvcName: mockSvcName,
@@ -384,6 +422,7 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 				gomock.InOrder(
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
+					mocks.targetGuard.EXPECT().Confirm(mockEnv, false).Return(nil),
 					// deleteStacks
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
 					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(testError),
@@ -406,6 +445,7 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			mockAppCFN := mocks.NewMocksvcRemoverFromApp(ctrl)
 			mockSvcCFN := mocks.NewMockwlDeleter(ctrl)
 			mockSpinner := mocks.NewMockprogress(ctrl)
+			mockTargetGuard := mocks.NewMocktargetConfirmer(ctrl)
 			mockImageRemover := mocks.NewMockimageRemover(ctrl)
 			mockGetSvcCFN := func(_ *session.Session) wlDeleter {
 				return mockSvcCFN
@@ -420,6 +460,7 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 				sessProvider:   mockSession,
 				appCFN:         mockAppCFN,
 				spinner:        mockSpinner,
+				targetGuard:    mockTargetGuard,
 				svcCFN:         mockSvcCFN,
 				ecr:            mockImageRemover,
 			}
@@ -432,12 +473,13 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					name:    test.inSvcName,
 					envName: test.inEnvName,
 				},
-				store:     mockstore,
-				sess:      mockSession,
-				spinner:   mockSpinner,
-				appCFN:    mockAppCFN,
-				getSvcCFN: mockGetSvcCFN,
-				getECR:    mockGetImageRemover,
+				store:       mockstore,
+				sess:        mockSession,
+				spinner:     mockSpinner,
+				targetGuard: mockTargetGuard,
+				appCFN:      mockAppCFN,
+				getSvcCFN:   mockGetSvcCFN,
+				getECR:      mockGetImageRemover,
 			}
 
 			// WHEN
@@ -6,17 +6,24 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
@@ -26,7 +33,9 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/remote"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -34,35 +43,77 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
+// maxRulesPerLoadBalancer is the default quota for the number of rules, excluding default rules, on an Application Load Balancer.
+// See https://docs.aws.amazon.com/elasticloadbalancing/latest/application/load-balancer-limits.html
+const maxRulesPerLoadBalancer = 100
+
+const (
+	fmtChangeSetConfirmPrompt = "Deploy these changes to service %s in environment %s?"
+	changeSetConfirmHelp      = "The service is deployed only if you confirm. Replaced resources are deleted and created again."
+)
+
+var errDeploymentCancelled = errors.New("deployment cancelled - no changes made")
+
+// dockerignoreFileName is the name of the file that Docker reads at the root of the build context to exclude files.
+const dockerignoreFileName = ".dockerignore"
+
 type deployWkldVars struct {
 	appName      string
 	name         string
 	envName      string
 	imageTag     string
 	resourceTags map[string]string
+
+	skipConfirmation bool // True if the command shouldn't ask to confirm a change of account, role or region.
+	shouldOutputJSON bool // True if the result of the deployment should be written to stdout as JSON.
+	strictVersion    bool // True if an environment on a newer template version should fail the deployment.
+
+	forceDeploy       bool // True if the in-progress deployment should be cancelled and the new one shouldn't be waited on.
+	forceDesiredCount *int // Number of tasks of the service during a forced deployment.
+
+	targetConfirmed bool // True if the caller already confirmed the target of the environment, such as "deploy --all".
 }
 
 type deploySvcOpts struct {
 	deployWkldVars
+	buildContext     string // Overrides the Docker build context of the manifest.
+	pushedDigest     string // Digest of an image already in the service's repository to deploy instead of building one.
+	noBuild          bool   // True if the image last pushed to the service's repository should be deployed instead of building one.
+	verify           bool   // True if the checks of the manifest should be run against the service once it's deployed.
+	manifestLocation string // Location of a remote manifest that the workspace manifest overrides.
+	confirmChangeSet bool   // True if the changes to the stack should be confirmed before they're deployed.
+	watchOnly        bool   // True if the deployment in progress should be followed instead of starting a new one.
 
 	store              store
 	ws                 wsSvcDirReader
+	fs                 afero.Fs
 	imageBuilderPusher imageBuilderPusher
 	unmarshal          func([]byte) (interface{}, error)
 	s3                 artifactUploader
 	cmd                runner
 	addons             templater
 	appCFN             appResourcesGetter
+	imageAccess        envImageAccessGranter
 	svcCFN             cloudformation.CloudFormation
+	updateRenderer     workloadUpdateRenderer
+	envCFN             stackOutputsDescriber
+	ruleCounter        listenerRuleCounter
 	sessProvider       sessionProvider
 	envUpgradeCmd      actionCommand
+	quotaDescriber     quotaDescriber
+	targetGuard        targetConfirmer
+	verifier           suiteVerifier
+	manifestReader     manifestReader
+	roleSimulator      rolePermissionsSimulator
 
 	spinner progress
 	sel     wsSelector
 	prompt  prompter
+	w       io.Writer
 
 	// cached variables
 	targetApp         *config.Application
@@ -70,6 +121,9 @@ type deploySvcOpts struct {
 	targetSvc         *config.Workload
 	imageDigest       string
 	buildRequired     bool
+	pullThroughImage  *stack.ECRImage // Image of the manifest in the application's ECR pull-through cache.
+	pullThroughCache  string          // Repository prefix of the pull-through cache of pullThroughImage.
+	remoteManifest    []byte          // Content of the manifest at manifestLocation.
 }
 
 func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
@@ -82,18 +136,30 @@ func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new workspace: %w", err)
 	}
+	defaultSess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, err
+	}
 	prompter := prompt.New()
+	guard, err := newTargetGuard(defaultSess, prompter)
+	if err != nil {
+		return nil, err
+	}
 	return &deploySvcOpts{
 		deployWkldVars: vars,
 
 		store:        store,
+		targetGuard:  guard,
 		ws:           ws,
+		fs:           afero.NewOsFs(),
 		unmarshal:    manifest.UnmarshalWorkload,
 		spinner:      termprogress.NewSpinner(log.DiagnosticWriter),
 		sel:          selector.NewWorkspaceSelect(prompter, store, ws),
 		prompt:       prompter,
+		w:            log.OutputWriter,
 		cmd:          command.New(),
 		sessProvider: sessions.NewProvider(),
+		verifier:     newSuiteVerifier(),
 	}, nil
 }
 
@@ -102,6 +168,33 @@ func (o *deploySvcOpts) Validate() error {
 	if o.appName == "" {
 		return errNoAppInWorkspace
 	}
+	if o.forceDesiredCount != nil {
+		if !o.forceDeploy {
+			return fmt.Errorf("--%s must be specified with --%s", forceDesiredCountFlag, forceFlag)
+		}
+		if aws.IntValue(o.forceDesiredCount) < 0 {
+			return fmt.Errorf("--%s must be greater than or equal to 0", forceDesiredCountFlag)
+		}
+	}
+	if o.verify && o.forceDeploy {
+		// A forced deployment returns before the new tasks serve traffic.
+		return fmt.Errorf("--%s cannot be specified with --%s", verifyFlag, forceFlag)
+	}
+	if o.confirmChangeSet && o.forceDeploy {
+		// A forced deployment executes the changes right away.
+		return fmt.Errorf("--%s cannot be specified with --%s", confirmChangeSetFlag, forceFlag)
+	}
+	if err := o.validateNoBuild(); err != nil {
+		return err
+	}
+	if err := o.validateWatchOnly(); err != nil {
+		return err
+	}
+	if o.manifestLocation != "" {
+		if err := remote.Validate(o.manifestLocation); err != nil {
+			return fmt.Errorf("--%s is invalid: %w", manifestFlag, err)
+		}
+	}
 	if o.name != "" {
 		if err := o.validateSvcName(); err != nil {
 			return err
@@ -115,6 +208,56 @@ func (o *deploySvcOpts) Validate() error {
 	return nil
 }
 
+// validateNoBuild returns an error if flags to build the image are combined with the ones that deploy an existing image.
+func (o *deploySvcOpts) validateNoBuild() error {
+	if o.pushedDigest == "" && !o.noBuild {
+		return nil
+	}
+	skipFlag := noBuildFlag
+	if o.pushedDigest != "" {
+		if o.noBuild {
+			return fmt.Errorf("--%s cannot be specified with --%s", imageDigestFlag, noBuildFlag)
+		}
+		if err := validateImageDigest(o.pushedDigest); err != nil {
+			return fmt.Errorf("image digest %s is invalid: %w", o.pushedDigest, err)
+		}
+		skipFlag = imageDigestFlag
+	}
+	if o.imageTag != "" {
+		return fmt.Errorf("--%s cannot be specified with --%s", imageTagFlag, skipFlag)
+	}
+	if o.buildContext != "" {
+		return fmt.Errorf("--%s cannot be specified with --%s", buildContextFlag, skipFlag)
+	}
+	return nil
+}
+
+// validateWatchOnly returns an error if flags that change what gets deployed are combined with --watch-only.
+func (o *deploySvcOpts) validateWatchOnly() error {
+	if !o.watchOnly {
+		return nil
+	}
+	conflicts := []struct {
+		flag string
+		set  bool
+	}{
+		{flag: imageTagFlag, set: o.imageTag != ""},
+		{flag: resourceTagsFlag, set: len(o.resourceTags) != 0},
+		{flag: forceFlag, set: o.forceDeploy},
+		{flag: buildContextFlag, set: o.buildContext != ""},
+		{flag: imageDigestFlag, set: o.pushedDigest != ""},
+		{flag: noBuildFlag, set: o.noBuild},
+		{flag: manifestFlag, set: o.manifestLocation != ""},
+		{flag: confirmChangeSetFlag, set: o.confirmChangeSet},
+	}
+	for _, conflict := range conflicts {
+		if conflict.set {
+			return fmt.Errorf("--%s cannot be specified with --%s", conflict.flag, watchOnlyFlag)
+		}
+	}
+	return nil
+}
+
 // Ask prompts the user for any required fields that are not provided.
 func (o *deploySvcOpts) Ask() error {
 	if err := o.askSvcName(); err != nil {
@@ -128,12 +271,19 @@ func (o *deploySvcOpts) Ask() error {
 
 // Execute builds and pushes the container image for the service,
 func (o *deploySvcOpts) Execute() error {
-	o.imageTag = imageTagFromGit(o.cmd, o.imageTag) // Best effort assign git tag.
+	if o.pushedDigest == "" && !o.noBuild && !o.watchOnly {
+		o.imageTag = imageTagFromGit(o.cmd, o.imageTag) // Best effort assign git tag.
+	}
 	env, err := targetEnv(o.store, o.appName, o.envName)
 	if err != nil {
 		return err
 	}
 	o.targetEnvironment = env
+	if !o.targetConfirmed {
+		if err := o.targetGuard.Confirm(env, o.skipConfirmation); err != nil {
+			return err
+		}
+	}
 
 	app, err := o.store.GetApplication(o.appName)
 	if err != nil {
@@ -151,10 +301,52 @@ func (o *deploySvcOpts) Execute() error {
 		return err
 	}
 
+	if o.watchOnly {
+		inProgress, err := o.watchSvc()
+		if err != nil || !inProgress {
+			return err
+		}
+	} else if err := o.buildAndDeploySvc(); err != nil {
+		return err
+	}
+
+	uri, err := o.showSvcURI()
+	if err != nil {
+		return err
+	}
+	if o.verify {
+		if err := o.verifySvc(uri); err != nil {
+			return err
+		}
+	}
+	if !o.shouldOutputJSON {
+		return nil
+	}
+	return writeJSON(o.w, deployWkldOutput{
+		Application: o.appName,
+		Environment: o.envName,
+		Name:        o.name,
+		Type:        o.targetSvc.Type,
+		Stack:       stack.NameForService(o.appName, o.envName, o.name),
+		ImageDigest: o.imageDigest,
+		URI:         uri,
+	})
+}
+
+// buildAndDeploySvc upgrades the environment, pushes the image and addons of the service, and deploys its stack.
+func (o *deploySvcOpts) buildAndDeploySvc() error {
 	if err := o.envUpgradeCmd.Execute(); err != nil {
 		return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.targetEnvironment.Name, err)
 	}
 
+	if err := o.validateListenerRuleQuota(); err != nil {
+		return err
+	}
+
+	if err := grantEnvImageAccess(o.imageAccess, o.targetApp, o.targetEnvironment); err != nil {
+		return err
+	}
+
 	if err := o.configureContainerImage(); err != nil {
 		return err
 	}
@@ -167,8 +359,41 @@ func (o *deploySvcOpts) Execute() error {
 	if err := o.deploySvc(addonsURL); err != nil {
 		return err
 	}
+	warnNearQuotas(o.quotaDescriber)
+	return nil
+}
 
-	return o.showSvcURI()
+// watchSvc renders the update of the service stack that is in progress until it completes, without starting a new one.
+// Returns false if the service isn't being deployed.
+func (o *deploySvcOpts) watchSvc() (inProgress bool, err error) {
+	err = o.updateRenderer.RenderWorkloadUpdate(os.Stderr, stack.NameForService(o.appName, o.envName, o.name))
+	var errNotInProgress *cloudformation.ErrStackNotInProgress
+	if errors.As(err, &errNotInProgress) {
+		log.Infof("Service %s is not being deployed to environment %s.\n", o.name, o.envName)
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("render the deployment of service %s: %w", o.name, err)
+	}
+	return true, nil
+}
+
+// verifySvc runs the checks of the manifest against the deployed service.
+func (o *deploySvcOpts) verifySvc(uri string) error {
+	mft, err := o.manifest()
+	if err != nil {
+		return err
+	}
+	conf, err := verifyConfig(mft, o.name, o.envName)
+	if err != nil {
+		return err
+	}
+	w := log.OutputWriter
+	if o.shouldOutputJSON {
+		// Keep stdout for the result of the deployment.
+		w = log.DiagnosticWriter
+	}
+	return verifySvc(o.verifier, w, o.name, uri, conf)
 }
 
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
@@ -242,7 +467,7 @@ func (o *deploySvcOpts) configureClients() error {
 	}
 
 	// ECR client against tools account profile AND target environment region
-	repoName := fmt.Sprintf("%s/%s", o.appName, o.name)
+	repoName := o.targetApp.RepositoryName(o.name)
 	registry := ecr.New(defaultSessEnvRegion)
 	o.imageBuilderPusher, err = repository.New(repoName, registry)
 	if err != nil {
@@ -253,6 +478,14 @@ func (o *deploySvcOpts) configureClients() error {
 
 	// CF client against env account profile AND target environment region
 	o.svcCFN = cloudformation.New(envSession)
+	o.updateRenderer = o.svcCFN
+	o.envCFN = awscloudformation.New(envSession)
+	o.ruleCounter = elbv2.New(envSession)
+	o.quotaDescriber, err = describe.NewEnvQuotaDescriber(o.appName, o.targetEnvironment)
+	if err != nil {
+		return fmt.Errorf("new quota describer for environment %s: %w", o.targetEnvironment.Name, err)
+	}
+	o.roleSimulator = iam.New(envSession)
 
 	addonsSvc, err := addon.New(o.name)
 	if err != nil {
@@ -265,11 +498,16 @@ func (o *deploySvcOpts) configureClients() error {
 	if err != nil {
 		return fmt.Errorf("create default session: %w", err)
 	}
-	o.appCFN = cloudformation.New(defaultSess)
+	appCFN := cloudformation.New(defaultSess)
+	o.appCFN = appCFN
+	o.imageAccess = appCFN
+	o.manifestReader = remote.New(defaultSess)
 
 	cmd, err := newEnvUpgradeOpts(envUpgradeVars{
-		appName: o.appName,
-		name:    o.targetEnvironment.Name,
+		appName:         o.appName,
+		name:            o.targetEnvironment.Name,
+		strictVersion:   o.strictVersion,
+		targetConfirmed: true, // The target was confirmed for the deployment.
 	})
 	if err != nil {
 		return fmt.Errorf("new env upgrade command: %v", err)
@@ -278,6 +516,49 @@ func (o *deploySvcOpts) configureClients() error {
 	return nil
 }
 
+// validateListenerRuleQuota returns an error if deploying a new Load Balanced Web Service would exceed
+// the number of rules allowed on the environment's shared Application Load Balancer.
+func (o *deploySvcOpts) validateListenerRuleQuota() error {
+	mft, err := o.manifest()
+	if err != nil {
+		return err
+	}
+	if _, ok := mft.(*manifest.LoadBalancedWebService); !ok {
+		return nil
+	}
+	exists, err := o.envCFN.Exists(stack.NameForService(o.appName, o.targetEnvironment.Name, o.name))
+	if err != nil {
+		return fmt.Errorf("check if service %s is deployed in environment %s: %w", o.name, o.targetEnvironment.Name, err)
+	}
+	if exists {
+		// The service already owns its listener rules.
+		return nil
+	}
+	outputs, err := o.envCFN.Outputs(awscloudformation.NewStack(stack.NameForEnv(o.appName, o.targetEnvironment.Name), ""))
+	if err != nil {
+		return fmt.Errorf("get outputs of environment %s: %w", o.targetEnvironment.Name, err)
+	}
+	var rules, newRules int
+	for _, key := range []string{stack.EnvOutputHTTPListenerARN, stack.EnvOutputHTTPSListenerARN} {
+		listenerARN, ok := outputs[key]
+		if !ok {
+			continue
+		}
+		count, err := o.ruleCounter.ListenerRuleCount(listenerARN)
+		if err != nil {
+			return err
+		}
+		rules += count
+		newRules++ // The service adds one rule to each listener.
+	}
+	if rules+newRules <= maxRulesPerLoadBalancer {
+		return nil
+	}
+	return fmt.Errorf(`the load balancer in environment %s has %d rules and service %s needs %d more, exceeding the quota of %d rules per load balancer
+Delete unused services with %s or request a quota increase for "Rules per Application Load Balancer" through Service Quotas`,
+		o.targetEnvironment.Name, rules, o.name, newRules, maxRulesPerLoadBalancer, color.HighlightCode("copilot svc delete"))
+}
+
 func (o *deploySvcOpts) configureContainerImage() error {
 	svc, err := o.manifest()
 	if err != nil {
@@ -288,28 +569,292 @@ func (o *deploySvcOpts) configureContainerImage() error {
 		return err
 	}
 	if !required {
+		if o.pushedDigest != "" || o.noBuild {
+			return fmt.Errorf("service %s uses an existing image instead of building one from a Dockerfile", o.name)
+		}
+		o.pullThroughImage, o.pullThroughCache = pullThroughImage(svc, o.targetApp, o.targetEnvironment)
 		return nil
 	}
+	if o.pushedDigest != "" || o.noBuild {
+		return o.configurePushedImage()
+	}
 	// If it is built from local Dockerfile, build and push to the ECR repo.
 	buildArg, err := o.dfBuildArgs(svc)
 	if err != nil {
 		return err
 	}
-	digest, err := o.imageBuilderPusher.BuildAndPush(exec.NewDockerCommand(), buildArg)
+	engine, err := exec.NewContainerEngineCommand()
+	if err != nil {
+		return fmt.Errorf("detect container engine: %w", err)
+	}
+	digest, err := o.imageBuilderPusher.BuildAndPush(engine, buildArg)
 	if err != nil {
 		return fmt.Errorf("build and push image: %w", err)
 	}
+	if lazyLoadImage(svc) {
+		if err := o.imageBuilderPusher.PushLazyLoadIndex(exec.NewSOCICommand(), digest); err != nil {
+			return fmt.Errorf("push lazy loading index: %w", err)
+		}
+	}
+	if err := updateImageLifecyclePolicy(o.imageBuilderPusher, svc); err != nil {
+		return err
+	}
 	o.imageDigest = digest
 	o.buildRequired = true
 	return nil
 }
 
+// configurePushedImage deploys an image already in the service's repository, so that the exact image
+// deployed to an environment can be promoted to the next one without being rebuilt.
+func (o *deploySvcOpts) configurePushedImage() error {
+	digest := o.pushedDigest
+	if o.noBuild {
+		last, err := o.imageBuilderPusher.LastPushedDigest()
+		if err != nil {
+			return err
+		}
+		digest = last
+	}
+	log.Infof("Deploying image %s of service %s without building it.\n", color.HighlightResource(digest), color.HighlightUserInput(o.name))
+	o.imageDigest = digest
+	o.buildRequired = true
+	return nil
+}
+
+// lazyLoadImage returns true if the workload's manifest asks for its built image to be lazily loaded.
+func lazyLoadImage(mft interface{}) bool {
+	type lazyLoader interface {
+		LazyLoadImage() bool
+	}
+	mf, ok := mft.(lazyLoader)
+	return ok && mf.LazyLoadImage()
+}
+
+// updateImageLifecyclePolicy sets the lifecycle policy of the workload's manifest on its repository.
+// If the manifest doesn't expire any image, the policy left by a previous deployment is deleted.
+func updateImageLifecyclePolicy(repo imageBuilderPusher, mft interface{}) error {
+	policy := imageLifecyclePolicy(mft)
+	if policy == (ecr.LifecyclePolicy{}) {
+		if err := repo.DeleteLifecyclePolicy(); err != nil {
+			return fmt.Errorf("delete image lifecycle policy: %w", err)
+		}
+		return nil
+	}
+	if err := repo.SetLifecyclePolicy(policy); err != nil {
+		return fmt.Errorf("set image lifecycle policy: %w", err)
+	}
+	return nil
+}
+
+// imageLifecyclePolicy returns which images the workload's manifest expires from its repository.
+// A zero policy means that all images are kept.
+func imageLifecyclePolicy(mft interface{}) ecr.LifecyclePolicy {
+	type imageExpirer interface {
+		ImagesToKeep() int
+		UntaggedImagesExpiry() int
+	}
+	mf, ok := mft.(imageExpirer)
+	if !ok {
+		return ecr.LifecyclePolicy{}
+	}
+	return ecr.LifecyclePolicy{
+		KeepLast:                mf.ImagesToKeep(),
+		ExpireUntaggedAfterDays: mf.UntaggedImagesExpiry(),
+	}
+}
+
+// pullThroughImage returns the workload's image pulled through the application's ECR cache of its registry,
+// along with the repository prefix of the cache.
+// Returns nil if the image is built from a Dockerfile, or if its registry isn't cached in the account of the environment.
+func pullThroughImage(mft interface{}, app *config.Application, env *config.Environment) (*stack.ECRImage, string) {
+	type imageLocator interface {
+		ImageLocation() string
+	}
+	mf, ok := mft.(imageLocator)
+	if !ok || mf.ImageLocation() == "" {
+		return nil, ""
+	}
+	location, prefix := app.PullThroughImage(mf.ImageLocation(), env.Region)
+	if location == "" {
+		return nil, ""
+	}
+	if env.AccountID != app.AccountID {
+		log.Warningf("Pulling %s directly from its registry: environment %s is not in the account of application %s that caches it.\n",
+			mf.ImageLocation(), env.Name, app.Name)
+		return nil, ""
+	}
+	if i := strings.LastIndex(location, "@"); i != -1 {
+		return &stack.ECRImage{RepoURL: location[:i], Digest: location[i+1:]}, prefix
+	}
+	if i := strings.LastIndex(location, ":"); i > strings.LastIndex(location, "/") {
+		return &stack.ECRImage{RepoURL: location[:i], ImageTag: location[i+1:]}, prefix
+	}
+	return &stack.ECRImage{RepoURL: location}, prefix
+}
+
+// grantEnvImageAccess makes sure that an environment in a different account than the application
+// can pull the images pushed to the application's ECR repositories.
+func grantEnvImageAccess(granter envImageAccessGranter, app *config.Application, env *config.Environment) error {
+	if env.AccountID == app.AccountID {
+		return nil
+	}
+	if err := granter.GrantEnvImageAccess(&cloudformation.AddEnvToAppOpts{
+		App:          app,
+		EnvName:      env.Name,
+		EnvAccountID: env.AccountID,
+		EnvRegion:    env.Region,
+	}); err != nil {
+		return fmt.Errorf("grant environment %s access to the images of application %s: %w", env.Name, app.Name, err)
+	}
+	return nil
+}
+
+// warnNearQuotas logs a warning for each quota that the environment is close to exhausting.
+// The check is best effort, so errors are ignored.
+func warnNearQuotas(d quotaDescriber) {
+	quotas, err := d.Describe()
+	if err != nil {
+		return
+	}
+	for _, quota := range quotas {
+		if !quota.NearLimit() {
+			continue
+		}
+		log.Warningf("Environment %s is close to the %s quota: %s.\n", color.HighlightUserInput(quota.Environment), quota.Name, quota)
+	}
+}
+
+// executionRolePermissions returns the permissions that a task execution role needs to start the tasks of a workload:
+// pulling the image from ECR, writing to the workload's log group, and reading the secrets of its containers.
+func executionRolePermissions(mft interface{}, env *config.Environment, wkld string, image *stack.ECRImage) []iam.Permission {
+	partition := "aws"
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), env.Region); ok {
+		partition = p.ID()
+	}
+	var perms []iam.Permission
+	if image != nil {
+		// Repository URLs look like "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api".
+		parts := strings.SplitN(image.RepoURL, "/", 2)
+		host := strings.Split(parts[0], ".")
+		if len(parts) == 2 && len(host) > 3 {
+			repo := fmt.Sprintf("arn:%s:ecr:%s:%s:repository/%s", partition, host[3], host[0], parts[1])
+			perms = append(perms,
+				iam.Permission{Action: "ecr:GetAuthorizationToken", Resource: "*"},
+				iam.Permission{Action: "ecr:BatchCheckLayerAvailability", Resource: repo},
+				iam.Permission{Action: "ecr:GetDownloadUrlForLayer", Resource: repo},
+				iam.Permission{Action: "ecr:BatchGetImage", Resource: repo})
+		}
+	}
+	logStreams := fmt.Sprintf("arn:%s:logs:%s:%s:log-group:%s:log-stream:*", partition, env.Region, env.AccountID, env.WorkloadLogGroupName(wkld))
+	perms = append(perms,
+		iam.Permission{Action: "logs:CreateLogStream", Resource: logStreams},
+		iam.Permission{Action: "logs:PutLogEvents", Resource: logStreams})
+
+	seen := make(map[string]bool)
+	for _, valueFrom := range containerSecrets(mft) {
+		perm := secretPermission(valueFrom, partition, env)
+		if seen[perm.Resource] {
+			continue
+		}
+		seen[perm.Resource] = true
+		perms = append(perms, perm)
+	}
+	return perms
+}
+
+// containerSecrets returns the sorted ValueFrom of the secrets of the main container, sidecars and init containers of a workload.
+func containerSecrets(mft interface{}) []string {
+	switch t := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		return collectSecrets(t.Secrets, t.Sidecars, t.InitContainers)
+	case *manifest.BackendService:
+		return collectSecrets(t.Secrets, t.Sidecars, t.InitContainers)
+	case *manifest.ScheduledJob:
+		return collectSecrets(t.Secrets, t.Sidecars, t.InitContainers)
+	}
+	return nil
+}
+
+func collectSecrets(main map[string]string, sidecars map[string]*manifest.SidecarConfig, inits map[string]*manifest.InitContainerConfig) []string {
+	valueFroms := make([]string, 0, len(main))
+	for _, valueFrom := range main {
+		valueFroms = append(valueFroms, valueFrom)
+	}
+	for _, sidecar := range sidecars {
+		for _, valueFrom := range sidecar.Secrets {
+			valueFroms = append(valueFroms, valueFrom)
+		}
+	}
+	for _, container := range inits {
+		for _, valueFrom := range container.Secrets {
+			valueFroms = append(valueFroms, valueFrom)
+		}
+	}
+	sort.Strings(valueFroms)
+	return valueFroms
+}
+
+// secretPermission returns the permission to read a container secret, which is either
+// the ARN of an SSM parameter or a Secrets Manager secret, the name of a Secrets Manager secret
+// prefixed with "secretsmanager:", or the name of an SSM parameter.
+func secretPermission(valueFrom, partition string, env *config.Environment) iam.Permission {
+	if parsed, err := arn.Parse(valueFrom); err == nil {
+		if parsed.Service == secretsmanager.ServiceName {
+			// Drop the JSON key, version stage and version ID of the secret if any.
+			if parts := strings.Split(valueFrom, ":"); len(parts) > 7 {
+				valueFrom = strings.Join(parts[:7], ":")
+			}
+			return iam.Permission{Action: "secretsmanager:GetSecretValue", Resource: valueFrom}
+		}
+		return iam.Permission{Action: "ssm:GetParameters", Resource: valueFrom}
+	}
+	if name := strings.TrimPrefix(valueFrom, template.SecretsManagerValueFromPrefix); name != valueFrom {
+		name = strings.SplitN(name, ":", 2)[0]
+		// Secrets Manager appends six random characters to the ARN of a secret.
+		return iam.Permission{
+			Action:   "secretsmanager:GetSecretValue",
+			Resource: fmt.Sprintf("arn:%s:secretsmanager:%s:%s:secret:%s-??????", partition, env.Region, env.AccountID, name),
+		}
+	}
+	return iam.Permission{
+		Action:   "ssm:GetParameters",
+		Resource: fmt.Sprintf("arn:%s:ssm:%s:%s:parameter/%s", partition, env.Region, env.AccountID, strings.TrimPrefix(valueFrom, "/")),
+	}
+}
+
+// validateExecutionRole returns an error listing the permissions that the shared execution role is missing.
+func validateExecutionRole(simulator rolePermissionsSimulator, roleARN string, perms []iam.Permission) error {
+	denied, err := simulator.DeniedPermissions(roleARN, perms)
+	if err != nil {
+		return fmt.Errorf("check permissions of execution role %s: %w", roleARN, err)
+	}
+	if len(denied) == 0 {
+		return nil
+	}
+	var missing []string
+	for _, perm := range denied {
+		missing = append(missing, fmt.Sprintf("- %s", perm))
+	}
+	return fmt.Errorf("execution role %s is missing the following permissions:\n%s", roleARN, strings.Join(missing, "\n"))
+}
+
 func (o *deploySvcOpts) dfBuildArgs(svc interface{}) (*exec.BuildArguments, error) {
 	copilotDir, err := o.ws.CopilotDirPath()
 	if err != nil {
 		return nil, fmt.Errorf("get copilot directory: %w", err)
 	}
-	return buildArgs(o.name, o.imageTag, copilotDir, svc)
+	args, err := buildArgs(o.name, o.imageTag, copilotDir, svc)
+	if err != nil {
+		return nil, err
+	}
+	wsRoot := filepath.Dir(copilotDir)
+	if o.buildContext != "" {
+		args.Context = filepath.Join(wsRoot, o.buildContext)
+	}
+	if err := validateBuildArgs(o.fs, wsRoot, args); err != nil {
+		return nil, err
+	}
+	return args, nil
 }
 
 func buildArgs(name, imageTag, copilotDir string, unmarshaledManifest interface{}) (*exec.BuildArguments, error) {
@@ -330,11 +875,48 @@ func buildArgs(name, imageTag, copilotDir string, unmarshaledManifest interface{
 		Context:    *args.Context,
 		Args:       args.Args,
 		CacheFrom:  args.CacheFrom,
+		CacheTo:    args.CacheTo,
 		Target:     aws.StringValue(args.Target),
 		Tags:       tags,
+		Platforms:  args.Platform.ToStringSlice(),
 	}, nil
 }
 
+// validateBuildArgs returns an error if the Dockerfile or the build context don't exist,
+// or if the build context is outside of the workspace.
+// It warns if the .dockerignore file next to the Dockerfile won't be applied because the context is elsewhere.
+func validateBuildArgs(fs afero.Fs, wsRoot string, args *exec.BuildArguments) error {
+	rel, err := filepath.Rel(wsRoot, args.Context)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("build context %s must be inside the workspace %s", args.Context, wsRoot)
+	}
+	if isDir, err := afero.IsDir(fs, args.Context); err != nil || !isDir {
+		return fmt.Errorf("build context %s must be an existing directory", args.Context)
+	}
+	if exists, _ := afero.Exists(fs, args.Dockerfile); !exists {
+		return fmt.Errorf("dockerfile %s does not exist", args.Dockerfile)
+	}
+
+	dfDir := filepath.Dir(args.Dockerfile)
+	if filepath.Clean(dfDir) == filepath.Clean(args.Context) {
+		return nil
+	}
+	if exists, _ := afero.Exists(fs, filepath.Join(args.Context, dockerignoreFileName)); exists {
+		return nil
+	}
+	// With BuildKit, an ignore file named after the Dockerfile takes precedence over the one in the context.
+	dfIgnoreFileName := filepath.Base(args.Dockerfile) + dockerignoreFileName
+	if exists, _ := afero.Exists(fs, filepath.Join(dfDir, dfIgnoreFileName)); exists {
+		return nil
+	}
+	if exists, _ := afero.Exists(fs, filepath.Join(dfDir, dockerignoreFileName)); exists {
+		log.Warningf(`%s in %s is ignored because the build context is %s.
+Move it to the root of the build context, or rename it to %s to use it with BuildKit.
+`, dockerignoreFileName, dfDir, args.Context, dfIgnoreFileName)
+	}
+	return nil
+}
+
 // pushAddonsTemplateToS3Bucket generates the addons template for the service and pushes it to S3.
 // If the service doesn't have any addons, it returns the empty string and no errors.
 // If the service has addons, it returns the URL of the S3 object storing the addons template.
@@ -366,6 +948,11 @@ func (o *deploySvcOpts) manifest() (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("read service %s manifest file: %w", o.name, err)
 	}
+	if o.manifestLocation != "" {
+		if raw, err = o.mergeRemoteManifest(raw); err != nil {
+			return nil, err
+		}
+	}
 	mft, err := o.unmarshal(raw)
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal service %s manifest: %w", o.name, err)
@@ -373,11 +960,39 @@ func (o *deploySvcOpts) manifest() (interface{}, error) {
 	return mft, nil
 }
 
+// mergeRemoteManifest returns the manifest at manifestLocation overridden by the fields of the workspace manifest.
+func (o *deploySvcOpts) mergeRemoteManifest(overrides []byte) ([]byte, error) {
+	if o.remoteManifest == nil {
+		base, err := o.manifestReader.Read(o.manifestLocation)
+		if err != nil {
+			return nil, fmt.Errorf("read manifest %s: %w", o.manifestLocation, err)
+		}
+		o.remoteManifest = base
+	}
+	merged, err := remote.Merge(o.remoteManifest, overrides)
+	if err != nil {
+		return nil, fmt.Errorf("override manifest %s with service %s manifest: %w", o.manifestLocation, o.name, err)
+	}
+	return merged, nil
+}
+
 func (o *deploySvcOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, error) {
+	executionRole, err := o.targetEnvironment.TaskExecutionRole(o.targetApp)
+	if err != nil {
+		return nil, err
+	}
+	redirect := o.targetEnvironment.HTTPRedirect()
 	if !o.buildRequired {
 		return &stack.RuntimeConfig{
-			AddonsTemplateURL: addonsURL,
-			AdditionalTags:    tags.Merge(o.targetApp.Tags, o.resourceTags),
+			Image:                  o.pullThroughImage,
+			PullThroughCache:       o.pullThroughCache,
+			AddonsTemplateURL:      addonsURL,
+			AdditionalTags:         tags.Merge(o.targetApp.Tags, o.resourceTags),
+			LogGroupPrefix:         o.targetEnvironment.LogGroupPrefix(),
+			DisableHTTPRedirect:    redirect.Disabled,
+			HTTPRedirectStatusCode: redirect.StatusCode,
+			ExecutionRoleARN:       executionRole,
+			DesiredCount:           o.forceDesiredCount,
 		}, nil
 	}
 	resources, err := o.appCFN.GetAppResourcesByRegion(o.targetApp, o.targetEnvironment.Region)
@@ -393,8 +1008,13 @@ func (o *deploySvcOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 		}
 	}
 	return &stack.RuntimeConfig{
-		AddonsTemplateURL: addonsURL,
-		AdditionalTags:    tags.Merge(o.targetApp.Tags, o.resourceTags),
+		AddonsTemplateURL:      addonsURL,
+		AdditionalTags:         tags.Merge(o.targetApp.Tags, o.resourceTags),
+		LogGroupPrefix:         o.targetEnvironment.LogGroupPrefix(),
+		DisableHTTPRedirect:    redirect.Disabled,
+		HTTPRedirectStatusCode: redirect.StatusCode,
+		ExecutionRoleARN:       executionRole,
+		DesiredCount:           o.forceDesiredCount,
 		Image: &stack.ECRImage{
 			RepoURL:  repoURL,
 			ImageTag: o.imageTag,
@@ -412,10 +1032,16 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	if err != nil {
 		return nil, err
 	}
+	if rc.ExecutionRoleARN != "" {
+		perms := executionRolePermissions(mft, o.targetEnvironment, o.name, rc.Image)
+		if err := validateExecutionRole(o.roleSimulator, rc.ExecutionRoleARN, perms); err != nil {
+			return nil, err
+		}
+	}
 	var conf cloudformation.StackConfiguration
 	switch t := mft.(type) {
 	case *manifest.LoadBalancedWebService:
-		if o.targetApp.RequiresDNSDelegation() {
+		if o.targetApp.RequiresDNSDelegation() || o.targetEnvironment.HasDomain() {
 			conf, err = stack.NewHTTPSLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
 		} else {
 			conf, err = stack.NewLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
@@ -437,13 +1063,58 @@ func (o *deploySvcOpts) deploySvc(addonsURL string) error {
 		return err
 	}
 
+	if o.forceDeploy {
+		return o.forceDeploySvc(conf)
+	}
+	if o.confirmChangeSet {
+		return o.reviewAndDeploySvc(conf)
+	}
 	if err := o.svcCFN.DeployService(os.Stderr, conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
+		var errInProgress *awscloudformation.ErrStackUpdateInProgress
+		if errors.As(err, &errInProgress) {
+			log.Infof("Run %s to follow the deployment in progress.\n",
+				color.HighlightCode(fmt.Sprintf("copilot svc deploy -n %s -e %s --watch-only", o.name, o.envName)))
+		}
+		return fmt.Errorf("deploy service: %w", err)
+	}
+	return nil
+}
+
+// forceDeploySvc cancels the in-progress deployment of the service,
+// and then starts the new deployment without waiting for the service to reach a steady state.
+func (o *deploySvcOpts) forceDeploySvc(conf cloudformation.StackConfiguration) error {
+	if err := o.svcCFN.CancelWorkloadUpdate(os.Stderr, conf.StackName()); err != nil {
+		return fmt.Errorf("cancel in-progress deployment of service %s: %w", o.name, err)
+	}
+	if err := o.svcCFN.ForceDeployService(os.Stderr, conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
+		return fmt.Errorf("force deploy service: %w", err)
+	}
+	log.Infof("Started the deployment of %s without waiting for it to stabilize.\nRun %s to follow its progress.\n",
+		color.HighlightUserInput(o.name),
+		color.HighlightCode(fmt.Sprintf("copilot svc status -n %s -e %s", o.name, o.envName)))
+	return nil
+}
+
+// reviewAndDeploySvc shows the changes to the service stack and deploys them only if they're confirmed.
+func (o *deploySvcOpts) reviewAndDeploySvc(conf cloudformation.StackConfiguration) error {
+	err := o.svcCFN.ReviewAndDeployService(os.Stderr, conf, o.confirmChanges, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN))
+	if err != nil {
+		var errDeclined *cloudformation.ErrChangeSetDeclined
+		if errors.As(err, &errDeclined) {
+			return errDeploymentCancelled
+		}
 		return fmt.Errorf("deploy service: %w", err)
 	}
 	return nil
 }
 
-func (o *deploySvcOpts) showSvcURI() error {
+// confirmChanges asks whether the changes proposed to the service stack should be deployed.
+func (o *deploySvcOpts) confirmChanges() (bool, error) {
+	return o.prompt.Confirm(fmt.Sprintf(fmtChangeSetConfirmPrompt, o.name, o.envName), changeSetConfirmHelp)
+}
+
+// showSvcURI logs the endpoint of the deployed service and returns it.
+func (o *deploySvcOpts) showSvcURI() (string, error) {
 	type identifier interface {
 		URI(string) (string, error)
 	}
@@ -471,12 +1142,12 @@ func (o *deploySvcOpts) showSvcURI() error {
 		err = errors.New("unexpected service type")
 	}
 	if err != nil {
-		return fmt.Errorf("create describer for service type %s: %w", o.targetSvc.Type, err)
+		return "", fmt.Errorf("create describer for service type %s: %w", o.targetSvc.Type, err)
 	}
 
 	uri, err := svcDescriber.URI(o.targetEnvironment.Name)
 	if err != nil {
-		return fmt.Errorf("get uri for environment %s: %w", o.targetEnvironment.Name, err)
+		return "", fmt.Errorf("get uri for environment %s: %w", o.targetEnvironment.Name, err)
 	}
 	switch o.targetSvc.Type {
 	case manifest.BackendServiceType:
@@ -488,12 +1159,15 @@ func (o *deploySvcOpts) showSvcURI() error {
 	default:
 		log.Successf("Deployed %s, you can access it at %s.\n", color.HighlightUserInput(o.name), color.HighlightResource(uri))
 	}
-	return nil
+	return uri, nil
 }
 
 // buildSvcDeployCmd builds the `svc deploy` subcommand.
 func buildSvcDeployCmd() *cobra.Command {
 	vars := deployWkldVars{}
+	var forceDesiredCount int
+	var buildContext, pushedDigest, manifestLocation string
+	var noBuild, verify, confirmChangeSet, watchOnly bool
 	cmd := &cobra.Command{
 		Use:   "deploy",
 		Short: "Deploys a service to an environment.",
@@ -502,12 +1176,40 @@ func buildSvcDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot svc deploy --name frontend --env test
   Deploys a service with additional resource tags.
-  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Cancels a stuck deployment, and deploys the service with 1 task without waiting for it to stabilize.
+  /code $ copilot svc deploy --name frontend --env prod --force --force-desired-count 1
+  Deploys a service whose image needs the shared libraries at the root of the workspace.
+  /code $ copilot svc deploy --name frontend --env test --context .
+  Promotes the image deployed to the "test" environment to "prod" without rebuilding it.
+  /code $ copilot svc deploy --name frontend --env prod --image-digest sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49
+  Redeploys the image last pushed for the service without rebuilding it.
+  /code $ copilot svc deploy --name frontend --env prod --no-build
+  Deploys a service and runs the checks of its manifest against it, failing if any of them fails.
+  /code $ copilot svc deploy --name frontend --env test --verify
+  Deploys a service from a manifest shared in S3, with the fields of the workspace manifest as overrides.
+  /code $ copilot svc deploy --name frontend --env test --manifest s3://platform-manifests/lb-web-service.yml
+  Shows the resources that the deployment replaces, and deploys them only once confirmed.
+  /code $ copilot svc deploy --name frontend --env prod --confirm-changeset
+  Resumes following a deployment that was interrupted, and reports its result.
+  /code $ copilot svc deploy --name frontend --env prod --watch-only`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed(forceDesiredCountFlag) {
+				vars.forceDesiredCount = aws.Int(forceDesiredCount)
+			}
 			opts, err := newSvcDeployOpts(vars)
 			if err != nil {
 				return err
 			}
+			opts.buildContext = buildContext
+			opts.pushedDigest = pushedDigest
+			opts.noBuild = noBuild
+			opts.verify = verify
+			opts.manifestLocation = manifestLocation
+			opts.confirmChangeSet = confirmChangeSet
+			opts.watchOnly = watchOnly
+			opts.shouldOutputJSON = shouldOutputJSON(cmd)
+			opts.strictVersion = shouldEnforceTemplateVersion(cmd)
 			if err := opts.Validate(); err != nil {
 				return err
 			}
@@ -525,6 +1227,16 @@ func buildSvcDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.forceDeploy, forceFlag, false, forceDeployFlagDescription)
+	cmd.Flags().IntVar(&forceDesiredCount, forceDesiredCountFlag, 0, forceDesiredCountFlagDescription)
+	cmd.Flags().StringVar(&buildContext, buildContextFlag, "", buildContextFlagDescription)
+	cmd.Flags().StringVar(&pushedDigest, imageDigestFlag, "", imageDigestFlagDescription)
+	cmd.Flags().BoolVar(&noBuild, noBuildFlag, false, noBuildFlagDescription)
+	cmd.Flags().BoolVar(&verify, verifyFlag, false, verifyFlagDescription)
+	cmd.Flags().StringVar(&manifestLocation, manifestFlag, "", manifestFlagDescription)
+	cmd.Flags().BoolVar(&confirmChangeSet, confirmChangeSetFlag, false, confirmChangeSetFlagDescription)
+	cmd.Flags().BoolVar(&watchOnly, watchOnlyFlag, false, watchOnlyFlagDescription)
 
 	return cmd
 }
@@ -9,12 +9,17 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	addon "github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
@@ -27,9 +32,19 @@ type deploySvcMocks struct {
 
 func TestSvcDeployOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inAppName string
-		inEnvName string
-		inSvcName string
+		inAppName           string
+		inEnvName           string
+		inSvcName           string
+		inForce             bool
+		inForceDesiredCount *int
+		inImageTag          string
+		inBuildContext      string
+		inImageDigest       string
+		inNoBuild           bool
+		inVerify            bool
+		inManifestLocation  string
+		inConfirmChangeSet  bool
+		inWatchOnly         bool
 
 		mockWs    func(m *mocks.MockwsSvcDirReader)
 		mockStore func(m *mocks.Mockstore)
@@ -42,6 +57,111 @@ func TestSvcDeployOpts_Validate(t *testing.T) {
 
 			wantedError: errNoAppInWorkspace,
 		},
+		"desired count without force": {
+			inAppName:           "phonetool",
+			inForceDesiredCount: aws.Int(1),
+			mockWs:              func(m *mocks.MockwsSvcDirReader) {},
+			mockStore:           func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--force-desired-count must be specified with --force"),
+		},
+		"negative desired count": {
+			inAppName:           "phonetool",
+			inForce:             true,
+			inForceDesiredCount: aws.Int(-1),
+			mockWs:              func(m *mocks.MockwsSvcDirReader) {},
+			mockStore:           func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--force-desired-count must be greater than or equal to 0"),
+		},
+		"verify with force": {
+			inAppName: "phonetool",
+			inForce:   true,
+			inVerify:  true,
+			mockWs:    func(m *mocks.MockwsSvcDirReader) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--verify cannot be specified with --force"),
+		},
+		"confirm changeset with force": {
+			inAppName:          "phonetool",
+			inForce:            true,
+			inConfirmChangeSet: true,
+			mockWs:             func(m *mocks.MockwsSvcDirReader) {},
+			mockStore:          func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--confirm-changeset cannot be specified with --force"),
+		},
+		"invalid manifest location": {
+			inAppName:          "phonetool",
+			inManifestLocation: "git@github.com:org/manifests.git",
+			mockWs:             func(m *mocks.MockwsSvcDirReader) {},
+			mockStore:          func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--manifest is invalid: location git@github.com:org/manifests.git must start with s3:// or https://"),
+		},
+		"image digest with no build": {
+			inAppName:     "phonetool",
+			inImageDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+			inNoBuild:     true,
+			mockWs:        func(m *mocks.MockwsSvcDirReader) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--image-digest cannot be specified with --no-build"),
+		},
+		"invalid image digest": {
+			inAppName:     "phonetool",
+			inImageDigest: "v1.2.0",
+			mockWs:        func(m *mocks.MockwsSvcDirReader) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("image digest v1.2.0 is invalid: %w", errImageDigestBadFormat),
+		},
+		"image digest with tag": {
+			inAppName:     "phonetool",
+			inImageDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+			inImageTag:    "v1.2.0",
+			mockWs:        func(m *mocks.MockwsSvcDirReader) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--tag cannot be specified with --image-digest"),
+		},
+		"no build with build context": {
+			inAppName:      "phonetool",
+			inNoBuild:      true,
+			inBuildContext: ".",
+			mockWs:         func(m *mocks.MockwsSvcDirReader) {},
+			mockStore:      func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--context cannot be specified with --no-build"),
+		},
+		"watch only with force": {
+			inAppName:   "phonetool",
+			inForce:     true,
+			inWatchOnly: true,
+			mockWs:      func(m *mocks.MockwsSvcDirReader) {},
+			mockStore:   func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--force cannot be specified with --watch-only"),
+		},
+		"watch only with no build": {
+			inAppName:   "phonetool",
+			inNoBuild:   true,
+			inWatchOnly: true,
+			mockWs:      func(m *mocks.MockwsSvcDirReader) {},
+			mockStore:   func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--no-build cannot be specified with --watch-only"),
+		},
+		"watch only with tag": {
+			inAppName:   "phonetool",
+			inImageTag:  "v1.2.0",
+			inWatchOnly: true,
+			mockWs:      func(m *mocks.MockwsSvcDirReader) {},
+			mockStore:   func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--tag cannot be specified with --watch-only"),
+		},
 		"with workspace error": {
 			inAppName: "phonetool",
 			inSvcName: "frontend",
@@ -74,9 +194,11 @@ func TestSvcDeployOpts_Validate(t *testing.T) {
 			wantedError: errors.New("get environment test configuration: unknown env"),
 		},
 		"successful validation": {
-			inAppName: "phonetool",
-			inSvcName: "frontend",
-			inEnvName: "test",
+			inAppName:           "phonetool",
+			inSvcName:           "frontend",
+			inEnvName:           "test",
+			inForce:             true,
+			inForceDesiredCount: aws.Int(0),
 			mockWs: func(m *mocks.MockwsSvcDirReader) {
 				m.EXPECT().ServiceNames().Return([]string{"frontend"}, nil)
 			},
@@ -85,6 +207,19 @@ func TestSvcDeployOpts_Validate(t *testing.T) {
 					Return(&config.Environment{Name: "test"}, nil)
 			},
 		},
+		"successful validation with watch only": {
+			inAppName:   "phonetool",
+			inVerify:    true,
+			inWatchOnly: true,
+			mockWs:      func(m *mocks.MockwsSvcDirReader) {},
+			mockStore:   func(m *mocks.Mockstore) {},
+		},
+		"successful validation with image digest": {
+			inAppName:     "phonetool",
+			inImageDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+			mockWs:        func(m *mocks.MockwsSvcDirReader) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -99,12 +234,23 @@ func TestSvcDeployOpts_Validate(t *testing.T) {
 			tc.mockStore(mockStore)
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
-					appName: tc.inAppName,
-					name:    tc.inSvcName,
-					envName: tc.inEnvName,
+					appName:  tc.inAppName,
+					name:     tc.inSvcName,
+					envName:  tc.inEnvName,
+					imageTag: tc.inImageTag,
+
+					forceDeploy:       tc.inForce,
+					forceDesiredCount: tc.inForceDesiredCount,
 				},
-				ws:    mockWs,
-				store: mockStore,
+				buildContext:     tc.inBuildContext,
+				pushedDigest:     tc.inImageDigest,
+				noBuild:          tc.inNoBuild,
+				verify:           tc.inVerify,
+				manifestLocation: tc.inManifestLocation,
+				confirmChangeSet: tc.inConfirmChangeSet,
+				watchOnly:        tc.inWatchOnly,
+				ws:               mockWs,
+				store:            mockStore,
 			}
 
 			// WHEN
@@ -120,6 +266,65 @@ func TestSvcDeployOpts_Validate(t *testing.T) {
 	}
 }
 
+func TestSvcDeployOpts_watchSvc(t *testing.T) {
+	testCases := map[string]struct {
+		mockRenderer func(m *mocks.MockworkloadUpdateRenderer)
+
+		wantedInProgress bool
+		wantedError      error
+	}{
+		"returns false if the service is not being deployed": {
+			mockRenderer: func(m *mocks.MockworkloadUpdateRenderer) {
+				m.EXPECT().RenderWorkloadUpdate(gomock.Any(), "phonetool-test-frontend").Return(&cloudformation.ErrStackNotInProgress{})
+			},
+		},
+		"wraps the error if the deployment fails": {
+			mockRenderer: func(m *mocks.MockworkloadUpdateRenderer) {
+				m.EXPECT().RenderWorkloadUpdate(gomock.Any(), "phonetool-test-frontend").Return(errors.New("some error"))
+			},
+
+			wantedError: errors.New("render the deployment of service frontend: some error"),
+		},
+		"returns true once the deployment in progress completes": {
+			mockRenderer: func(m *mocks.MockworkloadUpdateRenderer) {
+				m.EXPECT().RenderWorkloadUpdate(gomock.Any(), "phonetool-test-frontend").Return(nil)
+			},
+
+			wantedInProgress: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRenderer := mocks.NewMockworkloadUpdateRenderer(ctrl)
+			tc.mockRenderer(mockRenderer)
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					appName: "phonetool",
+					name:    "frontend",
+					envName: "test",
+				},
+				updateRenderer: mockRenderer,
+			}
+
+			// WHEN
+			inProgress, err := opts.watchSvc()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedInProgress, inProgress)
+		})
+	}
+}
+
 func TestSvcDeployOpts_Ask(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName  string
@@ -220,10 +425,40 @@ type: 'Load Balanced Web Service'
 image:
   build:
     dockerfile: path/to/Dockerfile`)
+	mockMftPlatforms := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  build:
+    dockerfile: path/to/Dockerfile
+    platform: [linux/amd64, linux/arm64]`)
+	mockMftCache := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  build:
+    dockerfile: path/to/Dockerfile
+    cache_from:
+      - type=registry,ref=mockURI:cache
+    cache_to:
+      - type=registry,ref=mockURI:cache,mode=max`)
+	mockMftLazyLoad := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  build: path/to/Dockerfile
+  lazy_load: true`)
+	mockMftLifecycle := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  build: path/to/Dockerfile
+  repository:
+    lifecycle:
+      keep_last: 20`)
 
 	tests := map[string]struct {
-		inputSvc   string
-		setupMocks func(mocks deploySvcMocks)
+		inputSvc       string
+		inBuildContext string
+		inImageDigest  string
+		inNoBuild      bool
+		setupMocks     func(mocks deploySvcMocks)
 
 		wantErr      error
 		wantedDigest string
@@ -257,6 +492,48 @@ image:
 				)
 			},
 		},
+		"should return error if an image digest is given for a service that isn't built": {
+			inputSvc:      "serviceA",
+			inImageDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+			setupMocks: func(m deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockMftNoBuild, nil)
+			},
+			wantErr: errors.New("service serviceA uses an existing image instead of building one from a Dockerfile"),
+		},
+		"success with image digest without building and pushing": {
+			inputSvc:      "serviceA",
+			inImageDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+		"should return error if fail to get the last pushed image": {
+			inputSvc:  "serviceA",
+			inNoBuild: true,
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil),
+					m.mockimageBuilderPusher.EXPECT().LastPushedDigest().Return("", mockError),
+				)
+			},
+			wantErr: mockError,
+		},
+		"success with the last pushed image without building and pushing": {
+			inputSvc:  "serviceA",
+			inNoBuild: true,
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil),
+					m.mockimageBuilderPusher.EXPECT().LastPushedDigest().Return("sha256:1234", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+			wantedDigest: "sha256:1234",
+		},
 		"should return error if fail to build and push": {
 			inputSvc: "serviceA",
 			setupMocks: func(m deploySvcMocks) {
@@ -278,10 +555,74 @@ image:
 						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
 						Context:    filepath.Join("/ws", "root", "path"),
 					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+					m.mockimageBuilderPusher.EXPECT().DeleteLifecyclePolicy().Return(nil),
 				)
 			},
 			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
 		},
+		"should return error if fail to push the lazy loading index": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockMftLazyLoad, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return("sha256:1234", nil),
+					m.mockimageBuilderPusher.EXPECT().PushLazyLoadIndex(gomock.Any(), "sha256:1234").Return(mockError),
+				)
+			},
+			wantErr: fmt.Errorf("push lazy loading index: mockError"),
+		},
+		"success with lazy loading": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockMftLazyLoad, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return("sha256:1234", nil),
+					m.mockimageBuilderPusher.EXPECT().PushLazyLoadIndex(gomock.Any(), "sha256:1234").Return(nil),
+					m.mockimageBuilderPusher.EXPECT().DeleteLifecyclePolicy().Return(nil),
+				)
+			},
+			wantedDigest: "sha256:1234",
+		},
+		"should return error if fail to delete the image lifecycle policy": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return("sha256:1234", nil),
+					m.mockimageBuilderPusher.EXPECT().SetLifecyclePolicy(gomock.Any()).Times(0),
+					m.mockimageBuilderPusher.EXPECT().DeleteLifecyclePolicy().Return(mockError),
+				)
+			},
+			wantErr: fmt.Errorf("delete image lifecycle policy: mockError"),
+		},
+		"should return error if fail to set the image lifecycle policy": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockMftLifecycle, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return("sha256:1234", nil),
+					m.mockimageBuilderPusher.EXPECT().SetLifecyclePolicy(ecr.LifecyclePolicy{KeepLast: 20}).Return(mockError),
+				)
+			},
+			wantErr: fmt.Errorf("set image lifecycle policy: mockError"),
+		},
+		"success with image lifecycle policy": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockMftLifecycle, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return("sha256:1234", nil),
+					m.mockimageBuilderPusher.EXPECT().SetLifecyclePolicy(ecr.LifecyclePolicy{KeepLast: 20}).Return(nil),
+					m.mockimageBuilderPusher.EXPECT().DeleteLifecyclePolicy().Times(0),
+				)
+			},
+			wantedDigest: "sha256:1234",
+		},
 		"using simple buildstring (backwards compatible)": {
 			inputSvc: "serviceA",
 			setupMocks: func(m deploySvcMocks) {
@@ -292,6 +633,7 @@ image:
 						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
 						Context:    filepath.Join("/ws", "root", "path", "to"),
 					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+					m.mockimageBuilderPusher.EXPECT().DeleteLifecyclePolicy().Return(nil),
 				)
 			},
 			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
@@ -306,6 +648,68 @@ image:
 						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
 						Context:    filepath.Join("/ws", "root", "path", "to"),
 					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+					m.mockimageBuilderPusher.EXPECT().DeleteLifecyclePolicy().Return(nil),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+		"overrides the build context": {
+			inputSvc:       "serviceA",
+			inBuildContext: ".",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &exec.BuildArguments{
+						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:    filepath.Join("/ws", "root"),
+					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+					m.mockimageBuilderPusher.EXPECT().DeleteLifecyclePolicy().Return(nil),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+		"should return error if the build context is outside of the workspace": {
+			inputSvc:       "serviceA",
+			inBuildContext: "..",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+			wantErr: fmt.Errorf("build context %s must be inside the workspace %s", filepath.Join("/ws"), filepath.Join("/ws", "root")),
+		},
+		"with multiple platforms": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockMftPlatforms, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &exec.BuildArguments{
+						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:    filepath.Join("/ws", "root", "path", "to"),
+						Platforms:  []string{"linux/amd64", "linux/arm64"},
+					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+					m.mockimageBuilderPusher.EXPECT().DeleteLifecyclePolicy().Return(nil),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+		"with build cache": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockMftCache, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &exec.BuildArguments{
+						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:    filepath.Join("/ws", "root", "path", "to"),
+						CacheFrom:  []string{"type=registry,ref=mockURI:cache"},
+						CacheTo:    []string{"type=registry,ref=mockURI:cache,mode=max"},
+					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+					m.mockimageBuilderPusher.EXPECT().DeleteLifecyclePolicy().Return(nil),
 				)
 			},
 			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
@@ -324,13 +728,21 @@ image:
 				mockimageBuilderPusher: mockimageBuilderPusher,
 			}
 			test.setupMocks(mocks)
+			fs := afero.NewMemMapFs()
+			_ = afero.WriteFile(fs, filepath.Join("/ws", "root", "path", "to", "Dockerfile"), []byte("FROM nginx"), 0644)
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
 					name: test.inputSvc,
 				},
+				buildContext:       test.inBuildContext,
+				pushedDigest:       test.inImageDigest,
+				noBuild:            test.inNoBuild,
 				unmarshal:          manifest.UnmarshalWorkload,
 				imageBuilderPusher: mockimageBuilderPusher,
 				ws:                 mockWorkspace,
+				fs:                 fs,
+				targetApp:          &config.Application{Name: "phonetool"},
+				targetEnvironment:  &config.Environment{Name: "test"},
 			}
 
 			gotErr := opts.configureContainerImage()
@@ -345,6 +757,444 @@ image:
 	}
 }
 
+func TestValidateBuildArgs(t *testing.T) {
+	wsRoot := filepath.Join("/ws", "root")
+	dockerfile := filepath.Join(wsRoot, "frontend", "Dockerfile")
+	testCases := map[string]struct {
+		inArgs  *exec.BuildArguments
+		setupFs func(fs afero.Fs)
+
+		wantedErr error
+	}{
+		"context outside of the workspace": {
+			inArgs: &exec.BuildArguments{
+				Dockerfile: dockerfile,
+				Context:    filepath.Join("/ws", "libs"),
+			},
+			wantedErr: fmt.Errorf("build context %s must be inside the workspace %s", filepath.Join("/ws", "libs"), wsRoot),
+		},
+		"context does not exist": {
+			inArgs: &exec.BuildArguments{
+				Dockerfile: dockerfile,
+				Context:    filepath.Join(wsRoot, "libs"),
+			},
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, dockerfile, []byte("FROM nginx"), 0644)
+			},
+			wantedErr: fmt.Errorf("build context %s must be an existing directory", filepath.Join(wsRoot, "libs")),
+		},
+		"dockerfile does not exist": {
+			inArgs: &exec.BuildArguments{
+				Dockerfile: dockerfile,
+				Context:    wsRoot,
+			},
+			setupFs: func(fs afero.Fs) {
+				_ = fs.MkdirAll(wsRoot, 0755)
+			},
+			wantedErr: fmt.Errorf("dockerfile %s does not exist", dockerfile),
+		},
+		"context above the service directory": {
+			inArgs: &exec.BuildArguments{
+				Dockerfile: dockerfile,
+				Context:    wsRoot,
+			},
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, dockerfile, []byte("FROM nginx"), 0644)
+				_ = afero.WriteFile(fs, filepath.Join(wsRoot, "frontend", ".dockerignore"), []byte("node_modules"), 0644)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			if tc.setupFs != nil {
+				tc.setupFs(fs)
+			}
+
+			// WHEN
+			err := validateBuildArgs(fs, wsRoot, tc.inArgs)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGrantEnvImageAccess(t *testing.T) {
+	app := &config.Application{
+		Name:      "phonetool",
+		AccountID: "1234",
+	}
+	testCases := map[string]struct {
+		inEnv       *config.Environment
+		mockGranter func(m *mocks.MockenvImageAccessGranter)
+
+		wantedErr string
+	}{
+		"skips environments in the application account": {
+			inEnv: &config.Environment{
+				Name:      "test",
+				AccountID: "1234",
+				Region:    "us-west-2",
+			},
+			mockGranter: func(m *mocks.MockenvImageAccessGranter) {
+				m.EXPECT().GrantEnvImageAccess(gomock.Any()).Times(0)
+			},
+		},
+		"wraps the error if access cannot be granted": {
+			inEnv: &config.Environment{
+				Name:      "prod",
+				AccountID: "5678",
+				Region:    "us-east-1",
+			},
+			mockGranter: func(m *mocks.MockenvImageAccessGranter) {
+				m.EXPECT().GrantEnvImageAccess(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: "grant environment prod access to the images of application phonetool: some error",
+		},
+		"grants access to environments in another account": {
+			inEnv: &config.Environment{
+				Name:      "prod",
+				AccountID: "5678",
+				Region:    "us-east-1",
+			},
+			mockGranter: func(m *mocks.MockenvImageAccessGranter) {
+				m.EXPECT().GrantEnvImageAccess(&cloudformation.AddEnvToAppOpts{
+					App:          app,
+					EnvName:      "prod",
+					EnvAccountID: "5678",
+					EnvRegion:    "us-east-1",
+				}).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockenvImageAccessGranter(ctrl)
+			tc.mockGranter(m)
+
+			// WHEN
+			err := grantEnvImageAccess(m, app, tc.inEnv)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPullThroughImage(t *testing.T) {
+	app := &config.Application{
+		Name:      "phonetool",
+		AccountID: "1234",
+		PullThroughCaches: []config.PullThroughCache{
+			{Registry: config.ECRPublicRegistry},
+		},
+	}
+	testCases := map[string]struct {
+		inManifest string
+		inEnv      *config.Environment
+
+		wantedImage  *stack.ECRImage
+		wantedPrefix string
+	}{
+		"returns nil if the image is built from a Dockerfile": {
+			inManifest: `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 80`,
+			inEnv: &config.Environment{Name: "test", AccountID: "1234", Region: "us-west-2"},
+		},
+		"returns nil if the registry of the image is not cached": {
+			inManifest: `name: api
+type: Backend Service
+image:
+  location: quay.io/prometheus/prometheus:v2.26.0
+  port: 80`,
+			inEnv: &config.Environment{Name: "test", AccountID: "1234", Region: "us-west-2"},
+		},
+		"returns nil if the environment is in a different account than the application": {
+			inManifest: `name: api
+type: Backend Service
+image:
+  location: public.ecr.aws/nginx/nginx:latest
+  port: 80`,
+			inEnv: &config.Environment{Name: "prod", AccountID: "5678", Region: "us-west-2"},
+		},
+		"returns the cached image with its tag": {
+			inManifest: `name: api
+type: Backend Service
+image:
+  location: public.ecr.aws/nginx/nginx:latest
+  port: 80`,
+			inEnv: &config.Environment{Name: "test", AccountID: "1234", Region: "us-west-2"},
+
+			wantedImage: &stack.ECRImage{
+				RepoURL:  "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool-ecr-public/nginx/nginx",
+				ImageTag: "latest",
+			},
+			wantedPrefix: "phonetool-ecr-public",
+		},
+		"returns the cached image with its digest": {
+			inManifest: `name: report
+type: Scheduled Job
+on:
+  schedule: "@daily"
+image:
+  location: public.ecr.aws/nginx/nginx@sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49`,
+			inEnv: &config.Environment{Name: "test", AccountID: "1234", Region: "us-west-2"},
+
+			wantedImage: &stack.ECRImage{
+				RepoURL: "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool-ecr-public/nginx/nginx",
+				Digest:  "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+			},
+			wantedPrefix: "phonetool-ecr-public",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft, err := manifest.UnmarshalWorkload([]byte(tc.inManifest))
+			require.NoError(t, err)
+
+			// WHEN
+			image, prefix := pullThroughImage(mft, app, tc.inEnv)
+
+			// THEN
+			require.Equal(t, tc.wantedImage, image)
+			require.Equal(t, tc.wantedPrefix, prefix)
+		})
+	}
+}
+
+func TestSvcDeployOpts_manifest(t *testing.T) {
+	const location = "s3://platform-manifests/backend.yml"
+	mockWsManifest := []byte(`name: api
+type: Backend Service
+count: 3`)
+	testCases := map[string]struct {
+		inManifestLocation string
+		mockReader         func(m *mocks.MockmanifestReader)
+
+		wanted    string
+		wantedErr error
+	}{
+		"reads the workspace manifest without a manifest location": {
+			mockReader: func(m *mocks.MockmanifestReader) {},
+
+			wanted: string(mockWsManifest),
+		},
+		"errors if the remote manifest can't be read": {
+			inManifestLocation: location,
+			mockReader: func(m *mocks.MockmanifestReader) {
+				m.EXPECT().Read(location).Return(nil, errors.New("some error"))
+			},
+
+			wantedErr: errors.New("read manifest s3://platform-manifests/backend.yml: some error"),
+		},
+		"overrides the remote manifest with the workspace manifest": {
+			inManifestLocation: location,
+			mockReader: func(m *mocks.MockmanifestReader) {
+				m.EXPECT().Read(location).Return([]byte(`name: api
+type: Backend Service
+count: 1
+image:
+  build: ./Dockerfile`), nil)
+			},
+
+			wanted: `count: 3
+image:
+  build: ./Dockerfile
+name: api
+type: Backend Service
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWs := mocks.NewMockwsSvcDirReader(ctrl)
+			mockWs.EXPECT().ReadServiceManifest("api").Return(mockWsManifest, nil)
+			mockReader := mocks.NewMockmanifestReader(ctrl)
+			tc.mockReader(mockReader)
+
+			var got string
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					name: "api",
+				},
+				manifestLocation: tc.inManifestLocation,
+				ws:               mockWs,
+				manifestReader:   mockReader,
+				unmarshal: func(in []byte) (interface{}, error) {
+					got = string(in)
+					return nil, nil
+				},
+			}
+
+			// WHEN
+			_, err := opts.manifest()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestSvcDeployOpts_validateListenerRuleQuota(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inManifest interface{}
+
+		mockEnvCFN      func(m *mocks.MockstackOutputsDescriber)
+		mockRuleCounter func(m *mocks.MocklistenerRuleCounter)
+
+		wantedErr string
+	}{
+		"skip if the service is not a Load Balanced Web Service": {
+			inManifest:      &manifest.BackendService{},
+			mockEnvCFN:      func(m *mocks.MockstackOutputsDescriber) {},
+			mockRuleCounter: func(m *mocks.MocklistenerRuleCounter) {},
+		},
+		"skip if the service is already deployed": {
+			inManifest: &manifest.LoadBalancedWebService{},
+			mockEnvCFN: func(m *mocks.MockstackOutputsDescriber) {
+				m.EXPECT().Exists("phonetool-test-frontend").Return(true, nil)
+			},
+			mockRuleCounter: func(m *mocks.MocklistenerRuleCounter) {},
+		},
+		"error if fail to check if the service exists": {
+			inManifest: &manifest.LoadBalancedWebService{},
+			mockEnvCFN: func(m *mocks.MockstackOutputsDescriber) {
+				m.EXPECT().Exists("phonetool-test-frontend").Return(false, mockError)
+			},
+			mockRuleCounter: func(m *mocks.MocklistenerRuleCounter) {},
+
+			wantedErr: "check if service frontend is deployed in environment test: some error",
+		},
+		"error if fail to get environment outputs": {
+			inManifest: &manifest.LoadBalancedWebService{},
+			mockEnvCFN: func(m *mocks.MockstackOutputsDescriber) {
+				m.EXPECT().Exists("phonetool-test-frontend").Return(false, nil)
+				m.EXPECT().Outputs(gomock.Any()).Return(nil, mockError)
+			},
+			mockRuleCounter: func(m *mocks.MocklistenerRuleCounter) {},
+
+			wantedErr: "get outputs of environment test: some error",
+		},
+		"error if fail to count listener rules": {
+			inManifest: &manifest.LoadBalancedWebService{},
+			mockEnvCFN: func(m *mocks.MockstackOutputsDescriber) {
+				m.EXPECT().Exists("phonetool-test-frontend").Return(false, nil)
+				m.EXPECT().Outputs(gomock.Any()).Return(map[string]string{
+					stack.EnvOutputHTTPListenerARN: "mockHTTPListenerARN",
+				}, nil)
+			},
+			mockRuleCounter: func(m *mocks.MocklistenerRuleCounter) {
+				m.EXPECT().ListenerRuleCount("mockHTTPListenerARN").Return(0, mockError)
+			},
+
+			wantedErr: "some error",
+		},
+		"success if the load balancer has room for new rules": {
+			inManifest: &manifest.LoadBalancedWebService{},
+			mockEnvCFN: func(m *mocks.MockstackOutputsDescriber) {
+				m.EXPECT().Exists("phonetool-test-frontend").Return(false, nil)
+				m.EXPECT().Outputs(gomock.Any()).Return(map[string]string{
+					stack.EnvOutputHTTPListenerARN:  "mockHTTPListenerARN",
+					stack.EnvOutputHTTPSListenerARN: "mockHTTPSListenerARN",
+				}, nil)
+			},
+			mockRuleCounter: func(m *mocks.MocklistenerRuleCounter) {
+				m.EXPECT().ListenerRuleCount("mockHTTPListenerARN").Return(49, nil)
+				m.EXPECT().ListenerRuleCount("mockHTTPSListenerARN").Return(49, nil)
+			},
+		},
+		"error if the load balancer runs out of rules": {
+			inManifest: &manifest.LoadBalancedWebService{},
+			mockEnvCFN: func(m *mocks.MockstackOutputsDescriber) {
+				m.EXPECT().Exists("phonetool-test-frontend").Return(false, nil)
+				m.EXPECT().Outputs(gomock.Any()).Return(map[string]string{
+					stack.EnvOutputHTTPListenerARN:  "mockHTTPListenerARN",
+					stack.EnvOutputHTTPSListenerARN: "mockHTTPSListenerARN",
+				}, nil)
+			},
+			mockRuleCounter: func(m *mocks.MocklistenerRuleCounter) {
+				m.EXPECT().ListenerRuleCount("mockHTTPListenerARN").Return(50, nil)
+				m.EXPECT().ListenerRuleCount("mockHTTPSListenerARN").Return(49, nil)
+			},
+
+			wantedErr: "the load balancer in environment test has 99 rules and service frontend needs 2 more, exceeding the quota of 100 rules per load balancer\n" +
+				"Delete unused services with `copilot svc delete` or request a quota increase for \"Rules per Application Load Balancer\" through Service Quotas",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWs := mocks.NewMockwsSvcDirReader(ctrl)
+			mockWs.EXPECT().ReadServiceManifest("frontend").Return([]byte("mock manifest"), nil)
+			mockEnvCFN := mocks.NewMockstackOutputsDescriber(ctrl)
+			tc.mockEnvCFN(mockEnvCFN)
+			mockRuleCounter := mocks.NewMocklistenerRuleCounter(ctrl)
+			tc.mockRuleCounter(mockRuleCounter)
+
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					appName: "phonetool",
+					name:    "frontend",
+				},
+				ws: mockWs,
+				unmarshal: func(in []byte) (interface{}, error) {
+					return tc.inManifest, nil
+				},
+				envCFN:      mockEnvCFN,
+				ruleCounter: mockRuleCounter,
+				targetEnvironment: &config.Environment{
+					Name: "test",
+				},
+			}
+
+			// WHEN
+			err := opts.validateListenerRuleQuota()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestSvcDeployOpts_pushAddonsTemplateToS3Bucket(t *testing.T) {
 	mockError := errors.New("some error")
 	tests := map[string]struct {
@@ -497,3 +1347,131 @@ func TestSvcDeployOpts_pushAddonsTemplateToS3Bucket(t *testing.T) {
 		})
 	}
 }
+
+func TestExecutionRolePermissions(t *testing.T) {
+	env := &config.Environment{App: "phonetool", Name: "test", AccountID: "1234", Region: "us-west-2"}
+	logStreams := "arn:aws:logs:us-west-2:1234:log-group:/copilot/phonetool-test-api:log-stream:*"
+	testCases := map[string]struct {
+		inManifest string
+		inImage    *stack.ECRImage
+
+		wanted []iam.Permission
+	}{
+		"only requires writing logs for an image outside of ECR": {
+			inManifest: `name: api
+type: Backend Service
+image:
+  location: nginx
+  port: 80`,
+
+			wanted: []iam.Permission{
+				{Action: "logs:CreateLogStream", Resource: logStreams},
+				{Action: "logs:PutLogEvents", Resource: logStreams},
+			},
+		},
+		"requires pulling the image from ECR and reading each secret once": {
+			inManifest: `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 80
+secrets:
+  GITHUB_TOKEN: /github/token
+  DB_PASSWORD: "secretsmanager:phonetool/db:password::"
+  API_KEY: "arn:aws:secretsmanager:us-west-2:1234:secret:api-key-AbCdEf:key::"
+sidecars:
+  nginx:
+    image: nginx
+    secrets:
+      TOKEN: GITHUB_TOKEN
+      GITHUB_TOKEN: /github/token
+init_containers:
+  migrate:
+    image: migrate
+    secrets:
+      DB_URL: /db/url`,
+			inImage: &stack.ECRImage{RepoURL: "5678.dkr.ecr.us-west-2.amazonaws.com/phonetool/api"},
+
+			wanted: []iam.Permission{
+				{Action: "ecr:GetAuthorizationToken", Resource: "*"},
+				{Action: "ecr:BatchCheckLayerAvailability", Resource: "arn:aws:ecr:us-west-2:5678:repository/phonetool/api"},
+				{Action: "ecr:GetDownloadUrlForLayer", Resource: "arn:aws:ecr:us-west-2:5678:repository/phonetool/api"},
+				{Action: "ecr:BatchGetImage", Resource: "arn:aws:ecr:us-west-2:5678:repository/phonetool/api"},
+				{Action: "logs:CreateLogStream", Resource: logStreams},
+				{Action: "logs:PutLogEvents", Resource: logStreams},
+				{Action: "ssm:GetParameters", Resource: "arn:aws:ssm:us-west-2:1234:parameter/db/url"},
+				{Action: "ssm:GetParameters", Resource: "arn:aws:ssm:us-west-2:1234:parameter/github/token"},
+				{Action: "ssm:GetParameters", Resource: "arn:aws:ssm:us-west-2:1234:parameter/GITHUB_TOKEN"},
+				{Action: "secretsmanager:GetSecretValue", Resource: "arn:aws:secretsmanager:us-west-2:1234:secret:api-key-AbCdEf"},
+				{Action: "secretsmanager:GetSecretValue", Resource: "arn:aws:secretsmanager:us-west-2:1234:secret:phonetool/db-??????"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft, err := manifest.UnmarshalWorkload([]byte(tc.inManifest))
+			require.NoError(t, err)
+
+			// WHEN
+			perms := executionRolePermissions(mft, env, "api", tc.inImage)
+
+			// THEN
+			require.Equal(t, tc.wanted, perms)
+		})
+	}
+}
+
+func TestValidateExecutionRole(t *testing.T) {
+	const mockRoleARN = "arn:aws:iam::1234:role/ecsTaskExecutionRole"
+	perms := []iam.Permission{
+		{Action: "ecr:GetAuthorizationToken", Resource: "*"},
+		{Action: "ssm:GetParameters", Resource: "arn:aws:ssm:us-west-2:1234:parameter/github/token"},
+	}
+	testCases := map[string]struct {
+		mockSimulator func(m *mocks.MockrolePermissionsSimulator)
+
+		wantedErr string
+	}{
+		"wraps the error if the permissions can't be simulated": {
+			mockSimulator: func(m *mocks.MockrolePermissionsSimulator) {
+				m.EXPECT().DeniedPermissions(mockRoleARN, perms).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "check permissions of execution role arn:aws:iam::1234:role/ecsTaskExecutionRole: some error",
+		},
+		"lists the missing permissions": {
+			mockSimulator: func(m *mocks.MockrolePermissionsSimulator) {
+				m.EXPECT().DeniedPermissions(mockRoleARN, perms).Return(perms, nil)
+			},
+			wantedErr: `execution role arn:aws:iam::1234:role/ecsTaskExecutionRole is missing the following permissions:
+- ecr:GetAuthorizationToken on *
+- ssm:GetParameters on arn:aws:ssm:us-west-2:1234:parameter/github/token`,
+		},
+		"succeeds if the role has every permission": {
+			mockSimulator: func(m *mocks.MockrolePermissionsSimulator) {
+				m.EXPECT().DeniedPermissions(mockRoleARN, perms).Return(nil, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockrolePermissionsSimulator(ctrl)
+			tc.mockSimulator(m)
+
+			// WHEN
+			err := validateExecutionRole(m, mockRoleARN, perms)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
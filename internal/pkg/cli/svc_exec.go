@@ -6,9 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
-	"math/rand"
 	"strings"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -23,13 +21,20 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
 )
 
 const (
 	svcExecNamePrompt     = "Into which service would you like to execute?"
 	svcExecNameHelpPrompt = `Copilot runs your command in one of your chosen service's tasks.
-The task is chosen at random, and the first essential container is used.`
+You can choose the task and container if the service runs more than one.`
+	svcExecTaskPrompt     = "Which task would you like to execute into?"
+	svcExecTaskHelpPrompt = `The command runs in a container of the selected task.
+Tasks are listed with their availability zone and the time they started.`
+	svcExecContainerPrompt     = "Which container would you like to execute into?"
+	svcExecContainerHelpPrompt = `The task runs more than one container, such as sidecars like Envoy or Fluent Bit.
+The first container is the main container of the service.`
 
 	ssmPluginInstallPrompt = `Looks like the Session Manager plugin is not installed yet.
 Would you like to install the plugin to execute into the container?`
@@ -47,12 +52,10 @@ type svcExecOpts struct {
 	execVars
 	store              store
 	sel                deploySelector
-	newSvcDescriber    func(*session.Session) serviceDescriber
+	newSvcDescriber    func(*session.Session) execServiceDescriber
 	newCommandExecutor func(*session.Session) ecsCommandExecutor
 	ssmPluginManager   ssmPluginManager
 	prompter           prompter
-	// Override in unit test
-	randInt func(int) int
 }
 
 func newSvcExecOpts(vars execVars) (*svcExecOpts, error) {
@@ -68,16 +71,12 @@ func newSvcExecOpts(vars execVars) (*svcExecOpts, error) {
 		execVars: vars,
 		store:    ssmStore,
 		sel:      selector.NewDeploySelect(prompt.New(), ssmStore, deployStore),
-		newSvcDescriber: func(s *session.Session) serviceDescriber {
+		newSvcDescriber: func(s *session.Session) execServiceDescriber {
 			return ecs.New(s)
 		},
 		newCommandExecutor: func(s *session.Session) ecsCommandExecutor {
 			return awsecs.New(s)
 		},
-		randInt: func(x int) int {
-			rand.Seed(time.Now().Unix())
-			return rand.Intn(x)
-		},
 		ssmPluginManager: exec.NewSSMPluginCommand(nil),
 		prompter:         prompt.New(),
 	}, nil
@@ -120,15 +119,37 @@ func (o *svcExecOpts) Execute() error {
 	if err != nil {
 		return err
 	}
-	svcDesc, err := o.newSvcDescriber(sess).DescribeService(o.appName, o.envName, o.name)
+	describer := o.newSvcDescriber(sess)
+	enabled, err := describer.ExecuteCommandEnabled(o.appName, o.envName, o.name)
+	if err != nil {
+		return fmt.Errorf("check if exec is enabled for service %s in environment %s: %w", o.name, o.envName, err)
+	}
+	if !enabled {
+		log.Errorf(`Exec is turned off for service %s in environment %s. You can either:
+1. Set %s in the manifest to turn it on in every environment.
+2. Set %s under %s in the manifest to turn it on in this environment only.
+Then run %s.
+`, o.name, o.envName, color.HighlightCode("exec: true"), color.HighlightCode("exec: true"),
+			color.HighlightCode(fmt.Sprintf("environments.%s", o.envName)),
+			color.HighlightCode(fmt.Sprintf("copilot svc deploy -n %s -e %s", o.name, o.envName)))
+		return fmt.Errorf("exec is not enabled for service %s in environment %s", o.name, o.envName)
+	}
+	svcDesc, err := describer.DescribeService(o.appName, o.envName, o.name)
 	if err != nil {
 		return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
 	}
-	taskID, err := o.selectTask(awsecs.FilterRunningTasks(svcDesc.Tasks))
+	task, err := o.selectTask(awsecs.FilterRunningTasks(svcDesc.Tasks))
+	if err != nil {
+		return err
+	}
+	taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+	if err != nil {
+		return err
+	}
+	container, err := o.selectContainer(task)
 	if err != nil {
 		return err
 	}
-	container := o.selectContainer()
 	log.Infof("Execute %s in container %s in task %s.\n", color.HighlightCode(o.command),
 		color.HighlightUserInput(container), color.HighlightResource(taskID))
 	if err = o.newCommandExecutor(sess).ExecuteCommand(awsecs.ExecuteCommandInput{
@@ -176,35 +197,89 @@ func (o *svcExecOpts) envSession() (*session.Session, error) {
 	return sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
 }
 
-func (o *svcExecOpts) selectTask(tasks []*awsecs.Task) (string, error) {
+func (o *svcExecOpts) selectTask(tasks []*awsecs.Task) (*awsecs.Task, error) {
 	if len(tasks) == 0 {
-		return "", fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
+		return nil, fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
 	}
 	if o.taskID != "" {
 		for _, task := range tasks {
 			taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
 			if err != nil {
-				return "", err
+				return nil, err
 			}
 			if strings.HasPrefix(taskID, o.taskID) {
-				return taskID, nil
+				return task, nil
 			}
 		}
-		return "", fmt.Errorf("found no running task whose ID is prefixed with %s", o.taskID)
+		return nil, fmt.Errorf("found no running task whose ID is prefixed with %s", o.taskID)
 	}
-	taskID, err := awsecs.TaskID(aws.StringValue(tasks[o.randInt(len(tasks))].TaskArn))
+	if len(tasks) == 1 {
+		return tasks[0], nil
+	}
+	var options []string
+	taskForOption := make(map[string]*awsecs.Task)
+	for _, task := range tasks {
+		option, err := fmtExecTaskOption(task)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, option)
+		taskForOption[option] = task
+	}
+	option, err := o.prompter.SelectOne(svcExecTaskPrompt, svcExecTaskHelpPrompt, options)
 	if err != nil {
-		return "", err
+		return nil, fmt.Errorf("select a task of service %s: %w", o.name, err)
 	}
-	return taskID, nil
+	return taskForOption[option], nil
 }
 
-func (o *svcExecOpts) selectContainer() string {
+func (o *svcExecOpts) selectContainer(task *awsecs.Task) (string, error) {
+	// The first essential container is named with the workload name, list it first.
+	containers := []string{o.name}
+	for _, container := range task.Containers {
+		if name := aws.StringValue(container.Name); name != o.name {
+			containers = append(containers, name)
+		}
+	}
 	if o.containerName != "" {
-		return o.containerName
+		if len(task.Containers) == 0 {
+			return o.containerName, nil
+		}
+		for _, container := range containers {
+			if container == o.containerName {
+				return container, nil
+			}
+		}
+		return "", fmt.Errorf("container %s does not exist in the task, available containers are: %s", o.containerName, strings.Join(containers, ", "))
+	}
+	if len(containers) == 1 {
+		return o.name, nil
+	}
+	container, err := o.prompter.SelectOne(svcExecContainerPrompt, svcExecContainerHelpPrompt, containers)
+	if err != nil {
+		return "", fmt.Errorf("select a container of the task: %w", err)
+	}
+	return container, nil
+}
+
+// fmtExecTaskOption formats a task as its ID, availability zone and started time.
+// For example, "4082490ee6c245e09d2145010aa1ba8d (us-west-2a, started 5 minutes ago)".
+func fmtExecTaskOption(task *awsecs.Task) (string, error) {
+	taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+	if err != nil {
+		return "", err
+	}
+	var details []string
+	if az := aws.StringValue(task.AvailabilityZone); az != "" {
+		details = append(details, az)
+	}
+	if task.StartedAt != nil {
+		details = append(details, fmt.Sprintf("started %s", humanize.Time(aws.TimeValue(task.StartedAt))))
+	}
+	if len(details) == 0 {
+		return taskID, nil
 	}
-	// The first essential container is named with the workload name.
-	return o.name
+	return fmt.Sprintf("%s (%s)", taskID, strings.Join(details, ", ")), nil
 }
 
 func validateSSMBinary(prompt prompter, manager ssmPluginManager, skipConfirmation *bool) error {
@@ -269,7 +344,9 @@ func buildSvcExecCmd() *cobra.Command {
   Start an interactive bash session with a task part of the "frontend" service.
   /code $ copilot svc exec -a my-app -e test -n frontend
   Runs the 'ls' command in the task prefixed with ID "8c38184" within the "backend" service.
-  /code $ copilot svc exec -a my-app -e test --name backend --task-id 8c38184 --command "ls"`,
+  /code $ copilot svc exec -a my-app -e test --name backend --task-id 8c38184 --command "ls"
+  Start a shell in the Envoy sidecar of a task of the "frontend" service.
+  /code $ copilot svc exec -a my-app -e test -n frontend --container envoy --command "sh"`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcExecOpts(vars)
 			if err != nil {
@@ -294,8 +371,8 @@ func buildSvcExecCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", nameFlagDescription)
 	cmd.Flags().StringVarP(&vars.command, commandFlag, commandFlagShort, defaultCommand, execCommandFlagDescription)
-	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskIDFlagDescription)
-	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", containerFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", svcExecTaskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", svcExecContainerFlagDescription)
 	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
 
 	cmd.SetUsageTemplate(template.Usage)
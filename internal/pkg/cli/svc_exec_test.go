@@ -7,9 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -23,7 +25,7 @@ import (
 type execSvcMocks struct {
 	storeSvc           *mocks.Mockstore
 	sel                *mocks.MockdeploySelector
-	svcDescriber       *mocks.MockserviceDescriber
+	svcDescriber       *mocks.MockexecServiceDescriber
 	ecsCommandExecutor *mocks.MockecsCommandExecutor
 	ssmPluginManager   *mocks.MockssmPluginManager
 	prompter           *mocks.Mockprompter
@@ -463,12 +465,35 @@ func TestSvcExec_Execute(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("get environment mockEnv: some error"),
 		},
+		"return error if fail to check if exec is enabled": {
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
+						Name: "my-env",
+					}, nil),
+					m.svcDescriber.EXPECT().ExecuteCommandEnabled("mockApp", "mockEnv", "mockSvc").Return(false, mockError),
+				)
+			},
+			wantedError: fmt.Errorf("check if exec is enabled for service mockSvc in environment mockEnv: some error"),
+		},
+		"return error if exec is not enabled": {
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
+						Name: "my-env",
+					}, nil),
+					m.svcDescriber.EXPECT().ExecuteCommandEnabled("mockApp", "mockEnv", "mockSvc").Return(false, nil),
+				)
+			},
+			wantedError: fmt.Errorf("exec is not enabled for service mockSvc in environment mockEnv"),
+		},
 		"return error if fail to describe service": {
 			setupMocks: func(m execSvcMocks) {
 				gomock.InOrder(
 					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
 						Name: "my-env",
 					}, nil),
+					m.svcDescriber.EXPECT().ExecuteCommandEnabled("mockApp", "mockEnv", "mockSvc").Return(true, nil),
 					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(nil, mockError),
 				)
 			},
@@ -480,6 +505,7 @@ func TestSvcExec_Execute(t *testing.T) {
 					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
 						Name: "my-env",
 					}, nil),
+					m.svcDescriber.EXPECT().ExecuteCommandEnabled("mockApp", "mockEnv", "mockSvc").Return(true, nil),
 					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
 						Tasks: []*awsecs.Task{},
 					}, nil),
@@ -494,6 +520,7 @@ func TestSvcExec_Execute(t *testing.T) {
 					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
 						Name: "my-env",
 					}, nil),
+					m.svcDescriber.EXPECT().ExecuteCommandEnabled("mockApp", "mockEnv", "mockSvc").Return(true, nil),
 					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
 						Tasks: []*awsecs.Task{
 							{
@@ -513,6 +540,7 @@ func TestSvcExec_Execute(t *testing.T) {
 					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
 						Name: "my-env",
 					}, nil),
+					m.svcDescriber.EXPECT().ExecuteCommandEnabled("mockApp", "mockEnv", "mockSvc").Return(true, nil),
 					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
 						ClusterName: "mockCluster",
 						Tasks: []*awsecs.Task{
@@ -532,12 +560,13 @@ func TestSvcExec_Execute(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("execute command mockCommand in container hello: some error"),
 		},
-		"success": {
+		"return error if fail to select a task": {
 			setupMocks: func(m execSvcMocks) {
 				gomock.InOrder(
 					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
 						Name: "my-env",
 					}, nil),
+					m.svcDescriber.EXPECT().ExecuteCommandEnabled("mockApp", "mockEnv", "mockSvc").Return(true, nil),
 					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
 						ClusterName: "mockCluster",
 						Tasks: []*awsecs.Task{
@@ -551,6 +580,56 @@ func TestSvcExec_Execute(t *testing.T) {
 							},
 						},
 					}, nil),
+					m.prompter.EXPECT().SelectOne(svcExecTaskPrompt, svcExecTaskHelpPrompt, []string{"mockTaskID", "mockTaskID1"}).
+						Return("", mockError),
+				)
+			},
+			wantedError: fmt.Errorf("select a task of service mockSvc: some error"),
+		},
+		"return error if the container does not exist in the task": {
+			containerName: "envoy",
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
+						Name: "my-env",
+					}, nil),
+					m.svcDescriber.EXPECT().ExecuteCommandEnabled("mockApp", "mockEnv", "mockSvc").Return(true, nil),
+					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+						ClusterName: "mockCluster",
+						Tasks: []*awsecs.Task{
+							{
+								TaskArn:    aws.String(mockTaskARN),
+								LastStatus: aws.String("RUNNING"),
+								Containers: []*sdkecs.Container{
+									{Name: aws.String("mockSvc")},
+									{Name: aws.String("firelens_log_router")},
+								},
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedError: fmt.Errorf("container envoy does not exist in the task, available containers are: mockSvc, firelens_log_router"),
+		},
+		"success with a single task": {
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
+						Name: "my-env",
+					}, nil),
+					m.svcDescriber.EXPECT().ExecuteCommandEnabled("mockApp", "mockEnv", "mockSvc").Return(true, nil),
+					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+						ClusterName: "mockCluster",
+						Tasks: []*awsecs.Task{
+							{
+								TaskArn:    aws.String(mockTaskARN),
+								LastStatus: aws.String("RUNNING"),
+								Containers: []*sdkecs.Container{
+									{Name: aws.String("mockSvc")},
+								},
+							},
+						},
+					}, nil),
 					m.ecsCommandExecutor.EXPECT().ExecuteCommand(awsecs.ExecuteCommandInput{
 						Cluster:   "mockCluster",
 						Container: "mockSvc",
@@ -560,6 +639,48 @@ func TestSvcExec_Execute(t *testing.T) {
 				)
 			},
 		},
+		"success with the task and container selected by the user": {
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
+						Name: "my-env",
+					}, nil),
+					m.svcDescriber.EXPECT().ExecuteCommandEnabled("mockApp", "mockEnv", "mockSvc").Return(true, nil),
+					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+						ClusterName: "mockCluster",
+						Tasks: []*awsecs.Task{
+							{
+								TaskArn:          aws.String(mockTaskARN),
+								LastStatus:       aws.String("RUNNING"),
+								AvailabilityZone: aws.String("us-west-2a"),
+								StartedAt:        aws.Time(time.Now().Add(-90 * time.Minute)),
+							},
+							{
+								TaskArn:          aws.String(mockOtherTaskARN),
+								LastStatus:       aws.String("RUNNING"),
+								AvailabilityZone: aws.String("us-west-2b"),
+								Containers: []*sdkecs.Container{
+									{Name: aws.String("envoy")},
+									{Name: aws.String("mockSvc")},
+								},
+							},
+						},
+					}, nil),
+					m.prompter.EXPECT().SelectOne(svcExecTaskPrompt, svcExecTaskHelpPrompt, []string{
+						"mockTaskID (us-west-2a, started 1 hour ago)",
+						"mockTaskID1 (us-west-2b)",
+					}).Return("mockTaskID1 (us-west-2b)", nil),
+					m.prompter.EXPECT().SelectOne(svcExecContainerPrompt, svcExecContainerHelpPrompt, []string{"mockSvc", "envoy"}).
+						Return("envoy", nil),
+					m.ecsCommandExecutor.EXPECT().ExecuteCommand(awsecs.ExecuteCommandInput{
+						Cluster:   "mockCluster",
+						Container: "envoy",
+						Task:      "mockTaskID1",
+						Command:   "mockCommand",
+					}).Return(nil),
+				)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -568,9 +689,10 @@ func TestSvcExec_Execute(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockStoreReader := mocks.NewMockstore(ctrl)
-			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
+			mockSvcDescriber := mocks.NewMockexecServiceDescriber(ctrl)
 			mockCommandExecutor := mocks.NewMockecsCommandExecutor(ctrl)
-			mockNewSvcDescriber := func(_ *session.Session) serviceDescriber {
+			mockPrompter := mocks.NewMockprompter(ctrl)
+			mockNewSvcDescriber := func(_ *session.Session) execServiceDescriber {
 				return mockSvcDescriber
 			}
 			mockNewCommandExecutor := func(_ *session.Session) ecsCommandExecutor {
@@ -581,6 +703,7 @@ func TestSvcExec_Execute(t *testing.T) {
 				storeSvc:           mockStoreReader,
 				ecsCommandExecutor: mockCommandExecutor,
 				svcDescriber:       mockSvcDescriber,
+				prompter:           mockPrompter,
 			}
 
 			tc.setupMocks(mocks)
@@ -597,7 +720,7 @@ func TestSvcExec_Execute(t *testing.T) {
 				store:              mockStoreReader,
 				newSvcDescriber:    mockNewSvcDescriber,
 				newCommandExecutor: mockNewCommandExecutor,
-				randInt:            func(i int) int { return 0 },
+				prompter:           mockPrompter,
 			}
 
 			// WHEN
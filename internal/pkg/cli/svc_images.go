@@ -0,0 +1,170 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcImagesNamePrompt     = "Which service of %s would you like to list images for?"
+	svcImagesNameHelpPrompt = "The images pushed to the service's ECR repositories, and the environments running them, will be listed."
+)
+
+type svcImagesVars struct {
+	shouldOutputJSON bool
+	appName          string
+	svcName          string
+}
+
+type svcImagesOpts struct {
+	svcImagesVars
+
+	w                   io.Writer
+	store               store
+	imagesDescriber     imagesDescriber
+	sel                 configSelector
+	initImagesDescriber func(*svcImagesOpts) error
+}
+
+func newSvcImagesOpts(vars svcImagesVars) (*svcImagesOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcImagesOpts{
+		svcImagesVars: vars,
+		store:         configStore,
+		w:             log.OutputWriter,
+		sel:           selector.NewConfigSelect(prompt.New(), configStore),
+		initImagesDescriber: func(o *svcImagesOpts) error {
+			app, err := o.store.GetApplication(o.appName)
+			if err != nil {
+				return fmt.Errorf("get application %s: %w", o.appName, err)
+			}
+			o.imagesDescriber = describe.NewServiceImages(describe.NewServiceImagesConfig{
+				App:         app,
+				Svc:         o.svcName,
+				ConfigStore: configStore,
+				DeployStore: deployStore,
+			})
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcImagesOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcImagesOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askSvcName()
+}
+
+// Execute lists the images of the service and the environments running them.
+func (o *svcImagesOpts) Execute() error {
+	if err := o.initImagesDescriber(o); err != nil {
+		return err
+	}
+	images, err := o.imagesDescriber.Describe()
+	if err != nil {
+		return fmt.Errorf("describe images of service %s: %w", o.svcName, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := images.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	} else {
+		fmt.Fprint(o.w, images.HumanString())
+	}
+	return nil
+}
+
+func (o *svcImagesOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcImagesOpts) askSvcName() error {
+	if o.svcName != "" {
+		return nil
+	}
+	svcName, err := o.sel.Service(fmt.Sprintf(svcImagesNamePrompt, color.HighlightUserInput(o.appName)),
+		svcImagesNameHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select service for application %s: %w", o.appName, err)
+	}
+	o.svcName = svcName
+	return nil
+}
+
+// buildSvcImagesCmd builds the command for listing the images of a service.
+func buildSvcImagesCmd() *cobra.Command {
+	vars := svcImagesVars{}
+	cmd := &cobra.Command{
+		Use:   "images",
+		Short: "Lists the images of a service and the environments running them.",
+		Long: `Lists the images pushed to a service's ECR repositories, from the most recently pushed.
+Each image shows its tags and digest, and the environments whose running tasks use it.`,
+
+		Example: `
+  Lists the images of the service "frontend".
+  /code $ copilot svc images -n frontend`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcImagesOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
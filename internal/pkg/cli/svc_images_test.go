@@ -0,0 +1,204 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcImages_Validate(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inputApp   string
+		inputSvc   string
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"valid app name and service name": {
+			inputApp: "my-app",
+			inputSvc: "my-svc",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetService("my-app", "my-svc").Return(&config.Workload{Name: "my-svc"}, nil)
+			},
+		},
+		"invalid service name": {
+			inputApp: "my-app",
+			inputSvc: "my-svc",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetService("my-app", "my-svc").Return(nil, mockError)
+			},
+			wantedError: mockError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+			opts := &svcImagesOpts{
+				svcImagesVars: svcImagesVars{
+					appName: tc.inputApp,
+					svcName: tc.inputSvc,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcImages_Ask(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inputApp   string
+		inputSvc   string
+		setupMocks func(m *mocks.MockconfigSelector)
+
+		wantedApp   string
+		wantedSvc   string
+		wantedError error
+	}{
+		"skips prompting if the flags are set": {
+			inputApp:   "my-app",
+			inputSvc:   "my-svc",
+			setupMocks: func(m *mocks.MockconfigSelector) {},
+			wantedApp:  "my-app",
+			wantedSvc:  "my-svc",
+		},
+		"prompts for the application and the service": {
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Application(svcAppNamePrompt, svcAppNameHelpPrompt).Return("my-app", nil)
+				m.EXPECT().Service(gomock.Any(), svcImagesNameHelpPrompt, "my-app").Return("my-svc", nil)
+			},
+			wantedApp: "my-app",
+			wantedSvc: "my-svc",
+		},
+		"wraps the error of selecting the service": {
+			inputApp: "my-app",
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Service(gomock.Any(), svcImagesNameHelpPrompt, "my-app").Return("", mockError)
+			},
+			wantedError: fmt.Errorf("select service for application my-app: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSel := mocks.NewMockconfigSelector(ctrl)
+			tc.setupMocks(mockSel)
+			opts := &svcImagesOpts{
+				svcImagesVars: svcImagesVars{
+					appName: tc.inputApp,
+					svcName: tc.inputSvc,
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+		})
+	}
+}
+
+func TestSvcImages_Execute(t *testing.T) {
+	mockError := errors.New("some error")
+	mockImages := &describe.ServiceImagesDesc{
+		Service: "mockSvc",
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON    bool
+		mockImagesDescriber func(m *mocks.MockimagesDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if failed to describe the images of the service": {
+			mockImagesDescriber: func(m *mocks.MockimagesDescriber) {
+				m.EXPECT().Describe().Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("describe images of service mockSvc: some error"),
+		},
+		"success with JSON output": {
+			shouldOutputJSON: true,
+			mockImagesDescriber: func(m *mocks.MockimagesDescriber) {
+				m.EXPECT().Describe().Return(mockImages, nil)
+			},
+			wantedContent: "{\"service\":\"mockSvc\",\"images\":null}\n",
+		},
+		"success with HumanString": {
+			mockImagesDescriber: func(m *mocks.MockimagesDescriber) {
+				m.EXPECT().Describe().Return(mockImages, nil)
+			},
+			wantedContent: "Images of mockSvc\n\n  No images have been pushed yet.\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			b := &bytes.Buffer{}
+			mockImagesDescriber := mocks.NewMockimagesDescriber(ctrl)
+			tc.mockImagesDescriber(mockImagesDescriber)
+			opts := &svcImagesOpts{
+				svcImagesVars: svcImagesVars{
+					appName:          "mockApp",
+					svcName:          "mockSvc",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				imagesDescriber:     mockImagesDescriber,
+				initImagesDescriber: func(*svcImagesOpts) error { return nil },
+				w:                   b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
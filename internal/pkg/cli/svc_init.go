@@ -9,6 +9,9 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -75,7 +78,8 @@ type initWkldVars struct {
 type initSvcVars struct {
 	initWkldVars
 
-	port uint16
+	port      uint16
+	importECS string // Existing ECS service to import, as "<cluster>/<service>".
 }
 
 type initSvcOpts struct {
@@ -88,6 +92,10 @@ type initSvcOpts struct {
 	df                    dockerfileParser
 	dockerEngineValidator dockerEngineValidator
 	sel                   dockerfileSelector
+	importer              svcImporter
+	ecsSvcDescriber       ecsServiceImportDescriber
+	scalingDescriber      ecsServiceScalingDescriber
+	targetGroupDescriber  targetGroupDescriber
 
 	// Outputs stored on successful actions.
 	manifestPath string
@@ -112,6 +120,10 @@ func newInitSvcOpts(vars initSvcVars) (*initSvcOpts, error) {
 	if err != nil {
 		return nil, err
 	}
+	engine, err := exec.NewContainerEngineCommand()
+	if err != nil {
+		return nil, fmt.Errorf("detect container engine: %w", err)
+	}
 	prompter := prompt.New()
 	sel := selector.NewWorkspaceSelect(prompter, store, ws)
 
@@ -128,7 +140,11 @@ func newInitSvcOpts(vars initSvcVars) (*initSvcOpts, error) {
 		init:                  initSvc,
 		prompt:                prompter,
 		sel:                   sel,
-		dockerEngineValidator: exec.NewDockerCommand(),
+		dockerEngineValidator: engine,
+		importer:              initSvc,
+		ecsSvcDescriber:       awsecs.New(sess),
+		scalingDescriber:      aas.New(sess),
+		targetGroupDescriber:  elbv2.New(sess),
 		setupParser: func(o *initSvcOpts) {
 			o.df = exec.NewDockerfile(o.fs, o.dockerfilePath)
 		},
@@ -163,11 +179,23 @@ func (o *initSvcOpts) Validate() error {
 			return err
 		}
 	}
+	if o.importECS != "" {
+		if o.wkldType != "" || o.dockerfilePath != "" || o.image != "" || o.port != 0 {
+			return fmt.Errorf("--%s cannot be specified with --%s, --%s, --%s or --%s", importECSFlag, svcTypeFlag, dockerFileFlag, imageFlag, svcPortFlag)
+		}
+		if _, _, err := parseECSService(o.importECS); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // Ask prompts for fields that are required but not passed in.
 func (o *initSvcOpts) Ask() error {
+	if o.importECS != "" {
+		// The type and the configuration of the service are read from the ECS service.
+		return o.askImportedSvcName()
+	}
 	if err := o.askSvcType(); err != nil {
 		return err
 	}
@@ -194,6 +222,9 @@ func (o *initSvcOpts) Ask() error {
 
 // Execute writes the service's manifest file and stores the service in SSM.
 func (o *initSvcOpts) Execute() error {
+	if o.importECS != "" {
+		return o.importECSService()
+	}
 	// Check for a valid healthcheck and add it to the opts.
 	var hc *manifest.ContainerHealthCheck
 	hc, err := o.parseHealthCheck()
@@ -221,12 +252,16 @@ func (o *initSvcOpts) Execute() error {
 
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
 func (o *initSvcOpts) RecommendedActions() []string {
-	return []string{
+	actions := []string{
 		fmt.Sprintf("Update your manifest %s to change the defaults.", color.HighlightResource(o.manifestPath)),
 		fmt.Sprintf("Run %s to deploy your service to a %s environment.",
 			color.HighlightCode(fmt.Sprintf("copilot svc deploy --name %s --env %s", o.name, defaultEnvironmentName)),
 			defaultEnvironmentName),
 	}
+	if o.importECS != "" {
+		actions = append(actions, fmt.Sprintf("Once the service serves your traffic, delete the ECS service %s.", color.HighlightUserInput(o.importECS)))
+	}
+	return actions
 }
 
 func (o *initSvcOpts) askSvcType() error {
@@ -287,7 +322,7 @@ func (o *initSvcOpts) askDockerfile() (isDfSelected bool, err error) {
 		var errDaemon *exec.ErrDockerDaemonNotResponsive
 		switch {
 		case errors.Is(err, exec.ErrDockerCommandNotFound):
-			log.Info("Docker command is not found; Copilot won't build from a Dockerfile.\n")
+			log.Infof("%v; Copilot won't build from a Dockerfile.\n", err)
 			return false, nil
 		case errors.As(err, &errDaemon):
 			log.Info("Docker daemon is not responsive; Copilot won't build from a Dockerfile.\n")
@@ -417,7 +452,10 @@ This command is also run as part of "copilot init".`,
   /code $ copilot svc init --name frontend --svc-type "Load Balanced Web Service" --dockerfile ./frontend/Dockerfile
 
   Create a "subscribers" backend service.
-  /code $ copilot svc init --name subscribers --svc-type "Backend Service"`,
+  /code $ copilot svc init --name subscribers --svc-type "Backend Service"
+
+  Import the "api" ECS service of the "legacy" cluster.
+  /code $ copilot svc init --import-ecs legacy/api`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitSvcOpts(vars)
 			if err != nil {
@@ -447,6 +485,7 @@ This command is also run as part of "copilot init".`,
 	cmd.Flags().StringVarP(&vars.image, imageFlag, imageFlagShort, "", imageFlagDescription)
 
 	cmd.Flags().Uint16Var(&vars.port, svcPortFlag, 0, svcPortFlagDescription)
+	cmd.Flags().StringVar(&vars.importECS, importECSFlag, "", importECSFlagDescription)
 
 	// Bucket flags by service type.
 	requiredFlags := pflag.NewFlagSet("Required Flags", pflag.ContinueOnError)
@@ -454,6 +493,7 @@ This command is also run as part of "copilot init".`,
 	requiredFlags.AddFlag(cmd.Flags().Lookup(svcTypeFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(dockerFileFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(imageFlag))
+	requiredFlags.AddFlag(cmd.Flags().Lookup(importECSFlag))
 
 	lbWebSvcFlags := pflag.NewFlagSet(manifest.LoadBalancedWebServiceType, pflag.ContinueOnError)
 	lbWebSvcFlags.AddFlag(cmd.Flags().Lookup(svcPortFlag))
@@ -0,0 +1,112 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/ecsimport"
+	"github.com/aws/copilot-cli/internal/pkg/initialize"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+)
+
+// askImportedSvcName prompts for the name of the imported service, defaulting to the name of the ECS service.
+func (o *initSvcOpts) askImportedSvcName() error {
+	if o.name != "" {
+		return nil
+	}
+	_, svc, err := parseECSService(o.importECS)
+	if err != nil {
+		return err
+	}
+	name, err := o.prompt.Get(
+		fmt.Sprintf(fmtWkldInitNamePrompt, color.Emphasize("name"), color.HighlightUserInput(o.importECS)),
+		fmt.Sprintf(fmtWkldInitNameHelpPrompt, service, o.appName),
+		validateSvcName,
+		prompt.WithDefaultInput(svc),
+		prompt.WithFinalMessage("Service name:"))
+	if err != nil {
+		return fmt.Errorf("get service name: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// importECSService translates an existing ECS service into a manifest, adds the service to the application,
+// and reports the configuration of the ECS service that could not be translated.
+func (o *initSvcOpts) importECSService() error {
+	cluster, svcName, err := parseECSService(o.importECS)
+	if err != nil {
+		return err
+	}
+	svc, err := o.ecsSvcDescriber.Service(cluster, svcName)
+	if err != nil {
+		return fmt.Errorf("describe ECS service %s: %w", o.importECS, err)
+	}
+	taskDef, err := o.ecsSvcDescriber.TaskDefinition(aws.StringValue(svc.TaskDefinition))
+	if err != nil {
+		return fmt.Errorf("describe task definition of ECS service %s: %w", o.importECS, err)
+	}
+	target, err := o.scalingDescriber.ECSServiceScalableTarget(cluster, svcName)
+	if err != nil {
+		return err
+	}
+	in := &ecsimport.Service{
+		Name:           o.name,
+		Service:        svc,
+		TaskDefinition: taskDef,
+		ScalableTarget: target,
+	}
+	if target != nil {
+		policies, err := o.scalingDescriber.ECSServiceScalingPolicies(cluster, svcName)
+		if err != nil {
+			return err
+		}
+		in.ScalingPolicies = policies
+	}
+	if lbs := svc.LoadBalancers; len(lbs) != 0 && lbs[0].TargetGroupArn != nil {
+		tg, err := o.targetGroupDescriber.TargetGroup(aws.StringValue(lbs[0].TargetGroupArn))
+		if err != nil {
+			return err
+		}
+		in.TargetGroup = tg
+	}
+	conversion, err := ecsimport.Convert(in)
+	if err != nil {
+		return fmt.Errorf("import ECS service %s: %w", o.importECS, err)
+	}
+
+	manifestPath, err := o.importer.ImportService(&initialize.WorkloadProps{
+		App:  o.appName,
+		Type: conversion.Type,
+		Name: o.name,
+	}, conversion.Manifest)
+	if err != nil {
+		return err
+	}
+	o.wkldType = conversion.Type
+	o.manifestPath = manifestPath
+
+	if len(conversion.Unsupported) != 0 {
+		log.Warningf("Migration report: the following configuration of ECS service %s is not in the manifest.\n", o.importECS)
+		for _, msg := range conversion.Unsupported {
+			log.Infof("- %s\n", msg)
+		}
+		log.Infoln()
+	}
+	return nil
+}
+
+// parseECSService splits a "<cluster>/<service>" value into the name of the cluster and of the service.
+func parseECSService(value string) (cluster, service string, err error) {
+	i := strings.LastIndex(value, "/")
+	if i <= 0 || i == len(value)-1 {
+		return "", "", fmt.Errorf("--%s must be in the format <cluster>/<service>, got %q", importECSFlag, value)
+	}
+	return value[:i], value[i+1:], nil
+}
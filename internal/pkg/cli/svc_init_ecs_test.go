@@ -0,0 +1,269 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/initialize"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcInitOpts_AskImportECS(t *testing.T) {
+	testCases := map[string]struct {
+		inSvcName string
+
+		mockPrompt func(m *mocks.Mockprompter)
+
+		wantedName string
+		wantedErr  string
+	}{
+		"skips prompting if the name is set": {
+			inSvcName:  "api",
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			wantedName: "api",
+		},
+		"prompts for the name with the ECS service name as default": {
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("orders", nil)
+			},
+			wantedName: "orders",
+		},
+		"wraps the prompt error": {
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: "get service name: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			tc.mockPrompt(mockPrompt)
+			opts := initSvcOpts{
+				initSvcVars: initSvcVars{
+					initWkldVars: initWkldVars{
+						appName: "phonetool",
+						name:    tc.inSvcName,
+					},
+					importECS: "legacy/api",
+				},
+				prompt: mockPrompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.name)
+		})
+	}
+}
+
+func TestSvcInitOpts_ExecuteImportECS(t *testing.T) {
+	const (
+		mockTaskDefARN     = "arn:aws:ecs:us-west-2:123456789012:task-definition/api:7"
+		mockTargetGroupARN = "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/api/1234"
+	)
+	mockTaskDef := &awsecs.TaskDefinition{
+		Cpu:    aws.String("256"),
+		Memory: aws.String("512"),
+		ContainerDefinitions: []*sdkecs.ContainerDefinition{
+			{Name: aws.String("api"), Image: aws.String("api:v1")},
+		},
+	}
+	type importMocks struct {
+		ecs      *mocks.MockecsServiceImportDescriber
+		scaling  *mocks.MockecsServiceScalingDescriber
+		tg       *mocks.MocktargetGroupDescriber
+		importer *mocks.MocksvcImporter
+	}
+	testCases := map[string]struct {
+		setupMocks func(m importMocks)
+
+		wantedType string
+		wantedErr  string
+	}{
+		"fails to describe the ECS service": {
+			setupMocks: func(m importMocks) {
+				m.ecs.EXPECT().Service("legacy", "api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "describe ECS service legacy/api: some error",
+		},
+		"fails to describe the task definition": {
+			setupMocks: func(m importMocks) {
+				m.ecs.EXPECT().Service("legacy", "api").Return(&awsecs.Service{TaskDefinition: aws.String(mockTaskDefARN)}, nil)
+				m.ecs.EXPECT().TaskDefinition(mockTaskDefARN).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "describe task definition of ECS service legacy/api: some error",
+		},
+		"fails to describe the scalable target": {
+			setupMocks: func(m importMocks) {
+				m.ecs.EXPECT().Service("legacy", "api").Return(&awsecs.Service{TaskDefinition: aws.String(mockTaskDefARN)}, nil)
+				m.ecs.EXPECT().TaskDefinition(mockTaskDefARN).Return(mockTaskDef, nil)
+				m.scaling.EXPECT().ECSServiceScalableTarget("legacy", "api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "some error",
+		},
+		"fails to describe the target group": {
+			setupMocks: func(m importMocks) {
+				m.ecs.EXPECT().Service("legacy", "api").Return(&awsecs.Service{
+					TaskDefinition: aws.String(mockTaskDefARN),
+					LoadBalancers: []*sdkecs.LoadBalancer{
+						{ContainerName: aws.String("api"), ContainerPort: aws.Int64(80), TargetGroupArn: aws.String(mockTargetGroupARN)},
+					},
+				}, nil)
+				m.ecs.EXPECT().TaskDefinition(mockTaskDefARN).Return(mockTaskDef, nil)
+				m.scaling.EXPECT().ECSServiceScalableTarget("legacy", "api").Return(nil, nil)
+				m.tg.EXPECT().TargetGroup(mockTargetGroupARN).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "some error",
+		},
+		"imports a service behind a load balancer that autoscales": {
+			setupMocks: func(m importMocks) {
+				m.ecs.EXPECT().Service("legacy", "api").Return(&awsecs.Service{
+					TaskDefinition: aws.String(mockTaskDefARN),
+					LoadBalancers: []*sdkecs.LoadBalancer{
+						{ContainerName: aws.String("api"), ContainerPort: aws.Int64(80), TargetGroupArn: aws.String(mockTargetGroupARN)},
+					},
+				}, nil)
+				m.ecs.EXPECT().TaskDefinition(mockTaskDefARN).Return(mockTaskDef, nil)
+				m.scaling.EXPECT().ECSServiceScalableTarget("legacy", "api").Return(&aas.ScalableTarget{MinCapacity: 1, MaxCapacity: 4}, nil)
+				m.scaling.EXPECT().ECSServiceScalingPolicies("legacy", "api").Return([]aas.ScalingPolicy{
+					{Name: "cpu", Type: "TargetTrackingScaling", Metric: "ECSServiceAverageCPUUtilization", TargetValue: 50},
+				}, nil)
+				m.tg.EXPECT().TargetGroup(mockTargetGroupARN).Return(&elbv2.TargetGroup{HealthCheckPath: "/ping"}, nil)
+				m.importer.EXPECT().ImportService(&initialize.WorkloadProps{
+					App:  "phonetool",
+					Type: manifest.LoadBalancedWebServiceType,
+					Name: "orders",
+				}, gomock.Any()).DoAndReturn(func(_ *initialize.WorkloadProps, mft *manifest.LoadBalancedWebService) (string, error) {
+					require.Equal(t, "/ping", aws.StringValue(mft.HealthCheck.HealthCheckArgs.Path))
+					require.Equal(t, 50, aws.IntValue(mft.Count.AdvancedCount.CPU))
+					return "copilot/orders/manifest.yml", nil
+				})
+			},
+			wantedType: manifest.LoadBalancedWebServiceType,
+		},
+		"imports a service without a load balancer": {
+			setupMocks: func(m importMocks) {
+				m.ecs.EXPECT().Service("legacy", "api").Return(&awsecs.Service{
+					TaskDefinition: aws.String(mockTaskDefARN),
+					DesiredCount:   aws.Int64(2),
+				}, nil)
+				m.ecs.EXPECT().TaskDefinition(mockTaskDefARN).Return(mockTaskDef, nil)
+				m.scaling.EXPECT().ECSServiceScalableTarget("legacy", "api").Return(nil, nil)
+				m.importer.EXPECT().ImportService(&initialize.WorkloadProps{
+					App:  "phonetool",
+					Type: manifest.BackendServiceType,
+					Name: "orders",
+				}, gomock.Any()).Return("copilot/orders/manifest.yml", nil)
+			},
+			wantedType: manifest.BackendServiceType,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := importMocks{
+				ecs:      mocks.NewMockecsServiceImportDescriber(ctrl),
+				scaling:  mocks.NewMockecsServiceScalingDescriber(ctrl),
+				tg:       mocks.NewMocktargetGroupDescriber(ctrl),
+				importer: mocks.NewMocksvcImporter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := initSvcOpts{
+				initSvcVars: initSvcVars{
+					initWkldVars: initWkldVars{
+						appName: "phonetool",
+						name:    "orders",
+					},
+					importECS: "legacy/api",
+				},
+				importer:             m.importer,
+				ecsSvcDescriber:      m.ecs,
+				scalingDescriber:     m.scaling,
+				targetGroupDescriber: m.tg,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedType, opts.wkldType)
+			require.Equal(t, "copilot/orders/manifest.yml", opts.manifestPath)
+		})
+	}
+}
+
+func TestParseECSService(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedCluster string
+		wantedService string
+		wantedErr     string
+	}{
+		"cluster name and service name": {
+			in:            "legacy/api",
+			wantedCluster: "legacy",
+			wantedService: "api",
+		},
+		"cluster ARN and service name": {
+			in:            "arn:aws:ecs:us-west-2:123456789012:cluster/legacy/api",
+			wantedCluster: "arn:aws:ecs:us-west-2:123456789012:cluster/legacy",
+			wantedService: "api",
+		},
+		"missing cluster": {
+			in:        "/api",
+			wantedErr: `--import-ecs must be in the format <cluster>/<service>, got "/api"`,
+		},
+		"missing service": {
+			in:        "legacy/",
+			wantedErr: `--import-ecs must be in the format <cluster>/<service>, got "legacy/"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			cluster, svc, err := parseECSService(tc.in)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedCluster, cluster)
+			require.Equal(t, tc.wantedService, svc)
+		})
+	}
+}
@@ -27,6 +27,7 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 		inImage          string
 		inAppName        string
 		inSvcPort        uint16
+		inImportECS      string
 
 		mockFileSystem func(mockFS afero.Fs)
 		wantedErr      error
@@ -56,6 +57,22 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 			inAppName: "",
 			wantedErr: errNoAppInWorkspace,
 		},
+		"fail if import-ecs is set with an image": {
+			inAppName:   "phonetool",
+			inImage:     "nginx",
+			inImportECS: "legacy/api",
+			wantedErr:   errors.New("--import-ecs cannot be specified with --svc-type, --dockerfile, --image or --port"),
+		},
+		"fail if import-ecs is not a cluster and a service": {
+			inAppName:   "phonetool",
+			inImportECS: "api",
+			wantedErr:   errors.New(`--import-ecs must be in the format <cluster>/<service>, got "api"`),
+		},
+		"valid import-ecs flag": {
+			inAppName:   "phonetool",
+			inSvcName:   "api",
+			inImportECS: "legacy/api",
+		},
 		"valid flags": {
 			inSvcName:        "frontend",
 			inSvcType:        "Load Balanced Web Service",
@@ -81,7 +98,8 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 						image:          tc.inImage,
 						appName:        tc.inAppName,
 					},
-					port: tc.inSvcPort,
+					port:      tc.inSvcPort,
+					importECS: tc.inImportECS,
 				},
 				fs: &afero.Afero{Fs: afero.NewMemMapFs()},
 			}
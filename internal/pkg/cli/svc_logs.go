@@ -7,12 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/logging"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -39,6 +41,8 @@ type svcLogsVars struct {
 	humanEndTime     string
 	taskIDs          []string
 	since            time.Duration
+	startFromDeploy  bool
+	query            string
 }
 
 type svcLogsOpts struct {
@@ -53,6 +57,8 @@ type svcLogsOpts struct {
 	deployStore deployedEnvironmentLister
 	sel         deploySelector
 	logsSvc     logEventsWriter
+	querySvc    logQueryWriter
+	deployments serviceDeploymentGetter
 	initLogsSvc func() error // Overriden in tests.
 }
 
@@ -85,7 +91,10 @@ func newSvcLogOpts(vars svcLogsVars) (*svcLogsOpts, error) {
 		if err != nil {
 			return err
 		}
-		opts.logsSvc = logging.NewServiceClient(sess, opts.appName, opts.envName, opts.svcName)
+		logsSvc := logging.NewServiceClient(sess, env, opts.svcName)
+		opts.logsSvc = logsSvc
+		opts.querySvc = logsSvc
+		opts.deployments = ecs.New(sess)
 		return nil
 	}
 	return opts, nil
@@ -104,20 +113,28 @@ func (o *svcLogsOpts) Validate() error {
 		return errors.New("only one of --since or --start-time may be used")
 	}
 
+	if o.startFromDeploy && (o.since != 0 || o.humanStartTime != "") {
+		return errors.New("--start-from-deploy cannot be used with --since or --start-time")
+	}
+
 	if o.humanEndTime != "" && o.follow {
 		return errors.New("only one of --follow or --end-time may be used")
 	}
 
+	if o.query != "" && o.follow {
+		return errors.New("only one of --follow or --query may be used")
+	}
+
 	if o.since != 0 {
 		if o.since < 0 {
 			return fmt.Errorf("--since must be greater than 0")
 		}
 		// round up to the nearest second
-		o.startTime = o.parseSince()
+		o.startTime = parseSince(o.since)
 	}
 
 	if o.humanStartTime != "" {
-		startTime, err := o.parseRFC3339(o.humanStartTime)
+		startTime, err := parseRFC3339(o.humanStartTime)
 		if err != nil {
 			return fmt.Errorf(`invalid argument %s for "--start-time" flag: %w`, o.humanStartTime, err)
 		}
@@ -125,7 +142,7 @@ func (o *svcLogsOpts) Validate() error {
 	}
 
 	if o.humanEndTime != "" {
-		endTime, err := o.parseRFC3339(o.humanEndTime)
+		endTime, err := parseRFC3339(o.humanEndTime)
 		if err != nil {
 			return fmt.Errorf(`invalid argument %s for "--end-time" flag: %w`, o.humanEndTime, err)
 		}
@@ -147,11 +164,16 @@ func (o *svcLogsOpts) Ask() error {
 	return o.askSvcEnvName()
 }
 
-// Execute outputs logs of the service.
+// Execute outputs logs of the service, or the results of a Logs Insights query on them.
 func (o *svcLogsOpts) Execute() error {
 	if err := o.initLogsSvc(); err != nil {
 		return err
 	}
+	if o.startFromDeploy {
+		if err := o.startFromLatestDeployment(); err != nil {
+			return err
+		}
+	}
 	eventsWriter := logging.WriteHumanLogs
 	if o.shouldOutputJSON {
 		eventsWriter = logging.WriteJSONLogs
@@ -160,6 +182,9 @@ func (o *svcLogsOpts) Execute() error {
 	if o.limit != 0 {
 		limit = aws.Int64(int64(o.limit))
 	}
+	if o.query != "" {
+		return o.writeQueryResults(limit)
+	}
 	err := o.logsSvc.WriteLogEvents(logging.WriteLogEventsOpts{
 		Follow:    o.follow,
 		Limit:     limit,
@@ -174,6 +199,39 @@ func (o *svcLogsOpts) Execute() error {
 	return nil
 }
 
+func (o *svcLogsOpts) writeQueryResults(limit *int64) error {
+	resultsWriter := logging.WriteHumanQueryResults
+	if o.shouldOutputJSON {
+		resultsWriter = logging.WriteJSONQueryResults
+	}
+	err := o.querySvc.WriteQueryResults(logging.QueryOpts{
+		Query:     o.query,
+		Limit:     limit,
+		StartTime: o.startTime,
+		EndTime:   o.endTime,
+		TaskIDs:   o.taskIDs,
+		OnResults: resultsWriter,
+	})
+	if err != nil {
+		return fmt.Errorf("query logs of service %s: %w", o.svcName, err)
+	}
+	return nil
+}
+
+// startFromLatestDeployment sets the start time to when the latest deployment of the service started
+// and writes the deployment boundary before the log events.
+func (o *svcLogsOpts) startFromLatestDeployment() error {
+	deployment, err := o.deployments.LatestDeployment(o.appName, o.envName, o.svcName)
+	if err != nil {
+		return fmt.Errorf("get latest deployment of service %s: %w", o.svcName, err)
+	}
+	o.startTime = aws.Int64(deployment.CreatedAt.Unix() * 1000)
+	// Task definition ARNs look like "arn:aws:ecs:us-west-2:1234567890:task-definition/app-env-svc:12".
+	taskDef := deployment.TaskDefinition[strings.LastIndex(deployment.TaskDefinition, "/")+1:]
+	log.Infof("Showing logs since the deployment of task definition %s started at %s.\n", taskDef, deployment.CreatedAt.Format(time.RFC3339))
+	return nil
+}
+
 func (o *svcLogsOpts) askApp() error {
 	if o.appName != "" {
 		return nil
@@ -196,13 +254,15 @@ func (o *svcLogsOpts) askSvcEnvName() error {
 	return nil
 }
 
-func (o *svcLogsOpts) parseSince() *int64 {
-	sinceSec := int64(o.since.Round(time.Second).Seconds())
+// parseSince returns the Unix time in milliseconds of the duration ago, rounded to the second.
+func parseSince(since time.Duration) *int64 {
+	sinceSec := int64(since.Round(time.Second).Seconds())
 	timeNow := time.Now().Add(time.Duration(-sinceSec) * time.Second)
 	return aws.Int64(timeNow.Unix() * 1000)
 }
 
-func (o *svcLogsOpts) parseRFC3339(timeStr string) (int64, error) {
+// parseRFC3339 returns the Unix time in milliseconds of the RFC3339 timestamp.
+func parseRFC3339(timeStr string) (int64, error) {
 	startTimeTmp, err := time.Parse(time.RFC3339, timeStr)
 	if err != nil {
 		return 0, fmt.Errorf("reading time value %s: %w", timeStr, err)
@@ -227,7 +287,11 @@ func buildSvcLogsCmd() *cobra.Command {
 	Displays logs from specific task IDs.
   /code $ copilot svc logs --tasks 709c7eae05f947f6861b150372ddc443,1de57fd63c6a4920ac416d02add891b9
   Displays logs in real time.
-  /code $ copilot svc logs --follow`,
+  /code $ copilot svc logs --follow
+  Displays logs since the latest deployment started.
+  /code $ copilot svc logs --start-from-deploy --follow
+  Counts the errors of each container, sidecars included, in the last day.
+  /code $ copilot svc logs --since 24h --query 'filter @message like /ERROR/ | stats count(*) by @logStream'`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcLogOpts(vars)
 			if err != nil {
@@ -252,5 +316,7 @@ func buildSvcLogsCmd() *cobra.Command {
 	cmd.Flags().DurationVar(&vars.since, sinceFlag, 0, sinceFlagDescription)
 	cmd.Flags().IntVar(&vars.limit, limitFlag, 0, limitFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.taskIDs, tasksFlag, nil, tasksLogsFlagDescription)
+	cmd.Flags().BoolVar(&vars.startFromDeploy, startFromDeployFlag, false, startFromDeployFlagDescription)
+	cmd.Flags().StringVar(&vars.query, queryFlag, "", queryFlagDescription)
 	return cmd
 }
@@ -9,6 +9,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/logging"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -31,14 +33,16 @@ func TestSvcLogs_Validate(t *testing.T) {
 		mockBadEndTime   = "badEndTime"
 	)
 	testCases := map[string]struct {
-		inputApp       string
-		inputSvc       string
-		inputLimit     int
-		inputFollow    bool
-		inputEnvName   string
-		inputStartTime string
-		inputEndTime   string
-		inputSince     time.Duration
+		inputApp        string
+		inputSvc        string
+		inputLimit      int
+		inputFollow     bool
+		inputEnvName    string
+		inputStartTime  string
+		inputEndTime    string
+		inputSince      time.Duration
+		inputFromDeploy bool
+		inputQuery      string
 
 		mockstore func(m *mocks.Mockstore)
 
@@ -66,6 +70,14 @@ func TestSvcLogs_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("only one of --since or --start-time may be used"),
 		},
+		"returns error if start-from-deploy and since flags are set together": {
+			inputSince:      mockSince,
+			inputFromDeploy: true,
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("--start-from-deploy cannot be used with --since or --start-time"),
+		},
 		"returns error if follow and endTime flags are set together": {
 			inputFollow:  true,
 			inputEndTime: mockEndTime,
@@ -74,6 +86,14 @@ func TestSvcLogs_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("only one of --follow or --end-time may be used"),
 		},
+		"returns error if follow and query flags are set together": {
+			inputFollow: true,
+			inputQuery:  "stats count(*) by @logStream",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --follow or --query may be used"),
+		},
 		"returns error if invalid start time flag value": {
 			inputStartTime: mockBadStartTime,
 
@@ -121,14 +141,16 @@ func TestSvcLogs_Validate(t *testing.T) {
 
 			svcLogs := &svcLogsOpts{
 				svcLogsVars: svcLogsVars{
-					follow:         tc.inputFollow,
-					limit:          tc.inputLimit,
-					envName:        tc.inputEnvName,
-					humanStartTime: tc.inputStartTime,
-					humanEndTime:   tc.inputEndTime,
-					since:          tc.inputSince,
-					svcName:        tc.inputSvc,
-					appName:        tc.inputApp,
+					follow:          tc.inputFollow,
+					limit:           tc.inputLimit,
+					envName:         tc.inputEnvName,
+					humanStartTime:  tc.inputStartTime,
+					humanEndTime:    tc.inputEndTime,
+					since:           tc.inputSince,
+					startFromDeploy: tc.inputFromDeploy,
+					query:           tc.inputQuery,
+					svcName:         tc.inputSvc,
+					appName:         tc.inputApp,
 				},
 				configStore: mockstore,
 			}
@@ -256,14 +278,18 @@ func TestSvcLogs_Execute(t *testing.T) {
 	mockLimit := int64(10)
 	var mockNilLimit *int64
 	testCases := map[string]struct {
-		inputSvc  string
-		follow    bool
-		limit     int
-		endTime   int64
-		startTime int64
-		taskIDs   []string
-
-		mocklogsSvc func(ctrl *gomock.Controller) logEventsWriter
+		inputSvc   string
+		follow     bool
+		limit      int
+		endTime    int64
+		startTime  int64
+		taskIDs    []string
+		fromDeploy bool
+		query      string
+
+		mocklogsSvc     func(ctrl *gomock.Controller) logEventsWriter
+		mockQuerySvc    func(m *mocks.MocklogQueryWriter)
+		mockDeployments func(m *mocks.MockserviceDeploymentGetter)
 
 		wantedError error
 	}{
@@ -312,6 +338,71 @@ func TestSvcLogs_Execute(t *testing.T) {
 
 			wantedError: nil,
 		},
+		"success with logs since the latest deployment": {
+			inputSvc:   "mockSvc",
+			fromDeploy: true,
+
+			mockDeployments: func(m *mocks.MockserviceDeploymentGetter) {
+				m.EXPECT().LatestDeployment("mockApp", "mockEnv", "mockSvc").Return(&awsecs.Deployment{
+					TaskDefinition: "arn:aws:ecs:us-west-2:1234567890:task-definition/mockApp-mockEnv-mockSvc:2",
+					CreatedAt:      time.Unix(1234567890, 0),
+				}, nil)
+			},
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+					require.Equal(t, aws.Int64(1234567890000), param.StartTime)
+				}).Return(nil)
+				return m
+			},
+		},
+		"returns error if fail to get the latest deployment": {
+			inputSvc:   "mockSvc",
+			fromDeploy: true,
+
+			mockDeployments: func(m *mocks.MockserviceDeploymentGetter) {
+				m.EXPECT().LatestDeployment("mockApp", "mockEnv", "mockSvc").Return(nil, errors.New("some error"))
+			},
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				return mocks.NewMocklogEventsWriter(ctrl)
+			},
+
+			wantedError: fmt.Errorf("get latest deployment of service mockSvc: some error"),
+		},
+		"success with a query": {
+			inputSvc:  "mockSvc",
+			endTime:   mockEndTime,
+			startTime: mockStartTime,
+			limit:     10,
+			taskIDs:   []string{"mockTaskID"},
+			query:     "stats count(*) by @logStream",
+
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				return mocks.NewMocklogEventsWriter(ctrl)
+			},
+			mockQuerySvc: func(m *mocks.MocklogQueryWriter) {
+				m.EXPECT().WriteQueryResults(gomock.Any()).Do(func(param logging.QueryOpts) {
+					require.Equal(t, "stats count(*) by @logStream", param.Query)
+					require.Equal(t, []string{"mockTaskID"}, param.TaskIDs)
+					require.Equal(t, &mockEndTime, param.EndTime)
+					require.Equal(t, &mockStartTime, param.StartTime)
+					require.Equal(t, &mockLimit, param.Limit)
+				}).Return(nil)
+			},
+		},
+		"returns error if fail to query logs": {
+			inputSvc: "mockSvc",
+			query:    "stats count(*) by @logStream",
+
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				return mocks.NewMocklogEventsWriter(ctrl)
+			},
+			mockQuerySvc: func(m *mocks.MocklogQueryWriter) {
+				m.EXPECT().WriteQueryResults(gomock.Any()).Return(errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("query logs of service mockSvc: some error"),
+		},
 		"returns error if fail to get event logs": {
 			inputSvc: "mockSvc",
 
@@ -332,17 +423,32 @@ func TestSvcLogs_Execute(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
+			mockDeployments := mocks.NewMockserviceDeploymentGetter(ctrl)
+			if tc.mockDeployments != nil {
+				tc.mockDeployments(mockDeployments)
+			}
+			mockQuerySvc := mocks.NewMocklogQueryWriter(ctrl)
+			if tc.mockQuerySvc != nil {
+				tc.mockQuerySvc(mockQuerySvc)
+			}
+
 			svcLogs := &svcLogsOpts{
 				svcLogsVars: svcLogsVars{
-					svcName: tc.inputSvc,
-					follow:  tc.follow,
-					limit:   tc.limit,
-					taskIDs: tc.taskIDs,
+					appName:         "mockApp",
+					envName:         "mockEnv",
+					svcName:         tc.inputSvc,
+					follow:          tc.follow,
+					limit:           tc.limit,
+					taskIDs:         tc.taskIDs,
+					startFromDeploy: tc.fromDeploy,
+					query:           tc.query,
 				},
 				startTime:   &tc.startTime,
 				endTime:     &tc.endTime,
 				initLogsSvc: func() error { return nil },
 				logsSvc:     tc.mocklogsSvc(ctrl),
+				querySvc:    mockQuerySvc,
+				deployments: mockDeployments,
 			}
 
 			// WHEN
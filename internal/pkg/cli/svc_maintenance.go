@@ -0,0 +1,260 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/maintenance"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcMaintenanceNamePrompt     = "Which service would you like to turn maintenance mode %s for?"
+	svcMaintenanceNameHelpPrompt = "In maintenance mode, the load balancer responds to requests with a 503 instead of forwarding them to the service's tasks."
+)
+
+// maxMaintenanceBodyLength is the maximum length of the body of a fixed response of a listener rule.
+const maxMaintenanceBodyLength = 1024
+
+type svcMaintenanceVars struct {
+	appName  string
+	svcName  string
+	envName  string
+	body     string
+	duration time.Duration
+	enable   bool
+}
+
+type svcMaintenanceOpts struct {
+	svcMaintenanceVars
+
+	store store
+	sel   deploySelector
+
+	newSwitcher func(*svcMaintenanceOpts) (maintenanceSwitcher, error)
+	now         func() time.Time
+}
+
+func newSvcMaintenanceOpts(vars svcMaintenanceVars) (*svcMaintenanceOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcMaintenanceOpts{
+		svcMaintenanceVars: vars,
+		store:              configStore,
+		sel:                selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		newSwitcher: func(o *svcMaintenanceOpts) (maintenanceSwitcher, error) {
+			env, err := o.store.GetEnvironment(o.appName, o.envName)
+			if err != nil {
+				return nil, fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+			}
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return maintenance.New(sess), nil
+		},
+		now: time.Now,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcMaintenanceOpts) Validate() error {
+	if len(o.body) > maxMaintenanceBodyLength {
+		return fmt.Errorf("--%s must be at most %d characters", maintenanceBodyFlag, maxMaintenanceBodyLength)
+	}
+	if o.duration < 0 {
+		return fmt.Errorf("--%s must not be negative", maintenanceDurationFlag)
+	}
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcMaintenanceOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askSvcEnvName()
+}
+
+// Execute turns the maintenance mode of the service on or off.
+func (o *svcMaintenanceOpts) Execute() error {
+	svc, err := o.store.GetService(o.appName, o.svcName)
+	if err != nil {
+		return fmt.Errorf("get service %s configuration: %w", o.svcName, err)
+	}
+	if svc.Type != manifest.LoadBalancedWebServiceType {
+		return fmt.Errorf("service %s can't be put in maintenance: only a %s receives traffic from a load balancer", o.svcName, manifest.LoadBalancedWebServiceType)
+	}
+	switcher, err := o.newSwitcher(o)
+	if err != nil {
+		return err
+	}
+	if !o.enable {
+		if err := switcher.Disable(o.appName, o.envName, o.svcName); err != nil {
+			return fmt.Errorf("turn off maintenance mode of service %s: %w", o.svcName, err)
+		}
+		log.Successf("Service %s in environment %s is out of maintenance and serving traffic.\n", color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName))
+		return nil
+	}
+	body := o.body
+	if body == "" {
+		body = maintenance.DefaultBody
+	}
+	var until time.Time
+	if o.duration != 0 {
+		until = o.now().Add(o.duration)
+	}
+	if err := switcher.Enable(o.appName, o.envName, o.svcName, body, until); err != nil {
+		return fmt.Errorf("turn on maintenance mode of service %s: %w", o.svcName, err)
+	}
+	log.Successf("Service %s in environment %s is in maintenance: its load balancer responds to requests with a 503.\n", color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName))
+	offCmd := color.HighlightCode(fmt.Sprintf("copilot svc maintenance off -n %s -e %s", o.svcName, o.envName))
+	if until.IsZero() {
+		log.Infof("Run %s to serve traffic again.\n", offCmd)
+		return nil
+	}
+	log.Infof("The maintenance is expected to end at %s. Copilot doesn't end it for you: run %s to serve traffic again.\n",
+		color.HighlightUserInput(until.UTC().Format(time.RFC3339)), offCmd)
+	return nil
+}
+
+func (o *svcMaintenanceOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcMaintenanceOpts) askSvcEnvName() error {
+	state := "off"
+	if o.enable {
+		state = "on"
+	}
+	deployedService, err := o.sel.DeployedService(fmt.Sprintf(svcMaintenanceNamePrompt, state), svcMaintenanceNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcMaintenanceCmd builds the command for turning the maintenance mode of a service on and off.
+func buildSvcMaintenanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "maintenance",
+		Short: "Commands for putting a Load Balanced Web Service in and out of maintenance.",
+		Long: `Commands for putting a Load Balanced Web Service in and out of maintenance.
+In maintenance mode, the load balancer responds to requests with a 503 instead of forwarding them to the service's tasks, which keep running.`,
+	}
+
+	cmd.AddCommand(buildSvcMaintenanceOnCmd())
+	cmd.AddCommand(buildSvcMaintenanceOffCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
+
+func buildSvcMaintenanceOnCmd() *cobra.Command {
+	vars := svcMaintenanceVars{
+		enable: true,
+	}
+	cmd := &cobra.Command{
+		Use:   "on",
+		Short: "Responds to the requests of a service with a 503 maintenance page.",
+		Long: `Responds to the requests of a service with a 503 maintenance page.
+The tasks of the service keep running. The maintenance page stays on until you run "svc maintenance off".
+The expected end of the maintenance can be recorded with --duration, "svc status" shows it and warns once it's past.`,
+
+		Example: `
+  Puts the service "frontend" in the "prod" environment in maintenance.
+  /code $ copilot svc maintenance on -n frontend -e prod
+  Puts the service in maintenance with a custom page.
+  /code $ copilot svc maintenance on -n frontend -e prod --body "<h1>Upgrading our database, back at 10:00 UTC</h1>"
+  Puts the service in maintenance for an expected two hours.
+  /code $ copilot svc maintenance on -n frontend -e prod --duration 2h`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return runSvcMaintenance(vars)
+		}),
+	}
+	addSvcMaintenanceFlags(cmd, &vars)
+	cmd.Flags().StringVar(&vars.body, maintenanceBodyFlag, "", maintenanceBodyFlagDescription)
+	cmd.Flags().DurationVar(&vars.duration, maintenanceDurationFlag, 0, maintenanceDurationFlagDescription)
+	return cmd
+}
+
+func buildSvcMaintenanceOffCmd() *cobra.Command {
+	vars := svcMaintenanceVars{}
+	cmd := &cobra.Command{
+		Use:   "off",
+		Short: "Forwards the requests of a service in maintenance to its tasks again.",
+		Long:  "Forwards the requests of a service in maintenance to its tasks again.",
+
+		Example: `
+  Takes the service "frontend" in the "prod" environment out of maintenance.
+  /code $ copilot svc maintenance off -n frontend -e prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return runSvcMaintenance(vars)
+		}),
+	}
+	addSvcMaintenanceFlags(cmd, &vars)
+	return cmd
+}
+
+func runSvcMaintenance(vars svcMaintenanceVars) error {
+	opts, err := newSvcMaintenanceOpts(vars)
+	if err != nil {
+		return err
+	}
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	if err := opts.Ask(); err != nil {
+		return err
+	}
+	return opts.Execute()
+}
+
+func addSvcMaintenanceFlags(cmd *cobra.Command, vars *svcMaintenanceVars) {
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+}
@@ -0,0 +1,283 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/maintenance"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcMaintenance_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inBody     string
+		inDuration time.Duration
+		mockStore  func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"errors if the body is too long": {
+			inBody:    strings.Repeat("a", 1025),
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--body must be at most 1024 characters"),
+		},
+		"errors if the duration is negative": {
+			inDuration: -time.Hour,
+			mockStore:  func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--duration must not be negative"),
+		},
+		"errors if the service doesn't exist": {
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().GetService("phonetool", "frontend").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"success": {
+			inBody: "<h1>back soon</h1>",
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.mockStore(mockStore)
+			opts := &svcMaintenanceOpts{
+				svcMaintenanceVars: svcMaintenanceVars{
+					appName:  "phonetool",
+					svcName:  "frontend",
+					envName:  "prod",
+					body:     tc.inBody,
+					duration: tc.inDuration,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcMaintenance_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp        string
+		inEnable     bool
+		mockSelector func(m *mocks.MockdeploySelector)
+
+		wantedApp   string
+		wantedSvc   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"errors if failed to select application": {
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(svcAppNamePrompt, svcAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"errors if failed to select deployed service": {
+			inApp: "phonetool",
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService("Which service would you like to turn maintenance mode off for?", svcMaintenanceNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("select deployed services for application phonetool: some error"),
+		},
+		"success": {
+			inApp:    "phonetool",
+			inEnable: true,
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService("Which service would you like to turn maintenance mode on for?", svcMaintenanceNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env: "prod",
+						Svc: "frontend",
+					}, nil)
+			},
+
+			wantedApp: "phonetool",
+			wantedSvc: "frontend",
+			wantedEnv: "prod",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSelector := mocks.NewMockdeploySelector(ctrl)
+			tc.mockSelector(mockSelector)
+			opts := &svcMaintenanceOpts{
+				svcMaintenanceVars: svcMaintenanceVars{
+					appName: tc.inApp,
+					enable:  tc.inEnable,
+				},
+				sel: mockSelector,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+		})
+	}
+}
+
+func TestSvcMaintenance_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inEnable     bool
+		inBody       string
+		inDuration   time.Duration
+		mockStore    func(m *mocks.Mockstore)
+		mockSwitcher func(m *mocks.MockmaintenanceSwitcher)
+
+		wantedError error
+	}{
+		"errors if the service is not a Load Balanced Web Service": {
+			inEnable: true,
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{Type: manifest.BackendServiceType}, nil)
+			},
+			mockSwitcher: func(m *mocks.MockmaintenanceSwitcher) {},
+
+			wantedError: errors.New("service frontend can't be put in maintenance: only a Load Balanced Web Service receives traffic from a load balancer"),
+		},
+		"errors if maintenance can't be turned on": {
+			inEnable: true,
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{Type: manifest.LoadBalancedWebServiceType}, nil)
+			},
+			mockSwitcher: func(m *mocks.MockmaintenanceSwitcher) {
+				m.EXPECT().Enable("phonetool", "prod", "frontend", gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+
+			wantedError: errors.New("turn on maintenance mode of service frontend: some error"),
+		},
+		"turns maintenance on with the default body": {
+			inEnable: true,
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{Type: manifest.LoadBalancedWebServiceType}, nil)
+			},
+			mockSwitcher: func(m *mocks.MockmaintenanceSwitcher) {
+				m.EXPECT().Enable("phonetool", "prod", "frontend", maintenance.DefaultBody, time.Time{}).Return(nil)
+			},
+		},
+		"turns maintenance on with a custom body": {
+			inEnable: true,
+			inBody:   "<h1>back at 10:00 UTC</h1>",
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{Type: manifest.LoadBalancedWebServiceType}, nil)
+			},
+			mockSwitcher: func(m *mocks.MockmaintenanceSwitcher) {
+				m.EXPECT().Enable("phonetool", "prod", "frontend", "<h1>back at 10:00 UTC</h1>", time.Time{}).Return(nil)
+			},
+		},
+		"turns maintenance on until the end of the duration": {
+			inEnable:   true,
+			inDuration: 2 * time.Hour,
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{Type: manifest.LoadBalancedWebServiceType}, nil)
+			},
+			mockSwitcher: func(m *mocks.MockmaintenanceSwitcher) {
+				m.EXPECT().Enable("phonetool", "prod", "frontend", maintenance.DefaultBody, time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)).Return(nil)
+			},
+		},
+		"errors if maintenance can't be turned off": {
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{Type: manifest.LoadBalancedWebServiceType}, nil)
+			},
+			mockSwitcher: func(m *mocks.MockmaintenanceSwitcher) {
+				m.EXPECT().Disable("phonetool", "prod", "frontend").Return(errors.New("some error"))
+			},
+
+			wantedError: errors.New("turn off maintenance mode of service frontend: some error"),
+		},
+		"turns maintenance off": {
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{Type: manifest.LoadBalancedWebServiceType}, nil)
+			},
+			mockSwitcher: func(m *mocks.MockmaintenanceSwitcher) {
+				m.EXPECT().Disable("phonetool", "prod", "frontend").Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockSwitcher := mocks.NewMockmaintenanceSwitcher(ctrl)
+			tc.mockStore(mockStore)
+			tc.mockSwitcher(mockSwitcher)
+			opts := &svcMaintenanceOpts{
+				svcMaintenanceVars: svcMaintenanceVars{
+					appName:  "phonetool",
+					svcName:  "frontend",
+					envName:  "prod",
+					body:     tc.inBody,
+					duration: tc.inDuration,
+					enable:   tc.inEnable,
+				},
+				store: mockStore,
+				newSwitcher: func(*svcMaintenanceOpts) (maintenanceSwitcher, error) {
+					return mockSwitcher, nil
+				},
+				now: func() time.Time {
+					return time.Date(2026, 10, 15, 10, 0, 0, 0, time.UTC)
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -13,18 +13,23 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/diff"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -47,6 +52,7 @@ type packageSvcVars struct {
 	appName   string
 	tag       string
 	outputDir string
+	showDiff  bool
 }
 
 type packageSvcOpts struct {
@@ -66,6 +72,16 @@ type packageSvcOpts struct {
 	sel              wsSelector
 	prompt           prompter
 	stackSerializer  func(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (stackSerializer, error)
+
+	newStackDescriber func(env *config.Environment) (deployedStackDescriber, error) // Overridden in tests.
+}
+
+func newDeployedStackDescriber(env *config.Environment) (deployedStackDescriber, error) {
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create session with the manager role of environment %s: %w", env.Name, err)
+	}
+	return awscloudformation.New(sess), nil
 }
 
 func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
@@ -97,12 +113,13 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 		addonsWriter:     ioutil.Discard,
 		fs:               &afero.Afero{Fs: afero.NewOsFs()},
 	}
+	opts.newStackDescriber = newDeployedStackDescriber
 
 	opts.stackSerializer = func(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (stackSerializer, error) {
 		var serializer stackSerializer
 		switch v := mft.(type) {
 		case *manifest.LoadBalancedWebService:
-			if app.RequiresDNSDelegation() {
+			if app.RequiresDNSDelegation() || env.HasDomain() {
 				serializer, err = stack.NewHTTPSLoadBalancedWebService(v, env.Name, app.Name, rc)
 				if err != nil {
 					return nil, fmt.Errorf("init https load balanced web service stack serializer: %w", err)
@@ -131,6 +148,9 @@ func (o *packageSvcOpts) Validate() error {
 	if o.appName == "" {
 		return errNoAppInWorkspace
 	}
+	if o.showDiff && o.outputDir != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s", diffFlag, stackOutputDirFlag)
+	}
 	if o.name != "" {
 		names, err := o.ws.ServiceNames()
 		if err != nil {
@@ -177,6 +197,9 @@ func (o *packageSvcOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	if o.showDiff {
+		return o.writeDiff(env, appTemplates)
+	}
 	if _, err = o.stackWriter.Write([]byte(appTemplates.stack)); err != nil {
 		return err
 	}
@@ -261,8 +284,17 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 	if err != nil {
 		return nil, err
 	}
+	executionRole, err := env.TaskExecutionRole(app)
+	if err != nil {
+		return nil, err
+	}
+	redirect := env.HTTPRedirect()
 	rc := stack.RuntimeConfig{
-		AdditionalTags: app.Tags,
+		AdditionalTags:         app.Tags,
+		LogGroupPrefix:         env.LogGroupPrefix(),
+		DisableHTTPRedirect:    redirect.Disabled,
+		HTTPRedirectStatusCode: redirect.StatusCode,
+		ExecutionRoleARN:       executionRole,
 	}
 	if imgNeedsBuild {
 		resources, err := o.appCFN.GetAppResourcesByRegion(app, env.Region)
@@ -297,6 +329,104 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 	return &svcCfnTemplates{stack: tpl, configuration: params}, nil
 }
 
+// writeDiff writes the differences between the deployed stack and the generated templates to the stack writer.
+func (o *packageSvcOpts) writeDiff(env *config.Environment, tpls *svcCfnTemplates) error {
+	describer, err := o.newStackDescriber(env)
+	if err != nil {
+		return err
+	}
+	stackName := stack.NameForService(o.appName, o.envName, o.name)
+	var deployedTpl, deployedParams string
+	descr, err := describer.Describe(stackName)
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if !errors.As(err, &errNotFound) {
+			return fmt.Errorf("describe stack %s: %w", stackName, err)
+		}
+		log.Infof("%s is not deployed in environment %s yet, every resource will be created.\n", o.name, o.envName)
+	} else {
+		deployedTpl, err = describer.TemplateBody(stackName)
+		if err != nil {
+			return fmt.Errorf("get template of stack %s: %w", stackName, err)
+		}
+		deployedParams, err = serializeDeployedParameters(descr)
+		if err != nil {
+			return err
+		}
+	}
+
+	return writeStackDiff(o.stackWriter, stackName, stackDiffInput{
+		deployedTemplate: deployedTpl,
+		template:         tpls.stack,
+		deployedParams:   deployedParams,
+		params:           tpls.configuration,
+	})
+}
+
+// stackDiffInput holds the deployed and the new template and template configuration of a stack.
+type stackDiffInput struct {
+	deployedTemplate string
+	template         string
+	deployedParams   string
+	params           string
+}
+
+// writeStackDiff writes the differences between the deployed and the new template and template configuration of a stack to w.
+func writeStackDiff(w io.Writer, stackName string, in stackDiffInput) error {
+	for _, section := range []struct {
+		title         string
+		deployed, gen string
+	}{
+		{title: "template", deployed: in.deployedTemplate, gen: in.template},
+		{title: "parameters and tags", deployed: in.deployedParams, gen: in.params},
+	} {
+		if err := writeDiffSection(w, section.title, section.deployed, section.gen); err != nil {
+			return fmt.Errorf("compare the %s of stack %s: %w", section.title, stackName, err)
+		}
+	}
+	return nil
+}
+
+// writeDiffSection writes the differences between the deployed and the new version of a YAML document to w under a title.
+func writeDiffSection(w io.Writer, title, deployed, gen string) error {
+	tree, err := diff.New([]byte(deployed), []byte(gen))
+	if err != nil {
+		return err
+	}
+	if tree.IsEmpty() {
+		_, err := fmt.Fprintf(w, "No changes to the %s.\n", title)
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Changes to the %s:\n", title); err != nil {
+		return err
+	}
+	return tree.Write(w)
+}
+
+// serializeDeployedParameters returns the parameters and tags of the deployed stack in the format of the template configuration.
+func serializeDeployedParameters(descr *awscloudformation.StackDescription) (string, error) {
+	config := struct {
+		Parameters map[string]string `yaml:"Parameters"`
+		Tags       map[string]string `yaml:"Tags,omitempty"`
+	}{
+		Parameters: make(map[string]string),
+	}
+	for _, param := range descr.Parameters {
+		config.Parameters[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	for _, tag := range descr.Tags {
+		if config.Tags == nil {
+			config.Tags = make(map[string]string)
+		}
+		config.Tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("marshal parameters of the deployed stack: %w", err)
+	}
+	return string(out), nil
+}
+
 // setOutputFileWriters creates the output directory, and updates the template and param writers to file writers in the directory.
 func (o *packageSvcOpts) setOutputFileWriters() error {
 	if err := o.fs.MkdirAll(o.outputDir, 0755); err != nil {
@@ -382,7 +512,10 @@ func buildSvcPackageCmd() *cobra.Command {
   Write the CloudFormation stack and configuration to a "infrastructure/" sub-directory instead of printing.
   /code $ copilot svc package -n frontend -e test --output-dir ./infrastructure
   /code $ ls ./infrastructure
-  /code frontend-test.stack.yml      frontend-test.params.yml`,
+  /code frontend-test.stack.yml      frontend-test.params.yml
+
+  Compare the template and parameters with the ones deployed in the "test" environment.
+  /code $ copilot svc package -n frontend -e test --diff`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newPackageSvcOpts(vars)
 			if err != nil {
@@ -403,5 +536,6 @@ func buildSvcPackageCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVar(&vars.tag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, "", stackOutputDirFlagDescription)
+	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
 	return cmd
 }
@@ -8,7 +8,10 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -23,9 +26,11 @@ func TestPackageSvcOpts_Validate(t *testing.T) {
 	)
 
 	testCases := map[string]struct {
-		inAppName string
-		inEnvName string
-		inSvcName string
+		inAppName   string
+		inEnvName   string
+		inSvcName   string
+		inOutputDir string
+		inDiff      bool
 
 		setupMocks func()
 
@@ -38,6 +43,17 @@ func TestPackageSvcOpts_Validate(t *testing.T) {
 			},
 			wantedErrorS: "could not find an application attached to this workspace, please run `app init` first",
 		},
+		"error if both diff and output dir are set": {
+			inAppName:   "phonetool",
+			inOutputDir: "./infrastructure",
+			inDiff:      true,
+			setupMocks: func() {
+				mockWorkspace.EXPECT().ServiceNames().Times(0)
+				mockStore.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			wantedErrorS: "cannot specify both --diff and --output-dir",
+		},
 		"error while fetching service": {
 			inAppName: "phonetool",
 			inSvcName: "frontend",
@@ -90,9 +106,11 @@ func TestPackageSvcOpts_Validate(t *testing.T) {
 
 			opts := &packageSvcOpts{
 				packageSvcVars: packageSvcVars{
-					name:    tc.inSvcName,
-					envName: tc.inEnvName,
-					appName: tc.inAppName,
+					name:      tc.inSvcName,
+					envName:   tc.inEnvName,
+					appName:   tc.inAppName,
+					outputDir: tc.inOutputDir,
+					showDiff:  tc.inDiff,
 				},
 				ws:    mockWorkspace,
 				store: mockStore,
@@ -293,6 +311,87 @@ count: 1`), nil)
 			wantedStack:  "mystack",
 			wantedParams: "myparams",
 		},
+		"writes the differences with the deployed stack": {
+			inVars: packageSvcVars{
+				appName:  "ecs-kudos",
+				name:     "api",
+				envName:  "test",
+				tag:      "1234",
+				showDiff: true,
+			},
+			mockDependencies: func(ctrl *gomock.Controller, opts *packageSvcOpts) {
+				mockPackageDiffDependencies(ctrl, opts, func(m *mocks.MockdeployedStackDescriber) {
+					m.EXPECT().Describe("ecs-kudos-test-api").Return(&awscfn.StackDescription{
+						Parameters: []*sdkcfn.Parameter{
+							{ParameterKey: aws.String("EnvName"), ParameterValue: aws.String("test")},
+							{ParameterKey: aws.String("ContainerPort"), ParameterValue: aws.String("8080")},
+						},
+						Tags: []*sdkcfn.Tag{
+							{Key: aws.String("copilot-application"), Value: aws.String("ecs-kudos")},
+						},
+					}, nil)
+					m.EXPECT().TemplateBody("ecs-kudos-test-api").Return(`Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+`, nil)
+				})
+			},
+
+			wantedStack: `Changes to the template:
+~ Resources:
+    ~ Queue:
+        + Properties:
+        +   DelaySeconds: 5
+Changes to the parameters and tags:
+~ Parameters:
+    ~ ContainerPort: "8080" -> "80"
+`,
+		},
+		"writes every resource as added if the service is not deployed": {
+			inVars: packageSvcVars{
+				appName:  "ecs-kudos",
+				name:     "api",
+				envName:  "test",
+				tag:      "1234",
+				showDiff: true,
+			},
+			mockDependencies: func(ctrl *gomock.Controller, opts *packageSvcOpts) {
+				mockPackageDiffDependencies(ctrl, opts, func(m *mocks.MockdeployedStackDescriber) {
+					m.EXPECT().Describe("ecs-kudos-test-api").Return(nil, &awscfn.ErrStackNotFound{})
+					m.EXPECT().TemplateBody(gomock.Any()).Times(0)
+				})
+			},
+
+			wantedStack: `Changes to the template:
++ Resources:
++   Queue:
++     Type: AWS::SQS::Queue
++     Properties:
++       DelaySeconds: 5
+Changes to the parameters and tags:
++ Parameters:
++   EnvName: "test"
++   ContainerPort: "80"
++ Tags:
++   copilot-application: "ecs-kudos"
+`,
+		},
+		"wraps the error of describing the deployed stack": {
+			inVars: packageSvcVars{
+				appName:  "ecs-kudos",
+				name:     "api",
+				envName:  "test",
+				tag:      "1234",
+				showDiff: true,
+			},
+			mockDependencies: func(ctrl *gomock.Controller, opts *packageSvcOpts) {
+				mockPackageDiffDependencies(ctrl, opts, func(m *mocks.MockdeployedStackDescriber) {
+					m.EXPECT().Describe("ecs-kudos-test-api").Return(nil, errors.New("some error"))
+				})
+			},
+
+			wantedErr: errors.New("describe stack ecs-kudos-test-api: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -317,10 +416,62 @@ count: 1`), nil)
 			err := opts.Execute()
 
 			// THEN
-			require.Equal(t, tc.wantedErr, err)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
 			require.Equal(t, tc.wantedStack, stackBuf.String())
 			require.Equal(t, tc.wantedParams, paramsBuf.String())
 			require.Equal(t, tc.wantedAddons, addonsBuf.String())
 		})
 	}
 }
+
+func mockPackageDiffDependencies(ctrl *gomock.Controller, opts *packageSvcOpts, mockDescriber func(m *mocks.MockdeployedStackDescriber)) {
+	env := &config.Environment{
+		App:            "ecs-kudos",
+		Name:           "test",
+		Region:         "us-west-2",
+		ManagerRoleARN: "arn:aws:iam::1111:role/manager",
+	}
+	mockStore := mocks.NewMockstore(ctrl)
+	mockStore.EXPECT().GetEnvironment("ecs-kudos", "test").Return(env, nil)
+	mockStore.EXPECT().GetApplication("ecs-kudos").Return(&config.Application{Name: "ecs-kudos"}, nil)
+	mockWs := mocks.NewMockwsSvcReader(ctrl)
+	mockWs.EXPECT().ReadServiceManifest("api").Return([]byte(`name: api
+type: Backend Service
+image:
+  location: nginx
+  port: 80`), nil)
+	m := mocks.NewMockdeployedStackDescriber(ctrl)
+	mockDescriber(m)
+
+	opts.store = mockStore
+	opts.ws = mockWs
+	opts.stackSerializer = func(_ interface{}, _ *config.Environment, _ *config.Application, _ stack.RuntimeConfig) (stackSerializer, error) {
+		mockStackSerializer := mocks.NewMockstackSerializer(ctrl)
+		mockStackSerializer.EXPECT().Template().Return(`Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+    Properties:
+      DelaySeconds: 5
+`, nil)
+		mockStackSerializer.EXPECT().SerializedParameters().Return(`{
+  "Parameters" : {
+    "EnvName": "test",
+    "ContainerPort": "80"
+  },
+  "Tags": {
+    "copilot-application": "ecs-kudos"
+  }
+}`, nil)
+		return mockStackSerializer, nil
+	}
+	opts.newStackDescriber = func(in *config.Environment) (deployedStackDescriber, error) {
+		if in != env {
+			return nil, errors.New("unexpected environment")
+		}
+		return m, nil
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcPortForwardNamePrompt     = "Which service would you like to forward a port to?"
+	svcPortForwardNameHelpPrompt = `Copilot forwards the port through one of your chosen service's tasks.
+The task is chosen at random, and the first essential container is used.`
+)
+
+type svcPortForwardVars struct {
+	appName          string
+	envName          string
+	name             string
+	taskID           string
+	containerName    string
+	localPort        uint16
+	remotePort       uint16
+	remoteHost       string
+	skipConfirmation *bool // If nil, we will prompt to upgrade the ssm plugin.
+}
+
+type svcPortForwardOpts struct {
+	svcPortForwardVars
+	store            store
+	sel              deploySelector
+	newSvcDescriber  func(*session.Session) serviceDescriber
+	newPortForwarder func(*session.Session) ssmPortForwarder
+	ssmPluginManager ssmPluginManager
+	prompter         prompter
+	// Override in unit test
+	randInt func(int) int
+}
+
+func newSvcPortForwardOpts(vars svcPortForwardVars) (*svcPortForwardOpts, error) {
+	ssmStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(ssmStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcPortForwardOpts{
+		svcPortForwardVars: vars,
+		store:              ssmStore,
+		sel:                selector.NewDeploySelect(prompt.New(), ssmStore, deployStore),
+		newSvcDescriber: func(s *session.Session) serviceDescriber {
+			return ecs.New(s)
+		},
+		newPortForwarder: func(s *session.Session) ssmPortForwarder {
+			return ssm.New(s)
+		},
+		randInt: func(x int) int {
+			rand.Seed(time.Now().Unix())
+			return rand.Intn(x)
+		},
+		ssmPluginManager: exec.NewSSMPluginCommand(nil),
+		prompter:         prompt.New(),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcPortForwardOpts) Validate() error {
+	if o.remotePort == 0 {
+		return fmt.Errorf("--%s is required", remotePortFlag)
+	}
+	if o.localPort == 0 {
+		o.localPort = o.remotePort
+	}
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetService(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	return validateSSMBinary(o.prompter, o.ssmPluginManager, o.skipConfirmation)
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcPortForwardOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	deployedService, err := o.sel.DeployedService(svcPortForwardNamePrompt, svcPortForwardNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute forwards the local port to the remote port through a running task of the service until interrupted.
+func (o *svcPortForwardOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return err
+	}
+	svcDesc, err := o.newSvcDescriber(sess).DescribeService(o.appName, o.envName, o.name)
+	if err != nil {
+		return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
+	}
+	task, err := o.selectTask(awsecs.FilterRunningTasks(svcDesc.Tasks))
+	if err != nil {
+		return err
+	}
+	container := o.containerName
+	if container == "" {
+		// The first essential container is named with the workload name.
+		container = o.name
+	}
+	target, err := task.SSMTarget(container)
+	if err != nil {
+		return fmt.Errorf("get session target: %w", err)
+	}
+	destination := fmt.Sprintf("container %s", color.HighlightUserInput(container))
+	if o.remoteHost != "" {
+		destination = color.HighlightUserInput(o.remoteHost)
+	}
+	log.Infof("Forward %s to port %d of %s through task %s. Press Ctrl+C to stop.\n",
+		color.HighlightResource(fmt.Sprintf("localhost:%d", o.localPort)), o.remotePort, destination, color.HighlightResource(target))
+	if err := o.newPortForwarder(sess).StartPortForwardingSession(ssm.PortForwardingSessionInput{
+		Target:     target,
+		RemoteHost: o.remoteHost,
+		RemotePort: int(o.remotePort),
+		LocalPort:  int(o.localPort),
+	}); err != nil {
+		log.Errorf("Failed to forward the port. Is %s set in your manifest?\n", color.HighlightCode("exec: true"))
+		return fmt.Errorf("forward local port %d to port %d: %w", o.localPort, o.remotePort, err)
+	}
+	return nil
+}
+
+func (o *svcPortForwardOpts) selectTask(tasks []*awsecs.Task) (*awsecs.Task, error) {
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
+	}
+	if o.taskID == "" {
+		return tasks[o.randInt(len(tasks))], nil
+	}
+	for _, task := range tasks {
+		taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(taskID, o.taskID) {
+			return task, nil
+		}
+	}
+	return nil, fmt.Errorf("found no running task whose ID is prefixed with %s", o.taskID)
+}
+
+// buildSvcPortForwardCmd builds the command for forwarding a local port to a service.
+func buildSvcPortForwardCmd() *cobra.Command {
+	vars := svcPortForwardVars{}
+	var skipPrompt bool
+	cmd := &cobra.Command{
+		Use:   "port-forward",
+		Short: "Forward a local port to a running container part of a service, or to a host it can reach.",
+		Long: `Forward a local port to a running container part of a service, or to a host it can reach.
+Traffic is tunneled through a Session Manager session, so private services and databases can be reached without a bastion host.`,
+		Example: `
+  Forward localhost:8080 to port 80 of the "frontend" service.
+  /code $ copilot svc port-forward -n frontend -e test --local-port 8080 --remote-port 80
+  Connect to the "api" service's Aurora database from localhost:5432.
+  /code $ copilot svc port-forward -n api -e test --remote-port 5432 --remote-host mycluster.cluster-abc.us-west-2.rds.amazonaws.com`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcPortForwardOpts(vars)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(yesFlag) {
+				opts.skipConfirmation = aws.Bool(false)
+				if skipPrompt {
+					opts.skipConfirmation = aws.Bool(true)
+				}
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", nameFlagDescription)
+	cmd.Flags().Uint16Var(&vars.localPort, localPortFlag, 0, localPortFlagDescription)
+	cmd.Flags().Uint16Var(&vars.remotePort, remotePortFlag, 0, remotePortFlagDescription)
+	cmd.Flags().StringVar(&vars.remoteHost, remoteHostFlag, "", remoteHostFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", containerFlagDescription)
+	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
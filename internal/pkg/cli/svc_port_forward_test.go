@@ -0,0 +1,327 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awssdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcPortForwardMocks struct {
+	store            *mocks.Mockstore
+	sel              *mocks.MockdeploySelector
+	svcDescriber     *mocks.MockserviceDescriber
+	portForwarder    *mocks.MockssmPortForwarder
+	ssmPluginManager *mocks.MockssmPluginManager
+}
+
+func TestSvcPortForwardOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inRemotePort uint16
+		inLocalPort  uint16
+		setupMocks   func(m svcPortForwardMocks)
+
+		wantedLocalPort uint16
+		wantedError     error
+	}{
+		"errors if the remote port is not set": {
+			setupMocks:  func(m svcPortForwardMocks) {},
+			wantedError: errors.New("--remote-port is required"),
+		},
+		"errors if the service does not exist": {
+			inRemotePort: 5432,
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{}, nil)
+				m.store.EXPECT().GetService("my-app", "my-svc").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"defaults the local port to the remote port": {
+			inRemotePort: 5432,
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{}, nil)
+				m.store.EXPECT().GetService("my-app", "my-svc").Return(&config.Workload{}, nil)
+				m.ssmPluginManager.EXPECT().ValidateBinary().Return(nil)
+			},
+			wantedLocalPort: 5432,
+		},
+		"keeps the local port if set": {
+			inRemotePort: 80,
+			inLocalPort:  8080,
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{}, nil)
+				m.store.EXPECT().GetService("my-app", "my-svc").Return(&config.Workload{}, nil)
+				m.ssmPluginManager.EXPECT().ValidateBinary().Return(nil)
+			},
+			wantedLocalPort: 8080,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcPortForwardMocks{
+				store:            mocks.NewMockstore(ctrl),
+				ssmPluginManager: mocks.NewMockssmPluginManager(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcPortForwardOpts{
+				svcPortForwardVars: svcPortForwardVars{
+					appName:    "my-app",
+					envName:    "my-env",
+					name:       "my-svc",
+					remotePort: tc.inRemotePort,
+					localPort:  tc.inLocalPort,
+				},
+				store:            m.store,
+				ssmPluginManager: m.ssmPluginManager,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedLocalPort, opts.localPort)
+		})
+	}
+}
+
+func TestSvcPortForwardOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp      string
+		setupMocks func(m svcPortForwardMocks)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedSvc   string
+		wantedError error
+	}{
+		"errors if the application can't be selected": {
+			setupMocks: func(m svcPortForwardMocks) {
+				m.sel.EXPECT().Application(svcAppNamePrompt, svcAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select application: some error"),
+		},
+		"errors if the service can't be selected": {
+			inApp: "my-app",
+			setupMocks: func(m svcPortForwardMocks) {
+				m.sel.EXPECT().DeployedService(svcPortForwardNamePrompt, svcPortForwardNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed service for application my-app: some error"),
+		},
+		"selects the application and the deployed service": {
+			setupMocks: func(m svcPortForwardMocks) {
+				m.sel.EXPECT().Application(svcAppNamePrompt, svcAppNameHelpPrompt).Return("my-app", nil)
+				m.sel.EXPECT().DeployedService(svcPortForwardNamePrompt, svcPortForwardNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env: "my-env",
+						Svc: "my-svc",
+					}, nil)
+			},
+			wantedApp: "my-app",
+			wantedEnv: "my-env",
+			wantedSvc: "my-svc",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcPortForwardMocks{
+				sel: mocks.NewMockdeploySelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcPortForwardOpts{
+				svcPortForwardVars: svcPortForwardVars{
+					appName: tc.inApp,
+				},
+				sel: m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedSvc, opts.name)
+		})
+	}
+}
+
+func TestSvcPortForwardOpts_Execute(t *testing.T) {
+	const (
+		mockClusterARN   = "arn:aws:ecs:us-west-2:123456789:cluster/mockCluster"
+		mockTaskARN      = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID"
+		mockOtherTaskARN = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockOtherTaskID"
+	)
+	mockTask := func(taskARN, runtimeID string) *awsecs.Task {
+		return &awsecs.Task{
+			ClusterArn: aws.String(mockClusterARN),
+			TaskArn:    aws.String(taskARN),
+			LastStatus: aws.String("RUNNING"),
+			Containers: []*awssdkecs.Container{
+				{
+					Name:      aws.String("mockSvc"),
+					RuntimeId: aws.String(runtimeID),
+				},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		inTaskID     string
+		inContainer  string
+		inRemoteHost string
+		setupMocks   func(m svcPortForwardMocks)
+
+		wantedError error
+	}{
+		"errors if the environment can't be retrieved": {
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment mockEnv: some error"),
+		},
+		"errors if the service can't be described": {
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe ECS service for mockSvc in environment mockEnv: some error"),
+		},
+		"errors if there is no running task": {
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{}, nil)
+			},
+			wantedError: errors.New("found no running task for service mockSvc in environment mockEnv"),
+		},
+		"errors if the container is not in the task": {
+			inContainer: "sidecar",
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{mockTask(mockTaskARN, "runtime")},
+				}, nil)
+			},
+			wantedError: errors.New("get session target: container sidecar not found in task mockTaskID"),
+		},
+		"errors if the session fails": {
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{mockTask(mockTaskARN, "runtime")},
+				}, nil)
+				m.portForwarder.EXPECT().StartPortForwardingSession(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("forward local port 8080 to port 80: some error"),
+		},
+		"forwards to the container of the task prefixed with the task ID": {
+			inTaskID: "mockOther",
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{
+						mockTask(mockTaskARN, "runtime"),
+						mockTask(mockOtherTaskARN, "otherRuntime"),
+					},
+				}, nil)
+				m.portForwarder.EXPECT().StartPortForwardingSession(ssm.PortForwardingSessionInput{
+					Target:     "ecs:mockCluster_mockOtherTaskID_otherRuntime",
+					RemotePort: 80,
+					LocalPort:  8080,
+				}).Return(nil)
+			},
+		},
+		"forwards to the remote host": {
+			inRemoteHost: "mydb.cluster-abc.us-west-2.rds.amazonaws.com",
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{mockTask(mockTaskARN, "runtime")},
+				}, nil)
+				m.portForwarder.EXPECT().StartPortForwardingSession(ssm.PortForwardingSessionInput{
+					Target:     "ecs:mockCluster_mockTaskID_runtime",
+					RemoteHost: "mydb.cluster-abc.us-west-2.rds.amazonaws.com",
+					RemotePort: 80,
+					LocalPort:  8080,
+				}).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcPortForwardMocks{
+				store:         mocks.NewMockstore(ctrl),
+				svcDescriber:  mocks.NewMockserviceDescriber(ctrl),
+				portForwarder: mocks.NewMockssmPortForwarder(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcPortForwardOpts{
+				svcPortForwardVars: svcPortForwardVars{
+					appName:       "mockApp",
+					envName:       "mockEnv",
+					name:          "mockSvc",
+					taskID:        tc.inTaskID,
+					containerName: tc.inContainer,
+					remoteHost:    tc.inRemoteHost,
+					remotePort:    80,
+					localPort:     8080,
+				},
+				store: m.store,
+				newSvcDescriber: func(_ *session.Session) serviceDescriber {
+					return m.svcDescriber
+				},
+				newPortForwarder: func(_ *session.Session) ssmPortForwarder {
+					return m.portForwarder
+				},
+				randInt: func(x int) int { return 0 },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
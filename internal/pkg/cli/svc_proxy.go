@@ -0,0 +1,212 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcProxyNamePrompt     = "Which service would you like to reach?"
+	svcProxyNameHelpPrompt = `Copilot tunnels a local port to the service discovery endpoint of the service,
+through a task of the environment, so that you can reach a service that is not public.`
+)
+
+type svcProxyVars struct {
+	appName          string
+	envName          string
+	name             string
+	via              string
+	localPort        uint16
+	skipConfirmation *bool // If nil, we will prompt to upgrade the ssm plugin.
+}
+
+type svcProxyOpts struct {
+	svcProxyVars
+	store            store
+	sel              deploySelector
+	ssmPluginManager ssmPluginManager
+	prompter         prompter
+
+	newEndpointDescriber func(app, svc string) (endpointDescriber, error)
+	// forward tunnels a local port to a remote host through a task of a service until interrupted.
+	forward func(vars svcPortForwardVars) error
+}
+
+func newSvcProxyOpts(vars svcProxyVars) (*svcProxyOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcProxyOpts{
+		svcProxyVars:     vars,
+		store:            configStore,
+		sel:              selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		ssmPluginManager: exec.NewSSMPluginCommand(nil),
+		prompter:         prompt.New(),
+		newEndpointDescriber: func(app, svc string) (endpointDescriber, error) {
+			return describe.NewBackendServiceDescriber(describe.NewBackendServiceConfig{
+				NewServiceConfig: describe.NewServiceConfig{
+					App:         app,
+					Svc:         svc,
+					ConfigStore: configStore,
+				},
+				DeployStore: deployStore,
+			})
+		},
+		forward: func(vars svcPortForwardVars) error {
+			opts, err := newSvcPortForwardOpts(vars)
+			if err != nil {
+				return err
+			}
+			return opts.Execute()
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcProxyOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	for _, svc := range []string{o.name, o.via} {
+		if svc == "" {
+			continue
+		}
+		if _, err := o.store.GetService(o.appName, svc); err != nil {
+			return err
+		}
+	}
+	return validateSSMBinary(o.prompter, o.ssmPluginManager, o.skipConfirmation)
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcProxyOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	deployedService, err := o.sel.DeployedService(svcProxyNamePrompt, svcProxyNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute forwards the local port to the service discovery endpoint of the service until interrupted.
+func (o *svcProxyOpts) Execute() error {
+	d, err := o.newEndpointDescriber(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("create describer for service %s: %w", o.name, err)
+	}
+	uri, err := d.URI(o.envName)
+	if err != nil {
+		return fmt.Errorf("get service discovery endpoint of service %s in environment %s: %w", o.name, o.envName, err)
+	}
+	if uri == describe.BlankServiceDiscoveryURI {
+		return fmt.Errorf("service %s does not expose a port to the other services of environment %s", o.name, o.envName)
+	}
+	host, rawPort, err := net.SplitHostPort(uri)
+	if err != nil {
+		return fmt.Errorf("parse service discovery endpoint %s: %w", uri, err)
+	}
+	port, err := strconv.ParseUint(rawPort, 10, 16)
+	if err != nil {
+		return fmt.Errorf("parse port of service discovery endpoint %s: %w", uri, err)
+	}
+	via := o.via
+	if via == "" {
+		via = o.name
+	}
+	localPort := o.localPort
+	if localPort == 0 {
+		localPort = uint16(port)
+	}
+	log.Infof("Proxy %s to service %s at %s.\n",
+		color.HighlightResource(fmt.Sprintf("localhost:%d", localPort)), color.HighlightUserInput(o.name), color.HighlightResource(uri))
+	return o.forward(svcPortForwardVars{
+		appName:    o.appName,
+		envName:    o.envName,
+		name:       via,
+		localPort:  localPort,
+		remotePort: uint16(port),
+		remoteHost: host,
+	})
+}
+
+// buildSvcProxyCmd builds the command for tunneling a local port to a service that is not public.
+func buildSvcProxyCmd() *cobra.Command {
+	vars := svcProxyVars{}
+	var skipPrompt bool
+	cmd := &cobra.Command{
+		Use:   "proxy",
+		Short: "Proxy a local port to the service discovery endpoint of a service.",
+		Long: `Proxy a local port to the service discovery endpoint of a service.
+Traffic is tunneled through a Session Manager session with a task of the environment, so that services
+that are only reachable from within the environment can be reached without a VPN or a bastion host.`,
+		Example: `
+  Reach the private "api" service of the "prod" environment from localhost.
+  /code $ copilot svc proxy -n api -e prod
+  Reach the "api" service from localhost:9000 through a task of the "frontend" service.
+  /code $ copilot svc proxy -n api -e prod --local-port 9000 --via frontend`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcProxyOpts(vars)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(yesFlag) {
+				opts.skipConfirmation = aws.Bool(false)
+				if skipPrompt {
+					opts.skipConfirmation = aws.Bool(true)
+				}
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", nameFlagDescription)
+	cmd.Flags().Uint16Var(&vars.localPort, localPortFlag, 0, proxyLocalPortFlagDescription)
+	cmd.Flags().StringVar(&vars.via, viaFlag, "", viaFlagDescription)
+	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,242 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcProxyMocks struct {
+	store            *mocks.Mockstore
+	sel              *mocks.MockdeploySelector
+	describer        *mocks.MockendpointDescriber
+	ssmPluginManager *mocks.MockssmPluginManager
+}
+
+func TestSvcProxyOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inVia      string
+		setupMocks func(m svcProxyMocks)
+
+		wantedError error
+	}{
+		"errors if the service to tunnel through does not exist": {
+			inVia: "frontend",
+			setupMocks: func(m svcProxyMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{}, nil)
+				m.store.EXPECT().GetService("my-app", "api").Return(&config.Workload{}, nil)
+				m.store.EXPECT().GetService("my-app", "frontend").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"validates the session manager plugin": {
+			setupMocks: func(m svcProxyMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{}, nil)
+				m.store.EXPECT().GetService("my-app", "api").Return(&config.Workload{}, nil)
+				m.ssmPluginManager.EXPECT().ValidateBinary().Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcProxyMocks{
+				store:            mocks.NewMockstore(ctrl),
+				ssmPluginManager: mocks.NewMockssmPluginManager(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcProxyOpts{
+				svcProxyVars: svcProxyVars{
+					appName: "my-app",
+					envName: "my-env",
+					name:    "api",
+					via:     tc.inVia,
+				},
+				store:            m.store,
+				ssmPluginManager: m.ssmPluginManager,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcProxyOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m svcProxyMocks)
+
+		wantedSvc   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"errors if failed to select the deployed service": {
+			setupMocks: func(m svcProxyMocks) {
+				m.sel.EXPECT().DeployedService(svcProxyNamePrompt, svcProxyNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed service for application my-app: some error"),
+		},
+		"success": {
+			setupMocks: func(m svcProxyMocks) {
+				m.sel.EXPECT().DeployedService(svcProxyNamePrompt, svcProxyNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Svc: "api",
+						Env: "prod",
+					}, nil)
+			},
+			wantedSvc: "api",
+			wantedEnv: "prod",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcProxyMocks{
+				sel: mocks.NewMockdeploySelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcProxyOpts{
+				svcProxyVars: svcProxyVars{
+					appName: "my-app",
+				},
+				sel: m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSvc, opts.name)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+		})
+	}
+}
+
+func TestSvcProxyOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inVia       string
+		inLocalPort uint16
+		setupMocks  func(m svcProxyMocks)
+		forwardErr  error
+
+		wantedForward *svcPortForwardVars
+		wantedError   error
+	}{
+		"errors if the endpoint can't be described": {
+			setupMocks: func(m svcProxyMocks) {
+				m.describer.EXPECT().URI("prod").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("get service discovery endpoint of service api in environment prod: some error"),
+		},
+		"errors if the service does not expose a port": {
+			setupMocks: func(m svcProxyMocks) {
+				m.describer.EXPECT().URI("prod").Return(describe.BlankServiceDiscoveryURI, nil)
+			},
+			wantedError: errors.New("service api does not expose a port to the other services of environment prod"),
+		},
+		"forwards the port of the service through one of its tasks": {
+			setupMocks: func(m svcProxyMocks) {
+				m.describer.EXPECT().URI("prod").Return("api.my-app.local:8080", nil)
+			},
+			wantedForward: &svcPortForwardVars{
+				appName:    "my-app",
+				envName:    "prod",
+				name:       "api",
+				localPort:  8080,
+				remotePort: 8080,
+				remoteHost: "api.my-app.local",
+			},
+		},
+		"forwards a custom local port through a task of another service": {
+			inVia:       "frontend",
+			inLocalPort: 9000,
+			setupMocks: func(m svcProxyMocks) {
+				m.describer.EXPECT().URI("prod").Return("api.my-app.local:8080", nil)
+			},
+			forwardErr: errors.New("some error"),
+			wantedForward: &svcPortForwardVars{
+				appName:    "my-app",
+				envName:    "prod",
+				name:       "frontend",
+				localPort:  9000,
+				remotePort: 8080,
+				remoteHost: "api.my-app.local",
+			},
+			wantedError: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcProxyMocks{
+				describer: mocks.NewMockendpointDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			var forwarded *svcPortForwardVars
+			opts := &svcProxyOpts{
+				svcProxyVars: svcProxyVars{
+					appName:          "my-app",
+					envName:          "prod",
+					name:             "api",
+					via:              tc.inVia,
+					localPort:        tc.inLocalPort,
+					skipConfirmation: aws.Bool(false),
+				},
+				newEndpointDescriber: func(app, svc string) (endpointDescriber, error) {
+					require.Equal(t, "my-app", app)
+					require.Equal(t, "api", svc)
+					return m.describer, nil
+				},
+				forward: func(vars svcPortForwardVars) error {
+					forwarded = &vars
+					return tc.forwardErr
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			require.Equal(t, tc.wantedForward, forwarded)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -147,7 +147,7 @@ func buildSvcStatusCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Shows status of a deployed service.",
-		Long:  "Shows status of a deployed service's task status, most recent deployment and alarm statuses.",
+		Long:  "Shows status of a deployed service's task status, most recent deployment, alarm statuses and Container Insights metrics.",
 
 		Example: `
   Shows status of the deployed service "my-svc"
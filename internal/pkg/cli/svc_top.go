@@ -0,0 +1,215 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	svcTopNamePrompt     = "Which service's resource usage would you like to monitor?"
+	svcTopNameHelpPrompt = "Displays the live CPU, memory and network utilization of each running task of the service."
+)
+
+type svcTopVars struct {
+	svcName string
+	envName string
+	appName string
+}
+
+type svcTopOpts struct {
+	svcTopVars
+
+	w                    termprogress.FileWriter
+	store                store
+	sel                  deploySelector
+	newSvcDescriber      func(*session.Session) serviceDescriber
+	newInsightsChecker   func(*session.Session) containerInsightsChecker
+	newTaskStatsStreamer func(sess *session.Session, cluster, service string) taskStatsStreamer
+}
+
+func newSvcTopOpts(vars svcTopVars) (*svcTopOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcTopOpts{
+		svcTopVars: vars,
+		w:          os.Stderr,
+		store:      configStore,
+		sel:        selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		newSvcDescriber: func(s *session.Session) serviceDescriber {
+			return ecs.New(s)
+		},
+		newInsightsChecker: func(s *session.Session) containerInsightsChecker {
+			return awsecs.New(s)
+		},
+		newTaskStatsStreamer: func(s *session.Session, cluster, service string) taskStatsStreamer {
+			return stream.NewECSTaskStatsStreamer(cloudwatchlogs.New(s), cluster, service)
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcTopOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcTopOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askSvcEnvName()
+}
+
+// Execute displays the resource utilization of each task of the service until the user interrupts the command.
+func (o *svcTopOpts) Execute() error {
+	sess, err := o.envSession()
+	if err != nil {
+		return err
+	}
+	svcDesc, err := o.newSvcDescriber(sess).DescribeService(o.appName, o.envName, o.svcName)
+	if err != nil {
+		return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.svcName, o.envName, err)
+	}
+	enabled, err := o.newInsightsChecker(sess).ContainerInsightsEnabled(svcDesc.ClusterName)
+	if err != nil {
+		return fmt.Errorf("check if Container Insights is enabled for cluster %s: %w", svcDesc.ClusterName, err)
+	}
+	if !enabled {
+		log.Infof("Enable Container Insights on the cluster by running %s\n",
+			color.HighlightCode(fmt.Sprintf("aws ecs update-cluster-settings --cluster %s --settings name=containerInsights,value=enabled", svcDesc.ClusterName)))
+		return fmt.Errorf("container insights is not enabled on cluster %s of environment %s", svcDesc.ClusterName, o.envName)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// Stop streaming on interrupt instead of exiting so that the terminal cursor is restored.
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt)
+	defer signal.Stop(sigCh)
+	go func() {
+		select {
+		case <-sigCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	streamer := o.newTaskStatsStreamer(sess, svcDesc.ClusterName, svcDesc.Name)
+	renderer := termprogress.ListeningTaskStatsRenderer(streamer, termprogress.RenderOptions{})
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		return stream.Stream(ctx, streamer)
+	})
+	g.Go(func() error {
+		return termprogress.Render(ctx, termprogress.NewTabbedFileWriter(o.w), renderer)
+	})
+	if err := g.Wait(); err != nil && !errors.Is(err, context.Canceled) {
+		return fmt.Errorf("stream resource usage of service %s: %w", o.svcName, err)
+	}
+	return nil
+}
+
+func (o *svcTopOpts) envSession() (*session.Session, error) {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	return sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+}
+
+func (o *svcTopOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcTopOpts) askSvcEnvName() error {
+	deployedService, err := o.sel.DeployedService(svcTopNamePrompt, svcTopNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcTopCmd builds the command for displaying the live resource usage of a service's tasks.
+func buildSvcTopCmd() *cobra.Command {
+	vars := svcTopVars{}
+	cmd := &cobra.Command{
+		Use:   "top",
+		Short: "Displays the live resource usage of a deployed service's tasks.",
+		Long: `Displays the live resource usage of a deployed service's tasks.
+The CPU, memory and network utilization of each running task is reported by Container Insights and refreshed until you press Ctrl-C.`,
+
+		Example: `
+  Displays the resource usage of the tasks of the service "my-svc" in the "test" environment.
+  /code $ copilot svc top -n my-svc -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcTopOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
@@ -0,0 +1,248 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcTopMocks struct {
+	store             *mocks.Mockstore
+	svcDescriber      *mocks.MockserviceDescriber
+	insightsChecker   *mocks.MockcontainerInsightsChecker
+	taskStatsStreamer *mocks.MocktaskStatsStreamer
+}
+
+type mockSvcTopFileWriter struct {
+	io.Writer
+}
+
+func (m mockSvcTopFileWriter) Fd() uintptr { return 0 }
+
+func TestSvcTop_Ask(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inputApp         string
+		inputSvc         string
+		inputEnvironment string
+		mockSelector     func(m *mocks.MockdeploySelector)
+
+		wantedApp   string
+		wantedSvc   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"errors if failed to select application": {
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(svcAppNamePrompt, svcAppNameHelpPrompt).Return("", mockError)
+			},
+
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"errors if failed to select deployed service": {
+			inputApp: "mockApp",
+
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(svcTopNamePrompt, svcTopNameHelpPrompt, "mockApp", gomock.Any(), gomock.Any()).
+					Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("select deployed services for application mockApp: some error"),
+		},
+		"success": {
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(svcAppNamePrompt, svcAppNameHelpPrompt).Return("mockApp", nil)
+				m.EXPECT().DeployedService(svcTopNamePrompt, svcTopNameHelpPrompt, "mockApp", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env: "mockEnv",
+						Svc: "mockSvc",
+					}, nil)
+			},
+
+			wantedApp: "mockApp",
+			wantedSvc: "mockSvc",
+			wantedEnv: "mockEnv",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSelector := mocks.NewMockdeploySelector(ctrl)
+			tc.mockSelector(mockSelector)
+
+			opts := &svcTopOpts{
+				svcTopVars: svcTopVars{
+					svcName: tc.inputSvc,
+					envName: tc.inputEnvironment,
+					appName: tc.inputApp,
+				},
+				sel: mockSelector,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.appName)
+				require.Equal(t, tc.wantedSvc, opts.svcName)
+				require.Equal(t, tc.wantedEnv, opts.envName)
+			}
+		})
+	}
+}
+
+func TestSvcTop_Execute(t *testing.T) {
+	mockError := errors.New("some error")
+	mockSvcDesc := &ecs.ServiceDesc{
+		ClusterName: "mockCluster",
+		Name:        "mockService",
+	}
+	testCases := map[string]struct {
+		setupMocks func(m svcTopMocks)
+
+		wantedError error
+	}{
+		"errors if failed to get environment": {
+			setupMocks: func(m svcTopMocks) {
+				m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get environment mockEnv: some error"),
+		},
+		"errors if failed to describe service": {
+			setupMocks: func(m svcTopMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil),
+					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(nil, mockError),
+				)
+			},
+
+			wantedError: fmt.Errorf("describe ECS service for mockSvc in environment mockEnv: some error"),
+		},
+		"errors if failed to check Container Insights": {
+			setupMocks: func(m svcTopMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil),
+					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil),
+					m.insightsChecker.EXPECT().ContainerInsightsEnabled("mockCluster").Return(false, mockError),
+				)
+			},
+
+			wantedError: fmt.Errorf("check if Container Insights is enabled for cluster mockCluster: some error"),
+		},
+		"errors if Container Insights is disabled": {
+			setupMocks: func(m svcTopMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil),
+					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil),
+					m.insightsChecker.EXPECT().ContainerInsightsEnabled("mockCluster").Return(false, nil),
+				)
+			},
+
+			wantedError: fmt.Errorf("container insights is not enabled on cluster mockCluster of environment mockEnv"),
+		},
+		"errors if failed to fetch task stats": {
+			setupMocks: func(m svcTopMocks) {
+				statsCh := make(chan []stream.ECSTaskStats)
+				gomock.InOrder(
+					m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil),
+					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil),
+					m.insightsChecker.EXPECT().ContainerInsightsEnabled("mockCluster").Return(true, nil),
+				)
+				m.taskStatsStreamer.EXPECT().Subscribe().Return(statsCh)
+				m.taskStatsStreamer.EXPECT().Done().Return(make(chan struct{})).AnyTimes()
+				m.taskStatsStreamer.EXPECT().Fetch().Return(time.Time{}, mockError)
+				m.taskStatsStreamer.EXPECT().Close().Do(func() { close(statsCh) })
+			},
+
+			wantedError: fmt.Errorf("stream resource usage of service mockSvc: some error"),
+		},
+		"stops rendering once the stream is done": {
+			setupMocks: func(m svcTopMocks) {
+				statsCh := make(chan []stream.ECSTaskStats)
+				done := make(chan struct{})
+				close(done)
+				gomock.InOrder(
+					m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil),
+					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil),
+					m.insightsChecker.EXPECT().ContainerInsightsEnabled("mockCluster").Return(true, nil),
+				)
+				m.taskStatsStreamer.EXPECT().Subscribe().Return(statsCh)
+				m.taskStatsStreamer.EXPECT().Done().Return(done).AnyTimes()
+				m.taskStatsStreamer.EXPECT().Fetch().Return(time.Time{}, nil).AnyTimes()
+				m.taskStatsStreamer.EXPECT().Notify().AnyTimes()
+				m.taskStatsStreamer.EXPECT().Close().Do(func() { close(statsCh) })
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := svcTopMocks{
+				store:             mocks.NewMockstore(ctrl),
+				svcDescriber:      mocks.NewMockserviceDescriber(ctrl),
+				insightsChecker:   mocks.NewMockcontainerInsightsChecker(ctrl),
+				taskStatsStreamer: mocks.NewMocktaskStatsStreamer(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &svcTopOpts{
+				svcTopVars: svcTopVars{
+					svcName: "mockSvc",
+					envName: "mockEnv",
+					appName: "mockApp",
+				},
+				w:     mockSvcTopFileWriter{Writer: &bytes.Buffer{}},
+				store: m.store,
+				newSvcDescriber: func(*session.Session) serviceDescriber {
+					return m.svcDescriber
+				},
+				newInsightsChecker: func(*session.Session) containerInsightsChecker {
+					return m.insightsChecker
+				},
+				newTaskStatsStreamer: func(_ *session.Session, cluster, service string) taskStatsStreamer {
+					require.Equal(t, "mockCluster", cluster)
+					require.Equal(t, "mockService", service)
+					return m.taskStatsStreamer
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
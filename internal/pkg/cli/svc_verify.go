@@ -0,0 +1,239 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/verify"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcVerifyNamePrompt     = "Which service would you like to verify?"
+	svcVerifyNameHelpPrompt = "Runs the HTTP checks in the verify section of the service's manifest against its endpoint."
+)
+
+// verifyRequestTimeout is the time after which a request of a check fails.
+const verifyRequestTimeout = 10 * time.Second
+
+type svcVerifyVars struct {
+	svcName string
+	envName string
+	appName string
+}
+
+type svcVerifyOpts struct {
+	svcVerifyVars
+
+	w         io.Writer
+	store     store
+	ws        svcManifestReader
+	unmarshal func([]byte) (interface{}, error)
+	sel       deploySelector
+	verifier  suiteVerifier
+
+	newEndpointDescriber func(*svcVerifyOpts) (endpointDescriber, error)
+}
+
+func newSvcVerifyOpts(vars svcVerifyVars) (*svcVerifyOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	return &svcVerifyOpts{
+		svcVerifyVars: vars,
+		w:             log.OutputWriter,
+		store:         configStore,
+		ws:            ws,
+		unmarshal:     manifest.UnmarshalWorkload,
+		sel:           selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		verifier:      newSuiteVerifier(),
+		newEndpointDescriber: func(o *svcVerifyOpts) (endpointDescriber, error) {
+			return describe.NewWebServiceDescriber(describe.NewWebServiceConfig{
+				NewServiceConfig: describe.NewServiceConfig{
+					App:         o.appName,
+					Svc:         o.svcName,
+					ConfigStore: configStore,
+				},
+			})
+		},
+	}, nil
+}
+
+func newSuiteVerifier() suiteVerifier {
+	return verify.New(&http.Client{Timeout: verifyRequestTimeout})
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcVerifyOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcVerifyOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askSvcEnvName()
+}
+
+// Execute runs the checks of the service's manifest against its endpoint and returns an error if any of them fails.
+func (o *svcVerifyOpts) Execute() error {
+	raw, err := o.ws.ReadServiceManifest(o.svcName)
+	if err != nil {
+		return fmt.Errorf("read service %s manifest file: %w", o.svcName, err)
+	}
+	mft, err := o.unmarshal(raw)
+	if err != nil {
+		return fmt.Errorf("unmarshal service %s manifest: %w", o.svcName, err)
+	}
+	conf, err := verifyConfig(mft, o.svcName, o.envName)
+	if err != nil {
+		return err
+	}
+	d, err := o.newEndpointDescriber(o)
+	if err != nil {
+		return fmt.Errorf("create describer for service %s: %w", o.svcName, err)
+	}
+	uri, err := d.URI(o.envName)
+	if err != nil {
+		return fmt.Errorf("get uri for environment %s: %w", o.envName, err)
+	}
+	return verifySvc(o.verifier, o.w, o.svcName, uri, conf)
+}
+
+// verifyConfig returns the verify section of the manifest of a Load Balanced Web Service with the environment's overrides.
+func verifyConfig(mft interface{}, svcName, envName string) (manifest.VerifyConfig, error) {
+	svc, ok := mft.(*manifest.LoadBalancedWebService)
+	if !ok {
+		return manifest.VerifyConfig{}, fmt.Errorf("service %s can't be verified: only a %s exposes an endpoint to check", svcName, manifest.LoadBalancedWebServiceType)
+	}
+	envSvc, err := svc.ApplyEnv(envName)
+	if err != nil {
+		return manifest.VerifyConfig{}, fmt.Errorf("apply environment %s override: %w", envName, err)
+	}
+	if len(envSvc.Verify.Checks) == 0 {
+		return manifest.VerifyConfig{}, fmt.Errorf("service %s has no checks to run: add them to the verify section of its manifest", svcName)
+	}
+	return envSvc.Verify, nil
+}
+
+// verifySvc runs the checks against the service at uri and writes the report to w.
+// It returns an error if any of the checks fails.
+func verifySvc(v suiteVerifier, w io.Writer, svcName, uri string, conf manifest.VerifyConfig) error {
+	suite := verify.Suite{
+		Requests:    aws.IntValue(conf.Requests),
+		Concurrency: aws.IntValue(conf.Concurrency),
+	}
+	if conf.LatencyBudget != nil {
+		suite.LatencyBudget = *conf.LatencyBudget
+	}
+	for _, check := range conf.Checks {
+		suite.Checks = append(suite.Checks, verify.Check{
+			Path:   check.Path,
+			Status: aws.IntValue(check.Status),
+		})
+	}
+	log.Infof("Verifying service %s at %s.\n", color.HighlightUserInput(svcName), color.HighlightResource(uri))
+	report := v.Run(context.Background(), uri, suite)
+	fmt.Fprint(w, report.HumanString())
+	if !report.Passed() {
+		return fmt.Errorf("%d of %d checks of service %s failed", report.Failed(), len(report), svcName)
+	}
+	log.Successf("All checks of service %s passed.\n", color.HighlightUserInput(svcName))
+	return nil
+}
+
+func (o *svcVerifyOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcVerifyOpts) askSvcEnvName() error {
+	deployedService, err := o.sel.DeployedService(svcVerifyNamePrompt, svcVerifyNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcVerifyCmd builds the command for running HTTP checks against a deployed service.
+func buildSvcVerifyCmd() *cobra.Command {
+	vars := svcVerifyVars{}
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Runs HTTP checks against a deployed service.",
+		Long: `Runs HTTP checks against a deployed service.
+The checks are defined in the verify section of the service's manifest.
+The command fails if a request gets an unexpected status code or if a check is over its latency budget.`,
+
+		Example: `
+  Verifies the service "frontend" in the "test" environment.
+  /code $ copilot svc verify -n frontend -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcVerifyOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
@@ -0,0 +1,255 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/verify"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcVerifyMocks struct {
+	ws        *mocks.MocksvcManifestReader
+	describer *mocks.MockendpointDescriber
+	verifier  *mocks.MocksuiteVerifier
+}
+
+func TestSvcVerify_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp         string
+		inputSvc         string
+		inputEnvironment string
+		mockSelector     func(m *mocks.MockdeploySelector)
+
+		wantedApp   string
+		wantedSvc   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"errors if failed to select application": {
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(svcAppNamePrompt, svcAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"errors if failed to select deployed service": {
+			inputApp: "phonetool",
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(svcVerifyNamePrompt, svcVerifyNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("select deployed services for application phonetool: some error"),
+		},
+		"success": {
+			inputApp: "phonetool",
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(svcVerifyNamePrompt, svcVerifyNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env: "test",
+						Svc: "frontend",
+					}, nil)
+			},
+
+			wantedApp: "phonetool",
+			wantedSvc: "frontend",
+			wantedEnv: "test",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSelector := mocks.NewMockdeploySelector(ctrl)
+			tc.mockSelector(mockSelector)
+			opts := &svcVerifyOpts{
+				svcVerifyVars: svcVerifyVars{
+					appName: tc.inputApp,
+					svcName: tc.inputSvc,
+					envName: tc.inputEnvironment,
+				},
+				sel: mockSelector,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+		})
+	}
+}
+
+func TestSvcVerify_Execute(t *testing.T) {
+	const frontendManifest = `name: frontend
+type: Load Balanced Web Service
+image:
+  build: frontend/Dockerfile
+  port: 80
+http:
+  path: '/'
+verify:
+  checks:
+    - path: /
+    - path: /admin
+      status: 401
+  requests: 50
+  concurrency: 5
+  latency_budget: 500ms
+environments:
+  prod:
+    verify:
+      checks:
+        - path: /
+      latency_budget: 1s
+`
+	const backendManifest = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 80
+`
+	const uncheckedManifest = `name: frontend
+type: Load Balanced Web Service
+image:
+  build: frontend/Dockerfile
+  port: 80
+http:
+  path: '/'
+`
+	passedReport := verify.Report{
+		{Check: verify.Check{Path: "/", Status: 200}, Requests: 50},
+	}
+	failedReport := verify.Report{
+		{Check: verify.Check{Path: "/", Status: 200}, Requests: 50, Failures: 2, FirstFailure: "got status 503"},
+		{Check: verify.Check{Path: "/admin", Status: 401}, Requests: 50},
+	}
+	testCases := map[string]struct {
+		inEnv      string
+		setupMocks func(m svcVerifyMocks)
+
+		wantedError error
+	}{
+		"errors if the manifest can't be read": {
+			inEnv: "test",
+			setupMocks: func(m svcVerifyMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("read service frontend manifest file: some error"),
+		},
+		"errors if the service isn't a Load Balanced Web Service": {
+			inEnv: "test",
+			setupMocks: func(m svcVerifyMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(backendManifest), nil)
+			},
+			wantedError: errors.New("service frontend can't be verified: only a Load Balanced Web Service exposes an endpoint to check"),
+		},
+		"errors if the manifest doesn't have checks": {
+			inEnv: "test",
+			setupMocks: func(m svcVerifyMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(uncheckedManifest), nil)
+			},
+			wantedError: errors.New("service frontend has no checks to run: add them to the verify section of its manifest"),
+		},
+		"errors if the endpoint of the service can't be described": {
+			inEnv: "test",
+			setupMocks: func(m svcVerifyMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(frontendManifest), nil)
+				m.describer.EXPECT().URI("test").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("get uri for environment test: some error"),
+		},
+		"errors if a check fails": {
+			inEnv: "test",
+			setupMocks: func(m svcVerifyMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(frontendManifest), nil)
+				m.describer.EXPECT().URI("test").Return("http://my-lb.us-west-2.elb.amazonaws.com", nil)
+				m.verifier.EXPECT().Run(gomock.Any(), "http://my-lb.us-west-2.elb.amazonaws.com", verify.Suite{
+					Checks: []verify.Check{
+						{Path: "/"},
+						{Path: "/admin", Status: 401},
+					},
+					Requests:      50,
+					Concurrency:   5,
+					LatencyBudget: 500 * time.Millisecond,
+				}).Return(failedReport)
+			},
+			wantedError: errors.New("1 of 2 checks of service frontend failed"),
+		},
+		"runs the checks of the environment": {
+			inEnv: "prod",
+			setupMocks: func(m svcVerifyMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(frontendManifest), nil)
+				m.describer.EXPECT().URI("prod").Return("https://frontend.prod.phonetool.example.com", nil)
+				m.verifier.EXPECT().Run(gomock.Any(), "https://frontend.prod.phonetool.example.com", verify.Suite{
+					Checks: []verify.Check{
+						{Path: "/"},
+					},
+					Requests:      50,
+					Concurrency:   5,
+					LatencyBudget: time.Second,
+				}).Return(passedReport)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcVerifyMocks{
+				ws:        mocks.NewMocksvcManifestReader(ctrl),
+				describer: mocks.NewMockendpointDescriber(ctrl),
+				verifier:  mocks.NewMocksuiteVerifier(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &svcVerifyOpts{
+				svcVerifyVars: svcVerifyVars{
+					appName: "phonetool",
+					svcName: "frontend",
+					envName: tc.inEnv,
+				},
+				w:         b,
+				ws:        m.ws,
+				unmarshal: manifest.UnmarshalWorkload,
+				verifier:  m.verifier,
+				newEndpointDescriber: func(*svcVerifyOpts) (endpointDescriber, error) {
+					return m.describer, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Contains(t, b.String(), "Requests")
+		})
+	}
+}
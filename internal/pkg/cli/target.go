@@ -0,0 +1,75 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const (
+	fmtTargetChangedConfirmPrompt = "Environment %s was last modified from this machine as %s in account %s from region %s. Are you sure you want to continue?"
+	targetChangedConfirmHelp      = "The credentials in use belong to a different identity, account or region than the last time. Make sure that you are using the intended AWS profile."
+)
+
+var errTargetNotConfirmed = errors.New("target not confirmed - no changes made")
+
+// targetGuard confirms the credentials that a command modifies an environment with.
+type targetGuard struct {
+	targets  targetStore
+	prompt   prompter
+	identity identityService
+	region   string // Region of the session that the command reads the application's configuration from.
+}
+
+// newTargetGuard returns a targetGuard for the credentials of sess.
+func newTargetGuard(sess *session.Session, p prompter) (*targetGuard, error) {
+	targets, err := config.NewTargetStore()
+	if err != nil {
+		return nil, fmt.Errorf("new target store: %w", err)
+	}
+	return &targetGuard{
+		targets:  targets,
+		prompt:   p,
+		identity: identity.New(sess),
+		region:   aws.StringValue(sess.Config.Region),
+	}, nil
+}
+
+// Confirm prints the identity, account and region of the credentials that are about to modify the environment.
+// If they differ from the ones last used for the environment on this machine, it asks for confirmation unless skipConfirmation is true.
+// The target is then recorded as the last one used for the environment.
+func (g *targetGuard) Confirm(env *config.Environment, skipConfirmation bool) error {
+	caller, err := g.identity.Get()
+	if err != nil {
+		return fmt.Errorf("get identity of the credentials: %w", err)
+	}
+	target := config.NewTarget(caller, g.region)
+	log.Infof("Targeting environment %s as %s in account %s from region %s.\n",
+		color.HighlightUserInput(env.Name), color.HighlightResource(target.ARN), color.HighlightResource(target.Account), color.HighlightResource(target.Region))
+	last, err := g.targets.LastTarget(env.App, env.Name)
+	if err != nil {
+		return fmt.Errorf("get last target of environment %s: %w", env.Name, err)
+	}
+	if last != nil && *last != target && !skipConfirmation {
+		confirmed, err := g.prompt.Confirm(fmt.Sprintf(fmtTargetChangedConfirmPrompt, env.Name, last.ARN, last.Account, last.Region), targetChangedConfirmHelp)
+		if err != nil {
+			return fmt.Errorf("confirm target of environment %s: %w", env.Name, err)
+		}
+		if !confirmed {
+			return errTargetNotConfirmed
+		}
+	}
+	if err := g.targets.SaveTarget(env.App, env.Name, target); err != nil {
+		return fmt.Errorf("save target of environment %s: %w", env.Name, err)
+	}
+	return nil
+}
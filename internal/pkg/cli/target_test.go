@@ -0,0 +1,173 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type targetGuardMocks struct {
+	targets  *mocks.MocktargetStore
+	prompt   *mocks.Mockprompter
+	identity *mocks.MockidentityService
+}
+
+func TestTargetGuard_Confirm(t *testing.T) {
+	env := &config.Environment{
+		App:    "phonetool",
+		Name:   "prod",
+		Region: "us-east-1",
+	}
+	caller := identity.Caller{
+		Account: "5678",
+		ARN:     "arn:aws:sts::5678:assumed-role/Admin/alice",
+	}
+	wantedTarget := config.Target{
+		Account: "5678",
+		ARN:     "arn:aws:sts::5678:assumed-role/Admin",
+		Region:  "us-west-2",
+	}
+	otherTarget := &config.Target{
+		Account: "1234",
+		ARN:     "arn:aws:sts::1234:assumed-role/Admin",
+		Region:  "us-west-2",
+	}
+	testCases := map[string]struct {
+		inSkipConfirmation bool
+		setupMocks         func(m targetGuardMocks)
+
+		wantedErr error
+	}{
+		"wraps the error of resolving the identity of the credentials": {
+			setupMocks: func(m targetGuardMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{}, errors.New("some error"))
+				m.targets.EXPECT().LastTarget(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("get identity of the credentials: some error"),
+		},
+		"wraps the error of reading the last target": {
+			setupMocks: func(m targetGuardMocks) {
+				m.identity.EXPECT().Get().Return(caller, nil)
+				m.targets.EXPECT().LastTarget("phonetool", "prod").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get last target of environment prod: some error"),
+		},
+		"records the target without confirmation the first time": {
+			setupMocks: func(m targetGuardMocks) {
+				m.identity.EXPECT().Get().Return(caller, nil)
+				m.targets.EXPECT().LastTarget("phonetool", "prod").Return(nil, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+				m.targets.EXPECT().SaveTarget("phonetool", "prod", wantedTarget).Return(nil)
+			},
+		},
+		"does not ask for confirmation if the target is unchanged": {
+			setupMocks: func(m targetGuardMocks) {
+				last := wantedTarget
+				m.identity.EXPECT().Get().Return(caller, nil)
+				m.targets.EXPECT().LastTarget("phonetool", "prod").Return(&last, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+				m.targets.EXPECT().SaveTarget("phonetool", "prod", wantedTarget).Return(nil)
+			},
+		},
+		"does not ask for confirmation if only the role session name changed": {
+			setupMocks: func(m targetGuardMocks) {
+				last := wantedTarget // Recorded with the "alice" session of the role.
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					Account: "5678",
+					ARN:     "arn:aws:sts::5678:assumed-role/Admin/aws-go-sdk-1602547200000000000",
+				}, nil)
+				m.targets.EXPECT().LastTarget("phonetool", "prod").Return(&last, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+				m.targets.EXPECT().SaveTarget("phonetool", "prod", wantedTarget).Return(nil)
+			},
+		},
+		"does not ask for confirmation if --yes is set": {
+			inSkipConfirmation: true,
+			setupMocks: func(m targetGuardMocks) {
+				m.identity.EXPECT().Get().Return(caller, nil)
+				m.targets.EXPECT().LastTarget("phonetool", "prod").Return(otherTarget, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+				m.targets.EXPECT().SaveTarget("phonetool", "prod", wantedTarget).Return(nil)
+			},
+		},
+		"returns an error if the user does not confirm the new target": {
+			setupMocks: func(m targetGuardMocks) {
+				m.identity.EXPECT().Get().Return(caller, nil)
+				m.targets.EXPECT().LastTarget("phonetool", "prod").Return(otherTarget, nil)
+				m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtTargetChangedConfirmPrompt, "prod", "arn:aws:sts::1234:assumed-role/Admin", "1234", "us-west-2"), targetChangedConfirmHelp).Return(false, nil)
+				m.targets.EXPECT().SaveTarget(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErr: errTargetNotConfirmed,
+		},
+		"wraps the error of the confirmation prompt": {
+			setupMocks: func(m targetGuardMocks) {
+				m.identity.EXPECT().Get().Return(caller, nil)
+				m.targets.EXPECT().LastTarget("phonetool", "prod").Return(otherTarget, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(false, errors.New("some error"))
+			},
+			wantedErr: errors.New("confirm target of environment prod: some error"),
+		},
+		"records the new target once confirmed": {
+			setupMocks: func(m targetGuardMocks) {
+				m.identity.EXPECT().Get().Return(caller, nil)
+				m.targets.EXPECT().LastTarget("phonetool", "prod").Return(otherTarget, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil)
+				m.targets.EXPECT().SaveTarget("phonetool", "prod", wantedTarget).Return(nil)
+			},
+		},
+		"wraps the error of recording the target": {
+			setupMocks: func(m targetGuardMocks) {
+				m.identity.EXPECT().Get().Return(caller, nil)
+				m.targets.EXPECT().LastTarget("phonetool", "prod").Return(nil, nil)
+				m.targets.EXPECT().SaveTarget("phonetool", "prod", wantedTarget).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("save target of environment prod: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := targetGuardMocks{
+				targets:  mocks.NewMocktargetStore(ctrl),
+				prompt:   mocks.NewMockprompter(ctrl),
+				identity: mocks.NewMockidentityService(ctrl),
+			}
+			tc.setupMocks(m)
+			guard := &targetGuard{
+				targets:  m.targets,
+				prompt:   m.prompt,
+				identity: m.identity,
+				region:   "us-west-2",
+			}
+
+			// WHEN
+			err := guard.Confirm(env, tc.inSkipConfirmation)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// mockConfirmedTarget returns a targetConfirmer that confirms the target of every environment.
+func mockConfirmedTarget(ctrl *gomock.Controller) *mocks.MocktargetConfirmer {
+	m := mocks.NewMocktargetConfirmer(ctrl)
+	m.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return m
+}
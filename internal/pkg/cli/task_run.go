@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -92,7 +93,8 @@ type runTaskVars struct {
 	entrypoint   string
 	resourceTags map[string]string
 
-	follow bool
+	follow           bool
+	shouldOutputJSON bool
 }
 
 type runTaskOpts struct {
@@ -104,6 +106,7 @@ type runTaskOpts struct {
 	store   store
 	sel     appEnvSelector
 	spinner progress
+	w       io.Writer
 
 	// Fields below are configured at runtime.
 	deployer             taskDeployer
@@ -136,6 +139,7 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 		store:   store,
 		sel:     selector.NewSelect(prompt.New(), store),
 		spinner: termprogress.NewSpinner(log.DiagnosticWriter),
+		w:       log.OutputWriter,
 	}
 
 	opts.configureRuntimeOpts = func() error {
@@ -269,6 +273,11 @@ func (o *runTaskOpts) Validate() error {
 		return errors.New("cannot specify both `--image` and `--dockerfile`")
 	}
 
+	if o.follow && o.shouldOutputJSON {
+		// The logs of the tasks are written to stdout.
+		return errors.New("cannot specify both `--follow` and `--json`")
+	}
+
 	if o.isDockerfileSet {
 		if _, err := o.fs.Stat(o.dockerfilePath); err != nil {
 			return err
@@ -470,7 +479,11 @@ func (o *runTaskOpts) Execute() error {
 		return err
 	}
 
-	o.showPublicIPs(tasks)
+	publicIPs := o.publicIPs(tasks)
+	if o.shouldOutputJSON {
+		return o.writeJSON(tasks, publicIPs)
+	}
+	o.showPublicIPs(publicIPs)
 
 	if o.follow {
 		o.configureEventsWriter(tasks)
@@ -503,7 +516,8 @@ func (o *runTaskOpts) runTask() ([]*task.Task, error) {
 	return tasks, nil
 }
 
-func (o *runTaskOpts) showPublicIPs(tasks []*task.Task) {
+// publicIPs returns the public IP address of each task by ARN, if the task has one.
+func (o *runTaskOpts) publicIPs(tasks []*task.Task) map[string]string {
 	publicIPs := make(map[string]string)
 	for _, t := range tasks {
 		if t.ENI == "" {
@@ -514,7 +528,27 @@ func (o *runTaskOpts) showPublicIPs(tasks []*task.Task) {
 			publicIPs[t.TaskARN] = ip
 		}
 	}
+	return publicIPs
+}
+
+// writeJSON writes the running tasks to stdout as JSON.
+func (o *runTaskOpts) writeJSON(tasks []*task.Task, publicIPs map[string]string) error {
+	out := runTaskOutput{
+		Group: o.groupName,
+		Tasks: make([]runTaskOutputTask, len(tasks)),
+	}
+	for i, t := range tasks {
+		out.Tasks[i] = runTaskOutputTask{
+			ARN:       t.TaskARN,
+			Cluster:   t.ClusterARN,
+			StartedAt: t.StartedAt,
+			PublicIP:  publicIPs[t.TaskARN],
+		}
+	}
+	return writeJSON(o.w, out)
+}
 
+func (o *runTaskOpts) showPublicIPs(publicIPs map[string]string) {
 	if len(publicIPs) == 0 {
 		return
 	}
@@ -538,7 +572,11 @@ func (o *runTaskOpts) buildAndPushImage() error {
 		additionalTags = append(additionalTags, o.imageTag)
 	}
 
-	if _, err := o.repository.BuildAndPush(exec.NewDockerCommand(), &exec.BuildArguments{
+	engine, err := exec.NewContainerEngineCommand()
+	if err != nil {
+		return fmt.Errorf("detect container engine: %w", err)
+	}
+	if _, err := o.repository.BuildAndPush(engine, &exec.BuildArguments{
 		Dockerfile: o.dockerfilePath,
 		Context:    filepath.Dir(o.dockerfilePath),
 		Tags:       append([]string{imageTagLatest}, additionalTags...),
@@ -694,6 +732,7 @@ Run a task with a command.
 			if cmd.Flags().Changed(dockerFileFlag) {
 				opts.isDockerfileSet = true
 			}
+			opts.shouldOutputJSON = shouldOutputJSON(cmd)
 
 			if err := opts.Validate(); err != nil {
 				return err
@@ -61,6 +61,8 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 		inEntryPoint string
 
 		inDefault bool
+		inFollow  bool
+		inJSON    bool
 
 		appName         string
 		isDockerfileSet bool
@@ -302,6 +304,14 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 			wantedError: errors.New("cannot specify both `--env` and `--cluster`"),
 		},
+		"both follow and json specified": {
+			basicOpts: defaultOpts,
+
+			inFollow: true,
+			inJSON:   true,
+
+			wantedError: errors.New("cannot specify both `--follow` and `--json`"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -330,6 +340,8 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 					command:                     tc.inCommand,
 					entrypoint:                  tc.inEntryPoint,
 					useDefaultSubnetsAndCluster: tc.inDefault,
+					follow:                      tc.inFollow,
+					shouldOutputJSON:            tc.inJSON,
 				},
 				isDockerfileSet: tc.isDockerfileSet,
 
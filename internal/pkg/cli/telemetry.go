@@ -0,0 +1,299 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/telemetry"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	telemetryEndpointFlag            = "endpoint"
+	telemetryEndpointFlagDescription = `Optional. HTTPS URL that the usage metrics aggregated by command are sent to once a day.
+Metrics are only kept on this machine if empty.`
+)
+
+// Format of the table of "telemetry show".
+const (
+	minCellWidth           = 20  // minimum number of characters in a table's cell.
+	tabWidth               = 4   // number of characters in between columns.
+	cellPaddingWidth       = 2   // number of padding characters added by default to a cell.
+	paddingChar            = ' ' // character in between columns.
+	noAdditionalFormatting = 0
+)
+
+type telemetryStore interface {
+	Settings() (*telemetry.Settings, error)
+	SaveSettings(settings telemetry.Settings) error
+	Events() ([]telemetry.Event, error)
+}
+
+type enableTelemetryOpts struct {
+	endpoint string
+
+	store telemetryStore
+	now   func() time.Time
+}
+
+// Validate returns an error if the endpoint isn't an HTTPS URL.
+func (o *enableTelemetryOpts) Validate() error {
+	if o.endpoint != "" && !strings.HasPrefix(o.endpoint, "https://") {
+		return fmt.Errorf("--%s %s must start with https://", telemetryEndpointFlag, o.endpoint)
+	}
+	return nil
+}
+
+// Execute opts in to recording usage metrics.
+func (o *enableTelemetryOpts) Execute() error {
+	if err := o.store.SaveSettings(telemetry.Settings{
+		Enabled:  true,
+		Endpoint: o.endpoint,
+		LastSent: o.now(),
+	}); err != nil {
+		return fmt.Errorf("enable telemetry: %w", err)
+	}
+	log.Successln("Copilot now records the commands you run and the category of their failures on this machine.")
+	if o.endpoint != "" {
+		log.Infof("The metrics aggregated by command are sent to %s once a day.\n", color.HighlightResource(o.endpoint))
+	}
+	return nil
+}
+
+type disableTelemetryOpts struct {
+	store telemetryStore
+}
+
+// Execute opts out of recording usage metrics and removes the metrics recorded so far.
+func (o *disableTelemetryOpts) Execute() error {
+	if err := o.store.SaveSettings(telemetry.Settings{}); err != nil {
+		return fmt.Errorf("disable telemetry: %w", err)
+	}
+	log.Successln("Copilot no longer records usage metrics, and the metrics recorded on this machine were removed.")
+	return nil
+}
+
+type showTelemetryOpts struct {
+	shouldOutputJSON bool
+
+	store telemetryStore
+	w     io.Writer
+}
+
+type showTelemetryOutput struct {
+	Enabled  bool                       `json:"enabled"`
+	Endpoint string                     `json:"endpoint,omitempty"`
+	Commands []telemetry.CommandSummary `json:"commands"`
+}
+
+// Execute writes the telemetry settings and the usage metrics recorded on this machine.
+func (o *showTelemetryOpts) Execute() error {
+	settings, err := o.store.Settings()
+	if err != nil {
+		return fmt.Errorf("get telemetry settings: %w", err)
+	}
+	events, err := o.store.Events()
+	if err != nil {
+		return fmt.Errorf("get telemetry events: %w", err)
+	}
+	summaries := telemetry.Summarize(events)
+	if o.shouldOutputJSON {
+		return writeJSON(o.w, showTelemetryOutput{
+			Enabled:  settings.Enabled,
+			Endpoint: settings.Endpoint,
+			Commands: summaries,
+		})
+	}
+
+	status := "disabled"
+	if settings.Enabled {
+		status = "enabled"
+	}
+	endpoint := settings.Endpoint
+	if endpoint == "" {
+		endpoint = "none, metrics are only kept on this machine"
+	}
+	fmt.Fprintf(o.w, "Telemetry: %s\nEndpoint: %s\n", status, endpoint)
+	if len(summaries) == 0 {
+		fmt.Fprintln(o.w, "\nNo commands were recorded.")
+		return nil
+	}
+	fmt.Fprintf(o.w, "\nCommands since %s\n", events[0].Timestamp.Format(time.RFC3339))
+	tw := tabwriter.NewWriter(o.w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintln(tw, "  Command\tRuns\tFailures\tFailure categories")
+	fmt.Fprintln(tw, "  -------\t----\t--------\t------------------")
+	for _, s := range summaries {
+		fmt.Fprintf(tw, "  %s\t%d\t%d\t%s\n", s.Command, s.Total(), s.Total()-s.Successes, failureCategories(s.Failures))
+	}
+	return tw.Flush()
+}
+
+// failureCategories returns the categories from the most to the least frequent, ex: "credentials (3), network (1)".
+func failureCategories(failures map[string]int) string {
+	if len(failures) == 0 {
+		return "-"
+	}
+	var categories []string
+	for category := range failures {
+		categories = append(categories, category)
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		if failures[categories[i]] != failures[categories[j]] {
+			return failures[categories[i]] > failures[categories[j]]
+		}
+		return categories[i] < categories[j]
+	})
+	for i, category := range categories {
+		categories[i] = fmt.Sprintf("%s (%d)", category, failures[category])
+	}
+	return strings.Join(categories, ", ")
+}
+
+// RecordUsage records the command that ran if users opted in to telemetry.
+// Errors are ignored since usage metrics must never interrupt users.
+func RecordUsage(cmd *cobra.Command, start time.Time, cmdErr error) {
+	event, ok := usageEvent(cmd, start, time.Now(), cmdErr)
+	if !ok {
+		return
+	}
+	store, err := telemetry.NewStore()
+	if err != nil {
+		return
+	}
+	_ = store.Record(event)
+}
+
+// usageEvent returns the anonymous event of the command, or false if the command shouldn't be recorded.
+func usageEvent(cmd *cobra.Command, start, end time.Time, cmdErr error) (telemetry.Event, bool) {
+	if cmd == nil || !cmd.HasParent() {
+		return telemetry.Event{}, false
+	}
+	path := strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+	switch strings.Fields(path)[0] {
+	case "telemetry", "help", "completion", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+		return telemetry.Event{}, false
+	}
+	event := telemetry.Event{
+		Command:    path,
+		Outcome:    telemetry.OutcomeSuccess,
+		DurationMs: end.Sub(start).Milliseconds(),
+		Version:    version.Version,
+		OS:         runtime.GOOS,
+		Timestamp:  end.UTC(),
+	}
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		event.Flags = append(event.Flags, f.Name)
+	})
+	if cmdErr != nil {
+		event.Outcome = telemetry.OutcomeFailure
+		event.Category = telemetry.Categorize(cmdErr)
+	}
+	return event, true
+}
+
+func buildTelemetryEnableCmd() *cobra.Command {
+	opts := &enableTelemetryOpts{}
+	cmd := &cobra.Command{
+		Use:   "enable",
+		Short: "Opt in to recording anonymous usage metrics.",
+		Long: `Opt in to recording anonymous usage metrics.
+Copilot records the name of each command, the names of its flags, its duration and the category of its failure.
+Argument and flag values are never recorded.`,
+		Example: `
+  Record usage metrics on this machine only
+  /code $ copilot telemetry enable
+
+  Also send the metrics aggregated by command to your team's endpoint
+  /code $ copilot telemetry enable --endpoint https://metrics.example.com/copilot`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			store, err := telemetry.NewStore()
+			if err != nil {
+				return err
+			}
+			opts.store = store
+			opts.now = time.Now
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVar(&opts.endpoint, telemetryEndpointFlag, "", telemetryEndpointFlagDescription)
+	return cmd
+}
+
+func buildTelemetryDisableCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "disable",
+		Short: "Opt out of recording usage metrics.",
+		Long: `Opt out of recording usage metrics.
+The metrics recorded on this machine are removed.`,
+		Example: `
+  Stop recording usage metrics
+  /code $ copilot telemetry disable`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			store, err := telemetry.NewStore()
+			if err != nil {
+				return err
+			}
+			return (&disableTelemetryOpts{store: store}).Execute()
+		}),
+	}
+}
+
+func buildTelemetryShowCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show",
+		Short: "Show the usage metrics recorded on this machine.",
+		Long: `Show the usage metrics recorded on this machine.
+Commands are sorted from the one that failed the most.`,
+		Example: `
+  Show which commands fail the most
+  /code $ copilot telemetry show`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			store, err := telemetry.NewStore()
+			if err != nil {
+				return err
+			}
+			opts := &showTelemetryOpts{
+				shouldOutputJSON: shouldOutputJSON(cmd),
+				store:            store,
+				w:                os.Stdout,
+			}
+			return opts.Execute()
+		}),
+	}
+}
+
+// BuildTelemetryCmd is the top level command for usage metrics.
+func BuildTelemetryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "telemetry",
+		Short: "Commands for anonymous usage metrics.",
+		Long: `Commands for anonymous usage metrics.
+Usage metrics are only recorded once you opt in with "copilot telemetry enable".`,
+	}
+	cmd.AddCommand(buildTelemetryEnableCmd())
+	cmd.AddCommand(buildTelemetryDisableCmd())
+	cmd.AddCommand(buildTelemetryShowCmd())
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Settings,
+	}
+	return cmd
+}
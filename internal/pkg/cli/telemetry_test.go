@@ -0,0 +1,252 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/telemetry"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnableTelemetryOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inEndpoint string
+
+		wantedErr error
+	}{
+		"valid without an endpoint": {},
+		"valid with an HTTPS endpoint": {
+			inEndpoint: "https://metrics.example.com/copilot",
+		},
+		"invalid with an HTTP endpoint": {
+			inEndpoint: "http://metrics.example.com/copilot",
+			wantedErr:  errors.New("--endpoint http://metrics.example.com/copilot must start with https://"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &enableTelemetryOpts{endpoint: tc.inEndpoint}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEnableTelemetryOpts_Execute(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	now := time.Date(2021, 3, 2, 10, 0, 0, 0, time.UTC)
+	mockStore := mocks.NewMocktelemetryStore(ctrl)
+	mockStore.EXPECT().SaveSettings(telemetry.Settings{
+		Enabled:  true,
+		Endpoint: "https://metrics.example.com/copilot",
+		LastSent: now,
+	}).Return(nil)
+	opts := &enableTelemetryOpts{
+		endpoint: "https://metrics.example.com/copilot",
+		store:    mockStore,
+		now:      func() time.Time { return now },
+	}
+
+	// WHEN
+	err := opts.Execute()
+
+	// THEN
+	require.NoError(t, err)
+}
+
+func TestDisableTelemetryOpts_Execute(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStore := mocks.NewMocktelemetryStore(ctrl)
+	mockStore.EXPECT().SaveSettings(telemetry.Settings{}).Return(errors.New("some error"))
+	opts := &disableTelemetryOpts{store: mockStore}
+
+	// WHEN
+	err := opts.Execute()
+
+	// THEN
+	require.EqualError(t, err, "disable telemetry: some error")
+}
+
+func TestShowTelemetryOpts_Execute(t *testing.T) {
+	events := []telemetry.Event{
+		{Command: "svc ls", Outcome: telemetry.OutcomeSuccess, Timestamp: time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC)},
+		{Command: "svc deploy", Outcome: telemetry.OutcomeFailure, Category: telemetry.CategoryCredentials},
+		{Command: "svc deploy", Outcome: telemetry.OutcomeFailure, Category: telemetry.CategoryNetwork},
+		{Command: "svc deploy", Outcome: telemetry.OutcomeFailure, Category: telemetry.CategoryCredentials},
+	}
+	testCases := map[string]struct {
+		inJSON     bool
+		setupMocks func(m *mocks.MocktelemetryStore)
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"wraps the settings error": {
+			setupMocks: func(m *mocks.MocktelemetryStore) {
+				m.EXPECT().Settings().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get telemetry settings: some error"),
+		},
+		"no recorded commands": {
+			setupMocks: func(m *mocks.MocktelemetryStore) {
+				m.EXPECT().Settings().Return(&telemetry.Settings{}, nil)
+				m.EXPECT().Events().Return(nil, nil)
+			},
+			wantedOutput: `Telemetry: disabled
+Endpoint: none, metrics are only kept on this machine
+
+No commands were recorded.
+`,
+		},
+		"writes the commands from the one that failed the most": {
+			setupMocks: func(m *mocks.MocktelemetryStore) {
+				m.EXPECT().Settings().Return(&telemetry.Settings{Enabled: true, Endpoint: "https://metrics.example.com"}, nil)
+				m.EXPECT().Events().Return(events, nil)
+			},
+			wantedOutput: `Telemetry: enabled
+Endpoint: https://metrics.example.com
+
+Commands since 2021-03-01T09:00:00Z
+  Command           Runs                Failures            Failure categories
+  -------           ----                --------            ------------------
+  svc deploy        3                   3                   credentials (2), network (1)
+  svc ls            1                   0                   -
+`,
+		},
+		"writes JSON": {
+			inJSON: true,
+			setupMocks: func(m *mocks.MocktelemetryStore) {
+				m.EXPECT().Settings().Return(&telemetry.Settings{Enabled: true}, nil)
+				m.EXPECT().Events().Return(events, nil)
+			},
+			wantedOutput: `{"enabled":true,"commands":[{"command":"svc deploy","successes":0,"failures":{"credentials":2,"network":1}},{"command":"svc ls","successes":1}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMocktelemetryStore(ctrl)
+			tc.setupMocks(mockStore)
+			b := &strings.Builder{}
+			opts := &showTelemetryOpts{
+				shouldOutputJSON: tc.inJSON,
+				store:            mockStore,
+				w:                b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
+
+func TestUsageEvent(t *testing.T) {
+	start := time.Date(2021, 3, 2, 10, 0, 0, 0, time.UTC)
+	end := start.Add(1500 * time.Millisecond)
+	newCmds := func() (root, svcDeploy, telemetryShow *cobra.Command) {
+		root = &cobra.Command{Use: "copilot"}
+		svc := &cobra.Command{Use: "svc"}
+		svcDeploy = &cobra.Command{Use: "deploy", Run: func(cmd *cobra.Command, args []string) {}}
+		svcDeploy.Flags().String(nameFlag, "", "")
+		svcDeploy.Flags().String(envFlag, "", "")
+		svcDeploy.Flags().Bool(yesFlag, false, "")
+		svc.AddCommand(svcDeploy)
+		tel := &cobra.Command{Use: "telemetry"}
+		telemetryShow = &cobra.Command{Use: "show", Run: func(cmd *cobra.Command, args []string) {}}
+		tel.AddCommand(telemetryShow)
+		root.AddCommand(svc, tel)
+		return root, svcDeploy, telemetryShow
+	}
+	testCases := map[string]struct {
+		pickCmd func(root, svcDeploy, telemetryShow *cobra.Command) *cobra.Command
+		inErr   error
+
+		wantedEvent *telemetry.Event
+	}{
+		"does not record the root command": {
+			pickCmd: func(root, _, _ *cobra.Command) *cobra.Command { return root },
+		},
+		"does not record telemetry commands": {
+			pickCmd: func(_, _, telemetryShow *cobra.Command) *cobra.Command { return telemetryShow },
+		},
+		"records the names of the flags that were set but never their values": {
+			pickCmd: func(_, svcDeploy, _ *cobra.Command) *cobra.Command { return svcDeploy },
+			wantedEvent: &telemetry.Event{
+				Command:    "svc deploy",
+				Flags:      []string{"env", "name"},
+				Outcome:    telemetry.OutcomeSuccess,
+				DurationMs: 1500,
+				Timestamp:  end,
+			},
+		},
+		"records the category of the failure": {
+			pickCmd: func(_, svcDeploy, _ *cobra.Command) *cobra.Command { return svcDeploy },
+			inErr:   fmt.Errorf("get service: %w", awserr.New("ExpiredToken", "token expired", nil)),
+			wantedEvent: &telemetry.Event{
+				Command:    "svc deploy",
+				Flags:      []string{"env", "name"},
+				Outcome:    telemetry.OutcomeFailure,
+				Category:   telemetry.CategoryCredentials,
+				DurationMs: 1500,
+				Timestamp:  end,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			root, svcDeploy, telemetryShow := newCmds()
+			require.NoError(t, svcDeploy.Flags().Parse([]string{"--name", "frontend", "--env", "test"}))
+			cmd := tc.pickCmd(root, svcDeploy, telemetryShow)
+
+			// WHEN
+			event, ok := usageEvent(cmd, start, end, tc.inErr)
+
+			// THEN
+			if tc.wantedEvent == nil {
+				require.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			event.Version, event.OS = "", ""
+			require.Equal(t, *tc.wantedEvent, event)
+		})
+	}
+}
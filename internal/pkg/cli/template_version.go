@@ -0,0 +1,39 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/spf13/cobra"
+)
+
+const fmtTemplateVersionSkewWarning = `%s %s was deployed with a newer version of Copilot than the one you are running.
+  Deployed template version:  %s
+  Latest supported version:   %s (copilot %s)
+Upgrade to the latest version of AWS Copilot to avoid unexpected template errors,
+or run the command with --%s to stop instead of continuing.
+`
+
+// shouldEnforceTemplateVersion returns true if the global --strict-version flag is set for the command.
+func shouldEnforceTemplateVersion(cmd *cobra.Command) bool {
+	value, err := cmd.Flags().GetBool(strictVersionFlag)
+	return err == nil && value
+}
+
+// handleTemplateVersionSkew logs a warning if err is a deploy.ErrTemplateVersionSkew and strict is false.
+// Otherwise, the error is returned as is.
+func handleTemplateVersionSkew(err error, strict bool) error {
+	var skew *deploy.ErrTemplateVersionSkew
+	if !errors.As(err, &skew) || strict {
+		return err
+	}
+	log.Warningf(fmtTemplateVersionSkewWarning, skew.Kind, color.HighlightUserInput(skew.Name),
+		color.Emphasize(skew.DeployedVersion), color.Emphasize(skew.LatestVersion), version.Version, strictVersionFlag)
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleTemplateVersionSkew(t *testing.T) {
+	skewErr := &deploy.ErrTemplateVersionSkew{
+		Kind:            "environment",
+		Name:            "test",
+		DeployedVersion: "v99.0.0",
+		LatestVersion:   deploy.LatestEnvTemplateVersion,
+	}
+	testCases := map[string]struct {
+		inErr    error
+		inStrict bool
+
+		wantedErr error
+	}{
+		"should return nil if there is no error": {},
+		"should return errors that are not a version skew": {
+			inErr:     errors.New("some error"),
+			inStrict:  false,
+			wantedErr: errors.New("some error"),
+		},
+		"should swallow a version skew if the version is not enforced": {
+			inErr: fmt.Errorf("upgrade: %w", skewErr),
+		},
+		"should return a version skew if the version is enforced": {
+			inErr:     fmt.Errorf("upgrade: %w", skewErr),
+			inStrict:  true,
+			wantedErr: fmt.Errorf("upgrade: environment test is on template version v99.0.0, which is newer than the latest version %s supported by this Copilot CLI", deploy.LatestEnvTemplateVersion),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			err := handleTemplateVersionSkew(tc.inErr, tc.inStrict)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
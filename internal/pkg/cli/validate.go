@@ -12,11 +12,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/robfig/cron/v3"
 
 	"github.com/spf13/afero"
 
 	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
@@ -41,21 +43,45 @@ var (
 	errDDBAttributeBadFormat              = errors.New("value must be of the form <name>:<T> where T is one of S, N, or B")
 	errTooManyLSIKeys                     = errors.New("number of specified LSI sort keys must be 5 or less")
 	errDomainInvalid                      = errors.New("value must contain at least one '.' character")
+	errRoleARNInvalid                     = errors.New("value must be an IAM role ARN (example: arn:aws:iam::123456789012:role/DNSAdmin)")
+	errCertARNInvalid                     = errors.New("value must be an ACM certificate ARN (example: arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012)")
+	errS3ObjectURIInvalid                 = errors.New("value must be the S3 URI of an object (example: s3://my-bucket/ca-bundle.pem)")
+	errHSTSBadFormat                      = errors.New(`value must be of the form "max-age=<seconds>" followed by the optional "; includeSubDomains" and "; preload" directives (example: max-age=31536000; includeSubDomains)`)
 	errDurationInvalid                    = errors.New("value must be a valid Go duration string (example: 1h30m)")
 	errDurationBadUnits                   = errors.New("duration cannot be in units smaller than a second")
 	errScheduleInvalid                    = errors.New("value must be a valid cron expression (examples: @weekly; @every 30m; 0 0 * * 0)")
+	errClusterNameBadFormat               = errors.New("value must contain only alphanumeric characters, hyphens and underscores, and be at most 255 characters long")
+	errLBNameBadFormat                    = errors.New(`value must contain only alphanumeric characters and hyphens, be at most 32 characters long, not start or end with a hyphen, and not start with "internal-"`)
+	errLogGroupPrefixBadFormat            = errors.New("value must start with a '/', not end with a '/', contain only alphanumeric characters and ._-/#, and be at most 256 characters long")
+	errPullThroughCacheCredentialsInvalid = errors.New(`value must be the ARN of a Secrets Manager secret whose name starts with "ecr-pullthroughcache/"`)
+	errRepositoryPrefixBadFormat          = errors.New("value must start with a lowercase letter or number, contain only lowercase alphanumeric characters and ._-/ with no consecutive separators, not end with a separator, and be at most 200 characters long")
+	errImageDigestBadFormat               = errors.New("value must be an image digest of the form sha256:<64 hexadecimal characters>")
 
 	// Aurora-Serverless-specific errors.
 	errInvalidRDSNameCharacters = errors.New("value must start with a letter")
+
+	// RDS-instance-specific errors.
+	errInvalidRDSInstanceClass = errors.New(`value must be a DB instance class of the form "db.<family>.<size>" (example: db.t3.micro)`)
 )
 
 var (
-	fmtErrInvalidStorageType = "invalid storage type %s: must be one of %s"
+	fmtErrInvalidStorageType      = "invalid storage type %s: must be one of %s"
+	fmtErrInvalidSecretProvider   = "invalid secret provider %s: must be one of %s"
+	fmtErrInvalidPipelineProvider = "invalid pipeline provider %s: must be one of %s"
+	fmtErrInvalidMutualTLSMode    = "invalid mutual TLS mode %s: must be one of %s"
+	fmtErrInvalidCacheRegistry    = "invalid pull-through cache registry %s: must be one of %s"
+
+	// DynamoDB-specific errors.
+	fmtErrInvalidDDBBillingMode = "invalid billing mode %s: must be one of %s"
+	fmtErrDDBCapacityBadSize    = "value must be an integer between %d and %d"
 
 	// Aurora-Serverless-specific errors.
 	fmtErrRDSNameBadSize          = "value must be between %d and %d characters in length"
 	fmtErrInvalidEngineType       = "invalid engine type %s: must be one of %s"
 	fmtErrInvalidDBNameCharacters = "invalid database name %s: must contain only alphanumeric characters and underscore; should start with a letter"
+
+	// RDS-instance-specific errors.
+	fmtErrRDSAllocatedStorageBadSize = "value must be an integer between %d and %d"
 )
 
 var (
@@ -123,6 +149,45 @@ var (
 	)
 )
 
+// RDS instance validation expressions.
+var (
+	// Instance classes are of the form db.<family>.<size>, e.g. "db.t3.micro" or "db.m5d.2xlarge".
+	// https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/Concepts.DBInstanceClass.html
+	rdsInstanceClassRegExp = regexp.MustCompile(`^db\.[a-z0-9\-]+\.[a-z0-9]+$`)
+)
+
+// Resource name override validation expressions.
+var (
+	// https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-ecs-cluster.html#cfn-ecs-cluster-clustername
+	clusterNameRegExp = regexp.MustCompile(`^[a-zA-Z0-9\-_]{1,255}$`)
+	// https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-elasticloadbalancingv2-loadbalancer.html#cfn-elasticloadbalancingv2-loadbalancer-name
+	lbNameRegExp = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9\-]{0,30}[a-zA-Z0-9])?$`)
+	// Log group names can be at most 512 characters, leave enough room for the "/<app>-<env>-<workload>" suffix.
+	// https://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_CreateLogGroup.html
+	logGroupPrefixRegExp = regexp.MustCompile(`^/[\.\-_/#A-Za-z0-9]{0,254}[\.\-_#A-Za-z0-9]$`)
+	// Repository names can be at most 256 characters, leave enough room for the "/<workload>" suffix.
+	// https://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_CreateRepository.html#ECR-CreateRepository-request-repositoryName
+	repositoryPrefixRegExp = regexp.MustCompile(`^[a-z0-9]+(?:[._\-/][a-z0-9]+)*$`)
+	// https://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_ImageIdentifier.html
+	imageDigestRegExp = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+)
+
+// https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Strict-Transport-Security#syntax
+var hstsRegExp = regexp.MustCompile(`^max-age=[0-9]+(?:; ?includeSubDomains)?(?:; ?preload)?$`)
+
+const (
+	maxRepositoryPrefixLength = 200
+	internalLBNamePrefix      = "internal-"
+	s3URIScheme               = "s3://"
+)
+
+const (
+	// https://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_CreatePullThroughCacheRule.html#ECR-CreatePullThroughCacheRule-request-ecrRepositoryPrefix
+	maxPullThroughCachePrefixLength = 30
+	// Pull-through cache rules can only read secrets whose name starts with "ecr-pullthroughcache/".
+	pullThroughCacheSecretPrefix = "secret:ecr-pullthroughcache/"
+)
+
 const regexpFindAllMatches = -1
 
 func validateAppName(val interface{}) error {
@@ -219,6 +284,81 @@ func validateDomainName(val interface{}) error {
 	return nil
 }
 
+func validateRoleARN(val interface{}) error {
+	roleARN, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	parsed, err := arn.Parse(roleARN)
+	if err != nil {
+		return errRoleARNInvalid
+	}
+	if parsed.Service != "iam" || !strings.HasPrefix(parsed.Resource, "role/") {
+		return errRoleARNInvalid
+	}
+	return nil
+}
+
+func validateCertARN(val interface{}) error {
+	certARN, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	parsed, err := arn.Parse(certARN)
+	if err != nil {
+		return errCertARNInvalid
+	}
+	if parsed.Service != "acm" || !strings.HasPrefix(parsed.Resource, "certificate/") {
+		return errCertARNInvalid
+	}
+	return nil
+}
+
+func validateImageDigest(val interface{}) error {
+	digest, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !imageDigestRegExp.MatchString(digest) {
+		return errImageDigestBadFormat
+	}
+	return nil
+}
+
+func validateS3ObjectURI(val interface{}) error {
+	uri, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if _, _, err := parseS3ObjectURI(uri); err != nil {
+		return err
+	}
+	return nil
+}
+
+// parseS3ObjectURI returns the bucket and the key of an object from its S3 URI such as "s3://my-bucket/ca-bundle.pem".
+func parseS3ObjectURI(uri string) (bucket, key string, err error) {
+	if !strings.HasPrefix(uri, s3URIScheme) {
+		return "", "", errS3ObjectURIInvalid
+	}
+	parts := strings.SplitN(strings.TrimPrefix(uri, s3URIScheme), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errS3ObjectURIInvalid
+	}
+	return parts[0], parts[1], nil
+}
+
+func validateHSTS(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !hstsRegExp.MatchString(s) {
+		return errHSTSBadFormat
+	}
+	return nil
+}
+
 func validatePath(fs afero.Fs, val interface{}) error {
 	path, ok := val.(string)
 	if !ok {
@@ -295,6 +435,71 @@ func validateDBNameCharacters(name string) error {
 	return fmt.Errorf(fmtErrInvalidDBNameCharacters, name)
 }
 
+func validateSecretProvider(val interface{}) error {
+	provider, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	for _, valid := range secretProviders {
+		if provider == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf(fmtErrInvalidSecretProvider, provider, prettify(secretProviders))
+}
+
+func validatePipelineProvider(val interface{}) error {
+	provider, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	for _, valid := range pipelineProviders {
+		if provider == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf(fmtErrInvalidPipelineProvider, provider, prettify(pipelineProviders))
+}
+
+func validateDDBBillingMode(val interface{}) error {
+	mode, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	for _, valid := range ddbBillingModes {
+		if mode == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf(fmtErrInvalidDDBBillingMode, mode, prettify(ddbBillingModes))
+}
+
+// validateDDBCapacity validates the number of read or write capacity units of a provisioned DynamoDB table.
+// See https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/ServiceQuotas.html#default-limits-throughput-capacity-modes
+func validateDDBCapacity(val interface{}) error {
+	const (
+		minCapacity = 1
+		maxCapacity = 40000
+	)
+	var capacity int
+	switch v := val.(type) {
+	case int:
+		capacity = v
+	case string:
+		parsed, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf(fmtErrDDBCapacityBadSize, minCapacity, maxCapacity)
+		}
+		capacity = parsed
+	default:
+		return errValueNotAString
+	}
+	if capacity < minCapacity || capacity > maxCapacity {
+		return fmt.Errorf(fmtErrDDBCapacityBadSize, minCapacity, maxCapacity)
+	}
+	return nil
+}
+
 func validateEngine(val interface{}) error {
 	engine, ok := val.(string)
 	if !ok {
@@ -308,6 +513,44 @@ func validateEngine(val interface{}) error {
 	return fmt.Errorf(fmtErrInvalidEngineType, engine, prettify(engineTypes))
 }
 
+func validateRDSInstanceClass(val interface{}) error {
+	class, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !rdsInstanceClassRegExp.MatchString(class) {
+		return errInvalidRDSInstanceClass
+	}
+	return nil
+}
+
+// validateRDSAllocatedStorage validates the number of GiB of general purpose storage allocated to a
+// MySQL or PostgreSQL RDS instance.
+// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-rds-database-instance.html#cfn-rds-dbinstance-allocatedstorage
+func validateRDSAllocatedStorage(val interface{}) error {
+	const (
+		minAllocatedStorage = 20
+		maxAllocatedStorage = 65536
+	)
+	var size int
+	switch v := val.(type) {
+	case int:
+		size = v
+	case string:
+		parsed, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf(fmtErrRDSAllocatedStorageBadSize, minAllocatedStorage, maxAllocatedStorage)
+		}
+		size = parsed
+	default:
+		return errValueNotAString
+	}
+	if size < minAllocatedStorage || size > maxAllocatedStorage {
+		return fmt.Errorf(fmtErrRDSAllocatedStorageBadSize, minAllocatedStorage, maxAllocatedStorage)
+	}
+	return nil
+}
+
 func validateEnvironmentName(val interface{}) error {
 	if err := basicNameValidation(val); err != nil {
 		return fmt.Errorf("environment name %v is invalid: %w", val, err)
@@ -609,3 +852,75 @@ func validateCIDRSlice(val interface{}) error {
 	}
 	return nil
 }
+
+func validateClusterName(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !clusterNameRegExp.MatchString(s) {
+		return errClusterNameBadFormat
+	}
+	return nil
+}
+
+func validateLBName(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !lbNameRegExp.MatchString(s) || strings.HasPrefix(s, internalLBNamePrefix) {
+		return errLBNameBadFormat
+	}
+	return nil
+}
+
+func validateLogGroupPrefix(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !logGroupPrefixRegExp.MatchString(s) {
+		return errLogGroupPrefixBadFormat
+	}
+	return nil
+}
+
+func validateRepositoryPrefix(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if len(s) > maxRepositoryPrefixLength || !repositoryPrefixRegExp.MatchString(s) {
+		return errRepositoryPrefixBadFormat
+	}
+	return nil
+}
+
+func validatePullThroughCacheRegistry(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	for _, registry := range config.PullThroughCacheRegistries {
+		if s == registry {
+			return nil
+		}
+	}
+	return fmt.Errorf(fmtErrInvalidCacheRegistry, s, prettify(config.PullThroughCacheRegistries))
+}
+
+func validatePullThroughCacheCredentials(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	parsed, err := arn.Parse(s)
+	if err != nil {
+		return errPullThroughCacheCredentialsInvalid
+	}
+	if parsed.Service != "secretsmanager" || !strings.HasPrefix(parsed.Resource, pullThroughCacheSecretPrefix) {
+		return errPullThroughCacheCredentialsInvalid
+	}
+	return nil
+}
@@ -104,6 +104,155 @@ func TestValidateEnvironmentName(t *testing.T) {
 	}
 }
 
+func TestValidateRoleARN(t *testing.T) {
+	testCases := map[string]struct {
+		input interface{}
+		want  error
+	}{
+		"not a string": {
+			input: 123,
+			want:  errValueNotAString,
+		},
+		"not an ARN": {
+			input: "DNSAdmin",
+			want:  errRoleARNInvalid,
+		},
+		"not an IAM ARN": {
+			input: "arn:aws:s3:::my-bucket",
+			want:  errRoleARNInvalid,
+		},
+		"not a role": {
+			input: "arn:aws:iam::123456789012:user/admin",
+			want:  errRoleARNInvalid,
+		},
+		"valid role ARN": {
+			input: "arn:aws:iam::123456789012:role/DNSAdmin",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateRoleARN(tc.input)
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestValidateImageDigest(t *testing.T) {
+	testCases := map[string]struct {
+		input interface{}
+		want  error
+	}{
+		"not a string": {
+			input: 123,
+			want:  errValueNotAString,
+		},
+		"missing algorithm": {
+			input: "741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+			want:  errImageDigestBadFormat,
+		},
+		"too short": {
+			input: "sha256:741d3e95",
+			want:  errImageDigestBadFormat,
+		},
+		"image tag": {
+			input: "v1.2.0",
+			want:  errImageDigestBadFormat,
+		},
+		"valid digest": {
+			input: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateImageDigest(tc.input)
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestValidateCertARN(t *testing.T) {
+	testCases := map[string]testCase{
+		"not an ARN": {
+			input: "my-cert",
+			want:  errCertARNInvalid,
+		},
+		"not an ACM ARN": {
+			input: "arn:aws:iam::123456789012:server-certificate/my-cert",
+			want:  errCertARNInvalid,
+		},
+		"valid certificate ARN": {
+			input: "arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateCertARN(tc.input)
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestValidateHSTS(t *testing.T) {
+	testCases := map[string]testCase{
+		"missing max-age": {
+			input: "includeSubDomains",
+			want:  errHSTSBadFormat,
+		},
+		"unknown directive": {
+			input: "max-age=31536000; includeSubdomains",
+			want:  errHSTSBadFormat,
+		},
+		"max-age only": {
+			input: "max-age=63072000",
+		},
+		"all directives": {
+			input: "max-age=63072000; includeSubDomains; preload",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateHSTS(tc.input)
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestValidateS3ObjectURI(t *testing.T) {
+	testCases := map[string]testCase{
+		"not an S3 URI": {
+			input: "https://my-bucket.s3.amazonaws.com/ca-bundle.pem",
+			want:  errS3ObjectURIInvalid,
+		},
+		"missing key": {
+			input: "s3://my-bucket/",
+			want:  errS3ObjectURIInvalid,
+		},
+		"missing bucket": {
+			input: "s3:///ca-bundle.pem",
+			want:  errS3ObjectURIInvalid,
+		},
+		"valid object URI": {
+			input: "s3://my-bucket/certs/ca-bundle.pem",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateS3ObjectURI(tc.input)
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
 func TestValidateS3Name(t *testing.T) {
 	testCases := map[string]testCase{
 		"good case": {
@@ -537,6 +686,130 @@ func TestValidateEngine(t *testing.T) {
 	}
 }
 
+func TestValidateRDSInstanceClass(t *testing.T) {
+	testCases := map[string]testCase{
+		"burstable class": {
+			input: "db.t3.micro",
+			want:  nil,
+		},
+		"class with storage suffix": {
+			input: "db.m5d.2xlarge",
+			want:  nil,
+		},
+		"missing db prefix": {
+			input: "t3.micro",
+			want:  errInvalidRDSInstanceClass,
+		},
+		"not a string": {
+			input: 1,
+			want:  errValueNotAString,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateRDSInstanceClass(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
+func TestValidateDDBBillingMode(t *testing.T) {
+	testCases := map[string]testCase{
+		"on-demand": {
+			input: "OnDemand",
+			want:  nil,
+		},
+		"provisioned": {
+			input: "Provisioned",
+			want:  nil,
+		},
+		"invalid billing mode": {
+			input: "PAY_PER_REQUEST",
+			want:  errors.New(`invalid billing mode PAY_PER_REQUEST: must be one of "OnDemand", "Provisioned"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateDDBBillingMode(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
+func TestValidateDDBCapacity(t *testing.T) {
+	testCases := map[string]testCase{
+		"integer input": {
+			input: 1,
+			want:  nil,
+		},
+		"string input": {
+			input: "40000",
+			want:  nil,
+		},
+		"too small": {
+			input: 0,
+			want:  errors.New("value must be an integer between 1 and 40000"),
+		},
+		"not a number": {
+			input: "many",
+			want:  errors.New("value must be an integer between 1 and 40000"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateDDBCapacity(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
+func TestValidateRDSAllocatedStorage(t *testing.T) {
+	testCases := map[string]testCase{
+		"integer input": {
+			input: 20,
+			want:  nil,
+		},
+		"string input": {
+			input: "100",
+			want:  nil,
+		},
+		"too small": {
+			input: 19,
+			want:  errors.New("value must be an integer between 20 and 65536"),
+		},
+		"too large": {
+			input: "65537",
+			want:  errors.New("value must be an integer between 20 and 65536"),
+		},
+		"not a number": {
+			input: "lots",
+			want:  errors.New("value must be an integer between 20 and 65536"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateRDSAllocatedStorage(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
 func TestValidateMySQLDBName(t *testing.T) {
 	testCases := map[string]testCase {
 		"good case": {
@@ -599,3 +872,139 @@ func TestValidatePostgreSQLDBName(t *testing.T) {
 	}
 }
 
+
+func TestValidateClusterName(t *testing.T) {
+	testCases := map[string]testCase{
+		"good case": {
+			input: "team-a_cluster",
+			want:  nil,
+		},
+		"bad character": {
+			input: "team.a",
+			want:  errClusterNameBadFormat,
+		},
+		"too long": {
+			input: strings.Repeat("a", 256),
+			want:  errClusterNameBadFormat,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateClusterName(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
+func TestValidateLBName(t *testing.T) {
+	testCases := map[string]testCase{
+		"good case": {
+			input: "team-a-alb",
+			want:  nil,
+		},
+		"bad character": {
+			input: "team_a_alb",
+			want:  errLBNameBadFormat,
+		},
+		"starts with a hyphen": {
+			input: "-team-a",
+			want:  errLBNameBadFormat,
+		},
+		"ends with a hyphen": {
+			input: "team-a-",
+			want:  errLBNameBadFormat,
+		},
+		"starts with internal-": {
+			input: "internal-alb",
+			want:  errLBNameBadFormat,
+		},
+		"too long": {
+			input: strings.Repeat("a", 33),
+			want:  errLBNameBadFormat,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateLBName(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
+func TestValidateLogGroupPrefix(t *testing.T) {
+	testCases := map[string]testCase{
+		"good case": {
+			input: "/team-a/ecs#1.0",
+			want:  nil,
+		},
+		"does not start with a slash": {
+			input: "team-a",
+			want:  errLogGroupPrefixBadFormat,
+		},
+		"ends with a slash": {
+			input: "/team-a/",
+			want:  errLogGroupPrefixBadFormat,
+		},
+		"bad character": {
+			input: "/team a",
+			want:  errLogGroupPrefixBadFormat,
+		},
+		"too long": {
+			input: "/" + strings.Repeat("a", 256),
+			want:  errLogGroupPrefixBadFormat,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateLogGroupPrefix(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
+func TestValidateRepositoryPrefix(t *testing.T) {
+	testCases := map[string]testCase{
+		"good case": {
+			input: "team-a/phonetool",
+			want:  nil,
+		},
+		"upper case": {
+			input: "Team-a",
+			want:  errRepositoryPrefixBadFormat,
+		},
+		"consecutive separators": {
+			input: "team-a//phonetool",
+			want:  errRepositoryPrefixBadFormat,
+		},
+		"trailing separator": {
+			input: "team-a/",
+			want:  errRepositoryPrefixBadFormat,
+		},
+		"too long": {
+			input: strings.Repeat("a", 201),
+			want:  errRepositoryPrefixBadFormat,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateRepositoryPrefix(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
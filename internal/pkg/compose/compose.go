@@ -0,0 +1,272 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package compose provides functionality to import Docker Compose files into Copilot workloads.
+package compose
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Volume types.
+const (
+	volumeTypeVolume = "volume"
+	volumeTypeBind   = "bind"
+)
+
+// Top-level and service keys of a Compose file that are translated to Copilot.
+var (
+	supportedProjectKeys = map[string]bool{
+		"version":  true,
+		"services": true,
+		"volumes":  true,
+	}
+	supportedServiceKeys = map[string]bool{
+		"image":        true,
+		"build":        true,
+		"ports":        true,
+		"expose":       true,
+		"environment":  true,
+		"volumes":      true,
+		"depends_on":   true,
+		"network_mode": true,
+		"command":      true,
+		"entrypoint":   true,
+	}
+)
+
+// Project is a parsed Docker Compose file.
+type Project struct {
+	Services map[string]*Service `yaml:"services"`
+
+	unsupportedKeys []string // Keys in the file that have no Copilot equivalent.
+}
+
+// Service is a service defined in a Docker Compose file.
+type Service struct {
+	Image       string          `yaml:"image"`
+	Build       Build           `yaml:"build"`
+	Ports       []Port          `yaml:"ports"`
+	Expose      []stringOrInt   `yaml:"expose"`
+	Environment Environment     `yaml:"environment"`
+	Volumes     []Volume        `yaml:"volumes"`
+	DependsOn   DependsOn       `yaml:"depends_on"`
+	NetworkMode string          `yaml:"network_mode"`
+	Command     stringOrStrings `yaml:"command"`
+	Entrypoint  stringOrStrings `yaml:"entrypoint"`
+}
+
+// Build holds the build configuration of a service, specified as a context path or as a map.
+type Build struct {
+	Context    string            `yaml:"context"`
+	Dockerfile string            `yaml:"dockerfile"`
+	Args       map[string]string `yaml:"args"`
+	Target     string            `yaml:"target"`
+}
+
+// UnmarshalYAML implements the yaml(v3) interface so that the build can be a string or a map.
+func (b *Build) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		b.Context = value.Value
+		return nil
+	}
+	type build Build
+	return value.Decode((*build)(b))
+}
+
+// IsEmpty returns true if the service is not built from a Dockerfile.
+func (b Build) IsEmpty() bool {
+	return b.Context == "" && b.Dockerfile == ""
+}
+
+// Port is a port mapping of a service, in the short "[HOST:]CONTAINER[/PROTOCOL]" syntax or the long syntax.
+type Port struct {
+	Target    uint16 `yaml:"target"`
+	Published string `yaml:"published"`
+	Protocol  string `yaml:"protocol"`
+}
+
+// UnmarshalYAML implements the yaml(v3) interface so that the port can be a number, a string or a map.
+func (p *Port) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.ScalarNode {
+		type port Port
+		return value.Decode((*port)(p))
+	}
+	mapping := value.Value
+	if i := strings.Index(mapping, "/"); i != -1 {
+		p.Protocol = mapping[i+1:]
+		mapping = mapping[:i]
+	}
+	parts := strings.Split(mapping, ":")
+	target := parts[len(parts)-1]
+	if len(parts) > 1 {
+		p.Published = parts[len(parts)-2]
+	}
+	if strings.Contains(target, "-") {
+		return fmt.Errorf("port ranges like %q are not supported", value.Value)
+	}
+	port, err := strconv.ParseUint(target, 10, 16)
+	if err != nil {
+		return fmt.Errorf("parse port %q: %w", value.Value, err)
+	}
+	p.Target = uint16(port)
+	return nil
+}
+
+// Environment holds the environment variables of a service, specified as a map or a list of "KEY=VALUE".
+// A variable without a value, that is read from the shell running Compose, is stored as nil.
+type Environment map[string]*string
+
+// UnmarshalYAML implements the yaml(v3) interface so that the environment can be a map or a list.
+func (e *Environment) UnmarshalYAML(value *yaml.Node) error {
+	env := make(Environment)
+	switch value.Kind {
+	case yaml.MappingNode:
+		for i := 0; i < len(value.Content); i += 2 {
+			key, val := value.Content[i].Value, value.Content[i+1]
+			if val.Tag == "!!null" {
+				env[key] = nil
+				continue
+			}
+			v := val.Value
+			env[key] = &v
+		}
+	case yaml.SequenceNode:
+		for _, item := range value.Content {
+			parts := strings.SplitN(item.Value, "=", 2)
+			if len(parts) == 1 {
+				env[parts[0]] = nil
+				continue
+			}
+			v := parts[1]
+			env[parts[0]] = &v
+		}
+	default:
+		return errors.New(`"environment" must be a map or a list`)
+	}
+	*e = env
+	return nil
+}
+
+// Volume is a volume mounted by a service, in the short "[SOURCE:]TARGET[:MODE]" syntax or the long syntax.
+type Volume struct {
+	Type     string `yaml:"type"`
+	Source   string `yaml:"source"`
+	Target   string `yaml:"target"`
+	ReadOnly bool   `yaml:"read_only"`
+}
+
+// UnmarshalYAML implements the yaml(v3) interface so that the volume can be a string or a map.
+func (v *Volume) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.ScalarNode {
+		type volume Volume
+		return value.Decode((*volume)(v))
+	}
+	parts := strings.Split(value.Value, ":")
+	switch len(parts) {
+	case 1:
+		v.Target = parts[0]
+	case 2:
+		v.Source, v.Target = parts[0], parts[1]
+	default:
+		v.Source, v.Target = parts[0], parts[1]
+		v.ReadOnly = strings.Contains(parts[2], "ro")
+	}
+	v.Type = volumeTypeVolume
+	if isPath(v.Source) {
+		v.Type = volumeTypeBind
+	}
+	return nil
+}
+
+// DependsOn holds the names of the services that a service depends on, specified as a list or a map.
+type DependsOn []string
+
+// UnmarshalYAML implements the yaml(v3) interface so that the dependencies can be a list or a map.
+func (d *DependsOn) UnmarshalYAML(value *yaml.Node) error {
+	var deps []string
+	switch value.Kind {
+	case yaml.SequenceNode:
+		if err := value.Decode(&deps); err != nil {
+			return err
+		}
+	case yaml.MappingNode:
+		for i := 0; i < len(value.Content); i += 2 {
+			deps = append(deps, value.Content[i].Value)
+		}
+	default:
+		return errors.New(`"depends_on" must be a list or a map`)
+	}
+	*d = deps
+	return nil
+}
+
+type stringOrInt string
+
+// UnmarshalYAML implements the yaml(v3) interface so that numbers are read as strings.
+func (s *stringOrInt) UnmarshalYAML(value *yaml.Node) error {
+	*s = stringOrInt(value.Value)
+	return nil
+}
+
+type stringOrStrings struct {
+	String      *string
+	StringSlice []string
+}
+
+// UnmarshalYAML implements the yaml(v3) interface so that the value can be a string or a list of strings.
+func (s *stringOrStrings) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		v := value.Value
+		s.String = &v
+		return nil
+	}
+	return value.Decode(&s.StringSlice)
+}
+
+// Parse reads the content of a Docker Compose file.
+func Parse(content []byte) (*Project, error) {
+	var raw map[string]yaml.Node
+	if err := yaml.Unmarshal(content, &raw); err != nil {
+		return nil, fmt.Errorf("unmarshal compose file: %w", err)
+	}
+	var project Project
+	if err := yaml.Unmarshal(content, &project); err != nil {
+		return nil, fmt.Errorf("unmarshal compose file: %w", err)
+	}
+	if len(project.Services) == 0 {
+		return nil, errors.New("no services defined in compose file")
+	}
+	project.unsupportedKeys = unsupportedKeys(raw)
+	return &project, nil
+}
+
+func unsupportedKeys(raw map[string]yaml.Node) []string {
+	var keys []string
+	for key := range raw {
+		if !supportedProjectKeys[key] {
+			keys = append(keys, key)
+		}
+	}
+	services := raw["services"]
+	for i := 0; i+1 < len(services.Content); i += 2 {
+		name, svc := services.Content[i].Value, services.Content[i+1]
+		for j := 0; j+1 < len(svc.Content); j += 2 {
+			if key := svc.Content[j].Value; !supportedServiceKeys[key] {
+				keys = append(keys, fmt.Sprintf("services.%s.%s", name, key))
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func isPath(source string) bool {
+	return strings.HasPrefix(source, ".") || strings.HasPrefix(source, "/") || strings.HasPrefix(source, "~")
+}
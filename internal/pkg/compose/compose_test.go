@@ -0,0 +1,133 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package compose
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wanted      *Project
+		wantedError string
+	}{
+		"parses short and long syntaxes": {
+			inContent: `
+version: "3.9"
+services:
+  web:
+    build: ./web
+    ports:
+      - "8080:80"
+      - target: 443
+        published: "8443"
+    environment:
+      LOG_LEVEL: info
+      DEBUG:
+    volumes:
+      - data:/var/lib/data:ro
+      - ./static:/static
+      - /tmp/cache
+    depends_on:
+      db:
+        condition: service_healthy
+    command: ["npm", "start"]
+  db:
+    image: postgres
+    expose:
+      - 5432
+    environment:
+      - POSTGRES_PASSWORD=example
+    entrypoint: docker-entrypoint.sh
+volumes:
+  data:
+networks:
+  default:
+`,
+			wanted: &Project{
+				Services: map[string]*Service{
+					"web": {
+						Build: Build{Context: "./web"},
+						Ports: []Port{
+							{Target: 80, Published: "8080"},
+							{Target: 443, Published: "8443"},
+						},
+						Environment: Environment{
+							"LOG_LEVEL": aws.String("info"),
+							"DEBUG":     nil,
+						},
+						Volumes: []Volume{
+							{Type: "volume", Source: "data", Target: "/var/lib/data", ReadOnly: true},
+							{Type: "bind", Source: "./static", Target: "/static"},
+							{Type: "volume", Target: "/tmp/cache"},
+						},
+						DependsOn: DependsOn{"db"},
+						Command:   stringOrStrings{StringSlice: []string{"npm", "start"}},
+					},
+					"db": {
+						Image:  "postgres",
+						Expose: []stringOrInt{"5432"},
+						Environment: Environment{
+							"POSTGRES_PASSWORD": aws.String("example"),
+						},
+						Entrypoint: stringOrStrings{String: aws.String("docker-entrypoint.sh")},
+					},
+				},
+				unsupportedKeys: []string{"networks"},
+			},
+		},
+		"flags unsupported service keys": {
+			inContent: `
+services:
+  web:
+    image: nginx
+    healthcheck:
+      test: ["CMD", "curl", "-f", "http://localhost"]
+    restart: always
+`,
+			wanted: &Project{
+				Services: map[string]*Service{
+					"web": {
+						Image: "nginx",
+					},
+				},
+				unsupportedKeys: []string{"services.web.healthcheck", "services.web.restart"},
+			},
+		},
+		"returns an error if there are no services": {
+			inContent:   `version: "3"`,
+			wantedError: "no services defined in compose file",
+		},
+		"returns an error for port ranges": {
+			inContent: `
+services:
+  web:
+    image: nginx
+    ports:
+      - "3000-3005"
+`,
+			wantedError: `unmarshal compose file: port ranges like "3000-3005" are not supported`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := Parse([]byte(tc.inContent))
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,318 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package compose
+
+import (
+	"encoding"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+)
+
+const (
+	sharedNetworkModePrefix = "service:"
+	defaultDockerfileName   = "Dockerfile"
+	rootPath                = "/"
+)
+
+// Workload is a Copilot service translated from a Compose service and its sidecars.
+type Workload struct {
+	Name     string
+	Type     string
+	Manifest encoding.BinaryMarshaler
+}
+
+// Conversion holds the Copilot workloads translated from a Compose project,
+// and explanations for the Compose configuration that could not be translated.
+type Conversion struct {
+	Workloads   []Workload
+	Unsupported []string
+}
+
+type converter struct {
+	project    *Project
+	composeDir string
+	wsRoot     string
+
+	hasRootPath bool // True if a Load Balanced Web Service already routes requests to "/".
+	unsupported []string
+}
+
+// Convert translates the services of a Compose project into Copilot service manifests.
+// Services that publish ports become Load Balanced Web Services and the others become Backend Services.
+// Services that share the network of another service with "network_mode: service:<name>" become its sidecars.
+// Dockerfile paths, relative to composeDir in the Compose file, are written relative to the workspace root wsRoot.
+func Convert(project *Project, composeDir, wsRoot string) (*Conversion, error) {
+	c := &converter{
+		project:    project,
+		composeDir: composeDir,
+		wsRoot:     wsRoot,
+	}
+	for _, key := range project.unsupportedKeys {
+		c.unsupported = append(c.unsupported, fmt.Sprintf("%s is not supported", key))
+	}
+
+	var names []string
+	for name := range project.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sidecars := c.sidecars(names)
+	var workloads []Workload
+	for _, name := range names {
+		if isSidecar(sidecars, name) {
+			continue
+		}
+		wl, err := c.convertService(name, sidecars[name])
+		if err != nil {
+			return nil, fmt.Errorf("convert service %s: %w", name, err)
+		}
+		workloads = append(workloads, wl)
+	}
+	return &Conversion{
+		Workloads:   workloads,
+		Unsupported: c.unsupported,
+	}, nil
+}
+
+// sidecars returns the names of the sidecars for each main service.
+func (c *converter) sidecars(names []string) map[string][]string {
+	sidecars := make(map[string][]string)
+	for _, name := range names {
+		main, ok := c.mainService(name)
+		if !ok {
+			continue
+		}
+		if _, exists := c.project.Services[main]; !exists {
+			c.unsupported = append(c.unsupported, fmt.Sprintf("services.%s.network_mode: service %s does not exist", name, main))
+			continue
+		}
+		if c.project.Services[name].Image == "" {
+			c.unsupported = append(c.unsupported, fmt.Sprintf("services.%s cannot be a sidecar of %s without an image, it is imported as a separate service", name, main))
+			continue
+		}
+		sidecars[main] = append(sidecars[main], name)
+	}
+	return sidecars
+}
+
+// mainService returns the name of the service whose network is shared with the service.
+func (c *converter) mainService(name string) (string, bool) {
+	mode := c.project.Services[name].NetworkMode
+	if !strings.HasPrefix(mode, sharedNetworkModePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(mode, sharedNetworkModePrefix), true
+}
+
+func (c *converter) convertService(name string, sidecarNames []string) (Workload, error) {
+	svc := c.project.Services[name]
+	props := manifest.WorkloadProps{
+		Name: name,
+	}
+	switch {
+	case !svc.Build.IsEmpty():
+		path, err := c.dockerfilePath(svc.Build)
+		if err != nil {
+			return Workload{}, err
+		}
+		props.Dockerfile = path
+		if len(svc.Build.Args) != 0 || svc.Build.Target != "" {
+			c.unsupported = append(c.unsupported, fmt.Sprintf("services.%s.build: args and target are not imported, add them to image.build in the manifest", name))
+		}
+	case svc.Image != "":
+		props.Image = svc.Image
+	default:
+		return Workload{}, fmt.Errorf("service must specify an image or a build")
+	}
+
+	port, err := c.port(name, svc)
+	if err != nil {
+		return Workload{}, err
+	}
+	var (
+		mft      encoding.BinaryMarshaler
+		wlType   string
+		task     *manifest.TaskConfig
+		override *manifest.ImageOverride
+		sidecars *map[string]*manifest.SidecarConfig
+	)
+	if len(svc.Ports) != 0 {
+		path := rootPath
+		if c.hasRootPath {
+			path = name
+		}
+		c.hasRootPath = true
+		lbws := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+			WorkloadProps: &props,
+			Path:          path,
+			Port:          port,
+		})
+		mft, wlType, task, override, sidecars = lbws, manifest.LoadBalancedWebServiceType, &lbws.TaskConfig, &lbws.ImageOverride, &lbws.Sidecars
+	} else {
+		backend := manifest.NewBackendService(manifest.BackendServiceProps{
+			WorkloadProps: props,
+			Port:          port,
+		})
+		mft, wlType, task, override, sidecars = backend, manifest.BackendServiceType, &backend.TaskConfig, &backend.ImageOverride, &backend.Sidecars
+	}
+
+	task.Variables = c.variables(name, svc.Environment)
+	task.Storage = c.storage(name, svc.Volumes)
+	override.EntryPoint = manifest.EntryPointOverride{String: svc.Entrypoint.String, StringSlice: svc.Entrypoint.StringSlice}
+	override.Command = manifest.CommandOverride{String: svc.Command.String, StringSlice: svc.Command.StringSlice}
+	if len(sidecarNames) != 0 {
+		*sidecars = make(map[string]*manifest.SidecarConfig)
+		for _, sidecarName := range sidecarNames {
+			(*sidecars)[sidecarName] = c.sidecar(sidecarName)
+		}
+	}
+	for _, dep := range svc.DependsOn {
+		if contains(sidecarNames, dep) {
+			continue
+		}
+		c.unsupported = append(c.unsupported, fmt.Sprintf("services.%s.depends_on: %s is deployed independently, %s can reach it at %s.${COPILOT_SERVICE_DISCOVERY_ENDPOINT}", name, dep, name, dep))
+	}
+	return Workload{
+		Name:     name,
+		Type:     wlType,
+		Manifest: mft,
+	}, nil
+}
+
+func (c *converter) sidecar(name string) *manifest.SidecarConfig {
+	svc := c.project.Services[name]
+	sidecar := &manifest.SidecarConfig{
+		Image:     aws.String(svc.Image),
+		Variables: c.variables(name, svc.Environment),
+	}
+	if port, err := c.port(name, svc); err == nil && port != 0 {
+		sidecar.Port = aws.String(strconv.Itoa(int(port)))
+	}
+	if len(svc.Volumes) != 0 {
+		c.unsupported = append(c.unsupported, fmt.Sprintf("services.%s.volumes: volumes are not imported for sidecars", name))
+	}
+	if svc.Command.String != nil || svc.Command.StringSlice != nil || svc.Entrypoint.String != nil || svc.Entrypoint.StringSlice != nil {
+		c.unsupported = append(c.unsupported, fmt.Sprintf("services.%s: command and entrypoint are not imported for sidecars", name))
+	}
+	return sidecar
+}
+
+// dockerfilePath returns the path to the Dockerfile relative to the workspace root.
+func (c *converter) dockerfilePath(build Build) (string, error) {
+	dockerfile := build.Dockerfile
+	if dockerfile == "" {
+		dockerfile = defaultDockerfileName
+	}
+	path := filepath.Join(c.composeDir, build.Context, dockerfile)
+	rel, err := filepath.Rel(c.wsRoot, path)
+	if err != nil {
+		return "", fmt.Errorf("find relative path from workspace root to Dockerfile: %w", err)
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// port returns the container port that receives traffic.
+func (c *converter) port(name string, svc *Service) (uint16, error) {
+	if len(svc.Ports) != 0 {
+		if len(svc.Ports) > 1 {
+			c.unsupported = append(c.unsupported, fmt.Sprintf("services.%s.ports: only the container port %d is imported", name, svc.Ports[0].Target))
+		}
+		if protocol := svc.Ports[0].Protocol; protocol != "" && protocol != "tcp" {
+			return 0, fmt.Errorf("port %d uses protocol %s but only tcp is supported", svc.Ports[0].Target, protocol)
+		}
+		return svc.Ports[0].Target, nil
+	}
+	if len(svc.Expose) == 0 {
+		return 0, nil
+	}
+	if len(svc.Expose) > 1 {
+		c.unsupported = append(c.unsupported, fmt.Sprintf("services.%s.expose: only the port %s is imported", name, svc.Expose[0]))
+	}
+	port, err := strconv.ParseUint(strings.TrimSuffix(string(svc.Expose[0]), "/tcp"), 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("parse exposed port %s: %w", svc.Expose[0], err)
+	}
+	return uint16(port), nil
+}
+
+func (c *converter) variables(name string, env Environment) map[string]string {
+	if len(env) == 0 {
+		return nil
+	}
+	vars := make(map[string]string)
+	for key, val := range env {
+		if val == nil {
+			c.unsupported = append(c.unsupported, fmt.Sprintf("services.%s.environment.%s: values from the shell are not supported, set it in variables or secrets", name, key))
+			continue
+		}
+		vars[key] = *val
+	}
+	return vars
+}
+
+func (c *converter) storage(name string, volumes []Volume) *manifest.Storage {
+	vols := make(map[string]manifest.Volume)
+	for _, vol := range volumes {
+		mountPoint := manifest.MountPointOpts{
+			ContainerPath: aws.String(vol.Target),
+		}
+		if vol.ReadOnly {
+			mountPoint.ReadOnly = aws.Bool(true)
+		}
+		switch {
+		case vol.Type != volumeTypeVolume:
+			c.unsupported = append(c.unsupported, fmt.Sprintf("services.%s.volumes: %s mount of %s is not supported, add the files to the image or use a named volume", name, vol.Type, vol.Target))
+		case vol.Source == "":
+			// Anonymous volumes only live as long as the container, they're imported as ephemeral volumes.
+			vols[volumeName(vol.Target)] = manifest.Volume{
+				MountPointOpts: mountPoint,
+			}
+		default:
+			// Named volumes persist data, they're imported as managed EFS volumes.
+			vols[vol.Source] = manifest.Volume{
+				EFS: &manifest.EFSConfigOrBool{
+					Enabled: aws.Bool(true),
+				},
+				MountPointOpts: mountPoint,
+			}
+		}
+	}
+	if len(vols) == 0 {
+		return nil
+	}
+	return &manifest.Storage{
+		Volumes: vols,
+	}
+}
+
+// volumeName returns a name for an anonymous volume from its path in the container.
+// For example, "/var/cache" is named "var-cache".
+func volumeName(target string) string {
+	return strings.ReplaceAll(strings.Trim(target, "/"), "/", "-")
+}
+
+func isSidecar(sidecars map[string][]string, name string) bool {
+	for _, names := range sidecars {
+		if contains(names, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,214 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package compose
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvert(t *testing.T) {
+	testCases := map[string]struct {
+		inProject    *Project
+		inComposeDir string
+		inWsRoot     string
+
+		wantedWorkloads   []Workload
+		wantedUnsupported []string
+		wantedError       string
+		checkManifests    func(t *testing.T, workloads []Workload)
+	}{
+		"errors if a service has neither an image nor a build": {
+			inProject: &Project{
+				Services: map[string]*Service{
+					"web": {},
+				},
+			},
+			wantedError: "convert service web: service must specify an image or a build",
+		},
+		"errors if a port uses udp": {
+			inProject: &Project{
+				Services: map[string]*Service{
+					"dns": {
+						Image: "coredns/coredns",
+						Ports: []Port{{Target: 53, Published: "53", Protocol: "udp"}},
+					},
+				},
+			},
+			wantedError: "convert service dns: port 53 uses protocol udp but only tcp is supported",
+		},
+		"translates services with published ports to Load Balanced Web Services": {
+			inProject: &Project{
+				Services: map[string]*Service{
+					"web": {
+						Build: Build{Context: "./web"},
+						Ports: []Port{{Target: 80, Published: "8080"}},
+					},
+					"admin": {
+						Image: "nginx",
+						Ports: []Port{{Target: 8080}},
+					},
+				},
+			},
+			inComposeDir: "/workspace/app",
+			inWsRoot:     "/workspace",
+
+			wantedWorkloads: []Workload{
+				{Name: "admin", Type: manifest.LoadBalancedWebServiceType},
+				{Name: "web", Type: manifest.LoadBalancedWebServiceType},
+			},
+			checkManifests: func(t *testing.T, workloads []Workload) {
+				admin := workloads[0].Manifest.(*manifest.LoadBalancedWebService)
+				require.Equal(t, "/", aws.StringValue(admin.Path))
+				require.Equal(t, "nginx", aws.StringValue(admin.ImageConfig.Location))
+				require.Equal(t, uint16(8080), aws.Uint16Value(admin.ImageConfig.Port))
+
+				web := workloads[1].Manifest.(*manifest.LoadBalancedWebService)
+				require.Equal(t, "web", aws.StringValue(web.Path))
+				require.Equal(t, "app/web/Dockerfile", aws.StringValue(web.ImageConfig.Build.BuildArgs.Dockerfile))
+				require.Equal(t, uint16(80), aws.Uint16Value(web.ImageConfig.Port))
+			},
+		},
+		"translates other services to Backend Services": {
+			inProject: &Project{
+				Services: map[string]*Service{
+					"api": {
+						Build: Build{
+							Context:    ".",
+							Dockerfile: "api.Dockerfile",
+							Args:       map[string]string{"VERSION": "1"},
+						},
+						Expose:      []stringOrInt{"3000"},
+						Environment: Environment{"LOG_LEVEL": aws.String("info"), "TOKEN": nil},
+						Command:     stringOrStrings{StringSlice: []string{"npm", "start"}},
+						DependsOn:   DependsOn{"db"},
+						Volumes: []Volume{
+							{Type: volumeTypeVolume, Source: "data", Target: "/var/lib/data", ReadOnly: true},
+							{Type: volumeTypeVolume, Target: "/var/cache"},
+							{Type: volumeTypeBind, Source: "./static", Target: "/static"},
+						},
+					},
+					"db": {
+						Image:      "postgres",
+						Entrypoint: stringOrStrings{String: aws.String("docker-entrypoint.sh")},
+					},
+				},
+				unsupportedKeys: []string{"networks"},
+			},
+			inComposeDir: "/workspace",
+			inWsRoot:     "/workspace",
+
+			wantedWorkloads: []Workload{
+				{Name: "api", Type: manifest.BackendServiceType},
+				{Name: "db", Type: manifest.BackendServiceType},
+			},
+			wantedUnsupported: []string{
+				"networks is not supported",
+				"services.api.build: args and target are not imported, add them to image.build in the manifest",
+				"services.api.environment.TOKEN: values from the shell are not supported, set it in variables or secrets",
+				"services.api.volumes: bind mount of /static is not supported, add the files to the image or use a named volume",
+				"services.api.depends_on: db is deployed independently, api can reach it at db.${COPILOT_SERVICE_DISCOVERY_ENDPOINT}",
+			},
+			checkManifests: func(t *testing.T, workloads []Workload) {
+				api := workloads[0].Manifest.(*manifest.BackendService)
+				require.Equal(t, "api.Dockerfile", aws.StringValue(api.ImageConfig.Build.BuildArgs.Dockerfile))
+				require.Equal(t, uint16(3000), aws.Uint16Value(api.ImageConfig.Port))
+				require.Equal(t, map[string]string{"LOG_LEVEL": "info"}, api.Variables)
+				require.Equal(t, []string{"npm", "start"}, api.Command.StringSlice)
+				require.Equal(t, map[string]manifest.Volume{
+					"data": {
+						EFS: &manifest.EFSConfigOrBool{Enabled: aws.Bool(true)},
+						MountPointOpts: manifest.MountPointOpts{
+							ContainerPath: aws.String("/var/lib/data"),
+							ReadOnly:      aws.Bool(true),
+						},
+					},
+					"var-cache": {
+						MountPointOpts: manifest.MountPointOpts{
+							ContainerPath: aws.String("/var/cache"),
+						},
+					},
+				}, api.Storage.Volumes)
+
+				db := workloads[1].Manifest.(*manifest.BackendService)
+				require.Equal(t, "postgres", aws.StringValue(db.ImageConfig.Location))
+				require.Equal(t, "docker-entrypoint.sh", aws.StringValue(db.EntryPoint.String))
+				require.Nil(t, db.Storage)
+			},
+		},
+		"translates services sharing a network to sidecars": {
+			inProject: &Project{
+				Services: map[string]*Service{
+					"web": {
+						Image:     "nginx",
+						Ports:     []Port{{Target: 80}},
+						DependsOn: DependsOn{"envoy"},
+					},
+					"envoy": {
+						Image:       "envoyproxy/envoy",
+						Expose:      []stringOrInt{"9901"},
+						NetworkMode: "service:web",
+						Environment: Environment{"ENVOY_UID": aws.String("0")},
+					},
+					"metrics": {
+						Build:       Build{Context: "./metrics"},
+						NetworkMode: "service:web",
+					},
+					"logs": {
+						Image:       "fluent/fluent-bit",
+						NetworkMode: "service:missing",
+					},
+				},
+			},
+			inComposeDir: "/workspace",
+			inWsRoot:     "/workspace",
+
+			wantedWorkloads: []Workload{
+				{Name: "logs", Type: manifest.BackendServiceType},
+				{Name: "metrics", Type: manifest.BackendServiceType},
+				{Name: "web", Type: manifest.LoadBalancedWebServiceType},
+			},
+			wantedUnsupported: []string{
+				"services.logs.network_mode: service missing does not exist",
+				"services.metrics cannot be a sidecar of web without an image, it is imported as a separate service",
+			},
+			checkManifests: func(t *testing.T, workloads []Workload) {
+				web := workloads[2].Manifest.(*manifest.LoadBalancedWebService)
+				require.Equal(t, map[string]*manifest.SidecarConfig{
+					"envoy": {
+						Image:     aws.String("envoyproxy/envoy"),
+						Port:      aws.String("9901"),
+						Variables: map[string]string{"ENVOY_UID": "0"},
+					},
+				}, web.Sidecars)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := Convert(tc.inProject, tc.inComposeDir, tc.inWsRoot)
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, len(tc.wantedWorkloads), len(got.Workloads))
+			for i, wanted := range tc.wantedWorkloads {
+				require.Equal(t, wanted.Name, got.Workloads[i].Name)
+				require.Equal(t, wanted.Type, got.Workloads[i].Type)
+			}
+			require.Equal(t, tc.wantedUnsupported, got.Unsupported)
+			if tc.checkManifests != nil {
+				tc.checkManifests(t, got.Workloads)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -14,12 +15,104 @@ import (
 
 // Application is a named collection of environments and services.
 type Application struct {
-	Name               string            `json:"name"`               // Name of an Application. Must be unique amongst other apps in the same account.
-	AccountID          string            `json:"account"`            // AccountID this app is mastered in.
-	Domain             string            `json:"domain"`             // Existing domain name in Route53. An empty domain name means the user does not have one.
-	DomainHostedZoneID string            `json:"domainHostedZoneID"` // Existing domain hosted zone in Route53. An empty domain name means the user does not have one.
-	Version            string            `json:"version"`            // The version of the app layout in the underlying datastore (e.g. SSM).
-	Tags               map[string]string `json:"tags,omitempty"`     // Labels to apply to resources created within the app.
+	Name                 string             `json:"name"`                           // Name of an Application. Must be unique amongst other apps in the same account.
+	AccountID            string             `json:"account"`                        // AccountID this app is mastered in.
+	Domain               string             `json:"domain"`                         // Existing domain name in Route53. An empty domain name means the user does not have one.
+	DomainHostedZoneID   string             `json:"domainHostedZoneID"`             // Existing domain hosted zone in Route53. An empty domain name means the user does not have one.
+	DomainRoleARN        string             `json:"domainRoleARN,omitempty"`        // IAM role to manage the domain hosted zone when it lives in another account.
+	Version              string             `json:"version"`                        // The version of the app layout in the underlying datastore (e.g. SSM).
+	Tags                 map[string]string  `json:"tags,omitempty"`                 // Labels to apply to resources created within the app.
+	RepositoryPrefix     string             `json:"repositoryPrefix,omitempty"`     // Prefix of the ECR repository names of workloads. Defaults to the app name.
+	PullThroughCaches    []PullThroughCache `json:"pullThroughCaches,omitempty"`    // Upstream registries whose images are cached in ECR.
+	PinnedVersion        string             `json:"pinnedVersion,omitempty"`        // Minimum template version of the app infrastructure. Older CLIs can't update the infrastructure.
+	TaskExecutionRoleARN string             `json:"taskExecutionRoleARN,omitempty"` // Execution role shared by the tasks of all workloads instead of one role per workload.
+}
+
+// Upstream registries that ECR can cache with pull-through cache rules.
+const (
+	ECRPublicRegistry = "ecr-public"
+	QuayRegistry      = "quay"
+	DockerHubRegistry = "docker-hub"
+)
+
+// PullThroughCacheRegistries are the upstream registries that an application can cache in ECR.
+var PullThroughCacheRegistries = []string{ECRPublicRegistry, QuayRegistry, DockerHubRegistry}
+
+var (
+	pullThroughCacheUpstreamURLs = map[string]string{
+		ECRPublicRegistry: "public.ecr.aws",
+		QuayRegistry:      "quay.io",
+		DockerHubRegistry: "registry-1.docker.io",
+	}
+	pullThroughCacheRegistryOfHost = map[string]string{
+		"public.ecr.aws":       ECRPublicRegistry,
+		"quay.io":              QuayRegistry,
+		"docker.io":            DockerHubRegistry,
+		"index.docker.io":      DockerHubRegistry,
+		"registry-1.docker.io": DockerHubRegistry,
+	}
+)
+
+// PullThroughCache is an ECR pull-through cache rule that caches the images of an upstream registry
+// in the application's account.
+type PullThroughCache struct {
+	Registry      string `json:"registry"`                // Upstream registry, one of PullThroughCacheRegistries.
+	CredentialARN string `json:"credentialARN,omitempty"` // Secrets Manager secret with the credentials of the upstream registry.
+}
+
+// UpstreamURL returns the URL of the upstream registry.
+func (c PullThroughCache) UpstreamURL() string {
+	return pullThroughCacheUpstreamURLs[c.Registry]
+}
+
+// RepositoryPrefix returns the prefix of the ECR repositories that cache the images of the upstream registry.
+func (c PullThroughCache) RepositoryPrefix(app string) string {
+	return fmt.Sprintf("%s-%s", app, c.Registry)
+}
+
+// RepositoryName returns the name of the ECR repository that holds the images of a workload.
+func (a *Application) RepositoryName(wkld string) string {
+	prefix := a.Name
+	if a.RepositoryPrefix != "" {
+		prefix = a.RepositoryPrefix
+	}
+	return fmt.Sprintf("%s/%s", prefix, wkld)
+}
+
+// PullThroughImage returns the location of an image in the ECR pull-through cache of its upstream registry
+// in the given region, along with the repository prefix of the cache.
+// If the application doesn't cache the registry of the image, returns empty strings.
+func (a *Application) PullThroughImage(location, region string) (image, repoPrefix string) {
+	host, path := splitImageLocation(location)
+	registry, ok := pullThroughCacheRegistryOfHost[host]
+	if !ok {
+		return "", ""
+	}
+	for _, cache := range a.PullThroughCaches {
+		if cache.Registry != registry {
+			continue
+		}
+		if registry == DockerHubRegistry && !strings.Contains(path, "/") {
+			// Official Docker Hub images live under the "library" namespace.
+			path = fmt.Sprintf("library/%s", path)
+		}
+		repoPrefix = cache.RepositoryPrefix(a.Name)
+		return fmt.Sprintf("%s.dkr.ecr.%s.amazonaws.com/%s/%s", a.AccountID, region, repoPrefix, path), repoPrefix
+	}
+	return "", ""
+}
+
+// splitImageLocation returns the registry host and the repository path of an image location.
+// Locations without a registry host, such as "nginx" or "bitnami/redis", are hosted in Docker Hub.
+func splitImageLocation(location string) (host, path string) {
+	parts := strings.SplitN(location, "/", 2)
+	if len(parts) == 1 {
+		return "docker.io", location
+	}
+	if !strings.ContainsAny(parts[0], ".:") && parts[0] != "localhost" {
+		return "docker.io", location
+	}
+	return parts[0], parts[1]
 }
 
 // RequiresDNSDelegation returns true if we have to set up DNS Delegation resources
@@ -56,6 +149,29 @@ func (s *Store) CreateApplication(application *Application) error {
 	return nil
 }
 
+// UpdateApplication overwrites the configuration of an existing application in SSM.
+func (s *Store) UpdateApplication(application *Application) error {
+	applicationPath := fmt.Sprintf(fmtApplicationPath, application.Name)
+	application.Version = schemaVersion
+
+	data, err := marshal(application)
+	if err != nil {
+		return fmt.Errorf("serializing application %s: %w", application.Name, err)
+	}
+
+	_, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(applicationPath),
+		Description: aws.String("Copilot Application"),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(data),
+		Overwrite:   aws.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("update application %s: %w", application.Name, err)
+	}
+	return nil
+}
+
 // GetApplication fetches an application by name. If it can't be found, return a ErrNoSuchApplication
 func (s *Store) GetApplication(applicationName string) (*Application, error) {
 	applicationPath := fmt.Sprintf(fmtApplicationPath, applicationName)
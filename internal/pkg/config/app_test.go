@@ -299,6 +299,56 @@ func TestStore_CreateApplication(t *testing.T) {
 	}
 }
 
+func TestStore_UpdateApplication(t *testing.T) {
+	testCases := map[string]struct {
+		inApplication *Application
+
+		mockPutParameter func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		wantedErr        error
+	}{
+		"overwrites the application": {
+			inApplication: &Application{Name: "phonetool", AccountID: "1234", PinnedVersion: "v1.1.0"},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, fmt.Sprintf(fmtApplicationPath, "phonetool"), *param.Name)
+				require.Equal(t, fmt.Sprintf(`{"name":"phonetool","account":"1234","domain":"","domainHostedZoneID":"","version":"%s","pinnedVersion":"v1.1.0"}`, schemaVersion), *param.Value)
+				require.True(t, aws.BoolValue(param.Overwrite))
+
+				return &ssm.PutParameterOutput{
+					Version: aws.Int64(2),
+				}, nil
+			},
+		},
+		"with SSM error": {
+			inApplication: &Application{Name: "phonetool", AccountID: "1234"},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, fmt.Errorf("broken")
+			},
+			wantedErr: fmt.Errorf("update application phonetool: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                t,
+					mockPutParameter: tc.mockPutParameter,
+				},
+			}
+
+			// WHEN
+			err := store.UpdateApplication(tc.inApplication)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestDeleteApplication(t *testing.T) {
 	mockApplicationName := "mockApplicationName"
 	mockError := errors.New("mockError")
@@ -349,3 +399,88 @@ func TestDeleteApplication(t *testing.T) {
 		})
 	}
 }
+
+func TestApplication_RepositoryName(t *testing.T) {
+	testCases := map[string]struct {
+		app    Application
+		wanted string
+	}{
+		"defaults to the application name as the prefix": {
+			app: Application{
+				Name: "phonetool",
+			},
+			wanted: "phonetool/frontend",
+		},
+		"uses the repository prefix if set": {
+			app: Application{
+				Name:             "phonetool",
+				RepositoryPrefix: "team-a/phonetool",
+			},
+			wanted: "team-a/phonetool/frontend",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.app.RepositoryName("frontend"))
+		})
+	}
+}
+
+func TestApplication_PullThroughImage(t *testing.T) {
+	app := Application{
+		Name:      "phonetool",
+		AccountID: "123456789012",
+		PullThroughCaches: []PullThroughCache{
+			{Registry: ECRPublicRegistry},
+			{Registry: DockerHubRegistry, CredentialARN: "arn:aws:secretsmanager:us-west-2:123456789012:secret:ecr-pullthroughcache/docker-hub"},
+		},
+	}
+	testCases := map[string]struct {
+		inLocation string
+
+		wantedImage  string
+		wantedPrefix string
+	}{
+		"rewrites an ECR Public image": {
+			inLocation:   "public.ecr.aws/nginx/nginx:1.21",
+			wantedImage:  "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool-ecr-public/nginx/nginx:1.21",
+			wantedPrefix: "phonetool-ecr-public",
+		},
+		"rewrites an official Docker Hub image": {
+			inLocation:   "nginx:latest",
+			wantedImage:  "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool-docker-hub/library/nginx:latest",
+			wantedPrefix: "phonetool-docker-hub",
+		},
+		"rewrites a Docker Hub image with a namespace": {
+			inLocation:   "bitnami/redis@sha256:abc",
+			wantedImage:  "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool-docker-hub/bitnami/redis@sha256:abc",
+			wantedPrefix: "phonetool-docker-hub",
+		},
+		"rewrites a Docker Hub image with the registry host": {
+			inLocation:   "docker.io/library/redis:6",
+			wantedImage:  "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool-docker-hub/library/redis:6",
+			wantedPrefix: "phonetool-docker-hub",
+		},
+		"does not rewrite images of registries that are not cached": {
+			inLocation: "quay.io/prometheus/node-exporter:v1.2.0",
+		},
+		"does not rewrite images of private registries": {
+			inLocation: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:latest",
+		},
+		"does not rewrite images of a local registry": {
+			inLocation: "localhost:5000/frontend",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			image, prefix := app.PullThroughImage(tc.inLocation, "us-west-2")
+
+			// THEN
+			require.Equal(t, tc.wantedImage, image)
+			require.Equal(t, tc.wantedPrefix, prefix)
+		})
+	}
+}
@@ -9,38 +9,157 @@ import (
 	"sort"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
 // Environment represents a deployment environment in an application.
 type Environment struct {
-	App              string        `json:"app"`                    // Name of the app this environment belongs to.
-	Name             string        `json:"name"`                   // Name of the environment, must be unique within a App.
-	Region           string        `json:"region"`                 // Name of the region this environment is stored in.
-	AccountID        string        `json:"accountID"`              // Account ID of the account this environment is stored in.
-	Prod             bool          `json:"prod"`                   // Whether or not this environment is a production environment.
-	RegistryURL      string        `json:"registryURL"`            // URL For ECR Registry for this environment.
-	ExecutionRoleARN string        `json:"executionRoleARN"`       // ARN used by CloudFormation to make modification to the environment stack.
-	ManagerRoleARN   string        `json:"managerRoleARN"`         // ARN for the manager role assumed to manipulate the environment and its services.
-	CustomConfig     *CustomizeEnv `json:"customConfig,omitempty"` // Custom environment configuration by users.
+	App                  string        `json:"app"`                            // Name of the app this environment belongs to.
+	Name                 string        `json:"name"`                           // Name of the environment, must be unique within a App.
+	Region               string        `json:"region"`                         // Name of the region this environment is stored in.
+	AccountID            string        `json:"accountID"`                      // Account ID of the account this environment is stored in.
+	Prod                 bool          `json:"prod"`                           // Whether or not this environment is a production environment.
+	RegistryURL          string        `json:"registryURL"`                    // URL For ECR Registry for this environment.
+	ExecutionRoleARN     string        `json:"executionRoleARN"`               // ARN used by CloudFormation to make modification to the environment stack.
+	ManagerRoleARN       string        `json:"managerRoleARN"`                 // ARN for the manager role assumed to manipulate the environment and its services.
+	TaskExecutionRoleARN string        `json:"taskExecutionRoleARN,omitempty"` // Execution role shared by the tasks of the workloads in the environment. Overrides the application's role.
+	CustomConfig         *CustomizeEnv `json:"customConfig,omitempty"`         // Custom environment configuration by users.
 }
 
+// DefaultLogGroupPrefix is the prefix of the log groups of workloads when the environment doesn't override it.
+const DefaultLogGroupPrefix = "/copilot"
+
 // CustomizeEnv represents the custom environment config.
 type CustomizeEnv struct {
-	ImportVPC *ImportVPC `json:"importVPC,omitempty"`
-	VPCConfig *AdjustVPC `json:"adjustVPC,omitempty"`
+	ImportVPC     *ImportVPC        `json:"importVPC,omitempty"`
+	VPCConfig     *AdjustVPC        `json:"adjustVPC,omitempty"`
+	ResourceNames *EnvResourceNames `json:"resourceNames,omitempty"`
+	HTTPSListener *HTTPSListener    `json:"httpsListener,omitempty"`
+	Domain        *EnvDomain        `json:"domain,omitempty"`
+
+	ExternalInstances bool `json:"externalInstances,omitempty"` // True if on-premises instances can be registered to the cluster with ECS Anywhere.
+	IPv6              bool `json:"ipv6,omitempty"`              // True if the VPC and the public load balancer are dual-stack.
 }
 
 // NewCustomizeEnv returns a new CustomizeEnv struct.
-func NewCustomizeEnv(importVPC *ImportVPC, adjustVPC *AdjustVPC) *CustomizeEnv {
-	if importVPC == nil && adjustVPC == nil {
+func NewCustomizeEnv(importVPC *ImportVPC, adjustVPC *AdjustVPC, names *EnvResourceNames, listener *HTTPSListener, domain *EnvDomain, externalInstances, ipv6 bool) *CustomizeEnv {
+	if importVPC == nil && adjustVPC == nil && names == nil && listener == nil && domain == nil && !externalInstances && !ipv6 {
 		return nil
 	}
 	return &CustomizeEnv{
-		ImportVPC: importVPC,
-		VPCConfig: adjustVPC,
+		ImportVPC:         importVPC,
+		VPCConfig:         adjustVPC,
+		ResourceNames:     names,
+		HTTPSListener:     listener,
+		Domain:            domain,
+		ExternalInstances: externalInstances,
+		IPv6:              ipv6,
+	}
+}
+
+// EnvResourceNames holds the names that override the ones generated by CloudFormation for environment resources.
+type EnvResourceNames struct {
+	Cluster        string `json:"cluster,omitempty"`        // Name of the ECS cluster.
+	LoadBalancer   string `json:"loadBalancer,omitempty"`   // Name of the public Application Load Balancer.
+	LogGroupPrefix string `json:"logGroupPrefix,omitempty"` // Prefix of the log groups of the workloads deployed in the environment.
+}
+
+// EnvDomain holds the domain of an environment that doesn't use a subdomain of the application's domain.
+type EnvDomain struct {
+	Name         string `json:"name"`         // Domain name of the environment, such as "dev.example.dev".
+	HostedZoneID string `json:"hostedZoneID"` // Public hosted zone of the domain in the environment's account.
+}
+
+// Mutual TLS modes of the HTTPS listener.
+const (
+	MutualTLSModeVerify      = "verify"      // The load balancer verifies client certificates against a trust store.
+	MutualTLSModePassthrough = "passthrough" // The load balancer forwards client certificates to the targets in HTTP headers.
+)
+
+// MutualTLSModes are the supported mutual TLS modes of the HTTPS listener.
+var MutualTLSModes = []string{MutualTLSModeVerify, MutualTLSModePassthrough}
+
+// HTTPSListener holds the TLS settings of the HTTPS listener of the environment's public load balancer.
+type HTTPSListener struct {
+	CertificateARNs []string      `json:"certificateARNs,omitempty"` // ACM certificates, such as ones issued by a Private CA, added to the listener.
+	SSLPolicy       string        `json:"sslPolicy,omitempty"`       // Security policy of the supported TLS protocols and ciphers.
+	MutualTLS       *MutualTLS    `json:"mutualTLS,omitempty"`       // Client certificate verification settings.
+	HSTS            string        `json:"hsts,omitempty"`            // Value of the Strict-Transport-Security header added to the responses.
+	HTTPRedirect    *HTTPRedirect `json:"httpRedirect,omitempty"`    // Redirect settings of HTTP requests to HTTPS.
+}
+
+// DefaultHTTPRedirectStatusCode is the status code of the redirect from HTTP to HTTPS when the environment doesn't override it.
+const DefaultHTTPRedirectStatusCode = 301
+
+// HTTPRedirect holds the settings to redirect HTTP requests to the HTTPS listener.
+type HTTPRedirect struct {
+	Disabled   bool `json:"disabled,omitempty"`   // True if HTTP requests are forwarded to the services instead of redirected.
+	StatusCode int  `json:"statusCode,omitempty"` // Either 301 or 302.
+}
+
+// MutualTLS holds the settings to authenticate clients with certificates.
+type MutualTLS struct {
+	Mode           string `json:"mode"`                     // One of MutualTLSModes.
+	CABundleBucket string `json:"caBundleBucket,omitempty"` // Bucket of the CA certificates bundle of the trust store in verify mode.
+	CABundleKey    string `json:"caBundleKey,omitempty"`    // Key of the CA certificates bundle of the trust store in verify mode.
+}
+
+// LogGroupPrefix returns the prefix of the log groups of workloads deployed in the environment.
+func (e *Environment) LogGroupPrefix() string {
+	if e.CustomConfig == nil || e.CustomConfig.ResourceNames == nil || e.CustomConfig.ResourceNames.LogGroupPrefix == "" {
+		return DefaultLogGroupPrefix
+	}
+	return e.CustomConfig.ResourceNames.LogGroupPrefix
+}
+
+// HasDomain returns true if the environment has its own domain instead of a subdomain of the application's domain.
+func (e *Environment) HasDomain() bool {
+	return e.CustomConfig != nil && e.CustomConfig.Domain != nil
+}
+
+// HTTPRedirect returns how HTTP requests to the services deployed in the environment are redirected to HTTPS.
+func (e *Environment) HTTPRedirect() HTTPRedirect {
+	if e.CustomConfig == nil || e.CustomConfig.HTTPSListener == nil || e.CustomConfig.HTTPSListener.HTTPRedirect == nil {
+		return HTTPRedirect{
+			StatusCode: DefaultHTTPRedirectStatusCode,
+		}
+	}
+	redirect := *e.CustomConfig.HTTPSListener.HTTPRedirect
+	if !redirect.Disabled && redirect.StatusCode == 0 {
+		redirect.StatusCode = DefaultHTTPRedirectStatusCode
+	}
+	return redirect
+}
+
+// WorkloadLogGroupName returns the name of the log group of a workload deployed in the environment.
+func (e *Environment) WorkloadLogGroupName(wkld string) string {
+	return fmt.Sprintf("%s/%s-%s-%s", e.LogGroupPrefix(), e.App, e.Name, wkld)
+}
+
+// TaskExecutionRole returns the execution role shared by the tasks of the workloads deployed in the environment.
+// The environment's role takes precedence over the application's one. Returns an empty string if
+// each workload creates its own execution role.
+// The application's role is only used if it belongs to the environment's account since ECS can't
+// assume an execution role from another account.
+func (e *Environment) TaskExecutionRole(app *Application) (string, error) {
+	if e.TaskExecutionRoleARN != "" {
+		return e.TaskExecutionRoleARN, nil
+	}
+	if app == nil || app.TaskExecutionRoleARN == "" {
+		return "", nil
+	}
+	parsed, err := arn.Parse(app.TaskExecutionRoleARN)
+	if err != nil {
+		return "", fmt.Errorf("parse task execution role ARN %s of application %s: %w", app.TaskExecutionRoleARN, app.Name, err)
+	}
+	if parsed.AccountID != e.AccountID {
+		return "", fmt.Errorf(`task execution role %s of application %s is in account %s but environment %s is in account %s
+Please run "copilot env init --task-execution-role" with a role in account %s`,
+			app.TaskExecutionRoleARN, app.Name, parsed.AccountID, e.Name, e.AccountID, e.AccountID)
 	}
+	return app.TaskExecutionRoleARN, nil
 }
 
 // ImportVPC holds the fields to import VPC resources.
@@ -386,3 +386,198 @@ func TestStore_DeleteEnvironment(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironment_WorkloadLogGroupName(t *testing.T) {
+	testCases := map[string]struct {
+		env    Environment
+		wanted string
+	}{
+		"defaults to the copilot prefix": {
+			env: Environment{
+				App:  "phonetool",
+				Name: "test",
+			},
+			wanted: "/copilot/phonetool-test-api",
+		},
+		"defaults to the copilot prefix if only other names are overridden": {
+			env: Environment{
+				App:  "phonetool",
+				Name: "test",
+				CustomConfig: &CustomizeEnv{
+					ResourceNames: &EnvResourceNames{
+						Cluster: "team-a-cluster",
+					},
+				},
+			},
+			wanted: "/copilot/phonetool-test-api",
+		},
+		"uses the log group prefix if set": {
+			env: Environment{
+				App:  "phonetool",
+				Name: "test",
+				CustomConfig: &CustomizeEnv{
+					ResourceNames: &EnvResourceNames{
+						LogGroupPrefix: "/team-a/ecs",
+					},
+				},
+			},
+			wanted: "/team-a/ecs/phonetool-test-api",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.env.WorkloadLogGroupName("api"))
+		})
+	}
+}
+
+func TestEnvironment_HasDomain(t *testing.T) {
+	testCases := map[string]struct {
+		env    Environment
+		wanted bool
+	}{
+		"no custom configuration": {
+			env:    Environment{},
+			wanted: false,
+		},
+		"subdomain of the application's domain": {
+			env: Environment{
+				CustomConfig: &CustomizeEnv{
+					IPv6: true,
+				},
+			},
+			wanted: false,
+		},
+		"own domain": {
+			env: Environment{
+				CustomConfig: &CustomizeEnv{
+					Domain: &EnvDomain{
+						Name:         "dev.example.com",
+						HostedZoneID: "Z0123",
+					},
+				},
+			},
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.env.HasDomain())
+		})
+	}
+}
+
+func TestEnvironment_HTTPRedirect(t *testing.T) {
+	testCases := map[string]struct {
+		env    Environment
+		wanted HTTPRedirect
+	}{
+		"defaults to a permanent redirect": {
+			env: Environment{
+				CustomConfig: &CustomizeEnv{
+					HTTPSListener: &HTTPSListener{
+						SSLPolicy: "ELBSecurityPolicy-TLS13-1-2-2021-06",
+					},
+				},
+			},
+			wanted: HTTPRedirect{
+				StatusCode: 301,
+			},
+		},
+		"uses the status code if set": {
+			env: Environment{
+				CustomConfig: &CustomizeEnv{
+					HTTPSListener: &HTTPSListener{
+						HTTPRedirect: &HTTPRedirect{
+							StatusCode: 302,
+						},
+					},
+				},
+			},
+			wanted: HTTPRedirect{
+				StatusCode: 302,
+			},
+		},
+		"disables the redirect": {
+			env: Environment{
+				CustomConfig: &CustomizeEnv{
+					HTTPSListener: &HTTPSListener{
+						HTTPRedirect: &HTTPRedirect{
+							Disabled: true,
+						},
+					},
+				},
+			},
+			wanted: HTTPRedirect{
+				Disabled: true,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.env.HTTPRedirect())
+		})
+	}
+}
+
+func TestEnvironment_TaskExecutionRole(t *testing.T) {
+	testCases := map[string]struct {
+		env         Environment
+		app         *Application
+		wanted      string
+		wantedError error
+	}{
+		"no shared role": {
+			app: &Application{},
+		},
+		"uses the application's role": {
+			env: Environment{
+				AccountID: "123456789012",
+			},
+			app: &Application{
+				TaskExecutionRoleARN: "arn:aws:iam::123456789012:role/app-execution-role",
+			},
+			wanted: "arn:aws:iam::123456789012:role/app-execution-role",
+		},
+		"the environment's role overrides the application's role": {
+			env: Environment{
+				AccountID:            "210987654321",
+				TaskExecutionRoleARN: "arn:aws:iam::210987654321:role/env-execution-role",
+			},
+			app: &Application{
+				TaskExecutionRoleARN: "arn:aws:iam::123456789012:role/app-execution-role",
+			},
+			wanted: "arn:aws:iam::210987654321:role/env-execution-role",
+		},
+		"error if the application's role is in another account": {
+			env: Environment{
+				Name:      "test",
+				AccountID: "210987654321",
+			},
+			app: &Application{
+				Name:                 "phonetool",
+				TaskExecutionRoleARN: "arn:aws:iam::123456789012:role/app-execution-role",
+			},
+			wantedError: errors.New(`task execution role arn:aws:iam::123456789012:role/app-execution-role of application phonetool is in account 123456789012 but environment test is in account 210987654321
+Please run "copilot env init --task-execution-role" with a role in account 210987654321`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := tc.env.TaskExecutionRole(tc.app)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/spf13/afero"
+)
+
+const (
+	localConfigDirName = ".copilot"
+	targetsFileName    = "targets.json"
+)
+
+// Target is the identity, account and region of the credentials that commands use to modify an environment.
+type Target struct {
+	Account string `json:"account"`
+	ARN     string `json:"arn"`    // ARN of the user, or of the assumed role without its session name.
+	Region  string `json:"region"` // Region of the session that the application's configuration is read from.
+}
+
+// NewTarget returns the Target of the caller of the AWS APIs in the region.
+// The session name of an assumed role is dropped, since it usually changes every time credentials are issued.
+func NewTarget(caller identity.Caller, region string) Target {
+	return Target{
+		Account: caller.Account,
+		ARN:     callerARN(caller.ARN),
+		Region:  region,
+	}
+}
+
+// callerARN turns the ARN of an assumed role session, "arn:aws:sts::1234:assumed-role/Admin/alice",
+// into the ARN of the assumed role, "arn:aws:sts::1234:assumed-role/Admin". Other ARNs are returned unchanged.
+func callerARN(callerARN string) string {
+	parsed, err := arn.Parse(callerARN)
+	if err != nil || parsed.Service != "sts" {
+		return callerARN
+	}
+	parts := strings.Split(parsed.Resource, "/")
+	if len(parts) != 3 || parts[0] != "assumed-role" {
+		return callerARN
+	}
+	parsed.Resource = strings.Join(parts[:2], "/")
+	return parsed.String()
+}
+
+// TargetStore records the last Target used for each environment on the local machine.
+type TargetStore struct {
+	fs   afero.Fs
+	path string // Path to the JSON file holding the targets keyed by application and environment name.
+}
+
+// NewTargetStore returns a TargetStore backed by the $HOME/.copilot/targets.json file.
+func NewTargetStore() (*TargetStore, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("get home directory: %w", err)
+	}
+	return &TargetStore{
+		fs:   afero.NewOsFs(),
+		path: filepath.Join(homeDir, localConfigDirName, targetsFileName),
+	}, nil
+}
+
+// LastTarget returns the Target last recorded for the environment.
+// If no Target was recorded on this machine, it returns nil and no errors.
+func (s *TargetStore) LastTarget(appName, envName string) (*Target, error) {
+	targets, err := s.targets()
+	if err != nil {
+		return nil, err
+	}
+	target, ok := targets[targetKey(appName, envName)]
+	if !ok {
+		return nil, nil
+	}
+	// Targets recorded before session names were dropped still compare equal to the new ones.
+	target.ARN = callerARN(target.ARN)
+	return &target, nil
+}
+
+// SaveTarget records the Target as the last one used for the environment.
+func (s *TargetStore) SaveTarget(appName, envName string, target Target) error {
+	targets, err := s.targets()
+	if err != nil {
+		return err
+	}
+	targets[targetKey(appName, envName)] = target
+	data, err := json.MarshalIndent(targets, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal targets: %w", err)
+	}
+	if err := s.fs.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("create directory %s: %w", filepath.Dir(s.path), err)
+	}
+	if err := afero.WriteFile(s.fs, s.path, data, 0644 /* -rw-r--r-- */); err != nil {
+		return fmt.Errorf("write file %s: %w", s.path, err)
+	}
+	return nil
+}
+
+func (s *TargetStore) targets() (map[string]Target, error) {
+	targets := make(map[string]Target)
+	exists, err := afero.Exists(s.fs, s.path)
+	if err != nil {
+		return nil, fmt.Errorf("check if file %s exists: %w", s.path, err)
+	}
+	if !exists {
+		return targets, nil
+	}
+	data, err := afero.ReadFile(s.fs, s.path)
+	if err != nil {
+		return nil, fmt.Errorf("read file %s: %w", s.path, err)
+	}
+	if err := json.Unmarshal(data, &targets); err != nil {
+		return nil, fmt.Errorf("unmarshal targets from %s: %w", s.path, err)
+	}
+	return targets, nil
+}
+
+func targetKey(appName, envName string) string {
+	return fmt.Sprintf("%s/%s", appName, envName)
+}
@@ -0,0 +1,160 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTarget(t *testing.T) {
+	testCases := map[string]struct {
+		inCaller identity.Caller
+
+		wantedTarget Target
+	}{
+		"keeps the ARN of an IAM user": {
+			inCaller: identity.Caller{
+				RootUserARN: "arn:aws:iam::1234:root",
+				Account:     "1234",
+				UserID:      "AIDAEXAMPLE",
+				ARN:         "arn:aws:iam::1234:user/alice",
+			},
+			wantedTarget: Target{
+				Account: "1234",
+				ARN:     "arn:aws:iam::1234:user/alice",
+				Region:  "us-west-2",
+			},
+		},
+		"drops the session name of an assumed role": {
+			inCaller: identity.Caller{
+				RootUserARN: "arn:aws:iam::1234:root",
+				Account:     "1234",
+				UserID:      "AROAEXAMPLE:alice@example.com",
+				ARN:         "arn:aws:sts::1234:assumed-role/AWSReservedSSO_Admin_0123456789abcdef/alice@example.com",
+			},
+			wantedTarget: Target{
+				Account: "1234",
+				ARN:     "arn:aws:sts::1234:assumed-role/AWSReservedSSO_Admin_0123456789abcdef",
+				Region:  "us-west-2",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			target := NewTarget(tc.inCaller, "us-west-2")
+
+			// THEN
+			require.Equal(t, tc.wantedTarget, target)
+		})
+	}
+}
+
+func TestNewTarget_RoleSessions(t *testing.T) {
+	// WHEN
+	first := NewTarget(identity.Caller{
+		Account: "1234",
+		ARN:     "arn:aws:sts::1234:assumed-role/Admin/aws-go-sdk-1602547200000000000",
+	}, "us-west-2")
+	second := NewTarget(identity.Caller{
+		Account: "1234",
+		ARN:     "arn:aws:sts::1234:assumed-role/Admin/botocore-session-1602547260",
+	}, "us-west-2")
+
+	// THEN
+	require.Equal(t, first, second, "expected sessions of the same role to have the same target")
+}
+
+func TestTargetStore_LastTarget(t *testing.T) {
+	testCases := map[string]struct {
+		setupFS func(fs afero.Fs)
+
+		wantedTarget *Target
+		wantedErr    string
+	}{
+		"returns nil if no targets were recorded": {
+			setupFS: func(fs afero.Fs) {},
+		},
+		"returns nil if the environment was never targeted": {
+			setupFS: func(fs afero.Fs) {
+				afero.WriteFile(fs, "/home/.copilot/targets.json", []byte(`{"phonetool/test": {"account": "1234", "arn": "arn:aws:sts::1234:assumed-role/Admin/alice", "region": "us-west-2"}}`), 0644)
+			},
+		},
+		"returns the recorded target of the environment without its role session name": {
+			setupFS: func(fs afero.Fs) {
+				afero.WriteFile(fs, "/home/.copilot/targets.json", []byte(`{"phonetool/prod": {"account": "5678", "arn": "arn:aws:sts::5678:assumed-role/Admin/alice", "region": "us-east-1"}}`), 0644)
+			},
+			wantedTarget: &Target{
+				Account: "5678",
+				ARN:     "arn:aws:sts::5678:assumed-role/Admin",
+				Region:  "us-east-1",
+			},
+		},
+		"wraps the error of a malformed file": {
+			setupFS: func(fs afero.Fs) {
+				afero.WriteFile(fs, "/home/.copilot/targets.json", []byte(`{`), 0644)
+			},
+			wantedErr: "unmarshal targets from /home/.copilot/targets.json: unexpected end of JSON input",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			tc.setupFS(fs)
+			s := &TargetStore{
+				fs:   fs,
+				path: "/home/.copilot/targets.json",
+			}
+
+			// WHEN
+			target, err := s.LastTarget("phonetool", "prod")
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTarget, target)
+		})
+	}
+}
+
+func TestTargetStore_SaveTarget(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	s := &TargetStore{
+		fs:   fs,
+		path: "/home/.copilot/targets.json",
+	}
+	test := Target{
+		Account: "1234",
+		ARN:     "arn:aws:sts::1234:assumed-role/Admin",
+		Region:  "us-west-2",
+	}
+	prod := Target{
+		Account: "5678",
+		ARN:     "arn:aws:iam::5678:user/alice",
+		Region:  "us-east-1",
+	}
+
+	// WHEN
+	require.NoError(t, s.SaveTarget("phonetool", "test", test))
+	require.NoError(t, s.SaveTarget("phonetool", "prod", prod))
+
+	// THEN
+	gotTest, err := s.LastTarget("phonetool", "test")
+	require.NoError(t, err)
+	require.Equal(t, &test, gotTest)
+	gotProd, err := s.LastTarget("phonetool", "prod")
+	require.NoError(t, err)
+	require.Equal(t, &prod, gotProd)
+}
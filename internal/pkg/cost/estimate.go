@@ -0,0 +1,322 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cost provides functionality to approximate the monthly cost of the resources in a CloudFormation template.
+package cost
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/pricing"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	hoursPerMonth = 730
+
+	// Display settings.
+	minCellWidth           = 20  // minimum number of characters in a table's cell.
+	tabWidth               = 4   // number of characters in between columns.
+	cellPaddingWidth       = 2   // number of padding characters added by default to a cell.
+	paddingChar            = ' ' // character in between columns.
+	noAdditionalFormatting = 0
+)
+
+// Human-readable explanations of the conditions of Copilot's environment template.
+var conditionDescriptions = map[string]string{
+	"CreateNATGateways": "Once a workload is placed in private subnets.",
+	"CreateALB":         "Once a Load Balanced Web Service is deployed.",
+	"CreateGPUCapacity": "Once a workload requests GPUs.",
+}
+
+// Resources that have no fixed hourly price.
+var usageBasedResources = map[string]string{
+	"AWS::DynamoDB::Table":  "DynamoDB table",
+	"AWS::S3::Bucket":       "S3 bucket",
+	"AWS::EFS::FileSystem":  "EFS file system",
+	"AWS::Lambda::Function": "Lambda function",
+}
+
+type pricer interface {
+	OnDemandPrice(in pricing.PriceInput) (float64, error)
+}
+
+// LineItem is the approximate monthly cost of identical resources.
+type LineItem struct {
+	Description string  // For example, "NAT Gateway".
+	Quantity    int     // Number of resources, or of capacity units such as Aurora capacity units.
+	UnitPrice   float64 // Monthly price in USD of a single unit.
+	Condition   string  // Optional. When the resources are created if they're not always created.
+}
+
+// MonthlyCost returns the monthly cost in USD of the line item.
+func (i LineItem) MonthlyCost() float64 {
+	return float64(i.Quantity) * i.UnitPrice
+}
+
+// Estimate is the approximate monthly cost of the resources in a region.
+type Estimate struct {
+	Region     string
+	Items      []LineItem
+	UsageBased []string // Resources only billed for what they use, such as S3 buckets.
+}
+
+// MonthlyTotal returns the monthly cost in USD of the line items that are always created.
+func (e *Estimate) MonthlyTotal() float64 {
+	var total float64
+	for _, item := range e.Items {
+		if item.Condition == "" {
+			total += item.MonthlyCost()
+		}
+	}
+	return total
+}
+
+// HumanString returns the estimate as tables of line items.
+func (e *Estimate) HumanString() string {
+	var always, conditional []LineItem
+	for _, item := range e.Items {
+		if item.Condition == "" {
+			always = append(always, item)
+			continue
+		}
+		conditional = append(conditional, item)
+	}
+
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprintf("Estimated monthly cost in %s\n\n", e.Region))
+	writer.Flush()
+	headers := []string{"Resource", "Quantity", "Monthly cost"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, item := range always {
+		fmt.Fprintf(writer, "  %s\t%d\t%s\n", item.Description, item.Quantity, dollars(item.MonthlyCost()))
+	}
+	fmt.Fprintf(writer, "  %s\t\t%s\n", color.Emphasize("Total"), dollars(e.MonthlyTotal()))
+	writer.Flush()
+	if len(conditional) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nCreated when needed\n\n"))
+		writer.Flush()
+		headers := []string{"Resource", "Quantity", "Monthly cost", "When"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, item := range conditional {
+			fmt.Fprintf(writer, "  %s\t%d\t%s\t%s\n", item.Description, item.Quantity, dollars(item.MonthlyCost()), item.Condition)
+		}
+		writer.Flush()
+	}
+	if len(e.UsageBased) != 0 {
+		fmt.Fprintf(writer, "\n  Billed by usage: %s.\n", strings.Join(e.UsageBased, ", "))
+	}
+	fmt.Fprintf(writer, "\n  Prices are on-demand rates for %d hours a month, excluding data transfer and taxes.\n", hoursPerMonth)
+	writer.Flush()
+	return b.String()
+}
+
+// Estimator approximates the monthly cost of resources with on-demand prices.
+type Estimator struct {
+	region string
+	pricer pricer
+}
+
+// New returns an Estimator that prices resources in the region.
+func New(region string, pricer pricer) *Estimator {
+	return &Estimator{
+		region: region,
+		pricer: pricer,
+	}
+}
+
+// lineItem is a group of identical resources to price.
+type lineItem struct {
+	description string
+	condition   string
+	quantity    int
+	price       pricing.PriceInput
+}
+
+// Estimate returns the approximate monthly cost of the billable resources in the CloudFormation template.
+// Resources created under a condition of the template are listed separately from the total.
+func (e *Estimator) Estimate(tpl []byte) (*Estimate, error) {
+	t, err := parseTemplate(tpl)
+	if err != nil {
+		return nil, err
+	}
+	est := &Estimate{
+		Region: e.region,
+	}
+	var groups []*lineItem
+	groupByKey := make(map[string]*lineItem)
+	usageBased := make(map[string]bool)
+	for _, id := range t.logicalIDs() {
+		r := t.Resources[id]
+		if desc, ok := usageBasedResources[r.Type]; ok {
+			if !usageBased[desc] {
+				usageBased[desc] = true
+				est.UsageBased = append(est.UsageBased, desc)
+			}
+			continue
+		}
+		desc, quantity, price, ok := e.billable(t, r)
+		if !ok {
+			continue
+		}
+		condition := describeCondition(r.Condition)
+		key := fmt.Sprintf("%s/%s", desc, condition)
+		group, ok := groupByKey[key]
+		if !ok {
+			group = &lineItem{
+				description: desc,
+				condition:   condition,
+				price:       price,
+			}
+			groupByKey[key] = group
+			groups = append(groups, group)
+		}
+		group.quantity += quantity
+	}
+	for _, group := range groups {
+		hourly, err := e.pricer.OnDemandPrice(group.price)
+		if err != nil {
+			return nil, fmt.Errorf("get price of %s: %w", group.description, err)
+		}
+		est.Items = append(est.Items, LineItem{
+			Description: group.description,
+			Quantity:    group.quantity,
+			UnitPrice:   hourly * hoursPerMonth,
+			Condition:   group.condition,
+		})
+	}
+	return est, nil
+}
+
+// FargateTask returns the line item of a Fargate task running all month with the cpu units and memory in MiB.
+// Set a condition to exclude the task from the total of an estimate.
+func (e *Estimator) FargateTask(cpu, memory int, condition string) (LineItem, error) {
+	vCPUPrice, err := e.pricer.OnDemandPrice(pricing.PriceInput{
+		ServiceCode: "AmazonECS",
+		Region:      e.region,
+		UsageType:   "Fargate-vCPU-Hours:perCPU",
+	})
+	if err != nil {
+		return LineItem{}, fmt.Errorf("get price of Fargate vCPU: %w", err)
+	}
+	memoryPrice, err := e.pricer.OnDemandPrice(pricing.PriceInput{
+		ServiceCode: "AmazonECS",
+		Region:      e.region,
+		UsageType:   "Fargate-GB-Hours",
+	})
+	if err != nil {
+		return LineItem{}, fmt.Errorf("get price of Fargate memory: %w", err)
+	}
+	vCPU, gb := float64(cpu)/1024, float64(memory)/1024
+	return LineItem{
+		Description: fmt.Sprintf("Fargate task (%s vCPU, %s GB)", formatFloat(vCPU), formatFloat(gb)),
+		Quantity:    1,
+		UnitPrice:   (vCPU*vCPUPrice + gb*memoryPrice) * hoursPerMonth,
+		Condition:   condition,
+	}, nil
+}
+
+// billable returns the description, the number of units, and the price input of a resource with an hourly price.
+// It returns false if the resource isn't billed hourly or if its size can't be determined from the template.
+func (e *Estimator) billable(t *cfnTemplate, r cfnResource) (desc string, quantity int, price pricing.PriceInput, ok bool) {
+	price.Region = e.region
+	switch r.Type {
+	case "AWS::EC2::NatGateway":
+		price.ServiceCode = "AmazonEC2"
+		price.UsageType = "NatGateway-Hours"
+		price.Filters = map[string]string{"productFamily": "NAT Gateway"}
+		return "NAT Gateway", 1, price, true
+	case "AWS::ElasticLoadBalancingV2::LoadBalancer":
+		price.ServiceCode = "AWSELB"
+		price.UsageType = "LoadBalancerUsage"
+		if lbType, _ := t.property(r, "Type"); lbType == "network" {
+			price.Filters = map[string]string{"productFamily": "Load Balancer-Network"}
+			return "Network Load Balancer", 1, price, true
+		}
+		price.Filters = map[string]string{"productFamily": "Load Balancer-Application"}
+		return "Application Load Balancer", 1, price, true
+	case "AWS::RDS::DBCluster":
+		mode, _ := t.property(r, "EngineMode")
+		minCapacity, hasMin := t.property(r, "ScalingConfiguration", "MinCapacity")
+		acus, err := strconv.Atoi(minCapacity)
+		if mode != "serverless" || !hasMin || err != nil {
+			return "", 0, price, false
+		}
+		engine, _ := t.property(r, "Engine")
+		price.ServiceCode = "AmazonRDS"
+		price.UsageType = "Aurora:ServerlessUsage"
+		price.Filters = map[string]string{"databaseEngine": rdsEngines[engine]}
+		return "Aurora Serverless minimum capacity (ACUs)", acus, price, true
+	case "AWS::RDS::DBInstance":
+		class, hasClass := t.property(r, "DBInstanceClass")
+		engine, _ := t.property(r, "Engine")
+		if !hasClass || rdsEngines[engine] == "" {
+			return "", 0, price, false
+		}
+		usage := "InstanceUsage"
+		if multiAZ, _ := t.property(r, "MultiAZ"); multiAZ == "true" {
+			usage = "Multi-AZUsage"
+		}
+		price.ServiceCode = "AmazonRDS"
+		price.UsageType = fmt.Sprintf("%s:%s", usage, class)
+		price.Filters = map[string]string{"databaseEngine": rdsEngines[engine]}
+		return fmt.Sprintf("RDS instance (%s)", class), 1, price, true
+	case "AWS::ElastiCache::ReplicationGroup":
+		nodeType, hasNodeType := t.property(r, "CacheNodeType")
+		if !hasNodeType {
+			return "", 0, price, false
+		}
+		nodes := 1
+		if n, ok := t.property(r, "NumCacheClusters"); ok {
+			if parsed, err := strconv.Atoi(n); err == nil {
+				nodes = parsed
+			}
+		}
+		price.ServiceCode = "AmazonElastiCache"
+		price.UsageType = fmt.Sprintf("NodeUsage:%s", nodeType)
+		price.Filters = map[string]string{"cacheEngine": "Redis"}
+		return fmt.Sprintf("ElastiCache node (%s)", nodeType), nodes, price, true
+	}
+	return "", 0, price, false
+}
+
+// Database engines of CloudFormation mapped to their name in the price list.
+var rdsEngines = map[string]string{
+	"aurora-mysql":      "Aurora MySQL",
+	"aurora-postgresql": "Aurora PostgreSQL",
+	"mysql":             "MySQL",
+	"postgres":          "PostgreSQL",
+}
+
+func describeCondition(condition string) string {
+	if condition == "" {
+		return ""
+	}
+	if desc, ok := conditionDescriptions[condition]; ok {
+		return desc
+	}
+	return fmt.Sprintf("If condition %s is true.", condition)
+}
+
+func dollars(amount float64) string {
+	return fmt.Sprintf("$%.2f", amount)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func underline(headings []string) []string {
+	var lines []string
+	for _, heading := range headings {
+		lines = append(lines, strings.Repeat("-", len(heading)))
+	}
+	return lines
+}
@@ -0,0 +1,301 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cost
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/pricing"
+	"github.com/aws/copilot-cli/internal/pkg/cost/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimator_Estimate(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplate string
+		mockPricer func(m *mocks.Mockpricer)
+
+		wantedEstimate *Estimate
+		wantedErr      error
+	}{
+		"errors if the template is invalid": {
+			inTemplate: "Resources: [",
+			mockPricer: func(m *mocks.Mockpricer) {},
+			wantedErr:  errors.New("unmarshal template: yaml: line 1: did not find expected node content"),
+		},
+		"wraps the error of the price list": {
+			inTemplate: `
+Resources:
+  NatGateway1:
+    Type: AWS::EC2::NatGateway
+`,
+			mockPricer: func(m *mocks.Mockpricer) {
+				m.EXPECT().OnDemandPrice(gomock.Any()).Return(0.0, errors.New("some error"))
+			},
+			wantedErr: errors.New("get price of NAT Gateway: some error"),
+		},
+		"groups identical resources and separates conditional ones": {
+			inTemplate: `
+Resources:
+  NatGateway1:
+    Type: AWS::EC2::NatGateway
+    Condition: CreateNATGateways
+  NatGateway2:
+    Type: AWS::EC2::NatGateway
+    Condition: CreateNATGateways
+  PublicLoadBalancer:
+    Type: AWS::ElasticLoadBalancingV2::LoadBalancer
+    Condition: CreateALB
+    Properties:
+      Scheme: internet-facing
+  Cluster:
+    Type: AWS::ECS::Cluster
+  Bucket:
+    Type: AWS::S3::Bucket
+`,
+			mockPricer: func(m *mocks.Mockpricer) {
+				m.EXPECT().OnDemandPrice(pricing.PriceInput{
+					ServiceCode: "AmazonEC2",
+					Region:      "us-west-2",
+					UsageType:   "NatGateway-Hours",
+					Filters:     map[string]string{"productFamily": "NAT Gateway"},
+				}).Return(0.045, nil)
+				m.EXPECT().OnDemandPrice(pricing.PriceInput{
+					ServiceCode: "AWSELB",
+					Region:      "us-west-2",
+					UsageType:   "LoadBalancerUsage",
+					Filters:     map[string]string{"productFamily": "Load Balancer-Application"},
+				}).Return(0.0225, nil)
+			},
+			wantedEstimate: &Estimate{
+				Region: "us-west-2",
+				Items: []LineItem{
+					{
+						Description: "NAT Gateway",
+						Quantity:    2,
+						UnitPrice:   0.045 * hoursPerMonth,
+						Condition:   "Once a workload is placed in private subnets.",
+					},
+					{
+						Description: "Application Load Balancer",
+						Quantity:    1,
+						UnitPrice:   0.0225 * hoursPerMonth,
+						Condition:   "Once a Load Balanced Web Service is deployed.",
+					},
+				},
+				UsageBased: []string{"S3 bucket"},
+			},
+		},
+		"prices the minimum capacity of an Aurora Serverless cluster": {
+			inTemplate: `
+Mappings:
+  dbEnvScalingConfigurationMap:
+    test:
+      DBMinCapacity: 2
+      DBMaxCapacity: 8
+Resources:
+  dbDBCluster:
+    Type: 'AWS::RDS::DBCluster'
+    Properties:
+      Engine: 'aurora-postgresql'
+      EngineMode: serverless
+      ScalingConfiguration:
+        MinCapacity: !FindInMap [dbEnvScalingConfigurationMap, !Ref Env, DBMinCapacity]
+`,
+			mockPricer: func(m *mocks.Mockpricer) {
+				m.EXPECT().OnDemandPrice(pricing.PriceInput{
+					ServiceCode: "AmazonRDS",
+					Region:      "us-west-2",
+					UsageType:   "Aurora:ServerlessUsage",
+					Filters:     map[string]string{"databaseEngine": "Aurora PostgreSQL"},
+				}).Return(0.06, nil)
+			},
+			wantedEstimate: &Estimate{
+				Region: "us-west-2",
+				Items: []LineItem{
+					{
+						Description: "Aurora Serverless minimum capacity (ACUs)",
+						Quantity:    2,
+						UnitPrice:   0.06 * hoursPerMonth,
+					},
+				},
+			},
+		},
+		"prices RDS instances and ElastiCache nodes": {
+			inTemplate: `
+Mappings:
+  cacheEnvConfigurationMap:
+    test:
+      NodeType: cache.t3.micro
+      NumCacheClusters: 2
+Resources:
+  dbDBInstance:
+    Type: 'AWS::RDS::DBInstance'
+    Properties:
+      Engine: 'mysql'
+      DBInstanceClass: db.t3.micro
+      MultiAZ: true
+  cacheReplicationGroup:
+    Type: 'AWS::ElastiCache::ReplicationGroup'
+    Properties:
+      CacheNodeType: !FindInMap [cacheEnvConfigurationMap, !Ref Env, NodeType]
+      NumCacheClusters: !FindInMap [cacheEnvConfigurationMap, !Ref Env, NumCacheClusters]
+`,
+			mockPricer: func(m *mocks.Mockpricer) {
+				m.EXPECT().OnDemandPrice(pricing.PriceInput{
+					ServiceCode: "AmazonElastiCache",
+					Region:      "us-west-2",
+					UsageType:   "NodeUsage:cache.t3.micro",
+					Filters:     map[string]string{"cacheEngine": "Redis"},
+				}).Return(0.017, nil)
+				m.EXPECT().OnDemandPrice(pricing.PriceInput{
+					ServiceCode: "AmazonRDS",
+					Region:      "us-west-2",
+					UsageType:   "Multi-AZUsage:db.t3.micro",
+					Filters:     map[string]string{"databaseEngine": "MySQL"},
+				}).Return(0.034, nil)
+			},
+			wantedEstimate: &Estimate{
+				Region: "us-west-2",
+				Items: []LineItem{
+					{
+						Description: "ElastiCache node (cache.t3.micro)",
+						Quantity:    2,
+						UnitPrice:   0.017 * hoursPerMonth,
+					},
+					{
+						Description: "RDS instance (db.t3.micro)",
+						Quantity:    1,
+						UnitPrice:   0.034 * hoursPerMonth,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockpricer(ctrl)
+			tc.mockPricer(m)
+			estimator := New("us-west-2", m)
+
+			// WHEN
+			est, err := estimator.Estimate([]byte(tc.inTemplate))
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEstimate, est)
+		})
+	}
+}
+
+func TestEstimator_FargateTask(t *testing.T) {
+	testCases := map[string]struct {
+		mockPricer func(m *mocks.Mockpricer)
+
+		wantedItem LineItem
+		wantedErr  error
+	}{
+		"wraps the error of the vCPU price": {
+			mockPricer: func(m *mocks.Mockpricer) {
+				m.EXPECT().OnDemandPrice(gomock.Any()).Return(0.0, errors.New("some error"))
+			},
+			wantedErr: errors.New("get price of Fargate vCPU: some error"),
+		},
+		"prices the vCPU and memory of the task": {
+			mockPricer: func(m *mocks.Mockpricer) {
+				m.EXPECT().OnDemandPrice(pricing.PriceInput{
+					ServiceCode: "AmazonECS",
+					Region:      "us-west-2",
+					UsageType:   "Fargate-vCPU-Hours:perCPU",
+				}).Return(0.04, nil)
+				m.EXPECT().OnDemandPrice(pricing.PriceInput{
+					ServiceCode: "AmazonECS",
+					Region:      "us-west-2",
+					UsageType:   "Fargate-GB-Hours",
+				}).Return(0.004, nil)
+			},
+			wantedItem: LineItem{
+				Description: "Fargate task (0.25 vCPU, 0.5 GB)",
+				Quantity:    1,
+				UnitPrice:   (0.25*0.04 + 0.5*0.004) * hoursPerMonth,
+				Condition:   "Per running task.",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockpricer(ctrl)
+			tc.mockPricer(m)
+			estimator := New("us-west-2", m)
+
+			// WHEN
+			item, err := estimator.FargateTask(256, 512, "Per running task.")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedItem, item)
+		})
+	}
+}
+
+func TestEstimate_HumanString(t *testing.T) {
+	// GIVEN
+	est := &Estimate{
+		Region: "us-west-2",
+		Items: []LineItem{
+			{
+				Description: "Aurora Serverless minimum capacity (ACUs)",
+				Quantity:    2,
+				UnitPrice:   43.8,
+			},
+			{
+				Description: "NAT Gateway",
+				Quantity:    2,
+				UnitPrice:   32.85,
+				Condition:   "Once a workload is placed in private subnets.",
+			},
+		},
+		UsageBased: []string{"S3 bucket"},
+	}
+
+	// WHEN
+	human := est.HumanString()
+
+	// THEN
+	require.Equal(t, `Estimated monthly cost in us-west-2
+
+  Resource                                   Quantity            Monthly cost
+  --------                                   --------            ------------
+  Aurora Serverless minimum capacity (ACUs)  2                   $87.60
+  Total                                                          $87.60
+
+Created when needed
+
+  Resource          Quantity            Monthly cost        When
+  --------          --------            ------------        ----
+  NAT Gateway       2                   $65.70              Once a workload is placed in private subnets.
+
+  Billed by usage: S3 bucket.
+
+  Prices are on-demand rates for 730 hours a month, excluding data transfer and taxes.
+`, human)
+}
@@ -0,0 +1,100 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cost
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const findInMapTag = "!FindInMap"
+
+// cfnTemplate holds the sections of a CloudFormation template needed to estimate its cost.
+type cfnTemplate struct {
+	Mappings  map[string]map[string]map[string]interface{} `yaml:"Mappings"`
+	Resources map[string]cfnResource                       `yaml:"Resources"`
+}
+
+type cfnResource struct {
+	Type       string    `yaml:"Type"`
+	Condition  string    `yaml:"Condition"`
+	Properties yaml.Node `yaml:"Properties"`
+}
+
+func parseTemplate(tpl []byte) (*cfnTemplate, error) {
+	var t cfnTemplate
+	if err := yaml.Unmarshal(tpl, &t); err != nil {
+		return nil, fmt.Errorf("unmarshal template: %w", err)
+	}
+	return &t, nil
+}
+
+// logicalIDs returns the logical IDs of the resources in alphabetical order.
+func (t *cfnTemplate) logicalIDs() []string {
+	var ids []string
+	for id := range t.Resources {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// property returns the value of the property at the path under the resource's Properties.
+// Values looked up with Fn::FindInMap are resolved against the first top-level key of the mapping since
+// Copilot renders the same values for every environment.
+// It returns false if the property doesn't exist or can't be resolved to a scalar.
+func (t *cfnTemplate) property(r cfnResource, path ...string) (string, bool) {
+	node := &r.Properties
+	for _, key := range path {
+		node = child(node, key)
+		if node == nil {
+			return "", false
+		}
+	}
+	switch {
+	case node.Kind == yaml.ScalarNode && !isIntrinsicFunction(node.Tag):
+		return node.Value, true
+	case node.Tag == findInMapTag && node.Kind == yaml.SequenceNode && len(node.Content) == 3:
+		return t.findInMap(node.Content[0].Value, node.Content[2].Value)
+	}
+	return "", false
+}
+
+func (t *cfnTemplate) findInMap(mapName, key string) (string, bool) {
+	mapping, ok := t.Mappings[mapName]
+	if !ok || len(mapping) == 0 {
+		return "", false
+	}
+	var topLevelKeys []string
+	for k := range mapping {
+		topLevelKeys = append(topLevelKeys, k)
+	}
+	sort.Strings(topLevelKeys)
+	value, ok := mapping[topLevelKeys[0]][key]
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprint(value), true
+}
+
+// isIntrinsicFunction returns true if the tag is a short form intrinsic function such as !Ref,
+// as opposed to a standard YAML tag such as !!bool.
+func isIntrinsicFunction(tag string) bool {
+	return strings.HasPrefix(tag, "!") && !strings.HasPrefix(tag, "!!")
+}
+
+func child(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -5,20 +5,62 @@
 // This file defines application deployment resources.
 package deploy
 
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"golang.org/x/mod/semver"
+)
+
 // CreateAppInput holds the fields required to create an application stack set.
 type CreateAppInput struct {
-	Name                  string            // Name of the application that needs to be created.
-	AccountID             string            // AWS account ID to administrate the application.
-	DNSDelegationAccounts []string          // Accounts to grant DNS access to for this application.
-	DomainName            string            // DNS Name used for this application.
-	DomainHostedZoneID    string            // Hosted Zone ID for the domain.
-	AdditionalTags        map[string]string // AdditionalTags are labels applied to resources under the application.
-	Version               string            // The version of the application template to create the stack/stackset. If empty, creates the legacy stack/stackset.
+	Name                  string                    // Name of the application that needs to be created.
+	AccountID             string                    // AWS account ID to administrate the application.
+	DNSDelegationAccounts []string                  // Accounts to grant DNS access to for this application.
+	DomainName            string                    // DNS Name used for this application.
+	DomainHostedZoneID    string                    // Hosted Zone ID for the domain.
+	DomainRoleARN         string                    // IAM role to assume to manage the domain hosted zone when it's in another account.
+	AdditionalTags        map[string]string         // AdditionalTags are labels applied to resources under the application.
+	Version               string                    // The version of the application template to create the stack/stackset. If empty, creates the legacy stack/stackset.
+	RepositoryPrefix      string                    // Prefix of the ECR repository names of workloads. Defaults to the application name.
+	PullThroughCaches     []config.PullThroughCache // Upstream registries whose images are cached in ECR.
 }
 
 const (
 	// LegacyAppTemplateVersion is the version associated with the application template before we started versioning.
 	LegacyAppTemplateVersion = "v0.0.0"
 	// LatestAppTemplateVersion is the latest version number available for application templates.
-	LatestAppTemplateVersion = "v1.0.1"
+	LatestAppTemplateVersion = "v1.1.0"
 )
+
+// ErrAppVersionPinned occurs when an application is pinned to a newer template version than the latest one of the CLI.
+type ErrAppVersionPinned struct {
+	App           string
+	PinnedVersion string
+}
+
+func (e *ErrAppVersionPinned) Error() string {
+	return fmt.Sprintf("application %s is pinned to template version %s, which is newer than version %s of this Copilot CLI",
+		e.App, e.PinnedVersion, LatestAppTemplateVersion)
+}
+
+// ValidateAppTemplateVersion returns an ErrTemplateVersionSkew if the application stacks were deployed
+// with a newer template version than LatestAppTemplateVersion.
+func ValidateAppTemplateVersion(app, version string) error {
+	return validateTemplateVersion("application", app, version, LatestAppTemplateVersion)
+}
+
+// ValidateAppVersion returns an ErrAppVersionPinned if the infrastructure of the application can't be updated
+// with the latest application template, so that an older CLI doesn't downgrade the infrastructure.
+func ValidateAppVersion(app *config.Application) error {
+	if app.PinnedVersion == "" {
+		return nil
+	}
+	if semver.Compare(LatestAppTemplateVersion, app.PinnedVersion) < 0 {
+		return &ErrAppVersionPinned{
+			App:           app.Name,
+			PinnedVersion: app.PinnedVersion,
+		}
+	}
+	return nil
+}
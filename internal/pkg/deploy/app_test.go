@@ -0,0 +1,89 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAppVersion(t *testing.T) {
+	testCases := map[string]struct {
+		inPinnedVersion string
+
+		wantedErr error
+	}{
+		"should not error if the application is not pinned": {},
+		"should not error if the application is pinned to the latest version": {
+			inPinnedVersion: LatestAppTemplateVersion,
+		},
+		"should not error if the application is pinned to an older version": {
+			inPinnedVersion: "v1.0.0",
+		},
+		"should error if the application is pinned to a newer version": {
+			inPinnedVersion: "v99.0.0",
+			wantedErr: &ErrAppVersionPinned{
+				App:           "phonetool",
+				PinnedVersion: "v99.0.0",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			err := ValidateAppVersion(&config.Application{
+				Name:          "phonetool",
+				PinnedVersion: tc.inPinnedVersion,
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateAppTemplateVersion(t *testing.T) {
+	testCases := map[string]struct {
+		inVersion string
+
+		wantedErr error
+	}{
+		"should not error if the application is on a legacy version": {
+			inVersion: LegacyAppTemplateVersion,
+		},
+		"should not error if the application is on the latest version": {
+			inVersion: LatestAppTemplateVersion,
+		},
+		"should error if the application is on a newer version": {
+			inVersion: "v99.0.0",
+			wantedErr: &ErrTemplateVersionSkew{
+				Kind:            "application",
+				Name:            "phonetool",
+				DeployedVersion: "v99.0.0",
+				LatestVersion:   LatestAppTemplateVersion,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			err := ValidateAppTemplateVersion("phonetool", tc.inVersion)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.Equal(t, tc.wantedErr, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -38,7 +38,9 @@ func (cf CloudFormation) DeployApp(in *deploy.CreateAppInput) error {
 	}
 
 	blankAppTemplate, err := appConfig.ResourceTemplate(&stack.AppResourcesConfig{
-		App: appConfig.Name,
+		App:               appConfig.Name,
+		RepositoryPrefix:  in.RepositoryPrefix,
+		PullThroughCaches: stack.NewPullThroughCacheRules(in.PullThroughCaches),
 	})
 	if err != nil {
 		return err
@@ -63,6 +65,53 @@ func (cf CloudFormation) UpgradeApplication(in *deploy.CreateAppInput) error {
 	return cf.upgradeAppStackSet(appConfig)
 }
 
+// AppUpgradePreview holds the deployed and the upgraded templates of the infrastructure roles stack
+// and of the StackSet of an application.
+type AppUpgradePreview struct {
+	StackName                string
+	DeployedStackTemplate    string
+	StackTemplate            string
+	StackSetName             string
+	DeployedStackSetTemplate string
+	StackSetTemplate         string
+}
+
+// PreviewApplicationUpgrade returns the templates that UpgradeApplication deploys along with the deployed ones,
+// without updating the application.
+func (cf CloudFormation) PreviewApplicationUpgrade(in *deploy.CreateAppInput) (*AppUpgradePreview, error) {
+	appConfig := stack.NewAppStackConfig(in)
+	tpl, err := appConfig.Template()
+	if err != nil {
+		return nil, fmt.Errorf("generate template of stack %s: %w", appConfig.StackName(), err)
+	}
+	deployedTpl, err := cf.cfnClient.TemplateBody(appConfig.StackName())
+	if err != nil {
+		return nil, fmt.Errorf("get template of stack %s: %w", appConfig.StackName(), err)
+	}
+	descr, err := cf.appStackSet.Describe(appConfig.StackSetName())
+	if err != nil {
+		return nil, fmt.Errorf("describe stack set %s: %w", appConfig.StackSetName(), err)
+	}
+	deployedConfig, err := stack.AppConfigFrom(&descr.Template)
+	if err != nil {
+		return nil, fmt.Errorf("parse previous deployed stackset %w", err)
+	}
+	// The version of the StackSet metadata is left as is since it's incremented on every update.
+	deployedConfig.App = appConfig.Name
+	stackSetTpl, err := appConfig.ResourceTemplate(deployedConfig)
+	if err != nil {
+		return nil, fmt.Errorf("generate template of stack set %s: %w", appConfig.StackSetName(), err)
+	}
+	return &AppUpgradePreview{
+		StackName:                appConfig.StackName(),
+		DeployedStackTemplate:    deployedTpl,
+		StackTemplate:            tpl,
+		StackSetName:             appConfig.StackSetName(),
+		DeployedStackSetTemplate: descr.Template,
+		StackSetTemplate:         stackSetTpl,
+	}, nil
+}
+
 func (cf CloudFormation) upgradeAppStackSet(config *stack.AppStackConfig) error {
 	for {
 		ssName := config.StackSetName()
@@ -121,11 +170,15 @@ func (cf CloudFormation) upgradeAppStack(s *cloudformation.Stack) error {
 // DelegateDNSPermissions grants the provided account ID the ability to write to this application's
 // DNS HostedZone. This allows us to perform cross account DNS delegation.
 func (cf CloudFormation) DelegateDNSPermissions(app *config.Application, accountID string) error {
+	if err := deploy.ValidateAppVersion(app); err != nil {
+		return err
+	}
 	deployApp := deploy.CreateAppInput{
 		Name:               app.Name,
 		AccountID:          app.AccountID,
 		DomainName:         app.Domain,
 		DomainHostedZoneID: app.DomainHostedZoneID,
+		DomainRoleARN:      app.DomainRoleARN,
 		Version:            deploy.LatestAppTemplateVersion,
 	}
 
@@ -232,6 +285,9 @@ func (cf CloudFormation) AddJobToApp(app *config.Application, jobName string) er
 }
 
 func (cf CloudFormation) addWorkloadToApp(app *config.Application, wlName string) error {
+	if err := deploy.ValidateAppVersion(app); err != nil {
+		return err
+	}
 	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
 		Name:           app.Name,
 		AccountID:      app.AccountID,
@@ -262,10 +318,12 @@ func (cf CloudFormation) addWorkloadToApp(app *config.Application, wlName string
 	wlList = append(wlList, wlName)
 
 	newDeploymentConfig := stack.AppResourcesConfig{
-		Version:  previouslyDeployedConfig.Version + 1,
-		Services: wlList,
-		Accounts: previouslyDeployedConfig.Accounts,
-		App:      appConfig.Name,
+		Version:           previouslyDeployedConfig.Version + 1,
+		Services:          wlList,
+		Accounts:          previouslyDeployedConfig.Accounts,
+		App:               appConfig.Name,
+		RepositoryPrefix:  previouslyDeployedConfig.RepositoryPrefix,
+		PullThroughCaches: previouslyDeployedConfig.PullThroughCaches,
 	}
 	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig); err != nil {
 		return err
@@ -291,6 +349,9 @@ func (cf CloudFormation) RemoveJobFromApp(app *config.Application, jobName strin
 }
 
 func (cf CloudFormation) removeWorkloadFromApp(app *config.Application, wlName string) error {
+	if err := deploy.ValidateAppVersion(app); err != nil {
+		return err
+	}
 	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
 		Name:      app.Name,
 		AccountID: app.AccountID,
@@ -319,10 +380,12 @@ func (cf CloudFormation) removeWorkloadFromApp(app *config.Application, wlName s
 	}
 
 	newDeploymentConfig := stack.AppResourcesConfig{
-		Version:  previouslyDeployedConfig.Version + 1,
-		Services: wlList,
-		Accounts: previouslyDeployedConfig.Accounts,
-		App:      appConfig.Name,
+		Version:           previouslyDeployedConfig.Version + 1,
+		Services:          wlList,
+		Accounts:          previouslyDeployedConfig.Accounts,
+		App:               appConfig.Name,
+		RepositoryPrefix:  previouslyDeployedConfig.RepositoryPrefix,
+		PullThroughCaches: previouslyDeployedConfig.PullThroughCaches,
 	}
 	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig); err != nil {
 		return err
@@ -343,6 +406,9 @@ type AddEnvToAppOpts struct {
 // with new Account IDs in resource policies (KMS Keys and ECR Repos) - and
 // sets up a new stack instance if the environment is in a new region.
 func (cf CloudFormation) AddEnvToApp(opts *AddEnvToAppOpts) error {
+	if err := deploy.ValidateAppVersion(opts.App); err != nil {
+		return err
+	}
 	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
 		Name:           opts.App.Name,
 		AccountID:      opts.App.AccountID,
@@ -371,10 +437,12 @@ func (cf CloudFormation) AddEnvToApp(opts *AddEnvToAppOpts) error {
 	}
 
 	newDeploymentConfig := stack.AppResourcesConfig{
-		Version:  previouslyDeployedConfig.Version + 1,
-		Services: previouslyDeployedConfig.Services,
-		Accounts: accountList,
-		App:      appConfig.Name,
+		Version:           previouslyDeployedConfig.Version + 1,
+		Services:          previouslyDeployedConfig.Services,
+		Accounts:          accountList,
+		App:               appConfig.Name,
+		RepositoryPrefix:  previouslyDeployedConfig.RepositoryPrefix,
+		PullThroughCaches: previouslyDeployedConfig.PullThroughCaches,
 	}
 
 	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig); err != nil {
@@ -443,6 +511,40 @@ func (cf CloudFormation) deployAppConfig(appConfig *stack.AppStackConfig, resour
 
 // addNewAppStackInstances takes an environment and determines if we need to create a new
 // stack instance. We only spin up a new stack instance if the env is in a new region.
+// GrantEnvImageAccess makes sure that the ECR repositories of the application in the environment's region
+// allow the environment's account to pull images. If the account or the region is missing from the
+// application resources, for example because the environment was added by an older version of the CLI
+// or the update was interrupted, the environment is added to the application again.
+func (cf CloudFormation) GrantEnvImageAccess(opts *AddEnvToAppOpts) error {
+	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
+		Name:      opts.App.Name,
+		AccountID: opts.App.AccountID,
+	})
+	deployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
+	if err != nil {
+		return fmt.Errorf("get previous deployed stackset: %w", err)
+	}
+	var hasAccount bool
+	for _, accountID := range deployedConfig.Accounts {
+		if accountID == opts.EnvAccountID {
+			hasAccount = true
+			break
+		}
+	}
+	if hasAccount {
+		summaries, err := cf.appStackSet.InstanceSummaries(appConfig.StackSetName(),
+			stackset.FilterSummariesByAccountID(opts.App.AccountID),
+			stackset.FilterSummariesByRegion(opts.EnvRegion))
+		if err != nil {
+			return fmt.Errorf("list stack instances of %s in region %s: %w", appConfig.StackSetName(), opts.EnvRegion, err)
+		}
+		if len(summaries) != 0 {
+			return nil
+		}
+	}
+	return cf.AddEnvToApp(opts)
+}
+
 func (cf CloudFormation) addNewAppStackInstances(appConfig *stack.AppStackConfig, region string) error {
 	summaries, err := cf.appStackSet.InstanceSummaries(appConfig.StackSetName())
 	if err != nil {
@@ -214,6 +214,95 @@ func TestCloudFormation_UpgradeApplication(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_PreviewApplicationUpgrade(t *testing.T) {
+	deployedStackSetTpl, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+		Services: []string{"frontend"},
+		Accounts: []string{"1234"},
+		Version:  3,
+	}})
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		mockDeployer func(t *testing.T, ctrl *gomock.Controller) *CloudFormation
+
+		wantedErr error
+	}{
+		"error if fail to get the template of the app stack": {
+			mockDeployer: func(t *testing.T, ctrl *gomock.Controller) *CloudFormation {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().TemplateBody("phonetool-infrastructure-roles").Return("", errors.New("some error"))
+				return &CloudFormation{
+					cfnClient: m,
+					box:       templates.Box(),
+				}
+			},
+			wantedErr: errors.New("get template of stack phonetool-infrastructure-roles: some error"),
+		},
+		"error if fail to describe the stack set": {
+			mockDeployer: func(t *testing.T, ctrl *gomock.Controller) *CloudFormation {
+				mockCFNClient := mocks.NewMockcfnClient(ctrl)
+				mockCFNClient.EXPECT().TemplateBody("phonetool-infrastructure-roles").Return("deployed", nil)
+				mockAppStackSet := mocks.NewMockstackSetClient(ctrl)
+				mockAppStackSet.EXPECT().Describe("phonetool-infrastructure").Return(stackset.Description{}, errors.New("some error"))
+				return &CloudFormation{
+					cfnClient:   mockCFNClient,
+					appStackSet: mockAppStackSet,
+					box:         templates.Box(),
+				}
+			},
+			wantedErr: errors.New("describe stack set phonetool-infrastructure: some error"),
+		},
+		"success": {
+			mockDeployer: func(t *testing.T, ctrl *gomock.Controller) *CloudFormation {
+				mockCFNClient := mocks.NewMockcfnClient(ctrl)
+				mockCFNClient.EXPECT().TemplateBody("phonetool-infrastructure-roles").Return("deployed", nil)
+				mockAppStackSet := mocks.NewMockstackSetClient(ctrl)
+				mockAppStackSet.EXPECT().Describe("phonetool-infrastructure").Return(stackset.Description{
+					Template: string(deployedStackSetTpl),
+				}, nil)
+				return &CloudFormation{
+					cfnClient:   mockCFNClient,
+					appStackSet: mockAppStackSet,
+					box:         templates.Box(),
+				}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := tc.mockDeployer(t, ctrl)
+
+			// WHEN
+			preview, err := cf.PreviewApplicationUpgrade(&deploy.CreateAppInput{
+				Name:      "phonetool",
+				AccountID: "1234",
+				Version:   deploy.LatestAppTemplateVersion,
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "phonetool-infrastructure-roles", preview.StackName)
+			require.Equal(t, "deployed", preview.DeployedStackTemplate)
+			require.Contains(t, preview.StackTemplate, "AdministrationRole")
+			require.Equal(t, "phonetool-infrastructure", preview.StackSetName)
+			require.Equal(t, string(deployedStackSetTpl), preview.DeployedStackSetTemplate)
+			upgradedConfig, err := stack.AppConfigFrom(&preview.StackSetTemplate)
+			require.NoError(t, err)
+			require.Equal(t, []string{"frontend"}, upgradedConfig.Services)
+			require.Equal(t, []string{"1234"}, upgradedConfig.Accounts)
+			require.Equal(t, 3, upgradedConfig.Version, "the version of the stack set is not incremented")
+		})
+	}
+}
+
 func TestCloudFormation_AddEnvToApp(t *testing.T) {
 	mockApp := config.Application{
 		Name:      "testapp",
@@ -331,6 +420,121 @@ func TestCloudFormation_AddEnvToApp(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_GrantEnvImageAccess(t *testing.T) {
+	mockApp := &config.Application{
+		Name:      "testapp",
+		AccountID: "1234",
+	}
+	deployedTemplate := func(t *testing.T, accounts ...string) string {
+		body, err := yaml.Marshal(stack.DeployedAppMetadata{
+			Metadata: stack.AppResourcesConfig{
+				Accounts: accounts,
+				Version:  1,
+			},
+		})
+		require.NoError(t, err)
+		return string(body)
+	}
+	testCases := map[string]struct {
+		mockStackSet func(t *testing.T, ctrl *gomock.Controller) stackSetClient
+		want         error
+	}{
+		"errors if the stackset cannot be described": {
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{}, errors.New("some error"))
+				return m
+			},
+			want: errors.New("get previous deployed stackset: some error"),
+		},
+		"errors if the stack instances cannot be listed": {
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: deployedTemplate(t, "5678"),
+				}, nil)
+				m.EXPECT().InstanceSummaries("testapp-infrastructure", gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			want: errors.New("list stack instances of testapp-infrastructure in region us-west-2: some error"),
+		},
+		"does nothing if the account and region already have access": {
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: deployedTemplate(t, "5678"),
+				}, nil)
+				m.EXPECT().InstanceSummaries("testapp-infrastructure", gomock.Any(), gomock.Any()).Return([]stackset.InstanceSummary{
+					{
+						Region:  "us-west-2",
+						Account: "1234",
+					},
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"adds the environment account if it's missing": {
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: deployedTemplate(t),
+				}, nil).Times(2)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.EXPECT().InstanceSummaries(gomock.Any()).Return([]stackset.InstanceSummary{
+					{
+						Region:  "us-west-2",
+						Account: "1234",
+					},
+				}, nil)
+				return m
+			},
+		},
+		"adds a stack instance if the region is missing": {
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: deployedTemplate(t, "5678"),
+				}, nil).Times(2)
+				m.EXPECT().InstanceSummaries("testapp-infrastructure", gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.EXPECT().InstanceSummaries(gomock.Any()).Return(nil, nil)
+				m.EXPECT().CreateInstancesAndWait(gomock.Any(), []string{"1234"}, []string{"us-west-2"}).Return(nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := CloudFormation{
+				appStackSet: tc.mockStackSet(t, ctrl),
+				box:         templates.Box(),
+			}
+
+			// WHEN
+			got := cf.GrantEnvImageAccess(&AddEnvToAppOpts{
+				App:          mockApp,
+				EnvName:      "test",
+				EnvAccountID: "5678",
+				EnvRegion:    "us-west-2",
+			})
+
+			// THEN
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_AddPipelineResourcesToApp(t *testing.T) {
 	mockApp := config.Application{
 		Name:      "testapp",
@@ -455,6 +659,18 @@ func TestCloudFormation_AddServiceToApp(t *testing.T) {
 				return m
 			},
 		},
+		"with an application pinned to a newer template version": {
+			app: &config.Application{
+				Name:          "testapp",
+				AccountID:     "1234",
+				PinnedVersion: "v99.0.0",
+			},
+			svcName: "test",
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				return mocks.NewMockstackSetClient(ctrl)
+			},
+			want: fmt.Errorf("adding service test resources to application testapp: application testapp is pinned to template version v99.0.0, which is newer than version %s of this Copilot CLI", deploy.LatestAppTemplateVersion),
+		},
 		"with existing service to existing app with existing services": {
 			app:     &mockApp,
 			svcName: "test",
@@ -0,0 +1,134 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// Labels of the actions that a change set takes on a resource.
+const (
+	addAction          = "Add"
+	modifyAction       = "Modify"
+	replaceAction      = "Replace"
+	maybeReplaceAction = "May replace"
+	removeAction       = "Remove"
+)
+
+// ErrChangeSetDeclined occurs when the changes proposed to a stack are not confirmed.
+type ErrChangeSetDeclined struct {
+	stackName string
+}
+
+func (e *ErrChangeSetDeclined) Error() string {
+	return fmt.Sprintf("changes to stack %s were declined", e.stackName)
+}
+
+// resourceChange is a row of the preview of a change set.
+type resourceChange struct {
+	action       string
+	logicalID    string
+	resourceType string
+}
+
+// writeChangeSetPreview writes the resources that the change set adds, modifies, replaces and removes to w.
+// Replacements are highlighted since they delete the resource and create a new one.
+func writeChangeSetPreview(w io.Writer, stackName string, changes []*sdkcloudformation.Change) error {
+	var rows []resourceChange
+	var added, modified, replaced, removed int
+	for _, change := range changes {
+		rc := change.ResourceChange
+		if rc == nil {
+			continue
+		}
+		row := resourceChange{
+			action:       aws.StringValue(rc.Action),
+			logicalID:    aws.StringValue(rc.LogicalResourceId),
+			resourceType: aws.StringValue(rc.ResourceType),
+		}
+		switch row.action {
+		case sdkcloudformation.ChangeActionAdd:
+			row.action = addAction
+			added++
+		case sdkcloudformation.ChangeActionRemove:
+			row.action = removeAction
+			removed++
+		case sdkcloudformation.ChangeActionModify:
+			switch aws.StringValue(rc.Replacement) {
+			case sdkcloudformation.ReplacementTrue:
+				row.action = replaceAction
+				replaced++
+			case sdkcloudformation.ReplacementConditional:
+				row.action = maybeReplaceAction
+				replaced++
+			default:
+				row.action = modifyAction
+				modified++
+			}
+		default:
+			modified++
+		}
+		rows = append(rows, row)
+	}
+
+	actionWidth, idWidth := 0, 0
+	for _, row := range rows {
+		if len(row.action) > actionWidth {
+			actionWidth = len(row.action)
+		}
+		if len(row.logicalID) > idWidth {
+			idWidth = len(row.logicalID)
+		}
+	}
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Proposed changes to stack %s:\n", stackName))
+	for _, row := range rows {
+		line := fmt.Sprintf("  %s %-*s  %-*s  %s", actionSign(row.action),
+			actionWidth, row.action, idWidth, row.logicalID, row.resourceType)
+		b.WriteString(colorAction(row.action).Sprintln(line))
+	}
+	b.WriteString(fmt.Sprintf("%d to add, %d to modify, %d to replace, %d to remove.\n", added, modified, replaced, removed))
+	if replaced > 0 {
+		b.WriteString(color.Red.Sprintln("Replacing a resource deletes it and creates a new one, which can interrupt the service or lose its data."))
+	}
+	_, err := w.Write([]byte(b.String()))
+	return err
+}
+
+func actionSign(action string) string {
+	switch action {
+	case addAction:
+		return "+"
+	case removeAction:
+		return "-"
+	case replaceAction, maybeReplaceAction:
+		return "!"
+	default:
+		return "~"
+	}
+}
+
+func colorAction(action string) colorizer {
+	switch action {
+	case addAction:
+		return color.Green
+	case removeAction:
+		return color.DullRed
+	case replaceAction, maybeReplaceAction:
+		return color.Red
+	default:
+		return color.Yellow
+	}
+}
+
+// colorizer colors formatted strings.
+type colorizer interface {
+	Sprintln(a ...interface{}) string
+}
@@ -0,0 +1,99 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_writeChangeSetPreview(t *testing.T) {
+	testCases := map[string]struct {
+		inChanges []*sdkcloudformation.Change
+
+		wanted string
+	}{
+		"no resource changes": {
+			wanted: `Proposed changes to stack myapp-test-frontend:
+0 to add, 0 to modify, 0 to replace, 0 to remove.
+`,
+		},
+		"adds, modifies and removes resources": {
+			inChanges: []*sdkcloudformation.Change{
+				{
+					ResourceChange: &sdkcloudformation.ResourceChange{
+						Action:            aws.String(sdkcloudformation.ChangeActionAdd),
+						LogicalResourceId: aws.String("LogGroup"),
+						ResourceType:      aws.String("AWS::Logs::LogGroup"),
+					},
+				},
+				{
+					ResourceChange: &sdkcloudformation.ResourceChange{
+						Action:            aws.String(sdkcloudformation.ChangeActionModify),
+						LogicalResourceId: aws.String("Service"),
+						ResourceType:      aws.String("AWS::ECS::Service"),
+						Replacement:       aws.String(sdkcloudformation.ReplacementFalse),
+					},
+				},
+				{
+					ResourceChange: &sdkcloudformation.ResourceChange{
+						Action:            aws.String(sdkcloudformation.ChangeActionRemove),
+						LogicalResourceId: aws.String("DiscoveryService"),
+						ResourceType:      aws.String("AWS::ServiceDiscovery::Service"),
+					},
+				},
+			},
+			wanted: `Proposed changes to stack myapp-test-frontend:
+  + Add     LogGroup          AWS::Logs::LogGroup
+  ~ Modify  Service           AWS::ECS::Service
+  - Remove  DiscoveryService  AWS::ServiceDiscovery::Service
+1 to add, 1 to modify, 0 to replace, 1 to remove.
+`,
+		},
+		"highlights replacements": {
+			inChanges: []*sdkcloudformation.Change{
+				{
+					ResourceChange: &sdkcloudformation.ResourceChange{
+						Action:            aws.String(sdkcloudformation.ChangeActionModify),
+						LogicalResourceId: aws.String("TaskDefinition"),
+						ResourceType:      aws.String("AWS::ECS::TaskDefinition"),
+						Replacement:       aws.String(sdkcloudformation.ReplacementTrue),
+					},
+				},
+				{
+					ResourceChange: &sdkcloudformation.ResourceChange{
+						Action:            aws.String(sdkcloudformation.ChangeActionModify),
+						LogicalResourceId: aws.String("TargetGroup"),
+						ResourceType:      aws.String("AWS::ElasticLoadBalancingV2::TargetGroup"),
+						Replacement:       aws.String(sdkcloudformation.ReplacementConditional),
+					},
+				},
+			},
+			wanted: `Proposed changes to stack myapp-test-frontend:
+  ! Replace      TaskDefinition  AWS::ECS::TaskDefinition
+  ! May replace  TargetGroup     AWS::ElasticLoadBalancingV2::TargetGroup
+0 to add, 0 to modify, 2 to replace, 0 to remove.
+Replacing a resource deletes it and creates a new one, which can interrupt the service or lose its data.
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			buf := new(strings.Builder)
+
+			// WHEN
+			err := writeChangeSetPreview(buf, "myapp-test-frontend", tc.inChanges)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, buf.String())
+		})
+	}
+}
@@ -37,8 +37,13 @@ const (
 	// waitForStackTimeout is how long we're willing to wait for a stack to go from in progress to a complete state.
 	waitForStackTimeout = 1*time.Hour + 30*time.Minute
 
+	// estimatedOperations is the number of latest stack operations, including the ongoing one,
+	// whose events are read to estimate how long the resources take to deploy.
+	estimatedOperations = 3
+
 	// CloudFormation resource types.
 	ecsServiceResourceType    = "AWS::ECS::Service"
+	stackResourceType         = "AWS::CloudFormation::Stack"
 	envControllerResourceType = "Custom::EnvControllerFunction"
 )
 
@@ -62,14 +67,19 @@ type cfnClient interface {
 	Update(*cloudformation.Stack) (string, error)
 	UpdateAndWait(*cloudformation.Stack) error
 	WaitForUpdate(ctx context.Context, stackName string) error
+	CreateChangeSet(*cloudformation.Stack) (string, error)
+	ExecuteChangeSet(changeSetID, stackName string) error
+	DeleteChangeSet(changeSetID, stackName string) error
 	Delete(stackName string) error
 	DeleteAndWait(stackName string) error
 	DeleteAndWaitWithRoleARN(stackName, roleARN string) error
+	CancelUpdateAndWait(stackName string) error
 	Describe(stackName string) (*cloudformation.StackDescription, error)
 	DescribeChangeSet(changeSetID, stackName string) (*cloudformation.ChangeSetDescription, error)
 	TemplateBody(stackName string) (string, error)
 	TemplateBodyFromChangeSet(changeSetID, stackName string) (string, error)
 	Events(stackName string) ([]cloudformation.StackEvent, error)
+	RecentEvents(stackName string, operations int) ([]cloudformation.StackEvent, error)
 	ListStacksWithTags(tags map[string]string) ([]cloudformation.StackDescription, error)
 	ErrorEvents(stackName string) ([]cloudformation.StackEvent, error)
 	Outputs(stack *cloudformation.Stack) (map[string]string, error)
@@ -110,6 +120,8 @@ type CloudFormation struct {
 	appStackSet    stackSetClient
 	box            packd.Box
 	s3Client       s3Client
+	progressMode   progress.Mode
+	streamerOpts   []stream.StackStreamerOption // Options of the streamers of stack events.
 }
 
 // New returns a configured CloudFormation client.
@@ -124,9 +136,11 @@ func New(sess *session.Session) CloudFormation {
 				Region: aws.String(region),
 			}))
 		},
-		appStackSet: stackset.New(sess),
-		box:         templates.Box(),
-		s3Client:    s3.New(sess),
+		appStackSet:  stackset.New(sess),
+		box:          templates.Box(),
+		s3Client:     s3.New(sess),
+		progressMode: progress.CurrentMode(),
+		streamerOpts: stream.StackStreamerOptionsFromEnv(),
 	}
 	return client
 }
@@ -203,28 +217,130 @@ func (cf CloudFormation) renderStackChanges(in *renderStackChangesInput) error {
 	defer cancelWait()
 	g, ctx := errgroup.WithContext(waitCtx)
 
-	renderer, err := cf.createChangeSetRenderer(g, ctx, changeSetID, in.stackName, in.stackDescription, progress.RenderOptions{})
-	if err != nil {
-		return err
+	if cf.progressMode == progress.PlainMode {
+		renderer, err := cf.createPlainChangeSetRenderer(g, ctx, changeSetID, in.stackName)
+		if err != nil {
+			return err
+		}
+		g.Go(func() error {
+			return progress.RenderPlain(ctx, in.w, renderer)
+		})
+	} else {
+		renderer, err := cf.createChangeSetRenderer(&changeSetRendererInput{
+			g:           g,
+			ctx:         ctx,
+			changeSetID: changeSetID,
+			stackName:   in.stackName,
+			description: in.stackDescription,
+		})
+		if err != nil {
+			return err
+		}
+		g.Go(func() error {
+			return progress.Render(ctx, progress.NewTabbedFileWriter(in.w), renderer)
+		})
 	}
-	g.Go(func() error {
-		return progress.Render(ctx, progress.NewTabbedFileWriter(in.w), renderer)
-	})
 	if err := g.Wait(); err != nil {
 		return err
 	}
+	cf.writeDeploySummary(in.w, in.stackName)
 	if err := cf.errOnFailedStack(in.stackName); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (cf CloudFormation) createChangeSetRenderer(group *errgroup.Group, ctx context.Context, changeSetID, stackName, description string, opts progress.RenderOptions) (progress.DynamicRenderer, error) {
-	changeSet, err := cf.cfnClient.DescribeChangeSet(changeSetID, stackName)
+// writeDeploySummary prints how long each resource of the stack took to deploy.
+// The summary is best effort: the stack operation is already over, so errors are not surfaced.
+func (cf CloudFormation) writeDeploySummary(w io.Writer, stackName string) {
+	events, err := cf.cfnClient.RecentEvents(stackName, 1)
+	if err != nil {
+		return
+	}
+	summary := newDeploySummary(stackName, events)
+	if summary == nil {
+		return
+	}
+	_ = summary.write(w)
+}
+
+// ErrStackNotInProgress occurs when there is no update of the stack in progress to render.
+type ErrStackNotInProgress struct {
+	stackName string
+	status    string
+}
+
+func (e *ErrStackNotInProgress) Error() string {
+	return fmt.Sprintf("stack %s is not being updated: its status is %s", e.stackName, e.status)
+}
+
+// renderInProgressStackChanges renders an update of the stack that was started elsewhere, such as by a pipeline.
+// If the update executes a change set, the changes are rendered the same way as the ones of a local deployment.
+// Otherwise the changed resources are unknown, and every event of the stack is written as a single line.
+func (cf CloudFormation) renderInProgressStackChanges(w progress.FileWriter, stackName, description string) error {
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		return fmt.Errorf("describe stack %s: %w", stackName, err)
+	}
+	status := aws.StringValue(descr.StackStatus)
+	if !cloudformation.StackStatus(status).InProgress() {
+		return &ErrStackNotInProgress{
+			stackName: stackName,
+			status:    status,
+		}
+	}
+	if changeSetID := aws.StringValue(descr.ChangeSetId); changeSetID != "" {
+		changeSet, err := cf.cfnClient.DescribeChangeSet(changeSetID, stackName)
+		if err == nil && changeSet.ExecutionStatus == sdkcloudformation.ExecutionStatusExecuteInProgress {
+			return cf.renderStackChanges(&renderStackChangesInput{
+				w:                w,
+				stackName:        stackName,
+				stackDescription: description,
+				createChangeSet: func() (string, error) {
+					return changeSetID, nil
+				},
+			})
+		}
+	}
+
+	since := aws.TimeValue(descr.CreationTime)
+	if descr.LastUpdatedTime != nil {
+		since = aws.TimeValue(descr.LastUpdatedTime)
+	}
+	waitCtx, cancelWait := context.WithTimeout(context.Background(), waitForStackTimeout)
+	defer cancelWait()
+	g, ctx := errgroup.WithContext(waitCtx)
+	streamer := stream.NewStackStreamer(cf.cfnClient, stackName, since, cf.streamerOpts...)
+	renderer := progress.ListeningPlainChangeSetRenderer(streamer, stackName, nil)
+	g.Go(func() error {
+		return stream.Stream(ctx, streamer)
+	})
+	g.Go(func() error {
+		return progress.RenderPlain(ctx, w, renderer)
+	})
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	cf.writeDeploySummary(w, stackName)
+	return cf.errOnFailedStack(stackName)
+}
+
+type changeSetRendererInput struct {
+	g           *errgroup.Group        // Group that all goroutines belong.
+	ctx         context.Context        // Context associated with the group.
+	changeSetID string                 // ID of the change set being executed.
+	stackName   string                 // Name of the stack.
+	description string                 // Description of the stack.
+	nested      bool                   // Whether the stack is nested, in which case all of its changes are rendered.
+	opts        progress.RenderOptions // Display options that should be applied to the stack.
+}
+
+func (cf CloudFormation) createChangeSetRenderer(in *changeSetRendererInput) (progress.DynamicRenderer, error) {
+	changeSet, err := cf.cfnClient.DescribeChangeSet(in.changeSetID, in.stackName)
 	if err != nil {
 		return nil, err
 	}
-	body, err := cf.cfnClient.TemplateBodyFromChangeSet(changeSetID, stackName)
+	body, err := cf.cfnClient.TemplateBodyFromChangeSet(in.changeSetID, in.stackName)
 	if err != nil {
 		return nil, err
 	}
@@ -232,22 +348,61 @@ func (cf CloudFormation) createChangeSetRenderer(group *errgroup.Group, ctx cont
 	if err != nil {
 		return nil, fmt.Errorf("parse cloudformation template for resource descriptions: %w", err)
 	}
+	pastEvents, err := cf.cfnClient.RecentEvents(in.stackName, estimatedOperations)
+	if err != nil {
+		// The estimates are best effort, render the changes without them.
+		log.Debugf("retrieve past events of stack %s: %v\n", in.stackName, err)
+	}
+	durations := newResourceDurations(pastEvents, changeSet.CreationTime)
 
-	streamer := stream.NewStackStreamer(cf.cfnClient, stackName, changeSet.CreationTime)
+	streamer := stream.NewStackStreamer(cf.cfnClient, in.stackName, changeSet.CreationTime, cf.streamerOpts...)
 	children, err := cf.changeRenderers(changeRenderersInput{
-		g:                  group,
-		ctx:                ctx,
-		stackName:          stackName,
-		stackStreamer:      streamer,
-		changes:            changeSet.Changes,
-		changeSetTimestamp: changeSet.CreationTime,
-		descriptions:       descriptions,
-		opts:               progress.NestedRenderOptions(opts),
+		g:                   in.g,
+		ctx:                 in.ctx,
+		stackName:           in.stackName,
+		stackStreamer:       streamer,
+		changes:             changeSet.Changes,
+		changeSetTimestamp:  changeSet.CreationTime,
+		descriptions:        descriptions,
+		describeByLogicalID: in.nested,
+		durations:           durations,
+		opts:                progress.NestedRenderOptions(in.opts),
 	})
 	if err != nil {
 		return nil, err
 	}
-	renderer := progress.ListeningChangeSetRenderer(streamer, stackName, description, children, opts)
+	renderer := progress.ListeningChangeSetRenderer(streamer, in.stackName, in.description, children, progress.ChangeSetRendererOpts{
+		EstimatedDuration: durations.estimate(in.stackName, stackResourceType),
+		RenderOpts:        in.opts,
+	})
+	in.g.Go(func() error {
+		return stream.Stream(in.ctx, streamer)
+	})
+	return renderer, nil
+}
+
+// createPlainChangeSetRenderer returns a renderer that writes a line for every event of the stack and its nested stacks.
+func (cf CloudFormation) createPlainChangeSetRenderer(group *errgroup.Group, ctx context.Context, changeSetID, stackName string) (progress.DynamicRenderer, error) {
+	changeSet, err := cf.cfnClient.DescribeChangeSet(changeSetID, stackName)
+	if err != nil {
+		return nil, err
+	}
+	var nestedStacks []progress.Renderer
+	for _, change := range changeSet.Changes {
+		if change.ResourceChange.ChangeSetId == nil {
+			continue
+		}
+		nestedChangeSetID := aws.StringValue(change.ResourceChange.ChangeSetId)
+		nestedStackName := parseStackNameFromARN(aws.StringValue(change.ResourceChange.PhysicalResourceId))
+		r, err := cf.createPlainChangeSetRenderer(group, ctx, nestedChangeSetID, nestedStackName)
+		if err != nil {
+			return nil, err
+		}
+		nestedStacks = append(nestedStacks, r)
+	}
+
+	streamer := stream.NewStackStreamer(cf.cfnClient, stackName, changeSet.CreationTime, cf.streamerOpts...)
+	renderer := progress.ListeningPlainChangeSetRenderer(streamer, stackName, nestedStacks)
 	group.Go(func() error {
 		return stream.Stream(ctx, streamer)
 	})
@@ -262,7 +417,11 @@ type changeRenderersInput struct {
 	changes            []*sdkcloudformation.Change // List of changes that will be applied to the stack.
 	changeSetTimestamp time.Time                   // ChangeSet creation time.
 	descriptions       map[string]string           // Descriptions for the logical IDs of the changes.
-	opts               progress.RenderOptions      // Display options that should be applied to the changes.
+	// Render changes without a description with their logical ID instead of filtering them out.
+	// Templates of nested stacks, like addons, are written by users and rarely have descriptions.
+	describeByLogicalID bool
+	durations           resourceDurations      // Durations of the resources in past deployments to estimate the time remaining.
+	opts                progress.RenderOptions // Display options that should be applied to the changes.
 }
 
 // changeRenderers filters changes by resources that have a description and returns the appropriate progress.Renderer for each resource type.
@@ -271,12 +430,16 @@ func (cf CloudFormation) changeRenderers(in changeRenderersInput) ([]progress.Re
 	for _, change := range in.changes {
 		logicalID := aws.StringValue(change.ResourceChange.LogicalResourceId)
 		description, ok := in.descriptions[logicalID]
-		if !ok {
+		if !ok && !in.describeByLogicalID {
 			continue
 		}
+		if !ok {
+			description = logicalID
+		}
+		resourceType := aws.StringValue(change.ResourceChange.ResourceType)
 		var renderer progress.Renderer
 		switch {
-		case aws.StringValue(change.ResourceChange.ResourceType) == envControllerResourceType:
+		case resourceType == envControllerResourceType:
 			r, err := cf.createEnvControllerRenderer(&envControllerRendererInput{
 				g:                 in.g,
 				ctx:               in.ctx,
@@ -291,25 +454,44 @@ func (cf CloudFormation) changeRenderers(in changeRenderersInput) ([]progress.Re
 				return nil, err
 			}
 			renderer = r
-		case aws.StringValue(change.ResourceChange.ResourceType) == ecsServiceResourceType:
+		case resourceType == ecsServiceResourceType:
 			renderer = progress.ListeningECSServiceResourceRenderer(in.stackStreamer, cf.ecsClient, logicalID, description, progress.ECSServiceRendererOpts{
-				Group:      in.g,
-				Ctx:        in.ctx,
-				RenderOpts: in.opts,
+				Group:             in.g,
+				Ctx:               in.ctx,
+				EstimatedDuration: in.durations.estimate(logicalID, resourceType),
+				RenderOpts:        in.opts,
 			})
 		case change.ResourceChange.ChangeSetId != nil:
 			// The resource change is a nested stack.
 			changeSetID := aws.StringValue(change.ResourceChange.ChangeSetId)
 			stackName := parseStackNameFromARN(aws.StringValue(change.ResourceChange.PhysicalResourceId))
 
-			r, err := cf.createChangeSetRenderer(in.g, in.ctx, changeSetID, stackName, description, in.opts)
+			r, err := cf.createChangeSetRenderer(&changeSetRendererInput{
+				g:           in.g,
+				ctx:         in.ctx,
+				changeSetID: changeSetID,
+				stackName:   stackName,
+				description: description,
+				nested:      true,
+				opts:        in.opts,
+			})
 			if err != nil {
 				return nil, err
 			}
 			renderer = r
+		case resourceType == stackResourceType:
+			// The nested stack doesn't have a change set, so its resources are discovered from its events.
+			renderer = progress.ListeningNestedStackRenderer(in.stackStreamer, cf.cfnClient, logicalID, description, progress.NestedStackRendererOpts{
+				Group:             in.g,
+				Ctx:               in.ctx,
+				EstimatedDuration: in.durations.estimate(logicalID, resourceType),
+				RenderOpts:        in.opts,
+				StreamerOpts:      cf.streamerOpts,
+			})
 		default:
 			renderer = progress.ListeningResourceRenderer(in.stackStreamer, logicalID, description, progress.ResourceRendererOpts{
-				RenderOpts: in.opts,
+				EstimatedDuration: in.durations.estimate(logicalID, resourceType),
+				RenderOpts:        in.opts,
 			})
 		}
 		resources = append(resources, renderer)
@@ -342,7 +524,7 @@ func (cf CloudFormation) createEnvControllerRenderer(in *envControllerRendererIn
 	if err != nil {
 		return nil, fmt.Errorf("parse cloudformation template for resource descriptions: %w", err)
 	}
-	envStreamer := stream.NewStackStreamer(cf.cfnClient, envStackName, in.workloadTimestamp)
+	envStreamer := stream.NewStackStreamer(cf.cfnClient, envStackName, in.workloadTimestamp, cf.streamerOpts...)
 	ctx, cancel := context.WithCancel(in.ctx)
 	in.g.Go(func() error {
 		if err := stream.Stream(ctx, envStreamer); err != nil {
@@ -119,6 +119,27 @@ func testDeployWorkload_OnTemplateBodyFailure(t *testing.T, when func(w progress
 	require.EqualError(t, err, "TemplateBody error")
 }
 
+func testDeployWorkload_RendersOnPastEventsFailure(t *testing.T, when func(w progress.FileWriter, cf CloudFormation) error) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	wantedErr := errors.New("streamer error")
+	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().Create(gomock.Any()).Return("1234", nil)
+	m.EXPECT().DescribeChangeSet(gomock.Any(), gomock.Any()).Return(&cloudformation.ChangeSetDescription{}, nil)
+	m.EXPECT().TemplateBodyFromChangeSet(gomock.Any(), gomock.Any()).Return("", nil)
+	m.EXPECT().RecentEvents(gomock.Any(), estimatedOperations).Return(nil, errors.New("some error"))
+	m.EXPECT().DescribeStackEvents(gomock.Any()).Return(nil, wantedErr)
+	client := CloudFormation{cfnClient: m}
+	buf := new(strings.Builder)
+
+	// WHEN
+	err := when(mockFileWriter{Writer: buf}, client)
+
+	// THEN
+	require.True(t, errors.Is(err, wantedErr), "expected the changes to be streamed without estimates")
+}
+
 func testDeployWorkload_StackStreamerFailureShouldCancelRenderer(t *testing.T, when func(w progress.FileWriter, cf CloudFormation) error) {
 	// GIVEN
 	ctrl := gomock.NewController(t)
@@ -128,6 +149,7 @@ func testDeployWorkload_StackStreamerFailureShouldCancelRenderer(t *testing.T, w
 	m.EXPECT().Create(gomock.Any()).Return("1234", nil)
 	m.EXPECT().DescribeChangeSet(gomock.Any(), gomock.Any()).Return(&cloudformation.ChangeSetDescription{}, nil)
 	m.EXPECT().TemplateBodyFromChangeSet(gomock.Any(), gomock.Any()).Return("", nil)
+	m.EXPECT().RecentEvents(gomock.Any(), estimatedOperations).Return(nil, nil)
 	m.EXPECT().DescribeStackEvents(gomock.Any()).Return(nil, wantedErr)
 	client := CloudFormation{cfnClient: m}
 	buf := new(strings.Builder)
@@ -147,6 +169,8 @@ func testDeployWorkload_StreamUntilStackCreationFails(t *testing.T, stackName st
 	m.EXPECT().Create(gomock.Any()).Return("1234", nil)
 	m.EXPECT().DescribeChangeSet(gomock.Any(), gomock.Any()).Return(&cloudformation.ChangeSetDescription{}, nil)
 	m.EXPECT().TemplateBodyFromChangeSet(gomock.Any(), gomock.Any()).Return("", nil)
+	m.EXPECT().RecentEvents(gomock.Any(), estimatedOperations).Return(nil, nil)
+	m.EXPECT().RecentEvents(gomock.Any(), 1).Return(nil, nil) // Summarize the deployment.
 	m.EXPECT().DescribeStackEvents(gomock.Any()).Return(&sdkcloudformation.DescribeStackEventsOutput{
 		StackEvents: []*sdkcloudformation.StackEvent{
 			{
@@ -197,6 +221,8 @@ Resources:
       'aws:copilot:description': 'My ECS Service'
     Type: AWS::ECS::Service
 `, nil)
+	mockCFN.EXPECT().RecentEvents(stackName, estimatedOperations).Return(nil, nil)
+	mockCFN.EXPECT().RecentEvents(stackName, 1).Return(nil, nil) // Summarize the deployment.
 	mockCFN.EXPECT().DescribeStackEvents(&sdkcloudformation.DescribeStackEventsInput{
 		StackName: aws.String(stackName),
 	}).Return(&sdkcloudformation.DescribeStackEventsOutput{
@@ -277,6 +303,8 @@ Resources:
     Metadata:
       'aws:copilot:description': "Updating environment"
 `, nil)
+	mockCFN.EXPECT().RecentEvents(svcStackName, estimatedOperations).Return(nil, nil)
+	mockCFN.EXPECT().RecentEvents(svcStackName, 1).Return(nil, nil) // Summarize the deployment.
 	mockCFN.EXPECT().Describe(svcStackName).Return(&cloudformation.StackDescription{
 		Tags: []*sdkcloudformation.Tag{
 			{
@@ -353,6 +381,8 @@ Resources:
     Metadata:
       'aws:copilot:description': "Updating environment"
 `, nil)
+	mockCFN.EXPECT().RecentEvents(svcStackName, estimatedOperations).Return(nil, nil)
+	mockCFN.EXPECT().RecentEvents(svcStackName, 1).Return(nil, nil) // Summarize the deployment.
 	mockCFN.EXPECT().Describe(svcStackName).Return(&cloudformation.StackDescription{
 		Tags: []*sdkcloudformation.Tag{
 			{
@@ -456,6 +486,8 @@ Resources:
       'aws:copilot:description': 'An Addons CloudFormation Stack for your additional AWS resources'
     Type: AWS::CloudFormation::Stack
 `, nil)
+	m.EXPECT().RecentEvents(stackName, estimatedOperations).Return(nil, nil)
+	m.EXPECT().RecentEvents(stackName, 1).Return(nil, nil) // Summarize the deployment.
 
 	m.EXPECT().DescribeStackEvents(&sdkcloudformation.DescribeStackEventsInput{
 		StackName: aws.String(stackName),
@@ -498,6 +530,12 @@ Resources:
 					PhysicalResourceId: aws.String("AWS::DynamoDB::Table"),
 				},
 			},
+			{
+				ResourceChange: &sdkcloudformation.ResourceChange{
+					LogicalResourceId:  aws.String("MyQueue"),
+					PhysicalResourceId: aws.String("AWS::SQS::Queue"),
+				},
+			},
 		},
 	}, nil)
 
@@ -506,7 +544,10 @@ Resources:
   MyTable:
     Metadata:
       'aws:copilot:description': 'A DynamoDB table to store data'
-    Type: AWS::DynamoDB::Table`, nil)
+    Type: AWS::DynamoDB::Table
+  MyQueue:
+    Type: AWS::SQS::Queue`, nil)
+	m.EXPECT().RecentEvents("my-nested-stack", estimatedOperations).Return(nil, nil)
 
 	m.EXPECT().DescribeStackEvents(&sdkcloudformation.DescribeStackEventsInput{
 		StackName: aws.String("my-nested-stack"),
@@ -539,4 +580,79 @@ Resources:
 	require.Contains(t, buf.String(), "An ECS cluster")
 	require.Contains(t, buf.String(), "An Addons CloudFormation Stack for your additional AWS resources")
 	require.Contains(t, buf.String(), "A DynamoDB table to store data")
+	require.Contains(t, buf.String(), "MyQueue", "resources of nested stacks without a description should be rendered")
+}
+
+func testDeployWorkload_RenderPlainEventsWithAddons(t *testing.T, stackName string, when func(w progress.FileWriter, cf CloudFormation) error) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockcfnClient(ctrl)
+	deploymentTime := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+
+	m.EXPECT().Create(gomock.Any()).Return("1234", nil)
+	m.EXPECT().DescribeChangeSet("1234", stackName).Return(&cloudformation.ChangeSetDescription{
+		Changes: []*sdkcloudformation.Change{
+			{
+				ResourceChange: &sdkcloudformation.ResourceChange{
+					LogicalResourceId: aws.String("Cluster"),
+				},
+			},
+			{
+				ResourceChange: &sdkcloudformation.ResourceChange{
+					ChangeSetId:        aws.String("5678"),
+					LogicalResourceId:  aws.String("AddonsStack"),
+					PhysicalResourceId: aws.String("arn:aws:cloudformation:us-west-2:12345:stack/my-nested-stack/d0a825a0-e4cd-xmpl-b9fb-061c69e99205"),
+				},
+			},
+		},
+	}, nil)
+	m.EXPECT().DescribeChangeSet("5678", "my-nested-stack").Return(&cloudformation.ChangeSetDescription{}, nil)
+	m.EXPECT().DescribeStackEvents(&sdkcloudformation.DescribeStackEventsInput{
+		StackName: aws.String(stackName),
+	}).Return(&sdkcloudformation.DescribeStackEventsOutput{
+		StackEvents: []*sdkcloudformation.StackEvent{
+			{
+				EventId:           aws.String("1"),
+				LogicalResourceId: aws.String("Cluster"),
+				ResourceType:      aws.String("AWS::ECS::Cluster"),
+				ResourceStatus:    aws.String("CREATE_COMPLETE"),
+				Timestamp:         aws.Time(deploymentTime),
+			},
+			{
+				EventId:           aws.String("2"),
+				LogicalResourceId: aws.String(stackName),
+				ResourceType:      aws.String("AWS::CloudFormation::Stack"),
+				ResourceStatus:    aws.String("CREATE_COMPLETE"),
+				Timestamp:         aws.Time(deploymentTime),
+			},
+		},
+	}, nil).AnyTimes()
+	m.EXPECT().DescribeStackEvents(&sdkcloudformation.DescribeStackEventsInput{
+		StackName: aws.String("my-nested-stack"),
+	}).Return(&sdkcloudformation.DescribeStackEventsOutput{
+		StackEvents: []*sdkcloudformation.StackEvent{
+			{
+				EventId:           aws.String("1"),
+				LogicalResourceId: aws.String("my-nested-stack"),
+				ResourceType:      aws.String("AWS::CloudFormation::Stack"),
+				ResourceStatus:    aws.String("CREATE_COMPLETE"),
+				Timestamp:         aws.Time(deploymentTime),
+			},
+		},
+	}, nil).AnyTimes()
+	m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
+		StackStatus: aws.String("CREATE_COMPLETE"),
+	}, nil)
+	m.EXPECT().RecentEvents(stackName, 1).Return(nil, nil)
+	client := CloudFormation{cfnClient: m, progressMode: progress.PlainMode}
+	buf := new(strings.Builder)
+
+	// WHEN
+	err := when(mockFileWriter{Writer: buf}, client)
+
+	// THEN
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), fmt.Sprintf("2020-11-23T18:00:00Z  %s  Cluster  AWS::ECS::Cluster  CREATE_COMPLETE\n", stackName))
+	require.Contains(t, buf.String(), "2020-11-23T18:00:00Z  my-nested-stack  my-nested-stack  AWS::CloudFormation::Stack  CREATE_COMPLETE\n")
 }
@@ -49,6 +49,12 @@ func (cf CloudFormation) DeployAndRenderEnvironment(out progress.FileWriter, env
 	})
 }
 
+// RenderEnvironmentUpdate renders the update of an environment stack that is in progress to out until it completes.
+// If the stack is not being updated, it returns an ErrStackNotInProgress.
+func (cf CloudFormation) RenderEnvironmentUpdate(out progress.FileWriter, appName, envName string) error {
+	return cf.renderInProgressStackChanges(out, stack.NameForEnv(appName, envName), fmt.Sprintf("Updating the infrastructure for the %s environment.", envName))
+}
+
 // DeleteEnvironment deletes the CloudFormation stack of an environment.
 func (cf CloudFormation) DeleteEnvironment(appName, envName, cfnExecRoleARN string) error {
 	conf := stack.NewEnvStackConfig(&deploy.CreateEnvironmentInput{
@@ -6,7 +6,9 @@ package cloudformation
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -14,6 +16,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -318,3 +321,130 @@ func TestCloudFormation_UpdateEnvironmentTemplate(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudFormation_RenderEnvironmentUpdate(t *testing.T) {
+	updateTime := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+	envUpdateEvents := &awscfn.DescribeStackEventsOutput{
+		StackEvents: []*awscfn.StackEvent{
+			{
+				EventId:           aws.String("2"),
+				LogicalResourceId: aws.String("phonetool-test"),
+				ResourceType:      aws.String("AWS::CloudFormation::Stack"),
+				ResourceStatus:    aws.String("UPDATE_COMPLETE"),
+				Timestamp:         aws.Time(updateTime.Add(2 * time.Minute)),
+			},
+			{
+				EventId:           aws.String("1"),
+				LogicalResourceId: aws.String("Cluster"),
+				ResourceType:      aws.String("AWS::ECS::Cluster"),
+				ResourceStatus:    aws.String("UPDATE_COMPLETE"),
+				Timestamp:         aws.Time(updateTime.Add(time.Minute)),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		mockCFN func(m *mocks.MockcfnClient)
+
+		wantedOutput []string
+		wantedErr    error
+	}{
+		"wraps the error if the stack can't be described": {
+			mockCFN: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe stack phonetool-test: some error"),
+		},
+		"returns an error if the stack is not being updated": {
+			mockCFN: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String("UPDATE_COMPLETE"),
+				}, nil)
+			},
+			wantedErr: &ErrStackNotInProgress{
+				stackName: "phonetool-test",
+				status:    "UPDATE_COMPLETE",
+			},
+		},
+		"renders the changes of the change set that is executing": {
+			mockCFN: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String("UPDATE_IN_PROGRESS"),
+					ChangeSetId: aws.String("1234"),
+				}, nil)
+				m.EXPECT().DescribeChangeSet("1234", "phonetool-test").Return(&cloudformation.ChangeSetDescription{
+					ExecutionStatus: awscfn.ExecutionStatusExecuteInProgress,
+					CreationTime:    updateTime,
+					Changes: []*awscfn.Change{
+						{
+							ResourceChange: &awscfn.ResourceChange{
+								LogicalResourceId: aws.String("Cluster"),
+								ResourceType:      aws.String("AWS::ECS::Cluster"),
+							},
+						},
+					},
+				}, nil).Times(2)
+				m.EXPECT().TemplateBodyFromChangeSet("1234", "phonetool-test").Return(`
+Resources:
+  Cluster:
+    Metadata:
+      'aws:copilot:description': 'An ECS cluster to group your services'
+    Type: AWS::ECS::Cluster
+`, nil)
+				m.EXPECT().RecentEvents("phonetool-test", estimatedOperations).Return(nil, nil)
+				m.EXPECT().RecentEvents("phonetool-test", 1).Return(nil, nil) // Summarize the deployment.
+				m.EXPECT().DescribeStackEvents(gomock.Any()).Return(envUpdateEvents, nil).AnyTimes()
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String("UPDATE_COMPLETE"),
+				}, nil)
+			},
+			wantedOutput: []string{"Updating the infrastructure for the test environment.", "An ECS cluster to group your services"},
+		},
+		"renders every event if the update doesn't execute a change set": {
+			mockCFN: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					StackStatus:     aws.String("UPDATE_IN_PROGRESS"),
+					ChangeSetId:     aws.String("1234"),
+					LastUpdatedTime: aws.Time(updateTime),
+				}, nil)
+				m.EXPECT().DescribeChangeSet("1234", "phonetool-test").Return(&cloudformation.ChangeSetDescription{
+					ExecutionStatus: awscfn.ExecutionStatusExecuteComplete,
+				}, nil)
+				m.EXPECT().DescribeStackEvents(gomock.Any()).Return(envUpdateEvents, nil).AnyTimes()
+				m.EXPECT().RecentEvents("phonetool-test", 1).Return(nil, nil)
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String("UPDATE_ROLLBACK_COMPLETE"),
+				}, nil)
+			},
+			wantedOutput: []string{"2020-11-23T18:01:00Z  phonetool-test  Cluster  AWS::ECS::Cluster  UPDATE_COMPLETE\n"},
+			wantedErr:    errors.New("stack phonetool-test did not complete successfully and exited with status UPDATE_ROLLBACK_COMPLETE"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfnClient(ctrl)
+			tc.mockCFN(m)
+			cf := CloudFormation{
+				cfnClient:    m,
+				progressMode: progress.TreeMode,
+			}
+			buf := new(strings.Builder)
+
+			// WHEN
+			err := cf.RenderEnvironmentUpdate(mockFileWriter{Writer: buf}, "phonetool", "test")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			for _, wanted := range tc.wantedOutput {
+				require.Contains(t, buf.String(), wanted)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+)
+
+// resourceDurations holds how long resources took to be created or updated in past stack operations.
+type resourceDurations struct {
+	byLogicalID    map[string]time.Duration
+	byResourceType map[string]time.Duration
+}
+
+// newResourceDurations calculates the duration of the latest successful create or update of each resource
+// from stack events, in chronological order, that happened before the given time.
+func newResourceDurations(events []cloudformation.StackEvent, before time.Time) resourceDurations {
+	durations := resourceDurations{
+		byLogicalID:    make(map[string]time.Duration),
+		byResourceType: make(map[string]time.Duration),
+	}
+	startTimes := make(map[string]time.Time)
+	for _, event := range events {
+		timestamp := aws.TimeValue(event.Timestamp)
+		if !timestamp.Before(before) {
+			break
+		}
+		logicalID := aws.StringValue(event.LogicalResourceId)
+		status := cloudformation.StackStatus(aws.StringValue(event.ResourceStatus))
+		startTime, started := startTimes[logicalID]
+		switch {
+		case status.UpsertInProgress():
+			if !started {
+				startTimes[logicalID] = timestamp
+			}
+		case status.InProgress():
+			// Intermediate states such as "UPDATE_COMPLETE_CLEANUP_IN_PROGRESS" don't end the operation.
+		case status.Success() && started:
+			duration := timestamp.Sub(startTime)
+			durations.byLogicalID[logicalID] = duration
+			durations.byResourceType[aws.StringValue(event.ResourceType)] = duration
+			delete(startTimes, logicalID)
+		default:
+			delete(startTimes, logicalID)
+		}
+	}
+	return durations
+}
+
+// estimate returns the expected duration for the resource to be created or updated.
+// If the resource was never deployed before, it falls back to the latest resource of the same type.
+// Returns zero if there is no history.
+func (d resourceDurations) estimate(logicalID, resourceType string) time.Duration {
+	if duration, ok := d.byLogicalID[logicalID]; ok {
+		return duration
+	}
+	return d.byResourceType[resourceType]
+}
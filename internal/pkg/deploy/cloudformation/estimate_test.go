@@ -0,0 +1,104 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceDurations_Estimate(t *testing.T) {
+	deployTime := time.Date(2021, time.March, 1, 10, 0, 0, 0, time.UTC)
+	event := func(logicalID, resourceType, status string, offset time.Duration) cloudformation.StackEvent {
+		return cloudformation.StackEvent{
+			LogicalResourceId: aws.String(logicalID),
+			ResourceType:      aws.String(resourceType),
+			ResourceStatus:    aws.String(status),
+			Timestamp:         aws.Time(deployTime.Add(offset)),
+		}
+	}
+	testCases := map[string]struct {
+		events       []cloudformation.StackEvent
+		before       time.Time
+		logicalID    string
+		resourceType string
+
+		wanted time.Duration
+	}{
+		"returns zero if there are no past events": {
+			before:       deployTime.Add(time.Hour),
+			logicalID:    "Service",
+			resourceType: "AWS::ECS::Service",
+		},
+		"returns the duration of the latest successful operation of the resource": {
+			events: []cloudformation.StackEvent{
+				event("Service", "AWS::ECS::Service", "CREATE_IN_PROGRESS", 0),
+				event("Service", "AWS::ECS::Service", "CREATE_IN_PROGRESS", 2*time.Second),
+				event("Service", "AWS::ECS::Service", "CREATE_COMPLETE", 3*time.Minute),
+				event("Service", "AWS::ECS::Service", "UPDATE_IN_PROGRESS", 10*time.Minute),
+				event("Service", "AWS::ECS::Service", "UPDATE_COMPLETE", 12*time.Minute),
+			},
+			before:       deployTime.Add(time.Hour),
+			logicalID:    "Service",
+			resourceType: "AWS::ECS::Service",
+
+			wanted: 2 * time.Minute,
+		},
+		"ignores failed operations": {
+			events: []cloudformation.StackEvent{
+				event("Service", "AWS::ECS::Service", "CREATE_IN_PROGRESS", 0),
+				event("Service", "AWS::ECS::Service", "CREATE_COMPLETE", 3*time.Minute),
+				event("Service", "AWS::ECS::Service", "UPDATE_IN_PROGRESS", 10*time.Minute),
+				event("Service", "AWS::ECS::Service", "UPDATE_FAILED", 40*time.Minute),
+				event("Service", "AWS::ECS::Service", "UPDATE_COMPLETE", 45*time.Minute),
+			},
+			before:       deployTime.Add(time.Hour),
+			logicalID:    "Service",
+			resourceType: "AWS::ECS::Service",
+
+			wanted: 3 * time.Minute,
+		},
+		"ignores events that happened after the given time": {
+			events: []cloudformation.StackEvent{
+				event("Service", "AWS::ECS::Service", "CREATE_IN_PROGRESS", 0),
+				event("Service", "AWS::ECS::Service", "CREATE_COMPLETE", 3*time.Minute),
+				event("Service", "AWS::ECS::Service", "UPDATE_IN_PROGRESS", 10*time.Minute),
+				event("Service", "AWS::ECS::Service", "UPDATE_COMPLETE", 11*time.Minute),
+			},
+			before:       deployTime.Add(5 * time.Minute),
+			logicalID:    "Service",
+			resourceType: "AWS::ECS::Service",
+
+			wanted: 3 * time.Minute,
+		},
+		"falls back to a resource of the same type if the resource was never deployed": {
+			events: []cloudformation.StackEvent{
+				event("Service", "AWS::ECS::Service", "CREATE_IN_PROGRESS", 0),
+				event("Service", "AWS::ECS::Service", "CREATE_COMPLETE", 3*time.Minute),
+			},
+			before:       deployTime.Add(time.Hour),
+			logicalID:    "OtherService",
+			resourceType: "AWS::ECS::Service",
+
+			wanted: 3 * time.Minute,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			durations := newResourceDurations(tc.events, tc.before)
+
+			// WHEN
+			got := durations.estimate(tc.logicalID, tc.resourceType)
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -158,6 +158,20 @@ func (m *MockcfnClient) EXPECT() *MockcfnClientMockRecorder {
 	return m.recorder
 }
 
+// CancelUpdateAndWait mocks base method.
+func (m *MockcfnClient) CancelUpdateAndWait(stackName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUpdateAndWait", stackName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelUpdateAndWait indicates an expected call of CancelUpdateAndWait.
+func (mr *MockcfnClientMockRecorder) CancelUpdateAndWait(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUpdateAndWait", reflect.TypeOf((*MockcfnClient)(nil).CancelUpdateAndWait), stackName)
+}
+
 // Create mocks base method.
 func (m *MockcfnClient) Create(arg0 *cloudformation0.Stack) (string, error) {
 	m.ctrl.T.Helper()
@@ -187,6 +201,21 @@ func (mr *MockcfnClientMockRecorder) CreateAndWait(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndWait", reflect.TypeOf((*MockcfnClient)(nil).CreateAndWait), arg0)
 }
 
+// CreateChangeSet mocks base method.
+func (m *MockcfnClient) CreateChangeSet(arg0 *cloudformation0.Stack) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChangeSet", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChangeSet indicates an expected call of CreateChangeSet.
+func (mr *MockcfnClientMockRecorder) CreateChangeSet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChangeSet", reflect.TypeOf((*MockcfnClient)(nil).CreateChangeSet), arg0)
+}
+
 // Delete mocks base method.
 func (m *MockcfnClient) Delete(stackName string) error {
 	m.ctrl.T.Helper()
@@ -229,6 +258,20 @@ func (mr *MockcfnClientMockRecorder) DeleteAndWaitWithRoleARN(stackName, roleARN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAndWaitWithRoleARN", reflect.TypeOf((*MockcfnClient)(nil).DeleteAndWaitWithRoleARN), stackName, roleARN)
 }
 
+// DeleteChangeSet mocks base method.
+func (m *MockcfnClient) DeleteChangeSet(changeSetID, stackName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangeSet", changeSetID, stackName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangeSet indicates an expected call of DeleteChangeSet.
+func (mr *MockcfnClientMockRecorder) DeleteChangeSet(changeSetID, stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangeSet", reflect.TypeOf((*MockcfnClient)(nil).DeleteChangeSet), changeSetID, stackName)
+}
+
 // Describe mocks base method.
 func (m *MockcfnClient) Describe(stackName string) (*cloudformation0.StackDescription, error) {
 	m.ctrl.T.Helper()
@@ -304,6 +347,20 @@ func (mr *MockcfnClientMockRecorder) Events(stackName interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockcfnClient)(nil).Events), stackName)
 }
 
+// ExecuteChangeSet mocks base method.
+func (m *MockcfnClient) ExecuteChangeSet(changeSetID, stackName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSet", changeSetID, stackName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSet indicates an expected call of ExecuteChangeSet.
+func (mr *MockcfnClientMockRecorder) ExecuteChangeSet(changeSetID, stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSet", reflect.TypeOf((*MockcfnClient)(nil).ExecuteChangeSet), changeSetID, stackName)
+}
+
 // ListStacksWithTags mocks base method.
 func (m *MockcfnClient) ListStacksWithTags(tags map[string]string) ([]cloudformation0.StackDescription, error) {
 	m.ctrl.T.Helper()
@@ -334,6 +391,21 @@ func (mr *MockcfnClientMockRecorder) Outputs(stack interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockcfnClient)(nil).Outputs), stack)
 }
 
+// RecentEvents mocks base method.
+func (m *MockcfnClient) RecentEvents(stackName string, operations int) ([]cloudformation0.StackEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecentEvents", stackName, operations)
+	ret0, _ := ret[0].([]cloudformation0.StackEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecentEvents indicates an expected call of RecentEvents.
+func (mr *MockcfnClientMockRecorder) RecentEvents(stackName, operations interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecentEvents", reflect.TypeOf((*MockcfnClient)(nil).RecentEvents), stackName, operations)
+}
+
 // TemplateBody mocks base method.
 func (m *MockcfnClient) TemplateBody(stackName string) (string, error) {
 	m.ctrl.T.Helper()
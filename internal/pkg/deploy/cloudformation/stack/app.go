@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"gopkg.in/yaml.v3"
@@ -25,10 +26,40 @@ type DeployedAppMetadata struct {
 // AppResourcesConfig is a configuration for a deployed Application
 // StackSet.
 type AppResourcesConfig struct {
-	Accounts []string `yaml:"Accounts,flow"`
-	Services []string `yaml:"Services,flow"`
-	App      string   `yaml:"App"`
-	Version  int      `yaml:"Version"`
+	Accounts          []string               `yaml:"Accounts,flow"`
+	Services          []string               `yaml:"Services,flow"`
+	App               string                 `yaml:"App"`
+	Version           int                    `yaml:"Version"`
+	RepositoryPrefix  string                 `yaml:"RepositoryPrefix,omitempty"` // Prefix of the ECR repository names, defaults to the app name.
+	PullThroughCaches []PullThroughCacheRule `yaml:"PullThroughCaches,omitempty"`
+}
+
+// PullThroughCacheRule is an ECR pull-through cache rule that caches the images of an upstream registry.
+type PullThroughCacheRule struct {
+	Registry      string `yaml:"Registry"`
+	CredentialARN string `yaml:"CredentialARN,omitempty"`
+}
+
+// NewPullThroughCacheRules returns the pull-through cache rules of an application's caches.
+func NewPullThroughCacheRules(caches []config.PullThroughCache) []PullThroughCacheRule {
+	var rules []PullThroughCacheRule
+	for _, cache := range caches {
+		rules = append(rules, PullThroughCacheRule{
+			Registry:      cache.Registry,
+			CredentialARN: cache.CredentialARN,
+		})
+	}
+	return rules
+}
+
+// UpstreamURL returns the URL of the upstream registry.
+func (r PullThroughCacheRule) UpstreamURL() string {
+	return config.PullThroughCache{Registry: r.Registry}.UpstreamURL()
+}
+
+// RepositoryPrefix returns the prefix of the ECR repositories that cache the images of the upstream registry.
+func (r PullThroughCacheRule) RepositoryPrefix(app string) string {
+	return config.PullThroughCache{Registry: r.Registry}.RepositoryPrefix(app)
 }
 
 // AppStackConfig is for providing all the values to set up an
@@ -46,6 +77,9 @@ type AppRegionalResources struct {
 	RepositoryURLs map[string]string // The image repository URLs by service name.
 }
 
+// AppOutputResourceGroup is the output key of the name of the application's resource group.
+const AppOutputResourceGroup = "ResourceGroup"
+
 const (
 	fmtAppTemplatePath            = "app/versions/%s/app.yml"
 	fmtAppResourcesTemplatePath   = "app/versions/%s/cf.yml"
@@ -58,6 +92,7 @@ const (
 	appDNSDelegatedAccountsKey    = "AppDNSDelegatedAccounts"
 	appDomainNameKey              = "AppDomainName"
 	appDomainHostedZoneIDKey      = "AppDomainHostedZoneID"
+	appDomainRoleARNKey           = "AppDomainRoleARN"
 	appNameKey                    = "AppName"
 	appDNSDelegationRoleName      = "DNSDelegationRole"
 )
@@ -132,7 +167,7 @@ func appResourcesTemplatePath(version string) string {
 
 // Parameters returns a list of parameters which accompany the app CloudFormation template.
 func (c *AppStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
-	return []*cloudformation.Parameter{
+	params := []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(appAdminRoleParamName),
 			ParameterValue: aws.String(c.stackSetAdminRoleName()),
@@ -161,7 +196,15 @@ func (c *AppStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
 			ParameterKey:   aws.String(appDNSDelegationRoleParamName),
 			ParameterValue: aws.String(dnsDelegationRoleName(c.Name)),
 		},
-	}, nil
+	}
+	if c.DomainRoleARN != "" {
+		// Only templates from v1.1.0 accept the parameter, so we don't add it unless the domain is in another account.
+		params = append(params, &cloudformation.Parameter{
+			ParameterKey:   aws.String(appDomainRoleARNKey),
+			ParameterValue: aws.String(c.DomainRoleARN),
+		})
+	}
+	return params, nil
 }
 
 // Tags returns the tags that should be applied to the Application CloudFormation stack.
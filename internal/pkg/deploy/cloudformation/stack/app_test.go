@@ -16,6 +16,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -237,6 +238,24 @@ func TestAppParameters(t *testing.T) {
 	require.ElementsMatch(t, expectedParams, params)
 }
 
+func TestAppParameters_WithDomainRole(t *testing.T) {
+	app := &AppStackConfig{
+		CreateAppInput: &deploy.CreateAppInput{
+			Name:               "testapp",
+			AccountID:          "1234",
+			DomainName:         "amazon.com",
+			DomainHostedZoneID: "mockHostedZoneID",
+			DomainRoleARN:      "arn:aws:iam::5678:role/dns",
+		},
+	}
+	params, err := app.Parameters()
+	require.NoError(t, err)
+	require.Contains(t, params, &cloudformation.Parameter{
+		ParameterKey:   aws.String(appDomainRoleARNKey),
+		ParameterValue: aws.String("arn:aws:iam::5678:role/dns"),
+	})
+}
+
 func TestAppTags(t *testing.T) {
 	app := &AppStackConfig{
 		CreateAppInput: &deploy.CreateAppInput{
@@ -410,3 +429,94 @@ Metadata:
 		Services: []string{"testsvc1", "testsvc2"},
 	}, *config)
 }
+
+func TestAppResourceTemplate_RepositoryPrefix(t *testing.T) {
+	testCases := map[string]struct {
+		prefix string
+
+		wantedRepoName string
+	}{
+		"should name repositories after the application by default": {
+			wantedRepoName: "RepositoryName: testapp/testsvc",
+		},
+		"should name repositories after the repository prefix": {
+			prefix: "team-a/testapp",
+
+			wantedRepoName: "RepositoryName: team-a/testapp/testsvc",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			appStack := NewAppStackConfig(&deploy.CreateAppInput{
+				Name:    "testapp",
+				Version: deploy.LatestAppTemplateVersion,
+			})
+
+			// WHEN
+			tpl, err := appStack.ResourceTemplate(&AppResourcesConfig{
+				Services:         []string{"testsvc"},
+				App:              "testapp",
+				Version:          1,
+				RepositoryPrefix: tc.prefix,
+			})
+
+			// THEN
+			require.NoError(t, err)
+			require.Contains(t, tpl, tc.wantedRepoName)
+			deployed, err := AppConfigFrom(&tpl)
+			require.NoError(t, err)
+			require.Equal(t, tc.prefix, deployed.RepositoryPrefix, "repository prefix should be stored in the metadata")
+		})
+	}
+}
+
+func TestAppResourceTemplate_PullThroughCaches(t *testing.T) {
+	// GIVEN
+	appStack := NewAppStackConfig(&deploy.CreateAppInput{
+		Name:    "testapp",
+		Version: deploy.LatestAppTemplateVersion,
+	})
+	rules := []PullThroughCacheRule{
+		{
+			Registry: "ecr-public",
+		},
+		{
+			Registry:      "docker-hub",
+			CredentialARN: "arn:aws:secretsmanager:us-west-2:123456789012:secret:ecr-pullthroughcache/docker-hub",
+		},
+	}
+
+	// WHEN
+	tpl, err := appStack.ResourceTemplate(&AppResourcesConfig{
+		Services:          []string{"testsvc"},
+		App:               "testapp",
+		Version:           1,
+		PullThroughCaches: rules,
+	})
+
+	// THEN
+	require.NoError(t, err)
+	parsed := struct {
+		Resources map[string]struct {
+			Type       string                 `yaml:"Type"`
+			Properties map[string]interface{} `yaml:"Properties"`
+		} `yaml:"Resources"`
+	}{}
+	require.NoError(t, yaml.Unmarshal([]byte(tpl), &parsed))
+	require.Equal(t, "AWS::ECR::PullThroughCacheRule", parsed.Resources["PullThroughCacheRuleecrDASHpublic"].Type)
+	require.Equal(t, map[string]interface{}{
+		"EcrRepositoryPrefix": "testapp-ecr-public",
+		"UpstreamRegistryUrl": "public.ecr.aws",
+	}, parsed.Resources["PullThroughCacheRuleecrDASHpublic"].Properties)
+	require.Equal(t, map[string]interface{}{
+		"EcrRepositoryPrefix": "testapp-docker-hub",
+		"UpstreamRegistryUrl": "registry-1.docker.io",
+		"CredentialArn":       "arn:aws:secretsmanager:us-west-2:123456789012:secret:ecr-pullthroughcache/docker-hub",
+	}, parsed.Resources["PullThroughCacheRuledockerDASHhub"].Properties)
+
+	deployed, err := AppConfigFrom(&tpl)
+	require.NoError(t, err)
+	require.Equal(t, rules, deployed.PullThroughCaches, "pull-through caches should be stored in the metadata")
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 )
 
 // Parameter logical IDs for a backend service.
@@ -44,20 +45,25 @@ func NewBackendService(mft *manifest.BackendService, env, app string, rc Runtime
 	if err != nil {
 		return nil, fmt.Errorf("new addons: %w", err)
 	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
 	envManifest, err := mft.ApplyEnv(env) // Apply environment overrides to the manifest values.
 	if err != nil {
 		return nil, fmt.Errorf("apply environment %s override: %w", env, err)
 	}
 	return &BackendService{
 		wkld: &wkld{
-			name:   aws.StringValue(mft.Name),
-			env:    env,
-			app:    app,
-			tc:     envManifest.BackendServiceConfig.TaskConfig,
-			rc:     rc,
-			image:  envManifest.ImageConfig,
-			parser: parser,
-			addons: addons,
+			name:    aws.StringValue(mft.Name),
+			env:     env,
+			app:     app,
+			tc:      envManifest.BackendServiceConfig.TaskConfig,
+			rc:      rc,
+			image:   envManifest.ImageConfig,
+			parser:  parser,
+			addons:  addons,
+			envFile: ws,
 		},
 		manifest: envManifest,
 
@@ -83,6 +89,20 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
+	initContainers, err := convertInitContainers(s.manifest.InitContainers)
+	if err != nil {
+		return "", fmt.Errorf("convert the init container configuration for service %s: %w", s.name, err)
+	}
+	if err := validateContainerNames(s.name, sidecars, initContainers); err != nil {
+		return "", fmt.Errorf("validate the containers of service %s: %w", s.name, err)
+	}
+	dependsOn, err := convertDependsOn(s.manifest.ImageConfig.DependsOn)
+	if err != nil {
+		return "", fmt.Errorf("convert the container dependencies of service %s: %w", s.name, err)
+	}
+	if err := validateContainerDependencies(s.name, dependsOn, s.manifest.BackendServiceConfig.ImageConfig.HealthCheckOpts(), sidecars); err != nil {
+		return "", fmt.Errorf("validate the container dependencies of service %s: %w", s.name, err)
+	}
 
 	advancedCount, err := convertAdvancedCount(&s.manifest.Count.AdvancedCount)
 	if err != nil {
@@ -103,6 +123,7 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("convert storage options for service %s: %w", s.name, err)
 	}
+	referenceAddonsFilesystems(storage, outputs)
 	entrypoint, err := s.manifest.EntryPoint.ToStringSlice()
 	if err != nil {
 		return "", fmt.Errorf(`convert 'entrypoint' to string slice: %w`, err)
@@ -111,26 +132,84 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf(`convert 'command' to string slice: %w`, err)
 	}
-	content, err := s.parser.ParseBackendService(template.WorkloadOpts{
-		Variables:           s.manifest.BackendServiceConfig.Variables,
+	if err := validateFeatures(s.manifest.Features); err != nil {
+		return "", fmt.Errorf("validate features for service %s: %w", s.name, err)
+	}
+	variables, err := s.variables()
+	if err != nil {
+		return "", err
+	}
+	gpu, err := convertGPU(s.manifest.GPU)
+	if err != nil {
+		return "", fmt.Errorf("convert the gpu configuration for service %s: %w", s.name, err)
+	}
+	network := convertNetworkConfig(s.manifest.Network)
+	if gpu > 0 {
+		if capacityProviders != nil {
+			return "", fmt.Errorf("convert the gpu configuration for service %s: %w", s.name, errGPUWithSpot)
+		}
+		network = gpuNetworkConfig(network)
+	}
+	external, err := convertLaunchType(s.manifest.LaunchType)
+	if err != nil {
+		return "", fmt.Errorf("convert the launch type for service %s: %w", s.name, err)
+	}
+	if external {
+		if err := validateExternalLaunchType(gpu, capacityProviders != nil, storage); err != nil {
+			return "", fmt.Errorf("validate the launch type for service %s: %w", s.name, err)
+		}
+	}
+	arch, err := convertCPUArchitecture(s.manifest.ImageConfig.Build.BuildArgs.Platform)
+	if err != nil {
+		return "", fmt.Errorf("convert the platform for service %s: %w", s.name, err)
+	}
+	if err := validateCPUArchitecture(arch, gpu, capacityProviders != nil); err != nil {
+		return "", fmt.Errorf("validate the platform for service %s: %w", s.name, err)
+	}
+	logConfig, err := convertLogging(s.manifest.Logging, s.manifest.TaskConfig.CPU, s.manifest.TaskConfig.Memory)
+	if err != nil {
+		return "", fmt.Errorf("convert the logging configuration for service %s: %w", s.name, err)
+	}
+	if strategy, err := convertDeploymentStrategy(s.manifest.Deployment); err != nil {
+		return "", fmt.Errorf("convert the deployment configuration for service %s: %w", s.name, err)
+	} else if strategy != nil {
+		// Traffic is shifted between the target groups of a load balancer, which backend services don't have.
+		return "", fmt.Errorf(`"deployment.strategy" %q is only supported by Load Balanced Web Services`, aws.StringValue(s.manifest.Deployment.Strategy))
+	}
+	opts := template.WorkloadOpts{
+		Variables:           variables,
 		Secrets:             s.manifest.BackendServiceConfig.Secrets,
 		NestedStack:         outputs,
 		Sidecars:            sidecars,
+		InitContainers:      initContainers,
 		Autoscaling:         autoscaling,
 		CapacityProviders:   capacityProviders,
 		DesiredCountOnSpot:  desiredCountOnSpot,
 		ExecuteCommand:      convertExecuteCommand(&s.manifest.ExecuteCommand),
 		WorkloadType:        manifest.BackendServiceType,
 		HealthCheck:         s.manifest.BackendServiceConfig.ImageConfig.HealthCheckOpts(),
-		LogConfig:           convertLogging(s.manifest.Logging),
+		LogConfig:           logConfig,
 		DockerLabels:        s.manifest.ImageConfig.DockerLabels,
+		DependsOn:           dependsOn,
+		GPU:                 gpu,
+		External:            external,
+		CPUArchitecture:     arch,
 		DesiredCountLambda:  desiredCountLambda.String(),
 		EnvControllerLambda: envControllerLambda.String(),
+		RollbackAlarms:      s.manifest.Deployment.RollbackAlarms,
 		Storage:             storage,
-		Network:             convertNetworkConfig(s.manifest.Network),
+		Network:             network,
 		EntryPoint:          entrypoint,
 		Command:             command,
-	})
+		Features:            s.manifest.Features,
+		LogGroupPrefix:      s.rc.LogGroupPrefix,
+		PullThroughCache:    s.rc.PullThroughCache,
+		ExecutionRoleARN:    s.rc.ExecutionRoleARN,
+	}
+	if err := validateTaskDefinition(s.name, opts); err != nil {
+		return "", fmt.Errorf("validate task definition for service %s: %w", s.name, err)
+	}
+	content, err := s.parser.ParseBackendService(opts)
 	if err != nil {
 		return "", fmt.Errorf("parse backend service template: %w", err)
 	}
@@ -131,6 +131,76 @@ Outputs:
 			},
 			wantedErr: fmt.Errorf("convert the advanced count configuration for service frontend: %w", errors.New("invalid range value badRange. Should be in format of ${min}-${max}")),
 		},
+		"failed converting gpu together with spot": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(baseProps)
+				svc.manifest.GPU = aws.Int(1)
+				svc.manifest.Count.AdvancedCount = manifest.AdvancedCount{
+					Spot: aws.Int(2),
+				}
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedErr: fmt.Errorf(`convert the gpu configuration for service frontend: "gpu" and "count.spot" cannot be specified together`),
+		},
+		"failed validating external launch type together with spot": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(baseProps)
+				svc.manifest.LaunchType = aws.String("EXTERNAL")
+				svc.manifest.Count.AdvancedCount = manifest.AdvancedCount{
+					Spot: aws.Int(2),
+				}
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedErr: fmt.Errorf(`validate the launch type for service frontend: "count.spot" is not supported for tasks with the EXTERNAL "launch_type"`),
+		},
+		"failed validating arm64 platform together with spot": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(baseProps)
+				svc.manifest.ImageConfig.Build.BuildArgs.Platform = manifest.BuildPlatform{
+					StringSlice: []string{"linux/arm64", "linux/amd64"},
+				}
+				svc.manifest.Count.AdvancedCount = manifest.AdvancedCount{
+					Spot: aws.Int(2),
+				}
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedErr: fmt.Errorf(`validate the platform for service frontend: "count.spot" is not supported for images built for the "linux/arm64" platform`),
+		},
+		"failed validating a deployment strategy without a load balancer": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(baseProps)
+				svc.manifest.Deployment = manifest.DeploymentConfig{
+					Strategy: aws.String("linear"),
+				}
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseBackendService(gomock.Any()).Times(0)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedErr: fmt.Errorf(`"deployment.strategy" "linear" is only supported by Load Balanced Web Services`),
+		},
 		"failed parsing svc template": {
 			setUpManifest: func(svc *BackendService) {
 				svc.manifest = manifest.NewBackendService(baseProps)
@@ -178,6 +248,8 @@ Outputs:
 					StringSlice: []string{"here"},
 				}
 				svc.manifest.ExecuteCommand = manifest.ExecuteCommand{Enable: aws.Bool(true)}
+				svc.manifest.Deployment = manifest.DeploymentConfig{RollbackAlarms: []string{"HighErrorRate"}}
+				svc.manifest.GPU = aws.Int(1)
 			},
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
 				m := mocks.NewMockbackendSvcReadParser(ctrl)
@@ -204,8 +276,10 @@ Outputs:
 						SubnetsType:    template.PrivateSubnetsPlacement,
 						SecurityGroups: []string{"sg-1234"},
 					},
-					EntryPoint: []string{"enter", "from"},
-					Command:    []string{"here"},
+					EntryPoint:     []string{"enter", "from"},
+					Command:        []string{"here"},
+					RollbackAlarms: []string{"HighErrorRate"},
+					GPU:            1,
 				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
 				svc.parser = m
 				svc.addons = mockTemplater{
@@ -339,3 +413,38 @@ func TestBackendService_Parameters(t *testing.T) {
 		},
 	}, params)
 }
+
+func TestBackendService_ParametersWithDesiredCount(t *testing.T) {
+	testBackendSvcManifest := manifest.NewBackendService(manifest.BackendServiceProps{
+		WorkloadProps: manifest.WorkloadProps{
+			Name:       testServiceName,
+			Dockerfile: testDockerfile,
+		},
+		Port: 8080,
+	})
+	conf := &BackendService{
+		wkld: &wkld{
+			name: aws.StringValue(testBackendSvcManifest.Name),
+			env:  testEnvName,
+			app:  testAppName,
+			image: manifest.Image{
+				Location: aws.String("mockLocation"),
+			},
+			tc: testBackendSvcManifest.BackendServiceConfig.TaskConfig,
+			rc: RuntimeConfig{
+				DesiredCount: aws.Int(0),
+			},
+		},
+		manifest: testBackendSvcManifest,
+	}
+
+	// WHEN
+	params, err := conf.Parameters()
+
+	// THEN
+	require.NoError(t, err)
+	require.Contains(t, params, &cloudformation.Parameter{
+		ParameterKey:   aws.String(WorkloadTaskCountParamKey),
+		ParameterValue: aws.String("0"),
+	})
+}
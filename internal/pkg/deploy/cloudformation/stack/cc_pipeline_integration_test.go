@@ -40,6 +40,27 @@ func TestCC_Pipeline_Template(t *testing.T) {
 				RequiresApproval: false,
 				TestCommands:     []string{`echo "test"`},
 			},
+			{
+				AssociatedEnvironment: &deploy.AssociatedEnvironment{
+					Name:      "prod",
+					Region:    "us-west-2",
+					AccountID: "1111",
+				},
+				LocalWorkloads:   []string{"api"},
+				RequiresApproval: true,
+				ApprovalTopic:    "arn:aws:sns:us-west-2:1111:approvals",
+			},
+			{
+				AssociatedEnvironment: &deploy.AssociatedEnvironment{
+					Name:      "staging",
+					Region:    "us-east-1",
+					AccountID: "2222",
+				},
+				LocalWorkloads:   []string{"api", "worker"},
+				ReviewChangeSets: true,
+				ApprovalTopic:    "arn:aws:sns:us-west-2:1111:approvals",
+				TestCommands:     []string{`echo "test"`},
+			},
 		},
 		ArtifactBuckets: []deploy.ArtifactBucket{
 			{
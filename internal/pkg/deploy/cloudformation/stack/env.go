@@ -37,11 +37,15 @@ const (
 	envParamAppDNSDelegationRoleKey  = "AppDNSDelegationRole"
 
 	// Output keys.
-	EnvOutputVPCID               = "VpcId"
-	EnvOutputPublicSubnets       = "PublicSubnets"
-	EnvOutputPrivateSubnets      = "PrivateSubnets"
-	envOutputCFNExecutionRoleARN = "CFNExecutionRoleARN"
-	envOutputManagerRoleKey      = "EnvironmentManagerRoleARN"
+	EnvOutputVPCID                       = "VpcId"
+	EnvOutputPublicSubnets               = "PublicSubnets"
+	EnvOutputPrivateSubnets              = "PrivateSubnets"
+	EnvOutputHTTPListenerARN             = "HTTPListenerArn"
+	EnvOutputHTTPSListenerARN            = "HTTPSListenerArn"
+	EnvOutputServiceDiscoveryNamespaceID = "ServiceDiscoveryNamespaceID"
+	EnvOutputResourceGroup               = "ResourceGroup"
+	envOutputCFNExecutionRoleARN         = "CFNExecutionRoleARN"
+	envOutputManagerRoleKey              = "EnvironmentManagerRoleARN"
 
 	// Default parameter values
 	DefaultVPCCIDR            = "10.0.0.0/16"
@@ -81,6 +85,11 @@ func (e *EnvStackConfig) Template() (string, error) {
 	if e.in.AdjustVPCConfig != nil {
 		vpcConf = e.in.AdjustVPCConfig
 	}
+	var clusterName, lbName string
+	if e.in.ResourceNames != nil {
+		clusterName = e.in.ResourceNames.Cluster
+		lbName = e.in.ResourceNames.LoadBalancer
+	}
 
 	content, err := e.parser.ParseEnv(&template.EnvOpts{
 		DNSCertValidatorLambda:    dnsCertValidator,
@@ -89,6 +98,12 @@ func (e *EnvStackConfig) Template() (string, error) {
 		ScriptBucketName:          bucket,
 		ImportVPC:                 e.in.ImportVPCConfig,
 		VPCConfig:                 vpcConf,
+		ClusterName:               clusterName,
+		LoadBalancerName:          lbName,
+		HTTPSListener:             e.in.HTTPSListener,
+		Domain:                    e.in.Domain,
+		ExternalInstances:         e.in.ExternalInstances,
+		IPv6:                      e.in.IPv6,
 		Version:                   e.in.Version,
 	}, template.WithFuncs(map[string]interface{}{
 		"inc": template.IncFunc,
@@ -20,7 +20,31 @@ import (
 )
 
 func TestEnv_Template(t *testing.T) {
+	inputWithNames := mockDeployEnvironmentInput()
+	inputWithNames.ResourceNames = &config.EnvResourceNames{
+		Cluster:        "team-a-cluster",
+		LoadBalancer:   "team-a-alb",
+		LogGroupPrefix: "/team-a",
+	}
+	inputWithExternal := mockDeployEnvironmentInput()
+	inputWithExternal.ExternalInstances = true
+	inputWithIPv6 := mockDeployEnvironmentInput()
+	inputWithIPv6.IPv6 = true
+	inputWithListener := mockDeployEnvironmentInput()
+	inputWithListener.HTTPSListener = &config.HTTPSListener{
+		SSLPolicy: "ELBSecurityPolicy-TLS13-1-2-2021-06",
+		MutualTLS: &config.MutualTLS{
+			Mode: config.MutualTLSModePassthrough,
+		},
+		HSTS: "max-age=31536000; includeSubDomains",
+	}
+	inputWithDomain := mockDeployEnvironmentInput()
+	inputWithDomain.Domain = &config.EnvDomain{
+		Name:         "dev.example.com",
+		HostedZoneID: "Z0123",
+	}
 	testCases := map[string]struct {
+		input            *deploy.CreateEnvironmentInput
 		mockDependencies func(ctrl *gomock.Controller, e *EnvStackConfig)
 		expectedOutput   string
 		want             error
@@ -44,6 +68,116 @@ func TestEnv_Template(t *testing.T) {
 			},
 			expectedOutput: mockTemplate,
 		},
+		"should override the generated names of resources": {
+			input: inputWithNames,
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(&template.EnvOpts{
+					ScriptBucketName:          "mockbucket",
+					DNSCertValidatorLambda:    "mockkey1",
+					DNSDelegationLambda:       "mockkey2",
+					EnableLongARNFormatLambda: "mockkey3",
+					VPCConfig: &config.AdjustVPC{
+						CIDR:               DefaultVPCCIDR,
+						PrivateSubnetCIDRs: strings.Split(DefaultPrivateSubnetCIDRs, ","),
+						PublicSubnetCIDRs:  strings.Split(DefaultPublicSubnetCIDRs, ","),
+					},
+					ClusterName:      "team-a-cluster",
+					LoadBalancerName: "team-a-alb",
+				}, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil)
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
+		"should allow external instances to join the cluster": {
+			input: inputWithExternal,
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(&template.EnvOpts{
+					ScriptBucketName:          "mockbucket",
+					DNSCertValidatorLambda:    "mockkey1",
+					DNSDelegationLambda:       "mockkey2",
+					EnableLongARNFormatLambda: "mockkey3",
+					VPCConfig: &config.AdjustVPC{
+						CIDR:               DefaultVPCCIDR,
+						PrivateSubnetCIDRs: strings.Split(DefaultPrivateSubnetCIDRs, ","),
+						PublicSubnetCIDRs:  strings.Split(DefaultPublicSubnetCIDRs, ","),
+					},
+					ExternalInstances: true,
+				}, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil)
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
+		"should create a dual-stack environment": {
+			input: inputWithIPv6,
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(&template.EnvOpts{
+					ScriptBucketName:          "mockbucket",
+					DNSCertValidatorLambda:    "mockkey1",
+					DNSDelegationLambda:       "mockkey2",
+					EnableLongARNFormatLambda: "mockkey3",
+					VPCConfig: &config.AdjustVPC{
+						CIDR:               DefaultVPCCIDR,
+						PrivateSubnetCIDRs: strings.Split(DefaultPrivateSubnetCIDRs, ","),
+						PublicSubnetCIDRs:  strings.Split(DefaultPublicSubnetCIDRs, ","),
+					},
+					IPv6: true,
+				}, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil)
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
+		"should configure the TLS settings of the HTTPS listener": {
+			input: inputWithListener,
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(&template.EnvOpts{
+					ScriptBucketName:          "mockbucket",
+					DNSCertValidatorLambda:    "mockkey1",
+					DNSDelegationLambda:       "mockkey2",
+					EnableLongARNFormatLambda: "mockkey3",
+					VPCConfig: &config.AdjustVPC{
+						CIDR:               DefaultVPCCIDR,
+						PrivateSubnetCIDRs: strings.Split(DefaultPrivateSubnetCIDRs, ","),
+						PublicSubnetCIDRs:  strings.Split(DefaultPublicSubnetCIDRs, ","),
+					},
+					HTTPSListener: &config.HTTPSListener{
+						SSLPolicy: "ELBSecurityPolicy-TLS13-1-2-2021-06",
+						MutualTLS: &config.MutualTLS{
+							Mode: config.MutualTLSModePassthrough,
+						},
+						HSTS: "max-age=31536000; includeSubDomains",
+					},
+				}, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil)
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
+		"should use the domain of the environment": {
+			input: inputWithDomain,
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(&template.EnvOpts{
+					ScriptBucketName:          "mockbucket",
+					DNSCertValidatorLambda:    "mockkey1",
+					DNSDelegationLambda:       "mockkey2",
+					EnableLongARNFormatLambda: "mockkey3",
+					VPCConfig: &config.AdjustVPC{
+						CIDR:               DefaultVPCCIDR,
+						PrivateSubnetCIDRs: strings.Split(DefaultPrivateSubnetCIDRs, ","),
+						PublicSubnetCIDRs:  strings.Split(DefaultPublicSubnetCIDRs, ","),
+					},
+					Domain: &config.EnvDomain{
+						Name:         "dev.example.com",
+						HostedZoneID: "Z0123",
+					},
+				}, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil)
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -51,8 +185,12 @@ func TestEnv_Template(t *testing.T) {
 			// GIVEN
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
+			in := tc.input
+			if in == nil {
+				in = mockDeployEnvironmentInput()
+			}
 			envStack := &EnvStackConfig{
-				in: mockDeployEnvironmentInput(),
+				in: in,
 			}
 			tc.mockDependencies(ctrl, envStack)
 
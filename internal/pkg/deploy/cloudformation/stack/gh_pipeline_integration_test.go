@@ -39,6 +39,21 @@ func TestGHPipeline_Template(t *testing.T) {
 				LocalWorkloads:   []string{"api"},
 				RequiresApproval: false,
 				TestCommands:     []string{`echo "test"`},
+				PostDeployments: []deploy.PostDeployment{
+					{
+						Name:        "integ-tests",
+						Commands:    []string{"make integ-test"},
+						Image:       "aws/codebuild/standard:5.0",
+						ComputeType: "BUILD_GENERAL1_MEDIUM",
+						Variables: map[string]string{
+							"ENDPOINT": "https://test.phonetool.com",
+						},
+					},
+					{
+						Name:      "load-tests",
+						Buildspec: "copilot/load-tests/buildspec.yml",
+					},
+				},
 			},
 		},
 		ArtifactBuckets: []deploy.ArtifactBucket{
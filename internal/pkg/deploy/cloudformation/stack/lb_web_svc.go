@@ -10,8 +10,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 )
 
 // Template rendering configuration.
@@ -52,20 +54,25 @@ func NewLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, app st
 	if err != nil {
 		return nil, fmt.Errorf("new addons: %w", err)
 	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
 	envManifest, err := mft.ApplyEnv(env) // Apply environment overrides to the manifest values.
 	if err != nil {
 		return nil, fmt.Errorf("apply environment %s override: %s", env, err)
 	}
 	return &LoadBalancedWebService{
 		wkld: &wkld{
-			name:   aws.StringValue(mft.Name),
-			env:    env,
-			app:    app,
-			tc:     envManifest.TaskConfig,
-			rc:     rc,
-			image:  envManifest.ImageConfig,
-			parser: parser,
-			addons: addons,
+			name:    aws.StringValue(mft.Name),
+			env:     env,
+			app:     app,
+			tc:      envManifest.TaskConfig,
+			rc:      rc,
+			image:   envManifest.ImageConfig,
+			parser:  parser,
+			addons:  addons,
+			envFile: ws,
 		},
 		manifest:     envManifest,
 		httpsEnabled: false,
@@ -108,6 +115,20 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
+	initContainers, err := convertInitContainers(s.manifest.InitContainers)
+	if err != nil {
+		return "", fmt.Errorf("convert the init container configuration for service %s: %w", s.name, err)
+	}
+	if err := validateContainerNames(s.name, sidecars, initContainers); err != nil {
+		return "", fmt.Errorf("validate the containers of service %s: %w", s.name, err)
+	}
+	dependsOn, err := convertDependsOn(s.manifest.ImageConfig.DependsOn)
+	if err != nil {
+		return "", fmt.Errorf("convert the container dependencies of service %s: %w", s.name, err)
+	}
+	if err := validateContainerDependencies(s.name, dependsOn, nil, sidecars); err != nil {
+		return "", fmt.Errorf("validate the container dependencies of service %s: %w", s.name, err)
+	}
 
 	advancedCount, err := convertAdvancedCount(&s.manifest.Count.AdvancedCount)
 	if err != nil {
@@ -128,6 +149,7 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("convert storage options for service %s: %w", s.name, err)
 	}
+	referenceAddonsFilesystems(storage, outputs)
 
 	entrypoint, err := s.manifest.EntryPoint.ToStringSlice()
 	if err != nil {
@@ -137,13 +159,45 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf(`convert 'command' to string slice: %w`, err)
 	}
-	content, err := s.parser.ParseLoadBalancedWebService(template.WorkloadOpts{
-		Variables:           s.manifest.Variables,
+	if err := validateFeatures(s.manifest.Features); err != nil {
+		return "", fmt.Errorf("validate features for service %s: %w", s.name, err)
+	}
+	variables, err := s.variables()
+	if err != nil {
+		return "", err
+	}
+	rulePriority, err := convertRulePriority(s.manifest.Priority)
+	if err != nil {
+		return "", fmt.Errorf("convert the listener rule priority for service %s: %w", s.name, err)
+	}
+	arch, err := convertCPUArchitecture(s.manifest.ImageConfig.Build.BuildArgs.Platform)
+	if err != nil {
+		return "", fmt.Errorf("convert the platform for service %s: %w", s.name, err)
+	}
+	if err := validateCPUArchitecture(arch, 0, capacityProviders != nil); err != nil {
+		return "", fmt.Errorf("validate the platform for service %s: %w", s.name, err)
+	}
+	logConfig, err := convertLogging(s.manifest.Logging, s.manifest.TaskConfig.CPU, s.manifest.TaskConfig.Memory)
+	if err != nil {
+		return "", fmt.Errorf("convert the logging configuration for service %s: %w", s.name, err)
+	}
+	strategy, err := convertDeploymentStrategy(s.manifest.Deployment)
+	if err != nil {
+		return "", fmt.Errorf("convert the deployment configuration for service %s: %w", s.name, err)
+	}
+	if strategy != nil && s.rc.DisableHTTPRedirect {
+		// Amazon ECS shifts the traffic of a single listener rule, so HTTP requests must be redirected to the HTTPS one.
+		return "", fmt.Errorf(`"deployment.strategy" %q of service %s requires the environment to redirect HTTP requests to HTTPS`, aws.StringValue(s.manifest.Deployment.Strategy), s.name)
+	}
+	opts := template.WorkloadOpts{
+		Variables:           variables,
 		Secrets:             s.manifest.Secrets,
 		NestedStack:         outputs,
 		Sidecars:            sidecars,
-		LogConfig:           convertLogging(s.manifest.Logging),
+		InitContainers:      initContainers,
+		LogConfig:           logConfig,
 		DockerLabels:        s.manifest.ImageConfig.DockerLabels,
+		DependsOn:           dependsOn,
 		Autoscaling:         autoscaling,
 		CapacityProviders:   capacityProviders,
 		DesiredCountOnSpot:  desiredCountOnSpot,
@@ -151,20 +205,46 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		WorkloadType:        manifest.LoadBalancedWebServiceType,
 		HTTPHealthCheck:     convertHTTPHealthCheck(&s.manifest.HealthCheck),
 		AllowedSourceIps:    s.manifest.AllowedSourceIps,
+		RulePriority:        rulePriority,
 		RulePriorityLambda:  rulePriorityLambda.String(),
 		DesiredCountLambda:  desiredCountLambda.String(),
 		EnvControllerLambda: envControllerLambda.String(),
+		RollbackAlarms:      s.manifest.Deployment.RollbackAlarms,
+		DeploymentStrategy:  strategy,
 		Storage:             storage,
 		Network:             convertNetworkConfig(s.manifest.Network),
 		EntryPoint:          entrypoint,
 		Command:             command,
-	})
+		Features:            s.manifest.Features,
+		CPUArchitecture:     arch,
+		LogGroupPrefix:      s.rc.LogGroupPrefix,
+		PullThroughCache:    s.rc.PullThroughCache,
+		HTTPRedirectCode:    s.httpRedirectCode(),
+		ExecutionRoleARN:    s.rc.ExecutionRoleARN,
+	}
+	if err := validateTaskDefinition(s.name, opts); err != nil {
+		return "", fmt.Errorf("validate task definition for service %s: %w", s.name, err)
+	}
+	content, err := s.parser.ParseLoadBalancedWebService(opts)
 	if err != nil {
 		return "", err
 	}
 	return content.String(), nil
 }
 
+// httpRedirectCode returns the status code of the listener rule that redirects HTTP requests to HTTPS.
+// It returns the empty string if HTTP requests are forwarded to the service instead.
+func (s *LoadBalancedWebService) httpRedirectCode() string {
+	if s.rc.DisableHTTPRedirect {
+		return ""
+	}
+	code := s.rc.HTTPRedirectStatusCode
+	if code == 0 {
+		code = config.DefaultHTTPRedirectStatusCode
+	}
+	return fmt.Sprintf("HTTP_%d", code)
+}
+
 func (s *LoadBalancedWebService) loadBalancerTarget() (targetContainer *string, targetPort *string, err error) {
 	containerName := s.name
 	containerPort := strconv.FormatUint(uint64(aws.Uint16Value(s.manifest.ImageConfig.Port)), 10)
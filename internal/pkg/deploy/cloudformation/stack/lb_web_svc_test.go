@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -28,8 +29,9 @@ const (
 )
 
 type mockTemplater struct {
-	tpl string
-	err error
+	tpl    string
+	params map[string]string
+	err    error
 }
 
 func (m mockTemplater) Template() (string, error) {
@@ -39,6 +41,10 @@ func (m mockTemplater) Template() (string, error) {
 	return m.tpl, nil
 }
 
+func (m mockTemplater) Parameters(envName string) (map[string]string, error) {
+	return m.params, nil
+}
+
 func TestLoadBalancedWebService_StackName(t *testing.T) {
 	testCases := map[string]struct {
 		inSvcName string
@@ -190,8 +196,9 @@ Outputs:
 						AssignPublicIP: template.EnablePublicIP,
 						SubnetsType:    template.PublicSubnetsPlacement,
 					},
-					EntryPoint: []string{"/bin/echo", "hello"},
-					Command:    []string{"world"},
+					EntryPoint:       []string{"/bin/echo", "hello"},
+					Command:          []string{"world"},
+					HTTPRedirectCode: "HTTP_301",
 				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
 
 				addons := mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
@@ -201,6 +208,66 @@ Outputs:
 
 			wantedTemplate: "template",
 		},
+		"deployment strategy that requires an HTTP redirect": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(gomock.Any()).Times(0)
+				mft := *testLBWebServiceManifest
+				mft.Deployment = manifest.DeploymentConfig{
+					Strategy: aws.String("canary"),
+				}
+				c.manifest = &mft
+				c.rc.DisableHTTPRedirect = true
+				c.parser = m
+				c.wkld.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedError: errors.New(`"deployment.strategy" "canary" of service frontend requires the environment to redirect HTTP requests to HTTPS`),
+		},
+		"render template with a canary deployment": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(template.WorkloadOpts{
+					WorkloadType: manifest.LoadBalancedWebServiceType,
+					HTTPHealthCheck: template.HTTPHealthCheckOpts{
+						HealthCheckPath: "/",
+					},
+					RulePriorityLambda:  "lambda",
+					DesiredCountLambda:  "something",
+					EnvControllerLambda: "something",
+					RollbackAlarms:      []string{"HighErrorRate"},
+					DeploymentStrategy: &template.DeploymentStrategyOpts{
+						Strategy:     template.CanaryDeploymentStrategy,
+						StepPercent:  aws.Float64(10),
+						StepBakeTime: aws.Int(5),
+					},
+					Network: &template.NetworkOpts{
+						AssignPublicIP: template.EnablePublicIP,
+						SubnetsType:    template.PublicSubnetsPlacement,
+					},
+					EntryPoint:       []string{"/bin/echo", "hello"},
+					Command:          []string{"world"},
+					HTTPRedirectCode: "HTTP_301",
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				stepBakeTime := 5 * time.Minute
+				mft := *testLBWebServiceManifest
+				mft.Deployment = manifest.DeploymentConfig{
+					Strategy:       aws.String("canary"),
+					StepPercent:    aws.Float64(10),
+					StepBakeTime:   &stepBakeTime,
+					RollbackAlarms: []string{"HighErrorRate"},
+				}
+				c.manifest = &mft
+				c.parser = m
+				c.wkld.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedTemplate: "template",
+		},
 		"render template with addons": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
 				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
@@ -209,7 +276,10 @@ Outputs:
 				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
 				m.EXPECT().ParseLoadBalancedWebService(template.WorkloadOpts{
 					NestedStack: &template.WorkloadNestedStackOpts{
-						StackName:       addon.StackName,
+						StackName: addon.StackName,
+						Parameters: map[string]string{
+							"BillingMode": "PROVISIONED",
+						},
 						VariableOutputs: []string{"Hello"},
 						SecretOutputs:   []string{"MySecretArn"},
 						PolicyOutputs:   []string{"AdditionalResourcesPolicyArn"},
@@ -225,8 +295,9 @@ Outputs:
 						AssignPublicIP: template.EnablePublicIP,
 						SubnetsType:    template.PublicSubnetsPlacement,
 					},
-					EntryPoint: []string{"/bin/echo", "hello"},
-					Command:    []string{"world"},
+					EntryPoint:       []string{"/bin/echo", "hello"},
+					Command:          []string{"world"},
+					HTTPRedirectCode: "HTTP_301",
 				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
 				addons := mockTemplater{
 					tpl: `Resources:
@@ -254,6 +325,9 @@ Outputs:
     Value: !Ref MySecret
   Hello:
     Value: hello`,
+					params: map[string]string{
+						"BillingMode": "PROVISIONED",
+					},
 				}
 				c.parser = m
 				c.addons = addons
@@ -297,6 +371,45 @@ Outputs:
 	}
 }
 
+func TestLoadBalancedWebService_httpRedirectCode(t *testing.T) {
+	testCases := map[string]struct {
+		rc     RuntimeConfig
+		wanted string
+	}{
+		"defaults to a permanent redirect": {
+			wanted: "HTTP_301",
+		},
+		"uses the status code of the environment": {
+			rc: RuntimeConfig{
+				HTTPRedirectStatusCode: 302,
+			},
+			wanted: "HTTP_302",
+		},
+		"forwards HTTP requests if the redirect is disabled": {
+			rc: RuntimeConfig{
+				DisableHTTPRedirect: true,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			s := &LoadBalancedWebService{
+				wkld: &wkld{
+					rc: tc.rc,
+				},
+			}
+
+			// WHEN
+			code := s.httpRedirectCode()
+
+			// THEN
+			require.Equal(t, tc.wanted, code)
+		})
+	}
+}
+
 func TestLoadBalancedWebService_Parameters(t *testing.T) {
 	baseProps := &manifest.LoadBalancedWebServiceProps{
 		WorkloadProps: &manifest.WorkloadProps{
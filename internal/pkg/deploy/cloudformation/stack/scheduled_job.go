@@ -4,6 +4,7 @@
 package stack
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
@@ -12,10 +13,12 @@ import (
 	"unicode"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/robfig/cron/v3"
 )
 
@@ -95,20 +98,25 @@ func NewScheduledJob(mft *manifest.ScheduledJob, env, app string, rc RuntimeConf
 	if err != nil {
 		return nil, fmt.Errorf("new addons: %w", err)
 	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
 	envManifest, err := mft.ApplyEnv(env)
 	if err != nil {
 		return nil, fmt.Errorf("apply environment %s override: %w", env, err)
 	}
 	return &ScheduledJob{
 		wkld: &wkld{
-			name:   aws.StringValue(mft.Name),
-			env:    env,
-			app:    app,
-			tc:     envManifest.ScheduledJobConfig.TaskConfig,
-			rc:     rc,
-			image:  envManifest.ImageConfig,
-			parser: parser,
-			addons: addons,
+			name:    aws.StringValue(mft.Name),
+			env:     env,
+			app:     app,
+			tc:      envManifest.ScheduledJobConfig.TaskConfig,
+			rc:      rc,
+			image:   envManifest.ImageConfig,
+			parser:  parser,
+			addons:  addons,
+			envFile: ws,
 		},
 		manifest: envManifest,
 
@@ -127,11 +135,29 @@ func (j *ScheduledJob) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for job %s: %w", j.name, err)
 	}
+	initContainers, err := convertInitContainers(j.manifest.InitContainers)
+	if err != nil {
+		return "", fmt.Errorf("convert the init container configuration for job %s: %w", j.name, err)
+	}
+	if err := validateContainerNames(j.name, sidecars, initContainers); err != nil {
+		return "", fmt.Errorf("validate the containers of job %s: %w", j.name, err)
+	}
+	dependsOn, err := convertDependsOn(j.manifest.ImageConfig.DependsOn)
+	if err != nil {
+		return "", fmt.Errorf("convert the container dependencies of job %s: %w", j.name, err)
+	}
+	if err := validateContainerDependencies(j.name, dependsOn, nil, sidecars); err != nil {
+		return "", fmt.Errorf("validate the container dependencies of job %s: %w", j.name, err)
+	}
 
 	schedule, err := j.awsSchedule()
 	if err != nil {
 		return "", fmt.Errorf("convert schedule for job %s: %w", j.name, err)
 	}
+	eventPattern, err := j.eventPattern()
+	if err != nil {
+		return "", fmt.Errorf("convert event pattern for job %s: %w", j.name, err)
+	}
 
 	stateMachine, err := j.stateMachineOpts()
 	if err != nil {
@@ -142,6 +168,7 @@ func (j *ScheduledJob) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("convert storage options for job %s: %w", j.name, err)
 	}
+	referenceAddonsFilesystems(storage, outputs)
 
 	envControllerLambda, err := j.parser.Read(envControllerPath)
 	if err != nil {
@@ -156,22 +183,68 @@ func (j *ScheduledJob) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf(`convert 'command' to string slice: %w`, err)
 	}
-	content, err := j.parser.ParseScheduledJob(template.WorkloadOpts{
-		Variables:          j.manifest.Variables,
+	variables, err := j.variables()
+	if err != nil {
+		return "", err
+	}
+	gpu, err := convertGPU(j.manifest.GPU)
+	if err != nil {
+		return "", fmt.Errorf("convert the gpu configuration for job %s: %w", j.name, err)
+	}
+	network := convertNetworkConfig(j.manifest.Network)
+	if gpu > 0 {
+		network = gpuNetworkConfig(network)
+	}
+	external, err := convertLaunchType(j.manifest.LaunchType)
+	if err != nil {
+		return "", fmt.Errorf("convert the launch type for job %s: %w", j.name, err)
+	}
+	if external {
+		if err := validateExternalLaunchType(gpu, false, storage); err != nil {
+			return "", fmt.Errorf("validate the launch type for job %s: %w", j.name, err)
+		}
+	}
+	arch, err := convertCPUArchitecture(j.manifest.ImageConfig.Build.BuildArgs.Platform)
+	if err != nil {
+		return "", fmt.Errorf("convert the platform for job %s: %w", j.name, err)
+	}
+	if err := validateCPUArchitecture(arch, gpu, false); err != nil {
+		return "", fmt.Errorf("validate the platform for job %s: %w", j.name, err)
+	}
+	logConfig, err := convertLogging(j.manifest.Logging, j.manifest.TaskConfig.CPU, j.manifest.TaskConfig.Memory)
+	if err != nil {
+		return "", fmt.Errorf("convert the logging configuration for job %s: %w", j.name, err)
+	}
+	opts := template.WorkloadOpts{
+		Variables:          variables,
 		Secrets:            j.manifest.Secrets,
 		NestedStack:        outputs,
 		Sidecars:           sidecars,
+		InitContainers:     initContainers,
 		ScheduleExpression: schedule,
+		EventPattern:       eventPattern,
+		EventBus:           aws.StringValue(j.manifest.On.EventBus),
 		StateMachine:       stateMachine,
-		LogConfig:          convertLogging(j.manifest.Logging),
+		LogConfig:          logConfig,
 		DockerLabels:       j.manifest.ImageConfig.DockerLabels,
+		DependsOn:          dependsOn,
+		GPU:                gpu,
+		External:           external,
+		CPUArchitecture:    arch,
 		Storage:            storage,
-		Network:            convertNetworkConfig(j.manifest.Network),
+		Network:            network,
 		EntryPoint:         entrypoint,
 		Command:            command,
+		ExecutionRoleARN:   j.rc.ExecutionRoleARN,
+		LogGroupPrefix:     j.rc.LogGroupPrefix,
+		PullThroughCache:   j.rc.PullThroughCache,
 
 		EnvControllerLambda: envControllerLambda.String(),
-	})
+	}
+	if err := validateTaskDefinition(j.name, opts); err != nil {
+		return "", fmt.Errorf("validate task definition for job %s: %w", j.name, err)
+	}
+	content, err := j.parser.ParseScheduledJob(opts)
 	if err != nil {
 		return "", fmt.Errorf("parse scheduled job template: %w", err)
 	}
@@ -214,7 +287,11 @@ func (j *ScheduledJob) SerializedParameters() (string, error) {
 func (j *ScheduledJob) awsSchedule() (string, error) {
 	schedule := aws.StringValue(j.manifest.On.Schedule)
 	if schedule == "" {
-		return "", fmt.Errorf(`missing required field "schedule" in manifest for job %s`, j.name)
+		if j.manifest.On.EventPattern != nil {
+			// The job is only triggered by events.
+			return "", nil
+		}
+		return "", fmt.Errorf(`missing required field "schedule" or "event_pattern" in manifest for job %s`, j.name)
 	}
 	// If the schedule uses default CloudWatch Events syntax, pass it through for server-side validation.
 	if match := awsScheduleRegexp.FindStringSubmatch(schedule); match != nil {
@@ -246,6 +323,26 @@ func (j *ScheduledJob) awsSchedule() (string, error) {
 	return scheduleExpression, nil
 }
 
+// eventPattern serializes the EventBridge event pattern that triggers the job to JSON.
+// It returns an empty string if the job isn't triggered by events.
+func (j *ScheduledJob) eventPattern() (string, error) {
+	pattern := j.manifest.On.EventPattern
+	if pattern == nil {
+		if j.manifest.On.EventBus != nil {
+			return "", errors.New(`"event_bus" requires "event_pattern" to be set`)
+		}
+		return "", nil
+	}
+	if len(pattern) == 0 {
+		return "", errors.New(`"event_pattern" must match at least one field of an event`)
+	}
+	out, err := json.Marshal(pattern)
+	if err != nil {
+		return "", fmt.Errorf("marshal event pattern to JSON: %w", err)
+	}
+	return string(out), nil
+}
+
 // toRate converts a cron "@every" directive to a rate expression defined in minutes.
 // example input: @every 1h30m
 //        output: rate(90 minutes)
@@ -381,6 +478,21 @@ func (j *ScheduledJob) stateMachineOpts() (*template.StateMachineOpts, error) {
 		timeoutSeconds = aws.Int(int(parsedTimeout.Seconds()))
 	}
 
+	var taskTimeoutSeconds *int
+	if inTaskTimeout := aws.StringValue(j.manifest.TaskTimeout); inTaskTimeout != "" {
+		parsedTaskTimeout, err := time.ParseDuration(inTaskTimeout)
+		if err != nil {
+			return nil, errDurationInvalid{reason: err}
+		}
+		if parsedTaskTimeout < 1*time.Second || parsedTaskTimeout != parsedTaskTimeout.Truncate(time.Second) {
+			return nil, errors.New("task timeout must be a whole number of seconds greater than or equal to 1 second")
+		}
+		taskTimeoutSeconds = aws.Int(int(parsedTaskTimeout.Seconds()))
+		if timeoutSeconds != nil && *taskTimeoutSeconds > *timeoutSeconds {
+			return nil, errors.New("task timeout must be less than or equal to timeout")
+		}
+	}
+
 	var retries *int
 	if inRetries := aws.IntValue(j.manifest.Retries); inRetries != 0 {
 		if inRetries < 0 {
@@ -388,8 +500,60 @@ func (j *ScheduledJob) stateMachineOpts() (*template.StateMachineOpts, error) {
 		}
 		retries = aws.Int(inRetries)
 	}
+
+	backoff := j.manifest.Backoff
+	if (backoff.Interval != nil || backoff.Rate != nil) && retries == nil {
+		return nil, errors.New(`"backoff" requires "retries" to be set`)
+	}
+	var retryInterval *int
+	if inInterval := aws.StringValue(backoff.Interval); inInterval != "" {
+		parsedInterval, err := time.ParseDuration(inInterval)
+		if err != nil {
+			return nil, errDurationInvalid{reason: err}
+		}
+		if parsedInterval < 1*time.Second || parsedInterval != parsedInterval.Truncate(time.Second) {
+			return nil, errors.New("backoff interval must be a whole number of seconds greater than or equal to 1 second")
+		}
+		retryInterval = aws.Int(int(parsedInterval.Seconds()))
+	}
+	if backoff.Rate != nil && aws.Float64Value(backoff.Rate) < 1 {
+		return nil, errors.New("backoff rate must be greater than or equal to 1")
+	}
+
+	onFailure, err := convertJobFailureNotification(j.manifest.OnFailure)
+	if err != nil {
+		return nil, err
+	}
 	return &template.StateMachineOpts{
-		Timeout: timeoutSeconds,
-		Retries: retries,
+		Timeout:       timeoutSeconds,
+		TaskTimeout:   taskTimeoutSeconds,
+		Retries:       retries,
+		RetryInterval: retryInterval,
+		BackoffRate:   backoff.Rate,
+		OnFailure:     onFailure,
 	}, nil
 }
+
+// convertJobFailureNotification validates the ARNs of the targets notified when the job fails.
+// It returns nil if no target is set.
+func convertJobFailureNotification(in manifest.JobFailureNotificationConfig) (*template.JobFailureNotificationOpts, error) {
+	if in.SNSTopic == nil && in.EventBus == nil {
+		return nil, nil
+	}
+	opts := &template.JobFailureNotificationOpts{}
+	if in.SNSTopic != nil {
+		parsed, err := arn.Parse(aws.StringValue(in.SNSTopic))
+		if err != nil || parsed.Service != "sns" {
+			return nil, fmt.Errorf(`"on_failure.sns_topic" %q must be the ARN of an SNS topic`, aws.StringValue(in.SNSTopic))
+		}
+		opts.SNSTopic = aws.StringValue(in.SNSTopic)
+	}
+	if in.EventBus != nil {
+		parsed, err := arn.Parse(aws.StringValue(in.EventBus))
+		if err != nil || parsed.Service != "events" || !strings.HasPrefix(parsed.Resource, "event-bus/") {
+			return nil, fmt.Errorf(`"on_failure.event_bus" %q must be the ARN of an EventBridge event bus`, aws.StringValue(in.EventBus))
+		}
+		opts.EventBus = aws.StringValue(in.EventBus)
+	}
+	return opts, nil
+}
@@ -66,8 +66,8 @@ func TestScheduledJob_Template(t *testing.T) {
 						AssignPublicIP: template.EnablePublicIP,
 						SubnetsType:    template.PublicSubnetsPlacement,
 					},
-					EntryPoint: []string{"/bin/echo", "hello"},
-					Command:    []string{"world"},
+					EntryPoint:          []string{"/bin/echo", "hello"},
+					Command:             []string{"world"},
 					EnvControllerLambda: "something",
 				})).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
 				addons := mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
@@ -96,8 +96,8 @@ func TestScheduledJob_Template(t *testing.T) {
 						AssignPublicIP: template.EnablePublicIP,
 						SubnetsType:    template.PublicSubnetsPlacement,
 					},
-					EntryPoint: []string{"/bin/echo", "hello"},
-					Command:    []string{"world"},
+					EntryPoint:          []string{"/bin/echo", "hello"},
+					Command:             []string{"world"},
 					EnvControllerLambda: "something",
 				})).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
 				addons := mockTemplater{
@@ -132,6 +132,76 @@ Outputs:
 			},
 			wantedTemplate: "template",
 		},
+		"render template with gpu in private subnets": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, j *ScheduledJob) {
+				mft := *testScheduledJobManifest
+				mft.GPU = aws.Int(1)
+				j.manifest = &mft
+				m := mocks.NewMockscheduledJobReadParser(ctrl)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseScheduledJob(gomock.Eq(template.WorkloadOpts{
+					ScheduleExpression: "cron(0 0 * * ? *)",
+					StateMachine: &template.StateMachineOpts{
+						Timeout: aws.Int(5400),
+						Retries: aws.Int(3),
+					},
+					Network: &template.NetworkOpts{
+						AssignPublicIP: template.DisablePublicIP,
+						SubnetsType:    template.PrivateSubnetsPlacement,
+					},
+					EntryPoint:          []string{"/bin/echo", "hello"},
+					Command:             []string{"world"},
+					GPU:                 1,
+					EnvControllerLambda: "something",
+				})).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				j.parser = m
+				j.wkld.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedTemplate: "template",
+		},
+		"render template on external instances": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, j *ScheduledJob) {
+				mft := *testScheduledJobManifest
+				mft.LaunchType = aws.String("EXTERNAL")
+				j.manifest = &mft
+				m := mocks.NewMockscheduledJobReadParser(ctrl)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseScheduledJob(gomock.Eq(template.WorkloadOpts{
+					ScheduleExpression: "cron(0 0 * * ? *)",
+					StateMachine: &template.StateMachineOpts{
+						Timeout: aws.Int(5400),
+						Retries: aws.Int(3),
+					},
+					Network: &template.NetworkOpts{
+						AssignPublicIP: template.EnablePublicIP,
+						SubnetsType:    template.PublicSubnetsPlacement,
+					},
+					EntryPoint:          []string{"/bin/echo", "hello"},
+					Command:             []string{"world"},
+					External:            true,
+					EnvControllerLambda: "something",
+				})).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				j.parser = m
+				j.wkld.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedTemplate: "template",
+		},
+		"error if the main container has too many secrets": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, j *ScheduledJob) {
+				mft := *testScheduledJobManifest
+				mft.Secrets = make(map[string]string)
+				for i := 0; i < 101; i++ {
+					mft.Secrets[fmt.Sprintf("SECRET_%d", i)] = fmt.Sprintf("/copilot/secret-%d", i)
+				}
+				j.manifest = &mft
+				m := mocks.NewMockscheduledJobReadParser(ctrl)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				j.parser = m
+				j.wkld.addons = mockTemplater{err: &addon.ErrAddonsDirNotExist{}}
+			},
+			wantedError: errors.New(`validate task definition for job mailer: container mailer has 101 secrets which exceeds the limit of 100: ` +
+				`consider storing related values as a single JSON secret in Secrets Manager and referencing its keys with "secretsmanager:<name>:<key>::"`),
+		},
 		"error parsing addons": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, j *ScheduledJob) {
 				m := mocks.NewMockscheduledJobReadParser(ctrl)
@@ -190,10 +260,11 @@ Outputs:
 
 func TestScheduledJob_awsSchedule(t *testing.T) {
 	testCases := map[string]struct {
-		inputSchedule   string
-		wantedSchedule  string
-		wantedError     error
-		wantedErrorType interface{}
+		inputSchedule     string
+		inputEventPattern map[string]interface{}
+		wantedSchedule    string
+		wantedError       error
+		wantedErrorType   interface{}
 	}{
 		"simple rate": {
 			inputSchedule:  "@every 1h30m",
@@ -201,7 +272,14 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 		},
 		"missing schedule": {
 			inputSchedule: "",
-			wantedError:   errors.New(`missing required field "schedule" in manifest for job mailer`),
+			wantedError:   errors.New(`missing required field "schedule" or "event_pattern" in manifest for job mailer`),
+		},
+		"no schedule if only triggered by events": {
+			inputSchedule: "",
+			inputEventPattern: map[string]interface{}{
+				"source": []interface{}{"aws.s3"},
+			},
+			wantedSchedule: "",
 		},
 		"one minute rate": {
 			inputSchedule:  "@every 1m",
@@ -310,7 +388,8 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 				manifest: &manifest.ScheduledJob{
 					ScheduledJobConfig: manifest.ScheduledJobConfig{
 						On: manifest.JobTriggerConfig{
-							Schedule: aws.String(tc.inputSchedule),
+							Schedule:     aws.String(tc.inputSchedule),
+							EventPattern: tc.inputEventPattern,
 						},
 					},
 				},
@@ -333,13 +412,80 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 	}
 }
 
+func TestScheduledJob_eventPattern(t *testing.T) {
+	testCases := map[string]struct {
+		inputEventPattern map[string]interface{}
+		inputEventBus     *string
+
+		wantedPattern string
+		wantedError   error
+	}{
+		"no pattern": {
+			wantedPattern: "",
+		},
+		"errors if the event bus is set without a pattern": {
+			inputEventBus: aws.String("orders"),
+			wantedError:   errors.New(`"event_bus" requires "event_pattern" to be set`),
+		},
+		"errors if the pattern is empty": {
+			inputEventPattern: map[string]interface{}{},
+			wantedError:       errors.New(`"event_pattern" must match at least one field of an event`),
+		},
+		"serializes nested patterns to JSON": {
+			inputEventPattern: map[string]interface{}{
+				"source":      []interface{}{"aws.s3"},
+				"detail-type": []interface{}{"Object Created"},
+				"detail": map[string]interface{}{
+					"bucket": map[string]interface{}{
+						"name": []interface{}{"uploads"},
+					},
+				},
+			},
+			inputEventBus: aws.String("default"),
+			wantedPattern: `{"detail":{"bucket":{"name":["uploads"]}},"detail-type":["Object Created"],"source":["aws.s3"]}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			job := &ScheduledJob{
+				wkld: &wkld{
+					name: "mailer",
+				},
+				manifest: &manifest.ScheduledJob{
+					ScheduledJobConfig: manifest.ScheduledJobConfig{
+						On: manifest.JobTriggerConfig{
+							EventPattern: tc.inputEventPattern,
+							EventBus:     tc.inputEventBus,
+						},
+					},
+				},
+			}
+
+			// WHEN
+			pattern, err := job.eventPattern()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPattern, pattern)
+		})
+	}
+}
+
 func TestScheduledJob_stateMachine(t *testing.T) {
 	testCases := map[string]struct {
-		inputTimeout    string
-		inputRetries    int
-		wantedConfig    template.StateMachineOpts
-		wantedError     error
-		wantedErrorType interface{}
+		inputTimeout     string
+		inputTaskTimeout string
+		inputRetries     int
+		inputBackoff     manifest.JobBackoffConfig
+		inputOnFailure   manifest.JobFailureNotificationConfig
+		wantedConfig     template.StateMachineOpts
+		wantedError      error
+		wantedErrorType  interface{}
 	}{
 		"timeout and retries": {
 			inputTimeout: "3h",
@@ -379,6 +525,85 @@ func TestScheduledJob_stateMachine(t *testing.T) {
 			inputTimeout: "1s40ms",
 			wantedError:  errors.New("timeout must be a whole number of seconds, minutes, or hours"),
 		},
+		"task timeout with retries": {
+			inputTimeout:     "1h",
+			inputTaskTimeout: "15m",
+			inputRetries:     3,
+			wantedConfig: template.StateMachineOpts{
+				Timeout:     aws.Int(3600),
+				TaskTimeout: aws.Int(900),
+				Retries:     aws.Int(3),
+			},
+		},
+		"task timeout non-integer number of seconds": {
+			inputTaskTimeout: "1s40ms",
+			wantedError:      errors.New("task timeout must be a whole number of seconds greater than or equal to 1 second"),
+		},
+		"invalid task timeout": {
+			inputTaskTimeout: "15 minutes",
+			wantedErrorType:  &errDurationInvalid{},
+		},
+		"task timeout longer than timeout": {
+			inputTimeout:     "10m",
+			inputTaskTimeout: "15m",
+			wantedError:      errors.New("task timeout must be less than or equal to timeout"),
+		},
+		"retries with backoff": {
+			inputRetries: 3,
+			inputBackoff: manifest.JobBackoffConfig{
+				Interval: aws.String("1m"),
+				Rate:     aws.Float64(2),
+			},
+			wantedConfig: template.StateMachineOpts{
+				Retries:       aws.Int(3),
+				RetryInterval: aws.Int(60),
+				BackoffRate:   aws.Float64(2),
+			},
+		},
+		"backoff without retries": {
+			inputBackoff: manifest.JobBackoffConfig{
+				Rate: aws.Float64(2),
+			},
+			wantedError: errors.New(`"backoff" requires "retries" to be set`),
+		},
+		"backoff interval too small": {
+			inputRetries: 3,
+			inputBackoff: manifest.JobBackoffConfig{
+				Interval: aws.String("500ms"),
+			},
+			wantedError: errors.New("backoff interval must be a whole number of seconds greater than or equal to 1 second"),
+		},
+		"backoff rate too small": {
+			inputRetries: 3,
+			inputBackoff: manifest.JobBackoffConfig{
+				Rate: aws.Float64(0.5),
+			},
+			wantedError: errors.New("backoff rate must be greater than or equal to 1"),
+		},
+		"notifies an SNS topic and an event bus on failure": {
+			inputOnFailure: manifest.JobFailureNotificationConfig{
+				SNSTopic: aws.String("arn:aws:sns:us-west-2:123456789012:job-failures"),
+				EventBus: aws.String("arn:aws:events:us-west-2:123456789012:event-bus/default"),
+			},
+			wantedConfig: template.StateMachineOpts{
+				OnFailure: &template.JobFailureNotificationOpts{
+					SNSTopic: "arn:aws:sns:us-west-2:123456789012:job-failures",
+					EventBus: "arn:aws:events:us-west-2:123456789012:event-bus/default",
+				},
+			},
+		},
+		"invalid SNS topic": {
+			inputOnFailure: manifest.JobFailureNotificationConfig{
+				SNSTopic: aws.String("job-failures"),
+			},
+			wantedError: errors.New(`"on_failure.sns_topic" "job-failures" must be the ARN of an SNS topic`),
+		},
+		"invalid event bus": {
+			inputOnFailure: manifest.JobFailureNotificationConfig{
+				EventBus: aws.String("arn:aws:events:us-west-2:123456789012:rule/my-rule"),
+			},
+			wantedError: errors.New(`"on_failure.event_bus" "arn:aws:events:us-west-2:123456789012:rule/my-rule" must be the ARN of an EventBridge event bus`),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -390,8 +615,11 @@ func TestScheduledJob_stateMachine(t *testing.T) {
 				manifest: &manifest.ScheduledJob{
 					ScheduledJobConfig: manifest.ScheduledJobConfig{
 						JobFailureHandlerConfig: manifest.JobFailureHandlerConfig{
-							Retries: aws.Int(tc.inputRetries),
-							Timeout: aws.String(tc.inputTimeout),
+							Retries:     aws.Int(tc.inputRetries),
+							Timeout:     aws.String(tc.inputTimeout),
+							TaskTimeout: aws.String(tc.inputTaskTimeout),
+							Backoff:     tc.inputBackoff,
+							OnFailure:   tc.inputOnFailure,
 						},
 					},
 				},
@@ -408,6 +636,10 @@ func TestScheduledJob_stateMachine(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, aws.IntValue(tc.wantedConfig.Retries), aws.IntValue(parsedStateMachine.Retries))
 				require.Equal(t, aws.IntValue(tc.wantedConfig.Timeout), aws.IntValue(parsedStateMachine.Timeout))
+				require.Equal(t, tc.wantedConfig.TaskTimeout, parsedStateMachine.TaskTimeout)
+				require.Equal(t, tc.wantedConfig.RetryInterval, parsedStateMachine.RetryInterval)
+				require.Equal(t, tc.wantedConfig.BackoffRate, parsedStateMachine.BackoffRate)
+				require.Equal(t, tc.wantedConfig.OnFailure, parsedStateMachine.OnFailure)
 			}
 		})
 	}
@@ -0,0 +1,110 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+)
+
+// Names of the vetted sidecar presets.
+const (
+	datadogAgentPreset  = "datadog-agent"
+	newRelicInfraPreset = "newrelic-infra"
+)
+
+// sidecarPreset is a vetted sidecar container definition for a common agent.
+type sidecarPreset struct {
+	image     string
+	port      string // Empty if the agent doesn't listen on a port.
+	essential bool
+	variables map[string]string
+	secrets   []string // Names of the secrets that the user must reference in the manifest.
+	actions   []string // IAM actions that the agent needs in the task role.
+}
+
+var sidecarPresets = map[string]sidecarPreset{
+	// See https://docs.datadoghq.com/integrations/ecs_fargate/
+	datadogAgentPreset: {
+		image:     "public.ecr.aws/datadog/agent:latest",
+		port:      "8126",
+		essential: false,
+		variables: map[string]string{
+			"ECS_FARGATE":                    "true",
+			"DD_APM_ENABLED":                 "true",
+			"DD_DOGSTATSD_NON_LOCAL_TRAFFIC": "true",
+		},
+		secrets: []string{"DD_API_KEY"},
+		actions: []string{
+			"ecs:ListClusters",
+			"ecs:ListContainerInstances",
+			"ecs:DescribeContainerInstances",
+		},
+	},
+	// See https://docs.newrelic.com/docs/integrations/elastic-container-service-integration/installation/install-ecs-integration/
+	newRelicInfraPreset: {
+		image:     "newrelic/nri-ecs:1.9.2",
+		essential: false,
+		variables: map[string]string{
+			"NRIA_OVERRIDE_HOST_ROOT":      "",
+			"NRIA_IS_FORWARD_ONLY":         "true",
+			"FARGATE":                      "true",
+			"NRIA_PASSTHROUGH_ENVIRONMENT": "ECS_CONTAINER_METADATA_URI,ECS_CONTAINER_METADATA_URI_V4,FARGATE",
+			"NRIA_CUSTOM_ATTRIBUTES":       `{"nrDeployMethod":"copilot"}`,
+		},
+		secrets: []string{"NRIA_LICENSE_KEY"},
+	},
+}
+
+// applySidecarPreset returns a copy of the sidecar configuration where the fields that are not set
+// are filled with the values of its preset, along with the IAM actions required by the preset.
+// If the sidecar doesn't use a preset, it's returned as is.
+func applySidecarPreset(name string, config *manifest.SidecarConfig) (*manifest.SidecarConfig, []string, error) {
+	if config.Preset == nil {
+		return config, nil, nil
+	}
+	preset, ok := sidecarPresets[aws.StringValue(config.Preset)]
+	if !ok {
+		return nil, nil, fmt.Errorf(`sidecar %s: preset "%s" is not supported, must be one of: %s`,
+			name, aws.StringValue(config.Preset), strings.Join(sidecarPresetNames(), ", "))
+	}
+	for _, secret := range preset.secrets {
+		if _, ok := config.Secrets[secret]; !ok {
+			return nil, nil, fmt.Errorf(`sidecar %s: preset "%s" requires the secret "%s" under "secrets"`,
+				name, aws.StringValue(config.Preset), secret)
+		}
+	}
+
+	out := *config
+	if out.Image == nil {
+		out.Image = aws.String(preset.image)
+	}
+	if out.Port == nil && preset.port != "" {
+		out.Port = aws.String(preset.port)
+	}
+	if out.Essential == nil {
+		out.Essential = aws.Bool(preset.essential)
+	}
+	out.Variables = make(map[string]string)
+	for k, v := range preset.variables {
+		out.Variables[k] = v
+	}
+	for k, v := range config.Variables {
+		out.Variables[k] = v
+	}
+	return &out, preset.actions, nil
+}
+
+func sidecarPresetNames() []string {
+	var names []string
+	for name := range sidecarPresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,111 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_convertSidecarPreset(t *testing.T) {
+	testCases := map[string]struct {
+		in *manifest.SidecarConfig
+
+		wanted    *template.SidecarOpts
+		wantedErr error
+	}{
+		"unknown preset": {
+			in: &manifest.SidecarConfig{
+				Preset: aws.String("splunk"),
+			},
+
+			wantedErr: errors.New(`sidecar agent: preset "splunk" is not supported, must be one of: datadog-agent, newrelic-infra`),
+		},
+		"missing required secret": {
+			in: &manifest.SidecarConfig{
+				Preset: aws.String("datadog-agent"),
+			},
+
+			wantedErr: errors.New(`sidecar agent: preset "datadog-agent" requires the secret "DD_API_KEY" under "secrets"`),
+		},
+		"expands the datadog agent preset": {
+			in: &manifest.SidecarConfig{
+				Preset: aws.String("datadog-agent"),
+				Secrets: map[string]string{
+					"DD_API_KEY": "/copilot/datadog/api-key",
+				},
+			},
+
+			wanted: &template.SidecarOpts{
+				Name:      aws.String("agent"),
+				Image:     aws.String("public.ecr.aws/datadog/agent:latest"),
+				Essential: aws.Bool(false),
+				Port:      aws.String("8126"),
+				Variables: map[string]string{
+					"ECS_FARGATE":                    "true",
+					"DD_APM_ENABLED":                 "true",
+					"DD_DOGSTATSD_NON_LOCAL_TRAFFIC": "true",
+				},
+				Secrets: map[string]string{
+					"DD_API_KEY": "/copilot/datadog/api-key",
+				},
+				Permissions: []string{
+					"ecs:ListClusters",
+					"ecs:ListContainerInstances",
+					"ecs:DescribeContainerInstances",
+				},
+			},
+		},
+		"manifest fields override the preset": {
+			in: &manifest.SidecarConfig{
+				Preset:    aws.String("newrelic-infra"),
+				Image:     aws.String("newrelic/nri-ecs:1.9.3"),
+				Essential: aws.Bool(true),
+				Variables: map[string]string{
+					"NRIA_IS_FORWARD_ONLY": "false",
+				},
+				Secrets: map[string]string{
+					"NRIA_LICENSE_KEY": "/copilot/newrelic/license",
+				},
+			},
+
+			wanted: &template.SidecarOpts{
+				Name:      aws.String("agent"),
+				Image:     aws.String("newrelic/nri-ecs:1.9.3"),
+				Essential: aws.Bool(true),
+				Variables: map[string]string{
+					"NRIA_OVERRIDE_HOST_ROOT":      "",
+					"NRIA_IS_FORWARD_ONLY":         "false",
+					"FARGATE":                      "true",
+					"NRIA_PASSTHROUGH_ENVIRONMENT": "ECS_CONTAINER_METADATA_URI,ECS_CONTAINER_METADATA_URI_V4,FARGATE",
+					"NRIA_CUSTOM_ATTRIBUTES":       `{"nrDeployMethod":"copilot"}`,
+				},
+				Secrets: map[string]string{
+					"NRIA_LICENSE_KEY": "/copilot/newrelic/license",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := convertSidecar(map[string]*manifest.SidecarConfig{
+				"agent": tc.in,
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, []*template.SidecarOpts{tc.wanted}, got)
+			}
+		})
+	}
+}
@@ -7,10 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"hash/crc32"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
@@ -20,6 +22,14 @@ const (
 	disabled = "DISABLED"
 )
 
+// containerDependencyConditions maps the conditions of "depends_on" to their ECS value.
+var containerDependencyConditions = map[string]string{
+	"start":    ecs.ContainerConditionStart,
+	"complete": ecs.ContainerConditionComplete,
+	"success":  ecs.ContainerConditionSuccess,
+	"healthy":  ecs.ContainerConditionHealthy,
+}
+
 // Default values for EFS options
 const (
 	defaultRootDirectory   = "/"
@@ -33,15 +43,57 @@ const (
 	defaultSidecarPort = "80"
 )
 
+// Default site of the Datadog logs intake.
+const (
+	defaultDatadogSite = "datadoghq.com"
+)
+
 // Supported capacity providers for Fargate services
 const (
 	capacityProviderFargateSpot = "FARGATE_SPOT"
 	capacityProviderFargate     = "FARGATE"
 )
 
-var (
+// Supported platforms of images built from a Dockerfile and the CPU architecture of the tasks running them.
+var platformCPUArchitecture = map[string]string{
+	"linux/amd64":    template.CPUArchitectureX86,
+	"linux/x86_64":   template.CPUArchitectureX86,
+	"linux/arm64":    template.CPUArchitectureARM,
+	"linux/arm64/v8": template.CPUArchitectureARM,
+}
+
+// Valid range of listener rule priorities.
+const (
+	minRulePriority = 1
+	maxRulePriority = 50000
+)
 
+// Valid ranges of the traffic shifting settings of a deployment.
+const (
+	minCanaryPercent     = 0.1
+	minLinearStepPercent = 3
+	maxStepPercent       = 100
+	maxBakeTimeInMinutes = 1440
+)
+
+var deploymentStrategies = map[string]string{
+	manifest.BlueGreenDeploymentStrategy: template.BlueGreenDeploymentStrategy,
+	manifest.CanaryDeploymentStrategy:    template.CanaryDeploymentStrategy,
+	manifest.LinearDeploymentStrategy:    template.LinearDeploymentStrategy,
+}
+
+var (
 	errInvalidSpotConfig   = errors.New(`"count.spot" and "count.range" cannot be specified together`)
+	errInvalidGPU          = errors.New(`"gpu" must be a positive integer`)
+	errGPUWithSpot         = errors.New(`"gpu" and "count.spot" cannot be specified together`)
+	errARM64WithSpot       = errors.New(`"count.spot" is not supported for images built for the "linux/arm64" platform`)
+	errARM64WithGPU        = errors.New(`"gpu" is not supported for images built for the "linux/arm64" platform`)
+	errExternalWithGPU     = errors.New(`"gpu" is not supported for tasks with the EXTERNAL "launch_type"`)
+	errExternalWithSpot    = errors.New(`"count.spot" is not supported for tasks with the EXTERNAL "launch_type"`)
+	errExternalWithEFS     = errors.New(`EFS volumes are not supported for tasks with the EXTERNAL "launch_type"`)
+	errInvalidRulePriority = fmt.Errorf(`"http.priority" must be between %d and %d`, minRulePriority, maxRulePriority)
+	errStepsWithoutShift   = errors.New(`"deployment.step_percent" and "deployment.step_bake_time" require a "canary" or "linear" "deployment.strategy"`)
+	errBakeTimeWithRolling = errors.New(`"deployment.bake_time" requires a "blue_green", "canary" or "linear" "deployment.strategy"`)
 )
 
 // convertSidecar converts the manifest sidecar configuration into a format parsable by the templates pkg.
@@ -51,14 +103,29 @@ func convertSidecar(s map[string]*manifest.SidecarConfig) ([]*template.SidecarOp
 	}
 	var sidecars []*template.SidecarOpts
 	for name, config := range s {
-		port, protocol, err := parsePortMapping(config.Port)
+		config, actions, err := applySidecarPreset(name, config)
 		if err != nil {
 			return nil, err
 		}
+		var port, protocol *string
+		// Presets of agents that don't listen on a port don't get the default sidecar port.
+		if config.Preset == nil || config.Port != nil {
+			port, protocol, err = parsePortMapping(config.Port)
+			if err != nil {
+				return nil, err
+			}
+		}
 		if err := validateSidecarMountPoints(config.MountPoints); err != nil {
 			return nil, err
 		}
 		mp := convertSidecarMountPoints(config.MountPoints)
+		if config.HealthCheck != nil && len(config.HealthCheck.Command) == 0 {
+			return nil, fmt.Errorf("sidecar %s: %w", name, errNoHealthCheckCommand)
+		}
+		dependsOn, err := convertDependsOn(config.DependsOn)
+		if err != nil {
+			return nil, fmt.Errorf("sidecar %s: %w", name, err)
+		}
 
 		sidecars = append(sidecars, &template.SidecarOpts{
 			Name:         aws.String(name),
@@ -71,11 +138,73 @@ func convertSidecar(s map[string]*manifest.SidecarConfig) ([]*template.SidecarOp
 			Variables:    config.Variables,
 			MountPoints:  mp,
 			DockerLabels: config.DockerLabels,
+			Permissions:  actions,
+			HealthCheck:  config.HealthCheckOpts(),
+			DependsOn:    dependsOn,
 		})
 	}
 	return sidecars, nil
 }
 
+// convertDependsOn converts the "depends_on" conditions of a container to their ECS value.
+func convertDependsOn(in map[string]string) (map[string]string, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]string, len(in))
+	for name, condition := range in {
+		ecsCondition, ok := containerDependencyConditions[strings.ToLower(condition)]
+		if !ok {
+			return nil, fmt.Errorf(`"depends_on" condition %q of container %s must be one of "start", "complete", "success" or "healthy"`, condition, name)
+		}
+		out[name] = ecsCondition
+	}
+	return out, nil
+}
+
+// convertInitContainers converts the manifest init containers into template options sorted by name,
+// so that the rendered task definition is stable across deployments.
+func convertInitContainers(in map[string]*manifest.InitContainerConfig) ([]*template.InitContainerOpts, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	names := make([]string, 0, len(in))
+	for name := range in {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var containers []*template.InitContainerOpts
+	for _, name := range names {
+		config := in[name]
+		if config == nil || aws.StringValue(config.Image) == "" {
+			return nil, fmt.Errorf("init container %s: %w", name, errNoInitContainerImage)
+		}
+		if err := validateSidecarMountPoints(config.MountPoints); err != nil {
+			return nil, fmt.Errorf("init container %s: %w", name, err)
+		}
+		entrypoint, err := config.EntryPoint.ToStringSlice()
+		if err != nil {
+			return nil, fmt.Errorf("convert entrypoint of init container %s: %w", name, err)
+		}
+		command, err := config.Command.ToStringSlice()
+		if err != nil {
+			return nil, fmt.Errorf("convert command of init container %s: %w", name, err)
+		}
+		containers = append(containers, &template.InitContainerOpts{
+			Name:        aws.String(name),
+			Image:       config.Image,
+			EntryPoint:  entrypoint,
+			Command:     command,
+			CredsParam:  config.CredsParam,
+			Variables:   config.Variables,
+			Secrets:     config.Secrets,
+			MountPoints: convertSidecarMountPoints(config.MountPoints),
+		})
+	}
+	return containers, nil
+}
+
 // Valid sidecar portMapping example: 2000/udp, or 2000 (default to be tcp).
 func parsePortMapping(s *string) (port *string, protocol *string, err error) {
 	if s == nil {
@@ -236,20 +365,110 @@ func convertExecuteCommand(e *manifest.ExecuteCommand) *template.ExecuteCommandO
 	return &template.ExecuteCommandOpts{}
 }
 
-func convertLogging(lc *manifest.Logging) *template.LogConfigOpts {
+func convertLogging(lc *manifest.Logging, taskCPU, taskMemory *int) (*template.LogConfigOpts, error) {
 	if lc == nil {
-		return nil
+		return nil, nil
 	}
-	return logConfigOpts(lc)
+	if err := validateLogging(lc, taskCPU, taskMemory); err != nil {
+		return nil, fmt.Errorf("logging: %w", err)
+	}
+	return logConfigOpts(lc), nil
 }
 
 func logConfigOpts(lc *manifest.Logging) *template.LogConfigOpts {
-	return &template.LogConfigOpts{
-		Image:          lc.LogImage(),
-		ConfigFile:     lc.ConfigFile,
-		EnableMetadata: lc.GetEnableMetadata(),
-		Destination:    lc.Destination,
-		SecretOptions:  lc.SecretOptions,
+	opts := logDestinationOpts(lc)
+	opts.Image = lc.LogImage()
+	opts.ConfigFile = lc.ConfigFile
+	opts.EnableMetadata = lc.GetEnableMetadata()
+	opts.MountPoints = convertSidecarMountPoints(lc.MountPoints)
+	opts.CPU = lc.RouterCPU
+	opts.Memory = lc.RouterMemory
+	// The options set in "destination" and "secretOptions" override the ones of the destination.
+	for name, value := range lc.Destination {
+		if opts.Destination == nil {
+			opts.Destination = make(map[string]string)
+		}
+		opts.Destination[name] = value
+		if name == opts.RegionOption {
+			opts.RegionOption = ""
+		}
+	}
+	for name, valueFrom := range lc.SecretOptions {
+		if opts.SecretOptions == nil {
+			opts.SecretOptions = make(map[string]string)
+		}
+		opts.SecretOptions[name] = valueFrom
+	}
+	return opts
+}
+
+// logDestinationOpts returns the Fluent Bit output options of the destination configured in the manifest.
+func logDestinationOpts(lc *manifest.Logging) *template.LogConfigOpts {
+	switch {
+	case lc.Firehose != nil:
+		opts := &template.LogConfigOpts{
+			Destination: map[string]string{
+				"Name":            "kinesis_firehose",
+				"delivery_stream": aws.StringValue(lc.Firehose.DeliveryStream),
+			},
+			Permissions: []string{"firehose:PutRecordBatch"},
+		}
+		if lc.Firehose.Region != nil {
+			opts.Destination["region"] = aws.StringValue(lc.Firehose.Region)
+		} else {
+			opts.RegionOption = "region"
+		}
+		return opts
+	case lc.OpenSearch != nil:
+		opts := &template.LogConfigOpts{
+			Destination: map[string]string{
+				"Name":               "opensearch",
+				"Host":               strings.TrimPrefix(aws.StringValue(lc.OpenSearch.Endpoint), "https://"),
+				"Port":               "443",
+				"tls":                "On",
+				"AWS_Auth":           "On",
+				"Suppress_Type_Name": "On",
+			},
+			Permissions: []string{"es:ESHttpPost", "es:ESHttpPut"},
+		}
+		if lc.OpenSearch.Index != nil {
+			opts.Destination["Index"] = aws.StringValue(lc.OpenSearch.Index)
+		}
+		if lc.OpenSearch.Region != nil {
+			opts.Destination["AWS_Region"] = aws.StringValue(lc.OpenSearch.Region)
+		} else {
+			opts.RegionOption = "AWS_Region"
+		}
+		return opts
+	case lc.Datadog != nil:
+		site := defaultDatadogSite
+		if lc.Datadog.Site != nil {
+			site = aws.StringValue(lc.Datadog.Site)
+		}
+		opts := &template.LogConfigOpts{
+			Destination: map[string]string{
+				"Name":     "datadog",
+				"Host":     "http-intake.logs." + site,
+				"TLS":      "on",
+				"compress": "gzip",
+				"provider": "ecs",
+			},
+			SecretOptions: map[string]string{
+				"apikey": aws.StringValue(lc.Datadog.APIKey),
+			},
+		}
+		if lc.Datadog.Service != nil {
+			opts.Destination["dd_service"] = aws.StringValue(lc.Datadog.Service)
+		}
+		if lc.Datadog.Source != nil {
+			opts.Destination["dd_source"] = aws.StringValue(lc.Datadog.Source)
+		}
+		if len(lc.Datadog.Tags) > 0 {
+			opts.Destination["dd_tags"] = strings.Join(lc.Datadog.Tags, ",")
+		}
+		return opts
+	default:
+		return &template.LogConfigOpts{}
 	}
 }
 
@@ -356,6 +575,28 @@ func convertEFSPermissions(input map[string]manifest.Volume) ([]*template.EFSPer
 	return output, nil
 }
 
+// referenceAddonsFilesystems marks the EFS volumes whose filesystem ID is the name of an output of the addons stack,
+// such as the filesystems created with "copilot storage init", so that the templates reference the output's value.
+func referenceAddonsFilesystems(storage *template.StorageOpts, addons *template.WorkloadNestedStackOpts) {
+	if storage == nil || addons == nil {
+		return
+	}
+	outputs := make(map[string]bool)
+	for _, out := range addons.VariableOutputs {
+		outputs[out] = true
+	}
+	for _, v := range storage.Volumes {
+		if v.EFS != nil && outputs[aws.StringValue(v.EFS.Filesystem)] {
+			v.EFS.FromAddons = true
+		}
+	}
+	for _, perm := range storage.EFSPerms {
+		if outputs[aws.StringValue(perm.FilesystemID)] {
+			perm.FromAddons = true
+		}
+	}
+}
+
 func convertManagedFSInfo(wlName *string, input map[string]manifest.Volume) (*template.ManagedVolumeCreationInfo, error) {
 	var output *template.ManagedVolumeCreationInfo
 	for name, volume := range input {
@@ -459,6 +700,166 @@ func convertEFSConfiguration(in manifest.EFSVolumeConfiguration) *template.EFSVo
 	}
 }
 
+// convertGPU returns the number of GPUs to reserve for the main container.
+func convertGPU(gpu *int) (int, error) {
+	if gpu == nil {
+		return 0, nil
+	}
+	if aws.IntValue(gpu) <= 0 {
+		return 0, errInvalidGPU
+	}
+	return aws.IntValue(gpu), nil
+}
+
+// convertLaunchType returns true if the tasks run on external instances registered to the cluster with ECS Anywhere.
+func convertLaunchType(launchType *string) (bool, error) {
+	if launchType == nil {
+		return false, nil
+	}
+	switch aws.StringValue(launchType) {
+	case manifest.FargateLaunchType:
+		return false, nil
+	case manifest.ExternalLaunchType:
+		return true, nil
+	}
+	return false, fmt.Errorf(`"launch_type" %q is not supported: must be one of %s`, aws.StringValue(launchType), strings.Join(manifest.LaunchTypes, ", "))
+}
+
+// validateExternalLaunchType returns an error if tasks on external instances are configured with features that only exist on AWS.
+func validateExternalLaunchType(gpu int, spot bool, storage *template.StorageOpts) error {
+	if gpu > 0 {
+		return errExternalWithGPU
+	}
+	if spot {
+		return errExternalWithSpot
+	}
+	if storage == nil {
+		return nil
+	}
+	if storage.ManagedVolumeInfo != nil {
+		return errExternalWithEFS
+	}
+	for _, v := range storage.Volumes {
+		if v.EFS != nil {
+			return errExternalWithEFS
+		}
+	}
+	return nil
+}
+
+// convertCPUArchitecture returns the CPU architecture of the tasks from the platforms the image is built for.
+// When the image is built for multiple platforms, the tasks run on the architecture of the first platform.
+func convertCPUArchitecture(platform manifest.BuildPlatform) (string, error) {
+	platforms := platform.ToStringSlice()
+	for _, p := range platforms {
+		if _, ok := platformCPUArchitecture[p]; !ok {
+			return "", fmt.Errorf(`platform %q is not supported: "platform" must be one of linux/amd64 or linux/arm64`, p)
+		}
+	}
+	if len(platforms) == 0 {
+		return "", nil
+	}
+	return platformCPUArchitecture[platforms[0]], nil
+}
+
+// validateCPUArchitecture returns an error if the CPU architecture can't run the tasks with the specified GPUs or on Fargate Spot.
+func validateCPUArchitecture(arch string, gpu int, spot bool) error {
+	if arch != template.CPUArchitectureARM {
+		return nil
+	}
+	if gpu > 0 {
+		return errARM64WithGPU
+	}
+	if spot {
+		return errARM64WithSpot
+	}
+	return nil
+}
+
+// convertRulePriority returns the priority of the service's listener rules.
+// A zero priority means that the next available priority on the listener is used.
+func convertRulePriority(priority *int) (int, error) {
+	if priority == nil {
+		return 0, nil
+	}
+	if p := aws.IntValue(priority); p < minRulePriority || p > maxRulePriority {
+		return 0, errInvalidRulePriority
+	}
+	return aws.IntValue(priority), nil
+}
+
+// convertDeploymentStrategy returns how a deployment shifts traffic to the new version of a service.
+// It returns nil for rolling updates, which don't shift traffic between target groups.
+func convertDeploymentStrategy(d manifest.DeploymentConfig) (*template.DeploymentStrategyOpts, error) {
+	name := aws.StringValue(d.Strategy)
+	if name == "" || name == manifest.RollingDeploymentStrategy {
+		if d.StepPercent != nil || d.StepBakeTime != nil {
+			return nil, errStepsWithoutShift
+		}
+		if d.BakeTime != nil {
+			return nil, errBakeTimeWithRolling
+		}
+		return nil, nil
+	}
+	strategy, ok := deploymentStrategies[name]
+	if !ok {
+		return nil, fmt.Errorf(`"deployment.strategy" %q is not supported: must be one of %s`, name, strings.Join(manifest.DeploymentStrategies, ", "))
+	}
+	opts := &template.DeploymentStrategyOpts{
+		Strategy: strategy,
+	}
+	bakeTime, err := bakeTimeInMinutes("deployment.bake_time", d.BakeTime)
+	if err != nil {
+		return nil, err
+	}
+	opts.BakeTime = bakeTime
+	if strategy == template.BlueGreenDeploymentStrategy {
+		if d.StepPercent != nil || d.StepBakeTime != nil {
+			return nil, errStepsWithoutShift
+		}
+		return opts, nil
+	}
+	if d.StepPercent != nil {
+		min := float64(minLinearStepPercent)
+		if strategy == template.CanaryDeploymentStrategy {
+			min = minCanaryPercent
+		}
+		if p := aws.Float64Value(d.StepPercent); p < min || p > maxStepPercent {
+			return nil, fmt.Errorf(`"deployment.step_percent" of a %q deployment must be between %v and %d`, name, min, maxStepPercent)
+		}
+		opts.StepPercent = d.StepPercent
+	}
+	stepBakeTime, err := bakeTimeInMinutes("deployment.step_bake_time", d.StepBakeTime)
+	if err != nil {
+		return nil, err
+	}
+	opts.StepBakeTime = stepBakeTime
+	return opts, nil
+}
+
+// bakeTimeInMinutes converts a bake time of the manifest to the whole number of minutes expected by Amazon ECS.
+func bakeTimeInMinutes(field string, d *time.Duration) (*int, error) {
+	if d == nil {
+		return nil, nil
+	}
+	if *d%time.Minute != 0 {
+		return nil, fmt.Errorf(`%q must be a whole number of minutes`, field)
+	}
+	minutes := int(*d / time.Minute)
+	if minutes < 0 || minutes > maxBakeTimeInMinutes {
+		return nil, fmt.Errorf(`%q must be between 0 and %d minutes`, field, maxBakeTimeInMinutes)
+	}
+	return &minutes, nil
+}
+
+// gpuNetworkConfig places tasks that require GPUs in private subnets.
+// These tasks run on EC2 instances, where tasks using the awsvpc network mode can't be assigned a public IP.
+func gpuNetworkConfig(opts *template.NetworkOpts) *template.NetworkOpts {
+	opts.AssignPublicIP = template.DisablePublicIP
+	opts.SubnetsType = template.PrivateSubnetsPlacement
+	return opts
+}
+
 func convertNetworkConfig(network manifest.NetworkConfig) *template.NetworkOpts {
 	opts := &template.NetworkOpts{
 		AssignPublicIP: template.EnablePublicIP,
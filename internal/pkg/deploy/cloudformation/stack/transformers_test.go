@@ -4,11 +4,13 @@
 package stack
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/stretchr/testify/require"
@@ -19,9 +21,11 @@ func Test_convertSidecar(t *testing.T) {
 	mockMap := map[string]string{"foo": "bar"}
 	mockCredsParam := aws.String("mockCredsParam")
 	testCases := map[string]struct {
-		inPort      string
-		inEssential bool
-		inLabels    map[string]string
+		inPort        string
+		inEssential   bool
+		inLabels      map[string]string
+		inHealthCheck *manifest.ContainerHealthCheck
+		inDependsOn   map[string]string
 
 		wanted    *template.SidecarOpts
 		wantedErr error
@@ -80,6 +84,45 @@ func Test_convertSidecar(t *testing.T) {
 				},
 			},
 		},
+		"healthcheck without a command": {
+			inPort:        "2000",
+			inHealthCheck: &manifest.ContainerHealthCheck{},
+
+			wantedErr: errors.New("sidecar foo: `healthcheck.command` cannot be empty"),
+		},
+		"invalid depends_on condition": {
+			inPort:      "2000",
+			inDependsOn: map[string]string{"nginx": "ready"},
+
+			wantedErr: errors.New(`sidecar foo: "depends_on" condition "ready" of container nginx must be one of "start", "complete", "success" or "healthy"`),
+		},
+		"healthcheck and depends_on": {
+			inPort:      "2000",
+			inEssential: true,
+			inHealthCheck: &manifest.ContainerHealthCheck{
+				Command: []string{"CMD-SHELL", "curl -f http://localhost:9901/ready || exit 1"},
+				Retries: aws.Int(5),
+			},
+			inDependsOn: map[string]string{"nginx": "Healthy"},
+
+			wanted: &template.SidecarOpts{
+				Name:       aws.String("foo"),
+				Port:       aws.String("2000"),
+				CredsParam: mockCredsParam,
+				Image:      mockImage,
+				Secrets:    mockMap,
+				Variables:  mockMap,
+				Essential:  aws.Bool(true),
+				HealthCheck: &ecs.HealthCheck{
+					Command:     aws.StringSlice([]string{"CMD-SHELL", "curl -f http://localhost:9901/ready || exit 1"}),
+					Interval:    aws.Int64(10),
+					Retries:     aws.Int64(5),
+					StartPeriod: aws.Int64(0),
+					Timeout:     aws.Int64(5),
+				},
+				DependsOn: map[string]string{"nginx": "HEALTHY"},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -92,6 +135,8 @@ func Test_convertSidecar(t *testing.T) {
 					Essential:    aws.Bool(tc.inEssential),
 					Port:         aws.String(tc.inPort),
 					DockerLabels: tc.inLabels,
+					HealthCheck:  tc.inHealthCheck,
+					DependsOn:    tc.inDependsOn,
 				},
 			}
 			got, err := convertSidecar(sidecar)
@@ -993,6 +1038,337 @@ func Test_convertStorageOpts(t *testing.T) {
 	}
 }
 
+func Test_referenceAddonsFilesystems(t *testing.T) {
+	testCases := map[string]struct {
+		inAddons *template.WorkloadNestedStackOpts
+
+		wantedFromAddons bool
+	}{
+		"no addons": {},
+		"filesystem is not an addons output": {
+			inAddons: &template.WorkloadNestedStackOpts{
+				VariableOutputs: []string{"MyTable"},
+			},
+		},
+		"filesystem is an addons output": {
+			inAddons: &template.WorkloadNestedStackOpts{
+				VariableOutputs: []string{"MyTable", "dataFileSystem"},
+			},
+			wantedFromAddons: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			storage := &template.StorageOpts{
+				Volumes: []*template.Volume{
+					{
+						Name: aws.String("data"),
+						EFS: &template.EFSVolumeConfiguration{
+							Filesystem:    aws.String("dataFileSystem"),
+							AccessPointID: aws.String("dataAccessPoint"),
+						},
+					},
+					{
+						Name: aws.String("scratch"),
+					},
+				},
+				EFSPerms: []*template.EFSPermission{
+					{
+						FilesystemID:  aws.String("dataFileSystem"),
+						AccessPointID: aws.String("dataAccessPoint"),
+					},
+				},
+			}
+
+			// WHEN
+			referenceAddonsFilesystems(storage, tc.inAddons)
+
+			// THEN
+			require.Equal(t, tc.wantedFromAddons, storage.Volumes[0].EFS.FromAddons)
+			require.Equal(t, tc.wantedFromAddons, storage.EFSPerms[0].FromAddons)
+		})
+	}
+}
+
+func Test_convertGPU(t *testing.T) {
+	testCases := map[string]struct {
+		in *int
+
+		wanted    int
+		wantedErr error
+	}{
+		"no gpu": {
+			wanted: 0,
+		},
+		"invalid gpu count": {
+			in:        aws.Int(0),
+			wantedErr: errInvalidGPU,
+		},
+		"success": {
+			in:     aws.Int(2),
+			wanted: 2,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertGPU(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func Test_convertLogging(t *testing.T) {
+	testCases := map[string]struct {
+		in *manifest.Logging
+
+		wanted    *template.LogConfigOpts
+		wantedErr error
+	}{
+		"no logging": {},
+		"destination options": {
+			in: &manifest.Logging{
+				Destination: map[string]string{
+					"Name":   "cloudwatch",
+					"region": "us-west-2",
+				},
+				SecretOptions: map[string]string{
+					"apikey": "API_KEY",
+				},
+				ConfigFile: aws.String("/extra.conf"),
+			},
+
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String("amazon/aws-for-fluent-bit:latest"),
+				EnableMetadata: aws.String("true"),
+				ConfigFile:     aws.String("/extra.conf"),
+				Destination: map[string]string{
+					"Name":   "cloudwatch",
+					"region": "us-west-2",
+				},
+				SecretOptions: map[string]string{
+					"apikey": "API_KEY",
+				},
+			},
+		},
+		"firehose in the region of the stack": {
+			in: &manifest.Logging{
+				Firehose: &manifest.FirehoseLogging{
+					DeliveryStream: aws.String("my-stream"),
+				},
+				RouterCPU:    aws.Int(64),
+				RouterMemory: aws.Int(128),
+			},
+
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String("amazon/aws-for-fluent-bit:latest"),
+				EnableMetadata: aws.String("true"),
+				Destination: map[string]string{
+					"Name":            "kinesis_firehose",
+					"delivery_stream": "my-stream",
+				},
+				RegionOption: "region",
+				Permissions:  []string{"firehose:PutRecordBatch"},
+				CPU:          aws.Int(64),
+				Memory:       aws.Int(128),
+			},
+		},
+		"opensearch with options overridden by the destination": {
+			in: &manifest.Logging{
+				OpenSearch: &manifest.OpenSearchLogging{
+					Endpoint: aws.String("https://search-logs.us-west-2.es.amazonaws.com"),
+					Index:    aws.String("copilot"),
+				},
+				Destination: map[string]string{
+					"AWS_Region":  "us-west-2",
+					"Retry_Limit": "5",
+				},
+			},
+
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String("amazon/aws-for-fluent-bit:latest"),
+				EnableMetadata: aws.String("true"),
+				Destination: map[string]string{
+					"Name":               "opensearch",
+					"Host":               "search-logs.us-west-2.es.amazonaws.com",
+					"Port":               "443",
+					"Index":              "copilot",
+					"tls":                "On",
+					"AWS_Auth":           "On",
+					"AWS_Region":         "us-west-2",
+					"Suppress_Type_Name": "On",
+					"Retry_Limit":        "5",
+				},
+				Permissions: []string{"es:ESHttpPost", "es:ESHttpPut"},
+			},
+		},
+		"datadog with a config file mounted from a volume": {
+			in: &manifest.Logging{
+				Datadog: &manifest.DatadogLogging{
+					APIKey:  aws.String("DD_API_KEY"),
+					Site:    aws.String("datadoghq.eu"),
+					Service: aws.String("api"),
+					Tags:    []string{"team:payments", "env:test"},
+				},
+				ConfigFile: aws.String("/fluent-bit/config/extra.conf"),
+				MountPoints: []manifest.SidecarMountPoint{
+					{
+						SourceVolume: aws.String("config"),
+						MountPointOpts: manifest.MountPointOpts{
+							ContainerPath: aws.String("/fluent-bit/config"),
+						},
+					},
+				},
+			},
+
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String("amazon/aws-for-fluent-bit:latest"),
+				EnableMetadata: aws.String("true"),
+				ConfigFile:     aws.String("/fluent-bit/config/extra.conf"),
+				Destination: map[string]string{
+					"Name":       "datadog",
+					"Host":       "http-intake.logs.datadoghq.eu",
+					"TLS":        "on",
+					"compress":   "gzip",
+					"provider":   "ecs",
+					"dd_service": "api",
+					"dd_tags":    "team:payments,env:test",
+				},
+				SecretOptions: map[string]string{
+					"apikey": "DD_API_KEY",
+				},
+				MountPoints: []*template.MountPoint{
+					{
+						SourceVolume:  aws.String("config"),
+						ContainerPath: aws.String("/fluent-bit/config"),
+						ReadOnly:      aws.Bool(true),
+					},
+				},
+			},
+		},
+		"invalid configuration": {
+			in: &manifest.Logging{
+				Firehose: &manifest.FirehoseLogging{},
+			},
+
+			wantedErr: errors.New("logging: firehose: `delivery_stream` cannot be empty"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertLogging(tc.in, aws.Int(256), aws.Int(512))
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func Test_convertLaunchType(t *testing.T) {
+	testCases := map[string]struct {
+		in *string
+
+		wanted    bool
+		wantedErr error
+	}{
+		"defaults to fargate": {
+			wanted: false,
+		},
+		"fargate": {
+			in:     aws.String("FARGATE"),
+			wanted: false,
+		},
+		"external": {
+			in:     aws.String("EXTERNAL"),
+			wanted: true,
+		},
+		"invalid launch type": {
+			in:        aws.String("EC2"),
+			wantedErr: errors.New(`"launch_type" "EC2" is not supported: must be one of FARGATE, EXTERNAL`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertLaunchType(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func Test_validateExternalLaunchType(t *testing.T) {
+	testCases := map[string]struct {
+		inGPU     int
+		inSpot    bool
+		inStorage *template.StorageOpts
+
+		wantedErr error
+	}{
+		"with gpu": {
+			inGPU:     1,
+			wantedErr: errExternalWithGPU,
+		},
+		"with spot": {
+			inSpot:    true,
+			wantedErr: errExternalWithSpot,
+		},
+		"with managed efs": {
+			inStorage: &template.StorageOpts{
+				ManagedVolumeInfo: &template.ManagedVolumeCreationInfo{
+					Name: aws.String("efs"),
+				},
+			},
+			wantedErr: errExternalWithEFS,
+		},
+		"with efs volume": {
+			inStorage: &template.StorageOpts{
+				Volumes: []*template.Volume{
+					{
+						Name: aws.String("efs"),
+						EFS:  &template.EFSVolumeConfiguration{},
+					},
+				},
+			},
+			wantedErr: errExternalWithEFS,
+		},
+		"with bind mount": {
+			inStorage: &template.StorageOpts{
+				Volumes: []*template.Volume{
+					{
+						Name: aws.String("scratch"),
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateExternalLaunchType(tc.inGPU, tc.inSpot, tc.inStorage)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_convertExecuteCommand(t *testing.T) {
 	testCases := map[string]struct {
 		inConfig manifest.ExecuteCommand
@@ -1028,6 +1404,84 @@ func Test_convertExecuteCommand(t *testing.T) {
 	}
 }
 
+func Test_convertInitContainers(t *testing.T) {
+	testCases := map[string]struct {
+		in map[string]*manifest.InitContainerConfig
+
+		wanted    []*template.InitContainerOpts
+		wantedErr error
+	}{
+		"no init containers": {},
+		"error if image is empty": {
+			in: map[string]*manifest.InitContainerConfig{
+				"migrate": {},
+			},
+			wantedErr: errors.New("init container migrate: `image` cannot be empty"),
+		},
+		"error if mount point has no source volume": {
+			in: map[string]*manifest.InitContainerConfig{
+				"migrate": {
+					Image: aws.String("flyway"),
+					MountPoints: []manifest.SidecarMountPoint{
+						{
+							MountPointOpts: manifest.MountPointOpts{
+								ContainerPath: aws.String("/data"),
+							},
+						},
+					},
+				},
+			},
+			wantedErr: errors.New("init container migrate: `source_volume` cannot be empty"),
+		},
+		"sorts init containers by name": {
+			in: map[string]*manifest.InitContainerConfig{
+				"migrate": {
+					Image: aws.String("flyway"),
+					ImageOverride: manifest.ImageOverride{
+						Command: manifest.CommandOverride{
+							String: aws.String("migrate -url=$DB_URL"),
+						},
+					},
+					Secrets: map[string]string{"DB_URL": "/db/url"},
+				},
+				"fetch-config": {
+					Image:      aws.String("aws-cli"),
+					CredsParam: aws.String("arn:creds"),
+					Variables:  map[string]string{"BUCKET": "config"},
+				},
+			},
+			wanted: []*template.InitContainerOpts{
+				{
+					Name:       aws.String("fetch-config"),
+					Image:      aws.String("aws-cli"),
+					CredsParam: aws.String("arn:creds"),
+					Variables:  map[string]string{"BUCKET": "config"},
+				},
+				{
+					Name:    aws.String("migrate"),
+					Image:   aws.String("flyway"),
+					Command: []string{"migrate", "-url=$DB_URL"},
+					Secrets: map[string]string{"DB_URL": "/db/url"},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := convertInitContainers(tc.in)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func Test_convertSidecarMountPoints(t *testing.T) {
 	testCases := map[string]struct {
 		inMountPoints  []manifest.SidecarMountPoint
@@ -1113,3 +1567,243 @@ func Test_validatePaths(t *testing.T) {
 		require.EqualError(t, validateContainerPath("/etc /bin/sh cat `i'm evil` > /dev/null"), "paths can only contain the characters a-zA-Z0-9.-_/", "invalid characters disallowed")
 	})
 }
+
+func Test_convertRulePriority(t *testing.T) {
+	testCases := map[string]struct {
+		in *int
+
+		wanted    int
+		wantedErr error
+	}{
+		"no priority": {
+			wanted: 0,
+		},
+		"priority too low": {
+			in:        aws.Int(0),
+			wantedErr: errInvalidRulePriority,
+		},
+		"priority too high": {
+			in:        aws.Int(50001),
+			wantedErr: errInvalidRulePriority,
+		},
+		"success": {
+			in:     aws.Int(10),
+			wanted: 10,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertRulePriority(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func Test_convertDeploymentStrategy(t *testing.T) {
+	duration := func(d time.Duration) *time.Duration {
+		return &d
+	}
+	minute := func(n int) *time.Duration {
+		return duration(time.Duration(n) * time.Minute)
+	}
+	testCases := map[string]struct {
+		in manifest.DeploymentConfig
+
+		wanted    *template.DeploymentStrategyOpts
+		wantedErr error
+	}{
+		"rolling update by default": {},
+		"rolling update": {
+			in: manifest.DeploymentConfig{
+				Strategy:       aws.String("rolling"),
+				RollbackAlarms: []string{"HighErrorRate"},
+			},
+		},
+		"unknown strategy": {
+			in: manifest.DeploymentConfig{
+				Strategy: aws.String("canary10percent5minutes"),
+			},
+			wantedErr: errors.New(`"deployment.strategy" "canary10percent5minutes" is not supported: must be one of rolling, blue_green, canary, linear`),
+		},
+		"steps with a rolling update": {
+			in: manifest.DeploymentConfig{
+				StepPercent: aws.Float64(10),
+			},
+			wantedErr: errStepsWithoutShift,
+		},
+		"bake time with a rolling update": {
+			in: manifest.DeploymentConfig{
+				BakeTime: minute(5),
+			},
+			wantedErr: errBakeTimeWithRolling,
+		},
+		"steps with a blue/green deployment": {
+			in: manifest.DeploymentConfig{
+				Strategy:     aws.String("blue_green"),
+				StepBakeTime: minute(5),
+			},
+			wantedErr: errStepsWithoutShift,
+		},
+		"bake time that isn't a whole number of minutes": {
+			in: manifest.DeploymentConfig{
+				Strategy: aws.String("blue_green"),
+				BakeTime: duration(90 * time.Second),
+			},
+			wantedErr: errors.New(`"deployment.bake_time" must be a whole number of minutes`),
+		},
+		"step bake time too long": {
+			in: manifest.DeploymentConfig{
+				Strategy:     aws.String("canary"),
+				StepBakeTime: minute(1441),
+			},
+			wantedErr: errors.New(`"deployment.step_bake_time" must be between 0 and 1440 minutes`),
+		},
+		"canary percentage too low": {
+			in: manifest.DeploymentConfig{
+				Strategy:    aws.String("canary"),
+				StepPercent: aws.Float64(0.05),
+			},
+			wantedErr: errors.New(`"deployment.step_percent" of a "canary" deployment must be between 0.1 and 100`),
+		},
+		"linear step percentage too low": {
+			in: manifest.DeploymentConfig{
+				Strategy:    aws.String("linear"),
+				StepPercent: aws.Float64(2),
+			},
+			wantedErr: errors.New(`"deployment.step_percent" of a "linear" deployment must be between 3 and 100`),
+		},
+		"blue/green deployment": {
+			in: manifest.DeploymentConfig{
+				Strategy: aws.String("blue_green"),
+				BakeTime: minute(0),
+			},
+			wanted: &template.DeploymentStrategyOpts{
+				Strategy: template.BlueGreenDeploymentStrategy,
+				BakeTime: aws.Int(0),
+			},
+		},
+		"canary deployment": {
+			in: manifest.DeploymentConfig{
+				Strategy:     aws.String("canary"),
+				StepPercent:  aws.Float64(10),
+				StepBakeTime: minute(5),
+				BakeTime:     minute(15),
+			},
+			wanted: &template.DeploymentStrategyOpts{
+				Strategy:     template.CanaryDeploymentStrategy,
+				StepPercent:  aws.Float64(10),
+				StepBakeTime: aws.Int(5),
+				BakeTime:     aws.Int(15),
+			},
+		},
+		"linear deployment with the default steps": {
+			in: manifest.DeploymentConfig{
+				Strategy: aws.String("linear"),
+			},
+			wanted: &template.DeploymentStrategyOpts{
+				Strategy: template.LinearDeploymentStrategy,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertDeploymentStrategy(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func Test_convertCPUArchitecture(t *testing.T) {
+	testCases := map[string]struct {
+		in manifest.BuildPlatform
+
+		wanted    string
+		wantedErr error
+	}{
+		"no platform": {
+			wanted: "",
+		},
+		"unsupported platform": {
+			in: manifest.BuildPlatform{
+				StringSlice: []string{"linux/amd64", "windows/amd64"},
+			},
+			wantedErr: errors.New(`platform "windows/amd64" is not supported: "platform" must be one of linux/amd64 or linux/arm64`),
+		},
+		"single arm64 platform": {
+			in: manifest.BuildPlatform{
+				String: aws.String("linux/arm64"),
+			},
+			wanted: "ARM64",
+		},
+		"runs on the architecture of the first platform": {
+			in: manifest.BuildPlatform{
+				StringSlice: []string{"linux/amd64", "linux/arm64"},
+			},
+			wanted: "X86_64",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertCPUArchitecture(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func Test_validateCPUArchitecture(t *testing.T) {
+	testCases := map[string]struct {
+		inArch string
+		inGPU  int
+		inSpot bool
+
+		wantedErr error
+	}{
+		"x86 with gpu and spot": {
+			inArch: "X86_64",
+			inGPU:  1,
+			inSpot: true,
+		},
+		"arm64 with gpu": {
+			inArch:    "ARM64",
+			inGPU:     1,
+			wantedErr: errARM64WithGPU,
+		},
+		"arm64 with spot": {
+			inArch:    "ARM64",
+			inSpot:    true,
+			wantedErr: errARM64WithSpot,
+		},
+		"arm64": {
+			inArch: "ARM64",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateCPUArchitecture(tc.inArch, tc.inGPU, tc.inSpot)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
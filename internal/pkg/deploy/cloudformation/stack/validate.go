@@ -4,11 +4,15 @@
 package stack
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 )
 
 // Validation errors when rendering manifest into template.
@@ -19,6 +23,13 @@ var (
 	errNoContainerPath = errors.New("`path` cannot be empty")
 	errNoSourceVolume  = errors.New("`source_volume` cannot be empty")
 	errEmptyEFSConfig  = errors.New("bad EFS configuration: `efs` cannot be empty")
+
+	errNoInitContainerImage = errors.New("`image` cannot be empty")
+	errNoHealthCheckCommand = errors.New("`healthcheck.command` cannot be empty")
+
+	errNoDeliveryStream     = errors.New("`delivery_stream` cannot be empty")
+	errNoOpenSearchEndpoint = errors.New("`endpoint` cannot be empty")
+	errNoDatadogAPIKey      = errors.New("`api_key` cannot be empty")
 )
 
 // Conditional errors.
@@ -29,6 +40,19 @@ var (
 	errInvalidUIDGIDConfig          = errors.New("must specify both UID and GID, or neither")
 	errInvalidEFSConfig             = errors.New("bad EFS configuration: cannot specify both bool and config")
 	errReservedUID                  = errors.New("UID must not be 0")
+	errMultipleLogDestinations      = errors.New("only one of `firehose`, `opensearch` and `datadog` can be specified")
+)
+
+// maxTaskDefinitionSize is the largest task definition, in bytes, that ECS accepts.
+// See https://docs.aws.amazon.com/AmazonECS/latest/developerguide/service-quotas.html
+const maxTaskDefinitionSize = 64 * 1024
+
+// Largest number of environment variables and secrets of a single container.
+// ECS doesn't cap them on their own, but containers with more entries than these thresholds almost always
+// exceed the task definition size, or get throttled by SSM and Secrets Manager while their secrets are retrieved at launch.
+const (
+	maxContainerVariables = 500
+	maxContainerSecrets   = 100
 )
 
 // Validate that paths contain only an approved set of characters to guard against command injection.
@@ -85,6 +109,113 @@ func validateMountPointConfig(in manifest.Volume) error {
 	return nil
 }
 
+// validateContainerNames returns an error if an init container has the same name as the main container or a sidecar.
+func validateContainerNames(wlName string, sidecars []*template.SidecarOpts, initContainers []*template.InitContainerOpts) error {
+	names := map[string]bool{
+		wlName: true,
+	}
+	for _, sidecar := range sidecars {
+		names[aws.StringValue(sidecar.Name)] = true
+	}
+	for _, container := range initContainers {
+		if names[aws.StringValue(container.Name)] {
+			return fmt.Errorf("init container %s has the same name as another container in the task", aws.StringValue(container.Name))
+		}
+	}
+	return nil
+}
+
+// validateContainerDependencies returns an error if the "depends_on" conditions of the main container
+// and the sidecars can't be met by the containers of the task.
+func validateContainerDependencies(wlName string, dependsOn map[string]string, healthCheck *ecs.HealthCheck, sidecars []*template.SidecarOpts) error {
+	type container struct {
+		dependsOn      map[string]string
+		essential      bool
+		hasHealthCheck bool
+	}
+	containers := map[string]container{
+		wlName: {
+			dependsOn:      dependsOn,
+			essential:      true,
+			hasHealthCheck: healthCheck != nil,
+		},
+	}
+	for _, sidecar := range sidecars {
+		containers[aws.StringValue(sidecar.Name)] = container{
+			dependsOn:      sidecar.DependsOn,
+			essential:      sidecar.Essential == nil || aws.BoolValue(sidecar.Essential), // Containers are essential by default.
+			hasHealthCheck: sidecar.HealthCheck != nil,
+		}
+	}
+
+	names := make([]string, 0, len(containers))
+	for name := range containers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, dep := range sortedKeys(containers[name].dependsOn) {
+			target, ok := containers[dep]
+			if !ok {
+				return fmt.Errorf("container %s depends on %s, which is neither the main container nor a sidecar", name, dep)
+			}
+			if dep == name {
+				return fmt.Errorf("container %s cannot depend on itself", name)
+			}
+			switch containers[name].dependsOn[dep] {
+			case ecs.ContainerConditionHealthy:
+				if !target.hasHealthCheck {
+					return fmt.Errorf("container %s depends on %s being healthy, but %s has no `healthcheck`", name, dep, dep)
+				}
+			case ecs.ContainerConditionComplete, ecs.ContainerConditionSuccess:
+				if target.essential {
+					return fmt.Errorf("container %s depends on %s exiting, but %s must have `essential: false` to exit without stopping the task", name, dep, dep)
+				}
+			}
+		}
+	}
+
+	// Detect cycles with a depth-first search, since ECS can't start containers that depend on each other.
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("containers cannot depend on each other in a cycle: %s is part of one", name)
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		for _, dep := range sortedKeys(containers[name].dependsOn) {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func validateSidecarMountPoints(in []manifest.SidecarMountPoint) error {
 	if in == nil {
 		return nil
@@ -100,6 +231,52 @@ func validateSidecarMountPoints(in []manifest.SidecarMountPoint) error {
 	return nil
 }
 
+func validateLogging(lc *manifest.Logging, taskCPU, taskMemory *int) error {
+	destinations := 0
+	if lc.Firehose != nil {
+		destinations++
+		if aws.StringValue(lc.Firehose.DeliveryStream) == "" {
+			return fmt.Errorf("firehose: %w", errNoDeliveryStream)
+		}
+	}
+	if lc.OpenSearch != nil {
+		destinations++
+		if aws.StringValue(lc.OpenSearch.Endpoint) == "" {
+			return fmt.Errorf("opensearch: %w", errNoOpenSearchEndpoint)
+		}
+	}
+	if lc.Datadog != nil {
+		destinations++
+		if aws.StringValue(lc.Datadog.APIKey) == "" {
+			return fmt.Errorf("datadog: %w", errNoDatadogAPIKey)
+		}
+	}
+	if destinations > 1 {
+		return errMultipleLogDestinations
+	}
+	if err := validateSidecarMountPoints(lc.MountPoints); err != nil {
+		return err
+	}
+	// The log router can't take all the resources of the task, or the main container couldn't run.
+	if err := validateLogRouterResource("cpu", lc.RouterCPU, taskCPU); err != nil {
+		return err
+	}
+	return validateLogRouterResource("memory", lc.RouterMemory, taskMemory)
+}
+
+func validateLogRouterResource(field string, value, taskValue *int) error {
+	if value == nil {
+		return nil
+	}
+	if aws.IntValue(value) <= 0 {
+		return fmt.Errorf("`%s` must be greater than 0", field)
+	}
+	if taskValue != nil && aws.IntValue(value) >= aws.IntValue(taskValue) {
+		return fmt.Errorf("`%s` %d must be less than the %s of the task %d", field, aws.IntValue(value), field, aws.IntValue(taskValue))
+	}
+	return nil
+}
+
 func validateEFSConfig(in manifest.Volume) error {
 	// EFS is implicitly disabled. We don't use the attached EmptyVolume function here
 	// because it may hide invalid config.
@@ -189,3 +366,105 @@ func validateRootDirPath(input string) error {
 func validateContainerPath(input string) error {
 	return validatePath(input, maxDockerContainerPathLength)
 }
+
+func validateFeatures(features []string) error {
+	for _, feature := range features {
+		supported := false
+		for _, wkldFeature := range template.WorkloadFeatures {
+			if feature == wkldFeature {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return fmt.Errorf("feature %s is not supported, must be one of %#v", feature, template.WorkloadFeatures)
+		}
+	}
+	return nil
+}
+
+// containerDefinition holds the fields of an ECS container definition that grow with the manifest.
+type containerDefinition struct {
+	Name         string            `json:"name"`
+	Environment  []keyValuePair    `json:"environment,omitempty"`
+	Secrets      []secret          `json:"secrets,omitempty"`
+	DockerLabels map[string]string `json:"dockerLabels,omitempty"`
+	EntryPoint   []string          `json:"entryPoint,omitempty"`
+	Command      []string          `json:"command,omitempty"`
+}
+
+type keyValuePair struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type secret struct {
+	Name      string `json:"name"`
+	ValueFrom string `json:"valueFrom"`
+}
+
+func newContainerDefinition(name string, variables, secrets, labels map[string]string) containerDefinition {
+	def := containerDefinition{
+		Name:         name,
+		DockerLabels: labels,
+	}
+	for k, v := range variables {
+		def.Environment = append(def.Environment, keyValuePair{Name: k, Value: v})
+	}
+	for k, v := range secrets {
+		def.Secrets = append(def.Secrets, secret{Name: k, ValueFrom: v})
+	}
+	return def
+}
+
+// validateTaskDefinition returns an error if a container of the workload has too many environment variables or secrets,
+// or if the container definitions are estimated to exceed the maximum task definition size accepted by ECS.
+func validateTaskDefinition(name string, opts template.WorkloadOpts) error {
+	main := newContainerDefinition(name, opts.Variables, opts.Secrets, opts.DockerLabels)
+	main.EntryPoint = opts.EntryPoint
+	main.Command = opts.Command
+	defs := []containerDefinition{main}
+	for _, sidecar := range opts.Sidecars {
+		defs = append(defs, newContainerDefinition(aws.StringValue(sidecar.Name), sidecar.Variables, sidecar.Secrets, sidecar.DockerLabels))
+	}
+	for _, container := range opts.InitContainers {
+		def := newContainerDefinition(aws.StringValue(container.Name), container.Variables, container.Secrets, nil)
+		def.EntryPoint = container.EntryPoint
+		def.Command = container.Command
+		defs = append(defs, def)
+	}
+
+	for _, def := range defs {
+		if len(def.Environment) > maxContainerVariables {
+			return fmt.Errorf(`container %s has %d environment variables which exceeds the limit of %d: `+
+				`consider grouping related settings into a single JSON variable or a configuration file loaded by the container`,
+				def.Name, len(def.Environment), maxContainerVariables)
+		}
+		if len(def.Secrets) > maxContainerSecrets {
+			return fmt.Errorf(`container %s has %d secrets which exceeds the limit of %d: `+
+				`consider storing related values as a single JSON secret in Secrets Manager and referencing its keys with "secretsmanager:<name>:<key>::"`,
+				def.Name, len(def.Secrets), maxContainerSecrets)
+		}
+	}
+
+	var total int
+	var largest containerDefinition
+	var largestSize int
+	for _, def := range defs {
+		data, err := json.Marshal(def)
+		if err != nil {
+			return fmt.Errorf("marshal container definition %s: %w", def.Name, err)
+		}
+		total += len(data)
+		if len(data) > largestSize {
+			largest, largestSize = def, len(data)
+		}
+	}
+	if total <= maxTaskDefinitionSize {
+		return nil
+	}
+	return fmt.Errorf(`container definitions are approximately %d bytes which exceeds the ECS task definition limit of %d bytes: `+
+		`container %s has %d environment variables and %d secrets totaling %d bytes, `+
+		`consider storing large values in SSM Parameter Store and referencing them under "secrets"`,
+		total, maxTaskDefinitionSize, largest.Name, len(largest.Environment), len(largest.Secrets), largestSize)
+}
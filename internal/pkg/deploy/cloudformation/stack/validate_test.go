@@ -4,10 +4,15 @@
 package stack
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/stretchr/testify/require"
 )
 
@@ -152,3 +157,323 @@ func Test_validateEFSConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateFeatures(t *testing.T) {
+	testCases := map[string]struct {
+		in []string
+
+		wantedErr string
+	}{
+		"no features": {},
+		"supported features": {
+			in: []string{"ecs-managed-tags"},
+		},
+		"unsupported feature": {
+			in: []string{"ecs-managed-tags", "teleportation"},
+
+			wantedErr: `feature teleportation is not supported, must be one of []string{"ecs-managed-tags"}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateFeatures(tc.in)
+			if tc.wantedErr == "" {
+				require.NoError(t, gotErr)
+			} else {
+				require.EqualError(t, gotErr, tc.wantedErr)
+			}
+		})
+	}
+}
+
+func Test_validateLogging(t *testing.T) {
+	testCases := map[string]struct {
+		in *manifest.Logging
+
+		wantedErr string
+	}{
+		"no destination": {
+			in: &manifest.Logging{},
+		},
+		"firehose without a delivery stream": {
+			in: &manifest.Logging{
+				Firehose: &manifest.FirehoseLogging{},
+			},
+
+			wantedErr: "firehose: `delivery_stream` cannot be empty",
+		},
+		"opensearch without an endpoint": {
+			in: &manifest.Logging{
+				OpenSearch: &manifest.OpenSearchLogging{Index: aws.String("logs")},
+			},
+
+			wantedErr: "opensearch: `endpoint` cannot be empty",
+		},
+		"datadog without an api key": {
+			in: &manifest.Logging{
+				Datadog: &manifest.DatadogLogging{Service: aws.String("api")},
+			},
+
+			wantedErr: "datadog: `api_key` cannot be empty",
+		},
+		"multiple destinations": {
+			in: &manifest.Logging{
+				Firehose: &manifest.FirehoseLogging{DeliveryStream: aws.String("stream")},
+				Datadog:  &manifest.DatadogLogging{APIKey: aws.String("DD_API_KEY")},
+			},
+
+			wantedErr: "only one of `firehose`, `opensearch` and `datadog` can be specified",
+		},
+		"mount point without a source volume": {
+			in: &manifest.Logging{
+				MountPoints: []manifest.SidecarMountPoint{
+					{MountPointOpts: manifest.MountPointOpts{ContainerPath: aws.String("/fluent-bit/config")}},
+				},
+			},
+
+			wantedErr: "`source_volume` cannot be empty",
+		},
+		"cpu is not positive": {
+			in: &manifest.Logging{
+				RouterCPU: aws.Int(0),
+			},
+
+			wantedErr: "`cpu` must be greater than 0",
+		},
+		"memory takes all the memory of the task": {
+			in: &manifest.Logging{
+				RouterCPU:    aws.Int(64),
+				RouterMemory: aws.Int(512),
+			},
+
+			wantedErr: "`memory` 512 must be less than the memory of the task 512",
+		},
+		"valid configuration": {
+			in: &manifest.Logging{
+				OpenSearch: &manifest.OpenSearchLogging{Endpoint: aws.String("search-logs.us-west-2.es.amazonaws.com")},
+				MountPoints: []manifest.SidecarMountPoint{
+					{
+						SourceVolume: aws.String("config"),
+						MountPointOpts: manifest.MountPointOpts{
+							ContainerPath: aws.String("/fluent-bit/config"),
+						},
+					},
+				},
+				RouterCPU:    aws.Int(64),
+				RouterMemory: aws.Int(128),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateLogging(tc.in, aws.Int(256), aws.Int(512))
+			if tc.wantedErr == "" {
+				require.NoError(t, gotErr)
+			} else {
+				require.EqualError(t, gotErr, tc.wantedErr)
+			}
+		})
+	}
+}
+
+func Test_validateContainerNames(t *testing.T) {
+	testCases := map[string]struct {
+		inSidecars       []*template.SidecarOpts
+		inInitContainers []*template.InitContainerOpts
+
+		wantedErr error
+	}{
+		"unique names": {
+			inSidecars:       []*template.SidecarOpts{{Name: aws.String("nginx")}},
+			inInitContainers: []*template.InitContainerOpts{{Name: aws.String("migrate")}},
+		},
+		"init container named after the main container": {
+			inInitContainers: []*template.InitContainerOpts{{Name: aws.String("api")}},
+			wantedErr:        errors.New("init container api has the same name as another container in the task"),
+		},
+		"init container named after a sidecar": {
+			inSidecars:       []*template.SidecarOpts{{Name: aws.String("nginx")}},
+			inInitContainers: []*template.InitContainerOpts{{Name: aws.String("nginx")}},
+			wantedErr:        errors.New("init container nginx has the same name as another container in the task"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			err := validateContainerNames("api", tc.inSidecars, tc.inInitContainers)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validateContainerDependencies(t *testing.T) {
+	testCases := map[string]struct {
+		inDependsOn   map[string]string
+		inHealthCheck *ecs.HealthCheck
+		inSidecars    []*template.SidecarOpts
+
+		wantedErr error
+	}{
+		"main container waits for a healthy sidecar": {
+			inDependsOn: map[string]string{"envoy": "HEALTHY"},
+			inSidecars: []*template.SidecarOpts{
+				{
+					Name:        aws.String("envoy"),
+					HealthCheck: &ecs.HealthCheck{},
+				},
+			},
+		},
+		"sidecar waits for the main container and a non-essential sidecar": {
+			inHealthCheck: &ecs.HealthCheck{},
+			inSidecars: []*template.SidecarOpts{
+				{
+					Name: aws.String("nginx"),
+					DependsOn: map[string]string{
+						"api":     "HEALTHY",
+						"migrate": "SUCCESS",
+					},
+				},
+				{
+					Name:      aws.String("migrate"),
+					Essential: aws.Bool(false),
+				},
+			},
+		},
+		"depends on an unknown container": {
+			inDependsOn: map[string]string{"envoy": "START"},
+			wantedErr:   errors.New("container api depends on envoy, which is neither the main container nor a sidecar"),
+		},
+		"depends on itself": {
+			inDependsOn: map[string]string{"api": "START"},
+			wantedErr:   errors.New("container api cannot depend on itself"),
+		},
+		"depends on a sidecar without a healthcheck being healthy": {
+			inDependsOn: map[string]string{"envoy": "HEALTHY"},
+			inSidecars:  []*template.SidecarOpts{{Name: aws.String("envoy")}},
+			wantedErr:   errors.New("container api depends on envoy being healthy, but envoy has no `healthcheck`"),
+		},
+		"depends on an essential sidecar exiting": {
+			inDependsOn: map[string]string{"migrate": "COMPLETE"},
+			inSidecars:  []*template.SidecarOpts{{Name: aws.String("migrate")}},
+			wantedErr:   errors.New("container api depends on migrate exiting, but migrate must have `essential: false` to exit without stopping the task"),
+		},
+		"containers depend on each other": {
+			inDependsOn: map[string]string{"envoy": "START"},
+			inSidecars: []*template.SidecarOpts{
+				{
+					Name:      aws.String("envoy"),
+					DependsOn: map[string]string{"api": "START"},
+				},
+			},
+			wantedErr: errors.New("containers cannot depend on each other in a cycle: api is part of one"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			err := validateContainerDependencies("api", tc.inDependsOn, tc.inHealthCheck, tc.inSidecars)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validateTaskDefinition(t *testing.T) {
+	largeVariables := make(map[string]string)
+	for i := 0; i < 70; i++ {
+		largeVariables[fmt.Sprintf("VAR_%d", i)] = strings.Repeat("a", 1000)
+	}
+	manyVariables := make(map[string]string)
+	for i := 0; i < 501; i++ {
+		manyVariables[fmt.Sprintf("VAR_%d", i)] = "a"
+	}
+	manySecrets := make(map[string]string)
+	for i := 0; i < 101; i++ {
+		manySecrets[fmt.Sprintf("SECRET_%d", i)] = fmt.Sprintf("/copilot/secret-%d", i)
+	}
+	testCases := map[string]struct {
+		in template.WorkloadOpts
+
+		wantedErr string
+	}{
+		"small task definition": {
+			in: template.WorkloadOpts{
+				Variables: map[string]string{"LOG_LEVEL": "info"},
+				Secrets:   map[string]string{"GITHUB_TOKEN": "GH_TOKEN"},
+				Sidecars: []*template.SidecarOpts{
+					{
+						Name:      aws.String("nginx"),
+						Variables: map[string]string{"PORT": "80"},
+					},
+				},
+			},
+		},
+		"main container exceeds the limit": {
+			in: template.WorkloadOpts{
+				Variables: largeVariables,
+				Secrets:   map[string]string{"GITHUB_TOKEN": "GH_TOKEN"},
+			},
+
+			wantedErr: `container definitions are approximately 72114 bytes which exceeds the ECS task definition limit of 65536 bytes: ` +
+				`container frontend has 70 environment variables and 1 secrets totaling 72114 bytes, ` +
+				`consider storing large values in SSM Parameter Store and referencing them under "secrets"`,
+		},
+		"main container has too many environment variables": {
+			in: template.WorkloadOpts{
+				Variables: manyVariables,
+			},
+
+			wantedErr: `container frontend has 501 environment variables which exceeds the limit of 500: ` +
+				`consider grouping related settings into a single JSON variable or a configuration file loaded by the container`,
+		},
+		"sidecar has too many secrets": {
+			in: template.WorkloadOpts{
+				Sidecars: []*template.SidecarOpts{
+					{
+						Name:    aws.String("nginx"),
+						Secrets: manySecrets,
+					},
+				},
+			},
+
+			wantedErr: `container nginx has 101 secrets which exceeds the limit of 100: ` +
+				`consider storing related values as a single JSON secret in Secrets Manager and referencing its keys with "secretsmanager:<name>:<key>::"`,
+		},
+		"sidecars contribute to the limit": {
+			in: template.WorkloadOpts{
+				Variables: map[string]string{"LOG_LEVEL": "info"},
+				Sidecars: []*template.SidecarOpts{
+					{
+						Name:      aws.String("nginx"),
+						Variables: largeVariables,
+					},
+				},
+			},
+
+			wantedErr: `container definitions are approximately 72123 bytes which exceeds the ECS task definition limit of 65536 bytes: ` +
+				`container nginx has 70 environment variables and 0 secrets totaling 72052 bytes, ` +
+				`consider storing large values in SSM Parameter Store and referencing them under "secrets"`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateTaskDefinition("frontend", tc.in)
+			if tc.wantedErr == "" {
+				require.NoError(t, gotErr)
+			} else {
+				require.EqualError(t, gotErr, tc.wantedErr)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/dotenv"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
@@ -52,6 +53,13 @@ type RuntimeConfig struct {
 	Image             *ECRImage         // Optional. Image location in an ECR repository.
 	AddonsTemplateURL string            // Optional. S3 object URL for the addons template.
 	AdditionalTags    map[string]string // AdditionalTags are labels applied to resources in the workload stack.
+	LogGroupPrefix    string            // Optional. Prefix of the workload's log group name, defaults to "/copilot".
+	PullThroughCache  string            // Optional. Repository prefix of the ECR pull-through cache that the image is pulled through.
+	ExecutionRoleARN  string            // Optional. Execution role shared with other workloads. If empty, the stack creates its own role.
+	DesiredCount      *int              // Optional. Overrides the number of tasks of the manifest, such as during a forced deployment.
+
+	DisableHTTPRedirect    bool // Optional. True if HTTP requests are forwarded to the service instead of redirected to HTTPS.
+	HTTPRedirectStatusCode int  // Optional. Status code of the redirect from HTTP to HTTPS, defaults to 301.
 }
 
 // ECRImage represents configuration about the pushed ECR image that is needed to
@@ -78,6 +86,11 @@ func (i ECRImage) GetLocation() string {
 
 type templater interface {
 	Template() (string, error)
+	Parameters(envName string) (map[string]string, error)
+}
+
+type envFileReader interface {
+	ReadFile(path string) ([]byte, error)
 }
 
 type location interface {
@@ -94,8 +107,9 @@ type wkld struct {
 	rc    RuntimeConfig
 	image location
 
-	parser template.Parser
-	addons templater
+	parser  template.Parser
+	addons  templater
+	envFile envFileReader
 }
 
 // StackName returns the name of the stack.
@@ -109,6 +123,9 @@ func (w *wkld) Parameters() ([]*cloudformation.Parameter, error) {
 	if err != nil {
 		return nil, err
 	}
+	if w.rc.DesiredCount != nil {
+		desiredCount = w.rc.DesiredCount
+	}
 
 	var img string
 	if w.image != nil {
@@ -157,6 +174,31 @@ func (w *wkld) Parameters() ([]*cloudformation.Parameter, error) {
 	}, nil
 }
 
+// variables returns the environment variables of the main container.
+// Variables defined in the manifest take precedence over the ones in the env_file.
+func (w *wkld) variables() (map[string]string, error) {
+	if w.tc.EnvFile == nil {
+		return w.tc.Variables, nil
+	}
+	path := aws.StringValue(w.tc.EnvFile)
+	content, err := w.envFile.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read env_file %s: %w", path, err)
+	}
+	fileVars, err := dotenv.Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("parse env_file %s: %w", path, err)
+	}
+	vars := make(map[string]string, len(fileVars)+len(w.tc.Variables))
+	for _, v := range fileVars {
+		vars[v.Key] = v.Value
+	}
+	for k, v := range w.tc.Variables {
+		vars[k] = v
+	}
+	return vars, nil
+}
+
 // Tags returns the list of tags to apply to the CloudFormation stack.
 func (w *wkld) Tags() []*cloudformation.Tag {
 	return mergeAndFlattenTags(w.rc.AdditionalTags, map[string]string{
@@ -205,8 +247,13 @@ func (w *wkld) addonsOutputs() (*template.WorkloadNestedStackOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get addons outputs for %s: %w", w.name, err)
 	}
+	params, err := w.addons.Parameters(w.env)
+	if err != nil {
+		return nil, fmt.Errorf("get addons parameters for %s in environment %s: %w", w.name, w.env, err)
+	}
 	return &template.WorkloadNestedStackOpts{
 		StackName:            addon.StackName,
+		Parameters:           params,
 		VariableOutputs:      envVarOutputNames(out),
 		SecretOutputs:        secretOutputNames(out),
 		PolicyOutputs:        managedPolicyOutputNames(out),
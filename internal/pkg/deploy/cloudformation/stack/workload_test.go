@@ -4,11 +4,23 @@
 package stack
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/stretchr/testify/require"
 )
 
+type mockEnvFileReader struct {
+	content []byte
+	err     error
+}
+
+func (m mockEnvFileReader) ReadFile(path string) ([]byte, error) {
+	return m.content, m.err
+}
+
 func TestECRImage_GetLocation(t *testing.T) {
 	testCases := map[string]struct {
 		in ECRImage
@@ -44,3 +56,66 @@ func TestECRImage_GetLocation(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkload_variables(t *testing.T) {
+	testCases := map[string]struct {
+		inTaskConfig manifest.TaskConfig
+		inEnvFile    envFileReader
+
+		wanted      map[string]string
+		wantedError string
+	}{
+		"returns the manifest variables without an env_file": {
+			inTaskConfig: manifest.TaskConfig{
+				Variables: map[string]string{"LOG_LEVEL": "info"},
+			},
+			wanted: map[string]string{"LOG_LEVEL": "info"},
+		},
+		"returns an error if the env_file cannot be read": {
+			inTaskConfig: manifest.TaskConfig{
+				EnvFile: aws.String("configs/dev.env"),
+			},
+			inEnvFile:   mockEnvFileReader{err: errors.New("some error")},
+			wantedError: "read env_file configs/dev.env: some error",
+		},
+		"returns an error if the env_file is malformed": {
+			inTaskConfig: manifest.TaskConfig{
+				EnvFile: aws.String("configs/dev.env"),
+			},
+			inEnvFile:   mockEnvFileReader{content: []byte("LOG_LEVEL")},
+			wantedError: `parse env_file configs/dev.env: line 1: "LOG_LEVEL" must be of the form "KEY=VALUE"`,
+		},
+		"manifest variables take precedence over the env_file": {
+			inTaskConfig: manifest.TaskConfig{
+				Variables: map[string]string{"LOG_LEVEL": "debug"},
+				EnvFile:   aws.String("configs/dev.env"),
+			},
+			inEnvFile: mockEnvFileReader{content: []byte("LOG_LEVEL=info\nDB_NAME=orders")},
+			wanted: map[string]string{
+				"LOG_LEVEL": "debug",
+				"DB_NAME":   "orders",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			w := &wkld{
+				tc:      tc.inTaskConfig,
+				envFile: tc.inEnvFile,
+			}
+
+			// WHEN
+			got, err := w.variables()
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
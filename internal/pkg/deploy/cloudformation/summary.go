@@ -0,0 +1,116 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	summaryMinCellWidth = 20
+	summaryCellPadding  = 2
+)
+
+// resourceSummary is the final status of a resource in a stack operation and how long it took to get there.
+type resourceSummary struct {
+	logicalID string
+	status    cloudformation.StackStatus
+	duration  time.Duration
+}
+
+// deploySummary is the outcome of the latest operation on a stack.
+type deploySummary struct {
+	stackName string
+	total     time.Duration
+	resources []resourceSummary // Sorted from the slowest to the fastest resource.
+}
+
+// newDeploySummary summarizes the latest create or update of the stack from its events in chronological order.
+// Returns nil if the stack was never created or updated.
+func newDeploySummary(stackName string, events []cloudformation.StackEvent) *deploySummary {
+	start := -1
+	for i, event := range events {
+		status := cloudformation.StackStatus(aws.StringValue(event.ResourceStatus))
+		if aws.StringValue(event.LogicalResourceId) == stackName && status.UpsertInProgress() {
+			start = i
+		}
+	}
+	if start == -1 {
+		return nil
+	}
+
+	startTime := aws.TimeValue(events[start].Timestamp)
+	endTime := startTime
+	var logicalIDs []string
+	startTimes := make(map[string]time.Time)
+	resources := make(map[string]resourceSummary)
+	for _, event := range events[start+1:] {
+		logicalID, timestamp := aws.StringValue(event.LogicalResourceId), aws.TimeValue(event.Timestamp)
+		status := cloudformation.StackStatus(aws.StringValue(event.ResourceStatus))
+		endTime = timestamp
+		if logicalID == stackName {
+			continue
+		}
+		resourceStart, started := startTimes[logicalID]
+		if !started {
+			resourceStart = timestamp
+			startTimes[logicalID] = timestamp
+			logicalIDs = append(logicalIDs, logicalID)
+		}
+		if status.InProgress() {
+			continue
+		}
+		resources[logicalID] = resourceSummary{
+			logicalID: logicalID,
+			status:    status,
+			duration:  timestamp.Sub(resourceStart),
+		}
+	}
+
+	summary := &deploySummary{
+		stackName: stackName,
+		total:     endTime.Sub(startTime),
+	}
+	for _, logicalID := range logicalIDs {
+		resource, ok := resources[logicalID]
+		if !ok {
+			// The resource never reached a final status.
+			continue
+		}
+		summary.resources = append(summary.resources, resource)
+	}
+	sort.SliceStable(summary.resources, func(i, j int) bool {
+		return summary.resources[i].duration > summary.resources[j].duration
+	})
+	return summary
+}
+
+// write prints the total time of the stack operation, followed by a table of the resources with their status and duration.
+func (s *deploySummary) write(w io.Writer) error {
+	if _, err := fmt.Fprint(w, color.Bold.Sprintf("\nDeployment summary for stack %s (total %s)\n\n", s.stackName, formatSummaryDuration(s.total))); err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, summaryMinCellWidth, 0, summaryCellPadding, ' ', 0)
+	headers := []string{"Resource", "Status", "Duration"}
+	fmt.Fprintf(tw, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(tw, "  %s\n", strings.Join([]string{"--------", "------", "--------"}, "\t"))
+	for _, resource := range s.resources {
+		status := strings.ToLower(strings.ReplaceAll(string(resource.status), "_", " "))
+		fmt.Fprintf(tw, "  %s\t%s\t%s\n", resource.logicalID, status, formatSummaryDuration(resource.duration))
+	}
+	return tw.Flush()
+}
+
+func formatSummaryDuration(d time.Duration) string {
+	return d.Round(100 * time.Millisecond).String()
+}
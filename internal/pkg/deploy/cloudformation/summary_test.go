@@ -0,0 +1,137 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDeploySummary(t *testing.T) {
+	deployTime := time.Date(2021, time.March, 1, 10, 0, 0, 0, time.UTC)
+	event := func(logicalID, status string, offset time.Duration) cloudformation.StackEvent {
+		return cloudformation.StackEvent{
+			LogicalResourceId: aws.String(logicalID),
+			ResourceStatus:    aws.String(status),
+			Timestamp:         aws.Time(deployTime.Add(offset)),
+		}
+	}
+	testCases := map[string]struct {
+		events []cloudformation.StackEvent
+
+		wanted *deploySummary
+	}{
+		"returns nil if the stack was never created or updated": {
+			events: []cloudformation.StackEvent{
+				event("Service", "CREATE_IN_PROGRESS", 0),
+			},
+		},
+		"summarizes only the latest operation on the stack sorted by duration": {
+			events: []cloudformation.StackEvent{
+				event("phonetool-test-api", "CREATE_IN_PROGRESS", 0),
+				event("Service", "CREATE_IN_PROGRESS", time.Second),
+				event("Service", "CREATE_COMPLETE", 2*time.Minute),
+				event("phonetool-test-api", "CREATE_COMPLETE", 3*time.Minute),
+				event("phonetool-test-api", "UPDATE_IN_PROGRESS", time.Hour),
+				event("TaskDefinition", "UPDATE_IN_PROGRESS", time.Hour+time.Second),
+				event("TaskDefinition", "UPDATE_COMPLETE", time.Hour+3*time.Second),
+				event("Service", "UPDATE_IN_PROGRESS", time.Hour+4*time.Second),
+				event("Service", "UPDATE_COMPLETE", time.Hour+4*time.Minute),
+				event("phonetool-test-api", "UPDATE_COMPLETE_CLEANUP_IN_PROGRESS", time.Hour+5*time.Minute),
+				event("phonetool-test-api", "UPDATE_COMPLETE", time.Hour+6*time.Minute),
+			},
+
+			wanted: &deploySummary{
+				stackName: "phonetool-test-api",
+				total:     6 * time.Minute,
+				resources: []resourceSummary{
+					{
+						logicalID: "Service",
+						status:    "UPDATE_COMPLETE",
+						duration:  4*time.Minute - 4*time.Second,
+					},
+					{
+						logicalID: "TaskDefinition",
+						status:    "UPDATE_COMPLETE",
+						duration:  2 * time.Second,
+					},
+				},
+			},
+		},
+		"keeps the latest final status of resources that were rolled back": {
+			events: []cloudformation.StackEvent{
+				event("phonetool-test-api", "UPDATE_IN_PROGRESS", 0),
+				event("Service", "UPDATE_IN_PROGRESS", time.Second),
+				event("Service", "UPDATE_FAILED", time.Minute),
+				event("phonetool-test-api", "UPDATE_ROLLBACK_IN_PROGRESS", time.Minute),
+				event("Service", "UPDATE_IN_PROGRESS", 2*time.Minute),
+				event("Service", "UPDATE_COMPLETE", 3*time.Minute),
+				event("Queue", "DELETE_IN_PROGRESS", 3*time.Minute),
+				event("phonetool-test-api", "UPDATE_ROLLBACK_COMPLETE", 4*time.Minute),
+			},
+
+			wanted: &deploySummary{
+				stackName: "phonetool-test-api",
+				total:     4 * time.Minute,
+				resources: []resourceSummary{
+					{
+						logicalID: "Service",
+						status:    "UPDATE_COMPLETE",
+						duration:  3*time.Minute - time.Second,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			summary := newDeploySummary("phonetool-test-api", tc.events)
+
+			// THEN
+			require.Equal(t, tc.wanted, summary)
+		})
+	}
+}
+
+func TestDeploySummary_Write(t *testing.T) {
+	// GIVEN
+	summary := &deploySummary{
+		stackName: "phonetool-test-api",
+		total:     6*time.Minute + 30*time.Second,
+		resources: []resourceSummary{
+			{
+				logicalID: "Service",
+				status:    "UPDATE_COMPLETE",
+				duration:  4*time.Minute + 1234*time.Millisecond,
+			},
+			{
+				logicalID: "TaskDefinition",
+				status:    "UPDATE_COMPLETE",
+				duration:  2 * time.Second,
+			},
+		},
+	}
+	buf := new(strings.Builder)
+
+	// WHEN
+	err := summary.write(buf)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `
+Deployment summary for stack phonetool-test-api (total 6m30s)
+
+  Resource          Status              Duration
+  --------          ------              --------
+  Service           update complete     4m1.2s
+  TaskDefinition    update complete     2s
+`, buf.String())
+}
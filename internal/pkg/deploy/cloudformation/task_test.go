@@ -42,6 +42,9 @@ func TestCloudFormation_DeployTask(t *testing.T) {
 	t.Run("returns an error when stack template body cannot be retrieved to parse resource descriptions", func(t *testing.T) {
 		testDeployWorkload_OnTemplateBodyFailure(t, when)
 	})
+	t.Run("renders the changes without estimates when past stack events cannot be retrieved", func(t *testing.T) {
+		testDeployWorkload_RendersOnPastEventsFailure(t, when)
+	})
 	t.Run("returns a wrapped error if a streamer fails and cancels the renderer", func(t *testing.T) {
 		testDeployWorkload_StackStreamerFailureShouldCancelRenderer(t, when)
 	})
@@ -4,25 +4,166 @@
 package cloudformation
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
 )
 
+// maxTemplateBodySize is the largest template, in bytes, that CloudFormation accepts without uploading it to S3.
+// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/cloudformation-limits.html
+const maxTemplateBodySize = 51200
+
 // DeployService deploys a service stack and renders progress updates to out until the deployment is done.
 // If the service stack doesn't exist, then it creates the stack.
 // If the service stack already exists, it updates the stack.
 func (cf CloudFormation) DeployService(out progress.FileWriter, conf StackConfiguration, opts ...cloudformation.StackOption) error {
-	stack, err := toStack(conf)
+	stack, err := toServiceStack(conf, opts...)
 	if err != nil {
 		return err
 	}
+	return cf.renderStackChanges(cf.newRenderWorkloadInput(out, stack))
+}
+
+// ForceDeployService starts the deployment of a service stack without waiting for the ECS service to reach a steady state.
+// If the service stack doesn't exist, then it creates the stack.
+// If the service stack already exists, it updates the stack.
+func (cf CloudFormation) ForceDeployService(out progress.FileWriter, conf StackConfiguration, opts ...cloudformation.StackOption) error {
+	stack, err := toServiceStack(conf, opts...)
+	if err != nil {
+		return err
+	}
+	_, err = cf.newRenderWorkloadInput(out, stack).createChangeSet()
+	return err
+}
+
+// ReviewAndDeployService proposes the changes to a service stack and writes them to out.
+// If confirm returns true, it deploys the stack and renders progress updates to out until the deployment is done.
+// Otherwise, it discards the changes and returns ErrChangeSetDeclined.
+func (cf CloudFormation) ReviewAndDeployService(out progress.FileWriter, conf StackConfiguration, confirm func() (bool, error), opts ...cloudformation.StackOption) error {
+	stack, err := toServiceStack(conf, opts...)
+	if err != nil {
+		return err
+	}
+	in := cf.newRenderWorkloadInput(out, stack)
+	in.createChangeSet = func() (string, error) {
+		return cf.reviewChangeSet(in, stack, confirm)
+	}
+	return cf.renderStackChanges(in)
+}
+
+// reviewChangeSet creates the change set of the stack, writes its changes, and executes it only if they're confirmed.
+func (cf CloudFormation) reviewChangeSet(in *renderStackChangesInput, stack *cloudformation.Stack, confirm func() (bool, error)) (string, error) {
+	spinner := progress.NewSpinner(in.w)
+	label := fmt.Sprintf("Proposing infrastructure changes for stack %s", stack.Name)
+	spinner.Start(label)
+	exists := true
+	if _, err := cf.cfnClient.Describe(stack.Name); err != nil {
+		var errNotFound *cloudformation.ErrStackNotFound
+		if !errors.As(err, &errNotFound) {
+			spinner.Stop(log.Serrorf("%s\n", label))
+			return "", err
+		}
+		exists = false
+	}
+	changeSetID, err := cf.cfnClient.CreateChangeSet(stack)
+	if err != nil {
+		msg := log.Serrorf("%s\n", label)
+		var errChangeSetEmpty *cloudformation.ErrChangeSetEmpty
+		if errors.As(err, &errChangeSetEmpty) {
+			msg = fmt.Sprintf("- No new infrastructure changes for stack %s\n", stack.Name)
+		}
+		spinner.Stop(msg)
+		return "", cf.handleStackError(stack.Name, err)
+	}
+	spinner.Stop(log.Ssuccessf("%s\n", label))
+
+	descr, err := cf.cfnClient.DescribeChangeSet(changeSetID, stack.Name)
+	if err != nil {
+		return "", err
+	}
+	if err := writeChangeSetPreview(in.w, stack.Name, descr.Changes); err != nil {
+		return "", fmt.Errorf("write changes to stack %s: %w", stack.Name, err)
+	}
+	confirmed, err := confirm()
+	if err != nil || !confirmed {
+		if discardErr := cf.discardChangeSet(changeSetID, stack.Name, exists); discardErr != nil {
+			log.Warningf("Failed to discard the changes to stack %s: %v\n", stack.Name, discardErr)
+		}
+		if err != nil {
+			return "", fmt.Errorf("confirm changes to stack %s: %w", stack.Name, err)
+		}
+		return "", &ErrChangeSetDeclined{
+			stackName: stack.Name,
+		}
+	}
+	if err := cf.cfnClient.ExecuteChangeSet(changeSetID, stack.Name); err != nil {
+		return "", cf.handleStackError(stack.Name, err)
+	}
+	if exists {
+		in.stackDescription = fmt.Sprintf("Updating the infrastructure for stack %s", stack.Name)
+	}
+	return changeSetID, nil
+}
+
+// discardChangeSet deletes a change set that won't be executed.
+// The change set of a new stack is discarded with the stack, which would otherwise stay in review.
+func (cf CloudFormation) discardChangeSet(changeSetID, stackName string, stackExists bool) error {
+	if !stackExists {
+		return cf.cfnClient.Delete(stackName)
+	}
+	return cf.cfnClient.DeleteChangeSet(changeSetID, stackName)
+}
+
+// CancelWorkloadUpdate cancels the in-progress update of a workload stack and waits until the stack is rolled back.
+// If the stack doesn't exist or isn't being updated, then it does nothing.
+func (cf CloudFormation) CancelWorkloadUpdate(out progress.FileWriter, stackName string) error {
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		var errNotFound *cloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return nil
+		}
+		return err
+	}
+	if aws.StringValue(descr.StackStatus) != sdkcloudformation.StackStatusUpdateInProgress {
+		return nil
+	}
+	spinner := progress.NewSpinner(out)
+	label := fmt.Sprintf("Canceling the in-progress update of stack %s", stackName)
+	spinner.Start(label)
+	if err := cf.cfnClient.CancelUpdateAndWait(stackName); err != nil {
+		spinner.Stop(log.Serrorf("%s\n", label))
+		return err
+	}
+	spinner.Stop(log.Ssuccessf("%s\n", label))
+	return nil
+}
+
+// RenderWorkloadUpdate renders the update of a workload stack that is in progress to out until it completes.
+// If the stack is not being updated, it returns an ErrStackNotInProgress.
+func (cf CloudFormation) RenderWorkloadUpdate(out progress.FileWriter, stackName string) error {
+	return cf.renderInProgressStackChanges(out, stackName, fmt.Sprintf("Updating the infrastructure for stack %s", stackName))
+}
+
+func toServiceStack(conf StackConfiguration, opts ...cloudformation.StackOption) (*cloudformation.Stack, error) {
+	stack, err := toStack(conf)
+	if err != nil {
+		return nil, err
+	}
+	if size := len(stack.TemplateBody); size > maxTemplateBodySize {
+		return nil, fmt.Errorf("template for stack %s is %d bytes which exceeds the CloudFormation limit of %d bytes, "+
+			"consider moving resources into addons or reducing the number of sidecars", stack.Name, size, maxTemplateBodySize)
+	}
 	for _, opt := range opts {
 		opt(stack)
 	}
-	return cf.renderStackChanges(cf.newRenderWorkloadInput(out, stack))
+	return stack, nil
 }
 
 func (cf CloudFormation) handleStackError(stackName string, err error) error {
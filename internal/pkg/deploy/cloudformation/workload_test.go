@@ -4,10 +4,14 @@
 package cloudformation
 
 import (
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -67,6 +71,22 @@ func TestCloudFormation_DeployService(t *testing.T) {
 		return cf.DeployService(w, serviceConfig)
 	}
 
+	t.Run("returns an error if the template exceeds the CloudFormation size limit", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		client := CloudFormation{cfnClient: mocks.NewMockcfnClient(ctrl)}
+		largeConfig := &mockStackConfig{
+			name:     "myapp-myenv-mysvc",
+			template: strings.Repeat("a", maxTemplateBodySize+1),
+		}
+
+		// WHEN
+		err := client.DeployService(mockFileWriter{Writer: new(strings.Builder)}, largeConfig)
+
+		// THEN
+		require.EqualError(t, err, "template for stack myapp-myenv-mysvc is 51201 bytes which exceeds the CloudFormation limit of 51200 bytes, consider moving resources into addons or reducing the number of sidecars")
+	})
 	t.Run("returns a wrapped error if creating a change set fails", func(t *testing.T) {
 		testDeployWorkload_OnCreateChangeSetFailure(t, when)
 	})
@@ -79,6 +99,9 @@ func TestCloudFormation_DeployService(t *testing.T) {
 	t.Run("returns an error when stack template body cannot be retrieved to parse resource descriptions", func(t *testing.T) {
 		testDeployWorkload_OnTemplateBodyFailure(t, when)
 	})
+	t.Run("renders the changes without estimates when past stack events cannot be retrieved", func(t *testing.T) {
+		testDeployWorkload_RendersOnPastEventsFailure(t, when)
+	})
 	t.Run("returns a wrapped error if a streamer fails and cancels the renderer", func(t *testing.T) {
 		testDeployWorkload_StackStreamerFailureShouldCancelRenderer(t, when)
 	})
@@ -97,6 +120,343 @@ func TestCloudFormation_DeployService(t *testing.T) {
 	t.Run("renders a stack with addons template if stack creation is successful", func(t *testing.T) {
 		testDeployWorkload_RenderNewlyCreatedStackWithAddons(t, "myapp-myenv-mysvc", when)
 	})
+	t.Run("writes a line per stack event in plain progress mode", func(t *testing.T) {
+		testDeployWorkload_RenderPlainEventsWithAddons(t, "myapp-myenv-mysvc", when)
+	})
+}
+
+func TestCloudFormation_ForceDeployService(t *testing.T) {
+	serviceConfig := &mockStackConfig{
+		name:     "myapp-myenv-mysvc",
+		template: "template",
+	}
+	when := func(w progress.FileWriter, cf CloudFormation) error {
+		return cf.ForceDeployService(w, serviceConfig)
+	}
+
+	t.Run("returns a wrapped error if creating a change set fails", func(t *testing.T) {
+		testDeployWorkload_OnCreateChangeSetFailure(t, when)
+	})
+	t.Run("calls Update if stack is already created and returns wrapped error if Update fails", func(t *testing.T) {
+		testDeployWorkload_OnUpdateChangeSetFailure(t, when)
+	})
+	t.Run("returns once the change set is executed without waiting for the stack", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockcfnClient(ctrl)
+		m.EXPECT().Create(gomock.Any()).Return("", &cloudformation.ErrStackAlreadyExists{})
+		m.EXPECT().Update(gomock.Any()).Return("1234", nil)
+		m.EXPECT().DescribeChangeSet(gomock.Any(), gomock.Any()).Times(0)
+		m.EXPECT().Describe(gomock.Any()).Times(0)
+		client := CloudFormation{cfnClient: m}
+
+		// WHEN
+		err := when(mockFileWriter{Writer: new(strings.Builder)}, client)
+
+		// THEN
+		require.NoError(t, err)
+	})
+}
+
+func TestCloudFormation_ReviewAndDeployService(t *testing.T) {
+	const stackName = "myapp-myenv-mysvc"
+	serviceConfig := &mockStackConfig{
+		name:     stackName,
+		template: "template",
+	}
+	changes := &cloudformation.ChangeSetDescription{
+		Changes: []*sdkcloudformation.Change{
+			{
+				ResourceChange: &sdkcloudformation.ResourceChange{
+					Action:            aws.String(sdkcloudformation.ChangeActionModify),
+					LogicalResourceId: aws.String("TaskDefinition"),
+					ResourceType:      aws.String("AWS::ECS::TaskDefinition"),
+					Replacement:       aws.String(sdkcloudformation.ReplacementTrue),
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		createMock   func(m *mocks.MockcfnClient)
+		inConfirmed  bool
+		inConfirmErr error
+
+		wantedErr     error
+		wantedPreview bool
+	}{
+		"returns an error if the stack can't be described": {
+			createMock: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe(stackName).Return(nil, errors.New("some error"))
+				m.EXPECT().CreateChangeSet(gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"returns a wrapped error if the change set can't be created": {
+			createMock: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return("", errors.New("some error"))
+				m.EXPECT().ErrorEvents(stackName).Return([]cloudformation.StackEvent{
+					{
+						ResourceStatusReason: aws.String("some reason. (Service: Amazon ECS)"),
+					},
+				}, nil)
+				m.EXPECT().DescribeChangeSet(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("some error: some reason"),
+		},
+		"discards the change set if the changes are declined": {
+			createMock: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return("1234", nil)
+				m.EXPECT().DescribeChangeSet("1234", stackName).Return(changes, nil)
+				m.EXPECT().DeleteChangeSet("1234", stackName).Return(nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErr:     &ErrChangeSetDeclined{stackName: stackName},
+			wantedPreview: true,
+		},
+		"discards the stack under review if the changes to create it are declined": {
+			createMock: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe(stackName).Return(nil, &cloudformation.ErrStackNotFound{})
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return("1234", nil)
+				m.EXPECT().DescribeChangeSet("1234", stackName).Return(changes, nil)
+				m.EXPECT().Delete(stackName).Return(nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErr:     &ErrChangeSetDeclined{stackName: stackName},
+			wantedPreview: true,
+		},
+		"discards the change set if the confirmation fails": {
+			createMock: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return("1234", nil)
+				m.EXPECT().DescribeChangeSet("1234", stackName).Return(changes, nil)
+				m.EXPECT().DeleteChangeSet("1234", stackName).Return(nil)
+			},
+			inConfirmErr: errors.New("some error"),
+
+			wantedErr:     errors.New("confirm changes to stack myapp-myenv-mysvc: some error"),
+			wantedPreview: true,
+		},
+		"executes the change set and renders the stack if the changes are confirmed": {
+			createMock: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return("1234", nil)
+				m.EXPECT().DescribeChangeSet("1234", stackName).Return(changes, nil).Times(2)
+				m.EXPECT().ExecuteChangeSet("1234", stackName).Return(nil)
+				m.EXPECT().TemplateBodyFromChangeSet("1234", stackName).Return("", errors.New("TemplateBody error"))
+			},
+			inConfirmed: true,
+
+			wantedErr:     errors.New("TemplateBody error"),
+			wantedPreview: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfnClient(ctrl)
+			tc.createMock(m)
+			c := CloudFormation{cfnClient: m}
+			buf := new(strings.Builder)
+
+			// WHEN
+			err := c.ReviewAndDeployService(mockFileWriter{Writer: buf}, serviceConfig, func() (bool, error) {
+				return tc.inConfirmed, tc.inConfirmErr
+			})
+
+			// THEN
+			require.EqualError(t, err, tc.wantedErr.Error())
+			if tc.wantedPreview {
+				require.Contains(t, buf.String(), "Proposed changes to stack myapp-myenv-mysvc:")
+			} else {
+				require.NotContains(t, buf.String(), "Proposed changes")
+			}
+		})
+	}
+}
+
+func TestCloudFormation_CancelWorkloadUpdate(t *testing.T) {
+	const stackName = "myapp-myenv-mysvc"
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedErr error
+	}{
+		"does nothing if the stack doesn't exist": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe(stackName).Return(nil, &cloudformation.ErrStackNotFound{})
+				m.EXPECT().CancelUpdateAndWait(gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"returns an error if the stack can't be described": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe(stackName).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"does nothing if the stack isn't being updated": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(sdkcloudformation.StackStatusUpdateComplete),
+				}, nil)
+				m.EXPECT().CancelUpdateAndWait(gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"returns an error if the update can't be canceled": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(sdkcloudformation.StackStatusUpdateInProgress),
+				}, nil)
+				m.EXPECT().CancelUpdateAndWait(stackName).Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"cancels the in-progress update": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(sdkcloudformation.StackStatusUpdateInProgress),
+				}, nil)
+				m.EXPECT().CancelUpdateAndWait(stackName).Return(nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.CancelWorkloadUpdate(mockFileWriter{Writer: new(strings.Builder)}, stackName)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_RenderWorkloadUpdate(t *testing.T) {
+	const stackName = "myapp-myenv-mysvc"
+	updateTime := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockCFN func(m *mocks.MockcfnClient)
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"returns an error if the stack is not being updated": {
+			mockCFN: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
+					StackStatus: aws.String("UPDATE_ROLLBACK_COMPLETE"),
+				}, nil)
+			},
+			wantedErr: &ErrStackNotInProgress{
+				stackName: stackName,
+				status:    "UPDATE_ROLLBACK_COMPLETE",
+			},
+		},
+		"renders the update in progress until it completes": {
+			mockCFN: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
+					StackStatus:     aws.String("UPDATE_IN_PROGRESS"),
+					LastUpdatedTime: aws.Time(updateTime),
+				}, nil)
+				m.EXPECT().DescribeStackEvents(gomock.Any()).Return(&sdkcloudformation.DescribeStackEventsOutput{
+					StackEvents: []*sdkcloudformation.StackEvent{
+						{
+							EventId:           aws.String("2"),
+							LogicalResourceId: aws.String(stackName),
+							ResourceType:      aws.String("AWS::CloudFormation::Stack"),
+							ResourceStatus:    aws.String("UPDATE_COMPLETE"),
+							Timestamp:         aws.Time(updateTime.Add(2 * time.Minute)),
+						},
+						{
+							EventId:           aws.String("1"),
+							LogicalResourceId: aws.String("Service"),
+							ResourceType:      aws.String("AWS::ECS::Service"),
+							ResourceStatus:    aws.String("UPDATE_COMPLETE"),
+							Timestamp:         aws.Time(updateTime.Add(time.Minute)),
+						},
+					},
+				}, nil).AnyTimes()
+				m.EXPECT().RecentEvents(stackName, 1).Return([]cloudformation.StackEvent{
+					{
+						LogicalResourceId: aws.String(stackName),
+						ResourceStatus:    aws.String("UPDATE_IN_PROGRESS"),
+						Timestamp:         aws.Time(updateTime),
+					},
+					{
+						LogicalResourceId: aws.String("Service"),
+						ResourceStatus:    aws.String("UPDATE_IN_PROGRESS"),
+						Timestamp:         aws.Time(updateTime.Add(time.Second)),
+					},
+					{
+						LogicalResourceId: aws.String("Service"),
+						ResourceStatus:    aws.String("UPDATE_COMPLETE"),
+						Timestamp:         aws.Time(updateTime.Add(time.Minute)),
+					},
+					{
+						LogicalResourceId: aws.String(stackName),
+						ResourceStatus:    aws.String("UPDATE_COMPLETE"),
+						Timestamp:         aws.Time(updateTime.Add(2 * time.Minute)),
+					},
+				}, nil)
+				m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
+					StackStatus: aws.String("UPDATE_COMPLETE"),
+				}, nil)
+			},
+			wantedOutput: "  Service           update complete     59s\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfnClient(ctrl)
+			tc.mockCFN(m)
+			cf := CloudFormation{
+				cfnClient:    m,
+				progressMode: progress.TreeMode,
+			}
+			buf := new(strings.Builder)
+
+			// WHEN
+			err := cf.RenderWorkloadUpdate(mockFileWriter{Writer: buf}, stackName)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Contains(t, buf.String(), tc.wantedOutput)
+		})
+	}
 }
 
 func TestCloudFormation_DeleteWorkload(t *testing.T) {
@@ -10,6 +10,7 @@ import (
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"golang.org/x/mod/semver"
 )
 
 const (
@@ -27,6 +28,32 @@ const (
 	ecsServiceResourceType = "ecs:service"
 )
 
+// ErrTemplateVersionSkew occurs when a stack was deployed with a newer template version than the latest one
+// known to this version of the CLI, likely because a teammate upgraded it with a newer version of Copilot.
+type ErrTemplateVersionSkew struct {
+	Kind            string // Kind of the stack, such as "application" or "environment".
+	Name            string // Name of the application or environment.
+	DeployedVersion string // Template version of the deployed stack.
+	LatestVersion   string // Latest template version known to this version of the CLI.
+}
+
+func (e *ErrTemplateVersionSkew) Error() string {
+	return fmt.Sprintf("%s %s is on template version %s, which is newer than the latest version %s supported by this Copilot CLI",
+		e.Kind, e.Name, e.DeployedVersion, e.LatestVersion)
+}
+
+func validateTemplateVersion(kind, name, deployed, latest string) error {
+	if semver.Compare(deployed, latest) <= 0 {
+		return nil
+	}
+	return &ErrTemplateVersionSkew{
+		Kind:            kind,
+		Name:            name,
+		DeployedVersion: deployed,
+		LatestVersion:   latest,
+	}
+}
+
 type resourceGetter interface {
 	GetResourcesByTags(resourceType string, tags map[string]string) ([]*rg.Resource, error)
 }
@@ -13,23 +13,34 @@ const (
 	// LegacyEnvTemplateVersion is the version associated with the environment template before we started versioning.
 	LegacyEnvTemplateVersion = "v0.0.0"
 	// LatestEnvTemplateVersion is the latest version number available for environment templates.
-	LatestEnvTemplateVersion = "v1.3.0"
+	LatestEnvTemplateVersion = "v1.4.0"
 )
 
+// ValidateEnvTemplateVersion returns an ErrTemplateVersionSkew if the environment stack was deployed
+// with a newer template version than LatestEnvTemplateVersion.
+func ValidateEnvTemplateVersion(env, version string) error {
+	return validateTemplateVersion("environment", env, version, LatestEnvTemplateVersion)
+}
+
 // CreateEnvironmentInput holds the fields required to deploy an environment.
 type CreateEnvironmentInput struct {
 	// The version of the environment template to create the stack. If empty, creates the legacy stack.
 	Version string
 
-	AppName                  string            // Name of the application this environment belongs to.
-	Name                     string            // Name of the environment, must be unique within an application.
-	Prod                     bool              // Whether or not this environment is a production environment.
-	ToolsAccountPrincipalARN string            // The Principal ARN of the tools account.
-	AppDNSName               string            // The DNS name of this application, if it exists
-	AdditionalTags           map[string]string // AdditionalTags are labels applied to resources under the application.
-	CustomResourcesURLs      map[string]string // Environment custom resource script S3 object URLs.
-	ImportVPCConfig          *config.ImportVPC // Optional configuration if users have an existing VPC.
-	AdjustVPCConfig          *config.AdjustVPC // Optional configuration if users want to override default VPC configuration.
+	AppName                  string                   // Name of the application this environment belongs to.
+	Name                     string                   // Name of the environment, must be unique within an application.
+	Prod                     bool                     // Whether or not this environment is a production environment.
+	ToolsAccountPrincipalARN string                   // The Principal ARN of the tools account.
+	AppDNSName               string                   // The DNS name of this application, if it exists
+	AdditionalTags           map[string]string        // AdditionalTags are labels applied to resources under the application.
+	CustomResourcesURLs      map[string]string        // Environment custom resource script S3 object URLs.
+	ImportVPCConfig          *config.ImportVPC        // Optional configuration if users have an existing VPC.
+	AdjustVPCConfig          *config.AdjustVPC        // Optional configuration if users want to override default VPC configuration.
+	ResourceNames            *config.EnvResourceNames // Optional names that override the generated names of environment resources.
+	HTTPSListener            *config.HTTPSListener    // Optional TLS settings of the HTTPS listener of the public load balancer.
+	Domain                   *config.EnvDomain        // Optional domain of the environment that replaces the subdomain of AppDNSName.
+	ExternalInstances        bool                     // Whether to create the role that lets on-premises instances join the cluster with ECS Anywhere.
+	IPv6                     bool                     // Whether to create a dual-stack VPC and public load balancer.
 
 	CFNServiceRoleARN string // Optional. A service role ARN that CloudFormation should use to make calls to resources in the stack.
 }
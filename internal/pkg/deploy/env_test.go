@@ -0,0 +1,44 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateEnvTemplateVersion(t *testing.T) {
+	testCases := map[string]struct {
+		inVersion string
+
+		wantedErr error
+	}{
+		"should not error if the environment is on a legacy version": {
+			inVersion: LegacyEnvTemplateVersion,
+		},
+		"should not error if the environment is on the latest version": {
+			inVersion: LatestEnvTemplateVersion,
+		},
+		"should error if the environment is on a newer version": {
+			inVersion: "v99.0.0",
+			wantedErr: fmt.Errorf("environment test is on template version v99.0.0, which is newer than the latest version %s supported by this Copilot CLI", LatestEnvTemplateVersion),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			err := ValidateEnvTemplateVersion("test", tc.inVersion)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
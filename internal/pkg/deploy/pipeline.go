@@ -18,7 +18,8 @@ import (
 const (
 	fmtInvalidRepo = "unable to locate the repository URL from the properties: %+v"
 
-	defaultPipelineBuildImage = "aws/codebuild/amazonlinux2-x86_64-standard:3.0"
+	defaultPipelineBuildImage        = "aws/codebuild/amazonlinux2-x86_64-standard:3.0"
+	defaultPostDeploymentComputeType = "BUILD_GENERAL1_SMALL"
 )
 
 var (
@@ -57,6 +58,17 @@ type CreatePipelineInput struct {
 	AdditionalTags map[string]string
 }
 
+// ApprovalTopics returns the ARNs of the SNS topics notified by the manual approval actions of the pipeline.
+func (in *CreatePipelineInput) ApprovalTopics() []string {
+	var topics []string
+	for _, stage := range in.Stages {
+		if (stage.RequiresApproval || stage.ReviewChangeSets) && stage.ApprovalTopic != "" {
+			topics = append(topics, stage.ApprovalTopic)
+		}
+	}
+	return topics
+}
+
 // Build represents CodeBuild project used in the CodePipeline
 // to build and test Docker image.
 type Build struct {
@@ -381,7 +393,53 @@ type PipelineStage struct {
 	*AssociatedEnvironment
 	LocalWorkloads   []string
 	RequiresApproval bool
+	ReviewChangeSets bool   // If true, the change sets of the stage are exported as an artifact and executed once approved.
+	ApprovalTopic    string // ARN of the SNS topic to notify when the manual approval action is reached.
 	TestCommands     []string
+	PostDeployments  []PostDeployment
+}
+
+// ChangeSetsArtifactName returns the name of the output artifact that holds the change sets of the stage under review.
+func (s *PipelineStage) ChangeSetsArtifactName() string {
+	return fmt.Sprintf("ChangeSets-%s", s.Name)
+}
+
+// TestRunOrder returns the run order of the test actions of the stage,
+// which run once all the workloads of the stage are deployed.
+func (s *PipelineStage) TestRunOrder() int {
+	if s.ReviewChangeSets {
+		// Approval, create change sets, export change sets, review, and execute change sets.
+		return 6
+	}
+	// Approval, and create or update stacks.
+	return 3
+}
+
+// PostDeployment is a test action of a stage that runs in its own CodeBuild project
+// after the workloads of the stage are deployed.
+type PostDeployment struct {
+	Name        string
+	Commands    []string
+	Buildspec   string // Path to a buildspec file from the root of the source repository.
+	Image       string
+	ComputeType string
+	Variables   map[string]string
+}
+
+// BuildImage returns the CodeBuild image that runs the action.
+func (p PostDeployment) BuildImage() string {
+	if p.Image == "" {
+		return defaultPipelineBuildImage
+	}
+	return p.Image
+}
+
+// BuildComputeType returns the CodeBuild compute type that runs the action.
+func (p PostDeployment) BuildComputeType() string {
+	if p.ComputeType == "" {
+		return defaultPostDeploymentComputeType
+	}
+	return p.ComputeType
 }
 
 // WorkloadTemplatePath returns the full path to the workload CFN template
@@ -178,6 +178,109 @@ func TestPipelineBuildFromManifest(t *testing.T) {
 	}
 }
 
+func TestCreatePipelineInput_ApprovalTopics(t *testing.T) {
+	testCases := map[string]struct {
+		inStages []PipelineStage
+
+		wantedTopics []string
+	}{
+		"no stage requires approval": {
+			inStages: []PipelineStage{
+				{},
+			},
+		},
+		"ignores topics of stages that don't require approval": {
+			inStages: []PipelineStage{
+				{
+					ApprovalTopic: "arn:aws:sns:us-west-2:1111:test",
+				},
+				{
+					RequiresApproval: true,
+				},
+				{
+					RequiresApproval: true,
+					ApprovalTopic:    "arn:aws:sns:us-west-2:1111:prod",
+				},
+			},
+			wantedTopics: []string{"arn:aws:sns:us-west-2:1111:prod"},
+		},
+		"includes topics of stages that review their change sets": {
+			inStages: []PipelineStage{
+				{
+					ReviewChangeSets: true,
+					ApprovalTopic:    "arn:aws:sns:us-west-2:1111:prod",
+				},
+			},
+			wantedTopics: []string{"arn:aws:sns:us-west-2:1111:prod"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			in := &CreatePipelineInput{
+				Stages: tc.inStages,
+			}
+			require.Equal(t, tc.wantedTopics, in.ApprovalTopics())
+		})
+	}
+}
+
+func TestPipelineStage_TestRunOrder(t *testing.T) {
+	testCases := map[string]struct {
+		in PipelineStage
+
+		wanted int
+	}{
+		"runs after the stacks are created or updated": {
+			in:     PipelineStage{},
+			wanted: 3,
+		},
+		"runs after the reviewed change sets are executed": {
+			in: PipelineStage{
+				ReviewChangeSets: true,
+			},
+			wanted: 6,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.TestRunOrder())
+		})
+	}
+}
+
+func TestPostDeployment_Build(t *testing.T) {
+	testCases := map[string]struct {
+		in PostDeployment
+
+		wantedImage       string
+		wantedComputeType string
+	}{
+		"uses the defaults": {
+			in: PostDeployment{},
+
+			wantedImage:       "aws/codebuild/amazonlinux2-x86_64-standard:3.0",
+			wantedComputeType: "BUILD_GENERAL1_SMALL",
+		},
+		"uses the custom image and compute type": {
+			in: PostDeployment{
+				Image:       "aws/codebuild/standard:5.0",
+				ComputeType: "BUILD_GENERAL1_LARGE",
+			},
+
+			wantedImage:       "aws/codebuild/standard:5.0",
+			wantedComputeType: "BUILD_GENERAL1_LARGE",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedImage, tc.in.BuildImage())
+			require.Equal(t, tc.wantedComputeType, tc.in.BuildComputeType())
+		})
+	}
+}
+
 func TestParseOwnerAndRepo(t *testing.T) {
 	testCases := map[string]struct {
 		src            *GitHubSource
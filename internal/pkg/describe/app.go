@@ -10,6 +10,7 @@ import (
 	"strings"
 	"text/tabwriter"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
@@ -23,12 +24,14 @@ import (
 
 // App contains serialized parameters for an application.
 type App struct {
-	Name      string                   `json:"name"`
-	Version   string                   `json:"version"`
-	URI       string                   `json:"uri"`
-	Envs      []*config.Environment    `json:"environments"`
-	Services  []*config.Workload       `json:"services"`
-	Pipelines []*codepipeline.Pipeline `json:"pipelines"`
+	Name          string                   `json:"name"`
+	Version       string                   `json:"version"`
+	URI           string                   `json:"uri"`
+	Envs          []*config.Environment    `json:"environments"`
+	Services      []*config.Workload       `json:"services"`
+	Pipelines     []*codepipeline.Pipeline `json:"pipelines"`
+	Quotas        []*QuotaUsage            `json:"quotas,omitempty"`
+	ResourceGroup string                   `json:"resourceGroup,omitempty"` // Console link to the Resource Group of the application's region.
 }
 
 // JSONString returns the stringified App struct with json format.
@@ -53,6 +56,9 @@ func (a *App) HumanString() string {
 	}
 	fmt.Fprintf(writer, "  %s\t%s %s\n", "Version", a.Version, availableVersion)
 	fmt.Fprintf(writer, "  %s\t%s\n", "URI", a.URI)
+	if a.ResourceGroup != "" {
+		fmt.Fprintf(writer, "  %s\t%s\n", "Resource Group", a.ResourceGroup)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nEnvironments\n\n"))
 	writer.Flush()
 	headers := []string{"Name", "AccountID", "Region"}
@@ -77,14 +83,29 @@ func (a *App) HumanString() string {
 	for _, pipeline := range a.Pipelines {
 		fmt.Fprintf(writer, "  %s\n", pipeline.Name)
 	}
+	if len(a.Quotas) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nQuotas\n\n"))
+		writer.Flush()
+		headers = []string{"Environment", "Quota", "Usage"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, quota := range a.Quotas {
+			usage := quota.String()
+			if quota.NearLimit() {
+				usage = color.Yellow.Sprint(usage)
+			}
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", quota.Environment, quota.Name, usage)
+		}
+	}
 	writer.Flush()
 	return b.String()
 }
 
 // AppDescriber retrieves information about an application.
 type AppDescriber struct {
-	app string
-	cfn cfn
+	app    string
+	region string // Region of the application stack.
+	cfn    cfn
 }
 
 // NewAppDescriber instantiates an application describer.
@@ -94,8 +115,9 @@ func NewAppDescriber(appName string) (*AppDescriber, error) {
 		return nil, fmt.Errorf("assume default role for app %s: %w", appName, err)
 	}
 	return &AppDescriber{
-		app: appName,
-		cfn: cloudformation.New(sess),
+		app:    appName,
+		region: aws.StringValue(sess.Config.Region),
+		cfn:    cloudformation.New(sess),
 	}, nil
 }
 
@@ -143,3 +165,19 @@ func (d *AppDescriber) Version() (string, error) {
 	}
 	return minVersion, nil
 }
+
+// ResourceGroupURL returns the console link to the Resource Group of the application.
+// If the application stack was created before it had a Resource Group, it returns an empty string and nil error.
+func (d *AppDescriber) ResourceGroupURL() (string, error) {
+	appStackName := stack.NameForAppStack(d.app)
+	appStack, err := d.cfn.Describe(appStackName)
+	if err != nil {
+		return "", fmt.Errorf("describe app stack %s: %w", appStackName, err)
+	}
+	for _, out := range appStack.Outputs {
+		if aws.StringValue(out.OutputKey) == stack.AppOutputResourceGroup {
+			return resourceGroupURL(aws.StringValue(out.OutputValue), d.region), nil
+		}
+	}
+	return "", nil
+}
@@ -8,6 +8,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -79,3 +82,74 @@ func TestAppDescriber_Version(t *testing.T) {
 		})
 	}
 }
+
+func TestAppDescriber_ResourceGroupURL(t *testing.T) {
+	testCases := map[string]struct {
+		given func(ctrl *gomock.Controller) *AppDescriber
+
+		wantedURL string
+		wantedErr error
+	}{
+		"should return error if fail to describe the app stack": {
+			given: func(ctrl *gomock.Controller) *AppDescriber {
+				m := mocks.NewMockcfn(ctrl)
+				m.EXPECT().Describe("phonetool-infrastructure-roles").Return(nil, errors.New("some error"))
+				return &AppDescriber{
+					app: "phonetool",
+					cfn: m,
+				}
+			},
+			wantedErr: fmt.Errorf("describe app stack phonetool-infrastructure-roles: some error"),
+		},
+		"should return empty if the app stack has no resource group": {
+			given: func(ctrl *gomock.Controller) *AppDescriber {
+				m := mocks.NewMockcfn(ctrl)
+				m.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil)
+				return &AppDescriber{
+					app: "phonetool",
+					cfn: m,
+				}
+			},
+		},
+		"success": {
+			given: func(ctrl *gomock.Controller) *AppDescriber {
+				m := mocks.NewMockcfn(ctrl)
+				m.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{
+					Outputs: []*awscfn.Output{
+						{
+							OutputKey:   aws.String("ResourceGroup"),
+							OutputValue: aws.String("phonetool-infrastructure-roles"),
+						},
+					},
+				}, nil)
+				return &AppDescriber{
+					app:    "phonetool",
+					region: "us-west-2",
+					cfn:    m,
+				}
+			},
+
+			wantedURL: "https://console.aws.amazon.com/resource-groups/group/phonetool-infrastructure-roles?region=us-west-2",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			d := tc.given(ctrl)
+
+			// WHEN
+			actual, err := d.ResourceGroupURL()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedURL, actual)
+			}
+		})
+	}
+}
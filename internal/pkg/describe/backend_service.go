@@ -10,6 +10,7 @@ import (
 	"text/tabwriter"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	copilotecs "github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
@@ -100,6 +101,8 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 	var services []*ServiceDiscovery
 	var envVars []*envVar
 	var secrets []*secret
+	var features []*ServiceFeatures
+	var scalings []*copilotecs.ServiceScaling
 	for _, env := range environments {
 		err := d.initServiceDescriber(env)
 		if err != nil {
@@ -135,6 +138,16 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 			return nil, fmt.Errorf("retrieve secrets: %w", err)
 		}
 		secrets = append(secrets, flattenSecrets(env, webSvcSecrets)...)
+		svcOutputs, err := d.svcDescriber[env].Outputs()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve service stack outputs: %w", err)
+		}
+		features = appendServiceFeatures(features, env, svcOutputs)
+		scaling, err := d.svcDescriber[env].Scaling()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve service scaling: %w", err)
+		}
+		scalings = append(scalings, scaling)
 	}
 
 	resources := make(map[string][]*CfnResource)
@@ -160,6 +173,8 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 		ServiceDiscovery: services,
 		Variables:        envVars,
 		Secrets:          secrets,
+		Features:         features,
+		Scaling:          scalings,
 		Resources:        resources,
 	}, nil
 }
@@ -173,6 +188,8 @@ type backendSvcDesc struct {
 	ServiceDiscovery serviceDiscoveries `json:"serviceDiscovery"`
 	Variables        envVars            `json:"variables"`
 	Secrets          secrets            `json:"secrets,omitempty"`
+	Features         serviceFeatures    `json:"features,omitempty"`
+	Scaling          serviceScalings    `json:"scaling,omitempty"`
 	Resources        cfnResources       `json:"resources,omitempty"`
 }
 
@@ -197,6 +214,11 @@ func (w *backendSvcDesc) HumanString() string {
 	fmt.Fprint(writer, color.Bold.Sprint("\nConfigurations\n\n"))
 	writer.Flush()
 	w.Configurations.humanString(writer)
+	if len(w.Scaling) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nScaling\n\n"))
+		writer.Flush()
+		w.Scaling.humanString(writer)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nService Discovery\n\n"))
 	writer.Flush()
 	w.ServiceDiscovery.humanString(writer)
@@ -208,6 +230,16 @@ func (w *backendSvcDesc) HumanString() string {
 		writer.Flush()
 		w.Secrets.humanString(writer)
 	}
+	if len(w.Features) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nFeatures\n\n"))
+		writer.Flush()
+		w.Features.humanString(writer)
+	}
+	if w.Scaling.hasAlarms() {
+		fmt.Fprint(writer, color.Bold.Sprint("\nAlarms\n\n"))
+		writer.Flush()
+		w.Scaling.alarmsHumanString(writer)
+	}
 	if len(w.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
 		writer.Flush()
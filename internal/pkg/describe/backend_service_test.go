@@ -11,9 +11,11 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	copilotecs "github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -95,6 +97,43 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("retrieve secrets: some error"),
 		},
+		"return error if fail to retrieve service stack outputs": {
+			setupMocks: func(m backendSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceContainerPortParamKey: "80",
+						stack.WorkloadTaskCountParamKey:         "1",
+						stack.WorkloadTaskCPUParamKey:           "256",
+						stack.WorkloadTaskMemoryParamKey:        "512",
+					}, nil),
+					m.svcDescriber.EXPECT().EnvVars().Return(nil, nil),
+					m.svcDescriber.EXPECT().Secrets().Return(nil, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve service stack outputs: some error"),
+		},
+		"return error if fail to retrieve service scaling": {
+			setupMocks: func(m backendSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceContainerPortParamKey: "80",
+						stack.WorkloadTaskCountParamKey:         "1",
+						stack.WorkloadTaskCPUParamKey:           "256",
+						stack.WorkloadTaskMemoryParamKey:        "512",
+					}, nil),
+					m.svcDescriber.EXPECT().EnvVars().Return(nil, nil),
+					m.svcDescriber.EXPECT().Secrets().Return(nil, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(nil, nil),
+					m.svcDescriber.EXPECT().Scaling().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve service scaling: some error"),
+		},
 		"success": {
 			shouldOutputResources: true,
 			setupMocks: func(m backendSvcDescriberMocks) {
@@ -121,6 +160,14 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 							ValueFrom: "GH_WEBHOOK_SECRET",
 						},
 					}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(map[string]string{
+						"EnabledFeatures": "ecs-managed-tags",
+					}, nil),
+					m.svcDescriber.EXPECT().Scaling().Return(&copilotecs.ServiceScaling{
+						Environment:  testEnv,
+						DesiredCount: 1,
+						RunningCount: 1,
+					}, nil),
 					m.svcDescriber.EXPECT().Params().Return(map[string]string{
 						stack.LBWebServiceContainerPortParamKey: "5000",
 						stack.WorkloadTaskCountParamKey:         "2",
@@ -141,6 +188,12 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 							ValueFrom: "SHHHHHHHH",
 						},
 					}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
+					m.svcDescriber.EXPECT().Scaling().Return(&copilotecs.ServiceScaling{
+						Environment:  prodEnv,
+						DesiredCount: 2,
+						RunningCount: 2,
+					}, nil),
 					m.svcDescriber.EXPECT().Params().Return(map[string]string{
 						stack.LBWebServiceContainerPortParamKey: "-1",
 						stack.WorkloadTaskCountParamKey:         "2",
@@ -156,6 +209,12 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 					}, nil),
 					m.svcDescriber.EXPECT().Secrets().Return(
 						nil, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
+					m.svcDescriber.EXPECT().Scaling().Return(&copilotecs.ServiceScaling{
+						Environment:  mockEnv,
+						DesiredCount: 2,
+						RunningCount: 1,
+					}, nil),
 					m.svcDescriber.EXPECT().ServiceStackResources().Return([]*cloudformation.StackResource{
 						{
 							ResourceType:       aws.String("AWS::EC2::SecurityGroupIngress"),
@@ -243,6 +302,17 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						ValueFrom:   "SHHHHHHHH",
 					},
 				},
+				Features: []*ServiceFeatures{
+					{
+						Environment: "test",
+						Features:    []string{"ecs-managed-tags"},
+					},
+				},
+				Scaling: []*copilotecs.ServiceScaling{
+					{Environment: "test", DesiredCount: 1, RunningCount: 1},
+					{Environment: "prod", DesiredCount: 2, RunningCount: 2},
+					{Environment: "mockEnv", DesiredCount: 2, RunningCount: 1},
+				},
 				Resources: map[string][]*CfnResource{
 					"test": {
 						{
@@ -327,6 +397,13 @@ Configurations
   test              1                   0.25                512                 80
   prod              3                   0.5                 1024                5000
 
+Scaling
+
+  Environment       Desired             Running             Range               Policies
+  -----------       -------             -------             -----               --------
+  test              1                   1                   -                   -
+  prod              3                   3                   2-6                 Memory 80%
+
 Service Discovery
 
   Environment       Namespace
@@ -355,7 +432,7 @@ Resources
   prod
     AWS::EC2::SecurityGroupIngress  ContainerSecurityGroupIngressFromPublicALB
 `,
-			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Backend Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"tasks\":\"1\",\"cpu\":\"256\",\"memory\":\"512\"},{\"environment\":\"prod\",\"port\":\"5000\",\"tasks\":\"3\",\"cpu\":\"512\",\"memory\":\"1024\"}],\"serviceDiscovery\":[{\"environment\":[\"test\",\"prod\"],\"namespace\":\"http://my-svc.my-app.local:5000\"}],\"variables\":[{\"environment\":\"prod\",\"container\":\"container\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"test\",\"container\":\"container\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"}],\"secrets\":[{\"name\":\"GITHUB_WEBHOOK_SECRET\",\"container\":\"container\",\"environment\":\"test\",\"valueFrom\":\"GH_WEBHOOK_SECRET\"},{\"name\":\"SOME_OTHER_SECRET\",\"container\":\"container\",\"environment\":\"prod\",\"valueFrom\":\"SHHHHH\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
+			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Backend Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"tasks\":\"1\",\"cpu\":\"256\",\"memory\":\"512\"},{\"environment\":\"prod\",\"port\":\"5000\",\"tasks\":\"3\",\"cpu\":\"512\",\"memory\":\"1024\"}],\"serviceDiscovery\":[{\"environment\":[\"test\",\"prod\"],\"namespace\":\"http://my-svc.my-app.local:5000\"}],\"variables\":[{\"environment\":\"prod\",\"container\":\"container\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"test\",\"container\":\"container\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"}],\"secrets\":[{\"name\":\"GITHUB_WEBHOOK_SECRET\",\"container\":\"container\",\"environment\":\"test\",\"valueFrom\":\"GH_WEBHOOK_SECRET\"},{\"name\":\"SOME_OTHER_SECRET\",\"container\":\"container\",\"environment\":\"prod\",\"valueFrom\":\"SHHHHH\"}],\"scaling\":[{\"environment\":\"test\",\"desiredCount\":1,\"runningCount\":1},{\"environment\":\"prod\",\"desiredCount\":3,\"runningCount\":3,\"range\":{\"minCapacity\":2,\"maxCapacity\":6},\"policies\":[{\"name\":\"my-svc-memory\",\"type\":\"TargetTrackingScaling\",\"metric\":\"ECSServiceAverageMemoryUtilization\",\"targetValue\":80}]}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
 		},
 	}
 
@@ -425,6 +502,30 @@ Resources
 					},
 				},
 			}
+			scalings := []*copilotecs.ServiceScaling{
+				{
+					Environment:  "test",
+					DesiredCount: 1,
+					RunningCount: 1,
+				},
+				{
+					Environment:  "prod",
+					DesiredCount: 3,
+					RunningCount: 3,
+					Range: &aas.ScalableTarget{
+						MinCapacity: 2,
+						MaxCapacity: 6,
+					},
+					Policies: []aas.ScalingPolicy{
+						{
+							Name:        "my-svc-memory",
+							Type:        "TargetTrackingScaling",
+							Metric:      "ECSServiceAverageMemoryUtilization",
+							TargetValue: 80,
+						},
+					},
+				},
+			}
 			backendSvc := &backendSvcDesc{
 				Service:          "my-svc",
 				Type:             "Backend Service",
@@ -433,6 +534,7 @@ Resources
 				Variables:        envVars,
 				Secrets:          secrets,
 				ServiceDiscovery: sds,
+				Scaling:          scalings,
 				Resources:        resources,
 			}
 			human := backendSvc.HumanString()
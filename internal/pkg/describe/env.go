@@ -6,13 +6,18 @@ package describe
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/costexplorer"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -27,7 +32,52 @@ type EnvDescription struct {
 	Services       []*config.Workload  `json:"services"`
 	Tags           map[string]string   `json:"tags,omitempty"`
 	Resources      []*CfnResource      `json:"resources,omitempty"`
+	Routes         []*ListenerRoute    `json:"routes,omitempty"`
 	EnvironmentVPC EnvironmentVPC      `json:"environmentVPC"`
+	ResourceGroup  string              `json:"resourceGroup,omitempty"` // Console link to the Resource Group of the environment.
+	Costs          []*WorkloadCost     `json:"costs,omitempty"`
+}
+
+// WorkloadCost is the unblended cost in USD of the resources of a workload in the environment during the current month.
+// The resources shared by the workloads of the environment, such as the load balancer, have no workload name.
+type WorkloadCost struct {
+	Name        string   `json:"name,omitempty"`
+	MonthToDate float64  `json:"monthToDate"`
+	Forecast    *float64 `json:"forecast,omitempty"` // Cost of the whole month. Nil if Cost Explorer has too little history to forecast it.
+}
+
+// ListenerRoute represents a rule of the environment's load balancer listeners and the service it forwards traffic to.
+type ListenerRoute struct {
+	Listener  string   `json:"listener"`
+	Priority  string   `json:"priority"`
+	Hosts     []string `json:"hosts,omitempty"`
+	Paths     []string `json:"paths,omitempty"`
+	SourceIPs []string `json:"sourceIPs,omitempty"`
+	Service   string   `json:"service,omitempty"`
+}
+
+type costGetter interface {
+	MonthToDateCostByTag(tags map[string]string, groupByKey string) (map[string]float64, error)
+	ForecastedCost(tags map[string]string) (float64, error)
+}
+
+type listenerRulesGetter interface {
+	ListenerRules(listenerARN string) ([]*elbv2.ListenerRule, error)
+	TargetGroupsTags(targetGroupARNs []string) (map[string]map[string]string, error)
+}
+
+// envListener holds the protocol and ARN of a listener of the environment's load balancer.
+type envListener struct {
+	protocol string
+	arn      string
+}
+
+// envStackInfo holds the information read from the environment stack.
+type envStackInfo struct {
+	tags          map[string]string
+	vpc           EnvironmentVPC
+	listeners     []envListener
+	resourceGroup string
 }
 
 // EnvironmentVPC holds the ID of the environment's VPC configuration.
@@ -42,10 +92,14 @@ type EnvDescriber struct {
 	app             string
 	env             *config.Environment
 	enableResources bool
+	enableRoutes    bool
+	enableCosts     bool
 
 	configStore ConfigStoreSvc
 	deployStore DeployedEnvServicesLister
 	cfn         cfn
+	elbv2       listenerRulesGetter
+	costs       costGetter
 }
 
 // NewEnvDescriberConfig contains fields that initiates EnvDescriber struct.
@@ -53,6 +107,8 @@ type NewEnvDescriberConfig struct {
 	App             string
 	Env             string
 	EnableResources bool
+	EnableRoutes    bool
+	EnableCosts     bool
 	ConfigStore     ConfigStoreSvc
 	DeployStore     DeployedEnvServicesLister
 }
@@ -67,15 +123,28 @@ func NewEnvDescriber(opt NewEnvDescriberConfig) (*EnvDescriber, error) {
 	if err != nil {
 		return nil, fmt.Errorf("assume role for environment %s: %w", env.ManagerRoleARN, err)
 	}
-	return &EnvDescriber{
+	d := &EnvDescriber{
 		app:             opt.App,
 		env:             env,
 		enableResources: opt.EnableResources,
+		enableRoutes:    opt.EnableRoutes,
+		enableCosts:     opt.EnableCosts,
 
 		configStore: opt.ConfigStore,
 		deployStore: opt.DeployStore,
 		cfn:         cloudformation.New(sess),
-	}, nil
+		elbv2:       elbv2.New(sess),
+	}
+	if opt.EnableCosts {
+		// Cost Explorer is queried with the credentials of the user rather than the environment manager role,
+		// since billing data is usually only accessible from the account that pays for the environment.
+		defaultSess, err := sessions.NewProvider().Default()
+		if err != nil {
+			return nil, fmt.Errorf("create default session: %w", err)
+		}
+		d.costs = costexplorer.New(defaultSess)
+	}
+	return d, nil
 }
 
 // Describe returns info about an application's environment.
@@ -85,7 +154,7 @@ func (d *EnvDescriber) Describe() (*EnvDescription, error) {
 		return nil, err
 	}
 
-	tags, environmentVPC, err := d.loadStackInfo()
+	info, err := d.loadStackInfo()
 	if err != nil {
 		return nil, err
 	}
@@ -98,12 +167,35 @@ func (d *EnvDescriber) Describe() (*EnvDescription, error) {
 		}
 	}
 
+	var routes []*ListenerRoute
+	if d.enableRoutes {
+		routes, err = d.routes(info.listeners)
+		if err != nil {
+			return nil, fmt.Errorf("retrieve environment routes: %w", err)
+		}
+	}
+
+	var costs []*WorkloadCost
+	if d.enableCosts {
+		costs, err = d.workloadCosts(svcs)
+		if err != nil {
+			return nil, fmt.Errorf("retrieve environment costs: %w", err)
+		}
+	}
+
+	var resourceGroup string
+	if info.resourceGroup != "" {
+		resourceGroup = resourceGroupURL(info.resourceGroup, d.env.Region)
+	}
 	return &EnvDescription{
 		Environment:    d.env,
 		Services:       svcs,
-		Tags:           tags,
+		Tags:           info.tags,
 		Resources:      stackResources,
-		EnvironmentVPC: environmentVPC,
+		Routes:         routes,
+		EnvironmentVPC: info.vpc,
+		ResourceGroup:  resourceGroup,
+		Costs:          costs,
 	}, nil
 }
 
@@ -129,32 +221,44 @@ func (d *EnvDescriber) Version() (string, error) {
 	return metadata.Version, nil
 }
 
-func (d *EnvDescriber) loadStackInfo() (map[string]string, EnvironmentVPC, error) {
-	var environmentVPC EnvironmentVPC
-	tags := make(map[string]string)
+func (d *EnvDescriber) loadStackInfo() (*envStackInfo, error) {
+	info := &envStackInfo{
+		tags: make(map[string]string),
+	}
 
 	envStack, err := d.cfn.Describe(stack.NameForEnv(d.app, d.env.Name))
 	if err != nil {
-		return nil, environmentVPC, fmt.Errorf("retrieve environment stack: %w", err)
+		return nil, fmt.Errorf("retrieve environment stack: %w", err)
 	}
 	for _, tag := range envStack.Tags {
-		tags[*tag.Key] = *tag.Value
+		info.tags[*tag.Key] = *tag.Value
 	}
 
+	var httpListener, httpsListener *envListener
 	for _, out := range envStack.Outputs {
 		value := aws.StringValue(out.OutputValue)
 
 		switch aws.StringValue(out.OutputKey) {
 		case stack.EnvOutputVPCID:
-			environmentVPC.ID = value
+			info.vpc.ID = value
 		case stack.EnvOutputPublicSubnets:
-			environmentVPC.PublicSubnetIDs = strings.Split(value, ",")
+			info.vpc.PublicSubnetIDs = strings.Split(value, ",")
 		case stack.EnvOutputPrivateSubnets:
-			environmentVPC.PrivateSubnetIDs = strings.Split(value, ",")
+			info.vpc.PrivateSubnetIDs = strings.Split(value, ",")
+		case stack.EnvOutputHTTPListenerARN:
+			httpListener = &envListener{protocol: "HTTP", arn: value}
+		case stack.EnvOutputHTTPSListenerARN:
+			httpsListener = &envListener{protocol: "HTTPS", arn: value}
+		case stack.EnvOutputResourceGroup:
+			info.resourceGroup = value
 		}
 	}
-
-	return tags, environmentVPC, nil
+	for _, listener := range []*envListener{httpListener, httpsListener} {
+		if listener != nil && listener.arn != "" {
+			info.listeners = append(info.listeners, *listener)
+		}
+	}
+	return info, nil
 }
 
 func (d *EnvDescriber) filterDeployedSvcs() ([]*config.Workload, error) {
@@ -186,6 +290,115 @@ func (d *EnvDescriber) resources() ([]*CfnResource, error) {
 	return outputs, nil
 }
 
+// routes returns the rules of the environment's listeners sorted by priority, with the default rule last.
+func (d *EnvDescriber) routes(listeners []envListener) ([]*ListenerRoute, error) {
+	var routes []*ListenerRoute
+	var targetGroupARNs []string
+	routeTargetGroups := make(map[*ListenerRoute][]string)
+	for _, listener := range listeners {
+		rules, err := d.elbv2.ListenerRules(listener.arn)
+		if err != nil {
+			return nil, err
+		}
+		sort.SliceStable(rules, func(i, j int) bool {
+			return rulePriority(rules[i]) < rulePriority(rules[j])
+		})
+		for _, rule := range rules {
+			route := &ListenerRoute{
+				Listener:  listener.protocol,
+				Priority:  rule.Priority,
+				Hosts:     rule.HostHeaders,
+				Paths:     rule.PathPatterns,
+				SourceIPs: rule.SourceIPs,
+			}
+			routes = append(routes, route)
+			routeTargetGroups[route] = rule.TargetGroupARNs
+			targetGroupARNs = append(targetGroupARNs, rule.TargetGroupARNs...)
+		}
+	}
+	if len(targetGroupARNs) == 0 {
+		return routes, nil
+	}
+	tags, err := d.elbv2.TargetGroupsTags(targetGroupARNs)
+	if err != nil {
+		return nil, err
+	}
+	for _, route := range routes {
+		for _, arn := range routeTargetGroups[route] {
+			if svc, ok := tags[arn][deploy.ServiceTagKey]; ok {
+				route.Service = svc
+				break
+			}
+		}
+	}
+	return routes, nil
+}
+
+// workloadCosts returns the cost of each service of the environment during the current month, followed by the cost
+// of the workloads that were removed from the environment during the month, and of the resources they share.
+func (d *EnvDescriber) workloadCosts(svcs []*config.Workload) ([]*WorkloadCost, error) {
+	envTags := map[string]string{
+		deploy.AppTagKey: d.app,
+		deploy.EnvTagKey: d.env.Name,
+	}
+	monthToDate, err := d.costs.MonthToDateCostByTag(envTags, deploy.ServiceTagKey)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	deployed := make(map[string]bool)
+	for _, svc := range svcs {
+		names = append(names, svc.Name)
+		deployed[svc.Name] = true
+	}
+	var others []string
+	for name := range monthToDate {
+		if name != "" && !deployed[name] {
+			others = append(others, name)
+		}
+	}
+	sort.Strings(others)
+	names = append(append(names, others...), "")
+
+	var costs []*WorkloadCost
+	for _, name := range names {
+		tags := map[string]string{
+			deploy.AppTagKey:     d.app,
+			deploy.EnvTagKey:     d.env.Name,
+			deploy.ServiceTagKey: name, // Matches the resources without a service tag if empty.
+		}
+		cost := &WorkloadCost{
+			Name:        name,
+			MonthToDate: monthToDate[name],
+		}
+		forecast, err := d.costs.ForecastedCost(tags)
+		var errUnavailable *costexplorer.ErrForecastUnavailable
+		switch {
+		case errors.As(err, &errUnavailable):
+		case err != nil:
+			return nil, err
+		default:
+			total := cost.MonthToDate + forecast
+			cost.Forecast = &total
+		}
+		costs = append(costs, cost)
+	}
+	return costs, nil
+}
+
+// rulePriority returns the numeric priority of a listener rule.
+// The default rule is always evaluated last.
+func rulePriority(rule *elbv2.ListenerRule) int {
+	if rule.IsDefault {
+		return math.MaxInt32
+	}
+	priority, err := strconv.Atoi(rule.Priority)
+	if err != nil {
+		return math.MaxInt32
+	}
+	return priority
+}
+
 // JSONString returns the stringified EnvDescription struct with json format.
 func (e *EnvDescription) JSONString() (string, error) {
 	b, err := json.Marshal(e)
@@ -205,6 +418,9 @@ func (e *EnvDescription) HumanString() string {
 	fmt.Fprintf(writer, "  %s\t%t\n", "Production", e.Environment.Prod)
 	fmt.Fprintf(writer, "  %s\t%s\n", "Region", e.Environment.Region)
 	fmt.Fprintf(writer, "  %s\t%s\n", "Account ID", e.Environment.AccountID)
+	if e.ResourceGroup != "" {
+		fmt.Fprintf(writer, "  %s\t%s\n", "Resource Group", e.ResourceGroup)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nServices\n\n"))
 	writer.Flush()
 	headers := []string{"Name", "Type"}
@@ -240,5 +456,63 @@ func (e *EnvDescription) HumanString() string {
 		}
 	}
 	writer.Flush()
+	if len(e.Routes) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nRoutes\n\n"))
+		writer.Flush()
+		headers := []string{"Listener", "Priority", "Hosts", "Paths", "Service"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, route := range e.Routes {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", route.Listener, route.Priority,
+				valueOrDash(strings.Join(route.Hosts, ", ")), valueOrDash(strings.Join(route.Paths, ", ")), valueOrDash(route.Service))
+		}
+	}
+	writer.Flush()
+	if len(e.Costs) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nCosts\n\n"))
+		writer.Flush()
+		headers := []string{"Service", "Month to date", "Forecast"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		var monthToDate, forecast float64
+		hasForecast := true
+		for _, cost := range e.Costs {
+			name := cost.Name
+			if name == "" {
+				name = "(environment)"
+			}
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", name, dollars(cost.MonthToDate), forecastOrDash(cost.Forecast))
+			monthToDate += cost.MonthToDate
+			if cost.Forecast == nil {
+				hasForecast = false
+				continue
+			}
+			forecast += *cost.Forecast
+		}
+		total := &forecast
+		if !hasForecast {
+			total = nil
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", "Total", dollars(monthToDate), forecastOrDash(total))
+	}
+	writer.Flush()
 	return b.String()
 }
+
+func dollars(amount float64) string {
+	return fmt.Sprintf("$%.2f", amount)
+}
+
+func forecastOrDash(forecast *float64) string {
+	if forecast == nil {
+		return "-"
+	}
+	return dollars(*forecast)
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
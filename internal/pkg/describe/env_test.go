@@ -11,6 +11,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/costexplorer"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
@@ -22,6 +24,8 @@ type envDescriberMocks struct {
 	configStoreSvc *mocks.MockConfigStoreSvc
 	deployStoreSvc *mocks.MockDeployedEnvServicesLister
 	stackDescriber *mocks.Mockcfn
+	elbv2          *mocks.MocklistenerRulesGetter
+	costs          *mocks.MockcostGetter
 }
 
 var wantedResources = []*CfnResource{
@@ -96,8 +100,20 @@ func TestEnvDescriber_Describe(t *testing.T) {
 	}
 	envSvcs := []*config.Workload{testSvc1, testSvc2}
 	mockError := errors.New("some error")
+	listenerOutputs := append([]*awscfn.Output{
+		{
+			OutputKey:   aws.String("HTTPListenerArn"),
+			OutputValue: aws.String("mockHTTPListenerARN"),
+		},
+		{
+			OutputKey:   aws.String("HTTPSListenerArn"),
+			OutputValue: aws.String("mockHTTPSListenerARN"),
+		},
+	}, stackOutputs...)
 	testCases := map[string]struct {
 		shouldOutputResources bool
+		shouldOutputRoutes    bool
+		shouldOutputCosts     bool
 
 		setupMocks func(mocks envDescriberMocks)
 
@@ -182,6 +198,37 @@ func TestEnvDescriber_Describe(t *testing.T) {
 				},
 			},
 		},
+		"success with a resource group": {
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Workload{
+						testSvc1, testSvc2, testSvc3,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.stackDescriber.EXPECT().Describe("testApp-testEnv").Return(&cloudformation.StackDescription{
+						Tags: stackTags,
+						Outputs: append([]*awscfn.Output{
+							{
+								OutputKey:   aws.String("ResourceGroup"),
+								OutputValue: aws.String("testApp-testEnv"),
+							},
+						}, stackOutputs...),
+					}, nil),
+				)
+			},
+			wantedEnv: &EnvDescription{
+				Environment: testEnv,
+				Services:    envSvcs,
+				Tags:        map[string]string{"copilot-application": "testApp", "copilot-environment": "testEnv"},
+				EnvironmentVPC: EnvironmentVPC{
+					ID:               "vpc-012abcd345",
+					PublicSubnetIDs:  []string{"subnet-0789ab", "subnet-0123cd"},
+					PrivateSubnetIDs: []string{"subnet-023ff", "subnet-04af"},
+				},
+				ResourceGroup: "https://console.aws.amazon.com/resource-groups/group/testApp-testEnv?region=us-west-2",
+			},
+		},
 		"success with resources": {
 			shouldOutputResources: true,
 			setupMocks: func(m envDescriberMocks) {
@@ -213,6 +260,158 @@ func TestEnvDescriber_Describe(t *testing.T) {
 				},
 			},
 		},
+		"error if fail to get listener rules": {
+			shouldOutputRoutes: true,
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Workload{
+						testSvc1, testSvc2, testSvc3,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.stackDescriber.EXPECT().Describe("testApp-testEnv").Return(&cloudformation.StackDescription{
+						Tags:    stackTags,
+						Outputs: listenerOutputs,
+					}, nil),
+					m.elbv2.EXPECT().ListenerRules("mockHTTPListenerARN").Return(nil, mockError),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve environment routes: some error"),
+		},
+		"error if fail to get month to date costs": {
+			shouldOutputCosts: true,
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Workload{
+						testSvc1, testSvc2, testSvc3,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.stackDescriber.EXPECT().Describe("testApp-testEnv").Return(&cloudformation.StackDescription{
+						Tags:    stackTags,
+						Outputs: stackOutputs,
+					}, nil),
+					m.costs.EXPECT().MonthToDateCostByTag(map[string]string{
+						deploy.AppTagKey: "testApp",
+						deploy.EnvTagKey: "testEnv",
+					}, deploy.ServiceTagKey).Return(nil, mockError),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve environment costs: some error"),
+		},
+		"success with costs": {
+			shouldOutputCosts: true,
+			setupMocks: func(m envDescriberMocks) {
+				svcTags := func(name string) map[string]string {
+					return map[string]string{
+						deploy.AppTagKey:     "testApp",
+						deploy.EnvTagKey:     "testEnv",
+						deploy.ServiceTagKey: name,
+					}
+				}
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Workload{
+						testSvc1, testSvc2, testSvc3,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.stackDescriber.EXPECT().Describe("testApp-testEnv").Return(&cloudformation.StackDescription{
+						Tags:    stackTags,
+						Outputs: stackOutputs,
+					}, nil),
+					m.costs.EXPECT().MonthToDateCostByTag(gomock.Any(), deploy.ServiceTagKey).Return(map[string]float64{
+						"":         20,
+						"testSvc1": 10,
+						"testSvc3": 1.5,
+					}, nil),
+					m.costs.EXPECT().ForecastedCost(svcTags("testSvc1")).Return(15.0, nil),
+					m.costs.EXPECT().ForecastedCost(svcTags("testSvc2")).Return(0.0, &costexplorer.ErrForecastUnavailable{}),
+					m.costs.EXPECT().ForecastedCost(svcTags("testSvc3")).Return(0.0, nil),
+					m.costs.EXPECT().ForecastedCost(svcTags("")).Return(30.0, nil),
+				)
+			},
+			wantedEnv: &EnvDescription{
+				Environment: testEnv,
+				Services:    []*config.Workload{testSvc1, testSvc2},
+				Tags:        map[string]string{"copilot-application": "testApp", "copilot-environment": "testEnv"},
+				EnvironmentVPC: EnvironmentVPC{
+					ID:               "vpc-012abcd345",
+					PublicSubnetIDs:  []string{"subnet-0789ab", "subnet-0123cd"},
+					PrivateSubnetIDs: []string{"subnet-023ff", "subnet-04af"},
+				},
+				Costs: []*WorkloadCost{
+					{Name: "testSvc1", MonthToDate: 10, Forecast: aws.Float64(25)},
+					{Name: "testSvc2"},
+					{Name: "testSvc3", MonthToDate: 1.5, Forecast: aws.Float64(1.5)},
+					{MonthToDate: 20, Forecast: aws.Float64(50)},
+				},
+			},
+		},
+		"success with routes": {
+			shouldOutputRoutes: true,
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Workload{
+						testSvc1, testSvc2, testSvc3,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.stackDescriber.EXPECT().Describe("testApp-testEnv").Return(&cloudformation.StackDescription{
+						Tags:    stackTags,
+						Outputs: listenerOutputs,
+					}, nil),
+					m.elbv2.EXPECT().ListenerRules("mockHTTPListenerARN").Return([]*elbv2.ListenerRule{
+						{
+							Priority:  "default",
+							IsDefault: true,
+						},
+						{
+							Priority:        "10",
+							PathPatterns:    []string{"/*"},
+							TargetGroupARNs: []string{"mockTargetGroup2"},
+						},
+						{
+							Priority:        "2",
+							PathPatterns:    []string{"/api", "/api/*"},
+							TargetGroupARNs: []string{"mockTargetGroup1"},
+						},
+					}, nil),
+					m.elbv2.EXPECT().ListenerRules("mockHTTPSListenerARN").Return(nil, nil),
+					m.elbv2.EXPECT().TargetGroupsTags([]string{"mockTargetGroup1", "mockTargetGroup2"}).Return(map[string]map[string]string{
+						"mockTargetGroup1": {"copilot-service": "testSvc1"},
+						"mockTargetGroup2": {"copilot-service": "testSvc2"},
+					}, nil),
+				)
+			},
+			wantedEnv: &EnvDescription{
+				Environment: testEnv,
+				Services:    envSvcs,
+				Tags:        map[string]string{"copilot-application": "testApp", "copilot-environment": "testEnv"},
+				Routes: []*ListenerRoute{
+					{
+						Listener: "HTTP",
+						Priority: "2",
+						Paths:    []string{"/api", "/api/*"},
+						Service:  "testSvc1",
+					},
+					{
+						Listener: "HTTP",
+						Priority: "10",
+						Paths:    []string{"/*"},
+						Service:  "testSvc2",
+					},
+					{
+						Listener: "HTTP",
+						Priority: "default",
+					},
+				},
+				EnvironmentVPC: EnvironmentVPC{
+					ID:               "vpc-012abcd345",
+					PublicSubnetIDs:  []string{"subnet-0789ab", "subnet-0123cd"},
+					PrivateSubnetIDs: []string{"subnet-023ff", "subnet-04af"},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -223,10 +422,14 @@ func TestEnvDescriber_Describe(t *testing.T) {
 			mockConfigStoreSvc := mocks.NewMockConfigStoreSvc(ctrl)
 			mockDeployedEnvServicesLister := mocks.NewMockDeployedEnvServicesLister(ctrl)
 			mockCFN := mocks.NewMockcfn(ctrl)
+			mockELBV2 := mocks.NewMocklistenerRulesGetter(ctrl)
+			mockCosts := mocks.NewMockcostGetter(ctrl)
 			mocks := envDescriberMocks{
 				configStoreSvc: mockConfigStoreSvc,
 				deployStoreSvc: mockDeployedEnvServicesLister,
 				stackDescriber: mockCFN,
+				elbv2:          mockELBV2,
+				costs:          mockCosts,
 			}
 
 			tc.setupMocks(mocks)
@@ -235,10 +438,14 @@ func TestEnvDescriber_Describe(t *testing.T) {
 				env:             testEnv,
 				app:             testApp,
 				enableResources: tc.shouldOutputResources,
+				enableRoutes:    tc.shouldOutputRoutes,
+				enableCosts:     tc.shouldOutputCosts,
 
 				configStore: mockConfigStoreSvc,
 				deployStore: mockDeployedEnvServicesLister,
 				cfn:         mockCFN,
+				elbv2:       mockELBV2,
+				costs:       mockCosts,
 			}
 
 			// WHEN
@@ -401,6 +608,7 @@ func TestEnvDescription_HumanString(t *testing.T) {
   Production        false
   Region            us-west-2
   Account ID        123456789012
+  Resource Group    https://console.aws.amazon.com/resource-groups/group/testApp-testEnv?region=us-west-2
 
 Services
 
@@ -421,6 +629,21 @@ Resources
 
   AWS::IAM::Role           testApp-testEnv-CFNExecutionRole
   testApp-testEnv-Cluster  AWS::ECS::Cluster-jI63pYBWU6BZ
+
+Routes
+
+  Listener          Priority            Hosts               Paths               Service
+  --------          --------            -----               -----               -------
+  HTTP              2                   -                   /api, /api/*        testSvc1
+  HTTP              default             -                   -                   -
+
+Costs
+
+  Service           Month to date       Forecast
+  -------           -------------       --------
+  testSvc1          $10.00              $25.00
+  (environment)     $20.50              $50.00
+  Total             $30.50              $75.00
 `
 	// GIVEN
 	ctrl := gomock.NewController(t)
@@ -431,6 +654,23 @@ Resources
 		Services:    allSvcs,
 		Tags:        testApp.Tags,
 		Resources:   wantedResources,
+		Routes: []*ListenerRoute{
+			{
+				Listener: "HTTP",
+				Priority: "2",
+				Paths:    []string{"/api", "/api/*"},
+				Service:  "testSvc1",
+			},
+			{
+				Listener: "HTTP",
+				Priority: "default",
+			},
+		},
+		Costs: []*WorkloadCost{
+			{Name: "testSvc1", MonthToDate: 10, Forecast: aws.Float64(25)},
+			{MonthToDate: 20.5, Forecast: aws.Float64(50)},
+		},
+		ResourceGroup: "https://console.aws.amazon.com/resource-groups/group/testApp-testEnv?region=us-west-2",
 	}
 
 	// WHEN
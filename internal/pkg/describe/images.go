@@ -0,0 +1,227 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	awsECS "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const shortDigestLength = 8
+
+type imageLister interface {
+	ListImages(repoName string) ([]ecr.Image, error)
+}
+
+// ServiceImages retrieves the images pushed to the ECR repositories of a service and where they are deployed.
+type ServiceImages struct {
+	app      string
+	svc      string
+	repoName string
+
+	store            ConfigStoreSvc
+	deployStore      DeployedEnvServicesLister
+	initImageLister  func(region string) (imageLister, error)
+	initSvcDescriber func(env *config.Environment) (serviceDescriber, error)
+}
+
+// NewServiceImagesConfig contains fields that initiates ServiceImages struct.
+type NewServiceImagesConfig struct {
+	App         *config.Application
+	Svc         string
+	ConfigStore ConfigStoreSvc
+	DeployStore DeployedEnvServicesLister
+}
+
+// NewServiceImages instantiates a new ServiceImages struct.
+func NewServiceImages(opt NewServiceImagesConfig) *ServiceImages {
+	provider := sessions.NewProvider()
+	return &ServiceImages{
+		app:         opt.App.Name,
+		svc:         opt.Svc,
+		repoName:    opt.App.RepositoryName(opt.Svc),
+		store:       opt.ConfigStore,
+		deployStore: opt.DeployStore,
+		initImageLister: func(region string) (imageLister, error) {
+			// Repositories live in the application's account, in every region with an environment.
+			sess, err := provider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("session for region %s: %w", region, err)
+			}
+			return ecr.New(sess), nil
+		},
+		initSvcDescriber: func(env *config.Environment) (serviceDescriber, error) {
+			sess, err := provider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return ecs.New(sess), nil
+		},
+	}
+}
+
+// ServiceImagesDesc contains the images of a service.
+type ServiceImagesDesc struct {
+	Service string      `json:"service"`
+	Images  []RepoImage `json:"images"`
+}
+
+// RepoImage is an image in the ECR repository of a service.
+type RepoImage struct {
+	Region     string    `json:"region"`
+	Digest     string    `json:"digest"`
+	Tags       []string  `json:"tags"`
+	PushedAt   time.Time `json:"pushedAt"`
+	DeployedTo []string  `json:"deployedTo"` // Environments whose running tasks use the image.
+}
+
+// Describe returns the images of the service from the most to the least recently pushed in each region.
+func (s *ServiceImages) Describe() (*ServiceImagesDesc, error) {
+	envs, err := s.store.ListEnvironments(s.app)
+	if err != nil {
+		return nil, fmt.Errorf("list environments for application %s: %w", s.app, err)
+	}
+	deployedEnvs, err := s.deployStore.ListEnvironmentsDeployedTo(s.app, s.svc)
+	if err != nil {
+		return nil, fmt.Errorf("list environments that service %s is deployed to: %w", s.svc, err)
+	}
+	isDeployed := make(map[string]bool)
+	for _, env := range deployedEnvs {
+		isDeployed[env] = true
+	}
+	var regions []string
+	hasRegion := make(map[string]bool)
+	deployedTo := make(map[string][]string) // Environments keyed by region and image digest.
+	for _, env := range envs {
+		if !hasRegion[env.Region] {
+			hasRegion[env.Region] = true
+			regions = append(regions, env.Region)
+		}
+		if !isDeployed[env.Name] {
+			continue
+		}
+		digests, err := s.runningDigests(env)
+		if err != nil {
+			return nil, err
+		}
+		for _, digest := range digests {
+			key := imageKey(env.Region, digest)
+			deployedTo[key] = append(deployedTo[key], env.Name)
+		}
+	}
+	desc := &ServiceImagesDesc{
+		Service: s.svc,
+	}
+	for _, region := range regions {
+		lister, err := s.initImageLister(region)
+		if err != nil {
+			return nil, err
+		}
+		images, err := lister.ListImages(s.repoName)
+		if err != nil {
+			return nil, fmt.Errorf("list images in region %s: %w", region, err)
+		}
+		sort.SliceStable(images, func(i, j int) bool {
+			return images[i].PushedAt.After(images[j].PushedAt)
+		})
+		for _, image := range images {
+			desc.Images = append(desc.Images, RepoImage{
+				Region:     region,
+				Digest:     image.Digest,
+				Tags:       image.Tags,
+				PushedAt:   image.PushedAt,
+				DeployedTo: deployedTo[imageKey(region, image.Digest)],
+			})
+		}
+	}
+	return desc, nil
+}
+
+// runningDigests returns the unique image digests of the containers in the running tasks of the service in the environment.
+func (s *ServiceImages) runningDigests(env *config.Environment) ([]string, error) {
+	describer, err := s.initSvcDescriber(env)
+	if err != nil {
+		return nil, err
+	}
+	svcDesc, err := describer.DescribeService(s.app, env.Name, s.svc)
+	if err != nil {
+		return nil, fmt.Errorf("get ECS service description for %s in environment %s: %w", s.svc, env.Name, err)
+	}
+	var digests []string
+	seen := make(map[string]bool)
+	for _, task := range awsECS.FilterRunningTasks(svcDesc.Tasks) {
+		for _, container := range task.Containers {
+			digest := aws.StringValue(container.ImageDigest)
+			if digest == "" || seen[digest] {
+				continue
+			}
+			seen[digest] = true
+			digests = append(digests, digest)
+		}
+	}
+	return digests, nil
+}
+
+func imageKey(region, digest string) string {
+	return fmt.Sprintf("%s/%s", region, digest)
+}
+
+// JSONString returns the stringified ServiceImagesDesc struct with json format.
+func (d *ServiceImagesDesc) JSONString() (string, error) {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return "", fmt.Errorf("marshal images: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified ServiceImagesDesc struct with human readable format.
+func (d *ServiceImagesDesc) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprintf("Images of %s\n\n", d.Service))
+	writer.Flush()
+	if len(d.Images) == 0 {
+		fmt.Fprint(writer, "  No images have been pushed yet.\n")
+		writer.Flush()
+		return b.String()
+	}
+	headers := []string{"Region", "Digest", "Tags", "Pushed", "Deployed To"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, image := range d.Images {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", image.Region, shortDigest(image.Digest),
+			joinOrDash(image.Tags), humanizeTime(image.PushedAt), joinOrDash(image.DeployedTo))
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func shortDigest(digest string) string {
+	digest = strings.TrimPrefix(digest, "sha256:")
+	if len(digest) < shortDigestLength {
+		return digest
+	}
+	return digest[:shortDigestLength]
+}
+
+func joinOrDash(values []string) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,228 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	awsECS "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	ecsDesc "github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type serviceImagesMocks struct {
+	store        *mocks.MockConfigStoreSvc
+	deployStore  *mocks.MockDeployedEnvServicesLister
+	imageLister  *mocks.MockimageLister
+	svcDescriber *mocks.MockserviceDescriber
+}
+
+func TestServiceImages_Describe(t *testing.T) {
+	testEnv := &config.Environment{Name: "test", Region: "us-west-2"}
+	prodEnv := &config.Environment{Name: "prod", Region: "us-west-2"}
+	firstPush := time.Unix(1600000000, 0)
+	secondPush := time.Unix(1600003600, 0)
+	runningTask := func(digest string) *awsECS.Task {
+		return &awsECS.Task{
+			LastStatus: aws.String("RUNNING"),
+			Containers: []*ecs.Container{
+				{
+					ImageDigest: aws.String(digest),
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		setupMocks func(m serviceImagesMocks)
+
+		wantedDesc *ServiceImagesDesc
+		wantedErr  error
+	}{
+		"wraps the error of listing environments": {
+			setupMocks: func(m serviceImagesMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("list environments for application phonetool: some error"),
+		},
+		"wraps the error of describing the ECS service": {
+			setupMocks: func(m serviceImagesMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "frontend").Return([]string{"test"}, nil)
+				m.svcDescriber.EXPECT().DescribeService("phonetool", "test", "frontend").Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("get ECS service description for frontend in environment test: some error"),
+		},
+		"wraps the error of listing images": {
+			setupMocks: func(m serviceImagesMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "frontend").Return(nil, nil)
+				m.imageLister.EXPECT().ListImages("phonetool/frontend").Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("list images in region us-west-2: some error"),
+		},
+		"returns the most recent images first with the environments running them": {
+			setupMocks: func(m serviceImagesMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv, prodEnv}, nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "frontend").Return([]string{"test", "prod"}, nil)
+				m.svcDescriber.EXPECT().DescribeService("phonetool", "test", "frontend").Return(&ecsDesc.ServiceDesc{
+					Tasks: []*awsECS.Task{runningTask("sha256:new"), runningTask("sha256:new")},
+				}, nil)
+				m.svcDescriber.EXPECT().DescribeService("phonetool", "prod", "frontend").Return(&ecsDesc.ServiceDesc{
+					Tasks: []*awsECS.Task{runningTask("sha256:old")},
+				}, nil)
+				m.imageLister.EXPECT().ListImages("phonetool/frontend").Return([]ecr.Image{
+					{
+						Digest:   "sha256:old",
+						Tags:     []string{"v1"},
+						PushedAt: firstPush,
+					},
+					{
+						Digest:   "sha256:new",
+						Tags:     []string{"v2", "latest"},
+						PushedAt: secondPush,
+					},
+				}, nil)
+			},
+			wantedDesc: &ServiceImagesDesc{
+				Service: "frontend",
+				Images: []RepoImage{
+					{
+						Region:     "us-west-2",
+						Digest:     "sha256:new",
+						Tags:       []string{"v2", "latest"},
+						PushedAt:   secondPush,
+						DeployedTo: []string{"test"},
+					},
+					{
+						Region:     "us-west-2",
+						Digest:     "sha256:old",
+						Tags:       []string{"v1"},
+						PushedAt:   firstPush,
+						DeployedTo: []string{"prod"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := serviceImagesMocks{
+				store:        mocks.NewMockConfigStoreSvc(ctrl),
+				deployStore:  mocks.NewMockDeployedEnvServicesLister(ctrl),
+				imageLister:  mocks.NewMockimageLister(ctrl),
+				svcDescriber: mocks.NewMockserviceDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			images := &ServiceImages{
+				app:         "phonetool",
+				svc:         "frontend",
+				repoName:    "phonetool/frontend",
+				store:       m.store,
+				deployStore: m.deployStore,
+				initImageLister: func(region string) (imageLister, error) {
+					return m.imageLister, nil
+				},
+				initSvcDescriber: func(env *config.Environment) (serviceDescriber, error) {
+					return m.svcDescriber, nil
+				},
+			}
+
+			// WHEN
+			desc, err := images.Describe()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDesc, desc)
+		})
+	}
+}
+
+func TestServiceImagesDesc_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2020-01-01T00:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	pushedAt, _ := time.Parse(time.RFC3339, "2019-12-31T22:00:00+00:00")
+
+	testCases := map[string]struct {
+		desc *ServiceImagesDesc
+
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"no images": {
+			desc: &ServiceImagesDesc{
+				Service: "frontend",
+			},
+			wantedHumanString: `Images of frontend
+
+  No images have been pushed yet.
+`,
+			wantedJSONString: "{\"service\":\"frontend\",\"images\":null}\n",
+		},
+		"images": {
+			desc: &ServiceImagesDesc{
+				Service: "frontend",
+				Images: []RepoImage{
+					{
+						Region:     "us-west-2",
+						Digest:     "sha256:1a2b3c4d5e6f",
+						Tags:       []string{"v2", "latest"},
+						PushedAt:   pushedAt,
+						DeployedTo: []string{"test", "prod"},
+					},
+					{
+						Region:   "us-west-2",
+						Digest:   "sha256:abcdef123456",
+						PushedAt: pushedAt,
+					},
+				},
+			},
+			wantedHumanString: `Images of frontend
+
+  Region            Digest              Tags                Pushed              Deployed To
+  ------            ------              ----                ------              -----------
+  us-west-2         1a2b3c4d            v2, latest          2 hours ago         test, prod
+  us-west-2         abcdef12            -                   2 hours ago         -
+`,
+			wantedJSONString: "{\"service\":\"frontend\",\"images\":[{\"region\":\"us-west-2\",\"digest\":\"sha256:1a2b3c4d5e6f\",\"tags\":[\"v2\",\"latest\"],\"pushedAt\":\"2019-12-31T22:00:00Z\",\"deployedTo\":[\"test\",\"prod\"]},{\"region\":\"us-west-2\",\"digest\":\"sha256:abcdef123456\",\"tags\":null,\"pushedAt\":\"2019-12-31T22:00:00Z\",\"deployedTo\":null}]}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			human := tc.desc.HumanString()
+			json, err := tc.desc.JSONString()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHumanString, human)
+			require.Equal(t, tc.wantedJSONString, json)
+		})
+	}
+}
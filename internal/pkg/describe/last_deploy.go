@@ -0,0 +1,306 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	awsECS "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	// Sources of the entries in a deployment timeline.
+	timelineSourceCloudFormation = "CloudFormation"
+	timelineSourceECS            = "ECS"
+	timelineSourceLogs           = "Logs"
+
+	targetGroupResourceType = "AWS::ElasticLoadBalancingV2::TargetGroup"
+	unhealthyTargetState    = "unhealthy"
+
+	lastDeployLogsLimit = 50
+)
+
+type stackEventsGetter interface {
+	Events(stackName string) ([]cloudformation.StackEvent, error)
+	StackResources(name string) ([]*cloudformation.StackResource, error)
+}
+
+type stoppedTasksGetter interface {
+	StoppedServiceTasks(cluster, service string) ([]*awsECS.Task, error)
+}
+
+type targetsHealthGetter interface {
+	TargetsHealth(targetGroupARN string) ([]*elbv2.TargetHealth, error)
+}
+
+type logEventsGetter interface {
+	LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error)
+}
+
+// LastDeployment gathers the events that explain the outcome of the latest deployment of a service.
+type LastDeployment struct {
+	app      string
+	env      string
+	svc      string
+	logGroup string
+
+	cfn          stackEventsGetter
+	svcDescriber serviceDescriber
+	ecsSvc       stoppedTasksGetter
+	elbSvc       targetsHealthGetter
+	logsSvc      logEventsGetter
+}
+
+// NewLastDeploymentConfig contains fields that initiates LastDeployment struct.
+type NewLastDeploymentConfig struct {
+	App         string
+	Env         string
+	Svc         string
+	ConfigStore ConfigStoreSvc
+}
+
+// LastDeploymentEntry is a single event of a deployment timeline.
+type LastDeploymentEntry struct {
+	Time    time.Time `json:"time"`
+	Source  string    `json:"source"`
+	Message string    `json:"message"`
+}
+
+// LastDeploymentDesc contains the timeline of the latest deployment of a service.
+type LastDeploymentDesc struct {
+	Service          string                `json:"service"`
+	Environment      string                `json:"environment"`
+	StartedAt        time.Time             `json:"startedAt"`
+	Status           string                `json:"status"`
+	Timeline         []LastDeploymentEntry `json:"timeline"`
+	UnhealthyTargets []*elbv2.TargetHealth `json:"unhealthyTargets,omitempty"`
+}
+
+// NewLastDeployment instantiates a new LastDeployment struct.
+func NewLastDeployment(opt *NewLastDeploymentConfig) (*LastDeployment, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return &LastDeployment{
+		app:          opt.App,
+		env:          opt.Env,
+		svc:          opt.Svc,
+		logGroup:     env.WorkloadLogGroupName(opt.Svc),
+		cfn:          cloudformation.New(sess),
+		svcDescriber: ecs.New(sess),
+		ecsSvc:       awsECS.New(sess),
+		elbSvc:       elbv2.New(sess),
+		logsSvc:      cloudwatchlogs.New(sess),
+	}, nil
+}
+
+// Describe returns the failed stack events, stopped tasks, unhealthy targets and logs
+// recorded since the latest deployment of the service started.
+func (d *LastDeployment) Describe() (*LastDeploymentDesc, error) {
+	stackName := stack.NameForService(d.app, d.env, d.svc)
+	events, err := d.cfn.Events(stackName)
+	if err != nil {
+		return nil, fmt.Errorf("get events for stack %s: %w", stackName, err)
+	}
+	startedAt, status, timeline, err := stackTimeline(stackName, events)
+	if err != nil {
+		return nil, err
+	}
+
+	svcDesc, err := d.svcDescriber.DescribeService(d.app, d.env, d.svc)
+	if err != nil {
+		return nil, fmt.Errorf("get ECS service description for %s: %w", d.svc, err)
+	}
+	tasks, err := d.ecsSvc.StoppedServiceTasks(svcDesc.ClusterName, svcDesc.Name)
+	if err != nil {
+		return nil, fmt.Errorf("get stopped tasks for service %s: %w", svcDesc.Name, err)
+	}
+	timeline = append(timeline, stoppedTasksTimeline(tasks, startedAt)...)
+
+	logs, err := d.logsSvc.LogEvents(cloudwatchlogs.LogEventsOpts{
+		LogGroup:  d.logGroup,
+		StartTime: aws.Int64(startedAt.UnixNano() / int64(time.Millisecond)),
+		Limit:     aws.Int64(lastDeployLogsLimit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get logs for service %s: %w", d.svc, err)
+	}
+	for _, event := range logs.Events {
+		timeline = append(timeline, LastDeploymentEntry{
+			Time:    time.Unix(0, event.Timestamp*int64(time.Millisecond)),
+			Source:  timelineSourceLogs,
+			Message: strings.TrimSpace(event.Message),
+		})
+	}
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].Time.Before(timeline[j].Time)
+	})
+
+	targets, err := d.unhealthyTargets(stackName)
+	if err != nil {
+		return nil, err
+	}
+	return &LastDeploymentDesc{
+		Service:          d.svc,
+		Environment:      d.env,
+		StartedAt:        startedAt,
+		Status:           status,
+		Timeline:         timeline,
+		UnhealthyTargets: targets,
+	}, nil
+}
+
+// unhealthyTargets returns the targets failing the health check of the service's target group.
+// Services that are not behind a load balancer don't have any targets.
+func (d *LastDeployment) unhealthyTargets(stackName string) ([]*elbv2.TargetHealth, error) {
+	resources, err := d.cfn.StackResources(stackName)
+	if err != nil {
+		return nil, fmt.Errorf("get resources for stack %s: %w", stackName, err)
+	}
+	var unhealthy []*elbv2.TargetHealth
+	for _, resource := range resources {
+		if aws.StringValue(resource.ResourceType) != targetGroupResourceType {
+			continue
+		}
+		targets, err := d.elbSvc.TargetsHealth(aws.StringValue(resource.PhysicalResourceId))
+		if err != nil {
+			return nil, err
+		}
+		for _, target := range targets {
+			if target.State == unhealthyTargetState {
+				unhealthy = append(unhealthy, target)
+			}
+		}
+	}
+	return unhealthy, nil
+}
+
+// stackTimeline finds when the latest update of the stack started, the status of the stack,
+// and the events of the update that failed. The events are expected in chronological order.
+func stackTimeline(stackName string, events []cloudformation.StackEvent) (time.Time, string, []LastDeploymentEntry, error) {
+	start := -1
+	for i, event := range events {
+		if aws.StringValue(event.LogicalResourceId) != stackName {
+			continue
+		}
+		switch aws.StringValue(event.ResourceStatus) {
+		case "CREATE_IN_PROGRESS", "UPDATE_IN_PROGRESS":
+			start = i
+		}
+	}
+	if start == -1 {
+		return time.Time{}, "", nil, fmt.Errorf("no deployment found for stack %s", stackName)
+	}
+	status := aws.StringValue(events[start].ResourceStatus)
+	var timeline []LastDeploymentEntry
+	for _, event := range events[start:] {
+		if aws.StringValue(event.LogicalResourceId) == stackName {
+			status = aws.StringValue(event.ResourceStatus)
+		}
+		if !strings.HasSuffix(aws.StringValue(event.ResourceStatus), "FAILED") {
+			continue
+		}
+		timeline = append(timeline, LastDeploymentEntry{
+			Time:   aws.TimeValue(event.Timestamp),
+			Source: timelineSourceCloudFormation,
+			Message: fmt.Sprintf("%s %s: %s", aws.StringValue(event.LogicalResourceId),
+				aws.StringValue(event.ResourceStatus), aws.StringValue(event.ResourceStatusReason)),
+		})
+	}
+	return aws.TimeValue(events[start].Timestamp), status, timeline, nil
+}
+
+// stoppedTasksTimeline returns why each task stopped after the deployment started, along with the exit code of its containers.
+func stoppedTasksTimeline(tasks []*awsECS.Task, since time.Time) []LastDeploymentEntry {
+	var timeline []LastDeploymentEntry
+	for _, task := range tasks {
+		stoppedAt := aws.TimeValue(task.StoppedAt)
+		if stoppedAt.Before(since) {
+			continue
+		}
+		taskID := aws.StringValue(task.TaskArn)
+		if i := strings.LastIndex(taskID, "/"); i != -1 {
+			taskID = taskID[i+1:]
+		}
+		msg := fmt.Sprintf("task %s stopped: %s", taskID, aws.StringValue(task.StoppedReason))
+		for _, container := range task.Containers {
+			if container.ExitCode == nil && container.Reason == nil {
+				continue
+			}
+			msg += fmt.Sprintf("; container %s", aws.StringValue(container.Name))
+			if container.ExitCode != nil {
+				msg += fmt.Sprintf(" exited with code %d", aws.Int64Value(container.ExitCode))
+			}
+			if container.Reason != nil {
+				msg += fmt.Sprintf(" (%s)", aws.StringValue(container.Reason))
+			}
+		}
+		timeline = append(timeline, LastDeploymentEntry{
+			Time:    stoppedAt,
+			Source:  timelineSourceECS,
+			Message: msg,
+		})
+	}
+	return timeline
+}
+
+// JSONString returns the stringified LastDeploymentDesc struct with json format.
+func (d *LastDeploymentDesc) JSONString() (string, error) {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return "", fmt.Errorf("marshal last deployment: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified LastDeploymentDesc struct with human readable format.
+func (d *LastDeploymentDesc) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Last Deployment\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Service", d.Service)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Environment", d.Environment)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Started At", d.StartedAt.Format(time.RFC3339))
+	fmt.Fprintf(writer, "  %s\t%s\n", "Status", d.Status)
+	fmt.Fprint(writer, color.Bold.Sprint("\nTimeline\n\n"))
+	writer.Flush()
+	headers := []string{"Time", "Source", "Message"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, entry := range d.Timeline {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", entry.Time.Format(time.RFC3339), entry.Source, entry.Message)
+	}
+	if len(d.UnhealthyTargets) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nUnhealthy Targets\n\n"))
+		writer.Flush()
+		headers = []string{"ID", "Port", "Reason", "Description"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, target := range d.UnhealthyTargets {
+			fmt.Fprintf(writer, "  %s\t%d\t%s\t%s\n", target.TargetID, target.Port, target.Reason, target.Description)
+		}
+	}
+	writer.Flush()
+	return b.String()
+}
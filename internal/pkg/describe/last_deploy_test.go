@@ -0,0 +1,294 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	awsECS "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type lastDeploymentMocks struct {
+	cfn          *mocks.MockstackEventsGetter
+	svcDescriber *mocks.MockserviceDescriber
+	ecs          *mocks.MockstoppedTasksGetter
+	elb          *mocks.MocktargetsHealthGetter
+	logs         *mocks.MocklogEventsGetter
+}
+
+func TestLastDeployment_Describe(t *testing.T) {
+	const stackName = "phonetool-test-api"
+	startTime, _ := time.Parse(time.RFC3339, "2021-05-01T11:00:00Z")
+	at := func(minutes int) *time.Time {
+		t := startTime.Add(time.Duration(minutes) * time.Minute)
+		return &t
+	}
+	mockEvents := []cloudformation.StackEvent{
+		{
+			LogicalResourceId: aws.String(stackName),
+			ResourceStatus:    aws.String("CREATE_COMPLETE"),
+			Timestamp:         at(-60),
+		},
+		{
+			LogicalResourceId: aws.String(stackName),
+			ResourceStatus:    aws.String("UPDATE_IN_PROGRESS"),
+			Timestamp:         at(0),
+		},
+		{
+			LogicalResourceId:    aws.String("Service"),
+			ResourceStatus:       aws.String("UPDATE_FAILED"),
+			ResourceStatusReason: aws.String("Service did not stabilize"),
+			Timestamp:            at(10),
+		},
+		{
+			LogicalResourceId: aws.String(stackName),
+			ResourceStatus:    aws.String("UPDATE_ROLLBACK_COMPLETE"),
+			Timestamp:         at(12),
+		},
+	}
+	mockServiceDesc := &ecs.ServiceDesc{
+		ClusterName: "mockCluster",
+		Name:        "mockService",
+	}
+	mockError := errors.New("some error")
+
+	testCases := map[string]struct {
+		setupMocks func(m lastDeploymentMocks)
+
+		wantedDesc  *LastDeploymentDesc
+		wantedError error
+	}{
+		"errors if failed to get stack events": {
+			setupMocks: func(m lastDeploymentMocks) {
+				m.cfn.EXPECT().Events(stackName).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get events for stack phonetool-test-api: some error"),
+		},
+		"errors if the stack was never deployed": {
+			setupMocks: func(m lastDeploymentMocks) {
+				m.cfn.EXPECT().Events(stackName).Return(nil, nil)
+			},
+			wantedError: fmt.Errorf("no deployment found for stack phonetool-test-api"),
+		},
+		"errors if failed to get stopped tasks": {
+			setupMocks: func(m lastDeploymentMocks) {
+				gomock.InOrder(
+					m.cfn.EXPECT().Events(stackName).Return(mockEvents, nil),
+					m.svcDescriber.EXPECT().DescribeService("phonetool", "test", "api").Return(mockServiceDesc, nil),
+					m.ecs.EXPECT().StoppedServiceTasks("mockCluster", "mockService").Return(nil, mockError),
+				)
+			},
+			wantedError: fmt.Errorf("get stopped tasks for service mockService: some error"),
+		},
+		"errors if failed to get logs": {
+			setupMocks: func(m lastDeploymentMocks) {
+				gomock.InOrder(
+					m.cfn.EXPECT().Events(stackName).Return(mockEvents, nil),
+					m.svcDescriber.EXPECT().DescribeService("phonetool", "test", "api").Return(mockServiceDesc, nil),
+					m.ecs.EXPECT().StoppedServiceTasks("mockCluster", "mockService").Return(nil, nil),
+					m.logs.EXPECT().LogEvents(gomock.Any()).Return(nil, mockError),
+				)
+			},
+			wantedError: fmt.Errorf("get logs for service api: some error"),
+		},
+		"returns the timeline of the last deployment": {
+			setupMocks: func(m lastDeploymentMocks) {
+				gomock.InOrder(
+					m.cfn.EXPECT().Events(stackName).Return(mockEvents, nil),
+					m.svcDescriber.EXPECT().DescribeService("phonetool", "test", "api").Return(mockServiceDesc, nil),
+					m.ecs.EXPECT().StoppedServiceTasks("mockCluster", "mockService").Return([]*awsECS.Task{
+						{
+							TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789012:task/mockCluster/old"),
+							StoppedReason: aws.String("Scaling activity initiated by deployment"),
+							StoppedAt:     at(-30),
+						},
+						{
+							TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789012:task/mockCluster/1234"),
+							StoppedReason: aws.String("Essential container in task exited"),
+							StoppedAt:     at(5),
+							Containers: []*awsecs.Container{
+								{
+									Name:     aws.String("api"),
+									ExitCode: aws.Int64(1),
+								},
+							},
+						},
+					}, nil),
+					m.logs.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+						LogGroup:  "/copilot/phonetool-test-api",
+						StartTime: aws.Int64(startTime.UnixNano() / int64(time.Millisecond)),
+						Limit:     aws.Int64(50),
+					}).Return(&cloudwatchlogs.LogEventsOutput{
+						Events: []*cloudwatchlogs.Event{
+							{
+								Message:   "Error: cannot connect to database\n",
+								Timestamp: at(4).UnixNano() / int64(time.Millisecond),
+							},
+						},
+					}, nil),
+					m.cfn.EXPECT().StackResources(stackName).Return([]*cloudformation.StackResource{
+						{
+							ResourceType:       aws.String("AWS::ECS::Service"),
+							PhysicalResourceId: aws.String("mockService"),
+						},
+						{
+							ResourceType:       aws.String("AWS::ElasticLoadBalancingV2::TargetGroup"),
+							PhysicalResourceId: aws.String("mockTargetGroupARN"),
+						},
+					}, nil),
+					m.elb.EXPECT().TargetsHealth("mockTargetGroupARN").Return([]*elbv2.TargetHealth{
+						{
+							TargetID: "10.0.0.12",
+							Port:     80,
+							State:    "unhealthy",
+							Reason:   "Target.Timeout",
+						},
+						{
+							TargetID: "10.0.0.13",
+							Port:     80,
+							State:    "healthy",
+						},
+					}, nil),
+				)
+			},
+			wantedDesc: &LastDeploymentDesc{
+				Service:     "api",
+				Environment: "test",
+				StartedAt:   startTime,
+				Status:      "UPDATE_ROLLBACK_COMPLETE",
+				Timeline: []LastDeploymentEntry{
+					{
+						Time:    *at(4),
+						Source:  "Logs",
+						Message: "Error: cannot connect to database",
+					},
+					{
+						Time:    *at(5),
+						Source:  "ECS",
+						Message: "task 1234 stopped: Essential container in task exited; container api exited with code 1",
+					},
+					{
+						Time:    *at(10),
+						Source:  "CloudFormation",
+						Message: "Service UPDATE_FAILED: Service did not stabilize",
+					},
+				},
+				UnhealthyTargets: []*elbv2.TargetHealth{
+					{
+						TargetID: "10.0.0.12",
+						Port:     80,
+						State:    "unhealthy",
+						Reason:   "Target.Timeout",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := lastDeploymentMocks{
+				cfn:          mocks.NewMockstackEventsGetter(ctrl),
+				svcDescriber: mocks.NewMockserviceDescriber(ctrl),
+				ecs:          mocks.NewMockstoppedTasksGetter(ctrl),
+				elb:          mocks.NewMocktargetsHealthGetter(ctrl),
+				logs:         mocks.NewMocklogEventsGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			d := &LastDeployment{
+				app:          "phonetool",
+				env:          "test",
+				svc:          "api",
+				logGroup:     "/copilot/phonetool-test-api",
+				cfn:          m.cfn,
+				svcDescriber: m.svcDescriber,
+				ecsSvc:       m.ecs,
+				elbSvc:       m.elb,
+				logsSvc:      m.logs,
+			}
+
+			// WHEN
+			got, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			for i := range got.Timeline {
+				got.Timeline[i].Time = got.Timeline[i].Time.UTC()
+			}
+			require.Equal(t, tc.wantedDesc, got)
+		})
+	}
+}
+
+func TestLastDeploymentDesc_String(t *testing.T) {
+	startTime, _ := time.Parse(time.RFC3339, "2021-05-01T11:00:00Z")
+	desc := &LastDeploymentDesc{
+		Service:     "api",
+		Environment: "test",
+		StartedAt:   startTime,
+		Status:      "UPDATE_ROLLBACK_COMPLETE",
+		Timeline: []LastDeploymentEntry{
+			{
+				Time:    startTime.Add(5 * time.Minute),
+				Source:  "ECS",
+				Message: "task 1234 stopped: Essential container in task exited",
+			},
+		},
+		UnhealthyTargets: []*elbv2.TargetHealth{
+			{
+				TargetID: "10.0.0.12",
+				Port:     80,
+				State:    "unhealthy",
+				Reason:   "Target.Timeout",
+			},
+		},
+	}
+	wantedHuman := `Last Deployment
+
+  Service           api
+  Environment       test
+  Started At        2021-05-01T11:00:00Z
+  Status            UPDATE_ROLLBACK_COMPLETE
+
+Timeline
+
+  Time                    Source              Message
+  ----                    ------              -------
+  2021-05-01T11:05:00Z    ECS                 task 1234 stopped: Essential container in task exited
+
+Unhealthy Targets
+
+  ID                Port                Reason              Description
+  --                ----                ------              -----------
+  10.0.0.12         80                  Target.Timeout      
+`
+	wantedJSON := `{"service":"api","environment":"test","startedAt":"2021-05-01T11:00:00Z","status":"UPDATE_ROLLBACK_COMPLETE","timeline":[{"time":"2021-05-01T11:05:00Z","source":"ECS","message":"task 1234 stopped: Essential container in task exited"}],"unhealthyTargets":[{"targetID":"10.0.0.12","port":80,"state":"unhealthy","reason":"Target.Timeout"}]}
+`
+
+	json, err := desc.JSONString()
+	require.NoError(t, err)
+	require.Equal(t, wantedJSON, json)
+	require.Equal(t, wantedHuman, desc.HumanString())
+}
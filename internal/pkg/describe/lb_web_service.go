@@ -19,6 +19,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	copilotecs "github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
@@ -67,10 +68,12 @@ func (s *serviceDiscovery) String() string {
 
 type svcDescriber interface {
 	Params() (map[string]string, error)
+	Outputs() (map[string]string, error)
 	EnvOutputs() (map[string]string, error)
 	EnvVars() ([]*ecs.ContainerEnvVar, error)
 	Secrets() ([]*ecs.ContainerSecret, error)
 	ServiceStackResources() ([]*cloudformation.StackResource, error)
+	Scaling() (*copilotecs.ServiceScaling, error)
 }
 
 // WebServiceDescriber retrieves information about a load balanced web service.
@@ -134,6 +137,8 @@ func (d *WebServiceDescriber) Describe() (HumanJSONStringer, error) {
 	var serviceDiscoveries []*ServiceDiscovery
 	var envVars []*envVar
 	var secrets []*secret
+	var features []*ServiceFeatures
+	var scalings []*copilotecs.ServiceScaling
 	for _, env := range environments {
 		err := d.initServiceDescriber(env)
 		if err != nil {
@@ -169,6 +174,16 @@ func (d *WebServiceDescriber) Describe() (HumanJSONStringer, error) {
 			return nil, fmt.Errorf("retrieve secrets: %w", err)
 		}
 		secrets = append(secrets, flattenSecrets(env, webSvcSecrets)...)
+		svcOutputs, err := d.svcDescriber[env].Outputs()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve service stack outputs: %w", err)
+		}
+		features = appendServiceFeatures(features, env, svcOutputs)
+		scaling, err := d.svcDescriber[env].Scaling()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve service scaling: %w", err)
+		}
+		scalings = append(scalings, scaling)
 	}
 	resources := make(map[string][]*CfnResource)
 	if d.enableResources {
@@ -194,6 +209,8 @@ func (d *WebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		ServiceDiscovery: serviceDiscoveries,
 		Variables:        envVars,
 		Secrets:          secrets,
+		Features:         features,
+		Scaling:          scalings,
 		Resources:        resources,
 	}, nil
 }
@@ -356,6 +373,8 @@ type webSvcDesc struct {
 	ServiceDiscovery serviceDiscoveries `json:"serviceDiscovery"`
 	Variables        envVars            `json:"variables"`
 	Secrets          secrets            `json:"secrets,omitempty"`
+	Features         serviceFeatures    `json:"features,omitempty"`
+	Scaling          serviceScalings    `json:"scaling,omitempty"`
 	Resources        cfnResources       `json:"resources,omitempty"`
 }
 
@@ -380,6 +399,11 @@ func (w *webSvcDesc) HumanString() string {
 	fmt.Fprint(writer, color.Bold.Sprint("\nConfigurations\n\n"))
 	writer.Flush()
 	w.Configurations.humanString(writer)
+	if len(w.Scaling) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nScaling\n\n"))
+		writer.Flush()
+		w.Scaling.humanString(writer)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nRoutes\n\n"))
 	writer.Flush()
 	headers := []string{"Environment", "URL"}
@@ -399,6 +423,16 @@ func (w *webSvcDesc) HumanString() string {
 		writer.Flush()
 		w.Secrets.humanString(writer)
 	}
+	if len(w.Features) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nFeatures\n\n"))
+		writer.Flush()
+		w.Features.humanString(writer)
+	}
+	if w.Scaling.hasAlarms() {
+		fmt.Fprint(writer, color.Bold.Sprint("\nAlarms\n\n"))
+		writer.Flush()
+		w.Scaling.alarmsHumanString(writer)
+	}
 	if len(w.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
 		writer.Flush()
@@ -11,9 +11,12 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	copilotecs "github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -301,11 +304,56 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 							ValueFrom: "SHHHHHHHH",
 						},
 					}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
+					m.svcDescriber.EXPECT().Scaling().Return(&copilotecs.ServiceScaling{Environment: testEnv}, nil),
 					m.svcDescriber.EXPECT().ServiceStackResources().Return(nil, mockErr),
 				)
 			},
 			wantedError: fmt.Errorf("retrieve service resources: some error"),
 		},
+		"return error if fail to retrieve service stack outputs": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceRulePathParamKey:      testSvcPath,
+						stack.LBWebServiceContainerPortParamKey: "80",
+						stack.WorkloadTaskCountParamKey:         "1",
+						stack.WorkloadTaskCPUParamKey:           "256",
+						stack.WorkloadTaskMemoryParamKey:        "512",
+					}, nil),
+					m.svcDescriber.EXPECT().EnvVars().Return(nil, nil),
+					m.svcDescriber.EXPECT().Secrets().Return(nil, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve service stack outputs: some error"),
+		},
+		"return error if fail to retrieve service scaling": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceRulePathParamKey:      testSvcPath,
+						stack.LBWebServiceContainerPortParamKey: "80",
+						stack.WorkloadTaskCountParamKey:         "1",
+						stack.WorkloadTaskCPUParamKey:           "256",
+						stack.WorkloadTaskMemoryParamKey:        "512",
+					}, nil),
+					m.svcDescriber.EXPECT().EnvVars().Return(nil, nil),
+					m.svcDescriber.EXPECT().Secrets().Return(nil, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(nil, nil),
+					m.svcDescriber.EXPECT().Scaling().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve service scaling: some error"),
+		},
 		"success": {
 			shouldOutputResources: true,
 			setupMocks: func(m webSvcDescriberMocks) {
@@ -336,6 +384,12 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 							ValueFrom: "GH_WEBHOOK_SECRET",
 						},
 					}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
+					m.svcDescriber.EXPECT().Scaling().Return(&copilotecs.ServiceScaling{
+						Environment:  testEnv,
+						DesiredCount: 1,
+						RunningCount: 1,
+					}, nil),
 					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
 						envOutputPublicLoadBalancerDNSName: prodEnvLBDNSName,
 					}, nil),
@@ -360,6 +414,15 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 							ValueFrom: "SHHHHHHHH",
 						},
 					}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(map[string]string{
+						"EnabledFeatures": "ecs-managed-tags",
+					}, nil),
+					m.svcDescriber.EXPECT().Scaling().Return(&copilotecs.ServiceScaling{
+						Environment:  prodEnv,
+						DesiredCount: 2,
+						RunningCount: 1,
+						Range:        &aas.ScalableTarget{MinCapacity: 2, MaxCapacity: 10},
+					}, nil),
 					m.svcDescriber.EXPECT().ServiceStackResources().Return([]*cloudformation.StackResource{
 						{
 							ResourceType:       aws.String("AWS::EC2::SecurityGroupIngress"),
@@ -438,6 +501,16 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						ValueFrom:   "SHHHHHHHH",
 					},
 				},
+				Features: []*ServiceFeatures{
+					{
+						Environment: "prod",
+						Features:    []string{"ecs-managed-tags"},
+					},
+				},
+				Scaling: []*copilotecs.ServiceScaling{
+					{Environment: "test", DesiredCount: 1, RunningCount: 1},
+					{Environment: "prod", DesiredCount: 2, RunningCount: 1, Range: &aas.ScalableTarget{MinCapacity: 2, MaxCapacity: 10}},
+				},
 				Resources: map[string][]*CfnResource{
 					"test": {
 						{
@@ -515,6 +588,13 @@ Configurations
   test              1                   0.25                512                 80
   prod              3                   0.5                 1024                5000
 
+Scaling
+
+  Environment       Desired             Running             Range               Policies
+  -----------       -------             -------             -----               --------
+  test              1                   1                   -                   -
+  prod              3                   2                   1-10                CPU 70%
+
 Routes
 
   Environment       URL
@@ -543,6 +623,18 @@ Secrets
   GITHUB_WEBHOOK_SECRET  containerA          test                parameter/GH_WEBHOOK_SECRET
   SOME_OTHER_SECRET      containerB          prod                parameter/SHHHHH
 
+Features
+
+  Environment       Features
+  -----------       --------
+  prod              ecs-managed-tags
+
+Alarms
+
+  Environment       Name                Health
+  -----------       ----                ------
+  prod              my-svc-cpu-high     OK
+
 Resources
 
   test
@@ -551,7 +643,7 @@ Resources
   prod
     AWS::EC2::SecurityGroupIngress  ContainerSecurityGroupIngressFromPublicALB
 `,
-			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Load Balanced Web Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"tasks\":\"1\",\"cpu\":\"256\",\"memory\":\"512\"},{\"environment\":\"prod\",\"port\":\"5000\",\"tasks\":\"3\",\"cpu\":\"512\",\"memory\":\"1024\"}],\"routes\":[{\"environment\":\"test\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend\"},{\"environment\":\"prod\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/backend\"}],\"serviceDiscovery\":[{\"environment\":[\"test\",\"prod\"],\"namespace\":\"http://my-svc.my-app.local:5000\"}],\"variables\":[{\"environment\":\"test\",\"container\":\"containerA\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"},{\"environment\":\"prod\",\"container\":\"containerB\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"prod\",\"container\":\"containerB\",\"name\":\"DIFFERENT_ENV_VAR\",\"value\":\"prod\"}],\"secrets\":[{\"name\":\"GITHUB_WEBHOOK_SECRET\",\"container\":\"containerA\",\"environment\":\"test\",\"valueFrom\":\"GH_WEBHOOK_SECRET\"},{\"name\":\"SOME_OTHER_SECRET\",\"container\":\"containerB\",\"environment\":\"prod\",\"valueFrom\":\"SHHHHH\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
+			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Load Balanced Web Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"tasks\":\"1\",\"cpu\":\"256\",\"memory\":\"512\"},{\"environment\":\"prod\",\"port\":\"5000\",\"tasks\":\"3\",\"cpu\":\"512\",\"memory\":\"1024\"}],\"routes\":[{\"environment\":\"test\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend\"},{\"environment\":\"prod\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/backend\"}],\"serviceDiscovery\":[{\"environment\":[\"test\",\"prod\"],\"namespace\":\"http://my-svc.my-app.local:5000\"}],\"variables\":[{\"environment\":\"test\",\"container\":\"containerA\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"},{\"environment\":\"prod\",\"container\":\"containerB\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"prod\",\"container\":\"containerB\",\"name\":\"DIFFERENT_ENV_VAR\",\"value\":\"prod\"}],\"secrets\":[{\"name\":\"GITHUB_WEBHOOK_SECRET\",\"container\":\"containerA\",\"environment\":\"test\",\"valueFrom\":\"GH_WEBHOOK_SECRET\"},{\"name\":\"SOME_OTHER_SECRET\",\"container\":\"containerB\",\"environment\":\"prod\",\"valueFrom\":\"SHHHHH\"}],\"features\":[{\"environment\":\"prod\",\"features\":[\"ecs-managed-tags\"]}],\"scaling\":[{\"environment\":\"test\",\"desiredCount\":1,\"runningCount\":1},{\"environment\":\"prod\",\"desiredCount\":3,\"runningCount\":2,\"range\":{\"minCapacity\":1,\"maxCapacity\":10},\"policies\":[{\"name\":\"my-svc-cpu\",\"type\":\"TargetTrackingScaling\",\"metric\":\"ECSServiceAverageCPUUtilization\",\"targetValue\":70}],\"alarms\":[{\"arn\":\"\",\"name\":\"my-svc-cpu-high\",\"condition\":\"\",\"status\":\"OK\",\"type\":\"\",\"updatedTimes\":\"0001-01-01T00:00:00Z\"}]}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
 		},
 	}
 
@@ -637,6 +729,42 @@ Resources
 					},
 				},
 			}
+			features := []*ServiceFeatures{
+				{
+					Environment: "prod",
+					Features:    []string{"ecs-managed-tags"},
+				},
+			}
+			scalings := []*copilotecs.ServiceScaling{
+				{
+					Environment:  "test",
+					DesiredCount: 1,
+					RunningCount: 1,
+				},
+				{
+					Environment:  "prod",
+					DesiredCount: 3,
+					RunningCount: 2,
+					Range: &aas.ScalableTarget{
+						MinCapacity: 1,
+						MaxCapacity: 10,
+					},
+					Policies: []aas.ScalingPolicy{
+						{
+							Name:        "my-svc-cpu",
+							Type:        "TargetTrackingScaling",
+							Metric:      "ECSServiceAverageCPUUtilization",
+							TargetValue: 70,
+						},
+					},
+					Alarms: []cloudwatch.AlarmStatus{
+						{
+							Name:   "my-svc-cpu-high",
+							Status: "OK",
+						},
+					},
+				},
+			}
 			webSvc := &webSvcDesc{
 				Service:          "my-svc",
 				Type:             "Load Balanced Web Service",
@@ -646,6 +774,8 @@ Resources
 				Secrets:          secrets,
 				Routes:           routes,
 				ServiceDiscovery: sds,
+				Scaling:          scalings,
+				Features:         features,
 				Resources:        resources,
 			}
 			human := webSvc.HumanString()
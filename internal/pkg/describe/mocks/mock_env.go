@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/env.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	elbv2 "github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockcostGetter is a mock of costGetter interface.
+type MockcostGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockcostGetterMockRecorder
+}
+
+// MockcostGetterMockRecorder is the mock recorder for MockcostGetter.
+type MockcostGetterMockRecorder struct {
+	mock *MockcostGetter
+}
+
+// NewMockcostGetter creates a new mock instance.
+func NewMockcostGetter(ctrl *gomock.Controller) *MockcostGetter {
+	mock := &MockcostGetter{ctrl: ctrl}
+	mock.recorder = &MockcostGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcostGetter) EXPECT() *MockcostGetterMockRecorder {
+	return m.recorder
+}
+
+// ForecastedCost mocks base method.
+func (m *MockcostGetter) ForecastedCost(tags map[string]string) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForecastedCost", tags)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForecastedCost indicates an expected call of ForecastedCost.
+func (mr *MockcostGetterMockRecorder) ForecastedCost(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForecastedCost", reflect.TypeOf((*MockcostGetter)(nil).ForecastedCost), tags)
+}
+
+// MonthToDateCostByTag mocks base method.
+func (m *MockcostGetter) MonthToDateCostByTag(tags map[string]string, groupByKey string) (map[string]float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MonthToDateCostByTag", tags, groupByKey)
+	ret0, _ := ret[0].(map[string]float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MonthToDateCostByTag indicates an expected call of MonthToDateCostByTag.
+func (mr *MockcostGetterMockRecorder) MonthToDateCostByTag(tags, groupByKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MonthToDateCostByTag", reflect.TypeOf((*MockcostGetter)(nil).MonthToDateCostByTag), tags, groupByKey)
+}
+
+// MocklistenerRulesGetter is a mock of listenerRulesGetter interface.
+type MocklistenerRulesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocklistenerRulesGetterMockRecorder
+}
+
+// MocklistenerRulesGetterMockRecorder is the mock recorder for MocklistenerRulesGetter.
+type MocklistenerRulesGetterMockRecorder struct {
+	mock *MocklistenerRulesGetter
+}
+
+// NewMocklistenerRulesGetter creates a new mock instance.
+func NewMocklistenerRulesGetter(ctrl *gomock.Controller) *MocklistenerRulesGetter {
+	mock := &MocklistenerRulesGetter{ctrl: ctrl}
+	mock.recorder = &MocklistenerRulesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklistenerRulesGetter) EXPECT() *MocklistenerRulesGetterMockRecorder {
+	return m.recorder
+}
+
+// ListenerRules mocks base method.
+func (m *MocklistenerRulesGetter) ListenerRules(listenerARN string) ([]*elbv2.ListenerRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenerRules", listenerARN)
+	ret0, _ := ret[0].([]*elbv2.ListenerRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListenerRules indicates an expected call of ListenerRules.
+func (mr *MocklistenerRulesGetterMockRecorder) ListenerRules(listenerARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenerRules", reflect.TypeOf((*MocklistenerRulesGetter)(nil).ListenerRules), listenerARN)
+}
+
+// TargetGroupsTags mocks base method.
+func (m *MocklistenerRulesGetter) TargetGroupsTags(targetGroupARNs []string) (map[string]map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TargetGroupsTags", targetGroupARNs)
+	ret0, _ := ret[0].(map[string]map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TargetGroupsTags indicates an expected call of TargetGroupsTags.
+func (mr *MocklistenerRulesGetterMockRecorder) TargetGroupsTags(targetGroupARNs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetGroupsTags", reflect.TypeOf((*MocklistenerRulesGetter)(nil).TargetGroupsTags), targetGroupARNs)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/images.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	ecr "github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockimageLister is a mock of imageLister interface.
+type MockimageLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageListerMockRecorder
+}
+
+// MockimageListerMockRecorder is the mock recorder for MockimageLister.
+type MockimageListerMockRecorder struct {
+	mock *MockimageLister
+}
+
+// NewMockimageLister creates a new mock instance.
+func NewMockimageLister(ctrl *gomock.Controller) *MockimageLister {
+	mock := &MockimageLister{ctrl: ctrl}
+	mock.recorder = &MockimageListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageLister) EXPECT() *MockimageListerMockRecorder {
+	return m.recorder
+}
+
+// ListImages mocks base method.
+func (m *MockimageLister) ListImages(repoName string) ([]ecr.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImages", repoName)
+	ret0, _ := ret[0].([]ecr.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImages indicates an expected call of ListImages.
+func (mr *MockimageListerMockRecorder) ListImages(repoName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockimageLister)(nil).ListImages), repoName)
+}
@@ -0,0 +1,182 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/last_deploy.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	elbv2 "github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockstackEventsGetter is a mock of stackEventsGetter interface.
+type MockstackEventsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackEventsGetterMockRecorder
+}
+
+// MockstackEventsGetterMockRecorder is the mock recorder for MockstackEventsGetter.
+type MockstackEventsGetterMockRecorder struct {
+	mock *MockstackEventsGetter
+}
+
+// NewMockstackEventsGetter creates a new mock instance.
+func NewMockstackEventsGetter(ctrl *gomock.Controller) *MockstackEventsGetter {
+	mock := &MockstackEventsGetter{ctrl: ctrl}
+	mock.recorder = &MockstackEventsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackEventsGetter) EXPECT() *MockstackEventsGetterMockRecorder {
+	return m.recorder
+}
+
+// Events mocks base method.
+func (m *MockstackEventsGetter) Events(stackName string) ([]cloudformation.StackEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Events", stackName)
+	ret0, _ := ret[0].([]cloudformation.StackEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Events indicates an expected call of Events.
+func (mr *MockstackEventsGetterMockRecorder) Events(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockstackEventsGetter)(nil).Events), stackName)
+}
+
+// StackResources mocks base method.
+func (m *MockstackEventsGetter) StackResources(name string) ([]*cloudformation.StackResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackResources", name)
+	ret0, _ := ret[0].([]*cloudformation.StackResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackResources indicates an expected call of StackResources.
+func (mr *MockstackEventsGetterMockRecorder) StackResources(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*MockstackEventsGetter)(nil).StackResources), name)
+}
+
+// MockstoppedTasksGetter is a mock of stoppedTasksGetter interface.
+type MockstoppedTasksGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockstoppedTasksGetterMockRecorder
+}
+
+// MockstoppedTasksGetterMockRecorder is the mock recorder for MockstoppedTasksGetter.
+type MockstoppedTasksGetterMockRecorder struct {
+	mock *MockstoppedTasksGetter
+}
+
+// NewMockstoppedTasksGetter creates a new mock instance.
+func NewMockstoppedTasksGetter(ctrl *gomock.Controller) *MockstoppedTasksGetter {
+	mock := &MockstoppedTasksGetter{ctrl: ctrl}
+	mock.recorder = &MockstoppedTasksGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstoppedTasksGetter) EXPECT() *MockstoppedTasksGetterMockRecorder {
+	return m.recorder
+}
+
+// StoppedServiceTasks mocks base method.
+func (m *MockstoppedTasksGetter) StoppedServiceTasks(cluster, service string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoppedServiceTasks", cluster, service)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoppedServiceTasks indicates an expected call of StoppedServiceTasks.
+func (mr *MockstoppedTasksGetterMockRecorder) StoppedServiceTasks(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoppedServiceTasks", reflect.TypeOf((*MockstoppedTasksGetter)(nil).StoppedServiceTasks), cluster, service)
+}
+
+// MocktargetsHealthGetter is a mock of targetsHealthGetter interface.
+type MocktargetsHealthGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocktargetsHealthGetterMockRecorder
+}
+
+// MocktargetsHealthGetterMockRecorder is the mock recorder for MocktargetsHealthGetter.
+type MocktargetsHealthGetterMockRecorder struct {
+	mock *MocktargetsHealthGetter
+}
+
+// NewMocktargetsHealthGetter creates a new mock instance.
+func NewMocktargetsHealthGetter(ctrl *gomock.Controller) *MocktargetsHealthGetter {
+	mock := &MocktargetsHealthGetter{ctrl: ctrl}
+	mock.recorder = &MocktargetsHealthGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktargetsHealthGetter) EXPECT() *MocktargetsHealthGetterMockRecorder {
+	return m.recorder
+}
+
+// TargetsHealth mocks base method.
+func (m *MocktargetsHealthGetter) TargetsHealth(targetGroupARN string) ([]*elbv2.TargetHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TargetsHealth", targetGroupARN)
+	ret0, _ := ret[0].([]*elbv2.TargetHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TargetsHealth indicates an expected call of TargetsHealth.
+func (mr *MocktargetsHealthGetterMockRecorder) TargetsHealth(targetGroupARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetsHealth", reflect.TypeOf((*MocktargetsHealthGetter)(nil).TargetsHealth), targetGroupARN)
+}
+
+// MocklogEventsGetter is a mock of logEventsGetter interface.
+type MocklogEventsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogEventsGetterMockRecorder
+}
+
+// MocklogEventsGetterMockRecorder is the mock recorder for MocklogEventsGetter.
+type MocklogEventsGetterMockRecorder struct {
+	mock *MocklogEventsGetter
+}
+
+// NewMocklogEventsGetter creates a new mock instance.
+func NewMocklogEventsGetter(ctrl *gomock.Controller) *MocklogEventsGetter {
+	mock := &MocklogEventsGetter{ctrl: ctrl}
+	mock.recorder = &MocklogEventsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklogEventsGetter) EXPECT() *MocklogEventsGetterMockRecorder {
+	return m.recorder
+}
+
+// LogEvents mocks base method.
+func (m *MocklogEventsGetter) LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogEvents", opts)
+	ret0, _ := ret[0].(*cloudwatchlogs.LogEventsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogEvents indicates an expected call of LogEvents.
+func (mr *MocklogEventsGetterMockRecorder) LogEvents(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogEvents", reflect.TypeOf((*MocklogEventsGetter)(nil).LogEvents), opts)
+}
@@ -9,6 +9,7 @@ import (
 
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	ecs0 "github.com/aws/copilot-cli/internal/pkg/ecs"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -65,6 +66,21 @@ func (mr *MocksvcDescriberMockRecorder) EnvVars() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvVars", reflect.TypeOf((*MocksvcDescriber)(nil).EnvVars))
 }
 
+// Outputs mocks base method.
+func (m *MocksvcDescriber) Outputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Outputs indicates an expected call of Outputs.
+func (mr *MocksvcDescriberMockRecorder) Outputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MocksvcDescriber)(nil).Outputs))
+}
+
 // Params mocks base method.
 func (m *MocksvcDescriber) Params() (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +96,21 @@ func (mr *MocksvcDescriberMockRecorder) Params() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MocksvcDescriber)(nil).Params))
 }
 
+// Scaling mocks base method.
+func (m *MocksvcDescriber) Scaling() (*ecs0.ServiceScaling, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scaling")
+	ret0, _ := ret[0].(*ecs0.ServiceScaling)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Scaling indicates an expected call of Scaling.
+func (mr *MocksvcDescriberMockRecorder) Scaling() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scaling", reflect.TypeOf((*MocksvcDescriber)(nil).Scaling))
+}
+
 // Secrets mocks base method.
 func (m *MocksvcDescriber) Secrets() ([]*ecs.ContainerSecret, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,218 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/quota.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockstackLister is a mock of stackLister interface.
+type MockstackLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackListerMockRecorder
+}
+
+// MockstackListerMockRecorder is the mock recorder for MockstackLister.
+type MockstackListerMockRecorder struct {
+	mock *MockstackLister
+}
+
+// NewMockstackLister creates a new mock instance.
+func NewMockstackLister(ctrl *gomock.Controller) *MockstackLister {
+	mock := &MockstackLister{ctrl: ctrl}
+	mock.recorder = &MockstackListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackLister) EXPECT() *MockstackListerMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockstackLister) Describe(name string) (*cloudformation.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe", name)
+	ret0, _ := ret[0].(*cloudformation.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockstackListerMockRecorder) Describe(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstackLister)(nil).Describe), name)
+}
+
+// ListStacksWithTags mocks base method.
+func (m *MockstackLister) ListStacksWithTags(tags map[string]string) ([]cloudformation.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStacksWithTags", tags)
+	ret0, _ := ret[0].([]cloudformation.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStacksWithTags indicates an expected call of ListStacksWithTags.
+func (mr *MockstackListerMockRecorder) ListStacksWithTags(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStacksWithTags", reflect.TypeOf((*MockstackLister)(nil).ListStacksWithTags), tags)
+}
+
+// MocklistenerRuleCounter is a mock of listenerRuleCounter interface.
+type MocklistenerRuleCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MocklistenerRuleCounterMockRecorder
+}
+
+// MocklistenerRuleCounterMockRecorder is the mock recorder for MocklistenerRuleCounter.
+type MocklistenerRuleCounterMockRecorder struct {
+	mock *MocklistenerRuleCounter
+}
+
+// NewMocklistenerRuleCounter creates a new mock instance.
+func NewMocklistenerRuleCounter(ctrl *gomock.Controller) *MocklistenerRuleCounter {
+	mock := &MocklistenerRuleCounter{ctrl: ctrl}
+	mock.recorder = &MocklistenerRuleCounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklistenerRuleCounter) EXPECT() *MocklistenerRuleCounterMockRecorder {
+	return m.recorder
+}
+
+// ListenerRuleCount mocks base method.
+func (m *MocklistenerRuleCounter) ListenerRuleCount(listenerARN string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenerRuleCount", listenerARN)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListenerRuleCount indicates an expected call of ListenerRuleCount.
+func (mr *MocklistenerRuleCounterMockRecorder) ListenerRuleCount(listenerARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenerRuleCount", reflect.TypeOf((*MocklistenerRuleCounter)(nil).ListenerRuleCount), listenerARN)
+}
+
+// MocknamespaceInstanceCounter is a mock of namespaceInstanceCounter interface.
+type MocknamespaceInstanceCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MocknamespaceInstanceCounterMockRecorder
+}
+
+// MocknamespaceInstanceCounterMockRecorder is the mock recorder for MocknamespaceInstanceCounter.
+type MocknamespaceInstanceCounterMockRecorder struct {
+	mock *MocknamespaceInstanceCounter
+}
+
+// NewMocknamespaceInstanceCounter creates a new mock instance.
+func NewMocknamespaceInstanceCounter(ctrl *gomock.Controller) *MocknamespaceInstanceCounter {
+	mock := &MocknamespaceInstanceCounter{ctrl: ctrl}
+	mock.recorder = &MocknamespaceInstanceCounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocknamespaceInstanceCounter) EXPECT() *MocknamespaceInstanceCounterMockRecorder {
+	return m.recorder
+}
+
+// NamespaceInstanceCount mocks base method.
+func (m *MocknamespaceInstanceCounter) NamespaceInstanceCount(namespaceID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespaceInstanceCount", namespaceID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NamespaceInstanceCount indicates an expected call of NamespaceInstanceCount.
+func (mr *MocknamespaceInstanceCounterMockRecorder) NamespaceInstanceCount(namespaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespaceInstanceCount", reflect.TypeOf((*MocknamespaceInstanceCounter)(nil).NamespaceInstanceCount), namespaceID)
+}
+
+// MockquotaGetter is a mock of quotaGetter interface.
+type MockquotaGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockquotaGetterMockRecorder
+}
+
+// MockquotaGetterMockRecorder is the mock recorder for MockquotaGetter.
+type MockquotaGetterMockRecorder struct {
+	mock *MockquotaGetter
+}
+
+// NewMockquotaGetter creates a new mock instance.
+func NewMockquotaGetter(ctrl *gomock.Controller) *MockquotaGetter {
+	mock := &MockquotaGetter{ctrl: ctrl}
+	mock.recorder = &MockquotaGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockquotaGetter) EXPECT() *MockquotaGetterMockRecorder {
+	return m.recorder
+}
+
+// Value mocks base method.
+func (m *MockquotaGetter) Value(serviceCode, quotaCode string) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Value", serviceCode, quotaCode)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Value indicates an expected call of Value.
+func (mr *MockquotaGetterMockRecorder) Value(serviceCode, quotaCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Value", reflect.TypeOf((*MockquotaGetter)(nil).Value), serviceCode, quotaCode)
+}
+
+// MockresourceUsageGetter is a mock of resourceUsageGetter interface.
+type MockresourceUsageGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockresourceUsageGetterMockRecorder
+}
+
+// MockresourceUsageGetterMockRecorder is the mock recorder for MockresourceUsageGetter.
+type MockresourceUsageGetterMockRecorder struct {
+	mock *MockresourceUsageGetter
+}
+
+// NewMockresourceUsageGetter creates a new mock instance.
+func NewMockresourceUsageGetter(ctrl *gomock.Controller) *MockresourceUsageGetter {
+	mock := &MockresourceUsageGetter{ctrl: ctrl}
+	mock.recorder = &MockresourceUsageGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockresourceUsageGetter) EXPECT() *MockresourceUsageGetterMockRecorder {
+	return m.recorder
+}
+
+// MaxResourceCount mocks base method.
+func (m *MockresourceUsageGetter) MaxResourceCount(service, resource, class string, startTime, endTime time.Time) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaxResourceCount", service, resource, class, startTime, endTime)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MaxResourceCount indicates an expected call of MaxResourceCount.
+func (mr *MockresourceUsageGetterMockRecorder) MaxResourceCount(service, resource, class, startTime, endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaxResourceCount", reflect.TypeOf((*MockresourceUsageGetter)(nil).MaxResourceCount), service, resource, class, startTime, endTime)
+}
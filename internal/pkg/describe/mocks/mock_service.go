@@ -7,6 +7,7 @@ package mocks
 import (
 	reflect "reflect"
 
+	aas "github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	gomock "github.com/golang/mock/gomock"
@@ -35,6 +36,21 @@ func (m *MockecsClient) EXPECT() *MockecsClientMockRecorder {
 	return m.recorder
 }
 
+// ServiceARN mocks base method.
+func (m *MockecsClient) ServiceARN(app, env, svc string) (*ecs.ServiceArn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceARN", app, env, svc)
+	ret0, _ := ret[0].(*ecs.ServiceArn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceARN indicates an expected call of ServiceARN.
+func (mr *MockecsClientMockRecorder) ServiceARN(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceARN", reflect.TypeOf((*MockecsClient)(nil).ServiceARN), app, env, svc)
+}
+
 // TaskDefinition mocks base method.
 func (m *MockecsClient) TaskDefinition(app, env, svc string) (*ecs.TaskDefinition, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +66,59 @@ func (mr *MockecsClientMockRecorder) TaskDefinition(app, env, svc interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MockecsClient)(nil).TaskDefinition), app, env, svc)
 }
 
+// MockautoscalingDescriber is a mock of autoscalingDescriber interface.
+type MockautoscalingDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockautoscalingDescriberMockRecorder
+}
+
+// MockautoscalingDescriberMockRecorder is the mock recorder for MockautoscalingDescriber.
+type MockautoscalingDescriberMockRecorder struct {
+	mock *MockautoscalingDescriber
+}
+
+// NewMockautoscalingDescriber creates a new mock instance.
+func NewMockautoscalingDescriber(ctrl *gomock.Controller) *MockautoscalingDescriber {
+	mock := &MockautoscalingDescriber{ctrl: ctrl}
+	mock.recorder = &MockautoscalingDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockautoscalingDescriber) EXPECT() *MockautoscalingDescriberMockRecorder {
+	return m.recorder
+}
+
+// ECSServiceScalableTarget mocks base method.
+func (m *MockautoscalingDescriber) ECSServiceScalableTarget(cluster, service string) (*aas.ScalableTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSServiceScalableTarget", cluster, service)
+	ret0, _ := ret[0].(*aas.ScalableTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSServiceScalableTarget indicates an expected call of ECSServiceScalableTarget.
+func (mr *MockautoscalingDescriberMockRecorder) ECSServiceScalableTarget(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceScalableTarget", reflect.TypeOf((*MockautoscalingDescriber)(nil).ECSServiceScalableTarget), cluster, service)
+}
+
+// ECSServiceScalingPolicies mocks base method.
+func (m *MockautoscalingDescriber) ECSServiceScalingPolicies(cluster, service string) ([]aas.ScalingPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSServiceScalingPolicies", cluster, service)
+	ret0, _ := ret[0].([]aas.ScalingPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSServiceScalingPolicies indicates an expected call of ECSServiceScalingPolicies.
+func (mr *MockautoscalingDescriberMockRecorder) ECSServiceScalingPolicies(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceScalingPolicies", reflect.TypeOf((*MockautoscalingDescriber)(nil).ECSServiceScalingPolicies), cluster, service)
+}
+
 // MockConfigStoreSvc is a mock of ConfigStoreSvc interface.
 type MockConfigStoreSvc struct {
 	ctrl     *gomock.Controller
@@ -6,10 +6,12 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	cloudwatch "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	ecs0 "github.com/aws/copilot-cli/internal/pkg/ecs"
+	maintenance "github.com/aws/copilot-cli/internal/pkg/maintenance"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -89,6 +91,21 @@ func (m *MockecsServiceGetter) EXPECT() *MockecsServiceGetterMockRecorder {
 	return m.recorder
 }
 
+// ContainerInsightsEnabled mocks base method.
+func (m *MockecsServiceGetter) ContainerInsightsEnabled(clusterName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainerInsightsEnabled", clusterName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainerInsightsEnabled indicates an expected call of ContainerInsightsEnabled.
+func (mr *MockecsServiceGetterMockRecorder) ContainerInsightsEnabled(clusterName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerInsightsEnabled", reflect.TypeOf((*MockecsServiceGetter)(nil).ContainerInsightsEnabled), clusterName)
+}
+
 // Service mocks base method.
 func (m *MockecsServiceGetter) Service(clusterName, serviceName string) (*ecs.Service, error) {
 	m.ctrl.T.Helper()
@@ -119,6 +136,44 @@ func (mr *MockecsServiceGetterMockRecorder) ServiceTasks(clusterName, serviceNam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceTasks", reflect.TypeOf((*MockecsServiceGetter)(nil).ServiceTasks), clusterName, serviceName)
 }
 
+// MockserviceMetricsGetter is a mock of serviceMetricsGetter interface.
+type MockserviceMetricsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceMetricsGetterMockRecorder
+}
+
+// MockserviceMetricsGetterMockRecorder is the mock recorder for MockserviceMetricsGetter.
+type MockserviceMetricsGetterMockRecorder struct {
+	mock *MockserviceMetricsGetter
+}
+
+// NewMockserviceMetricsGetter creates a new mock instance.
+func NewMockserviceMetricsGetter(ctrl *gomock.Controller) *MockserviceMetricsGetter {
+	mock := &MockserviceMetricsGetter{ctrl: ctrl}
+	mock.recorder = &MockserviceMetricsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceMetricsGetter) EXPECT() *MockserviceMetricsGetterMockRecorder {
+	return m.recorder
+}
+
+// ContainerInsightsMetrics mocks base method.
+func (m *MockserviceMetricsGetter) ContainerInsightsMetrics(cluster, service string, startTime, endTime time.Time) (*cloudwatch.ServiceMetrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainerInsightsMetrics", cluster, service, startTime, endTime)
+	ret0, _ := ret[0].(*cloudwatch.ServiceMetrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainerInsightsMetrics indicates an expected call of ContainerInsightsMetrics.
+func (mr *MockserviceMetricsGetterMockRecorder) ContainerInsightsMetrics(cluster, service, startTime, endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerInsightsMetrics", reflect.TypeOf((*MockserviceMetricsGetter)(nil).ContainerInsightsMetrics), cluster, service, startTime, endTime)
+}
+
 // MockserviceDescriber is a mock of serviceDescriber interface.
 type MockserviceDescriber struct {
 	ctrl     *gomock.Controller
@@ -194,3 +249,41 @@ func (mr *MockautoscalingAlarmNamesGetterMockRecorder) ECSServiceAlarmNames(clus
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceAlarmNames", reflect.TypeOf((*MockautoscalingAlarmNamesGetter)(nil).ECSServiceAlarmNames), cluster, service)
 }
+
+// MockmaintenanceGetter is a mock of maintenanceGetter interface.
+type MockmaintenanceGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockmaintenanceGetterMockRecorder
+}
+
+// MockmaintenanceGetterMockRecorder is the mock recorder for MockmaintenanceGetter.
+type MockmaintenanceGetterMockRecorder struct {
+	mock *MockmaintenanceGetter
+}
+
+// NewMockmaintenanceGetter creates a new mock instance.
+func NewMockmaintenanceGetter(ctrl *gomock.Controller) *MockmaintenanceGetter {
+	mock := &MockmaintenanceGetter{ctrl: ctrl}
+	mock.recorder = &MockmaintenanceGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmaintenanceGetter) EXPECT() *MockmaintenanceGetterMockRecorder {
+	return m.recorder
+}
+
+// Status mocks base method.
+func (m *MockmaintenanceGetter) Status(app, env, svc string) (*maintenance.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", app, env, svc)
+	ret0, _ := ret[0].(*maintenance.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockmaintenanceGetterMockRecorder) Status(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockmaintenanceGetter)(nil).Status), app, env, svc)
+}
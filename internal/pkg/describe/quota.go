@@ -0,0 +1,232 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/servicediscovery"
+	"github.com/aws/copilot-cli/internal/pkg/aws/servicequotas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+)
+
+// Names of the quotas that an environment can run into.
+const (
+	QuotaLoadBalancerRules    = "Rules per Application Load Balancer"
+	QuotaCloudMapInstances    = "Instances per Cloud Map namespace"
+	QuotaCloudFormationStacks = "CloudFormation stacks"
+	QuotaFargateOnDemandVCPUs = "Fargate On-Demand vCPUs"
+)
+
+const (
+	// Quotas that Service Quotas doesn't report are compared against their AWS default value.
+	defaultLoadBalancerRules = 100
+	defaultCloudMapInstances = 2000
+
+	cfnServiceCode       = "cloudformation"
+	cfnStackCountQuota   = "L-0485CB21"
+	fargateServiceCode   = "fargate"
+	fargateOnDemandVCPUs = "L-3032A538"
+
+	// fargateUsageWindow is how far back we look for the peak number of Fargate vCPUs in use.
+	fargateUsageWindow = time.Hour
+
+	errCodeAccessDeniedException = "AccessDeniedException"
+
+	// nearQuotaThreshold is the fraction of a quota above which the usage is considered close to exhaustion.
+	nearQuotaThreshold = 0.8
+)
+
+type stackLister interface {
+	Describe(name string) (*cloudformation.StackDescription, error)
+	ListStacksWithTags(tags map[string]string) ([]cloudformation.StackDescription, error)
+}
+
+type listenerRuleCounter interface {
+	ListenerRuleCount(listenerARN string) (int, error)
+}
+
+type namespaceInstanceCounter interface {
+	NamespaceInstanceCount(namespaceID string) (int, error)
+}
+
+type quotaGetter interface {
+	Value(serviceCode, quotaCode string) (float64, error)
+}
+
+type resourceUsageGetter interface {
+	MaxResourceCount(service, resource, class string, startTime, endTime time.Time) (float64, error)
+}
+
+// QuotaUsage is how much of a quota an environment uses.
+type QuotaUsage struct {
+	Environment string  `json:"environment"`
+	Name        string  `json:"name"`
+	Usage       float64 `json:"usage"`
+	Limit       float64 `json:"limit"`
+}
+
+// NearLimit returns true if the usage is close to exhausting the quota.
+func (q *QuotaUsage) NearLimit() bool {
+	return q.Limit > 0 && q.Usage >= nearQuotaThreshold*q.Limit
+}
+
+// String returns the usage against the limit, like "12/100 (12%)".
+func (q *QuotaUsage) String() string {
+	if q.Limit <= 0 {
+		return fmt.Sprintf("%g", q.Usage)
+	}
+	return fmt.Sprintf("%g/%g (%.0f%%)", q.Usage, q.Limit, 100*q.Usage/q.Limit)
+}
+
+// EnvQuotaDescriber retrieves how close an environment is to the quotas of the resources it runs.
+type EnvQuotaDescriber struct {
+	app string
+	env string
+
+	cfn          stackLister
+	ruleCounter  listenerRuleCounter
+	cloudMap     namespaceInstanceCounter
+	quotas       quotaGetter
+	usageMetrics resourceUsageGetter
+
+	now func() time.Time
+}
+
+// NewEnvQuotaDescriber instantiates a quota describer for an environment.
+func NewEnvQuotaDescriber(app string, env *config.Environment) (*EnvQuotaDescriber, error) {
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("assume role for environment %s: %w", env.ManagerRoleARN, err)
+	}
+	return &EnvQuotaDescriber{
+		app: app,
+		env: env.Name,
+
+		cfn:          cloudformation.New(sess),
+		ruleCounter:  elbv2.New(sess),
+		cloudMap:     servicediscovery.New(sess),
+		quotas:       servicequotas.New(sess),
+		usageMetrics: cloudwatch.New(sess),
+		now:          time.Now,
+	}, nil
+}
+
+// Describe returns the usage of each quota that applies to the environment.
+// Quotas that the environment manager role isn't allowed to read, for example in environments that weren't
+// upgraded yet, are left out.
+func (d *EnvQuotaDescriber) Describe() ([]*QuotaUsage, error) {
+	envStack, err := d.cfn.Describe(stack.NameForEnv(d.app, d.env))
+	if err != nil {
+		return nil, fmt.Errorf("retrieve environment stack: %w", err)
+	}
+	var listenerARNs []string
+	var namespaceID string
+	for _, out := range envStack.Outputs {
+		value := aws.StringValue(out.OutputValue)
+		switch aws.StringValue(out.OutputKey) {
+		case stack.EnvOutputHTTPListenerARN, stack.EnvOutputHTTPSListenerARN:
+			listenerARNs = append(listenerARNs, value)
+		case stack.EnvOutputServiceDiscoveryNamespaceID:
+			namespaceID = value
+		}
+	}
+
+	var usages []*QuotaUsage
+	for _, describeQuota := range []func() (*QuotaUsage, error){
+		func() (*QuotaUsage, error) { return d.loadBalancerRules(listenerARNs) },
+		func() (*QuotaUsage, error) { return d.cloudMapInstances(namespaceID) },
+		d.cloudFormationStacks,
+		d.fargateVCPUs,
+	} {
+		usage, err := describeQuota()
+		if err != nil {
+			if isAccessDenied(err) {
+				continue
+			}
+			return nil, err
+		}
+		if usage != nil {
+			usages = append(usages, usage)
+		}
+	}
+	return usages, nil
+}
+
+func (d *EnvQuotaDescriber) loadBalancerRules(listenerARNs []string) (*QuotaUsage, error) {
+	if len(listenerARNs) == 0 {
+		return nil, nil
+	}
+	var rules int
+	for _, arn := range listenerARNs {
+		count, err := d.ruleCounter.ListenerRuleCount(arn)
+		if err != nil {
+			return nil, err
+		}
+		rules += count
+	}
+	return d.usage(QuotaLoadBalancerRules, float64(rules), defaultLoadBalancerRules), nil
+}
+
+func (d *EnvQuotaDescriber) cloudMapInstances(namespaceID string) (*QuotaUsage, error) {
+	if namespaceID == "" {
+		return nil, nil
+	}
+	count, err := d.cloudMap.NamespaceInstanceCount(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	return d.usage(QuotaCloudMapInstances, float64(count), defaultCloudMapInstances), nil
+}
+
+func (d *EnvQuotaDescriber) cloudFormationStacks() (*QuotaUsage, error) {
+	stacks, err := d.cfn.ListStacksWithTags(nil)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := d.quotas.Value(cfnServiceCode, cfnStackCountQuota)
+	if err != nil {
+		return nil, err
+	}
+	return d.usage(QuotaCloudFormationStacks, float64(len(stacks)), limit), nil
+}
+
+func (d *EnvQuotaDescriber) fargateVCPUs() (*QuotaUsage, error) {
+	endTime := d.now()
+	vCPUs, err := d.usageMetrics.MaxResourceCount("Fargate", "vCPU", "Standard/OnDemand", endTime.Add(-fargateUsageWindow), endTime)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := d.quotas.Value(fargateServiceCode, fargateOnDemandVCPUs)
+	if err != nil {
+		return nil, err
+	}
+	return d.usage(QuotaFargateOnDemandVCPUs, vCPUs, limit), nil
+}
+
+func (d *EnvQuotaDescriber) usage(name string, usage, limit float64) *QuotaUsage {
+	return &QuotaUsage{
+		Environment: d.env,
+		Name:        name,
+		Usage:       usage,
+		Limit:       limit,
+	}
+}
+
+func isAccessDenied(err error) bool {
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
+		return false
+	}
+	return aerr.Code() == errCodeAccessDenied || aerr.Code() == errCodeAccessDeniedException
+}
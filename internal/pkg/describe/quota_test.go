@@ -0,0 +1,168 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type envQuotaDescriberMocks struct {
+	cfn          *mocks.MockstackLister
+	ruleCounter  *mocks.MocklistenerRuleCounter
+	cloudMap     *mocks.MocknamespaceInstanceCounter
+	quotas       *mocks.MockquotaGetter
+	usageMetrics *mocks.MockresourceUsageGetter
+}
+
+func TestEnvQuotaDescriber_Describe(t *testing.T) {
+	now, _ := time.Parse(time.RFC3339, "2021-05-01T11:00:00+00:00")
+	envStack := &cloudformation.StackDescription{
+		Outputs: []*awscfn.Output{
+			{
+				OutputKey:   aws.String("HTTPListenerArn"),
+				OutputValue: aws.String("httpListener"),
+			},
+			{
+				OutputKey:   aws.String("HTTPSListenerArn"),
+				OutputValue: aws.String("httpsListener"),
+			},
+			{
+				OutputKey:   aws.String("ServiceDiscoveryNamespaceID"),
+				OutputValue: aws.String("ns-1234"),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m envQuotaDescriberMocks)
+
+		wanted    []*QuotaUsage
+		wantedErr error
+	}{
+		"wraps the error if the environment stack can't be described": {
+			setupMocks: func(m envQuotaDescriberMocks) {
+				m.cfn.EXPECT().Describe("phonetool-test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("retrieve environment stack: some error"),
+		},
+		"returns the error if a quota can't be retrieved": {
+			setupMocks: func(m envQuotaDescriberMocks) {
+				m.cfn.EXPECT().Describe("phonetool-test").Return(envStack, nil)
+				m.ruleCounter.EXPECT().ListenerRuleCount("httpListener").Return(0, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"returns the usage of every quota": {
+			setupMocks: func(m envQuotaDescriberMocks) {
+				m.cfn.EXPECT().Describe("phonetool-test").Return(envStack, nil)
+				m.ruleCounter.EXPECT().ListenerRuleCount("httpListener").Return(40, nil)
+				m.ruleCounter.EXPECT().ListenerRuleCount("httpsListener").Return(45, nil)
+				m.cloudMap.EXPECT().NamespaceInstanceCount("ns-1234").Return(12, nil)
+				m.cfn.EXPECT().ListStacksWithTags(nil).Return(make([]cloudformation.StackDescription, 5), nil)
+				m.quotas.EXPECT().Value("cloudformation", "L-0485CB21").Return(float64(2000), nil)
+				m.usageMetrics.EXPECT().MaxResourceCount("Fargate", "vCPU", "Standard/OnDemand", now.Add(-time.Hour), now).Return(float64(6), nil)
+				m.quotas.EXPECT().Value("fargate", "L-3032A538").Return(float64(6), nil)
+			},
+			wanted: []*QuotaUsage{
+				{Environment: "test", Name: QuotaLoadBalancerRules, Usage: 85, Limit: 100},
+				{Environment: "test", Name: QuotaCloudMapInstances, Usage: 12, Limit: 2000},
+				{Environment: "test", Name: QuotaCloudFormationStacks, Usage: 5, Limit: 2000},
+				{Environment: "test", Name: QuotaFargateOnDemandVCPUs, Usage: 6, Limit: 6},
+			},
+		},
+		"skips the quotas that the environment manager role can't read": {
+			setupMocks: func(m envQuotaDescriberMocks) {
+				accessDenied := awserr.New("AccessDeniedException", "not authorized", nil)
+				m.cfn.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{}, nil)
+				m.cfn.EXPECT().ListStacksWithTags(nil).Return(nil, nil)
+				m.quotas.EXPECT().Value("cloudformation", "L-0485CB21").Return(float64(0), fmt.Errorf("get quota: %w", accessDenied))
+				m.usageMetrics.EXPECT().MaxResourceCount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(float64(0), awserr.New("AccessDenied", "not authorized", nil))
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := envQuotaDescriberMocks{
+				cfn:          mocks.NewMockstackLister(ctrl),
+				ruleCounter:  mocks.NewMocklistenerRuleCounter(ctrl),
+				cloudMap:     mocks.NewMocknamespaceInstanceCounter(ctrl),
+				quotas:       mocks.NewMockquotaGetter(ctrl),
+				usageMetrics: mocks.NewMockresourceUsageGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &EnvQuotaDescriber{
+				app:          "phonetool",
+				env:          "test",
+				cfn:          m.cfn,
+				ruleCounter:  m.ruleCounter,
+				cloudMap:     m.cloudMap,
+				quotas:       m.quotas,
+				usageMetrics: m.usageMetrics,
+				now: func() time.Time {
+					return now
+				},
+			}
+
+			// WHEN
+			got, err := d.Describe()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestQuotaUsage(t *testing.T) {
+	testCases := map[string]struct {
+		in *QuotaUsage
+
+		wantedNearLimit bool
+		wantedString    string
+	}{
+		"well within the limit": {
+			in:           &QuotaUsage{Usage: 12, Limit: 100},
+			wantedString: "12/100 (12%)",
+		},
+		"close to the limit": {
+			in:              &QuotaUsage{Usage: 1700, Limit: 2000},
+			wantedNearLimit: true,
+			wantedString:    "1700/2000 (85%)",
+		},
+		"fractional usage": {
+			in:           &QuotaUsage{Usage: 0.5, Limit: 6},
+			wantedString: "0.5/6 (8%)",
+		},
+		"unknown limit": {
+			in:           &QuotaUsage{Usage: 3},
+			wantedString: "3",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedNearLimit, tc.in.NearLimit())
+			require.Equal(t, tc.wantedString, tc.in.String())
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+)
+
+const fmtResourceGroupURL = "https://console.aws.amazon.com/resource-groups/group/%s?region=%s"
+
+type resourceGetter interface {
+	GetResourcesByTags(resourceType string, tags map[string]string) ([]*resourcegroups.Resource, error)
+}
+
+// ResourceGroupDescriber retrieves the member resources of the resource group of an application or environment.
+type ResourceGroupDescriber struct {
+	name   string
+	region string
+	tags   map[string]string // Tags that the members of the group are queried by.
+	client resourceGetter
+}
+
+// NewAppResourceGroupDescriber instantiates a describer for the resource group of the application
+// in the region of the default session.
+func NewAppResourceGroupDescriber(app string) (*ResourceGroupDescriber, error) {
+	sess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	return &ResourceGroupDescriber{
+		name:   stack.NameForAppStack(app),
+		region: aws.StringValue(sess.Config.Region),
+		tags: map[string]string{
+			deploy.AppTagKey: app,
+		},
+		client: resourcegroups.New(sess),
+	}, nil
+}
+
+// NewEnvResourceGroupDescriber instantiates a describer for the resource group of the environment.
+func NewEnvResourceGroupDescriber(env *config.Environment) (*ResourceGroupDescriber, error) {
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("assume role for environment %s: %w", env.ManagerRoleARN, err)
+	}
+	return &ResourceGroupDescriber{
+		name:   stack.NameForEnv(env.App, env.Name),
+		region: env.Region,
+		tags: map[string]string{
+			deploy.AppTagKey: env.App,
+			deploy.EnvTagKey: env.Name,
+		},
+		client: resourcegroups.New(sess),
+	}, nil
+}
+
+// ResourceGroup contains the member resources of a resource group.
+type ResourceGroup struct {
+	Name      string           `json:"name"`
+	Region    string           `json:"region"`
+	Resources []*GroupResource `json:"resources"`
+}
+
+// GroupResource is a member resource of a resource group.
+type GroupResource struct {
+	ARN  string            `json:"arn"`
+	Tags map[string]string `json:"tags"`
+}
+
+// Describe returns the resource group with the resources that match its tag query.
+func (d *ResourceGroupDescriber) Describe() (*ResourceGroup, error) {
+	resources, err := d.client.GetResourcesByTags("", d.tags)
+	if err != nil {
+		return nil, fmt.Errorf("get resources of resource group %s: %w", d.name, err)
+	}
+	group := &ResourceGroup{
+		Name:   d.name,
+		Region: d.region,
+	}
+	for _, resource := range resources {
+		group.Resources = append(group.Resources, &GroupResource{
+			ARN:  resource.ARN,
+			Tags: resource.Tags,
+		})
+	}
+	return group, nil
+}
+
+// JSONString returns the stringified ResourceGroup struct with json format.
+func (g *ResourceGroup) JSONString() (string, error) {
+	b, err := json.Marshal(g)
+	if err != nil {
+		return "", fmt.Errorf("marshal resource group: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// resourceGroupURL returns the link to the resource group in the AWS console.
+func resourceGroupURL(name, region string) string {
+	return fmt.Sprintf(fmtResourceGroupURL, name, region)
+}
@@ -0,0 +1,105 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceGroupDescriber_Describe(t *testing.T) {
+	envTags := map[string]string{
+		"copilot-application": "phonetool",
+		"copilot-environment": "test",
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockresourceGetter)
+
+		wantedGroup *ResourceGroup
+		wantedErr   error
+	}{
+		"wraps the error of getting resources": {
+			setupMocks: func(m *mocks.MockresourceGetter) {
+				m.EXPECT().GetResourcesByTags("", envTags).Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("get resources of resource group phonetool-test: some error"),
+		},
+		"returns the resources of every type with the tags of the group": {
+			setupMocks: func(m *mocks.MockresourceGetter) {
+				m.EXPECT().GetResourcesByTags("", envTags).Return([]*resourcegroups.Resource{
+					{
+						ARN:  "arn:aws:ecs:us-west-2:1234:cluster/phonetool-test-Cluster",
+						Tags: envTags,
+					},
+				}, nil)
+			},
+			wantedGroup: &ResourceGroup{
+				Name:   "phonetool-test",
+				Region: "us-west-2",
+				Resources: []*GroupResource{
+					{
+						ARN:  "arn:aws:ecs:us-west-2:1234:cluster/phonetool-test-Cluster",
+						Tags: envTags,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockresourceGetter(ctrl)
+			tc.setupMocks(m)
+			d := &ResourceGroupDescriber{
+				name:   "phonetool-test",
+				region: "us-west-2",
+				tags:   envTags,
+				client: m,
+			}
+
+			// WHEN
+			group, err := d.Describe()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedGroup, group)
+		})
+	}
+}
+
+func TestResourceGroup_JSONString(t *testing.T) {
+	// GIVEN
+	group := &ResourceGroup{
+		Name:   "phonetool-test",
+		Region: "us-west-2",
+		Resources: []*GroupResource{
+			{
+				ARN: "arn:aws:ecs:us-west-2:1234:cluster/phonetool-test-Cluster",
+				Tags: map[string]string{
+					"copilot-application": "phonetool",
+				},
+			},
+		},
+	}
+
+	// WHEN
+	out, err := group.JSONString()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "{\"name\":\"phonetool-test\",\"region\":\"us-west-2\",\"resources\":[{\"arn\":\"arn:aws:ecs:us-west-2:1234:cluster/phonetool-test-Cluster\",\"tags\":{\"copilot-application\":\"phonetool\"}}]}\n", out)
+}
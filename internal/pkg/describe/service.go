@@ -13,9 +13,12 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 )
 
@@ -26,8 +29,18 @@ const (
 	waitConditionHandle  = "AWS::CloudFormation::WaitConditionHandle"
 )
 
+const (
+	svcOutputEnabledFeatures = "EnabledFeatures"
+)
+
 type ecsClient interface {
 	TaskDefinition(app, env, svc string) (*awsecs.TaskDefinition, error)
+	ServiceARN(app, env, svc string) (*awsecs.ServiceArn, error)
+}
+
+type autoscalingDescriber interface {
+	ECSServiceScalableTarget(cluster, service string) (*aas.ScalableTarget, error)
+	ECSServiceScalingPolicies(cluster, service string) ([]aas.ScalingPolicy, error)
 }
 
 // ConfigStoreSvc wraps methods of config store.
@@ -54,6 +67,93 @@ type ServiceConfig struct {
 
 type configurations []*ServiceConfig
 
+// ServiceFeatures contains the template features that a service opted into in an environment.
+type ServiceFeatures struct {
+	Environment string   `json:"environment"`
+	Features    []string `json:"features"`
+}
+
+type serviceFeatures []*ServiceFeatures
+
+func (f serviceFeatures) humanString(w io.Writer) {
+	headers := []string{"Environment", "Features"}
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, feature := range f {
+		fmt.Fprintf(w, "  %s\t%s\n", feature.Environment, strings.Join(feature.Features, ", "))
+	}
+}
+
+// appendServiceFeatures appends the features enabled in the environment, if any, read from the service stack outputs.
+func appendServiceFeatures(features []*ServiceFeatures, env string, svcOutputs map[string]string) []*ServiceFeatures {
+	enabled, ok := svcOutputs[svcOutputEnabledFeatures]
+	if !ok || enabled == "" {
+		return features
+	}
+	return append(features, &ServiceFeatures{
+		Environment: env,
+		Features:    strings.Split(enabled, ","),
+	})
+}
+
+type serviceScalings []*ecs.ServiceScaling
+
+func (s serviceScalings) humanString(w io.Writer) {
+	headers := []string{"Environment", "Desired", "Running", "Range", "Policies"}
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, scaling := range s {
+		capacityRange, policies := "-", "-"
+		if scaling.Range != nil {
+			capacityRange = fmt.Sprintf("%d-%d", scaling.Range.MinCapacity, scaling.Range.MaxCapacity)
+		}
+		if len(scaling.Policies) != 0 {
+			var summaries []string
+			for _, policy := range scaling.Policies {
+				summaries = append(summaries, scalingPolicySummary(policy))
+			}
+			policies = strings.Join(summaries, ", ")
+		}
+		fmt.Fprintf(w, "  %s\t%d\t%d\t%s\t%s\n", scaling.Environment, scaling.DesiredCount, scaling.RunningCount, capacityRange, policies)
+	}
+}
+
+func (s serviceScalings) hasAlarms() bool {
+	for _, scaling := range s {
+		if len(scaling.Alarms) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (s serviceScalings) alarmsHumanString(w io.Writer) {
+	headers := []string{"Environment", "Name", "Health"}
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, scaling := range s {
+		for _, alarm := range scaling.Alarms {
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", scaling.Environment, alarm.Name, alarmHealthColor(alarm.Status))
+		}
+	}
+}
+
+// scalingPolicySummary returns a short description of what the policy tracks, such as "CPU 70%".
+func scalingPolicySummary(policy aas.ScalingPolicy) string {
+	switch policy.Metric {
+	case "ECSServiceAverageCPUUtilization":
+		return fmt.Sprintf("CPU %g%%", policy.TargetValue)
+	case "ECSServiceAverageMemoryUtilization":
+		return fmt.Sprintf("Memory %g%%", policy.TargetValue)
+	case "ALBRequestCountPerTarget":
+		return fmt.Sprintf("Requests %g/target", policy.TargetValue)
+	case "":
+		return policy.Name
+	default:
+		return fmt.Sprintf("%s %g", policy.Metric, policy.TargetValue)
+	}
+}
+
 func (c configurations) humanString(w io.Writer) {
 	headers := []string{"Environment", "Tasks", "CPU (vCPU)", "Memory (MiB)", "Port"}
 	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
@@ -71,6 +171,9 @@ type ServiceDescriber struct {
 
 	cfn       cfn
 	ecsClient ecsClient
+	ecsSvc    ecsServiceGetter
+	aas       autoscalingDescriber
+	cw        alarmStatusGetter
 }
 
 // NewServiceConfig contains fields that initiates ServiceDescriber struct.
@@ -98,6 +201,66 @@ func NewServiceDescriber(opt NewServiceConfig) (*ServiceDescriber, error) {
 
 		cfn:       cloudformation.New(sess),
 		ecsClient: ecs.New(sess),
+		ecsSvc:    awsecs.New(sess),
+		aas:       aas.New(sess),
+		cw:        cloudwatch.New(sess),
+	}, nil
+}
+
+// Scaling returns the desired and running task counts of the service, its autoscaling range and policies,
+// and the state of the CloudWatch alarms created by Copilot for the service.
+func (d *ServiceDescriber) Scaling() (*ecs.ServiceScaling, error) {
+	svcARN, err := d.ecsClient.ServiceARN(d.app, d.env, d.service)
+	if err != nil {
+		return nil, fmt.Errorf("get ECS service ARN for service %s: %w", d.service, err)
+	}
+	cluster, err := svcARN.ClusterName()
+	if err != nil {
+		return nil, fmt.Errorf("get cluster name: %w", err)
+	}
+	name, err := svcARN.ServiceName()
+	if err != nil {
+		return nil, fmt.Errorf("get service name: %w", err)
+	}
+	svc, err := d.ecsSvc.Service(cluster, name)
+	if err != nil {
+		return nil, fmt.Errorf("get service %s: %w", name, err)
+	}
+	target, err := d.aas.ECSServiceScalableTarget(cluster, name)
+	if err != nil {
+		return nil, err
+	}
+	policies, err := d.aas.ECSServiceScalingPolicies(cluster, name)
+	if err != nil {
+		return nil, err
+	}
+	alarms, err := d.cw.AlarmsWithTags(map[string]string{
+		deploy.AppTagKey:     d.app,
+		deploy.EnvTagKey:     d.env,
+		deploy.ServiceTagKey: d.service,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get tagged CloudWatch alarms: %w", err)
+	}
+	var policyAlarms []string
+	for _, policy := range policies {
+		policyAlarms = append(policyAlarms, policy.Alarms...)
+	}
+	if len(policyAlarms) != 0 {
+		// The alarms of target tracking policies are created by Application Auto Scaling, so they aren't tagged.
+		statuses, err := d.cw.AlarmStatus(policyAlarms)
+		if err != nil {
+			return nil, fmt.Errorf("get auto scaling CloudWatch alarms: %w", err)
+		}
+		alarms = append(alarms, statuses...)
+	}
+	return &ecs.ServiceScaling{
+		Environment:  d.env,
+		DesiredCount: aws.Int64Value(svc.DesiredCount),
+		RunningCount: aws.Int64Value(svc.RunningCount),
+		Range:        target,
+		Policies:     policies,
+		Alarms:       alarms,
 	}, nil
 }
 
@@ -154,6 +317,19 @@ func (d *ServiceDescriber) EnvOutputs() (map[string]string, error) {
 	return outputs, nil
 }
 
+// Outputs returns the outputs of the service stack.
+func (d *ServiceDescriber) Outputs() (map[string]string, error) {
+	svcStack, err := d.cfn.Describe(stack.NameForService(d.app, d.env, d.service))
+	if err != nil {
+		return nil, err
+	}
+	outputs := make(map[string]string)
+	for _, out := range svcStack.Outputs {
+		outputs[*out.OutputKey] = *out.OutputValue
+	}
+	return outputs, nil
+}
+
 // Params returns the parameters of the service stack.
 func (d *ServiceDescriber) Params() (map[string]string, error) {
 	svcStack, err := d.cfn.Describe(stack.NameForService(d.app, d.env, d.service))
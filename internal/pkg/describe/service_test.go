@@ -11,11 +11,14 @@ import (
 	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	copilotecs "github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -23,6 +26,9 @@ import (
 type svcDescriberMocks struct {
 	mockCFN       *mocks.Mockcfn
 	mockECSClient *mocks.MockecsClient
+	mockECSSvc    *mocks.MockecsServiceGetter
+	mockAAS       *mocks.MockautoscalingDescriber
+	mockCW        *mocks.MockalarmStatusGetter
 }
 
 func TestServiceDescriber_EnvVars(t *testing.T) {
@@ -297,3 +303,217 @@ func TestServiceDescriber_ServiceStackResources(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceDescriber_Scaling(t *testing.T) {
+	const (
+		testApp     = "phonetool"
+		testSvc     = "svc"
+		testEnv     = "test"
+		testCluster = "phonetool-test-Cluster"
+		testECSSvc  = "phonetool-test-svc-Service"
+	)
+	testSvcARN := awsecs.ServiceArn("arn:aws:ecs:us-west-2:1234567890:service/phonetool-test-Cluster/phonetool-test-svc-Service")
+	testTags := map[string]string{
+		"copilot-application": testApp,
+		"copilot-environment": testEnv,
+		"copilot-service":     testSvc,
+	}
+	testCases := map[string]struct {
+		setupMocks func(mocks svcDescriberMocks)
+
+		wantedScaling *copilotecs.ServiceScaling
+		wantedError   error
+	}{
+		"returns error if fails to get service ARN": {
+			setupMocks: func(m svcDescriberMocks) {
+				m.mockECSClient.EXPECT().ServiceARN(testApp, testEnv, testSvc).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get ECS service ARN for service svc: some error"),
+		},
+		"returns error if fails to get ECS service": {
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockECSClient.EXPECT().ServiceARN(testApp, testEnv, testSvc).Return(&testSvcARN, nil),
+					m.mockECSSvc.EXPECT().Service(testCluster, testECSSvc).Return(nil, errors.New("some error")),
+				)
+			},
+
+			wantedError: errors.New("get service phonetool-test-svc-Service: some error"),
+		},
+		"returns error if fails to get scalable target": {
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockECSClient.EXPECT().ServiceARN(testApp, testEnv, testSvc).Return(&testSvcARN, nil),
+					m.mockECSSvc.EXPECT().Service(testCluster, testECSSvc).Return(&awsecs.Service{}, nil),
+					m.mockAAS.EXPECT().ECSServiceScalableTarget(testCluster, testECSSvc).Return(nil, errors.New("some error")),
+				)
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns error if fails to get tagged alarms": {
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockECSClient.EXPECT().ServiceARN(testApp, testEnv, testSvc).Return(&testSvcARN, nil),
+					m.mockECSSvc.EXPECT().Service(testCluster, testECSSvc).Return(&awsecs.Service{}, nil),
+					m.mockAAS.EXPECT().ECSServiceScalableTarget(testCluster, testECSSvc).Return(nil, nil),
+					m.mockAAS.EXPECT().ECSServiceScalingPolicies(testCluster, testECSSvc).Return(nil, nil),
+					m.mockCW.EXPECT().AlarmsWithTags(testTags).Return(nil, errors.New("some error")),
+				)
+			},
+
+			wantedError: errors.New("get tagged CloudWatch alarms: some error"),
+		},
+		"returns error if fails to get the status of the autoscaling alarms": {
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockECSClient.EXPECT().ServiceARN(testApp, testEnv, testSvc).Return(&testSvcARN, nil),
+					m.mockECSSvc.EXPECT().Service(testCluster, testECSSvc).Return(&awsecs.Service{}, nil),
+					m.mockAAS.EXPECT().ECSServiceScalableTarget(testCluster, testECSSvc).Return(nil, nil),
+					m.mockAAS.EXPECT().ECSServiceScalingPolicies(testCluster, testECSSvc).Return([]aas.ScalingPolicy{
+						{
+							Name:   "cpu",
+							Alarms: []string{"cpu-high"},
+						},
+					}, nil),
+					m.mockCW.EXPECT().AlarmsWithTags(testTags).Return(nil, nil),
+					m.mockCW.EXPECT().AlarmStatus([]string{"cpu-high"}).Return(nil, errors.New("some error")),
+				)
+			},
+
+			wantedError: errors.New("get auto scaling CloudWatch alarms: some error"),
+		},
+		"returns the task counts without a range if the service doesn't autoscale": {
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockECSClient.EXPECT().ServiceARN(testApp, testEnv, testSvc).Return(&testSvcARN, nil),
+					m.mockECSSvc.EXPECT().Service(testCluster, testECSSvc).Return(&awsecs.Service{
+						DesiredCount: aws.Int64(1),
+						RunningCount: aws.Int64(1),
+					}, nil),
+					m.mockAAS.EXPECT().ECSServiceScalableTarget(testCluster, testECSSvc).Return(nil, nil),
+					m.mockAAS.EXPECT().ECSServiceScalingPolicies(testCluster, testECSSvc).Return(nil, nil),
+					m.mockCW.EXPECT().AlarmsWithTags(testTags).Return(nil, nil),
+				)
+			},
+
+			wantedScaling: &copilotecs.ServiceScaling{
+				Environment:  testEnv,
+				DesiredCount: 1,
+				RunningCount: 1,
+			},
+		},
+		"returns the range, policies and alarms of an autoscaling service": {
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockECSClient.EXPECT().ServiceARN(testApp, testEnv, testSvc).Return(&testSvcARN, nil),
+					m.mockECSSvc.EXPECT().Service(testCluster, testECSSvc).Return(&awsecs.Service{
+						DesiredCount: aws.Int64(3),
+						RunningCount: aws.Int64(2),
+					}, nil),
+					m.mockAAS.EXPECT().ECSServiceScalableTarget(testCluster, testECSSvc).Return(&aas.ScalableTarget{
+						MinCapacity: 1,
+						MaxCapacity: 10,
+					}, nil),
+					m.mockAAS.EXPECT().ECSServiceScalingPolicies(testCluster, testECSSvc).Return([]aas.ScalingPolicy{
+						{
+							Name:        "cpu",
+							Type:        "TargetTrackingScaling",
+							Metric:      "ECSServiceAverageCPUUtilization",
+							TargetValue: 70,
+							Alarms:      []string{"cpu-high", "cpu-low"},
+						},
+					}, nil),
+					m.mockCW.EXPECT().AlarmsWithTags(testTags).Return([]cloudwatch.AlarmStatus{
+						{
+							Name:   "custom",
+							Status: "OK",
+						},
+					}, nil),
+					m.mockCW.EXPECT().AlarmStatus([]string{"cpu-high", "cpu-low"}).Return([]cloudwatch.AlarmStatus{
+						{
+							Name:   "cpu-high",
+							Status: "ALARM",
+						},
+						{
+							Name:   "cpu-low",
+							Status: "OK",
+						},
+					}, nil),
+				)
+			},
+
+			wantedScaling: &copilotecs.ServiceScaling{
+				Environment:  testEnv,
+				DesiredCount: 3,
+				RunningCount: 2,
+				Range: &aas.ScalableTarget{
+					MinCapacity: 1,
+					MaxCapacity: 10,
+				},
+				Policies: []aas.ScalingPolicy{
+					{
+						Name:        "cpu",
+						Type:        "TargetTrackingScaling",
+						Metric:      "ECSServiceAverageCPUUtilization",
+						TargetValue: 70,
+						Alarms:      []string{"cpu-high", "cpu-low"},
+					},
+				},
+				Alarms: []cloudwatch.AlarmStatus{
+					{
+						Name:   "custom",
+						Status: "OK",
+					},
+					{
+						Name:   "cpu-high",
+						Status: "ALARM",
+					},
+					{
+						Name:   "cpu-low",
+						Status: "OK",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := svcDescriberMocks{
+				mockECSClient: mocks.NewMockecsClient(ctrl),
+				mockECSSvc:    mocks.NewMockecsServiceGetter(ctrl),
+				mockAAS:       mocks.NewMockautoscalingDescriber(ctrl),
+				mockCW:        mocks.NewMockalarmStatusGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			d := &ServiceDescriber{
+				app:     testApp,
+				service: testSvc,
+				env:     testEnv,
+
+				ecsClient: m.mockECSClient,
+				ecsSvc:    m.mockECSSvc,
+				aas:       m.mockAAS,
+				cw:        m.mockCW,
+			}
+
+			// WHEN
+			actual, err := d.Scaling()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedScaling, actual)
+			}
+		})
+	}
+}
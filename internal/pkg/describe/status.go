@@ -6,24 +6,36 @@ package describe
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"strings"
 	"text/tabwriter"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	awsECS "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/maintenance"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/dustin/go-humanize"
 )
 
 const (
 	maxAlarmStatusColumnWidth = 30
+
+	// containerInsightsWindow is how far back we look for Container Insights metrics.
+	containerInsightsWindow = time.Hour
+	errCodeAccessDenied     = "AccessDenied"
 )
 
+// sparkBlocks are the characters used to render a metric trend, from lowest to highest value.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
 type alarmStatusGetter interface {
 	AlarmsWithTags(tags map[string]string) ([]cloudwatch.AlarmStatus, error)
 	AlarmStatus(alarms []string) ([]cloudwatch.AlarmStatus, error)
@@ -32,6 +44,11 @@ type alarmStatusGetter interface {
 type ecsServiceGetter interface {
 	ServiceTasks(clusterName, serviceName string) ([]*awsECS.Task, error)
 	Service(clusterName, serviceName string) (*awsECS.Service, error)
+	ContainerInsightsEnabled(clusterName string) (bool, error)
+}
+
+type serviceMetricsGetter interface {
+	ContainerInsightsMetrics(cluster, service string, startTime, endTime time.Time) (*cloudwatch.ServiceMetrics, error)
 }
 
 type serviceDescriber interface {
@@ -42,6 +59,10 @@ type autoscalingAlarmNamesGetter interface {
 	ECSServiceAlarmNames(cluster, service string) ([]string, error)
 }
 
+type maintenanceGetter interface {
+	Status(app, env, svc string) (*maintenance.Status, error)
+}
+
 // ServiceStatus retrieves status of a service.
 type ServiceStatus struct {
 	app string
@@ -51,14 +72,25 @@ type ServiceStatus struct {
 	svcDescriber serviceDescriber
 	ecsSvc       ecsServiceGetter
 	cwSvc        alarmStatusGetter
+	metricsSvc   serviceMetricsGetter
 	aasSvc       autoscalingAlarmNamesGetter
+	maintenance  maintenanceGetter
+
+	now func() time.Time
 }
 
 // ServiceStatusDesc contains the status for a service.
 type ServiceStatusDesc struct {
 	Service awsECS.ServiceStatus
-	Tasks   []awsECS.TaskStatus      `json:"tasks"`
-	Alarms  []cloudwatch.AlarmStatus `json:"alarms"`
+	Tasks   []awsECS.TaskStatus        `json:"tasks"`
+	Alarms  []cloudwatch.AlarmStatus   `json:"alarms"`
+	Metrics *cloudwatch.ServiceMetrics `json:"metrics,omitempty"`
+	// Maintenance is true if the load balancer responds to requests with a 503 instead of forwarding them to the tasks.
+	Maintenance bool `json:"maintenance,omitempty"`
+	// MaintenanceUntil is the end of the maintenance window, if it was time-boxed.
+	MaintenanceUntil *time.Time `json:"maintenanceUntil,omitempty"`
+	// MaintenanceOverdue is true if the maintenance window ended but the service is still in maintenance.
+	MaintenanceOverdue bool `json:"maintenanceOverdue,omitempty"`
 }
 
 // NewServiceStatusConfig contains fields that initiates ServiceStatus struct.
@@ -79,14 +111,18 @@ func NewServiceStatus(opt *NewServiceStatusConfig) (*ServiceStatus, error) {
 	if err != nil {
 		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
 	}
+	cw := cloudwatch.New(sess)
 	return &ServiceStatus{
 		app:          opt.App,
 		env:          opt.Env,
 		svc:          opt.Svc,
 		svcDescriber: ecs.New(sess),
-		cwSvc:        cloudwatch.New(sess),
+		cwSvc:        cw,
+		metricsSvc:   cw,
 		ecsSvc:       awsECS.New(sess),
 		aasSvc:       aas.New(sess),
+		maintenance:  maintenance.New(sess),
+		now:          time.Now,
 	}, nil
 }
 
@@ -123,11 +159,51 @@ func (s *ServiceStatus) Describe() (*ServiceStatusDesc, error) {
 		return nil, err
 	}
 	alarms = append(alarms, autoscalingAlarms...)
-	return &ServiceStatusDesc{
-		Service: service.ServiceStatus(),
-		Tasks:   taskStatus,
-		Alarms:  alarms,
-	}, nil
+	metrics, err := s.containerInsightsMetrics(svcDesc.ClusterName, svcDesc.Name)
+	if err != nil {
+		return nil, err
+	}
+	maintenanceStatus, err := s.maintenance.Status(s.app, s.env, s.svc)
+	if err != nil {
+		return nil, fmt.Errorf("check if service %s is in maintenance: %w", s.svc, err)
+	}
+	desc := &ServiceStatusDesc{
+		Service:     service.ServiceStatus(),
+		Tasks:       taskStatus,
+		Alarms:      alarms,
+		Metrics:     metrics,
+		Maintenance: maintenanceStatus.Enabled,
+	}
+	if maintenanceStatus.Enabled && !maintenanceStatus.Until.IsZero() {
+		until := maintenanceStatus.Until
+		desc.MaintenanceUntil = &until
+		desc.MaintenanceOverdue = s.now().After(until)
+	}
+	return desc, nil
+}
+
+// containerInsightsMetrics returns the utilization of the service over the last hour.
+// If Container Insights is not enabled on the cluster, or if it can't be determined, it returns nil.
+func (s *ServiceStatus) containerInsightsMetrics(cluster, service string) (*cloudwatch.ServiceMetrics, error) {
+	enabled, err := s.ecsSvc.ContainerInsightsEnabled(cluster)
+	if err != nil {
+		// The metrics are optional, the rest of the status can still be shown without them.
+		return nil, nil
+	}
+	if !enabled {
+		return nil, nil
+	}
+	endTime := s.now()
+	metrics, err := s.metricsSvc.ContainerInsightsMetrics(cluster, service, endTime.Add(-containerInsightsWindow), endTime)
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == errCodeAccessDenied {
+			// Environments created before the manager role could read metrics can still show the rest of the status.
+			return nil, nil
+		}
+		return nil, err
+	}
+	return metrics, nil
 }
 
 func (s *ServiceStatus) ecsServiceAutoscalingAlarms(cluster, service string) ([]cloudwatch.AlarmStatus, error) {
@@ -159,6 +235,9 @@ func (s *ServiceStatusDesc) HumanString() string {
 	writer.Flush()
 	fmt.Fprintf(writer, "  %s %v / %v running tasks (%v pending)\n", statusColor(s.Service.Status),
 		s.Service.RunningCount, s.Service.DesiredCount, s.Service.DesiredCount-s.Service.RunningCount)
+	if s.Maintenance {
+		fmt.Fprintf(writer, "  %s\n", color.Yellow.Sprint(s.maintenanceString()))
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nLast Deployment\n\n"))
 	writer.Flush()
 	fmt.Fprintf(writer, "  %s\t%s\n", "Updated At", humanizeTime(s.Service.LastDeploymentAt))
@@ -171,6 +250,18 @@ func (s *ServiceStatusDesc) HumanString() string {
 	for _, task := range s.Tasks {
 		fmt.Fprint(writer, task.HumanString())
 	}
+	if s.Metrics != nil {
+		fmt.Fprint(writer, color.Bold.Sprint("\nUtilization (last hour)\n\n"))
+		writer.Flush()
+		headers = []string{"Metric", "Latest", "Max", "Trend"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		printMetric(writer, "CPU", s.Metrics.CPUUtilization, formatPercentage)
+		printMetric(writer, "Memory", s.Metrics.MemoryUtilization, formatPercentage)
+		printMetric(writer, "Network In", s.Metrics.NetworkRxBytes, formatBytesPerSecond)
+		printMetric(writer, "Network Out", s.Metrics.NetworkTxBytes, formatBytesPerSecond)
+		printMetric(writer, "Running Tasks", s.Metrics.RunningTaskCount, formatCount)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nAlarms\n\n"))
 	writer.Flush()
 	headers = []string{"Name", "Condition", "Last Updated", "Health"}
@@ -185,6 +276,61 @@ func (s *ServiceStatusDesc) HumanString() string {
 	return b.String()
 }
 
+func (s *ServiceStatusDesc) maintenanceString() string {
+	if s.MaintenanceUntil == nil {
+		return "⚠ In maintenance: the load balancer responds to requests with a 503"
+	}
+	until := s.MaintenanceUntil.UTC().Format(time.RFC3339)
+	if s.MaintenanceOverdue {
+		return fmt.Sprintf("⚠ In maintenance: the maintenance window ended at %s (%s), run `copilot svc maintenance off` to serve requests again",
+			until, humanizeTime(*s.MaintenanceUntil))
+	}
+	return fmt.Sprintf("⚠ In maintenance until %s (%s): the load balancer responds to requests with a 503",
+		until, humanizeTime(*s.MaintenanceUntil))
+}
+
+func printMetric(w *tabwriter.Writer, name string, points []cloudwatch.Datapoint, format func(float64) string) {
+	if len(points) == 0 {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", name, "-", "-", "-")
+		return
+	}
+	max := points[0].Value
+	for _, point := range points {
+		max = math.Max(max, point.Value)
+	}
+	fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", name, format(points[len(points)-1].Value), format(max), sparkline(points))
+}
+
+// sparkline renders the datapoints as a string of blocks whose heights are relative to the minimum and maximum values.
+func sparkline(points []cloudwatch.Datapoint) string {
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, point := range points {
+		min = math.Min(min, point.Value)
+		max = math.Max(max, point.Value)
+	}
+	var b strings.Builder
+	for _, point := range points {
+		idx := 0
+		if max > min {
+			idx = int((point.Value - min) / (max - min) * float64(len(sparkBlocks)-1))
+		}
+		b.WriteRune(sparkBlocks[idx])
+	}
+	return b.String()
+}
+
+func formatPercentage(v float64) string {
+	return fmt.Sprintf("%.1f%%", v)
+}
+
+func formatBytesPerSecond(v float64) string {
+	return fmt.Sprintf("%s/s", humanize.Bytes(uint64(v)))
+}
+
+func formatCount(v float64) string {
+	return fmt.Sprintf("%.0f", v)
+}
+
 func printWithMaxWidth(w *tabwriter.Writer, format string, width int, members ...string) {
 	columns := make([][]string, len(members))
 	maxNumOfLinesPerCol := 0
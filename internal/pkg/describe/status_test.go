@@ -10,10 +10,12 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/maintenance"
 
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
 	"github.com/dustin/go-humanize"
@@ -24,8 +26,10 @@ import (
 type serviceStatusMocks struct {
 	ecsServiceGetter  *mocks.MockecsServiceGetter
 	alarmStatusGetter *mocks.MockalarmStatusGetter
+	metricsGetter     *mocks.MockserviceMetricsGetter
 	serviceDescriber  *mocks.MockserviceDescriber
 	aas               *mocks.MockautoscalingAlarmNamesGetter
+	maintenance       *mocks.MockmaintenanceGetter
 }
 
 func TestServiceStatus_Describe(t *testing.T) {
@@ -36,6 +40,8 @@ func TestServiceStatus_Describe(t *testing.T) {
 	startTime, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05+00:00")
 	stopTime, _ := time.Parse(time.RFC3339, "2006-01-02T16:04:05+00:00")
 	updateTime, _ := time.Parse(time.RFC3339, "2020-03-13T19:50:30+00:00")
+	mockNow, _ := time.Parse(time.RFC3339, "2021-05-01T11:00:00+00:00")
+	mockMaintenanceUntil := mockNow.Add(-time.Minute)
 	mockServiceDesc := &ecs.ServiceDesc{
 		ClusterName: mockCluster,
 		Name:        mockService,
@@ -126,6 +132,156 @@ func TestServiceStatus_Describe(t *testing.T) {
 
 			wantedError: fmt.Errorf("get auto scaling CloudWatch alarms: some error"),
 		},
+		"skips Container Insights metrics if failed to check if they are enabled": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.serviceDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+						ClusterName: mockCluster,
+						Name:        mockService,
+					}, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&awsecs.Service{
+						Status: aws.String("ACTIVE"),
+						Deployments: []*ecsapi.Deployment{
+							{
+								UpdatedAt:      &startTime,
+								TaskDefinition: aws.String("mockTaskDefinition"),
+							},
+						},
+					}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return([]string{}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus([]string{}).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.ecsServiceGetter.EXPECT().ContainerInsightsEnabled(mockCluster).Return(false, mockError),
+					m.maintenance.EXPECT().Status("mockApp", "mockEnv", "mockSvc").Return(&maintenance.Status{}, nil),
+				)
+			},
+
+			wantedContent: &ServiceStatusDesc{
+				Service: awsecs.ServiceStatus{
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+			},
+		},
+		"errors if failed to get Container Insights metrics": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.serviceDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockServiceDesc, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&awsecs.Service{}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return([]string{}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus([]string{}).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.ecsServiceGetter.EXPECT().ContainerInsightsEnabled(mockCluster).Return(true, nil),
+					m.metricsGetter.EXPECT().ContainerInsightsMetrics(mockCluster, mockService, mockNow.Add(-time.Hour), mockNow).Return(nil, mockError),
+				)
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"skip Container Insights metrics if the environment cannot read them": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.serviceDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockServiceDesc, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&awsecs.Service{
+						Status: aws.String("ACTIVE"),
+						Deployments: []*ecsapi.Deployment{
+							{
+								UpdatedAt:      &startTime,
+								TaskDefinition: aws.String("mockTaskDefinition"),
+							},
+						},
+					}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return([]string{}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus([]string{}).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.ecsServiceGetter.EXPECT().ContainerInsightsEnabled(mockCluster).Return(true, nil),
+					m.metricsGetter.EXPECT().ContainerInsightsMetrics(mockCluster, mockService, mockNow.Add(-time.Hour), mockNow).
+						Return(nil, fmt.Errorf("get metrics: %w", awserr.New("AccessDenied", "not authorized", nil))),
+					m.maintenance.EXPECT().Status("mockApp", "mockEnv", "mockSvc").Return(&maintenance.Status{}, nil),
+				)
+			},
+
+			wantedContent: &ServiceStatusDesc{
+				Service: awsecs.ServiceStatus{
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				Tasks: []awsecs.TaskStatus{
+					{
+						ID:        "1234567890123456789",
+						StartedAt: startTime,
+					},
+				},
+			},
+		},
+		"success with Container Insights metrics": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.serviceDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockServiceDesc, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&awsecs.Service{
+						Status: aws.String("ACTIVE"),
+						Deployments: []*ecsapi.Deployment{
+							{
+								UpdatedAt:      &startTime,
+								TaskDefinition: aws.String("mockTaskDefinition"),
+							},
+						},
+					}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return([]string{}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus([]string{}).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.ecsServiceGetter.EXPECT().ContainerInsightsEnabled(mockCluster).Return(true, nil),
+					m.metricsGetter.EXPECT().ContainerInsightsMetrics(mockCluster, mockService, mockNow.Add(-time.Hour), mockNow).Return(&cloudwatch.ServiceMetrics{
+						CPUUtilization: []cloudwatch.Datapoint{
+							{Timestamp: mockNow, Value: 12.5},
+						},
+					}, nil),
+					m.maintenance.EXPECT().Status("mockApp", "mockEnv", "mockSvc").Return(&maintenance.Status{
+						Enabled: true,
+						Until:   mockMaintenanceUntil,
+					}, nil),
+				)
+			},
+
+			wantedContent: &ServiceStatusDesc{
+				Service: awsecs.ServiceStatus{
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				Tasks: []awsecs.TaskStatus{
+					{
+						ID:        "1234567890123456789",
+						StartedAt: startTime,
+					},
+				},
+				Metrics: &cloudwatch.ServiceMetrics{
+					CPUUtilization: []cloudwatch.Datapoint{
+						{Timestamp: mockNow, Value: 12.5},
+					},
+				},
+				Maintenance:        true,
+				MaintenanceUntil:   &mockMaintenanceUntil,
+				MaintenanceOverdue: true,
+			},
+		},
+		"errors if failed to check maintenance": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.serviceDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockServiceDesc, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&awsecs.Service{}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return([]string{}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus([]string{}).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.ecsServiceGetter.EXPECT().ContainerInsightsEnabled(mockCluster).Return(false, nil),
+					m.maintenance.EXPECT().Status("mockApp", "mockEnv", "mockSvc").Return(nil, mockError),
+				)
+			},
+
+			wantedError: fmt.Errorf("check if service mockSvc is in maintenance: some error"),
+		},
 		"success": {
 			setupMocks: func(m serviceStatusMocks) {
 				gomock.InOrder(
@@ -189,6 +345,8 @@ func TestServiceStatus_Describe(t *testing.T) {
 							UpdatedTimes: updateTime,
 						},
 					}, nil),
+					m.ecsServiceGetter.EXPECT().ContainerInsightsEnabled(mockCluster).Return(false, nil),
+					m.maintenance.EXPECT().Status("mockApp", "mockEnv", "mockSvc").Return(&maintenance.Status{}, nil),
 				)
 			},
 
@@ -249,13 +407,17 @@ func TestServiceStatus_Describe(t *testing.T) {
 
 			mockecsSvc := mocks.NewMockecsServiceGetter(ctrl)
 			mockcwSvc := mocks.NewMockalarmStatusGetter(ctrl)
+			mockMetricsSvc := mocks.NewMockserviceMetricsGetter(ctrl)
 			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
 			mockaasClient := mocks.NewMockautoscalingAlarmNamesGetter(ctrl)
+			mockMaintenance := mocks.NewMockmaintenanceGetter(ctrl)
 			mocks := serviceStatusMocks{
 				ecsServiceGetter:  mockecsSvc,
 				alarmStatusGetter: mockcwSvc,
+				metricsGetter:     mockMetricsSvc,
 				serviceDescriber:  mockSvcDescriber,
 				aas:               mockaasClient,
+				maintenance:       mockMaintenance,
 			}
 
 			tc.setupMocks(mocks)
@@ -265,9 +427,14 @@ func TestServiceStatus_Describe(t *testing.T) {
 				env:          "mockEnv",
 				app:          "mockApp",
 				cwSvc:        mockcwSvc,
+				metricsSvc:   mockMetricsSvc,
 				ecsSvc:       mockecsSvc,
 				svcDescriber: mockSvcDescriber,
 				aasSvc:       mockaasClient,
+				maintenance:  mockMaintenance,
+				now: func() time.Time {
+					return mockNow
+				},
 			}
 
 			// WHEN
@@ -297,6 +464,8 @@ func TestServiceStatusDesc_String(t *testing.T) {
 
 	startTime, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05+00:00")
 	updateTime, _ := time.Parse(time.RFC3339, "2020-03-13T19:50:30+00:00")
+	maintenanceStart, _ := time.Parse(time.RFC3339, "2019-12-31T21:00:00+00:00")
+	maintenanceEnd, _ := time.Parse(time.RFC3339, "2020-01-01T02:00:00+00:00")
 
 	testCases := map[string]struct {
 		desc  *ServiceStatusDesc
@@ -428,6 +597,168 @@ Alarms
 `,
 			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":1,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":[{\"health\":\"HEALTHY\",\"id\":\"1234567890123456789\",\"images\":[{\"ID\":\"mockImageID1\",\"Digest\":\"69671a968e8ec3648e2697417750e\"},{\"ID\":\"mockImageID2\",\"Digest\":\"ca27a44e25ce17fea7b07940ad793\"}],\"lastStatus\":\"RUNNING\",\"startedAt\":\"0001-01-01T00:00:00Z\",\"stoppedAt\":\"0001-01-01T00:00:00Z\",\"stoppedReason\":\"some reason\",\"capacityProvider\":\"\"}],\"alarms\":[{\"arn\":\"mockAlarmArn\",\"name\":\"mockAlarm\",\"condition\":\"mockCondition\",\"status\":\"OK\",\"type\":\"Metric\",\"updatedTimes\":\"2020-03-13T19:50:30Z\"}]}\n",
 		},
+		"with Container Insights metrics": {
+			desc: &ServiceStatusDesc{
+				Service: awsecs.ServiceStatus{
+					DesiredCount:     2,
+					RunningCount:     2,
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				Metrics: &cloudwatch.ServiceMetrics{
+					CPUUtilization: []cloudwatch.Datapoint{
+						{Timestamp: updateTime, Value: 10},
+						{Timestamp: updateTime.Add(5 * time.Minute), Value: 50},
+						{Timestamp: updateTime.Add(10 * time.Minute), Value: 25.25},
+					},
+					MemoryUtilization: []cloudwatch.Datapoint{
+						{Timestamp: updateTime, Value: 40},
+						{Timestamp: updateTime.Add(5 * time.Minute), Value: 40},
+					},
+					NetworkRxBytes: []cloudwatch.Datapoint{
+						{Timestamp: updateTime, Value: 2048},
+						{Timestamp: updateTime.Add(5 * time.Minute), Value: 1024},
+					},
+					RunningTaskCount: []cloudwatch.Datapoint{
+						{Timestamp: updateTime, Value: 1},
+						{Timestamp: updateTime.Add(5 * time.Minute), Value: 2},
+					},
+				},
+			},
+			human: `Service Status
+
+  ACTIVE 2 / 2 running tasks (0 pending)
+
+Last Deployment
+
+  Updated At         14 years ago
+  Task Definition    mockTaskDefinition
+
+Task Status
+
+  ID                Image Digest        Last Status         Started At          Stopped At          Capacity Provider    Health Status
+  --                ------------        -----------         ----------          ----------          -----------------    -------------
+
+Utilization (last hour)
+
+  Metric            Latest              Max                 Trend
+  ------            ------              ---                 -----
+  CPU               25.2%               50.0%               ▁█▃
+  Memory            40.0%               40.0%               ▁▁
+  Network In        1.0 kB/s            2.0 kB/s            █▁
+  Network Out       -                   -                   -
+  Running Tasks     2                   2                   ▁█
+
+Alarms
+
+  Name              Condition           Last Updated        Health
+  ----              ---------           ------------        ------
+`,
+			json: "{\"Service\":{\"desiredCount\":2,\"runningCount\":2,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":null,\"alarms\":null,\"metrics\":{\"cpuUtilization\":[{\"timestamp\":\"2020-03-13T19:50:30Z\",\"value\":10},{\"timestamp\":\"2020-03-13T19:55:30Z\",\"value\":50},{\"timestamp\":\"2020-03-13T20:00:30Z\",\"value\":25.25}],\"memoryUtilization\":[{\"timestamp\":\"2020-03-13T19:50:30Z\",\"value\":40},{\"timestamp\":\"2020-03-13T19:55:30Z\",\"value\":40}],\"networkRxBytes\":[{\"timestamp\":\"2020-03-13T19:50:30Z\",\"value\":2048},{\"timestamp\":\"2020-03-13T19:55:30Z\",\"value\":1024}],\"networkTxBytes\":null,\"runningTaskCount\":[{\"timestamp\":\"2020-03-13T19:50:30Z\",\"value\":1},{\"timestamp\":\"2020-03-13T19:55:30Z\",\"value\":2}]}}\n",
+		},
+		"in maintenance": {
+			desc: &ServiceStatusDesc{
+				Service: awsecs.ServiceStatus{
+					DesiredCount:     1,
+					RunningCount:     1,
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				Maintenance: true,
+			},
+			human: `Service Status
+
+  ACTIVE 1 / 1 running tasks (0 pending)
+  ⚠ In maintenance: the load balancer responds to requests with a 503
+
+Last Deployment
+
+  Updated At         14 years ago
+  Task Definition    mockTaskDefinition
+
+Task Status
+
+  ID                Image Digest        Last Status         Started At          Stopped At          Capacity Provider    Health Status
+  --                ------------        -----------         ----------          ----------          -----------------    -------------
+
+Alarms
+
+  Name              Condition           Last Updated        Health
+  ----              ---------           ------------        ------
+`,
+			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":1,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":null,\"alarms\":null,\"maintenance\":true}\n",
+		},
+		"in maintenance until the end of the window": {
+			desc: &ServiceStatusDesc{
+				Service: awsecs.ServiceStatus{
+					DesiredCount:     1,
+					RunningCount:     1,
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				Maintenance:      true,
+				MaintenanceUntil: &maintenanceEnd,
+			},
+			human: `Service Status
+
+  ACTIVE 1 / 1 running tasks (0 pending)
+  ⚠ In maintenance until 2020-01-01T02:00:00Z (2 hours from now): the load balancer responds to requests with a 503
+
+Last Deployment
+
+  Updated At         14 years ago
+  Task Definition    mockTaskDefinition
+
+Task Status
+
+  ID                Image Digest        Last Status         Started At          Stopped At          Capacity Provider    Health Status
+  --                ------------        -----------         ----------          ----------          -----------------    -------------
+
+Alarms
+
+  Name              Condition           Last Updated        Health
+  ----              ---------           ------------        ------
+`,
+			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":1,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":null,\"alarms\":null,\"maintenance\":true,\"maintenanceUntil\":\"2020-01-01T02:00:00Z\"}\n",
+		},
+		"in maintenance after the end of the window": {
+			desc: &ServiceStatusDesc{
+				Service: awsecs.ServiceStatus{
+					DesiredCount:     1,
+					RunningCount:     1,
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				Maintenance:        true,
+				MaintenanceUntil:   &maintenanceStart,
+				MaintenanceOverdue: true,
+			},
+			human: `Service Status
+
+  ACTIVE 1 / 1 running tasks (0 pending)
+  ⚠ In maintenance: the maintenance window ended at 2019-12-31T21:00:00Z (3 hours ago), run ` + "`copilot svc maintenance off`" + ` to serve requests again
+
+Last Deployment
+
+  Updated At         14 years ago
+  Task Definition    mockTaskDefinition
+
+Task Status
+
+  ID                Image Digest        Last Status         Started At          Stopped At          Capacity Provider    Health Status
+  --                ------------        -----------         ----------          ----------          -----------------    -------------
+
+Alarms
+
+  Name              Condition           Last Updated        Health
+  ----              ---------           ------------        ------
+`,
+			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":1,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":null,\"alarms\":null,\"maintenance\":true,\"maintenanceUntil\":\"2019-12-31T21:00:00Z\",\"maintenanceOverdue\":true}\n",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,141 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Graph formats supported by Topology.
+const (
+	TopologyFormatDOT     = "dot"
+	TopologyFormatMermaid = "mermaid"
+)
+
+// TopologyFormats are the formats that a Topology can be rendered in.
+var TopologyFormats = []string{TopologyFormatDOT, TopologyFormatMermaid}
+
+var nonAlphanumeric = regexp.MustCompile("[^a-zA-Z0-9]")
+
+// Topology is the architecture graph of an application.
+type Topology struct {
+	App       string
+	Envs      []string
+	Workloads []*TopologyWorkload
+}
+
+// TopologyWorkload is a service or job of an application along with what it depends on.
+type TopologyWorkload struct {
+	Name string
+	Type string
+	// DeployedTo holds the names of the environments the workload is deployed to.
+	DeployedTo []string
+	// DependsOn holds the names of the workloads that the workload calls through service discovery.
+	DependsOn []string
+	Addons    []*TopologyAddon
+}
+
+// TopologyAddon is a resource declared in the addons of a workload.
+type TopologyAddon struct {
+	LogicalID string
+	Type      string
+}
+
+// Render returns the graph in the input format, either "dot" or "mermaid".
+func (t *Topology) Render(format string) (string, error) {
+	switch format {
+	case TopologyFormatDOT:
+		return t.dot(), nil
+	case TopologyFormatMermaid:
+		return t.mermaid(), nil
+	default:
+		return "", fmt.Errorf("unsupported graph format %q", format)
+	}
+}
+
+// dot renders the graph in the Graphviz DOT language.
+func (t *Topology) dot() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %q {\n", t.App)
+	fmt.Fprintln(&b, "  rankdir=LR;")
+	fmt.Fprintln(&b, "  node [shape=box];")
+	if len(t.Envs) != 0 {
+		fmt.Fprintln(&b, `  subgraph "cluster_environments" {`)
+		fmt.Fprintln(&b, `    label="Environments";`)
+		for _, env := range t.Envs {
+			fmt.Fprintf(&b, "    %q [label=%q, shape=ellipse];\n", envNodeID(env), env)
+		}
+		fmt.Fprintln(&b, "  }")
+	}
+	for _, wl := range t.Workloads {
+		fmt.Fprintf(&b, "  %q [label=\"%s\\n%s\"];\n", workloadNodeID(wl.Name), wl.Name, wl.Type)
+		for _, addon := range wl.Addons {
+			fmt.Fprintf(&b, "  %q [label=\"%s\\n%s\", shape=cylinder];\n", addonNodeID(wl.Name, addon.LogicalID), addon.LogicalID, addon.Type)
+		}
+	}
+	for _, wl := range t.Workloads {
+		for _, env := range wl.DeployedTo {
+			fmt.Fprintf(&b, "  %q -> %q [style=dashed];\n", envNodeID(env), workloadNodeID(wl.Name))
+		}
+		for _, dep := range wl.DependsOn {
+			fmt.Fprintf(&b, "  %q -> %q;\n", workloadNodeID(wl.Name), workloadNodeID(dep))
+		}
+		for _, addon := range wl.Addons {
+			fmt.Fprintf(&b, "  %q -> %q;\n", workloadNodeID(wl.Name), addonNodeID(wl.Name, addon.LogicalID))
+		}
+	}
+	fmt.Fprintln(&b, "}")
+	return b.String()
+}
+
+// mermaid renders the graph as a Mermaid flowchart.
+func (t *Topology) mermaid() string {
+	var b strings.Builder
+	fmt.Fprintln(&b, "graph LR")
+	if len(t.Envs) != 0 {
+		fmt.Fprintln(&b, "  subgraph environments [Environments]")
+		for _, env := range t.Envs {
+			fmt.Fprintf(&b, "    %s([%q])\n", mermaidID(envNodeID(env)), env)
+		}
+		fmt.Fprintln(&b, "  end")
+	}
+	for _, wl := range t.Workloads {
+		fmt.Fprintf(&b, "  %s[\"%s<br/>%s\"]\n", mermaidID(workloadNodeID(wl.Name)), wl.Name, wl.Type)
+		for _, addon := range wl.Addons {
+			fmt.Fprintf(&b, "  %s[(\"%s<br/>%s\")]\n", mermaidID(addonNodeID(wl.Name, addon.LogicalID)), addon.LogicalID, addon.Type)
+		}
+	}
+	for _, wl := range t.Workloads {
+		for _, env := range wl.DeployedTo {
+			fmt.Fprintf(&b, "  %s -.-> %s\n", mermaidID(envNodeID(env)), mermaidID(workloadNodeID(wl.Name)))
+		}
+		for _, dep := range wl.DependsOn {
+			fmt.Fprintf(&b, "  %s --> %s\n", mermaidID(workloadNodeID(wl.Name)), mermaidID(workloadNodeID(dep)))
+		}
+		for _, addon := range wl.Addons {
+			fmt.Fprintf(&b, "  %s --> %s\n", mermaidID(workloadNodeID(wl.Name)), mermaidID(addonNodeID(wl.Name, addon.LogicalID)))
+		}
+	}
+	return b.String()
+}
+
+func envNodeID(env string) string {
+	return "env:" + env
+}
+
+func workloadNodeID(name string) string {
+	return "wl:" + name
+}
+
+func addonNodeID(wl, logicalID string) string {
+	return "addon:" + wl + ":" + logicalID
+}
+
+// mermaidID replaces the characters that Mermaid doesn't accept in node IDs.
+// Names of Copilot resources can't contain underscores, so IDs stay unique.
+func mermaidID(id string) string {
+	return nonAlphanumeric.ReplaceAllString(id, "_")
+}
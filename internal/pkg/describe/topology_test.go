@@ -0,0 +1,98 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopology_Render(t *testing.T) {
+	topology := &Topology{
+		App:  "my-app",
+		Envs: []string{"test", "prod"},
+		Workloads: []*TopologyWorkload{
+			{
+				Name:       "frontend",
+				Type:       "Load Balanced Web Service",
+				DeployedTo: []string{"test", "prod"},
+				DependsOn:  []string{"orders-api"},
+			},
+			{
+				Name:       "orders-api",
+				Type:       "Backend Service",
+				DeployedTo: []string{"test"},
+				Addons: []*TopologyAddon{
+					{LogicalID: "OrdersTable", Type: "AWS::DynamoDB::Table"},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inFormat string
+
+		wantedGraph string
+		wantedErr   error
+	}{
+		"unsupported format": {
+			inFormat:  "png",
+			wantedErr: errors.New(`unsupported graph format "png"`),
+		},
+		"dot": {
+			inFormat: "dot",
+			wantedGraph: `digraph "my-app" {
+  rankdir=LR;
+  node [shape=box];
+  subgraph "cluster_environments" {
+    label="Environments";
+    "env:test" [label="test", shape=ellipse];
+    "env:prod" [label="prod", shape=ellipse];
+  }
+  "wl:frontend" [label="frontend\nLoad Balanced Web Service"];
+  "wl:orders-api" [label="orders-api\nBackend Service"];
+  "addon:orders-api:OrdersTable" [label="OrdersTable\nAWS::DynamoDB::Table", shape=cylinder];
+  "env:test" -> "wl:frontend" [style=dashed];
+  "env:prod" -> "wl:frontend" [style=dashed];
+  "wl:frontend" -> "wl:orders-api";
+  "env:test" -> "wl:orders-api" [style=dashed];
+  "wl:orders-api" -> "addon:orders-api:OrdersTable";
+}
+`,
+		},
+		"mermaid": {
+			inFormat: "mermaid",
+			wantedGraph: `graph LR
+  subgraph environments [Environments]
+    env_test(["test"])
+    env_prod(["prod"])
+  end
+  wl_frontend["frontend<br/>Load Balanced Web Service"]
+  wl_orders_api["orders-api<br/>Backend Service"]
+  addon_orders_api_OrdersTable[("OrdersTable<br/>AWS::DynamoDB::Table")]
+  env_test -.-> wl_frontend
+  env_prod -.-> wl_frontend
+  wl_frontend --> wl_orders_api
+  env_test -.-> wl_orders_api
+  wl_orders_api --> addon_orders_api_OrdersTable
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			graph, err := topology.Render(tc.inFormat)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedGraph, graph)
+		})
+	}
+}
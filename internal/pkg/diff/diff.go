@@ -0,0 +1,289 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package diff computes the structural differences between two YAML documents, such as CloudFormation templates.
+package diff
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"gopkg.in/yaml.v3"
+)
+
+// Display settings.
+const (
+	indentWidth = 4 // number of spaces to indent the children of a node.
+	yamlIndent  = 2 // number of spaces to indent the values that are added or removed.
+	arrow       = " -> "
+)
+
+// colorizer colors formatted strings.
+type colorizer interface {
+	Sprintf(format string, a ...interface{}) string
+}
+
+type changeType int
+
+const (
+	added changeType = iota + 1
+	removed
+	modified
+)
+
+// node is a key of a mapping or an item of a sequence that differs between two documents.
+type node struct {
+	label    string
+	change   changeType
+	old      *yaml.Node
+	new      *yaml.Node
+	children []*node // Set if both values are mappings or sequences.
+}
+
+// Tree holds the differences between two YAML documents.
+type Tree struct {
+	root *node
+}
+
+// New returns the differences from the old YAML document to the new one.
+// An empty old document means that every field of the new one is added.
+func New(old, new []byte) (*Tree, error) {
+	oldNode, err := parse(old)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal old document: %w", err)
+	}
+	newNode, err := parse(new)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal new document: %w", err)
+	}
+	// Compare an empty document with the fields of the other one instead of as a whole.
+	if oldNode == nil && newNode != nil {
+		oldNode = &yaml.Node{Kind: newNode.Kind}
+	}
+	if newNode == nil && oldNode != nil {
+		newNode = &yaml.Node{Kind: oldNode.Kind}
+	}
+	return &Tree{
+		root: compare("", oldNode, newNode),
+	}, nil
+}
+
+// IsEmpty returns true if the documents are equal.
+func (t *Tree) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Write writes the differences to w. Added fields are prefixed with "+", removed ones with "-",
+// and fields whose value changed with "~".
+func (t *Tree) Write(w io.Writer) error {
+	if t.root == nil {
+		return nil
+	}
+	buf := new(bytes.Buffer)
+	nodes := []*node{t.root}
+	if t.root.children != nil {
+		// The documents are the same kind of collection, there's no need to print their root.
+		nodes = t.root.children
+	}
+	for _, n := range nodes {
+		if err := writeNode(buf, n, 0); err != nil {
+			return err
+		}
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func parse(in []byte) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(in, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	return doc.Content[0], nil
+}
+
+// compare returns the differences between the two values, or nil if they're equal.
+func compare(label string, old, new *yaml.Node) *node {
+	old, new = resolveAlias(old), resolveAlias(new)
+	switch {
+	case old == nil && new == nil:
+		return nil
+	case old == nil:
+		return &node{label: label, change: added, new: new}
+	case new == nil:
+		return &node{label: label, change: removed, old: old}
+	}
+	if old.Kind != new.Kind {
+		return &node{label: label, change: modified, old: old, new: new}
+	}
+	var children []*node
+	switch old.Kind {
+	case yaml.MappingNode:
+		children = compareMappings(old, new)
+	case yaml.SequenceNode:
+		children = compareSequences(old, new)
+	default:
+		if old.ShortTag() == new.ShortTag() && old.Value == new.Value {
+			return nil
+		}
+		return &node{label: label, change: modified, old: old, new: new}
+	}
+	if len(children) == 0 {
+		return nil
+	}
+	return &node{label: label, change: modified, old: old, new: new, children: children}
+}
+
+// compareMappings returns the differences between the keys of the mappings.
+// The keys of the new mapping come first in their order, followed by the removed keys.
+func compareMappings(old, new *yaml.Node) []*node {
+	oldValues := mappingValues(old)
+	newValues := mappingValues(new)
+	var children []*node
+	for _, key := range mappingKeys(new) {
+		if child := compare(key, oldValues[key], newValues[key]); child != nil {
+			children = append(children, child)
+		}
+	}
+	for _, key := range mappingKeys(old) {
+		if _, ok := newValues[key]; ok {
+			continue
+		}
+		children = append(children, compare(key, oldValues[key], nil))
+	}
+	return children
+}
+
+// compareSequences returns the differences between the items of the sequences at the same index.
+func compareSequences(old, new *yaml.Node) []*node {
+	var children []*node
+	for i := 0; i < len(old.Content) || i < len(new.Content); i++ {
+		var oldItem, newItem *yaml.Node
+		if i < len(old.Content) {
+			oldItem = old.Content[i]
+		}
+		if i < len(new.Content) {
+			newItem = new.Content[i]
+		}
+		if child := compare(fmt.Sprintf("[%d]", i), oldItem, newItem); child != nil {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+func mappingKeys(n *yaml.Node) []string {
+	var keys []string
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		keys = append(keys, n.Content[i].Value)
+	}
+	return keys
+}
+
+func mappingValues(n *yaml.Node) map[string]*yaml.Node {
+	values := make(map[string]*yaml.Node)
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		values[n.Content[i].Value] = n.Content[i+1]
+	}
+	return values
+}
+
+func resolveAlias(n *yaml.Node) *yaml.Node {
+	for n != nil && n.Kind == yaml.AliasNode {
+		n = n.Alias
+	}
+	return n
+}
+
+func writeNode(buf *bytes.Buffer, n *node, indent int) error {
+	switch {
+	case n.change == added:
+		return writeValue(buf, color.Green, "+", n.label, n.new, indent)
+	case n.change == removed:
+		return writeValue(buf, color.Red, "-", n.label, n.old, indent)
+	case n.children != nil:
+		buf.WriteString(color.Yellow.Sprintf("%s~ %s:\n", strings.Repeat(" ", indent), n.label))
+		for _, child := range n.children {
+			if err := writeNode(buf, child, indent+indentWidth); err != nil {
+				return err
+			}
+		}
+		return nil
+	case isInlineScalar(n.old) && isInlineScalar(n.new):
+		buf.WriteString(color.Yellow.Sprintf("%s~ %s: %s%s%s\n", strings.Repeat(" ", indent), n.label,
+			scalar(n.old), arrow, scalar(n.new)))
+		return nil
+	default:
+		// Values of a different kind, or multi-line strings, are easier to read in full.
+		if err := writeValue(buf, color.Red, "-", n.label, n.old, indent); err != nil {
+			return err
+		}
+		return writeValue(buf, color.Green, "+", n.label, n.new, indent)
+	}
+}
+
+// writeValue writes the labeled value, with each line colored and prefixed with the sign of the change.
+func writeValue(buf *bytes.Buffer, c colorizer, sign, label string, value *yaml.Node, indent int) error {
+	prefix := strings.Repeat(" ", indent)
+	if isInlineScalar(value) {
+		buf.WriteString(c.Sprintf("%s%s %s: %s\n", prefix, sign, label, scalar(value)))
+		return nil
+	}
+	clearFlowStyle(value)
+	out := new(bytes.Buffer)
+	enc := yaml.NewEncoder(out)
+	enc.SetIndent(yamlIndent)
+	if err := enc.Encode(value); err != nil {
+		return fmt.Errorf("marshal value of %s: %w", label, err)
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("marshal value of %s: %w", label, err)
+	}
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	padding := strings.Repeat(" ", yamlIndent)
+	if value.Kind == yaml.ScalarNode {
+		// Multi-line strings are marshaled as an indented block scalar whose header goes next to the label.
+		buf.WriteString(c.Sprintf("%s%s %s: %s\n", prefix, sign, label, lines[0]))
+		lines, padding = lines[1:], ""
+	} else {
+		buf.WriteString(c.Sprintf("%s%s %s:\n", prefix, sign, label))
+	}
+	for _, line := range lines {
+		buf.WriteString(c.Sprintf("%s%s %s%s\n", prefix, sign, padding, line))
+	}
+	return nil
+}
+
+// clearFlowStyle writes collections in the block style and unquotes the keys of mappings, such as the ones of JSON documents.
+func clearFlowStyle(n *yaml.Node) {
+	n.Style &^= yaml.FlowStyle
+	for i, child := range n.Content {
+		if n.Kind == yaml.MappingNode && i%2 == 0 {
+			child.Style &^= yaml.DoubleQuotedStyle | yaml.SingleQuotedStyle
+		}
+		clearFlowStyle(child)
+	}
+}
+
+func isInlineScalar(n *yaml.Node) bool {
+	return n.Kind == yaml.ScalarNode && !strings.Contains(n.Value, "\n")
+}
+
+// scalar returns the value of a scalar with its tag if it's a custom one, such as "!Ref".
+func scalar(n *yaml.Node) string {
+	tag := n.ShortTag()
+	value := n.Value
+	if n.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle) != 0 || value == "" {
+		value = fmt.Sprintf("%q", value)
+	}
+	if strings.HasPrefix(tag, "!") && !strings.HasPrefix(tag, "!!") {
+		return tag + " " + value
+	}
+	return value
+}
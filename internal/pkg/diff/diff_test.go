@@ -0,0 +1,188 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTree_Write(t *testing.T) {
+	testCases := map[string]struct {
+		inOld string
+		inNew string
+
+		wantedEmpty bool
+		wantedDiff  string
+		wantedError string
+	}{
+		"equal documents": {
+			inOld: `
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+    Properties:
+      QueueName: !Sub '${AWS::StackName}-queue'`,
+			inNew: `
+Resources:
+  Queue:
+    Properties:
+      QueueName: !Sub '${AWS::StackName}-queue'
+    Type: AWS::SQS::Queue`,
+
+			wantedEmpty: true,
+		},
+		"modified scalars": {
+			inOld: `
+Resources:
+  TaskDefinition:
+    Properties:
+      Cpu: 256
+      Family: !Ref AWS::StackName
+      Memory: 512`,
+			inNew: `
+Resources:
+  TaskDefinition:
+    Properties:
+      Cpu: 512
+      Family: !Sub '${AWS::StackName}-family'
+      Memory: 512`,
+
+			wantedDiff: `
+~ Resources:
+    ~ TaskDefinition:
+        ~ Properties:
+            ~ Cpu: 256 -> 512
+            ~ Family: !Ref AWS::StackName -> !Sub "${AWS::StackName}-family"
+`,
+		},
+		"added and removed keys": {
+			inOld: `
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+  Queue:
+    Type: AWS::SQS::Queue`,
+			inNew: `
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+    Properties:
+      DelaySeconds: 5
+  Topic:
+    Type: AWS::SNS::Topic
+    Properties:
+      Tags:
+        - Key: app
+          Value: demo`,
+
+			wantedDiff: `
+~ Resources:
+    ~ Queue:
+        + Properties:
+        +   DelaySeconds: 5
+    + Topic:
+    +   Type: AWS::SNS::Topic
+    +   Properties:
+    +     Tags:
+    +       - Key: app
+    +         Value: demo
+    - Bucket:
+    -   Type: AWS::S3::Bucket
+`,
+		},
+		"sequence items": {
+			inOld: `
+ContainerDefinitions:
+  - Name: api
+    Image: nginx
+  - Name: envoy
+    Image: envoy`,
+			inNew: `
+ContainerDefinitions:
+  - Name: api
+    Image: nginx:2`,
+
+			wantedDiff: `
+~ ContainerDefinitions:
+    ~ [0]:
+        ~ Image: nginx -> nginx:2
+    - [1]:
+    -   Name: envoy
+    -   Image: envoy
+`,
+		},
+		"values of a different kind and multi-line strings": {
+			inOld: `
+Subnets: subnet-1
+Definition: |
+  {
+    "StartAt": "Run"
+  }`,
+			inNew: `
+Subnets:
+  - subnet-1
+  - subnet-2
+Definition: |
+  {
+    "StartAt": "Retry"
+  }`,
+
+			wantedDiff: `
+- Subnets: subnet-1
++ Subnets:
++   - subnet-1
++   - subnet-2
+- Definition: |-
+-   {
+-     "StartAt": "Run"
+-   }
++ Definition: |-
++   {
++     "StartAt": "Retry"
++   }
+`,
+		},
+		"empty old document": {
+			inNew: `
+Parameters:
+  EnvName: test`,
+
+			wantedDiff: `
++ Parameters:
++   EnvName: test
+`,
+		},
+		"malformed document": {
+			inOld: `Resources: [`,
+
+			wantedError: "unmarshal old document: yaml: line 1: did not find expected node content",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			tree, err := New([]byte(tc.inOld), []byte(tc.inNew))
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			out := new(strings.Builder)
+			err = tree.Write(out)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEmpty, tree.IsEmpty())
+			if tc.wantedEmpty {
+				require.Empty(t, out.String())
+				return
+			}
+			require.Equal(t, strings.TrimPrefix(tc.wantedDiff, "\n"), out.String())
+		})
+	}
+}
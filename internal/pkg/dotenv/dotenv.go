@@ -0,0 +1,71 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package dotenv parses files of KEY=VALUE lines, such as the env_file of a workload or the secrets of `secret init`.
+package dotenv
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const exportPrefix = "export "
+
+// Var is a variable defined in a dotenv file.
+type Var struct {
+	Key   string
+	Value string
+	Line  int // Line number of the variable in the file, starting at 1.
+}
+
+// Parse returns the variables defined in a dotenv file in the order they're defined.
+// Each line is of the form KEY=VALUE, and blank lines or lines starting with "#" are ignored.
+// Values can be surrounded by single or double quotes, and lines can be prefixed with "export".
+// A variable can only be defined once.
+func Parse(content []byte) ([]Var, error) {
+	var vars []Var
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, exportPrefix)
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf(`line %d: %q must be of the form "KEY=VALUE"`, lineNum, line)
+		}
+		key := strings.TrimSpace(parts[0])
+		if key == "" || strings.ContainsAny(key, " \t") {
+			return nil, fmt.Errorf("line %d: invalid variable name %q", lineNum, key)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("line %d: variable %s is defined more than once", lineNum, key)
+		}
+		seen[key] = true
+		vars = append(vars, Var{
+			Key:   key,
+			Value: unquote(strings.TrimSpace(parts[1])),
+			Line:  lineNum,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return vars, nil
+}
+
+// unquote removes matching single or double quotes surrounding the value.
+func unquote(value string) string {
+	if len(value) < 2 {
+		return value
+	}
+	first, last := value[0], value[len(value)-1]
+	if first == last && (first == '"' || first == '\'') {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
@@ -0,0 +1,74 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package dotenv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wanted      []Var
+		wantedError string
+	}{
+		"parses variables ignoring comments and blank lines": {
+			inContent: `# Database configuration.
+DB_HOST=localhost
+
+export DB_PORT = 5432
+DB_NAME="orders"
+GREETING='hello world'
+EMPTY=
+URL=https://example.com/?a=b
+`,
+			wanted: []Var{
+				{Key: "DB_HOST", Value: "localhost", Line: 2},
+				{Key: "DB_PORT", Value: "5432", Line: 4},
+				{Key: "DB_NAME", Value: "orders", Line: 5},
+				{Key: "GREETING", Value: "hello world", Line: 6},
+				{Key: "EMPTY", Value: "", Line: 7},
+				{Key: "URL", Value: "https://example.com/?a=b", Line: 8},
+			},
+		},
+		"keeps unmatched quotes": {
+			inContent: `QUOTE="hello'`,
+			wanted: []Var{
+				{Key: "QUOTE", Value: `"hello'`, Line: 1},
+			},
+		},
+		"errors if a line is not a variable assignment": {
+			inContent: `DB_HOST=localhost
+DB_PORT`,
+			wantedError: `line 2: "DB_PORT" must be of the form "KEY=VALUE"`,
+		},
+		"errors if a variable name is invalid": {
+			inContent:   `DB HOST=localhost`,
+			wantedError: `line 1: invalid variable name "DB HOST"`,
+		},
+		"errors if a variable is defined more than once": {
+			inContent: `DB_HOST=localhost
+DB_HOST=example.com`,
+			wantedError: `line 2: variable DB_HOST is defined more than once`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := Parse([]byte(tc.inContent))
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -36,6 +38,7 @@ type ecsClient interface {
 	StopTasks(tasks []string, opts ...ecs.StopTasksOpts) error
 	TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error)
 	NetworkConfiguration(cluster, serviceName string) (*ecs.NetworkConfiguration, error)
+	Service(clusterName, serviceName string) (*ecs.Service, error)
 }
 
 // ServiceDesc contains the description of an ECS service.
@@ -45,6 +48,16 @@ type ServiceDesc struct {
 	Tasks       []*ecs.Task
 }
 
+// ServiceScaling contains the task counts, the autoscaling configuration and the alarms of a service in an environment.
+type ServiceScaling struct {
+	Environment  string                   `json:"environment"`
+	DesiredCount int64                    `json:"desiredCount"`
+	RunningCount int64                    `json:"runningCount"`
+	Range        *aas.ScalableTarget      `json:"range,omitempty"` // Nil if the service doesn't autoscale.
+	Policies     []aas.ScalingPolicy      `json:"policies,omitempty"`
+	Alarms       []cloudwatch.AlarmStatus `json:"alarms,omitempty"`
+}
+
 // Client retrieves Copilot information from ECS endpoint.
 type Client struct {
 	rgGetter  resourceGetter
@@ -94,6 +107,48 @@ func (c Client) DescribeService(app, env, svc string) (*ServiceDesc, error) {
 	}, nil
 }
 
+// LatestDeployment returns the most recent deployment of an ECS service given Copilot service info.
+func (c Client) LatestDeployment(app, env, svc string) (*ecs.Deployment, error) {
+	svcARN, err := c.ServiceARN(app, env, svc)
+	if err != nil {
+		return nil, err
+	}
+	clusterName, err := svcARN.ClusterName()
+	if err != nil {
+		return nil, fmt.Errorf("get cluster name: %w", err)
+	}
+	serviceName, err := svcARN.ServiceName()
+	if err != nil {
+		return nil, fmt.Errorf("get service name: %w", err)
+	}
+	service, err := c.ecsClient.Service(clusterName, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("get service %s: %w", serviceName, err)
+	}
+	return service.PrimaryDeployment()
+}
+
+// ExecuteCommandEnabled returns true if commands can be executed in the tasks of an ECS service given Copilot service info.
+func (c Client) ExecuteCommandEnabled(app, env, svc string) (bool, error) {
+	svcARN, err := c.ServiceARN(app, env, svc)
+	if err != nil {
+		return false, err
+	}
+	clusterName, err := svcARN.ClusterName()
+	if err != nil {
+		return false, fmt.Errorf("get cluster name: %w", err)
+	}
+	serviceName, err := svcARN.ServiceName()
+	if err != nil {
+		return false, fmt.Errorf("get service name: %w", err)
+	}
+	service, err := c.ecsClient.Service(clusterName, serviceName)
+	if err != nil {
+		return false, fmt.Errorf("get service %s: %w", serviceName, err)
+	}
+	return aws.BoolValue(service.EnableExecuteCommand), nil
+}
+
 // ListActiveAppEnvTasksOpts contains the parameters for ListActiveAppEnvTasks.
 type ListActiveAppEnvTasksOpts struct {
 	App string
@@ -0,0 +1,431 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ecsimport translates an existing Amazon ECS service into a Copilot service manifest.
+package ecsimport
+
+import (
+	"encoding"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+)
+
+const (
+	rootPath               = "/"
+	defaultSuccessCodes    = "200"
+	fargateLaunchType      = "FARGATE"
+	awslogsLogDriver       = "awslogs"
+	targetTrackingPolicy   = "TargetTrackingScaling"
+	cpuUtilizationMetric   = "ECSServiceAverageCPUUtilization"
+	memUtilizationMetric   = "ECSServiceAverageMemoryUtilization"
+	requestPerTargetMetric = "ALBRequestCountPerTarget"
+)
+
+// Service holds the configuration of an ECS service and of the resources attached to it.
+type Service struct {
+	Name            string // Name of the Copilot service.
+	Service         *awsecs.Service
+	TaskDefinition  *awsecs.TaskDefinition
+	ScalableTarget  *aas.ScalableTarget // Nil if the service doesn't autoscale.
+	ScalingPolicies []aas.ScalingPolicy
+	TargetGroup     *elbv2.TargetGroup // Nil if the service isn't behind a load balancer.
+}
+
+// Conversion holds the Copilot service translated from an ECS service,
+// and explanations for the ECS configuration that could not be translated.
+type Conversion struct {
+	Type        string
+	Manifest    encoding.BinaryMarshaler
+	Unsupported []string
+}
+
+type converter struct {
+	svc         *Service
+	unsupported []string
+}
+
+// Convert translates an ECS service into a Copilot service manifest.
+// Services registered with a load balancer become Load Balanced Web Services and the others become Backend Services.
+// The container that receives traffic from the load balancer, or else the first essential container, becomes the main
+// container of the service and the other containers become its sidecars.
+func Convert(svc *Service) (*Conversion, error) {
+	c := &converter{
+		svc: svc,
+	}
+	return c.convert()
+}
+
+func (c *converter) convert() (*Conversion, error) {
+	main, err := c.mainContainer()
+	if err != nil {
+		return nil, err
+	}
+	port, err := c.port(main)
+	if err != nil {
+		return nil, err
+	}
+	props := manifest.WorkloadProps{
+		Name:  c.svc.Name,
+		Image: aws.StringValue(main.Image),
+	}
+
+	var (
+		mft      encoding.BinaryMarshaler
+		wlType   string
+		task     *manifest.TaskConfig
+		override *manifest.ImageOverride
+		sidecars *map[string]*manifest.SidecarConfig
+	)
+	if len(c.svc.Service.LoadBalancers) != 0 {
+		lbws := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+			WorkloadProps: &props,
+			Path:          rootPath,
+			Port:          port,
+		})
+		if hc := c.targetGroupHealthCheck(); hc != nil {
+			lbws.HealthCheck = manifest.HealthCheckArgsOrString{
+				HealthCheckArgs: *hc,
+			}
+		}
+		if main.HealthCheck != nil {
+			c.unsupported = append(c.unsupported, fmt.Sprintf("containerDefinitions.%s.healthCheck: container health checks are not imported for %s, the load balancer checks the health of the tasks", aws.StringValue(main.Name), manifest.LoadBalancedWebServiceType))
+		}
+		c.unsupported = append(c.unsupported, fmt.Sprintf("service.loadBalancers: listener rules are not imported, requests to %q are forwarded to the service, update http.path to match your routing", rootPath))
+		mft, wlType, task, override, sidecars = lbws, manifest.LoadBalancedWebServiceType, &lbws.TaskConfig, &lbws.ImageOverride, &lbws.Sidecars
+	} else {
+		backend := manifest.NewBackendService(manifest.BackendServiceProps{
+			WorkloadProps: props,
+			Port:          port,
+			HealthCheck:   containerHealthCheck(main.HealthCheck),
+		})
+		mft, wlType, task, override, sidecars = backend, manifest.BackendServiceType, &backend.TaskConfig, &backend.ImageOverride, &backend.Sidecars
+	}
+
+	c.taskSize(task)
+	task.Count = c.count(wlType)
+	task.Variables = variables(main.Environment)
+	task.Secrets = c.secrets(main)
+	task.Storage = c.storage(main)
+	if len(main.EntryPoint) != 0 {
+		override.EntryPoint = manifest.EntryPointOverride{StringSlice: aws.StringValueSlice(main.EntryPoint)}
+	}
+	if len(main.Command) != 0 {
+		override.Command = manifest.CommandOverride{StringSlice: aws.StringValueSlice(main.Command)}
+	}
+	c.logging(main)
+	for _, container := range c.svc.TaskDefinition.ContainerDefinitions {
+		if container == main {
+			continue
+		}
+		if *sidecars == nil {
+			*sidecars = make(map[string]*manifest.SidecarConfig)
+		}
+		(*sidecars)[aws.StringValue(container.Name)] = c.sidecar(container)
+		c.logging(container)
+	}
+	c.taskDefinition()
+	c.service()
+	return &Conversion{
+		Type:        wlType,
+		Manifest:    mft,
+		Unsupported: c.unsupported,
+	}, nil
+}
+
+// mainContainer returns the container that receives traffic from the load balancer, or the first essential container.
+func (c *converter) mainContainer() (*ecs.ContainerDefinition, error) {
+	containers := c.svc.TaskDefinition.ContainerDefinitions
+	if len(containers) == 0 {
+		return nil, fmt.Errorf("task definition %s does not have any containers", aws.StringValue(c.svc.TaskDefinition.TaskDefinitionArn))
+	}
+	if lbs := c.svc.Service.LoadBalancers; len(lbs) != 0 {
+		if len(lbs) > 1 {
+			c.unsupported = append(c.unsupported, fmt.Sprintf("service.loadBalancers: only the target group of container %s on port %d is imported", aws.StringValue(lbs[0].ContainerName), aws.Int64Value(lbs[0].ContainerPort)))
+		}
+		name := aws.StringValue(lbs[0].ContainerName)
+		for _, container := range containers {
+			if aws.StringValue(container.Name) == name {
+				return container, nil
+			}
+		}
+		return nil, fmt.Errorf("container %s that receives traffic from the load balancer is not in task definition %s", name, aws.StringValue(c.svc.TaskDefinition.TaskDefinitionArn))
+	}
+	for _, container := range containers {
+		if isEssential(container) {
+			return container, nil
+		}
+	}
+	return containers[0], nil
+}
+
+// port returns the container port that receives traffic.
+func (c *converter) port(main *ecs.ContainerDefinition) (uint16, error) {
+	var port int64
+	if lbs := c.svc.Service.LoadBalancers; len(lbs) != 0 {
+		port = aws.Int64Value(lbs[0].ContainerPort)
+	} else if len(main.PortMappings) != 0 {
+		port = aws.Int64Value(main.PortMappings[0].ContainerPort)
+		if len(main.PortMappings) > 1 {
+			c.unsupported = append(c.unsupported, fmt.Sprintf("containerDefinitions.%s.portMappings: only the container port %d is imported", aws.StringValue(main.Name), port))
+		}
+	}
+	if port < 0 || port > 65535 {
+		return 0, fmt.Errorf("container port %d of %s is out of range", port, aws.StringValue(main.Name))
+	}
+	return uint16(port), nil
+}
+
+// targetGroupHealthCheck returns the health check of the target group if it differs from the Copilot defaults.
+func (c *converter) targetGroupHealthCheck() *manifest.HTTPHealthCheckArgs {
+	tg := c.svc.TargetGroup
+	if tg == nil {
+		return nil
+	}
+	path, codes := tg.HealthCheckPath, tg.SuccessCodes
+	if (path == "" || path == manifest.DefaultHealthCheckPath) && (codes == "" || codes == defaultSuccessCodes) {
+		return nil
+	}
+	if path == "" {
+		path = manifest.DefaultHealthCheckPath
+	}
+	hc := &manifest.HTTPHealthCheckArgs{
+		Path: aws.String(path),
+	}
+	if codes != "" && codes != defaultSuccessCodes {
+		hc.SuccessCodes = aws.String(codes)
+	}
+	return hc
+}
+
+// taskSize sets the CPU and memory of the task, if the task definition specifies them.
+func (c *converter) taskSize(task *manifest.TaskConfig) {
+	td := c.svc.TaskDefinition
+	if cpu, err := strconv.Atoi(aws.StringValue(td.Cpu)); err == nil {
+		task.CPU = aws.Int(cpu)
+	} else {
+		c.unsupported = append(c.unsupported, fmt.Sprintf("taskDefinition.cpu: task-level CPU is not set, the service uses %d CPU units", aws.IntValue(task.CPU)))
+	}
+	if mem, err := strconv.Atoi(aws.StringValue(td.Memory)); err == nil {
+		task.Memory = aws.Int(mem)
+	} else {
+		c.unsupported = append(c.unsupported, fmt.Sprintf("taskDefinition.memory: task-level memory is not set, the service uses %d MiB", aws.IntValue(task.Memory)))
+	}
+}
+
+// count returns the desired count of the service, or its autoscaling configuration.
+func (c *converter) count(wlType string) manifest.Count {
+	target := c.svc.ScalableTarget
+	if target == nil {
+		return manifest.Count{
+			Value: aws.Int(int(aws.Int64Value(c.svc.Service.DesiredCount))),
+		}
+	}
+	band := manifest.IntRangeBand(fmt.Sprintf("%d-%d", target.MinCapacity, target.MaxCapacity))
+	count := manifest.AdvancedCount{
+		Range: &manifest.Range{
+			Value: &band,
+		},
+	}
+	for _, policy := range c.svc.ScalingPolicies {
+		if policy.Type != targetTrackingPolicy {
+			c.unsupported = append(c.unsupported, fmt.Sprintf("scaling policy %s: %s policies are not supported, scale on cpu_percentage, memory_percentage or requests under count", policy.Name, policy.Type))
+			continue
+		}
+		value := aws.Int(int(policy.TargetValue))
+		switch {
+		case policy.Metric == cpuUtilizationMetric:
+			count.CPU = value
+		case policy.Metric == memUtilizationMetric:
+			count.Memory = value
+		case policy.Metric == requestPerTargetMetric && wlType == manifest.LoadBalancedWebServiceType:
+			count.Requests = value
+		default:
+			c.unsupported = append(c.unsupported, fmt.Sprintf("scaling policy %s: tracking a custom metric is not supported, scale on cpu_percentage, memory_percentage or requests under count", policy.Name))
+		}
+	}
+	return manifest.Count{
+		AdvancedCount: count,
+	}
+}
+
+func (c *converter) secrets(container *ecs.ContainerDefinition) map[string]string {
+	if len(container.Secrets) == 0 {
+		return nil
+	}
+	secrets := make(map[string]string)
+	var names []string
+	for _, secret := range container.Secrets {
+		secrets[aws.StringValue(secret.Name)] = aws.StringValue(secret.ValueFrom)
+		names = append(names, aws.StringValue(secret.Name))
+	}
+	sort.Strings(names)
+	c.unsupported = append(c.unsupported, fmt.Sprintf("containerDefinitions.%s.secrets: tag the parameters or secrets of %s with copilot-application and copilot-environment so that the service can read them", aws.StringValue(container.Name), strings.Join(names, ", ")))
+	return secrets
+}
+
+func (c *converter) storage(main *ecs.ContainerDefinition) *manifest.Storage {
+	vols := make(map[string]manifest.Volume)
+	for _, mountPoint := range main.MountPoints {
+		name := aws.StringValue(mountPoint.SourceVolume)
+		vol, ok := c.volume(name)
+		if !ok {
+			continue
+		}
+		opts := manifest.MountPointOpts{
+			ContainerPath: mountPoint.ContainerPath,
+		}
+		if aws.BoolValue(mountPoint.ReadOnly) {
+			opts.ReadOnly = aws.Bool(true)
+		}
+		switch {
+		case vol.EfsVolumeConfiguration != nil:
+			conf := vol.EfsVolumeConfiguration
+			efs := manifest.EFSVolumeConfiguration{
+				FileSystemID: conf.FileSystemId,
+			}
+			if dir := aws.StringValue(conf.RootDirectory); dir != "" && dir != rootPath {
+				efs.RootDirectory = aws.String(dir)
+			}
+			if conf.AuthorizationConfig != nil {
+				c.unsupported = append(c.unsupported, fmt.Sprintf("volumes.%s.efsVolumeConfiguration.authorizationConfig: add the access point and IAM authorization to storage.volumes.%s.efs.auth", name, name))
+			}
+			vols[name] = manifest.Volume{
+				EFS: &manifest.EFSConfigOrBool{
+					Advanced: efs,
+				},
+				MountPointOpts: opts,
+			}
+		case vol.Host != nil && vol.Host.SourcePath != nil:
+			c.unsupported = append(c.unsupported, fmt.Sprintf("volumes.%s: bind mounts of host paths are not supported on Fargate", name))
+		case vol.DockerVolumeConfiguration != nil, vol.FsxWindowsFileServerVolumeConfiguration != nil:
+			c.unsupported = append(c.unsupported, fmt.Sprintf("volumes.%s: only EFS and ephemeral volumes are supported", name))
+		default:
+			vols[name] = manifest.Volume{
+				MountPointOpts: opts,
+			}
+		}
+	}
+	if len(vols) == 0 {
+		return nil
+	}
+	return &manifest.Storage{
+		Volumes: vols,
+	}
+}
+
+func (c *converter) volume(name string) (*ecs.Volume, bool) {
+	for _, vol := range c.svc.TaskDefinition.Volumes {
+		if aws.StringValue(vol.Name) == name {
+			return vol, true
+		}
+	}
+	return nil, false
+}
+
+func (c *converter) sidecar(container *ecs.ContainerDefinition) *manifest.SidecarConfig {
+	name := aws.StringValue(container.Name)
+	sidecar := &manifest.SidecarConfig{
+		Image:     container.Image,
+		Variables: variables(container.Environment),
+		Secrets:   c.secrets(container),
+	}
+	if len(container.PortMappings) != 0 {
+		sidecar.Port = aws.String(strconv.FormatInt(aws.Int64Value(container.PortMappings[0].ContainerPort), 10))
+	}
+	if !isEssential(container) {
+		sidecar.Essential = aws.Bool(false)
+	}
+	if len(container.Command) != 0 || len(container.EntryPoint) != 0 || len(container.MountPoints) != 0 || len(container.DependsOn) != 0 || container.HealthCheck != nil {
+		c.unsupported = append(c.unsupported, fmt.Sprintf("containerDefinitions.%s: command, entry point, mount points, dependencies and health checks are not imported for sidecars", name))
+	}
+	return sidecar
+}
+
+func (c *converter) logging(container *ecs.ContainerDefinition) {
+	conf := container.LogConfiguration
+	if conf == nil || aws.StringValue(conf.LogDriver) == awslogsLogDriver {
+		return
+	}
+	c.unsupported = append(c.unsupported, fmt.Sprintf("containerDefinitions.%s.logConfiguration: the %s log driver is not imported, route logs with FireLens using the logging field", aws.StringValue(container.Name), aws.StringValue(conf.LogDriver)))
+}
+
+func (c *converter) taskDefinition() {
+	td := c.svc.TaskDefinition
+	if role := aws.StringValue(td.TaskRoleArn); role != "" {
+		c.unsupported = append(c.unsupported, fmt.Sprintf("taskDefinition.taskRoleArn: Copilot creates a task role for the service, grant it the permissions of %s with an addon", role))
+	}
+	for _, container := range td.ContainerDefinitions {
+		if len(container.EnvironmentFiles) != 0 {
+			c.unsupported = append(c.unsupported, fmt.Sprintf("containerDefinitions.%s.environmentFiles: environment files are not imported, set the values in variables", aws.StringValue(container.Name)))
+		}
+	}
+}
+
+func (c *converter) service() {
+	svc := c.svc.Service
+	if launchType := aws.StringValue(svc.LaunchType); launchType != "" && launchType != fargateLaunchType {
+		c.unsupported = append(c.unsupported, fmt.Sprintf("service.launchType: %s is not supported, the service runs on Fargate", launchType))
+	}
+	if len(svc.CapacityProviderStrategy) != 0 {
+		c.unsupported = append(c.unsupported, "service.capacityProviderStrategy: capacity providers are not imported, use count.range and count.spot_from to run tasks on Fargate Spot")
+	}
+	if svc.NetworkConfiguration != nil {
+		c.unsupported = append(c.unsupported, "service.networkConfiguration: subnets and security groups are not imported, the service runs in the VPC of the environment")
+	}
+	if len(svc.ServiceRegistries) != 0 {
+		c.unsupported = append(c.unsupported, "service.serviceRegistries: the service is registered in the service discovery namespace of the environment instead")
+	}
+}
+
+func containerHealthCheck(hc *ecs.HealthCheck) *manifest.ContainerHealthCheck {
+	if hc == nil {
+		return nil
+	}
+	out := &manifest.ContainerHealthCheck{
+		Command: aws.StringValueSlice(hc.Command),
+	}
+	if hc.Interval != nil {
+		out.Interval = durationSeconds(*hc.Interval)
+	}
+	if hc.Retries != nil {
+		out.Retries = aws.Int(int(*hc.Retries))
+	}
+	if hc.Timeout != nil {
+		out.Timeout = durationSeconds(*hc.Timeout)
+	}
+	if hc.StartPeriod != nil {
+		out.StartPeriod = durationSeconds(*hc.StartPeriod)
+	}
+	return out
+}
+
+func variables(env []*ecs.KeyValuePair) map[string]string {
+	if len(env) == 0 {
+		return nil
+	}
+	vars := make(map[string]string)
+	for _, kv := range env {
+		vars[aws.StringValue(kv.Name)] = aws.StringValue(kv.Value)
+	}
+	return vars
+}
+
+func durationSeconds(secs int64) *time.Duration {
+	d := time.Duration(secs) * time.Second
+	return &d
+}
+
+// isEssential returns true if the container is essential. Containers are essential unless marked otherwise.
+func isEssential(container *ecs.ContainerDefinition) bool {
+	return container.Essential == nil || aws.BoolValue(container.Essential)
+}
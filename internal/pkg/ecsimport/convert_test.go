@@ -0,0 +1,306 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecsimport
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvert(t *testing.T) {
+	testCases := map[string]struct {
+		inService *Service
+
+		wantedType        string
+		wantedUnsupported []string
+		wantedError       string
+		checkManifest     func(t *testing.T, mft interface{})
+	}{
+		"errors if the task definition has no containers": {
+			inService: &Service{
+				Name:    "api",
+				Service: &awsecs.Service{},
+				TaskDefinition: &awsecs.TaskDefinition{
+					TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/api:3"),
+				},
+			},
+			wantedError: "task definition arn:aws:ecs:us-west-2:123456789012:task-definition/api:3 does not have any containers",
+		},
+		"errors if the container of the load balancer is not in the task definition": {
+			inService: &Service{
+				Name: "web",
+				Service: &awsecs.Service{
+					LoadBalancers: []*ecs.LoadBalancer{
+						{ContainerName: aws.String("nginx"), ContainerPort: aws.Int64(80)},
+					},
+				},
+				TaskDefinition: &awsecs.TaskDefinition{
+					TaskDefinitionArn: aws.String("web:1"),
+					ContainerDefinitions: []*ecs.ContainerDefinition{
+						{Name: aws.String("app"), Image: aws.String("app")},
+					},
+				},
+			},
+			wantedError: "container nginx that receives traffic from the load balancer is not in task definition web:1",
+		},
+		"translates services behind a load balancer to Load Balanced Web Services": {
+			inService: &Service{
+				Name: "web",
+				Service: &awsecs.Service{
+					DesiredCount: aws.Int64(3),
+					LaunchType:   aws.String("FARGATE"),
+					LoadBalancers: []*ecs.LoadBalancer{
+						{ContainerName: aws.String("app"), ContainerPort: aws.Int64(8080)},
+					},
+					NetworkConfiguration: &ecs.NetworkConfiguration{
+						AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+							Subnets: aws.StringSlice([]string{"subnet-1"}),
+						},
+					},
+				},
+				TaskDefinition: &awsecs.TaskDefinition{
+					Cpu:         aws.String("512"),
+					Memory:      aws.String("1024"),
+					TaskRoleArn: aws.String("arn:aws:iam::123456789012:role/web-task"),
+					ContainerDefinitions: []*ecs.ContainerDefinition{
+						{
+							Name:  aws.String("datadog"),
+							Image: aws.String("datadog/agent"),
+							Environment: []*ecs.KeyValuePair{
+								{Name: aws.String("DD_APM_ENABLED"), Value: aws.String("true")},
+							},
+							Essential: aws.Bool(false),
+							PortMappings: []*ecs.PortMapping{
+								{ContainerPort: aws.Int64(8126)},
+							},
+						},
+						{
+							Name:       aws.String("app"),
+							Image:      aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/web:v1"),
+							EntryPoint: aws.StringSlice([]string{"/bin/sh", "-c"}),
+							Command:    aws.StringSlice([]string{"./start.sh"}),
+							Environment: []*ecs.KeyValuePair{
+								{Name: aws.String("LOG_LEVEL"), Value: aws.String("info")},
+							},
+							Secrets: []*ecs.Secret{
+								{Name: aws.String("DB_PASSWORD"), ValueFrom: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/db-password")},
+							},
+							HealthCheck: &ecs.HealthCheck{
+								Command: aws.StringSlice([]string{"CMD-SHELL", "curl -f http://localhost:8080"}),
+							},
+						},
+					},
+				},
+				TargetGroup: &elbv2.TargetGroup{
+					HealthCheckPath: "/healthz",
+					SuccessCodes:    "200",
+				},
+			},
+
+			wantedType: manifest.LoadBalancedWebServiceType,
+			wantedUnsupported: []string{
+				"containerDefinitions.app.healthCheck: container health checks are not imported for Load Balanced Web Service, the load balancer checks the health of the tasks",
+				`service.loadBalancers: listener rules are not imported, requests to "/" are forwarded to the service, update http.path to match your routing`,
+				"containerDefinitions.app.secrets: tag the parameters or secrets of DB_PASSWORD with copilot-application and copilot-environment so that the service can read them",
+				"taskDefinition.taskRoleArn: Copilot creates a task role for the service, grant it the permissions of arn:aws:iam::123456789012:role/web-task with an addon",
+				"service.networkConfiguration: subnets and security groups are not imported, the service runs in the VPC of the environment",
+			},
+			checkManifest: func(t *testing.T, mft interface{}) {
+				web := mft.(*manifest.LoadBalancedWebService)
+				require.Equal(t, "web", aws.StringValue(web.Name))
+				require.Equal(t, "/", aws.StringValue(web.Path))
+				require.Equal(t, "/healthz", aws.StringValue(web.HealthCheck.HealthCheckArgs.Path))
+				require.Nil(t, web.HealthCheck.HealthCheckArgs.SuccessCodes)
+				require.Equal(t, "123456789012.dkr.ecr.us-west-2.amazonaws.com/web:v1", aws.StringValue(web.ImageConfig.Location))
+				require.Equal(t, uint16(8080), aws.Uint16Value(web.ImageConfig.Port))
+				require.Equal(t, 512, aws.IntValue(web.CPU))
+				require.Equal(t, 1024, aws.IntValue(web.Memory))
+				require.Equal(t, 3, aws.IntValue(web.Count.Value))
+				require.Equal(t, []string{"/bin/sh", "-c"}, web.EntryPoint.StringSlice)
+				require.Equal(t, []string{"./start.sh"}, web.Command.StringSlice)
+				require.Equal(t, map[string]string{"LOG_LEVEL": "info"}, web.Variables)
+				require.Equal(t, map[string]string{"DB_PASSWORD": "arn:aws:ssm:us-west-2:123456789012:parameter/db-password"}, web.Secrets)
+				require.Equal(t, map[string]*manifest.SidecarConfig{
+					"datadog": {
+						Image:     aws.String("datadog/agent"),
+						Port:      aws.String("8126"),
+						Essential: aws.Bool(false),
+						Variables: map[string]string{"DD_APM_ENABLED": "true"},
+					},
+				}, web.Sidecars)
+			},
+		},
+		"translates other services to Backend Services": {
+			inService: &Service{
+				Name: "worker",
+				Service: &awsecs.Service{
+					DesiredCount: aws.Int64(1),
+					LaunchType:   aws.String("EC2"),
+					ServiceRegistries: []*ecs.ServiceRegistry{
+						{RegistryArn: aws.String("arn:aws:servicediscovery:us-west-2:123456789012:service/srv-1")},
+					},
+				},
+				TaskDefinition: &awsecs.TaskDefinition{
+					ContainerDefinitions: []*ecs.ContainerDefinition{
+						{
+							Name:  aws.String("worker"),
+							Image: aws.String("worker:latest"),
+							PortMappings: []*ecs.PortMapping{
+								{ContainerPort: aws.Int64(3000)},
+								{ContainerPort: aws.Int64(9090)},
+							},
+							HealthCheck: &ecs.HealthCheck{
+								Command:  aws.StringSlice([]string{"CMD", "/bin/healthcheck"}),
+								Interval: aws.Int64(15),
+								Retries:  aws.Int64(3),
+							},
+							MountPoints: []*ecs.MountPoint{
+								{SourceVolume: aws.String("data"), ContainerPath: aws.String("/var/lib/data"), ReadOnly: aws.Bool(true)},
+								{SourceVolume: aws.String("scratch"), ContainerPath: aws.String("/tmp/scratch")},
+								{SourceVolume: aws.String("docker"), ContainerPath: aws.String("/var/run/docker.sock")},
+							},
+							LogConfiguration: &ecs.LogConfiguration{
+								LogDriver: aws.String("splunk"),
+							},
+						},
+					},
+					Volumes: []*ecs.Volume{
+						{
+							Name: aws.String("data"),
+							EfsVolumeConfiguration: &ecs.EFSVolumeConfiguration{
+								FileSystemId:  aws.String("fs-1234"),
+								RootDirectory: aws.String("/"),
+							},
+						},
+						{Name: aws.String("scratch")},
+						{
+							Name: aws.String("docker"),
+							Host: &ecs.HostVolumeProperties{SourcePath: aws.String("/var/run/docker.sock")},
+						},
+					},
+				},
+			},
+
+			wantedType: manifest.BackendServiceType,
+			wantedUnsupported: []string{
+				"containerDefinitions.worker.portMappings: only the container port 3000 is imported",
+				"taskDefinition.cpu: task-level CPU is not set, the service uses 256 CPU units",
+				"taskDefinition.memory: task-level memory is not set, the service uses 512 MiB",
+				"volumes.docker: bind mounts of host paths are not supported on Fargate",
+				"containerDefinitions.worker.logConfiguration: the splunk log driver is not imported, route logs with FireLens using the logging field",
+				"service.launchType: EC2 is not supported, the service runs on Fargate",
+				"service.serviceRegistries: the service is registered in the service discovery namespace of the environment instead",
+			},
+			checkManifest: func(t *testing.T, mft interface{}) {
+				worker := mft.(*manifest.BackendService)
+				require.Equal(t, uint16(3000), aws.Uint16Value(worker.ImageConfig.Port))
+				require.Equal(t, []string{"CMD", "/bin/healthcheck"}, worker.ImageConfig.HealthCheck.Command)
+				require.Equal(t, 15*time.Second, *worker.ImageConfig.HealthCheck.Interval)
+				require.Equal(t, 3, aws.IntValue(worker.ImageConfig.HealthCheck.Retries))
+				require.Equal(t, 256, aws.IntValue(worker.CPU))
+				require.Equal(t, 1, aws.IntValue(worker.Count.Value))
+				require.Equal(t, &manifest.Storage{
+					Volumes: map[string]manifest.Volume{
+						"data": {
+							EFS: &manifest.EFSConfigOrBool{
+								Advanced: manifest.EFSVolumeConfiguration{
+									FileSystemID: aws.String("fs-1234"),
+								},
+							},
+							MountPointOpts: manifest.MountPointOpts{
+								ContainerPath: aws.String("/var/lib/data"),
+								ReadOnly:      aws.Bool(true),
+							},
+						},
+						"scratch": {
+							MountPointOpts: manifest.MountPointOpts{
+								ContainerPath: aws.String("/tmp/scratch"),
+							},
+						},
+					},
+				}, worker.Storage)
+				require.Nil(t, worker.Sidecars)
+			},
+		},
+		"translates the autoscaling configuration": {
+			inService: &Service{
+				Name: "web",
+				Service: &awsecs.Service{
+					DesiredCount: aws.Int64(2),
+					LoadBalancers: []*ecs.LoadBalancer{
+						{ContainerName: aws.String("web"), ContainerPort: aws.Int64(80)},
+					},
+				},
+				TaskDefinition: &awsecs.TaskDefinition{
+					Cpu:    aws.String("256"),
+					Memory: aws.String("512"),
+					ContainerDefinitions: []*ecs.ContainerDefinition{
+						{Name: aws.String("web"), Image: aws.String("nginx")},
+					},
+				},
+				ScalableTarget: &aas.ScalableTarget{
+					MinCapacity: 2,
+					MaxCapacity: 10,
+				},
+				ScalingPolicies: []aas.ScalingPolicy{
+					{Name: "cpu", Type: "TargetTrackingScaling", Metric: "ECSServiceAverageCPUUtilization", TargetValue: 70},
+					{Name: "requests", Type: "TargetTrackingScaling", Metric: "ALBRequestCountPerTarget", TargetValue: 1000},
+					{Name: "queue", Type: "TargetTrackingScaling", TargetValue: 10},
+					{Name: "step", Type: "StepScaling"},
+				},
+				TargetGroup: &elbv2.TargetGroup{
+					HealthCheckPath: "/",
+					SuccessCodes:    "200-299",
+				},
+			},
+
+			wantedType: manifest.LoadBalancedWebServiceType,
+			wantedUnsupported: []string{
+				`service.loadBalancers: listener rules are not imported, requests to "/" are forwarded to the service, update http.path to match your routing`,
+				"scaling policy queue: tracking a custom metric is not supported, scale on cpu_percentage, memory_percentage or requests under count",
+				"scaling policy step: StepScaling policies are not supported, scale on cpu_percentage, memory_percentage or requests under count",
+			},
+			checkManifest: func(t *testing.T, mft interface{}) {
+				web := mft.(*manifest.LoadBalancedWebService)
+				band := manifest.IntRangeBand("2-10")
+				require.Equal(t, manifest.Count{
+					AdvancedCount: manifest.AdvancedCount{
+						Range:    &manifest.Range{Value: &band},
+						CPU:      aws.Int(70),
+						Requests: aws.Int(1000),
+					},
+				}, web.Count)
+				require.Equal(t, manifest.HTTPHealthCheckArgs{
+					Path:         aws.String("/"),
+					SuccessCodes: aws.String("200-299"),
+				}, web.HealthCheck.HealthCheckArgs)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			conversion, err := Convert(tc.inService)
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedType, conversion.Type)
+			require.Equal(t, tc.wantedUnsupported, conversion.Unsupported)
+			tc.checkManifest(t, conversion.Manifest)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+)
+
+// CDKCommand represents AWS CDK commands that can be run.
+type CDKCommand struct {
+	runner
+}
+
+// NewCDKCommand returns a CDKCommand.
+func NewCDKCommand() CDKCommand {
+	return CDKCommand{
+		runner: command.New(),
+	}
+}
+
+// Synth runs `cdk synth` in the CDK app directory and returns the CloudFormation template of the app's stack.
+// The app must define a single stack so that the template is written to stdout.
+func (c CDKCommand) Synth(appDir string) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := c.runner.Run("npx", []string{"cdk", "synth",
+		"--no-version-reporting", "--no-path-metadata", "--no-asset-metadata"},
+		command.Dir(appDir), command.Stdout(buf)); err != nil {
+		return nil, fmt.Errorf("synthesize CDK app %s: %w", appDir, err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,74 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCDKCommand_Synth(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockrunner)
+
+		wantedTemplate string
+		wantedError    error
+	}{
+		"should wrap the error if cdk synth fails": {
+			setupMocks: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("npx", []string{"cdk", "synth", "--no-version-reporting", "--no-path-metadata", "--no-asset-metadata"},
+					gomock.Any(), gomock.Any()).Return(mockError)
+			},
+			wantedError: fmt.Errorf("synthesize CDK app /copilot/api/addons/queue: some error"),
+		},
+		"should return the synthesized template from the app directory": {
+			setupMocks: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("npx", []string{"cdk", "synth", "--no-version-reporting", "--no-path-metadata", "--no-asset-metadata"},
+					gomock.Any(), gomock.Any()).
+					DoAndReturn(func(name string, args []string, opts ...command.Option) error {
+						cmd := &exec.Cmd{}
+						for _, opt := range opts {
+							opt(cmd)
+						}
+						require.Equal(t, "/copilot/api/addons/queue", cmd.Dir)
+						_, err := cmd.Stdout.Write([]byte("Resources:\n  Queue:\n    Type: AWS::SQS::Queue\n"))
+						return err
+					})
+			},
+			wantedTemplate: "Resources:\n  Queue:\n    Type: AWS::SQS::Queue\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockrunner(ctrl)
+			tc.setupMocks(m)
+			cdk := CDKCommand{
+				runner: m,
+			}
+
+			// WHEN
+			got, err := cdk.Synth("/copilot/api/addons/queue")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTemplate, string(got))
+			}
+		})
+	}
+}
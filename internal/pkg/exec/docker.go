@@ -6,8 +6,11 @@ package exec
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os/exec"
 	"path/filepath"
 	"sort"
@@ -17,19 +20,74 @@ import (
 )
 
 // DockerCommand represents docker commands that can be run.
+// The commands are run with the binary of its container engine, which is compatible with the docker CLI.
 type DockerCommand struct {
 	runner
+	engine string // Defaults to docker.
+	host   string // Address of the daemon of a Docker Desktop alternative. Empty if the engine's default is used.
+	// Set if the credential helper of the docker config file can't be used.
+	credentialHelperErr error
 	// Override in unit tests.
-	buf *bytes.Buffer
+	buf      *bytes.Buffer
+	lookPath func(file string) (string, error) // Defaults to exec.LookPath.
 }
 
 // NewDockerCommand returns a DockerCommand.
 func NewDockerCommand() DockerCommand {
 	return DockerCommand{
 		runner: command.New(),
+		engine: DockerEngine,
 	}
 }
 
+// NewContainerEngineCommand returns a DockerCommand that runs the container engine returned by DetectEngine.
+// If the engine is docker, the commands connect to the daemon of the Docker Desktop alternative running on the host,
+// such as Colima or Rancher Desktop, and find credential helpers installed outside of the PATH.
+func NewContainerEngineCommand() (DockerCommand, error) {
+	engine, err := DetectEngine()
+	if err != nil {
+		return DockerCommand{}, err
+	}
+	cmd := DockerCommand{
+		runner: command.New(),
+		engine: engine,
+	}
+	if engine != DockerEngine {
+		return cmd, nil
+	}
+	return cmd.withRuntime(newContainerRuntime()), nil
+}
+
+func (c DockerCommand) withRuntime(r containerRuntime) DockerCommand {
+	c.host = r.dockerHost()
+	var helperDir string
+	store, err := r.credentialStore()
+	if err == nil && store != "" {
+		helperDir, err = r.credentialHelperDir(store)
+	}
+	c.credentialHelperErr = err
+	if env := r.env(c.host, helperDir); len(env) > 0 {
+		c.runner = envRunner{
+			runner: c.runner,
+			env:    env,
+		}
+	}
+	return c
+}
+
+// Engine returns the name of the container engine that runs the commands.
+func (c DockerCommand) Engine() string {
+	if c.engine == "" {
+		return DockerEngine
+	}
+	return c.engine
+}
+
+// Host returns the address of the daemon that the commands connect to if it's not the engine's default.
+func (c DockerCommand) Host() string {
+	return c.host
+}
+
 // BuildArguments holds the arguments we can pass in as flags from the manifest.
 type BuildArguments struct {
 	URI        string            // Required. Location of ECR Repo. Used to generate image name in conjunction with tag.
@@ -38,17 +96,32 @@ type BuildArguments struct {
 	Context    string            // Optional. Build context directory to pass to `docker build`
 	Target     string            // Optional. The target build stage to pass to `docker build`
 	CacheFrom  []string          // Optional. Images to consider as cache sources to pass to `docker build`
+	CacheTo    []string          // Optional. Cache export destinations to pass to `docker buildx build`. The image is loaded in the local image store.
 	Args       map[string]string // Optional. Build args to pass via `--build-arg` flags. Equivalent to ARG directives in dockerfile.
+	Platforms  []string          // Optional. Target platforms to pass to `docker buildx build`. The image is pushed while it's built.
 }
 
 // Build will run a `docker build` command for the given ecr repo URI and build arguments.
+// If target platforms are specified, it runs `docker buildx build` instead and pushes the multi-platform image.
+// If cache export destinations are specified, it runs `docker buildx build` and loads the image for it to be pushed.
 func (c DockerCommand) Build(in *BuildArguments) error {
 	dfDir := in.Context
 	if dfDir == "" { // Context wasn't specified use the Dockerfile's directory as context.
 		dfDir = filepath.Dir(in.Dockerfile)
 	}
 
+	if c.Engine() != DockerEngine && (len(in.Platforms) > 0 || len(in.CacheTo) > 0) {
+		// Multi-platform images and cache exports rely on docker buildx.
+		return fmt.Errorf("%s can't build multi-platform images or export the build cache, use %s instead", c.Engine(), DockerEngine)
+	}
 	args := []string{"build"}
+	if len(in.Platforms) > 0 {
+		// Multi-platform images can't be loaded in the local image store, so they're pushed as soon as they're built.
+		args = []string{"buildx", "build", "--platform", strings.Join(in.Platforms, ","), "--push"}
+	} else if len(in.CacheTo) > 0 {
+		// The classic builder can't export its cache, so the image is built with BuildKit and loaded back.
+		args = []string{"buildx", "build", "--load"}
+	}
 
 	// Add additional image tags to the docker build call.
 	args = append(args, "-t", in.URI)
@@ -61,6 +134,11 @@ func (c DockerCommand) Build(in *BuildArguments) error {
 		args = append(args, "--cache-from", imageFrom)
 	}
 
+	// Add cache to options
+	for _, cacheTo := range in.CacheTo {
+		args = append(args, "--cache-to", cacheTo)
+	}
+
 	// Add target option
 	if in.Target != "" {
 		args = append(args, "--target", in.Target)
@@ -80,7 +158,7 @@ func (c DockerCommand) Build(in *BuildArguments) error {
 
 	args = append(args, dfDir, "-f", in.Dockerfile)
 
-	if err := c.Run("docker", args); err != nil {
+	if err := c.Run(c.Engine(), args); err != nil {
 		return fmt.Errorf("building image: %w", err)
 	}
 
@@ -89,7 +167,10 @@ func (c DockerCommand) Build(in *BuildArguments) error {
 
 // Login will run a `docker login` command against the Service repository URI with the input uri and auth data.
 func (c DockerCommand) Login(uri, username, password string) error {
-	err := c.Run("docker",
+	if err := c.CheckCredentialHelper(); err != nil {
+		return fmt.Errorf("authenticate to ECR: %w", err)
+	}
+	err := c.Run(c.Engine(),
 		[]string{"login", "-u", username, "--password-stdin", uri},
 		command.Stdin(strings.NewReader(password)))
 
@@ -108,12 +189,12 @@ func (c DockerCommand) Push(uri string, tags ...string) (digest string, err erro
 	}
 
 	for _, img := range images {
-		if err := c.Run("docker", []string{"push", img}); err != nil {
-			return "", fmt.Errorf("docker push %s: %w", img, err)
+		if err := c.Run(c.Engine(), []string{"push", img}); err != nil {
+			return "", fmt.Errorf("%s push %s: %w", c.Engine(), img, err)
 		}
 	}
 	buf := new(strings.Builder)
-	if err := c.Run("docker", []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", uri}, command.Stdout(buf)); err != nil {
+	if err := c.Run(c.Engine(), []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", uri}, command.Stdout(buf)); err != nil {
 		return "", fmt.Errorf("inspect image digest for %s: %w", uri, err)
 	}
 	repoDigest := strings.Trim(strings.TrimSpace(buf.String()), `"'`) // remove new lines and quotes from output
@@ -124,15 +205,86 @@ func (c DockerCommand) Push(uri string, tags ...string) (digest string, err erro
 	return parts[1], nil
 }
 
+// ManifestDigest returns the digest of the manifest pushed to the repository for the image uri.
+// For multi-platform images, it's the digest of the manifest list referencing the image of each platform.
+func (c DockerCommand) ManifestDigest(uri string) (string, error) {
+	buf := new(bytes.Buffer)
+	if err := c.Run(c.Engine(), []string{"buildx", "imagetools", "inspect", "--raw", uri}, command.Stdout(buf)); err != nil {
+		return "", fmt.Errorf("inspect manifest for %s: %w", uri, err)
+	}
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(buf.Bytes())), nil
+}
+
+// RunContainerInput holds the arguments to run a container in the background.
+type RunContainerInput struct {
+	Name       string            // Required. Name of the container.
+	Image      string            // Required. Image to run.
+	EnvVars    map[string]string // Optional. Environment variables of the container. Their values are not passed as arguments, so they don't show up in the process list.
+	Ports      map[string]string // Optional. Container ports to publish, keyed by host port.
+	NetworkOf  string            // Optional. Name of a running container to share the network namespace of, so that containers reach each other on localhost.
+	EntryPoint []string          // Optional. Override of the image's entrypoint.
+	Command    []string          // Optional. Override of the image's command.
+}
+
+// RunContainer runs a container in the background with `docker run --detach`. The container is removed once it stops.
+func (c DockerCommand) RunContainer(in *RunContainerInput) error {
+	args := []string{"run", "--detach", "--rm", "--name", in.Name}
+	if in.NetworkOf != "" {
+		args = append(args, "--network", fmt.Sprintf("container:%s", in.NetworkOf))
+	}
+	for _, hostPort := range sortedKeys(in.Ports) {
+		args = append(args, "--publish", fmt.Sprintf("%s:%s", hostPort, in.Ports[hostPort]))
+	}
+	var env []string
+	for _, name := range sortedKeys(in.EnvVars) {
+		args = append(args, "--env", name)
+		env = append(env, fmt.Sprintf("%s=%s", name, in.EnvVars[name]))
+	}
+	cmd := in.Command
+	if len(in.EntryPoint) > 0 {
+		// The --entrypoint flag only takes the executable, its arguments come before the command.
+		args = append(args, "--entrypoint", in.EntryPoint[0])
+		cmd = append(append([]string{}, in.EntryPoint[1:]...), in.Command...)
+	}
+	args = append(args, in.Image)
+	args = append(args, cmd...)
+	if err := c.Run(c.Engine(), args, command.Env(env), command.Stdout(ioutil.Discard)); err != nil {
+		return fmt.Errorf("run container %s: %w", in.Name, err)
+	}
+	return nil
+}
+
+// FollowContainerLogs writes the logs of a container to w until it stops.
+func (c DockerCommand) FollowContainerLogs(name string, w io.Writer) error {
+	if err := c.Run(c.Engine(), []string{"logs", "--follow", name}, command.Stdout(w), command.Stderr(w)); err != nil {
+		return fmt.Errorf("follow logs of container %s: %w", name, err)
+	}
+	return nil
+}
+
+// StopContainer stops a container started with RunContainer, which also removes it.
+func (c DockerCommand) StopContainer(name string) error {
+	if err := c.Run(c.Engine(), []string{"stop", name}, command.Stdout(ioutil.Discard)); err != nil {
+		return fmt.Errorf("stop container %s: %w", name, err)
+	}
+	return nil
+}
+
 // CheckDockerEngineRunning will run `docker info` command to check if the docker engine is running.
 func (c DockerCommand) CheckDockerEngineRunning() error {
-	if _, err := exec.LookPath("docker"); err != nil {
-		return ErrDockerCommandNotFound
+	lookPath := exec.LookPath
+	if c.lookPath != nil {
+		lookPath = c.lookPath
+	}
+	if _, err := lookPath(c.Engine()); err != nil {
+		return &ErrContainerEngineNotFound{
+			Engine: c.Engine(),
+		}
 	}
 	buf := &bytes.Buffer{}
-	err := c.runner.Run("docker", []string{"info", "-f", "'{{json .}}'"}, command.Stdout(buf))
+	err := c.runner.Run(c.Engine(), []string{"info", "-f", "'{{json .}}'"}, command.Stdout(buf))
 	if err != nil {
-		return fmt.Errorf("get docker info: %w", err)
+		return fmt.Errorf("get %s info: %w", c.Engine(), err)
 	}
 	if c.buf != nil {
 		buf = c.buf
@@ -145,7 +297,7 @@ func (c DockerCommand) CheckDockerEngineRunning() error {
 	}
 	var msg dockerEngineNotRunningMsg
 	if err := json.Unmarshal([]byte(out), &msg); err != nil {
-		return fmt.Errorf("unmarshal docker info message: %w", err)
+		return fmt.Errorf("unmarshal %s info message: %w", c.Engine(), err)
 	}
 	if len(msg.ServerErrors) == 0 {
 		return nil
@@ -155,6 +307,20 @@ func (c DockerCommand) CheckDockerEngineRunning() error {
 	}
 }
 
+// CheckCredentialHelper returns an error if the credential helper set by the docker config file can't be used to store credentials.
+func (c DockerCommand) CheckCredentialHelper() error {
+	return c.credentialHelperErr
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func imageName(uri, tag string) string {
 	if tag == "" {
 		return uri // If no tag is specified build with latest.
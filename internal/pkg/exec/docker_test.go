@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"os"
 	"os/exec"
 	"testing"
 
@@ -37,10 +38,32 @@ func TestDockerCommand_Build(t *testing.T) {
 		args       map[string]string
 		target     string
 		cacheFrom  []string
+		cacheTo    []string
+		platforms  []string
+		engine     string
 		setupMocks func(controller *gomock.Controller)
 
 		wantedError error
 	}{
+		"runs the build with the container engine": {
+			path:   mockPath,
+			engine: PodmanEngine,
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+				mockRunner.EXPECT().Run("podman", []string{"build",
+					"-t", mockURI,
+					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
+			},
+		},
+		"should error if the container engine can't build multi-platform images": {
+			path:      mockPath,
+			engine:    FinchEngine,
+			platforms: []string{"linux/amd64", "linux/arm64"},
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+			},
+			wantedError: errors.New("finch can't build multi-platform images or export the build cache, use docker instead"),
+		},
 		"should error if the docker build command fails": {
 			path:    mockPath,
 			context: "",
@@ -137,6 +160,45 @@ func TestDockerCommand_Build(t *testing.T) {
 					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
 			},
 		},
+		"builds and loads the image with buildx to export its cache": {
+			path:      mockPath,
+			cacheFrom: []string{"type=registry,ref=mockURI:cache"},
+			cacheTo:   []string{"type=registry,ref=mockURI:cache,mode=max"},
+			setupMocks: func(c *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(c)
+				mockRunner.EXPECT().Run("docker", []string{"buildx", "build", "--load",
+					"-t", mockURI,
+					"--cache-from", "type=registry,ref=mockURI:cache",
+					"--cache-to", "type=registry,ref=mockURI:cache,mode=max",
+					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
+			},
+		},
+		"exports the cache of a multi-platform image": {
+			path:      mockPath,
+			platforms: []string{"linux/amd64", "linux/arm64"},
+			cacheTo:   []string{"type=registry,ref=mockURI:cache"},
+			setupMocks: func(c *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(c)
+				mockRunner.EXPECT().Run("docker", []string{"buildx", "build",
+					"--platform", "linux/amd64,linux/arm64", "--push",
+					"-t", mockURI,
+					"--cache-to", "type=registry,ref=mockURI:cache",
+					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
+			},
+		},
+		"builds and pushes a multi-platform image with buildx": {
+			path:      mockPath,
+			tags:      []string{mockTag1},
+			platforms: []string{"linux/amd64", "linux/arm64"},
+			setupMocks: func(c *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(c)
+				mockRunner.EXPECT().Run("docker", []string{"buildx", "build",
+					"--platform", "linux/amd64,linux/arm64", "--push",
+					"-t", mockURI,
+					"-t", mockURI + ":" + mockTag1,
+					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -145,6 +207,7 @@ func TestDockerCommand_Build(t *testing.T) {
 			tc.setupMocks(controller)
 			s := DockerCommand{
 				runner: mockRunner,
+				engine: tc.engine,
 			}
 			buildInput := BuildArguments{
 				Context:    tc.context,
@@ -153,7 +216,9 @@ func TestDockerCommand_Build(t *testing.T) {
 				Args:       tc.args,
 				Target:     tc.target,
 				CacheFrom:  tc.cacheFrom,
+				CacheTo:    tc.cacheTo,
 				Tags:       tc.tags,
+				Platforms:  tc.platforms,
 			}
 			got := s.Build(&buildInput)
 
@@ -176,10 +241,19 @@ func TestDockerCommand_Login(t *testing.T) {
 	var mockRunner *mocks.Mockrunner
 
 	tests := map[string]struct {
-		setupMocks func(controller *gomock.Controller)
+		setupMocks          func(controller *gomock.Controller)
+		credentialHelperErr error
 
 		want error
 	}{
+		"errors if the credential helper is not installed": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+			},
+			credentialHelperErr: &ErrCredentialHelperNotFound{Store: "desktop"},
+
+			want: fmt.Errorf("authenticate to ECR: %w", &ErrCredentialHelperNotFound{Store: "desktop"}),
+		},
 		"wrap error returned from Run()": {
 			setupMocks: func(controller *gomock.Controller) {
 				mockRunner = mocks.NewMockrunner(controller)
@@ -203,7 +277,8 @@ func TestDockerCommand_Login(t *testing.T) {
 			controller := gomock.NewController(t)
 			test.setupMocks(controller)
 			s := DockerCommand{
-				runner: mockRunner,
+				runner:              mockRunner,
+				credentialHelperErr: test.credentialHelperErr,
 			}
 
 			got := s.Login(mockURI, mockUsername, mockPassword)
@@ -254,6 +329,23 @@ func TestDockerCommand_Push(t *testing.T) {
 		// THEN
 		require.EqualError(t, err, "docker push uri: some error")
 	})
+	t.Run("pushes with the container engine", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockrunner(ctrl)
+		m.EXPECT().Run("podman", []string{"push", "uri"}).Return(errors.New("some error"))
+
+		// WHEN
+		cmd := DockerCommand{
+			runner: m,
+			engine: PodmanEngine,
+		}
+		_, err := cmd.Push("uri")
+
+		// THEN
+		require.EqualError(t, err, "podman push uri: some error")
+	})
 	t.Run("returns a wrapped error on failure to retrieve image digest", func(t *testing.T) {
 		// GIVEN
 		ctrl := gomock.NewController(t)
@@ -296,16 +388,100 @@ func TestDockerCommand_Push(t *testing.T) {
 	})
 }
 
+func TestDockerCommand_withRuntime(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvVar map[string]string
+		inFiles  map[string]string
+
+		wantedHost                string
+		wantedEnv                 []string
+		wantedCredentialHelperErr error
+	}{
+		"runs commands as is with the default runtime": {
+			inFiles: map[string]string{"/var/run/docker.sock": ""},
+		},
+		"connects to the daemon of colima and finds the credential helper of docker desktop": {
+			inEnvVar: map[string]string{"PATH": "/usr/bin"},
+			inFiles: map[string]string{
+				"/home/user/.colima/default/docker.sock":                                    "",
+				"/home/user/.docker/config.json":                                            `{"credsStore": "desktop"}`,
+				"/Applications/Docker.app/Contents/Resources/bin/docker-credential-desktop": "",
+			},
+
+			wantedHost: "unix:///home/user/.colima/default/docker.sock",
+			wantedEnv: []string{
+				"DOCKER_HOST=unix:///home/user/.colima/default/docker.sock",
+				fmt.Sprintf("PATH=/usr/bin%c/Applications/Docker.app/Contents/Resources/bin", os.PathListSeparator),
+			},
+		},
+		"keeps the error of a missing credential helper": {
+			inFiles: map[string]string{
+				"/var/run/docker.sock":           "",
+				"/home/user/.docker/config.json": `{"credsStore": "desktop"}`,
+			},
+
+			wantedCredentialHelperErr: &ErrCredentialHelperNotFound{Store: "desktop"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockrunner(ctrl)
+			var env []string
+			m.EXPECT().Run("docker", []string{"push", "uri"}, gomock.Any()).
+				Do(func(_ string, _ []string, opts ...command.Option) {
+					cmd := &exec.Cmd{}
+					for _, opt := range opts {
+						opt(cmd)
+					}
+					env = cmd.Env
+				}).Return(nil)
+			r := newTestContainerRuntime("darwin", tc.inEnvVar, tc.inFiles, nil)
+
+			// WHEN
+			cmd := DockerCommand{
+				runner: m,
+				engine: DockerEngine,
+			}.withRuntime(r)
+			err := cmd.Run(cmd.Engine(), []string{"push", "uri"})
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHost, cmd.Host())
+			require.Equal(t, tc.wantedCredentialHelperErr, cmd.CheckCredentialHelper())
+			if tc.wantedEnv == nil {
+				require.Nil(t, env)
+				return
+			}
+			require.Equal(t, tc.wantedEnv, env[len(env)-len(tc.wantedEnv):])
+		})
+	}
+}
+
 func TestDockerCommand_CheckDockerEngineRunning(t *testing.T) {
 	mockError := errors.New("some error")
 	var mockRunner *mocks.Mockrunner
 
 	tests := map[string]struct {
-		setupMocks func(controller *gomock.Controller)
-		inBuffer   *bytes.Buffer
+		engine      string
+		lookPathErr error
+		setupMocks  func(controller *gomock.Controller)
+		inBuffer    *bytes.Buffer
 
 		wantedErr error
 	}{
+		"error if the engine is not installed": {
+			engine:      "podman",
+			lookPathErr: errors.New("not found"),
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+			},
+
+			wantedErr: fmt.Errorf("podman: command not found, install it or set COPILOT_CONTAINER_ENGINE to one of docker, podman, finch"),
+		},
 		"error running docker info": {
 			setupMocks: func(controller *gomock.Controller) {
 				mockRunner = mocks.NewMockrunner(controller)
@@ -341,7 +517,11 @@ func TestDockerCommand_CheckDockerEngineRunning(t *testing.T) {
 			tc.setupMocks(controller)
 			s := DockerCommand{
 				runner: mockRunner,
+				engine: tc.engine,
 				buf:    tc.inBuffer,
+				lookPath: func(string) (string, error) {
+					return "", tc.lookPathErr
+				},
 			}
 
 			err := s.CheckDockerEngineRunning()
@@ -353,3 +533,189 @@ func TestDockerCommand_CheckDockerEngineRunning(t *testing.T) {
 		})
 	}
 }
+
+func TestDockerCommand_ManifestDigest(t *testing.T) {
+	t.Run("returns the digest of the raw manifest", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockrunner(ctrl)
+		m.EXPECT().Run("docker", []string{"buildx", "imagetools", "inspect", "--raw", "uri"}, gomock.Any()).
+			Do(func(_ string, _ []string, opt command.Option) {
+				cmd := &exec.Cmd{}
+				opt(cmd)
+				_, _ = cmd.Stdout.Write([]byte("hello"))
+			}).Return(nil)
+
+		// WHEN
+		cmd := DockerCommand{
+			runner: m,
+		}
+		digest, err := cmd.ManifestDigest("uri")
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", digest)
+	})
+	t.Run("returns a wrapped error on failure to inspect the manifest", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockrunner(ctrl)
+		m.EXPECT().Run("docker", []string{"buildx", "imagetools", "inspect", "--raw", "uri"}, gomock.Any()).Return(errors.New("some error"))
+
+		// WHEN
+		cmd := DockerCommand{
+			runner: m,
+		}
+		_, err := cmd.ManifestDigest("uri")
+
+		// THEN
+		require.EqualError(t, err, "inspect manifest for uri: some error")
+	})
+}
+
+func TestDockerCommand_RunContainer(t *testing.T) {
+	testCases := map[string]struct {
+		in         *RunContainerInput
+		runErr     error
+		wantedArgs []string
+		wantedEnv  []string
+		wantedErr  error
+	}{
+		"runs the container with its ports and environment variables": {
+			in: &RunContainerInput{
+				Name:  "phonetool-frontend",
+				Image: "phonetool/frontend",
+				EnvVars: map[string]string{
+					"LOG_LEVEL":   "debug",
+					"DB_PASSWORD": "hunter2",
+				},
+				Ports: map[string]string{
+					"8080": "80",
+					"2000": "2000",
+				},
+				Command: []string{"serve", "--verbose"},
+			},
+			wantedArgs: []string{"run", "--detach", "--rm", "--name", "phonetool-frontend",
+				"--publish", "2000:2000", "--publish", "8080:80",
+				"--env", "DB_PASSWORD", "--env", "LOG_LEVEL",
+				"phonetool/frontend", "serve", "--verbose"},
+			wantedEnv: []string{"DB_PASSWORD=hunter2", "LOG_LEVEL=debug"},
+		},
+		"joins the network of another container and overrides the entrypoint": {
+			in: &RunContainerInput{
+				Name:       "phonetool-frontend-nginx",
+				Image:      "nginx",
+				NetworkOf:  "phonetool-frontend",
+				EntryPoint: []string{"/bin/sh", "-c"},
+				Command:    []string{"nginx"},
+			},
+			wantedArgs: []string{"run", "--detach", "--rm", "--name", "phonetool-frontend-nginx",
+				"--network", "container:phonetool-frontend",
+				"--entrypoint", "/bin/sh", "nginx", "-c", "nginx"},
+		},
+		"wraps the error": {
+			in: &RunContainerInput{
+				Name:  "phonetool-frontend",
+				Image: "phonetool/frontend",
+			},
+			runErr:     errors.New("some error"),
+			wantedArgs: []string{"run", "--detach", "--rm", "--name", "phonetool-frontend", "phonetool/frontend"},
+			wantedErr:  errors.New("run container phonetool-frontend: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockrunner(ctrl)
+			m.EXPECT().Run("docker", tc.wantedArgs, gomock.Any(), gomock.Any()).
+				Do(func(_ string, _ []string, opts ...command.Option) {
+					cmd := &exec.Cmd{}
+					for _, opt := range opts {
+						opt(cmd)
+					}
+					for _, env := range tc.wantedEnv {
+						require.Contains(t, cmd.Env, env)
+					}
+				}).Return(tc.runErr)
+			cmd := DockerCommand{
+				runner: m,
+			}
+
+			// WHEN
+			err := cmd.RunContainer(tc.in)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDockerCommand_FollowContainerLogs(t *testing.T) {
+	t.Run("writes the logs of the container", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockrunner(ctrl)
+		m.EXPECT().Run("docker", []string{"logs", "--follow", "phonetool-frontend"}, gomock.Any(), gomock.Any()).
+			Do(func(_ string, _ []string, opts ...command.Option) {
+				cmd := &exec.Cmd{}
+				for _, opt := range opts {
+					opt(cmd)
+				}
+				_, _ = cmd.Stdout.Write([]byte("listening on :80\n"))
+				_, _ = cmd.Stderr.Write([]byte("GET / 200\n"))
+			}).Return(nil)
+		buf := new(bytes.Buffer)
+
+		// WHEN
+		err := DockerCommand{runner: m}.FollowContainerLogs("phonetool-frontend", buf)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, "listening on :80\nGET / 200\n", buf.String())
+	})
+	t.Run("wraps the error", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockrunner(ctrl)
+		m.EXPECT().Run("docker", []string{"logs", "--follow", "phonetool-frontend"}, gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+
+		// WHEN
+		err := DockerCommand{runner: m}.FollowContainerLogs("phonetool-frontend", new(bytes.Buffer))
+
+		// THEN
+		require.EqualError(t, err, "follow logs of container phonetool-frontend: some error")
+	})
+}
+
+func TestDockerCommand_StopContainer(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockrunner(ctrl)
+	m.EXPECT().Run("docker", []string{"stop", "phonetool-frontend"}, gomock.Any()).Return(errors.New("some error"))
+
+	// WHEN
+	err := DockerCommand{runner: m}.StopContainer("phonetool-frontend")
+
+	// THEN
+	require.EqualError(t, err, "stop container phonetool-frontend: some error")
+}
+
+func TestErrContainerEngineNotFound(t *testing.T) {
+	err := &ErrContainerEngineNotFound{
+		Engine: "podman",
+	}
+
+	require.True(t, errors.Is(err, ErrDockerCommandNotFound))
+}
@@ -0,0 +1,47 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Container engines that can build, login and push images.
+const (
+	DockerEngine = "docker"
+	PodmanEngine = "podman"
+	FinchEngine  = "finch"
+)
+
+// EngineEnvVar is the environment variable that sets the container engine instead of detecting it.
+const EngineEnvVar = "COPILOT_CONTAINER_ENGINE"
+
+// Engines are the supported container engines, in the order they're detected.
+var Engines = []string{DockerEngine, PodmanEngine, FinchEngine}
+
+// DetectEngine returns the container engine set by the COPILOT_CONTAINER_ENGINE environment variable.
+// If the variable is not set, it returns the first of the Engines installed, or docker if none of them is.
+func DetectEngine() (string, error) {
+	return detectEngine(os.LookupEnv, exec.LookPath)
+}
+
+func detectEngine(lookupEnv func(string) (string, bool), lookPath func(string) (string, error)) (string, error) {
+	if value, ok := lookupEnv(EngineEnvVar); ok && value != "" {
+		for _, engine := range Engines {
+			if strings.ToLower(value) == engine {
+				return engine, nil
+			}
+		}
+		return "", fmt.Errorf("container engine %q set by %s is not supported, must be one of %s", value, EngineEnvVar, strings.Join(Engines, ", "))
+	}
+	for _, engine := range Engines {
+		if _, err := lookPath(engine); err == nil {
+			return engine, nil
+		}
+	}
+	return DockerEngine, nil
+}
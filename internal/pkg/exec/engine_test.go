@@ -0,0 +1,76 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectEngine(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvVar    map[string]string
+		inInstalled []string
+
+		wantedEngine string
+		wantedError  error
+	}{
+		"uses the engine of the environment variable": {
+			inEnvVar:    map[string]string{EngineEnvVar: "Finch"},
+			inInstalled: []string{"docker", "finch"},
+
+			wantedEngine: FinchEngine,
+		},
+		"errors if the engine of the environment variable is not supported": {
+			inEnvVar: map[string]string{EngineEnvVar: "nerdctl"},
+
+			wantedError: errors.New(`container engine "nerdctl" set by COPILOT_CONTAINER_ENGINE is not supported, must be one of docker, podman, finch`),
+		},
+		"prefers docker if several engines are installed": {
+			inInstalled: []string{"finch", "podman", "docker"},
+
+			wantedEngine: DockerEngine,
+		},
+		"detects podman": {
+			inEnvVar:    map[string]string{EngineEnvVar: ""},
+			inInstalled: []string{"finch", "podman"},
+
+			wantedEngine: PodmanEngine,
+		},
+		"defaults to docker if no engine is installed": {
+			wantedEngine: DockerEngine,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			lookupEnv := func(key string) (string, bool) {
+				value, ok := tc.inEnvVar[key]
+				return value, ok
+			}
+			lookPath := func(file string) (string, error) {
+				for _, installed := range tc.inInstalled {
+					if installed == file {
+						return "/usr/local/bin/" + file, nil
+					}
+				}
+				return "", errors.New("executable file not found in $PATH")
+			}
+
+			// WHEN
+			engine, err := detectEngine(lookupEnv, lookPath)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEngine, engine)
+		})
+	}
+}
@@ -6,6 +6,7 @@ package exec
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 // ErrInvalidPort means that while there was a port provided, it was out of bounds or unparseable
@@ -46,6 +47,22 @@ func (e ErrOutdatedSSMPlugin) Error() string {
 // ErrDockerCommandNotFound means the docker command is not found.
 var ErrDockerCommandNotFound = errors.New("docker: command not found")
 
+// ErrContainerEngineNotFound means the command of the container engine is not found.
+// It matches ErrDockerCommandNotFound with errors.Is, whichever the engine is.
+type ErrContainerEngineNotFound struct {
+	Engine string
+}
+
+func (e *ErrContainerEngineNotFound) Error() string {
+	return fmt.Sprintf("%s: command not found, install it or set %s to one of %s",
+		e.Engine, EngineEnvVar, strings.Join(Engines, ", "))
+}
+
+// Is returns true if target is ErrDockerCommandNotFound.
+func (e *ErrContainerEngineNotFound) Is(target error) bool {
+	return target == ErrDockerCommandNotFound
+}
+
 // ErrDockerDaemonNotResponsive means the docker daemon is not responsive.
 type ErrDockerDaemonNotResponsive struct {
 	msg string
@@ -54,3 +71,12 @@ type ErrDockerDaemonNotResponsive struct {
 func (e ErrDockerDaemonNotResponsive) Error() string {
 	return fmt.Sprintf("docker daemon is not responsive: %s", e.msg)
 }
+
+// ErrCredentialHelperNotFound means the credential helper set by the docker config file is not installed.
+type ErrCredentialHelperNotFound struct {
+	Store string
+}
+
+func (e *ErrCredentialHelperNotFound) Error() string {
+	return fmt.Sprintf("credential helper docker-credential-%s set by \"credsStore\" in the docker config file is not installed", e.Store)
+}
@@ -0,0 +1,167 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+)
+
+// HostEnvVar is the environment variable that sets the docker daemon socket instead of detecting it.
+const HostEnvVar = "COPILOT_DOCKER_HOST"
+
+const (
+	dockerHostEnvVar   = "DOCKER_HOST"
+	dockerConfigEnvVar = "DOCKER_CONFIG"
+
+	defaultDockerSocket = "/var/run/docker.sock"
+)
+
+// Sockets of Docker Desktop alternatives relative to the home directory, in the order they're detected.
+var alternativeDockerSockets = []string{
+	".docker/run/docker.sock",     // Docker Desktop without the privileged helper.
+	".colima/default/docker.sock", // Colima.
+	".colima/docker.sock",         // Colima before v0.4.
+	".rd/docker.sock",             // Rancher Desktop.
+}
+
+// Directories where Docker Desktop and its alternatives install credential helpers outside of the PATH.
+var credentialHelperDirs = map[string][]string{
+	"darwin": {
+		"/Applications/Docker.app/Contents/Resources/bin",
+		"~/.rd/bin",
+	},
+	"linux": {
+		"~/.rd/bin",
+	},
+	"windows": {
+		`C:\Program Files\Docker\Docker\resources\bin`,
+		`~\.rd\bin`,
+	},
+}
+
+// containerRuntime holds the functions to inspect the host's container runtime.
+type containerRuntime struct {
+	goos       string
+	homeDir    string
+	lookupEnv  func(string) (string, bool)
+	lookPath   func(string) (string, error)
+	fileExists func(string) bool
+	readFile   func(string) ([]byte, error)
+}
+
+func newContainerRuntime() containerRuntime {
+	homeDir, _ := os.UserHomeDir()
+	return containerRuntime{
+		goos:      runtime.GOOS,
+		homeDir:   homeDir,
+		lookupEnv: os.LookupEnv,
+		lookPath:  exec.LookPath,
+		fileExists: func(path string) bool {
+			_, err := os.Stat(path)
+			return err == nil
+		},
+		readFile: ioutil.ReadFile,
+	}
+}
+
+// dockerHost returns the address of the docker daemon to connect to if it's not the engine's default.
+// The COPILOT_DOCKER_HOST environment variable takes precedence, then DOCKER_HOST and the default socket are left to the engine.
+// Otherwise, it returns the socket of the first Docker Desktop alternative running on the host.
+func (r containerRuntime) dockerHost() string {
+	if host, ok := r.lookupEnv(HostEnvVar); ok && host != "" {
+		return host
+	}
+	if host, ok := r.lookupEnv(dockerHostEnvVar); ok && host != "" {
+		return ""
+	}
+	if r.goos == "windows" || r.fileExists(defaultDockerSocket) {
+		// Docker Desktop on Windows listens on a named pipe that the docker CLI connects to by default.
+		return ""
+	}
+	for _, socket := range alternativeDockerSockets {
+		path := filepath.Join(r.homeDir, socket)
+		if r.fileExists(path) {
+			return "unix://" + path
+		}
+	}
+	return ""
+}
+
+// credentialStore returns the credential helper set by "credsStore" in the docker config file.
+// It returns an empty string if the config file doesn't exist or doesn't set a credential helper.
+func (r containerRuntime) credentialStore() (string, error) {
+	dir, ok := r.lookupEnv(dockerConfigEnvVar)
+	if !ok || dir == "" {
+		dir = filepath.Join(r.homeDir, ".docker")
+	}
+	path := filepath.Join(dir, "config.json")
+	if !r.fileExists(path) {
+		return "", nil
+	}
+	content, err := r.readFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read docker config file %s: %w", path, err)
+	}
+	var cfg struct {
+		CredsStore string `json:"credsStore"`
+	}
+	if err := json.Unmarshal(content, &cfg); err != nil {
+		return "", fmt.Errorf("unmarshal docker config file %s: %w", path, err)
+	}
+	return cfg.CredsStore, nil
+}
+
+// credentialHelperDir returns the directory of a credential helper that isn't in the PATH.
+// It returns an empty string if the helper is in the PATH, and ErrCredentialHelperNotFound if it's not installed.
+func (r containerRuntime) credentialHelperDir(store string) (string, error) {
+	binary := "docker-credential-" + store
+	if _, err := r.lookPath(binary); err == nil {
+		return "", nil
+	}
+	if r.goos == "windows" {
+		binary += ".exe"
+	}
+	for _, dir := range credentialHelperDirs[r.goos] {
+		if strings.HasPrefix(dir, "~") {
+			dir = filepath.Join(r.homeDir, dir[1:])
+		}
+		if r.fileExists(filepath.Join(dir, binary)) {
+			return dir, nil
+		}
+	}
+	return "", &ErrCredentialHelperNotFound{Store: store}
+}
+
+// env returns the environment variables for the engine to use the detected runtime.
+func (r containerRuntime) env(host, helperDir string) []string {
+	var env []string
+	if host != "" {
+		env = append(env, fmt.Sprintf("%s=%s", dockerHostEnvVar, host))
+	}
+	if helperDir != "" {
+		path, _ := r.lookupEnv("PATH")
+		env = append(env, fmt.Sprintf("PATH=%s%c%s", path, os.PathListSeparator, helperDir))
+	}
+	return env
+}
+
+// envRunner runs commands with additional environment variables.
+type envRunner struct {
+	runner
+	env []string
+}
+
+// Run runs the command with the additional environment variables.
+func (r envRunner) Run(name string, args []string, options ...command.Option) error {
+	return r.runner.Run(name, args, append([]command.Option{command.Env(r.env)}, options...)...)
+}
@@ -0,0 +1,213 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestContainerRuntime(goos string, envVars map[string]string, files map[string]string, binaries []string) containerRuntime {
+	return containerRuntime{
+		goos:    goos,
+		homeDir: "/home/user",
+		lookupEnv: func(key string) (string, bool) {
+			value, ok := envVars[key]
+			return value, ok
+		},
+		lookPath: func(file string) (string, error) {
+			for _, binary := range binaries {
+				if binary == file {
+					return "/usr/local/bin/" + file, nil
+				}
+			}
+			return "", errors.New("executable file not found in $PATH")
+		},
+		fileExists: func(path string) bool {
+			_, ok := files[path]
+			return ok
+		},
+		readFile: func(path string) ([]byte, error) {
+			content, ok := files[path]
+			if !ok {
+				return nil, os.ErrNotExist
+			}
+			return []byte(content), nil
+		},
+	}
+}
+
+func TestContainerRuntime_dockerHost(t *testing.T) {
+	testCases := map[string]struct {
+		inGOOS   string
+		inEnvVar map[string]string
+		inFiles  []string
+
+		wantedHost string
+	}{
+		"uses the host of the environment variable": {
+			inEnvVar: map[string]string{HostEnvVar: "tcp://127.0.0.1:2375", "DOCKER_HOST": "unix:///var/run/docker.sock"},
+			inFiles:  []string{"/home/user/.colima/default/docker.sock"},
+
+			wantedHost: "tcp://127.0.0.1:2375",
+		},
+		"leaves DOCKER_HOST to the engine": {
+			inEnvVar: map[string]string{"DOCKER_HOST": "unix:///home/user/.lima/docker.sock"},
+			inFiles:  []string{"/home/user/.colima/default/docker.sock"},
+		},
+		"uses the default socket if it exists": {
+			inFiles: []string{"/var/run/docker.sock", "/home/user/.colima/default/docker.sock"},
+		},
+		"uses the default named pipe on windows": {
+			inGOOS:  "windows",
+			inFiles: []string{"/home/user/.rd/docker.sock"},
+		},
+		"detects colima": {
+			inFiles: []string{"/home/user/.colima/default/docker.sock", "/home/user/.rd/docker.sock"},
+
+			wantedHost: "unix:///home/user/.colima/default/docker.sock",
+		},
+		"detects rancher desktop": {
+			inEnvVar: map[string]string{HostEnvVar: ""},
+			inFiles:  []string{"/home/user/.rd/docker.sock"},
+
+			wantedHost: "unix:///home/user/.rd/docker.sock",
+		},
+		"uses the engine's default if no runtime is detected": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			goos := "darwin"
+			if tc.inGOOS != "" {
+				goos = tc.inGOOS
+			}
+			files := make(map[string]string)
+			for _, file := range tc.inFiles {
+				files[file] = ""
+			}
+			r := newTestContainerRuntime(goos, tc.inEnvVar, files, nil)
+
+			// WHEN
+			host := r.dockerHost()
+
+			// THEN
+			require.Equal(t, tc.wantedHost, host)
+		})
+	}
+}
+
+func TestContainerRuntime_credentialStore(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvVar map[string]string
+		inFiles  map[string]string
+
+		wantedStore string
+		wantedError error
+	}{
+		"no config file": {},
+		"config file without credential helper": {
+			inFiles: map[string]string{"/home/user/.docker/config.json": `{"auths": {}}`},
+		},
+		"reads the credential helper of the config file": {
+			inFiles: map[string]string{"/home/user/.docker/config.json": `{"credsStore": "desktop"}`},
+
+			wantedStore: "desktop",
+		},
+		"reads the config file of the DOCKER_CONFIG directory": {
+			inEnvVar: map[string]string{"DOCKER_CONFIG": "/home/user/.rd/docker"},
+			inFiles: map[string]string{
+				"/home/user/.docker/config.json":    `{"credsStore": "desktop"}`,
+				"/home/user/.rd/docker/config.json": `{"credsStore": "osxkeychain"}`,
+			},
+
+			wantedStore: "osxkeychain",
+		},
+		"errors if the config file is malformed": {
+			inFiles: map[string]string{"/home/user/.docker/config.json": `{`},
+
+			wantedError: fmt.Errorf("unmarshal docker config file /home/user/.docker/config.json: unexpected end of JSON input"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			r := newTestContainerRuntime("linux", tc.inEnvVar, tc.inFiles, nil)
+
+			// WHEN
+			store, err := r.credentialStore()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStore, store)
+		})
+	}
+}
+
+func TestContainerRuntime_credentialHelperDir(t *testing.T) {
+	testCases := map[string]struct {
+		inGOOS     string
+		inFiles    []string
+		inBinaries []string
+
+		wantedDir   string
+		wantedError error
+	}{
+		"helper in the PATH": {
+			inGOOS:     "darwin",
+			inBinaries: []string{"docker-credential-desktop"},
+			inFiles:    []string{"/Applications/Docker.app/Contents/Resources/bin/docker-credential-desktop"},
+		},
+		"helper of Docker Desktop on macOS": {
+			inGOOS:  "darwin",
+			inFiles: []string{"/Applications/Docker.app/Contents/Resources/bin/docker-credential-desktop"},
+
+			wantedDir: "/Applications/Docker.app/Contents/Resources/bin",
+		},
+		"helper of Rancher Desktop": {
+			inGOOS:  "linux",
+			inFiles: []string{"/home/user/.rd/bin/docker-credential-desktop"},
+
+			wantedDir: "/home/user/.rd/bin",
+		},
+		"helper is not installed": {
+			inGOOS:  "linux",
+			inFiles: []string{"/Applications/Docker.app/Contents/Resources/bin/docker-credential-desktop"},
+
+			wantedError: &ErrCredentialHelperNotFound{Store: "desktop"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			files := make(map[string]string)
+			for _, file := range tc.inFiles {
+				files[file] = ""
+			}
+			r := newTestContainerRuntime(tc.inGOOS, nil, files, tc.inBinaries)
+
+			// WHEN
+			dir, err := r.credentialHelperDir("desktop")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDir, dir)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+)
+
+// hostsFileTemplate is the containerd registry host configuration that authenticates requests to the registry.
+// See https://github.com/containerd/containerd/blob/main/docs/hosts.md.
+const hostsFileTemplate = `server = "https://%[1]s"
+
+[host."https://%[1]s"]
+  capabilities = ["pull", "resolve", "push"]
+
+  [host."https://%[1]s".header]
+    Authorization = "Basic %[2]s"
+`
+
+// SOCICommand represents Seekable OCI (SOCI) commands that can be run.
+type SOCICommand struct {
+	runner
+
+	tempDir string // Directory of the registry credentials. Created and removed by CreateAndPush if empty.
+}
+
+// NewSOCICommand returns a SOCICommand.
+func NewSOCICommand() SOCICommand {
+	return SOCICommand{
+		runner: command.New(),
+	}
+}
+
+// CreateAndPush creates a SOCI index for the image and pushes it to the image's repository,
+// so that Fargate can start containers before the image is fully downloaded.
+// Indexes are created from containerd's content store, so the image is pulled with `ctr` first.
+// Both `ctr` and `soci` connect to the containerd socket, which usually requires running as root.
+//
+// The credentials are written to a registry host configuration file that only the current user can read,
+// instead of being passed as arguments that other users could see in the process list.
+func (c SOCICommand) CreateAndPush(image, username, password string) error {
+	if c.tempDir == "" {
+		dir, err := ioutil.TempDir("", "copilot-soci")
+		if err != nil {
+			return fmt.Errorf("create a temporary directory: %w", err)
+		}
+		defer os.RemoveAll(dir)
+		c.tempDir = dir
+	}
+	if err := writeHostsFile(c.tempDir, registryHost(image), username, password); err != nil {
+		return err
+	}
+	if err := c.Run("ctr", []string{"image", "pull", "--hosts-dir", c.tempDir, image}); err != nil {
+		return fmt.Errorf("pull image %s into containerd: %w", image, err)
+	}
+	if err := c.Run("soci", []string{"create", image}); err != nil {
+		return fmt.Errorf("create SOCI index for %s: %w", image, err)
+	}
+	if err := c.Run("soci", []string{"push", "--hosts-dir", c.tempDir, image}); err != nil {
+		return fmt.Errorf("push SOCI index for %s: %w", image, err)
+	}
+	return nil
+}
+
+// writeHostsFile writes the credentials of the registry to <dir>/<host>/hosts.toml.
+func writeHostsFile(dir, host, username, password string) error {
+	hostDir := filepath.Join(dir, host)
+	if err := os.MkdirAll(hostDir, 0700); err != nil {
+		return fmt.Errorf("create directory for the credentials of registry %s: %w", host, err)
+	}
+	auth := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", username, password)))
+	content := fmt.Sprintf(hostsFileTemplate, host, auth)
+	if err := ioutil.WriteFile(filepath.Join(hostDir, "hosts.toml"), []byte(content), 0600); err != nil {
+		return fmt.Errorf("write credentials of registry %s: %w", host, err)
+	}
+	return nil
+}
+
+// registryHost returns the host of the registry of an image, such as "aws_account_id.dkr.ecr.region.amazonaws.com".
+func registryHost(image string) string {
+	return strings.SplitN(image, "/", 2)[0]
+}
@@ -0,0 +1,96 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSOCICommand_CreateAndPush(t *testing.T) {
+	const image = "aws_account_id.dkr.ecr.region.amazonaws.com/my-svc@sha256:abcd"
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockrunner, hostsDir string)
+
+		wantedError error
+	}{
+		"should wrap the error if the image can't be pulled": {
+			setupMocks: func(m *mocks.Mockrunner, hostsDir string) {
+				m.EXPECT().Run("ctr", []string{"image", "pull", "--hosts-dir", hostsDir, image}).Return(mockError)
+			},
+			wantedError: errors.New("pull image aws_account_id.dkr.ecr.region.amazonaws.com/my-svc@sha256:abcd into containerd: some error"),
+		},
+		"should wrap the error if the index can't be created": {
+			setupMocks: func(m *mocks.Mockrunner, hostsDir string) {
+				m.EXPECT().Run("ctr", []string{"image", "pull", "--hosts-dir", hostsDir, image}).Return(nil)
+				m.EXPECT().Run("soci", []string{"create", image}).Return(mockError)
+			},
+			wantedError: errors.New("create SOCI index for aws_account_id.dkr.ecr.region.amazonaws.com/my-svc@sha256:abcd: some error"),
+		},
+		"should wrap the error if the index can't be pushed": {
+			setupMocks: func(m *mocks.Mockrunner, hostsDir string) {
+				m.EXPECT().Run("ctr", []string{"image", "pull", "--hosts-dir", hostsDir, image}).Return(nil)
+				m.EXPECT().Run("soci", []string{"create", image}).Return(nil)
+				m.EXPECT().Run("soci", []string{"push", "--hosts-dir", hostsDir, image}).Return(mockError)
+			},
+			wantedError: errors.New("push SOCI index for aws_account_id.dkr.ecr.region.amazonaws.com/my-svc@sha256:abcd: some error"),
+		},
+		"should create and push the index": {
+			setupMocks: func(m *mocks.Mockrunner, hostsDir string) {
+				gomock.InOrder(
+					m.EXPECT().Run("ctr", []string{"image", "pull", "--hosts-dir", hostsDir, image}).Return(nil),
+					m.EXPECT().Run("soci", []string{"create", image}).Return(nil),
+					m.EXPECT().Run("soci", []string{"push", "--hosts-dir", hostsDir, image}).Return(nil),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockrunner(ctrl)
+			hostsDir := t.TempDir()
+			tc.setupMocks(m, hostsDir)
+			soci := SOCICommand{
+				runner:  m,
+				tempDir: hostsDir,
+			}
+
+			// WHEN
+			err := soci.CreateAndPush(image, "AWS", "pwd")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			hostsFile := filepath.Join(hostsDir, "aws_account_id.dkr.ecr.region.amazonaws.com", "hosts.toml")
+			content, err := ioutil.ReadFile(hostsFile)
+			require.NoError(t, err)
+			require.Equal(t, `server = "https://aws_account_id.dkr.ecr.region.amazonaws.com"
+
+[host."https://aws_account_id.dkr.ecr.region.amazonaws.com"]
+  capabilities = ["pull", "resolve", "push"]
+
+  [host."https://aws_account_id.dkr.ecr.region.amazonaws.com".header]
+    Authorization = "Basic QVdTOnB3ZA=="
+`, string(content))
+			info, err := os.Stat(hostsFile)
+			require.NoError(t, err)
+			require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+		})
+	}
+}
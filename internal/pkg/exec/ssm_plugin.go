@@ -13,8 +13,10 @@ import (
 	"os"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
 )
 
@@ -60,6 +62,29 @@ func (s SSMPluginCommand) StartSession(ssmSess *ecs.Session) error {
 	return nil
 }
 
+// StartPortForwardingSession starts a port forwarding session created by ssm:StartSession using the ssm plugin.
+// Unlike ECS Exec sessions, the plugin needs the request to know the target and the ports to forward.
+func (s SSMPluginCommand) StartPortForwardingSession(ssmSess *ssm.StartSessionOutput, request *ssm.StartSessionInput) error {
+	response, err := json.Marshal(ssmSess)
+	if err != nil {
+		return fmt.Errorf("marshal session response: %w", err)
+	}
+	params, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("marshal session request: %w", err)
+	}
+	region := aws.StringValue(s.sess.Config.Region)
+	endpoint, err := endpoints.DefaultResolver().EndpointFor(ssm.EndpointsID, region)
+	if err != nil {
+		return fmt.Errorf("resolve ssm endpoint in region %s: %w", region, err)
+	}
+	if err := s.runner.InteractiveRun(ssmPluginBinaryName,
+		[]string{string(response), region, startSessionAction, "", string(params), endpoint.URL}); err != nil {
+		return fmt.Errorf("start session: %w", err)
+	}
+	return nil
+}
+
 func download(client httpClient, filepath string, url string) error {
 	resp, err := client.Get(url)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/exec/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -84,3 +85,70 @@ func TestSSMPluginCommand_StartSession(t *testing.T) {
 		})
 	}
 }
+
+func TestSSMPluginCommand_StartPortForwardingSession(t *testing.T) {
+	mockSession := &ssm.StartSessionOutput{
+		SessionId:  aws.String("mockSessionID"),
+		StreamUrl:  aws.String("mockStreamURL"),
+		TokenValue: aws.String("mockTokenValue"),
+	}
+	mockRequest := &ssm.StartSessionInput{
+		DocumentName: aws.String("AWS-StartPortForwardingSession"),
+		Parameters: map[string][]*string{
+			"portNumber":      {aws.String("80")},
+			"localPortNumber": {aws.String("8080")},
+		},
+		Target: aws.String("ecs:cluster_task_runtime"),
+	}
+	wantedArgs := []string{
+		`{"SessionId":"mockSessionID","StreamUrl":"mockStreamURL","TokenValue":"mockTokenValue"}`,
+		"us-west-2",
+		"StartSession",
+		"",
+		`{"DocumentName":"AWS-StartPortForwardingSession","Parameters":{"localPortNumber":["8080"],"portNumber":["80"]},"Target":"ecs:cluster_task_runtime"}`,
+		"https://ssm.us-west-2.amazonaws.com",
+	}
+	tests := map[string]struct {
+		setupMocks  func(m *mocks.Mockrunner)
+		wantedError error
+	}{
+		"return error if fail to start session": {
+			setupMocks: func(m *mocks.Mockrunner) {
+				m.EXPECT().InteractiveRun(ssmPluginBinaryName, wantedArgs).Return(errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("start session: some error"),
+		},
+		"success": {
+			setupMocks: func(m *mocks.Mockrunner) {
+				m.EXPECT().InteractiveRun(ssmPluginBinaryName, wantedArgs).Return(nil)
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockrunner(ctrl)
+			tc.setupMocks(m)
+			s := SSMPluginCommand{
+				runner: m,
+				sess: &session.Session{
+					Config: &aws.Config{
+						Region: aws.String("us-west-2"),
+					},
+				},
+			}
+
+			// WHEN
+			err := s.StartPortForwardingSession(mockSession, mockRequest)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
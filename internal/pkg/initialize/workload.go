@@ -98,6 +98,11 @@ func (w *WorkloadInitializer) Service(i *ServiceProps) (string, error) {
 	return w.initService(i)
 }
 
+// ImportService writes a service manifest that was generated outside of Copilot, creates an ECR repository, and adds the service to SSM.
+func (w *WorkloadInitializer) ImportService(props *WorkloadProps, mf encoding.BinaryMarshaler) (string, error) {
+	return w.importService(props, mf)
+}
+
 // Job writes the job manifest, creates an ECR repository, and adds the job to SSM.
 func (w *WorkloadInitializer) Job(i *JobProps) (string, error) {
 	return w.initJob(i)
@@ -193,12 +198,51 @@ func (w *WorkloadInitializer) initService(props *ServiceProps) (string, error) {
 		props.appDomain = aws.String(app.Domain)
 	}
 
-	var manifestExists bool
 	mf, err := w.newServiceManifest(props)
 	if err != nil {
 		return "", err
 	}
-	manifestPath, err := w.Ws.WriteServiceManifest(mf, props.Name)
+	manifestPath, err := w.writeServiceManifest(mf, props.Name)
+	if err != nil {
+		return "", err
+	}
+
+	helpText := "Your manifest contains configurations like your container size and port."
+	if props.Port != 0 {
+		helpText = fmt.Sprintf("Your manifest contains configurations like your container size and port (:%d).", props.Port)
+	}
+	log.Infoln(color.Help(helpText))
+	log.Infoln()
+
+	err = w.addSvcToAppAndSSM(app, props.WorkloadProps)
+	if err != nil {
+		return "", err
+	}
+	return manifestPath, nil
+}
+
+func (w *WorkloadInitializer) importService(props *WorkloadProps, mf encoding.BinaryMarshaler) (string, error) {
+	app, err := w.Store.GetApplication(props.App)
+	if err != nil {
+		return "", fmt.Errorf("get application %s: %w", props.App, err)
+	}
+	manifestPath, err := w.writeServiceManifest(mf, props.Name)
+	if err != nil {
+		return "", err
+	}
+	log.Infoln()
+
+	err = w.addSvcToAppAndSSM(app, *props)
+	if err != nil {
+		return "", err
+	}
+	return manifestPath, nil
+}
+
+// writeServiceManifest writes the service manifest unless it already exists, and returns its path relative to the working directory.
+func (w *WorkloadInitializer) writeServiceManifest(mf encoding.BinaryMarshaler, name string) (string, error) {
+	var manifestExists bool
+	manifestPath, err := w.Ws.WriteServiceManifest(mf, name)
 	if err != nil {
 		e, ok := err.(*workspace.ErrFileExists)
 		if !ok {
@@ -215,19 +259,7 @@ func (w *WorkloadInitializer) initService(props *ServiceProps) (string, error) {
 	if manifestExists {
 		manifestMsgFmt = "Manifest file for %s %s already exists at %s, skipping writing it.\n"
 	}
-	log.Successf(manifestMsgFmt, svcWlType, color.HighlightUserInput(props.Name), color.HighlightResource(manifestPath))
-
-	helpText := "Your manifest contains configurations like your container size and port."
-	if props.Port != 0 {
-		helpText = fmt.Sprintf("Your manifest contains configurations like your container size and port (:%d).", props.Port)
-	}
-	log.Infoln(color.Help(helpText))
-	log.Infoln()
-
-	err = w.addSvcToAppAndSSM(app, props.WorkloadProps)
-	if err != nil {
-		return "", err
-	}
+	log.Successf(manifestMsgFmt, svcWlType, color.HighlightUserInput(name), color.HighlightResource(manifestPath))
 	return manifestPath, nil
 }
 
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/initialize/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -718,3 +719,103 @@ func TestWorkloadInitializer_Service(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkloadInitializer_ImportService(t *testing.T) {
+	mockManifest := manifest.NewBackendService(manifest.BackendServiceProps{
+		WorkloadProps: manifest.WorkloadProps{
+			Name:  "api",
+			Image: "node",
+		},
+	})
+	testCases := map[string]struct {
+		mockWriter      func(m *mocks.MockWorkspace)
+		mockstore       func(m *mocks.MockStore)
+		mockappDeployer func(m *mocks.MockWorkloadAdder)
+		mockProg        func(m *mocks.MockProg)
+
+		wantedErr error
+	}{
+		"app error": {
+			mockstore: func(m *mocks.MockStore) {
+				m.EXPECT().GetApplication("app").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get application app: some error"),
+		},
+		"write manifest error": {
+			mockWriter: func(m *mocks.MockWorkspace) {
+				m.EXPECT().WriteServiceManifest(mockManifest, "api").Return("", errors.New("some error"))
+			},
+			mockstore: func(m *mocks.MockStore) {
+				m.EXPECT().GetApplication("app").Return(&config.Application{Name: "app"}, nil)
+			},
+			wantedErr: errors.New("write service manifest: some error"),
+		},
+		"skips writing an existing manifest and adds the service to the app": {
+			mockWriter: func(m *mocks.MockWorkspace) {
+				m.EXPECT().WriteServiceManifest(mockManifest, "api").Return("", &workspace.ErrFileExists{FileName: "/api/manifest.yml"})
+			},
+			mockstore: func(m *mocks.MockStore) {
+				m.EXPECT().GetApplication("app").Return(&config.Application{Name: "app"}, nil)
+				m.EXPECT().CreateService(&config.Workload{
+					Name: "api",
+					App:  "app",
+					Type: manifest.BackendServiceType,
+				}).Return(nil)
+			},
+			mockappDeployer: func(m *mocks.MockWorkloadAdder) {
+				m.EXPECT().AddServiceToApp(&config.Application{Name: "app"}, "api").Return(nil)
+			},
+			mockProg: func(m *mocks.MockProg) {
+				m.EXPECT().Start(fmt.Sprintf(fmtAddWlToAppStart, "service", "api"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtAddWlToAppComplete, "service", "api"))
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWriter := mocks.NewMockWorkspace(ctrl)
+			mockstore := mocks.NewMockStore(ctrl)
+			mockappDeployer := mocks.NewMockWorkloadAdder(ctrl)
+			mockProg := mocks.NewMockProg(ctrl)
+
+			if tc.mockWriter != nil {
+				tc.mockWriter(mockWriter)
+			}
+			if tc.mockstore != nil {
+				tc.mockstore(mockstore)
+			}
+			if tc.mockappDeployer != nil {
+				tc.mockappDeployer(mockappDeployer)
+			}
+			if tc.mockProg != nil {
+				tc.mockProg(mockProg)
+			}
+
+			initializer := &WorkloadInitializer{
+				Store:    mockstore,
+				Ws:       mockWriter,
+				Prog:     mockProg,
+				Deployer: mockappDeployer,
+			}
+
+			// WHEN
+			_, err := initializer.ImportService(&WorkloadProps{
+				App:  "app",
+				Name: "api",
+				Type: manifest.BackendServiceType,
+			}, mockManifest)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -5,12 +5,27 @@
 package logging
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 )
 
+const (
+	// Display settings for the table of query results.
+	minCellWidth           = 20  // minimum number of characters in a table's cell.
+	tabWidth               = 4   // number of characters in between columns.
+	cellPaddingWidth       = 2   // number of padding characters added by default to a cell.
+	paddingChar            = ' ' // character in between columns.
+	noAdditionalFormatting = 0
+)
+
+// cellReplacer keeps multi-line and tab-separated values on a single row of the table.
+var cellReplacer = strings.NewReplacer("\n", " ", "\t", " ")
+
 // HumanJSONStringer can output in both human-readable and JSON format.
 type HumanJSONStringer interface {
 	HumanString() string
@@ -37,10 +52,49 @@ func WriteHumanLogs(w io.Writer, logStringers []HumanJSONStringer) error {
 	return nil
 }
 
+// WriteJSONQueryResults outputs each row of a CloudWatch Logs Insights query as a JSON object.
+func WriteJSONQueryResults(w io.Writer, results *cloudwatchlogs.QueryResults) error {
+	for _, row := range results.Rows {
+		data, err := json.Marshal(row)
+		if err != nil {
+			return fmt.Errorf("marshal query result to JSON: %w", err)
+		}
+		fmt.Fprintln(w, string(data))
+	}
+	return nil
+}
+
+// WriteHumanQueryResults outputs the rows of a CloudWatch Logs Insights query as a table.
+func WriteHumanQueryResults(w io.Writer, results *cloudwatchlogs.QueryResults) error {
+	if len(results.Rows) == 0 {
+		fmt.Fprintln(w, "No log events matched the query.")
+		return nil
+	}
+	writer := tabwriter.NewWriter(w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	var underlines []string
+	for _, field := range results.Fields {
+		underlines = append(underlines, strings.Repeat("-", len(field)))
+	}
+	fmt.Fprintf(writer, "%s\n", strings.Join(results.Fields, "\t"))
+	fmt.Fprintf(writer, "%s\n", strings.Join(underlines, "\t"))
+	for _, row := range results.Rows {
+		var values []string
+		for _, field := range results.Fields {
+			values = append(values, cellReplacer.Replace(row[field]))
+		}
+		fmt.Fprintf(writer, "%s\n", strings.Join(values, "\t"))
+	}
+	return writer.Flush()
+}
+
 func cwEventsToHumanJSONStringers(events []*cloudwatchlogs.Event) []HumanJSONStringer {
 	// golang limitation: https://golang.org/doc/faq#convert_slice_of_interface
 	logStringers := make([]HumanJSONStringer, len(events))
 	for ind, event := range events {
+		if strings.HasPrefix(event.LogStreamName, stateMachineLogStreamPrefix) {
+			logStringers[ind] = &stateMachineEvent{event}
+			continue
+		}
 		logStringers[ind] = event
 	}
 	return logStringers
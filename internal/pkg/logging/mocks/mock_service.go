@@ -48,3 +48,41 @@ func (mr *MocklogGetterMockRecorder) LogEvents(opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogEvents", reflect.TypeOf((*MocklogGetter)(nil).LogEvents), opts)
 }
+
+// MocklogQuerier is a mock of logQuerier interface.
+type MocklogQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogQuerierMockRecorder
+}
+
+// MocklogQuerierMockRecorder is the mock recorder for MocklogQuerier.
+type MocklogQuerierMockRecorder struct {
+	mock *MocklogQuerier
+}
+
+// NewMocklogQuerier creates a new mock instance.
+func NewMocklogQuerier(ctrl *gomock.Controller) *MocklogQuerier {
+	mock := &MocklogQuerier{ctrl: ctrl}
+	mock.recorder = &MocklogQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklogQuerier) EXPECT() *MocklogQuerierMockRecorder {
+	return m.recorder
+}
+
+// Query mocks base method.
+func (m *MocklogQuerier) Query(in cloudwatchlogs.QueryInput) (*cloudwatchlogs.QueryResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", in)
+	ret0, _ := ret[0].(*cloudwatchlogs.QueryResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MocklogQuerierMockRecorder) Query(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MocklogQuerier)(nil).Query), in)
+}
@@ -7,31 +7,42 @@ package logging
 import (
 	"fmt"
 	"io"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 )
 
 const (
 	defaultServiceLogsLimit = 10
+	defaultQueryPeriod      = time.Hour
 
-	fmtSvclogGroupName    = "/copilot/%s-%s-%s"
-	fmtSvcLogStreamPrefix = "copilot/%s"
+	taskLogStreamPrefix            = "copilot/"
+	fmtSvcLogStreamPrefix          = "copilot/%s"
+	fmtStateMachineLogStreamPrefix = "states/%s-%s-%s" // Step Functions names log streams "states/{state machine}/{time}/{id}".
 )
 
 type logGetter interface {
 	LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error)
 }
 
-// ServiceClient retrieves the logs of an Amazon ECS service.
+type logQuerier interface {
+	Query(in cloudwatchlogs.QueryInput) (*cloudwatchlogs.QueryResults, error)
+}
+
+// ServiceClient retrieves the logs of an Amazon ECS service or of a job.
 type ServiceClient struct {
-	logGroupName        string
-	logStreamNamePrefix string
-	eventsGetter        logGetter
-	w                   io.Writer
+	logGroupName                string
+	logStreamNamePrefix         string
+	stateMachineLogStreamPrefix string // Empty unless the workload is a job.
+	eventsGetter                logGetter
+	querier                     logQuerier
+	w                           io.Writer
 }
 
 // WriteLogEventsOpts wraps the parameters to call WriteLogEvents.
@@ -41,10 +52,23 @@ type WriteLogEventsOpts struct {
 	StartTime *int64
 	EndTime   *int64
 	TaskIDs   []string
+	// IncludeStateMachine interleaves the execution events of a job's state machine with the logs of its tasks.
+	IncludeStateMachine bool
 	// OnEvents is a handler that's invoked when logs are retrieved from the service.
 	OnEvents func(w io.Writer, logs []HumanJSONStringer) error
 }
 
+// QueryOpts wraps the parameters to call WriteQueryResults.
+type QueryOpts struct {
+	Query     string // A CloudWatch Logs Insights query.
+	Limit     *int64
+	StartTime *int64 // Unix time in milliseconds. If nil, the query starts an hour ago.
+	EndTime   *int64 // Unix time in milliseconds. If nil, the query ends now.
+	TaskIDs   []string
+	// OnResults is a handler that's invoked with the rows matched by the query.
+	OnResults func(w io.Writer, results *cloudwatchlogs.QueryResults) error
+}
+
 func (o WriteLogEventsOpts) limit() *int64 {
 	if o.Limit != nil {
 		return o.Limit
@@ -57,17 +81,27 @@ func (o WriteLogEventsOpts) limit() *int64 {
 	return aws.Int64(defaultServiceLogsLimit)
 }
 
-// NewServiceClient returns a ServiceClient for the svc service under env and app.
+// NewServiceClient returns a ServiceClient for the svc service deployed in env.
 // The logging client is initialized from the given sess session.
-func NewServiceClient(sess *session.Session, app, env, svc string) *ServiceClient {
+func NewServiceClient(sess *session.Session, env *config.Environment, svc string) *ServiceClient {
+	cwlogs := cloudwatchlogs.New(sess)
 	return &ServiceClient{
-		logGroupName:        fmt.Sprintf(fmtSvclogGroupName, app, env, svc),
+		logGroupName:        env.WorkloadLogGroupName(svc),
 		logStreamNamePrefix: fmt.Sprintf(fmtSvcLogStreamPrefix, svc),
-		eventsGetter:        cloudwatchlogs.New(sess),
+		eventsGetter:        cwlogs,
+		querier:             cwlogs,
 		w:                   log.OutputWriter,
 	}
 }
 
+// NewJobClient returns a ServiceClient for the job deployed in env.
+// The job's state machine writes its execution events to the same log group as the job's tasks.
+func NewJobClient(sess *session.Session, env *config.Environment, job string) *ServiceClient {
+	client := NewServiceClient(sess, env, job)
+	client.stateMachineLogStreamPrefix = fmt.Sprintf(fmtStateMachineLogStreamPrefix, env.App, env.Name, job)
+	return client
+}
+
 // WriteLogEvents writes service logs.
 func (s *ServiceClient) WriteLogEvents(opts WriteLogEventsOpts) error {
 	logEventsOpts := cloudwatchlogs.LogEventsOpts{
@@ -75,7 +109,7 @@ func (s *ServiceClient) WriteLogEvents(opts WriteLogEventsOpts) error {
 		Limit:      opts.limit(),
 		EndTime:    opts.EndTime,
 		StartTime:  opts.StartTime,
-		LogStreams: s.logStreams(opts.TaskIDs),
+		LogStreams: s.logStreams(opts),
 	}
 	for {
 		logEventsOutput, err := s.eventsGetter.LogEvents(logEventsOpts)
@@ -97,9 +131,59 @@ func (s *ServiceClient) WriteLogEvents(opts WriteLogEventsOpts) error {
 	}
 }
 
-func (s *ServiceClient) logStreams(taskIDs []string) (logStreamName []string) {
-	for _, taskID := range taskIDs {
+// WriteQueryResults runs a CloudWatch Logs Insights query on the log events of the service's containers,
+// sidecars included, and writes the matched rows.
+func (s *ServiceClient) WriteQueryResults(opts QueryOpts) error {
+	now := time.Now()
+	startTime := now.Add(-defaultQueryPeriod).Unix() * 1000
+	if opts.StartTime != nil {
+		startTime = aws.Int64Value(opts.StartTime)
+	}
+	endTime := now.Unix() * 1000
+	if opts.EndTime != nil {
+		endTime = aws.Int64Value(opts.EndTime)
+	}
+	results, err := s.querier.Query(cloudwatchlogs.QueryInput{
+		LogGroups: []string{s.logGroupName},
+		Query:     queryTasks(opts.Query, opts.TaskIDs),
+		StartTime: startTime,
+		EndTime:   endTime,
+		Limit:     opts.Limit,
+	})
+	if err != nil {
+		return fmt.Errorf("query log group %s: %w", s.logGroupName, err)
+	}
+	return opts.OnResults(s.w, results)
+}
+
+// queryTasks restricts the query to the log streams of the tasks, if any.
+// Log streams are named "copilot/{container}/{taskID}" so that the streams of sidecars match as well.
+func queryTasks(query string, taskIDs []string) string {
+	if len(taskIDs) == 0 {
+		return query
+	}
+	var ids []string
+	for _, id := range taskIDs {
+		ids = append(ids, regexp.QuoteMeta(id))
+	}
+	return fmt.Sprintf("filter @logStream like /(%s)/ | %s", strings.Join(ids, "|"), query)
+}
+
+// logStreams returns the prefixes of the log streams to retrieve events from.
+func (s *ServiceClient) logStreams(opts WriteLogEventsOpts) (logStreamName []string) {
+	for _, taskID := range opts.TaskIDs {
 		logStreamName = append(logStreamName, fmt.Sprintf("%s/%s", s.logStreamNamePrefix, taskID))
 	}
+	if s.stateMachineLogStreamPrefix == "" {
+		return
+	}
+	// Jobs share their log group with their state machine, so restrict the streams to the tasks' unless asked otherwise.
+	if len(logStreamName) == 0 {
+		// Match the streams of sidecars as well, which are named "copilot/{container}/{taskID}".
+		logStreamName = append(logStreamName, taskLogStreamPrefix)
+	}
+	if opts.IncludeStateMachine {
+		logStreamName = append(logStreamName, s.stateMachineLogStreamPrefix)
+	}
 	return
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	"github.com/aws/copilot-cli/internal/pkg/logging/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -184,3 +185,189 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 		})
 	}
 }
+
+func TestServiceClient_WriteLogEventsOfJob(t *testing.T) {
+	logEvents := []*cloudwatchlogs.Event{
+		{
+			LogStreamName: "states/phonetool-test-report/2021-07-01-00-00/00000000",
+			Message:       `{"id":"1","type":"ExecutionStarted","details":{"input":"{}"},"execution_arn":"arn:aws:states:us-west-2:123456789012:execution:phonetool-test-report:1a2b3c4d-5e6f"}`,
+		},
+		{
+			LogStreamName: "copilot/report/1de57fd63c6a4920ac416d02add891b9",
+			Message:       "generating report",
+		},
+		{
+			LogStreamName: "states/phonetool-test-report/2021-07-01-00-00/00000000",
+			Message:       `{"id":"5","type":"TaskFailed","details":{"error":"States.TaskFailed","cause":"{\"StopCode\":\"EssentialContainerExited\"}"},"execution_arn":"arn:aws:states:us-west-2:123456789012:execution:phonetool-test-report:1a2b3c4d-5e6f"}`,
+		},
+	}
+	testCases := map[string]struct {
+		taskIDs             []string
+		includeStateMachine bool
+
+		wantedLogStreams []string
+	}{
+		"retrieves the logs of all tasks": {
+			wantedLogStreams: []string{"copilot/"},
+		},
+		"retrieves the logs of tasks and of the state machine": {
+			includeStateMachine: true,
+			wantedLogStreams:    []string{"copilot/", "states/phonetool-test-report"},
+		},
+		"retrieves the logs of specific tasks and of the state machine": {
+			taskIDs:             []string{"mockTaskID"},
+			includeStateMachine: true,
+			wantedLogStreams:    []string{"copilot/report/mockTaskID", "states/phonetool-test-report"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			color.DisableColorBasedOnEnvVar()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mocklogGetter := mocks.NewMocklogGetter(ctrl)
+			mocklogGetter.EXPECT().LogEvents(gomock.Any()).
+				Do(func(param cloudwatchlogs.LogEventsOpts) {
+					require.Equal(t, tc.wantedLogStreams, param.LogStreams)
+				}).
+				Return(&cloudwatchlogs.LogEventsOutput{
+					Events: logEvents,
+				}, nil)
+			b := &bytes.Buffer{}
+			jobLogs := &ServiceClient{
+				logGroupName:                "/copilot/phonetool-test-report",
+				logStreamNamePrefix:         "copilot/report",
+				stateMachineLogStreamPrefix: "states/phonetool-test-report",
+				eventsGetter:                mocklogGetter,
+				w:                           b,
+			}
+
+			// WHEN
+			err := jobLogs.WriteLogEvents(WriteLogEventsOpts{
+				TaskIDs:             tc.taskIDs,
+				IncludeStateMachine: tc.includeStateMachine,
+				OnEvents:            WriteHumanLogs,
+			})
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, `states/1a2b3c4d ExecutionStarted
+copilot/report/1de57fd63c generating report
+states/1a2b3c4d TaskFailed States.TaskFailed: {"StopCode":"EssentialContainerExited"}
+`, b.String())
+		})
+	}
+}
+
+func TestServiceClient_WriteQueryResults(t *testing.T) {
+	results := &cloudwatchlogs.QueryResults{
+		Fields: []string{"@timestamp", "@message"},
+		Rows: []map[string]string{
+			{
+				"@timestamp": "2021-07-01 00:00:00.000",
+				"@message":   "GET / 200",
+			},
+			{
+				"@timestamp": "2021-07-01 00:00:01.000",
+				"@message":   "GET /api\n500",
+			},
+		},
+	}
+	testCases := map[string]struct {
+		jsonOutput bool
+		taskIDs    []string
+		mockQuery  func(m *mocks.MocklogQuerier)
+
+		wantedError   error
+		wantedContent string
+	}{
+		"wraps the error of the query": {
+			mockQuery: func(m *mocks.MocklogQuerier) {
+				m.EXPECT().Query(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("query log group mockLogGroup: some error"),
+		},
+		"success with human output": {
+			mockQuery: func(m *mocks.MocklogQuerier) {
+				m.EXPECT().Query(cloudwatchlogs.QueryInput{
+					LogGroups: []string{"mockLogGroup"},
+					Query:     "fields @timestamp, @message",
+					StartTime: 1000,
+					EndTime:   2000,
+					Limit:     aws.Int64(10),
+				}).Return(results, nil)
+			},
+
+			wantedContent: `@timestamp               @message
+----------               --------
+2021-07-01 00:00:00.000  GET / 200
+2021-07-01 00:00:01.000  GET /api 500
+`,
+		},
+		"success with json output restricted to tasks": {
+			jsonOutput: true,
+			taskIDs:    []string{"mockTaskID1", "mockTaskID2"},
+			mockQuery: func(m *mocks.MocklogQuerier) {
+				m.EXPECT().Query(cloudwatchlogs.QueryInput{
+					LogGroups: []string{"mockLogGroup"},
+					Query:     "filter @logStream like /(mockTaskID1|mockTaskID2)/ | fields @timestamp, @message",
+					StartTime: 1000,
+					EndTime:   2000,
+					Limit:     aws.Int64(10),
+				}).Return(results, nil)
+			},
+
+			wantedContent: `{"@message":"GET / 200","@timestamp":"2021-07-01 00:00:00.000"}
+{"@message":"GET /api\n500","@timestamp":"2021-07-01 00:00:01.000"}
+`,
+		},
+		"no matched rows": {
+			mockQuery: func(m *mocks.MocklogQuerier) {
+				m.EXPECT().Query(gomock.Any()).Return(&cloudwatchlogs.QueryResults{}, nil)
+			},
+
+			wantedContent: "No log events matched the query.\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := mocks.NewMocklogQuerier(ctrl)
+			tc.mockQuery(mockQuerier)
+			b := &bytes.Buffer{}
+			svcLogs := &ServiceClient{
+				logGroupName: "mockLogGroup",
+				querier:      mockQuerier,
+				w:            b,
+			}
+			resultsWriter := WriteHumanQueryResults
+			if tc.jsonOutput {
+				resultsWriter = WriteJSONQueryResults
+			}
+
+			// WHEN
+			err := svcLogs.WriteQueryResults(QueryOpts{
+				Query:     "fields @timestamp, @message",
+				Limit:     aws.Int64(10),
+				StartTime: aws.Int64(1000),
+				EndTime:   aws.Int64(2000),
+				TaskIDs:   tc.taskIDs,
+				OnResults: resultsWriter,
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
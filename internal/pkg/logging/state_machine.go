@@ -0,0 +1,67 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	stateMachineLogStreamPrefix = "states/"
+	shortExecutionNameLength    = 8
+)
+
+// stateMachineEvent is a log event written by the state machine of a job, such as "ExecutionStarted" or "TaskFailed".
+// See https://docs.aws.amazon.com/step-functions/latest/dg/cw-logs.html#cloudwatch-log-level.
+type stateMachineEvent struct {
+	*cloudwatchlogs.Event
+}
+
+type executionEvent struct {
+	Type         string `json:"type"`
+	ExecutionARN string `json:"execution_arn"`
+	Details      struct {
+		Error string `json:"error"`
+		Cause string `json:"cause"`
+	} `json:"details"`
+}
+
+// HumanString returns the type of the execution event, and the error that caused it if any, prefixed by the execution's name.
+// Events that can't be parsed are written as is.
+func (e *stateMachineEvent) HumanString() string {
+	var event executionEvent
+	if err := json.Unmarshal([]byte(e.Message), &event); err != nil || event.Type == "" {
+		return e.Event.HumanString()
+	}
+	// Execution ARNs look like "arn:aws:states:us-west-2:123456789012:execution:app-env-job:name".
+	name := event.ExecutionARN[strings.LastIndex(event.ExecutionARN, ":")+1:]
+	if len(name) > shortExecutionNameLength {
+		name = name[:shortExecutionNameLength]
+	}
+	msg := event.Type
+	if isFailure(event.Type) {
+		msg = color.Red.Sprint(event.Type)
+	}
+	if event.Details.Error != "" {
+		msg = fmt.Sprintf("%s %s", msg, event.Details.Error)
+	}
+	if event.Details.Cause != "" {
+		msg = fmt.Sprintf("%s: %s", msg, cellReplacer.Replace(event.Details.Cause))
+	}
+	return fmt.Sprintf("%s %s\n", color.Grey.Sprintf("%s%s", stateMachineLogStreamPrefix, name), msg)
+}
+
+func isFailure(eventType string) bool {
+	for _, suffix := range []string{"Failed", "TimedOut", "Aborted"} {
+		if strings.HasSuffix(eventType, suffix) {
+			return true
+		}
+	}
+	return false
+}
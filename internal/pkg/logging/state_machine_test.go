@@ -0,0 +1,51 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateMachineEvent_HumanString(t *testing.T) {
+	testCases := map[string]struct {
+		inMessage string
+
+		wanted string
+	}{
+		"writes messages that aren't execution events as is": {
+			inMessage: "not json",
+			wanted:    "states/phonetool-test-rep not json\n",
+		},
+		"writes the type of the event": {
+			inMessage: `{"type":"TaskSubmitted","details":{"resource":"runTask.sync"},"execution_arn":"arn:aws:states:us-west-2:123456789012:execution:phonetool-test-report:5e6f"}`,
+			wanted:    "states/5e6f TaskSubmitted\n",
+		},
+		"writes the error and the cause of a timeout": {
+			inMessage: `{"type":"ExecutionTimedOut","details":{"error":"States.Timeout","cause":"The execution timed out."},"execution_arn":"arn:aws:states:us-west-2:123456789012:execution:phonetool-test-report:1a2b3c4d-5e6f"}`,
+			wanted:    "states/1a2b3c4d ExecutionTimedOut States.Timeout: The execution timed out.\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			color.DisableColorBasedOnEnvVar()
+			event := &stateMachineEvent{
+				&cloudwatchlogs.Event{
+					LogStreamName: "states/phonetool-test-report/2021-07-01-00-00/00000000",
+					Message:       tc.inMessage,
+				},
+			}
+
+			// WHEN
+			got := event.HumanString()
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
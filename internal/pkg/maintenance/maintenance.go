@@ -0,0 +1,279 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package maintenance puts Load Balanced Web Services in and out of maintenance mode.
+// In maintenance mode, the listener rules of a service respond with a fixed 503 instead of forwarding requests to its tasks.
+package maintenance
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+)
+
+const (
+	listenerRuleResourceType = "AWS::ElasticLoadBalancingV2::ListenerRule"
+	targetGroupLogicalID     = "TargetGroup"
+
+	statusCode  = "503"
+	contentType = "text/html"
+
+	// Tags that save the target groups, and their weights if any, that a rule forwards to while it's in maintenance.
+	// The i-th target group is saved under the key with the suffix i.
+	savedTargetGroupTagPrefix = "copilot-maintenance-target-group-"
+	savedWeightTagPrefix      = "copilot-maintenance-weight-"
+	// Tag that records when the maintenance window ends, in RFC 3339 format.
+	untilTagKey = "copilot-maintenance-until"
+)
+
+// DefaultBody is the body of the maintenance response if none is provided.
+const DefaultBody = "<h1>Down for maintenance</h1><p>We'll be back shortly.</p>"
+
+// ErrNoListenerRules means that the service doesn't receive traffic from a load balancer.
+var ErrNoListenerRules = errors.New("service has no load balancer listener rules")
+
+type stackResourcesDescriber interface {
+	StackResources(name string) ([]*cloudformation.StackResource, error)
+}
+
+type ruleModifier interface {
+	Rules(ruleARNs []string) ([]*elbv2.ListenerRule, error)
+	RespondWith(ruleARN string, resp elbv2.FixedResponse) error
+	ForwardTo(ruleARN, targetGroupARN string) error
+	ForwardToWeighted(ruleARN string, targetGroups []elbv2.WeightedTargetGroup) error
+	RuleTags(ruleARN string) (map[string]string, error)
+	TagRule(ruleARN string, tags map[string]string) error
+	UntagRule(ruleARN string, keys []string) error
+}
+
+// Client turns the maintenance mode of services on and off.
+type Client struct {
+	cfn stackResourcesDescriber
+	elb ruleModifier
+}
+
+// New returns a Client configured against the input session.
+func New(sess *session.Session) *Client {
+	return &Client{
+		cfn: cloudformation.New(sess),
+		elb: elbv2.New(sess),
+	}
+}
+
+// Status is the maintenance state of a service.
+type Status struct {
+	Enabled bool
+	Until   time.Time // End of the maintenance window, zero if the window has no end.
+}
+
+// Enable makes the listener rules of the service respond with a 503 and the body, without stopping its tasks.
+// The target groups that each rule forwards to are saved in the tags of the rule, so that Disable can restore them.
+// If until is not zero, it's recorded as the end of the maintenance window. Maintenance stays on until Disable is called.
+func (c *Client) Enable(app, env, svc, body string, until time.Time) error {
+	rules, _, err := c.listenerRules(app, env, svc)
+	if err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		if len(rule.TargetGroupARNs) == 0 && rule.FixedResponse == nil {
+			// Rules that redirect HTTP to HTTPS keep redirecting to the rule that's in maintenance.
+			continue
+		}
+		tags := make(map[string]string)
+		if rule.FixedResponse == nil {
+			tags = forwardTags(rule)
+		}
+		if !until.IsZero() {
+			tags[untilTagKey] = until.UTC().Format(time.RFC3339)
+		}
+		if len(tags) != 0 {
+			if err := c.elb.TagRule(rule.ARN, tags); err != nil {
+				return err
+			}
+		}
+		if until.IsZero() && rule.FixedResponse != nil {
+			// The new maintenance of a rule already in maintenance has no end.
+			if err := c.elb.UntagRule(rule.ARN, []string{untilTagKey}); err != nil {
+				return err
+			}
+		}
+		if err := c.elb.RespondWith(rule.ARN, elbv2.FixedResponse{
+			StatusCode:  statusCode,
+			ContentType: contentType,
+			Body:        body,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Disable makes the listener rules of the service in maintenance forward requests to its tasks again,
+// with the target groups and weights that the rules had before Enable.
+func (c *Client) Disable(app, env, svc string) error {
+	rules, targetGroupARN, err := c.listenerRules(app, env, svc)
+	if err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		if rule.FixedResponse == nil {
+			continue
+		}
+		tags, err := c.elb.RuleTags(rule.ARN)
+		if err != nil {
+			return err
+		}
+		saved, keys, err := savedTargetGroups(tags)
+		if err != nil {
+			return fmt.Errorf("read target groups saved on rule %s: %w", rule.ARN, err)
+		}
+		if err := c.forward(rule.ARN, saved, targetGroupARN); err != nil {
+			return err
+		}
+		if _, ok := tags[untilTagKey]; ok {
+			keys = append(keys, untilTagKey)
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		if err := c.elb.UntagRule(rule.ARN, keys); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// forward restores the actions of a rule from the target groups saved in its tags.
+// Rules put in maintenance before the target groups were saved forward to the target group of the service.
+func (c *Client) forward(ruleARN string, saved []savedTargetGroup, targetGroupARN string) error {
+	if len(saved) == 0 {
+		return c.elb.ForwardTo(ruleARN, targetGroupARN)
+	}
+	if len(saved) == 1 && saved[0].weight == nil {
+		return c.elb.ForwardTo(ruleARN, saved[0].arn)
+	}
+	var tgs []elbv2.WeightedTargetGroup
+	for _, tg := range saved {
+		tgs = append(tgs, elbv2.WeightedTargetGroup{
+			ARN:    tg.arn,
+			Weight: aws.Int64Value(tg.weight),
+		})
+	}
+	return c.elb.ForwardToWeighted(ruleARN, tgs)
+}
+
+// Status returns whether a listener rule of the service is in maintenance, and when the maintenance window ends.
+// Services without listener rules are never in maintenance.
+func (c *Client) Status(app, env, svc string) (*Status, error) {
+	rules, _, err := c.listenerRules(app, env, svc)
+	if errors.Is(err, ErrNoListenerRules) {
+		return &Status{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		if rule.FixedResponse == nil {
+			continue
+		}
+		tags, err := c.elb.RuleTags(rule.ARN)
+		if err != nil {
+			return nil, err
+		}
+		status := &Status{
+			Enabled: true,
+		}
+		value, ok := tags[untilTagKey]
+		if !ok {
+			return status, nil
+		}
+		until, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("parse end of maintenance %q saved on rule %s: %w", value, rule.ARN, err)
+		}
+		status.Until = until
+		return status, nil
+	}
+	return &Status{}, nil
+}
+
+// listenerRules returns the listener rules of the service and the ARN of its target group.
+func (c *Client) listenerRules(app, env, svc string) ([]*elbv2.ListenerRule, string, error) {
+	stackName := stack.NameForService(app, env, svc)
+	resources, err := c.cfn.StackResources(stackName)
+	if err != nil {
+		return nil, "", fmt.Errorf("describe resources of stack %s: %w", stackName, err)
+	}
+	var ruleARNs []string
+	var targetGroupARN string
+	for _, resource := range resources {
+		switch {
+		case aws.StringValue(resource.ResourceType) == listenerRuleResourceType:
+			ruleARNs = append(ruleARNs, aws.StringValue(resource.PhysicalResourceId))
+		case aws.StringValue(resource.LogicalResourceId) == targetGroupLogicalID:
+			targetGroupARN = aws.StringValue(resource.PhysicalResourceId)
+		}
+	}
+	if len(ruleARNs) == 0 || targetGroupARN == "" {
+		return nil, "", ErrNoListenerRules
+	}
+	rules, err := c.elb.Rules(ruleARNs)
+	if err != nil {
+		return nil, "", err
+	}
+	return rules, targetGroupARN, nil
+}
+
+type savedTargetGroup struct {
+	arn    string
+	weight *int64 // Nil if the rule forwarded to the target group without a ForwardConfig.
+}
+
+// forwardTags returns the tags that save the target groups and weights that the rule forwards to.
+func forwardTags(rule *elbv2.ListenerRule) map[string]string {
+	tags := make(map[string]string)
+	if len(rule.WeightedTargetGroups) == 0 {
+		for i, arn := range rule.TargetGroupARNs {
+			tags[savedTargetGroupTagPrefix+strconv.Itoa(i)] = arn
+		}
+		return tags
+	}
+	for i, tg := range rule.WeightedTargetGroups {
+		tags[savedTargetGroupTagPrefix+strconv.Itoa(i)] = tg.ARN
+		tags[savedWeightTagPrefix+strconv.Itoa(i)] = strconv.FormatInt(tg.Weight, 10)
+	}
+	return tags
+}
+
+// savedTargetGroups returns the target groups saved by forwardTags, and the keys of their tags.
+func savedTargetGroups(tags map[string]string) ([]savedTargetGroup, []string, error) {
+	var saved []savedTargetGroup
+	var keys []string
+	for i := 0; ; i++ {
+		tgKey, weightKey := savedTargetGroupTagPrefix+strconv.Itoa(i), savedWeightTagPrefix+strconv.Itoa(i)
+		arn, ok := tags[tgKey]
+		if !ok {
+			return saved, keys, nil
+		}
+		keys = append(keys, tgKey)
+		tg := savedTargetGroup{
+			arn: arn,
+		}
+		if value, ok := tags[weightKey]; ok {
+			weight, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, nil, fmt.Errorf("parse weight %q of target group %s: %w", value, arn, err)
+			}
+			tg.weight = aws.Int64(weight)
+			keys = append(keys, weightKey)
+		}
+		saved = append(saved, tg)
+	}
+}
@@ -0,0 +1,451 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/maintenance/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const mockStackName = "phonetool-test-frontend"
+
+type clientMocks struct {
+	cfn *mocks.MockstackResourcesDescriber
+	elb *mocks.MockruleModifier
+}
+
+var lbStackResources = []*cloudformation.StackResource{
+	{
+		LogicalResourceId:  aws.String("TargetGroup"),
+		PhysicalResourceId: aws.String("mockTargetGroupARN"),
+		ResourceType:       aws.String("AWS::ElasticLoadBalancingV2::TargetGroup"),
+	},
+	{
+		LogicalResourceId:  aws.String("HTTPListenerRuleWithDomain"),
+		PhysicalResourceId: aws.String("mockRedirectRuleARN"),
+		ResourceType:       aws.String("AWS::ElasticLoadBalancingV2::ListenerRule"),
+	},
+	{
+		LogicalResourceId:  aws.String("HTTPSListenerRule"),
+		PhysicalResourceId: aws.String("mockHTTPSRuleARN"),
+		ResourceType:       aws.String("AWS::ElasticLoadBalancingV2::ListenerRule"),
+	},
+}
+
+func newClient(ctrl *gomock.Controller, setupMocks func(m clientMocks)) *Client {
+	m := clientMocks{
+		cfn: mocks.NewMockstackResourcesDescriber(ctrl),
+		elb: mocks.NewMockruleModifier(ctrl),
+	}
+	setupMocks(m)
+	return &Client{
+		cfn: m.cfn,
+		elb: m.elb,
+	}
+}
+
+func TestClient_Enable(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m clientMocks)
+		inUntil    time.Time
+
+		wantedErr error
+	}{
+		"errors if the stack resources can't be described": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe resources of stack phonetool-test-frontend: some error"),
+		},
+		"errors if the service has no listener rules": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("Service"),
+						PhysicalResourceId: aws.String("mockServiceARN"),
+						ResourceType:       aws.String("AWS::ECS::Service"),
+					},
+				}, nil)
+			},
+			wantedErr: ErrNoListenerRules,
+		},
+		"errors if the target groups of a rule can't be saved": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules([]string{"mockRedirectRuleARN", "mockHTTPSRuleARN"}).Return([]*elbv2.ListenerRule{
+					{ARN: "mockHTTPSRuleARN", TargetGroupARNs: []string{"mockTargetGroupARN"}},
+				}, nil)
+				m.elb.EXPECT().TagRule("mockHTTPSRuleARN", gomock.Any()).Return(errors.New("some error"))
+				m.elb.EXPECT().RespondWith(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"errors if a rule can't be modified": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules([]string{"mockRedirectRuleARN", "mockHTTPSRuleARN"}).Return([]*elbv2.ListenerRule{
+					{ARN: "mockHTTPSRuleARN", TargetGroupARNs: []string{"mockTargetGroupARN"}},
+				}, nil)
+				m.elb.EXPECT().TagRule("mockHTTPSRuleARN", gomock.Any()).Return(nil)
+				m.elb.EXPECT().RespondWith("mockHTTPSRuleARN", gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"responds with a 503 on forwarding rules only": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules([]string{"mockRedirectRuleARN", "mockHTTPSRuleARN"}).Return([]*elbv2.ListenerRule{
+					{ARN: "mockRedirectRuleARN"},
+					{ARN: "mockHTTPSRuleARN", TargetGroupARNs: []string{"mockTargetGroupARN"}},
+				}, nil)
+				gomock.InOrder(
+					m.elb.EXPECT().TagRule("mockHTTPSRuleARN", map[string]string{
+						"copilot-maintenance-target-group-0": "mockTargetGroupARN",
+					}).Return(nil),
+					m.elb.EXPECT().RespondWith("mockHTTPSRuleARN", elbv2.FixedResponse{
+						StatusCode:  "503",
+						ContentType: "text/html",
+						Body:        "back soon",
+					}).Return(nil),
+				)
+			},
+		},
+		"saves the weights of the target groups of a ForwardConfig": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules([]string{"mockRedirectRuleARN", "mockHTTPSRuleARN"}).Return([]*elbv2.ListenerRule{
+					{
+						ARN:             "mockHTTPSRuleARN",
+						TargetGroupARNs: []string{"mockTargetGroupARN", "mockAlternateTargetGroupARN"},
+						WeightedTargetGroups: []elbv2.WeightedTargetGroup{
+							{ARN: "mockTargetGroupARN", Weight: 0},
+							{ARN: "mockAlternateTargetGroupARN", Weight: 100},
+						},
+					},
+				}, nil)
+				m.elb.EXPECT().TagRule("mockHTTPSRuleARN", map[string]string{
+					"copilot-maintenance-target-group-0": "mockTargetGroupARN",
+					"copilot-maintenance-weight-0":       "0",
+					"copilot-maintenance-target-group-1": "mockAlternateTargetGroupARN",
+					"copilot-maintenance-weight-1":       "100",
+				}).Return(nil)
+				m.elb.EXPECT().RespondWith("mockHTTPSRuleARN", gomock.Any()).Return(nil)
+			},
+		},
+		"records the end of the maintenance window": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules([]string{"mockRedirectRuleARN", "mockHTTPSRuleARN"}).Return([]*elbv2.ListenerRule{
+					{ARN: "mockHTTPSRuleARN", TargetGroupARNs: []string{"mockTargetGroupARN"}},
+				}, nil)
+				m.elb.EXPECT().TagRule("mockHTTPSRuleARN", map[string]string{
+					"copilot-maintenance-target-group-0": "mockTargetGroupARN",
+					"copilot-maintenance-until":          "2026-10-15T20:30:00Z",
+				}).Return(nil)
+				m.elb.EXPECT().UntagRule(gomock.Any(), gomock.Any()).Times(0)
+				m.elb.EXPECT().RespondWith("mockHTTPSRuleARN", gomock.Any()).Return(nil)
+			},
+			inUntil: time.Date(2026, 10, 15, 13, 30, 0, 0, time.FixedZone("PDT", -7*60*60)),
+		},
+		"updates the body of rules already in maintenance without saving their target groups again": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules([]string{"mockRedirectRuleARN", "mockHTTPSRuleARN"}).Return([]*elbv2.ListenerRule{
+					{ARN: "mockHTTPSRuleARN", FixedResponse: &elbv2.FixedResponse{StatusCode: "503"}},
+				}, nil)
+				m.elb.EXPECT().TagRule(gomock.Any(), gomock.Any()).Times(0)
+				m.elb.EXPECT().UntagRule("mockHTTPSRuleARN", []string{"copilot-maintenance-until"}).Return(nil)
+				m.elb.EXPECT().RespondWith("mockHTTPSRuleARN", gomock.Any()).Return(nil)
+			},
+		},
+		"replaces the end of the maintenance window of rules already in maintenance": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules([]string{"mockRedirectRuleARN", "mockHTTPSRuleARN"}).Return([]*elbv2.ListenerRule{
+					{ARN: "mockHTTPSRuleARN", FixedResponse: &elbv2.FixedResponse{StatusCode: "503"}},
+				}, nil)
+				m.elb.EXPECT().TagRule("mockHTTPSRuleARN", map[string]string{
+					"copilot-maintenance-until": "2026-10-15T20:30:00Z",
+				}).Return(nil)
+				m.elb.EXPECT().UntagRule(gomock.Any(), gomock.Any()).Times(0)
+				m.elb.EXPECT().RespondWith("mockHTTPSRuleARN", gomock.Any()).Return(nil)
+			},
+			inUntil: time.Date(2026, 10, 15, 20, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := newClient(ctrl, tc.setupMocks)
+
+			// WHEN
+			err := c.Enable("phonetool", "test", "frontend", "back soon", tc.inUntil)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestClient_Disable(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m clientMocks)
+
+		wantedErr error
+	}{
+		"errors if the rules can't be described": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"errors if the tags of a rule can't be described": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules(gomock.Any()).Return([]*elbv2.ListenerRule{
+					{ARN: "mockHTTPSRuleARN", FixedResponse: &elbv2.FixedResponse{StatusCode: "503"}},
+				}, nil)
+				m.elb.EXPECT().RuleTags("mockHTTPSRuleARN").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"errors if a saved weight is malformed": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules(gomock.Any()).Return([]*elbv2.ListenerRule{
+					{ARN: "mockHTTPSRuleARN", FixedResponse: &elbv2.FixedResponse{StatusCode: "503"}},
+				}, nil)
+				m.elb.EXPECT().RuleTags("mockHTTPSRuleARN").Return(map[string]string{
+					"copilot-maintenance-target-group-0": "mockTargetGroupARN",
+					"copilot-maintenance-weight-0":       "heavy",
+				}, nil)
+			},
+			wantedErr: errors.New(`read target groups saved on rule mockHTTPSRuleARN: parse weight "heavy" of target group mockTargetGroupARN: strconv.ParseInt: parsing "heavy": invalid syntax`),
+		},
+		"forwards rules without saved target groups to the target group of the service": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules(gomock.Any()).Return([]*elbv2.ListenerRule{
+					{ARN: "mockRedirectRuleARN"},
+					{ARN: "mockHTTPSRuleARN", FixedResponse: &elbv2.FixedResponse{StatusCode: "503"}},
+				}, nil)
+				m.elb.EXPECT().RuleTags("mockHTTPSRuleARN").Return(map[string]string{}, nil)
+				m.elb.EXPECT().ForwardTo("mockHTTPSRuleARN", "mockTargetGroupARN").Return(nil)
+				m.elb.EXPECT().UntagRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"restores the saved target group": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules(gomock.Any()).Return([]*elbv2.ListenerRule{
+					{ARN: "mockHTTPSRuleARN", FixedResponse: &elbv2.FixedResponse{StatusCode: "503"}},
+				}, nil)
+				m.elb.EXPECT().RuleTags("mockHTTPSRuleARN").Return(map[string]string{
+					"copilot-environment":                "test",
+					"copilot-maintenance-target-group-0": "mockTargetGroupARN",
+				}, nil)
+				gomock.InOrder(
+					m.elb.EXPECT().ForwardTo("mockHTTPSRuleARN", "mockTargetGroupARN").Return(nil),
+					m.elb.EXPECT().UntagRule("mockHTTPSRuleARN", []string{"copilot-maintenance-target-group-0"}).Return(nil),
+				)
+			},
+		},
+		"restores the saved weights of a ForwardConfig": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules(gomock.Any()).Return([]*elbv2.ListenerRule{
+					{ARN: "mockHTTPSRuleARN", FixedResponse: &elbv2.FixedResponse{StatusCode: "503"}},
+				}, nil)
+				m.elb.EXPECT().RuleTags("mockHTTPSRuleARN").Return(map[string]string{
+					"copilot-maintenance-target-group-0": "mockTargetGroupARN",
+					"copilot-maintenance-weight-0":       "0",
+					"copilot-maintenance-target-group-1": "mockAlternateTargetGroupARN",
+					"copilot-maintenance-weight-1":       "100",
+				}, nil)
+				gomock.InOrder(
+					m.elb.EXPECT().ForwardToWeighted("mockHTTPSRuleARN", []elbv2.WeightedTargetGroup{
+						{ARN: "mockTargetGroupARN", Weight: 0},
+						{ARN: "mockAlternateTargetGroupARN", Weight: 100},
+					}).Return(nil),
+					m.elb.EXPECT().UntagRule("mockHTTPSRuleARN", []string{
+						"copilot-maintenance-target-group-0",
+						"copilot-maintenance-weight-0",
+						"copilot-maintenance-target-group-1",
+						"copilot-maintenance-weight-1",
+					}).Return(nil),
+				)
+			},
+		},
+		"removes the end of the maintenance window": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules(gomock.Any()).Return([]*elbv2.ListenerRule{
+					{ARN: "mockHTTPSRuleARN", FixedResponse: &elbv2.FixedResponse{StatusCode: "503"}},
+				}, nil)
+				m.elb.EXPECT().RuleTags("mockHTTPSRuleARN").Return(map[string]string{
+					"copilot-maintenance-target-group-0": "mockTargetGroupARN",
+					"copilot-maintenance-until":          "2026-10-15T20:30:00Z",
+				}, nil)
+				m.elb.EXPECT().ForwardTo("mockHTTPSRuleARN", "mockTargetGroupARN").Return(nil)
+				m.elb.EXPECT().UntagRule("mockHTTPSRuleARN", []string{
+					"copilot-maintenance-target-group-0",
+					"copilot-maintenance-until",
+				}).Return(nil)
+			},
+		},
+		"errors if the saved target groups can't be removed": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules(gomock.Any()).Return([]*elbv2.ListenerRule{
+					{ARN: "mockHTTPSRuleARN", FixedResponse: &elbv2.FixedResponse{StatusCode: "503"}},
+				}, nil)
+				m.elb.EXPECT().RuleTags("mockHTTPSRuleARN").Return(map[string]string{
+					"copilot-maintenance-target-group-0": "mockTargetGroupARN",
+				}, nil)
+				m.elb.EXPECT().ForwardTo("mockHTTPSRuleARN", "mockTargetGroupARN").Return(nil)
+				m.elb.EXPECT().UntagRule("mockHTTPSRuleARN", gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := newClient(ctrl, tc.setupMocks)
+
+			// WHEN
+			err := c.Disable("phonetool", "test", "frontend")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestClient_Status(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m clientMocks)
+
+		wanted    *Status
+		wantedErr error
+	}{
+		"errors if the stack resources can't be described": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe resources of stack phonetool-test-frontend: some error"),
+		},
+		"not enabled if the service has no listener rules": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(nil, nil)
+			},
+			wanted: &Status{},
+		},
+		"not enabled if every rule forwards requests": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules(gomock.Any()).Return([]*elbv2.ListenerRule{
+					{ARN: "mockHTTPSRuleARN", TargetGroupARNs: []string{"mockTargetGroupARN"}},
+				}, nil)
+				m.elb.EXPECT().RuleTags(gomock.Any()).Times(0)
+			},
+			wanted: &Status{},
+		},
+		"errors if the tags of a rule in maintenance can't be read": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules(gomock.Any()).Return([]*elbv2.ListenerRule{
+					{ARN: "mockHTTPSRuleARN", FixedResponse: &elbv2.FixedResponse{StatusCode: "503"}},
+				}, nil)
+				m.elb.EXPECT().RuleTags("mockHTTPSRuleARN").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"errors if the end of the maintenance window is malformed": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules(gomock.Any()).Return([]*elbv2.ListenerRule{
+					{ARN: "mockHTTPSRuleARN", FixedResponse: &elbv2.FixedResponse{StatusCode: "503"}},
+				}, nil)
+				m.elb.EXPECT().RuleTags("mockHTTPSRuleARN").Return(map[string]string{
+					"copilot-maintenance-until": "tomorrow",
+				}, nil)
+			},
+			wantedErr: errors.New(`parse end of maintenance "tomorrow" saved on rule mockHTTPSRuleARN: parsing time "tomorrow" as "2006-01-02T15:04:05Z07:00": cannot parse "tomorrow" as "2006"`),
+		},
+		"enabled without an end if a rule responds with a fixed response": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules(gomock.Any()).Return([]*elbv2.ListenerRule{
+					{ARN: "mockHTTPSRuleARN", FixedResponse: &elbv2.FixedResponse{StatusCode: "503"}},
+				}, nil)
+				m.elb.EXPECT().RuleTags("mockHTTPSRuleARN").Return(map[string]string{
+					"copilot-maintenance-target-group-0": "mockTargetGroupARN",
+				}, nil)
+			},
+			wanted: &Status{
+				Enabled: true,
+			},
+		},
+		"enabled with the end of the maintenance window": {
+			setupMocks: func(m clientMocks) {
+				m.cfn.EXPECT().StackResources(mockStackName).Return(lbStackResources, nil)
+				m.elb.EXPECT().Rules(gomock.Any()).Return([]*elbv2.ListenerRule{
+					{ARN: "mockHTTPSRuleARN", FixedResponse: &elbv2.FixedResponse{StatusCode: "503"}},
+				}, nil)
+				m.elb.EXPECT().RuleTags("mockHTTPSRuleARN").Return(map[string]string{
+					"copilot-maintenance-target-group-0": "mockTargetGroupARN",
+					"copilot-maintenance-until":          "2026-10-15T20:30:00Z",
+				}, nil)
+			},
+			wanted: &Status{
+				Enabled: true,
+				Until:   time.Date(2026, 10, 15, 20, 30, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := newClient(ctrl, tc.setupMocks)
+
+			// WHEN
+			got, err := c.Status("phonetool", "test", "frontend")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
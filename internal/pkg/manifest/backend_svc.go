@@ -35,12 +35,17 @@ type BackendService struct {
 
 // BackendServiceConfig holds the configuration that can be overriden per environments.
 type BackendServiceConfig struct {
-	ImageConfig   imageWithPortAndHealthcheck `yaml:"image,flow"`
-	ImageOverride `yaml:",inline"`
-	TaskConfig    `yaml:",inline"`
-	*Logging      `yaml:"logging,flow"`
-	Sidecars      map[string]*SidecarConfig `yaml:"sidecars"`
-	Network       NetworkConfig             `yaml:"network"`
+	ImageConfig    imageWithPortAndHealthcheck `yaml:"image,flow"`
+	ImageOverride  `yaml:",inline"`
+	TaskConfig     `yaml:",inline"`
+	GPU            *int    `yaml:"gpu"`         // Number of GPUs reserved for the main container.
+	LaunchType     *string `yaml:"launch_type"` // Either FARGATE or EXTERNAL. Defaults to FARGATE.
+	*Logging       `yaml:"logging,flow"`
+	Sidecars       map[string]*SidecarConfig       `yaml:"sidecars"`
+	InitContainers map[string]*InitContainerConfig `yaml:"init_containers"`
+	Network        NetworkConfig                   `yaml:"network"`
+	Deployment     DeploymentConfig                `yaml:"deployment"`
+	Features       []string                        `yaml:"features"` // Template features to opt into ahead of a template version bump.
 }
 
 type imageWithPortAndHealthcheck struct {
@@ -84,6 +89,7 @@ func (s *BackendService) MarshalBinary() ([]byte, error) {
 	content, err := s.parser.Parse(backendSvcManifestPath, *s, template.WithFuncs(map[string]interface{}{
 		"fmtSlice":   template.FmtSliceFunc,
 		"quoteSlice": template.QuoteSliceFunc,
+		"quote":      tplQuote,
 		"dirName":    tplDirName,
 	}))
 	if err != nil {
@@ -97,11 +103,31 @@ func (s *BackendService) BuildRequired() (bool, error) {
 	return requiresBuild(s.ImageConfig.Image)
 }
 
+// ImageLocation returns the location of the existing image of the service, or an empty string if the image is built from a Dockerfile.
+func (s *BackendService) ImageLocation() string {
+	return s.ImageConfig.GetLocation()
+}
+
 // BuildArgs returns a docker.BuildArguments object for the service given a workspace root directory
 func (s *BackendService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.BuildConfig(wsRoot)
 }
 
+// LazyLoadImage returns true if the image built from the Dockerfile should be lazily loaded.
+func (s *BackendService) LazyLoadImage() bool {
+	return s.ImageConfig.LazyLoadEnabled()
+}
+
+// ImagesToKeep returns the number of images built from the Dockerfile to keep in the repository, or 0 to keep all of them.
+func (s *BackendService) ImagesToKeep() int {
+	return s.ImageConfig.ImagesToKeep()
+}
+
+// UntaggedImagesExpiry returns the number of days after which untagged images expire from the repository, or 0 to keep them.
+func (s *BackendService) UntaggedImagesExpiry() int {
+	return s.ImageConfig.UntaggedImagesExpiry()
+}
+
 // ApplyEnv returns the service manifest with environment overrides.
 // If the environment passed in does not have any overrides then it returns itself.
 func (s BackendService) ApplyEnv(envName string) (*BackendService, error) {
@@ -202,11 +228,16 @@ func (i imageWithPortAndHealthcheck) HealthCheckOpts() *ecs.HealthCheck {
 	if i.HealthCheck == nil {
 		return nil
 	}
+	return i.HealthCheck.ecsHealthCheck()
+}
+
+// ecsHealthCheck converts a healthcheck whose fields are all set to its ECS representation.
+func (hc *ContainerHealthCheck) ecsHealthCheck() *ecs.HealthCheck {
 	return &ecs.HealthCheck{
-		Command:     aws.StringSlice(i.HealthCheck.Command),
-		Interval:    aws.Int64(int64(i.HealthCheck.Interval.Seconds())),
-		Retries:     aws.Int64(int64(*i.HealthCheck.Retries)),
-		StartPeriod: aws.Int64(int64(i.HealthCheck.StartPeriod.Seconds())),
-		Timeout:     aws.Int64(int64(i.HealthCheck.Timeout.Seconds())),
+		Command:     aws.StringSlice(hc.Command),
+		Interval:    aws.Int64(int64(hc.Interval.Seconds())),
+		Retries:     aws.Int64(int64(*hc.Retries)),
+		StartPeriod: aws.Int64(int64(hc.StartPeriod.Seconds())),
+		Timeout:     aws.Int64(int64(hc.Timeout.Seconds())),
 	}
 }
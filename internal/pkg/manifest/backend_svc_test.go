@@ -132,6 +132,7 @@ func TestNewBackendSvc(t *testing.T) {
 func TestBackendSvc_MarshalBinary(t *testing.T) {
 	testCases := map[string]struct {
 		inProps BackendServiceProps
+		mutate  func(svc *BackendService)
 
 		wantedTestdata string
 	}{
@@ -161,6 +162,39 @@ func TestBackendSvc_MarshalBinary(t *testing.T) {
 			},
 			wantedTestdata: "backend-svc-customhealthcheck.yml",
 		},
+		"with imported configuration": {
+			inProps: BackendServiceProps{
+				WorkloadProps: WorkloadProps{
+					Name:  "api",
+					Image: "node",
+				},
+				Port: 3000,
+			},
+			wantedTestdata: "backend-svc-imported.yml",
+			mutate: func(svc *BackendService) {
+				svc.EntryPoint = EntryPointOverride{String: aws.String("/bin/sh")}
+				svc.Command = CommandOverride{StringSlice: []string{"npm", "start"}}
+				svc.Variables = map[string]string{"LOG_LEVEL": "info"}
+				svc.Storage = &Storage{
+					Volumes: map[string]Volume{
+						"data": {
+							EFS: &EFSConfigOrBool{Enabled: aws.Bool(true)},
+							MountPointOpts: MountPointOpts{
+								ContainerPath: aws.String("/var/lib/data"),
+								ReadOnly:      aws.Bool(true),
+							},
+						},
+					},
+				}
+				svc.Sidecars = map[string]*SidecarConfig{
+					"envoy": {
+						Image:     aws.String("envoyproxy/envoy"),
+						Port:      aws.String("9901"),
+						Variables: map[string]string{"ENVOY_UID": "0"},
+					},
+				}
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -170,6 +204,9 @@ func TestBackendSvc_MarshalBinary(t *testing.T) {
 			wantedBytes, err := ioutil.ReadFile(path)
 			require.NoError(t, err)
 			manifest := NewBackendService(tc.inProps)
+			if tc.mutate != nil {
+				tc.mutate(manifest)
+			}
 
 			// WHEN
 			tpl, err := manifest.MarshalBinary()
@@ -253,6 +290,7 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 				Count: Count{
 					Value: aws.Int(1),
 				},
+				EnvFile: aws.String("configs/dev.env"),
 			},
 			Sidecars: map[string]*SidecarConfig{
 				"xray": {
@@ -288,6 +326,7 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 					Variables: map[string]string{
 						"LOG_LEVEL": "",
 					},
+					EnvFile: aws.String("configs/test.env"),
 				},
 				Sidecars: map[string]*SidecarConfig{
 					"xray": {
@@ -303,6 +342,24 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 			},
 		},
 	}
+	mockBackendServiceWithExecOverride := BackendService{
+		BackendServiceConfig: BackendServiceConfig{
+			TaskConfig: TaskConfig{
+				ExecuteCommand: ExecuteCommand{
+					Enable: aws.Bool(true),
+				},
+			},
+		},
+		Environments: map[string]*BackendServiceConfig{
+			"test": {
+				TaskConfig: TaskConfig{
+					ExecuteCommand: ExecuteCommand{
+						Enable: aws.Bool(false),
+					},
+				},
+			},
+		},
+	}
 	testCases := map[string]struct {
 		svc       *BackendService
 		inEnvName string
@@ -360,6 +417,7 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 						Variables: map[string]string{
 							"LOG_LEVEL": "",
 						},
+						EnvFile: aws.String("configs/test.env"),
 					},
 					Sidecars: map[string]*SidecarConfig{
 						"xray": {
@@ -379,6 +437,21 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 			},
 			original: &mockBackendServiceWithAllOverride,
 		},
+		"turns exec off in the env": {
+			svc:       &mockBackendServiceWithExecOverride,
+			inEnvName: "test",
+
+			wanted: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					TaskConfig: TaskConfig{
+						ExecuteCommand: ExecuteCommand{
+							Enable: aws.Bool(false),
+						},
+					},
+				},
+			},
+			original: &mockBackendServiceWithExecOverride,
+		},
 	}
 
 	for name, tc := range testCases {
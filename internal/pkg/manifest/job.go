@@ -39,22 +39,42 @@ type ScheduledJobConfig struct {
 	ImageConfig             Image `yaml:"image,flow"`
 	ImageOverride           `yaml:",inline"`
 	TaskConfig              `yaml:",inline"`
+	GPU                     *int    `yaml:"gpu"`         // Number of GPUs reserved for the main container.
+	LaunchType              *string `yaml:"launch_type"` // Either FARGATE or EXTERNAL. Defaults to FARGATE.
 	*Logging                `yaml:"logging,flow"`
-	Sidecars                map[string]*SidecarConfig `yaml:"sidecars"`
-	On                      JobTriggerConfig          `yaml:"on,flow"`
+	Sidecars                map[string]*SidecarConfig       `yaml:"sidecars"`
+	InitContainers          map[string]*InitContainerConfig `yaml:"init_containers"`
+	On                      JobTriggerConfig                `yaml:"on,flow"`
 	JobFailureHandlerConfig `yaml:",inline"`
 	Network                 NetworkConfig `yaml:"network"`
 }
 
 // JobTriggerConfig represents the configuration for the event that triggers the job.
 type JobTriggerConfig struct {
-	Schedule *string `yaml:"schedule"`
+	Schedule     *string                `yaml:"schedule"`
+	EventPattern map[string]interface{} `yaml:"event_pattern"` // EventBridge event pattern that triggers the job.
+	EventBus     *string                `yaml:"event_bus"`     // Name or ARN of the event bus that the pattern matches events on. Defaults to the default bus.
 }
 
 // JobFailureHandlerConfig represents the error handling configuration for the job.
 type JobFailureHandlerConfig struct {
-	Timeout *string `yaml:"timeout"`
-	Retries *int    `yaml:"retries"`
+	Timeout     *string                      `yaml:"timeout"`
+	TaskTimeout *string                      `yaml:"task_timeout"` // How long a single attempt of the job can run.
+	Retries     *int                         `yaml:"retries"`
+	Backoff     JobBackoffConfig             `yaml:"backoff"`
+	OnFailure   JobFailureNotificationConfig `yaml:"on_failure"`
+}
+
+// JobBackoffConfig represents the wait between the retries of a job.
+type JobBackoffConfig struct {
+	Interval *string  `yaml:"interval"` // Wait before the first retry. Defaults to 10s.
+	Rate     *float64 `yaml:"rate"`     // Multiplier applied to the wait after each retry. Defaults to 1.5.
+}
+
+// JobFailureNotificationConfig represents the targets notified when a job fails after all its retries.
+type JobFailureNotificationConfig struct {
+	SNSTopic *string `yaml:"sns_topic"` // ARN of an SNS topic.
+	EventBus *string `yaml:"event_bus"` // ARN of an EventBridge event bus.
 }
 
 // ScheduledJobProps contains properties for creating a new scheduled job manifest.
@@ -147,11 +167,31 @@ func (j *ScheduledJob) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return j.ImageConfig.BuildConfig(wsRoot)
 }
 
+// LazyLoadImage returns true if the image built from the Dockerfile should be lazily loaded.
+func (j *ScheduledJob) LazyLoadImage() bool {
+	return j.ImageConfig.LazyLoadEnabled()
+}
+
+// ImagesToKeep returns the number of images built from the Dockerfile to keep in the repository, or 0 to keep all of them.
+func (j *ScheduledJob) ImagesToKeep() int {
+	return j.ImageConfig.ImagesToKeep()
+}
+
+// UntaggedImagesExpiry returns the number of days after which untagged images expire from the repository, or 0 to keep them.
+func (j *ScheduledJob) UntaggedImagesExpiry() int {
+	return j.ImageConfig.UntaggedImagesExpiry()
+}
+
 // BuildRequired returns if the service requires building from the local Dockerfile.
 func (j *ScheduledJob) BuildRequired() (bool, error) {
 	return requiresBuild(j.ImageConfig)
 }
 
+// ImageLocation returns the location of the existing image of the job, or an empty string if the image is built from a Dockerfile.
+func (j *ScheduledJob) ImageLocation() string {
+	return j.ImageConfig.GetLocation()
+}
+
 // JobDockerfileBuildRequired returns if the job container image should be built from local Dockerfile.
 func JobDockerfileBuildRequired(job interface{}) (bool, error) {
 	return dockerfileBuildRequired("job", job)
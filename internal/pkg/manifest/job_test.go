@@ -175,6 +175,45 @@ func TestScheduledJob_ApplyEnv(t *testing.T) {
 				Environments: nil,
 			},
 		},
+		"should keep the event pattern when only the event bus is overridden": {
+			inputManifest: &ScheduledJob{
+				Workload: Workload{
+					Name: aws.String("thumbnailer"),
+					Type: aws.String(ScheduledJobType),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					On: JobTriggerConfig{
+						EventPattern: map[string]interface{}{
+							"source": []interface{}{"aws.s3"},
+						},
+					},
+				},
+				Environments: map[string]*ScheduledJobConfig{
+					"prod": {
+						On: JobTriggerConfig{
+							EventBus: aws.String("uploads"),
+						},
+					},
+				},
+			},
+			inputEnv: "prod",
+
+			wantedManifest: &ScheduledJob{
+				Workload: Workload{
+					Name: aws.String("thumbnailer"),
+					Type: aws.String(ScheduledJobType),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					On: JobTriggerConfig{
+						EventPattern: map[string]interface{}{
+							"source": []interface{}{"aws.s3"},
+						},
+						EventBus: aws.String("uploads"),
+					},
+				},
+				Environments: nil,
+			},
+		},
 	}
 
 	for name, tc := range testCases {
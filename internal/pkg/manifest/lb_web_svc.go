@@ -6,6 +6,7 @@ package manifest
 import (
 	"errors"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -46,13 +47,17 @@ type LoadBalancedWebService struct {
 
 // LoadBalancedWebServiceConfig holds the configuration for a load balanced web service.
 type LoadBalancedWebServiceConfig struct {
-	ImageConfig   ServiceImageWithPort `yaml:"image,flow"`
-	ImageOverride `yaml:",inline"`
-	RoutingRule   `yaml:"http,flow"`
-	TaskConfig    `yaml:",inline"`
-	*Logging      `yaml:"logging,flow"`
-	Sidecars      map[string]*SidecarConfig `yaml:"sidecars"`
-	Network       NetworkConfig             `yaml:"network"`
+	ImageConfig    ServiceImageWithPort `yaml:"image,flow"`
+	ImageOverride  `yaml:",inline"`
+	RoutingRule    `yaml:"http,flow"`
+	TaskConfig     `yaml:",inline"`
+	*Logging       `yaml:"logging,flow"`
+	Sidecars       map[string]*SidecarConfig       `yaml:"sidecars"`
+	InitContainers map[string]*InitContainerConfig `yaml:"init_containers"`
+	Network        NetworkConfig                   `yaml:"network"`
+	Deployment     DeploymentConfig                `yaml:"deployment"`
+	Features       []string                        `yaml:"features"` // Template features to opt into ahead of a template version bump.
+	Verify         VerifyConfig                    `yaml:"verify"`
 
 	// Fields that are used while marshaling the template for additional clarifications,
 	// but don't correspond to a field in the manifests.
@@ -71,6 +76,20 @@ type HTTPHealthCheckArgs struct {
 	Interval           *time.Duration `yaml:"interval"`
 }
 
+// VerifyConfig holds the HTTP checks that "svc verify" runs against the endpoint of the service.
+type VerifyConfig struct {
+	Checks        []VerifyCheck  `yaml:"checks"`
+	Requests      *int           `yaml:"requests"`       // Number of requests sent for each check.
+	Concurrency   *int           `yaml:"concurrency"`    // Number of requests in flight at once for each check.
+	LatencyBudget *time.Duration `yaml:"latency_budget"` // Maximum 95th percentile latency of each check.
+}
+
+// VerifyCheck is a path of the service along with the status code expected in response.
+type VerifyCheck struct {
+	Path   string `yaml:"path"`
+	Status *int   `yaml:"status"`
+}
+
 // HealthCheckArgsOrString is a custom type which supports unmarshaling yaml which
 // can either be of type string or type HealthCheckArgs.
 type HealthCheckArgsOrString struct {
@@ -112,6 +131,8 @@ type RoutingRule struct {
 	TargetContainer          *string  `yaml:"target_container"`
 	TargetContainerCamelCase *string  `yaml:"targetContainer"` // "targetContainerCamelCase" for backwards compatibility
 	AllowedSourceIps         []string `yaml:"allowed_source_ips"`
+	// Priority is the priority of the listener rule. If empty, the next available priority is used.
+	Priority *int `yaml:"priority"`
 }
 
 // LoadBalancedWebServiceProps contains properties for creating a new load balanced fargate service manifest.
@@ -177,7 +198,10 @@ func (h *HTTPHealthCheckArgs) isEmpty() bool {
 // Implements the encoding.BinaryMarshaler interface.
 func (s *LoadBalancedWebService) MarshalBinary() ([]byte, error) {
 	content, err := s.parser.Parse(lbWebSvcManifestPath, *s, template.WithFuncs(map[string]interface{}{
-		"dirName": tplDirName,
+		"fmtSlice":   template.FmtSliceFunc,
+		"quoteSlice": template.QuoteSliceFunc,
+		"quote":      tplQuote,
+		"dirName":    tplDirName,
 	}))
 	if err != nil {
 		return nil, err
@@ -189,16 +213,40 @@ func tplDirName(s string) string {
 	return filepath.Dir(s)
 }
 
+func tplQuote(s *string) string {
+	return strconv.Quote(aws.StringValue(s))
+}
+
 // BuildRequired returns if the service requires building from the local Dockerfile.
 func (s *LoadBalancedWebService) BuildRequired() (bool, error) {
 	return requiresBuild(s.ImageConfig.Image)
 }
 
+// ImageLocation returns the location of the existing image of the service, or an empty string if the image is built from a Dockerfile.
+func (s *LoadBalancedWebService) ImageLocation() string {
+	return s.ImageConfig.GetLocation()
+}
+
 // BuildArgs returns a docker.BuildArguments object given a ws root directory.
 func (s *LoadBalancedWebService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.BuildConfig(wsRoot)
 }
 
+// LazyLoadImage returns true if the image built from the Dockerfile should be lazily loaded.
+func (s *LoadBalancedWebService) LazyLoadImage() bool {
+	return s.ImageConfig.LazyLoadEnabled()
+}
+
+// ImagesToKeep returns the number of images built from the Dockerfile to keep in the repository, or 0 to keep all of them.
+func (s *LoadBalancedWebService) ImagesToKeep() int {
+	return s.ImageConfig.ImagesToKeep()
+}
+
+// UntaggedImagesExpiry returns the number of days after which untagged images expire from the repository, or 0 to keep them.
+func (s *LoadBalancedWebService) UntaggedImagesExpiry() int {
+	return s.ImageConfig.UntaggedImagesExpiry()
+}
+
 // ApplyEnv returns the service manifest with environment overrides.
 // If the environment passed in does not have any overrides then it returns itself.
 func (s LoadBalancedWebService) ApplyEnv(envName string) (*LoadBalancedWebService, error) {
@@ -146,9 +146,52 @@ func TestNewLoadBalancedWebService_UnmarshalYaml(t *testing.T) {
 	}
 }
 
+func TestLoadBalancedWebService_UnmarshalVerify(t *testing.T) {
+	// GIVEN
+	in := []byte(`name: frontend
+type: Load Balanced Web Service
+image:
+  build: frontend/Dockerfile
+  port: 80
+http:
+  path: '/'
+verify:
+  checks:
+    - path: /
+    - path: /admin
+      status: 401
+  requests: 50
+  concurrency: 5
+  latency_budget: 500ms
+environments:
+  prod:
+    verify:
+      checks:
+        - path: /
+      requests: 200
+`)
+
+	// WHEN
+	mft, err := UnmarshalWorkload(in)
+	require.NoError(t, err)
+	svc, err := mft.(*LoadBalancedWebService).ApplyEnv("prod")
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, VerifyConfig{
+		Checks: []VerifyCheck{
+			{Path: "/"},
+		},
+		Requests:      aws.Int(200),
+		Concurrency:   aws.Int(5),
+		LatencyBudget: durationp(500 * time.Millisecond),
+	}, svc.Verify)
+}
+
 func TestLoadBalancedWebService_MarshalBinary(t *testing.T) {
 	testCases := map[string]struct {
 		inProps LoadBalancedWebServiceProps
+		mutate  func(svc *LoadBalancedWebService)
 
 		wantedTestdata string
 	}{
@@ -161,6 +204,56 @@ func TestLoadBalancedWebService_MarshalBinary(t *testing.T) {
 			},
 			wantedTestdata: "lb-svc.yml",
 		},
+		"with imported configuration": {
+			inProps: LoadBalancedWebServiceProps{
+				WorkloadProps: &WorkloadProps{
+					Name:  "frontend",
+					Image: "nginx",
+				},
+				Path: "/",
+				Port: 80,
+			},
+			mutate: func(svc *LoadBalancedWebService) {
+				band := IntRangeBand("2-10")
+				svc.HealthCheck = HealthCheckArgsOrString{
+					HealthCheckArgs: HTTPHealthCheckArgs{
+						Path:         aws.String("/healthz"),
+						SuccessCodes: aws.String("200-299"),
+					},
+				}
+				svc.Count = Count{
+					AdvancedCount: AdvancedCount{
+						Range:    &Range{Value: &band},
+						CPU:      aws.Int(70),
+						Requests: aws.Int(1000),
+					},
+				}
+				svc.Secrets = map[string]string{"DB_PASSWORD": "arn:aws:ssm:us-west-2:123456789012:parameter/db-password"}
+				svc.Storage = &Storage{
+					Volumes: map[string]Volume{
+						"assets": {
+							EFS: &EFSConfigOrBool{
+								Advanced: EFSVolumeConfiguration{
+									FileSystemID:  aws.String("fs-1234"),
+									RootDirectory: aws.String("/assets"),
+								},
+							},
+							MountPointOpts: MountPointOpts{
+								ContainerPath: aws.String("/usr/share/nginx/html"),
+							},
+						},
+					},
+				}
+				svc.Sidecars = map[string]*SidecarConfig{
+					"datadog": {
+						Image:     aws.String("datadog/agent"),
+						Essential: aws.Bool(false),
+						Secrets:   map[string]string{"DD_API_KEY": "DD_API_KEY"},
+					},
+				}
+			},
+			wantedTestdata: "lb-svc-imported.yml",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -170,6 +263,9 @@ func TestLoadBalancedWebService_MarshalBinary(t *testing.T) {
 			wantedBytes, err := ioutil.ReadFile(path)
 			require.NoError(t, err)
 			manifest := NewLoadBalancedWebService(&tc.inProps)
+			if tc.mutate != nil {
+				tc.mutate(manifest)
+			}
 
 			// WHEN
 			tpl, err := manifest.MarshalBinary()
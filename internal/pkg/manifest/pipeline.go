@@ -6,7 +6,9 @@ package manifest
 import (
 	"errors"
 	"fmt"
+	"regexp"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/fatih/structs"
 	"gopkg.in/yaml.v3"
@@ -19,8 +21,17 @@ const (
 	BitbucketProviderName  = "Bitbucket"
 
 	pipelineManifestPath = "cicd/pipeline.yml"
+
+	snsServiceName = "sns"
+
+	// testCommandsActionName is the name of the action generated for the "test_commands" of a stage.
+	testCommandsActionName = "TestCommands"
 )
 
+// postDeploymentNameRegexp matches names that are valid for both CodePipeline actions and CloudFormation logical IDs
+// once their dashes are replaced.
+var postDeploymentNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9-]+$`)
+
 // Provider defines a source of the artifacts
 // that will be built and deployed via a pipeline
 type Provider interface {
@@ -176,9 +187,22 @@ type Build struct {
 
 // PipelineStage represents a stage in the pipeline manifest
 type PipelineStage struct {
-	Name             string   `yaml:"name"`
-	RequiresApproval bool     `yaml:"requires_approval,omitempty"`
-	TestCommands     []string `yaml:"test_commands,omitempty"`
+	Name             string           `yaml:"name"`
+	RequiresApproval bool             `yaml:"requires_approval,omitempty"`
+	ReviewChangeSets bool             `yaml:"review_changesets,omitempty"` // Executes the change sets of the stage only once they are reviewed and approved.
+	ApprovalTopic    string           `yaml:"approval_topic,omitempty"`    // ARN of the SNS topic notified when the stage waits for approval.
+	TestCommands     []string         `yaml:"test_commands,omitempty"`
+	PostDeployments  []PostDeployment `yaml:"post_deployments,omitempty"`
+}
+
+// PostDeployment is a test action that runs in its own CodeBuild project once the workloads of a stage are deployed.
+type PostDeployment struct {
+	Name        string            `yaml:"name"`
+	Commands    []string          `yaml:"commands,omitempty"`
+	Buildspec   string            `yaml:"buildspec,omitempty"` // Path to a buildspec file from the root of the repository.
+	Image       string            `yaml:"image,omitempty"`
+	ComputeType string            `yaml:"compute_type,omitempty"`
+	Variables   map[string]string `yaml:"variables,omitempty"`
 }
 
 // NewPipelineManifest returns a pipeline manifest object.
@@ -230,6 +254,11 @@ func UnmarshalPipeline(in []byte) (*PipelineManifest, error) {
 	// TODO: #221 Do more validations
 	switch version {
 	case Ver1:
+		for _, stage := range pm.Stages {
+			if err := stage.validate(); err != nil {
+				return nil, err
+			}
+		}
 		return &pm, nil
 	}
 	// we should never reach here, this is just to make the compiler happy
@@ -248,6 +277,47 @@ func (s Source) IsCodeStarConnection() bool {
 	}
 }
 
+func (s PipelineStage) validate() error {
+	if err := s.validateApprovalTopic(); err != nil {
+		return err
+	}
+	names := make(map[string]bool)
+	for _, pd := range s.PostDeployments {
+		if err := pd.validate(); err != nil {
+			return fmt.Errorf("stage %s: %w", s.Name, err)
+		}
+		if pd.Name == testCommandsActionName || names[pd.Name] {
+			return fmt.Errorf(`stage %s: "post_deployments" name %s is already used by another test action`, s.Name, pd.Name)
+		}
+		names[pd.Name] = true
+	}
+	return nil
+}
+
+func (s PipelineStage) validateApprovalTopic() error {
+	if s.ApprovalTopic == "" {
+		return nil
+	}
+	if !s.RequiresApproval && !s.ReviewChangeSets {
+		return fmt.Errorf(`stage %s: "approval_topic" can only be specified with "requires_approval: true" or "review_changesets: true"`, s.Name)
+	}
+	parsed, err := arn.Parse(s.ApprovalTopic)
+	if err != nil || parsed.Service != snsServiceName {
+		return fmt.Errorf(`stage %s: "approval_topic" must be the ARN of an SNS topic: %s`, s.Name, s.ApprovalTopic)
+	}
+	return nil
+}
+
+func (p PostDeployment) validate() error {
+	if !postDeploymentNameRegexp.MatchString(p.Name) {
+		return fmt.Errorf(`"post_deployments" name %q must only contain letters, numbers and dashes`, p.Name)
+	}
+	if (len(p.Commands) == 0) == (p.Buildspec == "") {
+		return fmt.Errorf(`"post_deployments" %s must specify exactly one of "commands" or "buildspec"`, p.Name)
+	}
+	return nil
+}
+
 func validateVersion(pm *PipelineManifest) (PipelineSchemaMajorVersion, error) {
 	switch pm.Version {
 	case Ver1:
@@ -214,6 +214,43 @@ stages:
 				PipelineSchemaMajorVersion(-1),
 			},
 		},
+		"approval topic without requires_approval": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: prod
+      approval_topic: arn:aws:sns:us-west-2:123456789012:approvals
+`,
+			expectedErr: errors.New(`stage prod: "approval_topic" can only be specified with "requires_approval: true" or "review_changesets: true"`),
+		},
+		"approval topic is not an SNS topic ARN": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: prod
+      requires_approval: true
+      approval_topic: approvals
+`,
+			expectedErr: errors.New(`stage prod: "approval_topic" must be the ARN of an SNS topic: approvals`),
+		},
 		"invalid pipeline.yml": {
 			inContent:   `corrupted yaml`,
 			expectedErr: errors.New("yaml: unmarshal errors:\n  line 1: cannot unmarshal !!str `corrupt...` into manifest.PipelineManifest"),
@@ -303,6 +340,201 @@ stages:
 				},
 			},
 		},
+		"valid pipeline.yml with manual approval": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: test
+    -
+      name: prod
+      requires_approval: true
+      approval_topic: arn:aws:sns:us-west-2:123456789012:approvals
+`,
+			expectedManifest: &PipelineManifest{
+				Name:    "pipepiper",
+				Version: Ver1,
+				Source: &Source{
+					ProviderName: "GitHub",
+					Properties: map[string]interface{}{
+						"repository": "aws/somethingCool",
+						"branch":     defaultGHBranch,
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+					},
+					{
+						Name:             "prod",
+						RequiresApproval: true,
+						ApprovalTopic:    "arn:aws:sns:us-west-2:123456789012:approvals",
+					},
+				},
+			},
+		},
+		"valid pipeline.yml with change set review": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: prod
+      review_changesets: true
+      approval_topic: arn:aws:sns:us-west-2:123456789012:approvals
+`,
+			expectedManifest: &PipelineManifest{
+				Name:    "pipepiper",
+				Version: Ver1,
+				Source: &Source{
+					ProviderName: "GitHub",
+					Properties: map[string]interface{}{
+						"repository": "aws/somethingCool",
+						"branch":     defaultGHBranch,
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name:             "prod",
+						ReviewChangeSets: true,
+						ApprovalTopic:    "arn:aws:sns:us-west-2:123456789012:approvals",
+					},
+				},
+			},
+		},
+		"post deployment with both commands and buildspec": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: test
+      post_deployments:
+        - name: integ-tests
+          commands: [make integ-test]
+          buildspec: tests/buildspec.yml
+`,
+			expectedErr: errors.New(`stage test: "post_deployments" integ-tests must specify exactly one of "commands" or "buildspec"`),
+		},
+		"post deployment with an invalid name": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: test
+      post_deployments:
+        - name: integ tests
+          commands: [make integ-test]
+`,
+			expectedErr: errors.New(`stage test: "post_deployments" name "integ tests" must only contain letters, numbers and dashes`),
+		},
+		"post deployment with a name that is already used": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: test
+      test_commands: [make test]
+      post_deployments:
+        - name: TestCommands
+          commands: [make integ-test]
+`,
+			expectedErr: errors.New(`stage test: "post_deployments" name TestCommands is already used by another test action`),
+		},
+		"valid pipeline.yml with post deployments": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: test
+      post_deployments:
+        - name: integ-tests
+          commands: [make integ-test]
+          image: aws/codebuild/standard:5.0
+          compute_type: BUILD_GENERAL1_MEDIUM
+          variables:
+            LOG_LEVEL: debug
+        - name: load-tests
+          buildspec: tests/buildspec.yml
+`,
+			expectedManifest: &PipelineManifest{
+				Name:    "pipepiper",
+				Version: Ver1,
+				Source: &Source{
+					ProviderName: "GitHub",
+					Properties: map[string]interface{}{
+						"repository": "aws/somethingCool",
+						"branch":     defaultGHBranch,
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						PostDeployments: []PostDeployment{
+							{
+								Name:        "integ-tests",
+								Commands:    []string{"make integ-test"},
+								Image:       "aws/codebuild/standard:5.0",
+								ComputeType: "BUILD_GENERAL1_MEDIUM",
+								Variables: map[string]string{
+									"LOG_LEVEL": "debug",
+								},
+							},
+							{
+								Name:      "load-tests",
+								Buildspec: "tests/buildspec.yml",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/manifest/remote/remote.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockobjectGetter is a mock of objectGetter interface.
+type MockobjectGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockobjectGetterMockRecorder
+}
+
+// MockobjectGetterMockRecorder is the mock recorder for MockobjectGetter.
+type MockobjectGetterMockRecorder struct {
+	mock *MockobjectGetter
+}
+
+// NewMockobjectGetter creates a new mock instance.
+func NewMockobjectGetter(ctrl *gomock.Controller) *MockobjectGetter {
+	mock := &MockobjectGetter{ctrl: ctrl}
+	mock.recorder = &MockobjectGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockobjectGetter) EXPECT() *MockobjectGetterMockRecorder {
+	return m.recorder
+}
+
+// GetObject mocks base method.
+func (m *MockobjectGetter) GetObject(bucket, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObject", bucket, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObject indicates an expected call of GetObject.
+func (mr *MockobjectGetterMockRecorder) GetObject(bucket, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObject", reflect.TypeOf((*MockobjectGetter)(nil).GetObject), bucket, key)
+}
+
+// MockhttpGetter is a mock of httpGetter interface.
+type MockhttpGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockhttpGetterMockRecorder
+}
+
+// MockhttpGetterMockRecorder is the mock recorder for MockhttpGetter.
+type MockhttpGetterMockRecorder struct {
+	mock *MockhttpGetter
+}
+
+// NewMockhttpGetter creates a new mock instance.
+func NewMockhttpGetter(ctrl *gomock.Controller) *MockhttpGetter {
+	mock := &MockhttpGetter{ctrl: ctrl}
+	mock.recorder = &MockhttpGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockhttpGetter) EXPECT() *MockhttpGetterMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockhttpGetter) Get(url string) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", url)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockhttpGetterMockRecorder) Get(url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockhttpGetter)(nil).Get), url)
+}
@@ -0,0 +1,131 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package remote reads workload manifests stored outside of the workspace, and merges local overrides into them.
+package remote
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	s3Scheme    = "s3://"
+	httpsScheme = "https://"
+
+	requestTimeout = 30 * time.Second
+	yamlIndent     = 2
+)
+
+type objectGetter interface {
+	GetObject(bucket, key string) ([]byte, error)
+}
+
+type httpGetter interface {
+	Get(url string) (*http.Response, error)
+}
+
+// Reader reads manifests from S3 buckets or HTTPS URLs.
+type Reader struct {
+	s3   objectGetter
+	http httpGetter
+}
+
+// New returns a Reader configured against the input session.
+func New(sess *session.Session) *Reader {
+	return &Reader{
+		s3:   s3.New(sess),
+		http: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// Validate returns an error if the location is neither an S3 object nor an HTTPS URL.
+func Validate(location string) error {
+	switch {
+	case strings.HasPrefix(location, s3Scheme):
+		if _, _, err := parseS3Location(location); err != nil {
+			return err
+		}
+		return nil
+	case strings.HasPrefix(location, httpsScheme):
+		return nil
+	default:
+		return fmt.Errorf("location %s must start with %s or %s", location, s3Scheme, httpsScheme)
+	}
+}
+
+// Read returns the content of the manifest at the location.
+// The location is either an S3 object like s3://bucket/key, or an HTTPS URL like the raw URL of a file in a git repository.
+func (r *Reader) Read(location string) ([]byte, error) {
+	if err := Validate(location); err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(location, s3Scheme) {
+		bucket, key, _ := parseS3Location(location)
+		return r.s3.GetObject(bucket, key)
+	}
+	resp, err := r.http.Get(location)
+	if err != nil {
+		return nil, fmt.Errorf("get %s: %w", location, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get %s: unexpected status %s", location, resp.Status)
+	}
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response of %s: %w", location, err)
+	}
+	return content, nil
+}
+
+// Merge returns the base manifest with the fields of the overrides manifest applied on top of it.
+// Maps are merged recursively, while any other value of the overrides, including lists, replaces the one of the base.
+func Merge(base, overrides []byte) ([]byte, error) {
+	var baseFields, overrideFields map[string]interface{}
+	if err := yaml.Unmarshal(base, &baseFields); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+	if err := yaml.Unmarshal(overrides, &overrideFields); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest overrides: %w", err)
+	}
+	var merged bytes.Buffer
+	enc := yaml.NewEncoder(&merged)
+	enc.SetIndent(yamlIndent)
+	if err := enc.Encode(mergeFields(baseFields, overrideFields)); err != nil {
+		return nil, fmt.Errorf("marshal merged manifest: %w", err)
+	}
+	return merged.Bytes(), nil
+}
+
+func mergeFields(dst, src map[string]interface{}) map[string]interface{} {
+	if dst == nil {
+		dst = make(map[string]interface{})
+	}
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			dst[key] = mergeFields(dstMap, srcMap)
+			continue
+		}
+		dst[key] = value
+	}
+	return dst
+}
+
+func parseS3Location(location string) (bucket, key string, err error) {
+	parts := strings.SplitN(strings.TrimPrefix(location, s3Scheme), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("location %s must be of the form %sbucket/key", location, s3Scheme)
+	}
+	return parts[0], parts[1], nil
+}
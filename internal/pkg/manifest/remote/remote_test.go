@@ -0,0 +1,210 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package remote
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest/remote/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := map[string]struct {
+		inLocation string
+
+		wantedErr error
+	}{
+		"errors if the scheme is not supported": {
+			inLocation: "http://example.com/manifest.yml",
+			wantedErr:  errors.New("location http://example.com/manifest.yml must start with s3:// or https://"),
+		},
+		"errors if the S3 location has no key": {
+			inLocation: "s3://platform-manifests",
+			wantedErr:  errors.New("location s3://platform-manifests must be of the form s3://bucket/key"),
+		},
+		"valid S3 location": {
+			inLocation: "s3://platform-manifests/golden/api.yml",
+		},
+		"valid HTTPS location": {
+			inLocation: "https://raw.githubusercontent.com/org/manifests/main/api.yml",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			err := Validate(tc.inLocation)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestReader_Read(t *testing.T) {
+	const httpsLocation = "https://raw.githubusercontent.com/org/manifests/main/api.yml"
+	testCases := map[string]struct {
+		inLocation string
+		setupMocks func(s3 *mocks.MockobjectGetter, http *mocks.MockhttpGetter)
+
+		wanted    []byte
+		wantedErr error
+	}{
+		"errors if the object can't be read from S3": {
+			inLocation: "s3://platform-manifests/golden/api.yml",
+			setupMocks: func(s3 *mocks.MockobjectGetter, _ *mocks.MockhttpGetter) {
+				s3.EXPECT().GetObject("platform-manifests", "golden/api.yml").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"reads the manifest from S3": {
+			inLocation: "s3://platform-manifests/golden/api.yml",
+			setupMocks: func(s3 *mocks.MockobjectGetter, _ *mocks.MockhttpGetter) {
+				s3.EXPECT().GetObject("platform-manifests", "golden/api.yml").Return([]byte("name: api"), nil)
+			},
+			wanted: []byte("name: api"),
+		},
+		"errors if the URL can't be fetched": {
+			inLocation: httpsLocation,
+			setupMocks: func(_ *mocks.MockobjectGetter, h *mocks.MockhttpGetter) {
+				h.EXPECT().Get(httpsLocation).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get https://raw.githubusercontent.com/org/manifests/main/api.yml: some error"),
+		},
+		"errors if the URL doesn't respond with a 200": {
+			inLocation: httpsLocation,
+			setupMocks: func(_ *mocks.MockobjectGetter, h *mocks.MockhttpGetter) {
+				h.EXPECT().Get(httpsLocation).Return(&http.Response{
+					StatusCode: http.StatusNotFound,
+					Status:     "404 Not Found",
+					Body:       ioutil.NopCloser(strings.NewReader("")),
+				}, nil)
+			},
+			wantedErr: errors.New("get https://raw.githubusercontent.com/org/manifests/main/api.yml: unexpected status 404 Not Found"),
+		},
+		"reads the manifest from the URL": {
+			inLocation: httpsLocation,
+			setupMocks: func(_ *mocks.MockobjectGetter, h *mocks.MockhttpGetter) {
+				h.EXPECT().Get(httpsLocation).Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(strings.NewReader("name: api")),
+				}, nil)
+			},
+			wanted: []byte("name: api"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			s3 := mocks.NewMockobjectGetter(ctrl)
+			h := mocks.NewMockhttpGetter(ctrl)
+			tc.setupMocks(s3, h)
+			r := &Reader{
+				s3:   s3,
+				http: h,
+			}
+
+			// WHEN
+			got, err := r.Read(tc.inLocation)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	testCases := map[string]struct {
+		inBase      string
+		inOverrides string
+
+		wanted    string
+		wantedErr error
+	}{
+		"errors if the base is not a map": {
+			inBase:    "- api",
+			wantedErr: errors.New("unmarshal manifest: yaml: unmarshal errors:\n  line 1: cannot unmarshal !!seq into map[string]interface {}"),
+		},
+		"returns the base if there are no overrides": {
+			inBase: `name: api
+type: Backend Service
+`,
+			wanted: `name: api
+type: Backend Service
+`,
+		},
+		"merges maps and replaces other values": {
+			inBase: `name: api
+type: Backend Service
+count: 1
+image:
+  build: ./Dockerfile
+  port: 8080
+variables:
+  LOG_LEVEL: info
+sidecars:
+  xray:
+    image: amazon/aws-xray-daemon
+secrets:
+  - DB_PASSWORD
+`,
+			inOverrides: `count: 3
+image:
+  port: 80
+variables:
+  FEATURE_FLAG: "on"
+secrets:
+  - API_KEY
+`,
+			wanted: `count: 3
+image:
+  build: ./Dockerfile
+  port: 80
+name: api
+secrets:
+  - API_KEY
+sidecars:
+  xray:
+    image: amazon/aws-xray-daemon
+type: Backend Service
+variables:
+  FEATURE_FLAG: "on"
+  LOG_LEVEL: info
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := Merge([]byte(tc.inBase), []byte(tc.inOverrides))
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(got))
+		})
+	}
+}
@@ -0,0 +1,456 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"gopkg.in/yaml.v3"
+)
+
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// JSON Schema types of manifest fields.
+const (
+	schemaTypeObject  = "object"
+	schemaTypeArray   = "array"
+	schemaTypeString  = "string"
+	schemaTypeInteger = "integer"
+	schemaTypeNumber  = "number"
+	schemaTypeBoolean = "boolean"
+)
+
+// JSONSchema is the subset of a JSON Schema (draft-07) document used to describe workload manifests.
+type JSONSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Type                 string                 `json:"type,omitempty"` // If empty, any value is accepted.
+	Enum                 []string               `json:"enum,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties interface{}            `json:"additionalProperties,omitempty"` // Either false or a *JSONSchema.
+	Items                *JSONSchema            `json:"items,omitempty"`
+	OneOf                []*JSONSchema          `json:"oneOf,omitempty"`
+}
+
+// yamlUnmarshaler is the yaml (v2) unmarshaler interface implemented by the fields with custom unmarshaling logic.
+type yamlUnmarshaler interface {
+	UnmarshalYAML(unmarshal func(interface{}) error) error
+}
+
+var (
+	stringType      = reflect.TypeOf("")
+	stringSliceType = reflect.TypeOf([]string{})
+	boolType        = reflect.TypeOf(true)
+	intType         = reflect.TypeOf(0)
+	unmarshalerType = reflect.TypeOf((*yamlUnmarshaler)(nil)).Elem()
+)
+
+// unionTypes maps the fields that can be unmarshaled from one of several YAML shapes to the types of these shapes.
+// Fields with custom unmarshaling logic that keep the shape of their struct map to nil.
+var unionTypes = map[reflect.Type][]reflect.Type{
+	reflect.TypeOf(BuildArgsOrString{}):       {stringType, reflect.TypeOf(DockerBuildArgs{})},
+	reflect.TypeOf(HealthCheckArgsOrString{}): {stringType, reflect.TypeOf(HTTPHealthCheckArgs{})},
+	reflect.TypeOf(EFSConfigOrBool{}):         {boolType, reflect.TypeOf(EFSVolumeConfiguration{})},
+	reflect.TypeOf(Range{}):                   {stringType, reflect.TypeOf(RangeConfig{})},
+	reflect.TypeOf(Count{}):                   {intType, reflect.TypeOf(AdvancedCount{})},
+	reflect.TypeOf(ExecuteCommand{}):          {boolType, reflect.TypeOf(ExecuteCommandConfig{})},
+	reflect.TypeOf(EntryPointOverride{}):      {stringType, stringSliceType},
+	reflect.TypeOf(CommandOverride{}):         {stringType, stringSliceType},
+	reflect.TypeOf(BuildPlatform{}):           {stringType, stringSliceType},
+	reflect.TypeOf(NetworkConfig{}):           nil,
+	reflect.TypeOf(ImageRetention{}):          nil,
+}
+
+// WorkloadSchema returns the JSON Schema of the manifest of a workload type.
+func WorkloadSchema(typ string) (*JSONSchema, error) {
+	var mft interface{}
+	switch typ {
+	case LoadBalancedWebServiceType:
+		mft = LoadBalancedWebService{}
+	case BackendServiceType:
+		mft = BackendService{}
+	case ScheduledJobType:
+		mft = ScheduledJob{}
+	default:
+		return nil, &ErrInvalidWorkloadType{Type: typ}
+	}
+	schema, err := schemaOf(reflect.TypeOf(mft))
+	if err != nil {
+		return nil, fmt.Errorf("generate JSON schema of %s manifest: %w", typ, err)
+	}
+	schema.Schema = jsonSchemaDraft
+	schema.Title = fmt.Sprintf("%s manifest", typ)
+	schema.Properties["type"].Enum = []string{typ}
+	schema.Required = []string{"name", "type"}
+	return schema, nil
+}
+
+// schemaOf returns the JSON Schema of the YAML representation of a Go type, as unmarshaled by the yaml package.
+func schemaOf(t reflect.Type) (*JSONSchema, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == reflect.TypeOf(time.Duration(0)) {
+		// Durations are unmarshaled from strings such as "30s".
+		return &JSONSchema{Type: schemaTypeString}, nil
+	}
+	if alternatives, ok := unionTypes[t]; ok && alternatives != nil {
+		schema := &JSONSchema{}
+		for _, alt := range alternatives {
+			altSchema, err := schemaOf(alt)
+			if err != nil {
+				return nil, err
+			}
+			schema.OneOf = append(schema.OneOf, altSchema)
+		}
+		return schema, nil
+	} else if !ok && reflect.PtrTo(t).Implements(unmarshalerType) {
+		return nil, fmt.Errorf("type %s has a custom YAML unmarshaler but no schema", t)
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return &JSONSchema{Type: schemaTypeString}, nil
+	case reflect.Bool:
+		return &JSONSchema{Type: schemaTypeBoolean}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &JSONSchema{Type: schemaTypeInteger}, nil
+	case reflect.Float32, reflect.Float64:
+		return &JSONSchema{Type: schemaTypeNumber}, nil
+	case reflect.Interface:
+		return &JSONSchema{}, nil
+	case reflect.Slice:
+		items, err := schemaOf(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &JSONSchema{Type: schemaTypeArray, Items: items}, nil
+	case reflect.Map:
+		values, err := schemaOf(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &JSONSchema{Type: schemaTypeObject, AdditionalProperties: values}, nil
+	case reflect.Struct:
+		schema := &JSONSchema{
+			Type:                 schemaTypeObject,
+			Properties:           make(map[string]*JSONSchema),
+			AdditionalProperties: false,
+		}
+		if err := addStructProperties(schema, t); err != nil {
+			return nil, err
+		}
+		return schema, nil
+	default:
+		return nil, fmt.Errorf("unsupported kind %s of type %s", t.Kind(), t)
+	}
+}
+
+// addStructProperties adds the YAML fields of a struct to the properties of the schema, including inlined structs.
+func addStructProperties(schema *JSONSchema, t reflect.Type) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue // Unexported fields are not unmarshaled.
+		}
+		tag, ok := field.Tag.Lookup("yaml")
+		if !ok {
+			// Fields without a yaml tag are set by Copilot while rendering templates, not by the manifest.
+			continue
+		}
+		opts := strings.Split(tag, ",")
+		name := opts[0]
+		if name == "-" {
+			continue
+		}
+		if contains(opts[1:], "inline") {
+			ft := field.Type
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if err := addStructProperties(schema, ft); err != nil {
+				return err
+			}
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		prop, err := schemaOf(field.Type)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", name, err)
+		}
+		schema.Properties[name] = prop
+	}
+	return nil
+}
+
+// SchemaViolation is a field of a manifest that doesn't match the JSON Schema of the manifest.
+type SchemaViolation struct {
+	Line  int    // Line of the field in the manifest.
+	Field string // Path to the field, such as "environments.test.count".
+	Msg   string
+}
+
+func (v *SchemaViolation) Error() string {
+	if v.Field == "" {
+		return fmt.Sprintf("line %d: %s", v.Line, v.Msg)
+	}
+	return fmt.Sprintf("line %d: %s: %s", v.Line, v.Field, v.Msg)
+}
+
+// ValidateWorkload checks a workload manifest against the JSON Schema of its type, and that the fields
+// overridden for each environment can be applied. If envs is not nil, every environment overridden in
+// the manifest must be one of envs.
+// The fields that are invalid are returned sorted by line. An error is returned if the manifest can't be read.
+func ValidateWorkload(in []byte, envs []string) ([]*SchemaViolation, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(in, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+	wl := Workload{}
+	if err := yaml.Unmarshal(in, &wl); err != nil {
+		return nil, fmt.Errorf("unmarshal to workload manifest: %w", err)
+	}
+	schema, err := WorkloadSchema(aws.StringValue(wl.Type))
+	if err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	root := doc.Content[0]
+	violations := schema.validate(root, "")
+	envNodes := mappingValue(root, "environments")
+	if envNodes != nil && envs != nil {
+		for i := 0; i+1 < len(envNodes.Content); i += 2 {
+			key := envNodes.Content[i]
+			if !contains(envs, key.Value) {
+				violations = append(violations, &SchemaViolation{
+					Line:  key.Line,
+					Field: "environments." + key.Value,
+					Msg:   fmt.Sprintf("environment does not exist in the application, must be one of: %s", strings.Join(envs, ", ")),
+				})
+			}
+		}
+	}
+	if len(violations) == 0 {
+		violations = applyEnvs(in, root, envNodes)
+	}
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Line < violations[j].Line
+	})
+	return violations, nil
+}
+
+// applyEnvs returns the errors of unmarshaling the manifest and of applying the overrides of each of its environments.
+func applyEnvs(in []byte, root, envNodes *yaml.Node) []*SchemaViolation {
+	if _, err := UnmarshalWorkload(in); err != nil {
+		return []*SchemaViolation{{Line: root.Line, Msg: err.Error()}}
+	}
+	if envNodes == nil {
+		return nil
+	}
+	var violations []*SchemaViolation
+	for i := 0; i+1 < len(envNodes.Content); i += 2 {
+		key := envNodes.Content[i]
+		// Unmarshal the manifest for each environment as overrides can modify the fields they are applied to.
+		mft, err := UnmarshalWorkload(in)
+		if err != nil {
+			return []*SchemaViolation{{Line: root.Line, Msg: err.Error()}}
+		}
+		switch m := mft.(type) {
+		case *LoadBalancedWebService:
+			_, err = m.ApplyEnv(key.Value)
+		case *BackendService:
+			_, err = m.ApplyEnv(key.Value)
+		case *ScheduledJob:
+			_, err = m.ApplyEnv(key.Value)
+		}
+		if err != nil {
+			violations = append(violations, &SchemaViolation{
+				Line:  key.Line,
+				Field: "environments." + key.Value,
+				Msg:   err.Error(),
+			})
+		}
+	}
+	return violations
+}
+
+// validate returns the fields of the YAML node that don't match the schema.
+func (s *JSONSchema) validate(node *yaml.Node, path string) []*SchemaViolation {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+		// Null values leave the field unset.
+		return nil
+	}
+	if len(s.OneOf) > 0 {
+		var types []string
+		for _, alt := range s.OneOf {
+			if alt.accepts(node) {
+				return alt.validate(node, path)
+			}
+			types = append(types, alt.Type)
+		}
+		return []*SchemaViolation{s.typeViolation(node, path, types)}
+	}
+	if !s.accepts(node) {
+		return []*SchemaViolation{s.typeViolation(node, path, []string{s.Type})}
+	}
+	if len(s.Enum) > 0 && !contains(s.Enum, node.Value) {
+		return []*SchemaViolation{{
+			Line:  node.Line,
+			Field: path,
+			Msg:   fmt.Sprintf("%q is not one of %s", node.Value, strings.Join(quoteAll(s.Enum), ", ")),
+		}}
+	}
+	switch node.Kind {
+	case yaml.SequenceNode:
+		if s.Items == nil {
+			return nil
+		}
+		var violations []*SchemaViolation
+		for i, item := range node.Content {
+			violations = append(violations, s.Items.validate(item, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return violations
+	case yaml.MappingNode:
+		return s.validateMapping(node, path)
+	}
+	return nil
+}
+
+func (s *JSONSchema) validateMapping(node *yaml.Node, path string) []*SchemaViolation {
+	var violations []*SchemaViolation
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if key.Value == "<<" {
+			// Merge keys insert the fields of other mappings.
+			merged := []*yaml.Node{value}
+			if value.Kind == yaml.SequenceNode {
+				merged = value.Content
+			}
+			for _, m := range merged {
+				violations = append(violations, s.validate(m, path)...)
+			}
+			continue
+		}
+		field := key.Value
+		if path != "" {
+			field = path + "." + key.Value
+		}
+		if prop, ok := s.Properties[key.Value]; ok {
+			violations = append(violations, prop.validate(value, field)...)
+			continue
+		}
+		switch additional := s.AdditionalProperties.(type) {
+		case *JSONSchema:
+			violations = append(violations, additional.validate(value, field)...)
+		case bool:
+			if !additional {
+				violations = append(violations, &SchemaViolation{
+					Line:  key.Line,
+					Field: field,
+					Msg:   "unknown field",
+				})
+			}
+		}
+	}
+	for _, name := range s.Required {
+		if mappingValue(node, name) == nil {
+			violations = append(violations, &SchemaViolation{
+				Line:  node.Line,
+				Field: name,
+				Msg:   "missing required field",
+			})
+		}
+	}
+	return violations
+}
+
+// accepts returns true if the kind of the YAML node matches the type of the schema.
+// Any scalar is accepted for strings, since the yaml package unmarshals it as its literal value.
+func (s *JSONSchema) accepts(node *yaml.Node) bool {
+	switch s.Type {
+	case schemaTypeObject:
+		return node.Kind == yaml.MappingNode
+	case schemaTypeArray:
+		return node.Kind == yaml.SequenceNode
+	case schemaTypeString:
+		return node.Kind == yaml.ScalarNode
+	case schemaTypeInteger:
+		return node.Kind == yaml.ScalarNode && node.Tag == "!!int"
+	case schemaTypeNumber:
+		return node.Kind == yaml.ScalarNode && (node.Tag == "!!int" || node.Tag == "!!float")
+	case schemaTypeBoolean:
+		return node.Kind == yaml.ScalarNode && node.Tag == "!!bool"
+	}
+	return true
+}
+
+func (s *JSONSchema) typeViolation(node *yaml.Node, path string, wanted []string) *SchemaViolation {
+	return &SchemaViolation{
+		Line:  node.Line,
+		Field: path,
+		Msg:   fmt.Sprintf("expected %s, got %s", strings.Join(wanted, " or "), nodeType(node)),
+	}
+}
+
+// nodeType returns the JSON Schema type of a YAML node.
+func nodeType(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return schemaTypeObject
+	case yaml.SequenceNode:
+		return schemaTypeArray
+	}
+	switch node.Tag {
+	case "!!int":
+		return schemaTypeInteger
+	case "!!float":
+		return schemaTypeNumber
+	case "!!bool":
+		return schemaTypeBoolean
+	}
+	return schemaTypeString
+}
+
+// mappingValue returns the value of the key in a YAML mapping node, or nil if the key doesn't exist.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func quoteAll(elems []string) []string {
+	quoted := make([]string, len(elems))
+	for i, elem := range elems {
+		quoted[i] = fmt.Sprintf("%q", elem)
+	}
+	return quoted
+}
+
+func contains(elems []string, s string) bool {
+	for _, elem := range elems {
+		if elem == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,199 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadSchema(t *testing.T) {
+	for _, typ := range WorkloadTypes {
+		t.Run(typ, func(t *testing.T) {
+			// WHEN
+			schema, err := WorkloadSchema(typ)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, jsonSchemaDraft, schema.Schema)
+			require.Equal(t, []string{typ}, schema.Properties["type"].Enum)
+			require.Equal(t, []string{"name", "type"}, schema.Required)
+			require.Equal(t, false, schema.AdditionalProperties)
+			require.Contains(t, schema.Properties, "environments")
+			require.Contains(t, schema.Properties, "image")
+			require.NotContains(t, schema.Properties, "appdomain", "fields without a yaml tag are not part of the manifest")
+		})
+	}
+
+	t.Run("errors on unknown workload type", func(t *testing.T) {
+		// WHEN
+		_, err := WorkloadSchema("Static Site")
+
+		// THEN
+		require.EqualError(t, err, "invalid manifest type: Static Site")
+	})
+}
+
+func TestValidateWorkload(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest string
+		inEnvs     []string
+
+		wantedViolations []string
+		wantedErr        error
+	}{
+		"errors if the manifest is not valid YAML": {
+			inManifest: "name: [api",
+			wantedErr:  errors.New("unmarshal manifest: yaml: line 1: did not find expected ',' or ']'"),
+		},
+		"errors if the workload type is unknown": {
+			inManifest: `name: api
+type: Static Site`,
+			wantedErr: errors.New("invalid manifest type: Static Site"),
+		},
+		"reports unknown fields and type errors": {
+			inManifest: `name: api
+type: Backend Service
+image:
+  build: ./Dockerfile
+  prot: 80
+cpu: lots
+count:
+  range: 1-10
+  cpu_percenage: 70
+exec: yes
+variables:
+  LOG_LEVEL: info
+`,
+			wantedViolations: []string{
+				"line 5: image.prot: unknown field",
+				"line 6: cpu: expected integer, got string",
+				"line 9: count.cpu_percenage: unknown field",
+				"line 10: exec: expected boolean or object, got string",
+			},
+		},
+		"reports fields of merged anchors": {
+			inManifest: `name: api
+type: Backend Service
+x-image: &image
+  build: ./Dockerfile
+  prot: 80
+image:
+  <<: *image
+`,
+			wantedViolations: []string{
+				"line 3: x-image: unknown field",
+				"line 5: image.prot: unknown field",
+			},
+		},
+		"reports invalid per-environment overrides": {
+			inManifest: `name: report
+type: Scheduled Job
+image:
+  build: ./Dockerfile
+on:
+  schedule: "@daily"
+environments:
+  test:
+    retries: many
+  staging:
+    cpu: 1024
+`,
+			inEnvs: []string{"test", "prod"},
+			wantedViolations: []string{
+				"line 9: environments.test.retries: expected integer, got string",
+				"line 10: environments.staging: environment does not exist in the application, must be one of: test, prod",
+			},
+		},
+		"reports errors of the manifest once it matches the schema": {
+			inManifest: `name: api
+type: Backend Service
+image:
+  build: ./Dockerfile
+network:
+  vpc:
+    placement: moon
+`,
+			wantedViolations: []string{
+				`line 1: unmarshal to backend service: field 'network.vpc.placement' is 'moon' must be one of []string{"public", "private"}`,
+			},
+		},
+		"accepts a valid manifest": {
+			inManifest: `name: frontend
+type: Load Balanced Web Service
+image:
+  build:
+    dockerfile: ./Dockerfile
+    args:
+      GO_VERSION: 1.15
+  port: 80
+http:
+  path: '/'
+  healthcheck: /_healthcheck
+count:
+  range:
+    min: 1
+    max: 10
+  response_time: 2s
+exec: true
+storage:
+  volumes:
+    data:
+      efs: true
+      path: /data
+environments:
+  test:
+    count: 1
+    http:
+      healthcheck:
+        path: /
+        healthy_threshold: 2
+`,
+			inEnvs: []string{"test"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			violations, err := ValidateWorkload([]byte(tc.inManifest), tc.inEnvs)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			var got []string
+			for _, v := range violations {
+				got = append(got, v.Error())
+			}
+			require.Equal(t, tc.wantedViolations, got)
+		})
+	}
+}
+
+func TestValidateWorkload_Testdata(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "*.yml"))
+	require.NoError(t, err)
+	for _, file := range files {
+		t.Run(file, func(t *testing.T) {
+			// GIVEN
+			in, err := ioutil.ReadFile(file)
+			require.NoError(t, err)
+
+			// WHEN
+			violations, err := ValidateWorkload(in, nil)
+
+			// THEN
+			require.NoError(t, err)
+			require.Empty(t, violations, fmt.Sprintf("manifest %s should match its schema", file))
+		})
+	}
+}
@@ -5,6 +5,8 @@ package manifest
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"gopkg.in/yaml.v3"
@@ -126,6 +128,88 @@ func (e *EFSVolumeConfiguration) EmptyUIDConfig() bool {
 	return e.UID == nil && e.GID == nil
 }
 
+// AddVolume returns the manifest with the YAML definition of the volume inserted under "storage.volumes".
+// The volume is inserted as text so that the comments and formatting of the rest of the manifest are preserved.
+func AddVolume(mft []byte, name, volume string) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(mft, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, errors.New("manifest is not a YAML map")
+	}
+	lines := strings.Split(strings.TrimRight(string(mft), "\n"), "\n")
+
+	storageKey, storage := mappingEntry(doc.Content[0], "storage")
+	if storage == nil {
+		inserted := append([]string{"", "storage:", "  volumes:"}, volumeLines(4, name, volume)...)
+		return insertLines(lines, len(lines), inserted)
+	}
+	if !isBlockMappingOrEmpty(storage) {
+		return nil, errors.New(`"storage" must be a block mapping to add a volume`)
+	}
+	volumesKey, volumes := mappingEntry(storage, "volumes")
+	if volumes == nil {
+		indent := childIndent(storageKey, storage)
+		inserted := append([]string{strings.Repeat(" ", indent) + "volumes:"}, volumeLines(indent+2, name, volume)...)
+		return insertLines(lines, storageKey.Line, inserted)
+	}
+	if !isBlockMappingOrEmpty(volumes) {
+		return nil, errors.New(`"storage.volumes" must be a block mapping to add a volume`)
+	}
+	if key, _ := mappingEntry(volumes, name); key != nil {
+		return nil, fmt.Errorf("volume %s already exists in the manifest", name)
+	}
+	return insertLines(lines, volumesKey.Line, volumeLines(childIndent(volumesKey, volumes), name, volume))
+}
+
+// mappingEntry returns the key and value nodes of the entry named key in the mapping node, or nils if it doesn't exist.
+func mappingEntry(mapping *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i], mapping.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+func isBlockMappingOrEmpty(node *yaml.Node) bool {
+	if node.Kind == yaml.ScalarNode {
+		return node.Tag == "!!null" && node.Value == ""
+	}
+	return node.Kind == yaml.MappingNode && node.Style&yaml.FlowStyle == 0 && len(node.Content) > 0
+}
+
+// childIndent returns the indentation of the entries under key, defaulting to two spaces deeper than the key.
+func childIndent(key, value *yaml.Node) int {
+	if value.Kind == yaml.MappingNode {
+		return value.Content[0].Column - 1
+	}
+	return key.Column - 1 + 2
+}
+
+func volumeLines(indent int, name, volume string) []string {
+	lines := []string{strings.Repeat(" ", indent) + name + ":"}
+	for _, line := range strings.Split(strings.TrimRight(volume, "\n"), "\n") {
+		lines = append(lines, strings.Repeat(" ", indent+2)+line)
+	}
+	return lines
+}
+
+// insertLines inserts the new lines after the line number, and validates that the result is still valid YAML.
+func insertLines(lines []string, after int, inserted []string) ([]byte, error) {
+	out := make([]string, 0, len(lines)+len(inserted))
+	out = append(out, lines[:after]...)
+	out = append(out, inserted...)
+	out = append(out, lines[after:]...)
+	mft := []byte(strings.Join(out, "\n") + "\n")
+	var node yaml.Node
+	if err := yaml.Unmarshal(mft, &node); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest with the new volume: %w", err)
+	}
+	return mft, nil
+}
+
 // AuthorizationConfig holds options relating to access points and IAM authorization.
 type AuthorizationConfig struct {
 	IAM           *bool   `yaml:"iam"`             // Default true
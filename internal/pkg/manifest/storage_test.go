@@ -4,6 +4,7 @@
 package manifest
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -238,3 +239,121 @@ func Test_UseManagedFS(t *testing.T) {
 		})
 	}
 }
+
+func TestAddVolume(t *testing.T) {
+	const volume = `path: /mnt/media
+efs:
+  id: mediaFileSystem
+`
+	testCases := map[string]struct {
+		inManifest string
+
+		wantedManifest string
+		wantedErr      error
+	}{
+		"appends storage section if it doesn't exist": {
+			inManifest: `name: api
+# The service's type.
+type: Backend Service
+`,
+			wantedManifest: `name: api
+# The service's type.
+type: Backend Service
+
+storage:
+  volumes:
+    media:
+      path: /mnt/media
+      efs:
+        id: mediaFileSystem
+`,
+		},
+		"adds volumes under existing storage section": {
+			inManifest: `name: api
+storage: # Persistent storage.
+    ephemeral: 20
+count: 1
+`,
+			wantedManifest: `name: api
+storage: # Persistent storage.
+    volumes:
+      media:
+        path: /mnt/media
+        efs:
+          id: mediaFileSystem
+    ephemeral: 20
+count: 1
+`,
+		},
+		"adds volumes under empty storage section": {
+			inManifest: `name: api
+storage:
+count: 1
+`,
+			wantedManifest: `name: api
+storage:
+  volumes:
+    media:
+      path: /mnt/media
+      efs:
+        id: mediaFileSystem
+count: 1
+`,
+		},
+		"adds volume to existing volumes": {
+			inManifest: `name: api
+storage:
+  volumes:
+    # A volume.
+    data:
+      path: /data
+      efs: true
+`,
+			wantedManifest: `name: api
+storage:
+  volumes:
+    media:
+      path: /mnt/media
+      efs:
+        id: mediaFileSystem
+    # A volume.
+    data:
+      path: /data
+      efs: true
+`,
+		},
+		"errors if the volume already exists": {
+			inManifest: `storage:
+  volumes:
+    media:
+      path: /media
+`,
+			wantedErr: errors.New("volume media already exists in the manifest"),
+		},
+		"errors if volumes is in flow style": {
+			inManifest: `storage:
+  volumes: {data: {path: /data}}
+`,
+			wantedErr: errors.New(`"storage.volumes" must be a block mapping to add a volume`),
+		},
+		"errors if the manifest is not a map": {
+			inManifest: `- api`,
+			wantedErr:  errors.New("manifest is not a YAML map"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			out, err := AddVolume([]byte(tc.inManifest), "media", volume)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedManifest, string(out))
+		})
+	}
+}
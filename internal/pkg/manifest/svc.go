@@ -27,6 +27,22 @@ var ServiceTypes = []string{
 	BackendServiceType,
 }
 
+// Strategies to deploy a new version of a service.
+const (
+	RollingDeploymentStrategy   = "rolling"
+	BlueGreenDeploymentStrategy = "blue_green"
+	CanaryDeploymentStrategy    = "canary"
+	LinearDeploymentStrategy    = "linear"
+)
+
+// DeploymentStrategies are the supported values of "deployment.strategy".
+var DeploymentStrategies = []string{
+	RollingDeploymentStrategy,
+	BlueGreenDeploymentStrategy,
+	CanaryDeploymentStrategy,
+	LinearDeploymentStrategy,
+}
+
 // Range contains either a Range or a range configuration for Autoscaling ranges
 type Range struct {
 	Value       *IntRangeBand // Mutually exclusive with RangeConfig
@@ -108,6 +124,15 @@ func (r *RangeConfig) IsEmpty() bool {
 	return r.Min == nil && r.Max == nil && r.SpotFrom == nil
 }
 
+// DeploymentConfig represents the deployment configuration of a service.
+type DeploymentConfig struct {
+	Strategy       *string        `yaml:"strategy"`        // How traffic shifts to the new tasks. Defaults to a rolling update.
+	StepPercent    *float64       `yaml:"step_percent"`    // Percentage of traffic shifted by the canary step, or by each linear step.
+	StepBakeTime   *time.Duration `yaml:"step_bake_time"`  // Time to wait after the canary step, or between linear steps.
+	BakeTime       *time.Duration `yaml:"bake_time"`       // Time that both versions keep running once all traffic is shifted.
+	RollbackAlarms []string       `yaml:"rollback_alarms"` // CloudWatch alarms that roll back the deployment when they fire.
+}
+
 // ServiceImageWithPort represents a container image with an exposed port.
 type ServiceImageWithPort struct {
 	Image `yaml:",inline"`
@@ -9,10 +9,12 @@ import (
 	"fmt"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/google/shlex"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"gopkg.in/yaml.v3"
 )
 
@@ -23,6 +25,10 @@ const (
 	// AWS VPC subnet placement options.
 	PublicSubnetPlacement  = "public"
 	PrivateSubnetPlacement = "private"
+
+	// Launch types of the tasks.
+	FargateLaunchType  = "FARGATE"
+	ExternalLaunchType = "EXTERNAL" // Tasks run on external instances registered to the cluster with ECS Anywhere.
 )
 
 var (
@@ -32,6 +38,9 @@ var (
 	// All placement options.
 	subnetPlacements = []string{PublicSubnetPlacement, PrivateSubnetPlacement}
 
+	// LaunchTypes holds the launch types that tasks can run on.
+	LaunchTypes = []string{FargateLaunchType, ExternalLaunchType}
+
 	// Error definitions.
 	errUnmarshalBuildOpts  = errors.New("cannot unmarshal build field into string or compose-style map")
 	errUnmarshalCountOpts  = errors.New(`cannot unmarshal "count" field to an integer or autoscaling configuration`)
@@ -40,6 +49,8 @@ var (
 	errUnmarshalExec       = errors.New("cannot unmarshal exec field into boolean or exec configuration")
 	errUnmarshalEntryPoint = errors.New("cannot unmarshal entrypoint into string or slice of strings")
 	errUnmarshalCommand    = errors.New("cannot unmarshal command into string or slice of strings")
+	errImageRetention      = errors.New(`"image.retention" is no longer supported: move "image.retention.keep_last" to "image.repository.lifecycle.keep_last"`)
+	errUnmarshalPlatform   = errors.New("cannot unmarshal platform into string or slice of strings")
 
 	errInvalidRangeOpts = errors.New(`cannot specify both "range" and "min"/"max"`)
 )
@@ -62,6 +73,31 @@ type Image struct {
 	Build        BuildArgsOrString `yaml:"build"`       // Build an image from a Dockerfile.
 	Location     *string           `yaml:"location"`    // Use an existing image instead.
 	DockerLabels map[string]string `yaml:"labels,flow"` // Apply Docker labels to the container at runtime.
+	LazyLoad     *bool             `yaml:"lazy_load"`   // Push a SOCI index with the built image so that tasks start before it's fully downloaded.
+	Retention    ImageRetention    `yaml:"retention"`   // Replaced by Repository.Lifecycle, always fails to unmarshal.
+	Repository   ImageRepository   `yaml:"repository"`  // Configure the workload's ECR repository.
+	DependsOn    map[string]string `yaml:"depends_on"`  // Conditions of the sidecars that must be met before the container starts.
+}
+
+// ImageRetention is the former field for the number of images kept in the workload's ECR repository.
+type ImageRetention struct{}
+
+// UnmarshalYAML returns an error so that manifests that still set "image.retention" don't silently
+// lose their lifecycle policy on the next deployment.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (r *ImageRetention) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return errImageRetention
+}
+
+// ImageRepository represents the configuration of the workload's ECR repository.
+type ImageRepository struct {
+	Lifecycle ImageLifecycle `yaml:"lifecycle"`
+}
+
+// ImageLifecycle represents the lifecycle policy that expires images pushed to the workload's ECR repository.
+type ImageLifecycle struct {
+	KeepLast                *int `yaml:"keep_last"`
+	ExpireUntaggedAfterDays *int `yaml:"expire_untagged_after_days"`
 }
 
 // GetLocation returns the location of the image.
@@ -69,6 +105,23 @@ func (i Image) GetLocation() string {
 	return aws.StringValue(i.Location)
 }
 
+// LazyLoadEnabled returns true if an index to lazily load the built image should be pushed with it.
+func (i Image) LazyLoadEnabled() bool {
+	return aws.BoolValue(i.LazyLoad)
+}
+
+// ImagesToKeep returns the number of most recently pushed images to keep in the repository.
+// It returns 0 if images never expire.
+func (i Image) ImagesToKeep() int {
+	return aws.IntValue(i.Repository.Lifecycle.KeepLast)
+}
+
+// UntaggedImagesExpiry returns the number of days after which untagged images expire from the repository.
+// It returns 0 if untagged images never expire.
+func (i Image) UntaggedImagesExpiry() int {
+	return aws.IntValue(i.Repository.Lifecycle.ExpireUntaggedAfterDays)
+}
+
 // BuildConfig populates a docker.BuildArguments struct from the fields available in the manifest.
 // Prefer the following hierarchy:
 // 1. Specific dockerfile, specific context
@@ -99,6 +152,8 @@ func (i *Image) BuildConfig(rootDirectory string) *DockerBuildArgs {
 		Args:       i.args(),
 		Target:     i.target(),
 		CacheFrom:  i.cacheFrom(),
+		CacheTo:    i.cacheTo(),
+		Platform:   i.Build.BuildArgs.Platform,
 	}
 }
 
@@ -141,6 +196,12 @@ func (i *Image) cacheFrom() []string {
 	return i.Build.BuildArgs.CacheFrom
 }
 
+// cacheTo returns the build cache export destinations, if they exist.
+// Otherwise it returns nil.
+func (i *Image) cacheTo() []string {
+	return i.Build.BuildArgs.CacheTo
+}
+
 // ImageOverride holds fields that override Dockerfile image defaults.
 type ImageOverride struct {
 	EntryPoint EntryPointOverride `yaml:"entrypoint"`
@@ -282,15 +343,50 @@ type DockerBuildArgs struct {
 	Args       map[string]string `yaml:"args,omitempty"`
 	Target     *string           `yaml:"target,omitempty"`
 	CacheFrom  []string          `yaml:"cache_from,omitempty"`
+	CacheTo    []string          `yaml:"cache_to,omitempty"`
+	Platform   BuildPlatform     `yaml:"platform,omitempty"`
 }
 
 func (b *DockerBuildArgs) isEmpty() bool {
-	if b.Context == nil && b.Dockerfile == nil && b.Args == nil && b.Target == nil && b.CacheFrom == nil {
+	if b.Context == nil && b.Dockerfile == nil && b.Args == nil && b.Target == nil && b.CacheFrom == nil &&
+		b.CacheTo == nil && b.Platform.String == nil && b.Platform.StringSlice == nil {
 		return true
 	}
 	return false
 }
 
+// BuildPlatform is a custom type which supports unmarshaling "platform" yaml which
+// can either be of type string or type slice of string.
+type BuildPlatform stringSliceOrString
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the BuildPlatform
+// struct, allowing it to perform more complex unmarshaling behavior.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (p *BuildPlatform) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if err := unmarshalYAMLToStringSliceOrString((*stringSliceOrString)(p), unmarshal); err != nil {
+		return errUnmarshalPlatform
+	}
+	return nil
+}
+
+// ToStringSlice converts a BuildPlatform to a slice of platforms.
+// Like the "--platform" flag of "docker buildx build", a string can hold multiple platforms separated by commas.
+func (p *BuildPlatform) ToStringSlice() []string {
+	if p.StringSlice != nil {
+		return p.StringSlice
+	}
+	if p.String == nil {
+		return nil
+	}
+	var platforms []string
+	for _, platform := range strings.Split(aws.StringValue(p.String), ",") {
+		if platform = strings.TrimSpace(platform); platform != "" {
+			platforms = append(platforms, platform)
+		}
+	}
+	return platforms
+}
+
 // ExecuteCommand is a custom type which supports unmarshaling yaml which
 // can either be of type bool or type ExecuteCommandConfig.
 type ExecuteCommand struct {
@@ -334,11 +430,41 @@ func (e ExecuteCommandConfig) IsEmpty() bool {
 
 // Logging holds configuration for Firelens to route your logs.
 type Logging struct {
-	Image          *string           `yaml:"image"`
-	Destination    map[string]string `yaml:"destination,flow"`
-	EnableMetadata *bool             `yaml:"enableMetadata"`
-	SecretOptions  map[string]string `yaml:"secretOptions"`
-	ConfigFile     *string           `yaml:"configFilePath"`
+	Image          *string             `yaml:"image"`
+	Destination    map[string]string   `yaml:"destination,flow"`
+	EnableMetadata *bool               `yaml:"enableMetadata"`
+	SecretOptions  map[string]string   `yaml:"secretOptions"`
+	ConfigFile     *string             `yaml:"configFilePath"`
+	MountPoints    []SidecarMountPoint `yaml:"mount_points"` // Volumes mounted in the log router, for example to read a custom config file.
+	RouterCPU      *int                `yaml:"cpu"`          // Named apart from the task's CPU, which the workloads embed too.
+	RouterMemory   *int                `yaml:"memory"`       // Hard limit of the log router's memory in MiB.
+
+	// Destinations that set the options of the log driver, the "destination" options take precedence.
+	Firehose   *FirehoseLogging   `yaml:"firehose"`
+	OpenSearch *OpenSearchLogging `yaml:"opensearch"`
+	Datadog    *DatadogLogging    `yaml:"datadog"`
+}
+
+// FirehoseLogging holds the configuration to route logs to a Kinesis Data Firehose delivery stream.
+type FirehoseLogging struct {
+	DeliveryStream *string `yaml:"delivery_stream"`
+	Region         *string `yaml:"region"`
+}
+
+// OpenSearchLogging holds the configuration to route logs to an Amazon OpenSearch Service domain.
+type OpenSearchLogging struct {
+	Endpoint *string `yaml:"endpoint"`
+	Index    *string `yaml:"index"`
+	Region   *string `yaml:"region"`
+}
+
+// DatadogLogging holds the configuration to route logs to Datadog.
+type DatadogLogging struct {
+	APIKey  *string  `yaml:"api_key"` // Name or ARN of the SSM parameter or secret holding the API key.
+	Site    *string  `yaml:"site"`
+	Service *string  `yaml:"service"`
+	Source  *string  `yaml:"source"`
+	Tags    []string `yaml:"tags"`
 }
 
 // LogImage returns the default Fluent Bit image if not otherwise configured.
@@ -360,14 +486,39 @@ func (lc *Logging) GetEnableMetadata() *string {
 
 // SidecarConfig represents the configurable options for setting up a sidecar container.
 type SidecarConfig struct {
-	Port         *string             `yaml:"port"`
-	Image        *string             `yaml:"image"`
-	Essential    *bool               `yaml:"essential"`
-	CredsParam   *string             `yaml:"credentialsParameter"`
-	Variables    map[string]string   `yaml:"variables"`
-	Secrets      map[string]string   `yaml:"secrets"`
-	MountPoints  []SidecarMountPoint `yaml:"mount_points"`
-	DockerLabels map[string]string   `yaml:"labels"`
+	Preset       *string               `yaml:"preset"`
+	Port         *string               `yaml:"port"`
+	Image        *string               `yaml:"image"`
+	Essential    *bool                 `yaml:"essential"`
+	CredsParam   *string               `yaml:"credentialsParameter"`
+	Variables    map[string]string     `yaml:"variables"`
+	Secrets      map[string]string     `yaml:"secrets"`
+	MountPoints  []SidecarMountPoint   `yaml:"mount_points"`
+	DockerLabels map[string]string     `yaml:"labels"`
+	HealthCheck  *ContainerHealthCheck `yaml:"healthcheck"`
+	DependsOn    map[string]string     `yaml:"depends_on"` // Conditions of the other containers that must be met before the sidecar starts.
+}
+
+// HealthCheckOpts converts the sidecar's healthcheck configuration into a format parsable by the templates pkg.
+// The fields that aren't set default to the ones of the main container's healthcheck.
+func (s *SidecarConfig) HealthCheckOpts() *ecs.HealthCheck {
+	if s.HealthCheck == nil {
+		return nil
+	}
+	hc := newDefaultContainerHealthCheck()
+	hc.apply(s.HealthCheck)
+	return hc.ecsHealthCheck()
+}
+
+// InitContainerConfig represents the configurable options for a container that runs to completion
+// before the main container starts.
+type InitContainerConfig struct {
+	Image         *string `yaml:"image"`
+	ImageOverride `yaml:",inline"`
+	CredsParam    *string             `yaml:"credentialsParameter"`
+	Variables     map[string]string   `yaml:"variables"`
+	Secrets       map[string]string   `yaml:"secrets"`
+	MountPoints   []SidecarMountPoint `yaml:"mount_points"`
 }
 
 // TaskConfig represents the resource boundaries and environment variables for the containers in the task.
@@ -377,6 +528,7 @@ type TaskConfig struct {
 	Count          Count             `yaml:"count"`
 	ExecuteCommand ExecuteCommand    `yaml:"exec"`
 	Variables      map[string]string `yaml:"variables"`
+	EnvFile        *string           `yaml:"env_file"`
 	Secrets        map[string]string `yaml:"secrets"`
 	Storage        *Storage          `yaml:"storage"`
 }
@@ -7,8 +7,10 @@ import (
 	"errors"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
 )
@@ -255,12 +257,70 @@ func TestBuildArgs_UnmarshalYAML(t *testing.T) {
 				BuildString: nil,
 			},
 		},
+		"Dockerfile with registry cache": {
+			inContent: []byte(`build:
+  dockerfile: path/to/Dockerfile
+  cache_from:
+    - type=registry,ref=123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/api:cache
+  cache_to:
+    - type=registry,ref=123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/api:cache,mode=max,image-manifest=true,oci-mediatypes=true`),
+			wantedStruct: BuildArgsOrString{
+				BuildArgs: DockerBuildArgs{
+					Dockerfile: aws.String("path/to/Dockerfile"),
+					CacheFrom: []string{
+						"type=registry,ref=123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/api:cache",
+					},
+					CacheTo: []string{
+						"type=registry,ref=123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/api:cache,mode=max,image-manifest=true,oci-mediatypes=true",
+					},
+				},
+			},
+		},
+		"Dockerfile with a single platform": {
+			inContent: []byte(`build:
+  dockerfile: path/to/Dockerfile
+  platform: linux/arm64`),
+			wantedStruct: BuildArgsOrString{
+				BuildArgs: DockerBuildArgs{
+					Dockerfile: aws.String("path/to/Dockerfile"),
+					Platform: BuildPlatform{
+						String: aws.String("linux/arm64"),
+					},
+				},
+			},
+		},
+		"Dockerfile with a list of platforms": {
+			inContent: []byte(`build:
+  dockerfile: path/to/Dockerfile
+  platform: [linux/amd64, linux/arm64]`),
+			wantedStruct: BuildArgsOrString{
+				BuildArgs: DockerBuildArgs{
+					Dockerfile: aws.String("path/to/Dockerfile"),
+					Platform: BuildPlatform{
+						StringSlice: []string{"linux/amd64", "linux/arm64"},
+					},
+				},
+			},
+		},
+		"Error if platform is unmarshalable": {
+			inContent: []byte(`build:
+  dockerfile: path/to/Dockerfile
+  platform:
+    os: linux`),
+			wantedError: errUnmarshalPlatform,
+		},
 		"Error if unmarshalable": {
 			inContent: []byte(`build:
   badfield: OH NOES
   otherbadfield: DOUBLE BAD`),
 			wantedError: errUnmarshalBuildOpts,
 		},
+		"Error if the former retention field is set": {
+			inContent: []byte(`build: path/to/Dockerfile
+retention:
+  keep_last: 20`),
+			wantedError: errImageRetention,
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -281,11 +341,43 @@ func TestBuildArgs_UnmarshalYAML(t *testing.T) {
 				require.Equal(t, tc.wantedStruct.BuildArgs.Args, b.Build.BuildArgs.Args)
 				require.Equal(t, tc.wantedStruct.BuildArgs.Target, b.Build.BuildArgs.Target)
 				require.Equal(t, tc.wantedStruct.BuildArgs.CacheFrom, b.Build.BuildArgs.CacheFrom)
+				require.Equal(t, tc.wantedStruct.BuildArgs.CacheTo, b.Build.BuildArgs.CacheTo)
+				require.Equal(t, tc.wantedStruct.BuildArgs.Platform, b.Build.BuildArgs.Platform)
 			}
 		})
 	}
 }
 
+func TestBuildPlatform_ToStringSlice(t *testing.T) {
+	testCases := map[string]struct {
+		in BuildPlatform
+
+		wanted []string
+	}{
+		"Both fields are empty": {
+			wanted: nil,
+		},
+		"Given a string": {
+			in: BuildPlatform{
+				String: aws.String("linux/amd64, linux/arm64"),
+			},
+			wanted: []string{"linux/amd64", "linux/arm64"},
+		},
+		"Given a string slice": {
+			in: BuildPlatform{
+				StringSlice: []string{"linux/arm64"},
+			},
+			wanted: []string{"linux/arm64"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.ToStringSlice())
+		})
+	}
+}
+
 func TestExec_UnmarshalYAML(t *testing.T) {
 	testCases := map[string]struct {
 		inContent []byte
@@ -433,6 +525,9 @@ func TestBuildConfig(t *testing.T) {
 						"foo/bar:latest",
 						"foo/bar/baz:1.2.3",
 					},
+					CacheTo: []string{
+						"type=inline",
+					},
 				},
 			},
 			wantedBuild: DockerBuildArgs{
@@ -443,6 +538,9 @@ func TestBuildConfig(t *testing.T) {
 					"foo/bar:latest",
 					"foo/bar/baz:1.2.3",
 				},
+				CacheTo: []string{
+					"type=inline",
+				},
 			},
 		},
 	}
@@ -458,6 +556,87 @@ func TestBuildConfig(t *testing.T) {
 	}
 }
 
+func TestImage_LazyLoadEnabled(t *testing.T) {
+	testCases := map[string]struct {
+		inLazyLoad *bool
+		wanted     bool
+	}{
+		"not specified": {
+			wanted: false,
+		},
+		"disabled": {
+			inLazyLoad: aws.Bool(false),
+			wanted:     false,
+		},
+		"enabled": {
+			inLazyLoad: aws.Bool(true),
+			wanted:     true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			i := Image{
+				LazyLoad: tc.inLazyLoad,
+			}
+			require.Equal(t, tc.wanted, i.LazyLoadEnabled())
+		})
+	}
+}
+
+func TestImage_ImagesToKeep(t *testing.T) {
+	testCases := map[string]struct {
+		inRepository ImageRepository
+		wanted       int
+	}{
+		"not specified": {
+			wanted: 0,
+		},
+		"keeps the last images of the lifecycle policy": {
+			inRepository: ImageRepository{
+				Lifecycle: ImageLifecycle{
+					KeepLast: aws.Int(10),
+				},
+			},
+			wanted: 10,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			i := Image{
+				Repository: tc.inRepository,
+			}
+			require.Equal(t, tc.wanted, i.ImagesToKeep())
+		})
+	}
+}
+
+func TestImage_UntaggedImagesExpiry(t *testing.T) {
+	testCases := map[string]struct {
+		inLifecycle ImageLifecycle
+		wanted      int
+	}{
+		"not specified": {
+			wanted: 0,
+		},
+		"expires untagged images": {
+			inLifecycle: ImageLifecycle{
+				ExpireUntaggedAfterDays: aws.Int(7),
+			},
+			wanted: 7,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			i := Image{
+				Repository: ImageRepository{
+					Lifecycle: tc.inLifecycle,
+				},
+			}
+			require.Equal(t, tc.wanted, i.UntaggedImagesExpiry())
+		})
+	}
+}
+
 func TestLogging_LogImage(t *testing.T) {
 	testCases := map[string]struct {
 		inputImage  *string
@@ -579,3 +758,35 @@ network:
 		})
 	}
 }
+
+func TestSidecarConfig_HealthCheckOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in *SidecarConfig
+
+		wanted *ecs.HealthCheck
+	}{
+		"no healthcheck": {
+			in: &SidecarConfig{},
+		},
+		"fills the fields that aren't set with the defaults": {
+			in: &SidecarConfig{
+				HealthCheck: &ContainerHealthCheck{
+					Command:  []string{"CMD-SHELL", "curl -f http://localhost:9901/ready || exit 1"},
+					Interval: durationp(30 * time.Second),
+				},
+			},
+			wanted: &ecs.HealthCheck{
+				Command:     aws.StringSlice([]string{"CMD-SHELL", "curl -f http://localhost:9901/ready || exit 1"}),
+				Interval:    aws.Int64(30),
+				Retries:     aws.Int64(2),
+				StartPeriod: aws.Int64(0),
+				Timeout:     aws.Int64(5),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.HealthCheckOpts())
+		})
+	}
+}
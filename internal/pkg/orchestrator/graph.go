@@ -0,0 +1,136 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package orchestrator provides functionality to run the stack operations of an application
+// in dependency order with as much parallelism as possible.
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
+)
+
+// Task is a stack operation to run as part of a Graph.
+type Task struct {
+	Description string                          // Label rendered while the task is tracked, e.g. "Deploy environment test".
+	Run         func(ctx context.Context) error // Operation to execute once all of the task's dependencies succeeded.
+	Renderer    progress.Renderer               // Optional. Rendered below the task's label while the task runs.
+}
+
+type node struct {
+	id         string
+	task       Task
+	deps       []string
+	dependents []string
+}
+
+// Graph is a directed acyclic graph of tasks where an edge means that a task depends on another.
+// A task can only depend on tasks that were added before it, so the graph can't contain cycles.
+type Graph struct {
+	app   string
+	nodes map[string]*node
+	order []string // IDs in the order they were added, which is a topological order.
+}
+
+// NewGraph returns an empty graph.
+func NewGraph() *Graph {
+	return &Graph{
+		nodes: make(map[string]*node),
+	}
+}
+
+// AppID returns the ID of the task that deploys the application.
+func AppID(app string) string {
+	return fmt.Sprintf("app/%s", app)
+}
+
+// EnvID returns the ID of the task that deploys an environment.
+func EnvID(env string) string {
+	return fmt.Sprintf("env/%s", env)
+}
+
+// AddonsID returns the ID of the task that deploys the addons of a workload in an environment.
+func AddonsID(env, wkld string) string {
+	return fmt.Sprintf("env/%s/addons/%s", env, wkld)
+}
+
+// WorkloadID returns the ID of the task that deploys a workload in an environment.
+func WorkloadID(env, wkld string) string {
+	return fmt.Sprintf("env/%s/workload/%s", env, wkld)
+}
+
+// AddApp adds the task that deploys the application.
+// Environments added afterwards depend on it.
+func (g *Graph) AddApp(app string, t Task) error {
+	if err := g.Add(AppID(app), t); err != nil {
+		return err
+	}
+	g.app = app
+	return nil
+}
+
+// AddEnv adds the task that deploys an environment, which depends on the application.
+func (g *Graph) AddEnv(env string, t Task) error {
+	var deps []string
+	if g.app != "" {
+		deps = append(deps, AppID(g.app))
+	}
+	return g.Add(EnvID(env), t, deps...)
+}
+
+// AddAddons adds the task that deploys the addons of a workload, which depends on the environment.
+func (g *Graph) AddAddons(env, wkld string, t Task) error {
+	return g.Add(AddonsID(env, wkld), t, EnvID(env))
+}
+
+// AddWorkload adds the task that deploys a workload. The task depends on the environment, the workload's addons
+// if they were added, and any other task in dependsOn, for example another workload that it calls.
+func (g *Graph) AddWorkload(env, wkld string, t Task, dependsOn ...string) error {
+	deps := []string{EnvID(env)}
+	if _, ok := g.nodes[AddonsID(env, wkld)]; ok {
+		deps = append(deps, AddonsID(env, wkld))
+	}
+	return g.Add(WorkloadID(env, wkld), t, append(deps, dependsOn...)...)
+}
+
+// Add adds a task identified by id that runs once all the tasks in dependsOn succeeded.
+// It returns an error if the id is already taken or if a dependency wasn't added yet.
+func (g *Graph) Add(id string, t Task, dependsOn ...string) error {
+	if _, ok := g.nodes[id]; ok {
+		return fmt.Errorf("task %s already exists", id)
+	}
+	var deps []string
+	seen := make(map[string]bool)
+	for _, dep := range dependsOn {
+		if _, ok := g.nodes[dep]; !ok {
+			return fmt.Errorf("task %s depends on unknown task %s", id, dep)
+		}
+		if seen[dep] {
+			continue
+		}
+		seen[dep] = true
+		deps = append(deps, dep)
+	}
+	n := &node{
+		id:   id,
+		task: t,
+		deps: deps,
+	}
+	for _, dep := range deps {
+		g.nodes[dep].dependents = append(g.nodes[dep].dependents, id)
+	}
+	g.nodes[id] = n
+	g.order = append(g.order, id)
+	return nil
+}
+
+// Dependencies returns the IDs of the tasks that the task identified by id depends on.
+func (g *Graph) Dependencies(id string) []string {
+	n, ok := g.nodes[id]
+	if !ok {
+		return nil
+	}
+	return n.deps
+}
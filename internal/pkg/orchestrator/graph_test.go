@@ -0,0 +1,88 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package orchestrator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraph_Add(t *testing.T) {
+	testCases := map[string]struct {
+		setupGraph func(g *Graph) error
+
+		wantedDeps map[string][]string
+		wantedErr  error
+	}{
+		"errors if the task already exists": {
+			setupGraph: func(g *Graph) error {
+				if err := g.AddEnv("test", Task{}); err != nil {
+					return err
+				}
+				return g.AddEnv("test", Task{})
+			},
+			wantedErr: errors.New("task env/test already exists"),
+		},
+		"errors if a dependency wasn't added yet": {
+			setupGraph: func(g *Graph) error {
+				if err := g.AddEnv("test", Task{}); err != nil {
+					return err
+				}
+				return g.AddWorkload("test", "frontend", Task{}, WorkloadID("test", "api"))
+			},
+			wantedErr: errors.New("task env/test/workload/frontend depends on unknown task env/test/workload/api"),
+		},
+		"links the app, environments, addons and workloads": {
+			setupGraph: func(g *Graph) error {
+				for _, add := range []func() error{
+					func() error { return g.AddApp("phonetool", Task{}) },
+					func() error { return g.AddEnv("test", Task{}) },
+					func() error { return g.AddEnv("prod", Task{}) },
+					func() error { return g.AddAddons("test", "api", Task{}) },
+					func() error { return g.AddWorkload("test", "api", Task{}) },
+					func() error {
+						return g.AddWorkload("test", "frontend", Task{}, WorkloadID("test", "api"), EnvID("test"))
+					},
+					func() error { return g.AddWorkload("prod", "api", Task{}) },
+				} {
+					if err := add(); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			wantedDeps: map[string][]string{
+				"app/phonetool":              nil,
+				"env/test":                   {"app/phonetool"},
+				"env/prod":                   {"app/phonetool"},
+				"env/test/addons/api":        {"env/test"},
+				"env/test/workload/api":      {"env/test", "env/test/addons/api"},
+				"env/test/workload/frontend": {"env/test", "env/test/workload/api"},
+				"env/prod/workload/api":      {"env/prod"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			g := NewGraph()
+
+			// WHEN
+			err := tc.setupGraph(g)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			for id, deps := range tc.wantedDeps {
+				require.Equal(t, deps, g.Dependencies(id), "dependencies of %s", id)
+			}
+		})
+	}
+}
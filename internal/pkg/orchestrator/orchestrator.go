@@ -0,0 +1,244 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const nestedPadding = 2 // Leading space characters for rendering the Renderer of a running task.
+
+type taskStatus string
+
+const (
+	statusNotStarted taskStatus = "not started"
+	statusInProgress taskStatus = "in progress"
+	statusComplete   taskStatus = "complete"
+	statusFailed     taskStatus = "failed"
+	statusSkipped    taskStatus = "skipped"
+)
+
+// TaskError is the error returned by a task of the graph.
+type TaskError struct {
+	ID  string
+	Err error
+}
+
+func (e *TaskError) Error() string {
+	return fmt.Sprintf("task %s: %v", e.ID, e.Err)
+}
+
+// Unwrap returns the error returned by the task.
+func (e *TaskError) Unwrap() error {
+	return e.Err
+}
+
+// ErrTasksFailed is returned when one or more tasks of the graph fail.
+type ErrTasksFailed struct {
+	Errors []*TaskError // Sorted in the order the tasks were added to the graph.
+}
+
+func (e *ErrTasksFailed) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Option configures an Orchestrator.
+type Option func(o *Orchestrator)
+
+// WithMaxParallel limits the number of tasks that run at the same time.
+// By default, every task whose dependencies succeeded runs right away.
+func WithMaxParallel(n int) Option {
+	return func(o *Orchestrator) {
+		o.maxParallel = n
+	}
+}
+
+// Orchestrator runs the tasks of a Graph with as much parallelism as their dependencies allow.
+// It's a progress.DynamicRenderer that renders the status of every task, and is done once Run returns.
+type Orchestrator struct {
+	graph       *Graph
+	maxParallel int
+
+	mu       sync.Mutex
+	statuses map[string]taskStatus
+	done     chan struct{}
+}
+
+// New returns an Orchestrator for the graph.
+func New(g *Graph, opts ...Option) *Orchestrator {
+	o := &Orchestrator{
+		graph:    g,
+		statuses: make(map[string]taskStatus),
+		done:     make(chan struct{}),
+	}
+	for _, id := range g.order {
+		o.statuses[id] = statusNotStarted
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+type taskResult struct {
+	id  string
+	err error
+}
+
+// Run executes every task once all of its dependencies succeeded.
+// If a task fails, the tasks that depend on it are skipped while the other ones keep running.
+// Once ctx is canceled, no new task starts.
+// It returns an *ErrTasksFailed if any task failed.
+func (o *Orchestrator) Run(ctx context.Context) error {
+	defer close(o.done)
+
+	pending := make(map[string]int) // Number of dependencies that haven't succeeded yet.
+	var ready []string
+	for _, id := range o.graph.order {
+		pending[id] = len(o.graph.nodes[id].deps)
+		if pending[id] == 0 {
+			ready = append(ready, id)
+		}
+	}
+
+	results := make(chan taskResult)
+	failed := make(map[string]error)
+	var running int
+	for {
+		for len(ready) > 0 && (o.maxParallel <= 0 || running < o.maxParallel) {
+			id := ready[0]
+			ready = ready[1:]
+			if ctx.Err() != nil {
+				o.skip(id)
+				continue
+			}
+			o.setStatus(id, statusInProgress)
+			running++
+			go func(n *node) {
+				results <- taskResult{
+					id:  n.id,
+					err: n.task.Run(ctx),
+				}
+			}(o.graph.nodes[id])
+		}
+		if running == 0 {
+			break
+		}
+
+		res := <-results
+		running--
+		if res.err != nil {
+			failed[res.id] = res.err
+			o.setStatus(res.id, statusFailed)
+			for _, dependent := range o.graph.nodes[res.id].dependents {
+				o.skip(dependent)
+			}
+			continue
+		}
+		o.setStatus(res.id, statusComplete)
+		for _, dependent := range o.graph.nodes[res.id].dependents {
+			pending[dependent]--
+			if pending[dependent] == 0 && o.status(dependent) == statusNotStarted {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	if len(failed) == 0 {
+		return nil
+	}
+	errs := &ErrTasksFailed{}
+	for _, id := range o.graph.order {
+		if err, ok := failed[id]; ok {
+			errs.Errors = append(errs.Errors, &TaskError{
+				ID:  id,
+				Err: err,
+			})
+		}
+	}
+	return errs
+}
+
+// Render writes the status of every task to out, in the order they were added to the graph.
+// The Renderer of the tasks in progress are rendered below their status.
+func (o *Orchestrator) Render(out io.Writer) (numLines int, err error) {
+	buf := new(bytes.Buffer)
+	for _, id := range o.graph.order {
+		n := o.graph.nodes[id]
+		status := o.status(id)
+		if _, err := fmt.Fprintf(buf, "- %s %s\n", n.task.Description, colorStatus(status)); err != nil {
+			return 0, err
+		}
+		numLines++
+		if status != statusInProgress || n.task.Renderer == nil {
+			continue
+		}
+		nested := new(bytes.Buffer)
+		nl, err := n.task.Renderer.Render(nested)
+		if err != nil {
+			return 0, fmt.Errorf("render task %s: %w", id, err)
+		}
+		for _, line := range strings.SplitAfter(nested.String(), "\n") {
+			if line == "" {
+				continue
+			}
+			buf.WriteString(strings.Repeat(" ", nestedPadding) + line)
+		}
+		numLines += nl
+	}
+	if _, err := buf.WriteTo(out); err != nil {
+		return 0, err
+	}
+	return numLines, nil
+}
+
+// Done returns a channel that's closed when Run returns.
+func (o *Orchestrator) Done() <-chan struct{} {
+	return o.done
+}
+
+// skip marks the task identified by id and all the tasks that depend on it as skipped.
+func (o *Orchestrator) skip(id string) {
+	if o.status(id) == statusSkipped {
+		return
+	}
+	o.setStatus(id, statusSkipped)
+	for _, dependent := range o.graph.nodes[id].dependents {
+		o.skip(dependent)
+	}
+}
+
+func (o *Orchestrator) status(id string) taskStatus {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.statuses[id]
+}
+
+func (o *Orchestrator) setStatus(id string, status taskStatus) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.statuses[id] = status
+}
+
+func colorStatus(status taskStatus) string {
+	switch status {
+	case statusComplete:
+		return color.Green.Sprintf("[%s]", status)
+	case statusFailed:
+		return color.Red.Sprintf("[%s]", status)
+	default:
+		return color.Faint.Sprintf("[%s]", status)
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// recorder keeps track of the order in which tasks run and how many of them run at the same time.
+type recorder struct {
+	mu         sync.Mutex
+	started    []string
+	running    int
+	maxRunning int
+}
+
+func (r *recorder) task(id string, err error) Task {
+	return Task{
+		Description: id,
+		Run: func(ctx context.Context) error {
+			r.mu.Lock()
+			r.started = append(r.started, id)
+			r.running++
+			if r.running > r.maxRunning {
+				r.maxRunning = r.running
+			}
+			r.mu.Unlock()
+
+			defer func() {
+				r.mu.Lock()
+				r.running--
+				r.mu.Unlock()
+			}()
+			return err
+		},
+	}
+}
+
+func (r *recorder) indexOf(id string) int {
+	for i, started := range r.started {
+		if started == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestOrchestrator_Run(t *testing.T) {
+	t.Run("runs every task after its dependencies", func(t *testing.T) {
+		// GIVEN
+		r := &recorder{}
+		g := NewGraph()
+		require.NoError(t, g.AddApp("phonetool", r.task("app", nil)))
+		require.NoError(t, g.AddEnv("test", r.task("test", nil)))
+		require.NoError(t, g.AddEnv("prod", r.task("prod", nil)))
+		require.NoError(t, g.AddAddons("test", "api", r.task("test-api-addons", nil)))
+		require.NoError(t, g.AddWorkload("test", "api", r.task("test-api", nil)))
+		require.NoError(t, g.AddWorkload("test", "frontend", r.task("test-frontend", nil), WorkloadID("test", "api")))
+		require.NoError(t, g.AddWorkload("prod", "api", r.task("prod-api", nil)))
+		o := New(g)
+
+		// WHEN
+		err := o.Run(context.Background())
+
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, r.started, 7)
+		for _, id := range g.order {
+			for _, dep := range g.Dependencies(id) {
+				require.Less(t, r.indexOf(g.nodes[dep].task.Description), r.indexOf(g.nodes[id].task.Description), "%s should start after %s", id, dep)
+			}
+		}
+		_, isOpen := <-o.Done()
+		require.False(t, isOpen, "expected Done to be closed once Run returns")
+	})
+	t.Run("runs independent tasks in parallel", func(t *testing.T) {
+		// GIVEN
+		var wg sync.WaitGroup
+		wg.Add(2)
+		allStarted := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(allStarted)
+		}()
+		waitForPeer := func(ctx context.Context) error {
+			wg.Done()
+			select {
+			case <-allStarted:
+				return nil
+			case <-time.After(time.Second):
+				return errors.New("timed out waiting for the other task to start")
+			}
+		}
+		g := NewGraph()
+		require.NoError(t, g.AddEnv("test", Task{Run: waitForPeer}))
+		require.NoError(t, g.AddEnv("prod", Task{Run: waitForPeer}))
+
+		// WHEN
+		err := New(g).Run(context.Background())
+
+		// THEN
+		require.NoError(t, err)
+	})
+	t.Run("limits the number of tasks running at the same time", func(t *testing.T) {
+		// GIVEN
+		r := &recorder{}
+		g := NewGraph()
+		for i := 0; i < 10; i++ {
+			require.NoError(t, g.Add(fmt.Sprintf("task-%d", i), r.task(fmt.Sprintf("task-%d", i), nil)))
+		}
+		o := New(g, WithMaxParallel(1))
+
+		// WHEN
+		err := o.Run(context.Background())
+
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, r.started, 10)
+		require.Equal(t, 1, r.maxRunning)
+	})
+	t.Run("skips the dependents of a failed task and keeps running the other ones", func(t *testing.T) {
+		// GIVEN
+		r := &recorder{}
+		g := NewGraph()
+		require.NoError(t, g.AddEnv("test", r.task("test", nil)))
+		require.NoError(t, g.AddWorkload("test", "api", r.task("api", errors.New("some error"))))
+		require.NoError(t, g.AddWorkload("test", "frontend", r.task("frontend", nil), WorkloadID("test", "api")))
+		require.NoError(t, g.AddWorkload("test", "worker", r.task("worker", nil)))
+		o := New(g)
+
+		// WHEN
+		err := o.Run(context.Background())
+
+		// THEN
+		var errs *ErrTasksFailed
+		require.True(t, errors.As(err, &errs))
+		require.EqualError(t, err, "task env/test/workload/api: some error")
+		require.ElementsMatch(t, []string{"test", "api", "worker"}, r.started)
+		require.Equal(t, statusSkipped, o.statuses[WorkloadID("test", "frontend")])
+		require.Equal(t, statusFailed, o.statuses[WorkloadID("test", "api")])
+		require.Equal(t, statusComplete, o.statuses[WorkloadID("test", "worker")])
+	})
+	t.Run("does not start new tasks once the context is canceled", func(t *testing.T) {
+		// GIVEN
+		ctx, cancel := context.WithCancel(context.Background())
+		g := NewGraph()
+		require.NoError(t, g.AddEnv("test", Task{
+			Run: func(ctx context.Context) error {
+				cancel()
+				return nil
+			},
+		}))
+		require.NoError(t, g.AddWorkload("test", "api", Task{
+			Run: func(ctx context.Context) error {
+				return errors.New("should not run")
+			},
+		}))
+		o := New(g)
+
+		// WHEN
+		err := o.Run(ctx)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, statusSkipped, o.statuses[WorkloadID("test", "api")])
+	})
+}
+
+type mockRenderer struct {
+	content string
+}
+
+func (m *mockRenderer) Render(out io.Writer) (int, error) {
+	if _, err := out.Write([]byte(m.content)); err != nil {
+		return 0, err
+	}
+	return strings.Count(m.content, "\n"), nil
+}
+
+func TestOrchestrator_Render(t *testing.T) {
+	// GIVEN
+	g := NewGraph()
+	require.NoError(t, g.AddEnv("test", Task{
+		Description: "Deploy environment test",
+	}))
+	require.NoError(t, g.AddWorkload("test", "api", Task{
+		Description: "Deploy service api",
+		Renderer: &mockRenderer{
+			content: "- Updating the service\n- Updating the target group\n",
+		},
+	}))
+	require.NoError(t, g.AddWorkload("test", "frontend", Task{
+		Description: "Deploy service frontend",
+		Renderer: &mockRenderer{
+			content: "- Should not be rendered\n",
+		},
+	}))
+	o := New(g)
+	o.statuses[EnvID("test")] = statusComplete
+	o.statuses[WorkloadID("test", "api")] = statusInProgress
+	buf := new(strings.Builder)
+
+	// WHEN
+	nl, err := o.Render(buf)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, 5, nl)
+	require.Equal(t, `- Deploy environment test [complete]
+- Deploy service api [in progress]
+  - Updating the service
+  - Updating the target group
+- Deploy service frontend [not started]
+`, buf.String())
+}
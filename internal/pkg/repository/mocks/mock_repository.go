@@ -7,6 +7,7 @@ package mocks
 import (
 	reflect "reflect"
 
+	ecr "github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	exec "github.com/aws/copilot-cli/internal/pkg/exec"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -62,6 +63,21 @@ func (mr *MockContainerLoginBuildPusherMockRecorder) Login(uri, username, passwo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).Login), uri, username, password)
 }
 
+// ManifestDigest mocks base method.
+func (m *MockContainerLoginBuildPusher) ManifestDigest(uri string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ManifestDigest", uri)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ManifestDigest indicates an expected call of ManifestDigest.
+func (mr *MockContainerLoginBuildPusherMockRecorder) ManifestDigest(uri interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManifestDigest", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).ManifestDigest), uri)
+}
+
 // Push mocks base method.
 func (m *MockContainerLoginBuildPusher) Push(uri string, tags ...string) (string, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +98,43 @@ func (mr *MockContainerLoginBuildPusherMockRecorder) Push(uri interface{}, tags
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).Push), varargs...)
 }
 
+// MockLazyLoadIndexPusher is a mock of LazyLoadIndexPusher interface.
+type MockLazyLoadIndexPusher struct {
+	ctrl     *gomock.Controller
+	recorder *MockLazyLoadIndexPusherMockRecorder
+}
+
+// MockLazyLoadIndexPusherMockRecorder is the mock recorder for MockLazyLoadIndexPusher.
+type MockLazyLoadIndexPusherMockRecorder struct {
+	mock *MockLazyLoadIndexPusher
+}
+
+// NewMockLazyLoadIndexPusher creates a new mock instance.
+func NewMockLazyLoadIndexPusher(ctrl *gomock.Controller) *MockLazyLoadIndexPusher {
+	mock := &MockLazyLoadIndexPusher{ctrl: ctrl}
+	mock.recorder = &MockLazyLoadIndexPusherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLazyLoadIndexPusher) EXPECT() *MockLazyLoadIndexPusherMockRecorder {
+	return m.recorder
+}
+
+// CreateAndPush mocks base method.
+func (m *MockLazyLoadIndexPusher) CreateAndPush(image, username, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAndPush", image, username, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAndPush indicates an expected call of CreateAndPush.
+func (mr *MockLazyLoadIndexPusherMockRecorder) CreateAndPush(image, username, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndPush", reflect.TypeOf((*MockLazyLoadIndexPusher)(nil).CreateAndPush), image, username, password)
+}
+
 // MockRegistry is a mock of Registry interface.
 type MockRegistry struct {
 	ctrl     *gomock.Controller
@@ -121,6 +174,35 @@ func (mr *MockRegistryMockRecorder) Auth() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*MockRegistry)(nil).Auth))
 }
 
+// DeleteLifecyclePolicy mocks base method.
+func (m *MockRegistry) DeleteLifecyclePolicy(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLifecyclePolicy", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLifecyclePolicy indicates an expected call of DeleteLifecyclePolicy.
+func (mr *MockRegistryMockRecorder) DeleteLifecyclePolicy(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLifecyclePolicy", reflect.TypeOf((*MockRegistry)(nil).DeleteLifecyclePolicy), name)
+}
+
+// LastPushedDigest mocks base method.
+func (m *MockRegistry) LastPushedDigest(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastPushedDigest", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastPushedDigest indicates an expected call of LastPushedDigest.
+func (mr *MockRegistryMockRecorder) LastPushedDigest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastPushedDigest", reflect.TypeOf((*MockRegistry)(nil).LastPushedDigest), name)
+}
+
 // RepositoryURI mocks base method.
 func (m *MockRegistry) RepositoryURI(name string) (string, error) {
 	m.ctrl.T.Helper()
@@ -135,3 +217,17 @@ func (mr *MockRegistryMockRecorder) RepositoryURI(name interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepositoryURI", reflect.TypeOf((*MockRegistry)(nil).RepositoryURI), name)
 }
+
+// SetLifecyclePolicy mocks base method.
+func (m *MockRegistry) SetLifecyclePolicy(name string, policy ecr.LifecyclePolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLifecyclePolicy", name, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLifecyclePolicy indicates an expected call of SetLifecyclePolicy.
+func (mr *MockRegistryMockRecorder) SetLifecyclePolicy(name, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLifecyclePolicy", reflect.TypeOf((*MockRegistry)(nil).SetLifecyclePolicy), name, policy)
+}
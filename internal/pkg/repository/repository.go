@@ -6,7 +6,9 @@ package repository
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 )
 
@@ -15,12 +17,22 @@ type ContainerLoginBuildPusher interface {
 	Build(args *exec.BuildArguments) error
 	Login(uri, username, password string) error
 	Push(uri string, tags ...string) (digest string, err error)
+	ManifestDigest(uri string) (digest string, err error)
+}
+
+// LazyLoadIndexPusher provides support for creating and pushing indexes that let containers start
+// before their image is fully downloaded.
+type LazyLoadIndexPusher interface {
+	CreateAndPush(image, username, password string) error
 }
 
 // Registry gets information of repositories.
 type Registry interface {
 	RepositoryURI(name string) (string, error)
 	Auth() (string, string, error)
+	SetLifecyclePolicy(name string, policy ecr.LifecyclePolicy) error
+	DeleteLifecyclePolicy(name string) error
+	LastPushedDigest(name string) (string, error)
 }
 
 // Repository builds and pushes images to a repository.
@@ -50,22 +62,92 @@ func (r *Repository) BuildAndPush(docker ContainerLoginBuildPusher, args *exec.B
 	if args.URI == "" {
 		args.URI = r.uri
 	}
+	if len(args.Platforms) > 0 {
+		return r.buildAndPushMultiPlatform(docker, args)
+	}
+	// The build cache can be exported to the repository while the image is built, so log in first.
+	loginFirst := len(args.CacheTo) > 0
+	if loginFirst {
+		if err := r.login(docker, args.URI); err != nil {
+			return "", err
+		}
+	}
 	if err := docker.Build(args); err != nil {
 		return "", fmt.Errorf("build Dockerfile at %s: %w", args.Dockerfile, err)
 	}
+	if !loginFirst {
+		if err := r.login(docker, args.URI); err != nil {
+			return "", err
+		}
+	}
+
+	digest, err = docker.Push(args.URI, args.Tags...)
+	if err != nil {
+		return "", fmt.Errorf("push to repo %s: %w", r.name, err)
+	}
+	return digest, nil
+}
+
+// buildAndPushMultiPlatform logs in to the repository first since multi-platform images are pushed while they're built.
+func (r *Repository) buildAndPushMultiPlatform(docker ContainerLoginBuildPusher, args *exec.BuildArguments) (digest string, err error) {
+	if err := r.login(docker, args.URI); err != nil {
+		return "", err
+	}
+	if err := docker.Build(args); err != nil {
+		return "", fmt.Errorf("build and push Dockerfile at %s for platforms %s: %w", args.Dockerfile, strings.Join(args.Platforms, ", "), err)
+	}
+	digest, err = docker.ManifestDigest(args.URI)
+	if err != nil {
+		return "", fmt.Errorf("get manifest digest from repo %s: %w", r.name, err)
+	}
+	return digest, nil
+}
 
+func (r *Repository) login(docker ContainerLoginBuildPusher, uri string) error {
 	username, password, err := r.registry.Auth()
 	if err != nil {
-		return "", fmt.Errorf("get auth: %w", err)
+		return fmt.Errorf("get auth: %w", err)
 	}
+	if err := docker.Login(uri, username, password); err != nil {
+		return fmt.Errorf("login to repo %s: %w", r.name, err)
+	}
+	return nil
+}
 
-	if err := docker.Login(args.URI, username, password); err != nil {
-		return "", fmt.Errorf("login to repo %s: %w", r.name, err)
+// PushLazyLoadIndex creates an index for the image with the digest and pushes it to the repository.
+func (r *Repository) PushLazyLoadIndex(soci LazyLoadIndexPusher, digest string) error {
+	username, password, err := r.registry.Auth()
+	if err != nil {
+		return fmt.Errorf("get auth: %w", err)
 	}
+	image := fmt.Sprintf("%s@%s", r.uri, digest)
+	if err := soci.CreateAndPush(image, username, password); err != nil {
+		return fmt.Errorf("push lazy loading index to repo %s: %w", r.name, err)
+	}
+	return nil
+}
 
-	digest, err = docker.Push(args.URI, args.Tags...)
+// SetLifecyclePolicy expires the images of the repository according to the policy.
+func (r *Repository) SetLifecyclePolicy(policy ecr.LifecyclePolicy) error {
+	if err := r.registry.SetLifecyclePolicy(r.name, policy); err != nil {
+		return fmt.Errorf("set lifecycle policy of repo %s: %w", r.name, err)
+	}
+	return nil
+}
+
+// DeleteLifecyclePolicy removes the lifecycle policy of the repository so that its images never expire.
+func (r *Repository) DeleteLifecyclePolicy() error {
+	if err := r.registry.DeleteLifecyclePolicy(r.name); err != nil {
+		return fmt.Errorf("delete lifecycle policy of repo %s: %w", r.name, err)
+	}
+	return nil
+}
+
+// LastPushedDigest returns the digest of the tagged image most recently pushed to the repository.
+func (r *Repository) LastPushedDigest() (string, error) {
+	digest, err := r.registry.LastPushedDigest(r.name)
 	if err != nil {
-		return "", fmt.Errorf("push to repo %s: %w", r.name, err)
+		return "", fmt.Errorf("get last pushed image of repo %s: %w", r.name, err)
 	}
 	return digest, nil
 }
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/repository/mocks"
 	"github.com/golang/mock/gomock"
@@ -32,6 +33,8 @@ func TestRepository_BuildAndPush(t *testing.T) {
 	testCases := map[string]struct {
 		inRepoName       string
 		inDockerfilePath string
+		inPlatforms      []string
+		inCacheTo        []string
 		inMockDocker     func(m *mocks.MockContainerLoginBuildPusher)
 
 		mockRegistry func(m *mocks.MockRegistry)
@@ -94,6 +97,75 @@ func TestRepository_BuildAndPush(t *testing.T) {
 			},
 			wantedDigest: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
 		},
+		"logs in before building an image that exports its cache": {
+			inCacheTo: []string{"type=registry,ref=mockURI:cache"},
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("my-name", "my-pwd", nil).Times(1)
+			},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				gomock.InOrder(
+					m.EXPECT().Login(mockRepoURI, "my-name", "my-pwd").Return(nil),
+					m.EXPECT().Build(&exec.BuildArguments{
+						URI:        mockRepoURI,
+						Dockerfile: inDockerfilePath,
+						Context:    filepath.Dir(inDockerfilePath),
+						Tags:       []string{mockTag1, mockTag2, mockTag3},
+						CacheTo:    []string{"type=registry,ref=mockURI:cache"},
+					}).Return(nil),
+					m.EXPECT().Push(mockRepoURI, mockTag1, mockTag2, mockTag3).Return("sha256:1234", nil),
+				)
+			},
+			wantedDigest: "sha256:1234",
+		},
+		"failed to build and push multi-platform image": {
+			inPlatforms: []string{"linux/amd64", "linux/arm64"},
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("my-name", "my-pwd", nil)
+			},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				gomock.InOrder(
+					m.EXPECT().Login(mockRepoURI, "my-name", "my-pwd").Return(nil),
+					m.EXPECT().Build(gomock.Any()).Return(errors.New("error building image")),
+				)
+				m.EXPECT().ManifestDigest(gomock.Any()).Times(0)
+			},
+			wantedError: fmt.Errorf("build and push Dockerfile at %s for platforms linux/amd64, linux/arm64: error building image", inDockerfilePath),
+		},
+		"failed to get the digest of the multi-platform image": {
+			inPlatforms: []string{"linux/arm64"},
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("my-name", "my-pwd", nil)
+			},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				gomock.InOrder(
+					m.EXPECT().Login(mockRepoURI, "my-name", "my-pwd").Return(nil),
+					m.EXPECT().Build(gomock.Any()).Return(nil),
+					m.EXPECT().ManifestDigest(mockRepoURI).Return("", errors.New("some error")),
+				)
+			},
+			wantedError: errors.New("get manifest digest from repo my-repo: some error"),
+		},
+		"success with multi-platform image": {
+			inPlatforms: []string{"linux/amd64", "linux/arm64"},
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("my-name", "my-pwd", nil)
+			},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				gomock.InOrder(
+					m.EXPECT().Login(mockRepoURI, "my-name", "my-pwd").Return(nil),
+					m.EXPECT().Build(&exec.BuildArguments{
+						URI:        mockRepoURI,
+						Dockerfile: inDockerfilePath,
+						Context:    filepath.Dir(inDockerfilePath),
+						Tags:       []string{mockTag1, mockTag2, mockTag3},
+						Platforms:  []string{"linux/amd64", "linux/arm64"},
+					}).Return(nil),
+					m.EXPECT().ManifestDigest(mockRepoURI).Return("sha256:1234", nil),
+				)
+				m.EXPECT().Push(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedDigest: "sha256:1234",
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -121,6 +193,8 @@ func TestRepository_BuildAndPush(t *testing.T) {
 				Dockerfile: inDockerfilePath,
 				Context:    filepath.Dir(inDockerfilePath),
 				Tags:       []string{mockTag1, mockTag2, mockTag3},
+				Platforms:  tc.inPlatforms,
+				CacheTo:    tc.inCacheTo,
 			})
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
@@ -131,3 +205,200 @@ func TestRepository_BuildAndPush(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_PushLazyLoadIndex(t *testing.T) {
+	testCases := map[string]struct {
+		mockRegistry func(m *mocks.MockRegistry)
+		mockSOCI     func(m *mocks.MockLazyLoadIndexPusher)
+
+		wantedError error
+	}{
+		"failed to get auth": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("", "", errors.New("error getting auth"))
+			},
+			mockSOCI: func(m *mocks.MockLazyLoadIndexPusher) {
+				m.EXPECT().CreateAndPush(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("get auth: error getting auth"),
+		},
+		"failed to push index": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("AWS", "pwd", nil)
+			},
+			mockSOCI: func(m *mocks.MockLazyLoadIndexPusher) {
+				m.EXPECT().CreateAndPush("mockURI@sha256:1234", "AWS", "pwd").Return(errors.New("some error"))
+			},
+			wantedError: errors.New("push lazy loading index to repo my-repo: some error"),
+		},
+		"success": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("AWS", "pwd", nil)
+			},
+			mockSOCI: func(m *mocks.MockLazyLoadIndexPusher) {
+				m.EXPECT().CreateAndPush("mockURI@sha256:1234", "AWS", "pwd").Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRegistry := mocks.NewMockRegistry(ctrl)
+			mockSOCI := mocks.NewMockLazyLoadIndexPusher(ctrl)
+			tc.mockRegistry(mockRegistry)
+			tc.mockSOCI(mockSOCI)
+			repo := &Repository{
+				name:     "my-repo",
+				registry: mockRegistry,
+				uri:      "mockURI",
+			}
+
+			// WHEN
+			err := repo.PushLazyLoadIndex(mockSOCI, "sha256:1234")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRepository_SetLifecyclePolicy(t *testing.T) {
+	testCases := map[string]struct {
+		mockRegistry func(m *mocks.MockRegistry)
+
+		wantedError error
+	}{
+		"failed to set the lifecycle policy": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().SetLifecyclePolicy("my-repo", ecr.LifecyclePolicy{KeepLast: 20}).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("set lifecycle policy of repo my-repo: some error"),
+		},
+		"success": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().SetLifecyclePolicy("my-repo", ecr.LifecyclePolicy{KeepLast: 20}).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRegistry := mocks.NewMockRegistry(ctrl)
+			tc.mockRegistry(mockRegistry)
+			repo := &Repository{
+				name:     "my-repo",
+				registry: mockRegistry,
+				uri:      "mockURI",
+			}
+
+			// WHEN
+			err := repo.SetLifecyclePolicy(ecr.LifecyclePolicy{KeepLast: 20})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRepository_DeleteLifecyclePolicy(t *testing.T) {
+	testCases := map[string]struct {
+		mockRegistry func(m *mocks.MockRegistry)
+
+		wantedError error
+	}{
+		"failed to delete the lifecycle policy": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().DeleteLifecyclePolicy("my-repo").Return(errors.New("some error"))
+			},
+			wantedError: errors.New("delete lifecycle policy of repo my-repo: some error"),
+		},
+		"success": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().DeleteLifecyclePolicy("my-repo").Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRegistry := mocks.NewMockRegistry(ctrl)
+			tc.mockRegistry(mockRegistry)
+			repo := &Repository{
+				name:     "my-repo",
+				registry: mockRegistry,
+				uri:      "mockURI",
+			}
+
+			// WHEN
+			err := repo.DeleteLifecyclePolicy()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRepository_LastPushedDigest(t *testing.T) {
+	testCases := map[string]struct {
+		mockRegistry func(m *mocks.MockRegistry)
+
+		wantedDigest string
+		wantedError  error
+	}{
+		"failed to get the last pushed image": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().LastPushedDigest("my-repo").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("get last pushed image of repo my-repo: some error"),
+		},
+		"success": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().LastPushedDigest("my-repo").Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRegistry := mocks.NewMockRegistry(ctrl)
+			tc.mockRegistry(mockRegistry)
+			repo := &Repository{
+				name:     "my-repo",
+				registry: mockRegistry,
+				uri:      "mockURI",
+			}
+
+			// WHEN
+			digest, err := repo.LastPushedDigest()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDigest, digest)
+			}
+		})
+	}
+}
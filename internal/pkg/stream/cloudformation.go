@@ -6,6 +6,7 @@ package stream
 import (
 	"fmt"
 	"math/rand"
+	"os"
 	"sync"
 	"time"
 
@@ -46,6 +47,18 @@ func (c fakeClock) now() time.Time {
 	return c.fakeNow
 }
 
+const (
+	stackStreamerMinFetchInterval = time.Second
+	stackStreamerMaxFetchInterval = 16 * time.Second
+
+	// describeStackEventsGap is the minimum time between two DescribeStackEvents calls across all stack streamers.
+	describeStackEventsGap = 250 * time.Millisecond
+)
+
+// describeStackEventsLimiter is shared by default by all the stack streamers of the process,
+// so that streaming several stacks at once doesn't get throttled.
+var describeStackEventsLimiter = NewFetchLimiter(describeStackEventsGap)
+
 // StackStreamer is a Streamer for StackEvent events started by a change set.
 type StackStreamer struct {
 	client                StackEventsDescriber
@@ -60,12 +73,90 @@ type StackStreamer struct {
 	eventsToFlush []StackEvent
 	mu            sync.Mutex
 
-	retries int
+	interval    PollInterval
+	limiter     *FetchLimiter
+	retries     int
+	idleFetches int // Number of consecutive Fetch calls that didn't find new events.
+}
+
+// StackStreamerOption configures a StackStreamer.
+type StackStreamerOption func(s *StackStreamer)
+
+// WithPollInterval sets the bounds of the interval between two Fetch calls.
+// The streamer polls every interval.Min while new events keep coming in, and backs off up to interval.Max otherwise.
+func WithPollInterval(interval PollInterval) StackStreamerOption {
+	return func(s *StackStreamer) {
+		s.interval = interval
+	}
+}
+
+// WithFetchLimiter sets the limiter that spaces out the Fetch calls of the streamer with the ones of other streamers.
+func WithFetchLimiter(limiter *FetchLimiter) StackStreamerOption {
+	return func(s *StackStreamer) {
+		s.limiter = limiter
+	}
+}
+
+// Environment variables that tune how stack events are polled.
+// Their values are durations such as "500ms" or "30s".
+const (
+	StackPollMinIntervalEnvVar = "COPILOT_STACK_POLL_MIN_INTERVAL" // Interval between two Fetch calls while new events keep coming in.
+	StackPollMaxIntervalEnvVar = "COPILOT_STACK_POLL_MAX_INTERVAL" // Interval the streamer backs off to when no new events are found.
+	StackPollGapEnvVar         = "COPILOT_STACK_POLL_GAP"          // Minimum time between two Fetch calls across all stack streamers.
+)
+
+var (
+	lookupEnv = os.LookupEnv
+
+	envLimiterOnce sync.Once
+	envLimiter     *FetchLimiter
+)
+
+// StackStreamerOptionsFromEnv returns the options set by the COPILOT_STACK_POLL_* environment variables.
+// Variables that are not set, or that hold an invalid duration, keep the default value.
+func StackStreamerOptionsFromEnv() []StackStreamerOption {
+	var opts []StackStreamerOption
+	interval := PollInterval{
+		Min: stackStreamerMinFetchInterval,
+		Max: stackStreamerMaxFetchInterval,
+	}
+	minInterval, hasMin := durationFromEnv(StackPollMinIntervalEnvVar)
+	if hasMin {
+		interval.Min = minInterval
+	}
+	maxInterval, hasMax := durationFromEnv(StackPollMaxIntervalEnvVar)
+	if hasMax {
+		interval.Max = maxInterval
+	}
+	if (hasMin || hasMax) && interval.Min <= interval.Max {
+		opts = append(opts, WithPollInterval(interval))
+	}
+	if gap, ok := durationFromEnv(StackPollGapEnvVar); ok {
+		// The limiter is created once so that all the streamers of the process still share it.
+		envLimiterOnce.Do(func() {
+			envLimiter = NewFetchLimiter(gap)
+		})
+		opts = append(opts, WithFetchLimiter(envLimiter))
+	}
+	return opts
+}
+
+func durationFromEnv(key string) (time.Duration, bool) {
+	value, ok := lookupEnv(key)
+	if !ok {
+		return 0, false
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, false
+	}
+	return d, true
 }
 
 // NewStackStreamer creates a StackStreamer from a cloudformation client, stack name, and the change set creation timestamp.
-func NewStackStreamer(cfn StackEventsDescriber, stackName string, csCreationTime time.Time) *StackStreamer {
-	return &StackStreamer{
+// By default, all stack streamers share the same fetch limiter.
+func NewStackStreamer(cfn StackEventsDescriber, stackName string, csCreationTime time.Time, opts ...StackStreamerOption) *StackStreamer {
+	s := &StackStreamer{
 		clock:                 realClock{},
 		rand:                  rand.Intn,
 		client:                cfn,
@@ -73,7 +164,16 @@ func NewStackStreamer(cfn StackEventsDescriber, stackName string, csCreationTime
 		changeSetCreationTime: csCreationTime,
 		pastEventIDs:          make(map[string]bool),
 		done:                  make(chan struct{}),
+		interval: PollInterval{
+			Min: stackStreamerMinFetchInterval,
+			Max: stackStreamerMaxFetchInterval,
+		},
+		limiter: describeStackEventsLimiter,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 // Subscribe returns a read-only channel that will receive stack events from the StackStreamer.
@@ -104,7 +204,7 @@ func (s *StackStreamer) Fetch() (next time.Time, err error) {
 			// Check for throttles and wait to try again using the StackStreamer's interval.
 			if request.IsErrorThrottle(err) {
 				s.retries += 1
-				return nextFetchDate(s.clock, s.rand, s.retries), nil
+				return s.nextFetchDate(), nil
 			}
 			return next, fmt.Errorf("describe stack events %s: %w", s.stackName, err)
 		}
@@ -146,8 +246,13 @@ func (s *StackStreamer) Fetch() (next time.Time, err error) {
 	// Store events to flush in chronological order.
 	reverse(events)
 	s.eventsToFlush = append(s.eventsToFlush, events...)
-	// Only use exponential backoff if there's an error.
-	return nextFetchDate(s.clock, s.rand, 0), nil
+	// Poll quickly while the stack is making progress, and back off while it's idle.
+	if len(events) == 0 {
+		s.idleFetches += 1
+	} else {
+		s.idleFetches = 0
+	}
+	return s.nextFetchDate(), nil
 }
 
 // Notify flushes all new events to the streamer's subscribers.
@@ -182,6 +287,16 @@ func (s *StackStreamer) Done() <-chan struct{} {
 	return s.done
 }
 
+// nextFetchDate returns the time of the next Fetch call, backing off for every throttle and idle fetch
+// and waiting for the next slot available in the limiter.
+func (s *StackStreamer) nextFetchDate() time.Time {
+	interval := s.interval
+	if interval == (PollInterval{}) {
+		interval = defaultPollInterval
+	}
+	return s.limiter.reserve(interval.nextFetchDate(s.clock, s.rand, s.retries+s.idleFetches))
+}
+
 // Taken from https://github.com/golang/go/wiki/SliceTricks#reversing
 func reverse(arr []StackEvent) {
 	for i := len(arr)/2 - 1; i >= 0; i-- {
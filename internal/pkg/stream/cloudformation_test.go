@@ -5,6 +5,8 @@ package stream
 
 import (
 	"errors"
+	"os"
+	"sync"
 	"testing"
 	"time"
 
@@ -41,6 +43,7 @@ func TestStackStreamer_Fetch(t *testing.T) {
 	t.Run("stores only events that have not been seen yet", testStackStreamer_Fetch_WithSeenEvents)
 	t.Run("returns wrapped error if describe call fails", testStackStreamer_Fetch_WithError)
 	t.Run("throttle results in a gracefully handled error and exponential backoff", testStackStreamer_Fetch_withThrottle)
+	t.Run("backs off while there are no new events and resets once new events arrive", testStackStreamer_Fetch_AdaptiveInterval)
 }
 
 func TestStackStreamer_Notify(t *testing.T) {
@@ -272,6 +275,137 @@ func testStackStreamer_Fetch_withThrottle(t *testing.T) {
 	require.Equal(t, 1, streamer.retries)
 }
 
+func testStackStreamer_Fetch_AdaptiveInterval(t *testing.T) {
+	// GIVEN
+	now := time.Date(2020, time.November, 23, 16, 0, 0, 0, time.UTC)
+	idle := mockCloudFormation{
+		out: &cloudformation.DescribeStackEventsOutput{},
+	}
+	streamer := &StackStreamer{
+		client:       idle,
+		clock:        fakeClock{fakeNow: now},
+		rand:         func(n int) int { return n },
+		stackName:    "phonetool-test",
+		pastEventIDs: make(map[string]bool),
+		interval: PollInterval{
+			Min: time.Second,
+			Max: 5 * time.Second,
+		},
+	}
+
+	// WHEN
+	var waits []time.Duration
+	for i := 0; i < 4; i++ {
+		next, err := streamer.Fetch()
+		require.NoError(t, err)
+		waits = append(waits, next.Sub(now))
+	}
+	streamer.client = mockCloudFormation{
+		out: &cloudformation.DescribeStackEventsOutput{
+			StackEvents: []*cloudformation.StackEvent{
+				{
+					EventId:           aws.String("1"),
+					LogicalResourceId: aws.String("Cluster"),
+					ResourceStatus:    aws.String("CREATE_IN_PROGRESS"),
+					Timestamp:         aws.Time(now),
+				},
+			},
+		},
+	}
+	next, err := streamer.Fetch()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []time.Duration{2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}, waits, "expected the interval to double up to the max while idle")
+	require.Equal(t, now.Add(time.Second), next, "expected the interval to reset to the min on new events")
+	require.Equal(t, 0, streamer.idleFetches)
+}
+
+func TestNewStackStreamer(t *testing.T) {
+	t.Run("shares the default fetch limiter across streamers", func(t *testing.T) {
+		// WHEN
+		a := NewStackStreamer(nil, "phonetool-test", time.Now())
+		b := NewStackStreamer(nil, "phonetool-prod", time.Now())
+
+		// THEN
+		require.Same(t, a.limiter, b.limiter)
+		require.Equal(t, PollInterval{Min: stackStreamerMinFetchInterval, Max: stackStreamerMaxFetchInterval}, a.interval)
+	})
+	t.Run("applies options", func(t *testing.T) {
+		// GIVEN
+		limiter := NewFetchLimiter(time.Second)
+		interval := PollInterval{Min: 2 * time.Second, Max: 10 * time.Second}
+
+		// WHEN
+		s := NewStackStreamer(nil, "phonetool-test", time.Now(), WithPollInterval(interval), WithFetchLimiter(limiter))
+
+		// THEN
+		require.Same(t, limiter, s.limiter)
+		require.Equal(t, interval, s.interval)
+	})
+}
+
+func TestStackStreamerOptionsFromEnv(t *testing.T) {
+	testCases := map[string]struct {
+		env map[string]string
+
+		wantedInterval PollInterval
+		wantedGap      time.Duration
+	}{
+		"keeps the defaults if no variable is set": {
+			env:            map[string]string{},
+			wantedInterval: PollInterval{Min: stackStreamerMinFetchInterval, Max: stackStreamerMaxFetchInterval},
+			wantedGap:      describeStackEventsGap,
+		},
+		"overrides the bounds of the interval and the gap": {
+			env: map[string]string{
+				StackPollMinIntervalEnvVar: "2s",
+				StackPollMaxIntervalEnvVar: "1m",
+				StackPollGapEnvVar:         "1s",
+			},
+			wantedInterval: PollInterval{Min: 2 * time.Second, Max: time.Minute},
+			wantedGap:      time.Second,
+		},
+		"ignores invalid durations": {
+			env: map[string]string{
+				StackPollMinIntervalEnvVar: "fast",
+				StackPollMaxIntervalEnvVar: "30s",
+			},
+			wantedInterval: PollInterval{Min: stackStreamerMinFetchInterval, Max: 30 * time.Second},
+			wantedGap:      describeStackEventsGap,
+		},
+		"ignores an interval whose min is greater than its max": {
+			env: map[string]string{
+				StackPollMinIntervalEnvVar: "1m",
+			},
+			wantedInterval: PollInterval{Min: stackStreamerMinFetchInterval, Max: stackStreamerMaxFetchInterval},
+			wantedGap:      describeStackEventsGap,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			defer func() {
+				lookupEnv = os.LookupEnv
+				envLimiterOnce = sync.Once{}
+				envLimiter = nil
+			}()
+			lookupEnv = func(key string) (string, bool) {
+				v, ok := tc.env[key]
+				return v, ok
+			}
+
+			// WHEN
+			s := NewStackStreamer(nil, "phonetool-test", time.Now(), StackStreamerOptionsFromEnv()...)
+
+			// THEN
+			require.Equal(t, tc.wantedInterval, s.interval)
+			require.Equal(t, tc.wantedGap, s.limiter.gap)
+		})
+	}
+}
+
 func TestStackStreamer_Close(t *testing.T) {
 	// GIVEN
 	streamer := &StackStreamer{}
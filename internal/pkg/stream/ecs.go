@@ -38,6 +38,7 @@ type ECSDeployment struct {
 	FailedCount     int
 	PendingCount    int
 	RolloutState    string
+	RolloutReason   string // Why the deployment is in its rollout state, such as the alarm that rolled it back.
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 }
@@ -131,6 +132,7 @@ func (s *ECSDeploymentStreamer) Fetch() (next time.Time, err error) {
 			FailedCount:     int(aws.Int64Value(deployment.FailedTasks)),
 			PendingCount:    int(aws.Int64Value(deployment.PendingCount)),
 			RolloutState:    aws.StringValue(deployment.RolloutState),
+			RolloutReason:   aws.StringValue(deployment.RolloutStateReason),
 			CreatedAt:       aws.TimeValue(deployment.CreatedAt),
 			UpdatedAt:       aws.TimeValue(deployment.UpdatedAt),
 		}
@@ -0,0 +1,204 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+)
+
+const (
+	containerInsightsLogGroupFmt = "/aws/ecs/containerinsights/%s/performance"
+	containerInsightsTaskPattern = `{ $.Type = "Task" && $.ServiceName = "%s" }`
+
+	// Container Insights publishes task performance events about once a minute,
+	// so look back far enough to always display the latest events.
+	taskStatsLookBack = 3 * time.Minute
+	// Tasks without any performance event for this long are considered stopped.
+	taskStatsExpiry = 3 * time.Minute
+)
+
+// FilteredLogEventsGetter is the interface to retrieve the log events of a log group matching a pattern.
+type FilteredLogEventsGetter interface {
+	FilteredLogEvents(logGroup, filterPattern string, startTime int64) ([]*cloudwatchlogs.Event, error)
+}
+
+// ECSTaskStats is the resource utilization of an ECS task as reported by Container Insights.
+type ECSTaskStats struct {
+	TaskID            string
+	CPUUtilized       float64 // In CPU units.
+	CPUReserved       float64 // In CPU units.
+	MemoryUtilized    float64 // In MiB.
+	MemoryReserved    float64 // In MiB.
+	NetworkRxBytesSec float64
+	NetworkTxBytesSec float64
+	UpdatedAt         time.Time
+}
+
+// CPUPercent returns the percentage of reserved CPU used by the task.
+func (s ECSTaskStats) CPUPercent() float64 {
+	return percent(s.CPUUtilized, s.CPUReserved)
+}
+
+// MemoryPercent returns the percentage of reserved memory used by the task.
+func (s ECSTaskStats) MemoryPercent() float64 {
+	return percent(s.MemoryUtilized, s.MemoryReserved)
+}
+
+// containerInsightsTaskEvent is the task performance log event emitted by Container Insights.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/Container-Insights-reference-performance-logs-ECS.html
+type containerInsightsTaskEvent struct {
+	TaskID         string  `json:"TaskId"`
+	Timestamp      int64   `json:"Timestamp"`
+	CPUUtilized    float64 `json:"CpuUtilized"`
+	CPUReserved    float64 `json:"CpuReserved"`
+	MemoryUtilized float64 `json:"MemoryUtilized"`
+	MemoryReserved float64 `json:"MemoryReserved"`
+	NetworkRxBytes float64 `json:"NetworkRxBytes"`
+	NetworkTxBytes float64 `json:"NetworkTxBytes"`
+}
+
+// ECSTaskStatsStreamer is a Streamer for the resource utilization of the running tasks of an ECS service.
+type ECSTaskStatsStreamer struct {
+	client  FilteredLogEventsGetter
+	clock   clock
+	rand    func(n int) int
+	cluster string
+	service string
+
+	subscribers   []chan []ECSTaskStats
+	done          chan struct{}
+	tasks         map[string]ECSTaskStats
+	lastEventTime int64
+	eventsToFlush [][]ECSTaskStats
+	mu            sync.Mutex
+
+	retries int
+}
+
+// NewECSTaskStatsStreamer creates a new ECSTaskStatsStreamer that streams the latest utilization of each task
+// in an ECS service until it's stopped.
+func NewECSTaskStatsStreamer(logs FilteredLogEventsGetter, cluster, service string) *ECSTaskStatsStreamer {
+	return &ECSTaskStatsStreamer{
+		client:  logs,
+		clock:   realClock{},
+		rand:    rand.Intn,
+		cluster: cluster,
+		service: service,
+		done:    make(chan struct{}),
+		tasks:   make(map[string]ECSTaskStats),
+	}
+}
+
+// Subscribe returns a read-only channel that will receive the utilization of the service's tasks sorted by task ID.
+func (s *ECSTaskStatsStreamer) Subscribe() <-chan []ECSTaskStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := make(chan []ECSTaskStats)
+	s.subscribers = append(s.subscribers, c)
+	return c
+}
+
+// Fetch retrieves the task performance events published since the last Fetch call
+// and stores a snapshot of the latest utilization of each task.
+// If an error occurs from filtering log events, returns a wrapped err.
+// Otherwise, returns the time the next Fetch should be attempted.
+func (s *ECSTaskStatsStreamer) Fetch() (next time.Time, err error) {
+	now := s.clock.now()
+	startTime := now.Add(-taskStatsLookBack).UnixNano() / int64(time.Millisecond)
+	if s.lastEventTime >= startTime {
+		startTime = s.lastEventTime + 1
+	}
+	events, err := s.client.FilteredLogEvents(fmt.Sprintf(containerInsightsLogGroupFmt, s.cluster),
+		fmt.Sprintf(containerInsightsTaskPattern, s.service), startTime)
+	if err != nil {
+		if request.IsErrorThrottle(err) {
+			s.retries += 1
+			return nextFetchDate(s.clock, s.rand, s.retries), nil
+		}
+		return next, fmt.Errorf("fetch task performance events: %w", err)
+	}
+	s.retries = 0
+	for _, event := range events {
+		var perf containerInsightsTaskEvent
+		if err := json.Unmarshal([]byte(event.Message), &perf); err != nil {
+			return next, fmt.Errorf("unmarshal task performance event: %w", err)
+		}
+		if event.Timestamp > s.lastEventTime {
+			s.lastEventTime = event.Timestamp
+		}
+		updatedAt := time.Unix(0, perf.Timestamp*int64(time.Millisecond))
+		if prev, ok := s.tasks[perf.TaskID]; ok && prev.UpdatedAt.After(updatedAt) {
+			continue
+		}
+		s.tasks[perf.TaskID] = ECSTaskStats{
+			TaskID:            perf.TaskID,
+			CPUUtilized:       perf.CPUUtilized,
+			CPUReserved:       perf.CPUReserved,
+			MemoryUtilized:    perf.MemoryUtilized,
+			MemoryReserved:    perf.MemoryReserved,
+			NetworkRxBytesSec: perf.NetworkRxBytes,
+			NetworkTxBytesSec: perf.NetworkTxBytes,
+			UpdatedAt:         updatedAt,
+		}
+	}
+	var stats []ECSTaskStats
+	for id, task := range s.tasks {
+		if now.Sub(task.UpdatedAt) > taskStatsExpiry {
+			delete(s.tasks, id)
+			continue
+		}
+		stats = append(stats, task)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].TaskID < stats[j].TaskID })
+	s.eventsToFlush = append(s.eventsToFlush, stats)
+	return nextFetchDate(s.clock, s.rand, 0), nil
+}
+
+// Notify flushes all new snapshots to the streamer's subscribers.
+func (s *ECSTaskStatsStreamer) Notify() {
+	// Copy current list of subscribers over, so that we can we add more subscribers while
+	// notifying previous subscribers of older events.
+	s.mu.Lock()
+	var subs []chan []ECSTaskStats
+	subs = append(subs, s.subscribers...)
+	s.mu.Unlock()
+
+	for _, event := range s.eventsToFlush {
+		for _, sub := range subs {
+			sub <- event
+		}
+	}
+	s.eventsToFlush = nil // reset after flushing all events.
+}
+
+// Close closes all subscribed channels notifying them that no more events will be sent.
+func (s *ECSTaskStatsStreamer) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sub := range s.subscribers {
+		close(sub)
+	}
+}
+
+// Done returns a channel that's closed when there are no more events that can be fetched.
+// Task utilization can always be fetched, so the channel is never closed and streaming stops only when the context is canceled.
+func (s *ECSTaskStatsStreamer) Done() <-chan struct{} {
+	return s.done
+}
+
+func percent(utilized, reserved float64) float64 {
+	if reserved == 0 {
+		return 0
+	}
+	return utilized / reserved * 100
+}
@@ -0,0 +1,210 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/stretchr/testify/require"
+)
+
+type mockFilteredLogEvents struct {
+	out []*cloudwatchlogs.Event
+	err error
+
+	logGroup      string
+	filterPattern string
+	startTime     int64
+}
+
+func (m *mockFilteredLogEvents) FilteredLogEvents(logGroup, filterPattern string, startTime int64) ([]*cloudwatchlogs.Event, error) {
+	m.logGroup, m.filterPattern, m.startTime = logGroup, filterPattern, startTime
+	return m.out, m.err
+}
+
+func taskPerfEvent(taskID string, at time.Time, cpu, mem float64) *cloudwatchlogs.Event {
+	ms := at.UnixNano() / int64(time.Millisecond)
+	return &cloudwatchlogs.Event{
+		Message: fmt.Sprintf(`{"Type":"Task","TaskId":"%s","ServiceName":"my-svc","Timestamp":%d,"CpuUtilized":%g,"CpuReserved":256,"MemoryUtilized":%g,"MemoryReserved":512,"NetworkRxBytes":1024,"NetworkTxBytes":2048}`,
+			taskID, ms, cpu, mem),
+		Timestamp: ms,
+	}
+}
+
+func TestECSTaskStatsStreamer_Subscribe(t *testing.T) {
+	// GIVEN
+	streamer := &ECSTaskStatsStreamer{}
+
+	// WHEN
+	_ = streamer.Subscribe()
+	_ = streamer.Subscribe()
+
+	// THEN
+	require.Equal(t, 2, len(streamer.subscribers), "expected number of subscribers to match")
+}
+
+func TestECSTaskStatsStreamer_Fetch(t *testing.T) {
+	now := time.Date(2021, time.May, 1, 11, 0, 0, 0, time.UTC)
+	t.Run("returns a wrapped error on filter log events call failure", func(t *testing.T) {
+		// GIVEN
+		m := &mockFilteredLogEvents{
+			err: errors.New("some error"),
+		}
+		streamer := NewECSTaskStatsStreamer(m, "my-cluster", "my-svc")
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.EqualError(t, err, "fetch task performance events: some error")
+	})
+	t.Run("returns a wrapped error if an event can't be parsed", func(t *testing.T) {
+		// GIVEN
+		m := &mockFilteredLogEvents{
+			out: []*cloudwatchlogs.Event{
+				{
+					Message: "not json",
+				},
+			},
+		}
+		streamer := NewECSTaskStatsStreamer(m, "my-cluster", "my-svc")
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unmarshal task performance event")
+	})
+	t.Run("stores the latest stats of each task sorted by task ID", func(t *testing.T) {
+		// GIVEN
+		m := &mockFilteredLogEvents{
+			out: []*cloudwatchlogs.Event{
+				taskPerfEvent("bbbb", now.Add(-2*time.Minute), 10, 100),
+				taskPerfEvent("aaaa", now.Add(-2*time.Minute), 20, 200),
+				taskPerfEvent("bbbb", now.Add(-1*time.Minute), 128, 256),
+			},
+		}
+		streamer := NewECSTaskStatsStreamer(m, "my-cluster", "my-svc")
+		streamer.clock = fakeClock{fakeNow: now}
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, "/aws/ecs/containerinsights/my-cluster/performance", m.logGroup)
+		require.Equal(t, `{ $.Type = "Task" && $.ServiceName = "my-svc" }`, m.filterPattern)
+		require.Equal(t, now.Add(-3*time.Minute).UnixNano()/int64(time.Millisecond), m.startTime)
+		require.Equal(t, [][]ECSTaskStats{
+			{
+				{
+					TaskID:            "aaaa",
+					CPUUtilized:       20,
+					CPUReserved:       256,
+					MemoryUtilized:    200,
+					MemoryReserved:    512,
+					NetworkRxBytesSec: 1024,
+					NetworkTxBytesSec: 2048,
+					UpdatedAt:         time.Unix(0, now.Add(-2*time.Minute).UnixNano()),
+				},
+				{
+					TaskID:            "bbbb",
+					CPUUtilized:       128,
+					CPUReserved:       256,
+					MemoryUtilized:    256,
+					MemoryReserved:    512,
+					NetworkRxBytesSec: 1024,
+					NetworkTxBytesSec: 2048,
+					UpdatedAt:         time.Unix(0, now.Add(-1*time.Minute).UnixNano()),
+				},
+			},
+		}, streamer.eventsToFlush)
+	})
+	t.Run("fetches events after the last seen event and removes stopped tasks", func(t *testing.T) {
+		// GIVEN
+		lastEvent := now.Add(-1 * time.Minute)
+		m := &mockFilteredLogEvents{
+			out: []*cloudwatchlogs.Event{
+				taskPerfEvent("bbbb", now, 50, 100),
+			},
+		}
+		streamer := NewECSTaskStatsStreamer(m, "my-cluster", "my-svc")
+		streamer.clock = fakeClock{fakeNow: now}
+		streamer.lastEventTime = lastEvent.UnixNano() / int64(time.Millisecond)
+		streamer.tasks["aaaa"] = ECSTaskStats{
+			TaskID:    "aaaa",
+			UpdatedAt: now.Add(-5 * time.Minute),
+		}
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, lastEvent.UnixNano()/int64(time.Millisecond)+1, m.startTime)
+		require.Equal(t, 1, len(streamer.eventsToFlush), "should have only one snapshot to flush")
+		require.Equal(t, 1, len(streamer.eventsToFlush[0]), "stopped task should be removed")
+		require.Equal(t, "bbbb", streamer.eventsToFlush[0][0].TaskID)
+		require.Equal(t, now.UnixNano()/int64(time.Millisecond), streamer.lastEventTime)
+	})
+}
+
+func TestECSTaskStats_Percent(t *testing.T) {
+	// GIVEN
+	stats := ECSTaskStats{
+		CPUUtilized:    64,
+		CPUReserved:    256,
+		MemoryUtilized: 100,
+	}
+
+	// THEN
+	require.Equal(t, 25.0, stats.CPUPercent())
+	require.Equal(t, 0.0, stats.MemoryPercent(), "should not divide by zero when nothing is reserved")
+}
+
+func TestECSTaskStatsStreamer_Notify(t *testing.T) {
+	// GIVEN
+	wantedEvents := [][]ECSTaskStats{
+		{
+			{
+				TaskID: "aaaa",
+			},
+		},
+	}
+	sub := make(chan []ECSTaskStats, 2)
+	streamer := &ECSTaskStatsStreamer{
+		subscribers:   []chan []ECSTaskStats{sub},
+		eventsToFlush: wantedEvents,
+	}
+
+	// WHEN
+	streamer.Notify()
+	close(sub) // Close the channel to stop expecting to receive new events.
+
+	// THEN
+	var actualEvents [][]ECSTaskStats
+	for event := range sub {
+		actualEvents = append(actualEvents, event)
+	}
+	require.ElementsMatch(t, wantedEvents, actualEvents)
+	require.Nil(t, streamer.eventsToFlush)
+}
+
+func TestECSTaskStatsStreamer_Close(t *testing.T) {
+	// GIVEN
+	streamer := &ECSTaskStatsStreamer{}
+	c := streamer.Subscribe()
+
+	// WHEN
+	streamer.Close()
+
+	// THEN
+	_, isOpen := <-c
+	require.False(t, isOpen, "expected subscribed channels to be closed")
+}
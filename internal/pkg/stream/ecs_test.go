@@ -67,14 +67,15 @@ func TestECSDeploymentStreamer_Fetch(t *testing.T) {
 						UpdatedAt:      aws.Time(startDate),
 					},
 					{
-						DesiredCount:   aws.Int64(10),
-						FailedTasks:    aws.Int64(10),
-						PendingCount:   aws.Int64(0),
-						RolloutState:   aws.String("FAILED"),
-						RunningCount:   aws.Int64(0),
-						Status:         aws.String("ACTIVE"),
-						TaskDefinition: aws.String("arn:aws:ecs:us-west-2:1111:task-definition/myapp-test-mysvc:1"),
-						UpdatedAt:      aws.Time(oldStartDate),
+						DesiredCount:       aws.Int64(10),
+						FailedTasks:        aws.Int64(10),
+						PendingCount:       aws.Int64(0),
+						RolloutState:       aws.String("FAILED"),
+						RolloutStateReason: aws.String("ECS deployment ecs-svc/1234 rolled back: CloudWatch alarm(s) HighErrorRate in ALARM state."),
+						RunningCount:       aws.Int64(0),
+						Status:             aws.String("ACTIVE"),
+						TaskDefinition:     aws.String("arn:aws:ecs:us-west-2:1111:task-definition/myapp-test-mysvc:1"),
+						UpdatedAt:          aws.Time(oldStartDate),
 					},
 				},
 			},
@@ -107,6 +108,7 @@ func TestECSDeploymentStreamer_Fetch(t *testing.T) {
 						FailedCount:     10,
 						PendingCount:    0,
 						RolloutState:    "FAILED",
+						RolloutReason:   "ECS deployment ecs-svc/1234 rolled back: CloudWatch alarm(s) HighErrorRate in ALARM state.",
 						UpdatedAt:       oldStartDate,
 					},
 				},
@@ -7,6 +7,7 @@ package stream
 
 import (
 	"context"
+	"sync"
 	"time"
 )
 
@@ -62,23 +63,95 @@ func Stream(ctx context.Context, streamer Streamer) error {
 	}
 }
 
+// PollInterval bounds the adaptive interval between two Fetch calls of a streamer.
+// The interval starts at Min and doubles, up to Max, every time a Fetch is throttled or doesn't find new events.
+type PollInterval struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+var defaultPollInterval = PollInterval{
+	Min: streamerFetchIntervalDurationMs * time.Millisecond,
+	Max: streamerMaxFetchIntervalDurationMs * time.Millisecond,
+}
+
 // nextFetchDate returns a time to wait using random jitter and exponential backoff.
 func nextFetchDate(clock clock, rand func(int) int, retries int) time.Time {
-	// waitMs := rand.Intn( 							// Get a random integer between 0 and ...
-	// 	min( 											// the minimum of ...
-	// 		streamerMaxFetchIntervalDuration,           // the max fetch interval and ...
-	// 		streamerFetchIntervalDuration*(1<<retries), // d*2^r, where r=retries and d= the normal
+	return defaultPollInterval.nextFetchDate(clock, rand, retries)
+}
+
+// nextFetchDate returns a time to wait using random jitter and exponential backoff from the interval's bounds.
+func (p PollInterval) nextFetchDate(clock clock, rand func(int) int, retries int) time.Time {
+	// waitMs := rand.Intn( 				// Get a random integer between 0 and ...
+	// 	min( 								// the minimum of ...
+	// 		maxMs,           				// the max fetch interval and ...
+	// 		minMs*(1<<retries), 			// d*2^r, where r=retries and d= the min interval
 	// 	),
 	// )
-	waitMs := rand(
-		min(
-			streamerMaxFetchIntervalDurationMs,
-			streamerFetchIntervalDurationMs*(1<<retries),
-		),
-	)
+	minMs, maxMs := int(p.Min/time.Millisecond), int(p.Max/time.Millisecond)
+	ceilMs := maxMs
+	if retries < 31 { // Avoid overflowing the shift.
+		ceilMs = min(maxMs, minMs*(1<<retries))
+	}
+	if ceilMs <= 0 {
+		return clock.now()
+	}
+	waitMs := rand(ceilMs)
 	return clock.now().Add(time.Duration(waitMs) * time.Millisecond)
 }
 
+// FetchLimiter spaces out the Fetch calls of all the streamers that share it,
+// so that concurrent streamers stay within the rate limits of the API they poll.
+type FetchLimiter struct {
+	gap   time.Duration
+	clock clock
+
+	mu    sync.Mutex
+	slots []time.Time // Booked Fetch calls that are not older than a gap, sorted in ascending order.
+}
+
+// NewFetchLimiter returns a FetchLimiter that schedules at most one Fetch call every gap.
+func NewFetchLimiter(gap time.Duration) *FetchLimiter {
+	return &FetchLimiter{
+		gap:   gap,
+		clock: realClock{},
+	}
+}
+
+// reserve books the first time at or after t that is at least a gap away from all the other booked calls, and returns it.
+// A call booked far in the future by a backed off streamer doesn't delay the calls booked before it.
+func (l *FetchLimiter) reserve(t time.Time) time.Time {
+	if l == nil {
+		return t
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Forget the calls that already happened and can't be too close to a new one.
+	cutoff := l.clock.now().Add(-l.gap)
+	var i int
+	for i < len(l.slots) && l.slots[i].Before(cutoff) {
+		i++
+	}
+	l.slots = l.slots[i:]
+
+	pos := len(l.slots)
+	for j, slot := range l.slots {
+		if !slot.Add(l.gap).After(t) {
+			continue // The slot is over before t.
+		}
+		if !t.Add(l.gap).After(slot) {
+			pos = j // t fits before the slot.
+			break
+		}
+		t = slot.Add(l.gap)
+	}
+	l.slots = append(l.slots, time.Time{})
+	copy(l.slots[pos+1:], l.slots[pos:])
+	l.slots[pos] = t
+	return t
+}
+
 func min(x, y int) int {
 	if x < y {
 		return x
@@ -131,3 +131,48 @@ func TestStream(t *testing.T) {
 		require.Equal(t, b, time.Date(2020, time.November, 1, 0, 0, 0, maxIntervalNS, time.UTC), "require that the given date for 10 retries is never more than the max interval")
 	})
 }
+
+func TestPollInterval_NextFetchDate(t *testing.T) {
+	clock := fakeClock{fakeNow: time.Date(2020, time.November, 1, 0, 0, 0, 0, time.UTC)}
+	rand := func(n int) int { return n }
+	interval := PollInterval{Min: 500 * time.Millisecond, Max: 3 * time.Second}
+
+	require.Equal(t, clock.fakeNow.Add(500*time.Millisecond), interval.nextFetchDate(clock, rand, 0))
+	require.Equal(t, clock.fakeNow.Add(2*time.Second), interval.nextFetchDate(clock, rand, 2))
+	require.Equal(t, clock.fakeNow.Add(3*time.Second), interval.nextFetchDate(clock, rand, 64), "expected the wait to be capped by the max interval")
+	require.Equal(t, clock.fakeNow, PollInterval{}.nextFetchDate(clock, rand, 1), "expected no wait for an empty interval")
+}
+
+func TestFetchLimiter_Reserve(t *testing.T) {
+	// GIVEN
+	start := time.Date(2020, time.November, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewFetchLimiter(time.Second)
+	limiter.clock = fakeClock{fakeNow: start}
+
+	// WHEN
+	first := limiter.reserve(start)
+	second := limiter.reserve(start)
+	third := limiter.reserve(start.Add(5 * time.Second))
+	backedOff := limiter.reserve(start.Add(16 * time.Second))
+	earlier := limiter.reserve(start.Add(2 * time.Second))
+	tooClose := limiter.reserve(start.Add(15500 * time.Millisecond))
+
+	// THEN
+	require.Equal(t, start, first)
+	require.Equal(t, start.Add(time.Second), second, "expected concurrent calls to be spaced out by the gap")
+	require.Equal(t, start.Add(5*time.Second), third, "expected calls past the last reserved slot to not wait")
+	require.Equal(t, start.Add(16*time.Second), backedOff)
+	require.Equal(t, start.Add(2*time.Second), earlier, "expected calls before a later booking to not wait for it")
+	require.Equal(t, start.Add(17*time.Second), tooClose, "expected calls to be spaced out from the later booking")
+
+	// WHEN
+	limiter.clock = fakeClock{fakeNow: start.Add(time.Minute)}
+	afterwards := limiter.reserve(start.Add(time.Minute))
+
+	// THEN
+	require.Equal(t, start.Add(time.Minute), afterwards)
+	require.Len(t, limiter.slots, 1, "expected past calls to be forgotten")
+
+	var nilLimiter *FetchLimiter
+	require.Equal(t, start, nilLimiter.reserve(start))
+}
@@ -0,0 +1,80 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetry
+
+import (
+	"errors"
+	"net"
+	"os/exec"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// Failure categories. They describe the kind of error without any of its details.
+const (
+	CategoryCancelled       = "cancelled"
+	CategoryCredentials     = "credentials"
+	CategoryPermissions     = "permissions"
+	CategoryThrottling      = "throttling"
+	CategoryAWS             = "aws"
+	CategoryNetwork         = "network"
+	CategoryExternalCommand = "external-command"
+	CategoryOther           = "other"
+)
+
+var (
+	credentialsErrCodes = map[string]bool{
+		"NoCredentialProviders":       true,
+		"ExpiredToken":                true,
+		"ExpiredTokenException":       true,
+		"InvalidClientTokenId":        true,
+		"UnrecognizedClientException": true,
+		"SharedCredsLoad":             true,
+	}
+	permissionsErrCodes = map[string]bool{
+		"AccessDenied":          true,
+		"AccessDeniedException": true,
+		"UnauthorizedOperation": true,
+	}
+	throttlingErrCodes = map[string]bool{
+		"Throttling":                             true,
+		"ThrottlingException":                    true,
+		"TooManyRequestsException":               true,
+		"RequestLimitExceeded":                   true,
+		"ProvisionedThroughputExceededException": true,
+	}
+)
+
+// Categorize returns the failure category of the error of a command.
+func Categorize(err error) string {
+	if errors.Is(err, terminal.InterruptErr) {
+		return CategoryCancelled
+	}
+	var aerr awserr.Error
+	if errors.As(err, &aerr) {
+		switch {
+		case credentialsErrCodes[aerr.Code()]:
+			return CategoryCredentials
+		case permissionsErrCodes[aerr.Code()]:
+			return CategoryPermissions
+		case throttlingErrCodes[aerr.Code()]:
+			return CategoryThrottling
+		case aerr.Code() == request.ErrCodeRequestError:
+			return CategoryNetwork
+		default:
+			return CategoryAWS
+		}
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return CategoryNetwork
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return CategoryExternalCommand
+	}
+	return CategoryOther
+}
@@ -0,0 +1,67 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetry
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os/exec"
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategorize(t *testing.T) {
+	testCases := map[string]struct {
+		inErr error
+
+		wantedCategory string
+	}{
+		"interrupted prompt": {
+			inErr:          fmt.Errorf("select service: %w", terminal.InterruptErr),
+			wantedCategory: CategoryCancelled,
+		},
+		"expired credentials": {
+			inErr:          fmt.Errorf("get application: %w", awserr.New("ExpiredTokenException", "token expired", nil)),
+			wantedCategory: CategoryCredentials,
+		},
+		"missing permissions": {
+			inErr:          awserr.New("AccessDenied", "not authorized", nil),
+			wantedCategory: CategoryPermissions,
+		},
+		"throttled request": {
+			inErr:          awserr.New("ThrottlingException", "rate exceeded", nil),
+			wantedCategory: CategoryThrottling,
+		},
+		"failed request": {
+			inErr:          awserr.New("RequestError", "send request failed", nil),
+			wantedCategory: CategoryNetwork,
+		},
+		"other AWS error": {
+			inErr:          awserr.New("ValidationError", "stack does not exist", nil),
+			wantedCategory: CategoryAWS,
+		},
+		"network error": {
+			inErr:          fmt.Errorf("verify service: %w", &net.DNSError{Err: "no such host"}),
+			wantedCategory: CategoryNetwork,
+		},
+		"external command error": {
+			inErr:          fmt.Errorf("build image: %w", &exec.ExitError{}),
+			wantedCategory: CategoryExternalCommand,
+		},
+		"other error": {
+			inErr:          errors.New("some error"),
+			wantedCategory: CategoryOther,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedCategory, Categorize(tc.inErr))
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetry
+
+import (
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/version"
+)
+
+// Report aggregates events by command. It's the only data that's sent to the endpoint.
+type Report struct {
+	Version  string           `json:"version"`
+	OS       string           `json:"os"`
+	Since    time.Time        `json:"since"`
+	Until    time.Time        `json:"until"`
+	Commands []CommandSummary `json:"commands"`
+}
+
+// CommandSummary counts the successes of a command and its failures by category.
+type CommandSummary struct {
+	Command   string         `json:"command"`
+	Successes int            `json:"successes"`
+	Failures  map[string]int `json:"failures,omitempty"`
+}
+
+// Total returns the number of times the command ran.
+func (s CommandSummary) Total() int {
+	total := s.Successes
+	for _, n := range s.Failures {
+		total += n
+	}
+	return total
+}
+
+// NewReport aggregates the events that happened between since and until.
+func NewReport(events []Event, since, until time.Time) *Report {
+	return &Report{
+		Version:  version.Version,
+		OS:       runtime.GOOS,
+		Since:    since,
+		Until:    until,
+		Commands: Summarize(events),
+	}
+}
+
+// Summarize aggregates the events by command, sorted from the command that failed the most.
+func Summarize(events []Event) []CommandSummary {
+	byCommand := make(map[string]*CommandSummary)
+	var commands []string
+	for _, e := range events {
+		summary, ok := byCommand[e.Command]
+		if !ok {
+			summary = &CommandSummary{Command: e.Command}
+			byCommand[e.Command] = summary
+			commands = append(commands, e.Command)
+		}
+		if e.Outcome == OutcomeSuccess {
+			summary.Successes++
+			continue
+		}
+		if summary.Failures == nil {
+			summary.Failures = make(map[string]int)
+		}
+		summary.Failures[e.Category]++
+	}
+	summaries := make([]CommandSummary, len(commands))
+	for i, command := range commands {
+		summaries[i] = *byCommand[command]
+	}
+	sort.SliceStable(summaries, func(i, j int) bool {
+		fi, fj := summaries[i].Total()-summaries[i].Successes, summaries[j].Total()-summaries[j].Successes
+		if fi != fj {
+			return fi > fj
+		}
+		return summaries[i].Command < summaries[j].Command
+	})
+	return summaries
+}
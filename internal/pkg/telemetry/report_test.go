@@ -0,0 +1,61 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarize(t *testing.T) {
+	testCases := map[string]struct {
+		inEvents []Event
+
+		wantedSummaries []CommandSummary
+	}{
+		"no events": {
+			wantedSummaries: []CommandSummary{},
+		},
+		"sorts commands from the one that failed the most": {
+			inEvents: []Event{
+				{Command: "svc ls", Outcome: OutcomeSuccess},
+				{Command: "svc deploy", Outcome: OutcomeFailure, Category: CategoryCredentials},
+				{Command: "svc deploy", Outcome: OutcomeSuccess},
+				{Command: "env init", Outcome: OutcomeFailure, Category: CategoryPermissions},
+				{Command: "svc deploy", Outcome: OutcomeFailure, Category: CategoryCredentials},
+				{Command: "svc deploy", Outcome: OutcomeFailure, Category: CategoryNetwork},
+				{Command: "app init", Outcome: OutcomeSuccess},
+			},
+			wantedSummaries: []CommandSummary{
+				{
+					Command:   "svc deploy",
+					Successes: 1,
+					Failures: map[string]int{
+						CategoryCredentials: 2,
+						CategoryNetwork:     1,
+					},
+				},
+				{
+					Command: "env init",
+					Failures: map[string]int{
+						CategoryPermissions: 1,
+					},
+				},
+				{Command: "app init", Successes: 1},
+				{Command: "svc ls", Successes: 1},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			summaries := Summarize(tc.inEvents)
+
+			// THEN
+			require.Equal(t, tc.wantedSummaries, summaries)
+		})
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package telemetry records anonymous usage metrics of commands on the local machine once users opt in,
+// and optionally sends them aggregated to an endpoint.
+package telemetry
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	localConfigDirName = ".copilot"
+	settingsFileName   = "telemetry.json"
+	eventsFileName     = "telemetry-events.jsonl"
+
+	maxEvents      = 1000           // Oldest events are dropped past this number.
+	sendInterval   = 24 * time.Hour // Minimum interval between two reports sent to the endpoint.
+	requestTimeout = 2 * time.Second
+)
+
+// EnvVar is the environment variable that turns off recording on a machine where users opted in when set to "off".
+const EnvVar = "COPILOT_TELEMETRY"
+
+// Outcomes of a command.
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+// Settings holds whether users opted in to recording usage metrics, and where to send them.
+type Settings struct {
+	Enabled  bool      `json:"enabled"`
+	Endpoint string    `json:"endpoint,omitempty"` // Events are only kept locally if empty.
+	LastSent time.Time `json:"lastSent"`
+}
+
+// Event is the anonymous record of a command execution.
+// It never holds argument or flag values since they can name users' resources.
+type Event struct {
+	Command    string    `json:"command"`         // Ex: "svc deploy"
+	Flags      []string  `json:"flags,omitempty"` // Names of the flags that were set.
+	Outcome    string    `json:"outcome"`
+	Category   string    `json:"category,omitempty"` // Category of the failure, see Categorize.
+	DurationMs int64     `json:"durationMs"`
+	Version    string    `json:"version"`
+	OS         string    `json:"os"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+type httpPoster interface {
+	Post(url, contentType string, body io.Reader) (*http.Response, error)
+}
+
+// Store reads and writes the telemetry settings and events under $HOME/.copilot.
+type Store struct {
+	fs     afero.Fs
+	dir    string
+	now    func() time.Time
+	getenv func(key string) string
+	http   httpPoster
+}
+
+// NewStore returns a Store backed by files in the $HOME/.copilot directory.
+func NewStore() (*Store, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("get home directory: %w", err)
+	}
+	return &Store{
+		fs:     afero.NewOsFs(),
+		dir:    filepath.Join(homeDir, localConfigDirName),
+		now:    time.Now,
+		getenv: os.Getenv,
+		http:   &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+// Settings returns the telemetry settings. Recording is disabled if users never opted in.
+func (s *Store) Settings() (*Settings, error) {
+	settings := &Settings{}
+	path := s.settingsPath()
+	exists, err := afero.Exists(s.fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("check if file %s exists: %w", path, err)
+	}
+	if !exists {
+		return settings, nil
+	}
+	data, err := afero.ReadFile(s.fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("read file %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, settings); err != nil {
+		return nil, fmt.Errorf("unmarshal telemetry settings from %s: %w", path, err)
+	}
+	return settings, nil
+}
+
+// SaveSettings writes the telemetry settings.
+// Disabling recording removes the events recorded so far.
+func (s *Store) SaveSettings(settings Settings) error {
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal telemetry settings: %w", err)
+	}
+	if err := s.write(s.settingsPath(), data); err != nil {
+		return err
+	}
+	if settings.Enabled {
+		return nil
+	}
+	if err := s.fs.Remove(s.eventsPath()); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove file %s: %w", s.eventsPath(), err)
+	}
+	return nil
+}
+
+// Events returns the events recorded on the machine from the oldest to the most recent.
+func (s *Store) Events() ([]Event, error) {
+	path := s.eventsPath()
+	exists, err := afero.Exists(s.fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("check if file %s exists: %w", path, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	data, err := afero.ReadFile(s.fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("read file %s: %w", path, err)
+	}
+	var events []Event
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var event Event
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			// Skip lines that were cut off, for example by an interrupted write.
+			continue
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// Record appends the event if users opted in, and sends the events aggregated since the last report
+// once a day if an endpoint is configured.
+func (s *Store) Record(event Event) error {
+	if strings.EqualFold(s.getenv(EnvVar), "off") {
+		return nil
+	}
+	settings, err := s.Settings()
+	if err != nil {
+		return err
+	}
+	if !settings.Enabled {
+		return nil
+	}
+	events, err := s.Events()
+	if err != nil {
+		return err
+	}
+	events = append(events, event)
+	if len(events) > maxEvents {
+		events = events[len(events)-maxEvents:]
+	}
+	buf := &bytes.Buffer{}
+	for _, e := range events {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("marshal telemetry event: %w", err)
+		}
+		buf.Write(data)
+		buf.WriteString("\n")
+	}
+	if err := s.write(s.eventsPath(), buf.Bytes()); err != nil {
+		return err
+	}
+	return s.send(settings, events)
+}
+
+// send posts the events recorded since the last report to the endpoint, at most once per sendInterval.
+func (s *Store) send(settings *Settings, events []Event) error {
+	now := s.now()
+	if settings.Endpoint == "" || now.Sub(settings.LastSent) < sendInterval {
+		return nil
+	}
+	var unsent []Event
+	for _, e := range events {
+		if e.Timestamp.After(settings.LastSent) {
+			unsent = append(unsent, e)
+		}
+	}
+	data, err := json.Marshal(NewReport(unsent, settings.LastSent, now))
+	if err != nil {
+		return fmt.Errorf("marshal telemetry report: %w", err)
+	}
+	// Record the attempt first so that an unreachable endpoint doesn't slow down every command until it recovers.
+	settings.LastSent = now
+	if err := s.SaveSettings(*settings); err != nil {
+		return err
+	}
+	resp, err := s.http.Post(settings.Endpoint, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("send telemetry report to %s: %w", settings.Endpoint, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("send telemetry report to %s: unexpected status %s", settings.Endpoint, resp.Status)
+	}
+	return nil
+}
+
+func (s *Store) write(path string, data []byte) error {
+	if err := s.fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("create directory %s: %w", filepath.Dir(path), err)
+	}
+	if err := afero.WriteFile(s.fs, path, data, 0644 /* -rw-r--r-- */); err != nil {
+		return fmt.Errorf("write file %s: %w", path, err)
+	}
+	return nil
+}
+
+func (s *Store) settingsPath() string {
+	return filepath.Join(s.dir, settingsFileName)
+}
+
+func (s *Store) eventsPath() string {
+	return filepath.Join(s.dir, eventsFileName)
+}
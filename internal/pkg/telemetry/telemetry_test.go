@@ -0,0 +1,237 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetry
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeHTTPPoster struct {
+	status int
+	err    error
+
+	url  string
+	body string
+}
+
+func (p *fakeHTTPPoster) Post(url, contentType string, body io.Reader) (*http.Response, error) {
+	p.url = url
+	data, _ := ioutil.ReadAll(body)
+	p.body = string(data)
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &http.Response{
+		StatusCode: p.status,
+		Status:     http.StatusText(p.status),
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+	}, nil
+}
+
+func TestStore_Settings(t *testing.T) {
+	testCases := map[string]struct {
+		setupFS func(fs afero.Fs)
+
+		wantedSettings *Settings
+		wantedErr      string
+	}{
+		"is disabled if users never opted in": {
+			setupFS:        func(fs afero.Fs) {},
+			wantedSettings: &Settings{},
+		},
+		"returns the saved settings": {
+			setupFS: func(fs afero.Fs) {
+				afero.WriteFile(fs, "/home/.copilot/telemetry.json", []byte(`{"enabled": true, "endpoint": "https://example.com", "lastSent": "2021-03-01T00:00:00Z"}`), 0644)
+			},
+			wantedSettings: &Settings{
+				Enabled:  true,
+				Endpoint: "https://example.com",
+				LastSent: time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"wraps the error of a malformed file": {
+			setupFS: func(fs afero.Fs) {
+				afero.WriteFile(fs, "/home/.copilot/telemetry.json", []byte(`{`), 0644)
+			},
+			wantedErr: "unmarshal telemetry settings from /home/.copilot/telemetry.json: unexpected end of JSON input",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			tc.setupFS(fs)
+			s := &Store{fs: fs, dir: "/home/.copilot"}
+
+			// WHEN
+			settings, err := s.Settings()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSettings, settings)
+		})
+	}
+}
+
+func TestStore_SaveSettings(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	afero.WriteFile(fs, "/home/.copilot/telemetry-events.jsonl", []byte(`{"command":"svc deploy"}`+"\n"), 0644)
+	s := &Store{fs: fs, dir: "/home/.copilot"}
+
+	// WHEN
+	err := s.SaveSettings(Settings{})
+
+	// THEN
+	require.NoError(t, err)
+	exists, _ := afero.Exists(fs, "/home/.copilot/telemetry-events.jsonl")
+	require.False(t, exists, "disabling telemetry should remove the recorded events")
+	settings, err := s.Settings()
+	require.NoError(t, err)
+	require.False(t, settings.Enabled)
+}
+
+func TestStore_Record(t *testing.T) {
+	now := time.Date(2021, 3, 2, 10, 0, 0, 0, time.UTC)
+	event := Event{Command: "svc deploy", Outcome: OutcomeSuccess, Timestamp: now}
+	testCases := map[string]struct {
+		inEnvVar string
+		setupFS  func(fs afero.Fs)
+		poster   *fakeHTTPPoster
+
+		wantedEvents   []Event
+		wantedLastSent time.Time
+		wantedPostURL  string
+		wantedErr      string
+	}{
+		"does not record if users never opted in": {
+			setupFS: func(fs afero.Fs) {},
+			poster:  &fakeHTTPPoster{},
+		},
+		"does not record if turned off by the environment variable": {
+			inEnvVar: "off",
+			setupFS: func(fs afero.Fs) {
+				afero.WriteFile(fs, "/home/.copilot/telemetry.json", []byte(`{"enabled": true}`), 0644)
+			},
+			poster: &fakeHTTPPoster{},
+		},
+		"appends the event without sending it if there is no endpoint": {
+			setupFS: func(fs afero.Fs) {
+				afero.WriteFile(fs, "/home/.copilot/telemetry.json", []byte(`{"enabled": true}`), 0644)
+				afero.WriteFile(fs, "/home/.copilot/telemetry-events.jsonl", []byte(`{"command":"app init","outcome":"success"}`+"\n"+`{"comm`), 0644)
+			},
+			poster: &fakeHTTPPoster{},
+			wantedEvents: []Event{
+				{Command: "app init", Outcome: OutcomeSuccess},
+				event,
+			},
+		},
+		"does not send the events more than once a day": {
+			setupFS: func(fs afero.Fs) {
+				afero.WriteFile(fs, "/home/.copilot/telemetry.json", []byte(`{"enabled": true, "endpoint": "https://example.com", "lastSent": "2021-03-02T00:00:00Z"}`), 0644)
+			},
+			poster:         &fakeHTTPPoster{},
+			wantedEvents:   []Event{event},
+			wantedLastSent: time.Date(2021, 3, 2, 0, 0, 0, 0, time.UTC),
+		},
+		"sends the events recorded since the last report": {
+			setupFS: func(fs afero.Fs) {
+				afero.WriteFile(fs, "/home/.copilot/telemetry.json", []byte(`{"enabled": true, "endpoint": "https://example.com", "lastSent": "2021-03-01T00:00:00Z"}`), 0644)
+			},
+			poster:         &fakeHTTPPoster{status: http.StatusOK},
+			wantedEvents:   []Event{event},
+			wantedLastSent: now,
+			wantedPostURL:  "https://example.com",
+		},
+		"does not retry on every command if the endpoint fails": {
+			setupFS: func(fs afero.Fs) {
+				afero.WriteFile(fs, "/home/.copilot/telemetry.json", []byte(`{"enabled": true, "endpoint": "https://example.com", "lastSent": "2021-03-01T00:00:00Z"}`), 0644)
+			},
+			poster:         &fakeHTTPPoster{err: errors.New("some error")},
+			wantedEvents:   []Event{event},
+			wantedLastSent: now,
+			wantedPostURL:  "https://example.com",
+			wantedErr:      "send telemetry report to https://example.com: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			tc.setupFS(fs)
+			s := &Store{
+				fs:  fs,
+				dir: "/home/.copilot",
+				now: func() time.Time { return now },
+				getenv: func(key string) string {
+					if key == EnvVar {
+						return tc.inEnvVar
+					}
+					return ""
+				},
+				http: tc.poster,
+			}
+
+			// WHEN
+			err := s.Record(event)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			events, err := s.Events()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEvents, events)
+			settings, err := s.Settings()
+			require.NoError(t, err)
+			require.True(t, tc.wantedLastSent.Equal(settings.LastSent))
+			require.Equal(t, tc.wantedPostURL, tc.poster.url)
+			if tc.wantedPostURL != "" {
+				require.Contains(t, tc.poster.body, `"commands":[{"command":"svc deploy","successes":1}]`)
+			}
+		})
+	}
+}
+
+func TestStore_Record_KeepsTheMostRecentEvents(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	afero.WriteFile(fs, "/home/.copilot/telemetry.json", []byte(`{"enabled": true}`), 0644)
+	s := &Store{
+		fs:     fs,
+		dir:    "/home/.copilot",
+		now:    time.Now,
+		getenv: func(string) string { return "" },
+	}
+	for i := 0; i < maxEvents; i++ {
+		require.NoError(t, s.Record(Event{Command: "svc ls"}))
+	}
+
+	// WHEN
+	err := s.Record(Event{Command: "svc deploy"})
+
+	// THEN
+	require.NoError(t, err)
+	events, err := s.Events()
+	require.NoError(t, err)
+	require.Len(t, events, maxEvents)
+	require.Equal(t, "svc deploy", events[len(events)-1].Command)
+}
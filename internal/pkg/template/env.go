@@ -25,6 +25,8 @@ var (
 		"lambdas",
 		"vpc-resources",
 		"nat-gateways",
+		"gpu-capacity",
+		"ipv6-resources",
 	}
 )
 
@@ -39,6 +41,15 @@ type EnvOpts struct {
 
 	ImportVPC *config.ImportVPC
 	VPCConfig *config.AdjustVPC
+
+	ClusterName      string // Optional. Name of the ECS cluster, generated by CloudFormation if empty.
+	LoadBalancerName string // Optional. Name of the public load balancer, generated by CloudFormation if empty.
+
+	HTTPSListener *config.HTTPSListener // Optional. TLS settings of the HTTPS listener.
+	Domain        *config.EnvDomain     // Optional. Domain of the environment, replaces the subdomain delegated from the application's domain.
+
+	ExternalInstances bool // True if on-premises instances can be registered to the cluster with ECS Anywhere.
+	IPv6              bool // True if the VPC and the public load balancer are dual-stack.
 }
 
 // ParseEnv parses an environment's CloudFormation template with the specified data object and returns its content.
@@ -35,6 +35,8 @@ func TestTemplate_ParseEnv(t *testing.T) {
   lambdas
   vpc-resources
   nat-gateways
+  gpu-capacity
+  ipv6-resources
 `,
 		},
 		"renders v1.0.0 template": {
@@ -60,6 +62,8 @@ func TestTemplate_ParseEnv(t *testing.T) {
 			tpl.box.AddString("environment/partials/lambdas.yml", "lambdas")
 			tpl.box.AddString("environment/partials/vpc-resources.yml", "vpc-resources")
 			tpl.box.AddString("environment/partials/nat-gateways.yml", "nat-gateways")
+			tpl.box.AddString("environment/partials/gpu-capacity.yml", "gpu-capacity")
+			tpl.box.AddString("environment/partials/ipv6-resources.yml", "ipv6-resources")
 
 			// WHEN
 			c, err := tpl.ParseEnv(&EnvOpts{
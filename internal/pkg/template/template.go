@@ -10,7 +10,9 @@ import (
 	"fmt"
 	"path"
 	"strings"
+	"sync"
 	"text/template"
+	"text/template/parse"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/templates"
@@ -204,6 +206,7 @@ func (t *Template) read(path string) (string, error) {
 }
 
 // parse reads the file at path and returns a parsed text/template object with the given name.
+// The parse tree of each template is cached so that the same file is only parsed once per process.
 func (t *Template) parse(name, path string, options ...ParseOption) (*template.Template, error) {
 	content, err := t.read(path)
 	if err != nil {
@@ -214,9 +217,51 @@ func (t *Template) parse(name, path string, options ...ParseOption) (*template.T
 	for _, opt := range options {
 		emptyTextTpl = opt(emptyTextTpl)
 	}
+	key := parseTreeKey{name: name, content: content}
+	if tree, ok := parseTrees.get(key); ok {
+		// Parse trees are read-only once parsed, so they can be shared by templates with their own functions.
+		tpl, err := emptyTextTpl.AddParseTree(name, tree)
+		if err != nil {
+			return nil, fmt.Errorf("add parse tree of %s: %w", path, err)
+		}
+		return tpl, nil
+	}
 	parsedTpl, err := emptyTextTpl.Parse(content)
 	if err != nil {
 		return nil, fmt.Errorf("parse template %s: %w", path, err)
 	}
+	if len(parsedTpl.Templates()) == 1 {
+		// Files that define nested templates are associated with more than one tree and aren't cached.
+		parseTrees.set(key, parsedTpl.Tree)
+	}
 	return parsedTpl, nil
 }
+
+// parseTreeKey identifies a parse tree by the name of the template and the content it was parsed from.
+type parseTreeKey struct {
+	name    string
+	content string
+}
+
+// parseTreeCache is a concurrency-safe cache of parse trees.
+type parseTreeCache struct {
+	mu    sync.RWMutex
+	trees map[parseTreeKey]*parse.Tree
+}
+
+var parseTrees = &parseTreeCache{
+	trees: make(map[parseTreeKey]*parse.Tree),
+}
+
+func (c *parseTreeCache) get(key parseTreeKey) (*parse.Tree, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	tree, ok := c.trees[key]
+	return tree, ok
+}
+
+func (c *parseTreeCache) set(key parseTreeKey, tree *parse.Tree) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.trees[key] = tree
+}
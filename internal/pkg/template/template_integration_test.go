@@ -37,6 +37,19 @@ func TestTemplate_ParseScheduledJob(t *testing.T) {
 				},
 			},
 		},
+		"renders with backoff and failure notifications": {
+			opts: template.WorkloadOpts{
+				StateMachine: &template.StateMachineOpts{
+					Retries:       aws.Int(3),
+					RetryInterval: aws.Int(60),
+					BackoffRate:   aws.Float64(2),
+					OnFailure: &template.JobFailureNotificationOpts{
+						SNSTopic: "arn:aws:sns:us-west-2:123456789012:job-failures",
+						EventBus: "arn:aws:events:us-west-2:123456789012:event-bus/default",
+					},
+				},
+			},
+		},
 		"renders with options and addons": {
 			opts: template.WorkloadOpts{
 				StateMachine: &template.StateMachineOpts{
@@ -182,3 +182,36 @@ func TestTemplate_Parse(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplate_Parse_ReusesParseTrees(t *testing.T) {
+	// GIVEN
+	mockBox := packd.NewMemoryBox()
+	mockBox.AddString("/fake/greeting.yml", `{{greet .Name}}`)
+	tpl := &Template{box: mockBox}
+	withGreeting := func(greeting string) ParseOption {
+		return WithFuncs(map[string]interface{}{
+			"greet": func(name string) string {
+				return fmt.Sprintf("%s %s", greeting, name)
+			},
+		})
+	}
+	data := struct{ Name string }{Name: "copilot"}
+
+	// WHEN
+	first, err := tpl.Parse("/fake/greeting.yml", data, withGreeting("hello"))
+	require.NoError(t, err)
+	tree, ok := parseTrees.get(parseTreeKey{name: "template", content: `{{greet .Name}}`})
+	require.True(t, ok, "parse tree should be cached after the first parse")
+	second, err := tpl.Parse("/fake/greeting.yml", data, withGreeting("bonjour"))
+	require.NoError(t, err)
+	mockBox.AddString("/fake/greeting.yml", `{{greet .Name}}!`)
+	third, err := tpl.Parse("/fake/greeting.yml", data, withGreeting("hola"))
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, "hello copilot", first.String())
+	require.Equal(t, "bonjour copilot", second.String(), "cached tree should execute with the functions of the call")
+	require.Equal(t, "hola copilot!", third.String(), "updated content should be parsed again")
+	cached, _ := parseTrees.get(parseTreeKey{name: "template", content: `{{greet .Name}}`})
+	require.Same(t, tree, cached)
+}
@@ -6,6 +6,7 @@ package template
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"text/template"
 
 	"github.com/google/uuid"
@@ -38,6 +39,26 @@ const (
 	PrivateSubnetsPlacement = "PrivateSubnets"
 )
 
+// CPU architectures of the tasks.
+const (
+	CPUArchitectureX86 = "X86_64"
+	CPUArchitectureARM = "ARM64"
+)
+
+// SecretsManagerValueFromPrefix is the prefix of a container secret that references a Secrets Manager secret by name.
+const SecretsManagerValueFromPrefix = "secretsmanager:"
+
+// Template features that a workload can opt into ahead of a global template version bump.
+const (
+	// ECSManagedTagsFeature tags the tasks of a service with the Amazon ECS managed cluster and service tags.
+	ECSManagedTagsFeature = "ecs-managed-tags"
+)
+
+// WorkloadFeatures holds all the template features that a workload can opt into.
+var WorkloadFeatures = []string{
+	ECSManagedTagsFeature,
+}
+
 var (
 	// Template names under "workloads/partials/cf/".
 	partialsWorkloadCFTemplateNames = []string{
@@ -49,9 +70,11 @@ var (
 		"workload-container",
 		"fargate-taskdef-base-properties",
 		"service-base-properties",
+		"target-group-properties",
 		"servicediscovery",
 		"addons",
 		"sidecars",
+		"init-containers",
 		"logconfig",
 		"autoscaling",
 		"eventrule",
@@ -67,7 +90,8 @@ var (
 
 // WorkloadNestedStackOpts holds configuration that's needed if the workload stack has a nested stack.
 type WorkloadNestedStackOpts struct {
-	StackName string
+	StackName  string
+	Parameters map[string]string // Additional parameter values passed to the nested stack.
 
 	VariableOutputs      []string
 	SecretOutputs        []string
@@ -87,6 +111,21 @@ type SidecarOpts struct {
 	Secrets      map[string]string
 	MountPoints  []*MountPoint
 	DockerLabels map[string]string
+	Permissions  []string // IAM actions granted to the task role for the sidecar.
+	HealthCheck  *ecs.HealthCheck
+	DependsOn    map[string]string // Container names to the ECS condition that they must meet before the sidecar starts.
+}
+
+// InitContainerOpts holds configuration for a container that must run to completion before the main container starts.
+type InitContainerOpts struct {
+	Name        *string
+	Image       *string
+	EntryPoint  []string
+	Command     []string
+	CredsParam  *string
+	Variables   map[string]string
+	Secrets     map[string]string
+	MountPoints []*MountPoint
 }
 
 // StorageOpts holds data structures for rendering Volumes and Mount Points
@@ -107,6 +146,7 @@ type EFSPermission struct {
 	FilesystemID  *string
 	Write         bool
 	AccessPointID *string
+	FromAddons    bool // True if FilesystemID and AccessPointID are names of outputs of the addons stack.
 }
 
 // MountPoint holds information needed to render a MountPoint in a containerdefinition.
@@ -140,6 +180,8 @@ type EFSVolumeConfiguration struct {
 	// Authorization Config
 	AccessPointID *string
 	IAM           *string // ENABLED or DISABLED
+
+	FromAddons bool // True if Filesystem and AccessPointID are names of outputs of the addons stack.
 }
 
 // LogConfigOpts holds configuration that's needed if the service is configured with Firelens to route
@@ -150,6 +192,11 @@ type LogConfigOpts struct {
 	EnableMetadata *string
 	SecretOptions  map[string]string
 	ConfigFile     *string
+	MountPoints    []*MountPoint
+	CPU            *int
+	Memory         *int
+	RegionOption   string   // Name of the destination option that defaults to the region of the stack.
+	Permissions    []string // IAM actions that the log router needs to route logs to the destination.
 }
 
 // HTTPHealthCheckOpts holds configuration that's needed for HTTP Health Check.
@@ -162,6 +209,21 @@ type HTTPHealthCheckOpts struct {
 	Timeout            *int64
 }
 
+// Strategies of Amazon ECS deployments that shift traffic between two target groups.
+const (
+	BlueGreenDeploymentStrategy = "BLUE_GREEN"
+	CanaryDeploymentStrategy    = "CANARY"
+	LinearDeploymentStrategy    = "LINEAR"
+)
+
+// DeploymentStrategyOpts holds configuration to shift traffic to a new version of a service with an Amazon ECS deployment strategy.
+type DeploymentStrategyOpts struct {
+	Strategy     string   // One of BLUE_GREEN, CANARY or LINEAR.
+	StepPercent  *float64 // Percentage of traffic shifted by the canary step, or by each linear step.
+	StepBakeTime *int     // Minutes to wait after the canary step, or between linear steps.
+	BakeTime     *int     // Minutes during which both versions keep running once all traffic is shifted.
+}
+
 // AdvancedCount holds configuration for autoscaling and capacity provider
 // parameters.
 type AdvancedCount struct {
@@ -193,8 +255,18 @@ type ExecuteCommandOpts struct{}
 
 // StateMachineOpts holds configuration needed for State Machine retries and timeout.
 type StateMachineOpts struct {
-	Timeout *int
-	Retries *int
+	Timeout       *int
+	TaskTimeout   *int // Seconds that a single attempt of the task can run before it times out.
+	Retries       *int
+	RetryInterval *int     // Seconds to wait before the first retry. Defaults to 10 if nil.
+	BackoffRate   *float64 // Multiplier applied to the wait after each retry. Defaults to 1.5 if nil.
+	OnFailure     *JobFailureNotificationOpts
+}
+
+// JobFailureNotificationOpts holds the ARNs of the targets notified when a job fails after all its retries.
+type JobFailureNotificationOpts struct {
+	SNSTopic string
+	EventBus string
 }
 
 // NetworkOpts holds AWS networking configuration for the workloads.
@@ -218,6 +290,7 @@ type WorkloadOpts struct {
 	Secrets            map[string]string
 	NestedStack        *WorkloadNestedStackOpts // Outputs from nested stacks such as the addons stack.
 	Sidecars           []*SidecarOpts
+	InitContainers     []*InitContainerOpts
 	LogConfig          *LogConfigOpts
 	Autoscaling        *AutoscalingOpts
 	CapacityProviders  []*CapacityProviderStrategy
@@ -229,21 +302,45 @@ type WorkloadOpts struct {
 	Command            []string
 	DomainAlias        string
 	DockerLabels       map[string]string
+	DependsOn          map[string]string // Container names to the ECS condition that they must meet before the main container starts.
+	GPU                int               // Number of GPUs reserved for the main container. Tasks requiring GPUs run on EC2 instances.
+	External           bool              // True if the tasks run on external instances registered to the cluster with ECS Anywhere.
+	CPUArchitecture    string            // CPU architecture of the tasks, either "X86_64" or "ARM64". Defaults to X86_64 if empty.
+	Features           []string          // Template features that the workload opted into.
+	LogGroupPrefix     string            // Prefix of the workload's log group name. Defaults to "/copilot" if empty.
+	PullThroughCache   string            // Repository prefix of the ECR pull-through cache that the image is pulled through.
+	ExecutionRoleARN   string            // Execution role shared with other workloads. If empty, the workload creates its own role.
 
 	// Additional options for service templates.
 	WorkloadType        string
 	HealthCheck         *ecs.HealthCheck
 	HTTPHealthCheck     HTTPHealthCheckOpts
 	AllowedSourceIps    []string
+	RulePriority        int    // Priority of the listener rules. If zero, the next available priority is used.
+	HTTPRedirectCode    string // Status code of the redirect from HTTP to HTTPS, such as "HTTP_301". If empty, HTTP requests are forwarded.
 	RulePriorityLambda  string
 	DesiredCountLambda  string
 	EnvControllerLambda string
+	RollbackAlarms      []string
+	DeploymentStrategy  *DeploymentStrategyOpts // Traffic shifting of the deployments. If nil, services are deployed with a rolling update.
 
 	// Additional options for job templates.
 	ScheduleExpression string
+	EventPattern       string // EventBridge event pattern that triggers the job, serialized as JSON.
+	EventBus           string // Name or ARN of the event bus of the event pattern. Defaults to the default bus if empty.
 	StateMachine       *StateMachineOpts
 }
 
+// HasFeature returns true if the workload opted into the template feature.
+func (o WorkloadOpts) HasFeature(name string) bool {
+	for _, feature := range o.Features {
+		if feature == name {
+			return true
+		}
+	}
+	return false
+}
+
 // ParseLoadBalancedWebService parses a load balanced web service's CloudFormation template
 // with the specified data object and returns its content.
 func (t *Template) ParseLoadBalancedWebService(data WorkloadOpts) (*Content, error) {
@@ -306,11 +403,13 @@ func withSvcParsingFuncs() ParseOption {
 		return t.Funcs(map[string]interface{}{
 			"toSnakeCase":         ToSnakeCaseFunc,
 			"hasSecrets":          hasSecrets,
+			"secretValueFrom":     secretValueFrom,
 			"fmtSlice":            FmtSliceFunc,
 			"quoteSlice":          QuotePSliceFunc,
 			"randomUUID":          randomUUIDFunc,
 			"jsonMountPoints":     generateMountPointJSON,
 			"envControllerParams": envControllerParameters,
+			"join":                strings.Join,
 		})
 	}
 }
@@ -325,6 +424,17 @@ func hasSecrets(opts WorkloadOpts) bool {
 	return false
 }
 
+// secretValueFrom returns the ValueFrom of a container secret.
+// Secrets Manager secrets referenced by name, like "secretsmanager:my-secret", are expanded to
+// the secret's ARN since ECS only resolves Secrets Manager secrets by ARN.
+func secretValueFrom(valueFrom string) string {
+	name := strings.TrimPrefix(valueFrom, SecretsManagerValueFromPrefix)
+	if name == valueFrom {
+		return valueFrom
+	}
+	return fmt.Sprintf("!Sub 'arn:${AWS::Partition}:secretsmanager:${AWS::Region}:${AWS::AccountId}:secret:%s'", name)
+}
+
 func randomUUIDFunc() (string, error) {
 	id, err := uuid.NewRandom()
 	if err != nil {
@@ -345,5 +455,8 @@ func envControllerParameters(o WorkloadOpts) []string {
 	if o.Storage != nil && o.Storage.requiresEFSCreation() {
 		parameters = append(parameters, "EFSWorkloads,")
 	}
+	if o.GPU > 0 {
+		parameters = append(parameters, "GPUWorkloads,")
+	}
 	return parameters
 }
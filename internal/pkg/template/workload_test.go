@@ -4,9 +4,12 @@
 package template
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/gobuffalo/packd"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
@@ -37,9 +40,11 @@ func TestTemplate_ParseSvc(t *testing.T) {
 				mockBox.AddString("workloads/partials/cf/workload-container.yml", "workload-container")
 				mockBox.AddString("workloads/partials/cf/fargate-taskdef-base-properties.yml", "fargate-taskdef-base-properties")
 				mockBox.AddString("workloads/partials/cf/service-base-properties.yml", "service-base-properties")
+				mockBox.AddString("workloads/partials/cf/target-group-properties.yml", "target-group-properties")
 				mockBox.AddString("workloads/partials/cf/servicediscovery.yml", "servicediscovery")
 				mockBox.AddString("workloads/partials/cf/addons.yml", "addons")
 				mockBox.AddString("workloads/partials/cf/sidecars.yml", "sidecars")
+				mockBox.AddString("workloads/partials/cf/init-containers.yml", "init-containers")
 				mockBox.AddString("workloads/partials/cf/logconfig.yml", "logconfig")
 				mockBox.AddString("workloads/partials/cf/autoscaling.yml", "autoscaling")
 				mockBox.AddString("workloads/partials/cf/state-machine-definition.json.yml", "state-machine-definition")
@@ -61,9 +66,11 @@ func TestTemplate_ParseSvc(t *testing.T) {
   workload-container
   fargate-taskdef-base-properties
   service-base-properties
+  target-group-properties
   servicediscovery
   addons
   sidecars
+  init-containers
   logconfig
   autoscaling
   eventrule
@@ -137,6 +144,1189 @@ func TestHasSecrets(t *testing.T) {
 	}
 }
 
+func TestTemplate_secretValueFrom(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted string
+	}{
+		"SSM parameter name": {
+			in:     "GH_WEBHOOK_SECRET",
+			wanted: "GH_WEBHOOK_SECRET",
+		},
+		"ARN": {
+			in:     "arn:aws:secretsmanager:us-west-2:111111111111:secret:mysql-AbCdEf",
+			wanted: "arn:aws:secretsmanager:us-west-2:111111111111:secret:mysql-AbCdEf",
+		},
+		"Secrets Manager secret name": {
+			in:     "secretsmanager:/copilot/phonetool/test/secrets/DB_PASSWORD",
+			wanted: "!Sub 'arn:${AWS::Partition}:secretsmanager:${AWS::Region}:${AWS::AccountId}:secret:/copilot/phonetool/test/secrets/DB_PASSWORD'",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, secretValueFrom(tc.in))
+		})
+	}
+}
+
+func TestWorkloadOpts_HasFeature(t *testing.T) {
+	testCases := map[string]struct {
+		in     WorkloadOpts
+		wanted bool
+	}{
+		"no features": {
+			in:     WorkloadOpts{},
+			wanted: false,
+		},
+		"feature not enabled": {
+			in: WorkloadOpts{
+				Features: []string{"some-other-feature"},
+			},
+			wanted: false,
+		},
+		"feature enabled": {
+			in: WorkloadOpts{
+				Features: []string{"some-other-feature", ECSManagedTagsFeature},
+			},
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.HasFeature(ECSManagedTagsFeature))
+		})
+	}
+}
+
+func TestTemplate_ParseFeatures(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			Service struct {
+				Properties struct {
+					EnableECSManagedTags bool `yaml:"EnableECSManagedTags"`
+				} `yaml:"Properties"`
+			} `yaml:"Service"`
+		} `yaml:"Resources"`
+		Outputs map[string]interface{} `yaml:"Outputs"`
+	}
+
+	testCases := map[string]struct {
+		input []string
+
+		wantedManagedTags     bool
+		wantedEnabledFeatures interface{}
+	}{
+		"should not render features by default": {},
+		"should enable ECS managed tags and output the enabled features": {
+			input: []string{ECSManagedTagsFeature},
+
+			wantedManagedTags: true,
+			wantedEnabledFeatures: map[string]interface{}{
+				"Description": "Template features that the service opted into.",
+				"Value":       "ecs-managed-tags",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseBackendService(WorkloadOpts{
+				Features: tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse backend service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual template")
+			require.Equal(t, tc.wantedManagedTags, actual.Resources.Service.Properties.EnableECSManagedTags)
+			require.Equal(t, tc.wantedEnabledFeatures, actual.Outputs["EnabledFeatures"])
+		})
+	}
+}
+
+func TestTemplate_ParseRollbackAlarms(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			Service struct {
+				Properties struct {
+					DeploymentConfiguration map[string]interface{} `yaml:"DeploymentConfiguration"`
+				} `yaml:"Properties"`
+			} `yaml:"Service"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		input []string
+
+		wantedAlarms interface{}
+	}{
+		"should not render alarms by default": {},
+		"should roll back the deployment when an alarm fires": {
+			input: []string{"HighLatency", "HighErrorRate"},
+
+			wantedAlarms: map[string]interface{}{
+				"Enable":     true,
+				"Rollback":   true,
+				"AlarmNames": []interface{}{"HighLatency", "HighErrorRate"},
+			},
+		},
+		"should quote alarm names that are not plain YAML scalars": {
+			input: []string{"api: 5XX errors", "#latency", "true"},
+
+			wantedAlarms: map[string]interface{}{
+				"Enable":     true,
+				"Rollback":   true,
+				"AlarmNames": []interface{}{"api: 5XX errors", "#latency", "true"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseBackendService(WorkloadOpts{
+				RollbackAlarms: tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse backend service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual template")
+			require.Equal(t, tc.wantedAlarms, actual.Resources.Service.Properties.DeploymentConfiguration["Alarms"])
+		})
+	}
+}
+
+func TestTemplate_ParseDeploymentStrategy(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			Service struct {
+				Properties struct {
+					DeploymentConfiguration map[string]interface{}   `yaml:"DeploymentConfiguration"`
+					LoadBalancers           []map[string]interface{} `yaml:"LoadBalancers"`
+				} `yaml:"Properties"`
+			} `yaml:"Service"`
+			AlternateTargetGroup       map[string]interface{} `yaml:"AlternateTargetGroup"`
+			LoadBalancerDeploymentRole map[string]interface{} `yaml:"LoadBalancerDeploymentRole"`
+			HTTPSListenerRule          struct {
+				Properties struct {
+					Actions []map[string]interface{} `yaml:"Actions"`
+				} `yaml:"Properties"`
+			} `yaml:"HTTPSListenerRule"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		input *DeploymentStrategyOpts
+
+		wantedConfig       map[string]interface{}
+		wantedTrafficShift bool
+	}{
+		"should deploy with a rolling update by default": {
+			wantedConfig: map[string]interface{}{
+				"DeploymentCircuitBreaker": map[string]interface{}{
+					"Enable":   true,
+					"Rollback": true,
+				},
+				"MinimumHealthyPercent": 100,
+				"MaximumPercent":        200,
+			},
+		},
+		"should shift traffic all at once with a blue/green deployment": {
+			input: &DeploymentStrategyOpts{
+				Strategy: BlueGreenDeploymentStrategy,
+				BakeTime: aws.Int(0),
+			},
+			wantedConfig: map[string]interface{}{
+				"DeploymentCircuitBreaker": map[string]interface{}{
+					"Enable":   true,
+					"Rollback": true,
+				},
+				"MinimumHealthyPercent": 100,
+				"MaximumPercent":        200,
+				"Strategy":              "BLUE_GREEN",
+				"BakeTimeInMinutes":     0,
+			},
+			wantedTrafficShift: true,
+		},
+		"should shift a percentage of the traffic before the rest with a canary deployment": {
+			input: &DeploymentStrategyOpts{
+				Strategy:     CanaryDeploymentStrategy,
+				StepPercent:  aws.Float64(12.5),
+				StepBakeTime: aws.Int(5),
+				BakeTime:     aws.Int(10),
+			},
+			wantedConfig: map[string]interface{}{
+				"DeploymentCircuitBreaker": map[string]interface{}{
+					"Enable":   true,
+					"Rollback": true,
+				},
+				"MinimumHealthyPercent": 100,
+				"MaximumPercent":        200,
+				"Strategy":              "CANARY",
+				"BakeTimeInMinutes":     10,
+				"CanaryConfiguration": map[string]interface{}{
+					"CanaryPercent":           12.5,
+					"CanaryBakeTimeInMinutes": 5,
+				},
+			},
+			wantedTrafficShift: true,
+		},
+		"should shift the traffic in equal steps with a linear deployment": {
+			input: &DeploymentStrategyOpts{
+				Strategy:    LinearDeploymentStrategy,
+				StepPercent: aws.Float64(20),
+			},
+			wantedConfig: map[string]interface{}{
+				"DeploymentCircuitBreaker": map[string]interface{}{
+					"Enable":   true,
+					"Rollback": true,
+				},
+				"MinimumHealthyPercent": 100,
+				"MaximumPercent":        200,
+				"Strategy":              "LINEAR",
+				"LinearConfiguration": map[string]interface{}{
+					"StepPercent": 20,
+				},
+			},
+			wantedTrafficShift: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseLoadBalancedWebService(WorkloadOpts{
+				DeploymentStrategy: tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse load balanced web service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual template")
+			require.Equal(t, tc.wantedConfig, actual.Resources.Service.Properties.DeploymentConfiguration)
+			require.Len(t, actual.Resources.Service.Properties.LoadBalancers, 1)
+			_, hasAdvancedConfig := actual.Resources.Service.Properties.LoadBalancers[0]["AdvancedConfiguration"]
+			require.Equal(t, tc.wantedTrafficShift, hasAdvancedConfig)
+			require.Equal(t, tc.wantedTrafficShift, actual.Resources.AlternateTargetGroup != nil)
+			require.Equal(t, tc.wantedTrafficShift, actual.Resources.LoadBalancerDeploymentRole != nil)
+			require.Len(t, actual.Resources.HTTPSListenerRule.Properties.Actions, 1)
+			_, hasForwardConfig := actual.Resources.HTTPSListenerRule.Properties.Actions[0]["ForwardConfig"]
+			require.Equal(t, tc.wantedTrafficShift, hasForwardConfig)
+		})
+	}
+}
+
+func TestTemplate_ParseEventRules(t *testing.T) {
+	type rule struct {
+		Properties struct {
+			ScheduleExpression interface{}            `yaml:"ScheduleExpression"`
+			EventBusName       string                 `yaml:"EventBusName"`
+			EventPattern       map[string]interface{} `yaml:"EventPattern"`
+		} `yaml:"Properties"`
+	}
+	type cfn struct {
+		Resources struct {
+			Rule             *rule `yaml:"Rule"`
+			EventPatternRule *rule `yaml:"EventPatternRule"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		input WorkloadOpts
+
+		wantedScheduleRule bool
+		wantedPattern      map[string]interface{}
+		wantedEventBus     string
+	}{
+		"should only render the schedule rule": {
+			input: WorkloadOpts{
+				ScheduleExpression: "rate(1 hour)",
+			},
+			wantedScheduleRule: true,
+		},
+		"should only render the event pattern rule": {
+			input: WorkloadOpts{
+				EventPattern: `{"source":["aws.s3"]}`,
+				EventBus:     "arn:aws:events:us-west-2:123456789012:event-bus/uploads",
+			},
+			wantedPattern: map[string]interface{}{
+				"source": []interface{}{"aws.s3"},
+			},
+			wantedEventBus: "arn:aws:events:us-west-2:123456789012:event-bus/uploads",
+		},
+		"should render both rules": {
+			input: WorkloadOpts{
+				ScheduleExpression: "rate(1 hour)",
+				EventPattern:       `{"detail":{"repository-name":["api"]},"source":["aws.ecr"]}`,
+			},
+			wantedScheduleRule: true,
+			wantedPattern: map[string]interface{}{
+				"source": []interface{}{"aws.ecr"},
+				"detail": map[string]interface{}{
+					"repository-name": []interface{}{"api"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseScheduledJob(tc.input)
+
+			// THEN
+			require.NoError(t, err, "parse scheduled job")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual template")
+			require.Equal(t, tc.wantedScheduleRule, actual.Resources.Rule != nil)
+			if tc.wantedPattern == nil {
+				require.Nil(t, actual.Resources.EventPatternRule)
+				return
+			}
+			require.NotNil(t, actual.Resources.EventPatternRule)
+			require.Equal(t, tc.wantedPattern, actual.Resources.EventPatternRule.Properties.EventPattern)
+			require.Equal(t, tc.wantedEventBus, actual.Resources.EventPatternRule.Properties.EventBusName)
+		})
+	}
+}
+
+func TestTemplate_ParseContainerDependencies(t *testing.T) {
+	type dependency struct {
+		ContainerName string `yaml:"ContainerName"`
+		Condition     string `yaml:"Condition"`
+	}
+	type container struct {
+		Name        interface{}  `yaml:"Name"`
+		DependsOn   []dependency `yaml:"DependsOn"`
+		HealthCheck *struct {
+			Command []string `yaml:"Command"`
+			Retries int      `yaml:"Retries"`
+		} `yaml:"HealthCheck"`
+	}
+	type cfn struct {
+		Resources struct {
+			TaskDefinition struct {
+				Properties struct {
+					ContainerDefinitions []container `yaml:"ContainerDefinitions"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskDefinition"`
+		} `yaml:"Resources"`
+	}
+
+	// GIVEN
+	tpl := New()
+
+	// WHEN
+	content, err := tpl.ParseBackendService(WorkloadOpts{
+		InitContainers: []*InitContainerOpts{
+			{
+				Name:  aws.String("migrate"),
+				Image: aws.String("flyway"),
+			},
+		},
+		DependsOn: map[string]string{
+			"envoy": "HEALTHY",
+		},
+		Sidecars: []*SidecarOpts{
+			{
+				Name:  aws.String("envoy"),
+				Image: aws.String("envoyproxy/envoy"),
+				HealthCheck: &ecs.HealthCheck{
+					Command:     aws.StringSlice([]string{"CMD-SHELL", "curl -f http://localhost:9901/ready || exit 1"}),
+					Interval:    aws.Int64(10),
+					Retries:     aws.Int64(3),
+					StartPeriod: aws.Int64(0),
+					Timeout:     aws.Int64(5),
+				},
+				DependsOn: map[string]string{
+					"xray": "START",
+				},
+			},
+			{
+				Name:  aws.String("xray"),
+				Image: aws.String("amazon/aws-xray-daemon"),
+			},
+		},
+	})
+
+	// THEN
+	require.NoError(t, err, "parse backend service")
+	var actual cfn
+	err = yaml.Unmarshal(content.Bytes(), &actual)
+	require.NoError(t, err, "unmarshal actual template")
+	containers := actual.Resources.TaskDefinition.Properties.ContainerDefinitions
+	require.Len(t, containers, 4) // The main container, the sidecars, and the init container.
+	require.Equal(t, []dependency{
+		{ContainerName: "migrate", Condition: "SUCCESS"},
+		{ContainerName: "envoy", Condition: "HEALTHY"},
+	}, containers[0].DependsOn)
+	require.Equal(t, "envoy", containers[1].Name)
+	require.Equal(t, []dependency{{ContainerName: "xray", Condition: "START"}}, containers[1].DependsOn)
+	require.NotNil(t, containers[1].HealthCheck)
+	require.Equal(t, []string{"CMD-SHELL", "curl -f http://localhost:9901/ready || exit 1"}, containers[1].HealthCheck.Command)
+	require.Equal(t, 3, containers[1].HealthCheck.Retries)
+	require.Nil(t, containers[2].HealthCheck)
+	require.Nil(t, containers[2].DependsOn)
+}
+
+func TestTemplate_ParseStateMachineDefinition(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			StateMachine struct {
+				Properties struct {
+					DefinitionString string `yaml:"DefinitionString"`
+				} `yaml:"Properties"`
+			} `yaml:"StateMachine"`
+		} `yaml:"Resources"`
+	}
+	type state struct {
+		Type           string `json:"Type"`
+		Next           string `json:"Next"`
+		TimeoutSeconds int    `json:"TimeoutSeconds"`
+		Retry          []struct {
+			IntervalSeconds int     `json:"IntervalSeconds"`
+			BackoffRate     float64 `json:"BackoffRate"`
+		} `json:"Retry"`
+		Catch []struct {
+			Next string `json:"Next"`
+		} `json:"Catch"`
+	}
+
+	testCases := map[string]struct {
+		input *StateMachineOpts
+
+		wantedStates         []string
+		wantedRetryInterval  int
+		wantedBackoffRate    float64
+		wantedFailureHandler string
+		wantedTaskTimeout    int
+	}{
+		"should time out a single attempt of the task": {
+			input: &StateMachineOpts{
+				Timeout:     aws.Int(3600),
+				TaskTimeout: aws.Int(900),
+			},
+			wantedStates:      []string{"Run Fargate Task"},
+			wantedTaskTimeout: 900,
+		},
+		"should retry with the default backoff": {
+			input: &StateMachineOpts{
+				Retries: aws.Int(3),
+			},
+			wantedStates:        []string{"Run Fargate Task"},
+			wantedRetryInterval: 10,
+			wantedBackoffRate:   1.5,
+		},
+		"should retry with a custom backoff": {
+			input: &StateMachineOpts{
+				Retries:       aws.Int(3),
+				RetryInterval: aws.Int(60),
+				BackoffRate:   aws.Float64(2),
+			},
+			wantedStates:        []string{"Run Fargate Task"},
+			wantedRetryInterval: 60,
+			wantedBackoffRate:   2,
+		},
+		"should publish failures to an SNS topic": {
+			input: &StateMachineOpts{
+				OnFailure: &JobFailureNotificationOpts{
+					SNSTopic: "arn:aws:sns:us-west-2:123456789012:job-failures",
+				},
+			},
+			wantedStates:         []string{"Run Fargate Task", "Publish Failure", "Job Failed"},
+			wantedFailureHandler: "Publish Failure",
+		},
+		"should put failure events on an event bus": {
+			input: &StateMachineOpts{
+				OnFailure: &JobFailureNotificationOpts{
+					EventBus: "arn:aws:events:us-west-2:123456789012:event-bus/default",
+				},
+			},
+			wantedStates:         []string{"Run Fargate Task", "Put Failure Event", "Job Failed"},
+			wantedFailureHandler: "Put Failure Event",
+		},
+		"should notify both targets": {
+			input: &StateMachineOpts{
+				Retries: aws.Int(1),
+				OnFailure: &JobFailureNotificationOpts{
+					SNSTopic: "arn:aws:sns:us-west-2:123456789012:job-failures",
+					EventBus: "arn:aws:events:us-west-2:123456789012:event-bus/default",
+				},
+			},
+			wantedStates:         []string{"Run Fargate Task", "Publish Failure", "Put Failure Event", "Job Failed"},
+			wantedRetryInterval:  10,
+			wantedBackoffRate:    1.5,
+			wantedFailureHandler: "Publish Failure",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseScheduledJob(WorkloadOpts{
+				StateMachine: tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse scheduled job")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual template")
+			var definition struct {
+				States map[string]state `json:"States"`
+			}
+			err = json.Unmarshal([]byte(actual.Resources.StateMachine.Properties.DefinitionString), &definition)
+			require.NoError(t, err, "unmarshal state machine definition")
+			var states []string
+			for name := range definition.States {
+				states = append(states, name)
+			}
+			require.ElementsMatch(t, tc.wantedStates, states)
+			task := definition.States["Run Fargate Task"]
+			require.Equal(t, tc.wantedTaskTimeout, task.TimeoutSeconds)
+			if tc.wantedRetryInterval != 0 {
+				require.Len(t, task.Retry, 1)
+				require.Equal(t, tc.wantedRetryInterval, task.Retry[0].IntervalSeconds)
+				require.Equal(t, tc.wantedBackoffRate, task.Retry[0].BackoffRate)
+			}
+			if tc.wantedFailureHandler != "" {
+				require.Len(t, task.Catch, 1)
+				require.Equal(t, tc.wantedFailureHandler, task.Catch[0].Next)
+			}
+		})
+	}
+}
+
+func TestTemplate_ParseGPU(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			TaskDefinition struct {
+				Properties struct {
+					RequiresCompatibilities []string `yaml:"RequiresCompatibilities"`
+					ContainerDefinitions    []struct {
+						ResourceRequirements []map[string]string `yaml:"ResourceRequirements"`
+					} `yaml:"ContainerDefinitions"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskDefinition"`
+			Service struct {
+				Properties struct {
+					LaunchType               string              `yaml:"LaunchType"`
+					CapacityProviderStrategy []map[string]string `yaml:"CapacityProviderStrategy"`
+				} `yaml:"Properties"`
+			} `yaml:"Service"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		input int
+
+		wantedCompatibilities      []string
+		wantedResourceRequirements []map[string]string
+		wantedLaunchType           string
+		wantedCapacityProviders    []map[string]string
+	}{
+		"should run on Fargate by default": {
+			wantedCompatibilities: []string{"FARGATE"},
+			wantedLaunchType:      "FARGATE",
+		},
+		"should reserve GPUs and run on the environment's GPU capacity provider": {
+			input: 2,
+
+			wantedCompatibilities: []string{"EC2"},
+			wantedResourceRequirements: []map[string]string{
+				{"Type": "GPU", "Value": "2"},
+			},
+			wantedCapacityProviders: []map[string]string{
+				{"CapacityProvider": "EnvControllerAction.GPUCapacityProvider", "Weight": "1"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseBackendService(WorkloadOpts{
+				GPU: tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse backend service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual template")
+			require.Equal(t, tc.wantedCompatibilities, actual.Resources.TaskDefinition.Properties.RequiresCompatibilities)
+			require.Equal(t, tc.wantedResourceRequirements, actual.Resources.TaskDefinition.Properties.ContainerDefinitions[0].ResourceRequirements)
+			require.Equal(t, tc.wantedLaunchType, actual.Resources.Service.Properties.LaunchType)
+			require.Equal(t, tc.wantedCapacityProviders, actual.Resources.Service.Properties.CapacityProviderStrategy)
+		})
+	}
+}
+
+func TestTemplate_ParseExternal(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			TaskDefinition struct {
+				Properties struct {
+					NetworkMode             string   `yaml:"NetworkMode"`
+					RequiresCompatibilities []string `yaml:"RequiresCompatibilities"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskDefinition"`
+			Service struct {
+				Properties struct {
+					LaunchType           string                 `yaml:"LaunchType"`
+					NetworkConfiguration map[string]interface{} `yaml:"NetworkConfiguration"`
+					ServiceRegistries    interface{}            `yaml:"ServiceRegistries"`
+				} `yaml:"Properties"`
+			} `yaml:"Service"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		input bool
+
+		wantedNetworkMode     string
+		wantedCompatibilities []string
+		wantedLaunchType      string
+		wantedAwsvpc          bool
+	}{
+		"should run on Fargate in awsvpc mode by default": {
+			wantedNetworkMode:     "awsvpc",
+			wantedCompatibilities: []string{"FARGATE"},
+			wantedLaunchType:      "FARGATE",
+			wantedAwsvpc:          true,
+		},
+		"should run on external instances in bridge mode": {
+			input: true,
+
+			wantedNetworkMode:     "bridge",
+			wantedCompatibilities: []string{"EXTERNAL"},
+			wantedLaunchType:      "EXTERNAL",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseBackendService(WorkloadOpts{
+				External: tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse backend service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual template")
+			require.Equal(t, tc.wantedNetworkMode, actual.Resources.TaskDefinition.Properties.NetworkMode)
+			require.Equal(t, tc.wantedCompatibilities, actual.Resources.TaskDefinition.Properties.RequiresCompatibilities)
+			require.Equal(t, tc.wantedLaunchType, actual.Resources.Service.Properties.LaunchType)
+			require.Equal(t, tc.wantedAwsvpc, actual.Resources.Service.Properties.NetworkConfiguration != nil)
+			require.Equal(t, tc.wantedAwsvpc, actual.Resources.Service.Properties.ServiceRegistries != nil)
+		})
+	}
+}
+
+func TestTemplate_ParseAddonsFilesystem(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			TaskDefinition struct {
+				Properties struct {
+					Volumes []struct {
+						EFSVolumeConfiguration struct {
+							FilesystemID        yaml.Node `yaml:"FilesystemId"`
+							AuthorizationConfig struct {
+								AccessPointID yaml.Node `yaml:"AccessPointId"`
+							} `yaml:"AuthorizationConfig"`
+						} `yaml:"EFSVolumeConfiguration"`
+					} `yaml:"Volumes"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskDefinition"`
+			TaskRole struct {
+				Properties struct {
+					Policies []struct {
+						PolicyName     string `yaml:"PolicyName"`
+						PolicyDocument struct {
+							Statement []struct {
+								Resource yaml.Node `yaml:"Resource"`
+							} `yaml:"Statement"`
+						} `yaml:"PolicyDocument"`
+					} `yaml:"Policies"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskRole"`
+		} `yaml:"Resources"`
+	}
+
+	// GIVEN
+	tpl := New()
+
+	// WHEN
+	content, err := tpl.ParseBackendService(WorkloadOpts{
+		NestedStack: &WorkloadNestedStackOpts{
+			StackName:       "AddonsStack",
+			VariableOutputs: []string{"dataFileSystem", "dataAccessPoint"},
+		},
+		Storage: &StorageOpts{
+			Volumes: []*Volume{
+				{
+					Name: aws.String("data"),
+					EFS: &EFSVolumeConfiguration{
+						Filesystem:    aws.String("dataFileSystem"),
+						RootDirectory: aws.String("/"),
+						AccessPointID: aws.String("dataAccessPoint"),
+						IAM:           aws.String("ENABLED"),
+						FromAddons:    true,
+					},
+				},
+			},
+			EFSPerms: []*EFSPermission{
+				{
+					FilesystemID:  aws.String("dataFileSystem"),
+					AccessPointID: aws.String("dataAccessPoint"),
+					Write:         true,
+					FromAddons:    true,
+				},
+			},
+		},
+	})
+
+	// THEN
+	require.NoError(t, err, "parse backend service")
+	var actual cfn
+	err = yaml.Unmarshal(content.Bytes(), &actual)
+	require.NoError(t, err, "unmarshal actual template")
+	efs := actual.Resources.TaskDefinition.Properties.Volumes[0].EFSVolumeConfiguration
+	require.Equal(t, "!GetAtt", efs.FilesystemID.Tag)
+	require.Equal(t, "AddonsStack.Outputs.dataFileSystem", efs.FilesystemID.Value)
+	require.Equal(t, "!GetAtt", efs.AuthorizationConfig.AccessPointID.Tag)
+	require.Equal(t, "AddonsStack.Outputs.dataAccessPoint", efs.AuthorizationConfig.AccessPointID.Value)
+	var resource yaml.Node
+	for _, policy := range actual.Resources.TaskRole.Properties.Policies {
+		if policy.PolicyName == "GrantEFSAccessdataFileSystem" {
+			resource = *policy.PolicyDocument.Statement[0].Resource.Content[0]
+		}
+	}
+	require.Equal(t, "!Sub", resource.Tag)
+	require.Equal(t, "arn:aws:elasticfilesystem:${AWS::Region}:${AWS::AccountId}:file-system/${AddonsStack.Outputs.dataFileSystem}", resource.Value)
+}
+
+func TestTemplate_ParseInitContainers(t *testing.T) {
+	type containerDefinition struct {
+		Name      string   `yaml:"Name"`
+		Image     string   `yaml:"Image"`
+		Essential *bool    `yaml:"Essential"`
+		Command   []string `yaml:"Command"`
+		DependsOn []struct {
+			ContainerName string `yaml:"ContainerName"`
+			Condition     string `yaml:"Condition"`
+		} `yaml:"DependsOn"`
+	}
+	type cfn struct {
+		Resources struct {
+			TaskDefinition struct {
+				Properties struct {
+					ContainerDefinitions []containerDefinition `yaml:"ContainerDefinitions"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskDefinition"`
+		} `yaml:"Resources"`
+	}
+
+	// GIVEN
+	tpl := New()
+
+	// WHEN
+	content, err := tpl.ParseBackendService(WorkloadOpts{
+		Sidecars: []*SidecarOpts{
+			{
+				Name:  aws.String("nginx"),
+				Image: aws.String("nginx"),
+			},
+		},
+		InitContainers: []*InitContainerOpts{
+			{
+				Name:    aws.String("migrate"),
+				Image:   aws.String("flyway"),
+				Command: []string{"migrate"},
+			},
+		},
+		WorkloadType: "Backend Service",
+	})
+
+	// THEN
+	require.NoError(t, err, "parse backend service")
+	var actual cfn
+	err = yaml.Unmarshal(content.Bytes(), &actual)
+	require.NoError(t, err, "unmarshal actual template")
+	defs := actual.Resources.TaskDefinition.Properties.ContainerDefinitions
+	require.Len(t, defs, 3)
+	require.Len(t, defs[0].DependsOn, 1)
+	require.Equal(t, "migrate", defs[0].DependsOn[0].ContainerName)
+	require.Equal(t, "SUCCESS", defs[0].DependsOn[0].Condition)
+	require.Equal(t, "nginx", defs[1].Name)
+	require.Equal(t, "migrate", defs[2].Name)
+	require.Equal(t, "flyway", defs[2].Image)
+	require.Equal(t, aws.Bool(false), defs[2].Essential)
+	require.Equal(t, []string{"migrate"}, defs[2].Command)
+}
+
+func TestTemplate_ParseCPUArchitecture(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			TaskDefinition struct {
+				Properties struct {
+					RuntimePlatform map[string]string `yaml:"RuntimePlatform"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskDefinition"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		input string
+
+		wantedRuntimePlatform map[string]string
+	}{
+		"should not render the runtime platform by default": {},
+		"should run the tasks on arm64": {
+			input: CPUArchitectureARM,
+
+			wantedRuntimePlatform: map[string]string{
+				"CpuArchitecture":       "ARM64",
+				"OperatingSystemFamily": "LINUX",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseBackendService(WorkloadOpts{
+				CPUArchitecture: tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse backend service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual template")
+			require.Equal(t, tc.wantedRuntimePlatform, actual.Resources.TaskDefinition.Properties.RuntimePlatform)
+		})
+	}
+}
+
+func TestTemplate_ParseLogGroupPrefix(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			LogGroup struct {
+				Properties struct {
+					LogGroupName yaml.Node `yaml:"LogGroupName"`
+				} `yaml:"Properties"`
+			} `yaml:"LogGroup"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		input string
+
+		wantedPrefix string
+	}{
+		"should use the copilot prefix by default": {
+			wantedPrefix: "/copilot/",
+		},
+		"should use the overridden prefix": {
+			input: "/team-a/ecs",
+
+			wantedPrefix: "/team-a/ecs/",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseBackendService(WorkloadOpts{
+				LogGroupPrefix: tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse backend service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual template")
+			join := actual.Resources.LogGroup.Properties.LogGroupName
+			require.Equal(t, "!Join", join.Tag)
+			require.Equal(t, tc.wantedPrefix, join.Content[1].Content[0].Value)
+		})
+	}
+}
+
+func TestTemplate_ParsePullThroughCache(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			ExecutionRole struct {
+				Properties struct {
+					Policies []struct {
+						PolicyDocument struct {
+							Statement []struct {
+								Action   []string    `yaml:"Action"`
+								Resource []yaml.Node `yaml:"Resource"`
+							} `yaml:"Statement"`
+						} `yaml:"PolicyDocument"`
+					} `yaml:"Policies"`
+				} `yaml:"Properties"`
+			} `yaml:"ExecutionRole"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		input string
+
+		wantedStatements int
+	}{
+		"should not allow importing upstream images by default": {
+			wantedStatements: 3,
+		},
+		"should allow importing upstream images into the cache": {
+			input: "phonetool-docker-hub",
+
+			wantedStatements: 4,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseBackendService(WorkloadOpts{
+				PullThroughCache: tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse backend service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual template")
+			statements := actual.Resources.ExecutionRole.Properties.Policies[0].PolicyDocument.Statement
+			require.Len(t, statements, tc.wantedStatements)
+			if tc.input == "" {
+				return
+			}
+			last := statements[len(statements)-1]
+			require.Equal(t, []string{"ecr:BatchImportUpstreamImage", "ecr:CreateRepository"}, last.Action)
+			require.Equal(t, "arn:aws:ecr:${AWS::Region}:${AWS::AccountId}:repository/phonetool-docker-hub/*", last.Resource[0].Value)
+		})
+	}
+}
+
+func TestTemplate_ParseAddonsParameters(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			AddonsStack struct {
+				Properties struct {
+					Parameters map[string]yaml.Node `yaml:"Parameters"`
+				} `yaml:"Properties"`
+			} `yaml:"AddonsStack"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		input *WorkloadNestedStackOpts
+
+		wantedParams map[string]string
+	}{
+		"should only pass the copilot parameters without a nested stack": {
+			wantedParams: map[string]string{
+				"App":  "AppName",
+				"Env":  "EnvName",
+				"Name": "WorkloadName",
+			},
+		},
+		"should pass the additional parameters to the addons stack": {
+			input: &WorkloadNestedStackOpts{
+				StackName: "AddonsStack",
+				Parameters: map[string]string{
+					"BillingMode":   "PROVISIONED",
+					"ReadCapacity":  "5",
+					"TableNameNote": `it's "quoted"`,
+				},
+			},
+			wantedParams: map[string]string{
+				"App":           "AppName",
+				"Env":           "EnvName",
+				"Name":          "WorkloadName",
+				"BillingMode":   "PROVISIONED",
+				"ReadCapacity":  "5",
+				"TableNameNote": `it's "quoted"`,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseBackendService(WorkloadOpts{
+				NestedStack: tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse backend service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual template")
+			params := make(map[string]string)
+			for k, v := range actual.Resources.AddonsStack.Properties.Parameters {
+				params[k] = v.Value
+			}
+			require.Equal(t, tc.wantedParams, params)
+		})
+	}
+}
+
+func TestTemplate_ParseSidecarPermissions(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			TaskRole struct {
+				Properties struct {
+					Policies []struct {
+						PolicyName     string `yaml:"PolicyName"`
+						PolicyDocument struct {
+							Statement []struct {
+								Action yaml.Node `yaml:"Action"`
+							} `yaml:"Statement"`
+						} `yaml:"PolicyDocument"`
+					} `yaml:"Policies"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskRole"`
+		} `yaml:"Resources"`
+	}
+
+	// GIVEN
+	tpl := New()
+
+	// WHEN
+	content, err := tpl.ParseBackendService(WorkloadOpts{
+		Sidecars: []*SidecarOpts{
+			{
+				Name:  aws.String("nginx"),
+				Image: aws.String("nginx"),
+			},
+			{
+				Name:        aws.String("datadog"),
+				Image:       aws.String("public.ecr.aws/datadog/agent:latest"),
+				Permissions: []string{"ecs:ListClusters", "ecs:ListContainerInstances"},
+			},
+		},
+	})
+
+	// THEN
+	require.NoError(t, err, "parse backend service")
+	var actual cfn
+	err = yaml.Unmarshal(content.Bytes(), &actual)
+	require.NoError(t, err, "unmarshal actual template")
+	policies := actual.Resources.TaskRole.Properties.Policies
+	require.Equal(t, 2, len(policies), "expected only the sidecar with permissions to have a policy")
+	require.Equal(t, "datadogSidecarPermissions", policies[1].PolicyName)
+	var actions []string
+	require.NoError(t, policies[1].PolicyDocument.Statement[0].Action.Decode(&actions))
+	require.Equal(t, []string{"ecs:ListClusters", "ecs:ListContainerInstances"}, actions)
+}
+
+func TestTemplate_ParseRulePriority(t *testing.T) {
+	type listenerRule struct {
+		Properties struct {
+			Priority interface{} `yaml:"Priority"`
+		} `yaml:"Properties"`
+	}
+	type cfn struct {
+		Resources struct {
+			HTTPSRulePriorityAction    map[string]interface{} `yaml:"HTTPSRulePriorityAction"`
+			HTTPRulePriorityAction     map[string]interface{} `yaml:"HTTPRulePriorityAction"`
+			HTTPListenerRuleWithDomain listenerRule           `yaml:"HTTPListenerRuleWithDomain"`
+			HTTPSListenerRule          listenerRule           `yaml:"HTTPSListenerRule"`
+			HTTPListenerRule           listenerRule           `yaml:"HTTPListenerRule"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		input int
+
+		wantedPriorityActions bool
+		wantedHTTPSPriority   interface{}
+	}{
+		"should use the next available priority by default": {
+			wantedPriorityActions: true,
+			wantedHTTPSPriority:   "HTTPSRulePriorityAction.Priority",
+		},
+		"should use the priority from the manifest": {
+			input: 100,
+
+			wantedPriorityActions: false,
+			wantedHTTPSPriority:   100,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseLoadBalancedWebService(WorkloadOpts{
+				RulePriority: tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse load balanced web service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual template")
+			require.Equal(t, tc.wantedPriorityActions, actual.Resources.HTTPSRulePriorityAction != nil)
+			require.Equal(t, tc.wantedPriorityActions, actual.Resources.HTTPRulePriorityAction != nil)
+			require.Equal(t, tc.wantedHTTPSPriority, actual.Resources.HTTPListenerRuleWithDomain.Properties.Priority)
+			require.Equal(t, tc.wantedHTTPSPriority, actual.Resources.HTTPSListenerRule.Properties.Priority)
+			if !tc.wantedPriorityActions {
+				require.Equal(t, tc.input, actual.Resources.HTTPListenerRule.Properties.Priority)
+			}
+		})
+	}
+}
+
 func TestTemplate_ParseNetwork(t *testing.T) {
 	type cfn struct {
 		Resources struct {
@@ -249,3 +1439,184 @@ func TestTemplate_ParseNetwork(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplate_ParseFireLens(t *testing.T) {
+	type mountPoint struct {
+		SourceVolume  string `yaml:"SourceVolume"`
+		ContainerPath string `yaml:"ContainerPath"`
+		ReadOnly      bool   `yaml:"ReadOnly"`
+	}
+	type container struct {
+		Name             string `yaml:"Name"`
+		Cpu              int    `yaml:"Cpu"`
+		Memory           int    `yaml:"Memory"`
+		LogConfiguration struct {
+			LogDriver string            `yaml:"LogDriver"`
+			Options   map[string]string `yaml:"Options"`
+		} `yaml:"LogConfiguration"`
+		MountPoints []mountPoint `yaml:"MountPoints"`
+	}
+	type policy struct {
+		PolicyName     string `yaml:"PolicyName"`
+		PolicyDocument struct {
+			Statement []struct {
+				Action interface{} `yaml:"Action"`
+			} `yaml:"Statement"`
+		} `yaml:"PolicyDocument"`
+	}
+	type cfn struct {
+		Resources struct {
+			TaskRole struct {
+				Properties struct {
+					Policies []policy `yaml:"Policies"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskRole"`
+			TaskDefinition struct {
+				Properties struct {
+					ContainerDefinitions []container `yaml:"ContainerDefinitions"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskDefinition"`
+		} `yaml:"Resources"`
+	}
+
+	// GIVEN
+	tpl := New()
+
+	// WHEN
+	content, err := tpl.ParseBackendService(WorkloadOpts{
+		LogConfig: &LogConfigOpts{
+			Image:          aws.String("amazon/aws-for-fluent-bit:latest"),
+			EnableMetadata: aws.String("true"),
+			ConfigFile:     aws.String("/fluent-bit/config/extra.conf"),
+			Destination: map[string]string{
+				"Name":            "kinesis_firehose",
+				"delivery_stream": "my-stream",
+			},
+			RegionOption: "region",
+			Permissions:  []string{"firehose:PutRecordBatch"},
+			CPU:          aws.Int(64),
+			Memory:       aws.Int(128),
+			MountPoints: []*MountPoint{
+				{
+					SourceVolume:  aws.String("fluent-bit-config"),
+					ContainerPath: aws.String("/fluent-bit/config"),
+					ReadOnly:      aws.Bool(true),
+				},
+			},
+		},
+	})
+
+	// THEN
+	require.NoError(t, err, "parse backend service")
+	var actual cfn
+	err = yaml.Unmarshal(content.Bytes(), &actual)
+	require.NoError(t, err, "unmarshal actual template")
+	containers := actual.Resources.TaskDefinition.Properties.ContainerDefinitions
+	require.Len(t, containers, 2) // The main container and the log router.
+	require.Equal(t, "awsfirelens", containers[0].LogConfiguration.LogDriver)
+	require.Equal(t, map[string]string{
+		"Name":            "kinesis_firehose",
+		"delivery_stream": "my-stream",
+		"region":          "AWS::Region",
+	}, containers[0].LogConfiguration.Options)
+	require.Equal(t, "firelens_log_router", containers[1].Name)
+	require.Equal(t, 64, containers[1].Cpu)
+	require.Equal(t, 128, containers[1].Memory)
+	require.Equal(t, []mountPoint{
+		{SourceVolume: "fluent-bit-config", ContainerPath: "/fluent-bit/config", ReadOnly: true},
+	}, containers[1].MountPoints)
+	var actions interface{}
+	for _, p := range actual.Resources.TaskRole.Properties.Policies {
+		if p.PolicyName == "FireLensPermissions" {
+			actions = p.PolicyDocument.Statement[0].Action
+		}
+	}
+	require.Equal(t, []interface{}{"firehose:PutRecordBatch"}, actions)
+}
+
+func TestTemplate_ParseSharedExecutionRole(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			ExecutionRole  *yaml.Node `yaml:"ExecutionRole"`
+			TaskDefinition struct {
+				Properties struct {
+					ExecutionRoleArn yaml.Node `yaml:"ExecutionRoleArn"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskDefinition"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		input string
+
+		wantedRole          bool
+		wantedExecutionRole string
+	}{
+		"should create an execution role by default": {
+			wantedRole:          true,
+			wantedExecutionRole: "ExecutionRole",
+		},
+		"should reference the shared execution role": {
+			input: "arn:aws:iam::123456789012:role/phonetool-execution-role",
+
+			wantedExecutionRole: "arn:aws:iam::123456789012:role/phonetool-execution-role",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseBackendService(WorkloadOpts{
+				ExecutionRoleARN: tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse backend service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual template")
+			require.Equal(t, tc.wantedRole, actual.Resources.ExecutionRole != nil)
+			require.Equal(t, tc.wantedExecutionRole, actual.Resources.TaskDefinition.Properties.ExecutionRoleArn.Value)
+		})
+	}
+}
+
+func TestTemplate_ParseScheduledJobSharedExecutionRole(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			ExecutionRole    *yaml.Node `yaml:"ExecutionRole"`
+			StateMachineRole struct {
+				Properties struct {
+					Policies []struct {
+						PolicyDocument struct {
+							Statement []struct {
+								Resource yaml.Node `yaml:"Resource"`
+							} `yaml:"Statement"`
+						} `yaml:"PolicyDocument"`
+					} `yaml:"Policies"`
+				} `yaml:"Properties"`
+			} `yaml:"StateMachineRole"`
+		} `yaml:"Resources"`
+	}
+
+	// GIVEN
+	tpl := New()
+
+	// WHEN
+	content, err := tpl.ParseScheduledJob(WorkloadOpts{
+		ScheduleExpression: "rate(1 hour)",
+		ExecutionRoleARN:   "arn:aws:iam::123456789012:role/phonetool-execution-role",
+	})
+
+	// THEN
+	require.NoError(t, err, "parse scheduled job")
+	var actual cfn
+	err = yaml.Unmarshal(content.Bytes(), &actual)
+	require.NoError(t, err, "unmarshal actual template")
+	require.Nil(t, actual.Resources.ExecutionRole)
+	passRole := actual.Resources.StateMachineRole.Properties.Policies[0].PolicyDocument.Statement[0]
+	require.Equal(t, "arn:aws:iam::123456789012:role/phonetool-execution-role", passRole.Resource.Content[0].Value)
+}
@@ -41,6 +41,27 @@ func Stderr(writer io.Writer) Option {
 	}
 }
 
+// Dir sets the internal *exec.Cmd's working directory.
+func Dir(dir string) Option {
+	return func(c *exec.Cmd) {
+		c.Dir = dir
+	}
+}
+
+// Env appends environment variables to the ones of the current process for the internal *exec.Cmd.
+// Multiple Env options add up.
+func Env(env []string) Option {
+	return func(c *exec.Cmd) {
+		if len(env) == 0 {
+			return
+		}
+		if c.Env == nil {
+			c.Env = os.Environ()
+		}
+		c.Env = append(c.Env, env...)
+	}
+}
+
 // Run runs the input command with input args with Stdout and Stderr defaulted to os.Stderr.
 // Input options will override these defaults.
 func (s Service) Run(name string, args []string, options ...Option) error {
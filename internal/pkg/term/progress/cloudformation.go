@@ -17,6 +17,9 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+// nestedStackResourceType is the CloudFormation resource type of a nested stack.
+const nestedStackResourceType = "AWS::CloudFormation::Stack"
+
 // StackSubscriber is the interface to subscribe channels to a CloudFormation stack stream event.
 type StackSubscriber interface {
 	Subscribe() <-chan stream.StackEvent
@@ -24,23 +27,41 @@ type StackSubscriber interface {
 
 // ResourceRendererOpts is optional configuration for a listening CloudFormation resource renderer.
 type ResourceRendererOpts struct {
-	StartEvent *stream.StackEvent // Specify the starting event for the resource instead of "[not started]".
-	RenderOpts RenderOptions
+	StartEvent        *stream.StackEvent // Specify the starting event for the resource instead of "[not started]".
+	EstimatedDuration time.Duration      // How long the resource is expected to take, used to render the time remaining.
+	RenderOpts        RenderOptions
+}
+
+// ChangeSetRendererOpts is optional configuration for a listening CloudFormation change set renderer.
+type ChangeSetRendererOpts struct {
+	EstimatedDuration time.Duration // How long the stack is expected to take, used to render the time remaining.
+	RenderOpts        RenderOptions
 }
 
 // ECSServiceRendererOpts is optional configuration for a listening ECS service renderer.
 type ECSServiceRendererOpts struct {
-	Group      *errgroup.Group
-	Ctx        context.Context
-	RenderOpts RenderOptions
+	Group             *errgroup.Group
+	Ctx               context.Context
+	EstimatedDuration time.Duration // How long the service is expected to take, used to render the time remaining.
+	RenderOpts        RenderOptions
+}
+
+// NestedStackRendererOpts is optional configuration for a listening CloudFormation nested stack renderer.
+type NestedStackRendererOpts struct {
+	Group             *errgroup.Group
+	Ctx               context.Context
+	EstimatedDuration time.Duration // How long the nested stack is expected to take, used to render the time remaining.
+	RenderOpts        RenderOptions
+	StreamerOpts      []stream.StackStreamerOption // Options of the streamers of the nested stack and its own nested stacks.
 }
 
 // ListeningChangeSetRenderer returns a component that listens for CloudFormation
 // resource events from a stack mutated with a changeSet until the streamer stops.
-func ListeningChangeSetRenderer(streamer StackSubscriber, stackName, description string, changes []Renderer, opts RenderOptions) DynamicRenderer {
+func ListeningChangeSetRenderer(streamer StackSubscriber, stackName, description string, changes []Renderer, opts ChangeSetRendererOpts) DynamicRenderer {
 	return &dynamicTreeComponent{
 		Root: ListeningResourceRenderer(streamer, stackName, description, ResourceRendererOpts{
-			RenderOpts: opts,
+			EstimatedDuration: opts.EstimatedDuration,
+			RenderOpts:        opts.RenderOpts,
 		}),
 		Children: changes,
 	}
@@ -78,7 +99,8 @@ func ListeningECSServiceResourceRenderer(streamer StackSubscriber, ecsDescriber
 		ctx:        ctx,
 		renderOpts: opts.RenderOpts,
 		resourceRenderer: ListeningResourceRenderer(streamer, logicalID, description, ResourceRendererOpts{
-			RenderOpts: opts.RenderOpts,
+			EstimatedDuration: opts.EstimatedDuration,
+			RenderOpts:        opts.RenderOpts,
 		}),
 		done: make(chan struct{}),
 	}
@@ -87,12 +109,45 @@ func ListeningECSServiceResourceRenderer(streamer StackSubscriber, ecsDescriber
 	return comp
 }
 
+// ListeningNestedStackRenderer is a ListeningResourceRenderer for a nested stack resource.
+// Once the nested stack starts, its own events are streamed and each of its resources is rendered as a child of the stack,
+// so that failures within the nested stack are displayed in place.
+func ListeningNestedStackRenderer(streamer StackSubscriber, stackDescriber stream.StackEventsDescriber, logicalID, description string, opts NestedStackRendererOpts) DynamicRenderer {
+	g := new(errgroup.Group)
+	ctx := context.Background()
+	if opts.Group != nil {
+		g = opts.Group
+	}
+	if opts.Ctx != nil {
+		ctx = opts.Ctx
+	}
+	comp := &nestedStackComponent{
+		cfnStream:      streamer.Subscribe(),
+		stackDescriber: stackDescriber,
+		logicalID:      logicalID,
+
+		group:        g,
+		ctx:          ctx,
+		renderOpts:   opts.RenderOpts,
+		streamerOpts: opts.StreamerOpts,
+		resourceRenderer: ListeningResourceRenderer(streamer, logicalID, description, ResourceRendererOpts{
+			EstimatedDuration: opts.EstimatedDuration,
+			RenderOpts:        opts.RenderOpts,
+		}),
+		done: make(chan struct{}),
+	}
+	comp.newStackRenderer = comp.newListeningNestedStackResourcesRenderer
+	go comp.Listen()
+	return comp
+}
+
 // regularResourceComponent can display a simple CloudFormation stack resource event.
 type regularResourceComponent struct {
 	logicalID   string        // The LogicalID defined in the template for the resource.
 	description string        // The human friendly explanation of the resource.
 	statuses    []stackStatus // In-order history of the CloudFormation status of the resource throughout the deployment.
 	stopWatch   *stopWatch    // Timer to measure how long the operation takes to complete.
+	estimate    time.Duration // How long the operation is expected to take, zero if unknown.
 
 	padding   int  // Leading spaces before rendering the resource.
 	separator rune // Character used to separate columns of text.
@@ -108,6 +163,7 @@ func listeningResourceComponent(streamer StackSubscriber, logicalID, description
 		description: description,
 		statuses:    []stackStatus{notStartedStackStatus},
 		stopWatch:   newStopWatch(),
+		estimate:    opts.EstimatedDuration,
 		stream:      streamer.Subscribe(),
 		done:        make(chan struct{}),
 		padding:     opts.RenderOpts.Padding,
@@ -138,7 +194,7 @@ func (c *regularResourceComponent) Render(out io.Writer) (numLines int, err erro
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	components := stackResourceComponents(c.description, c.separator, c.statuses, c.stopWatch, c.padding)
+	components := stackResourceComponents(c.description, c.separator, c.statuses, c.stopWatch, c.estimate, c.padding)
 	return renderComponents(out, components)
 }
 
@@ -155,7 +211,8 @@ type stackComponent struct {
 	resourceDescriptions map[string]string
 
 	// Optional inputs.
-	renderOpts RenderOptions
+	renderOpts          RenderOptions
+	describeByLogicalID bool // Render resources without a description with their logical ID instead of skipping them.
 
 	// Sub-components.
 	resources     []Renderer
@@ -189,6 +246,37 @@ func listeningStackComponent(streamer StackSubscriber, stackName, description st
 	return comp
 }
 
+// listeningNestedStackResourcesComponent returns a stackComponent that renders every resource of a nested stack.
+// Resources that are nested stacks themselves are rendered along with their own resources.
+func listeningNestedStackResourcesComponent(streamer StackSubscriber, stackDescriber stream.StackEventsDescriber, stackName string, opts NestedStackRendererOpts) *stackComponent {
+	comp := &stackComponent{
+		cfnStream:           streamer.Subscribe(),
+		stack:               streamer,
+		renderOpts:          opts.RenderOpts,
+		describeByLogicalID: true,
+		seenResources: map[string]bool{
+			stackName: true,
+		},
+		done: make(chan struct{}),
+	}
+	comp.addRenderer = func(ev stream.StackEvent, description string) {
+		if ev.ResourceType != nestedStackResourceType {
+			comp.addResourceRenderer(ev, description)
+			return
+		}
+		comp.mu.Lock()
+		defer comp.mu.Unlock()
+		comp.resources = append(comp.resources, ListeningNestedStackRenderer(streamer, stackDescriber, ev.LogicalResourceID, description, NestedStackRendererOpts{
+			Group:        opts.Group,
+			Ctx:          opts.Ctx,
+			RenderOpts:   NestedRenderOptions(opts.RenderOpts),
+			StreamerOpts: opts.StreamerOpts,
+		}))
+	}
+	go comp.Listen()
+	return comp
+}
+
 // Listen consumes stack events from the stream.
 // On new resource events, if the resource's LogicalID has a description
 // then the resource is added to the list of sub-components to render.
@@ -201,9 +289,12 @@ func (c *stackComponent) Listen() {
 		c.seenResources[logicalID] = true
 
 		description, ok := c.resourceDescriptions[logicalID]
-		if !ok {
+		if !ok && !c.describeByLogicalID {
 			continue
 		}
+		if !ok {
+			description = logicalID
+		}
 		c.addRenderer(ev, description)
 	}
 	close(c.done)
@@ -334,6 +425,104 @@ func (c *ecsServiceResourceComponent) newListeningRollingUpdateRenderer(serviceA
 	return renderer
 }
 
+// nestedStackComponent can display a nested stack created with CloudFormation along with the resources of the nested stack.
+type nestedStackComponent struct {
+	// Required inputs.
+	cfnStream      <-chan stream.StackEvent    // Subscribed stream of the parent stack to detect when the nested stack starts.
+	stackDescriber stream.StackEventsDescriber // Client needed to stream the events of the nested stack.
+	logicalID      string                      // LogicalID for the nested stack in the parent stack.
+
+	// Optional inputs.
+	group        *errgroup.Group // Existing group to catch nested StackStreamer errors.
+	ctx          context.Context // Context for the nested StackStreamer.
+	renderOpts   RenderOptions
+	streamerOpts []stream.StackStreamerOption // Options for the nested StackStreamer.
+
+	// Sub-components.
+	resourceRenderer DynamicRenderer
+	stackRenderer    Renderer
+
+	done             chan struct{}
+	mu               sync.Mutex
+	newStackRenderer func(string, time.Time) DynamicRenderer // Overriden in tests.
+}
+
+// Listen creates a renderer for the resources of the nested stack once the nested stack is being created or updated.
+// It closes the Done channel if the CFN resource is Done and the resources of the nested stack are also Done.
+func (c *nestedStackComponent) Listen() {
+	renderers := []DynamicRenderer{c.resourceRenderer}
+	for ev := range c.cfnStream {
+		if c.logicalID != ev.LogicalResourceID {
+			continue
+		}
+		if !cloudformation.StackStatus(ev.ResourceStatus).UpsertInProgress() || ev.PhysicalResourceID == "" {
+			// New nested stacks receive two "CREATE_IN_PROGRESS" events.
+			// The first event doesn't have a stack ARN yet, the second one has.
+			continue
+		}
+		c.mu.Lock()
+		started := c.stackRenderer != nil
+		c.mu.Unlock()
+		if started {
+			continue
+		}
+		renderer := c.newStackRenderer(ev.PhysicalResourceID, ev.Timestamp)
+		c.mu.Lock()
+		c.stackRenderer = renderer
+		c.mu.Unlock()
+		renderers = append(renderers, renderer)
+	}
+
+	// Close the done channel once all the renderers are done listening.
+	for _, r := range renderers {
+		<-r.Done()
+	}
+	close(c.done)
+}
+
+// Render writes the status of the CloudFormation nested stack resource, followed with its resources if the stack started.
+func (c *nestedStackComponent) Render(out io.Writer) (numLines int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	components := []Renderer{c.resourceRenderer}
+	if c.stackRenderer != nil {
+		components = append(components, c.stackRenderer)
+	}
+	return renderComponents(out, components)
+}
+
+// Done returns a channel that's closed when there are no more events to Listen.
+func (c *nestedStackComponent) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *nestedStackComponent) newListeningNestedStackResourcesRenderer(stackARN string, startTime time.Time) DynamicRenderer {
+	stackName := parseStackARN(stackARN)
+	streamer := stream.NewStackStreamer(c.stackDescriber, stackName, startTime, c.streamerOpts...)
+	renderer := listeningNestedStackResourcesComponent(streamer, c.stackDescriber, stackName, NestedStackRendererOpts{
+		Group:        c.group,
+		Ctx:          c.ctx,
+		RenderOpts:   c.renderOpts,
+		StreamerOpts: c.streamerOpts,
+	})
+	c.group.Go(func() error {
+		return stream.Stream(c.ctx, streamer)
+	})
+	return renderer
+}
+
+// parseStackARN returns the name of a stack from its ARN.
+// For example: arn:aws:cloudformation:us-west-2:1234567890:stack/my-nested-stack/d0a825a0-e4cd-xmpl-b9fb-061c69e99205
+// will return my-nested-stack
+func parseStackARN(stackARN string) string {
+	parts := strings.Split(stackARN, "/")
+	if len(parts) < 2 {
+		return stackARN
+	}
+	return parts[1]
+}
+
 func updateComponentStatus(mu *sync.Mutex, statuses *[]stackStatus, event stream.StackEvent) {
 	mu.Lock()
 	defer mu.Unlock()
@@ -369,8 +558,8 @@ func updateComponentTimer(mu *sync.Mutex, statuses []stackStatus, sw *stopWatch)
 	}
 }
 
-func stackResourceComponents(description string, separator rune, statuses []stackStatus, sw *stopWatch, padding int) []Renderer {
-	columns := []string{fmt.Sprintf("- %s", description), prettifyLatestStackStatus(statuses), prettifyElapsedTime(sw)}
+func stackResourceComponents(description string, separator rune, statuses []stackStatus, sw *stopWatch, estimate time.Duration, padding int) []Renderer {
+	columns := []string{fmt.Sprintf("- %s", description), prettifyLatestStackStatus(statuses), prettifyElapsedTime(sw, estimate)}
 	components := []Renderer{
 		&singleLineComponent{
 			Text:    strings.Join(columns, string(separator)),
@@ -90,6 +90,49 @@ func TestStackComponent_Listen(t *testing.T) {
 	require.Equal(t, wantedRenderers, actualRenderers)
 }
 
+func TestStackComponent_ListenDescribeByLogicalID(t *testing.T) {
+	// GIVEN
+	ch := make(chan stream.StackEvent)
+	done := make(chan struct{})
+	var actualDescriptions []string
+	comp := &stackComponent{
+		cfnStream: ch,
+		resourceDescriptions: map[string]string{
+			"Table": "dynamodb table",
+		},
+		describeByLogicalID: true,
+		seenResources: map[string]bool{
+			"my-nested-stack": true,
+		},
+		done: done,
+		addRenderer: func(_ stream.StackEvent, description string) {
+			actualDescriptions = append(actualDescriptions, description)
+		},
+	}
+
+	// WHEN
+	go comp.Listen()
+	go func() {
+		ch <- stream.StackEvent{
+			LogicalResourceID: "my-nested-stack",
+			ResourceStatus:    "CREATE_IN_PROGRESS",
+		}
+		ch <- stream.StackEvent{
+			LogicalResourceID: "Table",
+			ResourceStatus:    "CREATE_IN_PROGRESS",
+		}
+		ch <- stream.StackEvent{
+			LogicalResourceID: "Queue",
+			ResourceStatus:    "CREATE_FAILED",
+		}
+		close(ch)
+	}()
+
+	// THEN
+	<-done
+	require.Equal(t, []string{"dynamodb table", "Queue"}, actualDescriptions)
+}
+
 func TestStackComponent_Render(t *testing.T) {
 	// GIVEN
 	comp := &stackComponent{
@@ -285,6 +328,66 @@ func TestRegularResourceComponent_Render(t *testing.T) {
 		require.Equal(t, 1, nl, "expected to be rendered as a single line component")
 		require.Equal(t, "- An ECS cluster to hold your services\t[create in progress]\t[10.0s]\n", buf.String())
 	})
+	t.Run("renders the estimated time remaining for a resource that is in progress", func(t *testing.T) {
+		// GIVEN
+		comp := &regularResourceComponent{
+			description: "An ECS cluster to hold your services",
+			statuses: []stackStatus{
+				notStartedStackStatus,
+				{
+					value: "CREATE_IN_PROGRESS",
+				},
+			},
+			stopWatch: &stopWatch{
+				startTime: testDate,
+				started:   true,
+				clock: &fakeClock{
+					wantedValues: []time.Time{testDate.Add(10*time.Second + 500*time.Millisecond)},
+				},
+			},
+			estimate:  1 * time.Minute,
+			separator: '\t',
+		}
+		buf := new(strings.Builder)
+
+		// WHEN
+		nl, err := comp.Render(buf)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, 1, nl, "expected to be rendered as a single line component")
+		require.Equal(t, "- An ECS cluster to hold your services\t[create in progress]\t[10.5s, ~50s left]\n", buf.String())
+	})
+	t.Run("does not render the estimated time remaining once the resource takes longer than expected", func(t *testing.T) {
+		// GIVEN
+		comp := &regularResourceComponent{
+			description: "An ECS cluster to hold your services",
+			statuses: []stackStatus{
+				notStartedStackStatus,
+				{
+					value: "CREATE_IN_PROGRESS",
+				},
+			},
+			stopWatch: &stopWatch{
+				startTime: testDate,
+				started:   true,
+				clock: &fakeClock{
+					wantedValues: []time.Time{testDate.Add(1*time.Minute + 5*time.Second)},
+				},
+			},
+			estimate:  1 * time.Minute,
+			separator: '\t',
+		}
+		buf := new(strings.Builder)
+
+		// WHEN
+		nl, err := comp.Render(buf)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, 1, nl, "expected to be rendered as a single line component")
+		require.Equal(t, "- An ECS cluster to hold your services\t[create in progress]\t[65.0s]\n", buf.String())
+	})
 	t.Run("splits long failure reason into multiple lines", func(t *testing.T) {
 		// GIVEN
 		comp := &regularResourceComponent{
@@ -502,3 +605,137 @@ func TestEcsServiceResourceComponent_Render(t *testing.T) {
 			"deployment\t\t\n", buf.String())
 	})
 }
+
+func TestNestedStackComponent_Listen(t *testing.T) {
+	t.Run("should create a renderer for the nested stack resources once the stack is in progress", func(t *testing.T) {
+		// GIVEN
+		ch := make(chan stream.StackEvent)
+		stackDone := make(chan struct{})
+		resourceDone := make(chan struct{})
+		var stackARNs []string
+		c := &nestedStackComponent{
+			cfnStream: ch,
+			logicalID: "AddonsStack",
+			group:     new(errgroup.Group),
+			ctx:       context.Background(),
+			done:      make(chan struct{}),
+			resourceRenderer: &mockDynamicRenderer{
+				done: resourceDone,
+			},
+			newStackRenderer: func(stackARN string, _ time.Time) DynamicRenderer {
+				stackARNs = append(stackARNs, stackARN)
+				return &mockDynamicRenderer{
+					done: stackDone,
+				}
+			},
+		}
+
+		// WHEN
+		go c.Listen()
+		go func() {
+			ch <- stream.StackEvent{
+				LogicalResourceID:  "AddonsStack",
+				PhysicalResourceID: "",
+				ResourceStatus:     "CREATE_IN_PROGRESS",
+			}
+			ch <- stream.StackEvent{
+				LogicalResourceID:  "AddonsStack",
+				PhysicalResourceID: "arn:aws:cloudformation:us-west-2:1111:stack/webapp-test-frontend-AddonsStack-1/d0a825a0",
+				ResourceStatus:     "CREATE_IN_PROGRESS",
+			}
+			// Should not create another renderer.
+			ch <- stream.StackEvent{
+				LogicalResourceID:  "AddonsStack",
+				PhysicalResourceID: "arn:aws:cloudformation:us-west-2:1111:stack/webapp-test-frontend-AddonsStack-1/d0a825a0",
+				ResourceStatus:     "CREATE_IN_PROGRESS",
+			}
+			// Close channels to notify that the nested stack is done.
+			close(stackDone)
+			close(resourceDone)
+			close(ch)
+		}()
+
+		// THEN
+		<-c.done // Wait for listen to exit.
+		require.NotNil(t, c.stackRenderer, "expected the nested stack renderer to be initialized")
+		require.Equal(t, []string{"arn:aws:cloudformation:us-west-2:1111:stack/webapp-test-frontend-AddonsStack-1/d0a825a0"}, stackARNs)
+	})
+	t.Run("should not create a renderer for the nested stack resources if the stack is not created or updated", func(t *testing.T) {
+		// GIVEN
+		ch := make(chan stream.StackEvent)
+		resourceDone := make(chan struct{})
+		c := &nestedStackComponent{
+			cfnStream: ch,
+			logicalID: "AddonsStack",
+			group:     new(errgroup.Group),
+			ctx:       context.Background(),
+			done:      make(chan struct{}),
+			resourceRenderer: &mockDynamicRenderer{
+				done: resourceDone,
+			},
+			newStackRenderer: func(string, time.Time) DynamicRenderer {
+				return &mockDynamicRenderer{}
+			},
+		}
+
+		// WHEN
+		go c.Listen()
+		go func() {
+			ch <- stream.StackEvent{
+				LogicalResourceID:  "AddonsStack",
+				PhysicalResourceID: "arn:aws:cloudformation:us-west-2:1111:stack/webapp-test-frontend-AddonsStack-1/d0a825a0",
+				ResourceStatus:     "DELETE_IN_PROGRESS",
+			}
+			close(resourceDone)
+			close(ch)
+		}()
+
+		// THEN
+		<-c.done // Wait for listen to exit.
+		require.Nil(t, c.stackRenderer, "expected the nested stack renderer to be nil")
+	})
+}
+
+func TestNestedStackComponent_Render(t *testing.T) {
+	t.Run("renders only the resource renderer if the nested stack did not start", func(t *testing.T) {
+		// GIVEN
+		buf := new(strings.Builder)
+		c := &nestedStackComponent{
+			resourceRenderer: &mockDynamicRenderer{
+				content: "addons\n",
+			},
+		}
+
+		// WHEN
+		nl, err := c.Render(buf)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, 1, nl)
+		require.Equal(t, "addons\n", buf.String())
+	})
+	t.Run("renders the nested stack resources under the resource", func(t *testing.T) {
+		// GIVEN
+		buf := new(strings.Builder)
+		c := &nestedStackComponent{
+			resourceRenderer: &mockDynamicRenderer{
+				content: "addons\n",
+			},
+			stackRenderer: &mockDynamicRenderer{
+				content: "  table\n",
+			},
+		}
+
+		// WHEN
+		nl, err := c.Render(buf)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, 2, nl)
+		require.Equal(t, "addons\n  table\n", buf.String())
+	})
+}
+
+func TestParseStackARN(t *testing.T) {
+	require.Equal(t, "my-nested-stack", parseStackARN("arn:aws:cloudformation:us-west-2:1234567890:stack/my-nested-stack/d0a825a0-e4cd-xmpl-b9fb-061c69e99205"))
+}
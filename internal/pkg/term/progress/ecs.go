@@ -17,6 +17,8 @@ import (
 
 const (
 	maxServiceEventsToDisplay = 5 // Total number of events we want to display at most for ECS service events.
+
+	rolloutFailed = "FAILED" // Rollout state of an ECS deployment that was rolled back.
 )
 
 // ECSServiceSubscriber is the interface to subscribe channels to ECS service descriptions.
@@ -76,6 +78,12 @@ func (c *rollingUpdateComponent) Render(out io.Writer) (numLines int, err error)
 	}
 	numLines += nl
 
+	nl, err = c.renderRolloutFailures(buf)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
 	nl, err = c.renderFailureMsgs(buf)
 	if err != nil {
 		return 0, err
@@ -116,6 +124,33 @@ func (c *rollingUpdateComponent) renderDeployments(out io.Writer) (numLines int,
 	return nl, err
 }
 
+// renderRolloutFailures prints why failed deployments were rolled back, such as a circuit breaker or an alarm.
+func (c *rollingUpdateComponent) renderRolloutFailures(out io.Writer) (numLines int, err error) {
+	var components []Renderer
+	for _, d := range c.deployments {
+		if d.RolloutState != rolloutFailed || d.RolloutReason == "" {
+			continue
+		}
+		components = append(components,
+			&singleLineComponent{}, // Add an empty line before rendering the reason.
+			&singleLineComponent{
+				Text:    fmt.Sprintf("%s%s", color.DullRed.Sprintf("✘ "), color.Faint.Sprintf("Rollout of revision %s failed", d.TaskDefRevision)),
+				Padding: c.padding,
+			})
+		for i, truncatedMsg := range splitByLength(d.RolloutReason, maxCellLength) {
+			pretty := fmt.Sprintf("  %s", truncatedMsg)
+			if i == 0 {
+				pretty = fmt.Sprintf("- %s", truncatedMsg)
+			}
+			components = append(components, &singleLineComponent{
+				Text:    pretty,
+				Padding: c.padding + nestedComponentPadding,
+			})
+		}
+	}
+	return renderComponents(out, components)
+}
+
 func (c *rollingUpdateComponent) renderFailureMsgs(out io.Writer) (numLines int, err error) {
 	if len(c.failureMsgs) == 0 {
 		return 0, nil
@@ -0,0 +1,97 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/dustin/go-humanize"
+)
+
+const shortTaskIDLength = 8
+
+// ECSTaskStatsSubscriber is the interface to subscribe channels to the resource utilization of ECS tasks.
+type ECSTaskStatsSubscriber interface {
+	Subscribe() <-chan []stream.ECSTaskStats
+}
+
+// ListeningTaskStatsRenderer renders the resource utilization of ECS tasks as a table that's updated as stats are streamed.
+func ListeningTaskStatsRenderer(streamer ECSTaskStatsSubscriber, opts RenderOptions) DynamicRenderer {
+	c := &taskStatsComponent{
+		padding: opts.Padding,
+		stream:  streamer.Subscribe(),
+		done:    make(chan struct{}),
+	}
+	go c.Listen()
+	return c
+}
+
+type taskStatsComponent struct {
+	// Data to render.
+	stats []stream.ECSTaskStats
+
+	// Style configuration for the component.
+	padding int
+
+	stream <-chan []stream.ECSTaskStats // Channel where task stats snapshots are received.
+	done   chan struct{}                // Channel that's closed when there are no more events to listen on.
+	mu     sync.Mutex                   // Lock used to mutate data to render.
+}
+
+// Listen replaces the task stats with the latest snapshot as they're streamed.
+func (c *taskStatsComponent) Listen() {
+	for ev := range c.stream {
+		c.mu.Lock()
+		c.stats = ev
+		c.mu.Unlock()
+	}
+	close(c.done)
+}
+
+// Render prints the utilization of each task as a tableComponent, or a waiting message if no task reported metrics yet.
+func (c *taskStatsComponent) Render(out io.Writer) (numLines int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	header := []string{"Task", "CPU (%)", "Memory", "Memory (%)", "Net Rx/s", "Net Tx/s"}
+	var rows [][]string
+	for _, s := range c.stats {
+		taskID := s.TaskID
+		if len(taskID) > shortTaskIDLength {
+			taskID = taskID[:shortTaskIDLength]
+		}
+		rows = append(rows, []string{
+			taskID,
+			fmt.Sprintf("%.2f", s.CPUPercent()),
+			fmt.Sprintf("%.0f MiB / %.0f MiB", s.MemoryUtilized, s.MemoryReserved),
+			fmt.Sprintf("%.2f", s.MemoryPercent()),
+			humanize.Bytes(uint64(s.NetworkRxBytesSec)),
+			humanize.Bytes(uint64(s.NetworkTxBytesSec)),
+		})
+	}
+	if len(rows) == 0 {
+		// Container Insights can take up to a minute to report the first metrics of a task.
+		waiting := &singleLineComponent{
+			Text:    color.Faint.Sprintf("Waiting for Container Insights to report task metrics..."),
+			Padding: c.padding,
+		}
+		return waiting.Render(out)
+	}
+	table := newTableComponent(color.Faint.Sprintf("Tasks"), header, rows)
+	table.Padding = c.padding
+	nl, err := table.Render(out)
+	if err != nil {
+		return 0, fmt.Errorf("render task stats table: %w", err)
+	}
+	return nl, nil
+}
+
+// Done returns a channel that's closed when there are no more events to listen.
+func (c *taskStatsComponent) Done() <-chan struct{} {
+	return c.done
+}
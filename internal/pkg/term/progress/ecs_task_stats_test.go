@@ -0,0 +1,111 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskStatsComponent_Listen(t *testing.T) {
+	t.Run("should keep only the latest snapshot of task stats", func(t *testing.T) {
+		// GIVEN
+		events := make(chan []stream.ECSTaskStats)
+		done := make(chan struct{})
+		c := &taskStatsComponent{
+			stream: events,
+			done:   done,
+		}
+
+		// WHEN
+		go c.Listen()
+		go func() {
+			events <- []stream.ECSTaskStats{
+				{
+					TaskID: "aaaa",
+				},
+				{
+					TaskID: "bbbb",
+				},
+			}
+			events <- []stream.ECSTaskStats{
+				{
+					TaskID: "bbbb",
+				},
+			}
+			close(events)
+		}()
+
+		// THEN
+		<-done // Listen should have closed the channel.
+		require.Equal(t, []stream.ECSTaskStats{
+			{
+				TaskID: "bbbb",
+			},
+		}, c.stats)
+	})
+}
+
+func TestTaskStatsComponent_Render(t *testing.T) {
+	testCases := map[string]struct {
+		inStats []stream.ECSTaskStats
+
+		wantedNumLines int
+		wantedOut      string
+	}{
+		"should render a waiting message if there are no stats yet": {
+			wantedNumLines: 1,
+			wantedOut: `Waiting for Container Insights to report task metrics...
+`,
+		},
+		"should render the stats of each task": {
+			inStats: []stream.ECSTaskStats{
+				{
+					TaskID:            "0123456789abcdef",
+					CPUUtilized:       64,
+					CPUReserved:       256,
+					MemoryUtilized:    128,
+					MemoryReserved:    512,
+					NetworkRxBytesSec: 2048,
+					NetworkTxBytesSec: 512,
+				},
+				{
+					TaskID:         "fedcba9876543210",
+					CPUUtilized:    1.5,
+					CPUReserved:    256,
+					MemoryUtilized: 40,
+					MemoryReserved: 512,
+				},
+			},
+
+			wantedNumLines: 4,
+			wantedOut: `Tasks
+  Task      CPU (%)  Memory             Memory (%)  Net Rx/s  Net Tx/s
+  01234567  25.00    128 MiB / 512 MiB  25.00       2.0 kB    512 B
+  fedcba98  0.59     40 MiB / 512 MiB   7.81        0 B       0 B
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			buf := new(strings.Builder)
+			c := &taskStatsComponent{
+				stats: tc.inStats,
+			}
+
+			// WHEN
+			nl, err := c.Render(buf)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNumLines, nl, "number of lines expected did not match")
+			require.Equal(t, tc.wantedOut, buf.String(), "the content written did not match")
+		})
+	}
+}
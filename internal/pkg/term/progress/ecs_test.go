@@ -98,6 +98,35 @@ func TestRollingUpdateComponent_Render(t *testing.T) {
 			wantedOut: `Deployments
            Revision  Rollout      Desired  Running  Failed  Pending
   PRIMARY  2         [completed]  10       10       0       0
+`,
+		},
+		"should render why a deployment was rolled back": {
+			inDeployments: []stream.ECSDeployment{
+				{
+					Status:          "PRIMARY",
+					TaskDefRevision: "1",
+					DesiredCount:    10,
+					RunningCount:    10,
+					RolloutState:    "COMPLETED",
+				},
+				{
+					Status:          "ACTIVE",
+					TaskDefRevision: "2",
+					DesiredCount:    0,
+					RunningCount:    0,
+					RolloutState:    "FAILED",
+					RolloutReason:   "CloudWatch alarm(s) HighErrorRate in ALARM state.",
+				},
+			},
+
+			wantedNumLines: 7,
+			wantedOut: `Deployments
+           Revision  Rollout      Desired  Running  Failed  Pending
+  PRIMARY  1         [completed]  10       10       0       0
+  ACTIVE   2         [failed]     0        0        0       0
+
+✘ Rollout of revision 2 failed
+  - CloudWatch alarm(s) HighErrorRate in ALARM state.
 `,
 		},
 		"should render a single failure event": {
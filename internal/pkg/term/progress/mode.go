@@ -0,0 +1,58 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Mode represents how progress updates are written to the terminal.
+type Mode string
+
+// Supported progress modes.
+const (
+	TreeMode  Mode = "tree"  // Re-render a tree of resources in place.
+	PlainMode Mode = "plain" // Write one timestamped line per event, without moving the cursor.
+)
+
+// ModeEnvVar is the environment variable that sets the progress mode if the flag is not provided.
+const ModeEnvVar = "COPILOT_PROGRESS"
+
+// Modes are the valid values for the progress mode.
+var Modes = []string{string(TreeMode), string(PlainMode)}
+
+var (
+	lookupEnv = os.LookupEnv
+
+	mode = TreeMode
+)
+
+// SetModeBasedOnEnvVar sets the progress mode from the COPILOT_PROGRESS environment variable.
+// If the variable is not set or holds an invalid value, the mode is unchanged.
+func SetModeBasedOnEnvVar() {
+	value, exists := lookupEnv(ModeEnvVar)
+	if !exists {
+		return
+	}
+	_ = SetMode(value)
+}
+
+// SetMode sets how progress updates are written to the terminal.
+// Returns an error if the value is not one of the supported Modes.
+func SetMode(value string) error {
+	for _, m := range Modes {
+		if strings.ToLower(value) == m {
+			mode = Mode(m)
+			return nil
+		}
+	}
+	return fmt.Errorf("progress mode %q is not supported, must be one of %s", value, strings.Join(Modes, ", "))
+}
+
+// CurrentMode returns how progress updates are written to the terminal.
+func CurrentMode() Mode {
+	return mode
+}
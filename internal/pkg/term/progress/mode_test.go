@@ -0,0 +1,62 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetModeBasedOnEnvVar(t *testing.T) {
+	testCases := map[string]struct {
+		env map[string]string
+
+		wanted Mode
+	}{
+		"defaults to the tree mode if the variable is not set": {
+			env:    map[string]string{},
+			wanted: TreeMode,
+		},
+		"sets the plain mode": {
+			env:    map[string]string{ModeEnvVar: "PLAIN"},
+			wanted: PlainMode,
+		},
+		"ignores invalid values": {
+			env:    map[string]string{ModeEnvVar: "fancy"},
+			wanted: TreeMode,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			defer func() {
+				lookupEnv = os.LookupEnv
+				mode = TreeMode
+			}()
+			lookupEnv = func(key string) (string, bool) {
+				v, ok := tc.env[key]
+				return v, ok
+			}
+
+			// WHEN
+			SetModeBasedOnEnvVar()
+
+			// THEN
+			require.Equal(t, tc.wanted, CurrentMode())
+		})
+	}
+}
+
+func TestSetMode(t *testing.T) {
+	defer func() { mode = TreeMode }()
+
+	require.NoError(t, SetMode("plain"))
+	require.Equal(t, PlainMode, CurrentMode())
+
+	require.EqualError(t, SetMode("fancy"), `progress mode "fancy" is not supported, must be one of tree, plain`)
+	require.Equal(t, PlainMode, CurrentMode(), "expected the mode to be unchanged")
+}
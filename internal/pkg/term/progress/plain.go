@@ -0,0 +1,108 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+)
+
+// ListeningPlainChangeSetRenderer returns a component that writes a timestamped line for every CloudFormation
+// stack event of a stack mutated with a changeSet, followed by the lines of its nested stacks, until the streamers stop.
+// Lines are written only once, so the component is suited for terminals that don't support cursor movements.
+func ListeningPlainChangeSetRenderer(streamer StackSubscriber, stackName string, nestedStacks []Renderer) DynamicRenderer {
+	return &dynamicTreeComponent{
+		Root:     listeningPlainStackComponent(streamer, stackName),
+		Children: nestedStacks,
+	}
+}
+
+// RenderPlain writes the new lines of r periodically to out.
+// Unlike Render, previous lines are never erased and the cursor is not hidden.
+// RenderPlain stops when the ctx is canceled or r is done listening to new events.
+func RenderPlain(ctx context.Context, out io.Writer, r DynamicRenderer) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-r.Done():
+			_, err := r.Render(out)
+			return err
+		case <-time.After(renderInterval):
+			if _, err := r.Render(out); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// plainStackComponent is a DynamicRenderer that writes each CloudFormation stack event as a single line.
+type plainStackComponent struct {
+	stackName string
+	stream    <-chan stream.StackEvent
+	pending   []string // Lines that were not rendered yet.
+
+	done chan struct{}
+	mu   sync.Mutex
+}
+
+func listeningPlainStackComponent(streamer StackSubscriber, stackName string) *plainStackComponent {
+	comp := &plainStackComponent{
+		stackName: stackName,
+		stream:    streamer.Subscribe(),
+		done:      make(chan struct{}),
+	}
+	go comp.Listen()
+	return comp
+}
+
+// Listen queues a line for every CloudFormation stack event received.
+func (c *plainStackComponent) Listen() {
+	for ev := range c.stream {
+		c.mu.Lock()
+		c.pending = append(c.pending, plainStackEventLine(c.stackName, ev))
+		c.mu.Unlock()
+	}
+	close(c.done) // No more events will be processed.
+}
+
+// Render writes the lines queued since the last Render call and returns the number of lines written.
+func (c *plainStackComponent) Render(out io.Writer) (numLines int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, line := range c.pending {
+		if _, err := fmt.Fprintln(out, line); err != nil {
+			return numLines, err
+		}
+		numLines += 1
+	}
+	c.pending = nil
+	return numLines, nil
+}
+
+// Done returns a channel that's closed when there are no more events to Listen.
+func (c *plainStackComponent) Done() <-chan struct{} {
+	return c.done
+}
+
+func plainStackEventLine(stackName string, ev stream.StackEvent) string {
+	columns := []string{
+		ev.Timestamp.UTC().Format(time.RFC3339),
+		stackName,
+		ev.LogicalResourceID,
+		ev.ResourceType,
+		ev.ResourceStatus,
+	}
+	if ev.ResourceStatusReason != "" {
+		columns = append(columns, fmt.Sprintf("%q", ev.ResourceStatusReason))
+	}
+	return strings.Join(columns, "  ")
+}
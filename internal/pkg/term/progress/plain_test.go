@@ -0,0 +1,99 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlainStackComponent_Render(t *testing.T) {
+	// GIVEN
+	ch := make(chan stream.StackEvent)
+	comp := &plainStackComponent{
+		stackName: "phonetool-test-api",
+		stream:    ch,
+		done:      make(chan struct{}),
+	}
+	go comp.Listen()
+	ch <- stream.StackEvent{
+		LogicalResourceID: "Service",
+		ResourceType:      "AWS::ECS::Service",
+		ResourceStatus:    "CREATE_IN_PROGRESS",
+		Timestamp:         testDate,
+	}
+	ch <- stream.StackEvent{
+		LogicalResourceID:    "Service",
+		ResourceType:         "AWS::ECS::Service",
+		ResourceStatus:       "CREATE_FAILED",
+		ResourceStatusReason: "Resource creation cancelled",
+		Timestamp:            testDate.Add(10 * time.Second),
+	}
+	close(ch)
+	<-comp.Done()
+	buf := new(strings.Builder)
+
+	// WHEN
+	nl, err := comp.Render(buf)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, 2, nl)
+	require.Equal(t, "2021-01-06T00:00:00Z  phonetool-test-api  Service  AWS::ECS::Service  CREATE_IN_PROGRESS\n"+
+		"2021-01-06T00:00:10Z  phonetool-test-api  Service  AWS::ECS::Service  CREATE_FAILED  \"Resource creation cancelled\"\n", buf.String())
+
+	// Lines are written only once.
+	buf.Reset()
+	nl, err = comp.Render(buf)
+	require.NoError(t, err)
+	require.Equal(t, 0, nl)
+	require.Empty(t, buf.String())
+}
+
+func TestRenderPlain(t *testing.T) {
+	t.Run("stops the renderer when context is canceled", func(t *testing.T) {
+		t.Parallel()
+		// GIVEN
+		ctx, cancel := context.WithTimeout(context.Background(), 350*time.Millisecond)
+		defer cancel()
+		actual := new(strings.Builder)
+		r := &mockDynamicRenderer{
+			content: "hi\n",
+			done:    make(chan struct{}),
+		}
+
+		// WHEN
+		err := RenderPlain(ctx, actual, r)
+
+		// THEN
+		require.EqualError(t, err, ctx.Err().Error(), "expected the context to be canceled")
+		require.Contains(t, actual.String(), "hi\n", "expected Render to be invoked until the context was canceled")
+	})
+	t.Run("renders without cursor movements until the renderer is done", func(t *testing.T) {
+		t.Parallel()
+		// GIVEN
+		actual := new(strings.Builder)
+		done := make(chan struct{})
+		r := &mockDynamicRenderer{
+			content: "hi\n",
+			done:    done,
+		}
+		go func() {
+			<-time.After(350 * time.Millisecond)
+			close(done)
+		}()
+
+		// WHEN
+		err := RenderPlain(context.Background(), actual, r)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, "hi\nhi\nhi\nhi\n", actual.String(), "expected only the rendered content to be written")
+	})
+}
@@ -83,3 +83,26 @@ func (s *Spinner) finalMSG(label string) {
 		spinner.FinalMSG = label
 	}
 }
+
+// PlainSpinner writes the labels of an asynchronous operation as lines instead of animating them.
+// Unlike Spinner, multiple PlainSpinners can write to the same terminal concurrently.
+type PlainSpinner struct {
+	w io.Writer
+}
+
+// NewPlainSpinner returns a spinner that writes its labels to w.
+func NewPlainSpinner(w io.Writer) *PlainSpinner {
+	return &PlainSpinner{
+		w: w,
+	}
+}
+
+// Start writes the label on its own line.
+func (s *PlainSpinner) Start(label string) {
+	fmt.Fprintf(s.w, "%s\n", label)
+}
+
+// Stop writes the label as is.
+func (s *PlainSpinner) Stop(label string) {
+	fmt.Fprint(s.w, label)
+}
@@ -53,3 +53,16 @@ func TestSpinner_Stop(t *testing.T) {
 	// WHEN
 	s.Stop("stop")
 }
+
+func TestPlainSpinner(t *testing.T) {
+	// GIVEN
+	buf := new(strings.Builder)
+	s := NewPlainSpinner(buf)
+
+	// WHEN
+	s.Start("Deploying service api.")
+	s.Stop("Deployed service api.\n")
+
+	// THEN
+	require.Equal(t, "Deploying service api.\nDeployed service api.\n", buf.String())
+}
@@ -5,7 +5,9 @@ package progress
 
 import (
 	"fmt"
+	"math"
 	"strings"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
@@ -34,12 +36,18 @@ func prettifyRolloutStatus(rollout string) string {
 	return fmt.Sprintf("[%s]", pretty)
 }
 
-func prettifyElapsedTime(sw *stopWatch) string {
+// prettifyElapsedTime returns the time elapsed since the stopWatch started.
+// If the stopWatch is still running and the operation is expected to take longer than the elapsed time,
+// then the estimated time remaining is rendered as well.
+func prettifyElapsedTime(sw *stopWatch, estimate time.Duration) string {
 	elapsed, hasStarted := sw.elapsed()
 	if !hasStarted {
 		return ""
 	}
-	return color.Faint.Sprintf("[%.1fs]", elapsed.Seconds())
+	if sw.stopped || elapsed >= estimate {
+		return color.Faint.Sprintf("[%.1fs]", elapsed.Seconds())
+	}
+	return color.Faint.Sprintf("[%.1fs, ~%.0fs left]", elapsed.Seconds(), math.Ceil((estimate - elapsed).Seconds()))
 }
 
 func failureReasons(statuses []stackStatus) []string {
@@ -0,0 +1,244 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package verify runs suites of HTTP checks against the endpoint of a deployed service.
+package verify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	// DefaultStatus is the status code expected from a check that doesn't specify one.
+	DefaultStatus = http.StatusOK
+	// DefaultRequests is the number of requests sent for each check if the suite doesn't specify it.
+	DefaultRequests = 10
+	// DefaultConcurrency is the number of requests in flight for each check if the suite doesn't specify it.
+	DefaultConcurrency = 1
+
+	latencyPercentile = 95
+)
+
+// Table formatting.
+const (
+	minCellWidth           = 10
+	tabWidth               = 4
+	cellPaddingWidth       = 2
+	paddingChar            = ' '
+	noAdditionalFormatting = 0
+)
+
+// Check is a request to send to a path of the service along with the status code expected in response.
+type Check struct {
+	Path   string
+	Status int
+}
+
+// Suite is a list of checks to run against a service.
+type Suite struct {
+	Checks        []Check
+	Requests      int           // Number of requests sent for each check.
+	Concurrency   int           // Number of requests in flight at once for each check.
+	LatencyBudget time.Duration // Maximum 95th percentile latency of each check, or 0 for no limit.
+}
+
+// HTTPClient sends HTTP requests.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Verifier runs suites of checks with an HTTP client.
+type Verifier struct {
+	client HTTPClient
+	now    func() time.Time
+}
+
+// New returns a Verifier that sends requests with the client.
+func New(client HTTPClient) *Verifier {
+	return &Verifier{
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// Result is the outcome of the requests sent for a check.
+type Result struct {
+	Check         Check
+	Requests      int
+	Failures      int           // Number of requests that errored or got an unexpected status code.
+	FirstFailure  string        // Reason of the first failed request, if any.
+	P95           time.Duration // 95th percentile latency of the requests.
+	LatencyBudget time.Duration
+}
+
+// Passed returns true if all the requests of the check succeeded within the latency budget.
+func (r Result) Passed() bool {
+	if r.Failures > 0 {
+		return false
+	}
+	return r.LatencyBudget == 0 || r.P95 <= r.LatencyBudget
+}
+
+// Report is the list of results of a suite.
+type Report []Result
+
+// Passed returns true if every check of the suite passed.
+func (r Report) Passed() bool {
+	for _, result := range r {
+		if !result.Passed() {
+			return false
+		}
+	}
+	return true
+}
+
+// Failed returns the number of checks that didn't pass.
+func (r Report) Failed() int {
+	var count int
+	for _, result := range r {
+		if !result.Passed() {
+			count++
+		}
+	}
+	return count
+}
+
+// HumanString returns the report as a table with one row per check.
+func (r Report) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	headers := []string{"Path", "Status", "Requests", "Failures", "P95", "Result"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, result := range r {
+		outcome := color.Green.Sprint("✔ passed")
+		switch {
+		case result.Failures > 0:
+			outcome = color.Red.Sprintf("✘ %s", result.FirstFailure)
+		case !result.Passed():
+			outcome = color.Red.Sprintf("✘ p95 over budget of %s", result.LatencyBudget)
+		}
+		fmt.Fprintf(writer, "  %s\t%d\t%d\t%d\t%s\t%s\n", result.Check.Path, result.Check.Status,
+			result.Requests, result.Failures, result.P95.Round(time.Millisecond), outcome)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// Run sends the requests of each check of the suite to the service at baseURL and returns their results.
+// Paths of the checks are relative to baseURL.
+func (v *Verifier) Run(ctx context.Context, baseURL string, suite Suite) Report {
+	requests, concurrency := suite.Requests, suite.Concurrency
+	if requests <= 0 {
+		requests = DefaultRequests
+	}
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	var report Report
+	for _, check := range suite.Checks {
+		if check.Status == 0 {
+			check.Status = DefaultStatus
+		}
+		result := v.runCheck(ctx, joinURL(baseURL, check.Path), check, requests, concurrency)
+		result.LatencyBudget = suite.LatencyBudget
+		report = append(report, result)
+	}
+	return report
+}
+
+func (v *Verifier) runCheck(ctx context.Context, url string, check Check, requests, concurrency int) Result {
+	latencies := make([]time.Duration, requests)
+	failures := make([]string, requests)
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				latencies[job], failures[job] = v.send(ctx, url, check.Status)
+			}
+		}()
+	}
+	for i := 0; i < requests; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	result := Result{
+		Check:    check,
+		Requests: requests,
+		P95:      percentile(latencies, latencyPercentile),
+	}
+	for _, failure := range failures {
+		if failure == "" {
+			continue
+		}
+		if result.Failures == 0 {
+			result.FirstFailure = failure
+		}
+		result.Failures++
+	}
+	return result
+}
+
+// send sends a GET request to the url and returns its latency, or the reason it failed.
+func (v *Verifier) send(ctx context.Context, url string, wantedStatus int) (time.Duration, string) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, fmt.Sprintf("create request: %v", err)
+	}
+	start := v.now()
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return v.now().Sub(start), fmt.Sprintf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	latency := v.now().Sub(start)
+	if resp.StatusCode != wantedStatus {
+		return latency, fmt.Sprintf("got status %d", resp.StatusCode)
+	}
+	return latency, ""
+}
+
+// percentile returns the latency under which p percent of the latencies fall.
+func percentile(latencies []time.Duration, p int) time.Duration {
+	if len(latencies) == 0 {
+		return 0
+	}
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := (p*len(sorted)+99)/100 - 1 // Nearest-rank method.
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+func joinURL(baseURL, path string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/" + strings.TrimPrefix(path, "/")
+}
+
+func underline(headings []string) []string {
+	var lines []string
+	for _, heading := range headings {
+		lines = append(lines, strings.Repeat("-", len(heading)))
+	}
+	return lines
+}
@@ -0,0 +1,182 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package verify
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock advances by step every time it's read.
+type fakeClock struct {
+	mu   sync.Mutex
+	t    time.Time
+	step time.Duration
+}
+
+func (c *fakeClock) now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.t = c.t.Add(c.step)
+	return c.t
+}
+
+func TestVerifier_Run(t *testing.T) {
+	testCases := map[string]struct {
+		inSuite     Suite
+		inClockStep time.Duration // Each request reads the clock before and after it's sent.
+
+		wantedReport Report
+		wantedPassed bool
+	}{
+		"passes when every request gets the expected status within the budget": {
+			inSuite: Suite{
+				Checks: []Check{
+					{Path: "/"},
+					{Path: "missing", Status: http.StatusNotFound},
+				},
+				Requests:      4,
+				Concurrency:   2,
+				LatencyBudget: 200 * time.Millisecond,
+			},
+			wantedReport: Report{
+				{
+					Check:         Check{Path: "/", Status: http.StatusOK},
+					Requests:      4,
+					LatencyBudget: 200 * time.Millisecond,
+				},
+				{
+					Check:         Check{Path: "missing", Status: http.StatusNotFound},
+					Requests:      4,
+					LatencyBudget: 200 * time.Millisecond,
+				},
+			},
+			wantedPassed: true,
+		},
+		"fails when requests get an unexpected status": {
+			inSuite: Suite{
+				Checks: []Check{
+					{Path: "/unavailable"},
+				},
+				Requests: 3,
+			},
+			inClockStep: 100 * time.Millisecond,
+			wantedReport: Report{
+				{
+					Check:        Check{Path: "/unavailable", Status: http.StatusOK},
+					Requests:     3,
+					Failures:     3,
+					FirstFailure: "got status 503",
+					P95:          100 * time.Millisecond,
+				},
+			},
+		},
+		"fails when the 95th percentile latency is over budget": {
+			inSuite: Suite{
+				Checks: []Check{
+					{Path: "/"},
+				},
+				LatencyBudget: 50 * time.Millisecond,
+			},
+			inClockStep: 100 * time.Millisecond,
+			wantedReport: Report{
+				{
+					Check:         Check{Path: "/", Status: http.StatusOK},
+					Requests:      DefaultRequests,
+					P95:           100 * time.Millisecond,
+					LatencyBudget: 50 * time.Millisecond,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var hits int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&hits, 1)
+				switch r.URL.Path {
+				case "/":
+					w.WriteHeader(http.StatusOK)
+				case "/unavailable":
+					w.WriteHeader(http.StatusServiceUnavailable)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+			clock := &fakeClock{step: tc.inClockStep}
+			v := &Verifier{
+				client: server.Client(),
+				now:    clock.now,
+			}
+
+			// WHEN
+			report := v.Run(context.Background(), server.URL+"/", tc.inSuite)
+
+			// THEN
+			require.Equal(t, tc.wantedReport, report)
+			require.Equal(t, tc.wantedPassed, report.Passed())
+			var wantedHits int
+			for _, result := range tc.wantedReport {
+				wantedHits += result.Requests
+			}
+			require.Equal(t, int32(wantedHits), atomic.LoadInt32(&hits))
+		})
+	}
+}
+
+func TestVerifier_Run_RequestError(t *testing.T) {
+	// GIVEN
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+	v := New(http.DefaultClient)
+
+	// WHEN
+	report := v.Run(context.Background(), url, Suite{
+		Checks:   []Check{{Path: "/"}},
+		Requests: 1,
+	})
+
+	// THEN
+	require.False(t, report.Passed())
+	require.Equal(t, 1, report.Failed())
+	require.Equal(t, 1, report[0].Failures)
+	require.Contains(t, report[0].FirstFailure, "request failed")
+}
+
+func TestPercentile(t *testing.T) {
+	testCases := map[string]struct {
+		in     []time.Duration
+		wanted time.Duration
+	}{
+		"no latencies": {},
+		"single latency": {
+			in:     []time.Duration{time.Second},
+			wanted: time.Second,
+		},
+		"picks the nearest rank": {
+			in: []time.Duration{
+				20 * time.Millisecond, 10 * time.Millisecond, 90 * time.Millisecond, 30 * time.Millisecond, 40 * time.Millisecond,
+				50 * time.Millisecond, 60 * time.Millisecond, 70 * time.Millisecond, 80 * time.Millisecond, 100 * time.Millisecond,
+			},
+			wanted: 100 * time.Millisecond,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, percentile(tc.in, latencyPercentile))
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package watch detects changes to the files under a directory by polling them.
+package watch
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+)
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// Watcher detects the files added, modified or removed under a directory.
+type Watcher struct {
+	fs    afero.Fs
+	root  string
+	files map[string]fileState // Nil until the files are first recorded.
+}
+
+// New returns a Watcher of the files under root.
+// Hidden directories, like .git, are not watched.
+func New(fs afero.Fs, root string) *Watcher {
+	return &Watcher{
+		fs:   fs,
+		root: root,
+	}
+}
+
+// Changed returns the sorted paths, relative to the root, of the files added, modified or removed since the previous call.
+// The first call records the files without reporting any change.
+func (w *Watcher) Changed() ([]string, error) {
+	files := make(map[string]fileState)
+	err := afero.Walk(w.fs, w.root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path != w.root && strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(w.root, path)
+		if err != nil {
+			return err
+		}
+		files[rel] = fileState{
+			modTime: info.ModTime(),
+			size:    info.Size(),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	prev := w.files
+	w.files = files
+	if prev == nil {
+		return nil, nil
+	}
+
+	var changed []string
+	for path, state := range files {
+		if prevState, ok := prev[path]; !ok || !prevState.modTime.Equal(state.modTime) || prevState.size != state.size {
+			changed = append(changed, path)
+		}
+	}
+	for path := range prev {
+		if _, ok := files[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+	return changed, nil
+}
@@ -0,0 +1,65 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package watch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcher_Changed(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	writeFile := func(path, content string) {
+		require.NoError(t, afero.WriteFile(fs, path, []byte(content), 0644))
+	}
+	writeFile("/ws/frontend/Dockerfile", "FROM nginx")
+	writeFile("/ws/frontend/index.html", "hello")
+	writeFile("/ws/frontend/styles.css", "body {}")
+	writeFile("/ws/frontend/.git/HEAD", "ref: refs/heads/main")
+	w := New(fs, "/ws/frontend")
+
+	// WHEN
+	changed, err := w.Changed()
+
+	// THEN
+	require.NoError(t, err)
+	require.Empty(t, changed, "the first call records the files")
+
+	// WHEN
+	changed, err = w.Changed()
+
+	// THEN
+	require.NoError(t, err)
+	require.Empty(t, changed)
+
+	// GIVEN
+	writeFile("/ws/frontend/index.html", "hello world")
+	writeFile("/ws/frontend/src/app.js", "console.log('hi')")
+	writeFile("/ws/frontend/.git/HEAD", "ref: refs/heads/feature")
+	require.NoError(t, fs.Remove("/ws/frontend/styles.css"))
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, fs.Chtimes("/ws/frontend/Dockerfile", later, later))
+
+	// WHEN
+	changed, err = w.Changed()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []string{"Dockerfile", "index.html", "src/app.js", "styles.css"}, changed)
+}
+
+func TestWatcher_ChangedMissingRoot(t *testing.T) {
+	// GIVEN
+	w := New(afero.NewMemMapFs(), "/ws/frontend")
+
+	// WHEN
+	_, err := w.Changed()
+
+	// THEN
+	require.Error(t, err)
+}
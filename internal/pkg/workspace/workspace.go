@@ -35,10 +35,13 @@ const (
 	SummaryFileName = ".workspace"
 
 	addonsDirName             = "addons"
+	cdkAppFileName            = "cdk.json"
 	maximumParentDirsToSearch = 5
 	pipelineFileName          = "pipeline.yml"
 	manifestFileName          = "manifest.yml"
 	buildspecFileName         = "buildspec.yml"
+	githubDirName             = ".github"
+	githubWorkflowsDirName    = "workflows"
 
 	ymlFileExtension = ".yml"
 
@@ -203,6 +206,15 @@ func (ws *Workspace) ReadJobManifest(name string) ([]byte, error) {
 	return mf, nil
 }
 
+// ReadWorkloadManifest returns the contents of the service or job's manifest under copilot/{name}/manifest.yml.
+func (ws *Workspace) ReadWorkloadManifest(name string) ([]byte, error) {
+	mf, err := ws.readWorkloadManifest(name)
+	if err != nil {
+		return nil, fmt.Errorf("read workload %s manifest file: %w", name, err)
+	}
+	return mf, nil
+}
+
 func (ws *Workspace) readWorkloadManifest(name string) ([]byte, error) {
 	return ws.read(name, manifestFileName)
 }
@@ -242,6 +254,27 @@ func (ws *Workspace) WriteJobManifest(marshaler encoding.BinaryMarshaler, name s
 	return ws.write(data, name, manifestFileName)
 }
 
+// OverwriteWorkloadManifest replaces the contents of the existing manifest under the copilot/{name}/ directory.
+// If successful returns the full path of the file, otherwise returns an empty string and the error.
+func (ws *Workspace) OverwriteWorkloadManifest(data []byte, name string) (string, error) {
+	copilotPath, err := ws.CopilotDirPath()
+	if err != nil {
+		return "", err
+	}
+	filename := filepath.Join(copilotPath, name, manifestFileName)
+	exist, err := ws.fsUtils.Exists(filename)
+	if err != nil {
+		return "", fmt.Errorf("check if manifest file %s exists: %w", filename, err)
+	}
+	if !exist {
+		return "", fmt.Errorf("manifest file %s does not exist", filename)
+	}
+	if err := ws.fsUtils.WriteFile(filename, data, 0644 /* -rw-r--r-- */); err != nil {
+		return "", fmt.Errorf("write manifest file: %w", err)
+	}
+	return filename, nil
+}
+
 // WritePipelineBuildspec writes the pipeline buildspec under the copilot/ directory.
 // If successful returns the full path of the file, otherwise returns an empty string and the error.
 func (ws *Workspace) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error) {
@@ -262,6 +295,17 @@ func (ws *Workspace) WritePipelineManifest(marshaler encoding.BinaryMarshaler) (
 	return ws.write(data, pipelineFileName)
 }
 
+// WriteGitHubActionsWorkflow writes a GitHub Actions workflow under the .github/workflows/ directory at the root of the workspace.
+// If successful returns the full path of the file, otherwise returns an empty string and the error.
+func (ws *Workspace) WriteGitHubActionsWorkflow(marshaler encoding.BinaryMarshaler, fileName string) (string, error) {
+	data, err := marshaler.MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("marshal github actions workflow to binary: %w", err)
+	}
+	// GitHub only picks up workflows from the root of the repository, which is the parent of the copilot directory.
+	return ws.write(data, "..", githubDirName, githubWorkflowsDirName, fileName)
+}
+
 // DeleteWorkspaceFile removes the .workspace file under copilot/ directory.
 // This will be called during app delete, we do not want to delete any other generated files.
 func (ws *Workspace) DeleteWorkspaceFile() error {
@@ -286,6 +330,35 @@ func (ws *Workspace) ReadAddonsDir(svcName string) ([]string, error) {
 	return names, nil
 }
 
+// ReadAddonCDKApps returns the absolute paths of the AWS CDK apps under the service's "addons/" directory.
+// A CDK app is a sub-directory of "addons/" that contains a "cdk.json" file.
+func (ws *Workspace) ReadAddonCDKApps(svcName string) ([]string, error) {
+	copilotPath, err := ws.CopilotDirPath()
+	if err != nil {
+		return nil, err
+	}
+	addonsPath := filepath.Join(copilotPath, svcName, addonsDirName)
+	files, err := ws.fsUtils.ReadDir(addonsPath)
+	if err != nil {
+		return nil, err
+	}
+	var apps []string
+	for _, f := range files {
+		if !f.IsDir() {
+			continue
+		}
+		appPath := filepath.Join(addonsPath, f.Name())
+		exists, err := ws.fsUtils.Exists(filepath.Join(appPath, cdkAppFileName))
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			apps = append(apps, appPath)
+		}
+	}
+	return apps, nil
+}
+
 // ReadAddon returns the contents of a file under the service's "addons/" directory.
 func (ws *Workspace) ReadAddon(svc, fname string) ([]byte, error) {
 	return ws.read(svc, addonsDirName, fname)
@@ -435,6 +508,15 @@ func (ws *Workspace) read(elem ...string) ([]byte, error) {
 	return ws.fsUtils.ReadFile(filepath.Join(pathElems...))
 }
 
+// ReadFile returns the contents of a file from its path relative to the root of the workspace.
+func (ws *Workspace) ReadFile(path string) ([]byte, error) {
+	copilotPath, err := ws.CopilotDirPath()
+	if err != nil {
+		return nil, err
+	}
+	return ws.fsUtils.ReadFile(filepath.Join(filepath.Dir(copilotPath), path))
+}
+
 // ListDockerfiles returns the list of Dockerfiles within the current
 // working directory and a sub-directory level below. If an error occurs while
 // reading directories, or no Dockerfiles found returns the error.
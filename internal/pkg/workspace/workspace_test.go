@@ -554,6 +554,59 @@ func TestWorkspace_read(t *testing.T) {
 	}
 }
 
+func TestWorkspace_ReadFile(t *testing.T) {
+	testCases := map[string]struct {
+		path string
+
+		copilotDir string
+		fs         func() afero.Fs
+
+		wantedData []byte
+		wantedErr  string
+	}{
+		"read file relative to the workspace root": {
+			path: "configs/dev.env",
+
+			copilotDir: "/workspace/copilot",
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				afero.WriteFile(fs, "/workspace/configs/dev.env", []byte("LOG_LEVEL=info"), 0644)
+				return fs
+			},
+
+			wantedData: []byte("LOG_LEVEL=info"),
+		},
+		"error if the file does not exist": {
+			path: "dev.env",
+
+			copilotDir: "/workspace/copilot",
+			fs:         afero.NewMemMapFs,
+
+			wantedErr: "open /workspace/dev.env: file does not exist",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ws := &Workspace{
+				copilotDir: tc.copilotDir,
+				fsUtils: &afero.Afero{
+					Fs: tc.fs(),
+				},
+			}
+
+			data, err := ws.ReadFile(tc.path)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedData, data)
+		})
+	}
+}
+
 func TestWorkspace_write(t *testing.T) {
 	testCases := map[string]struct {
 		elems []string
@@ -662,6 +715,65 @@ func TestWorkspace_ReadAddonsDir(t *testing.T) {
 	}
 }
 
+func TestWorkspace_ReadAddonCDKApps(t *testing.T) {
+	testCases := map[string]struct {
+		svcName        string
+		copilotDirPath string
+		fs             func() afero.Fs
+
+		wantedApps []string
+		wantedErr  error
+	}{
+		"dir not exist": {
+			svcName:        "webhook",
+			copilotDirPath: "/copilot",
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/webhook", 0755)
+				return fs
+			},
+			wantedErr: &os.PathError{
+				Op:   "open",
+				Path: "/copilot/webhook/addons",
+				Err:  os.ErrNotExist,
+			},
+		},
+		"retrieves only sub-directories with a cdk.json file": {
+			svcName:        "webhook",
+			copilotDirPath: "/copilot",
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/webhook/addons/queue", 0755)
+				fs.MkdirAll("/copilot/webhook/addons/scripts", 0755)
+				afero.WriteFile(fs, "/copilot/webhook/addons/queue/cdk.json", []byte(`{"app": "npx ts-node bin/queue.ts"}`), 0644)
+				afero.WriteFile(fs, "/copilot/webhook/addons/cdk.json", []byte("{}"), 0644)
+				afero.WriteFile(fs, "/copilot/webhook/addons/table.yml", []byte(""), 0644)
+				return fs
+			},
+			wantedApps: []string{"/copilot/webhook/addons/queue"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: tc.copilotDirPath,
+				fsUtils: &afero.Afero{
+					Fs: tc.fs(),
+				},
+			}
+
+			// WHEN
+			actualApps, actualErr := ws.ReadAddonCDKApps(tc.svcName)
+
+			// THEN
+			require.Equal(t, tc.wantedErr, actualErr)
+			require.Equal(t, tc.wantedApps, actualApps)
+		})
+	}
+}
+
 func TestWorkspace_WriteAddon(t *testing.T) {
 	testCases := map[string]struct {
 		marshaler   mockBinaryMarshaler
@@ -721,6 +833,110 @@ func TestWorkspace_WriteAddon(t *testing.T) {
 	}
 }
 
+func TestWorkspace_WriteGitHubActionsWorkflow(t *testing.T) {
+	testCases := map[string]struct {
+		marshaler mockBinaryMarshaler
+
+		wantedPath string
+		wantedErr  error
+	}{
+		"writes the workflow at the root of the repository": {
+			marshaler: mockBinaryMarshaler{
+				content: []byte("name: copilot"),
+			},
+
+			wantedPath: "/.github/workflows/copilot-phonetool.yml",
+		},
+		"wraps error if cannot marshal to binary": {
+			marshaler: mockBinaryMarshaler{
+				err: errors.New("some error"),
+			},
+
+			wantedErr: errors.New("marshal github actions workflow to binary: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			utils := &afero.Afero{
+				Fs: afero.NewMemMapFs(),
+			}
+			utils.MkdirAll("/copilot", 0755)
+			ws := &Workspace{
+				workingDir: "/",
+				copilotDir: "/copilot",
+				fsUtils:    utils,
+			}
+
+			// WHEN
+			actualPath, actualErr := ws.WriteGitHubActionsWorkflow(tc.marshaler, "copilot-phonetool.yml")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, actualErr, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, actualErr)
+				require.Equal(t, tc.wantedPath, actualPath)
+				out, err := utils.ReadFile(tc.wantedPath)
+				require.NoError(t, err)
+				require.Equal(t, tc.marshaler.content, out)
+			}
+		})
+	}
+}
+
+func TestWorkspace_OverwriteWorkloadManifest(t *testing.T) {
+	testCases := map[string]struct {
+		existingManifest []byte
+
+		wantedPath string
+		wantedErr  error
+	}{
+		"overwrites the existing manifest": {
+			existingManifest: []byte("name: webhook"),
+
+			wantedPath: "/copilot/webhook/manifest.yml",
+		},
+		"errors if the manifest does not exist": {
+			wantedErr: errors.New("manifest file /copilot/webhook/manifest.yml does not exist"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			utils := &afero.Afero{
+				Fs: fs,
+			}
+			utils.MkdirAll(filepath.Join("/", "copilot", "webhook"), 0755)
+			if tc.existingManifest != nil {
+				utils.WriteFile(filepath.Join("/", "copilot", "webhook", "manifest.yml"), tc.existingManifest, 0644)
+			}
+			ws := &Workspace{
+				workingDir: "/",
+				copilotDir: "/copilot",
+				fsUtils:    utils,
+			}
+
+			// WHEN
+			actualPath, actualErr := ws.OverwriteWorkloadManifest([]byte("name: webhook\ntype: Backend Service"), "webhook")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, actualErr, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, actualErr)
+				require.Equal(t, tc.wantedPath, actualPath)
+				out, err := utils.ReadFile(tc.wantedPath)
+				require.NoError(t, err)
+				require.Equal(t, []byte("name: webhook\ntype: Backend Service"), out)
+			}
+		})
+	}
+}
+
 func TestWorkspace_ReadPipelineManifest(t *testing.T) {
 	copilotDir := "/copilot"
 	testCases := map[string]struct {